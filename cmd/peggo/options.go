@@ -251,6 +251,11 @@ type Config struct {
 	ethNodeAlchemyWS      *string
 	ethGasPriceAdjustment *float64
 	ethMaxGasPrice        *string
+	ethDynamicFees        *bool
+	ethPriorityFeePolicy  *string
+	ethPriorityFee        *string
+	ethFeeBumpPercent     *int
+	ethStuckTxTimeout     *string
 
 	// Ethereum Key Management
 	ethKeystoreDir *string
@@ -266,12 +271,24 @@ type Config struct {
 	relayBatchOffsetDur   *string
 	pendingTxWaitDuration *string
 
+	relayBatchProfitabilityCheck *bool
+	ethPriceToken                *string
+
 	// Batch requester config
 	minBatchFeeUSD *float64
 
 	coingeckoApi        *string
+	priceFeedDefault    *string
+	priceFeedTokens     *string
 	loopDuration        *string
 	relayerLoopDuration *string
+
+	// Local state
+	stateDir *string
+
+	// HTTP API
+	apiListenAddr *string
+	apiAdminToken *string
 }
 
 func initConfig(cmd *cli.Cmd) Config {
@@ -389,6 +406,41 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "500gwei",
 	})
 
+	cfg.ethDynamicFees = cmd.Bool(cli.BoolOpt{
+		Name:   "eth_dynamic_fees",
+		Desc:   "If enabled, Ethereum transactions are sent as EIP-1559 dynamic-fee transactions (falls back to legacy if the network has no base fee)",
+		EnvVar: "PEGGO_ETH_DYNAMIC_FEES",
+		Value:  true,
+	})
+
+	cfg.ethPriorityFeePolicy = cmd.String(cli.StringOpt{
+		Name:   "eth_priority_fee_policy",
+		Desc:   "Priority fee policy for dynamic-fee transactions (suggested|fixed). Suggested tip is multiplied by eth_gas_price_adjustment",
+		EnvVar: "PEGGO_ETH_PRIORITY_FEE_POLICY",
+		Value:  "suggested",
+	})
+
+	cfg.ethPriorityFee = cmd.String(cli.StringOpt{
+		Name:   "eth_priority_fee",
+		Desc:   "Priority fee (tip) used with the fixed policy, in wei or gwei",
+		EnvVar: "PEGGO_ETH_PRIORITY_FEE",
+		Value:  "2gwei",
+	})
+
+	cfg.ethFeeBumpPercent = cmd.Int(cli.IntOpt{
+		Name:   "eth_fee_bump_percent",
+		Desc:   "Percentage by which fees of a stuck valset/batch submission are bumped when it is replaced using the same nonce (min 10)",
+		EnvVar: "PEGGO_ETH_FEE_BUMP_PERCENT",
+		Value:  20,
+	})
+
+	cfg.ethStuckTxTimeout = cmd.String(cli.StringOpt{
+		Name:   "eth_stuck_tx_timeout",
+		Desc:   "Time after which a pending valset/batch submission is considered stuck and gets replaced with bumped fees",
+		EnvVar: "PEGGO_ETH_STUCK_TX_TIMEOUT",
+		Value:  "10m",
+	})
+
 	cfg.ethKeystoreDir = cmd.String(cli.StringOpt{
 		Name:   "eth-keystore-dir",
 		Desc:   "Specify Ethereum keystore dir (Geth-format) prefix.",
@@ -457,6 +509,20 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "20m",
 	})
 
+	cfg.relayBatchProfitabilityCheck = cmd.Bool(cli.BoolOpt{
+		Name:   "relay_batch_profitability_check",
+		Desc:   "If enabled, relayer will relay a batch only if its fees cover the projected gas cost",
+		EnvVar: "PEGGO_RELAY_BATCH_PROFITABILITY_CHECK",
+		Value:  false,
+	})
+
+	cfg.ethPriceToken = cmd.String(cli.StringOpt{
+		Name:   "eth_price_token",
+		Desc:   "Token address used to price ETH in USD for the profitability check (WETH by default)",
+		EnvVar: "PEGGO_ETH_PRICE_TOKEN",
+		Value:  "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+	})
+
 	/** Batch Requester **/
 
 	cfg.minBatchFeeUSD = cmd.Float64(cli.Float64Opt{
@@ -475,6 +541,22 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "https://api.coingecko.com/api/v3",
 	})
 
+	/** Price feed **/
+
+	cfg.priceFeedDefault = cmd.String(cli.StringOpt{
+		Name:   "pricefeed_default",
+		Desc:   "Price source used for tokens without an explicit pricefeed_tokens entry (coingecko|none)",
+		EnvVar: "PEGGO_PRICEFEED_DEFAULT",
+		Value:  "coingecko",
+	})
+
+	cfg.priceFeedTokens = cmd.String(cli.StringOpt{
+		Name:   "pricefeed_tokens",
+		Desc:   "Comma-separated per-token price sources, e.g. 0xdAC1...=static:1,0xC02a...=oracle:pyth:<price_id>,0x2260...=oracle:provider:<provider>:<symbol>",
+		EnvVar: "PEGGO_PRICEFEED_TOKENS",
+		Value:  "",
+	})
+
 	/** Loop durations **/
 	cfg.loopDuration = cmd.String(cli.StringOpt{
 		Name:   "loop_duration",
@@ -490,5 +572,30 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "5m",
 	})
 
+	/** Local state **/
+
+	cfg.stateDir = cmd.String(cli.StringOpt{
+		Name:   "state_dir",
+		Desc:   "Directory of the local state DB used to resume after restarts (defaults to $HOME/.peggo/state)",
+		EnvVar: "PEGGO_STATE_DIR",
+		Value:  "",
+	})
+
+	/** HTTP API **/
+
+	cfg.apiListenAddr = cmd.String(cli.StringOpt{
+		Name:   "api_listen_addr",
+		Desc:   "If set, serves health, readiness, status, Prometheus metrics and admin endpoints on this address (e.g. 127.0.0.1:9191)",
+		EnvVar: "PEGGO_API_LISTEN_ADDR",
+		Value:  "",
+	})
+
+	cfg.apiAdminToken = cmd.String(cli.StringOpt{
+		Name:   "api_admin_token",
+		Desc:   "Bearer token required by the admin endpoints (pause/resume loops). Admin endpoints are disabled if empty",
+		EnvVar: "PEGGO_API_ADMIN_TOKEN",
+		Value:  "",
+	})
+
 	return cfg
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -12,9 +13,11 @@ import (
 	log "github.com/xlab/suplog"
 
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/api"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/pricefeed"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/version"
 )
 
@@ -54,6 +57,10 @@ func orchestratorCmd(cmd *cli.Cmd) {
 				MaxGasPrice:           *cfg.ethMaxGasPrice,
 				PendingTxWaitDuration: *cfg.pendingTxWaitDuration,
 				EthNodeAlchemyWS:      *cfg.ethNodeAlchemyWS,
+				DynamicFees:           *cfg.ethDynamicFees,
+				PriorityFeePolicy:     *cfg.ethPriorityFeePolicy,
+				PriorityFee:           *cfg.ethPriorityFee,
+				FeeBumpPercent:        uint64(*cfg.ethFeeBumpPercent),
 			}
 		)
 
@@ -61,6 +68,17 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			log.Fatalln("cannot use Ledger for orchestrator, since signatures must be realtime")
 		}
 
+		stuckTxTimeout, err := time.ParseDuration(*cfg.ethStuckTxTimeout)
+		orShutdown(errors.Wrap(err, "failed to parse stuck tx timeout"))
+		ethNetworkCfg.StuckTxTimeout = stuckTxTimeout
+
+		stateDir := *cfg.stateDir
+		if stateDir == "" {
+			homeDir, err := os.UserHomeDir()
+			orShutdown(errors.Wrap(err, "failed to get home dir"))
+			stateDir = filepath.Join(homeDir, ".peggo", "state")
+		}
+
 		log.WithFields(log.Fields{
 			"version":    version.AppVersion,
 			"git":        version.GitCommit,
@@ -69,6 +87,17 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			"go_arch":    version.GoArch,
 		}).Infoln("Peggo - Peggy module companion binary for bridging assets between Injective and Ethereum")
 
+		stateStore, err := store.NewStore(stateDir)
+		orShutdown(errors.Wrap(err, "failed to open local state"))
+		closer.Bind(func() {
+			if err := stateStore.Close(); err != nil {
+				log.WithError(err).Warningln("failed to close local state")
+			}
+		})
+		log.WithField("dir", stateDir).Infoln("opened local state")
+
+		ethNetworkCfg.PendingTxStore = stateStore
+
 		// 1. Connect to Injective network
 
 		cosmosKeyring, err := cosmos.NewKeyring(cosmosKeyringCfg)
@@ -114,6 +143,7 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			"rpc":                  *cfg.ethNodeRPC,
 			"max_gas_price":        *cfg.ethMaxGasPrice,
 			"gas_price_adjustment": *cfg.ethGasPriceAdjustment,
+			"dynamic_fees":         *cfg.ethDynamicFees,
 		}).Infoln("connected to Ethereum network")
 
 		var isValidator bool
@@ -161,17 +191,37 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			RelayValsets:         *cfg.relayValsets,
 			RelayBatches:         *cfg.relayBatches,
 			RelayerOnlyMode:      !isValidator,
+
+			RelayBatchProfitabilityCheck: *cfg.relayBatchProfitabilityCheck,
+			EthPriceToken:                gethcommon.HexToAddress(*cfg.ethPriceToken),
+			RelayResubmitDelay:           stuckTxTimeout,
 		}
 
+		priceFeed, err := initPriceFeed(cfg, cosmosNetwork)
+		orShutdown(errors.Wrap(err, "failed to initialize price feed"))
+
 		// Create peggo and run it
 		peggo, err := orchestrator.NewOrchestrator(
 			cosmosNetwork,
 			ethNetwork,
-			pricefeed.NewCoingeckoPriceFeed(100, &pricefeed.Config{BaseURL: *cfg.coingeckoApi}),
+			priceFeed,
+			stateStore,
 			orchestratorCfg,
 		)
 		orShutdown(err)
 
+		if *cfg.apiListenAddr != "" {
+			apiServer := api.NewServer(api.Config{
+				ListenAddr: *cfg.apiListenAddr,
+				AdminToken: *cfg.apiAdminToken,
+			}, peggo)
+
+			apiServer.Start()
+			closer.Bind(func() {
+				_ = apiServer.Close()
+			})
+		}
+
 		go func() {
 			if err := peggo.Run(ctx, cosmosNetwork, ethNetwork); err != nil {
 				log.Errorln(err)
@@ -182,3 +232,48 @@ func orchestratorCmd(cmd *cli.Cmd) {
 		closer.Hold()
 	}
 }
+
+// initPriceFeed builds the price source used for batch fee checks. Each token can be priced
+// by Coingecko, by Injective's oracle module or by a static value, with a default source
+// for tokens that are not explicitly configured.
+func initPriceFeed(cfg Config, inj cosmos.Network) (orchestrator.PriceFeed, error) {
+	var defaultFeed pricefeed.USDPriceFeed
+
+	coingeckoFeed := pricefeed.NewCoingeckoPriceFeed(100, &pricefeed.Config{BaseURL: *cfg.coingeckoApi})
+
+	switch *cfg.priceFeedDefault {
+	case string(pricefeed.SourceCoingecko):
+		defaultFeed = coingeckoFeed
+	case "none", "":
+	default:
+		return nil, errors.Errorf("unsupported default price source: %s", *cfg.priceFeedDefault)
+	}
+
+	sources, err := pricefeed.ParseTokenSources(*cfg.priceFeedTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		priceFeed     = pricefeed.NewMultiPriceFeed(defaultFeed)
+		staticFeed    = pricefeed.NewStaticPriceFeed(nil)
+		injectiveFeed = pricefeed.NewInjectivePriceFeed(inj, nil)
+	)
+
+	for _, src := range sources {
+		switch src.Source {
+		case pricefeed.SourceCoingecko:
+			priceFeed.SetFeed(src.Token, coingeckoFeed)
+		case pricefeed.SourceStatic:
+			staticFeed.SetPrice(src.Token, src.Static)
+			priceFeed.SetFeed(src.Token, staticFeed)
+		case pricefeed.SourceInjective:
+			injectiveFeed.SetSource(src.Token, src.Oracle)
+			priceFeed.SetFeed(src.Token, injectiveFeed)
+		}
+
+		log.WithFields(log.Fields{"token": src.Token.String(), "source": src.Source}).Infoln("configured token price source")
+	}
+
+	return priceFeed, nil
+}
@@ -164,6 +164,7 @@ import (
 	erc20types "github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
 	bankpc "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/bank"
 	exchangepc "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/exchange"
+	oraclepc "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/oracle"
 	stakingpc "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/staking"
 	cosmostracing "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/tracing"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange"
@@ -1191,6 +1192,12 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 					storetypes.TransientGasConfig(),
 				)
 			},
+			func(_ sdk.Context, rules ethparams.Rules) vm.PrecompiledContract {
+				return oraclepc.NewOracleContract(
+					&app.OracleKeeper, // oracle keeper is initialized below, the generator is only called later
+					storetypes.TransientGasConfig(),
+				)
+			},
 		},
 		cast.ToBool(appOpts.Get("evm.enable-grpc-tracing")),
 	)
@@ -1254,6 +1261,7 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 		app.AccountKeeper,
 		app.BankKeeper.(wasmxtypes.BankKeeper),
 		app.FeeGrantKeeper,
+		app.EvmKeeper,
 		authority,
 	)
 
@@ -1305,6 +1313,9 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 	)
 
 	app.ERC20Keeper = erc20keeper.NewKeeper(app.keys[erc20module.StoreKey], app.EvmKeeper, app.BankKeeper, app.AccountKeeper, app.TokenFactoryKeeper, authority)
+	// queue the ERC20 wrapper creation of bank denoms on their first transfer or EVM access
+	app.BankKeeper.AppendSendRestriction(app.ERC20Keeper.SendRestrictionFn)
+	app.EvmKeeper.SetHooks(evmkeeper.NewMultiEvmHooks(app.ERC20Keeper.Hooks()))
 
 	app.StakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(
 		app.DistrKeeper.Hooks(),
@@ -1416,11 +1427,14 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 		app.BankKeeper,
 		app.TokenFactoryKeeper,
 		app.WasmKeeper,
+		app.EvmKeeper,
+		app.ExchangeKeeper,
 		authtypes.NewModuleAddress(tokenfactorytypes.ModuleName).String(),
 		GetModuleAccAddresses(),
 		authority,
 	)
 	app.TokenFactoryKeeper.SetPermissionsKeeper(app.PermissionsKeeper)
+	app.ExchangeKeeper.SetPermissionsKeeper(app.PermissionsKeeper)
 
 	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
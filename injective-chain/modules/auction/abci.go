@@ -1,6 +1,7 @@
 package auction
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/InjectiveLabs/metrics"
@@ -24,11 +25,33 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 	logger.Info("Settling auction round...", "blockTimestamp", ctx.BlockTime().Unix(), "endingTimeStamp", endingTimeStamp)
 	auctionModuleAddress := am.accountKeeper.GetModuleAddress(auctiontypes.ModuleName)
 
-	// get and validate highest bid
-	lastBid := am.keeper.GetHighestBid(ctx)
+	params := am.keeper.GetParams(ctx)
+
+	auctionRound := am.keeper.GetAuctionRound(ctx)
+	roundAuctionMode := am.keeper.GetRoundAuctionMode(ctx)
+
+	// get and validate highest bid, sealed-bid rounds are won by the highest revealed bid
+	var lastBid *auctiontypes.Bid
+	if roundAuctionMode == auctiontypes.AuctionMode_SealedBid {
+		lastBid = am.keeper.SettleSealedBids(ctx, auctionRound)
+	} else {
+		lastBid = am.keeper.GetHighestBid(ctx)
+	}
 	lastBidAmount := lastBid.Amount.Amount
 
-	maxInjCap := am.keeper.GetParams(ctx).InjBasketMaxCap
+	maxInjCap := params.InjBasketMaxCap
+	// the module also holds the claimable refunds of sealed bid deposits, which are not part of the basket
+	claimableRefunds := am.keeper.GetTotalClaimableBidRefunds(ctx)
+
+	roundRecord := &auctiontypes.AuctionRoundRecord{
+		Round:            auctionRound,
+		Mode:             roundAuctionMode,
+		EndingTimestamp:  endingTimeStamp,
+		SettlementHeight: ctx.BlockHeight(),
+		Basket:           sdk.NewCoins(),
+		WinningBid:       chaintypes.NewInjectiveCoin(math.ZeroInt()),
+		Burned:           chaintypes.NewInjectiveCoin(math.ZeroInt()),
+	}
 
 	// settle auction round
 	if lastBidAmount.IsPositive() && lastBid.Bidder != "" {
@@ -48,6 +71,8 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 			if err != nil {
 				metrics.ReportFuncError(am.svcTags)
 				logger.Info(err.Error())
+			} else {
+				roundRecord.Burned = injBurnAmount
 			}
 		}
 
@@ -56,6 +81,7 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 		for _, coin := range coins {
 			// cap the amount of inj that can be sent to the winner
 			if coin.Denom == chaintypes.InjectiveCoin {
+				coin.Amount = math.MaxInt(coin.Amount.Sub(claimableRefunds), math.ZeroInt())
 				if coin.Amount.GT(maxInjCap) {
 					coin.Amount = maxInjCap
 				}
@@ -65,12 +91,14 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 				if err := am.bankKeeper.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, lastBidder, sdk.NewCoins(coin)); err != nil {
 					metrics.ReportFuncError(am.svcTags)
 					am.keeper.Logger(ctx).Error("Transferring coins to winner failed")
+				} else {
+					roundRecord.Basket = roundRecord.Basket.Add(coin)
 				}
 			}
 		}
 
-		// emit typed event for auction result
-		auctionRound := am.keeper.GetAuctionRound(ctx)
+		roundRecord.Winner = lastBid.Bidder
+		roundRecord.WinningBid = lastBid.Amount
 
 		// Store the auction result, so that it can be queried later
 		am.keeper.SetLastAuctionResult(ctx, auctiontypes.LastAuctionResult{
@@ -88,15 +116,25 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 
 		// clear bid
 		am.keeper.DeleteBid(ctx)
+	} else {
+		// the basket is rolled over into the next round
+		roundRecord.Basket = excludeInj(am.bankKeeper.GetAllBalances(ctx, auctionModuleAddress), claimableRefunds)
+		if injAmount := roundRecord.Basket.AmountOf(chaintypes.InjectiveCoin); injAmount.GT(maxInjCap) {
+			roundRecord.Basket = roundRecord.Basket.Sub(sdk.NewCoin(chaintypes.InjectiveCoin, injAmount.Sub(maxInjCap)))
+		}
 	}
 
+	am.keeper.ArchiveAuctionRound(ctx, roundRecord)
+
 	// advance auctionRound, endingTimestamp
 	nextRound := am.keeper.AdvanceNextAuctionRound(ctx)
 	nextEndingTimestamp := am.keeper.AdvanceNextEndingTimeStamp(ctx)
+	// auction mode changes take effect from the next round
+	am.keeper.SetRoundAuctionMode(ctx, params.AuctionMode)
 	// ping exchange module to flush fee for next round
 	balances := am.exchangeKeeper.WithdrawAllAuctionBalances(ctx)
 
-	newBasket := am.bankKeeper.GetAllBalances(ctx, auctionModuleAddress)
+	newBasket := excludeInj(am.bankKeeper.GetAllBalances(ctx, auctionModuleAddress), am.keeper.GetTotalClaimableBidRefunds(ctx))
 
 	// for correctness, emit the correct INJ value in the new basket in the event the INJ balances exceed the cap
 	newInjAmount := newBasket.AmountOf(chaintypes.InjectiveCoin)
@@ -118,3 +156,13 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 		logger.Info("💰 Auction module received", balances.String(), "new auction basket is now", newBasket.String())
 	}
 }
+
+// excludeInj returns the coins without the given INJ amount
+func excludeInj(coins sdk.Coins, amount math.Int) sdk.Coins {
+	injAmount := math.MinInt(coins.AmountOf(chaintypes.InjectiveCoin), amount)
+	if !injAmount.IsPositive() {
+		return coins
+	}
+
+	return coins.Sub(sdk.NewCoin(chaintypes.InjectiveCoin, injAmount))
+}
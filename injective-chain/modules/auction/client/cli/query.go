@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	"github.com/InjectiveLabs/injective-core/cli"
+	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
 )
 
@@ -15,6 +20,10 @@ func GetQueryCmd() *cobra.Command {
 		GetAuctionParamsCmd(),
 		GetAuctionInfo(),
 		GetLastAuctionResult(),
+		GetAuctionPhase(),
+		GetBidCommitments(),
+		GetAuctionRound(),
+		GetAuctionRounds(),
 	)
 	return cmd
 }
@@ -46,3 +55,65 @@ func GetLastAuctionResult() *cobra.Command {
 	cmd.Long = "Gets last auction result"
 	return cmd
 }
+
+func GetAuctionPhase() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"phase",
+		"Gets current auction round mode and phase",
+		types.NewQueryClient,
+		&types.QueryAuctionPhaseRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets current auction round mode and phase, including the ending time of the phase"
+	return cmd
+}
+
+func GetBidCommitments() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"bid-commitments [round]",
+		"Gets the bid commitments of a sealed-bid auction round",
+		types.NewQueryClient,
+		&types.QueryBidCommitmentsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the bid commitments and reveals of a sealed-bid auction round, the current round if 0"
+	return cmd
+}
+
+func GetAuctionRound() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"round [round]",
+		"Gets the archived result of a settled auction round",
+		types.NewQueryClient,
+		&types.QueryAuctionRoundRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the archived result of a settled auction round, including the basket, winner, winning bid, burned amount and number of participants"
+	return cmd
+}
+
+func GetAuctionRounds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rounds",
+		Short: "Gets the archived results of the settled auction rounds",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AuctionRounds(context.Background(), &types.QueryAuctionRoundsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "rounds")
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,9 +1,16 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/InjectiveLabs/injective-core/cli"
+	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
 )
@@ -11,6 +18,8 @@ import (
 const (
 	FlagRoundNumber = "round"
 	FlagBidAmount   = "bid"
+	FlagDeposit     = "deposit"
+	FlagSalt        = "salt"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/auction transaction commands.
@@ -19,6 +28,9 @@ func NewTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewBidCmd(),
+		NewCommitBidCmd(),
+		NewRevealBidCmd(),
+		NewClaimBidRefundCmd(),
 	)
 	return cmd
 }
@@ -35,3 +47,140 @@ func NewBidCmd() *cobra.Command {
 	cmd.Flags().Uint64(FlagRoundNumber, 4, "Auction round number")
 	return cmd
 }
+
+func NewCommitBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bid",
+		Args:  cobra.ExactArgs(0),
+		Short: "commit a sealed bid on current exchange basket",
+		Long: `Commit a sealed bid on current exchange basket in a sealed-bid auction round.
+
+The commitment is computed locally from the bid amount and salt, which must be kept to reveal the bid
+with the reveal-bid command during the reveal phase. The deposit is locked until the end of the round
+and caps the revealed bid.`,
+		Example: `injectived tx auction commit-bid --bid="100000000000000000000inj" --deposit="150000000000000000000inj" --salt="<32 random bytes in hex>" --round=4 --from=genesis --keyring-backend=file --yes`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidAmount, round, salt, err := parseSealedBidFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit := bidAmount
+			if depositStr != "" {
+				if deposit, err = sdk.ParseCoinNormalized(depositStr); err != nil {
+					return err
+				}
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg := &types.MsgCommitBid{
+				Sender:     from.String(),
+				Round:      round,
+				Commitment: types.ComputeBidCommitment(from, round, bidAmount, salt),
+				Deposit:    deposit,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBidAmount, "", "Auction bid amount")
+	cmd.Flags().String(FlagDeposit, "", "Deposit locked with the commitment, defaults to the bid amount")
+	cmd.Flags().String(FlagSalt, "", "Hex encoded salt of at least 16 bytes")
+	cmd.Flags().Uint64(FlagRoundNumber, 0, "Auction round number")
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevealBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reveal-bid",
+		Args:    cobra.ExactArgs(0),
+		Short:   "reveal a sealed bid committed with commit-bid",
+		Example: `injectived tx auction reveal-bid --bid="100000000000000000000inj" --salt="<salt used in commit-bid>" --round=4 --from=genesis --keyring-backend=file --yes`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidAmount, round, salt, err := parseSealedBidFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevealBid{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Round:     round,
+				BidAmount: bidAmount,
+				Salt:      salt,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBidAmount, "", "Auction bid amount")
+	cmd.Flags().String(FlagSalt, "", "Hex encoded salt used to commit the bid")
+	cmd.Flags().Uint64(FlagRoundNumber, 0, "Auction round number")
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClaimBidRefundCmd() *cobra.Command {
+	cmd := cli.TxCmd("claim-bid-refund",
+		"claim the sealed bid deposit refunds which couldn't be sent when the rounds were settled",
+		&types.MsgClaimBidRefund{},
+		cli.FlagsMapping{},
+		cli.ArgsMapping{},
+	)
+	cmd.Example = `injectived tx auction claim-bid-refund --from=genesis --keyring-backend=file --yes`
+	return cmd
+}
+
+func parseSealedBidFlags(cmd *cobra.Command) (bidAmount sdk.Coin, round uint64, salt []byte, err error) {
+	bidAmountStr, err := cmd.Flags().GetString(FlagBidAmount)
+	if err != nil {
+		return bidAmount, 0, nil, err
+	}
+
+	bidAmount, err = sdk.ParseCoinNormalized(bidAmountStr)
+	if err != nil {
+		return bidAmount, 0, nil, err
+	}
+
+	round, err = cmd.Flags().GetUint64(FlagRoundNumber)
+	if err != nil {
+		return bidAmount, 0, nil, err
+	}
+
+	saltStr, err := cmd.Flags().GetString(FlagSalt)
+	if err != nil {
+		return bidAmount, 0, nil, err
+	}
+
+	salt, err = hex.DecodeString(saltStr)
+	if err != nil {
+		return bidAmount, 0, nil, fmt.Errorf("invalid salt: %w", err)
+	}
+
+	return bidAmount, round, salt, nil
+}
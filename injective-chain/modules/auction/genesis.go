@@ -30,6 +30,24 @@ func InitGenesis(ctx sdk.Context, keeper auctionkeeper.Keeper, data types.Genesi
 		keeper.SetLastAuctionResult(ctx, *data.LastAuctionResult)
 	}
 
+	keeper.SetRoundAuctionMode(ctx, data.RoundAuctionMode)
+
+	for idx := range data.BidCommitments {
+		keeper.SetBidCommitment(ctx, &data.BidCommitments[idx])
+	}
+
+	for idx := range data.AuctionRoundRecords {
+		keeper.SetAuctionRoundRecord(ctx, &data.AuctionRoundRecords[idx])
+	}
+
+	for _, bidder := range data.RoundBidders {
+		keeper.AddRoundBidder(ctx, sdk.MustAccAddressFromBech32(bidder))
+	}
+
+	for idx := range data.ClaimableBidRefunds {
+		keeper.SetClaimableBidRefund(ctx, &data.ClaimableBidRefunds[idx])
+	}
+
 	keeper.CreateModuleAccount(ctx)
 }
 
@@ -40,5 +58,10 @@ func ExportGenesis(ctx sdk.Context, k auctionkeeper.Keeper) *types.GenesisState
 		HighestBid:             k.GetHighestBid(ctx),
 		AuctionEndingTimestamp: k.GetEndingTimeStamp(ctx),
 		LastAuctionResult:      k.GetLastAuctionResult(ctx),
+		RoundAuctionMode:       k.GetRoundAuctionMode(ctx),
+		BidCommitments:         k.GetAllBidCommitments(ctx),
+		AuctionRoundRecords:    k.GetAllAuctionRoundRecords(ctx),
+		RoundBidders:           k.GetRoundBidders(ctx),
+		ClaimableBidRefunds:    k.GetAllClaimableBidRefunds(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/InjectiveLabs/metrics"
@@ -37,12 +38,21 @@ func (k *Keeper) CurrentAuctionBasket(c context.Context, _ *types.QueryCurrentAu
 
 	coins := k.bankKeeper.GetAllBalances(ctx, auctionModuleAddress)
 	lastBid := k.GetHighestBid(ctx)
+	round := k.GetAuctionRound(ctx)
+
+	// the deposits of sealed bids are held by the module until the round is settled
+	lockedInj := lastBid.Amount.Amount
+	if k.GetRoundAuctionMode(ctx) == types.AuctionMode_SealedBid {
+		lockedInj = k.GetUnsettledBidDeposits(ctx, round)
+	}
+	// claimable refunds are held by the module until they are claimed
+	lockedInj = lockedInj.Add(k.GetTotalClaimableBidRefunds(ctx))
 
 	currentBasketCoins := make([]sdk.Coin, 0)
 	for _, coin := range coins {
-		// We subtract the current highest bid amount from the basket
+		// We subtract the current highest bid amount or the sealed bid deposits from the basket
 		if coin.Denom == chaintypes.InjectiveCoin {
-			coin = coin.SubAmount(lastBid.Amount.Amount)
+			coin = coin.SubAmount(lockedInj)
 			maxCap := k.GetParams(ctx).InjBasketMaxCap
 
 			if coin.Amount.GT(maxCap) {
@@ -54,7 +64,7 @@ func (k *Keeper) CurrentAuctionBasket(c context.Context, _ *types.QueryCurrentAu
 
 	closingTime := k.GetEndingTimeStamp(ctx)
 	res := &types.QueryCurrentAuctionBasketResponse{
-		AuctionRound:       k.GetAuctionRound(ctx),
+		AuctionRound:       round,
 		AuctionClosingTime: uint64(closingTime),
 		HighestBidAmount:   lastBid.Amount.Amount,
 		HighestBidder:      lastBid.Bidder,
@@ -75,6 +85,11 @@ func (k *Keeper) AuctionModuleState(c context.Context, _ *types.QueryModuleState
 			AuctionRound:           k.GetAuctionRound(ctx),
 			HighestBid:             k.GetHighestBid(ctx),
 			AuctionEndingTimestamp: k.GetEndingTimeStamp(ctx),
+			RoundAuctionMode:       k.GetRoundAuctionMode(ctx),
+			BidCommitments:         k.GetAllBidCommitments(ctx),
+			AuctionRoundRecords:    k.GetAllAuctionRoundRecords(ctx),
+			RoundBidders:           k.GetRoundBidders(ctx),
+			ClaimableBidRefunds:    k.GetAllClaimableBidRefunds(ctx),
 		},
 	}
 	return res, nil
@@ -90,3 +105,75 @@ func (k *Keeper) LastAuctionResult(c context.Context, _ *types.QueryLastAuctionR
 	}
 	return res, nil
 }
+
+func (k *Keeper) AuctionPhase(c context.Context, _ *types.QueryAuctionPhaseRequest) (*types.QueryAuctionPhaseResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	mode, phase, phaseEndingTimestamp := k.GetAuctionPhase(ctx)
+
+	res := &types.QueryAuctionPhaseResponse{
+		Round:                  k.GetAuctionRound(ctx),
+		Mode:                   mode,
+		Phase:                  phase,
+		PhaseEndingTimestamp:   phaseEndingTimestamp,
+		AuctionEndingTimestamp: k.GetEndingTimeStamp(ctx),
+	}
+	return res, nil
+}
+
+func (k *Keeper) BidCommitments(c context.Context, req *types.QueryBidCommitmentsRequest) (*types.QueryBidCommitmentsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	round := req.Round
+	if round == 0 {
+		round = k.GetAuctionRound(ctx)
+	}
+
+	res := &types.QueryBidCommitmentsResponse{
+		Commitments: k.GetBidCommitments(ctx, round),
+	}
+	return res, nil
+}
+
+func (k *Keeper) AuctionRound(c context.Context, req *types.QueryAuctionRoundRequest) (*types.QueryAuctionRoundResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record := k.GetAuctionRoundRecord(ctx, req.Round)
+	if record == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrAuctionRoundNotFound, "round %d", req.Round)
+	}
+
+	res := &types.QueryAuctionRoundResponse{
+		Record: *record,
+	}
+	return res, nil
+}
+
+func (k *Keeper) AuctionRounds(c context.Context, req *types.QueryAuctionRoundsRequest) (*types.QueryAuctionRoundsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, pageRes, err := k.GetAuctionRoundRecordsPage(ctx, req.Pagination)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	res := &types.QueryAuctionRoundsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}
+	return res, nil
+}
@@ -60,19 +60,14 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 	params := k.GetParams(ctx)
 
 	// check bidders whitelist FIRST to prevent information leakage
-	if len(params.BiddersWhitelist) > 0 {
-		// If whitelist is populated, only allow whitelisted addresses to bid
-		isWhitelisted := false
-		for _, whitelistedAddr := range params.BiddersWhitelist {
-			if msg.Sender == whitelistedAddr {
-				isWhitelisted = true
-				break
-			}
-		}
-		if !isWhitelisted {
-			metrics.ReportFuncError(k.svcTags)
-			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not in bidders whitelist", msg.Sender)
-		}
+	if err := checkBiddersWhitelist(params, msg.Sender); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if mode := k.GetRoundAuctionMode(ctx); mode != types.AuctionMode_English {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalidAuctionMode, "current round is a %s auction, bids must be committed", mode.String())
 	}
 
 	round := k.GetAuctionRound(ctx)
@@ -126,6 +121,7 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 
 	// set new bid to store
 	k.SetBid(ctx, msg.Sender, msg.BidAmount)
+	k.AddRoundBidder(ctx, senderAddr)
 
 	// emit typed event for bid
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventBid{
@@ -136,6 +132,169 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 	return &types.MsgBidResponse{}, nil
 }
 
+func (k msgServer) CommitBid(goCtx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkBiddersWhitelist(k.GetParams(ctx), msg.Sender); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if err := k.checkSealedBidRound(ctx, msg.Round, types.AuctionPhase_Commit); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+
+	if k.GetBidCommitment(ctx, msg.Round, senderAddr) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrBidCommitmentExists, "sender %s already committed a bid in round %d", msg.Sender, msg.Round)
+	}
+
+	// lock the deposit until the end of the round
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, sdk.NewCoins(msg.Deposit)); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("Bidder deposit failed", "senderAddr", senderAddr.String(), "coin", msg.Deposit.String())
+		return nil, errors.Wrap(err, "deposit failed")
+	}
+
+	commitment := types.NewBidCommitment(senderAddr, msg.Round, msg.Commitment, msg.Deposit, ctx.BlockHeight())
+	k.SetBidCommitment(ctx, commitment)
+	k.AddRoundBidder(ctx, senderAddr)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventBidCommitment{
+		Bidder:     msg.Sender,
+		Round:      msg.Round,
+		Commitment: msg.Commitment,
+		Deposit:    msg.Deposit,
+	})
+	return &types.MsgCommitBidResponse{}, nil
+}
+
+func (k msgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkSealedBidRound(ctx, msg.Round, types.AuctionPhase_Reveal); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+
+	commitment := k.GetBidCommitment(ctx, msg.Round, senderAddr)
+	if commitment == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrBidCommitmentNotFound, "sender %s has no bid commitment in round %d", msg.Sender, msg.Round)
+	}
+
+	if commitment.Revealed {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidCommitment, "bid commitment already revealed")
+	}
+
+	if !commitment.Matches(msg.BidAmount, msg.Salt) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidCommitment, "bid amount and salt don't match the commitment")
+	}
+
+	if msg.BidAmount.Amount.GT(commitment.Deposit.Amount) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalidBidCommitment, "bid %s exceeds deposit %s", msg.BidAmount.String(), commitment.Deposit.String())
+	}
+
+	commitment.Reveal(msg.BidAmount, ctx.BlockHeight())
+	k.SetBidCommitment(ctx, commitment)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventBidReveal{
+		Bidder: msg.Sender,
+		Round:  msg.Round,
+		Amount: msg.BidAmount,
+	})
+	return &types.MsgRevealBidResponse{}, nil
+}
+
+func (k msgServer) ClaimBidRefund(goCtx context.Context, msg *types.MsgClaimBidRefund) (*types.MsgClaimBidRefundResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+
+	refund := k.GetClaimableBidRefund(ctx, senderAddr)
+	if !refund.IsPositive() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrNoClaimableBidRefund, "sender %s has no claimable bid refund", msg.Sender)
+	}
+
+	k.DeleteClaimableBidRefund(ctx, senderAddr)
+
+	refundCoins := sdk.NewCoins(sdk.NewCoin(chaintypes.InjectiveCoin, refund))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, refundCoins); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(err, "bid refund failed")
+	}
+
+	return &types.MsgClaimBidRefundResponse{}, nil
+}
+
+// checkSealedBidRound checks that the current round is a sealed-bid round in the expected phase
+func (k msgServer) checkSealedBidRound(ctx sdk.Context, round uint64, expectedPhase types.AuctionPhase) error {
+	currentRound := k.GetAuctionRound(ctx)
+	if currentRound == 0 {
+		return errors.Wrap(types.ErrBidRound, "auction has not been initialized")
+	}
+
+	if round != currentRound {
+		return errors.Wrapf(types.ErrBidRound, "current round is %d but got bid for %d", currentRound, round)
+	}
+
+	mode, phase, _ := k.GetAuctionPhase(ctx)
+	if mode != types.AuctionMode_SealedBid {
+		return errors.Wrapf(types.ErrInvalidAuctionMode, "current round is a %s auction", mode.String())
+	}
+
+	if phase != expectedPhase {
+		return errors.Wrapf(types.ErrInvalidAuctionPhase, "expected %s phase but round is in %s phase", expectedPhase.String(), phase.String())
+	}
+
+	return nil
+}
+
+// checkBiddersWhitelist checks that the sender is allowed to bid when the bidders whitelist is populated
+func checkBiddersWhitelist(params types.Params, sender string) error {
+	if len(params.BiddersWhitelist) == 0 {
+		return nil
+	}
+
+	for _, whitelistedAddr := range params.BiddersWhitelist {
+		if sender == whitelistedAddr {
+			return nil
+		}
+	}
+
+	return errors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not in bidders whitelist", sender)
+}
+
 func (k msgServer) refundLastBidder(ctx sdk.Context) error {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
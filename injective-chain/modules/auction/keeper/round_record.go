@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+)
+
+// AddRoundBidder records the bidder as a participant of the current round
+func (k *Keeper) AddRoundBidder(ctx sdk.Context, bidder sdk.AccAddress) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	ctx.KVStore(k.storeKey).Set(types.GetRoundBidderKey(bidder), []byte{1})
+}
+
+// GetRoundBidders returns the participants of the current round
+func (k *Keeper) GetRoundBidders(ctx sdk.Context) []string {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bidders := make([]string, 0)
+	bidderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RoundBiddersKey)
+
+	iterator := bidderStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bidders = append(bidders, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return bidders
+}
+
+// clearRoundBidders deletes the participants of the current round and returns their number
+func (k *Keeper) clearRoundBidders(ctx sdk.Context) uint64 {
+	bidderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RoundBiddersKey)
+	iterator := bidderStore.Iterator(nil, nil)
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		bidderStore.Delete(key)
+	}
+
+	return uint64(len(keys))
+}
+
+// ArchiveAuctionRound stores the result of the settled round along with its number of participants, prunes the
+// records and bid commitments exceeding the MaxRoundRecords param and emits the record.
+func (k *Keeper) ArchiveAuctionRound(ctx sdk.Context, record *types.AuctionRoundRecord) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	record.ParticipantCount = k.clearRoundBidders(ctx)
+	k.SetAuctionRoundRecord(ctx, record)
+	k.pruneAuctionRoundRecords(ctx, record.Round)
+	k.pruneBidCommitments(ctx, record.Round)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventAuctionRoundSettled{
+		Record: *record,
+	})
+}
+
+func (k *Keeper) SetAuctionRoundRecord(ctx sdk.Context, record *types.AuctionRoundRecord) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	ctx.KVStore(k.storeKey).Set(types.GetAuctionRoundRecordKey(record.Round), k.cdc.MustMarshal(record))
+}
+
+func (k *Keeper) GetAuctionRoundRecord(ctx sdk.Context, round uint64) *types.AuctionRoundRecord {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAuctionRoundRecordKey(round))
+	if bz == nil {
+		return nil
+	}
+
+	var record types.AuctionRoundRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// pruneAuctionRoundRecords deletes the records of the rounds older than the MaxRoundRecords most recent ones
+func (k *Keeper) pruneAuctionRoundRecords(ctx sdk.Context, latestRound uint64) {
+	maxRoundRecords := k.GetParams(ctx).MaxRoundRecords
+	if maxRoundRecords == 0 || latestRound <= maxRoundRecords {
+		return
+	}
+
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRoundRecordsKey)
+	iterator := recordStore.Iterator(nil, sdk.Uint64ToBigEndian(latestRound-maxRoundRecords+1))
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		recordStore.Delete(key)
+	}
+}
+
+// GetAllAuctionRoundRecords returns the archived results of all rounds
+func (k *Keeper) GetAllAuctionRoundRecords(ctx sdk.Context) []types.AuctionRoundRecord {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	records := make([]types.AuctionRoundRecord, 0)
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRoundRecordsKey)
+
+	iterator := recordStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.AuctionRoundRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAuctionRoundRecordsPage returns a page of the archived round results, ordered by round
+func (k *Keeper) GetAuctionRoundRecordsPage(ctx sdk.Context, pagination *query.PageRequest) ([]types.AuctionRoundRecord, *query.PageResponse, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	records := make([]types.AuctionRoundRecord, 0)
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRoundRecordsKey)
+
+	pageRes, err := query.Paginate(recordStore, pagination, func(_, value []byte) error {
+		var record types.AuctionRoundRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// GetRoundAuctionMode returns the auction mode of the current round, which is fixed when the round starts.
+func (k *Keeper) GetRoundAuctionMode(ctx sdk.Context) types.AuctionMode {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyRoundAuctionMode)
+	if bz == nil {
+		return types.AuctionMode_English
+	}
+
+	return types.AuctionMode(sdk.BigEndianToUint64(bz))
+}
+
+func (k *Keeper) SetRoundAuctionMode(ctx sdk.Context, mode types.AuctionMode) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	ctx.KVStore(k.storeKey).Set(types.KeyRoundAuctionMode, sdk.Uint64ToBigEndian(uint64(mode)))
+}
+
+// GetAuctionPhase returns the auction mode and phase of the current round along with the ending timestamp of the phase.
+// Sealed-bid rounds accept commitments until the reveal period before the end of the round, and reveals afterwards.
+func (k *Keeper) GetAuctionPhase(ctx sdk.Context) (mode types.AuctionMode, phase types.AuctionPhase, phaseEndingTimestamp int64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	mode = k.GetRoundAuctionMode(ctx)
+	endingTimestamp := k.GetEndingTimeStamp(ctx)
+
+	if mode != types.AuctionMode_SealedBid {
+		return mode, types.AuctionPhase_Bidding, endingTimestamp
+	}
+
+	revealStartTimestamp := endingTimestamp - k.GetParams(ctx).RevealPeriod
+	if ctx.BlockTime().Unix() < revealStartTimestamp {
+		return mode, types.AuctionPhase_Commit, revealStartTimestamp
+	}
+
+	return mode, types.AuctionPhase_Reveal, endingTimestamp
+}
+
+func (k *Keeper) GetBidCommitment(ctx sdk.Context, round uint64, bidder sdk.AccAddress) *types.BidCommitment {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBidCommitmentKey(round, bidder))
+	if bz == nil {
+		return nil
+	}
+
+	var commitment types.BidCommitment
+	k.cdc.MustUnmarshal(bz, &commitment)
+	return &commitment
+}
+
+func (k *Keeper) SetBidCommitment(ctx sdk.Context, commitment *types.BidCommitment) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bidder := sdk.MustAccAddressFromBech32(commitment.Bidder)
+	ctx.KVStore(k.storeKey).Set(types.GetBidCommitmentKey(commitment.Round, bidder), k.cdc.MustMarshal(commitment))
+}
+
+// GetBidCommitments returns the bid commitments of the round
+func (k *Keeper) GetBidCommitments(ctx sdk.Context, round uint64) []types.BidCommitment {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	return k.iterateBidCommitments(ctx, types.GetBidCommitmentsPrefix(round))
+}
+
+// GetAllBidCommitments returns the bid commitments of all rounds
+func (k *Keeper) GetAllBidCommitments(ctx sdk.Context) []types.BidCommitment {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	return k.iterateBidCommitments(ctx, types.BidCommitmentsKey)
+}
+
+func (k *Keeper) iterateBidCommitments(ctx sdk.Context, prefixKey []byte) []types.BidCommitment {
+	commitments := make([]types.BidCommitment, 0)
+	commitmentStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+
+	iterator := commitmentStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.BidCommitment
+		k.cdc.MustUnmarshal(iterator.Value(), &commitment)
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments
+}
+
+// GetUnsettledBidDeposits returns the total INJ deposited with the bid commitments of the round which are still held
+// by the auction module.
+func (k *Keeper) GetUnsettledBidDeposits(ctx sdk.Context, round uint64) math.Int {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	total := math.ZeroInt()
+	for _, commitment := range k.GetBidCommitments(ctx, round) {
+		if !commitment.Settled {
+			total = total.Add(commitment.Deposit.Amount)
+		}
+	}
+
+	return total
+}
+
+// SettleSealedBids determines the winner of the sealed-bid round as the highest revealed bid, the earliest commitment
+// winning ties, and refunds the deposits. The winning bid stays in the auction module and is returned to be settled
+// like an English auction bid. Unrevealed commitments are refunded in full, and refunds which can't be sent are kept as
+// claimable refunds. The settled commitments are kept as the audit trail of the round until the round record is pruned.
+func (k *Keeper) SettleSealedBids(ctx sdk.Context, round uint64) *types.Bid {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	commitments := k.GetBidCommitments(ctx, round)
+
+	var winner *types.BidCommitment
+	for idx := range commitments {
+		commitment := &commitments[idx]
+		if commitment.Settled || !commitment.Revealed || !commitment.RevealedAmount.IsPositive() {
+			continue
+		}
+
+		if winner == nil || commitment.IsBetterThan(winner) {
+			winner = commitment
+		}
+	}
+
+	for idx := range commitments {
+		commitment := &commitments[idx]
+		if commitment.Settled {
+			continue
+		}
+
+		refund := commitment.Deposit.Amount
+		if commitment == winner {
+			refund = refund.Sub(winner.RevealedAmount.Amount)
+		}
+
+		if refund.IsPositive() {
+			bidder := sdk.MustAccAddressFromBech32(commitment.Bidder)
+			refundCoins := sdk.NewCoins(sdk.NewCoin(chaintypes.InjectiveCoin, refund))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, refundCoins); err != nil {
+				metrics.ReportFuncError(k.svcTags)
+				k.Logger(ctx).Error("Bid deposit refund failed, keeping it claimable", "bidder", commitment.Bidder, "coin", refundCoins.String(), "err", err.Error())
+				k.addClaimableBidRefund(ctx, bidder, refund)
+			}
+		}
+
+		commitment.Settled = true
+		k.SetBidCommitment(ctx, commitment)
+	}
+
+	if winner == nil {
+		return &types.Bid{
+			Bidder: "",
+			Amount: chaintypes.NewInjectiveCoin(math.ZeroInt()),
+		}
+	}
+
+	return &types.Bid{
+		Bidder: winner.Bidder,
+		Amount: winner.RevealedAmount,
+	}
+}
+
+// pruneBidCommitments deletes the bid commitments of the rounds older than the MaxRoundRecords most recent ones, along
+// with their round records
+func (k *Keeper) pruneBidCommitments(ctx sdk.Context, latestRound uint64) {
+	maxRoundRecords := k.GetParams(ctx).MaxRoundRecords
+	if maxRoundRecords == 0 || latestRound <= maxRoundRecords {
+		return
+	}
+
+	commitmentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidCommitmentsKey)
+	iterator := commitmentStore.Iterator(nil, sdk.Uint64ToBigEndian(latestRound-maxRoundRecords+1))
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		commitmentStore.Delete(key)
+	}
+}
+
+// GetClaimableBidRefund returns the INJ amount of the sealed bid deposit refunds the bidder can claim
+func (k *Keeper) GetClaimableBidRefund(ctx sdk.Context, bidder sdk.AccAddress) math.Int {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClaimableBidRefundKey(bidder))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var refund types.ClaimableBidRefund
+	k.cdc.MustUnmarshal(bz, &refund)
+	return refund.Amount.Amount
+}
+
+func (k *Keeper) SetClaimableBidRefund(ctx sdk.Context, refund *types.ClaimableBidRefund) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bidder := sdk.MustAccAddressFromBech32(refund.Bidder)
+	ctx.KVStore(k.storeKey).Set(types.GetClaimableBidRefundKey(bidder), k.cdc.MustMarshal(refund))
+}
+
+func (k *Keeper) DeleteClaimableBidRefund(ctx sdk.Context, bidder sdk.AccAddress) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	ctx.KVStore(k.storeKey).Delete(types.GetClaimableBidRefundKey(bidder))
+}
+
+func (k *Keeper) addClaimableBidRefund(ctx sdk.Context, bidder sdk.AccAddress, amount math.Int) {
+	k.SetClaimableBidRefund(ctx, &types.ClaimableBidRefund{
+		Bidder: bidder.String(),
+		Amount: chaintypes.NewInjectiveCoin(k.GetClaimableBidRefund(ctx, bidder).Add(amount)),
+	})
+}
+
+// GetAllClaimableBidRefunds returns the claimable sealed bid deposit refunds of all bidders
+func (k *Keeper) GetAllClaimableBidRefunds(ctx sdk.Context) []types.ClaimableBidRefund {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	refunds := make([]types.ClaimableBidRefund, 0)
+	refundStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClaimableBidRefundsKey)
+
+	iterator := refundStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.ClaimableBidRefund
+		k.cdc.MustUnmarshal(iterator.Value(), &refund)
+		refunds = append(refunds, refund)
+	}
+
+	return refunds
+}
+
+// GetTotalClaimableBidRefunds returns the INJ held by the auction module for claimable refunds, which is not part of
+// the auction basket
+func (k *Keeper) GetTotalClaimableBidRefunds(ctx sdk.Context) math.Int {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	total := math.ZeroInt()
+	for _, refund := range k.GetAllClaimableBidRefunds(ctx) {
+		total = total.Add(refund.Amount.Amount)
+	}
+
+	return total
+}
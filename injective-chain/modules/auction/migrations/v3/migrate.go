@@ -0,0 +1,40 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+)
+
+// Migrate sets the defaults of the params added with the sealed-bid mode and the round records, which are left zero in
+// the stored params
+func Migrate(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &currParams)
+	}
+
+	currParams.AuctionMode = types.DefaultAuctionMode
+	// keep a zero reveal period (valid in the English mode) if the default does not fit in the auction period
+	if currParams.RevealPeriod == 0 && types.DefaultRevealPeriod < currParams.AuctionPeriod {
+		currParams.RevealPeriod = types.DefaultRevealPeriod
+	}
+
+	if currParams.MaxRoundRecords == 0 {
+		currParams.MaxRoundRecords = types.DefaultMaxRoundRecords
+	}
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&currParams))
+
+	return nil
+}
@@ -87,7 +87,7 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
 }
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 type AppModule struct {
 	AppModuleBasic
@@ -147,6 +147,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate auction from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate auction from version 2 to 3: %v", err))
+	}
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
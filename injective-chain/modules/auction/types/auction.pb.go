@@ -27,6 +27,67 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AuctionMode defines the mechanism of an auction round
+type AuctionMode int32
+
+const (
+	// open ascending auction, the highest bid is public
+	AuctionMode_English AuctionMode = 0
+	// commit-reveal auction, bids are committed as hashes and revealed at the
+	// end of the round
+	AuctionMode_SealedBid AuctionMode = 1
+)
+
+var AuctionMode_name = map[int32]string{
+	0: "English",
+	1: "SealedBid",
+}
+
+var AuctionMode_value = map[string]int32{
+	"English":   0,
+	"SealedBid": 1,
+}
+
+func (x AuctionMode) String() string {
+	return proto.EnumName(AuctionMode_name, int32(x))
+}
+
+func (AuctionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{0}
+}
+
+// AuctionPhase defines the phase of the current auction round
+type AuctionPhase int32
+
+const (
+	// bids are placed with MsgBid
+	AuctionPhase_Bidding AuctionPhase = 0
+	// bid commitments are placed with MsgCommitBid
+	AuctionPhase_Commit AuctionPhase = 1
+	// bid commitments are revealed with MsgRevealBid
+	AuctionPhase_Reveal AuctionPhase = 2
+)
+
+var AuctionPhase_name = map[int32]string{
+	0: "Bidding",
+	1: "Commit",
+	2: "Reveal",
+}
+
+var AuctionPhase_value = map[string]int32{
+	"Bidding": 0,
+	"Commit":  1,
+	"Reveal":  2,
+}
+
+func (x AuctionPhase) String() string {
+	return proto.EnumName(AuctionPhase_name, int32(x))
+}
+
+func (AuctionPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{1}
+}
+
 type Params struct {
 	// auction_period_duration defines the auction period duration
 	AuctionPeriod int64 `protobuf:"varint,1,opt,name=auction_period,json=auctionPeriod,proto3" json:"auction_period,omitempty"`
@@ -39,6 +100,15 @@ type Params struct {
 	// if empty, any address can bid; if populated, only whitelisted addresses can
 	// bid
 	BiddersWhitelist []string `protobuf:"bytes,4,rep,name=bidders_whitelist,json=biddersWhitelist,proto3" json:"bidders_whitelist,omitempty"`
+	// auction_mode defines the auction mechanism used for the auction rounds
+	// started after it is set
+	AuctionMode AuctionMode `protobuf:"varint,5,opt,name=auction_mode,json=auctionMode,proto3,enum=injective.auction.v1beta1.AuctionMode" json:"auction_mode,omitempty"`
+	// reveal_period defines the duration in seconds at the end of a sealed-bid
+	// round during which the bid commitments are revealed
+	RevealPeriod int64 `protobuf:"varint,6,opt,name=reveal_period,json=revealPeriod,proto3" json:"reveal_period,omitempty"`
+	// max_round_records defines the number of most recent auction rounds kept
+	// in the auction history, 0 keeps all rounds
+	MaxRoundRecords uint64 `protobuf:"varint,7,opt,name=max_round_records,json=maxRoundRecords,proto3" json:"max_round_records,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +158,27 @@ func (m *Params) GetBiddersWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetAuctionMode() AuctionMode {
+	if m != nil {
+		return m.AuctionMode
+	}
+	return AuctionMode_English
+}
+
+func (m *Params) GetRevealPeriod() int64 {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRoundRecords() uint64 {
+	if m != nil {
+		return m.MaxRoundRecords
+	}
+	return 0
+}
+
 type Bid struct {
 	Bidder string                                  `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder" yaml:"bidder"`
 	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
@@ -133,6 +224,266 @@ func (m *Bid) GetBidder() string {
 	return ""
 }
 
+// BidCommitment defines a sealed bid committed in a sealed-bid auction round
+type BidCommitment struct {
+	// bidder describes the address of the bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// round defines the round number of the auction
+	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// commitment is the sha256 hash of the bidder, round, bid amount and salt
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit is the INJ amount locked with the commitment, which caps the
+	// revealed bid
+	Deposit github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+	// commit_height is the block height the commitment was placed at
+	CommitHeight int64 `protobuf:"varint,5,opt,name=commit_height,json=commitHeight,proto3" json:"commit_height,omitempty"`
+	// revealed defines whether the commitment has been revealed
+	Revealed bool `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// revealed_amount is the revealed bid amount
+	RevealedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=revealed_amount,json=revealedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"revealed_amount"`
+	// reveal_height is the block height the commitment was revealed at
+	RevealHeight int64 `protobuf:"varint,8,opt,name=reveal_height,json=revealHeight,proto3" json:"reveal_height,omitempty"`
+	// settled defines whether the deposit has been settled at the end of the
+	// round
+	Settled bool `protobuf:"varint,9,opt,name=settled,proto3" json:"settled,omitempty"`
+}
+
+func (m *BidCommitment) Reset()         { *m = BidCommitment{} }
+func (m *BidCommitment) String() string { return proto.CompactTextString(m) }
+func (*BidCommitment) ProtoMessage()    {}
+func (*BidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{2}
+}
+func (m *BidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidCommitment.Merge(m, src)
+}
+func (m *BidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidCommitment proto.InternalMessageInfo
+
+func (m *BidCommitment) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BidCommitment) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BidCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *BidCommitment) GetCommitHeight() int64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *BidCommitment) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *BidCommitment) GetRevealHeight() int64 {
+	if m != nil {
+		return m.RevealHeight
+	}
+	return 0
+}
+
+func (m *BidCommitment) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+// ClaimableBidRefund defines a sealed bid deposit refund which couldn't be
+// sent to the bidder when the round was settled
+type ClaimableBidRefund struct {
+	// bidder describes the address of the bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// amount is the INJ amount the bidder can claim
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *ClaimableBidRefund) Reset()         { *m = ClaimableBidRefund{} }
+func (m *ClaimableBidRefund) String() string { return proto.CompactTextString(m) }
+func (*ClaimableBidRefund) ProtoMessage()    {}
+func (*ClaimableBidRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{3}
+}
+func (m *ClaimableBidRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableBidRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableBidRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableBidRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableBidRefund.Merge(m, src)
+}
+func (m *ClaimableBidRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableBidRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableBidRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableBidRefund proto.InternalMessageInfo
+
+func (m *ClaimableBidRefund) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+// AuctionRoundRecord defines the archived result of a settled auction round
+type AuctionRoundRecord struct {
+	// round defines the round number of the auction
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// mode describes the auction mode of the round
+	Mode AuctionMode `protobuf:"varint,2,opt,name=mode,proto3,enum=injective.auction.v1beta1.AuctionMode" json:"mode,omitempty"`
+	// ending_timestamp describes the end time of the round
+	EndingTimestamp int64 `protobuf:"varint,3,opt,name=ending_timestamp,json=endingTimestamp,proto3" json:"ending_timestamp,omitempty"`
+	// settlement_height is the block height the round was settled at
+	SettlementHeight int64 `protobuf:"varint,4,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
+	// basket describes the coins auctioned in the round, which are rolled over
+	// into the next round if there is no winner
+	Basket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=basket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"basket"`
+	// winner describes the address of the winner, empty if there is no winner
+	Winner string `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	// winning_bid describes the winning bid amount
+	WinningBid github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=winning_bid,json=winningBid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"winning_bid"`
+	// burned describes the INJ amount burned when settling the round
+	Burned github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=burned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"burned"`
+	// participant_count describes the number of distinct bidders in the round
+	ParticipantCount uint64 `protobuf:"varint,9,opt,name=participant_count,json=participantCount,proto3" json:"participant_count,omitempty"`
+}
+
+func (m *AuctionRoundRecord) Reset()         { *m = AuctionRoundRecord{} }
+func (m *AuctionRoundRecord) String() string { return proto.CompactTextString(m) }
+func (*AuctionRoundRecord) ProtoMessage()    {}
+func (*AuctionRoundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{4}
+}
+func (m *AuctionRoundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionRoundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionRoundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionRoundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionRoundRecord.Merge(m, src)
+}
+func (m *AuctionRoundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionRoundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionRoundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionRoundRecord proto.InternalMessageInfo
+
+func (m *AuctionRoundRecord) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AuctionRoundRecord) GetMode() AuctionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return AuctionMode_English
+}
+
+func (m *AuctionRoundRecord) GetEndingTimestamp() int64 {
+	if m != nil {
+		return m.EndingTimestamp
+	}
+	return 0
+}
+
+func (m *AuctionRoundRecord) GetSettlementHeight() int64 {
+	if m != nil {
+		return m.SettlementHeight
+	}
+	return 0
+}
+
+func (m *AuctionRoundRecord) GetBasket() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Basket
+	}
+	return nil
+}
+
+func (m *AuctionRoundRecord) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *AuctionRoundRecord) GetParticipantCount() uint64 {
+	if m != nil {
+		return m.ParticipantCount
+	}
+	return 0
+}
+
 type LastAuctionResult struct {
 	// winner describes the address of the winner
 	Winner string `protobuf:"bytes,1,opt,name=winner,proto3" json:"winner,omitempty"`
@@ -146,7 +497,7 @@ func (m *LastAuctionResult) Reset()         { *m = LastAuctionResult{} }
 func (m *LastAuctionResult) String() string { return proto.CompactTextString(m) }
 func (*LastAuctionResult) ProtoMessage()    {}
 func (*LastAuctionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49edfee5f1ef4b5a, []int{2}
+	return fileDescriptor_49edfee5f1ef4b5a, []int{5}
 }
 func (m *LastAuctionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +553,7 @@ func (m *EventBid) Reset()         { *m = EventBid{} }
 func (m *EventBid) String() string { return proto.CompactTextString(m) }
 func (*EventBid) ProtoMessage()    {}
 func (*EventBid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49edfee5f1ef4b5a, []int{3}
+	return fileDescriptor_49edfee5f1ef4b5a, []int{6}
 }
 func (m *EventBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +609,7 @@ func (m *EventAuctionResult) Reset()         { *m = EventAuctionResult{} }
 func (m *EventAuctionResult) String() string { return proto.CompactTextString(m) }
 func (*EventAuctionResult) ProtoMessage()    {}
 func (*EventAuctionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49edfee5f1ef4b5a, []int{4}
+	return fileDescriptor_49edfee5f1ef4b5a, []int{7}
 }
 func (m *EventAuctionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +666,7 @@ func (m *EventAuctionStart) Reset()         { *m = EventAuctionStart{} }
 func (m *EventAuctionStart) String() string { return proto.CompactTextString(m) }
 func (*EventAuctionStart) ProtoMessage()    {}
 func (*EventAuctionStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49edfee5f1ef4b5a, []int{5}
+	return fileDescriptor_49edfee5f1ef4b5a, []int{8}
 }
 func (m *EventAuctionStart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,81 +716,283 @@ func (m *EventAuctionStart) GetNewBasket() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "injective.auction.v1beta1.Params")
-	proto.RegisterType((*Bid)(nil), "injective.auction.v1beta1.Bid")
-	proto.RegisterType((*LastAuctionResult)(nil), "injective.auction.v1beta1.LastAuctionResult")
-	proto.RegisterType((*EventBid)(nil), "injective.auction.v1beta1.EventBid")
-	proto.RegisterType((*EventAuctionResult)(nil), "injective.auction.v1beta1.EventAuctionResult")
-	proto.RegisterType((*EventAuctionStart)(nil), "injective.auction.v1beta1.EventAuctionStart")
+type EventBidCommitment struct {
+	// bidder describes the address of the bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// commitment is the hash of the sealed bid
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit describes the amount locked with the commitment
+	Deposit github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
 }
 
-func init() {
-	proto.RegisterFile("injective/auction/v1beta1/auction.proto", fileDescriptor_49edfee5f1ef4b5a)
+func (m *EventBidCommitment) Reset()         { *m = EventBidCommitment{} }
+func (m *EventBidCommitment) String() string { return proto.CompactTextString(m) }
+func (*EventBidCommitment) ProtoMessage()    {}
+func (*EventBidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{9}
+}
+func (m *EventBidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBidCommitment.Merge(m, src)
+}
+func (m *EventBidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBidCommitment.DiscardUnknown(m)
 }
 
-var fileDescriptor_49edfee5f1ef4b5a = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x35, 0x98, 0x91, 0xda, 0x66, 0xa8, 0x36, 0x6d, 0x71, 0x13, 0x22, 0xd2, 0xa8,
-	0x74, 0xd7, 0xda, 0x5b, 0x6f, 0x6e, 0xf5, 0x50, 0x69, 0xa5, 0xac, 0x82, 0xe0, 0x65, 0x99, 0xdd,
-	0x7d, 0x24, 0x93, 0x66, 0x66, 0xc2, 0xce, 0xa4, 0x49, 0xff, 0x82, 0x27, 0x7f, 0x80, 0x88, 0x07,
-	0xf5, 0xe0, 0xc9, 0x9f, 0xe0, 0xb1, 0xc7, 0x1e, 0xc5, 0x43, 0x94, 0xf6, 0xa0, 0x78, 0xf4, 0x17,
-	0x48, 0x66, 0x67, 0x43, 0x40, 0x14, 0x4f, 0xc5, 0x4b, 0xb2, 0xef, 0x9b, 0xf7, 0xbe, 0xf9, 0xde,
-	0xf7, 0x98, 0x87, 0xd7, 0x98, 0xe8, 0x40, 0xac, 0xd9, 0x21, 0x78, 0xb4, 0x1f, 0x6b, 0x26, 0x85,
-	0x77, 0xb8, 0x11, 0x81, 0xa6, 0x1b, 0x79, 0xec, 0xf6, 0x52, 0xa9, 0x25, 0x59, 0x9e, 0x24, 0xba,
-	0xf9, 0x81, 0x4d, 0x5c, 0x59, 0x6c, 0xc9, 0x96, 0x34, 0x59, 0xde, 0xf8, 0x2b, 0x2b, 0x58, 0x71,
-	0x62, 0xa9, 0xb8, 0x54, 0x5e, 0x44, 0x15, 0x4c, 0x38, 0x63, 0xc9, 0x2c, 0xe1, 0x4a, 0x85, 0x72,
-	0x26, 0xa4, 0x67, 0x7e, 0x33, 0xa8, 0xf1, 0x66, 0x06, 0x97, 0xf6, 0x69, 0x4a, 0xb9, 0x22, 0x37,
-	0xf0, 0x65, 0x7b, 0x4d, 0xd8, 0x83, 0x94, 0xc9, 0xa4, 0x8a, 0xea, 0xa8, 0x59, 0x0c, 0xe6, 0x2c,
-	0xba, 0x6f, 0x40, 0x42, 0xf1, 0x2a, 0x67, 0x22, 0x14, 0x30, 0xd4, 0x61, 0xc4, 0x92, 0x90, 0x89,
-	0x38, 0x05, 0x0e, 0x42, 0x87, 0x29, 0xd5, 0x50, 0x9d, 0xa9, 0xa3, 0x66, 0xd9, 0xbf, 0x7e, 0x3c,
-	0xaa, 0x15, 0x3e, 0x8f, 0x6a, 0xab, 0x99, 0x22, 0x95, 0x1c, 0xb8, 0x4c, 0x7a, 0x9c, 0xea, 0xb6,
-	0xbb, 0x0b, 0x2d, 0x1a, 0x1f, 0xdd, 0x87, 0x38, 0x58, 0xe2, 0x4c, 0x3c, 0x82, 0xa1, 0xf6, 0x59,
-	0xb2, 0x93, 0x93, 0x04, 0x54, 0x03, 0x79, 0x88, 0x09, 0x13, 0x9d, 0x30, 0xa2, 0xea, 0x00, 0x74,
-	0xc8, 0xe9, 0x30, 0x8c, 0x69, 0xaf, 0x5a, 0x34, 0xcc, 0xd7, 0x2c, 0xf3, 0x95, 0xdf, 0x99, 0x77,
-	0x84, 0x0e, 0xe6, 0x99, 0xe8, 0xf8, 0xa6, 0x6e, 0x8f, 0x0e, 0xb7, 0x69, 0x8f, 0xdc, 0xc6, 0x95,
-	0x88, 0x25, 0x09, 0xa4, 0x2a, 0x1c, 0xb4, 0x99, 0x86, 0x2e, 0x53, 0xba, 0x3a, 0x5b, 0x2f, 0x36,
-	0xcb, 0xc1, 0x82, 0x3d, 0x78, 0x9a, 0xe3, 0x5b, 0x4b, 0xdf, 0x5f, 0xd7, 0xd0, 0xf3, 0x6f, 0x1f,
-	0x6e, 0xe5, 0x4e, 0x78, 0x99, 0x37, 0x8d, 0x57, 0x08, 0x17, 0x7d, 0x96, 0x90, 0x4d, 0x5c, 0xca,
-	0x8a, 0x8c, 0x37, 0x65, 0x7f, 0xf5, 0xc7, 0xa8, 0x66, 0x91, 0x9f, 0xa3, 0xda, 0xdc, 0x11, 0xe5,
-	0xdd, 0xad, 0x46, 0x16, 0x37, 0x02, 0x7b, 0x40, 0x22, 0x5c, 0xa2, 0x5c, 0xf6, 0x85, 0x36, 0xe6,
-	0x5c, 0xba, 0xbb, 0xec, 0x66, 0xda, 0xdd, 0xf1, 0x9c, 0xf2, 0x91, 0xba, 0xdb, 0x92, 0x09, 0xdf,
-	0xb3, 0xdd, 0xad, 0xb5, 0x98, 0x6e, 0xf7, 0x23, 0x37, 0x96, 0xdc, 0xb3, 0x43, 0xcd, 0xfe, 0xd6,
-	0x55, 0x72, 0xe0, 0xe9, 0xa3, 0x1e, 0x28, 0x53, 0x10, 0x58, 0xe6, 0xc6, 0x5b, 0x84, 0x2b, 0xbb,
-	0x54, 0xe9, 0x7b, 0x99, 0xee, 0x00, 0x54, 0xbf, 0xab, 0xc9, 0x55, 0x5c, 0x1a, 0x30, 0x21, 0x72,
-	0xb9, 0x81, 0x8d, 0xce, 0x43, 0x11, 0x59, 0xc4, 0x17, 0x52, 0xd9, 0x17, 0x89, 0x99, 0xdb, 0x6c,
-	0x90, 0x05, 0x8d, 0x97, 0x08, 0x5f, 0x7c, 0x70, 0x08, 0x62, 0x3c, 0xf4, 0xb1, 0xbc, 0x69, 0x37,
-	0xcf, 0xd3, 0xb0, 0x3f, 0xc8, 0x7b, 0x87, 0x30, 0x31, 0xf2, 0xfe, 0x77, 0x1f, 0x3f, 0x22, 0x5c,
-	0x99, 0x16, 0xfa, 0x58, 0xd3, 0x74, 0x2a, 0x17, 0x4d, 0xe5, 0x92, 0x9b, 0x78, 0x01, 0x44, 0xc2,
-	0x44, 0x2b, 0xd4, 0x8c, 0x83, 0xd2, 0x94, 0xf7, 0x8c, 0xde, 0x62, 0x30, 0x9f, 0xe1, 0x4f, 0x72,
-	0x98, 0x74, 0x30, 0x16, 0x30, 0xb0, 0x2f, 0xaf, 0x5a, 0xac, 0x17, 0xff, 0xde, 0xd4, 0x9d, 0x71,
-	0x53, 0xef, 0xbf, 0xd4, 0x9a, 0xff, 0xd8, 0x94, 0x0a, 0xca, 0x02, 0x06, 0xd9, 0xfb, 0xf4, 0x5b,
-	0xc7, 0xa7, 0x0e, 0x3a, 0x39, 0x75, 0xd0, 0xd7, 0x53, 0x07, 0xbd, 0x38, 0x73, 0x0a, 0x27, 0x67,
-	0x4e, 0xe1, 0xd3, 0x99, 0x53, 0x78, 0xb6, 0x37, 0x45, 0xb7, 0x93, 0xef, 0xc0, 0x5d, 0x1a, 0x29,
-	0x6f, 0xb2, 0x11, 0xd7, 0x63, 0x99, 0xc2, 0x74, 0xd8, 0xa6, 0x4c, 0x78, 0x5c, 0x26, 0xfd, 0x2e,
-	0xa8, 0xc9, 0x5e, 0x35, 0x37, 0x47, 0x25, 0xb3, 0xea, 0x36, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0xbc, 0x1a, 0xed, 0x79, 0x05, 0x00, 0x00,
+var xxx_messageInfo_EventBidCommitment proto.InternalMessageInfo
+
+func (m *EventBidCommitment) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EventBidCommitment) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *EventBidCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+type EventBidReveal struct {
+	// bidder describes the address of the bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// amount describes the revealed bid amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *EventBidReveal) Reset()         { *m = EventBidReveal{} }
+func (m *EventBidReveal) String() string { return proto.CompactTextString(m) }
+func (*EventBidReveal) ProtoMessage()    {}
+func (*EventBidReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{10}
+}
+func (m *EventBidReveal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBidReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBidReveal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EventBidReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBidReveal.Merge(m, src)
+}
+func (m *EventBidReveal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBidReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBidReveal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBidReveal proto.InternalMessageInfo
+
+func (m *EventBidReveal) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *EventBidReveal) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+type EventAuctionRoundSettled struct {
+	// record describes the archived result of the settled round
+	Record AuctionRoundRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *EventAuctionRoundSettled) Reset()         { *m = EventAuctionRoundSettled{} }
+func (m *EventAuctionRoundSettled) String() string { return proto.CompactTextString(m) }
+func (*EventAuctionRoundSettled) ProtoMessage()    {}
+func (*EventAuctionRoundSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{11}
+}
+func (m *EventAuctionRoundSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAuctionRoundSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAuctionRoundSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAuctionRoundSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAuctionRoundSettled.Merge(m, src)
+}
+func (m *EventAuctionRoundSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAuctionRoundSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAuctionRoundSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAuctionRoundSettled proto.InternalMessageInfo
+
+func (m *EventAuctionRoundSettled) GetRecord() AuctionRoundRecord {
+	if m != nil {
+		return m.Record
+	}
+	return AuctionRoundRecord{}
+}
+
+func init() {
+	proto.RegisterEnum("injective.auction.v1beta1.AuctionMode", AuctionMode_name, AuctionMode_value)
+	proto.RegisterEnum("injective.auction.v1beta1.AuctionPhase", AuctionPhase_name, AuctionPhase_value)
+	proto.RegisterType((*Params)(nil), "injective.auction.v1beta1.Params")
+	proto.RegisterType((*Bid)(nil), "injective.auction.v1beta1.Bid")
+	proto.RegisterType((*BidCommitment)(nil), "injective.auction.v1beta1.BidCommitment")
+	proto.RegisterType((*ClaimableBidRefund)(nil), "injective.auction.v1beta1.ClaimableBidRefund")
+	proto.RegisterType((*AuctionRoundRecord)(nil), "injective.auction.v1beta1.AuctionRoundRecord")
+	proto.RegisterType((*LastAuctionResult)(nil), "injective.auction.v1beta1.LastAuctionResult")
+	proto.RegisterType((*EventBid)(nil), "injective.auction.v1beta1.EventBid")
+	proto.RegisterType((*EventAuctionResult)(nil), "injective.auction.v1beta1.EventAuctionResult")
+	proto.RegisterType((*EventAuctionStart)(nil), "injective.auction.v1beta1.EventAuctionStart")
+	proto.RegisterType((*EventBidCommitment)(nil), "injective.auction.v1beta1.EventBidCommitment")
+	proto.RegisterType((*EventBidReveal)(nil), "injective.auction.v1beta1.EventBidReveal")
+	proto.RegisterType((*EventAuctionRoundSettled)(nil), "injective.auction.v1beta1.EventAuctionRoundSettled")
+}
+
+func init() {
+	proto.RegisterFile("injective/auction/v1beta1/auction.proto", fileDescriptor_49edfee5f1ef4b5a)
+}
+
+var fileDescriptor_49edfee5f1ef4b5a = []byte{
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x23, 0xb5,
+	0x17, 0xcf, 0x74, 0xb2, 0x69, 0xf3, 0xd2, 0x1f, 0xa9, 0xb5, 0xdf, 0xef, 0x66, 0x5b, 0x91, 0x44,
+	0x59, 0xc1, 0x66, 0x8b, 0x9a, 0x61, 0xdb, 0x5b, 0x6f, 0x3b, 0x65, 0x25, 0x0a, 0x2d, 0xaa, 0xa6,
+	0x48, 0x48, 0x5c, 0x46, 0x9e, 0xb1, 0x49, 0xdc, 0x66, 0xec, 0x68, 0xec, 0xf4, 0xc7, 0xbf, 0xc0,
+	0x69, 0xff, 0x00, 0x84, 0x10, 0x12, 0x1c, 0x38, 0x71, 0x84, 0xdb, 0x1e, 0x7b, 0xdc, 0x23, 0xe2,
+	0x50, 0x50, 0x7b, 0x00, 0x71, 0xe4, 0x2f, 0x40, 0x63, 0x7b, 0xd2, 0xa9, 0xd8, 0x02, 0x2b, 0x75,
+	0x17, 0xb8, 0x24, 0x7e, 0xcf, 0xf6, 0xf3, 0x7b, 0x9f, 0xf7, 0x79, 0x7e, 0x1e, 0xb8, 0xcf, 0xf8,
+	0x3e, 0x8d, 0x15, 0x3b, 0xa4, 0x1e, 0x1e, 0xc7, 0x8a, 0x09, 0xee, 0x1d, 0x3e, 0x8c, 0xa8, 0xc2,
+	0x0f, 0x73, 0xb9, 0x37, 0x4a, 0x85, 0x12, 0xe8, 0xee, 0x64, 0x61, 0x2f, 0x9f, 0xb0, 0x0b, 0x97,
+	0x6e, 0xf7, 0x45, 0x5f, 0xe8, 0x55, 0x5e, 0x36, 0x32, 0x1b, 0x96, 0x9a, 0xb1, 0x90, 0x89, 0x90,
+	0x5e, 0x84, 0x25, 0x9d, 0xd8, 0x8c, 0x05, 0xb3, 0x06, 0x97, 0x16, 0x71, 0xc2, 0xb8, 0xf0, 0xf4,
+	0xaf, 0x51, 0x75, 0x9e, 0xba, 0x50, 0xd9, 0xc5, 0x29, 0x4e, 0x24, 0x7a, 0x1d, 0xe6, 0xed, 0x31,
+	0xe1, 0x88, 0xa6, 0x4c, 0x90, 0x86, 0xd3, 0x76, 0xba, 0x6e, 0x30, 0x67, 0xb5, 0xbb, 0x5a, 0x89,
+	0x30, 0x2c, 0x27, 0x8c, 0x87, 0x9c, 0x1e, 0xab, 0x30, 0x62, 0x24, 0x64, 0x3c, 0x4e, 0x69, 0x42,
+	0xb9, 0x0a, 0x53, 0xac, 0x68, 0x63, 0xaa, 0xed, 0x74, 0xab, 0xfe, 0xbd, 0xd3, 0xb3, 0x56, 0xe9,
+	0x87, 0xb3, 0xd6, 0xb2, 0xf1, 0x48, 0x92, 0x83, 0x1e, 0x13, 0x5e, 0x82, 0xd5, 0xa0, 0xb7, 0x4d,
+	0xfb, 0x38, 0x3e, 0x79, 0x9b, 0xc6, 0xc1, 0x9d, 0x84, 0xf1, 0xf7, 0xe9, 0xb1, 0xf2, 0x19, 0xd9,
+	0xca, 0x8d, 0x04, 0x58, 0x51, 0xf4, 0x2e, 0x20, 0xc6, 0xf7, 0xc3, 0x08, 0xcb, 0x03, 0xaa, 0xc2,
+	0x04, 0x1f, 0x87, 0x31, 0x1e, 0x35, 0x5c, 0x6d, 0xf9, 0x35, 0x6b, 0xf9, 0x7f, 0x7f, 0xb4, 0xbc,
+	0xc5, 0x55, 0xb0, 0xc0, 0xf8, 0xbe, 0xaf, 0xf7, 0xed, 0xe0, 0xe3, 0x4d, 0x3c, 0x42, 0x6f, 0xc2,
+	0x62, 0xc4, 0x08, 0xa1, 0xa9, 0x0c, 0x8f, 0x06, 0x4c, 0xd1, 0x21, 0x93, 0xaa, 0x51, 0x6e, 0xbb,
+	0xdd, 0x6a, 0x50, 0xb7, 0x13, 0x1f, 0xe6, 0x7a, 0xb4, 0x05, 0xb3, 0x39, 0x04, 0x89, 0x20, 0xb4,
+	0x71, 0xab, 0xed, 0x74, 0xe7, 0xd7, 0xde, 0xe8, 0x5d, 0x9b, 0x88, 0xde, 0x23, 0x23, 0xef, 0x08,
+	0x42, 0x83, 0x1a, 0xbe, 0x14, 0xd0, 0x3d, 0x98, 0x4b, 0xe9, 0x21, 0xc5, 0xc3, 0x1c, 0xcc, 0x8a,
+	0x06, 0x73, 0xd6, 0x28, 0x2d, 0x96, 0x2b, 0xb0, 0x98, 0x45, 0x97, 0x8a, 0x31, 0x27, 0x61, 0x4a,
+	0x63, 0x91, 0x12, 0xd9, 0x98, 0x6e, 0x3b, 0xdd, 0x72, 0xb0, 0x90, 0xe0, 0xe3, 0x20, 0xd3, 0x07,
+	0x46, 0xbd, 0x71, 0xe7, 0x97, 0xcf, 0x5b, 0xce, 0x27, 0x3f, 0x7f, 0xb3, 0x92, 0x67, 0xc9, 0x33,
+	0x79, 0xeb, 0x7c, 0xe6, 0x80, 0xeb, 0x33, 0x82, 0xd6, 0xa1, 0x62, 0x02, 0xd2, 0x79, 0xab, 0xfa,
+	0xcb, 0xbf, 0x9e, 0xb5, 0xac, 0xe6, 0xb7, 0xb3, 0xd6, 0xdc, 0x09, 0x4e, 0x86, 0x1b, 0x1d, 0x23,
+	0x77, 0x02, 0x3b, 0x81, 0x22, 0xa8, 0xe0, 0x44, 0x8c, 0xb9, 0xd2, 0x89, 0xab, 0xad, 0xdd, 0xed,
+	0x19, 0x5c, 0x7b, 0x19, 0x87, 0x26, 0x51, 0x6e, 0x0a, 0xc6, 0x7d, 0xcf, 0x22, 0x7f, 0xbf, 0xcf,
+	0xd4, 0x60, 0x1c, 0xf5, 0x62, 0x91, 0x78, 0x96, 0x70, 0xe6, 0x6f, 0x55, 0x92, 0x03, 0x4f, 0x9d,
+	0x8c, 0xa8, 0xd4, 0x1b, 0x02, 0x6b, 0xb9, 0xf3, 0xad, 0x0b, 0x73, 0x3e, 0x23, 0x9b, 0x22, 0x49,
+	0x98, 0xca, 0x92, 0x8c, 0xfe, 0x7f, 0xd5, 0xd5, 0x89, 0x37, 0xb7, 0xe1, 0x96, 0xc6, 0x42, 0x3b,
+	0x53, 0x0e, 0x8c, 0x80, 0x9a, 0x00, 0xf1, 0x64, 0xaf, 0xa6, 0xc1, 0x6c, 0x50, 0xd0, 0x20, 0x02,
+	0xd3, 0x84, 0x8e, 0x84, 0x64, 0x59, 0x62, 0x6f, 0x3a, 0x88, 0xdc, 0x74, 0x96, 0x50, 0x73, 0x66,
+	0x38, 0xa0, 0xac, 0x3f, 0x50, 0x9a, 0x1c, 0x6e, 0x30, 0x6b, 0x94, 0xef, 0x68, 0x1d, 0x5a, 0x82,
+	0x19, 0x93, 0x60, 0x6a, 0x12, 0x3e, 0x13, 0x4c, 0x64, 0x24, 0x61, 0x21, 0x1f, 0x87, 0x16, 0xf3,
+	0xe9, 0x1b, 0x77, 0x77, 0x3e, 0x3f, 0xe2, 0x91, 0x3e, 0xa1, 0x40, 0x43, 0xeb, 0xf5, 0x4c, 0x91,
+	0x86, 0xd6, 0xeb, 0x06, 0x4c, 0x4b, 0xaa, 0x54, 0xe6, 0x74, 0x55, 0x3b, 0x9d, 0x8b, 0x9d, 0x27,
+	0x0e, 0xa0, 0xcd, 0x21, 0x66, 0x09, 0x8e, 0x86, 0xd4, 0x67, 0x24, 0xa0, 0x1f, 0x67, 0x19, 0xb9,
+	0x2e, 0x7f, 0xaf, 0x82, 0x4d, 0xdf, 0x95, 0x01, 0xd9, 0xaa, 0x2b, 0xd4, 0xc7, 0x25, 0x75, 0x9c,
+	0x22, 0x75, 0x36, 0xa0, 0xac, 0x0b, 0x79, 0xea, 0x85, 0x0a, 0x59, 0xef, 0x41, 0x0f, 0xa0, 0x4e,
+	0x39, 0x61, 0xbc, 0x1f, 0x2a, 0x96, 0x50, 0xa9, 0x70, 0x62, 0xee, 0x20, 0x37, 0x58, 0x30, 0xfa,
+	0x0f, 0x72, 0x75, 0x76, 0xc9, 0x18, 0xc4, 0xf4, 0x3d, 0x68, 0x91, 0x2e, 0xeb, 0xb5, 0xf5, 0xcb,
+	0x09, 0x8b, 0x76, 0x0c, 0x15, 0x73, 0xb3, 0x35, 0x6e, 0xb5, 0xdd, 0x3f, 0x07, 0xe9, 0xad, 0x0c,
+	0xa4, 0xaf, 0x7f, 0x6c, 0x75, 0xff, 0x26, 0x48, 0x32, 0xb0, 0xa6, 0xb3, 0x0c, 0x1d, 0x31, 0xce,
+	0x69, 0xaa, 0x69, 0x58, 0x0d, 0xac, 0x84, 0x0e, 0xa0, 0x96, 0x8d, 0xb2, 0xa8, 0x22, 0x46, 0x5e,
+	0x02, 0x01, 0xc1, 0x9a, 0xcf, 0x6e, 0xa4, 0x08, 0x2a, 0xd1, 0x38, 0xe5, 0x94, 0x34, 0x66, 0x6e,
+	0xfc, 0x1c, 0x6b, 0x39, 0x83, 0x7e, 0x84, 0x53, 0xc5, 0x62, 0x36, 0xc2, 0x5c, 0x85, 0xb1, 0x66,
+	0x5f, 0x55, 0x73, 0xa0, 0x5e, 0x98, 0xd8, 0xd4, 0xdc, 0xf9, 0xd2, 0x81, 0xc5, 0x6d, 0x2c, 0x55,
+	0xce, 0x1f, 0x2a, 0xc7, 0xc3, 0x22, 0x56, 0xce, 0x15, 0xac, 0x5e, 0x01, 0x9b, 0x2f, 0x69, 0xeb,
+	0x16, 0x68, 0xdb, 0xf9, 0xd4, 0x81, 0x99, 0xc7, 0x87, 0x94, 0x67, 0xad, 0xf1, 0x9f, 0x2c, 0xb6,
+	0x6b, 0xdc, 0xfb, 0xca, 0x01, 0xa4, 0xdd, 0xfb, 0xb7, 0xe3, 0xf8, 0xd4, 0x81, 0xc5, 0xa2, 0xa3,
+	0x7b, 0x0a, 0xa7, 0xea, 0x9a, 0xab, 0xe2, 0x79, 0xe5, 0x3e, 0xf5, 0xfc, 0x72, 0xdf, 0x07, 0xe0,
+	0xf4, 0xc8, 0xbe, 0x4f, 0x1a, 0xee, 0xcd, 0x57, 0x71, 0x95, 0xd3, 0x23, 0xf3, 0x8a, 0xe9, 0x9c,
+	0xe6, 0x58, 0xff, 0xe7, 0x3b, 0x68, 0xe7, 0x0b, 0x07, 0xe6, 0xf3, 0x50, 0x02, 0xdd, 0x7f, 0x5e,
+	0x30, 0x8c, 0x4b, 0x22, 0xb9, 0x2f, 0xad, 0xbd, 0xf4, 0xa1, 0x71, 0x85, 0xda, 0xd9, 0xc1, 0x7b,
+	0xa6, 0x1b, 0xa2, 0xf7, 0xa0, 0x62, 0x1e, 0x69, 0xda, 0xdb, 0xda, 0xda, 0xea, 0x5f, 0xf7, 0x93,
+	0x42, 0x8b, 0xf2, 0xcb, 0x99, 0x4f, 0x81, 0x35, 0xb1, 0xf2, 0x00, 0x6a, 0x85, 0x9e, 0x83, 0x6a,
+	0x30, 0xfd, 0x98, 0xf7, 0x87, 0x4c, 0x0e, 0xea, 0x25, 0x34, 0x07, 0xd5, 0x3d, 0xdd, 0xc5, 0x7d,
+	0x46, 0xea, 0xce, 0xca, 0x3a, 0xcc, 0xda, 0xa5, 0xbb, 0x03, 0x2c, 0xf5, 0x5a, 0x9f, 0x91, 0x8c,
+	0x93, 0xf5, 0x12, 0x02, 0xa8, 0x18, 0x5e, 0xd4, 0x9d, 0x6c, 0x6c, 0x80, 0xad, 0x4f, 0xf9, 0xfd,
+	0xd3, 0xf3, 0xa6, 0xf3, 0xec, 0xbc, 0xe9, 0xfc, 0x74, 0xde, 0x74, 0x9e, 0x5c, 0x34, 0x4b, 0xcf,
+	0x2e, 0x9a, 0xa5, 0xef, 0x2f, 0x9a, 0xa5, 0x8f, 0x76, 0x0a, 0x98, 0x6c, 0xe5, 0x01, 0x6c, 0xe3,
+	0x48, 0x7a, 0x93, 0x70, 0x56, 0x63, 0x91, 0xd2, 0xa2, 0x38, 0xc0, 0x8c, 0x7b, 0x89, 0x20, 0xe3,
+	0x21, 0x95, 0x93, 0xcf, 0x16, 0x0d, 0x5f, 0x54, 0xd1, 0x5f, 0x12, 0xeb, 0xbf, 0x0f, 0x00, 0x33,
+	0x8c, 0x6f, 0xd1, 0xd8, 0x0c, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
 	if this.AuctionPeriod != that1.AuctionPeriod {
 		return false
@@ -458,6 +1011,15 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.AuctionMode != that1.AuctionMode {
+		return false
+	}
+	if this.RevealPeriod != that1.RevealPeriod {
+		return false
+	}
+	if this.MaxRoundRecords != that1.MaxRoundRecords {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -480,6 +1042,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRoundRecords != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.MaxRoundRecords))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RevealPeriod != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AuctionMode != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionMode))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.BiddersWhitelist) > 0 {
 		for iNdEx := len(m.BiddersWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.BiddersWhitelist[iNdEx])
@@ -557,6 +1134,227 @@ func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RevealHeight != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.RevealedAmount.Size()
+		i -= size
+		if _, err := m.RevealedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitHeight != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.CommitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableBidRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableBidRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableBidRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionRoundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionRoundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionRoundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParticipantCount != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.ParticipantCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WinningBid.Size()
+		i -= size
+		if _, err := m.WinningBid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Basket) > 0 {
+		for iNdEx := len(m.Basket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Basket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SettlementHeight != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.SettlementHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndingTimestamp != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.EndingTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mode != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastAuctionResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -739,6 +1537,136 @@ func (m *EventAuctionStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBidReveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBidReveal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBidReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAuctionRoundSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAuctionRoundSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuctionRoundSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuction(v)
 	base := offset
@@ -769,6 +1697,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovAuction(uint64(l))
 		}
 	}
+	if m.AuctionMode != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionMode))
+	}
+	if m.RevealPeriod != 0 {
+		n += 1 + sovAuction(uint64(m.RevealPeriod))
+	}
+	if m.MaxRoundRecords != 0 {
+		n += 1 + sovAuction(uint64(m.MaxRoundRecords))
+	}
 	return n
 }
 
@@ -787,6 +1724,95 @@ func (m *Bid) Size() (n int) {
 	return n
 }
 
+func (m *BidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.CommitHeight != 0 {
+		n += 1 + sovAuction(uint64(m.CommitHeight))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	l = m.RevealedAmount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.RevealHeight != 0 {
+		n += 1 + sovAuction(uint64(m.RevealHeight))
+	}
+	if m.Settled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClaimableBidRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *AuctionRoundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovAuction(uint64(m.Mode))
+	}
+	if m.EndingTimestamp != 0 {
+		n += 1 + sovAuction(uint64(m.EndingTimestamp))
+	}
+	if m.SettlementHeight != 0 {
+		n += 1 + sovAuction(uint64(m.SettlementHeight))
+	}
+	if len(m.Basket) > 0 {
+		for _, e := range m.Basket {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.WinningBid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.ParticipantCount != 0 {
+		n += 1 + sovAuction(uint64(m.ParticipantCount))
+	}
+	return n
+}
+
 func (m *LastAuctionResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -862,6 +1888,57 @@ func (m *EventAuctionStart) Size() (n int) {
 	return n
 }
 
+func (m *EventBidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *EventBidReveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *EventAuctionRoundSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
 func sovAuction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -988,7 +2065,1131 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BiddersWhitelist", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BiddersWhitelist = append(m.BiddersWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMode", wireType)
+			}
+			m.AuctionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionMode |= AuctionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			m.RevealPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoundRecords", wireType)
+			}
+			m.MaxRoundRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoundRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHeight", wireType)
+			}
+			m.CommitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevealedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealHeight", wireType)
+			}
+			m.RevealHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableBidRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableBidRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableBidRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionRoundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionRoundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionRoundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AuctionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingTimestamp", wireType)
+			}
+			m.EndingTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
+			}
+			m.SettlementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Basket = append(m.Basket, types.Coin{})
+			if err := m.Basket[len(m.Basket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WinningBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantCount", wireType)
+			}
+			m.ParticipantCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipantCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastAuctionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -998,24 +3199,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Round |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BiddersWhitelist = append(m.BiddersWhitelist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1037,7 +3225,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bid) Unmarshal(dAtA []byte) error {
+func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1060,15 +3248,15 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAuctionResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1096,7 +3284,7 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1131,6 +3319,25 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1152,7 +3359,7 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
+func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1175,17 +3382,17 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastAuctionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAuctionStart: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAuctionStart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			var stringLen uint64
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1195,27 +3402,33 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Round |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingTimestamp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndingTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBasket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1242,29 +3455,11 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewBasket = append(m.NewBasket, types.Coin{})
+			if err := m.NewBasket[len(m.NewBasket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1286,7 +3481,7 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBid) Unmarshal(dAtA []byte) error {
+func (m *EventBidCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,10 +3504,10 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBidCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1348,10 +3543,29 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1361,30 +3575,31 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.Round = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1394,11 +3609,25 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1420,7 +3649,7 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
+func (m *EventBidReveal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1443,15 +3672,15 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAuctionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBidReveal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBidReveal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,9 +3708,28 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1514,25 +3762,6 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1554,7 +3783,7 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
+func (m *EventAuctionRoundSettled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,53 +3806,15 @@ func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAuctionStart: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAuctionRoundSettled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAuctionStart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAuctionRoundSettled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingTimestamp", wireType)
-			}
-			m.EndingTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBasket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1650,8 +3841,7 @@ func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewBasket = append(m.NewBasket, types.Coin{})
-			if err := m.NewBasket[len(m.NewBasket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
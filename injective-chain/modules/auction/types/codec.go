@@ -13,6 +13,9 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBid{}, "auction/MsgBid", nil)
+	cdc.RegisterConcrete(&MsgCommitBid{}, "auction/MsgCommitBid", nil)
+	cdc.RegisterConcrete(&MsgRevealBid{}, "auction/MsgRevealBid", nil)
+	cdc.RegisterConcrete(&MsgClaimBidRefund{}, "auction/MsgClaimBidRefund", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "auction/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&Params{}, "auction/Params", nil)
 }
@@ -20,6 +23,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgBid{},
+		&MsgCommitBid{},
+		&MsgRevealBid{},
+		&MsgClaimBidRefund{},
 		&MsgUpdateParams{},
 	)
 
@@ -3,6 +3,13 @@ package types
 import "cosmossdk.io/errors"
 
 var (
-	ErrBidInvalid = errors.Register(ModuleName, 1, "invalid bid denom")
-	ErrBidRound   = errors.Register(ModuleName, 2, "invalid bid round")
+	ErrBidInvalid            = errors.Register(ModuleName, 1, "invalid bid denom")
+	ErrBidRound              = errors.Register(ModuleName, 2, "invalid bid round")
+	ErrInvalidAuctionMode    = errors.Register(ModuleName, 3, "invalid auction mode")
+	ErrInvalidAuctionPhase   = errors.Register(ModuleName, 4, "invalid auction phase")
+	ErrInvalidBidCommitment  = errors.Register(ModuleName, 5, "invalid bid commitment")
+	ErrBidCommitmentNotFound = errors.Register(ModuleName, 6, "bid commitment not found")
+	ErrBidCommitmentExists   = errors.Register(ModuleName, 7, "bid commitment already exists")
+	ErrAuctionRoundNotFound  = errors.Register(ModuleName, 8, "auction round not found")
+	ErrNoClaimableBidRefund  = errors.Register(ModuleName, 9, "no claimable bid refund")
 )
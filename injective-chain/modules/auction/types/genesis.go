@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewGenesisState() GenesisState {
 	return GenesisState{}
 }
@@ -8,6 +14,63 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	if _, ok := AuctionMode_name[int32(gs.RoundAuctionMode)]; !ok {
+		return fmt.Errorf("invalid round auction mode: %d", gs.RoundAuctionMode)
+	}
+
+	seenCommitments := make(map[string]struct{}, len(gs.BidCommitments))
+	for idx := range gs.BidCommitments {
+		commitment := gs.BidCommitments[idx]
+		if err := commitment.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetBidCommitmentKey(commitment.Round, sdk.MustAccAddressFromBech32(commitment.Bidder)))
+		if _, ok := seenCommitments[key]; ok {
+			return fmt.Errorf("duplicate bid commitment of %s in round %d", commitment.Bidder, commitment.Round)
+		}
+		seenCommitments[key] = struct{}{}
+	}
+
+	seenRounds := make(map[uint64]struct{}, len(gs.AuctionRoundRecords))
+	for idx := range gs.AuctionRoundRecords {
+		record := gs.AuctionRoundRecords[idx]
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenRounds[record.Round]; ok {
+			return fmt.Errorf("duplicate auction round record %d", record.Round)
+		}
+		seenRounds[record.Round] = struct{}{}
+	}
+
+	seenBidders := make(map[string]struct{}, len(gs.RoundBidders))
+	for _, bidder := range gs.RoundBidders {
+		if _, err := sdk.AccAddressFromBech32(bidder); err != nil {
+			return fmt.Errorf("invalid round bidder address %s", bidder)
+		}
+
+		if _, ok := seenBidders[bidder]; ok {
+			return fmt.Errorf("duplicate round bidder %s", bidder)
+		}
+		seenBidders[bidder] = struct{}{}
+	}
+
+	seenRefunds := make(map[string]struct{}, len(gs.ClaimableBidRefunds))
+	for idx := range gs.ClaimableBidRefunds {
+		refund := gs.ClaimableBidRefunds[idx]
+		if err := refund.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenRefunds[refund.Bidder]; ok {
+			return fmt.Errorf("duplicate claimable bid refund of %s", refund.Bidder)
+		}
+		seenRefunds[refund.Bidder] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -17,5 +80,10 @@ func DefaultGenesisState() *GenesisState {
 		AuctionRound:           0,
 		HighestBid:             nil,
 		AuctionEndingTimestamp: 0,
+		RoundAuctionMode:       DefaultAuctionMode,
+		BidCommitments:         []BidCommitment{},
+		AuctionRoundRecords:    []AuctionRoundRecord{},
+		RoundBidders:           []string{},
+		ClaimableBidRefunds:    []ClaimableBidRefund{},
 	}
 }
@@ -35,6 +35,16 @@ type GenesisState struct {
 	AuctionEndingTimestamp int64 `protobuf:"varint,4,opt,name=auction_ending_timestamp,json=auctionEndingTimestamp,proto3" json:"auction_ending_timestamp,omitempty"`
 	// last auction result
 	LastAuctionResult *LastAuctionResult `protobuf:"bytes,5,opt,name=last_auction_result,json=lastAuctionResult,proto3" json:"last_auction_result,omitempty"`
+	// auction mode of the current round
+	RoundAuctionMode AuctionMode `protobuf:"varint,6,opt,name=round_auction_mode,json=roundAuctionMode,proto3,enum=injective.auction.v1beta1.AuctionMode" json:"round_auction_mode,omitempty"`
+	// bid commitments of sealed-bid rounds
+	BidCommitments []BidCommitment `protobuf:"bytes,7,rep,name=bid_commitments,json=bidCommitments,proto3" json:"bid_commitments"`
+	// archived results of the settled rounds
+	AuctionRoundRecords []AuctionRoundRecord `protobuf:"bytes,8,rep,name=auction_round_records,json=auctionRoundRecords,proto3" json:"auction_round_records"`
+	// addresses of the bidders of the current round
+	RoundBidders []string `protobuf:"bytes,9,rep,name=round_bidders,json=roundBidders,proto3" json:"round_bidders,omitempty"`
+	// sealed bid deposit refunds which are waiting to be claimed
+	ClaimableBidRefunds []ClaimableBidRefund `protobuf:"bytes,10,rep,name=claimable_bid_refunds,json=claimableBidRefunds,proto3" json:"claimable_bid_refunds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -105,6 +115,41 @@ func (m *GenesisState) GetLastAuctionResult() *LastAuctionResult {
 	return nil
 }
 
+func (m *GenesisState) GetRoundAuctionMode() AuctionMode {
+	if m != nil {
+		return m.RoundAuctionMode
+	}
+	return AuctionMode_English
+}
+
+func (m *GenesisState) GetBidCommitments() []BidCommitment {
+	if m != nil {
+		return m.BidCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionRoundRecords() []AuctionRoundRecord {
+	if m != nil {
+		return m.AuctionRoundRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoundBidders() []string {
+	if m != nil {
+		return m.RoundBidders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimableBidRefunds() []ClaimableBidRefund {
+	if m != nil {
+		return m.ClaimableBidRefunds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.auction.v1beta1.GenesisState")
 }
@@ -114,29 +159,38 @@ func init() {
 }
 
 var fileDescriptor_56f095f457353f49 = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xb6, 0xb7, 0x8b, 0x69, 0xef, 0xe2, 0xe6, 0x8a, 0xc4, 0x2e, 0x62, 0xd5, 0x85,
-	0x5d, 0x68, 0x42, 0x75, 0xe3, 0xae, 0x18, 0x10, 0x11, 0x2a, 0x48, 0x74, 0x25, 0x42, 0x98, 0x24,
-	0xc3, 0x64, 0x24, 0x99, 0x09, 0x99, 0x93, 0x82, 0x6f, 0xe1, 0x63, 0x75, 0x23, 0x74, 0xe9, 0x4a,
-	0xa4, 0x7d, 0x11, 0xe9, 0x74, 0x12, 0x44, 0xb0, 0xbb, 0x33, 0xff, 0xf9, 0xcf, 0xf9, 0xfe, 0xe1,
-	0xa0, 0x63, 0xc6, 0x9f, 0x49, 0x0c, 0x6c, 0x46, 0x3c, 0x5c, 0xc5, 0xc0, 0x04, 0xf7, 0x66, 0xe3,
-	0x88, 0x00, 0x1e, 0x7b, 0x94, 0x70, 0x22, 0x99, 0x74, 0x8b, 0x52, 0x80, 0xb0, 0xf6, 0x1a, 0xa3,
-	0xab, 0x8d, 0xae, 0x36, 0x0e, 0xb6, 0xec, 0xa8, 0xad, 0x6a, 0xc7, 0x60, 0x87, 0x0a, 0x2a, 0x54,
-	0xe9, 0xad, 0xab, 0x8d, 0x7a, 0xf8, 0xd6, 0x42, 0xfd, 0xeb, 0x0d, 0xeb, 0x1e, 0x30, 0x10, 0x6b,
-	0x82, 0xba, 0x05, 0x2e, 0x71, 0x2e, 0x6d, 0x73, 0x68, 0x8e, 0x7a, 0x67, 0x07, 0xee, 0xaf, 0x6c,
-	0xf7, 0x4e, 0x19, 0xfd, 0xce, 0xfc, 0x63, 0xdf, 0x08, 0xf4, 0x98, 0x75, 0x84, 0xfe, 0x6a, 0x5f,
-	0x58, 0x8a, 0x8a, 0x27, 0x76, 0x6b, 0x68, 0x8e, 0x3a, 0x41, 0x5f, 0x8b, 0xc1, 0x5a, 0xb3, 0x26,
-	0xa8, 0x97, 0x32, 0x9a, 0x12, 0x09, 0x61, 0xc4, 0x12, 0xbb, 0xad, 0x50, 0xce, 0x16, 0x94, 0xcf,
-	0x92, 0x00, 0xe9, 0x11, 0x9f, 0x25, 0xd6, 0x05, 0xb2, 0x6b, 0x0a, 0xe1, 0x09, 0xe3, 0x34, 0x04,
-	0x96, 0x13, 0x09, 0x38, 0x2f, 0xec, 0xce, 0xd0, 0x1c, 0xb5, 0x83, 0x5d, 0xdd, 0xbf, 0x52, 0xed,
-	0x87, 0xba, 0x6b, 0x3d, 0xa1, 0xff, 0x19, 0x96, 0x10, 0x36, 0x21, 0x89, 0xac, 0x32, 0xb0, 0xff,
-	0xa8, 0x08, 0x27, 0x5b, 0x22, 0x4c, 0xb1, 0x84, 0x4b, 0xfd, 0x09, 0x35, 0x13, 0xfc, 0xcb, 0x7e,
-	0x4a, 0x3e, 0x9d, 0x2f, 0x1d, 0x73, 0xb1, 0x74, 0xcc, 0xcf, 0xa5, 0x63, 0xbe, 0xae, 0x1c, 0x63,
-	0xb1, 0x72, 0x8c, 0xf7, 0x95, 0x63, 0x3c, 0xde, 0x52, 0x06, 0x69, 0x15, 0xb9, 0xb1, 0xc8, 0xbd,
-	0x9b, 0x1a, 0x32, 0xc5, 0x91, 0xf4, 0x1a, 0xe4, 0x69, 0x2c, 0x4a, 0xf2, 0xfd, 0x99, 0x62, 0xc6,
-	0xbd, 0x5c, 0x24, 0x55, 0x46, 0x64, 0x73, 0x5e, 0x78, 0x29, 0x88, 0x8c, 0xba, 0xea, 0x7e, 0xe7,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0x86, 0x72, 0xfe, 0x44, 0x02, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6a, 0xd4, 0x40,
+	0x18, 0xdf, 0xb8, 0xdb, 0xd5, 0xce, 0xae, 0x55, 0xa7, 0x2a, 0x63, 0x0f, 0x31, 0x2a, 0x68, 0x0e,
+	0x36, 0xa1, 0xeb, 0xc5, 0x5b, 0x31, 0x45, 0x44, 0x68, 0x41, 0x62, 0x41, 0x10, 0x21, 0x4c, 0x32,
+	0x9f, 0xd9, 0x91, 0x4c, 0x66, 0xc9, 0x4c, 0x0a, 0xbe, 0x85, 0x8f, 0xd5, 0x63, 0x8f, 0x9e, 0x44,
+	0x76, 0x1f, 0xc1, 0x17, 0x90, 0xcc, 0x4e, 0xc2, 0x6a, 0x69, 0x7a, 0x9b, 0xfc, 0xbe, 0xdf, 0xbf,
+	0xc9, 0xcc, 0xa0, 0x17, 0xbc, 0xfc, 0x06, 0x99, 0xe6, 0x67, 0x10, 0xd2, 0x3a, 0xd3, 0x5c, 0x96,
+	0xe1, 0xd9, 0x41, 0x0a, 0x9a, 0x1e, 0x84, 0x39, 0x94, 0xa0, 0xb8, 0x0a, 0x16, 0x95, 0xd4, 0x12,
+	0x3f, 0xea, 0x88, 0x81, 0x25, 0x06, 0x96, 0xb8, 0xd7, 0xe3, 0xd1, 0x52, 0x8d, 0xc7, 0xde, 0xfd,
+	0x5c, 0xe6, 0xd2, 0x2c, 0xc3, 0x66, 0xb5, 0x46, 0x9f, 0xfe, 0xd9, 0x42, 0xd3, 0x77, 0xeb, 0xac,
+	0x8f, 0x9a, 0x6a, 0xc0, 0x87, 0x68, 0xbc, 0xa0, 0x15, 0x15, 0x8a, 0x38, 0x9e, 0xe3, 0x4f, 0x66,
+	0x4f, 0x82, 0x2b, 0xb3, 0x83, 0x0f, 0x86, 0x18, 0x8d, 0xce, 0x7f, 0x3d, 0x1e, 0xc4, 0x56, 0x86,
+	0x9f, 0xa1, 0xdb, 0x96, 0x97, 0x54, 0xb2, 0x2e, 0x19, 0xb9, 0xe1, 0x39, 0xfe, 0x28, 0x9e, 0x5a,
+	0x30, 0x6e, 0x30, 0x7c, 0x88, 0x26, 0x73, 0x9e, 0xcf, 0x41, 0xe9, 0x24, 0xe5, 0x8c, 0x0c, 0x4d,
+	0x94, 0xdb, 0x13, 0x15, 0x71, 0x16, 0x23, 0x2b, 0x89, 0x38, 0xc3, 0xaf, 0x11, 0x69, 0x53, 0xa0,
+	0x64, 0xbc, 0xcc, 0x13, 0xcd, 0x05, 0x28, 0x4d, 0xc5, 0x82, 0x8c, 0x3c, 0xc7, 0x1f, 0xc6, 0x0f,
+	0xed, 0xfc, 0xad, 0x19, 0x9f, 0xb6, 0x53, 0xfc, 0x05, 0xed, 0x16, 0x54, 0xe9, 0xa4, 0x2b, 0x09,
+	0xaa, 0x2e, 0x34, 0xd9, 0x32, 0x15, 0x5e, 0xf6, 0x54, 0x38, 0xa6, 0x4a, 0xbf, 0xb1, 0x9b, 0x30,
+	0x9a, 0xf8, 0x5e, 0xf1, 0x3f, 0x84, 0x4f, 0x11, 0x36, 0xbb, 0xee, 0xec, 0x85, 0x64, 0x40, 0xc6,
+	0x9e, 0xe3, 0xef, 0xcc, 0x9e, 0xf7, 0x98, 0x5b, 0x97, 0x13, 0xc9, 0x20, 0xbe, 0x6b, 0x1c, 0x36,
+	0x10, 0xfc, 0x09, 0xdd, 0x49, 0x39, 0x4b, 0x32, 0x29, 0x04, 0xd7, 0x02, 0x4a, 0xad, 0xc8, 0x4d,
+	0x6f, 0xe8, 0x4f, 0x66, 0x7e, 0xff, 0x2f, 0x3b, 0xea, 0x04, 0xf6, 0x90, 0x76, 0xd2, 0x4d, 0x50,
+	0xe1, 0x1c, 0x3d, 0xf8, 0xe7, 0xb0, 0x92, 0x0a, 0x32, 0x59, 0x31, 0x45, 0x6e, 0x19, 0xfb, 0xfd,
+	0xeb, 0x1b, 0x9b, 0xf3, 0x8c, 0x8d, 0xca, 0x66, 0xec, 0xd2, 0x4b, 0x13, 0x73, 0x2b, 0xd6, 0x01,
+	0x29, 0x67, 0x0c, 0x2a, 0x45, 0xb6, 0xbd, 0xa1, 0xbf, 0x1d, 0x4f, 0x0d, 0x18, 0xad, 0xb1, 0xa6,
+	0x4d, 0x56, 0x50, 0x2e, 0x68, 0x5a, 0x40, 0x43, 0x4c, 0x2a, 0xf8, 0x5a, 0x97, 0x4c, 0x11, 0x74,
+	0x6d, 0x9b, 0xa3, 0x56, 0xd7, 0x5c, 0x14, 0xa3, 0x6a, 0xdb, 0x64, 0x97, 0x26, 0x2a, 0xca, 0xcf,
+	0x97, 0xae, 0x73, 0xb1, 0x74, 0x9d, 0xdf, 0x4b, 0xd7, 0xf9, 0xb1, 0x72, 0x07, 0x17, 0x2b, 0x77,
+	0xf0, 0x73, 0xe5, 0x0e, 0x3e, 0x9f, 0xe4, 0x5c, 0xcf, 0xeb, 0x34, 0xc8, 0xa4, 0x08, 0xdf, 0xb7,
+	0x69, 0xc7, 0x34, 0x55, 0x61, 0x97, 0xbd, 0x9f, 0xc9, 0x0a, 0x36, 0x3f, 0xe7, 0x94, 0x97, 0xa1,
+	0x90, 0xac, 0x2e, 0x40, 0x75, 0x8f, 0x50, 0x7f, 0x5f, 0x80, 0x4a, 0xc7, 0xe6, 0x95, 0xbd, 0xfa,
+	0x3b, 0x00, 0xb5, 0x8a, 0x1f, 0x65, 0xea, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -159,6 +213,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimableBidRefunds) > 0 {
+		for iNdEx := len(m.ClaimableBidRefunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableBidRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RoundBidders) > 0 {
+		for iNdEx := len(m.RoundBidders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoundBidders[iNdEx])
+			copy(dAtA[i:], m.RoundBidders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RoundBidders[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AuctionRoundRecords) > 0 {
+		for iNdEx := len(m.AuctionRoundRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionRoundRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BidCommitments) > 0 {
+		for iNdEx := len(m.BidCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RoundAuctionMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RoundAuctionMode))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.LastAuctionResult != nil {
 		{
 			size, err := m.LastAuctionResult.MarshalToSizedBuffer(dAtA[:i])
@@ -239,6 +349,33 @@ func (m *GenesisState) Size() (n int) {
 		l = m.LastAuctionResult.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.RoundAuctionMode != 0 {
+		n += 1 + sovGenesis(uint64(m.RoundAuctionMode))
+	}
+	if len(m.BidCommitments) > 0 {
+		for _, e := range m.BidCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionRoundRecords) > 0 {
+		for _, e := range m.AuctionRoundRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoundBidders) > 0 {
+		for _, s := range m.RoundBidders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimableBidRefunds) > 0 {
+		for _, e := range m.ClaimableBidRefunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -420,6 +557,159 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundAuctionMode", wireType)
+			}
+			m.RoundAuctionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundAuctionMode |= AuctionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidCommitments = append(m.BidCommitments, BidCommitment{})
+			if err := m.BidCommitments[len(m.BidCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRoundRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionRoundRecords = append(m.AuctionRoundRecords, AuctionRoundRecord{})
+			if err := m.AuctionRoundRecords[len(m.AuctionRoundRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundBidders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundBidders = append(m.RoundBidders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableBidRefunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableBidRefunds = append(m.ClaimableBidRefunds, ClaimableBidRefund{})
+			if err := m.ClaimableBidRefunds[len(m.ClaimableBidRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
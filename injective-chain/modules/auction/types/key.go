@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName = "auction"
 	StoreKey   = ModuleName
@@ -8,9 +12,39 @@ const (
 
 var (
 	// Keys for store prefixes
-	BidsKey              = []byte{0x01}
-	AuctionRoundKey      = []byte{0x03}
-	KeyEndingTimeStamp   = []byte{0x04}
-	KeyLastAuctionResult = []byte{0x05}
-	ParamsKey            = []byte{0x10}
+	BidsKey                = []byte{0x01}
+	AuctionRoundKey        = []byte{0x03}
+	KeyEndingTimeStamp     = []byte{0x04}
+	KeyLastAuctionResult   = []byte{0x05}
+	KeyRoundAuctionMode    = []byte{0x06}
+	BidCommitmentsKey      = []byte{0x07}
+	AuctionRoundRecordsKey = []byte{0x08}
+	RoundBiddersKey        = []byte{0x09}
+	ClaimableBidRefundsKey = []byte{0x0a}
+	ParamsKey              = []byte{0x10}
 )
+
+// GetBidCommitmentsPrefix returns the prefix of the bid commitments of the round
+func GetBidCommitmentsPrefix(round uint64) []byte {
+	return append(BidCommitmentsKey, sdk.Uint64ToBigEndian(round)...)
+}
+
+// GetAuctionRoundRecordKey returns the key of the archived result of the round
+func GetAuctionRoundRecordKey(round uint64) []byte {
+	return append(AuctionRoundRecordsKey, sdk.Uint64ToBigEndian(round)...)
+}
+
+// GetRoundBidderKey returns the key marking the bidder as a participant of the current round
+func GetRoundBidderKey(bidder sdk.AccAddress) []byte {
+	return append(RoundBiddersKey, bidder.Bytes()...)
+}
+
+// GetClaimableBidRefundKey returns the key of the claimable sealed bid deposit refund of the bidder
+func GetClaimableBidRefundKey(bidder sdk.AccAddress) []byte {
+	return append(ClaimableBidRefundsKey, bidder.Bytes()...)
+}
+
+// GetBidCommitmentKey returns the key of the bid commitment of the bidder in the round
+func GetBidCommitmentKey(round uint64, bidder sdk.AccAddress) []byte {
+	return append(GetBidCommitmentsPrefix(round), bidder.Bytes()...)
+}
@@ -11,12 +11,18 @@ import (
 const (
 	RouterKey = ModuleName
 
-	TypeMsgBid          = "bid"
-	TypeMsgUpdateParams = "updateParams"
+	TypeMsgBid            = "bid"
+	TypeMsgCommitBid      = "commitBid"
+	TypeMsgRevealBid      = "revealBid"
+	TypeMsgClaimBidRefund = "claimBidRefund"
+	TypeMsgUpdateParams   = "updateParams"
 )
 
 var (
 	_ sdk.Msg = &MsgBid{}
+	_ sdk.Msg = &MsgCommitBid{}
+	_ sdk.Msg = &MsgRevealBid{}
+	_ sdk.Msg = &MsgClaimBidRefund{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -93,3 +99,114 @@ func (msg MsgBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgCommitBid) Type() string { return TypeMsgCommitBid }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgCommitBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if len(msg.Commitment) != BidCommitmentLength {
+		return errors.Wrapf(ErrInvalidBidCommitment, "commitment must be %d bytes", BidCommitmentLength)
+	}
+
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Deposit.String())
+	}
+
+	if msg.Deposit.Denom != chaintypes.InjectiveCoin {
+		return errors.Wrap(ErrBidInvalid, msg.Deposit.Denom)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgRevealBid) Type() string { return TypeMsgRevealBid }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgRevealBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	if !msg.BidAmount.IsValid() || !msg.BidAmount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.BidAmount.String())
+	}
+
+	if msg.BidAmount.Denom != chaintypes.InjectiveCoin {
+		return errors.Wrap(ErrBidInvalid, msg.BidAmount.Denom)
+	}
+
+	if len(msg.Salt) < MinBidSaltLength {
+		return errors.Wrapf(ErrInvalidBidCommitment, "salt must be at least %d bytes", MinBidSaltLength)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgClaimBidRefund) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgClaimBidRefund) Type() string { return TypeMsgClaimBidRefund }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgClaimBidRefund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgClaimBidRefund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgClaimBidRefund) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -21,6 +21,12 @@ var (
 	DefaultInjBasketMaxCap = math.NewIntWithDecimal(10_000, 18)
 	// DefaultBiddersWhitelist represents default bidders whitelist (empty = all allowed)
 	DefaultBiddersWhitelist = []string{}
+	// DefaultAuctionMode represents the default open ascending auction
+	DefaultAuctionMode = AuctionMode_English
+	// DefaultRevealPeriod represents the number of seconds in 1 day
+	DefaultRevealPeriod int64 = 60 * 60 * 24
+	// DefaultMaxRoundRecords represents about 10 years of weekly auction rounds
+	DefaultMaxRoundRecords uint64 = 520
 )
 
 // Parameter keys
@@ -42,12 +48,18 @@ func NewParams(
 	minNextBidIncrementRate math.LegacyDec,
 	injBasketMaxCap math.Int,
 	biddersWhitelist []string,
+	auctionMode AuctionMode,
+	revealPeriod int64,
+	maxRoundRecords uint64,
 ) Params {
 	return Params{
 		AuctionPeriod:           auctionPeriod,
 		MinNextBidIncrementRate: minNextBidIncrementRate,
 		InjBasketMaxCap:         injBasketMaxCap,
 		BiddersWhitelist:        biddersWhitelist,
+		AuctionMode:             auctionMode,
+		RevealPeriod:            revealPeriod,
+		MaxRoundRecords:         maxRoundRecords,
 	}
 }
 
@@ -68,6 +80,9 @@ func DefaultParams() Params {
 		MinNextBidIncrementRate: DefaultMinNextBidIncrementRate,
 		InjBasketMaxCap:         DefaultInjBasketMaxCap,
 		BiddersWhitelist:        DefaultBiddersWhitelist,
+		AuctionMode:             DefaultAuctionMode,
+		RevealPeriod:            DefaultRevealPeriod,
+		MaxRoundRecords:         DefaultMaxRoundRecords,
 	}
 }
 
@@ -85,7 +100,19 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateBiddersWhitelist(p.BiddersWhitelist)
+	if err := validateBiddersWhitelist(p.BiddersWhitelist); err != nil {
+		return err
+	}
+
+	if err := validateAuctionMode(p.AuctionMode); err != nil {
+		return err
+	}
+
+	if err := validateRevealPeriod(p.RevealPeriod, p.AuctionPeriod, p.AuctionMode); err != nil {
+		return err
+	}
+
+	return validateMaxRoundRecords(p.MaxRoundRecords)
 }
 
 func validateAuctionPeriodDuration(i interface{}) error {
@@ -163,3 +190,45 @@ func validateBiddersWhitelist(i any) error {
 
 	return nil
 }
+
+func validateAuctionMode(i interface{}) error {
+	v, ok := i.(AuctionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := AuctionMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid AuctionMode: %d", v)
+	}
+
+	return nil
+}
+
+func validateRevealPeriod(revealPeriod, auctionPeriod int64, mode AuctionMode) error {
+	if revealPeriod < 0 {
+		return fmt.Errorf("RevealPeriod cannot be negative: %d", revealPeriod)
+	}
+
+	if revealPeriod >= auctionPeriod {
+		return fmt.Errorf("RevealPeriod must be less than AuctionPeriod: %d >= %d", revealPeriod, auctionPeriod)
+	}
+
+	if mode == AuctionMode_SealedBid && revealPeriod == 0 {
+		return errors.New("RevealPeriod must be positive in sealed-bid mode")
+	}
+
+	return nil
+}
+
+func validateMaxRoundRecords(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("MaxRoundRecords must be positive")
+	}
+
+	return nil
+}
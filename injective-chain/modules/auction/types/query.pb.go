@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -394,6 +395,412 @@ func (m *QueryLastAuctionResultResponse) GetLastAuctionResult() *LastAuctionResu
 	return nil
 }
 
+// QueryAuctionPhaseRequest is the request type for the Query/AuctionPhase RPC
+// method.
+type QueryAuctionPhaseRequest struct {
+}
+
+func (m *QueryAuctionPhaseRequest) Reset()         { *m = QueryAuctionPhaseRequest{} }
+func (m *QueryAuctionPhaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionPhaseRequest) ProtoMessage()    {}
+func (*QueryAuctionPhaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{8}
+}
+func (m *QueryAuctionPhaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionPhaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionPhaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionPhaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionPhaseRequest.Merge(m, src)
+}
+func (m *QueryAuctionPhaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionPhaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionPhaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionPhaseRequest proto.InternalMessageInfo
+
+// QueryAuctionPhaseResponse is the response type for the Query/AuctionPhase
+// RPC method.
+type QueryAuctionPhaseResponse struct {
+	// round describes the current auction round
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// mode describes the auction mode of the current round
+	Mode AuctionMode `protobuf:"varint,2,opt,name=mode,proto3,enum=injective.auction.v1beta1.AuctionMode" json:"mode,omitempty"`
+	// phase describes the current phase of the round
+	Phase AuctionPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=injective.auction.v1beta1.AuctionPhase" json:"phase,omitempty"`
+	// phase_ending_timestamp describes the end time of the current phase
+	PhaseEndingTimestamp int64 `protobuf:"varint,4,opt,name=phase_ending_timestamp,json=phaseEndingTimestamp,proto3" json:"phase_ending_timestamp,omitempty"`
+	// auction_ending_timestamp describes the end time of the current round
+	AuctionEndingTimestamp int64 `protobuf:"varint,5,opt,name=auction_ending_timestamp,json=auctionEndingTimestamp,proto3" json:"auction_ending_timestamp,omitempty"`
+}
+
+func (m *QueryAuctionPhaseResponse) Reset()         { *m = QueryAuctionPhaseResponse{} }
+func (m *QueryAuctionPhaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionPhaseResponse) ProtoMessage()    {}
+func (*QueryAuctionPhaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{9}
+}
+func (m *QueryAuctionPhaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionPhaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionPhaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionPhaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionPhaseResponse.Merge(m, src)
+}
+func (m *QueryAuctionPhaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionPhaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionPhaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionPhaseResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionPhaseResponse) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *QueryAuctionPhaseResponse) GetMode() AuctionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return AuctionMode_English
+}
+
+func (m *QueryAuctionPhaseResponse) GetPhase() AuctionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return AuctionPhase_Bidding
+}
+
+func (m *QueryAuctionPhaseResponse) GetPhaseEndingTimestamp() int64 {
+	if m != nil {
+		return m.PhaseEndingTimestamp
+	}
+	return 0
+}
+
+func (m *QueryAuctionPhaseResponse) GetAuctionEndingTimestamp() int64 {
+	if m != nil {
+		return m.AuctionEndingTimestamp
+	}
+	return 0
+}
+
+// QueryBidCommitmentsRequest is the request type for the Query/BidCommitments
+// RPC method.
+type QueryBidCommitmentsRequest struct {
+	// round to query, the current round if 0
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *QueryBidCommitmentsRequest) Reset()         { *m = QueryBidCommitmentsRequest{} }
+func (m *QueryBidCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBidCommitmentsRequest) ProtoMessage()    {}
+func (*QueryBidCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{10}
+}
+func (m *QueryBidCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidCommitmentsRequest.Merge(m, src)
+}
+func (m *QueryBidCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidCommitmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidCommitmentsRequest proto.InternalMessageInfo
+
+func (m *QueryBidCommitmentsRequest) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// QueryBidCommitmentsResponse is the response type for the
+// Query/BidCommitments RPC method.
+type QueryBidCommitmentsResponse struct {
+	Commitments []BidCommitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments"`
+}
+
+func (m *QueryBidCommitmentsResponse) Reset()         { *m = QueryBidCommitmentsResponse{} }
+func (m *QueryBidCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBidCommitmentsResponse) ProtoMessage()    {}
+func (*QueryBidCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{11}
+}
+func (m *QueryBidCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBidCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBidCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBidCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBidCommitmentsResponse.Merge(m, src)
+}
+func (m *QueryBidCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBidCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBidCommitmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBidCommitmentsResponse proto.InternalMessageInfo
+
+func (m *QueryBidCommitmentsResponse) GetCommitments() []BidCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+// QueryAuctionRoundRequest is the request type for the Query/AuctionRound RPC
+// method.
+type QueryAuctionRoundRequest struct {
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *QueryAuctionRoundRequest) Reset()         { *m = QueryAuctionRoundRequest{} }
+func (m *QueryAuctionRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRoundRequest) ProtoMessage()    {}
+func (*QueryAuctionRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{12}
+}
+func (m *QueryAuctionRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRoundRequest.Merge(m, src)
+}
+func (m *QueryAuctionRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRoundRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionRoundRequest) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// QueryAuctionRoundResponse is the response type for the Query/AuctionRound
+// RPC method.
+type QueryAuctionRoundResponse struct {
+	Record AuctionRoundRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryAuctionRoundResponse) Reset()         { *m = QueryAuctionRoundResponse{} }
+func (m *QueryAuctionRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRoundResponse) ProtoMessage()    {}
+func (*QueryAuctionRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{13}
+}
+func (m *QueryAuctionRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRoundResponse.Merge(m, src)
+}
+func (m *QueryAuctionRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRoundResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionRoundResponse) GetRecord() AuctionRoundRecord {
+	if m != nil {
+		return m.Record
+	}
+	return AuctionRoundRecord{}
+}
+
+// QueryAuctionRoundsRequest is the request type for the Query/AuctionRounds
+// RPC method.
+type QueryAuctionRoundsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionRoundsRequest) Reset()         { *m = QueryAuctionRoundsRequest{} }
+func (m *QueryAuctionRoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRoundsRequest) ProtoMessage()    {}
+func (*QueryAuctionRoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{14}
+}
+func (m *QueryAuctionRoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRoundsRequest.Merge(m, src)
+}
+func (m *QueryAuctionRoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRoundsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionRoundsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionRoundsResponse is the response type for the Query/AuctionRounds
+// RPC method.
+type QueryAuctionRoundsResponse struct {
+	Records    []AuctionRoundRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionRoundsResponse) Reset()         { *m = QueryAuctionRoundsResponse{} }
+func (m *QueryAuctionRoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRoundsResponse) ProtoMessage()    {}
+func (*QueryAuctionRoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{15}
+}
+func (m *QueryAuctionRoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRoundsResponse.Merge(m, src)
+}
+func (m *QueryAuctionRoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRoundsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionRoundsResponse) GetRecords() []AuctionRoundRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryAuctionRoundsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAuctionParamsRequest)(nil), "injective.auction.v1beta1.QueryAuctionParamsRequest")
 	proto.RegisterType((*QueryAuctionParamsResponse)(nil), "injective.auction.v1beta1.QueryAuctionParamsResponse")
@@ -403,6 +810,14 @@ func init() {
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.auction.v1beta1.QueryModuleStateResponse")
 	proto.RegisterType((*QueryLastAuctionResultRequest)(nil), "injective.auction.v1beta1.QueryLastAuctionResultRequest")
 	proto.RegisterType((*QueryLastAuctionResultResponse)(nil), "injective.auction.v1beta1.QueryLastAuctionResultResponse")
+	proto.RegisterType((*QueryAuctionPhaseRequest)(nil), "injective.auction.v1beta1.QueryAuctionPhaseRequest")
+	proto.RegisterType((*QueryAuctionPhaseResponse)(nil), "injective.auction.v1beta1.QueryAuctionPhaseResponse")
+	proto.RegisterType((*QueryBidCommitmentsRequest)(nil), "injective.auction.v1beta1.QueryBidCommitmentsRequest")
+	proto.RegisterType((*QueryBidCommitmentsResponse)(nil), "injective.auction.v1beta1.QueryBidCommitmentsResponse")
+	proto.RegisterType((*QueryAuctionRoundRequest)(nil), "injective.auction.v1beta1.QueryAuctionRoundRequest")
+	proto.RegisterType((*QueryAuctionRoundResponse)(nil), "injective.auction.v1beta1.QueryAuctionRoundResponse")
+	proto.RegisterType((*QueryAuctionRoundsRequest)(nil), "injective.auction.v1beta1.QueryAuctionRoundsRequest")
+	proto.RegisterType((*QueryAuctionRoundsResponse)(nil), "injective.auction.v1beta1.QueryAuctionRoundsResponse")
 }
 
 func init() {
@@ -410,51 +825,75 @@ func init() {
 }
 
 var fileDescriptor_2ae80edbdb9fffb7 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x02, 0x25, 0x71, 0x90, 0x44, 0x46, 0x8c, 0xed, 0x2a, 0xdb, 0xb2, 0x6a, 0x28, 0x89,
-	0xec, 0x42, 0x51, 0xa3, 0x51, 0x63, 0x28, 0x07, 0x43, 0x02, 0x89, 0xae, 0x5e, 0x34, 0x1a, 0x32,
-	0xdd, 0x4e, 0xb6, 0x23, 0xdd, 0x99, 0xb2, 0x33, 0x4b, 0xc2, 0xc5, 0x83, 0x9f, 0xc0, 0xc4, 0x0f,
-	0x61, 0xe2, 0xc1, 0x4f, 0xe0, 0xc1, 0x23, 0x17, 0x13, 0x12, 0x2f, 0xc6, 0x03, 0x1a, 0xe0, 0x83,
-	0x98, 0x9d, 0x99, 0x16, 0xb0, 0xdd, 0x6d, 0xca, 0xa9, 0xbb, 0xef, 0xbd, 0xdf, 0xef, 0xfd, 0xde,
-	0xbf, 0x2d, 0xb8, 0x45, 0xe8, 0x3b, 0xec, 0x0b, 0xb2, 0x83, 0x5d, 0x14, 0xfb, 0x82, 0x30, 0xea,
-	0xee, 0x2c, 0xd5, 0xb1, 0x40, 0x4b, 0xee, 0x76, 0x8c, 0xa3, 0x5d, 0xa7, 0x1d, 0x31, 0xc1, 0x60,
-	0xb1, 0x1b, 0xe6, 0xe8, 0x30, 0x47, 0x87, 0x99, 0xd7, 0x03, 0xc6, 0x82, 0x16, 0x76, 0x51, 0x9b,
-	0xb8, 0x88, 0x52, 0x26, 0x50, 0xe2, 0xe6, 0x0a, 0x68, 0xce, 0xa5, 0xf3, 0x77, 0x88, 0x06, 0x06,
-	0x06, 0x98, 0x62, 0x4e, 0x3a, 0x8c, 0xd3, 0x01, 0x0b, 0x98, 0x7c, 0x74, 0x93, 0x27, 0x6d, 0xb5,
-	0x7c, 0xc6, 0x43, 0xc6, 0xdd, 0x3a, 0xe2, 0xb8, 0x0b, 0xf4, 0x19, 0xd1, 0xf4, 0xf6, 0x35, 0x50,
-	0x7c, 0x9e, 0xd4, 0xb3, 0xa2, 0xb8, 0x9f, 0xa1, 0x08, 0x85, 0xdc, 0xc3, 0xdb, 0x31, 0xe6, 0xc2,
-	0x7e, 0x0b, 0xcc, 0x7e, 0x4e, 0xde, 0x66, 0x94, 0x63, 0xf8, 0x04, 0x8c, 0xb7, 0xa5, 0xa5, 0x60,
-	0x94, 0x8d, 0xca, 0x44, 0x75, 0xd6, 0x49, 0x6d, 0x86, 0xa3, 0xa0, 0xb5, 0xb1, 0xbd, 0x83, 0x52,
-	0xce, 0xd3, 0x30, 0xdb, 0x06, 0x65, 0x49, 0xbf, 0x1a, 0x47, 0x11, 0xa6, 0x42, 0x67, 0xa9, 0x21,
-	0xbe, 0x85, 0x45, 0x47, 0xc2, 0x8f, 0x11, 0x30, 0x9b, 0x11, 0xa4, 0xa5, 0xf8, 0x60, 0x1c, 0x85,
-	0x2c, 0xa6, 0xa2, 0x60, 0x94, 0x47, 0x2b, 0x13, 0xd5, 0xa2, 0xa3, 0xca, 0x76, 0x92, 0xb2, 0xbb,
-	0x22, 0x56, 0x19, 0xa1, 0xb5, 0xc5, 0x44, 0xc2, 0x97, 0x3f, 0xa5, 0x4a, 0x40, 0x44, 0x33, 0xae,
-	0x3b, 0x3e, 0x0b, 0x5d, 0xdd, 0x23, 0xf5, 0xb3, 0xc0, 0x1b, 0x5b, 0xae, 0xd8, 0x6d, 0x63, 0x2e,
-	0x01, 0xdc, 0xd3, 0xd4, 0xd0, 0x06, 0x17, 0x75, 0x59, 0x1e, 0x8b, 0x69, 0xa3, 0x30, 0x52, 0x36,
-	0x2a, 0x63, 0xde, 0x19, 0x1b, 0x74, 0x00, 0xd4, 0xef, 0xab, 0x2d, 0xc6, 0x09, 0x0d, 0x5e, 0x92,
-	0x10, 0x17, 0x46, 0x65, 0x64, 0x1f, 0x0f, 0xbc, 0x09, 0x26, 0x9b, 0x24, 0x68, 0x62, 0x2e, 0x6a,
-	0xa4, 0xd1, 0xc0, 0x51, 0x61, 0xac, 0x6c, 0x54, 0x2e, 0x78, 0x67, 0x8d, 0x70, 0x0d, 0x5c, 0x3a,
-	0x31, 0xac, 0xa8, 0x42, 0xf3, 0x49, 0x60, 0x6d, 0x26, 0xa9, 0xe6, 0xf7, 0x41, 0xe9, 0x8a, 0xd2,
-	0xce, 0x1b, 0x5b, 0x0e, 0x61, 0x6e, 0x88, 0x44, 0xd3, 0x59, 0xa3, 0xc2, 0xeb, 0x81, 0xd9, 0x45,
-	0x70, 0x55, 0xb6, 0x73, 0x83, 0x35, 0xe2, 0x16, 0x7e, 0x21, 0x90, 0xc0, 0x9d, 0x56, 0xbf, 0x02,
-	0x85, 0x5e, 0x97, 0x6e, 0xf0, 0x63, 0x90, 0xe7, 0x89, 0x41, 0x8f, 0x7a, 0x2e, 0x63, 0xd4, 0x4f,
-	0xd5, 0x56, 0x2a, 0xbc, 0x42, 0xd9, 0x25, 0x30, 0x23, 0xa9, 0xd7, 0x11, 0xef, 0x4c, 0xd0, 0xc3,
-	0x3c, 0x6e, 0x75, 0xc7, 0xfc, 0x1e, 0x58, 0x69, 0x01, 0x5a, 0xc1, 0x1b, 0x70, 0xb9, 0x85, 0xb8,
-	0xd8, 0xd4, 0xe9, 0x36, 0x23, 0xe9, 0xd6, 0x7a, 0x6e, 0x67, 0xe8, 0xe9, 0xa5, 0x9c, 0x6a, 0xfd,
-	0x6f, 0xaa, 0x1e, 0xe7, 0x41, 0x5e, 0x0a, 0x80, 0x9f, 0x0d, 0x30, 0x79, 0x66, 0xdf, 0xe1, 0x9d,
-	0x0c, 0xf2, 0xd4, 0xdb, 0x31, 0xef, 0x0e, 0x89, 0x52, 0x65, 0xda, 0xf3, 0x1f, 0x7e, 0x1e, 0x7f,
-	0x1a, 0xb9, 0x01, 0x67, 0xdd, 0xf4, 0xbb, 0x57, 0xe7, 0x03, 0xbf, 0x19, 0x60, 0xba, 0xdf, 0x55,
-	0xc0, 0x87, 0x83, 0x52, 0x67, 0x1c, 0x9c, 0xf9, 0xe8, 0x7c, 0xe0, 0x21, 0xe4, 0xd7, 0x95, 0xca,
-	0xaf, 0x06, 0x80, 0x9a, 0xe4, 0xd4, 0xc6, 0xc1, 0xea, 0xa0, 0xfc, 0xbd, 0x9b, 0x6b, 0x2e, 0x0f,
-	0x85, 0xd1, 0x52, 0x5d, 0x29, 0x75, 0x1e, 0xce, 0x65, 0x48, 0x0d, 0x25, 0x6e, 0x53, 0x2e, 0x31,
-	0xfc, 0x6e, 0x80, 0xa9, 0x9e, 0x65, 0x82, 0xf7, 0x07, 0xe5, 0x4e, 0xdb, 0x79, 0xf3, 0xc1, 0x39,
-	0x90, 0x5a, 0xfb, 0x3d, 0xa9, 0x7d, 0x11, 0x3a, 0x19, 0xda, 0xfb, 0x5c, 0x4b, 0x2d, 0xd8, 0x3b,
-	0xb4, 0x8c, 0xfd, 0x43, 0xcb, 0xf8, 0x7b, 0x68, 0x19, 0x1f, 0x8f, 0xac, 0xdc, 0xfe, 0x91, 0x95,
-	0xfb, 0x75, 0x64, 0xe5, 0x5e, 0x6f, 0x9c, 0xfa, 0x1c, 0xae, 0x75, 0x38, 0xd7, 0x51, 0x9d, 0x9f,
-	0x64, 0x58, 0xf0, 0x59, 0x84, 0x4f, 0xbf, 0x36, 0x11, 0xa1, 0xba, 0x45, 0xbc, 0x9b, 0x5e, 0x7e,
-	0x39, 0xeb, 0xe3, 0xf2, 0xdf, 0x65, 0xf9, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xf4, 0xc4,
-	0x76, 0x47, 0x07, 0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x49, 0x1c, 0xc4, 0x4b, 0x1b, 0xd1, 0x21, 0x14, 0x7b, 0x4b, 0x1d, 0x67, 0x81,
+	0xc6, 0x29, 0xcd, 0x6e, 0xe2, 0xa4, 0x55, 0xf9, 0x25, 0x54, 0x47, 0x50, 0x45, 0x34, 0x52, 0x59,
+	0xb8, 0x80, 0x40, 0xd6, 0x78, 0x77, 0xb4, 0x1e, 0xe2, 0xdd, 0x71, 0x77, 0xc6, 0x95, 0x2a, 0x04,
+	0x07, 0xfe, 0x02, 0x24, 0x4e, 0x48, 0xdc, 0x38, 0x20, 0x21, 0xc4, 0x05, 0x8e, 0x1c, 0x38, 0xf6,
+	0x82, 0x54, 0x89, 0x0b, 0xe2, 0x50, 0x50, 0xc2, 0x1f, 0x52, 0xed, 0xcc, 0xac, 0xbd, 0xae, 0xd7,
+	0xeb, 0x38, 0xa7, 0x78, 0x67, 0xde, 0xf7, 0xbd, 0xcf, 0xbc, 0x79, 0xfb, 0xde, 0x06, 0x5e, 0xa5,
+	0xd1, 0xe7, 0xc4, 0x13, 0xf4, 0x3e, 0x71, 0x70, 0xdf, 0x13, 0x94, 0x45, 0xce, 0xfd, 0x9d, 0x36,
+	0x11, 0x78, 0xc7, 0xb9, 0xd7, 0x27, 0xf1, 0x03, 0xbb, 0x17, 0x33, 0xc1, 0x50, 0x65, 0x60, 0x66,
+	0x6b, 0x33, 0x5b, 0x9b, 0x99, 0x2f, 0x05, 0x8c, 0x05, 0x5d, 0xe2, 0xe0, 0x1e, 0x75, 0x70, 0x14,
+	0x31, 0x81, 0x93, 0x6d, 0xae, 0x84, 0xe6, 0xc6, 0x64, 0xff, 0xa9, 0xa3, 0xa9, 0x86, 0x01, 0x89,
+	0x08, 0xa7, 0xa9, 0xc7, 0xd5, 0x80, 0x05, 0x4c, 0xfe, 0x74, 0x92, 0x5f, 0x7a, 0xb5, 0xea, 0x31,
+	0x1e, 0x32, 0xee, 0xb4, 0x31, 0x27, 0x03, 0xa1, 0xc7, 0x68, 0xea, 0xfe, 0x6a, 0x76, 0x5f, 0x9e,
+	0x6c, 0x60, 0xd5, 0xc3, 0x01, 0x8d, 0xf0, 0x10, 0xc5, 0xba, 0x04, 0x95, 0x0f, 0x12, 0x8b, 0x5b,
+	0x8a, 0xe3, 0x2e, 0x8e, 0x71, 0xc8, 0x5d, 0x72, 0xaf, 0x4f, 0xb8, 0xb0, 0x3e, 0x03, 0x33, 0x6f,
+	0x93, 0xf7, 0x58, 0xc4, 0x09, 0x7a, 0x07, 0x96, 0x7a, 0x72, 0xa5, 0x6c, 0xd4, 0x8c, 0xfa, 0x72,
+	0x63, 0xdd, 0x9e, 0x98, 0x38, 0x5b, 0x49, 0x9b, 0x8b, 0x0f, 0x1f, 0xaf, 0xcd, 0xb9, 0x5a, 0x66,
+	0x59, 0x50, 0x93, 0xee, 0xf7, 0xfb, 0x71, 0x4c, 0x22, 0xa1, 0xa3, 0x34, 0x31, 0x3f, 0x22, 0x22,
+	0x45, 0xf8, 0x73, 0x1e, 0xd6, 0x0b, 0x8c, 0x34, 0x8a, 0x07, 0x4b, 0x38, 0x64, 0xfd, 0x48, 0x94,
+	0x8d, 0xda, 0x42, 0x7d, 0xb9, 0x51, 0xb1, 0x55, 0x0a, 0xec, 0x24, 0x05, 0x03, 0x88, 0x7d, 0x46,
+	0xa3, 0xe6, 0x76, 0x82, 0xf0, 0xd3, 0xbf, 0x6b, 0xf5, 0x80, 0x8a, 0x4e, 0xbf, 0x6d, 0x7b, 0x2c,
+	0x74, 0x74, 0xbe, 0xd4, 0x9f, 0x2d, 0xee, 0x1f, 0x39, 0xe2, 0x41, 0x8f, 0x70, 0x29, 0xe0, 0xae,
+	0x76, 0x8d, 0x2c, 0x38, 0xa7, 0x8f, 0xe5, 0xb2, 0x7e, 0xe4, 0x97, 0xe7, 0x6b, 0x46, 0x7d, 0xd1,
+	0x1d, 0x59, 0x43, 0x36, 0x20, 0xfd, 0xbc, 0xdf, 0x65, 0x9c, 0x46, 0xc1, 0x47, 0x34, 0x24, 0xe5,
+	0x05, 0x69, 0x99, 0xb3, 0x83, 0x5e, 0x81, 0xf3, 0x1d, 0x1a, 0x74, 0x08, 0x17, 0x4d, 0xea, 0xfb,
+	0x24, 0x2e, 0x2f, 0xd6, 0x8c, 0xfa, 0xb3, 0xee, 0xe8, 0x22, 0x3a, 0x80, 0xe7, 0x86, 0x0b, 0xb7,
+	0xd4, 0x41, 0x4b, 0x89, 0x61, 0xf3, 0x72, 0x72, 0x9a, 0x7f, 0x1e, 0xaf, 0xbd, 0xa0, 0xd8, 0xb9,
+	0x7f, 0x64, 0x53, 0xe6, 0x84, 0x58, 0x74, 0xec, 0x83, 0x48, 0xb8, 0x63, 0x32, 0xab, 0x02, 0x2f,
+	0xca, 0x74, 0x1e, 0x32, 0xbf, 0xdf, 0x25, 0x1f, 0x0a, 0x2c, 0x48, 0x9a, 0xea, 0x8f, 0xa1, 0x3c,
+	0xbe, 0xa5, 0x13, 0xfc, 0x36, 0x94, 0x78, 0xb2, 0xa0, 0xaf, 0x7a, 0xa3, 0xe0, 0xaa, 0x6f, 0xab,
+	0x0a, 0x56, 0x7a, 0xa5, 0xb2, 0xd6, 0xe0, 0xb2, 0x74, 0x7d, 0x07, 0xf3, 0xf4, 0x06, 0x5d, 0xc2,
+	0xfb, 0xdd, 0xc1, 0x35, 0x7f, 0x05, 0xd5, 0x49, 0x06, 0x9a, 0xe0, 0x53, 0x78, 0xbe, 0x8b, 0xb9,
+	0x68, 0xe9, 0x70, 0xad, 0x58, 0x6e, 0x6b, 0x9e, 0x6b, 0x05, 0x3c, 0xe3, 0x2e, 0x2f, 0x74, 0x9f,
+	0x5e, 0xb2, 0x4c, 0x7d, 0xf6, 0xb4, 0xd2, 0x3b, 0x98, 0x0f, 0xf2, 0xf2, 0xfd, 0x3c, 0x54, 0x72,
+	0x36, 0x35, 0xd7, 0x2a, 0x94, 0x62, 0x59, 0x0e, 0x86, 0xbc, 0x64, 0xf5, 0x80, 0xde, 0x80, 0xc5,
+	0x90, 0xf9, 0x44, 0xd6, 0xc8, 0x4a, 0xe3, 0x4a, 0x01, 0x9e, 0x76, 0x7a, 0xc8, 0x7c, 0xe2, 0x4a,
+	0x4d, 0x92, 0xeb, 0x5e, 0x12, 0x42, 0x96, 0xcd, 0x4a, 0x63, 0x63, 0xba, 0x58, 0x11, 0x29, 0x15,
+	0xda, 0x83, 0x8b, 0xf2, 0x47, 0x8b, 0x44, 0x3e, 0x8d, 0x82, 0x96, 0xa0, 0x21, 0xe1, 0x02, 0x87,
+	0x3d, 0x59, 0x5b, 0x0b, 0xee, 0xaa, 0xdc, 0x7d, 0x37, 0xf2, 0x75, 0x0d, 0xca, 0x3d, 0x74, 0x13,
+	0xca, 0x69, 0x66, 0xc7, 0x74, 0x25, 0xa9, 0xbb, 0xa8, 0xf7, 0x9f, 0x52, 0x5a, 0x0d, 0xdd, 0x24,
+	0x9a, 0xd4, 0xdf, 0x67, 0x61, 0x48, 0x45, 0x48, 0x22, 0x91, 0xb6, 0x90, 0xfc, 0xf4, 0x58, 0x0c,
+	0x2e, 0xe5, 0x6a, 0x74, 0x4e, 0xef, 0xc2, 0xb2, 0x37, 0x5c, 0xd6, 0xef, 0x74, 0xbd, 0x20, 0x0f,
+	0x23, 0x7e, 0x74, 0x97, 0xc9, 0xba, 0xb0, 0xb6, 0x47, 0xef, 0x57, 0xbe, 0xac, 0xc5, 0x88, 0x1d,
+	0xa8, 0xe4, 0x28, 0x34, 0xe0, 0xfb, 0xb0, 0x14, 0x13, 0x8f, 0xc5, 0xbe, 0xae, 0xbf, 0xad, 0xe9,
+	0x77, 0xa4, 0x1d, 0x24, 0xa2, 0xb4, 0x0d, 0x2a, 0x17, 0x96, 0x97, 0x13, 0x69, 0x90, 0xbf, 0xf7,
+	0x00, 0x86, 0x3d, 0x5b, 0x47, 0xbb, 0x32, 0xd2, 0xdd, 0xd4, 0xe8, 0x1a, 0x36, 0xda, 0x20, 0x2d,
+	0x5c, 0x37, 0xa3, 0xb4, 0x7e, 0x33, 0xc0, 0xcc, 0x8b, 0xa2, 0x0f, 0x74, 0x08, 0xcf, 0x28, 0x9a,
+	0x34, 0xdb, 0x67, 0x3a, 0x51, 0xea, 0x03, 0xdd, 0x1e, 0xa1, 0x9e, 0xd7, 0x3d, 0x63, 0x1a, 0xb5,
+	0x62, 0xc9, 0x62, 0x37, 0xbe, 0x5b, 0x86, 0x92, 0xc4, 0x46, 0x3f, 0x1a, 0x70, 0x7e, 0x64, 0x0e,
+	0xa1, 0xbd, 0x02, 0xc4, 0x89, 0x33, 0xcd, 0xbc, 0x3e, 0xa3, 0x4a, 0x41, 0x59, 0x9b, 0x5f, 0xff,
+	0xf5, 0xff, 0xb7, 0xf3, 0x2f, 0xa3, 0x75, 0x67, 0xf2, 0xec, 0x56, 0x63, 0x0d, 0xfd, 0x6e, 0xc0,
+	0x6a, 0xde, 0xb4, 0x42, 0x6f, 0x4e, 0x0b, 0x5d, 0x30, 0x08, 0xcd, 0xb7, 0xce, 0x26, 0x9e, 0x01,
+	0xbf, 0xad, 0x28, 0x7f, 0x31, 0x00, 0x0d, 0x9b, 0x52, 0x3a, 0x09, 0x50, 0x63, 0x5a, 0xfc, 0xf1,
+	0x89, 0x62, 0xee, 0xce, 0xa4, 0xd1, 0xa8, 0x8e, 0x44, 0xdd, 0x44, 0x1b, 0x05, 0xa8, 0xa1, 0xd4,
+	0xb5, 0xe4, 0x70, 0x41, 0x7f, 0x18, 0x70, 0x61, 0xac, 0xc9, 0xa3, 0x9b, 0xd3, 0x62, 0x4f, 0x9a,
+	0x45, 0xe6, 0xeb, 0x67, 0x50, 0x6a, 0xf6, 0x1b, 0x92, 0x7d, 0x1b, 0xd9, 0x05, 0xec, 0x39, 0x53,
+	0x0c, 0xfd, 0x60, 0xc0, 0xb9, 0x6c, 0x2f, 0x47, 0xbb, 0xa7, 0xad, 0xd2, 0xcc, 0xa0, 0x32, 0xf7,
+	0x66, 0x13, 0x69, 0xe6, 0xba, 0x64, 0xb6, 0x50, 0xad, 0xa8, 0xb2, 0x25, 0xd4, 0xaf, 0x06, 0xac,
+	0x8c, 0x76, 0x6c, 0x34, 0xf5, 0x6d, 0xca, 0x9d, 0x0a, 0xe6, 0x8d, 0x59, 0x65, 0x9a, 0xb5, 0x21,
+	0x59, 0xaf, 0xa1, 0xab, 0x45, 0x65, 0x4c, 0xfd, 0x56, 0xa6, 0xf5, 0xa3, 0x9f, 0x87, 0xb9, 0x55,
+	0xdf, 0x68, 0xa7, 0xcd, 0x6d, 0x76, 0x48, 0x98, 0x7b, 0xb3, 0x89, 0x34, 0xef, 0x8e, 0xe4, 0x7d,
+	0x0d, 0x6d, 0x16, 0xf0, 0xca, 0x71, 0xc3, 0x9d, 0x2f, 0xe4, 0xdf, 0x2f, 0xb3, 0x7d, 0x4e, 0xfa,
+	0x3a, 0x7d, 0x9f, 0x1b, 0x19, 0x1c, 0xe6, 0xf5, 0x19, 0x55, 0x33, 0x34, 0x0a, 0x45, 0xdc, 0x0c,
+	0x1e, 0x1e, 0x57, 0x8d, 0x47, 0xc7, 0x55, 0xe3, 0xbf, 0xe3, 0xaa, 0xf1, 0xcd, 0x49, 0x75, 0xee,
+	0xd1, 0x49, 0x75, 0xee, 0xef, 0x93, 0xea, 0xdc, 0x27, 0x87, 0x99, 0x6f, 0xeb, 0x83, 0xd4, 0xcd,
+	0x1d, 0xdc, 0xe6, 0x43, 0xa7, 0x5b, 0x1e, 0x8b, 0x49, 0xf6, 0xb1, 0x83, 0x69, 0xa4, 0xdf, 0x6b,
+	0x3e, 0x88, 0x28, 0x3f, 0xc3, 0xdb, 0x4b, 0xf2, 0x5f, 0x95, 0xdd, 0x27, 0x03, 0x00, 0xf4, 0x02,
+	0x2c, 0x38, 0xc0, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -476,6 +915,14 @@ type QueryClient interface {
 	// Retrieves the entire auction module's state
 	AuctionModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 	LastAuctionResult(ctx context.Context, in *QueryLastAuctionResultRequest, opts ...grpc.CallOption) (*QueryLastAuctionResultResponse, error)
+	// Retrieves the mode and phase of the current auction round
+	AuctionPhase(ctx context.Context, in *QueryAuctionPhaseRequest, opts ...grpc.CallOption) (*QueryAuctionPhaseResponse, error)
+	// Retrieves the bid commitments of a sealed-bid auction round
+	BidCommitments(ctx context.Context, in *QueryBidCommitmentsRequest, opts ...grpc.CallOption) (*QueryBidCommitmentsResponse, error)
+	// Retrieves the archived result of a settled auction round
+	AuctionRound(ctx context.Context, in *QueryAuctionRoundRequest, opts ...grpc.CallOption) (*QueryAuctionRoundResponse, error)
+	// Retrieves the archived results of the settled auction rounds
+	AuctionRounds(ctx context.Context, in *QueryAuctionRoundsRequest, opts ...grpc.CallOption) (*QueryAuctionRoundsResponse, error)
 }
 
 type queryClient struct {
@@ -522,6 +969,42 @@ func (c *queryClient) LastAuctionResult(ctx context.Context, in *QueryLastAuctio
 	return out, nil
 }
 
+func (c *queryClient) AuctionPhase(ctx context.Context, in *QueryAuctionPhaseRequest, opts ...grpc.CallOption) (*QueryAuctionPhaseResponse, error) {
+	out := new(QueryAuctionPhaseResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/AuctionPhase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BidCommitments(ctx context.Context, in *QueryBidCommitmentsRequest, opts ...grpc.CallOption) (*QueryBidCommitmentsResponse, error) {
+	out := new(QueryBidCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/BidCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionRound(ctx context.Context, in *QueryAuctionRoundRequest, opts ...grpc.CallOption) (*QueryAuctionRoundResponse, error) {
+	out := new(QueryAuctionRoundResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/AuctionRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionRounds(ctx context.Context, in *QueryAuctionRoundsRequest, opts ...grpc.CallOption) (*QueryAuctionRoundsResponse, error) {
+	out := new(QueryAuctionRoundsResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/AuctionRounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Retrieves auction params
@@ -531,6 +1014,14 @@ type QueryServer interface {
 	// Retrieves the entire auction module's state
 	AuctionModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 	LastAuctionResult(context.Context, *QueryLastAuctionResultRequest) (*QueryLastAuctionResultResponse, error)
+	// Retrieves the mode and phase of the current auction round
+	AuctionPhase(context.Context, *QueryAuctionPhaseRequest) (*QueryAuctionPhaseResponse, error)
+	// Retrieves the bid commitments of a sealed-bid auction round
+	BidCommitments(context.Context, *QueryBidCommitmentsRequest) (*QueryBidCommitmentsResponse, error)
+	// Retrieves the archived result of a settled auction round
+	AuctionRound(context.Context, *QueryAuctionRoundRequest) (*QueryAuctionRoundResponse, error)
+	// Retrieves the archived results of the settled auction rounds
+	AuctionRounds(context.Context, *QueryAuctionRoundsRequest) (*QueryAuctionRoundsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -549,9 +1040,21 @@ func (*UnimplementedQueryServer) AuctionModuleState(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) LastAuctionResult(ctx context.Context, req *QueryLastAuctionResultRequest) (*QueryLastAuctionResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastAuctionResult not implemented")
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (*UnimplementedQueryServer) AuctionPhase(ctx context.Context, req *QueryAuctionPhaseRequest) (*QueryAuctionPhaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionPhase not implemented")
+}
+func (*UnimplementedQueryServer) BidCommitments(ctx context.Context, req *QueryBidCommitmentsRequest) (*QueryBidCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidCommitments not implemented")
+}
+func (*UnimplementedQueryServer) AuctionRound(ctx context.Context, req *QueryAuctionRoundRequest) (*QueryAuctionRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionRound not implemented")
+}
+func (*UnimplementedQueryServer) AuctionRounds(ctx context.Context, req *QueryAuctionRoundsRequest) (*QueryAuctionRoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionRounds not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
 func _Query_AuctionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -626,6 +1129,78 @@ func _Query_LastAuctionResult_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionPhaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/AuctionPhase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionPhase(ctx, req.(*QueryAuctionPhaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BidCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BidCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/BidCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BidCommitments(ctx, req.(*QueryBidCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/AuctionRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionRound(ctx, req.(*QueryAuctionRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionRounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionRounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/AuctionRounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionRounds(ctx, req.(*QueryAuctionRoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.auction.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -646,6 +1221,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastAuctionResult",
 			Handler:    _Query_LastAuctionResult_Handler,
 		},
+		{
+			MethodName: "AuctionPhase",
+			Handler:    _Query_AuctionPhase_Handler,
+		},
+		{
+			MethodName: "BidCommitments",
+			Handler:    _Query_BidCommitments_Handler,
+		},
+		{
+			MethodName: "AuctionRound",
+			Handler:    _Query_AuctionRound_Handler,
+		},
+		{
+			MethodName: "AuctionRounds",
+			Handler:    _Query_AuctionRounds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/auction/v1beta1/query.proto",
@@ -910,124 +1501,1027 @@ func (m *QueryLastAuctionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAuctionPhaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAuctionParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryAuctionPhaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuctionParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAuctionPhaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentAuctionBasketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionPhaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentAuctionBasketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAuctionPhaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionPhaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.AuctionEndingTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionEndingTimestamp))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.AuctionRound != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionRound))
+	if m.PhaseEndingTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PhaseEndingTimestamp))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AuctionClosingTime != 0 {
-		n += 1 + sovQuery(uint64(m.AuctionClosingTime))
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.HighestBidder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Mode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.HighestBidAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Round != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Round != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBidCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBidCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBidCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastAuctionResultRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Round != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastAuctionResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuctionRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LastAuctionResult != nil {
-		l = m.LastAuctionResult.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
+func (m *QueryAuctionRoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAuctionParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuctionParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentAuctionBasketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentAuctionBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AuctionRound != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionRound))
+	}
+	if m.AuctionClosingTime != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionClosingTime))
+	}
+	l = len(m.HighestBidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.HighestBidAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastAuctionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastAuctionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastAuctionResult != nil {
+		l = m.LastAuctionResult.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionPhaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuctionPhaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovQuery(uint64(m.Round))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovQuery(uint64(m.Mode))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.PhaseEndingTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.PhaseEndingTimestamp))
+	}
+	if m.AuctionEndingTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionEndingTimestamp))
+	}
+	return n
+}
+
+func (m *QueryBidCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovQuery(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *QueryBidCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuctionRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovQuery(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *QueryAuctionRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionRoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryAuctionParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRound", wireType)
+			}
+			m.AuctionRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionClosingTime", wireType)
+			}
+			m.AuctionClosingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionClosingTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighestBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &GenesisState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1050,10 +2544,10 @@ func (m *QueryAuctionParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastAuctionResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastAuctionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1077,7 +2571,7 @@ func (m *QueryAuctionParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1100,15 +2594,15 @@ func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastAuctionResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastAuctionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAuctionResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1120,25 +2614,223 @@ func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAuctionResult == nil {
+				m.LastAuctionResult = &LastAuctionResult{}
+			}
+			if err := m.LastAuctionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionPhaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionPhaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionPhaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionPhaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionPhaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionPhaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AuctionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= AuctionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseEndingTimestamp", wireType)
+			}
+			m.PhaseEndingTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhaseEndingTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionEndingTimestamp", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AuctionEndingTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionEndingTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1160,7 +2852,7 @@ func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBidCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1183,12 +2875,31 @@ func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1210,7 +2921,7 @@ func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBidCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,15 +2944,15 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBidCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBidCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1268,112 +2979,8 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRound", wireType)
-			}
-			m.AuctionRound = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionRound |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionClosingTime", wireType)
-			}
-			m.AuctionClosingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionClosingTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HighestBidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HighestBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, BidCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1398,7 +3005,7 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRoundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,12 +3028,31 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRoundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1448,7 +3074,7 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,15 +3097,15 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,10 +3132,7 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &GenesisState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1534,7 +3157,7 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRoundsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,12 +3180,48 @@ func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastAuctionResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRoundsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastAuctionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1584,7 +3243,7 @@ func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRoundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,15 +3266,15 @@ func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastAuctionResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRoundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastAuctionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAuctionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1642,10 +3301,44 @@ func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastAuctionResult == nil {
-				m.LastAuctionResult = &LastAuctionResult{}
+			m.Records = append(m.Records, AuctionRoundRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.LastAuctionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
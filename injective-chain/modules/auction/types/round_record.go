@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (r *AuctionRoundRecord) Validate() error {
+	if err := r.Basket.Validate(); err != nil {
+		return fmt.Errorf("auction round record %d: invalid basket: %w", r.Round, err)
+	}
+
+	if r.Winner != "" {
+		if _, err := sdk.AccAddressFromBech32(r.Winner); err != nil {
+			return fmt.Errorf("auction round record %d: invalid winner address %s", r.Round, r.Winner)
+		}
+	}
+
+	if !r.WinningBid.IsValid() {
+		return fmt.Errorf("auction round record %d: invalid winning bid %s", r.Round, r.WinningBid.String())
+	}
+
+	if !r.Burned.IsValid() {
+		return fmt.Errorf("auction round record %d: invalid burned amount %s", r.Round, r.Burned.String())
+	}
+
+	return nil
+}
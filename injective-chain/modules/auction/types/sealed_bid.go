@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+const (
+	// BidCommitmentLength is the length of a sha256 bid commitment
+	BidCommitmentLength = sha256.Size
+	// MinBidSaltLength is the minimum length of the salt of a sealed bid, which prevents brute forcing the bid amount
+	MinBidSaltLength = 16
+)
+
+// ComputeBidCommitment returns the commitment of a sealed bid, sha256(bidder || round || bidAmount || salt), where
+// the round is big endian encoded and the bid amount is the coin string, e.g. 1000inj.
+func ComputeBidCommitment(bidder sdk.AccAddress, round uint64, bidAmount sdk.Coin, salt []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write(bidder.Bytes())
+	hasher.Write(sdk.Uint64ToBigEndian(round))
+	hasher.Write([]byte(bidAmount.String()))
+	hasher.Write(salt)
+	return hasher.Sum(nil)
+}
+
+func NewBidCommitment(bidder sdk.AccAddress, round uint64, commitment []byte, deposit sdk.Coin, height int64) *BidCommitment {
+	return &BidCommitment{
+		Bidder:         bidder.String(),
+		Round:          round,
+		Commitment:     commitment,
+		Deposit:        deposit,
+		CommitHeight:   height,
+		RevealedAmount: sdk.NewCoin(deposit.Denom, math.ZeroInt()),
+	}
+}
+
+// Matches returns whether the bid amount and salt were used to compute the commitment
+func (c *BidCommitment) Matches(bidAmount sdk.Coin, salt []byte) bool {
+	bidder := sdk.MustAccAddressFromBech32(c.Bidder)
+	return bytes.Equal(c.Commitment, ComputeBidCommitment(bidder, c.Round, bidAmount, salt))
+}
+
+// Reveal records the revealed bid amount of the commitment
+func (c *BidCommitment) Reveal(bidAmount sdk.Coin, height int64) {
+	c.Revealed = true
+	c.RevealedAmount = bidAmount
+	c.RevealHeight = height
+}
+
+// IsBetterThan returns whether the revealed bid beats the other one, ties being won by the earliest commitment
+func (c *BidCommitment) IsBetterThan(other *BidCommitment) bool {
+	if !c.RevealedAmount.Amount.Equal(other.RevealedAmount.Amount) {
+		return c.RevealedAmount.Amount.GT(other.RevealedAmount.Amount)
+	}
+
+	return c.CommitHeight < other.CommitHeight
+}
+
+func (c *BidCommitment) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Bidder); err != nil {
+		return errors.Wrapf(ErrInvalidBidCommitment, "invalid bidder address %s", c.Bidder)
+	}
+
+	if len(c.Commitment) != BidCommitmentLength {
+		return errors.Wrapf(ErrInvalidBidCommitment, "commitment must be %d bytes", BidCommitmentLength)
+	}
+
+	if !c.Deposit.IsValid() || !c.Deposit.IsPositive() {
+		return errors.Wrapf(ErrInvalidBidCommitment, "invalid deposit %s", c.Deposit.String())
+	}
+
+	if c.Revealed {
+		if !c.RevealedAmount.IsValid() || c.RevealedAmount.Denom != c.Deposit.Denom {
+			return errors.Wrapf(ErrInvalidBidCommitment, "invalid revealed amount %s", c.RevealedAmount.String())
+		}
+
+		if c.RevealedAmount.Amount.GT(c.Deposit.Amount) {
+			return errors.Wrapf(ErrInvalidBidCommitment, "revealed amount %s exceeds deposit %s", c.RevealedAmount.String(), c.Deposit.String())
+		}
+	}
+
+	return nil
+}
+
+func (r *ClaimableBidRefund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Bidder); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address %s", r.Bidder)
+	}
+
+	if !r.Amount.IsValid() || !r.Amount.IsPositive() || r.Amount.Denom != chaintypes.InjectiveCoin {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid refund amount %s", r.Amount.String())
+	}
+
+	return nil
+}
@@ -16,6 +16,9 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairs(),
 		GetTokenPairByDenom(),
 		GetTokenPairByERC20(),
+		GetWrapperAddress(),
+		GetDeprecatedTokenPairs(),
+		GetDeprecatedTokenPairHolders(),
 	)
 
 	return cmd
@@ -52,3 +55,27 @@ func GetTokenPairByERC20() *cobra.Command {
 		&types.QueryTokenPairByERC20AddressRequest{}, nil, nil,
 	)
 }
+
+func GetWrapperAddress() *cobra.Command {
+	return cli.QueryCmd("wrapper-address <denom>",
+		"Returns the deterministic ERC20 wrapper address of denom",
+		types.NewQueryClient,
+		&types.QueryWrapperAddressRequest{}, nil, nil,
+	)
+}
+
+func GetDeprecatedTokenPairs() *cobra.Command {
+	return cli.QueryCmd("deprecated-token-pairs",
+		"Returns the deprecated token pairs whose conversion window is still open",
+		types.NewQueryClient,
+		&types.QueryDeprecatedTokenPairsRequest{}, nil, nil,
+	)
+}
+
+func GetDeprecatedTokenPairHolders() *cobra.Command {
+	return cli.QueryCmd("deprecated-token-pair-holders <erc20 address>",
+		"Returns the contract accounts still holding the denom of a deprecated erc20 address",
+		types.NewQueryClient,
+		&types.QueryDeprecatedTokenPairHoldersRequest{}, nil, nil,
+	)
+}
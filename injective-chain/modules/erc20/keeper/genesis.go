@@ -14,6 +14,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, pair := range genState.GetTokenPairs() {
 		k.storeTokenPair(ctx, pair)
 	}
+
+	for _, denom := range genState.GetPendingWrapperDenoms() {
+		k.setWrapperPending(ctx, denom)
+	}
+
+	for _, pair := range genState.GetDeprecatedTokenPairs() {
+		k.setDeprecatedTokenPair(ctx, &pair)
+	}
+
+	for _, denom := range genState.GetRetiredDenoms() {
+		k.setDenomRetired(ctx, denom)
+	}
+
+	// the wrapper address index isn't exported, it's rebuilt from the bank supply
+	k.indexWrapperDenoms(ctx)
 }
 
 // ExportGenesis returns the permissions module's exported genesis.
@@ -24,12 +39,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	gs := &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:               k.GetParams(ctx),
+		PendingWrapperDenoms: k.GetAllPendingWrapperDenoms(ctx),
+		RetiredDenoms:        k.GetAllRetiredDenoms(ctx),
 	}
 
 	for _, pair := range pairs {
 		gs.TokenPairs = append(gs.TokenPairs, *pair)
 	}
 
+	deprecatedPairs, err := k.GetAllDeprecatedTokenPairs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pair := range deprecatedPairs {
+		gs.DeprecatedTokenPairs = append(gs.DeprecatedTokenPairs, *pair)
+	}
+
 	return gs
 }
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
@@ -13,6 +14,9 @@ import (
 
 var _ types.QueryServer = queryServer{}
 
+// maxScannedDenomOwners is the maximum number of owners of the bank denom scanned by a DeprecatedTokenPairHolders query
+const maxScannedDenomOwners = uint64(1000)
+
 type queryServer struct {
 	Keeper
 }
@@ -75,3 +79,142 @@ func (q queryServer) TokenPairByERC20Address(c context.Context, req *types.Query
 
 	return &types.QueryTokenPairByERC20AddressResponse{TokenPair: pair}, nil
 }
+
+func (q queryServer) WrapperAddress(c context.Context, req *types.QueryWrapperAddressRequest) (*types.QueryWrapperAddressResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidQueryRequest, "no request provided")
+	}
+
+	if !types.IsWrappableDenom(req.BankDenom) {
+		return nil, errors.Wrapf(types.ErrInvalidQueryRequest, "bank denom %s is not supported", req.BankDenom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, err := q.GetTokenPairForDenom(ctx, req.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWrapperAddressResponse{
+		WrapperAddress: types.WrapperAddress(req.BankDenom).String(),
+		TokenPair:      pair,
+		IsPending:      q.IsWrapperPending(ctx, req.BankDenom),
+	}, nil
+}
+
+func (q queryServer) DeprecatedTokenPairs(c context.Context, _ *types.QueryDeprecatedTokenPairsRequest) (*types.QueryDeprecatedTokenPairsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pairs, err := q.GetAllDeprecatedTokenPairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryDeprecatedTokenPairsResponse{
+		DeprecatedTokenPairs: make([]types.DeprecatedTokenPair, 0, len(pairs)),
+	}
+	for _, pair := range pairs {
+		res.DeprecatedTokenPairs = append(res.DeprecatedTokenPairs, *pair)
+	}
+
+	return res, nil
+}
+
+func (q queryServer) DeprecatedTokenPairHolders(
+	c context.Context,
+	req *types.QueryDeprecatedTokenPairHoldersRequest,
+) (*types.QueryDeprecatedTokenPairHoldersResponse, error) {
+	if req == nil || !common.IsHexAddress(req.Erc20Address) {
+		return nil, errors.Wrap(types.ErrInvalidQueryRequest, "invalid ERC20 address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, err := q.GetDeprecatedTokenPair(ctx, common.HexToAddress(req.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+	if pair == nil {
+		return nil, errors.Wrapf(types.ErrInvalidQueryRequest, "ERC20 token %s is not deprecated", req.Erc20Address)
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.CountTotal || pageReq.Reverse {
+		return nil, errors.Wrap(types.ErrInvalidQueryRequest, "only key based pagination is supported")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the holders are filtered while paginating over the owners of the bank denom: owners are fetched in pages no
+	// larger than the number of holders still missing, so that the next key always resumes right after the last owner
+	// scanned. The scan stops early after maxScannedDenomOwners owners, in which case the page is shorter than its
+	// limit but still has a next key.
+	holders := make([]types.DeprecatedTokenPairHolder, 0)
+	nextKey := pageReq.Key
+	scanned := uint64(0)
+
+	for uint64(len(holders)) < limit && scanned < maxScannedDenomOwners {
+		owners, err := q.bankKeeper.DenomOwners(c, &banktypes.QueryDenomOwnersRequest{
+			Denom: pair.BankDenom,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: min(limit-uint64(len(holders)), maxScannedDenomOwners-scanned),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, owner := range owners.DenomOwners {
+			holder, err := q.getContractHolder(ctx, owner)
+			if err != nil {
+				return nil, err
+			}
+			if holder != nil {
+				holders = append(holders, *holder)
+			}
+		}
+		scanned += uint64(len(owners.DenomOwners))
+
+		nextKey = nil
+		if owners.Pagination != nil {
+			nextKey = owners.Pagination.NextKey
+		}
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+
+	return &types.QueryDeprecatedTokenPairHoldersResponse{
+		Holders:    holders,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// getContractHolder returns the holder of the denom if it's a contract account, or nil otherwise. Externally owned
+// accounts can move their balance with the bank or the new ERC20 contract, only contracts may depend on the deprecated
+// one.
+func (q queryServer) getContractHolder(ctx sdk.Context, owner *banktypes.DenomOwner) (*types.DeprecatedTokenPairHolder, error) {
+	addr, err := sdk.AccAddressFromBech32(owner.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	evmAddress := common.BytesToAddress(addr.Bytes())
+	if !q.hasContract(ctx, evmAddress) {
+		return nil, nil
+	}
+
+	return &types.DeprecatedTokenPairHolder{
+		Address:    owner.Address,
+		EvmAddress: evmAddress.Hex(),
+		Balance:    owner.Balance,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
+)
+
+// Wrapper struct
+type Hooks struct {
+	k Keeper
+}
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Create new hook receivers
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// PostTxProcessing queues the ERC20 wrapper creation of the bank denom when an EVM transaction calls its deterministic
+// wrapper address before the wrapper exists, so that wrappers also materialize on their first EVM access
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg *core.Message, _ *ethtypes.Receipt) error {
+	if msg.To == nil || h.k.hasContract(ctx, *msg.To) {
+		return nil
+	}
+
+	if bankDenom := h.k.GetWrapperDenom(ctx, *msg.To); bankDenom != "" {
+		h.k.queueWrapper(ctx, bankDenom)
+	}
+
+	return nil
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/bindings/cosmos/precompile/bank"
-
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -84,9 +82,10 @@ func (k Keeper) createTokenPairTokenFactory(c context.Context, sender sdk.AccAdd
 	if sender.String() != metadata.Admin && sender.String() != k.authority {
 		return errors.Wrap(types.ErrUnauthorized, "only token factory denom admin can create erc20 pair for it")
 	}
-	// deploy ERC20 contract if one was not provided in the msg
+	// deploy ERC20 contract at the deterministic wrapper address if one was not provided in the msg
 	if pair.Erc20Address == "" {
-		contractAddr, err := k.DeploySmartContract(c, bank.MintBurnBankERC20MetaData, sender, common.BytesToAddress(sender.Bytes()), "", "", uint8(0))
+		owner := common.BytesToAddress(sender.Bytes())
+		contractAddr, err := k.deployWrapper(ctx, pair.BankDenom, &owner)
 		if err != nil {
 			return errors.Wrap(types.ErrUploadERC20Contract, err.Error())
 		}
@@ -100,7 +99,7 @@ func (k Keeper) createTokenPairTokenFactory(c context.Context, sender sdk.AccAdd
 
 // createTokenPairPeggy is a permissionless call to create token pair for peggy denoms.
 // Only support deploying owner-less ERC-20 implementation.
-func (k Keeper) createTokenPairPeggy(c context.Context, sender sdk.AccAddress, pair *types.TokenPair) error {
+func (k Keeper) createTokenPairPeggy(c context.Context, _ sdk.AccAddress, pair *types.TokenPair) error {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// we do not allow custom ERC-20 implementations due to this msg being permissionless
@@ -108,8 +107,8 @@ func (k Keeper) createTokenPairPeggy(c context.Context, sender sdk.AccAddress, p
 		return errors.Wrap(types.ErrInvalidERC20Address, "peggy denom does not support custom ERC-20 smart contracts")
 	}
 
-	// deploy ERC20 contract
-	contractAddr, err := k.DeploySmartContract(c, bank.FixedSupplyBankERC20MetaData, sender, "", "", uint8(0), big.NewInt(0))
+	// deploy ERC20 contract at the deterministic wrapper address
+	contractAddr, err := k.deployWrapper(ctx, pair.BankDenom, nil)
 	if err != nil {
 		return errors.Wrap(types.ErrUploadERC20Contract, err.Error())
 	}
@@ -122,7 +121,7 @@ func (k Keeper) createTokenPairPeggy(c context.Context, sender sdk.AccAddress, p
 
 // createTokenPairIBC is a permissionless call to create token pair for IBC denoms.
 // Only support deploying owner-less ERC-20 implementation.
-func (k Keeper) createTokenPairIBC(c context.Context, sender sdk.AccAddress, pair *types.TokenPair) error {
+func (k Keeper) createTokenPairIBC(c context.Context, _ sdk.AccAddress, pair *types.TokenPair) error {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// we do not allow custom ERC-20 implementations due to this msg being permissionless
@@ -130,8 +129,8 @@ func (k Keeper) createTokenPairIBC(c context.Context, sender sdk.AccAddress, pai
 		return errors.Wrap(types.ErrInvalidERC20Address, "IBC denom does not support custom ERC-20 smart contracts")
 	}
 
-	// deploy ERC20 contract
-	contractAddr, err := k.DeploySmartContract(c, bank.FixedSupplyBankERC20MetaData, sender, "", "", uint8(0), big.NewInt(0))
+	// deploy ERC20 contract at the deterministic wrapper address
+	contractAddr, err := k.deployWrapper(ctx, pair.BankDenom, nil)
 	if err != nil {
 		return errors.Wrap(types.ErrUploadERC20Contract, err.Error())
 	}
@@ -143,23 +142,38 @@ func (k Keeper) createTokenPairIBC(c context.Context, sender sdk.AccAddress, pai
 }
 
 func (k Keeper) DeploySmartContract(c context.Context, metadata *bind.MetaData, from sdk.AccAddress, args ...any) (common.Address, error) {
-	abi, err := metadata.GetAbi()
+	data, err := deploymentData(metadata, args...)
 	if err != nil {
 		return common.Address{}, err
 	}
 
-	ctorArgs, err := abi.Pack("", args...)
+	nonce, err := k.accountKeeper.GetSequence(c, from)
 	if err != nil {
 		return common.Address{}, err
 	}
-	data := common.FromHex(metadata.Bin)
-	data = append(data, ctorArgs...)
 
-	nonce, err := k.accountKeeper.GetSequence(c, from)
+	return k.deploySmartContract(c, data, common.BytesToAddress(from.Bytes()), nonce)
+}
+
+// deploymentData returns the contract bytecode followed by the ABI encoded constructor arguments
+func deploymentData(metadata *bind.MetaData, args ...any) ([]byte, error) {
+	abi, err := metadata.GetAbi()
 	if err != nil {
-		return common.Address{}, err
+		return nil, err
+	}
+
+	ctorArgs, err := abi.Pack("", args...)
+	if err != nil {
+		return nil, err
 	}
 
+	data := common.FromHex(metadata.Bin)
+	return append(data, ctorArgs...), nil
+}
+
+// deploySmartContract creates a contract with the deployment data from the address, using the nonce given rather than
+// the current nonce of the address. The contract is created at the address derived from the sender and that nonce.
+func (k Keeper) deploySmartContract(c context.Context, data []byte, from common.Address, nonce uint64) (common.Address, error) {
 	msg := evmtypes.NewTx(
 		nil,           // chain id
 		nonce,         // nonce
@@ -182,7 +196,7 @@ func (k Keeper) DeploySmartContract(c context.Context, metadata *bind.MetaData,
 		return common.Address{}, errors.Wrap(types.ErrUploadERC20Contract, response.VmError)
 	}
 
-	contractAddr := crypto.CreateAddress(common.Address(from.Bytes()), nonce)
+	contractAddr := crypto.CreateAddress(from, nonce)
 
 	return contractAddr, nil
 }
@@ -10,6 +10,11 @@ var (
 	paramsKey        = []byte{0x01}
 	erc20ByBankDenom = []byte{0x02} // bank_denom => erc20_address
 	bankDenomByERC20 = []byte{0x03} // erc20_address => bank_denom
+
+	pendingWrapperDenoms = []byte{0x04} // bank_denom => nil
+	deprecatedTokenPairs = []byte{0x05} // erc20_address => deprecated_token_pair
+	retiredDenoms        = []byte{0x06} // bank_denom => nil
+	wrapperDenoms        = []byte{0x07} // wrapper_address => bank_denom
 )
 
 // getTokenPairsStoreByBankDenom returns the store prefix for denom => token map
@@ -23,3 +28,27 @@ func (k Keeper) getTokenPairsStoreByERC20(ctx sdk.Context) storetypes.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, bankDenomByERC20)
 }
+
+// getPendingWrappersStore returns the store prefix for the bank denoms queued for ERC20 wrapper creation
+func (k Keeper) getPendingWrappersStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, pendingWrapperDenoms)
+}
+
+// getDeprecatedTokenPairsStore returns the store prefix for erc20 => deprecated token pair map
+func (k Keeper) getDeprecatedTokenPairsStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, deprecatedTokenPairs)
+}
+
+// getRetiredDenomsStore returns the store prefix for the bank denoms whose token pair is retired
+func (k Keeper) getRetiredDenomsStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, retiredDenoms)
+}
+
+// getWrapperDenomsStore returns the store prefix for the wrapper address => bank denom map of the lazily wrappable denoms
+func (k Keeper) getWrapperDenomsStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, wrapperDenoms)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
+)
+
+// GetDeprecatedTokenPair returns the deprecated token pair of the ERC20 contract, or nil if the contract isn't
+// deprecated or its conversion window has ended
+func (k Keeper) GetDeprecatedTokenPair(ctx sdk.Context, erc20Address common.Address) (*types.DeprecatedTokenPair, error) {
+	store := k.getDeprecatedTokenPairsStore(ctx)
+	bz := store.Get(erc20Address.Bytes())
+	if bz == nil {
+		return nil, nil
+	}
+
+	pair := &types.DeprecatedTokenPair{}
+	if err := proto.Unmarshal(bz, pair); err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+func (k Keeper) setDeprecatedTokenPair(ctx sdk.Context, pair *types.DeprecatedTokenPair) {
+	store := k.getDeprecatedTokenPairsStore(ctx)
+	bz, _ := proto.Marshal(pair)
+	store.Set(common.HexToAddress(pair.Erc20Address).Bytes(), bz)
+}
+
+func (k Keeper) deleteDeprecatedTokenPair(ctx sdk.Context, erc20Address common.Address) {
+	store := k.getDeprecatedTokenPairsStore(ctx)
+	store.Delete(erc20Address.Bytes())
+}
+
+// GetAllDeprecatedTokenPairs returns the deprecated token pairs whose conversion window is still open
+func (k Keeper) GetAllDeprecatedTokenPairs(ctx sdk.Context) ([]*types.DeprecatedTokenPair, error) {
+	pairs := make([]*types.DeprecatedTokenPair, 0)
+	store := k.getDeprecatedTokenPairsStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pair := &types.DeprecatedTokenPair{}
+		if err := proto.Unmarshal(iter.Value(), pair); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, nil
+}
+
+// IsDenomRetired returns true if the token pair of the bank denom was retired
+func (k Keeper) IsDenomRetired(ctx sdk.Context, bankDenom string) bool {
+	return k.getRetiredDenomsStore(ctx).Has([]byte(bankDenom))
+}
+
+func (k Keeper) setDenomRetired(ctx sdk.Context, bankDenom string) {
+	k.getRetiredDenomsStore(ctx).Set([]byte(bankDenom), []byte{})
+}
+
+func (k Keeper) deleteRetiredDenom(ctx sdk.Context, bankDenom string) {
+	k.getRetiredDenomsStore(ctx).Delete([]byte(bankDenom))
+}
+
+// GetAllRetiredDenoms returns all the bank denoms whose token pair was retired
+func (k Keeper) GetAllRetiredDenoms(ctx sdk.Context) []string {
+	denoms := make([]string, 0)
+	store := k.getRetiredDenomsStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()))
+	}
+	return denoms
+}
+
+// migrateTokenPair re-points the bank denom of the pair to the new ERC20 contract, or retires the pair if the new
+// address is empty. The former contract is deprecated: it keeps operating on the balances of the bank denom until the
+// end of the conversion window, after which it's detached from the denom.
+func (k Keeper) migrateTokenPair(ctx sdk.Context, pair types.TokenPair, newErc20Address string, conversionWindow int64) {
+	k.deleteTokenPair(ctx, pair)
+
+	if newErc20Address != "" {
+		k.storeTokenPair(ctx, types.TokenPair{
+			BankDenom:    pair.BankDenom,
+			Erc20Address: newErc20Address,
+		})
+	} else {
+		k.setDenomRetired(ctx, pair.BankDenom)
+	}
+
+	deprecatedPair := &types.DeprecatedTokenPair{
+		BankDenom:               pair.BankDenom,
+		Erc20Address:            pair.Erc20Address,
+		ReplacementErc20Address: newErc20Address,
+		ConversionEndTime:       ctx.BlockTime().Unix() + conversionWindow,
+	}
+	k.setDeprecatedTokenPair(ctx, deprecatedPair)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventMigrateTokenPair{
+		BankDenom:         pair.BankDenom,
+		OldErc20Address:   pair.Erc20Address,
+		NewErc20Address:   newErc20Address,
+		ConversionEndTime: deprecatedPair.ConversionEndTime,
+	})
+}
+
+// ProcessConversionWindows detaches the deprecated ERC20 contracts whose conversion window has ended from their bank
+// denom. From then on, they operate on their own erc20:... denom like any contract without a token pair.
+func (k Keeper) ProcessConversionWindows(ctx sdk.Context) {
+	pairs, err := k.GetAllDeprecatedTokenPairs(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get deprecated token pairs", "error", err)
+		return
+	}
+
+	for _, pair := range pairs {
+		if pair.ConversionEndTime > ctx.BlockTime().Unix() {
+			continue
+		}
+
+		k.deleteDeprecatedTokenPair(ctx, common.HexToAddress(pair.Erc20Address))
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventConversionWindowEnded{
+			BankDenom:    pair.BankDenom,
+			Erc20Address: pair.Erc20Address,
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 indexes the existing lazily wrappable bank denoms without a token pair by their wrapper address, so that
+// their wrappers are queued on their first EVM access
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.indexWrapperDenoms(ctx)
+	return nil
+}
@@ -54,6 +54,11 @@ func (k msgServer) CreateTokenPair(c context.Context, msg *types.MsgCreateTokenP
 	bankDenom := msg.TokenPair.BankDenom
 	erc20Address := common.HexToAddress(msg.TokenPair.Erc20Address)
 
+	// only governance can create a new pair for a denom whose pair was retired
+	if k.IsDenomRetired(ctx, bankDenom) && msg.Sender != k.authority {
+		return nil, errors.Wrapf(types.ErrTokenPairRetired, "token pair for denom %s was retired", bankDenom)
+	}
+
 	// validate that the pair doesn't already exist
 	if pair, _ := k.GetTokenPairForDenom(ctx, bankDenom); pair != nil {
 		return nil, errors.Wrapf(types.ErrTokenPairExists, "token pair for denom %s already exists", bankDenom)
@@ -79,6 +84,7 @@ func (k msgServer) CreateTokenPair(c context.Context, msg *types.MsgCreateTokenP
 	if err := k.createTokenPair(ctx, sdk.MustAccAddressFromBech32(msg.Sender), &pair); err != nil {
 		return nil, err
 	}
+	k.deleteRetiredDenom(ctx, bankDenom)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCreateTokenPair{
@@ -91,9 +97,15 @@ func (k msgServer) CreateTokenPair(c context.Context, msg *types.MsgCreateTokenP
 	}, nil
 }
 
+// hasContract returns true if there is a contract deployed at the address
+func (k Keeper) hasContract(ctx sdk.Context, addr common.Address) bool {
+	acc := k.evmKeeper.GetAccount(ctx, addr)
+	return acc != nil && !bytes.Equal(acc.CodeHash, evmtypes.EmptyCodeHash)
+}
+
 func (k Keeper) validateErc20Address(ctx sdk.Context, erc20Address common.Address) error {
 	// does account exist?
-	if acc := k.evmKeeper.GetAccount(ctx, erc20Address); acc == nil || bytes.Equal(acc.CodeHash, evmtypes.EmptyCodeHash) {
+	if !k.hasContract(ctx, erc20Address) {
 		return errors.Wrap(types.ErrInvalidTokenPair, "ERC20 contract address is not correct or doesn't exist")
 	}
 	// check that the SC does not have associated "erc20:..." token circualating already
@@ -139,3 +151,53 @@ func (k msgServer) DeleteTokenPair(c context.Context, msg *types.MsgDeleteTokenP
 
 	return &types.MsgDeleteTokenPairResponse{}, nil
 }
+
+func (k msgServer) BackfillTokenPairs(c context.Context, msg *types.MsgBackfillTokenPairs) (*types.MsgBackfillTokenPairsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queued := uint64(0)
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if k.queueWrapper(ctx, coin.Denom) {
+			queued++
+		}
+		return false
+	})
+
+	return &types.MsgBackfillTokenPairsResponse{QueuedDenoms: queued}, nil
+}
+
+func (k msgServer) MigrateTokenPair(c context.Context, msg *types.MsgMigrateTokenPair) (*types.MsgMigrateTokenPairResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pair, _ := k.GetTokenPairForDenom(ctx, msg.BankDenom)
+	if pair == nil {
+		return nil, errors.Wrapf(types.ErrUnknownBankDenom, "token pair for denom %s does not exist", msg.BankDenom)
+	}
+
+	newErc20Address := ""
+	if msg.NewErc20Address != "" {
+		erc20Address := common.HexToAddress(msg.NewErc20Address)
+
+		if existingPair, _ := k.GetTokenPairForERC20(ctx, erc20Address); existingPair != nil {
+			return nil, errors.Wrapf(types.ErrTokenPairExists, "token pair for ERC20 token %s already exists", erc20Address)
+		}
+
+		if err := k.validateErc20Address(ctx, erc20Address); err != nil {
+			return nil, err
+		}
+
+		newErc20Address = erc20Address.String()
+	}
+
+	k.migrateTokenPair(ctx, *pair, newErc20Address, msg.ConversionWindow)
+
+	return &types.MsgMigrateTokenPairResponse{}, nil
+}
@@ -23,12 +23,21 @@ func (k Keeper) GetTokenPairForDenom(ctx sdk.Context, bankDenom string) (*types.
 	return pair, nil
 }
 
-// GetTokenPairForERC20 return token pair associated with the erc20 token address.
+// GetTokenPairForERC20 return token pair associated with the erc20 token address. A deprecated erc20 token is still
+// associated with its bank denom until the end of its conversion window.
 func (k Keeper) GetTokenPairForERC20(ctx sdk.Context, erc20Address common.Address) (*types.TokenPair, error) {
 	store := k.getTokenPairsStoreByERC20(ctx)
 	bz := store.Get(erc20Address.Bytes())
 	if bz == nil {
-		return nil, nil
+		deprecatedPair, err := k.GetDeprecatedTokenPair(ctx, erc20Address)
+		if deprecatedPair == nil || err != nil {
+			return nil, err
+		}
+
+		return &types.TokenPair{
+			BankDenom:    deprecatedPair.BankDenom,
+			Erc20Address: erc20Address.String(),
+		}, nil
 	}
 
 	pair := &types.TokenPair{
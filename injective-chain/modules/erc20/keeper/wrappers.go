@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/bindings/cosmos/precompile/bank"
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
+)
+
+// deployWrapper deploys the ERC20 wrapper of the bank denom at its deterministic address. The wrapper is a
+// MintBurnBankERC20 owned by the owner if one is given, or an owner-less FixedSupplyBankERC20 otherwise, and its
+// name, symbol and decimals are taken from the bank metadata of the denom. If a wrapper of the denom was already
+// deployed there (e.g. its token pair was deleted afterwards), the existing contract is reused as long as it has the
+// code and owner the new wrapper would have.
+func (k Keeper) deployWrapper(ctx sdk.Context, bankDenom string, owner *common.Address) (common.Address, error) {
+	bankMetadata, _ := k.bankKeeper.GetDenomMetaData(ctx, bankDenom)
+	name, symbol, decimals := bankMetadata.Name, bankMetadata.Symbol, uint8(bankMetadata.Decimals)
+
+	var (
+		data []byte
+		err  error
+	)
+	if owner != nil {
+		data, err = deploymentData(bank.MintBurnBankERC20MetaData, *owner, name, symbol, decimals)
+	} else {
+		data, err = deploymentData(bank.FixedSupplyBankERC20MetaData, name, symbol, decimals, big.NewInt(0))
+	}
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	wrapperAddr := types.WrapperAddress(bankDenom)
+	if !k.hasContract(ctx, wrapperAddr) {
+		return k.deploySmartContract(ctx, data, types.WrapperDeployerAddress(bankDenom), 0)
+	}
+
+	if err := k.validateExistingWrapper(ctx, wrapperAddr, data, owner); err != nil {
+		return common.Address{}, err
+	}
+
+	return wrapperAddr, nil
+}
+
+// validateExistingWrapper checks that the contract at the wrapper address has the code deployed by the deployment data,
+// and the owner given, if any
+func (k Keeper) validateExistingWrapper(ctx sdk.Context, wrapperAddr common.Address, data []byte, owner *common.Address) error {
+	// the runtime code doesn't depend on the deployer, so it's obtained by deploying the same contract from a probe
+	// address in a discarded cache context
+	cacheCtx, _ := ctx.CacheContext()
+	probeAddr, err := k.deploySmartContract(cacheCtx, data, types.WrapperDeployerAddress(""), 0)
+	if err != nil {
+		return errors.Wrap(types.ErrUnexpectedWrapper, err.Error())
+	}
+
+	wrapper, probe := k.evmKeeper.GetAccount(ctx, wrapperAddr), k.evmKeeper.GetAccount(cacheCtx, probeAddr)
+	if probe == nil || !bytes.Equal(wrapper.CodeHash, probe.CodeHash) {
+		return errors.Wrapf(types.ErrUnexpectedWrapper, "contract at %s has an unexpected code", wrapperAddr.Hex())
+	}
+
+	if owner == nil {
+		return nil
+	}
+
+	wrapperOwner, err := k.getContractOwner(ctx, wrapperAddr)
+	if err != nil {
+		return errors.Wrap(types.ErrUnexpectedWrapper, err.Error())
+	}
+
+	if wrapperOwner != *owner {
+		return errors.Wrapf(types.ErrUnexpectedWrapper, "contract at %s is owned by %s", wrapperAddr.Hex(), wrapperOwner.Hex())
+	}
+
+	return nil
+}
+
+// getContractOwner returns the owner of an Ownable contract
+func (k Keeper) getContractOwner(ctx sdk.Context, contractAddr common.Address) (common.Address, error) {
+	contractABI, err := bank.MintBurnBankERC20MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	input, err := contractABI.Pack("owner")
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	args, _ := json.Marshal(evmtypes.TransactionArgs{To: &contractAddr, Input: (*hexutil.Bytes)(&input)})
+	resp, err := k.evmKeeper.EthCall(ctx, &evmtypes.EthCallRequest{
+		Args:   args,
+		GasCap: uint64(300_000),
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+	if resp.VmError != "" {
+		return common.Address{}, errors.Wrap(types.ErrInvalidERC20Address, resp.VmError)
+	}
+
+	out, err := contractABI.Unpack("owner", resp.Ret)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	ownerAddr, ok := out[0].(common.Address)
+	if !ok {
+		return common.Address{}, errors.Wrap(types.ErrInvalidERC20Address, "owner is not an address")
+	}
+
+	return ownerAddr, nil
+}
+
+// createWrapperTokenPair creates the token pair of the bank denom with an owner-less ERC20 wrapper deployed at its
+// deterministic address
+func (k Keeper) createWrapperTokenPair(ctx sdk.Context, bankDenom string) (*types.TokenPair, error) {
+	contractAddr, err := k.deployWrapper(ctx, bankDenom, nil)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrUploadERC20Contract, err.Error())
+	}
+
+	pair := types.TokenPair{
+		BankDenom:    bankDenom,
+		Erc20Address: contractAddr.String(),
+	}
+	k.storeTokenPair(ctx, pair)
+	k.getWrapperDenomsStore(ctx).Delete(contractAddr.Bytes())
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCreateTokenPair{
+		BankDenom:    pair.BankDenom,
+		Erc20Address: pair.Erc20Address,
+	})
+
+	return &pair, nil
+}
+
+// IsWrapperPending returns true if the bank denom is queued for ERC20 wrapper creation
+func (k Keeper) IsWrapperPending(ctx sdk.Context, bankDenom string) bool {
+	return k.getPendingWrappersStore(ctx).Has([]byte(bankDenom))
+}
+
+func (k Keeper) setWrapperPending(ctx sdk.Context, bankDenom string) {
+	k.getPendingWrappersStore(ctx).Set([]byte(bankDenom), []byte{})
+}
+
+func (k Keeper) deletePendingWrapper(ctx sdk.Context, bankDenom string) {
+	k.getPendingWrappersStore(ctx).Delete([]byte(bankDenom))
+}
+
+// GetAllPendingWrapperDenoms returns all the bank denoms queued for ERC20 wrapper creation
+func (k Keeper) GetAllPendingWrapperDenoms(ctx sdk.Context) []string {
+	denoms := make([]string, 0)
+	store := k.getPendingWrappersStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()))
+	}
+	return denoms
+}
+
+// GetWrapperDenom returns the lazily wrappable bank denom whose deterministic wrapper address is the given address, or an
+// empty string if it's unknown
+func (k Keeper) GetWrapperDenom(ctx sdk.Context, wrapperAddr common.Address) string {
+	return string(k.getWrapperDenomsStore(ctx).Get(wrapperAddr.Bytes()))
+}
+
+// indexWrapperDenoms indexes the lazily wrappable bank denoms of the total supply without a token pair by their wrapper
+// address, so that an EVM call to the wrapper address can be resolved to its denom
+func (k Keeper) indexWrapperDenoms(ctx sdk.Context) {
+	store := k.getWrapperDenomsStore(ctx)
+
+	k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if !types.IsLazilyWrappableDenom(coin.Denom) {
+			return false
+		}
+
+		if pair, _ := k.GetTokenPairForDenom(ctx, coin.Denom); pair != nil {
+			return false
+		}
+
+		store.Set(types.WrapperAddress(coin.Denom).Bytes(), []byte(coin.Denom))
+		return false
+	})
+}
+
+// queueWrapper queues the bank denom for ERC20 wrapper creation if it's lazily wrappable, isn't retired and has neither
+// a token pair nor a pending wrapper yet. It returns true if the denom was queued.
+func (k Keeper) queueWrapper(ctx sdk.Context, bankDenom string) bool {
+	if !types.IsLazilyWrappableDenom(bankDenom) || k.IsWrapperPending(ctx, bankDenom) || k.IsDenomRetired(ctx, bankDenom) {
+		return false
+	}
+
+	if pair, _ := k.GetTokenPairForDenom(ctx, bankDenom); pair != nil {
+		return false
+	}
+
+	k.setWrapperPending(ctx, bankDenom)
+	return true
+}
+
+// SendRestrictionFn queues the ERC20 wrapper creation of the transferred denoms without a token pair, so that
+// wrappers materialize on the first transfer of a denom to an account, including IBC and peggy deposits. Wrappers of the
+// denoms which aren't transferred are queued on their first EVM access instead, see Hooks.PostTxProcessing.
+func (k Keeper) SendRestrictionFn(c context.Context, _, to sdk.AccAddress, amount sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	for _, coin := range amount {
+		k.queueWrapper(ctx, coin.Denom)
+	}
+
+	return to, nil
+}
+
+// ProcessPendingWrappers creates the ERC20 wrappers of up to MaxWrappersPerBlock queued bank denoms. A denom which
+// wrapper can't be created is dropped from the queue, it's queued again on its next transfer.
+func (k Keeper) ProcessPendingWrappers(ctx sdk.Context) {
+	denoms := make([]string, 0, types.MaxWrappersPerBlock)
+
+	store := k.getPendingWrappersStore(ctx)
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid() && len(denoms) < types.MaxWrappersPerBlock; iter.Next() {
+		denoms = append(denoms, string(iter.Key()))
+	}
+	iter.Close()
+
+	for _, denom := range denoms {
+		k.deletePendingWrapper(ctx, denom)
+
+		// the pair may have been created explicitly since the denom was queued
+		if pair, _ := k.GetTokenPairForDenom(ctx, denom); pair != nil {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.createWrapperTokenPair(cacheCtx, denom); err != nil {
+			k.Logger(ctx).Error("failed to create ERC20 wrapper", "denom", denom, "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
@@ -16,6 +16,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "erc20/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgCreateTokenPair{}, "erc20/MsgCreateTokenPair", nil)
 	cdc.RegisterConcrete(&MsgDeleteTokenPair{}, "erc20/MsgDeleteTokenPair", nil)
+	cdc.RegisterConcrete(&MsgBackfillTokenPairs{}, "erc20/MsgBackfillTokenPairs", nil)
+	cdc.RegisterConcrete(&MsgMigrateTokenPair{}, "erc20/MsgMigrateTokenPair", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,6 +25,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCreateTokenPair{},
 		&MsgDeleteTokenPair{},
+		&MsgBackfillTokenPairs{},
+		&MsgMigrateTokenPair{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -76,8 +76,84 @@ func (m *TokenPair) GetErc20Address() string {
 	return ""
 }
 
+// DeprecatedTokenPair is the former ERC-20 contract of a bank denom whose
+// token pair was migrated or retired. Until the end of the conversion window,
+// the contract keeps operating on the balances of the bank denom.
+type DeprecatedTokenPair struct {
+	// bank denom the ERC-20 contract was associated with
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// address of the deprecated ERC-20 contract
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// address of the ERC-20 contract replacing it, empty if the pair is retired
+	ReplacementErc20Address string `protobuf:"bytes,3,opt,name=replacement_erc20_address,json=replacementErc20Address,proto3" json:"replacement_erc20_address,omitempty"`
+	// unix timestamp (in seconds) of the end of the conversion window
+	ConversionEndTime int64 `protobuf:"varint,4,opt,name=conversion_end_time,json=conversionEndTime,proto3" json:"conversion_end_time,omitempty"`
+}
+
+func (m *DeprecatedTokenPair) Reset()         { *m = DeprecatedTokenPair{} }
+func (m *DeprecatedTokenPair) String() string { return proto.CompactTextString(m) }
+func (*DeprecatedTokenPair) ProtoMessage()    {}
+func (*DeprecatedTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a54415e94b67e1cf, []int{1}
+}
+func (m *DeprecatedTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecatedTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecatedTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeprecatedTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecatedTokenPair.Merge(m, src)
+}
+func (m *DeprecatedTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecatedTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecatedTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeprecatedTokenPair proto.InternalMessageInfo
+
+func (m *DeprecatedTokenPair) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *DeprecatedTokenPair) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *DeprecatedTokenPair) GetReplacementErc20Address() string {
+	if m != nil {
+		return m.ReplacementErc20Address
+	}
+	return ""
+}
+
+func (m *DeprecatedTokenPair) GetConversionEndTime() int64 {
+	if m != nil {
+		return m.ConversionEndTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TokenPair)(nil), "injective.erc20.v1beta1.TokenPair")
+	proto.RegisterType((*DeprecatedTokenPair)(nil), "injective.erc20.v1beta1.DeprecatedTokenPair")
 }
 
 func init() {
@@ -85,21 +161,25 @@ func init() {
 }
 
 var fileDescriptor_a54415e94b67e1cf = []byte{
-	// 212 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0xf0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe1, 0x8a, 0xf4,
-	0x20, 0xc2, 0x50, 0x45, 0x4a, 0xfe, 0x5c, 0x9c, 0x21, 0xf9, 0xd9, 0xa9, 0x79, 0x01, 0x89, 0x99,
-	0x45, 0x42, 0xb2, 0x5c, 0x5c, 0x49, 0x89, 0x79, 0xd9, 0xf1, 0x29, 0xa9, 0x79, 0xf9, 0xb9, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x9c, 0x20, 0x11, 0x17, 0x90, 0x80, 0x90, 0x32, 0x17, 0x2f,
-	0x58, 0x73, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0x13, 0x58, 0x05, 0x0f, 0x58,
-	0xd0, 0x11, 0x22, 0xe6, 0x94, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0xde, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x9e, 0x30, 0xe7, 0xf8,
-	0x24, 0x26, 0x15, 0xeb, 0xc3, 0x1d, 0xa7, 0x9b, 0x9c, 0x5f, 0x94, 0x8a, 0xcc, 0xcd, 0x48, 0xcc,
-	0xcc, 0xd3, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x86, 0x7a, 0xaf, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0xec, 0x2f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x4b, 0x8a, 0xdb,
-	0xfe, 0x00, 0x00, 0x00,
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x40, 0x6b, 0x8a, 0x90, 0x62, 0xc1, 0x40, 0x3a, 0x34, 0x0c, 0x58, 0x55, 0xbb, 0x74, 0x21,
+	0xa1, 0xb0, 0xb1, 0x81, 0xda, 0x01, 0x81, 0x04, 0xaa, 0x3a, 0xb1, 0x44, 0x8e, 0x7d, 0xa2, 0xa6,
+	0xb5, 0x1d, 0xd9, 0x6e, 0x24, 0xfe, 0x82, 0x9f, 0x42, 0x62, 0xec, 0xc8, 0x88, 0x92, 0x1f, 0x41,
+	0x75, 0x4a, 0x09, 0x3b, 0xe3, 0x3d, 0xbf, 0xe7, 0xe1, 0x0e, 0x0f, 0x84, 0x7a, 0x01, 0xe6, 0x44,
+	0x01, 0x09, 0x18, 0x76, 0x71, 0x9e, 0x14, 0xa3, 0x0c, 0x1c, 0x1d, 0xd5, 0x53, 0x9c, 0x1b, 0xed,
+	0x74, 0xd8, 0xdd, 0x49, 0x71, 0x8d, 0xb7, 0x52, 0xff, 0x01, 0x07, 0x33, 0xbd, 0x00, 0xf5, 0x48,
+	0x85, 0x09, 0x4f, 0x31, 0xce, 0xa8, 0x5a, 0xa4, 0x1c, 0x94, 0x96, 0x11, 0xea, 0xa1, 0x61, 0x30,
+	0x0d, 0x36, 0x64, 0xbc, 0x01, 0xe1, 0x00, 0x1f, 0xf9, 0x38, 0xa5, 0x9c, 0x1b, 0xb0, 0x36, 0xda,
+	0xf3, 0xc6, 0xa1, 0x87, 0xd7, 0x35, 0xeb, 0xbf, 0x23, 0xdc, 0x19, 0x43, 0x6e, 0x80, 0x51, 0x07,
+	0xfc, 0x5f, 0xff, 0x0e, 0xaf, 0xf0, 0x89, 0x81, 0x7c, 0x49, 0x19, 0x48, 0x50, 0x2e, 0xfd, 0x1b,
+	0xb4, 0x7d, 0xd0, 0x6d, 0x08, 0x93, 0x66, 0x1b, 0xe3, 0x0e, 0xd3, 0xaa, 0x00, 0x63, 0x85, 0x56,
+	0x29, 0x28, 0x9e, 0x3a, 0x21, 0x21, 0xda, 0xef, 0xa1, 0x61, 0x7b, 0x7a, 0xfc, 0xfb, 0x34, 0x51,
+	0x7c, 0x26, 0x24, 0xdc, 0xc0, 0x47, 0x49, 0xd0, 0xba, 0x24, 0xe8, 0xab, 0x24, 0xe8, 0xad, 0x22,
+	0xad, 0x75, 0x45, 0x5a, 0x9f, 0x15, 0x69, 0x3d, 0xdd, 0x3d, 0x0b, 0x37, 0x5f, 0x65, 0x31, 0xd3,
+	0x32, 0xb9, 0xfd, 0x59, 0xeb, 0x3d, 0xcd, 0x6c, 0xb2, 0x5b, 0xf2, 0x19, 0xd3, 0x06, 0x9a, 0xe3,
+	0x9c, 0x0a, 0x95, 0x48, 0xcd, 0x57, 0x4b, 0xb0, 0xdb, 0x33, 0xb9, 0xd7, 0x1c, 0x6c, 0x76, 0xe0,
+	0xef, 0x73, 0xf9, 0x3d, 0x00, 0xb2, 0x0e, 0x58, 0xc3, 0xc6, 0x01, 0x00, 0x00,
 }
 
 func (m *TokenPair) Marshal() (dAtA []byte, err error) {
@@ -139,6 +219,55 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeprecatedTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecatedTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeprecatedTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConversionEndTime != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ConversionEndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReplacementErc20Address) > 0 {
+		i -= len(m.ReplacementErc20Address)
+		copy(dAtA[i:], m.ReplacementErc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ReplacementErc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
 	offset -= sovErc20(v)
 	base := offset
@@ -167,6 +296,30 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *DeprecatedTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.ReplacementErc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.ConversionEndTime != 0 {
+		n += 1 + sovErc20(uint64(m.ConversionEndTime))
+	}
+	return n
+}
+
 func sovErc20(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -287,6 +440,171 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeprecatedTokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeprecatedTokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeprecatedTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacementErc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionEndTime", wireType)
+			}
+			m.ConversionEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipErc20(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,6 @@ var (
 	ErrInvalidTFDenom           = errors.Register(ModuleName, 9, "invalid token factory denom")
 	ErrExistingERC20DenomSupply = errors.Register(ModuleName, 10, "respective erc20:... denom has existing supply")
 	ErrInvalidQueryRequest      = errors.Register(ModuleName, 11, "invalid query request")
+	ErrTokenPairRetired         = errors.Register(ModuleName, 12, "token pair of bank denom is retired")
+	ErrUnexpectedWrapper        = errors.Register(ModuleName, 13, "unexpected contract at the wrapper address")
 )
@@ -118,9 +118,132 @@ func (m *EventDeleteTokenPair) GetBankDenom() string {
 	return ""
 }
 
+type EventMigrateTokenPair struct {
+	BankDenom       string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	OldErc20Address string `protobuf:"bytes,2,opt,name=old_erc20_address,json=oldErc20Address,proto3" json:"old_erc20_address,omitempty"`
+	// empty if the pair is retired
+	NewErc20Address   string `protobuf:"bytes,3,opt,name=new_erc20_address,json=newErc20Address,proto3" json:"new_erc20_address,omitempty"`
+	ConversionEndTime int64  `protobuf:"varint,4,opt,name=conversion_end_time,json=conversionEndTime,proto3" json:"conversion_end_time,omitempty"`
+}
+
+func (m *EventMigrateTokenPair) Reset()         { *m = EventMigrateTokenPair{} }
+func (m *EventMigrateTokenPair) String() string { return proto.CompactTextString(m) }
+func (*EventMigrateTokenPair) ProtoMessage()    {}
+func (*EventMigrateTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a583daf83db5e2f, []int{2}
+}
+func (m *EventMigrateTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMigrateTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMigrateTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMigrateTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMigrateTokenPair.Merge(m, src)
+}
+func (m *EventMigrateTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMigrateTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMigrateTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMigrateTokenPair proto.InternalMessageInfo
+
+func (m *EventMigrateTokenPair) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventMigrateTokenPair) GetOldErc20Address() string {
+	if m != nil {
+		return m.OldErc20Address
+	}
+	return ""
+}
+
+func (m *EventMigrateTokenPair) GetNewErc20Address() string {
+	if m != nil {
+		return m.NewErc20Address
+	}
+	return ""
+}
+
+func (m *EventMigrateTokenPair) GetConversionEndTime() int64 {
+	if m != nil {
+		return m.ConversionEndTime
+	}
+	return 0
+}
+
+type EventConversionWindowEnded struct {
+	BankDenom    string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *EventConversionWindowEnded) Reset()         { *m = EventConversionWindowEnded{} }
+func (m *EventConversionWindowEnded) String() string { return proto.CompactTextString(m) }
+func (*EventConversionWindowEnded) ProtoMessage()    {}
+func (*EventConversionWindowEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a583daf83db5e2f, []int{3}
+}
+func (m *EventConversionWindowEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConversionWindowEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConversionWindowEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConversionWindowEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConversionWindowEnded.Merge(m, src)
+}
+func (m *EventConversionWindowEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConversionWindowEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConversionWindowEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConversionWindowEnded proto.InternalMessageInfo
+
+func (m *EventConversionWindowEnded) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventConversionWindowEnded) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateTokenPair)(nil), "injective.erc20.v1beta1.EventCreateTokenPair")
 	proto.RegisterType((*EventDeleteTokenPair)(nil), "injective.erc20.v1beta1.EventDeleteTokenPair")
+	proto.RegisterType((*EventMigrateTokenPair)(nil), "injective.erc20.v1beta1.EventMigrateTokenPair")
+	proto.RegisterType((*EventConversionWindowEnded)(nil), "injective.erc20.v1beta1.EventConversionWindowEnded")
 }
 
 func init() {
@@ -128,22 +251,28 @@ func init() {
 }
 
 var fileDescriptor_2a583daf83db5e2f = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xab, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xaa, 0x52, 0x8a, 0xe2, 0x12, 0x71, 0x05,
-	0x29, 0x74, 0x2e, 0x4a, 0x4d, 0x2c, 0x49, 0x0d, 0xc9, 0xcf, 0x4e, 0xcd, 0x0b, 0x48, 0xcc, 0x2c,
-	0x12, 0x92, 0xe5, 0xe2, 0x4a, 0x4a, 0xcc, 0xcb, 0x8e, 0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x04, 0x89, 0xb8, 0x80, 0x04, 0x84, 0x94, 0xb9, 0x78, 0xc1,
-	0xe6, 0xc4, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x81, 0x55, 0xf0, 0x80, 0x05,
-	0x1d, 0x21, 0x62, 0x4a, 0xa6, 0x50, 0xb3, 0x5d, 0x52, 0x73, 0x52, 0x89, 0x37, 0xdb, 0x29, 0xf5,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xbc, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x3d, 0x61, 0x1e, 0xf2, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0x7b,
-	0x4f, 0x37, 0x39, 0xbf, 0x28, 0x15, 0x99, 0x9b, 0x91, 0x98, 0x99, 0xa7, 0x9f, 0x9b, 0x9f, 0x52,
-	0x9a, 0x93, 0x5a, 0x0c, 0x0d, 0xa1, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xc8, 0x18,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0x9d, 0x46, 0xfd, 0x41, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xbb, 0x56, 0x84, 0x2e, 0x8a, 0xb4, 0x2a, 0x16, 0xc1, 0x50, 0xa2, 0x87, 0x22, 0x98,
+	0x58, 0xc5, 0x07, 0x50, 0x9b, 0x83, 0xa8, 0x20, 0xa5, 0x20, 0xf4, 0x12, 0x37, 0xd9, 0xa1, 0x5d,
+	0x9b, 0xcc, 0x96, 0xdd, 0x6d, 0x8a, 0x6f, 0xe1, 0x13, 0x79, 0xf6, 0xd8, 0xa3, 0x47, 0x69, 0x5f,
+	0x44, 0x92, 0xd6, 0x58, 0xc5, 0x83, 0x82, 0xc7, 0xfd, 0xe7, 0xdb, 0x9f, 0x9f, 0x99, 0x9f, 0xee,
+	0x0b, 0x7c, 0x80, 0xd0, 0x88, 0x04, 0x5c, 0x50, 0xe1, 0xf1, 0x91, 0x9b, 0x34, 0x02, 0x30, 0xac,
+	0xe1, 0x42, 0x02, 0x68, 0xb4, 0x33, 0x50, 0xd2, 0xc8, 0xca, 0x76, 0x4e, 0x39, 0x19, 0xe5, 0xcc,
+	0x29, 0xbb, 0x43, 0x37, 0xbd, 0x14, 0xbc, 0x50, 0xc0, 0x0c, 0xb4, 0x65, 0x1f, 0xf0, 0x96, 0x09,
+	0x55, 0xd9, 0xa5, 0x34, 0x60, 0xd8, 0xf7, 0x39, 0xa0, 0x8c, 0xab, 0xa4, 0x46, 0xea, 0xa5, 0x56,
+	0x29, 0x55, 0x9a, 0xa9, 0x50, 0xd9, 0xa3, 0x6b, 0x99, 0x8f, 0xcf, 0x38, 0x57, 0xa0, 0x75, 0x75,
+	0x29, 0x23, 0x56, 0x33, 0xf1, 0x6c, 0xa6, 0xd9, 0xa7, 0x73, 0xef, 0x26, 0x44, 0xf0, 0x7b, 0x6f,
+	0xfb, 0x99, 0xd0, 0xad, 0xec, 0xdf, 0x8d, 0xe8, 0xaa, 0xbf, 0x84, 0x3a, 0xa0, 0x65, 0x19, 0x71,
+	0xff, 0xa7, 0x60, 0xeb, 0x32, 0xe2, 0xde, 0x42, 0xb6, 0x94, 0x45, 0x18, 0x7d, 0x63, 0x8b, 0x33,
+	0x16, 0x61, 0xf4, 0x85, 0x75, 0xe8, 0x46, 0x28, 0x31, 0x01, 0xa5, 0x85, 0x44, 0x1f, 0x90, 0xfb,
+	0x46, 0xc4, 0x50, 0x5d, 0xae, 0x91, 0x7a, 0xb1, 0x55, 0xfe, 0x1c, 0x79, 0xc8, 0xdb, 0x22, 0x06,
+	0xfb, 0x9e, 0xee, 0xcc, 0x76, 0x9a, 0x4f, 0xee, 0x04, 0x72, 0x39, 0xf2, 0x90, 0x03, 0xff, 0x8f,
+	0xcd, 0x9e, 0xc3, 0xcb, 0xc4, 0x22, 0xe3, 0x89, 0x45, 0xde, 0x26, 0x16, 0x79, 0x9a, 0x5a, 0x85,
+	0xf1, 0xd4, 0x2a, 0xbc, 0x4e, 0xad, 0x42, 0xe7, 0xaa, 0x2b, 0x4c, 0x6f, 0x18, 0x38, 0xa1, 0x8c,
+	0xdd, 0xcb, 0x8f, 0x9b, 0x5f, 0xb3, 0x40, 0xbb, 0x79, 0x03, 0x0e, 0x43, 0xa9, 0x60, 0xf1, 0xd9,
+	0x63, 0x02, 0xdd, 0x58, 0xf2, 0x61, 0x04, 0x7a, 0x5e, 0x22, 0xf3, 0x38, 0x00, 0x1d, 0xac, 0x64,
+	0xe5, 0x39, 0x79, 0x1f, 0x00, 0xf6, 0xc4, 0x89, 0x30, 0x64, 0x02, 0x00, 0x00,
 }
 
 func (m *EventCreateTokenPair) Marshal() (dAtA []byte, err error) {
@@ -213,6 +342,92 @@ func (m *EventDeleteTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventMigrateTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMigrateTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMigrateTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConversionEndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConversionEndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewErc20Address) > 0 {
+		i -= len(m.NewErc20Address)
+		copy(dAtA[i:], m.NewErc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewErc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldErc20Address) > 0 {
+		i -= len(m.OldErc20Address)
+		copy(dAtA[i:], m.OldErc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldErc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConversionWindowEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConversionWindowEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConversionWindowEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -254,6 +469,47 @@ func (m *EventDeleteTokenPair) Size() (n int) {
 	return n
 }
 
+func (m *EventMigrateTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldErc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewErc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ConversionEndTime != 0 {
+		n += 1 + sovEvents(uint64(m.ConversionEndTime))
+	}
+	return n
+}
+
+func (m *EventConversionWindowEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -456,6 +712,285 @@ func (m *EventDeleteTokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventMigrateTokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMigrateTokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMigrateTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldErc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionEndTime", wireType)
+			}
+			m.ConversionEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConversionWindowEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConversionWindowEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConversionWindowEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
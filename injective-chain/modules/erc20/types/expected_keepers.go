@@ -15,6 +15,8 @@ import (
 type BankKeeper interface {
 	HasSupply(ctx context.Context, denom string) bool
 	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
+	DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
 }
 
 type AccountKeeper interface {
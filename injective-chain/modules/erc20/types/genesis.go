@@ -8,8 +8,11 @@ import (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		TokenPairs: []TokenPair{},
+		Params:               DefaultParams(),
+		TokenPairs:           []TokenPair{},
+		PendingWrapperDenoms: []string{},
+		DeprecatedTokenPairs: []DeprecatedTokenPair{},
+		RetiredDenoms:        []string{},
 	}
 }
 
@@ -45,5 +48,53 @@ func (gs GenesisState) Validate() error {
 		seenErc20Addresses[string(erc20Address.Bytes())] = struct{}{}
 	}
 
+	seenPendingDenoms := map[string]struct{}{}
+
+	for _, denom := range gs.GetPendingWrapperDenoms() {
+		if !IsLazilyWrappableDenom(denom) {
+			return errors.Wrapf(ErrInvalidGenesis, "pending wrapper for unsupported bank denom: %s", denom)
+		}
+
+		if _, ok := seenDenoms[denom]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "pending wrapper for bank denom with a token pair: %s", denom)
+		}
+
+		if _, ok := seenPendingDenoms[denom]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "duplicate pending wrapper denom: %s", denom)
+		}
+		seenPendingDenoms[denom] = struct{}{}
+	}
+
+	for _, pair := range gs.GetDeprecatedTokenPairs() {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		// a contract can't be both the ERC20 of a pair and a deprecated one
+		erc20Address := ethcommon.HexToAddress(pair.GetErc20Address())
+
+		if _, ok := seenErc20Addresses[string(erc20Address.Bytes())]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "duplicate ERC20 address: %s", erc20Address.Hex())
+		}
+		seenErc20Addresses[string(erc20Address.Bytes())] = struct{}{}
+	}
+
+	seenRetiredDenoms := map[string]struct{}{}
+
+	for _, denom := range gs.GetRetiredDenoms() {
+		if _, ok := seenDenoms[denom]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "retired bank denom with a token pair: %s", denom)
+		}
+
+		if _, ok := seenPendingDenoms[denom]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "retired bank denom with a pending wrapper: %s", denom)
+		}
+
+		if _, ok := seenRetiredDenoms[denom]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "duplicate retired denom: %s", denom)
+		}
+		seenRetiredDenoms[denom] = struct{}{}
+	}
+
 	return nil
 }
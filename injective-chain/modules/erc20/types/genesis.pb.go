@@ -28,6 +28,13 @@ type GenesisState struct {
 	// params defines the parameters of the module.
 	Params     Params      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// bank denoms whose ERC-20 wrapper is still to be created
+	PendingWrapperDenoms []string `protobuf:"bytes,3,rep,name=pending_wrapper_denoms,json=pendingWrapperDenoms,proto3" json:"pending_wrapper_denoms,omitempty"`
+	// former ERC-20 contracts of migrated or retired token pairs whose
+	// conversion window is still open
+	DeprecatedTokenPairs []DeprecatedTokenPair `protobuf:"bytes,4,rep,name=deprecated_token_pairs,json=deprecatedTokenPairs,proto3" json:"deprecated_token_pairs"`
+	// bank denoms whose token pair was retired
+	RetiredDenoms []string `protobuf:"bytes,5,rep,name=retired_denoms,json=retiredDenoms,proto3" json:"retired_denoms,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +84,27 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetPendingWrapperDenoms() []string {
+	if m != nil {
+		return m.PendingWrapperDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeprecatedTokenPairs() []DeprecatedTokenPair {
+	if m != nil {
+		return m.DeprecatedTokenPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetiredDenoms() []string {
+	if m != nil {
+		return m.RetiredDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.erc20.v1beta1.GenesisState")
 }
@@ -86,24 +114,30 @@ func init() {
 }
 
 var fileDescriptor_5a8bcdefb4cc9eed = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x2b, 0xd3, 0x03, 0x2b, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0x54, 0x70, 0x99, 0x5a, 0x90, 0x58, 0x94,
-	0x98, 0x0b, 0x35, 0x54, 0x4a, 0x19, 0x97, 0x2a, 0x88, 0x15, 0x60, 0x45, 0x4a, 0x33, 0x18, 0xb9,
-	0x78, 0xdc, 0x21, 0x6e, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe5, 0x62, 0x83, 0x98, 0x22,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xaf, 0x87, 0xc3, 0x6d, 0x7a, 0x01, 0x60, 0x65, 0x4e,
-	0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x35, 0x09, 0x79, 0x72, 0x71, 0x97, 0xe4, 0x67, 0xa7,
-	0xe6, 0xc5, 0x17, 0x24, 0x66, 0x16, 0x15, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x29, 0xe1,
-	0x34, 0x23, 0x04, 0xa4, 0x36, 0x20, 0x31, 0xb3, 0x08, 0x6a, 0x0c, 0x57, 0x09, 0x4c, 0xa0, 0xd8,
-	0x29, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xbc, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x3d, 0x61, 0x26, 0xfb, 0x24, 0x26, 0x15, 0xeb,
-	0xc3, 0xed, 0xd1, 0x4d, 0xce, 0x2f, 0x4a, 0x45, 0xe6, 0x66, 0x24, 0x66, 0xe6, 0xe9, 0xe7, 0xe6,
-	0xa7, 0x94, 0xe6, 0xa4, 0x16, 0x43, 0xc3, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c,
-	0x10, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x86, 0xa6, 0x31, 0xab, 0x01, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x5b, 0xe0, 0x92, 0xdc, 0xe1, 0x5e, 0x17, 0x0d, 0x41, 0xc2, 0xa2, 0x10, 0x94, 0x84,
+	0x85, 0xb6, 0x82, 0x6e, 0xdd, 0x10, 0x12, 0x43, 0x74, 0x41, 0xd0, 0xc4, 0xc4, 0x4d, 0x33, 0x6d,
+	0x4f, 0xca, 0xa8, 0x9d, 0x99, 0xcc, 0x0c, 0x18, 0xdf, 0xc2, 0xc7, 0x62, 0xc9, 0xd2, 0x95, 0x1a,
+	0x78, 0x11, 0xc3, 0x74, 0x20, 0x44, 0xd3, 0x5d, 0x7b, 0xfe, 0xef, 0xfc, 0xf3, 0x25, 0x07, 0x75,
+	0x08, 0x7d, 0x84, 0x48, 0x91, 0x39, 0xf8, 0x20, 0xa2, 0xfe, 0x99, 0x3f, 0xef, 0x85, 0xa0, 0x70,
+	0xcf, 0x4f, 0x80, 0x82, 0x24, 0xd2, 0xe3, 0x82, 0x29, 0xe6, 0x1c, 0xee, 0x30, 0x4f, 0x63, 0x9e,
+	0xc1, 0x1a, 0xd5, 0x84, 0x25, 0x4c, 0x33, 0xfe, 0xe6, 0x2b, 0xc3, 0x1b, 0xc7, 0x79, 0xad, 0x1c,
+	0x0b, 0x9c, 0x9a, 0xd2, 0xc6, 0x51, 0x1e, 0x95, 0x3d, 0xa1, 0xa1, 0xf6, 0x67, 0x01, 0xfd, 0xbb,
+	0xca, 0x5c, 0x6e, 0x15, 0x56, 0xe0, 0x5c, 0xa2, 0x72, 0xd6, 0x52, 0xb7, 0x5b, 0x76, 0xb7, 0xd2,
+	0x6f, 0x7a, 0x39, 0x6e, 0xde, 0x58, 0x63, 0x83, 0xd2, 0xe2, 0xa3, 0x69, 0x4d, 0xcc, 0x92, 0x33,
+	0x42, 0x15, 0xc5, 0x9e, 0x80, 0x06, 0x1c, 0x13, 0x21, 0xeb, 0x85, 0x56, 0xb1, 0x5b, 0xe9, 0xb7,
+	0x73, 0x3b, 0xee, 0x36, 0xec, 0x18, 0x13, 0x61, 0x6a, 0x90, 0xda, 0x0e, 0xa4, 0x73, 0x81, 0x6a,
+	0x1c, 0x68, 0x4c, 0x68, 0x12, 0xbc, 0x08, 0xcc, 0x39, 0x88, 0x20, 0x06, 0xca, 0x52, 0x59, 0x2f,
+	0xb6, 0x8a, 0xdd, 0xbf, 0x93, 0xaa, 0x49, 0xef, 0xb3, 0x70, 0xa8, 0x33, 0x67, 0x8a, 0x6a, 0x31,
+	0x70, 0x01, 0x11, 0x56, 0x10, 0x07, 0xfb, 0x2e, 0x25, 0xed, 0x72, 0x92, 0xeb, 0x32, 0xdc, 0xad,
+	0xfd, 0xb4, 0xaa, 0xc6, 0xbf, 0x23, 0xe9, 0x74, 0xd0, 0x81, 0x00, 0x45, 0x04, 0xc4, 0x5b, 0xaf,
+	0x3f, 0xda, 0xeb, 0xbf, 0x99, 0x66, 0x42, 0x03, 0x58, 0xac, 0x5c, 0x7b, 0xb9, 0x72, 0xed, 0xaf,
+	0x95, 0x6b, 0xbf, 0xad, 0x5d, 0x6b, 0xb9, 0x76, 0xad, 0xf7, 0xb5, 0x6b, 0x3d, 0x5c, 0x27, 0x44,
+	0x4d, 0x67, 0xa1, 0x17, 0xb1, 0xd4, 0x1f, 0x6d, 0xa5, 0x6e, 0x70, 0x28, 0xfd, 0x9d, 0xe2, 0x69,
+	0xc4, 0x04, 0xec, 0xff, 0x4e, 0x31, 0xa1, 0x7e, 0xca, 0xe2, 0xd9, 0x33, 0x48, 0x73, 0x56, 0xf5,
+	0xca, 0x41, 0x86, 0x65, 0x7d, 0xcf, 0xf3, 0xef, 0x01, 0x00, 0xac, 0x39, 0xb5, 0x43, 0x72, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +160,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetiredDenoms) > 0 {
+		for iNdEx := len(m.RetiredDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetiredDenoms[iNdEx])
+			copy(dAtA[i:], m.RetiredDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RetiredDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeprecatedTokenPairs) > 0 {
+		for iNdEx := len(m.DeprecatedTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeprecatedTokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingWrapperDenoms) > 0 {
+		for iNdEx := len(m.PendingWrapperDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingWrapperDenoms[iNdEx])
+			copy(dAtA[i:], m.PendingWrapperDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingWrapperDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +244,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingWrapperDenoms) > 0 {
+		for _, s := range m.PendingWrapperDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeprecatedTokenPairs) > 0 {
+		for _, e := range m.DeprecatedTokenPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetiredDenoms) > 0 {
+		for _, s := range m.RetiredDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +367,104 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWrapperDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWrapperDenoms = append(m.PendingWrapperDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedTokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedTokenPairs = append(m.DeprecatedTokenPairs, DeprecatedTokenPair{})
+			if err := m.DeprecatedTokenPairs[len(m.DeprecatedTokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredDenoms = append(m.RetiredDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,12 +13,17 @@ const (
 	TypeMsgUpdateParams    = "update_params"
 	TypeMsgCreateTokenPair = "create_token_pair"
 	TypeMsgDeleteTokenPair = "delete_token_pair"
+
+	TypeMsgBackfillTokenPairs = "backfill_token_pairs"
+	TypeMsgMigrateTokenPair   = "migrate_token_pair"
 )
 
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgCreateTokenPair{}
 	_ sdk.Msg = &MsgDeleteTokenPair{}
+	_ sdk.Msg = &MsgBackfillTokenPairs{}
+	_ sdk.Msg = &MsgMigrateTokenPair{}
 )
 
 func (m MsgUpdateParams) Route() string { return routerKey }
@@ -88,3 +94,55 @@ func (m MsgDeleteTokenPair) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{addr}
 }
+
+func (m MsgBackfillTokenPairs) Route() string { return routerKey }
+
+func (m MsgBackfillTokenPairs) Type() string { return TypeMsgBackfillTokenPairs }
+
+func (m MsgBackfillTokenPairs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MsgBackfillTokenPairs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgBackfillTokenPairs) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgMigrateTokenPair) Route() string { return routerKey }
+
+func (m MsgMigrateTokenPair) Type() string { return TypeMsgMigrateTokenPair }
+
+func (m MsgMigrateTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	// the same rules as for the creation of a pair with an existing ERC20 contract apply to the new pair
+	newPair := TokenPair{BankDenom: m.BankDenom, Erc20Address: m.NewErc20Address}
+	if err := newPair.Validate(); err != nil {
+		return err
+	}
+
+	if m.ConversionWindow < 0 {
+		return errors.Wrap(ErrInvalidTokenPair, "conversion window cannot be negative")
+	}
+
+	return nil
+}
+
+func (m *MsgMigrateTokenPair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgMigrateTokenPair) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -396,6 +396,381 @@ func (m *QueryTokenPairByERC20AddressResponse) GetTokenPair() *TokenPair {
 	return nil
 }
 
+// QueryWrapperAddressRequest is the request type for the Query/WrapperAddress
+// RPC method.
+type QueryWrapperAddressRequest struct {
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+}
+
+func (m *QueryWrapperAddressRequest) Reset()         { *m = QueryWrapperAddressRequest{} }
+func (m *QueryWrapperAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWrapperAddressRequest) ProtoMessage()    {}
+func (*QueryWrapperAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{8}
+}
+func (m *QueryWrapperAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrapperAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrapperAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrapperAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrapperAddressRequest.Merge(m, src)
+}
+func (m *QueryWrapperAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrapperAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrapperAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrapperAddressRequest proto.InternalMessageInfo
+
+func (m *QueryWrapperAddressRequest) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+// QueryWrapperAddressResponse is the response type for the
+// Query/WrapperAddress RPC method.
+type QueryWrapperAddressResponse struct {
+	// deterministic address of the ERC-20 wrapper of the bank denom
+	WrapperAddress string `protobuf:"bytes,1,opt,name=wrapper_address,json=wrapperAddress,proto3" json:"wrapper_address,omitempty"`
+	// token pair of the bank denom, if it exists. Its ERC-20 address differs
+	// from the wrapper address if the pair uses a custom ERC-20 contract.
+	TokenPair *TokenPair `protobuf:"bytes,2,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair,omitempty"`
+	// true if the creation of the wrapper is queued
+	IsPending bool `protobuf:"varint,3,opt,name=is_pending,json=isPending,proto3" json:"is_pending,omitempty"`
+}
+
+func (m *QueryWrapperAddressResponse) Reset()         { *m = QueryWrapperAddressResponse{} }
+func (m *QueryWrapperAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWrapperAddressResponse) ProtoMessage()    {}
+func (*QueryWrapperAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{9}
+}
+func (m *QueryWrapperAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWrapperAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWrapperAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWrapperAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWrapperAddressResponse.Merge(m, src)
+}
+func (m *QueryWrapperAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWrapperAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWrapperAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWrapperAddressResponse proto.InternalMessageInfo
+
+func (m *QueryWrapperAddressResponse) GetWrapperAddress() string {
+	if m != nil {
+		return m.WrapperAddress
+	}
+	return ""
+}
+
+func (m *QueryWrapperAddressResponse) GetTokenPair() *TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return nil
+}
+
+func (m *QueryWrapperAddressResponse) GetIsPending() bool {
+	if m != nil {
+		return m.IsPending
+	}
+	return false
+}
+
+// QueryDeprecatedTokenPairsRequest is the request type for the
+// Query/DeprecatedTokenPairs RPC method.
+type QueryDeprecatedTokenPairsRequest struct {
+}
+
+func (m *QueryDeprecatedTokenPairsRequest) Reset()         { *m = QueryDeprecatedTokenPairsRequest{} }
+func (m *QueryDeprecatedTokenPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeprecatedTokenPairsRequest) ProtoMessage()    {}
+func (*QueryDeprecatedTokenPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{10}
+}
+func (m *QueryDeprecatedTokenPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeprecatedTokenPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeprecatedTokenPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeprecatedTokenPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeprecatedTokenPairsRequest.Merge(m, src)
+}
+func (m *QueryDeprecatedTokenPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeprecatedTokenPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeprecatedTokenPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeprecatedTokenPairsRequest proto.InternalMessageInfo
+
+// QueryDeprecatedTokenPairsResponse is the response type for the
+// Query/DeprecatedTokenPairs RPC method.
+type QueryDeprecatedTokenPairsResponse struct {
+	DeprecatedTokenPairs []DeprecatedTokenPair `protobuf:"bytes,1,rep,name=deprecated_token_pairs,json=deprecatedTokenPairs,proto3" json:"deprecated_token_pairs"`
+}
+
+func (m *QueryDeprecatedTokenPairsResponse) Reset()         { *m = QueryDeprecatedTokenPairsResponse{} }
+func (m *QueryDeprecatedTokenPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeprecatedTokenPairsResponse) ProtoMessage()    {}
+func (*QueryDeprecatedTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{11}
+}
+func (m *QueryDeprecatedTokenPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeprecatedTokenPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeprecatedTokenPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeprecatedTokenPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeprecatedTokenPairsResponse.Merge(m, src)
+}
+func (m *QueryDeprecatedTokenPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeprecatedTokenPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeprecatedTokenPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeprecatedTokenPairsResponse proto.InternalMessageInfo
+
+func (m *QueryDeprecatedTokenPairsResponse) GetDeprecatedTokenPairs() []DeprecatedTokenPair {
+	if m != nil {
+		return m.DeprecatedTokenPairs
+	}
+	return nil
+}
+
+// QueryDeprecatedTokenPairHoldersRequest is the request type for the
+// Query/DeprecatedTokenPairHolders RPC method.
+type QueryDeprecatedTokenPairHoldersRequest struct {
+	// address of the deprecated ERC-20 contract
+	Erc20Address string             `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeprecatedTokenPairHoldersRequest) Reset() {
+	*m = QueryDeprecatedTokenPairHoldersRequest{}
+}
+func (m *QueryDeprecatedTokenPairHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeprecatedTokenPairHoldersRequest) ProtoMessage()    {}
+func (*QueryDeprecatedTokenPairHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{12}
+}
+func (m *QueryDeprecatedTokenPairHoldersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeprecatedTokenPairHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeprecatedTokenPairHoldersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeprecatedTokenPairHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeprecatedTokenPairHoldersRequest.Merge(m, src)
+}
+func (m *QueryDeprecatedTokenPairHoldersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeprecatedTokenPairHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeprecatedTokenPairHoldersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeprecatedTokenPairHoldersRequest proto.InternalMessageInfo
+
+func (m *QueryDeprecatedTokenPairHoldersRequest) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *QueryDeprecatedTokenPairHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DeprecatedTokenPairHolder is a contract account holding the bank denom of a
+// deprecated ERC-20 contract.
+type DeprecatedTokenPairHolder struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	EvmAddress string     `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	Balance    types.Coin `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *DeprecatedTokenPairHolder) Reset()         { *m = DeprecatedTokenPairHolder{} }
+func (m *DeprecatedTokenPairHolder) String() string { return proto.CompactTextString(m) }
+func (*DeprecatedTokenPairHolder) ProtoMessage()    {}
+func (*DeprecatedTokenPairHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{13}
+}
+func (m *DeprecatedTokenPairHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecatedTokenPairHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecatedTokenPairHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeprecatedTokenPairHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecatedTokenPairHolder.Merge(m, src)
+}
+func (m *DeprecatedTokenPairHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecatedTokenPairHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecatedTokenPairHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeprecatedTokenPairHolder proto.InternalMessageInfo
+
+func (m *DeprecatedTokenPairHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DeprecatedTokenPairHolder) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *DeprecatedTokenPairHolder) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+// QueryDeprecatedTokenPairHoldersResponse is the response type for the
+// Query/DeprecatedTokenPairHolders RPC method. The holders are filtered while
+// paginating over the owners of the bank denom, so a page has up to limit
+// holders. At most 1000 owners are scanned per query, so a page can have less
+// holders than its limit and still have a next key. Only key based pagination
+// is supported.
+type QueryDeprecatedTokenPairHoldersResponse struct {
+	Holders    []DeprecatedTokenPairHolder `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders"`
+	Pagination *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) Reset() {
+	*m = QueryDeprecatedTokenPairHoldersResponse{}
+}
+func (m *QueryDeprecatedTokenPairHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeprecatedTokenPairHoldersResponse) ProtoMessage()    {}
+func (*QueryDeprecatedTokenPairHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{14}
+}
+func (m *QueryDeprecatedTokenPairHoldersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeprecatedTokenPairHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeprecatedTokenPairHoldersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeprecatedTokenPairHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeprecatedTokenPairHoldersResponse.Merge(m, src)
+}
+func (m *QueryDeprecatedTokenPairHoldersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeprecatedTokenPairHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeprecatedTokenPairHoldersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeprecatedTokenPairHoldersResponse proto.InternalMessageInfo
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) GetHolders() []DeprecatedTokenPairHolder {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
+}
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.erc20.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.erc20.v1beta1.QueryParamsResponse")
@@ -405,6 +780,13 @@ func init() {
 	proto.RegisterType((*QueryTokenPairByDenomResponse)(nil), "injective.erc20.v1beta1.QueryTokenPairByDenomResponse")
 	proto.RegisterType((*QueryTokenPairByERC20AddressRequest)(nil), "injective.erc20.v1beta1.QueryTokenPairByERC20AddressRequest")
 	proto.RegisterType((*QueryTokenPairByERC20AddressResponse)(nil), "injective.erc20.v1beta1.QueryTokenPairByERC20AddressResponse")
+	proto.RegisterType((*QueryWrapperAddressRequest)(nil), "injective.erc20.v1beta1.QueryWrapperAddressRequest")
+	proto.RegisterType((*QueryWrapperAddressResponse)(nil), "injective.erc20.v1beta1.QueryWrapperAddressResponse")
+	proto.RegisterType((*QueryDeprecatedTokenPairsRequest)(nil), "injective.erc20.v1beta1.QueryDeprecatedTokenPairsRequest")
+	proto.RegisterType((*QueryDeprecatedTokenPairsResponse)(nil), "injective.erc20.v1beta1.QueryDeprecatedTokenPairsResponse")
+	proto.RegisterType((*QueryDeprecatedTokenPairHoldersRequest)(nil), "injective.erc20.v1beta1.QueryDeprecatedTokenPairHoldersRequest")
+	proto.RegisterType((*DeprecatedTokenPairHolder)(nil), "injective.erc20.v1beta1.DeprecatedTokenPairHolder")
+	proto.RegisterType((*QueryDeprecatedTokenPairHoldersResponse)(nil), "injective.erc20.v1beta1.QueryDeprecatedTokenPairHoldersResponse")
 }
 
 func init() {
@@ -412,48 +794,66 @@ func init() {
 }
 
 var fileDescriptor_5110d01ee5d7f02e = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc7, 0x63, 0x5a, 0x90, 0x18, 0x8a, 0x54, 0x6d, 0x91, 0xa0, 0x16, 0x18, 0xea, 0xd0, 0x12,
-	0x51, 0x6a, 0x07, 0x43, 0x39, 0x91, 0x43, 0x42, 0x3f, 0xd4, 0x8f, 0x03, 0xb5, 0x38, 0xf5, 0x12,
-	0xad, 0x9d, 0x95, 0x71, 0x71, 0x76, 0x8d, 0xd7, 0x41, 0xca, 0xb5, 0xe7, 0x1e, 0x2a, 0xf5, 0x2d,
-	0x78, 0x81, 0xbe, 0x02, 0x47, 0xa4, 0x72, 0xe8, 0xa9, 0xaa, 0x92, 0x3e, 0x48, 0xe5, 0xf5, 0xc6,
-	0x49, 0x68, 0x9c, 0x86, 0xaa, 0x37, 0x98, 0xfd, 0xcf, 0xfc, 0x7f, 0x33, 0x9e, 0x51, 0xa0, 0xe8,
-	0xd3, 0x0f, 0xc4, 0x8d, 0xfd, 0x33, 0x62, 0x92, 0xc8, 0xb5, 0xca, 0xe6, 0xd9, 0xb6, 0x43, 0x62,
-	0xbc, 0x6d, 0x9e, 0xb6, 0x48, 0xd4, 0x36, 0xc2, 0x88, 0xc5, 0x0c, 0x2d, 0x66, 0x22, 0x43, 0x88,
-	0x0c, 0x29, 0x52, 0x17, 0x3c, 0xe6, 0x31, 0xa1, 0x31, 0x93, 0xbf, 0x52, 0xb9, 0xba, 0xec, 0x31,
-	0xe6, 0x05, 0xc4, 0xc4, 0xa1, 0x6f, 0x62, 0x4a, 0x59, 0x8c, 0x63, 0x9f, 0x51, 0x2e, 0x5f, 0x35,
-	0x97, 0xf1, 0x26, 0xe3, 0xa6, 0x83, 0x39, 0xc9, 0xdc, 0x5c, 0xe6, 0x53, 0xf9, 0xbe, 0x39, 0xf8,
-	0x2e, 0x28, 0x32, 0x55, 0x88, 0x3d, 0x9f, 0x8a, 0x62, 0x52, 0xbb, 0x9e, 0x47, 0x1f, 0xe2, 0x08,
-	0x37, 0x7b, 0x8e, 0x0f, 0xf3, 0x54, 0x1e, 0xa1, 0x84, 0xfb, 0x3d, 0x59, 0xee, 0x28, 0xd2, 0x9e,
-	0x85, 0x48, 0x5f, 0x00, 0xf4, 0x2e, 0x61, 0x3a, 0x14, 0x06, 0x36, 0x39, 0x6d, 0x11, 0x1e, 0xeb,
-	0x47, 0x70, 0x6f, 0x28, 0xca, 0x43, 0x46, 0x39, 0x41, 0x15, 0x98, 0x49, 0x41, 0x96, 0x94, 0x35,
-	0xa5, 0x34, 0x67, 0xad, 0x1a, 0x39, 0x83, 0x34, 0xd2, 0xc4, 0xda, 0xed, 0x8b, 0x1f, 0xab, 0x05,
-	0x5b, 0x26, 0xe9, 0x2e, 0xdc, 0x17, 0x55, 0xab, 0x41, 0x70, 0xc4, 0x4e, 0x08, 0x3d, 0xc4, 0x7e,
-	0xd4, 0xb3, 0x44, 0x2f, 0x00, 0xfa, 0xe3, 0x90, 0xf5, 0x1f, 0x19, 0xe9, 0xec, 0x8c, 0x64, 0x76,
-	0x46, 0xfa, 0x05, 0xfb, 0x0e, 0x1e, 0x91, 0xb9, 0xf6, 0x40, 0xa6, 0x7e, 0xae, 0x80, 0x3a, 0xca,
-	0x45, 0xb6, 0x70, 0x00, 0x73, 0x71, 0x12, 0xad, 0x87, 0x49, 0x78, 0x49, 0x59, 0xbb, 0x55, 0x9a,
-	0xb3, 0xf4, 0xdc, 0x3e, 0xb2, 0x0a, 0x36, 0xc4, 0x59, 0x31, 0xf4, 0x72, 0x88, 0x75, 0x4a, 0xb0,
-	0x6e, 0xfc, 0x95, 0x35, 0x25, 0x18, 0x82, 0xad, 0xc0, 0xb2, 0x60, 0xcd, 0x6c, 0x6a, 0xed, 0x67,
-	0x84, 0xb2, 0x66, 0x6f, 0x28, 0x2b, 0x00, 0x0e, 0xa6, 0x27, 0xf5, 0x46, 0x12, 0x14, 0x43, 0x99,
-	0xb5, 0x67, 0x93, 0x88, 0x50, 0xe9, 0x0e, 0xac, 0xe4, 0xa4, 0xcb, 0x6e, 0xab, 0x00, 0xfd, 0x6e,
-	0xe5, 0x50, 0x27, 0x69, 0x76, 0x36, 0x6b, 0x56, 0x7f, 0x0d, 0xc5, 0xeb, 0x1e, 0xcf, 0xed, 0x03,
-	0xab, 0x5c, 0x6d, 0x34, 0x22, 0xc2, 0xb3, 0xcf, 0x57, 0x84, 0x79, 0x51, 0xac, 0x8e, 0xd3, 0xb8,
-	0x84, 0xbd, 0x23, 0x82, 0x52, 0xab, 0xfb, 0xb0, 0x3e, 0xbe, 0xd6, 0x7f, 0xc3, 0xb6, 0xae, 0xa6,
-	0x61, 0x5a, 0x78, 0xa1, 0x4f, 0x0a, 0xcc, 0xa4, 0xeb, 0x88, 0x1e, 0xe7, 0xd6, 0xf8, 0xf3, 0x06,
-	0xd4, 0xad, 0xc9, 0xc4, 0x29, 0xb2, 0xbe, 0xf1, 0xf1, 0xdb, 0xaf, 0x2f, 0x53, 0x0f, 0xd0, 0xaa,
-	0x39, 0xfe, 0x84, 0xd1, 0xb9, 0x02, 0xf3, 0x43, 0xab, 0x89, 0xac, 0xf1, 0x46, 0xa3, 0xae, 0x45,
-	0xdd, 0xb9, 0x51, 0x8e, 0x64, 0x2c, 0x0b, 0xc6, 0x4d, 0x54, 0xca, 0x65, 0xc4, 0x41, 0x50, 0x1f,
-	0x38, 0x0f, 0xf4, 0x55, 0x81, 0xbb, 0xd7, 0x97, 0x0b, 0x3d, 0x1d, 0xef, 0x9d, 0xb3, 0xcb, 0xea,
-	0xde, 0x4d, 0xd3, 0x24, 0xf5, 0xae, 0xa0, 0x36, 0xd0, 0x56, 0x2e, 0x75, 0x9f, 0xb8, 0xee, 0xb4,
-	0xd3, 0x5b, 0x41, 0x57, 0x0a, 0x2c, 0xe6, 0xac, 0x19, 0xda, 0x9f, 0x98, 0x64, 0xc4, 0xa6, 0xab,
-	0x95, 0x7f, 0xcc, 0x96, 0xed, 0xec, 0x8b, 0x76, 0xf6, 0xd0, 0xee, 0x84, 0xed, 0x0c, 0x5d, 0x55,
-	0x8d, 0x5c, 0x74, 0x34, 0xe5, 0xb2, 0xa3, 0x29, 0x3f, 0x3b, 0x9a, 0xf2, 0xb9, 0xab, 0x15, 0x2e,
-	0xbb, 0x5a, 0xe1, 0x7b, 0x57, 0x2b, 0xbc, 0x7f, 0xe3, 0xf9, 0xf1, 0x71, 0xcb, 0x31, 0x5c, 0xd6,
-	0x34, 0x5f, 0xf5, 0x2a, 0xbf, 0xc5, 0x0e, 0xef, 0xfb, 0x3c, 0x71, 0x59, 0x44, 0x06, 0xff, 0x3d,
-	0xc6, 0x3e, 0x35, 0x9b, 0xac, 0xd1, 0x0a, 0x08, 0x97, 0x10, 0x71, 0x3b, 0x24, 0xdc, 0x99, 0x11,
-	0x3f, 0x0e, 0x3b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xc5, 0x75, 0x7f, 0x4e, 0x07, 0x00,
-	0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x5b, 0x48, 0xc8, 0x5b, 0x5a, 0xd0, 0x10, 0xd1, 0xc4, 0xb4, 0x9b, 0xd4, 0x29,
+	0x4d, 0x54, 0x82, 0x9d, 0xb8, 0x21, 0x50, 0x68, 0x80, 0x24, 0xe5, 0x37, 0x87, 0x60, 0x55, 0x42,
+	0xe2, 0xb2, 0x1a, 0xdb, 0x23, 0xc7, 0xd4, 0x3b, 0xe3, 0xda, 0x4e, 0xaa, 0x5c, 0x39, 0x83, 0x04,
+	0x42, 0xfc, 0x0f, 0xa8, 0x12, 0xe2, 0xc8, 0x7f, 0x50, 0xf5, 0x58, 0x09, 0x0e, 0x9c, 0x00, 0x25,
+	0xfc, 0x21, 0x95, 0xc7, 0x63, 0xef, 0x7a, 0xe3, 0xd9, 0x5f, 0xca, 0x2d, 0xfb, 0xe6, 0xfd, 0xf8,
+	0x7c, 0xdf, 0x78, 0xde, 0x53, 0x60, 0x39, 0x60, 0xdf, 0x52, 0x37, 0x0d, 0x8e, 0xa8, 0x49, 0x63,
+	0xd7, 0x5a, 0x37, 0x8f, 0x36, 0x1c, 0x9a, 0x92, 0x0d, 0xf3, 0xc1, 0x21, 0x8d, 0x8f, 0x8d, 0x28,
+	0xe6, 0x29, 0xc7, 0x97, 0x4b, 0x27, 0x43, 0x38, 0x19, 0xd2, 0x49, 0x9b, 0xf3, 0xb9, 0xcf, 0x85,
+	0x8f, 0x99, 0xfd, 0x95, 0xbb, 0x6b, 0x57, 0x7c, 0xce, 0xfd, 0x90, 0x9a, 0x24, 0x0a, 0x4c, 0xc2,
+	0x18, 0x4f, 0x49, 0x1a, 0x70, 0x96, 0xc8, 0xd3, 0x96, 0xcb, 0x93, 0x0e, 0x4f, 0x4c, 0x87, 0x24,
+	0xb4, 0xac, 0xe6, 0xf2, 0x80, 0xc9, 0xf3, 0x9b, 0xbd, 0xe7, 0x82, 0xa2, 0xf4, 0x8a, 0x88, 0x1f,
+	0x30, 0x91, 0x4c, 0xfa, 0x5e, 0x57, 0xd1, 0x47, 0x24, 0x26, 0x9d, 0xa2, 0xe2, 0xeb, 0x2a, 0x2f,
+	0x9f, 0x32, 0x9a, 0x04, 0x85, 0x9b, 0xb2, 0x15, 0xb9, 0x66, 0xe1, 0xa4, 0xcf, 0x01, 0xfe, 0x2a,
+	0x63, 0xda, 0x17, 0x05, 0x6c, 0xfa, 0xe0, 0x90, 0x26, 0xa9, 0x7e, 0x0f, 0x5e, 0xa9, 0x58, 0x93,
+	0x88, 0xb3, 0x84, 0xe2, 0x6d, 0x98, 0xce, 0x41, 0xe6, 0xd1, 0x12, 0x5a, 0x6d, 0x5a, 0x8b, 0x86,
+	0xa2, 0x91, 0x46, 0x1e, 0xb8, 0xfb, 0xdc, 0x93, 0x7f, 0x16, 0xa7, 0x6c, 0x19, 0xa4, 0xbb, 0xb0,
+	0x20, 0xb2, 0xee, 0x84, 0xe1, 0x3d, 0x7e, 0x9f, 0xb2, 0x7d, 0x12, 0xc4, 0x45, 0x49, 0xfc, 0x31,
+	0x40, 0xb7, 0x1d, 0x32, 0xff, 0x0d, 0x23, 0xef, 0x9d, 0x91, 0xf5, 0xce, 0xc8, 0x6f, 0xb0, 0x5b,
+	0xc1, 0xa7, 0x32, 0xd6, 0xee, 0x89, 0xd4, 0x1f, 0x21, 0xd0, 0xea, 0xaa, 0x48, 0x09, 0x7b, 0xd0,
+	0x4c, 0x33, 0x6b, 0x3b, 0xca, 0xcc, 0xf3, 0x68, 0xe9, 0xc2, 0x6a, 0xd3, 0xd2, 0x95, 0x3a, 0xca,
+	0x0c, 0x36, 0xa4, 0x65, 0x32, 0xfc, 0x49, 0x85, 0xb5, 0x21, 0x58, 0x57, 0x86, 0xb2, 0xe6, 0x04,
+	0x15, 0xd8, 0x6d, 0xb8, 0x22, 0x58, 0xcb, 0x32, 0xbb, 0xc7, 0x77, 0x29, 0xe3, 0x9d, 0xa2, 0x29,
+	0x57, 0x01, 0x1c, 0xc2, 0xee, 0xb7, 0xbd, 0xcc, 0x28, 0x9a, 0x32, 0x6b, 0xcf, 0x66, 0x16, 0xe1,
+	0xa5, 0x3b, 0x70, 0x55, 0x11, 0x2e, 0xd5, 0xee, 0x00, 0x74, 0xd5, 0xca, 0xa6, 0x8e, 0x22, 0x76,
+	0xb6, 0x14, 0xab, 0x7f, 0x0e, 0xcb, 0xfd, 0x35, 0x3e, 0xb2, 0xf7, 0xac, 0xf5, 0x1d, 0xcf, 0x8b,
+	0x69, 0x52, 0x5e, 0xdf, 0x32, 0x5c, 0x14, 0xc9, 0xda, 0x24, 0xb7, 0x4b, 0xd8, 0x17, 0x85, 0x51,
+	0xfa, 0xea, 0x01, 0x5c, 0x1f, 0x9c, 0xeb, 0xfc, 0xb0, 0xdf, 0x93, 0x5f, 0xc1, 0xd7, 0x31, 0x89,
+	0x22, 0x1a, 0xf7, 0xd1, 0x0e, 0xe9, 0xeb, 0xaf, 0x08, 0x5e, 0xab, 0x8d, 0x96, 0x7c, 0x2b, 0xf0,
+	0xd2, 0xc3, 0xfc, 0xa4, 0x4f, 0xee, 0xa5, 0x87, 0x95, 0x80, 0x3e, 0x21, 0x8d, 0x09, 0x84, 0x64,
+	0xa8, 0x41, 0xd2, 0x8e, 0x28, 0xf3, 0x02, 0xe6, 0xcf, 0x5f, 0x58, 0x42, 0xab, 0x2f, 0xd8, 0xb3,
+	0x41, 0xb2, 0x9f, 0x1b, 0x74, 0x1d, 0x96, 0x04, 0xe9, 0x5d, 0x1a, 0xc5, 0xd4, 0x25, 0x29, 0xf5,
+	0xce, 0x3c, 0x2d, 0xfd, 0x07, 0x04, 0xd7, 0x06, 0x38, 0x49, 0x51, 0x07, 0xf0, 0xaa, 0x57, 0x9e,
+	0xb7, 0xcf, 0x3e, 0x92, 0x35, 0x25, 0x77, 0x4d, 0x5a, 0xf9, 0xf2, 0xe7, 0xbc, 0x9a, 0x8a, 0xfa,
+	0x2f, 0x08, 0x6e, 0xa8, 0x78, 0x3e, 0xe5, 0xa1, 0x47, 0xe3, 0xb1, 0x3e, 0xab, 0xbe, 0xd1, 0xd1,
+	0x98, 0x78, 0x74, 0xfc, 0x84, 0x60, 0x41, 0x89, 0x84, 0xe7, 0x61, 0xa6, 0x0a, 0x51, 0xfc, 0xc4,
+	0x8b, 0xd0, 0xa4, 0x47, 0x9d, 0x12, 0xb1, 0x21, 0x4e, 0x81, 0x1e, 0x75, 0x0a, 0xc0, 0xdb, 0x30,
+	0xe3, 0x90, 0x90, 0x30, 0x97, 0x8a, 0x0b, 0x6c, 0x5a, 0x0b, 0x15, 0xba, 0x82, 0x6b, 0x8f, 0x07,
+	0x4c, 0x36, 0xae, 0xf0, 0xd7, 0x1f, 0x23, 0x58, 0x19, 0xda, 0x2b, 0x79, 0x83, 0x36, 0xcc, 0x1c,
+	0xe4, 0x26, 0x79, 0x65, 0xd6, 0x38, 0x57, 0x96, 0x67, 0x2b, 0xea, 0xcb, 0x44, 0xe7, 0x36, 0xea,
+	0xac, 0xdf, 0x01, 0x9e, 0x17, 0x42, 0xf0, 0xf7, 0x08, 0xa6, 0xf3, 0xfd, 0x80, 0xdf, 0x50, 0x02,
+	0x9e, 0x5d, 0x4a, 0xda, 0xda, 0x68, 0xce, 0x79, 0x6d, 0x7d, 0xe5, 0xbb, 0x3f, 0xff, 0xff, 0xb9,
+	0x71, 0x0d, 0x2f, 0x9a, 0x83, 0x77, 0x2a, 0x7e, 0x84, 0xe0, 0x62, 0x65, 0x57, 0x60, 0x6b, 0x70,
+	0xa1, 0xba, 0xf5, 0xa5, 0xdd, 0x1a, 0x2b, 0x46, 0x32, 0xae, 0x0b, 0xc6, 0x9b, 0x78, 0x55, 0xc9,
+	0x48, 0xc2, 0xb0, 0xf7, 0x29, 0xe2, 0x3f, 0x10, 0xbc, 0xdc, 0x3f, 0xed, 0xf1, 0x5b, 0x83, 0x6b,
+	0x2b, 0x96, 0x8b, 0xb6, 0x35, 0x6e, 0x98, 0xa4, 0xde, 0x14, 0xd4, 0x06, 0x5e, 0x53, 0x52, 0x77,
+	0x89, 0xdb, 0xce, 0x71, 0x3e, 0x64, 0xf1, 0x5f, 0x08, 0x2e, 0x2b, 0xe6, 0x3e, 0xbe, 0x33, 0x32,
+	0x49, 0xcd, 0xea, 0xd1, 0xb6, 0x27, 0x8c, 0x96, 0x72, 0xee, 0x08, 0x39, 0x5b, 0x78, 0x73, 0x44,
+	0x39, 0x95, 0x79, 0x84, 0x7f, 0x43, 0x70, 0xa9, 0xba, 0x25, 0xf0, 0x90, 0x4f, 0xa1, 0x76, 0x23,
+	0x69, 0x9b, 0xe3, 0x05, 0x8d, 0xfc, 0x01, 0xf5, 0xed, 0x29, 0xfc, 0x18, 0xc1, 0x5c, 0xdd, 0x1a,
+	0xc0, 0xb7, 0x07, 0x03, 0x0c, 0xd8, 0x2f, 0xda, 0xbb, 0x93, 0x84, 0x4a, 0x05, 0x6f, 0x0b, 0x05,
+	0x1b, 0xd8, 0x54, 0x2a, 0xa8, 0x5f, 0x4a, 0xf8, 0x5f, 0x04, 0x9a, 0x7a, 0x26, 0xe2, 0x0f, 0xc6,
+	0x66, 0xaa, 0x6e, 0x1e, 0xed, 0xc3, 0xc9, 0x13, 0x48, 0x69, 0xef, 0x0b, 0x69, 0xef, 0xe0, 0xad,
+	0xf1, 0xa4, 0xb5, 0xe5, 0xe8, 0xdd, 0xa5, 0x4f, 0x4e, 0x5a, 0xe8, 0xe9, 0x49, 0x0b, 0xfd, 0x77,
+	0xd2, 0x42, 0x3f, 0x9e, 0xb6, 0xa6, 0x9e, 0x9e, 0xb6, 0xa6, 0xfe, 0x3e, 0x6d, 0x4d, 0x7d, 0xf3,
+	0x85, 0x1f, 0xa4, 0x07, 0x87, 0x8e, 0xe1, 0xf2, 0x8e, 0xf9, 0x59, 0x91, 0xfb, 0x4b, 0xe2, 0x24,
+	0xdd, 0x4a, 0x6f, 0xba, 0x3c, 0xa6, 0xbd, 0x3f, 0x0f, 0x48, 0xc0, 0xcc, 0x0e, 0xf7, 0x0e, 0x43,
+	0x9a, 0x48, 0x8c, 0xf4, 0x38, 0xa2, 0x89, 0x33, 0x2d, 0xfe, 0x11, 0xb8, 0xf5, 0x6c, 0x00, 0xc1,
+	0xf6, 0x6d, 0xc2, 0x3a, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -480,6 +880,21 @@ type QueryClient interface {
 	// TokenPairByERC20Address defines a gRPC query method that returns the erc20
 	// module's token pair associated with the provided erc20 contract address.
 	TokenPairByERC20Address(ctx context.Context, in *QueryTokenPairByERC20AddressRequest, opts ...grpc.CallOption) (*QueryTokenPairByERC20AddressResponse, error)
+	// WrapperAddress defines a gRPC query method that returns the deterministic
+	// address of the ERC-20 wrapper of the provided bank denom, and whether it
+	// has been created yet.
+	WrapperAddress(ctx context.Context, in *QueryWrapperAddressRequest, opts ...grpc.CallOption) (*QueryWrapperAddressResponse, error)
+	// DeprecatedTokenPairs defines a gRPC query method that returns the former
+	// ERC-20 contracts of migrated or retired token pairs whose conversion
+	// window is still open.
+	DeprecatedTokenPairs(ctx context.Context, in *QueryDeprecatedTokenPairsRequest, opts ...grpc.CallOption) (*QueryDeprecatedTokenPairsResponse, error)
+	// DeprecatedTokenPairHolders defines a gRPC query method that returns the
+	// accounts with EVM code (contracts) holding a positive bank balance of the
+	// bank denom of a deprecated ERC-20 contract, with their total bank balance
+	// of the denom. Such contracts may only be able to move the denom through
+	// the deprecated contract, but they're reported whether they use it or not.
+	// Externally owned accounts and ERC-20 allowances aren't reported.
+	DeprecatedTokenPairHolders(ctx context.Context, in *QueryDeprecatedTokenPairHoldersRequest, opts ...grpc.CallOption) (*QueryDeprecatedTokenPairHoldersResponse, error)
 }
 
 type queryClient struct {
@@ -526,6 +941,33 @@ func (c *queryClient) TokenPairByERC20Address(ctx context.Context, in *QueryToke
 	return out, nil
 }
 
+func (c *queryClient) WrapperAddress(ctx context.Context, in *QueryWrapperAddressRequest, opts ...grpc.CallOption) (*QueryWrapperAddressResponse, error) {
+	out := new(QueryWrapperAddressResponse)
+	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Query/WrapperAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeprecatedTokenPairs(ctx context.Context, in *QueryDeprecatedTokenPairsRequest, opts ...grpc.CallOption) (*QueryDeprecatedTokenPairsResponse, error) {
+	out := new(QueryDeprecatedTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Query/DeprecatedTokenPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeprecatedTokenPairHolders(ctx context.Context, in *QueryDeprecatedTokenPairHoldersRequest, opts ...grpc.CallOption) (*QueryDeprecatedTokenPairHoldersResponse, error) {
+	out := new(QueryDeprecatedTokenPairHoldersResponse)
+	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Query/DeprecatedTokenPairHolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the erc20 module's
@@ -540,6 +982,21 @@ type QueryServer interface {
 	// TokenPairByERC20Address defines a gRPC query method that returns the erc20
 	// module's token pair associated with the provided erc20 contract address.
 	TokenPairByERC20Address(context.Context, *QueryTokenPairByERC20AddressRequest) (*QueryTokenPairByERC20AddressResponse, error)
+	// WrapperAddress defines a gRPC query method that returns the deterministic
+	// address of the ERC-20 wrapper of the provided bank denom, and whether it
+	// has been created yet.
+	WrapperAddress(context.Context, *QueryWrapperAddressRequest) (*QueryWrapperAddressResponse, error)
+	// DeprecatedTokenPairs defines a gRPC query method that returns the former
+	// ERC-20 contracts of migrated or retired token pairs whose conversion
+	// window is still open.
+	DeprecatedTokenPairs(context.Context, *QueryDeprecatedTokenPairsRequest) (*QueryDeprecatedTokenPairsResponse, error)
+	// DeprecatedTokenPairHolders defines a gRPC query method that returns the
+	// accounts with EVM code (contracts) holding a positive bank balance of the
+	// bank denom of a deprecated ERC-20 contract, with their total bank balance
+	// of the denom. Such contracts may only be able to move the denom through
+	// the deprecated contract, but they're reported whether they use it or not.
+	// Externally owned accounts and ERC-20 allowances aren't reported.
+	DeprecatedTokenPairHolders(context.Context, *QueryDeprecatedTokenPairHoldersRequest) (*QueryDeprecatedTokenPairHoldersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -558,6 +1015,15 @@ func (*UnimplementedQueryServer) TokenPairByDenom(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) TokenPairByERC20Address(ctx context.Context, req *QueryTokenPairByERC20AddressRequest) (*QueryTokenPairByERC20AddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPairByERC20Address not implemented")
 }
+func (*UnimplementedQueryServer) WrapperAddress(ctx context.Context, req *QueryWrapperAddressRequest) (*QueryWrapperAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrapperAddress not implemented")
+}
+func (*UnimplementedQueryServer) DeprecatedTokenPairs(ctx context.Context, req *QueryDeprecatedTokenPairsRequest) (*QueryDeprecatedTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecatedTokenPairs not implemented")
+}
+func (*UnimplementedQueryServer) DeprecatedTokenPairHolders(ctx context.Context, req *QueryDeprecatedTokenPairHoldersRequest) (*QueryDeprecatedTokenPairHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecatedTokenPairHolders not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -635,27 +1101,93 @@ func _Query_TokenPairByERC20Address_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.erc20.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AllTokenPairs",
-			Handler:    _Query_AllTokenPairs_Handler,
-		},
-		{
-			MethodName: "TokenPairByDenom",
-			Handler:    _Query_TokenPairByDenom_Handler,
-		},
-		{
-			MethodName: "TokenPairByERC20Address",
-			Handler:    _Query_TokenPairByERC20Address_Handler,
-		},
-	},
+func _Query_WrapperAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWrapperAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WrapperAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.erc20.v1beta1.Query/WrapperAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WrapperAddress(ctx, req.(*QueryWrapperAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeprecatedTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeprecatedTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeprecatedTokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.erc20.v1beta1.Query/DeprecatedTokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeprecatedTokenPairs(ctx, req.(*QueryDeprecatedTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeprecatedTokenPairHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeprecatedTokenPairHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeprecatedTokenPairHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.erc20.v1beta1.Query/DeprecatedTokenPairHolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeprecatedTokenPairHolders(ctx, req.(*QueryDeprecatedTokenPairHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.erc20.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllTokenPairs",
+			Handler:    _Query_AllTokenPairs_Handler,
+		},
+		{
+			MethodName: "TokenPairByDenom",
+			Handler:    _Query_TokenPairByDenom_Handler,
+		},
+		{
+			MethodName: "TokenPairByERC20Address",
+			Handler:    _Query_TokenPairByERC20Address_Handler,
+		},
+		{
+			MethodName: "WrapperAddress",
+			Handler:    _Query_WrapperAddress_Handler,
+		},
+		{
+			MethodName: "DeprecatedTokenPairs",
+			Handler:    _Query_DeprecatedTokenPairs_Handler,
+		},
+		{
+			MethodName: "DeprecatedTokenPairHolders",
+			Handler:    _Query_DeprecatedTokenPairHolders_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/erc20/v1beta1/query.proto",
 }
@@ -930,156 +1462,1393 @@ func (m *QueryTokenPairByERC20AddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWrapperAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryWrapperAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWrapperAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWrapperAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWrapperAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWrapperAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokenPairs) > 0 {
-		for _, e := range m.TokenPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IsPending {
+		i--
+		if m.IsPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TokenPair != nil {
+		{
+			size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.WrapperAddress) > 0 {
+		i -= len(m.WrapperAddress)
+		copy(dAtA[i:], m.WrapperAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WrapperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BankDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDeprecatedTokenPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenPairByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TokenPair != nil {
-		l = m.TokenPair.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDeprecatedTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokenPairByERC20AddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDeprecatedTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairByERC20AddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDeprecatedTokenPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeprecatedTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeprecatedTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TokenPair != nil {
-		l = m.TokenPair.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DeprecatedTokenPairs) > 0 {
+		for iNdEx := len(m.DeprecatedTokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeprecatedTokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDeprecatedTokenPairHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDeprecatedTokenPairHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
+
+func (m *QueryDeprecatedTokenPairHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeprecatedTokenPairHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecatedTokenPairHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeprecatedTokenPairHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenPair != nil {
+		l = m.TokenPair.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByERC20AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairByERC20AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenPair != nil {
+		l = m.TokenPair.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWrapperAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWrapperAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WrapperAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TokenPair != nil {
+		l = m.TokenPair.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDeprecatedTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeprecatedTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeprecatedTokenPairs) > 0 {
+		for _, e := range m.DeprecatedTokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeprecatedTokenPairHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DeprecatedTokenPairHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeprecatedTokenPairHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, &TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenPair == nil {
+				m.TokenPair = &TokenPair{}
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByERC20AddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByERC20AddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairByERC20AddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairByERC20AddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairByERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenPair == nil {
+				m.TokenPair = &TokenPair{}
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrapperAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrapperAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrapperAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWrapperAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWrapperAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWrapperAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrapperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrapperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenPair == nil {
+				m.TokenPair = &TokenPair{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1101,7 +2870,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeprecatedTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1124,45 +2893,12 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1184,7 +2920,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeprecatedTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,15 +2943,15 @@ func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedTokenPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1242,10 +2978,8 @@ func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeprecatedTokenPairs = append(m.DeprecatedTokenPairs, DeprecatedTokenPair{})
+			if err := m.DeprecatedTokenPairs[len(m.DeprecatedTokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1270,7 +3004,7 @@ func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeprecatedTokenPairHoldersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,17 +3027,17 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairHoldersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1313,25 +3047,23 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, &TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1363,7 +3095,7 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1390,7 +3122,7 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *DeprecatedTokenPairHolder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,15 +3145,15 @@ func (m *QueryTokenPairByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeprecatedTokenPairHolder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeprecatedTokenPairHolder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1449,61 +3181,43 @@ func (m *QueryTokenPairByDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTokenPairByDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1530,10 +3244,7 @@ func (m *QueryTokenPairByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenPair == nil {
-				m.TokenPair = &TokenPair{}
-			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1558,7 +3269,7 @@ func (m *QueryTokenPairByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairByERC20AddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeprecatedTokenPairHoldersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1581,17 +3292,17 @@ func (m *QueryTokenPairByERC20AddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByERC20AddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairHoldersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeprecatedTokenPairHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1601,77 +3312,29 @@ func (m *QueryTokenPairByERC20AddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Holders = append(m.Holders, DeprecatedTokenPairHolder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTokenPairByERC20AddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairByERC20AddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairByERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1698,10 +3361,10 @@ func (m *QueryTokenPairByERC20AddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenPair == nil {
-				m.TokenPair = &TokenPair{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -309,6 +309,211 @@ func (m *MsgDeleteTokenPairResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteTokenPairResponse proto.InternalMessageInfo
 
+// MsgBackfillTokenPairs queues the creation of the ERC-20 wrappers of all the
+// existing peggy and IBC denoms that don't have a token pair yet. The wrappers
+// are deployed at their deterministic addresses in the following EndBlockers.
+type MsgBackfillTokenPairs struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgBackfillTokenPairs) Reset()         { *m = MsgBackfillTokenPairs{} }
+func (m *MsgBackfillTokenPairs) String() string { return proto.CompactTextString(m) }
+func (*MsgBackfillTokenPairs) ProtoMessage()    {}
+func (*MsgBackfillTokenPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be4c1f5401622e51, []int{6}
+}
+func (m *MsgBackfillTokenPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBackfillTokenPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBackfillTokenPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBackfillTokenPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBackfillTokenPairs.Merge(m, src)
+}
+func (m *MsgBackfillTokenPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBackfillTokenPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBackfillTokenPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBackfillTokenPairs proto.InternalMessageInfo
+
+func (m *MsgBackfillTokenPairs) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+type MsgBackfillTokenPairsResponse struct {
+	// number of bank denoms queued for the creation of their ERC-20 wrapper
+	QueuedDenoms uint64 `protobuf:"varint,1,opt,name=queued_denoms,json=queuedDenoms,proto3" json:"queued_denoms,omitempty"`
+}
+
+func (m *MsgBackfillTokenPairsResponse) Reset()         { *m = MsgBackfillTokenPairsResponse{} }
+func (m *MsgBackfillTokenPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBackfillTokenPairsResponse) ProtoMessage()    {}
+func (*MsgBackfillTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be4c1f5401622e51, []int{7}
+}
+func (m *MsgBackfillTokenPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBackfillTokenPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBackfillTokenPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBackfillTokenPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBackfillTokenPairsResponse.Merge(m, src)
+}
+func (m *MsgBackfillTokenPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBackfillTokenPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBackfillTokenPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBackfillTokenPairsResponse proto.InternalMessageInfo
+
+func (m *MsgBackfillTokenPairsResponse) GetQueuedDenoms() uint64 {
+	if m != nil {
+		return m.QueuedDenoms
+	}
+	return 0
+}
+
+// MsgMigrateTokenPair re-points a bank denom to a new ERC-20 contract, or
+// retires its token pair if no new contract is provided. The former ERC-20
+// contract keeps operating on the balances of the bank denom until the end of
+// the conversion window, so that its holders can move to the new contract.
+type MsgMigrateTokenPair struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// bank denom of the pair to be migrated
+	BankDenom string `protobuf:"bytes,2,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// address of the new ERC-20 contract, empty to retire the pair
+	NewErc20Address string `protobuf:"bytes,3,opt,name=new_erc20_address,json=newErc20Address,proto3" json:"new_erc20_address,omitempty"`
+	// duration (in seconds) of the conversion window
+	ConversionWindow int64 `protobuf:"varint,4,opt,name=conversion_window,json=conversionWindow,proto3" json:"conversion_window,omitempty"`
+}
+
+func (m *MsgMigrateTokenPair) Reset()         { *m = MsgMigrateTokenPair{} }
+func (m *MsgMigrateTokenPair) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTokenPair) ProtoMessage()    {}
+func (*MsgMigrateTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be4c1f5401622e51, []int{8}
+}
+func (m *MsgMigrateTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTokenPair.Merge(m, src)
+}
+func (m *MsgMigrateTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateTokenPair proto.InternalMessageInfo
+
+func (m *MsgMigrateTokenPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMigrateTokenPair) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *MsgMigrateTokenPair) GetNewErc20Address() string {
+	if m != nil {
+		return m.NewErc20Address
+	}
+	return ""
+}
+
+func (m *MsgMigrateTokenPair) GetConversionWindow() int64 {
+	if m != nil {
+		return m.ConversionWindow
+	}
+	return 0
+}
+
+type MsgMigrateTokenPairResponse struct {
+}
+
+func (m *MsgMigrateTokenPairResponse) Reset()         { *m = MsgMigrateTokenPairResponse{} }
+func (m *MsgMigrateTokenPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTokenPairResponse) ProtoMessage()    {}
+func (*MsgMigrateTokenPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be4c1f5401622e51, []int{9}
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTokenPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTokenPairResponse.Merge(m, src)
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTokenPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTokenPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateTokenPairResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "injective.erc20.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.erc20.v1beta1.MsgUpdateParamsResponse")
@@ -316,48 +521,63 @@ func init() {
 	proto.RegisterType((*MsgCreateTokenPairResponse)(nil), "injective.erc20.v1beta1.MsgCreateTokenPairResponse")
 	proto.RegisterType((*MsgDeleteTokenPair)(nil), "injective.erc20.v1beta1.MsgDeleteTokenPair")
 	proto.RegisterType((*MsgDeleteTokenPairResponse)(nil), "injective.erc20.v1beta1.MsgDeleteTokenPairResponse")
+	proto.RegisterType((*MsgBackfillTokenPairs)(nil), "injective.erc20.v1beta1.MsgBackfillTokenPairs")
+	proto.RegisterType((*MsgBackfillTokenPairsResponse)(nil), "injective.erc20.v1beta1.MsgBackfillTokenPairsResponse")
+	proto.RegisterType((*MsgMigrateTokenPair)(nil), "injective.erc20.v1beta1.MsgMigrateTokenPair")
+	proto.RegisterType((*MsgMigrateTokenPairResponse)(nil), "injective.erc20.v1beta1.MsgMigrateTokenPairResponse")
 }
 
 func init() { proto.RegisterFile("injective/erc20/v1beta1/tx.proto", fileDescriptor_be4c1f5401622e51) }
 
 var fileDescriptor_be4c1f5401622e51 = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x8b, 0xd3, 0x4e,
-	0x18, 0xc6, 0x9b, 0xfd, 0xff, 0x2d, 0x74, 0x54, 0x96, 0x0d, 0x2b, 0xed, 0x06, 0x4d, 0x4b, 0xf4,
-	0x50, 0x2b, 0x9b, 0x6c, 0xbb, 0xe0, 0x61, 0xc1, 0x83, 0x75, 0x41, 0x44, 0x0b, 0x4b, 0xd5, 0x8b,
-	0x97, 0x32, 0x4d, 0x5e, 0xd2, 0xec, 0x36, 0x99, 0x30, 0x33, 0x2d, 0xf6, 0x26, 0x9e, 0xc4, 0x93,
-	0x1f, 0xa5, 0x88, 0x1f, 0x62, 0x8f, 0x8b, 0x27, 0x4f, 0x8b, 0xb4, 0x87, 0xde, 0xfd, 0x04, 0x92,
-	0xcc, 0x24, 0xbb, 0xce, 0x92, 0xa2, 0x7b, 0x69, 0x3b, 0xcf, 0xfb, 0xe4, 0x7d, 0xde, 0xdf, 0xdb,
-	0x21, 0xa8, 0x11, 0x44, 0xc7, 0xe0, 0xf2, 0x60, 0x0a, 0x0e, 0x50, 0xb7, 0xb3, 0xe7, 0x4c, 0xdb,
-	0x43, 0xe0, 0xb8, 0xed, 0xf0, 0xf7, 0x76, 0x4c, 0x09, 0x27, 0x7a, 0x35, 0x77, 0xd8, 0xa9, 0xc3,
-	0x96, 0x0e, 0x63, 0xdb, 0x27, 0x3e, 0x49, 0x3d, 0x4e, 0xf2, 0x4b, 0xd8, 0x0d, 0xd3, 0x25, 0x2c,
-	0x24, 0xcc, 0x19, 0x62, 0x06, 0x79, 0x33, 0x97, 0x04, 0xd1, 0x95, 0x7a, 0x74, 0x92, 0xd7, 0x93,
-	0x83, 0xac, 0x57, 0x65, 0x3d, 0x64, 0xbe, 0x33, 0x6d, 0x27, 0x5f, 0xb2, 0xb0, 0x23, 0x0a, 0x03,
-	0x91, 0x28, 0x0e, 0xb2, 0xf4, 0xa0, 0x08, 0x22, 0xc6, 0x14, 0x87, 0x99, 0xeb, 0x7e, 0x91, 0x4b,
-	0x60, 0x09, 0xd3, 0x16, 0x0e, 0x83, 0x88, 0x38, 0xe9, 0xa7, 0x90, 0xac, 0xaf, 0x1a, 0xda, 0xec,
-	0x31, 0xff, 0x6d, 0xec, 0x61, 0x0e, 0x47, 0x69, 0x47, 0xfd, 0x31, 0xaa, 0xe0, 0x09, 0x1f, 0x11,
-	0x1a, 0xf0, 0x59, 0x4d, 0x6b, 0x68, 0xcd, 0x4a, 0xb7, 0xf6, 0xfd, 0xdb, 0xee, 0xb6, 0x1c, 0xeb,
-	0xa9, 0xe7, 0x51, 0x60, 0xec, 0x35, 0xa7, 0x41, 0xe4, 0xf7, 0x2f, 0xac, 0xfa, 0x13, 0x54, 0x16,
-	0x33, 0xd5, 0x36, 0x1a, 0x5a, 0xf3, 0x66, 0xa7, 0x6e, 0x17, 0x6c, 0xd7, 0x16, 0x41, 0xdd, 0xff,
-	0x4f, 0xcf, 0xeb, 0xa5, 0xbe, 0x7c, 0xe8, 0xa0, 0xf9, 0x71, 0x35, 0x6f, 0x5d, 0xb4, 0xfb, 0xbc,
-	0x9a, 0xb7, 0xee, 0x08, 0x16, 0x65, 0x40, 0x6b, 0x07, 0x55, 0x15, 0xa9, 0x0f, 0x2c, 0x26, 0x11,
-	0x83, 0x84, 0x47, 0xef, 0x31, 0xff, 0x19, 0x05, 0xcc, 0xe1, 0x0d, 0x39, 0x81, 0xe8, 0x08, 0x07,
-	0x54, 0x7f, 0x88, 0xca, 0x0c, 0x22, 0x0f, 0xa8, 0xe4, 0xd9, 0xfa, 0x75, 0x5e, 0xbf, 0x3d, 0xc3,
-	0xe1, 0xf8, 0xc0, 0x12, 0xba, 0xd5, 0x97, 0x06, 0xfd, 0x39, 0x42, 0x3c, 0x79, 0x6e, 0x10, 0xe3,
-	0x80, 0x4a, 0x12, 0xab, 0x90, 0x24, 0x8f, 0x90, 0x30, 0x15, 0x9e, 0x09, 0x82, 0x47, 0x76, 0x4d,
-	0x60, 0x6a, 0x39, 0x8c, 0x32, 0x9d, 0x05, 0xc8, 0xb8, 0xaa, 0x66, 0x48, 0xca, 0x40, 0xda, 0xb5,
-	0x07, 0xb2, 0x3e, 0x89, 0xdd, 0x1c, 0xc2, 0x18, 0xae, 0xb9, 0x9b, 0x7b, 0x08, 0x25, 0xb7, 0x79,
-	0xe0, 0x41, 0x44, 0xc2, 0x74, 0x37, 0x95, 0x7e, 0x25, 0x51, 0x0e, 0x13, 0x61, 0x0d, 0xb1, 0x92,
-	0x69, 0xdd, 0x4d, 0x89, 0x15, 0x35, 0x23, 0xee, 0x2c, 0x36, 0xd0, 0x7f, 0x3d, 0xe6, 0xeb, 0xc7,
-	0xe8, 0xd6, 0x1f, 0x17, 0xb3, 0x59, 0x48, 0xad, 0x5c, 0x07, 0x63, 0xef, 0x6f, 0x9d, 0xf9, 0x96,
-	0x19, 0xda, 0x54, 0x2f, 0xcd, 0xa3, 0x75, 0x4d, 0x14, 0xb3, 0xb1, 0xff, 0x0f, 0xe6, 0xcb, 0xa1,
-	0xea, 0xbf, 0xb1, 0x36, 0x54, 0x31, 0xaf, 0x0f, 0x2d, 0xd8, 0xae, 0x71, 0xe3, 0xc3, 0x6a, 0xde,
-	0xd2, 0xba, 0x70, 0xba, 0x30, 0xb5, 0xb3, 0x85, 0xa9, 0xfd, 0x5c, 0x98, 0xda, 0x97, 0xa5, 0x59,
-	0x3a, 0x5b, 0x9a, 0xa5, 0x1f, 0x4b, 0xb3, 0xf4, 0xee, 0xa5, 0x1f, 0xf0, 0xd1, 0x64, 0x68, 0xbb,
-	0x24, 0x74, 0x5e, 0x64, 0xfd, 0x5f, 0xe1, 0x21, 0x73, 0xf2, 0xb4, 0x5d, 0x97, 0x50, 0xb8, 0x7c,
-	0x1c, 0xe1, 0x20, 0x72, 0x42, 0xe2, 0x4d, 0xc6, 0xc0, 0xe4, 0x1b, 0x88, 0xcf, 0x62, 0x60, 0xc3,
-	0x72, 0xfa, 0x9e, 0xd9, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x9b, 0x3e, 0x9d, 0x8c, 0x05,
-	0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x1f, 0x79, 0x48, 0x99, 0x07, 0x82, 0xf8, 0x81, 0x08, 0xe6, 0x61, 0x22, 0xf3, 0x16,
+	0x69, 0x00, 0x9b, 0x8f, 0x8a, 0x45, 0xa4, 0x2e, 0x9a, 0x52, 0x55, 0x55, 0x1b, 0x09, 0xb9, 0xad,
+	0x2a, 0x75, 0x13, 0x4d, 0xec, 0xa9, 0x19, 0x88, 0x67, 0x52, 0x8f, 0x93, 0x14, 0xa9, 0x52, 0xab,
+	0xae, 0xaa, 0xae, 0xfa, 0x53, 0x50, 0xd5, 0x1f, 0xc1, 0x12, 0x75, 0xc5, 0x0a, 0x55, 0xb0, 0x60,
+	0xdf, 0xfe, 0x81, 0xca, 0x9e, 0xc9, 0x00, 0x36, 0x89, 0x9a, 0x6c, 0x12, 0xcf, 0xb9, 0xc7, 0xf7,
+	0xdc, 0x73, 0xe7, 0x8e, 0x07, 0x14, 0x31, 0xd9, 0x47, 0x4e, 0x88, 0x3b, 0xc8, 0x42, 0x81, 0xb3,
+	0xb9, 0x6e, 0x75, 0x36, 0x1a, 0x28, 0x84, 0x1b, 0x56, 0xf8, 0xd6, 0x6c, 0x05, 0x34, 0xa4, 0xea,
+	0x9c, 0x64, 0x98, 0x31, 0xc3, 0x14, 0x0c, 0x6d, 0xc6, 0xa3, 0x1e, 0x8d, 0x39, 0x56, 0xf4, 0xc4,
+	0xe9, 0x9a, 0xee, 0x50, 0xe6, 0x53, 0x66, 0x35, 0x20, 0x43, 0x32, 0x99, 0x43, 0x31, 0x49, 0xc5,
+	0xc9, 0x81, 0x8c, 0x47, 0x0b, 0x11, 0x9f, 0x13, 0x71, 0x9f, 0x79, 0x56, 0x67, 0x23, 0xfa, 0x13,
+	0x81, 0x79, 0x1e, 0xa8, 0x73, 0x45, 0xbe, 0x10, 0xa1, 0xff, 0xfb, 0x99, 0x68, 0xc1, 0x00, 0xfa,
+	0x3d, 0xd6, 0x72, 0x3f, 0x16, 0xb7, 0xc5, 0x49, 0x79, 0xe8, 0x63, 0x42, 0xad, 0xf8, 0x97, 0x43,
+	0xc6, 0x57, 0x05, 0x4c, 0xd5, 0x98, 0xf7, 0xa2, 0xe5, 0xc2, 0x10, 0xed, 0xc6, 0x19, 0xd5, 0x6d,
+	0x90, 0x83, 0xed, 0x70, 0x8f, 0x06, 0x38, 0x3c, 0x2c, 0x28, 0x45, 0xa5, 0x94, 0xab, 0x16, 0xbe,
+	0x7f, 0x5b, 0x9b, 0x11, 0x65, 0xdd, 0x77, 0xdd, 0x00, 0x31, 0xf6, 0x2c, 0x0c, 0x30, 0xf1, 0xec,
+	0x2b, 0xaa, 0x7a, 0x0f, 0x8c, 0xf3, 0x9a, 0x0a, 0x7f, 0x15, 0x95, 0xd2, 0x3f, 0x9b, 0x4b, 0x66,
+	0x9f, 0xee, 0x9a, 0x5c, 0xa8, 0x9a, 0x3d, 0x3e, 0x5b, 0xca, 0xd8, 0xe2, 0xa5, 0x4a, 0xe9, 0xe3,
+	0xe5, 0x51, 0xf9, 0x2a, 0xdd, 0xe7, 0xcb, 0xa3, 0xf2, 0x2c, 0xf7, 0x92, 0x28, 0xd0, 0x98, 0x07,
+	0x73, 0x09, 0xc8, 0x46, 0xac, 0x45, 0x09, 0x43, 0x91, 0x1f, 0xb5, 0xc6, 0xbc, 0x07, 0x01, 0x82,
+	0x21, 0x7a, 0x4e, 0x0f, 0x10, 0xd9, 0x85, 0x38, 0x50, 0xef, 0x80, 0x71, 0x86, 0x88, 0x8b, 0x02,
+	0xe1, 0x27, 0xff, 0xf3, 0x6c, 0x69, 0xf2, 0x10, 0xfa, 0xcd, 0x8a, 0xc1, 0x71, 0xc3, 0x16, 0x04,
+	0xf5, 0x11, 0x00, 0x61, 0xf4, 0x5e, 0xbd, 0x05, 0x71, 0x20, 0x9c, 0x18, 0x7d, 0x9d, 0x48, 0x09,
+	0x61, 0x26, 0x17, 0xf6, 0x00, 0xee, 0x47, 0x64, 0x8d, 0xcc, 0x14, 0xa4, 0x99, 0x44, 0x75, 0x06,
+	0x02, 0x5a, 0x1a, 0xed, 0x59, 0x4a, 0x14, 0xa4, 0x8c, 0x5c, 0x90, 0xf1, 0x89, 0xf7, 0x66, 0x07,
+	0x35, 0xd1, 0x88, 0xbd, 0x59, 0x04, 0x20, 0x9a, 0xe6, 0xba, 0x8b, 0x08, 0xf5, 0xe3, 0xde, 0xe4,
+	0xec, 0x5c, 0x84, 0xec, 0x44, 0xc0, 0x00, 0xc7, 0x09, 0x4d, 0xe3, 0x3f, 0xa0, 0xa5, 0x51, 0xb9,
+	0x89, 0xef, 0xc1, 0x6c, 0x8d, 0x79, 0x55, 0xe8, 0x1c, 0xbc, 0xc6, 0xcd, 0xa6, 0x8c, 0x8f, 0x3c,
+	0x99, 0x15, 0x33, 0x3d, 0x5a, 0x0b, 0xb2, 0xb6, 0xb4, 0x8e, 0xb1, 0x03, 0x16, 0x6f, 0x0d, 0xc8,
+	0x3d, 0x59, 0x06, 0x93, 0x6f, 0xda, 0xa8, 0x8d, 0x5c, 0xde, 0x0a, 0x16, 0x17, 0x93, 0xb5, 0x27,
+	0x38, 0x18, 0x77, 0x83, 0x19, 0xbf, 0x14, 0xf0, 0x6f, 0x8d, 0x79, 0x35, 0xec, 0x05, 0x37, 0x86,
+	0x71, 0xd4, 0xf3, 0x35, 0xb8, 0xfb, 0x6a, 0x19, 0xe4, 0x09, 0xea, 0xd6, 0x63, 0x5f, 0x75, 0xc8,
+	0x93, 0x14, 0xc6, 0x62, 0xd6, 0x14, 0x41, 0xdd, 0x87, 0x11, 0x2e, 0x72, 0xab, 0x2b, 0x20, 0xef,
+	0x50, 0xd2, 0x41, 0x01, 0xc3, 0x94, 0xd4, 0xbb, 0x98, 0xb8, 0xb4, 0x5b, 0xc8, 0x16, 0x95, 0xd2,
+	0x98, 0x3d, 0x7d, 0x15, 0x78, 0x19, 0xe3, 0x95, 0xd5, 0x74, 0xf7, 0xe6, 0x65, 0xf7, 0x92, 0xee,
+	0x8c, 0x45, 0xb0, 0x70, 0x0b, 0xdc, 0xeb, 0xdc, 0xe6, 0x69, 0x16, 0x8c, 0xd5, 0x98, 0xa7, 0xee,
+	0x83, 0x89, 0x1b, 0x1f, 0x9d, 0x52, 0xdf, 0x89, 0x4e, 0x1c, 0x75, 0x6d, 0xfd, 0x4f, 0x99, 0x72,
+	0xb7, 0x18, 0x98, 0x4a, 0x7e, 0x10, 0x56, 0x06, 0x25, 0x49, 0x90, 0xb5, 0xad, 0x21, 0xc8, 0xd7,
+	0x45, 0x93, 0x27, 0x6d, 0xa0, 0x68, 0x82, 0xac, 0x6d, 0x0d, 0x41, 0x96, 0xa2, 0xef, 0x80, 0x7a,
+	0xcb, 0xb1, 0x31, 0x07, 0xa5, 0x4a, 0xf3, 0xb5, 0xed, 0xe1, 0xf8, 0x52, 0xbd, 0x03, 0xa6, 0x53,
+	0xc3, 0xbe, 0x3a, 0x28, 0x57, 0x92, 0xad, 0xdd, 0x1d, 0x86, 0xdd, 0xd3, 0xd5, 0xfe, 0xfe, 0x70,
+	0x79, 0x54, 0x56, 0xaa, 0xe8, 0xf8, 0x5c, 0x57, 0x4e, 0xce, 0x75, 0xe5, 0xc7, 0xb9, 0xae, 0x7c,
+	0xb9, 0xd0, 0x33, 0x27, 0x17, 0x7a, 0xe6, 0xf4, 0x42, 0xcf, 0xbc, 0x7a, 0xe2, 0xe1, 0x70, 0xaf,
+	0xdd, 0x30, 0x1d, 0xea, 0x5b, 0x8f, 0x7b, 0x02, 0x4f, 0x61, 0x83, 0x59, 0x52, 0x6e, 0xcd, 0xa1,
+	0x01, 0xba, 0xbe, 0xdc, 0x83, 0x98, 0x58, 0x3e, 0x75, 0xdb, 0x4d, 0xc4, 0xc4, 0x9d, 0x1a, 0x1e,
+	0xb6, 0x10, 0x6b, 0x8c, 0xc7, 0x37, 0xe7, 0xd6, 0xef, 0x01, 0x00, 0x8a, 0xe4, 0xc3, 0xa4, 0x5e,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -375,6 +595,8 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	CreateTokenPair(ctx context.Context, in *MsgCreateTokenPair, opts ...grpc.CallOption) (*MsgCreateTokenPairResponse, error)
 	DeleteTokenPair(ctx context.Context, in *MsgDeleteTokenPair, opts ...grpc.CallOption) (*MsgDeleteTokenPairResponse, error)
+	BackfillTokenPairs(ctx context.Context, in *MsgBackfillTokenPairs, opts ...grpc.CallOption) (*MsgBackfillTokenPairsResponse, error)
+	MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error)
 }
 
 type msgClient struct {
@@ -412,11 +634,31 @@ func (c *msgClient) DeleteTokenPair(ctx context.Context, in *MsgDeleteTokenPair,
 	return out, nil
 }
 
+func (c *msgClient) BackfillTokenPairs(ctx context.Context, in *MsgBackfillTokenPairs, opts ...grpc.CallOption) (*MsgBackfillTokenPairsResponse, error) {
+	out := new(MsgBackfillTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Msg/BackfillTokenPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error) {
+	out := new(MsgMigrateTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Msg/MigrateTokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	CreateTokenPair(context.Context, *MsgCreateTokenPair) (*MsgCreateTokenPairResponse, error)
 	DeleteTokenPair(context.Context, *MsgDeleteTokenPair) (*MsgDeleteTokenPairResponse, error)
+	BackfillTokenPairs(context.Context, *MsgBackfillTokenPairs) (*MsgBackfillTokenPairsResponse, error)
+	MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -432,6 +674,12 @@ func (*UnimplementedMsgServer) CreateTokenPair(ctx context.Context, req *MsgCrea
 func (*UnimplementedMsgServer) DeleteTokenPair(ctx context.Context, req *MsgDeleteTokenPair) (*MsgDeleteTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTokenPair not implemented")
 }
+func (*UnimplementedMsgServer) BackfillTokenPairs(ctx context.Context, req *MsgBackfillTokenPairs) (*MsgBackfillTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillTokenPairs not implemented")
+}
+func (*UnimplementedMsgServer) MigrateTokenPair(ctx context.Context, req *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTokenPair not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -491,6 +739,42 @@ func _Msg_DeleteTokenPair_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BackfillTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBackfillTokenPairs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BackfillTokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.erc20.v1beta1.Msg/BackfillTokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BackfillTokenPairs(ctx, req.(*MsgBackfillTokenPairs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.erc20.v1beta1.Msg/MigrateTokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTokenPair(ctx, req.(*MsgMigrateTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.erc20.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -507,6 +791,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTokenPair",
 			Handler:    _Msg_DeleteTokenPair_Handler,
 		},
+		{
+			MethodName: "BackfillTokenPairs",
+			Handler:    _Msg_BackfillTokenPairs_Handler,
+		},
+		{
+			MethodName: "MigrateTokenPair",
+			Handler:    _Msg_MigrateTokenPair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/erc20/v1beta1/tx.proto",
@@ -708,6 +1000,136 @@ func (m *MsgDeleteTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBackfillTokenPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBackfillTokenPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBackfillTokenPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBackfillTokenPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBackfillTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBackfillTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedDenoms != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueuedDenoms))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConversionWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConversionWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewErc20Address) > 0 {
+		i -= len(m.NewErc20Address)
+		copy(dAtA[i:], m.NewErc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewErc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -795,13 +1217,236 @@ func (m *MsgDeleteTokenPairResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBackfillTokenPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBackfillTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueuedDenoms != 0 {
+		n += 1 + sovTx(uint64(m.QueuedDenoms))
+	}
+	return n
+}
+
+func (m *MsgMigrateTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewErc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConversionWindow != 0 {
+		n += 1 + sovTx(uint64(m.ConversionWindow))
+	}
+	return n
+}
+
+func (m *MsgMigrateTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,15 +1469,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,11 +1505,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -891,7 +1536,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -916,7 +1561,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,12 +1584,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -966,7 +1644,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTokenPair) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -989,10 +1667,10 @@ func (m *MsgCreateTokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1029,9 +1707,9 @@ func (m *MsgCreateTokenPair) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1041,25 +1719,74 @@ func (m *MsgCreateTokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1081,7 +1808,7 @@ func (m *MsgCreateTokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBackfillTokenPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,17 +1831,17 @@ func (m *MsgCreateTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBackfillTokenPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBackfillTokenPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1124,25 +1851,93 @@ func (m *MsgCreateTokenPairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBackfillTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBackfillTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBackfillTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDenoms", wireType)
+			}
+			m.QueuedDenoms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedDenoms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1164,7 +1959,7 @@ func (m *MsgCreateTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTokenPair) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,15 +1982,15 @@ func (m *MsgDeleteTokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1223,7 +2018,7 @@ func (m *MsgDeleteTokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1257,6 +2052,57 @@ func (m *MsgDeleteTokenPair) Unmarshal(dAtA []byte) error {
 			}
 			m.BankDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionWindow", wireType)
+			}
+			m.ConversionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1278,7 +2124,7 @@ func (m *MsgDeleteTokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1301,10 +2147,10 @@ func (m *MsgDeleteTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
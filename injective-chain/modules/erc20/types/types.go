@@ -56,6 +56,27 @@ func (pair *TokenPair) Validate() error {
 	return nil
 }
 
+func (p *DeprecatedTokenPair) Validate() error {
+	if p.BankDenom == "" {
+		return errors.Wrap(ErrInvalidTokenPair, "deprecated token pair has empty bank denom")
+	}
+
+	if !common.IsHexAddress(p.Erc20Address) {
+		return errors.Wrap(ErrInvalidTokenPair, "deprecated token pair has invalid ERC20 address")
+	}
+
+	if p.ReplacementErc20Address != "" && !common.IsHexAddress(p.ReplacementErc20Address) {
+		return errors.Wrap(ErrInvalidTokenPair, "deprecated token pair has invalid replacement ERC20 address")
+	}
+
+	return nil
+}
+
+// IsRetired returns true if the deprecated token pair has no replacement ERC20 contract
+func (p *DeprecatedTokenPair) IsRetired() bool {
+	return p.ReplacementErc20Address == ""
+}
+
 func GetDenomType(bankDenom string) denomType {
 	switch {
 	case strings.HasPrefix(bankDenom, "ibc/"):
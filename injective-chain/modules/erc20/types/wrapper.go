@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// WrapperDeployerSeed is hashed together with the bank denom to derive the deployer of its ERC-20 wrapper
+	WrapperDeployerSeed = "erc20/wrapper/"
+
+	// MaxWrappersPerBlock is the maximum number of queued ERC-20 wrappers created in an EndBlocker
+	MaxWrappersPerBlock = 20
+)
+
+// WrapperDeployerAddress returns the EVM address deploying the ERC-20 wrapper of the bank denom, which is the last 20
+// bytes of keccak256("erc20/wrapper/" + denom). Nobody holds its private key, so it never sends transactions itself.
+func WrapperDeployerAddress(bankDenom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(WrapperDeployerSeed + bankDenom)))
+}
+
+// WrapperAddress returns the deterministic address of the ERC-20 wrapper of the bank denom, which is the address of
+// the contract created by its deployer with nonce 0. It can be computed off-chain before the wrapper is created.
+func WrapperAddress(bankDenom string) common.Address {
+	return crypto.CreateAddress(WrapperDeployerAddress(bankDenom), 0)
+}
+
+// IsLazilyWrappableDenom returns true if the ERC-20 wrapper of the bank denom is created on its first transfer. Token
+// factory denoms are excluded, their wrapper address is reserved for the MintBurnBankERC20 owned by the denom admin.
+func IsLazilyWrappableDenom(bankDenom string) bool {
+	return IsWrappableDenom(bankDenom) && GetDenomType(bankDenom) != DenomTypeTokenFactory
+}
+
+// IsWrappableDenom returns true if an ERC-20 wrapper can be created for the bank denom without any custom ERC-20
+// contract, which is the case for valid token factory, peggy and IBC denoms
+func IsWrappableDenom(bankDenom string) bool {
+	pair := &TokenPair{BankDenom: bankDenom}
+	return pair.Validate() == nil
+}
@@ -276,6 +276,52 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg *core.Message, tracer *tracer
 	return k.ApplyMessageWithConfig(ctx, msg, cfg, commit)
 }
 
+// StaticCall executes a read-only call (as with the STATICCALL opcode) of the contract against the current state, so any
+// state modification fails the call, and the StateDB is never committed. It's meant for native modules which need to
+// query a contract in the middle of a cosmos transaction or a block hook.
+func (k *Keeper) StaticCall(ctx sdk.Context, from, contract common.Address, data []byte, gasLimit uint64) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, common.Hash{})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	// return error if contract call is disabled through governance
+	if !cfg.Params.EnableCall {
+		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+	}
+
+	msg := &core.Message{
+		From:             from,
+		To:               &contract,
+		Nonce:            k.GetNonce(ctx, from),
+		Value:            new(big.Int),
+		GasLimit:         gasLimit,
+		GasPrice:         new(big.Int),
+		GasFeeCap:        new(big.Int),
+		GasTipCap:        new(big.Int),
+		Data:             data,
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+
+	stateDB := statedb.NewWithParams(ctx, k, cfg.TxConfig, cfg.Params.EvmDenom)
+	evm := k.NewEVM(ctx, msg, cfg, stateDB)
+	stateDB.Prepare(cfg.Rules, from, cfg.CoinBase, &contract, vm.DefaultActivePrecompiles(cfg.Rules), nil)
+
+	ret, leftoverGas, vmErr := evm.StaticCall(from, contract, data, gasLimit)
+
+	var vmError string
+	if vmErr != nil {
+		vmError = vmErr.Error()
+	}
+
+	return &types.MsgEthereumTxResponse{
+		GasUsed: gasLimit - leftoverGas,
+		VmError: vmError,
+		Ret:     ret,
+	}, nil
+}
+
 // ApplyMessageWithConfig computes the new state by applying the given message against the existing state.
 // If the message fails, the VM execution error with the reason will be returned to the client
 // and the transaction won't be committed to the store.
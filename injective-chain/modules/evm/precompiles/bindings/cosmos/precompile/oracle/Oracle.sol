@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.4;
+
+address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000067;
+
+IOracleModule constant ORACLE_CONTRACT = IOracleModule(
+    ORACLE_PRECOMPILE_ADDRESS
+);
+
+/// @dev The IOracleModule contract's interface.
+interface IOracleModule {
+    /// @dev Queries the time-weighted average price of an oracle over a time range.
+    /// @param baseSymbol The symbol of the base oracle
+    /// @param baseOracleType The type of the base oracle
+    /// @param quoteSymbol The symbol of the quote oracle, empty if the base price is quoted in USD
+    /// @param quoteOracleType The type of the quote oracle
+    /// @param startTime The start of the time range (unix seconds)
+    /// @param endTime The end of the time range (unix seconds), the current block time if 0
+    /// @param maxGap The maximum time a price may be used without being updated (seconds), 0 to disable the check
+    /// @return price The TWAP as an 18 decimals fixed point number
+    /// @return recordsCount The number of price records the TWAP was computed from
+    /// @return largestGap The largest gap between two price records (seconds)
+    function twap(
+        string calldata baseSymbol,
+        int32 baseOracleType,
+        string calldata quoteSymbol,
+        int32 quoteOracleType,
+        int64 startTime,
+        int64 endTime,
+        int64 maxGap
+    ) external view returns (uint256 price, uint32 recordsCount, int64 largestGap);
+}
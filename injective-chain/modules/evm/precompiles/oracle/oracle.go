@@ -0,0 +1,182 @@
+package oracle
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	storetypes "cosmossdk.io/store/types"
+
+	oraclekeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/keeper"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/bindings/cosmos/precompile/oracle"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/types"
+)
+
+const (
+	TWAPQueryMethodName = "twap"
+)
+
+var (
+	oracleABI                 abi.ABI
+	oracleContractAddress     = common.BytesToAddress([]byte{103})
+	oracleGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+var (
+	ErrPrecompilePanic = errors.New("precompile panic")
+)
+
+func init() {
+	if err := oracleABI.UnmarshalJSON([]byte(oracle.OracleModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range oracleABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], oracleABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case TWAPQueryMethodName:
+			// reads and decodes up to two price record lists of types.MaxHistoricalPriceRecordAge seconds
+			oracleGasRequiredByMethod[methodID] = 50_000
+		default:
+			oracleGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+type OracleContract struct {
+	oracleKeeper *oraclekeeper.Keeper
+	kvGasConfig  storetypes.GasConfig
+}
+
+func NewOracleContract(
+	oracleKeeper *oraclekeeper.Keeper,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &OracleContract{
+		oracleKeeper: oracleKeeper,
+		kvGasConfig:  kvGasConfig,
+	}
+}
+
+func (oc *OracleContract) ABI() abi.ABI {
+	return oracleABI
+}
+
+func (oc *OracleContract) Address() common.Address {
+	return oracleContractAddress
+}
+
+func (*OracleContract) Name() string {
+	return "INJ_ORACLE"
+}
+
+func (oc *OracleContract) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * oc.kvGasConfig.WriteCostPerByte
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	requiredGas, ok := oracleGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + baseCost
+	}
+	return baseCost
+}
+
+func (oc *OracleContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	res, err := oc.run(evm, contract, readonly)
+	if err != nil {
+		return types.RevertReasonAndError(err)
+	}
+	return res, nil
+}
+
+func (oc *OracleContract) run(evm *vm.EVM, contract *vm.Contract, _ bool) (output []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrPrecompilePanic
+			output = nil
+		}
+	}()
+
+	// parse input
+	methodID := contract.Input[:4]
+	method, err := oracleABI.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+
+	switch method.Name {
+	case TWAPQueryMethodName:
+		return oc.queryTWAP(evm, method, args)
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
+
+// queryTWAP returns the TWAP of GetOracleTWAP as an 18 decimals fixed point number. An empty quote symbol means the
+// base price is quoted in USD.
+func (oc *OracleContract) queryTWAP(
+	evm *vm.EVM,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	baseSymbol, err := types.CastString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	baseOracleType, err := types.CastInt32(args[1])
+	if err != nil {
+		return nil, err
+	}
+	quoteSymbol, err := types.CastString(args[2])
+	if err != nil {
+		return nil, err
+	}
+	quoteOracleType, err := types.CastInt32(args[3])
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := types.CastInt64(args[4])
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := types.CastInt64(args[5])
+	if err != nil {
+		return nil, err
+	}
+	maxGap, err := types.CastInt64(args[6])
+	if err != nil {
+		return nil, err
+	}
+
+	base := &oracletypes.OracleInfo{
+		Symbol:     baseSymbol,
+		OracleType: oracletypes.OracleType(baseOracleType),
+	}
+	quote := &oracletypes.OracleInfo{
+		Symbol:     quoteSymbol,
+		OracleType: oracletypes.OracleType(quoteOracleType),
+	}
+
+	stateDB := evm.StateDB.(precompiles.ExtStateDB)
+	twap, err := oc.oracleKeeper.GetOracleTWAP(stateDB.CacheContext(), base, quote, startTime, endTime, maxGap)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(twap.Twap.BigInt(), twap.RecordsCount, twap.LargestGap)
+}
@@ -58,6 +58,14 @@ func CastInt32(input interface{}) (int32, error) {
 	return res, nil
 }
 
+func CastInt64(input interface{}) (int64, error) {
+	res, ok := input.(int64)
+	if !ok {
+		return 0, errors.New("could not cast input to int64")
+	}
+	return res, nil
+}
+
 // ConvertLegacyDecToBigInt removes the scaling factor from the LegacyDec
 func ConvertLegacyDecToBigInt(in sdkmath.LegacyDec) *big.Int {
 	return in.RoundInt().BigInt()
@@ -37,6 +37,9 @@ func (h *BlockHandler) BeginBlocker(ctx sdk.Context) {
 
 	// swap the gas meter with a threadsafe version
 
+	// End the downtime grace mode once its recovery period has elapsed
+	h.processDowntimeGraceModeExpiry(ctx)
+
 	// Check for downtime-based post-only mode activation (execute first to ensure immediate response to downtime)
 	params := h.k.GetParams(ctx)
 	h.processDowntimePostOnlyMode(ctx, params)
@@ -513,10 +516,10 @@ func triggerLimitOrderWithoutCache(
 }
 
 // processDowntimePostOnlyMode checks if the current block is the first block after a detected downtime
-// and activates post-only mode if the downtime exceeds the configured MinPostOnlyModeDowntimeDuration
+// and enters the downtime grace mode if the downtime exceeds the configured MinPostOnlyModeDowntimeDuration
 func (h *BlockHandler) processDowntimePostOnlyMode(ctx sdk.Context, params v2.Params) {
-	// Skip if MinPostOnlyModeDowntimeDuration is empty or if exchange is already in post-only mode
-	if params.MinPostOnlyModeDowntimeDuration == "" || h.k.IsPostOnlyMode(ctx) {
+	// Skip if MinPostOnlyModeDowntimeDuration is empty or if exchange is already in grace mode
+	if params.MinPostOnlyModeDowntimeDuration == "" || h.k.GetActiveDowntimeGraceMode(ctx) != nil {
 		return
 	}
 
@@ -538,24 +541,38 @@ func (h *BlockHandler) processDowntimePostOnlyMode(ctx sdk.Context, params v2.Pa
 	// Check if the current block time matches the last recorded downtime block time
 	// This means this is the first block after the detected downtime
 	if ctx.BlockTime().Equal(lastDowntimeBlockTime) {
-		// Activate post-only mode by setting PostOnlyModeHeightThreshold
-		newThreshold := ctx.BlockHeight() + int64(params.PostOnlyModeBlocksAmountAfterDowntime)
-
-		// Update the params with the new threshold
-		updatedParams := params
-		updatedParams.PostOnlyModeHeightThreshold = newThreshold
-		h.k.SetParams(ctx, updatedParams)
+		// Enter the grace mode, which activates post-only mode until the end of the grace period
+		graceMode := h.k.StartDowntimeGraceMode(ctx, params, params.MinPostOnlyModeDowntimeDuration)
 
 		ctx.Logger().Info(
-			"Post-only mode activated due to downtime detection",
+			"Downtime grace mode activated due to downtime detection",
 			"downtime_duration", params.MinPostOnlyModeDowntimeDuration,
 			"current_height", ctx.BlockHeight(),
-			"post_only_until_height", newThreshold,
+			"post_only_until_height", graceMode.EndHeight,
+			"trading_mode", graceMode.TradingMode.String(),
+			"liquidations_paused", graceMode.LiquidationsPaused,
+			"funding_frozen", graceMode.FundingFrozen,
 			"downtime_block_time", lastDowntimeBlockTime,
 		)
 	}
 }
 
+// processDowntimeGraceModeExpiry ends the downtime grace mode once its recovery period has elapsed
+func (h *BlockHandler) processDowntimeGraceModeExpiry(ctx sdk.Context) {
+	graceMode := h.k.GetDowntimeGraceMode(ctx)
+	if graceMode == nil || ctx.BlockHeight() < graceMode.EndHeight {
+		return
+	}
+
+	h.k.EndDowntimeGraceMode(ctx, false)
+
+	ctx.Logger().Info(
+		"Downtime grace mode ended",
+		"current_height", ctx.BlockHeight(),
+		"start_height", graceMode.StartHeight,
+	)
+}
+
 // processPostOnlyModeCancellation checks if the post-only mode cancellation flag is set
 // and disables post-only mode if requested by governance or exchange admins
 func (h *BlockHandler) processPostOnlyModeCancellation(ctx sdk.Context) {
@@ -572,6 +589,9 @@ func (h *BlockHandler) processPostOnlyModeCancellation(ctx sdk.Context) {
 	// Remove the cancellation flag
 	h.k.DeletePostOnlyModeCancellationFlag(ctx)
 
+	// The grace mode following a downtime is cancelled along with the post-only mode
+	h.k.EndDowntimeGraceMode(ctx, true)
+
 	ctx.Logger().Info(
 		"Post-only mode cancelled via governance/admin action",
 		"current_height", ctx.BlockHeight(),
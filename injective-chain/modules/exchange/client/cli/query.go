@@ -41,6 +41,7 @@ func GetQueryCmd() *cobra.Command {
 		GetFeeDiscountAccountInfo(),
 		GetMinNotionalForDenom(),
 		GetAllDenomMinNotionals(),
+		GetDowntimeGraceMode(),
 	)
 	return cmd
 }
@@ -514,3 +515,11 @@ func GetAllDenomMinNotionals() *cobra.Command {
 		&exchangev2.QueryDenomMinNotionalsRequest{}, nil, nil,
 	)
 }
+
+func GetDowntimeGraceMode() *cobra.Command {
+	return cli.QueryCmd("downtime-grace-mode",
+		"Returns the grace mode the exchange entered after a chain downtime, if it is still active",
+		exchangev2.NewQueryClient,
+		&exchangev2.QueryDowntimeGraceModeRequest{}, nil, nil,
+	)
+}
@@ -129,6 +129,10 @@ func (k AccountsMsgServer) SubaccountTransfer(
 
 		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 	}
+	if err := k.checkSubaccountNotFrozen(ctx, srcSubaccountID, denom); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.DecrementDeposit(ctx, srcSubaccountID, denom, amount); err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, err
@@ -181,6 +185,10 @@ func (k AccountsMsgServer) ExternalTransfer(
 		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 	}
 
+	if err := k.checkSubaccountNotFrozen(ctx, srcSubaccountID, denom); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.DecrementDeposit(ctx, srcSubaccountID, denom, amount); err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, err
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	v2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+)
+
+// checkSubaccountNotFrozen returns ErrAccountFrozen if the balance of the denom held by the owner of the subaccount is
+// frozen by the permissions module
+func (k *Keeper) checkSubaccountNotFrozen(ctx sdk.Context, subaccountID common.Hash, denom string) error {
+	if k.permissionsKeeper == nil {
+		return nil
+	}
+
+	owner := types.SubaccountIDToSdkAddress(subaccountID)
+	if k.permissionsKeeper.IsAccountFrozen(ctx, denom, owner) {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrAccountFrozen, "owner %s of subaccount %s is frozen for %s", owner, subaccountID.Hex(), denom)
+	}
+
+	return nil
+}
+
+// CancelAllOrdersForDenom cancels the orders of the account's subaccounts holding the denom in all the markets where
+// the denom is used, i.e. spot markets trading it and derivative and binary options markets quoted in it, so that the
+// balances held by the orders are released. It's used by the permissions module when freezing or clawing back the
+// denom, since the settlement of these orders would otherwise move the frozen balance.
+func (k *Keeper) CancelAllOrdersForDenom(ctx sdk.Context, account sdk.AccAddress, denom string) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	subaccountIDs := k.getSubaccountIDsHoldingDenom(ctx, account, denom)
+	if len(subaccountIDs) == 0 {
+		return
+	}
+
+	spotMarkets := k.FindSpotMarkets(ctx, func(m *v2.SpotMarket) bool {
+		return (m.BaseDenom == denom || m.QuoteDenom == denom) && m.StatusSupportsOrderCancellations()
+	})
+	for _, market := range spotMarkets {
+		for _, subaccountID := range subaccountIDs {
+			k.CancelAllSpotLimitOrders(ctx, market, subaccountID, market.MarketID())
+		}
+	}
+
+	derivativeMarkets := k.FindDerivativeAndBinaryOptionsMarkets(ctx, func(m MarketInterface) bool {
+		return m.GetQuoteDenom() == denom && m.StatusSupportsOrderCancellations()
+	})
+	for _, market := range derivativeMarkets {
+		for _, subaccountID := range subaccountIDs {
+			k.CancelAllRestingDerivativeLimitOrdersForSubaccount(ctx, market, subaccountID, true, true)
+			k.CancelAllTransientDerivativeLimitOrdersBySubaccountID(ctx, market, subaccountID)
+			k.CancelAllConditionalDerivativeOrdersBySubaccountIDAndMarket(ctx, market, subaccountID)
+		}
+	}
+}
+
+// getSubaccountIDsHoldingDenom returns the IDs of the account's subaccounts which have a deposit of the denom
+func (k *Keeper) getSubaccountIDsHoldingDenom(ctx sdk.Context, account sdk.AccAddress, denom string) []common.Hash {
+	// subaccount IDs are prefixed by the address of their owner
+	keyPrefix := append(types.DepositsPrefix, account.Bytes()...)
+	depositStore := prefix.NewStore(k.getStore(ctx), keyPrefix)
+	iter := depositStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	subaccountIDs := make([]common.Hash, 0)
+	for ; iter.Valid(); iter.Next() {
+		subaccountID, depositDenom := types.ParseDepositStoreKey(append(account.Bytes(), iter.Key()...))
+		if depositDenom == denom {
+			subaccountIDs = append(subaccountIDs, subaccountID)
+		}
+	}
+
+	return subaccountIDs
+}
+
+// ClawbackSubaccountDeposits force transfers up to amount of the denom from the available balances of the account's
+// non-default subaccounts to the recipient, and returns the amount transferred. Balances held by open orders are not
+// clawed back. Default subaccount funds are held in the bank, so they should be clawed back from there instead.
+func (k *Keeper) ClawbackSubaccountDeposits(
+	ctx sdk.Context,
+	account, recipient sdk.AccAddress,
+	denom string,
+	amount math.Int,
+) (math.Int, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	clawedBack := math.ZeroInt()
+	for _, subaccountID := range k.getSubaccountIDsHoldingDenom(ctx, account, denom) {
+		if types.IsDefaultSubaccountID(subaccountID) {
+			continue
+		}
+
+		remaining := amount.Sub(clawedBack)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		deposit := k.GetDeposit(ctx, subaccountID, denom)
+		clawbackAmount := math.MinInt(deposit.AvailableBalance.TruncateInt(), remaining)
+		if !clawbackAmount.IsPositive() {
+			continue
+		}
+
+		if err := k.DecrementDeposit(ctx, subaccountID, denom, clawbackAmount.ToLegacyDec()); err != nil {
+			return clawedBack, err
+		}
+
+		coin := sdk.NewCoin(denom, clawbackAmount)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(coin)); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return clawedBack, errors.Wrap(err, "clawback failed")
+		}
+
+		k.EmitEvent(ctx, &v2.EventSubaccountWithdraw{
+			SubaccountId: subaccountID.Bytes(),
+			DstAddress:   recipient.String(),
+			Amount:       coin,
+		})
+
+		clawedBack = clawedBack.Add(clawbackAmount)
+	}
+
+	return clawedBack, nil
+}
@@ -73,6 +73,10 @@ func (k *Keeper) ExecuteWithdraw(ctx sdk.Context, msg *v2.MsgWithdraw) error {
 		return sdkerrors.ErrInvalidCoins
 	}
 
+	if err := k.checkSubaccountNotFrozen(ctx, subaccountID, denom); err != nil {
+		return err
+	}
+
 	if err := k.DecrementDeposit(ctx, subaccountID, denom, amount); err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return errors.Wrap(err, "withdrawal failed")
@@ -409,6 +413,10 @@ func (k *Keeper) chargeBank(ctx sdk.Context, account sdk.AccAddress, denom strin
 }
 
 func (k *Keeper) chargeAvailableDeposits(ctx sdk.Context, subaccountID common.Hash, denom string, amount math.LegacyDec) error {
+	if err := k.checkSubaccountNotFrozen(ctx, subaccountID, denom); err != nil {
+		return err
+	}
+
 	deposit := k.GetDeposit(ctx, subaccountID, denom)
 	if deposit.IsEmpty() {
 		metrics.ReportFuncError(k.svcTags)
@@ -634,6 +642,10 @@ func (k *Keeper) DecrementDepositOrChargeFromBank(
 		return chargeAmount, err
 	}
 
+	if err := k.checkSubaccountNotFrozen(ctx, subaccountID, denom); err != nil {
+		return amount, err
+	}
+
 	err = k.DecrementDeposit(ctx, subaccountID, denom, amount)
 	return amount, err
 }
@@ -95,17 +95,15 @@ func (k *Keeper) PayDeficitFromInsuranceFund(
 		return math.LegacyZeroDec(), nil
 	}
 
-	insuranceFund := k.insuranceKeeper.GetInsuranceFund(ctx, marketID)
-
-	if insuranceFund == nil {
+	if !k.insuranceKeeper.HasInsuranceFund(ctx, marketID) {
 		metrics.ReportFuncError(k.svcTags)
 		return absoluteDeficitAmount, insurancetypes.ErrInsuranceFundNotFound
 	}
 
 	withdrawalAmount := absoluteDeficitAmount.Ceil().RoundInt()
 
-	if insuranceFund.Balance.LT(withdrawalAmount) {
-		withdrawalAmount = insuranceFund.Balance
+	if availableBalance := k.insuranceKeeper.GetAvailableInsuranceBalance(ctx, marketID); availableBalance.LT(withdrawalAmount) {
+		withdrawalAmount = availableBalance
 	}
 
 	if err := k.insuranceKeeper.WithdrawFromInsuranceFund(ctx, marketID, withdrawalAmount); err != nil {
@@ -632,6 +630,18 @@ func (k DerivativesMsgServer) liquidatePosition(
 		return nil, errors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market for marketID %s not found", marketID.Hex())
 	}
 
+	// liquidations are paused while the oracle price is halted, unless the market is being settled
+	if !isEmergencySettlingMarket && k.IsMarketOraclePriceHalted(cacheCtx, market) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrOraclePriceHalted, "liquidations are paused for market %s", marketID.Hex())
+	}
+
+	// liquidations are paused during the grace mode following a chain downtime, unless the market is being settled
+	if !isEmergencySettlingMarket && k.AreLiquidationsPaused(cacheCtx) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrLiquidationsPaused, "liquidations are paused until height %d after a chain downtime", k.GetDowntimeGraceMode(cacheCtx).EndHeight)
+	}
+
 	position := k.GetPosition(cacheCtx, marketID, positionSubaccountID)
 	if position == nil || position.Quantity.IsZero() {
 		metrics.ReportFuncError(k.svcTags)
@@ -94,15 +94,8 @@ func (k *Keeper) GetAvailableMarketFunds(
 	ctx sdk.Context,
 	marketID common.Hash,
 ) math.LegacyDec {
-	var insuranceFundBalance math.LegacyDec
-
 	marketBalance := k.GetMarketBalance(ctx, marketID)
-	insuranceFund := k.insuranceKeeper.GetInsuranceFund(ctx, marketID)
-	if insuranceFund == nil {
-		insuranceFundBalance = math.LegacyZeroDec()
-	} else {
-		insuranceFundBalance = insuranceFund.Balance.ToLegacyDec()
-	}
+	insuranceFundBalance := k.insuranceKeeper.GetAvailableInsuranceBalance(ctx, marketID).ToLegacyDec()
 	return marketBalance.Add(insuranceFundBalance)
 }
 
@@ -131,14 +124,13 @@ func (k *Keeper) TransferFullInsuranceFundBalance(
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
-	insuranceFund := k.insuranceKeeper.GetInsuranceFund(ctx, marketID)
-
-	if insuranceFund == nil {
+	if !k.insuranceKeeper.HasInsuranceFund(ctx, marketID) {
 		metrics.ReportFuncError(k.svcTags)
 		return
 	}
 
-	withdrawalAmount := insuranceFund.Balance
+	// for markets backed by a shared insurance fund, only the remaining exposure of the market is transferred
+	withdrawalAmount := k.insuranceKeeper.GetAvailableInsuranceBalance(ctx, marketID)
 
 	if err := k.insuranceKeeper.WithdrawFromInsuranceFund(ctx, marketID, withdrawalAmount); err != nil {
 		metrics.ReportFuncError(k.svcTags)
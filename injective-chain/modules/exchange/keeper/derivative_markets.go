@@ -63,6 +63,19 @@ func (k *Keeper) GetDerivativeMarketPrice(
 	return &scaledPrice, nil
 }
 
+// IsMarketOraclePriceHalted returns true if an oracle circuit breaker halted the price the market depends on.
+func (k *Keeper) IsMarketOraclePriceHalted(ctx sdk.Context, market DerivativeMarketInterface) bool {
+	switch m := market.(type) {
+	case *v2.DerivativeMarket:
+		return k.OracleKeeper.IsPriceHalted(ctx, m.OracleType, m.OracleBase, m.OracleQuote)
+	case *v2.BinaryOptionsMarket:
+		// symbol is used as base and provider is used as quote for provider oracles
+		return k.OracleKeeper.IsPriceHalted(ctx, m.OracleType, m.OracleSymbol, m.OracleProvider)
+	}
+
+	return false
+}
+
 // GetDerivativeMarketCumulativePrice fetches both base and quote cumulative prices for proper TWAP calculation.
 // Returns base and quote cumulative prices that enable unified TWAP calculation:
 //
@@ -655,10 +668,10 @@ func (k *Keeper) UpdateDerivativeMarketParam(
 		}
 	}
 
-	insuranceFund := k.insuranceKeeper.GetInsuranceFund(ctx, marketID)
+	insuranceFund := k.insuranceKeeper.GetMarketInsuranceFund(ctx, marketID)
 	if insuranceFund == nil {
 		return errors.Wrapf(insurancetypes.ErrInsuranceFundNotFound, "ticker %s marketID %s", market.Ticker, marketID.Hex())
-	} else {
+	} else if !insuranceFund.IsShared() {
 		shouldUpdateInsuranceFundOracleParams := insuranceFund.OracleBase != market.OracleBase ||
 			insuranceFund.OracleQuote != market.OracleQuote ||
 			insuranceFund.OracleType != market.OracleType
@@ -24,15 +24,17 @@ type DerivativesMsgServer struct {
 
 // Using a map for the list of enabled oracle types to improve lookup time
 var enabledOracleTypes = map[oracletypes.OracleType]struct{}{
-	oracletypes.OracleType_Coinbase:  {},
-	oracletypes.OracleType_Chainlink: {},
-	oracletypes.OracleType_Razor:     {},
-	oracletypes.OracleType_Dia:       {},
-	oracletypes.OracleType_API3:      {},
-	oracletypes.OracleType_Uma:       {},
-	oracletypes.OracleType_Pyth:      {},
-	oracletypes.OracleType_BandIBC:   {},
-	oracletypes.OracleType_Stork:     {},
+	oracletypes.OracleType_Coinbase:             {},
+	oracletypes.OracleType_Chainlink:            {},
+	oracletypes.OracleType_Razor:                {},
+	oracletypes.OracleType_Dia:                  {},
+	oracletypes.OracleType_API3:                 {},
+	oracletypes.OracleType_Uma:                  {},
+	oracletypes.OracleType_Pyth:                 {},
+	oracletypes.OracleType_BandIBC:              {},
+	oracletypes.OracleType_Stork:                {},
+	oracletypes.OracleType_Composite:            {},
+	oracletypes.OracleType_ChainlinkDataStreams: {},
 }
 
 // NewDerivativesMsgServerImpl returns an implementation of the exchange MsgServer interface for the provided Keeper
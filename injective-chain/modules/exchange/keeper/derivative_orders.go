@@ -1045,6 +1045,12 @@ func (k *Keeper) createDerivativeMarketOrder(
 	// set the actual subaccountID value in the order, since it might be a nonce value
 	derivativeOrder.OrderInfo.SubaccountId = subaccountID.Hex()
 
+	// market orders are paused while the oracle price is halted
+	if k.IsMarketOraclePriceHalted(ctx, market) {
+		metrics.ReportFuncError(k.svcTags)
+		return orderHash, nil, errors.Wrapf(types.ErrOraclePriceHalted, "market orders are paused for market %s", marketID.Hex())
+	}
+
 	metadata := k.GetSubaccountOrderbookMetadata(ctx, marketID, subaccountID, derivativeOrder.IsBuy())
 
 	var orderMarginHold math.LegacyDec
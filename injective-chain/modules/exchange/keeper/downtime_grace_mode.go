@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	v2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+)
+
+// GetDowntimeGraceMode returns the grace mode entered after a chain downtime, or nil if there is none
+func (k *Keeper) GetDowntimeGraceMode(ctx sdk.Context) *v2.DowntimeGraceMode {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.DowntimeGraceModeKey)
+	if bz == nil {
+		return nil
+	}
+
+	var graceMode v2.DowntimeGraceMode
+	k.cdc.MustUnmarshal(bz, &graceMode)
+	return &graceMode
+}
+
+func (k *Keeper) SetDowntimeGraceMode(ctx sdk.Context, graceMode *v2.DowntimeGraceMode) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.getStore(ctx).Set(types.DowntimeGraceModeKey, k.cdc.MustMarshal(graceMode))
+}
+
+func (k *Keeper) DeleteDowntimeGraceMode(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.getStore(ctx).Delete(types.DowntimeGraceModeKey)
+}
+
+// GetActiveDowntimeGraceMode returns the grace mode entered after a chain downtime if it is still ongoing
+func (k *Keeper) GetActiveDowntimeGraceMode(ctx sdk.Context) *v2.DowntimeGraceMode {
+	graceMode := k.GetDowntimeGraceMode(ctx)
+	if graceMode == nil || ctx.BlockHeight() >= graceMode.EndHeight {
+		return nil
+	}
+
+	return graceMode
+}
+
+// IsCancelOnlyMode returns true if the exchange only accepts order cancellations during a downtime grace mode
+func (k *Keeper) IsCancelOnlyMode(ctx sdk.Context) bool {
+	graceMode := k.GetActiveDowntimeGraceMode(ctx)
+	return graceMode != nil && graceMode.TradingMode == v2.DowntimeTradingMode_DowntimeCancelOnly
+}
+
+// AreLiquidationsPaused returns true if liquidations are paused during a downtime grace mode
+func (k *Keeper) AreLiquidationsPaused(ctx sdk.Context) bool {
+	graceMode := k.GetActiveDowntimeGraceMode(ctx)
+	return graceMode != nil && graceMode.LiquidationsPaused
+}
+
+// IsFundingFrozen returns true if the funding of perpetual markets is frozen during a downtime grace mode
+func (k *Keeper) IsFundingFrozen(ctx sdk.Context) bool {
+	graceMode := k.GetActiveDowntimeGraceMode(ctx)
+	return graceMode != nil && graceMode.FundingFrozen
+}
+
+// StartDowntimeGraceMode enters the grace mode following a chain downtime of the given duration. The exchange is put in
+// post-only mode for the grace period, with the additional restrictions configured in the params.
+func (k *Keeper) StartDowntimeGraceMode(ctx sdk.Context, params v2.Params, downtime string) *v2.DowntimeGraceMode {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	graceMode := &v2.DowntimeGraceMode{
+		Downtime:           downtime,
+		StartHeight:        ctx.BlockHeight(),
+		EndHeight:          ctx.BlockHeight() + int64(params.PostOnlyModeBlocksAmountAfterDowntime),
+		TradingMode:        params.DowntimeTradingMode,
+		LiquidationsPaused: params.DowntimePauseLiquidations,
+		FundingFrozen:      params.DowntimeFreezeFunding,
+	}
+	k.SetDowntimeGraceMode(ctx, graceMode)
+
+	// extend any ongoing post-only mode until the end of the grace mode
+	if params.PostOnlyModeHeightThreshold < graceMode.EndHeight {
+		params.PostOnlyModeHeightThreshold = graceMode.EndHeight
+		k.SetParams(ctx, params)
+	}
+
+	k.EmitEvent(ctx, &v2.EventDowntimeGraceModeStarted{
+		GraceMode: graceMode,
+	})
+
+	return graceMode
+}
+
+// EndDowntimeGraceMode ends the grace mode following a chain downtime, either because the recovery period elapsed or
+// because it was cancelled by governance or an exchange admin.
+func (k *Keeper) EndDowntimeGraceMode(ctx sdk.Context, cancelled bool) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	graceMode := k.GetDowntimeGraceMode(ctx)
+	if graceMode == nil {
+		return
+	}
+
+	k.DeleteDowntimeGraceMode(ctx)
+
+	k.EmitEvent(ctx, &v2.EventDowntimeGraceModeEnded{
+		GraceMode: graceMode,
+		Cancelled: cancelled,
+	})
+}
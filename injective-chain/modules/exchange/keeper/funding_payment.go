@@ -25,6 +25,9 @@ func (k *Keeper) ProcessHourlyFundings(ctx sdk.Context) {
 		return
 	}
 
+	// no funding accrues during the grace mode following a chain downtime
+	isFundingFrozen := k.IsFundingFrozen(ctx)
+
 	marketInfos := k.GetAllPerpetualMarketInfoStates(ctx)
 	for _, marketInfo := range marketInfos {
 		currFundingTimestamp := marketInfo.NextFundingTimestamp
@@ -56,6 +59,9 @@ func (k *Keeper) ProcessHourlyFundings(ctx sdk.Context) {
 		// nolint:all
 		// fundingRate = cap(twap + hourlyInterestRate)
 		fundingRate := capFundingRate(twap.Add(marketInfo.HourlyInterestRate), marketInfo.HourlyFundingRateCap)
+		if isFundingFrozen {
+			fundingRate = math.LegacyZeroDec()
+		}
 		fundingRatePayment := fundingRate.Mul(markPrice)
 
 		cumulativeFunding := funding.CumulativeFunding.Add(fundingRatePayment)
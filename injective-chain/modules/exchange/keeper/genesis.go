@@ -271,6 +271,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data v2.GenesisState) {
 	for _, denomMinNotional := range data.DenomMinNotionals {
 		k.SetMinNotionalForDenom(ctx, denomMinNotional.Denom, denomMinNotional.MinNotional)
 	}
+
+	if data.DowntimeGraceMode != nil {
+		k.SetDowntimeGraceMode(ctx, data.DowntimeGraceMode)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *v2.GenesisState {
@@ -312,5 +316,6 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *v2.GenesisState {
 		GrantAuthorizations:                          k.GetAllGrantAuthorizations(ctx),
 		ActiveGrants:                                 k.GetAllActiveGrants(ctx),
 		DenomMinNotionals:                            k.GetAllDenomMinNotionals(ctx),
+		DowntimeGraceMode:                            k.GetDowntimeGraceMode(ctx),
 	}
 }
@@ -1670,3 +1670,22 @@ func (q queryServer) OpenInterest(
 
 	return res, nil
 }
+
+func (q queryServer) DowntimeGraceMode(
+	c context.Context, _ *v2.QueryDowntimeGraceModeRequest,
+) (*v2.QueryDowntimeGraceModeResponse, error) {
+	metrics.ReportFuncCall(q.Keeper.svcTags)
+	defer metrics.ReportFuncTiming(q.Keeper.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &v2.QueryDowntimeGraceModeResponse{
+		GraceMode: q.Keeper.GetActiveDowntimeGraceMode(ctx),
+	}
+
+	if res.GraceMode != nil {
+		res.BlocksRemaining = res.GraceMode.EndHeight - ctx.BlockHeight()
+	}
+
+	return res, nil
+}
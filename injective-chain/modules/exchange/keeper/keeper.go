@@ -41,6 +41,7 @@ type Keeper struct {
 	wasmViewKeeper       types.WasmViewKeeper
 	wasmxExecutionKeeper types.WasmxExecutionKeeper
 	DowntimeKeeper       types.DowntimeKeeper
+	permissionsKeeper    types.PermissionsKeeper
 
 	svcTags   metrics.Tags
 	authority string
@@ -90,6 +91,10 @@ func (k *Keeper) SetGovKeeper(gk govkeeper.Keeper) {
 	k.govKeeper = gk
 }
 
+func (k *Keeper) SetPermissionsKeeper(pk types.PermissionsKeeper) {
+	k.permissionsKeeper = pk
+}
+
 func (k *Keeper) SetWasmKeepers(
 	wk wasmkeeper.Keeper,
 	wxk types.WasmxExecutionKeeper,
@@ -57,6 +57,11 @@ func (k *Keeper) ensureValidDerivativeOrder(
 		}
 	}
 
+	if k.IsCancelOnlyMode(ctx) {
+		metrics.ReportFuncError(k.svcTags)
+		return orderHash, errors.Wrapf(types.ErrCancelOnlyMode, "cannot create orders in cancel only mode until height %d", k.GetDowntimeGraceMode(ctx).EndHeight)
+	}
+
 	doesOrderCrossTopOfBook := k.DerivativeOrderCrossesTopOfBook(ctx, derivativeOrder)
 
 	isPostOnlyMode := k.IsPostOnlyMode(ctx)
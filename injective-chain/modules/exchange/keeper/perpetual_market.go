@@ -72,11 +72,18 @@ func (k *Keeper) PerpetualMarketLaunch(
 		return nil, nil, err
 	}
 
-	if !k.insuranceKeeper.HasInsuranceFund(ctx, marketID) {
+	insuranceFund := k.insuranceKeeper.GetMarketInsuranceFund(ctx, marketID)
+	if insuranceFund == nil {
 		metrics.ReportFuncError(k.svcTags)
 		return nil, nil, errors.Wrapf(insurancetypes.ErrInsuranceFundNotFound, "ticker %s marketID %s", ticker, marketID.Hex())
 	}
 
+	// a shared insurance fund can be assigned to the market before it's launched
+	if insuranceFund.IsShared() && insuranceFund.DepositDenom != quoteDenom {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, errors.Wrapf(insurancetypes.ErrInvalidQuoteDenom, "insurance fund deposit denom %s does not match quote denom %s", insuranceFund.DepositDenom, quoteDenom)
+	}
+
 	params := k.GetParams(ctx)
 
 	// Get next hour
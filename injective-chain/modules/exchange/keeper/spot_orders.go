@@ -792,6 +792,14 @@ func (k *Keeper) validateSpotOrder(
 		return nil, types.ErrInvalidExpirationBlock.Wrap("expiration block must be higher than current block")
 	}
 
+	if k.IsCancelOnlyMode(ctx) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrCancelOnlyMode.Wrapf(
+			"cannot create orders in cancel only mode until height %d",
+			k.GetDowntimeGraceMode(ctx).EndHeight,
+		)
+	}
+
 	isPostOnlyMode := k.IsPostOnlyMode(ctx)
 	if (order.OrderType.IsPostOnly() || isPostOnlyMode) && k.SpotOrderCrossesTopOfBook(ctx, order) {
 		metrics.ReportFuncError(k.svcTags)
@@ -119,4 +119,8 @@ var (
 	ErrInvalidOpenNotionalCap                   = errors.Register(ModuleName, 111, "invalid open notional cap")
 	ErrOpenNotionalCapBreached                  = errors.Register(ModuleName, 112, "open notional cap breached")
 	ErrNoOffsettingPositionsFound               = errors.Register(ModuleName, 113, "no valid offsetting positions found")
+	ErrOraclePriceHalted                        = errors.Register(ModuleName, 114, "oracle price halted by circuit breaker")
+	ErrCancelOnlyMode                           = errors.Register(ModuleName, 115, "exchange is in cancel-only mode")
+	ErrLiquidationsPaused                       = errors.Register(ModuleName, 116, "liquidations are paused")
+	ErrAccountFrozen                            = errors.Register(ModuleName, 117, "account is frozen for denom")
 )
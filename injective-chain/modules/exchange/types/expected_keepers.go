@@ -56,14 +56,20 @@ type OracleKeeper interface {
 	GetProviderInfo(ctx sdk.Context, provider string) *oracletypes.ProviderInfo
 	GetProviderPrice(ctx sdk.Context, provider, symbol string) *sdkmath.LegacyDec
 	GetProviderPriceState(ctx sdk.Context, provider, symbol string) *oracletypes.ProviderPriceState
+	IsPriceHalted(ctx sdk.Context, oracleType oracletypes.OracleType, base, quote string) bool
 }
 
 // InsuranceKeeper defines the expected insurance keeper methods.
 type InsuranceKeeper interface {
-	// HasInsuranceFund returns true if InsuranceFund for the given marketID exists.
+	// HasInsuranceFund returns true if InsuranceFund for the given marketID exists or if the market is backed by a
+	// shared insurance fund.
 	HasInsuranceFund(ctx sdk.Context, marketID common.Hash) bool
 	// GetInsuranceFund returns the insurance fund corresponding to the given marketID.
 	GetInsuranceFund(ctx sdk.Context, marketID common.Hash) *insurancetypes.InsuranceFund
+	// GetMarketInsuranceFund returns the insurance fund backing the market, which may be a shared insurance fund.
+	GetMarketInsuranceFund(ctx sdk.Context, marketID common.Hash) *insurancetypes.InsuranceFund
+	// GetAvailableInsuranceBalance returns the amount the market can draw from the insurance fund backing it.
+	GetAvailableInsuranceBalance(ctx sdk.Context, marketID common.Hash) sdkmath.Int
 	// DepositIntoInsuranceFund increments the insurance fund balance by amount.
 	DepositIntoInsuranceFund(ctx sdk.Context, marketID common.Hash, amount sdkmath.Int) error
 	// WithdrawFromInsuranceFund decrements the insurance fund balance by amount and sends
@@ -104,3 +110,7 @@ type DowntimeKeeper interface {
 	GetLastDowntimeOfLength(ctx sdk.Context, dur downtimetypes.Downtime) (time.Time, error)
 	GetLastBlockTime(ctx sdk.Context) (time.Time, error)
 }
+
+type PermissionsKeeper interface {
+	IsAccountFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+}
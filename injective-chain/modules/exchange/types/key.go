@@ -124,6 +124,7 @@ var (
 	OrderExpirationsPrefix       = []byte{0x85} // prefix to store order expirations
 	OrderExpirationMarketsPrefix = []byte{0x86} // prefix to store markets with order expirations
 	PostOnlyModeCancellationKey  = []byte{0x87} // key to mark post-only mode cancellation for next BeginBlock
+	DowntimeGraceModeKey         = []byte{0x88} // key to store the grace mode entered after a chain downtime
 )
 
 func GetSubaccountCidKey(subaccountID common.Hash, cid string) []byte {
@@ -2652,6 +2652,104 @@ func (m *EventDerivativePositionV2Migration) GetPosition() *DerivativePosition {
 	return nil
 }
 
+type EventDowntimeGraceModeStarted struct {
+	GraceMode *DowntimeGraceMode `protobuf:"bytes,1,opt,name=grace_mode,json=graceMode,proto3" json:"grace_mode,omitempty"`
+}
+
+func (m *EventDowntimeGraceModeStarted) Reset()         { *m = EventDowntimeGraceModeStarted{} }
+func (m *EventDowntimeGraceModeStarted) String() string { return proto.CompactTextString(m) }
+func (*EventDowntimeGraceModeStarted) ProtoMessage()    {}
+func (*EventDowntimeGraceModeStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ac8f3da550fa1c4, []int{45}
+}
+func (m *EventDowntimeGraceModeStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDowntimeGraceModeStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDowntimeGraceModeStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDowntimeGraceModeStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDowntimeGraceModeStarted.Merge(m, src)
+}
+func (m *EventDowntimeGraceModeStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDowntimeGraceModeStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDowntimeGraceModeStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDowntimeGraceModeStarted proto.InternalMessageInfo
+
+func (m *EventDowntimeGraceModeStarted) GetGraceMode() *DowntimeGraceMode {
+	if m != nil {
+		return m.GraceMode
+	}
+	return nil
+}
+
+type EventDowntimeGraceModeEnded struct {
+	GraceMode *DowntimeGraceMode `protobuf:"bytes,1,opt,name=grace_mode,json=graceMode,proto3" json:"grace_mode,omitempty"`
+	// cancelled indicates whether the grace mode was ended early by governance
+	// or an exchange admin
+	Cancelled bool `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *EventDowntimeGraceModeEnded) Reset()         { *m = EventDowntimeGraceModeEnded{} }
+func (m *EventDowntimeGraceModeEnded) String() string { return proto.CompactTextString(m) }
+func (*EventDowntimeGraceModeEnded) ProtoMessage()    {}
+func (*EventDowntimeGraceModeEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ac8f3da550fa1c4, []int{46}
+}
+func (m *EventDowntimeGraceModeEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDowntimeGraceModeEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDowntimeGraceModeEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDowntimeGraceModeEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDowntimeGraceModeEnded.Merge(m, src)
+}
+func (m *EventDowntimeGraceModeEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDowntimeGraceModeEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDowntimeGraceModeEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDowntimeGraceModeEnded proto.InternalMessageInfo
+
+func (m *EventDowntimeGraceModeEnded) GetGraceMode() *DowntimeGraceMode {
+	if m != nil {
+		return m.GraceMode
+	}
+	return nil
+}
+
+func (m *EventDowntimeGraceModeEnded) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventBatchSpotExecution)(nil), "injective.exchange.v2.EventBatchSpotExecution")
 	proto.RegisterType((*EventBatchDerivativeExecution)(nil), "injective.exchange.v2.EventBatchDerivativeExecution")
@@ -2698,6 +2796,8 @@ func init() {
 	proto.RegisterType((*EventTriggerConditionalLimitOrderFailed)(nil), "injective.exchange.v2.EventTriggerConditionalLimitOrderFailed")
 	proto.RegisterType((*SpotOrderV2Changes)(nil), "injective.exchange.v2.SpotOrderV2Changes")
 	proto.RegisterType((*EventDerivativePositionV2Migration)(nil), "injective.exchange.v2.EventDerivativePositionV2Migration")
+	proto.RegisterType((*EventDowntimeGraceModeStarted)(nil), "injective.exchange.v2.EventDowntimeGraceModeStarted")
+	proto.RegisterType((*EventDowntimeGraceModeEnded)(nil), "injective.exchange.v2.EventDowntimeGraceModeEnded")
 }
 
 func init() {
@@ -2705,158 +2805,161 @@ func init() {
 }
 
 var fileDescriptor_8ac8f3da550fa1c4 = []byte{
-	// 2401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xbb, 0x6f, 0x1c, 0xc7,
-	0x19, 0xd7, 0x1e, 0x29, 0x8a, 0xf7, 0x1d, 0x45, 0x8a, 0x2b, 0x51, 0xa6, 0x24, 0x8b, 0xa4, 0xd6,
-	0x7a, 0x59, 0xb6, 0xef, 0x2c, 0x1a, 0x81, 0x8b, 0xbc, 0xc0, 0xa7, 0x44, 0x83, 0x94, 0xe9, 0xa5,
-	0x64, 0x07, 0x09, 0x8c, 0xcd, 0xdc, 0xee, 0xf0, 0x6e, 0xcc, 0xbd, 0x9d, 0xe5, 0xce, 0xec, 0x49,
-	0x97, 0xce, 0x41, 0x0a, 0x77, 0x4e, 0x13, 0xc4, 0x4d, 0xba, 0x74, 0x69, 0x92, 0x2e, 0x40, 0x8a,
-	0x20, 0x6e, 0xe2, 0xd2, 0x49, 0x65, 0x18, 0x88, 0x11, 0x48, 0x55, 0xfe, 0x86, 0x34, 0xc1, 0x3c,
-	0xf6, 0x71, 0xef, 0x3b, 0x4a, 0x41, 0x02, 0x77, 0xbb, 0xb3, 0xdf, 0x6b, 0x7e, 0xdf, 0x63, 0xbe,
-	0x6f, 0xee, 0xc0, 0x22, 0xc1, 0x47, 0xd8, 0xe5, 0xa4, 0x89, 0x2b, 0xf8, 0x89, 0x5b, 0x47, 0x41,
-	0x0d, 0x57, 0x9a, 0xab, 0x15, 0xdc, 0xc4, 0x01, 0x67, 0xe5, 0x30, 0xa2, 0x9c, 0x9a, 0x0b, 0x29,
-	0x4d, 0x39, 0xa1, 0x29, 0x37, 0x57, 0x2f, 0x5f, 0xa8, 0xd1, 0x1a, 0x95, 0x14, 0x15, 0xf1, 0xa4,
-	0x88, 0x2f, 0x2f, 0xb9, 0x94, 0x35, 0x28, 0xab, 0x54, 0x11, 0xc3, 0x95, 0xe6, 0xdd, 0x2a, 0xe6,
-	0xe8, 0x6e, 0xc5, 0xa5, 0x24, 0xd0, 0xdf, 0x6f, 0x64, 0x0a, 0x69, 0x84, 0x5c, 0x3f, 0x23, 0x52,
-	0xaf, 0x9a, 0xec, 0x7a, 0x1f, 0xbb, 0x12, 0xfd, 0x8a, 0xaa, 0x8f, 0xf5, 0x0d, 0x14, 0x1d, 0x61,
-	0xae, 0x69, 0xae, 0xf5, 0xa6, 0xa1, 0x91, 0x87, 0x23, 0x45, 0x62, 0xfd, 0xdd, 0x80, 0x97, 0xb6,
-	0xc4, 0x8e, 0xd7, 0x11, 0x77, 0xeb, 0x07, 0x21, 0xe5, 0x5b, 0x4f, 0xb0, 0x1b, 0x73, 0x42, 0x03,
-	0xf3, 0x0a, 0x14, 0x95, 0x38, 0x87, 0x78, 0x8b, 0xc6, 0x8a, 0x71, 0xbb, 0x68, 0x4f, 0xab, 0x85,
-	0x1d, 0xcf, 0x5c, 0x80, 0x29, 0xc2, 0x9c, 0x6a, 0xdc, 0x5a, 0x2c, 0xac, 0x18, 0xb7, 0xa7, 0xed,
-	0xd3, 0x84, 0xad, 0xc7, 0x2d, 0xf3, 0x1d, 0x38, 0x8b, 0x13, 0x01, 0x0f, 0x5b, 0x21, 0x5e, 0x9c,
-	0x58, 0x31, 0x6e, 0xcf, 0xae, 0x5e, 0x2f, 0xf7, 0x04, 0xb2, 0xbc, 0x95, 0xa7, 0xb5, 0xdb, 0x59,
-	0xcd, 0xb7, 0x61, 0x8a, 0x47, 0xc8, 0xc3, 0x6c, 0x71, 0x72, 0x65, 0xe2, 0x76, 0x69, 0x75, 0xb9,
-	0x8f, 0x90, 0x87, 0x82, 0x68, 0x97, 0xd6, 0x6c, 0x4d, 0x6e, 0xfd, 0xa3, 0x00, 0x57, 0xb3, 0x4d,
-	0x6d, 0xe2, 0x88, 0x34, 0x91, 0xe0, 0x7a, 0xbe, 0xad, 0xdd, 0x80, 0x59, 0xc2, 0x1c, 0x9f, 0x1c,
-	0xc7, 0xc4, 0x43, 0x42, 0x8a, 0xdc, 0xdb, 0xb4, 0x7d, 0x96, 0xb0, 0xdd, 0x6c, 0xd1, 0xb4, 0xc1,
-	0x74, 0xe3, 0x46, 0xec, 0x4b, 0x8d, 0xce, 0x61, 0x1c, 0x78, 0x24, 0xa8, 0x2d, 0x4e, 0x0a, 0x1d,
-	0xeb, 0xaf, 0x7c, 0xf1, 0xcd, 0xb2, 0xf1, 0xf5, 0x37, 0xcb, 0x57, 0x54, 0xa4, 0x30, 0xef, 0xa8,
-	0x4c, 0x68, 0xa5, 0x81, 0x78, 0xbd, 0xbc, 0x8b, 0x6b, 0xc8, 0x6d, 0x6d, 0x62, 0xd7, 0x9e, 0xcf,
-	0xd8, 0xb7, 0x15, 0x77, 0x37, 0xaa, 0xa7, 0x4f, 0x8e, 0xea, 0x5a, 0x8a, 0xea, 0x94, 0x44, 0xf5,
-	0xd5, 0x3e, 0x42, 0x32, 0xd8, 0xba, 0xf0, 0xfd, 0x3c, 0xc1, 0x77, 0x97, 0x32, 0x2e, 0x6c, 0x64,
-	0xdb, 0x11, 0x6d, 0xe4, 0x41, 0x18, 0x88, 0xef, 0x2b, 0x70, 0x96, 0xc5, 0x55, 0xe4, 0xba, 0x34,
-	0x0e, 0x24, 0x81, 0x80, 0x79, 0xc6, 0x9e, 0xc9, 0x16, 0x77, 0x3c, 0xf3, 0x09, 0xdc, 0xf2, 0x29,
-	0xe3, 0x12, 0x40, 0xe6, 0x1c, 0x46, 0xb4, 0xe1, 0xa0, 0x26, 0x22, 0x3e, 0xaa, 0xfa, 0xd8, 0xf1,
-	0xe2, 0x88, 0x04, 0x35, 0x27, 0x44, 0x2d, 0x1a, 0x73, 0xe9, 0x06, 0x85, 0xed, 0xa9, 0x61, 0xd8,
-	0x5a, 0x7e, 0xde, 0xe2, 0xb5, 0x44, 0xe0, 0xa6, 0x94, 0xb7, 0x2f, 0xc5, 0x99, 0x18, 0xae, 0x76,
-	0x6a, 0x96, 0x19, 0xe3, 0xb8, 0x28, 0x70, 0xb1, 0xcf, 0x72, 0xbe, 0x1c, 0xaa, 0xef, 0x52, 0x9b,
-	0xbe, 0x77, 0x85, 0x98, 0x0d, 0x25, 0xc5, 0xfa, 0x85, 0x01, 0x2f, 0xf7, 0x0a, 0xd2, 0x7d, 0xca,
-	0xc8, 0x70, 0x0c, 0xef, 0x41, 0x31, 0xd4, 0x84, 0x6c, 0xb1, 0x30, 0xd0, 0x91, 0x07, 0x29, 0xac,
-	0x89, 0x68, 0x3b, 0xe3, 0xb5, 0xfe, 0x64, 0xc0, 0x15, 0x69, 0x46, 0x66, 0xc1, 0x9e, 0x54, 0xb2,
-	0x8f, 0x62, 0x86, 0xbd, 0xc1, 0x56, 0x5c, 0x83, 0x19, 0x86, 0x39, 0xf7, 0xb1, 0x13, 0x46, 0xc4,
-	0xc5, 0xd2, 0x91, 0x45, 0xbb, 0xa4, 0xd6, 0xf6, 0xc5, 0x92, 0x59, 0x86, 0xf3, 0x9c, 0x72, 0xe4,
-	0x3b, 0x0d, 0xc2, 0x98, 0x70, 0x9a, 0x84, 0x55, 0xf9, 0xcc, 0x9e, 0x97, 0x9f, 0xf6, 0xd4, 0x17,
-	0x09, 0x93, 0xf9, 0x3a, 0x98, 0x6d, 0x94, 0x4e, 0x84, 0x38, 0x56, 0x90, 0xdb, 0xe7, 0x1a, 0x39,
-	0x4a, 0x1b, 0x71, 0x6c, 0xed, 0xc3, 0x25, 0x69, 0xfc, 0x81, 0xd4, 0xe8, 0x29, 0xcb, 0xd7, 0x91,
-	0x2f, 0x30, 0x1e, 0x6c, 0xfa, 0x45, 0x98, 0x42, 0x0d, 0x01, 0x8a, 0x36, 0x5a, 0xbf, 0x59, 0x07,
-	0xda, 0x2b, 0x0f, 0xe8, 0x0b, 0x14, 0xfa, 0x69, 0x02, 0xb2, 0x96, 0x85, 0x5b, 0x34, 0xf0, 0xd6,
-	0x51, 0x70, 0x14, 0xc5, 0x21, 0x77, 0x5b, 0xcf, 0x0d, 0xf2, 0x9b, 0x70, 0x21, 0x01, 0x4d, 0xcb,
-	0xc9, 0xa3, 0x9c, 0x00, 0xaa, 0x94, 0x4b, 0xf0, 0xac, 0x4f, 0x0c, 0x58, 0x94, 0x16, 0xad, 0xf9,
-	0x7e, 0x12, 0x16, 0xec, 0x3e, 0x22, 0x91, 0x1b, 0xf3, 0xe7, 0x36, 0xa7, 0xb7, 0x0f, 0x27, 0xfa,
-	0xf8, 0xf0, 0x23, 0x58, 0x52, 0x79, 0x40, 0x02, 0x14, 0xb5, 0xde, 0x0d, 0xa5, 0x29, 0xca, 0xd6,
-	0x47, 0xa1, 0x87, 0x38, 0x36, 0xef, 0xc3, 0x94, 0x52, 0x2f, 0x8d, 0x29, 0xad, 0xde, 0xe9, 0x13,
-	0xe9, 0x3d, 0x24, 0xac, 0x4f, 0x8a, 0x34, 0xb5, 0x35, 0xbf, 0xf5, 0x67, 0x03, 0x4c, 0xe5, 0x5e,
-	0xfc, 0x58, 0x1c, 0x76, 0x32, 0x23, 0xd9, 0xe0, 0x0d, 0x6f, 0x02, 0x54, 0xe3, 0x96, 0xaa, 0x01,
-	0x49, 0xae, 0xdd, 0xe8, 0x97, 0x6b, 0x21, 0xe5, 0xbb, 0xa4, 0x41, 0x94, 0x60, 0xbb, 0x58, 0x8d,
-	0x5b, 0x5a, 0xc5, 0x36, 0x94, 0x18, 0xf6, 0xfd, 0x44, 0xcc, 0xc4, 0x38, 0x62, 0x40, 0x70, 0x2a,
-	0x39, 0xd6, 0xdf, 0x12, 0xc7, 0x3d, 0xc0, 0x8f, 0xb3, 0x94, 0x1d, 0x65, 0x1f, 0xef, 0xf4, 0xd8,
-	0xc7, 0x6b, 0x43, 0x8b, 0x7f, 0xef, 0xdd, 0xec, 0xf6, 0xda, 0xcd, 0x58, 0xc2, 0xf2, 0x7b, 0x6a,
-	0xc2, 0x05, 0xb9, 0x25, 0x55, 0x1a, 0x53, 0xbf, 0x0c, 0xde, 0xce, 0x1a, 0x9c, 0x96, 0xda, 0x65,
-	0x00, 0x8e, 0x0a, 0xa5, 0x0e, 0x07, 0xc5, 0x69, 0xfd, 0x08, 0x16, 0x54, 0xf5, 0x08, 0x29, 0x6f,
-	0x0b, 0xb8, 0x1f, 0x76, 0x04, 0xdc, 0xb5, 0x01, 0xc2, 0x7b, 0xc6, 0xd9, 0x67, 0x05, 0xb8, 0x2c,
-	0x45, 0xef, 0xe3, 0x28, 0xc4, 0x3c, 0x46, 0x7e, 0x9b, 0xfc, 0xad, 0x0e, 0xf9, 0xb7, 0x86, 0x22,
-	0xd7, 0x4b, 0x8b, 0xe9, 0xc1, 0x42, 0x98, 0xc8, 0x4f, 0x12, 0x9f, 0x04, 0x87, 0x54, 0x43, 0xd2,
-	0x2f, 0x4d, 0x3a, 0x6c, 0xda, 0x09, 0x0e, 0xa9, 0x14, 0x6c, 0xd8, 0xe7, 0xc3, 0xee, 0x4f, 0xe6,
-	0x1e, 0x9c, 0x49, 0xba, 0x98, 0x09, 0x29, 0xf7, 0x8d, 0xd1, 0xe4, 0xea, 0xe6, 0x45, 0x8b, 0x4e,
-	0x64, 0x58, 0x5f, 0x1b, 0x3a, 0xdf, 0xb7, 0x9e, 0x84, 0x24, 0x6a, 0x6d, 0xc7, 0x3c, 0x8e, 0x30,
-	0xfb, 0x6f, 0xc0, 0x73, 0x0c, 0x97, 0xb1, 0xd4, 0xe1, 0x1c, 0x2a, 0x25, 0x6d, 0x18, 0xa9, 0xbd,
-	0x94, 0xfb, 0xb6, 0x50, 0x5d, 0xc6, 0xe5, 0x70, 0x7a, 0x09, 0xf7, 0xfe, 0x6c, 0xfd, 0xb5, 0x00,
-	0xd7, 0x7a, 0xf9, 0x5d, 0x63, 0xa1, 0xf7, 0x37, 0x30, 0xae, 0x73, 0x70, 0x17, 0x4e, 0x0a, 0xf7,
-	0xa9, 0x14, 0x6e, 0xf3, 0x0e, 0xcc, 0x13, 0xe6, 0xd4, 0x69, 0x1c, 0xf9, 0x2d, 0x27, 0xef, 0xc7,
-	0x69, 0x7b, 0x8e, 0xb0, 0xfb, 0x72, 0x3d, 0x69, 0x33, 0xb7, 0x61, 0x46, 0x53, 0xe4, 0x4e, 0xdd,
-	0xd1, 0x9a, 0xd6, 0x92, 0x66, 0x14, 0x15, 0xdd, 0x5c, 0x07, 0x10, 0xdb, 0xd1, 0x07, 0xc4, 0xe9,
-	0xd1, 0xa5, 0x48, 0x58, 0xe4, 0x19, 0x62, 0xfd, 0xda, 0x80, 0x8b, 0x2a, 0x39, 0xd3, 0xf6, 0x65,
-	0x13, 0xcb, 0xb6, 0xc5, 0x5c, 0x86, 0x12, 0x8b, 0x5c, 0x07, 0x79, 0x5e, 0x84, 0x19, 0xd3, 0x00,
-	0x02, 0x8b, 0xdc, 0x35, 0xb5, 0x32, 0x5a, 0x83, 0xf9, 0x76, 0x7a, 0x56, 0xab, 0x48, 0xb8, 0x54,
-	0x56, 0x96, 0x95, 0xc5, 0xf8, 0x56, 0xd6, 0x93, 0x59, 0x79, 0x83, 0x92, 0x20, 0x09, 0x2b, 0x7d,
-	0x98, 0x7f, 0x96, 0x8c, 0x4c, 0x99, 0x65, 0x1f, 0x10, 0x5e, 0xf7, 0x22, 0xf4, 0xb8, 0x5b, 0xb3,
-	0xd1, 0x43, 0xf3, 0x32, 0x94, 0x3c, 0xc6, 0x53, 0xfb, 0xd5, 0x01, 0x0a, 0x1e, 0xe3, 0x89, 0xfd,
-	0x27, 0x36, 0xed, 0x0f, 0x49, 0x6e, 0x65, 0xa6, 0xe9, 0xbe, 0xe5, 0x61, 0x84, 0x02, 0x76, 0x88,
-	0x23, 0x11, 0x0f, 0x02, 0xbc, 0x6e, 0x2b, 0x8b, 0xf6, 0x1c, 0x8b, 0xdc, 0x83, 0xbc, 0xa1, 0x77,
-	0x60, 0x5e, 0x18, 0xda, 0x8d, 0x65, 0xd1, 0x9e, 0xf3, 0x18, 0x3f, 0x78, 0x21, 0x70, 0xd6, 0xf3,
-	0x03, 0xa8, 0x76, 0xb1, 0xce, 0x93, 0x3d, 0x98, 0xf3, 0xd4, 0x82, 0x13, 0xcb, 0x15, 0xe1, 0x6c,
-	0x71, 0xd2, 0x5c, 0xef, 0x5b, 0x10, 0x72, 0xec, 0xf6, 0xac, 0x97, 0x7f, 0x65, 0xd6, 0xe7, 0x06,
-	0x5c, 0xe9, 0x2c, 0x19, 0xb9, 0x96, 0xdc, 0x7c, 0x04, 0x33, 0x3a, 0x2d, 0xd5, 0xc1, 0xa2, 0x8a,
-	0xcf, 0xeb, 0x23, 0x16, 0x9f, 0xec, 0x7c, 0x31, 0xec, 0x52, 0x23, 0x5b, 0x32, 0x77, 0x61, 0x4e,
-	0x4d, 0x0e, 0xce, 0x71, 0x8c, 0x02, 0x4e, 0xb8, 0x9a, 0x2b, 0x47, 0x9c, 0x20, 0x66, 0x15, 0xef,
-	0x7b, 0x9a, 0xd5, 0xfa, 0x4d, 0x72, 0xb2, 0x28, 0xa3, 0x3b, 0x5a, 0x80, 0xc1, 0xa5, 0xe5, 0x3a,
-	0xc8, 0x59, 0xb5, 0x41, 0x34, 0xb3, 0x9e, 0x6f, 0xdb, 0x17, 0x4d, 0x1b, 0x4a, 0xbe, 0x78, 0xd5,
-	0x28, 0x28, 0x77, 0x8e, 0x73, 0xb6, 0x6b, 0x10, 0xc0, 0x4f, 0x57, 0xcc, 0x3a, 0x9c, 0xcf, 0x43,
-	0xab, 0x47, 0x29, 0x59, 0x60, 0x4a, 0xab, 0xab, 0xe3, 0x20, 0xac, 0x8c, 0xd4, 0x2a, 0xe6, 0x1b,
-	0x9d, 0x1f, 0xac, 0xaa, 0x6e, 0x8f, 0xb6, 0x31, 0xde, 0x24, 0x4c, 0x46, 0xe7, 0x81, 0x5b, 0xc7,
-	0x5e, 0xec, 0x63, 0x73, 0x1b, 0xa6, 0x99, 0x7e, 0x1e, 0xd2, 0x49, 0xf6, 0xe0, 0xb6, 0x53, 0x5e,
-	0xeb, 0x2b, 0x03, 0x56, 0xa4, 0x12, 0x31, 0x19, 0x8b, 0xa2, 0x87, 0x1f, 0xa3, 0xc8, 0xdb, 0x40,
-	0x8d, 0x10, 0x91, 0x5a, 0xa0, 0x83, 0xf7, 0x11, 0x9c, 0x75, 0xf5, 0x8a, 0x3a, 0x70, 0x94, 0xc6,
-	0x37, 0x07, 0x5c, 0x62, 0x74, 0x89, 0x12, 0x67, 0x8a, 0x3d, 0xe3, 0xe6, 0xde, 0xcc, 0x0f, 0x61,
-	0x21, 0x15, 0x1b, 0x49, 0x62, 0x27, 0xa4, 0xd4, 0x1f, 0x36, 0x04, 0x26, 0x12, 0x95, 0xfc, 0x7d,
-	0x4a, 0x7d, 0xfb, 0xbc, 0xdb, 0xb5, 0xc6, 0xac, 0x50, 0x17, 0x90, 0x36, 0x73, 0x36, 0x09, 0xe3,
-	0x11, 0xa9, 0xaa, 0xab, 0x93, 0x07, 0x30, 0x97, 0x54, 0x03, 0xa5, 0x3f, 0x49, 0xca, 0x7e, 0x1d,
-	0xd8, 0x9a, 0xa2, 0x56, 0xa2, 0x98, 0x3d, 0x8b, 0xda, 0xde, 0xad, 0xdf, 0x1b, 0x60, 0x25, 0x0d,
-	0xed, 0x06, 0x0d, 0x3c, 0x39, 0x8a, 0xa0, 0xf1, 0x02, 0xfb, 0x7b, 0xed, 0xbd, 0xe0, 0xcd, 0xa1,
-	0x01, 0xa5, 0x7a, 0x50, 0xc5, 0x64, 0x9a, 0x30, 0x59, 0x47, 0xac, 0x2e, 0x23, 0x7d, 0xc6, 0x96,
-	0xcf, 0x42, 0x1d, 0x49, 0xfa, 0x05, 0x19, 0xa6, 0xd3, 0xf6, 0x34, 0xd1, 0x27, 0xbd, 0xf5, 0xab,
-	0x02, 0xdc, 0xc8, 0xe5, 0xe0, 0x49, 0xad, 0xfe, 0xdf, 0xa5, 0x63, 0x67, 0xa5, 0x9b, 0x7c, 0x21,
-	0x95, 0xce, 0xfa, 0xb7, 0x01, 0x37, 0x15, 0x2e, 0x7d, 0x11, 0x79, 0x18, 0x91, 0x5a, 0xad, 0x17,
-	0x30, 0x33, 0x39, 0x60, 0x6e, 0xc2, 0xac, 0xc6, 0x40, 0x93, 0x6b, 0x64, 0x3a, 0x56, 0xc5, 0xd8,
-	0xcb, 0xd5, 0x23, 0xf6, 0x74, 0x61, 0xc9, 0x39, 0xd2, 0x4c, 0xbf, 0x49, 0xcd, 0xf7, 0x85, 0x5b,
-	0xef, 0xc0, 0x7c, 0xe8, 0x23, 0xb7, 0x9d, 0x7c, 0x52, 0x92, 0xcf, 0xa9, 0x0f, 0x19, 0x6d, 0x19,
-	0xce, 0x77, 0x4a, 0x77, 0x89, 0xa7, 0xda, 0x19, 0x7b, 0xbe, 0x5d, 0xf8, 0x06, 0xf1, 0x2c, 0x1f,
-	0x66, 0xe5, 0xe6, 0xe5, 0xc2, 0x36, 0x22, 0xbe, 0xb9, 0x08, 0x67, 0x74, 0xb0, 0xeb, 0x2d, 0x26,
-	0xaf, 0xe6, 0x45, 0x98, 0x12, 0xaa, 0xb1, 0x4a, 0xdb, 0x19, 0x5b, 0xbf, 0x99, 0x17, 0xe0, 0xf4,
-	0xa1, 0x8f, 0x6a, 0x6a, 0xa2, 0x3a, 0x6b, 0xab, 0x17, 0x11, 0xa0, 0x2e, 0xf1, 0xd4, 0x35, 0x68,
-	0xd1, 0x96, 0xcf, 0xd6, 0xa7, 0x06, 0xbc, 0xa6, 0x06, 0x78, 0x4e, 0x1b, 0xc4, 0xcd, 0x79, 0x66,
-	0x1b, 0xe3, 0xbd, 0xd8, 0xe7, 0x24, 0xf4, 0x09, 0x8e, 0x98, 0xaa, 0x46, 0x9e, 0xf9, 0x53, 0xb8,
-	0x98, 0x5c, 0x0d, 0x60, 0xec, 0x34, 0x32, 0x02, 0x9d, 0xbd, 0xfd, 0x2a, 0xa1, 0x6e, 0x2e, 0xf3,
-	0x32, 0xed, 0x0b, 0x8d, 0xee, 0x45, 0x66, 0xfd, 0xd1, 0xd0, 0x63, 0x9c, 0xb4, 0xa2, 0x4a, 0xe9,
-	0x91, 0xae, 0x84, 0x3b, 0x30, 0xc3, 0x42, 0xda, 0x79, 0x86, 0xf7, 0x4b, 0xd2, 0x0e, 0x6e, 0xbb,
-	0x24, 0x78, 0xf5, 0x11, 0x6e, 0x3e, 0x02, 0xd3, 0x4b, 0x03, 0x2a, 0x15, 0x58, 0x18, 0x4b, 0xe0,
-	0x7c, 0x26, 0x21, 0xe9, 0x0c, 0x5c, 0x98, 0xeb, 0x34, 0xfa, 0x1c, 0x4c, 0x30, 0x7c, 0x2c, 0xfd,
-	0x36, 0x69, 0x8b, 0x47, 0xf3, 0x07, 0x50, 0xa4, 0x09, 0x91, 0x2e, 0x34, 0x2b, 0xc3, 0x54, 0xda,
-	0x19, 0x8b, 0xf5, 0x5b, 0x03, 0x8a, 0xe9, 0x87, 0xc1, 0x09, 0xf0, 0x5d, 0x35, 0xaa, 0xfb, 0xb8,
-	0x89, 0xd3, 0xca, 0xfe, 0x72, 0x1f, 0x5d, 0xbb, 0x82, 0x48, 0xce, 0xe6, 0xf2, 0x89, 0x99, 0xdf,
-	0xd7, 0xb3, 0xb9, 0xe6, 0x9e, 0x18, 0x81, 0x5b, 0x0e, 0xe3, 0x8a, 0xdd, 0x7a, 0xac, 0x0f, 0xd0,
-	0x7b, 0x11, 0x0a, 0xf8, 0x5a, 0xcc, 0xeb, 0x34, 0x22, 0x3f, 0x93, 0xb7, 0xba, 0x4c, 0x04, 0x74,
-	0x4d, 0x2c, 0xeb, 0xe6, 0xa8, 0x68, 0x27, 0xaf, 0xe6, 0x1a, 0x4c, 0xc9, 0xc7, 0x61, 0xe7, 0x50,
-	0xb7, 0x54, 0x5b, 0x33, 0x5a, 0x1f, 0x27, 0xf1, 0xa3, 0x68, 0x04, 0xaf, 0xba, 0x4c, 0x4e, 0xb5,
-	0xe2, 0x76, 0xad, 0x38, 0x6f, 0x4f, 0xa1, 0xdd, 0x9e, 0xef, 0xb4, 0xb5, 0xa3, 0xc5, 0xf5, 0xab,
-	0xba, 0xd7, 0x5a, 0xe8, 0xee, 0xb5, 0x76, 0x02, 0x9e, 0x36, 0xa3, 0xf7, 0x60, 0x5e, 0x9a, 0xb0,
-	0x13, 0x34, 0x91, 0x4f, 0x3c, 0x69, 0xc9, 0x49, 0xf4, 0x5b, 0xbf, 0x6b, 0x4b, 0x06, 0x55, 0xca,
-	0x65, 0x4d, 0x18, 0xff, 0x66, 0xbc, 0xd8, 0x31, 0x3e, 0x5c, 0x05, 0xe8, 0xa8, 0x75, 0x45, 0x1d,
-	0x66, 0xb2, 0x6c, 0x9d, 0x83, 0x09, 0x51, 0xa6, 0xd4, 0x8d, 0xa9, 0x78, 0x34, 0x57, 0xa0, 0xe4,
-	0x61, 0xe6, 0x46, 0x44, 0x5e, 0x8c, 0xe9, 0x02, 0x96, 0x5f, 0x12, 0x85, 0x7b, 0xa5, 0xe3, 0x12,
-	0x58, 0x5d, 0xcd, 0xbc, 0xbf, 0xba, 0x47, 0x6a, 0xd1, 0x08, 0x77, 0xfa, 0x3f, 0x81, 0xf9, 0xf4,
-	0x72, 0xc9, 0x51, 0xee, 0x4e, 0x42, 0xa1, 0x32, 0xda, 0x69, 0xfc, 0xfe, 0xea, 0x86, 0x62, 0xb3,
-	0xe7, 0x92, 0x7b, 0x26, 0xbd, 0x60, 0x7e, 0x08, 0x66, 0x76, 0xdb, 0x94, 0x4a, 0x9f, 0x38, 0x99,
-	0xf4, 0x73, 0xe9, 0xc5, 0x93, 0x5e, 0xb1, 0xfe, 0x52, 0x80, 0xc5, 0x7e, 0xe4, 0x09, 0x9c, 0x46,
-	0x06, 0x67, 0xd2, 0x2e, 0x14, 0x72, 0xed, 0xc2, 0x5d, 0x30, 0xc2, 0x71, 0x7e, 0x87, 0x30, 0x42,
-	0xc1, 0x72, 0x3c, 0xce, 0x4f, 0x09, 0xc6, 0xb1, 0x60, 0x69, 0xe4, 0xc6, 0xe9, 0xe1, 0x2c, 0x0d,
-	0xc1, 0x72, 0xb8, 0x38, 0x35, 0x06, 0xcb, 0xa1, 0xf9, 0x16, 0x14, 0x78, 0xb8, 0x78, 0x66, 0xf4,
-	0xa9, 0xbd, 0xc0, 0x43, 0xeb, 0x5f, 0x86, 0x1e, 0x4b, 0xb2, 0x5b, 0xd5, 0x91, 0x63, 0xe7, 0x51,
-	0xff, 0xd8, 0x79, 0x75, 0xc0, 0xc5, 0xdb, 0xb0, 0xa8, 0xf9, 0x60, 0x40, 0xd4, 0x8c, 0x21, 0xb7,
-	0x3b, 0x5e, 0x7e, 0x5e, 0x80, 0xdb, 0xba, 0x49, 0x96, 0x3d, 0x40, 0xae, 0xdb, 0xc9, 0x1f, 0xc3,
-	0x88, 0xf8, 0xc3, 0x7e, 0x3f, 0x19, 0x29, 0xdf, 0xdb, 0x6f, 0x53, 0xc6, 0x08, 0xb2, 0xec, 0x36,
-	0xa5, 0xa3, 0x66, 0xa8, 0x86, 0x27, 0x57, 0x33, 0x96, 0xa1, 0xa4, 0xfb, 0x19, 0x07, 0x47, 0x91,
-	0xae, 0x10, 0xa0, 0x97, 0xb6, 0xa2, 0x28, 0xc9, 0x82, 0xa9, 0x34, 0x0b, 0xac, 0x8f, 0x0b, 0x70,
-	0xab, 0x0f, 0x08, 0x59, 0xeb, 0xf9, 0x2d, 0xc7, 0xe0, 0x93, 0x02, 0x98, 0xdd, 0x11, 0xf3, 0xff,
-	0x56, 0x32, 0x0e, 0xc7, 0x2a, 0x19, 0x49, 0xfe, 0x4f, 0x8d, 0x97, 0xff, 0x47, 0x7a, 0x88, 0xeb,
-	0xfe, 0x1d, 0x33, 0x5f, 0x06, 0xb6, 0x60, 0x3a, 0xf9, 0xe5, 0x51, 0x8f, 0xc3, 0xc3, 0x7f, 0x7d,
-	0x4e, 0x7f, 0xb4, 0x4c, 0x59, 0xd7, 0x8f, 0xbe, 0x78, 0xba, 0x64, 0x7c, 0xf9, 0x74, 0xc9, 0xf8,
-	0xe7, 0xd3, 0x25, 0xe3, 0x97, 0xcf, 0x96, 0x4e, 0x7d, 0xf9, 0x6c, 0xe9, 0xd4, 0x57, 0xcf, 0x96,
-	0x4e, 0xfd, 0xf8, 0xbd, 0x1a, 0xe1, 0xf5, 0xb8, 0x5a, 0x76, 0x69, 0xa3, 0xb2, 0x93, 0x08, 0xde,
-	0x45, 0x55, 0x56, 0x49, 0xd5, 0xbc, 0xe1, 0xd2, 0x08, 0xe7, 0x5f, 0xeb, 0x88, 0x04, 0x95, 0x06,
-	0x15, 0x93, 0x3d, 0xcb, 0xfe, 0x27, 0xc1, 0x5b, 0x21, 0x66, 0x95, 0xe6, 0x6a, 0x75, 0x4a, 0xfe,
-	0x51, 0xe2, 0xad, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x8b, 0x83, 0xca, 0x2f, 0x22, 0x00,
-	0x00,
+	// 2460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcb, 0x6f, 0xdc, 0xc6,
+	0x19, 0x37, 0x57, 0x8f, 0x68, 0xbf, 0x95, 0x25, 0x8b, 0xb6, 0x92, 0x8d, 0x1f, 0x92, 0xcc, 0xf8,
+	0xa1, 0x38, 0xc9, 0x6e, 0xac, 0xa0, 0xc8, 0xa1, 0x2f, 0xe8, 0x69, 0x2b, 0x90, 0x1c, 0x85, 0xb2,
+	0x93, 0xa2, 0x45, 0xb0, 0x9d, 0x25, 0x47, 0xbb, 0x13, 0x91, 0x1c, 0x8a, 0x43, 0x4a, 0xde, 0xde,
+	0x52, 0xe4, 0x90, 0x5b, 0x7b, 0x29, 0x9a, 0x4b, 0x6f, 0xbd, 0xf5, 0xd2, 0xde, 0x0a, 0xf4, 0x50,
+	0x34, 0x97, 0xe6, 0x98, 0xf6, 0x14, 0x04, 0x68, 0x50, 0xd8, 0xa7, 0xfe, 0x0d, 0xb9, 0x14, 0xf3,
+	0x22, 0xb9, 0x4f, 0xed, 0xca, 0x2e, 0x5a, 0xf4, 0x46, 0x0e, 0xbf, 0xd7, 0xfc, 0xe6, 0xfb, 0xbe,
+	0xf9, 0xbe, 0x6f, 0x17, 0x2c, 0x12, 0x7c, 0x84, 0x9d, 0x98, 0x1c, 0xe3, 0x2a, 0x7e, 0xec, 0x34,
+	0x51, 0xd0, 0xc0, 0xd5, 0xe3, 0x95, 0x2a, 0x3e, 0xc6, 0x41, 0xcc, 0x2a, 0x61, 0x44, 0x63, 0x6a,
+	0xce, 0xa7, 0x34, 0x15, 0x4d, 0x53, 0x39, 0x5e, 0xb9, 0x7c, 0xa9, 0x41, 0x1b, 0x54, 0x50, 0x54,
+	0xf9, 0x93, 0x24, 0xbe, 0xbc, 0xe0, 0x50, 0xe6, 0x53, 0x56, 0xad, 0x23, 0x86, 0xab, 0xc7, 0x77,
+	0xeb, 0x38, 0x46, 0x77, 0xab, 0x0e, 0x25, 0x81, 0xfa, 0x7e, 0x33, 0x53, 0x48, 0x23, 0xe4, 0x78,
+	0x19, 0x91, 0x7c, 0x55, 0x64, 0x37, 0xfa, 0xd8, 0xa5, 0xf5, 0x4b, 0xaa, 0x3e, 0xd6, 0xfb, 0x28,
+	0x3a, 0xc4, 0xb1, 0xa2, 0xb9, 0xde, 0x9b, 0x86, 0x46, 0x2e, 0x8e, 0x24, 0x89, 0xf5, 0x77, 0x03,
+	0x5e, 0xda, 0xe4, 0x3b, 0x5e, 0x43, 0xb1, 0xd3, 0xdc, 0x0f, 0x69, 0xbc, 0xf9, 0x18, 0x3b, 0x49,
+	0x4c, 0x68, 0x60, 0x5e, 0x81, 0xa2, 0x14, 0x57, 0x23, 0x6e, 0xd9, 0x58, 0x32, 0x96, 0x8b, 0xf6,
+	0x94, 0x5c, 0xd8, 0x76, 0xcd, 0x79, 0x98, 0x24, 0xac, 0x56, 0x4f, 0x5a, 0xe5, 0xc2, 0x92, 0xb1,
+	0x3c, 0x65, 0x4f, 0x10, 0xb6, 0x96, 0xb4, 0xcc, 0x77, 0xe0, 0x3c, 0xd6, 0x02, 0x1e, 0xb6, 0x42,
+	0x5c, 0x1e, 0x5b, 0x32, 0x96, 0x67, 0x56, 0x6e, 0x54, 0x7a, 0x02, 0x59, 0xd9, 0xcc, 0xd3, 0xda,
+	0xed, 0xac, 0xe6, 0xdb, 0x30, 0x19, 0x47, 0xc8, 0xc5, 0xac, 0x3c, 0xbe, 0x34, 0xb6, 0x5c, 0x5a,
+	0x59, 0xec, 0x23, 0xe4, 0x21, 0x27, 0xda, 0xa1, 0x0d, 0x5b, 0x91, 0x5b, 0xff, 0x28, 0xc0, 0xb5,
+	0x6c, 0x53, 0x1b, 0x38, 0x22, 0xc7, 0x88, 0x73, 0x3d, 0xdb, 0xd6, 0x6e, 0xc2, 0x0c, 0x61, 0x35,
+	0x8f, 0x1c, 0x25, 0xc4, 0x45, 0x5c, 0x8a, 0xd8, 0xdb, 0x94, 0x7d, 0x9e, 0xb0, 0x9d, 0x6c, 0xd1,
+	0xb4, 0xc1, 0x74, 0x12, 0x3f, 0xf1, 0x84, 0xc6, 0xda, 0x41, 0x12, 0xb8, 0x24, 0x68, 0x94, 0xc7,
+	0xb9, 0x8e, 0xb5, 0x57, 0xbe, 0xf8, 0x66, 0xd1, 0xf8, 0xfa, 0x9b, 0xc5, 0x2b, 0xd2, 0x53, 0x98,
+	0x7b, 0x58, 0x21, 0xb4, 0xea, 0xa3, 0xb8, 0x59, 0xd9, 0xc1, 0x0d, 0xe4, 0xb4, 0x36, 0xb0, 0x63,
+	0xcf, 0x65, 0xec, 0x5b, 0x92, 0xbb, 0x1b, 0xd5, 0x89, 0xb3, 0xa3, 0xba, 0x9a, 0xa2, 0x3a, 0x29,
+	0x50, 0x7d, 0xb5, 0x8f, 0x90, 0x0c, 0xb6, 0x2e, 0x7c, 0x3f, 0xd7, 0xf8, 0xee, 0x50, 0x16, 0x73,
+	0x1b, 0xd9, 0x56, 0x44, 0xfd, 0x3c, 0x08, 0x03, 0xf1, 0x7d, 0x05, 0xce, 0xb3, 0xa4, 0x8e, 0x1c,
+	0x87, 0x26, 0x81, 0x20, 0xe0, 0x30, 0x4f, 0xdb, 0xd3, 0xd9, 0xe2, 0xb6, 0x6b, 0x3e, 0x86, 0xdb,
+	0x1e, 0x65, 0xb1, 0x00, 0x90, 0xd5, 0x0e, 0x22, 0xea, 0xd7, 0xd0, 0x31, 0x22, 0x1e, 0xaa, 0x7b,
+	0xb8, 0xe6, 0x26, 0x11, 0x09, 0x1a, 0xb5, 0x10, 0xb5, 0x68, 0x12, 0x97, 0xc7, 0x52, 0x6c, 0xcf,
+	0x9d, 0x86, 0xad, 0xe5, 0xe5, 0x2d, 0x5e, 0xd5, 0x02, 0x37, 0x84, 0xbc, 0x3d, 0x21, 0xce, 0xc4,
+	0x70, 0xad, 0x53, 0xb3, 0x88, 0x98, 0x9a, 0x83, 0x02, 0x07, 0x7b, 0xac, 0x3c, 0x3e, 0xbc, 0xbe,
+	0x97, 0xdb, 0xf4, 0xbd, 0xcb, 0xc5, 0xac, 0x4b, 0x29, 0xd6, 0x27, 0x06, 0x5c, 0xed, 0xe5, 0xa4,
+	0x7b, 0x94, 0x91, 0xd3, 0x31, 0xbc, 0x07, 0xc5, 0x50, 0x11, 0xb2, 0x72, 0x61, 0xe0, 0x41, 0xee,
+	0xa7, 0xb0, 0x6a, 0xd1, 0x76, 0xc6, 0x6b, 0xfd, 0xc9, 0x80, 0x2b, 0xc2, 0x8c, 0xcc, 0x82, 0x5d,
+	0xa1, 0x64, 0x0f, 0x25, 0x0c, 0xbb, 0x83, 0xad, 0xb8, 0x0e, 0xd3, 0x0c, 0xc7, 0xb1, 0x87, 0x6b,
+	0x61, 0x44, 0x1c, 0x2c, 0x0e, 0xb2, 0x68, 0x97, 0xe4, 0xda, 0x1e, 0x5f, 0x32, 0x2b, 0x70, 0x31,
+	0xa6, 0x31, 0xf2, 0x6a, 0x3e, 0x61, 0x8c, 0x1f, 0x9a, 0x80, 0x55, 0x9e, 0x99, 0x3d, 0x27, 0x3e,
+	0xed, 0xca, 0x2f, 0x02, 0x26, 0xf3, 0x75, 0x30, 0xdb, 0x28, 0x6b, 0x11, 0x8a, 0xb1, 0x84, 0xdc,
+	0xbe, 0xe0, 0xe7, 0x28, 0x6d, 0x14, 0x63, 0x6b, 0x0f, 0x5e, 0x16, 0xc6, 0xef, 0x0b, 0x8d, 0xae,
+	0xb4, 0x7c, 0x0d, 0x79, 0x1c, 0xe3, 0xc1, 0xa6, 0xbf, 0x08, 0x93, 0xc8, 0xe7, 0xa0, 0x28, 0xa3,
+	0xd5, 0x9b, 0xb5, 0xaf, 0x4e, 0xe5, 0x01, 0x7d, 0x8e, 0x42, 0x7f, 0xa1, 0x41, 0x56, 0xb2, 0x70,
+	0x8b, 0x06, 0xee, 0x1a, 0x0a, 0x0e, 0xa3, 0x24, 0x8c, 0x9d, 0xd6, 0x33, 0x83, 0xfc, 0x26, 0x5c,
+	0xd2, 0xa0, 0x29, 0x39, 0x79, 0x94, 0x35, 0xa0, 0x52, 0xb9, 0x00, 0xcf, 0xfa, 0xd4, 0x80, 0xb2,
+	0xb0, 0x68, 0xd5, 0xf3, 0xb4, 0x5b, 0xb0, 0xfb, 0x88, 0x44, 0x4e, 0x12, 0x3f, 0xb3, 0x39, 0xbd,
+	0xcf, 0x70, 0xac, 0xcf, 0x19, 0x7e, 0x04, 0x0b, 0x32, 0x0e, 0x48, 0x80, 0xa2, 0xd6, 0xbb, 0xa1,
+	0x30, 0x45, 0xda, 0xfa, 0x28, 0x74, 0x51, 0x8c, 0xcd, 0xfb, 0x30, 0x29, 0xd5, 0x0b, 0x63, 0x4a,
+	0x2b, 0x77, 0xfa, 0x78, 0x7a, 0x0f, 0x09, 0x6b, 0xe3, 0x3c, 0x4c, 0x6d, 0xc5, 0x6f, 0xfd, 0xd9,
+	0x00, 0x53, 0x1e, 0x2f, 0x3e, 0xe1, 0x97, 0x9d, 0x88, 0x48, 0x36, 0x78, 0xc3, 0x1b, 0x00, 0xf5,
+	0xa4, 0x25, 0x73, 0x80, 0x8e, 0xb5, 0x9b, 0xfd, 0x62, 0x2d, 0xa4, 0xf1, 0x0e, 0xf1, 0x89, 0x14,
+	0x6c, 0x17, 0xeb, 0x49, 0x4b, 0xa9, 0xd8, 0x82, 0x12, 0xc3, 0x9e, 0xa7, 0xc5, 0x8c, 0x8d, 0x22,
+	0x06, 0x38, 0xa7, 0x94, 0x63, 0xfd, 0x4d, 0x1f, 0xdc, 0x03, 0x7c, 0x92, 0x85, 0xec, 0x30, 0xfb,
+	0x78, 0xa7, 0xc7, 0x3e, 0x5e, 0x3b, 0x35, 0xf9, 0xf7, 0xde, 0xcd, 0x4e, 0xaf, 0xdd, 0x8c, 0x24,
+	0x2c, 0xbf, 0xa7, 0x63, 0xb8, 0x24, 0xb6, 0x24, 0x53, 0x63, 0x7a, 0x2e, 0x83, 0xb7, 0xb3, 0x0a,
+	0x13, 0x42, 0xbb, 0x70, 0xc0, 0x61, 0xa1, 0x54, 0xee, 0x20, 0x39, 0xad, 0x1f, 0xc1, 0xbc, 0xcc,
+	0x1e, 0x21, 0x8d, 0xdb, 0x1c, 0xee, 0x87, 0x1d, 0x0e, 0x77, 0x7d, 0x80, 0xf0, 0x9e, 0x7e, 0xf6,
+	0x59, 0x01, 0x2e, 0x0b, 0xd1, 0x7b, 0x38, 0x0a, 0x71, 0x9c, 0x20, 0xaf, 0x4d, 0xfe, 0x66, 0x87,
+	0xfc, 0xdb, 0xa7, 0x22, 0xd7, 0x4b, 0x8b, 0xe9, 0xc2, 0x7c, 0xa8, 0xe5, 0xeb, 0xc0, 0x27, 0xc1,
+	0x01, 0x2d, 0x17, 0x06, 0x86, 0x49, 0x87, 0x4d, 0xdb, 0xc1, 0x01, 0x15, 0x82, 0x0d, 0xfb, 0x62,
+	0xd8, 0xfd, 0xc9, 0xdc, 0x85, 0x17, 0x74, 0x15, 0x33, 0x26, 0xe4, 0xbe, 0x31, 0x9c, 0x5c, 0x55,
+	0xbc, 0x28, 0xd1, 0x5a, 0x86, 0xf5, 0xb5, 0xa1, 0xe2, 0x7d, 0xf3, 0x71, 0x48, 0xa2, 0xd6, 0x56,
+	0x12, 0x27, 0x11, 0x66, 0xff, 0x09, 0x78, 0x8e, 0xe0, 0x32, 0x16, 0x3a, 0x6a, 0x07, 0x52, 0x49,
+	0x1b, 0x46, 0x72, 0x2f, 0x95, 0xbe, 0x25, 0x54, 0x97, 0x71, 0x39, 0x9c, 0x5e, 0xc2, 0xbd, 0x3f,
+	0x5b, 0x7f, 0x2d, 0xc0, 0xf5, 0x5e, 0xe7, 0xae, 0xb0, 0x50, 0xfb, 0x1b, 0xe8, 0xd7, 0x39, 0xb8,
+	0x0b, 0x67, 0x85, 0xfb, 0x5c, 0x0a, 0xb7, 0x79, 0x07, 0xe6, 0x08, 0xab, 0x35, 0x69, 0x12, 0x79,
+	0xad, 0x5a, 0xfe, 0x1c, 0xa7, 0xec, 0x59, 0xc2, 0xee, 0x8b, 0x75, 0xc5, 0x6a, 0x6e, 0xc1, 0xb4,
+	0xa2, 0xc8, 0xdd, 0xba, 0xc3, 0x15, 0xad, 0x25, 0xc5, 0xc8, 0x33, 0xba, 0xb9, 0x06, 0xc0, 0xb7,
+	0xa3, 0x2e, 0x88, 0x89, 0xe1, 0xa5, 0x08, 0x58, 0xc4, 0x1d, 0x62, 0xfd, 0xda, 0x80, 0x17, 0x65,
+	0x70, 0xa6, 0xe5, 0xcb, 0x06, 0x16, 0x65, 0x8b, 0xb9, 0x08, 0x25, 0x16, 0x39, 0x35, 0xe4, 0xba,
+	0x11, 0x66, 0x4c, 0x01, 0x08, 0x2c, 0x72, 0x56, 0xe5, 0xca, 0x70, 0x05, 0xe6, 0xdb, 0xe9, 0x5d,
+	0x2d, 0x3d, 0xe1, 0xe5, 0x8a, 0xb4, 0xac, 0xc2, 0xdb, 0xb7, 0x8a, 0xea, 0xcc, 0x2a, 0xeb, 0x94,
+	0x04, 0xda, 0xad, 0xd4, 0x65, 0xfe, 0x99, 0x6e, 0x99, 0x32, 0xcb, 0x3e, 0x20, 0x71, 0xd3, 0x8d,
+	0xd0, 0x49, 0xb7, 0x66, 0xa3, 0x87, 0xe6, 0x45, 0x28, 0xb9, 0x2c, 0x4e, 0xed, 0x97, 0x17, 0x28,
+	0xb8, 0x2c, 0xd6, 0xf6, 0x9f, 0xd9, 0xb4, 0x3f, 0xe8, 0xd8, 0xca, 0x4c, 0x53, 0x75, 0xcb, 0xc3,
+	0x08, 0x05, 0xec, 0x00, 0x47, 0xdc, 0x1f, 0x38, 0x78, 0xdd, 0x56, 0x16, 0xed, 0x59, 0x16, 0x39,
+	0xfb, 0x79, 0x43, 0xef, 0xc0, 0x1c, 0x37, 0xb4, 0x1b, 0xcb, 0xa2, 0x3d, 0xeb, 0xb2, 0x78, 0xff,
+	0xb9, 0xc0, 0xd9, 0xcc, 0x37, 0xa0, 0xea, 0x88, 0x55, 0x9c, 0xec, 0xc2, 0xac, 0x2b, 0x17, 0x6a,
+	0x89, 0x58, 0xe1, 0x87, 0xcd, 0x6f, 0x9a, 0x1b, 0x7d, 0x13, 0x42, 0x8e, 0xdd, 0x9e, 0x71, 0xf3,
+	0xaf, 0xcc, 0xfa, 0xdc, 0x80, 0x2b, 0x9d, 0x29, 0x23, 0x57, 0x92, 0x9b, 0x8f, 0x60, 0x5a, 0x85,
+	0xa5, 0xbc, 0x58, 0x64, 0xf2, 0x79, 0x7d, 0xc8, 0xe4, 0x93, 0xdd, 0x2f, 0x86, 0x5d, 0xf2, 0xb3,
+	0x25, 0x73, 0x07, 0x66, 0x65, 0xe7, 0x50, 0x3b, 0x4a, 0x50, 0x10, 0x93, 0x58, 0xf6, 0x95, 0x43,
+	0x76, 0x10, 0x33, 0x92, 0xf7, 0x3d, 0xc5, 0x6a, 0xfd, 0x46, 0xdf, 0x2c, 0xd2, 0xe8, 0x8e, 0x12,
+	0x60, 0x70, 0x6a, 0xb9, 0x01, 0xa2, 0x57, 0xf5, 0x89, 0x62, 0x56, 0xfd, 0x6d, 0xfb, 0xa2, 0x69,
+	0x43, 0xc9, 0xe3, 0xaf, 0x0a, 0x05, 0x79, 0x9c, 0xa3, 0xdc, 0xed, 0x0a, 0x04, 0xf0, 0xd2, 0x15,
+	0xb3, 0x09, 0x17, 0xf3, 0xd0, 0xaa, 0x56, 0x4a, 0x24, 0x98, 0xd2, 0xca, 0xca, 0x28, 0x08, 0x4b,
+	0x23, 0x95, 0x8a, 0x39, 0xbf, 0xf3, 0x83, 0x55, 0x57, 0xe5, 0xd1, 0x16, 0xc6, 0x1b, 0x84, 0x09,
+	0xef, 0xdc, 0x77, 0x9a, 0xd8, 0x4d, 0x3c, 0x6c, 0x6e, 0xc1, 0x14, 0x53, 0xcf, 0xa7, 0x54, 0x92,
+	0x3d, 0xb8, 0xed, 0x94, 0xd7, 0xfa, 0xca, 0x80, 0x25, 0xa1, 0x84, 0x77, 0xc6, 0x3c, 0xe9, 0xe1,
+	0x13, 0x14, 0xb9, 0xeb, 0xc8, 0x0f, 0x11, 0x69, 0x04, 0xca, 0x79, 0x1f, 0xc1, 0x79, 0x47, 0xad,
+	0xc8, 0x0b, 0x47, 0x6a, 0x7c, 0x73, 0xc0, 0x10, 0xa3, 0x4b, 0x14, 0xbf, 0x53, 0xec, 0x69, 0x27,
+	0xf7, 0x66, 0x7e, 0x08, 0xf3, 0xa9, 0xd8, 0x48, 0x10, 0xd7, 0x42, 0x4a, 0xbd, 0xd3, 0x9a, 0x40,
+	0x2d, 0x51, 0xca, 0xdf, 0xa3, 0xd4, 0xb3, 0x2f, 0x3a, 0x5d, 0x6b, 0xcc, 0x0a, 0x55, 0x02, 0x69,
+	0x33, 0x67, 0x83, 0xb0, 0x38, 0x22, 0x75, 0x39, 0x3a, 0x79, 0x00, 0xb3, 0x3a, 0x1b, 0x48, 0xfd,
+	0x3a, 0x28, 0xfb, 0x55, 0x60, 0xab, 0x92, 0x5a, 0x8a, 0x62, 0xf6, 0x0c, 0x6a, 0x7b, 0xb7, 0x7e,
+	0x6f, 0x80, 0xa5, 0x0b, 0xda, 0x75, 0x1a, 0xb8, 0xa2, 0x15, 0x41, 0xa3, 0x39, 0xf6, 0xf7, 0xda,
+	0x6b, 0xc1, 0x5b, 0xa7, 0x3a, 0x94, 0xac, 0x41, 0x25, 0x93, 0x69, 0xc2, 0x78, 0x13, 0xb1, 0xa6,
+	0xf0, 0xf4, 0x69, 0x5b, 0x3c, 0x73, 0x75, 0x44, 0xd7, 0x0b, 0xc2, 0x4d, 0xa7, 0xec, 0x29, 0xa2,
+	0x6e, 0x7a, 0xeb, 0x57, 0x05, 0xb8, 0x99, 0x8b, 0xc1, 0xb3, 0x5a, 0xfd, 0xdf, 0x0b, 0xc7, 0xce,
+	0x4c, 0x37, 0xfe, 0x5c, 0x32, 0x9d, 0xf5, 0xad, 0x01, 0xb7, 0x24, 0x2e, 0x7d, 0x11, 0x79, 0x18,
+	0x91, 0x46, 0xa3, 0x17, 0x30, 0xd3, 0x39, 0x60, 0x6e, 0xf1, 0x49, 0x9b, 0xd8, 0x80, 0x22, 0x57,
+	0xc8, 0x74, 0xac, 0xf2, 0xb6, 0x37, 0x96, 0x8f, 0xd8, 0x55, 0x89, 0x25, 0x77, 0x90, 0x66, 0xfa,
+	0x4d, 0x68, 0xbe, 0xcf, 0x8f, 0xf5, 0x0e, 0xcc, 0x85, 0x1e, 0x72, 0xda, 0xc9, 0xc7, 0x05, 0xf9,
+	0xac, 0xfc, 0x90, 0xd1, 0xf2, 0xc9, 0x45, 0x87, 0x74, 0x87, 0xb8, 0xb2, 0x9c, 0xb1, 0xe7, 0xda,
+	0x85, 0xaf, 0x13, 0xd7, 0xf2, 0x60, 0x46, 0x6c, 0x5e, 0x2c, 0x6c, 0x21, 0xe2, 0x99, 0x65, 0x78,
+	0x41, 0x39, 0xbb, 0xda, 0xa2, 0x7e, 0xe5, 0x83, 0x02, 0xae, 0x1a, 0xcb, 0xb0, 0x9d, 0xb6, 0xd5,
+	0x9b, 0x79, 0x09, 0x26, 0x0e, 0x3c, 0xd4, 0x90, 0x1d, 0xd5, 0x79, 0x5b, 0xbe, 0x70, 0x07, 0x75,
+	0x88, 0x2b, 0xc7, 0xa0, 0x45, 0x5b, 0x3c, 0xf3, 0x91, 0xc2, 0x6b, 0xb2, 0x81, 0x8f, 0xa9, 0x4f,
+	0x9c, 0xdc, 0xc9, 0x6c, 0x61, 0xbc, 0x9b, 0x78, 0x31, 0x09, 0x3d, 0x82, 0x23, 0x26, 0xb3, 0x91,
+	0x6b, 0xfe, 0x14, 0x5e, 0xd4, 0xa3, 0x01, 0x8c, 0x6b, 0x7e, 0x46, 0xa0, 0xa2, 0xb7, 0x5f, 0x26,
+	0x54, 0xc5, 0x65, 0x5e, 0xa6, 0x7d, 0xc9, 0xef, 0x5e, 0x64, 0xd6, 0x1f, 0x0d, 0xd5, 0xc6, 0x09,
+	0x2b, 0xea, 0x94, 0x1e, 0xaa, 0x4c, 0xb8, 0x0d, 0xd3, 0x2c, 0xa4, 0x9d, 0x77, 0x78, 0xbf, 0x20,
+	0xed, 0xe0, 0xb6, 0x4b, 0x9c, 0x57, 0x3e, 0x33, 0xf3, 0x11, 0x98, 0x6e, 0xea, 0x50, 0xa9, 0xc0,
+	0xc2, 0x48, 0x02, 0xe7, 0x32, 0x09, 0xba, 0x32, 0x70, 0x60, 0xb6, 0xd3, 0xe8, 0x0b, 0x30, 0xc6,
+	0xf0, 0x91, 0x38, 0xb7, 0x71, 0x9b, 0x3f, 0x9a, 0x3f, 0x80, 0x22, 0xd5, 0x44, 0x2a, 0xd1, 0x2c,
+	0x9d, 0xa6, 0xd2, 0xce, 0x58, 0xac, 0xdf, 0x1a, 0x50, 0x4c, 0x3f, 0x0c, 0x0e, 0x80, 0xef, 0xca,
+	0x56, 0xdd, 0xc3, 0xc7, 0x38, 0xcd, 0xec, 0x57, 0xfb, 0xe8, 0xda, 0xe1, 0x44, 0xa2, 0x37, 0x17,
+	0x4f, 0xcc, 0xfc, 0xbe, 0xea, 0xcd, 0x15, 0xf7, 0xd8, 0x10, 0xdc, 0xa2, 0x19, 0x97, 0xec, 0xd6,
+	0x89, 0xba, 0x40, 0xef, 0x45, 0x28, 0x88, 0x57, 0x93, 0xb8, 0x49, 0x23, 0xf2, 0x33, 0x31, 0xd5,
+	0x65, 0xdc, 0xa1, 0x1b, 0x7c, 0x59, 0x15, 0x47, 0x45, 0x5b, 0xbf, 0xf2, 0xa9, 0xb2, 0x78, 0x3c,
+	0xed, 0x1e, 0xea, 0x96, 0x6a, 0x2b, 0x46, 0xeb, 0x63, 0xed, 0x3f, 0x92, 0x86, 0xf3, 0x0a, 0x82,
+	0x4c, 0x2b, 0x6e, 0xd7, 0x8a, 0xf3, 0xf6, 0x14, 0xda, 0xed, 0xf9, 0x4e, 0x5b, 0x39, 0x5a, 0x5c,
+	0xbb, 0xa6, 0x6a, 0xad, 0xf9, 0xee, 0x5a, 0x6b, 0x3b, 0x88, 0xd3, 0x62, 0xf4, 0x1e, 0xcc, 0x09,
+	0x13, 0xb6, 0x83, 0x63, 0xe4, 0x11, 0x57, 0x58, 0x72, 0x16, 0xfd, 0xd6, 0xef, 0xda, 0x82, 0x41,
+	0xa6, 0x72, 0x91, 0x13, 0x46, 0x9f, 0x8c, 0x17, 0x3b, 0xda, 0x87, 0x6b, 0x00, 0x1d, 0xb9, 0xae,
+	0xa8, 0xdc, 0x4c, 0xa4, 0xad, 0x0b, 0x30, 0xc6, 0xd3, 0x94, 0x9c, 0x98, 0xf2, 0x47, 0x73, 0x09,
+	0x4a, 0x2e, 0x66, 0x4e, 0x44, 0xc4, 0x60, 0x4c, 0x25, 0xb0, 0xfc, 0x92, 0xf5, 0xad, 0x2e, 0x68,
+	0x3a, 0x27, 0x4a, 0xef, 0xaf, 0xec, 0x92, 0x46, 0x34, 0xc4, 0x4c, 0xff, 0x27, 0x30, 0x97, 0x0e,
+	0x97, 0x6a, 0xf2, 0xb8, 0xb5, 0x2b, 0x54, 0x87, 0xbb, 0x8d, 0xdf, 0x5f, 0x59, 0x97, 0x6c, 0xf6,
+	0xac, 0x9e, 0x33, 0xa9, 0x05, 0xf3, 0x43, 0x30, 0xb3, 0x69, 0x53, 0x2a, 0x7d, 0xec, 0x6c, 0xd2,
+	0x2f, 0xa4, 0x83, 0x27, 0xb5, 0x62, 0xfd, 0xa5, 0x00, 0xe5, 0x7e, 0xe4, 0x1a, 0x4e, 0x23, 0x83,
+	0x53, 0x97, 0x0b, 0x85, 0x5c, 0xb9, 0x70, 0x17, 0x8c, 0x70, 0x94, 0xdf, 0x21, 0x8c, 0x90, 0xb3,
+	0x1c, 0x8d, 0xf2, 0x53, 0x82, 0x71, 0xc4, 0x59, 0xfc, 0xf2, 0xc4, 0x08, 0x2c, 0x3e, 0x67, 0x39,
+	0x28, 0x4f, 0x8e, 0xc0, 0x72, 0x60, 0xbe, 0x05, 0x85, 0x38, 0x2c, 0xbf, 0x30, 0x7c, 0xd7, 0x5e,
+	0x88, 0x43, 0xeb, 0x5f, 0x86, 0x6a, 0x4b, 0xb2, 0xa9, 0xea, 0xd0, 0xbe, 0xf3, 0xa8, 0xbf, 0xef,
+	0xbc, 0x3a, 0x60, 0xf0, 0x76, 0x9a, 0xd7, 0x7c, 0x30, 0xc0, 0x6b, 0x46, 0x90, 0xdb, 0xed, 0x2f,
+	0x3f, 0x2f, 0xc0, 0xb2, 0x2a, 0x92, 0x45, 0x0d, 0x90, 0xab, 0x76, 0xf2, 0xd7, 0x30, 0x22, 0x1e,
+	0x76, 0x9f, 0x43, 0xbc, 0xb7, 0x4f, 0x53, 0x46, 0x70, 0xb2, 0x6c, 0x9a, 0xd2, 0x91, 0x33, 0x64,
+	0xc1, 0x93, 0xcb, 0x19, 0x8b, 0x50, 0x52, 0xf5, 0x4c, 0x0d, 0x47, 0x91, 0xca, 0x10, 0xa0, 0x96,
+	0x36, 0xa3, 0x48, 0x47, 0xc1, 0x64, 0x1a, 0x05, 0xd6, 0xc7, 0x05, 0xb8, 0xdd, 0x07, 0x84, 0xac,
+	0xf4, 0xfc, 0x3f, 0xc7, 0xe0, 0xd3, 0x02, 0x98, 0xdd, 0x1e, 0xf3, 0xbf, 0x96, 0x32, 0x0e, 0xca,
+	0x13, 0x67, 0x88, 0xff, 0xc9, 0xd1, 0xe2, 0xff, 0x50, 0x35, 0x71, 0xdd, 0xbf, 0x63, 0xe6, 0xd3,
+	0xc0, 0x26, 0x4c, 0xe9, 0x5f, 0x1e, 0x55, 0x3b, 0x7c, 0xfa, 0xaf, 0xcf, 0x5a, 0x8e, 0x9d, 0xb2,
+	0x5a, 0x4d, 0xf5, 0xf3, 0xf3, 0x06, 0x3d, 0x09, 0x62, 0xe2, 0xe3, 0x7b, 0x11, 0x72, 0xf0, 0x2e,
+	0x75, 0xf1, 0x7e, 0x8c, 0x22, 0x5e, 0xec, 0xde, 0x03, 0x68, 0xf0, 0xb5, 0x9a, 0x4f, 0x5d, 0xdd,
+	0xea, 0x2f, 0xf7, 0xd3, 0xd4, 0x29, 0xc4, 0x2e, 0x36, 0xf4, 0xa3, 0xf5, 0x89, 0xfe, 0xe1, 0xae,
+	0x8b, 0x6a, 0x33, 0x70, 0x9f, 0xa3, 0x22, 0xf3, 0x2a, 0x14, 0xe5, 0x4c, 0xc4, 0xc3, 0xae, 0xea,
+	0x76, 0xb2, 0x85, 0xb5, 0xc3, 0x2f, 0x9e, 0x2c, 0x18, 0x5f, 0x3e, 0x59, 0x30, 0xfe, 0xf9, 0x64,
+	0xc1, 0xf8, 0xe5, 0xd3, 0x85, 0x73, 0x5f, 0x3e, 0x5d, 0x38, 0xf7, 0xd5, 0xd3, 0x85, 0x73, 0x3f,
+	0x7e, 0xaf, 0x41, 0xe2, 0x66, 0x52, 0xaf, 0x38, 0xd4, 0xaf, 0x6e, 0x6b, 0xb5, 0x3b, 0xa8, 0xce,
+	0xaa, 0xa9, 0x11, 0x6f, 0x38, 0x34, 0xc2, 0xf9, 0xd7, 0x26, 0x22, 0x41, 0xd5, 0xa7, 0x7c, 0x94,
+	0xc1, 0xb2, 0x3f, 0x86, 0xc4, 0xad, 0x10, 0xb3, 0xea, 0xf1, 0x4a, 0x7d, 0x52, 0xfc, 0x33, 0xe4,
+	0xad, 0x7f, 0x0f, 0x00, 0x65, 0x81, 0x94, 0xf9, 0x20, 0x23, 0x00, 0x00,
 }
 
 func (m *EventBatchSpotExecution) Marshal() (dAtA []byte, err error) {
@@ -5196,6 +5299,86 @@ func (m *EventDerivativePositionV2Migration) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDowntimeGraceModeStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDowntimeGraceModeStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDowntimeGraceModeStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GraceMode != nil {
+		{
+			size, err := m.GraceMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDowntimeGraceModeEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDowntimeGraceModeEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDowntimeGraceModeEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GraceMode != nil {
+		{
+			size, err := m.GraceMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -6160,6 +6343,35 @@ func (m *EventDerivativePositionV2Migration) Size() (n int) {
 	return n
 }
 
+func (m *EventDowntimeGraceModeStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GraceMode != nil {
+		l = m.GraceMode.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDowntimeGraceModeEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GraceMode != nil {
+		l = m.GraceMode.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12946,6 +13158,198 @@ func (m *EventDerivativePositionV2Migration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDowntimeGraceModeStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDowntimeGraceModeStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDowntimeGraceModeStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceMode == nil {
+				m.GraceMode = &DowntimeGraceMode{}
+			}
+			if err := m.GraceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDowntimeGraceModeEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDowntimeGraceModeEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDowntimeGraceModeEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceMode == nil {
+				m.GraceMode = &DowntimeGraceMode{}
+			}
+			if err := m.GraceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,6 +71,35 @@ func (ExecutionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0b5851fb01a33564, []int{0}
 }
 
+// DowntimeTradingMode defines the trading restriction applied during the grace
+// mode following a chain downtime
+type DowntimeTradingMode int32
+
+const (
+	// only orders that don't cross the top of the book are accepted
+	DowntimeTradingMode_DowntimePostOnly DowntimeTradingMode = 0
+	// no new orders are accepted, orders can only be cancelled
+	DowntimeTradingMode_DowntimeCancelOnly DowntimeTradingMode = 1
+)
+
+var DowntimeTradingMode_name = map[int32]string{
+	0: "DowntimePostOnly",
+	1: "DowntimeCancelOnly",
+}
+
+var DowntimeTradingMode_value = map[string]int32{
+	"DowntimePostOnly":   0,
+	"DowntimeCancelOnly": 1,
+}
+
+func (x DowntimeTradingMode) String() string {
+	return proto.EnumName(DowntimeTradingMode_name, int32(x))
+}
+
+func (DowntimeTradingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0b5851fb01a33564, []int{1}
+}
+
 type Params struct {
 	// spot_market_instant_listing_fee defines the expedited fee in INJ required
 	// to create a spot market by bypassing governance
@@ -171,6 +200,15 @@ type Params struct {
 	// mode will be enabled after the downtime-detector module detects a chain
 	// downtime
 	PostOnlyModeBlocksAmountAfterDowntime uint64 `protobuf:"varint,35,opt,name=post_only_mode_blocks_amount_after_downtime,json=postOnlyModeBlocksAmountAfterDowntime,proto3" json:"post_only_mode_blocks_amount_after_downtime,omitempty"`
+	// downtime_trading_mode defines the trading restriction applied during the
+	// grace mode following a downtime detected by the downtime-detector module
+	DowntimeTradingMode DowntimeTradingMode `protobuf:"varint,36,opt,name=downtime_trading_mode,json=downtimeTradingMode,proto3,enum=injective.exchange.v2.DowntimeTradingMode" json:"downtime_trading_mode,omitempty"`
+	// downtime_pause_liquidations defines whether liquidations are paused during
+	// the grace mode following a downtime
+	DowntimePauseLiquidations bool `protobuf:"varint,37,opt,name=downtime_pause_liquidations,json=downtimePauseLiquidations,proto3" json:"downtime_pause_liquidations,omitempty"`
+	// downtime_freeze_funding defines whether the funding of perpetual markets is
+	// frozen during the grace mode following a downtime
+	DowntimeFreezeFunding bool `protobuf:"varint,38,opt,name=downtime_freeze_funding,json=downtimeFreezeFunding,proto3" json:"downtime_freeze_funding,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -325,6 +363,120 @@ func (m *Params) GetPostOnlyModeBlocksAmountAfterDowntime() uint64 {
 	return 0
 }
 
+func (m *Params) GetDowntimeTradingMode() DowntimeTradingMode {
+	if m != nil {
+		return m.DowntimeTradingMode
+	}
+	return DowntimeTradingMode_DowntimePostOnly
+}
+
+func (m *Params) GetDowntimePauseLiquidations() bool {
+	if m != nil {
+		return m.DowntimePauseLiquidations
+	}
+	return false
+}
+
+func (m *Params) GetDowntimeFreezeFunding() bool {
+	if m != nil {
+		return m.DowntimeFreezeFunding
+	}
+	return false
+}
+
+// DowntimeGraceMode defines the grace mode the exchange enters after a chain
+// downtime, until the recovery period has elapsed
+type DowntimeGraceMode struct {
+	// downtime is the Downtime duration from the downtime-detector module that
+	// triggered the grace mode
+	Downtime string `protobuf:"bytes,1,opt,name=downtime,proto3" json:"downtime,omitempty"`
+	// start_height is the height of the first block after the downtime
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which the grace mode ends
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// trading_mode is the trading restriction applied during the grace mode
+	TradingMode DowntimeTradingMode `protobuf:"varint,4,opt,name=trading_mode,json=tradingMode,proto3,enum=injective.exchange.v2.DowntimeTradingMode" json:"trading_mode,omitempty"`
+	// liquidations_paused indicates whether liquidations are paused
+	LiquidationsPaused bool `protobuf:"varint,5,opt,name=liquidations_paused,json=liquidationsPaused,proto3" json:"liquidations_paused,omitempty"`
+	// funding_frozen indicates whether the funding of perpetual markets is frozen
+	FundingFrozen bool `protobuf:"varint,6,opt,name=funding_frozen,json=fundingFrozen,proto3" json:"funding_frozen,omitempty"`
+}
+
+func (m *DowntimeGraceMode) Reset()         { *m = DowntimeGraceMode{} }
+func (m *DowntimeGraceMode) String() string { return proto.CompactTextString(m) }
+func (*DowntimeGraceMode) ProtoMessage()    {}
+func (*DowntimeGraceMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5851fb01a33564, []int{1}
+}
+func (m *DowntimeGraceMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeGraceMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeGraceMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeGraceMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeGraceMode.Merge(m, src)
+}
+func (m *DowntimeGraceMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeGraceMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeGraceMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeGraceMode proto.InternalMessageInfo
+
+func (m *DowntimeGraceMode) GetDowntime() string {
+	if m != nil {
+		return m.Downtime
+	}
+	return ""
+}
+
+func (m *DowntimeGraceMode) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DowntimeGraceMode) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *DowntimeGraceMode) GetTradingMode() DowntimeTradingMode {
+	if m != nil {
+		return m.TradingMode
+	}
+	return DowntimeTradingMode_DowntimePostOnly
+}
+
+func (m *DowntimeGraceMode) GetLiquidationsPaused() bool {
+	if m != nil {
+		return m.LiquidationsPaused
+	}
+	return false
+}
+
+func (m *DowntimeGraceMode) GetFundingFrozen() bool {
+	if m != nil {
+		return m.FundingFrozen
+	}
+	return false
+}
+
 type NextFundingTimestamp struct {
 	NextTimestamp int64 `protobuf:"varint,1,opt,name=next_timestamp,json=nextTimestamp,proto3" json:"next_timestamp,omitempty"`
 }
@@ -333,7 +485,7 @@ func (m *NextFundingTimestamp) Reset()         { *m = NextFundingTimestamp{} }
 func (m *NextFundingTimestamp) String() string { return proto.CompactTextString(m) }
 func (*NextFundingTimestamp) ProtoMessage()    {}
 func (*NextFundingTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{1}
+	return fileDescriptor_0b5851fb01a33564, []int{2}
 }
 func (m *NextFundingTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +534,7 @@ func (m *MidPriceAndTOB) Reset()         { *m = MidPriceAndTOB{} }
 func (m *MidPriceAndTOB) String() string { return proto.CompactTextString(m) }
 func (*MidPriceAndTOB) ProtoMessage()    {}
 func (*MidPriceAndTOB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{2}
+	return fileDescriptor_0b5851fb01a33564, []int{3}
 }
 func (m *MidPriceAndTOB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,7 +575,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{3}
+	return fileDescriptor_0b5851fb01a33564, []int{4}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +612,7 @@ func (m *SubaccountTradeNonce) Reset()         { *m = SubaccountTradeNonce{} }
 func (m *SubaccountTradeNonce) String() string { return proto.CompactTextString(m) }
 func (*SubaccountTradeNonce) ProtoMessage()    {}
 func (*SubaccountTradeNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{4}
+	return fileDescriptor_0b5851fb01a33564, []int{5}
 }
 func (m *SubaccountTradeNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +661,7 @@ func (m *SubaccountOrder) Reset()         { *m = SubaccountOrder{} }
 func (m *SubaccountOrder) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrder) ProtoMessage()    {}
 func (*SubaccountOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{5}
+	return fileDescriptor_0b5851fb01a33564, []int{6}
 }
 func (m *SubaccountOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +713,7 @@ func (m *SubaccountOrderData) Reset()         { *m = SubaccountOrderData{} }
 func (m *SubaccountOrderData) String() string { return proto.CompactTextString(m) }
 func (*SubaccountOrderData) ProtoMessage()    {}
 func (*SubaccountOrderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{6}
+	return fileDescriptor_0b5851fb01a33564, []int{7}
 }
 func (m *SubaccountOrderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +773,7 @@ func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{7}
+	return fileDescriptor_0b5851fb01a33564, []int{8}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +822,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{8}
+	return fileDescriptor_0b5851fb01a33564, []int{9}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +864,7 @@ func (m *DerivativePosition) Reset()         { *m = DerivativePosition{} }
 func (m *DerivativePosition) String() string { return proto.CompactTextString(m) }
 func (*DerivativePosition) ProtoMessage()    {}
 func (*DerivativePosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{9}
+	return fileDescriptor_0b5851fb01a33564, []int{10}
 }
 func (m *DerivativePosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +903,7 @@ func (m *MarketOrderIndicator) Reset()         { *m = MarketOrderIndicator{} }
 func (m *MarketOrderIndicator) String() string { return proto.CompactTextString(m) }
 func (*MarketOrderIndicator) ProtoMessage()    {}
 func (*MarketOrderIndicator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{10}
+	return fileDescriptor_0b5851fb01a33564, []int{11}
 }
 func (m *MarketOrderIndicator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +961,7 @@ func (m *TradeLog) Reset()         { *m = TradeLog{} }
 func (m *TradeLog) String() string { return proto.CompactTextString(m) }
 func (*TradeLog) ProtoMessage()    {}
 func (*TradeLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{11}
+	return fileDescriptor_0b5851fb01a33564, []int{12}
 }
 func (m *TradeLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -877,7 +1029,7 @@ func (m *PositionDelta) Reset()         { *m = PositionDelta{} }
 func (m *PositionDelta) String() string { return proto.CompactTextString(m) }
 func (*PositionDelta) ProtoMessage()    {}
 func (*PositionDelta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{12}
+	return fileDescriptor_0b5851fb01a33564, []int{13}
 }
 func (m *PositionDelta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1080,7 @@ func (m *DerivativeTradeLog) Reset()         { *m = DerivativeTradeLog{} }
 func (m *DerivativeTradeLog) String() string { return proto.CompactTextString(m) }
 func (*DerivativeTradeLog) ProtoMessage()    {}
 func (*DerivativeTradeLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{13}
+	return fileDescriptor_0b5851fb01a33564, []int{14}
 }
 func (m *DerivativeTradeLog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1153,7 @@ func (m *SubaccountPosition) Reset()         { *m = SubaccountPosition{} }
 func (m *SubaccountPosition) String() string { return proto.CompactTextString(m) }
 func (*SubaccountPosition) ProtoMessage()    {}
 func (*SubaccountPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{14}
+	return fileDescriptor_0b5851fb01a33564, []int{15}
 }
 func (m *SubaccountPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1205,7 @@ func (m *SubaccountDeposit) Reset()         { *m = SubaccountDeposit{} }
 func (m *SubaccountDeposit) String() string { return proto.CompactTextString(m) }
 func (*SubaccountDeposit) ProtoMessage()    {}
 func (*SubaccountDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{15}
+	return fileDescriptor_0b5851fb01a33564, []int{16}
 }
 func (m *SubaccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1257,7 @@ func (m *DepositUpdate) Reset()         { *m = DepositUpdate{} }
 func (m *DepositUpdate) String() string { return proto.CompactTextString(m) }
 func (*DepositUpdate) ProtoMessage()    {}
 func (*DepositUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{16}
+	return fileDescriptor_0b5851fb01a33564, []int{17}
 }
 func (m *DepositUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1157,7 +1309,7 @@ func (m *PointsMultiplier) Reset()         { *m = PointsMultiplier{} }
 func (m *PointsMultiplier) String() string { return proto.CompactTextString(m) }
 func (*PointsMultiplier) ProtoMessage()    {}
 func (*PointsMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{17}
+	return fileDescriptor_0b5851fb01a33564, []int{18}
 }
 func (m *PointsMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,7 +1349,7 @@ func (m *TradingRewardCampaignBoostInfo) Reset()         { *m = TradingRewardCam
 func (m *TradingRewardCampaignBoostInfo) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignBoostInfo) ProtoMessage()    {}
 func (*TradingRewardCampaignBoostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{18}
+	return fileDescriptor_0b5851fb01a33564, []int{19}
 }
 func (m *TradingRewardCampaignBoostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,7 +1418,7 @@ func (m *CampaignRewardPool) Reset()         { *m = CampaignRewardPool{} }
 func (m *CampaignRewardPool) String() string { return proto.CompactTextString(m) }
 func (*CampaignRewardPool) ProtoMessage()    {}
 func (*CampaignRewardPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{19}
+	return fileDescriptor_0b5851fb01a33564, []int{20}
 }
 func (m *CampaignRewardPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,7 +1476,7 @@ func (m *TradingRewardCampaignInfo) Reset()         { *m = TradingRewardCampaign
 func (m *TradingRewardCampaignInfo) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignInfo) ProtoMessage()    {}
 func (*TradingRewardCampaignInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{20}
+	return fileDescriptor_0b5851fb01a33564, []int{21}
 }
 func (m *TradingRewardCampaignInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1548,7 @@ func (m *FeeDiscountTierInfo) Reset()         { *m = FeeDiscountTierInfo{} }
 func (m *FeeDiscountTierInfo) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountTierInfo) ProtoMessage()    {}
 func (*FeeDiscountTierInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{21}
+	return fileDescriptor_0b5851fb01a33564, []int{22}
 }
 func (m *FeeDiscountTierInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1596,7 @@ func (m *FeeDiscountSchedule) Reset()         { *m = FeeDiscountSchedule{} }
 func (m *FeeDiscountSchedule) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountSchedule) ProtoMessage()    {}
 func (*FeeDiscountSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{22}
+	return fileDescriptor_0b5851fb01a33564, []int{23}
 }
 func (m *FeeDiscountSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1519,7 +1671,7 @@ func (m *FeeDiscountTierTTL) Reset()         { *m = FeeDiscountTierTTL{} }
 func (m *FeeDiscountTierTTL) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountTierTTL) ProtoMessage()    {}
 func (*FeeDiscountTierTTL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{23}
+	return fileDescriptor_0b5851fb01a33564, []int{24}
 }
 func (m *FeeDiscountTierTTL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1571,7 +1723,7 @@ func (m *AccountRewards) Reset()         { *m = AccountRewards{} }
 func (m *AccountRewards) String() string { return proto.CompactTextString(m) }
 func (*AccountRewards) ProtoMessage()    {}
 func (*AccountRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{24}
+	return fileDescriptor_0b5851fb01a33564, []int{25}
 }
 func (m *AccountRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1623,7 +1775,7 @@ func (m *TradeRecords) Reset()         { *m = TradeRecords{} }
 func (m *TradeRecords) String() string { return proto.CompactTextString(m) }
 func (*TradeRecords) ProtoMessage()    {}
 func (*TradeRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{25}
+	return fileDescriptor_0b5851fb01a33564, []int{26}
 }
 func (m *TradeRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +1826,7 @@ func (m *SubaccountIDs) Reset()         { *m = SubaccountIDs{} }
 func (m *SubaccountIDs) String() string { return proto.CompactTextString(m) }
 func (*SubaccountIDs) ProtoMessage()    {}
 func (*SubaccountIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{26}
+	return fileDescriptor_0b5851fb01a33564, []int{27}
 }
 func (m *SubaccountIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +1875,7 @@ func (m *TradeRecord) Reset()         { *m = TradeRecord{} }
 func (m *TradeRecord) String() string { return proto.CompactTextString(m) }
 func (*TradeRecord) ProtoMessage()    {}
 func (*TradeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{27}
+	return fileDescriptor_0b5851fb01a33564, []int{28}
 }
 func (m *TradeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1770,7 +1922,7 @@ func (m *Level) Reset()         { *m = Level{} }
 func (m *Level) String() string { return proto.CompactTextString(m) }
 func (*Level) ProtoMessage()    {}
 func (*Level) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{28}
+	return fileDescriptor_0b5851fb01a33564, []int{29}
 }
 func (m *Level) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1810,7 +1962,7 @@ func (m *AggregateSubaccountVolumeRecord) Reset()         { *m = AggregateSubacc
 func (m *AggregateSubaccountVolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*AggregateSubaccountVolumeRecord) ProtoMessage()    {}
 func (*AggregateSubaccountVolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{29}
+	return fileDescriptor_0b5851fb01a33564, []int{30}
 }
 func (m *AggregateSubaccountVolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2016,7 @@ func (m *AggregateAccountVolumeRecord) Reset()         { *m = AggregateAccountVo
 func (m *AggregateAccountVolumeRecord) String() string { return proto.CompactTextString(m) }
 func (*AggregateAccountVolumeRecord) ProtoMessage()    {}
 func (*AggregateAccountVolumeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{30}
+	return fileDescriptor_0b5851fb01a33564, []int{31}
 }
 func (m *AggregateAccountVolumeRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1918,7 +2070,7 @@ func (m *DenomDecimals) Reset()         { *m = DenomDecimals{} }
 func (m *DenomDecimals) String() string { return proto.CompactTextString(m) }
 func (*DenomDecimals) ProtoMessage()    {}
 func (*DenomDecimals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{31}
+	return fileDescriptor_0b5851fb01a33564, []int{32}
 }
 func (m *DenomDecimals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1972,7 +2124,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{32}
+	return fileDescriptor_0b5851fb01a33564, []int{33}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2017,7 +2169,7 @@ func (m *ActiveGrant) Reset()         { *m = ActiveGrant{} }
 func (m *ActiveGrant) String() string { return proto.CompactTextString(m) }
 func (*ActiveGrant) ProtoMessage()    {}
 func (*ActiveGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{33}
+	return fileDescriptor_0b5851fb01a33564, []int{34}
 }
 func (m *ActiveGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2063,7 +2215,7 @@ func (m *EffectiveGrant) Reset()         { *m = EffectiveGrant{} }
 func (m *EffectiveGrant) String() string { return proto.CompactTextString(m) }
 func (*EffectiveGrant) ProtoMessage()    {}
 func (*EffectiveGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{34}
+	return fileDescriptor_0b5851fb01a33564, []int{35}
 }
 func (m *EffectiveGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2117,7 +2269,7 @@ func (m *DenomMinNotional) Reset()         { *m = DenomMinNotional{} }
 func (m *DenomMinNotional) String() string { return proto.CompactTextString(m) }
 func (*DenomMinNotional) ProtoMessage()    {}
 func (*DenomMinNotional) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5851fb01a33564, []int{35}
+	return fileDescriptor_0b5851fb01a33564, []int{36}
 }
 func (m *DenomMinNotional) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2155,7 +2307,9 @@ func (m *DenomMinNotional) GetDenom() string {
 
 func init() {
 	proto.RegisterEnum("injective.exchange.v2.ExecutionType", ExecutionType_name, ExecutionType_value)
+	proto.RegisterEnum("injective.exchange.v2.DowntimeTradingMode", DowntimeTradingMode_name, DowntimeTradingMode_value)
 	proto.RegisterType((*Params)(nil), "injective.exchange.v2.Params")
+	proto.RegisterType((*DowntimeGraceMode)(nil), "injective.exchange.v2.DowntimeGraceMode")
 	proto.RegisterType((*NextFundingTimestamp)(nil), "injective.exchange.v2.NextFundingTimestamp")
 	proto.RegisterType((*MidPriceAndTOB)(nil), "injective.exchange.v2.MidPriceAndTOB")
 	proto.RegisterType((*Deposit)(nil), "injective.exchange.v2.Deposit")
@@ -2198,201 +2352,213 @@ func init() {
 }
 
 var fileDescriptor_0b5851fb01a33564 = []byte{
-	// 3090 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x5b, 0xc7,
-	0xd5, 0xf7, 0xd5, 0x93, 0x3a, 0x12, 0x25, 0x6a, 0xf4, 0xa2, 0x2c, 0x5b, 0x92, 0xaf, 0xed, 0x58,
-	0x71, 0x12, 0xe9, 0xb3, 0x03, 0x07, 0xf9, 0xec, 0xef, 0x51, 0xca, 0xb4, 0x6c, 0x26, 0x92, 0xad,
-	0x5c, 0x29, 0x46, 0x91, 0xa0, 0xb9, 0x18, 0xde, 0x3b, 0x22, 0xc7, 0xba, 0x0f, 0xea, 0xce, 0x90,
-	0x91, 0x5a, 0x74, 0x51, 0x20, 0x40, 0x8b, 0x74, 0x93, 0x16, 0xe8, 0xa6, 0x68, 0x81, 0x2c, 0x5a,
-	0x14, 0xe8, 0xaa, 0x7f, 0x40, 0x17, 0x05, 0x8a, 0xa2, 0x59, 0xb4, 0x40, 0x96, 0x45, 0x17, 0x69,
-	0x91, 0x2c, 0x1a, 0x74, 0xdd, 0x3f, 0xa0, 0x98, 0xc7, 0x7d, 0x90, 0x7a, 0x91, 0x4e, 0x0b, 0x74,
-	0x63, 0xf3, 0xce, 0x9c, 0xf3, 0x3b, 0x67, 0xe6, 0x3c, 0x67, 0x46, 0x70, 0x8d, 0x06, 0xcf, 0x88,
-	0xc3, 0x69, 0x8b, 0xac, 0x91, 0x43, 0xa7, 0x8e, 0x83, 0x1a, 0x59, 0x6b, 0xdd, 0x4e, 0x7e, 0xaf,
-	0x36, 0xa2, 0x90, 0x87, 0x68, 0x26, 0xa1, 0x5a, 0x4d, 0x66, 0x5a, 0xb7, 0x2f, 0x4e, 0xd7, 0xc2,
-	0x5a, 0x28, 0x29, 0xd6, 0xc4, 0x2f, 0x45, 0x7c, 0x71, 0x12, 0xfb, 0x34, 0x08, 0xd7, 0xe4, 0xbf,
-	0x7a, 0x68, 0xd1, 0x09, 0x99, 0x1f, 0xb2, 0xb5, 0x2a, 0x66, 0x64, 0xad, 0x75, 0xab, 0x4a, 0x38,
-	0xbe, 0xb5, 0xe6, 0x84, 0x34, 0xd0, 0xf3, 0xd7, 0x53, 0x2d, 0xc2, 0x08, 0x3b, 0x5e, 0x4a, 0xa4,
-	0x3e, 0x35, 0x99, 0x79, 0xb2, 0xb2, 0x3e, 0x8e, 0xf6, 0x09, 0xd7, 0x34, 0x57, 0x4e, 0xa6, 0x09,
-	0x23, 0x97, 0x44, 0x8a, 0xc4, 0xfc, 0xd1, 0x3c, 0x0c, 0x6d, 0xe3, 0x08, 0xfb, 0x0c, 0x11, 0x58,
-	0x62, 0x8d, 0x90, 0xdb, 0x0a, 0xc2, 0xa6, 0x01, 0xe3, 0x38, 0xe0, 0xb6, 0x47, 0x19, 0xa7, 0x41,
-	0xcd, 0xde, 0x23, 0xa4, 0x68, 0x2c, 0x1b, 0x2b, 0xa3, 0xb7, 0xe7, 0x57, 0xd5, 0x12, 0x56, 0xc5,
-	0x12, 0x56, 0xb5, 0x76, 0xab, 0xf7, 0x43, 0x1a, 0xac, 0x0f, 0x7c, 0xf2, 0xd9, 0xd2, 0x05, 0x6b,
-	0x41, 0xe0, 0x6c, 0x49, 0x98, 0x8a, 0x42, 0xd9, 0x54, 0x20, 0x1b, 0x84, 0xa0, 0x03, 0xb8, 0xee,
-	0x92, 0x88, 0xb6, 0xb0, 0xd0, 0xeb, 0x2c, 0x61, 0x7d, 0xdd, 0x09, 0xbb, 0x92, 0xa2, 0x9d, 0x26,
-	0x12, 0xc3, 0x82, 0x4b, 0xf6, 0x70, 0xd3, 0xe3, 0xb6, 0x5e, 0xe1, 0x3e, 0x89, 0x84, 0x0c, 0x3b,
-	0xc2, 0x9c, 0x14, 0xfb, 0x97, 0x8d, 0x95, 0x91, 0xf5, 0xab, 0x02, 0xed, 0xcf, 0x9f, 0x2d, 0x2d,
-	0x28, 0x79, 0xcc, 0xdd, 0x5f, 0xa5, 0xe1, 0x9a, 0x8f, 0x79, 0x7d, 0x75, 0x93, 0xd4, 0xb0, 0x73,
-	0x54, 0x26, 0x8e, 0x35, 0xa7, 0x71, 0x76, 0xe4, 0x02, 0xf7, 0x49, 0xb4, 0x41, 0x88, 0x85, 0xf9,
-	0x71, 0x11, 0xbc, 0x5d, 0xc4, 0xc0, 0xf3, 0x89, 0xd8, 0xcd, 0x8a, 0xf0, 0xe1, 0x4a, 0x2c, 0xa2,
-	0x6d, 0x03, 0xdb, 0x04, 0x0d, 0x76, 0x2f, 0xe8, 0xb2, 0x46, 0x2b, 0x67, 0xf6, 0xef, 0x5c, 0x71,
-	0x1d, 0xeb, 0x1a, 0xfa, 0x2a, 0xe2, 0xda, 0x56, 0xe7, 0xc2, 0xa5, 0x58, 0x1c, 0x0d, 0x28, 0xa7,
-	0xd8, 0x13, 0xbe, 0x51, 0xa3, 0x81, 0x10, 0x44, 0xc3, 0xe2, 0x70, 0xf7, 0x92, 0xe6, 0x35, 0x50,
-	0x45, 0xe1, 0x6c, 0x49, 0x18, 0x4b, 0xa0, 0x20, 0x0f, 0x96, 0x63, 0x29, 0x3e, 0xa6, 0x01, 0x27,
-	0x01, 0x0e, 0x1c, 0xd2, 0x2e, 0x29, 0xd7, 0xfb, 0x9a, 0xb6, 0x52, 0xac, 0xac, 0xb4, 0xd7, 0xa1,
-	0x18, 0x4b, 0xdb, 0x6b, 0x06, 0xae, 0x70, 0x6c, 0x41, 0x17, 0xb5, 0xb0, 0x57, 0x1c, 0x59, 0x36,
-	0x56, 0xfa, 0xad, 0x59, 0x3d, 0xbf, 0xa1, 0xa6, 0x2b, 0x7a, 0x16, 0xbd, 0x08, 0x85, 0x98, 0xc3,
-	0x6f, 0x7a, 0x9c, 0x36, 0x3c, 0x52, 0x04, 0xc9, 0x31, 0xa1, 0xc7, 0xb7, 0xf4, 0x30, 0xfa, 0x3a,
-	0xcc, 0x46, 0xc4, 0xc3, 0x47, 0xda, 0x2c, 0xac, 0x8e, 0x23, 0x6d, 0x9c, 0xd1, 0xee, 0x17, 0x32,
-	0xa5, 0x21, 0x36, 0x08, 0xd9, 0x11, 0x00, 0xd2, 0x24, 0x14, 0x96, 0x62, 0xf5, 0xeb, 0x61, 0x33,
-	0xf2, 0x8e, 0x92, 0x55, 0x08, 0x78, 0xdb, 0xc1, 0x8d, 0xe2, 0x58, 0xf7, 0x22, 0xe2, 0xf8, 0x78,
-	0x24, 0xa1, 0xf4, 0x82, 0x85, 0x9c, 0xfb, 0xb8, 0x91, 0xb5, 0xbe, 0x16, 0x25, 0x37, 0x8a, 0x30,
-	0xae, 0x96, 0x92, 0xef, 0xdd, 0xfa, 0x4a, 0x4e, 0x45, 0xc3, 0xc8, 0x05, 0x95, 0x61, 0xc9, 0xc7,
-	0x87, 0x59, 0x77, 0x96, 0xa9, 0xd0, 0x66, 0xd4, 0x25, 0xb6, 0x13, 0x36, 0x03, 0x5e, 0x1c, 0x5f,
-	0x36, 0x56, 0xf2, 0xd6, 0x82, 0x8f, 0x0f, 0x53, 0x3f, 0x7d, 0x22, 0x88, 0x76, 0xa8, 0x4b, 0xee,
-	0x0b, 0x12, 0xc4, 0xe0, 0x06, 0x0d, 0x9e, 0xd9, 0x11, 0x79, 0x1f, 0x47, 0xae, 0xcd, 0x44, 0x44,
-	0xb8, 0x76, 0x44, 0x0e, 0x9a, 0x34, 0x22, 0x3e, 0x09, 0xb8, 0xcd, 0xeb, 0x11, 0x61, 0xf5, 0xd0,
-	0x73, 0x8b, 0x13, 0x52, 0xed, 0xcb, 0x5a, 0xed, 0x99, 0xe3, 0x6a, 0x57, 0x02, 0x6e, 0x5d, 0xa5,
-	0xc1, 0x33, 0x4b, 0x82, 0xed, 0x48, 0x2c, 0x2b, 0x85, 0xda, 0x8d, 0x91, 0xd0, 0x43, 0x58, 0xe6,
-	0x11, 0x56, 0x9b, 0x2f, 0x69, 0x99, 0xdd, 0x22, 0x2a, 0x57, 0xba, 0x4d, 0xe9, 0xb7, 0x41, 0xb1,
-	0x20, 0x1d, 0xe4, 0xb2, 0xa6, 0x53, 0x90, 0xec, 0xa9, 0xa2, 0x2a, 0x6b, 0x22, 0xb1, 0xd3, 0x1e,
-	0x3d, 0x68, 0x52, 0x17, 0xf3, 0x30, 0x4a, 0x16, 0x91, 0x3a, 0xcd, 0x64, 0x0f, 0x3b, 0x9d, 0x02,
-	0x69, 0xfd, 0x13, 0xd7, 0x39, 0x84, 0x17, 0xab, 0x34, 0xc0, 0xd1, 0x91, 0x1d, 0x36, 0x84, 0x58,
-	0x76, 0x56, 0xa2, 0x47, 0xdd, 0x25, 0xfa, 0x6b, 0x0a, 0xf1, 0x89, 0x02, 0x3c, 0x2d, 0xd7, 0x7f,
-	0x0b, 0x96, 0x31, 0x0f, 0x7d, 0xea, 0xc4, 0x12, 0x95, 0x89, 0xb1, 0xe3, 0x10, 0xc6, 0x6c, 0x8f,
-	0xb4, 0x88, 0x57, 0x9c, 0x5a, 0x36, 0x56, 0xc6, 0x6f, 0xbf, 0xba, 0x7a, 0x62, 0x25, 0x5f, 0x2d,
-	0x49, 0x76, 0x85, 0x2f, 0x4d, 0x5f, 0x92, 0xbc, 0x9b, 0x82, 0xd5, 0xba, 0x84, 0xcf, 0x98, 0x45,
-	0x87, 0x70, 0x43, 0x66, 0xff, 0x93, 0x34, 0x10, 0xc1, 0xa9, 0x63, 0x99, 0x92, 0xa8, 0x38, 0xdd,
-	0xfd, 0x3e, 0x9b, 0x02, 0xf3, 0x98, 0x56, 0x1b, 0x84, 0x6c, 0x25, 0x70, 0xe8, 0x03, 0x03, 0x5e,
-	0xc9, 0xf8, 0x75, 0x17, 0x0a, 0xcc, 0x74, 0xaf, 0xc0, 0x4a, 0x8a, 0x7c, 0x8e, 0x1a, 0xdf, 0x37,
-	0xe0, 0x56, 0x87, 0xe1, 0xbb, 0x50, 0x65, 0xb6, 0x7b, 0x55, 0x5e, 0x6a, 0x73, 0x82, 0x73, 0xb4,
-	0x79, 0x0f, 0xe6, 0x7d, 0x1a, 0x50, 0x1f, 0x7b, 0xb6, 0xec, 0x76, 0x9c, 0xd0, 0x4b, 0x4b, 0xd7,
-	0x5c, 0xf7, 0x42, 0x67, 0x35, 0xca, 0xb6, 0x06, 0x89, 0x6b, 0xd6, 0xbb, 0xf0, 0x12, 0x65, 0x89,
-	0x4b, 0x1f, 0xef, 0x6a, 0x3c, 0xdc, 0x0c, 0x9c, 0xba, 0x4d, 0x02, 0x5c, 0xf5, 0x88, 0x5b, 0x2c,
-	0x2e, 0x1b, 0x2b, 0x39, 0xeb, 0x05, 0xca, 0xb4, 0xd7, 0x96, 0x3b, 0x1a, 0x97, 0x4d, 0x49, 0xfe,
-	0x40, 0x51, 0x8b, 0x64, 0xd5, 0x08, 0x19, 0xb7, 0xc3, 0xc0, 0x3b, 0xb2, 0xfd, 0xd0, 0x25, 0x76,
-	0x9d, 0xd0, 0x5a, 0x3d, 0x9b, 0x5e, 0xe6, 0x65, 0xc0, 0x2f, 0x08, 0xb2, 0x27, 0x81, 0x77, 0xb4,
-	0x15, 0xba, 0xe4, 0x91, 0xa4, 0x49, 0xf3, 0x46, 0x0d, 0x6e, 0xe9, 0xe2, 0xe6, 0x12, 0x27, 0x22,
-	0x98, 0x11, 0xbb, 0x11, 0x51, 0x87, 0xd8, 0x9c, 0xfa, 0x84, 0x71, 0xec, 0x37, 0x52, 0x3c, 0x9b,
-	0x11, 0x27, 0x0c, 0x5c, 0x56, 0xbc, 0x28, 0x71, 0x5f, 0x56, 0x8c, 0x65, 0xcd, 0xb7, 0x2d, 0xd8,
-	0x76, 0x63, 0xae, 0x44, 0xc2, 0x8e, 0xe2, 0x41, 0x37, 0x60, 0x22, 0x0e, 0x22, 0x1b, 0xbb, 0x3e,
-	0x0d, 0x58, 0x71, 0x61, 0xb9, 0x7f, 0x65, 0xc4, 0x1a, 0x8f, 0x87, 0x4b, 0x72, 0x14, 0x6d, 0xc2,
-	0x94, 0x48, 0x9f, 0xb8, 0xe9, 0x08, 0x13, 0xda, 0x22, 0x21, 0x8b, 0x4a, 0x72, 0xa9, 0x9b, 0x54,
-	0x59, 0xa0, 0xc1, 0xb3, 0x92, 0x62, 0xdc, 0xc2, 0x87, 0xa2, 0x70, 0xdc, 0x84, 0xc9, 0x3d, 0x7a,
-	0x48, 0x5c, 0xbb, 0x86, 0x59, 0xb2, 0xd1, 0x97, 0xe5, 0x46, 0x4f, 0xc8, 0x89, 0x87, 0x98, 0xc5,
-	0x3b, 0x7a, 0x0f, 0x2e, 0x12, 0x9f, 0x72, 0xdb, 0x93, 0x86, 0xb5, 0x5b, 0x24, 0x62, 0x42, 0x03,
-	0xd2, 0x22, 0x01, 0x67, 0xc5, 0x45, 0xc9, 0x34, 0x27, 0x28, 0x94, 0xe5, 0x9f, 0xaa, 0xf9, 0x07,
-	0x72, 0x1a, 0x55, 0xd3, 0x06, 0x2f, 0x22, 0x6e, 0xb3, 0xb3, 0x69, 0x58, 0xea, 0xde, 0x9b, 0xe2,
-	0x9e, 0xc0, 0x92, 0x30, 0xd9, 0x7e, 0xe1, 0xff, 0xe0, 0x52, 0x87, 0xc9, 0xab, 0x5e, 0xe8, 0xec,
-	0x33, 0x1b, 0xfb, 0xb2, 0x38, 0x5d, 0x59, 0x36, 0x56, 0x06, 0xac, 0x62, 0xd6, 0xde, 0xeb, 0x92,
-	0xa0, 0x24, 0xe7, 0xd1, 0x16, 0x5c, 0xf3, 0x69, 0x60, 0x77, 0x60, 0xb8, 0xe1, 0xfb, 0x81, 0xb0,
-	0x76, 0x5a, 0x28, 0x4c, 0xa1, 0xac, 0xb5, 0xe4, 0xd3, 0x60, 0x3b, 0x03, 0x55, 0xd6, 0x74, 0x49,
-	0xa9, 0x78, 0x07, 0x5e, 0x3a, 0x4b, 0x1d, 0x1b, 0xef, 0x71, 0x12, 0x25, 0xf0, 0xc5, 0xab, 0x52,
-	0xbb, 0xeb, 0xa7, 0x69, 0x57, 0x12, 0xd4, 0xb1, 0x8c, 0xbb, 0xc5, 0x2f, 0x3f, 0x5e, 0x32, 0x3e,
-	0xfc, 0xdb, 0xaf, 0x6e, 0x26, 0x5e, 0xb3, 0xa6, 0x8e, 0x21, 0x6f, 0x0c, 0xe4, 0x96, 0x0b, 0x57,
-	0xcc, 0xff, 0x85, 0xe9, 0xc7, 0xe4, 0x30, 0xee, 0x8b, 0x12, 0xb7, 0x43, 0xd7, 0x61, 0x3c, 0x20,
-	0x87, 0x3c, 0x75, 0x5f, 0x79, 0x26, 0xe9, 0xb7, 0xf2, 0x62, 0x34, 0x21, 0x33, 0xff, 0x6e, 0xc0,
-	0xf8, 0x16, 0x75, 0xa5, 0xcf, 0x96, 0x02, 0x77, 0xf7, 0xc9, 0x3a, 0xfa, 0x1a, 0x8c, 0xf8, 0xd4,
-	0x55, 0xde, 0x2f, 0x99, 0x94, 0xb9, 0x8c, 0xf3, 0xcc, 0x95, 0xf3, 0x35, 0x0e, 0xaa, 0xc0, 0x78,
-	0x55, 0x74, 0x24, 0xd5, 0xe6, 0x91, 0x86, 0xe9, 0xeb, 0x1e, 0x66, 0x4c, 0xb0, 0xae, 0x37, 0x8f,
-	0x14, 0xd4, 0x9b, 0x30, 0x21, 0xa1, 0x18, 0xf1, 0x3c, 0x8d, 0xd5, 0xdf, 0x3d, 0x56, 0x5e, 0xf0,
-	0xee, 0x10, 0xcf, 0x93, 0x60, 0xe6, 0xcf, 0x0d, 0x18, 0x2e, 0x93, 0x46, 0xc8, 0x28, 0x47, 0xdb,
-	0x30, 0x89, 0x5b, 0x98, 0x7a, 0xc2, 0xe3, 0xed, 0x2a, 0xf6, 0x44, 0x4b, 0x9a, 0x59, 0xed, 0xb9,
-	0xce, 0x59, 0x48, 0xb8, 0xd7, 0x15, 0x33, 0x7a, 0x04, 0x79, 0x1e, 0x72, 0xec, 0x25, 0x68, 0x7d,
-	0xdd, 0xa3, 0x8d, 0x49, 0x4e, 0x8d, 0x64, 0xbe, 0x0c, 0xd3, 0x3b, 0xcd, 0x2a, 0x76, 0x64, 0xa7,
-	0xb5, 0x1b, 0x61, 0x97, 0x3c, 0x0e, 0x85, 0x84, 0x69, 0x18, 0x0c, 0xc2, 0x58, 0xcf, 0xbc, 0xa5,
-	0x3e, 0xcc, 0xdf, 0x1a, 0x30, 0x91, 0x92, 0xcb, 0xec, 0x8e, 0xfe, 0x1b, 0x06, 0x3b, 0xed, 0x77,
-	0xae, 0x0e, 0x8a, 0x03, 0xfd, 0x3f, 0xe4, 0x0e, 0x9a, 0x38, 0xe0, 0x94, 0x1f, 0xf5, 0xb2, 0x82,
-	0x84, 0x09, 0x99, 0x30, 0x46, 0x99, 0x8a, 0x59, 0xe1, 0xde, 0xd2, 0x5e, 0x39, 0xab, 0x6d, 0x0c,
-	0x15, 0xa0, 0xdf, 0xa1, 0xae, 0x3a, 0xed, 0x59, 0xe2, 0xa7, 0x19, 0xc1, 0x54, 0xc7, 0x22, 0xca,
-	0x98, 0x63, 0xf4, 0x3f, 0x30, 0x28, 0x2b, 0xa1, 0x3e, 0x51, 0xbf, 0x70, 0x4a, 0x2b, 0xd2, 0xc1,
-	0x6a, 0x29, 0x26, 0x74, 0x19, 0x40, 0xd5, 0xd1, 0x3a, 0x66, 0x75, 0xb9, 0x9a, 0x31, 0x6b, 0x44,
-	0x8e, 0x3c, 0xc2, 0xac, 0x6e, 0xfe, 0xae, 0x0f, 0x72, 0xdb, 0xc2, 0x1b, 0x44, 0x10, 0xcf, 0xc2,
-	0x10, 0x65, 0x9b, 0x61, 0x50, 0x93, 0xa2, 0x72, 0x96, 0xfe, 0xfa, 0xea, 0xfb, 0x51, 0x86, 0x51,
-	0x12, 0xf0, 0xe8, 0xe8, 0x98, 0xfb, 0x9e, 0x8b, 0x01, 0x92, 0x4f, 0x05, 0xc2, 0x3d, 0x18, 0x52,
-	0x79, 0xb4, 0x97, 0x23, 0xb2, 0x66, 0x41, 0xdf, 0x80, 0xa2, 0xd3, 0xf4, 0x9b, 0x9e, 0x2a, 0xba,
-	0xf1, 0xe1, 0x44, 0xa2, 0xf7, 0x72, 0x10, 0x9e, 0x4d, 0x41, 0x74, 0xbe, 0x79, 0x20, 0x20, 0xcc,
-	0x0f, 0x0d, 0x18, 0x8e, 0xa3, 0xe0, 0x2a, 0xe4, 0x59, 0x62, 0x0c, 0x9b, 0xba, 0xca, 0x03, 0xad,
-	0xb1, 0x74, 0xb0, 0xe2, 0x0a, 0x47, 0x76, 0x49, 0x10, 0xfa, 0x6a, 0x43, 0x2d, 0xf5, 0x81, 0xee,
-	0x42, 0xce, 0x55, 0xd1, 0xc9, 0xe4, 0x2e, 0x8d, 0xde, 0x5e, 0x3c, 0xc5, 0xdc, 0x3a, 0x88, 0xad,
-	0x84, 0xfe, 0x6e, 0xee, 0x7b, 0x1f, 0x2f, 0x5d, 0xf8, 0xf2, 0xe3, 0xa5, 0x0b, 0xe6, 0x4f, 0x0d,
-	0x40, 0x69, 0xc3, 0x90, 0x98, 0xb7, 0x2b, 0xbd, 0x16, 0x60, 0x24, 0x6e, 0xbf, 0x5d, 0xad, 0x5b,
-	0x4e, 0x0d, 0x54, 0x44, 0x55, 0xcc, 0x35, 0x34, 0x9a, 0x56, 0x6f, 0xe9, 0x14, 0xf5, 0x62, 0xa1,
-	0x56, 0xc2, 0x90, 0xd1, 0xaf, 0x02, 0xd3, 0x99, 0x3e, 0xac, 0x12, 0xb8, 0xd4, 0x11, 0x07, 0x83,
-	0x76, 0xd9, 0x46, 0x87, 0xec, 0x69, 0x18, 0xa4, 0x6c, 0xbd, 0xa9, 0x3c, 0x30, 0x67, 0xa9, 0x0f,
-	0xf3, 0x8f, 0x7d, 0x90, 0x93, 0xe9, 0x61, 0x33, 0x6c, 0xf7, 0x53, 0xe3, 0x79, 0xfc, 0x34, 0xc9,
-	0x19, 0x7d, 0x3d, 0xe7, 0x8c, 0x63, 0x9b, 0xdb, 0x2f, 0x43, 0xad, 0x7d, 0x73, 0xef, 0x40, 0xbf,
-	0x38, 0xc4, 0xf4, 0xe0, 0xbe, 0x82, 0xbe, 0x23, 0x86, 0x07, 0x3b, 0x62, 0x18, 0xbd, 0x0e, 0x33,
-	0xb2, 0x53, 0x25, 0x0e, 0x6d, 0x50, 0x71, 0xa8, 0xc4, 0xae, 0x1b, 0x11, 0xc6, 0xe4, 0x8d, 0xcb,
-	0x98, 0x3c, 0x11, 0x19, 0xd6, 0xd4, 0x1e, 0x21, 0x56, 0x4c, 0x51, 0x52, 0x04, 0x71, 0x0e, 0x1a,
-	0x4e, 0x73, 0xd0, 0x8f, 0xfb, 0x20, 0x1f, 0xdb, 0xae, 0x4c, 0x3c, 0x8e, 0xd1, 0x1c, 0x0c, 0x53,
-	0x66, 0x7b, 0xc7, 0xb3, 0x82, 0x05, 0x88, 0x1c, 0x12, 0xa7, 0x29, 0x5b, 0xb3, 0xe7, 0xc9, 0x0f,
-	0x93, 0x09, 0xfb, 0x5b, 0xb1, 0x01, 0x1e, 0x43, 0x21, 0xc5, 0xd4, 0xc1, 0xde, 0x43, 0xb6, 0x98,
-	0x48, 0x98, 0x55, 0xab, 0x84, 0x36, 0x21, 0x1d, 0xd2, 0xc9, 0xa7, 0x87, 0xcd, 0x1f, 0x4f, 0x78,
-	0x55, 0xf1, 0xfc, 0x49, 0x7f, 0x36, 0xae, 0x12, 0xb7, 0x3b, 0x31, 0xae, 0x3a, 0x4d, 0xff, 0x26,
-	0x8c, 0xc7, 0x91, 0x60, 0xbb, 0x62, 0x63, 0xf5, 0x9d, 0xe5, 0xb5, 0x73, 0x02, 0x48, 0x1a, 0xc1,
-	0xca, 0x37, 0xda, 0x6c, 0x72, 0x0f, 0x86, 0x1a, 0xf8, 0x28, 0x6c, 0xf2, 0x5e, 0x36, 0x47, 0xb3,
-	0xfc, 0xe7, 0x3b, 0xa1, 0xd0, 0xb0, 0x11, 0x78, 0xbd, 0x5c, 0xae, 0x09, 0x7a, 0xb3, 0x05, 0x28,
-	0x2d, 0x82, 0x49, 0xd6, 0xcb, 0xe6, 0x2c, 0xa3, 0xc7, 0x9c, 0x75, 0xdc, 0xb4, 0x7d, 0xc7, 0x4d,
-	0x6b, 0x46, 0x30, 0x99, 0xca, 0x8d, 0x9b, 0xab, 0xae, 0x9c, 0xe2, 0x75, 0x18, 0xd6, 0xe9, 0x5b,
-	0x7b, 0xc3, 0x79, 0xd9, 0x3e, 0x26, 0x37, 0xf7, 0x21, 0xaf, 0xc7, 0xde, 0x6e, 0xb8, 0xe2, 0x7c,
-	0x99, 0xd4, 0x13, 0x23, 0x5b, 0x4f, 0xca, 0x99, 0x7a, 0xd2, 0xb7, 0xdc, 0xbf, 0x32, 0x7a, 0x7b,
-	0xe5, 0xdc, 0xf6, 0xe1, 0x58, 0x65, 0x31, 0xff, 0x60, 0x40, 0x61, 0x3b, 0xa4, 0x01, 0x67, 0x99,
-	0x03, 0xf3, 0xbb, 0x30, 0xa7, 0xee, 0x93, 0x1b, 0x72, 0x26, 0x7b, 0x46, 0xef, 0x21, 0xf7, 0xce,
-	0x48, 0x8c, 0x93, 0xc0, 0xf9, 0x29, 0xe0, 0x3d, 0x24, 0x98, 0x19, 0x7e, 0x12, 0xb8, 0xf9, 0x8f,
-	0x3e, 0x58, 0xdc, 0xcd, 0x5e, 0x7c, 0xdd, 0xc7, 0x7e, 0x03, 0xd3, 0x5a, 0xb0, 0x1e, 0x86, 0x8c,
-	0x57, 0x82, 0xbd, 0x10, 0xdd, 0x81, 0xb9, 0xaa, 0xf8, 0x20, 0xae, 0xdd, 0xf6, 0xce, 0xe1, 0xb2,
-	0xa2, 0x21, 0x4f, 0xaa, 0xd3, 0x7a, 0x7a, 0x27, 0x7d, 0xbd, 0x70, 0x19, 0x22, 0x30, 0x97, 0x25,
-	0x4f, 0xb5, 0x8e, 0x77, 0xff, 0xc6, 0xa9, 0xae, 0xd7, 0xae, 0xa3, 0xbe, 0xc6, 0x9a, 0x49, 0x1f,
-	0x47, 0xd2, 0x39, 0x86, 0x4a, 0x70, 0x39, 0xd6, 0xee, 0x84, 0xe7, 0x11, 0x57, 0xb4, 0x0e, 0x42,
-	0xc7, 0x8b, 0x9a, 0xa8, 0xf3, 0xee, 0x40, 0x68, 0x7a, 0x00, 0x97, 0x8f, 0xb3, 0x66, 0xf5, 0x1d,
-	0x78, 0x1e, 0x7d, 0x17, 0x3a, 0xdf, 0x57, 0x32, 0x5a, 0x9b, 0xbf, 0x36, 0x00, 0xc5, 0x3b, 0xad,
-	0xf6, 0x7d, 0x3b, 0x0c, 0x3d, 0x74, 0x03, 0x26, 0x18, 0xc7, 0xd1, 0xf1, 0x63, 0xda, 0xb8, 0x1c,
-	0x4e, 0x8f, 0x73, 0xdf, 0x86, 0x69, 0x75, 0x01, 0xa0, 0x20, 0xe2, 0xbb, 0x4d, 0xbd, 0xb3, 0x67,
-	0x5c, 0x09, 0xfe, 0x97, 0xd0, 0xed, 0x97, 0x7f, 0x59, 0x5a, 0xa9, 0x51, 0x5e, 0x6f, 0x56, 0x57,
-	0x9d, 0xd0, 0x5f, 0xd3, 0x0f, 0x6b, 0xea, 0xbf, 0x57, 0x98, 0xbb, 0xbf, 0xc6, 0x8f, 0x1a, 0x84,
-	0x49, 0x06, 0x66, 0x21, 0x1f, 0x1f, 0xb6, 0xab, 0xca, 0xcc, 0x9f, 0xf5, 0xc1, 0xfc, 0x89, 0x5e,
-	0x23, 0x1d, 0xe6, 0x2e, 0xcc, 0x27, 0x8a, 0xc5, 0x67, 0xe7, 0xe4, 0x8e, 0x44, 0xad, 0x67, 0x2e,
-	0x26, 0x88, 0x0f, 0xcd, 0xf1, 0x75, 0xc8, 0x15, 0x18, 0x3b, 0x68, 0x86, 0x9c, 0xd8, 0x32, 0x66,
-	0xd5, 0x82, 0x46, 0xac, 0x51, 0x39, 0x56, 0x96, 0x43, 0xa8, 0x01, 0xf3, 0xed, 0x57, 0xba, 0xb6,
-	0xb4, 0xad, 0x4d, 0x83, 0xbd, 0x50, 0x77, 0x62, 0x77, 0x4e, 0x31, 0xd5, 0xd9, 0x9e, 0x6e, 0xcd,
-	0xb6, 0x5d, 0x01, 0xa7, 0x11, 0xf0, 0x1a, 0xcc, 0xb9, 0x94, 0x1d, 0x34, 0xb1, 0x47, 0xf7, 0x28,
-	0x71, 0xb3, 0xde, 0x35, 0x20, 0xf5, 0x9b, 0xc9, 0x4e, 0x27, 0x8e, 0x65, 0xfe, 0xa6, 0x0f, 0xa6,
-	0x36, 0x08, 0x29, 0x53, 0xa6, 0x8e, 0x6e, 0x54, 0x34, 0x78, 0x7b, 0x21, 0xda, 0x81, 0x29, 0x95,
-	0x2e, 0x5c, 0x3d, 0xa3, 0x6e, 0xd6, 0x7a, 0x48, 0x15, 0x93, 0x92, 0x3f, 0x06, 0x96, 0x97, 0x6a,
-	0x3b, 0x30, 0xc5, 0x4f, 0x00, 0xed, 0xa5, 0x07, 0xe1, 0xc7, 0x40, 0xd7, 0x21, 0xaf, 0x2f, 0xea,
-	0xf5, 0x55, 0x4a, 0x7f, 0x37, 0xd7, 0x4d, 0x63, 0x8a, 0x47, 0xdf, 0xae, 0xdc, 0x83, 0xa1, 0x56,
-	0xe8, 0x35, 0xfd, 0x9e, 0xca, 0xac, 0x66, 0x31, 0xbf, 0xdb, 0xbe, 0x85, 0x3b, 0x4e, 0x9d, 0xb8,
-	0x4d, 0x8f, 0x08, 0x3f, 0xa9, 0x36, 0x1d, 0x61, 0x05, 0xf5, 0xfe, 0x60, 0xc8, 0x4b, 0x94, 0x51,
-	0x35, 0xa6, 0xde, 0x1b, 0x6e, 0xc0, 0x84, 0x26, 0x49, 0x2e, 0x70, 0xfa, 0x54, 0x30, 0xa9, 0xe1,
-	0xe4, 0xbe, 0xa6, 0xd3, 0xe7, 0xfa, 0x8f, 0xfb, 0x5c, 0x05, 0x80, 0x53, 0x12, 0x49, 0x1f, 0x8b,
-	0xf3, 0xc1, 0xcd, 0x53, 0x9c, 0xec, 0x04, 0x8b, 0x5b, 0x23, 0x5c, 0xff, 0x62, 0x67, 0x39, 0xd3,
-	0xe0, 0x59, 0xce, 0xb4, 0x05, 0xa8, 0x03, 0x79, 0x77, 0x77, 0x13, 0x21, 0x18, 0xe0, 0x71, 0x99,
-	0x19, 0xb0, 0xe4, 0x6f, 0x51, 0x6e, 0x39, 0xf7, 0x32, 0x39, 0x44, 0x2d, 0x7b, 0x8c, 0x73, 0x2f,
-	0xbd, 0xe9, 0xf9, 0x81, 0x01, 0xe3, 0x25, 0x55, 0xe4, 0x74, 0x54, 0xa3, 0x22, 0x0c, 0xeb, 0xb2,
-	0xa7, 0x0b, 0x67, 0xfc, 0x89, 0x08, 0x0c, 0xff, 0x1b, 0x33, 0x4c, 0x8c, 0x6d, 0x7e, 0xc7, 0x80,
-	0x31, 0xd9, 0x49, 0x5a, 0xc4, 0x09, 0x85, 0x46, 0x67, 0x1e, 0x82, 0x76, 0x61, 0xda, 0xc3, 0x9c,
-	0x30, 0x6e, 0x8b, 0xb0, 0x95, 0xed, 0x56, 0x98, 0x6a, 0x68, 0x9e, 0x91, 0x02, 0x34, 0xbe, 0x85,
-	0x14, 0x7f, 0x56, 0xa4, 0xf9, 0x1a, 0xe4, 0xd3, 0xf2, 0x5f, 0x29, 0x33, 0x74, 0x1d, 0xc6, 0xdb,
-	0x9a, 0x17, 0x55, 0xf5, 0xc6, 0xac, 0x7c, 0xb6, 0x7b, 0x61, 0xe6, 0x2f, 0x0c, 0x18, 0xcd, 0x00,
-	0xa1, 0x4b, 0x30, 0xd2, 0x99, 0xc4, 0xd3, 0x81, 0xaf, 0x72, 0xb8, 0xca, 0x1e, 0xec, 0xfa, 0x9f,
-	0xe3, 0x60, 0x67, 0xfa, 0x30, 0xa8, 0x5e, 0x5d, 0x6e, 0x81, 0xd1, 0xe8, 0x25, 0xe9, 0x18, 0x0d,
-	0xc1, 0x72, 0xd0, 0x8b, 0xce, 0xc6, 0x81, 0xf9, 0x43, 0x03, 0x96, 0x4a, 0xb5, 0x5a, 0x44, 0x6a,
-	0x98, 0x93, 0x74, 0x6b, 0x9f, 0xca, 0xf8, 0xd6, 0x9b, 0xd5, 0xd5, 0x69, 0xfc, 0x0d, 0x18, 0xd7,
-	0xce, 0xa0, 0x72, 0x43, 0x6c, 0xe9, 0xab, 0xa7, 0x58, 0x5a, 0x85, 0x8e, 0x96, 0x93, 0xf7, 0x33,
-	0x5f, 0xcc, 0xfc, 0xc0, 0x80, 0x4b, 0x89, 0x52, 0xa5, 0x13, 0x34, 0x3a, 0x3d, 0x16, 0xfe, 0x95,
-	0x6a, 0x94, 0x44, 0xe7, 0x1a, 0x84, 0x7e, 0x99, 0x38, 0xd4, 0xc7, 0x1e, 0x3b, 0xa5, 0x73, 0xbd,
-	0x28, 0x3a, 0x57, 0x45, 0x21, 0x37, 0x7f, 0xc0, 0x4a, 0xbe, 0x4d, 0x02, 0xe8, 0x61, 0x84, 0x03,
-	0x5e, 0x6a, 0xf2, 0x7a, 0x18, 0xd1, 0x6f, 0xaa, 0x94, 0x56, 0x84, 0xe1, 0x9a, 0x18, 0xd5, 0x7f,
-	0x7b, 0x32, 0x62, 0xc5, 0x9f, 0xe8, 0x0e, 0x0c, 0xe9, 0x54, 0xde, 0xd7, 0x4d, 0x2a, 0xd7, 0xc4,
-	0xe6, 0x7b, 0x30, 0x5a, 0x92, 0x6b, 0x93, 0xc2, 0x52, 0xfc, 0xa8, 0x1d, 0x3f, 0x7a, 0x5e, 0xfc,
-	0x8f, 0x0c, 0x18, 0x7f, 0xb0, 0xb7, 0x47, 0xba, 0x92, 0x51, 0x81, 0xc9, 0x80, 0x70, 0x5b, 0x7d,
-	0xea, 0xa7, 0xe4, 0xee, 0xc4, 0x4d, 0x04, 0x84, 0x3f, 0x54, 0x6c, 0xf2, 0xd1, 0x18, 0xcd, 0x43,
-	0x8e, 0x32, 0xbb, 0x85, 0x3d, 0x7d, 0x4b, 0x91, 0xb3, 0x86, 0x29, 0x7b, 0x2a, 0x3e, 0xcd, 0x06,
-	0x14, 0xa4, 0x71, 0xb6, 0x68, 0xf0, 0x38, 0x14, 0xbb, 0x8a, 0xbd, 0x53, 0xec, 0xb3, 0x01, 0x63,
-	0x3e, 0x0d, 0xec, 0x40, 0x53, 0xf5, 0x12, 0x20, 0xa3, 0x7e, 0x8a, 0x7e, 0xf3, 0xf7, 0x06, 0xe4,
-	0x1f, 0xc4, 0xc7, 0xec, 0xdd, 0xa3, 0x06, 0x41, 0x97, 0xa0, 0xf8, 0x76, 0xc0, 0x1a, 0xc4, 0x91,
-	0xc5, 0xa0, 0x6d, 0xae, 0x70, 0x01, 0x01, 0x0c, 0x29, 0xef, 0x2a, 0x18, 0x28, 0x0f, 0x23, 0x9b,
-	0xd4, 0xa7, 0x7c, 0x83, 0x7a, 0x5e, 0xa1, 0x0f, 0x5d, 0x84, 0x59, 0xf9, 0xb9, 0x85, 0xb9, 0x53,
-	0xb7, 0xd4, 0x5b, 0xb6, 0xbc, 0x61, 0x2a, 0xf4, 0xa3, 0x59, 0x40, 0xe9, 0xdc, 0x63, 0xf2, 0xbe,
-	0x1a, 0x1f, 0x40, 0x33, 0x30, 0xa9, 0x1f, 0xd4, 0xf4, 0xfb, 0x34, 0x0d, 0x83, 0xc2, 0xa0, 0x80,
-	0x7a, 0x70, 0xd8, 0xa0, 0xd1, 0x91, 0x9a, 0xdc, 0x21, 0x9c, 0x7b, 0xf2, 0x95, 0xbd, 0x30, 0x24,
-	0xa0, 0x9e, 0xec, 0xed, 0x31, 0xc2, 0x05, 0x7e, 0x7c, 0x66, 0x2c, 0x0c, 0xaf, 0xef, 0x7f, 0xf2,
-	0xf9, 0xa2, 0xf1, 0xe9, 0xe7, 0x8b, 0xc6, 0x5f, 0x3f, 0x5f, 0x34, 0x3e, 0xfa, 0x62, 0xf1, 0xc2,
-	0xa7, 0x5f, 0x2c, 0x5e, 0xf8, 0xd3, 0x17, 0x8b, 0x17, 0xde, 0x79, 0x2b, 0x53, 0x16, 0x2a, 0x71,
-	0xc0, 0x6c, 0xe2, 0x2a, 0x5b, 0x4b, 0xc2, 0xe7, 0x15, 0x27, 0x8c, 0x48, 0xf6, 0xb3, 0x8e, 0x69,
-	0xb0, 0xe6, 0x87, 0xa2, 0xf0, 0xb3, 0xf4, 0x2f, 0xb2, 0x64, 0x09, 0x59, 0x6b, 0xdd, 0xae, 0x0e,
-	0xc9, 0x47, 0xca, 0x57, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x58, 0x3c, 0x91, 0x3c, 0x88, 0x26,
-	0x00, 0x00,
+	// 3290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
+	0x99, 0x67, 0xcf, 0xf0, 0x31, 0xfc, 0xc8, 0x21, 0x87, 0xc5, 0xd7, 0x90, 0x94, 0x48, 0xaa, 0x25,
+	0x59, 0xb4, 0x6c, 0x93, 0x2b, 0x19, 0x32, 0xbc, 0xd2, 0xae, 0x77, 0x49, 0x8d, 0x28, 0xd1, 0x26,
+	0x25, 0xba, 0x49, 0x0b, 0x0b, 0x1b, 0xeb, 0x46, 0xb1, 0xbb, 0x66, 0xa6, 0xc4, 0x7e, 0x0c, 0xbb,
+	0x6a, 0x68, 0xd2, 0x8b, 0x3d, 0x2c, 0x60, 0x60, 0x03, 0xe7, 0xe2, 0xe4, 0x14, 0x04, 0x09, 0xe0,
+	0x43, 0x82, 0x00, 0x39, 0xe5, 0x0f, 0xc8, 0x21, 0x40, 0x10, 0xc4, 0x87, 0x04, 0xf0, 0x31, 0xc8,
+	0xc1, 0x09, 0xec, 0x43, 0x8c, 0x00, 0xb9, 0xe5, 0x0f, 0x08, 0xea, 0xd1, 0x8f, 0x19, 0xbe, 0x66,
+	0xe4, 0x04, 0xc8, 0x45, 0x9a, 0xae, 0xfa, 0xbe, 0xdf, 0xf7, 0xd5, 0xf7, 0xaa, 0xaa, 0xaf, 0x08,
+	0xd7, 0x68, 0xf0, 0x8c, 0x38, 0x9c, 0x1e, 0x92, 0x15, 0x72, 0xe4, 0xd4, 0x71, 0x50, 0x23, 0x2b,
+	0x87, 0xb7, 0x93, 0xdf, 0xcb, 0x8d, 0x28, 0xe4, 0x21, 0x9a, 0x4c, 0xa8, 0x96, 0x93, 0x99, 0xc3,
+	0xdb, 0xb3, 0x13, 0xb5, 0xb0, 0x16, 0x4a, 0x8a, 0x15, 0xf1, 0x4b, 0x11, 0xcf, 0x8e, 0x61, 0x9f,
+	0x06, 0xe1, 0x8a, 0xfc, 0x57, 0x0f, 0xcd, 0x3b, 0x21, 0xf3, 0x43, 0xb6, 0xb2, 0x87, 0x19, 0x59,
+	0x39, 0xbc, 0xb5, 0x47, 0x38, 0xbe, 0xb5, 0xe2, 0x84, 0x34, 0xd0, 0xf3, 0xd7, 0x53, 0x2d, 0xc2,
+	0x08, 0x3b, 0x5e, 0x4a, 0xa4, 0x3e, 0x35, 0x99, 0x79, 0xba, 0xb2, 0x3e, 0x8e, 0xf6, 0x09, 0xd7,
+	0x34, 0x57, 0x4e, 0xa7, 0x09, 0x23, 0x97, 0x44, 0x8a, 0xc4, 0xfc, 0xcb, 0x2c, 0xf4, 0x6f, 0xe3,
+	0x08, 0xfb, 0x0c, 0x11, 0x58, 0x60, 0x8d, 0x90, 0xdb, 0x0a, 0xc2, 0xa6, 0x01, 0xe3, 0x38, 0xe0,
+	0xb6, 0x47, 0x19, 0xa7, 0x41, 0xcd, 0xae, 0x12, 0x52, 0x36, 0x16, 0x8d, 0xa5, 0xa1, 0xdb, 0x33,
+	0xcb, 0x6a, 0x09, 0xcb, 0x62, 0x09, 0xcb, 0x5a, 0xbb, 0xe5, 0xfb, 0x21, 0x0d, 0xd6, 0x7a, 0x3f,
+	0xfb, 0x62, 0xa1, 0xc7, 0x9a, 0x13, 0x38, 0x5b, 0x12, 0x66, 0x43, 0xa1, 0x6c, 0x2a, 0x90, 0x75,
+	0x42, 0xd0, 0x01, 0x5c, 0x77, 0x49, 0x44, 0x0f, 0xb1, 0xd0, 0xeb, 0x3c, 0x61, 0xb9, 0xce, 0x84,
+	0x5d, 0x49, 0xd1, 0xce, 0x12, 0x89, 0x61, 0xce, 0x25, 0x55, 0xdc, 0xf4, 0xb8, 0xad, 0x57, 0xb8,
+	0x4f, 0x22, 0x21, 0xc3, 0x8e, 0x30, 0x27, 0xe5, 0xfc, 0xa2, 0xb1, 0x34, 0xb8, 0x76, 0x55, 0xa0,
+	0xfd, 0xfe, 0x8b, 0x85, 0x39, 0x25, 0x8f, 0xb9, 0xfb, 0xcb, 0x34, 0x5c, 0xf1, 0x31, 0xaf, 0x2f,
+	0x6f, 0x92, 0x1a, 0x76, 0x8e, 0x2b, 0xc4, 0xb1, 0xa6, 0x35, 0xce, 0x8e, 0x5c, 0xe0, 0x3e, 0x89,
+	0xd6, 0x09, 0xb1, 0x30, 0x3f, 0x29, 0x82, 0xb7, 0x8a, 0xe8, 0x7d, 0x3e, 0x11, 0xbb, 0x59, 0x11,
+	0x3e, 0x5c, 0x89, 0x45, 0xb4, 0x18, 0xb0, 0x45, 0x50, 0x5f, 0xe7, 0x82, 0x2e, 0x6b, 0xb4, 0x4a,
+	0xc6, 0x7e, 0x17, 0x8a, 0x6b, 0x5b, 0x57, 0xff, 0x37, 0x11, 0xd7, 0xb2, 0x3a, 0x17, 0x2e, 0xc5,
+	0xe2, 0x68, 0x40, 0x39, 0xc5, 0x9e, 0x88, 0x8d, 0x1a, 0x0d, 0x84, 0x20, 0x1a, 0x96, 0x07, 0x3a,
+	0x97, 0x34, 0xa3, 0x81, 0x36, 0x14, 0xce, 0x96, 0x84, 0xb1, 0x04, 0x0a, 0xf2, 0x60, 0x31, 0x96,
+	0xe2, 0x63, 0x1a, 0x70, 0x12, 0xe0, 0xc0, 0x21, 0xad, 0x92, 0x0a, 0xdd, 0xaf, 0x69, 0x2b, 0xc5,
+	0xca, 0x4a, 0x7b, 0x1d, 0xca, 0xb1, 0xb4, 0x6a, 0x33, 0x70, 0x45, 0x60, 0x0b, 0xba, 0xe8, 0x10,
+	0x7b, 0xe5, 0xc1, 0x45, 0x63, 0x29, 0x6f, 0x4d, 0xe9, 0xf9, 0x75, 0x35, 0xbd, 0xa1, 0x67, 0xd1,
+	0x8b, 0x50, 0x8a, 0x39, 0xfc, 0xa6, 0xc7, 0x69, 0xc3, 0x23, 0x65, 0x90, 0x1c, 0xa3, 0x7a, 0x7c,
+	0x4b, 0x0f, 0xa3, 0xff, 0x82, 0xa9, 0x88, 0x78, 0xf8, 0x58, 0xbb, 0x85, 0xd5, 0x71, 0xa4, 0x9d,
+	0x33, 0xd4, 0xf9, 0x42, 0xc6, 0x35, 0xc4, 0x3a, 0x21, 0x3b, 0x02, 0x40, 0xba, 0x84, 0xc2, 0x42,
+	0xac, 0x7e, 0x3d, 0x6c, 0x46, 0xde, 0x71, 0xb2, 0x0a, 0x01, 0x6f, 0x3b, 0xb8, 0x51, 0x1e, 0xee,
+	0x5c, 0x44, 0x9c, 0x1f, 0x8f, 0x24, 0x94, 0x5e, 0xb0, 0x90, 0x73, 0x1f, 0x37, 0xb2, 0xde, 0xd7,
+	0xa2, 0xa4, 0xa1, 0x08, 0xe3, 0x6a, 0x29, 0xc5, 0xee, 0xbd, 0xaf, 0xe4, 0x6c, 0x68, 0x18, 0xb9,
+	0xa0, 0x0a, 0x2c, 0xf8, 0xf8, 0x28, 0x1b, 0xce, 0xb2, 0x14, 0xda, 0x8c, 0xba, 0xc4, 0x76, 0xc2,
+	0x66, 0xc0, 0xcb, 0x23, 0x8b, 0xc6, 0x52, 0xd1, 0x9a, 0xf3, 0xf1, 0x51, 0x1a, 0xa7, 0x4f, 0x04,
+	0xd1, 0x0e, 0x75, 0xc9, 0x7d, 0x41, 0x82, 0x18, 0xdc, 0xa0, 0xc1, 0x33, 0x3b, 0x22, 0x1f, 0xe0,
+	0xc8, 0xb5, 0x99, 0xc8, 0x08, 0xd7, 0x8e, 0xc8, 0x41, 0x93, 0x46, 0xc4, 0x27, 0x01, 0xb7, 0x79,
+	0x3d, 0x22, 0xac, 0x1e, 0x7a, 0x6e, 0x79, 0x54, 0xaa, 0x7d, 0x59, 0xab, 0x3d, 0x79, 0x52, 0xed,
+	0x8d, 0x80, 0x5b, 0x57, 0x69, 0xf0, 0xcc, 0x92, 0x60, 0x3b, 0x12, 0xcb, 0x4a, 0xa1, 0x76, 0x63,
+	0x24, 0xf4, 0x10, 0x16, 0x79, 0x84, 0x95, 0xf1, 0x25, 0x2d, 0xb3, 0x0f, 0x89, 0xaa, 0x95, 0x6e,
+	0x53, 0xc6, 0x6d, 0x50, 0x2e, 0xc9, 0x00, 0xb9, 0xac, 0xe9, 0x14, 0x24, 0x7b, 0xaa, 0xa8, 0x2a,
+	0x9a, 0x48, 0x58, 0xda, 0xa3, 0x07, 0x4d, 0xea, 0x62, 0x1e, 0x46, 0xc9, 0x22, 0xd2, 0xa0, 0x19,
+	0xeb, 0xc2, 0xd2, 0x29, 0x90, 0xd6, 0x3f, 0x09, 0x9d, 0x23, 0x78, 0x71, 0x8f, 0x06, 0x38, 0x3a,
+	0xb6, 0xc3, 0x86, 0x10, 0xcb, 0xce, 0x2b, 0xf4, 0xa8, 0xb3, 0x42, 0x7f, 0x4d, 0x21, 0x3e, 0x51,
+	0x80, 0x67, 0xd5, 0xfa, 0xff, 0x81, 0x45, 0xcc, 0x43, 0x9f, 0x3a, 0xb1, 0x44, 0xe5, 0x62, 0xec,
+	0x38, 0x84, 0x31, 0xdb, 0x23, 0x87, 0xc4, 0x2b, 0x8f, 0x2f, 0x1a, 0x4b, 0x23, 0xb7, 0x5f, 0x5d,
+	0x3e, 0x75, 0x27, 0x5f, 0x5e, 0x95, 0xec, 0x0a, 0x5f, 0xba, 0x7e, 0x55, 0xf2, 0x6e, 0x0a, 0x56,
+	0xeb, 0x12, 0x3e, 0x67, 0x16, 0x1d, 0xc1, 0x0d, 0x59, 0xfd, 0x4f, 0xd3, 0x40, 0x24, 0xa7, 0xce,
+	0x65, 0x4a, 0xa2, 0xf2, 0x44, 0xe7, 0x76, 0x36, 0x05, 0xe6, 0x09, 0xad, 0xd6, 0x09, 0xd9, 0x4a,
+	0xe0, 0xd0, 0x47, 0x06, 0xbc, 0x92, 0x89, 0xeb, 0x0e, 0x14, 0x98, 0xec, 0x5c, 0x81, 0xa5, 0x14,
+	0xf9, 0x02, 0x35, 0xbe, 0x6d, 0xc0, 0xad, 0x36, 0xc7, 0x77, 0xa0, 0xca, 0x54, 0xe7, 0xaa, 0xbc,
+	0xd4, 0x12, 0x04, 0x17, 0x68, 0xf3, 0x3e, 0xcc, 0xf8, 0x34, 0xa0, 0x3e, 0xf6, 0x6c, 0x79, 0xda,
+	0x71, 0x42, 0x2f, 0xdd, 0xba, 0xa6, 0x3b, 0x17, 0x3a, 0xa5, 0x51, 0xb6, 0x35, 0x48, 0xbc, 0x67,
+	0xbd, 0x07, 0x2f, 0x51, 0x96, 0x84, 0xf4, 0xc9, 0x53, 0x8d, 0x87, 0x9b, 0x81, 0x53, 0xb7, 0x49,
+	0x80, 0xf7, 0x3c, 0xe2, 0x96, 0xcb, 0x8b, 0xc6, 0x52, 0xc1, 0x7a, 0x81, 0x32, 0x1d, 0xb5, 0x95,
+	0xb6, 0x83, 0xcb, 0xa6, 0x24, 0x7f, 0xa0, 0xa8, 0x45, 0xb1, 0x6a, 0x84, 0x8c, 0xdb, 0x61, 0xe0,
+	0x1d, 0xdb, 0x7e, 0xe8, 0x12, 0xbb, 0x4e, 0x68, 0xad, 0x9e, 0x2d, 0x2f, 0x33, 0x32, 0xe1, 0xe7,
+	0x04, 0xd9, 0x93, 0xc0, 0x3b, 0xde, 0x0a, 0x5d, 0xf2, 0x48, 0xd2, 0xa4, 0x75, 0xa3, 0x06, 0xb7,
+	0xf4, 0xe6, 0xe6, 0x12, 0x27, 0x22, 0x98, 0x11, 0xbb, 0x11, 0x51, 0x87, 0xd8, 0x9c, 0xfa, 0x84,
+	0x71, 0xec, 0x37, 0x52, 0x3c, 0x9b, 0x11, 0x27, 0x0c, 0x5c, 0x56, 0x9e, 0x95, 0xb8, 0x2f, 0x2b,
+	0xc6, 0x8a, 0xe6, 0xdb, 0x16, 0x6c, 0xbb, 0x31, 0x57, 0x22, 0x61, 0x47, 0xf1, 0xa0, 0x1b, 0x30,
+	0x1a, 0x27, 0x91, 0x8d, 0x5d, 0x9f, 0x06, 0xac, 0x3c, 0xb7, 0x98, 0x5f, 0x1a, 0xb4, 0x46, 0xe2,
+	0xe1, 0x55, 0x39, 0x8a, 0x36, 0x61, 0x5c, 0x94, 0x4f, 0xdc, 0x74, 0x84, 0x0b, 0x6d, 0x51, 0x90,
+	0xc5, 0x4e, 0x72, 0xa9, 0x93, 0x52, 0x59, 0xa2, 0xc1, 0xb3, 0x55, 0xc5, 0xb8, 0x85, 0x8f, 0xc4,
+	0xc6, 0x71, 0x13, 0xc6, 0xaa, 0xf4, 0x88, 0xb8, 0x76, 0x0d, 0xb3, 0xc4, 0xd0, 0x97, 0xa5, 0xa1,
+	0x47, 0xe5, 0xc4, 0x43, 0xcc, 0x62, 0x8b, 0xde, 0x83, 0x59, 0xe2, 0x53, 0x6e, 0x7b, 0xd2, 0xb1,
+	0xf6, 0x21, 0x89, 0x98, 0xd0, 0x80, 0x1c, 0x92, 0x80, 0xb3, 0xf2, 0xbc, 0x64, 0x9a, 0x16, 0x14,
+	0xca, 0xf3, 0x4f, 0xd5, 0xfc, 0x03, 0x39, 0x8d, 0xf6, 0xd2, 0x03, 0x5e, 0x44, 0xdc, 0x66, 0xfb,
+	0xa1, 0x61, 0xa1, 0xf3, 0x68, 0x8a, 0xcf, 0x04, 0x96, 0x84, 0xc9, 0x9e, 0x17, 0xde, 0x80, 0x4b,
+	0x6d, 0x2e, 0xdf, 0xf3, 0x42, 0x67, 0x9f, 0xd9, 0xd8, 0x97, 0x9b, 0xd3, 0x95, 0x45, 0x63, 0xa9,
+	0xd7, 0x2a, 0x67, 0xfd, 0xbd, 0x26, 0x09, 0x56, 0xe5, 0x3c, 0xda, 0x82, 0x6b, 0x3e, 0x0d, 0xec,
+	0x36, 0x0c, 0x37, 0xfc, 0x20, 0x10, 0xde, 0x4e, 0x37, 0x0a, 0x53, 0x28, 0x6b, 0x2d, 0xf8, 0x34,
+	0xd8, 0xce, 0x40, 0x55, 0x34, 0x5d, 0xb2, 0x55, 0xbc, 0x0b, 0x2f, 0x9d, 0xa7, 0x8e, 0x8d, 0xab,
+	0x9c, 0x44, 0x09, 0x7c, 0xf9, 0xaa, 0xd4, 0xee, 0xfa, 0x59, 0xda, 0xad, 0x0a, 0xea, 0x58, 0x06,
+	0x7a, 0x1f, 0x26, 0x13, 0xbd, 0xe2, 0x8d, 0x4d, 0x88, 0x28, 0x5f, 0x93, 0xb5, 0xf9, 0xe6, 0x19,
+	0xb5, 0x39, 0xe6, 0xdf, 0x55, 0x2c, 0x42, 0x86, 0x35, 0xee, 0x9e, 0x1c, 0x44, 0x6f, 0xc0, 0x5c,
+	0x82, 0xdf, 0xc0, 0x4d, 0x46, 0xec, 0x78, 0xb3, 0x12, 0x75, 0xa3, 0x7c, 0x5d, 0x3a, 0x7b, 0x26,
+	0x26, 0xd9, 0x16, 0x14, 0x9b, 0x19, 0x02, 0xf4, 0x1a, 0x4c, 0x27, 0xfc, 0xd5, 0x88, 0x90, 0x0f,
+	0x49, 0x7c, 0xf8, 0x29, 0xbf, 0x20, 0x79, 0x13, 0xf5, 0xd7, 0xe5, 0xac, 0x3e, 0xcf, 0xdc, 0x2d,
+	0x7f, 0xfd, 0xe9, 0x82, 0xf1, 0xf1, 0x9f, 0x7e, 0x76, 0x33, 0xc9, 0x86, 0x15, 0x75, 0xbd, 0x7a,
+	0xb3, 0xb7, 0xb0, 0x58, 0xba, 0x62, 0x7e, 0x2f, 0x07, 0x63, 0xf1, 0x22, 0x1e, 0x46, 0xd8, 0x21,
+	0x52, 0xdb, 0x59, 0x28, 0x24, 0x66, 0x34, 0xa4, 0x73, 0x92, 0x6f, 0x74, 0x05, 0x86, 0x19, 0xc7,
+	0x11, 0xd7, 0xe9, 0x2f, 0xaf, 0x45, 0x79, 0x6b, 0x48, 0x8e, 0xa9, 0x6c, 0x47, 0x97, 0x01, 0x48,
+	0xe0, 0xc6, 0x04, 0x79, 0x49, 0x30, 0x48, 0x02, 0x57, 0x4f, 0x6f, 0xc1, 0x70, 0x8b, 0x89, 0x7b,
+	0xbb, 0x36, 0xf1, 0x10, 0x4f, 0x3f, 0xd0, 0x0a, 0x8c, 0x67, 0x6d, 0xa9, 0xcc, 0xeb, 0xca, 0x9b,
+	0x47, 0xc1, 0x42, 0xd9, 0x29, 0x69, 0x56, 0x17, 0x5d, 0x87, 0x91, 0xf8, 0xe0, 0x58, 0x8d, 0xc2,
+	0x0f, 0x49, 0x20, 0xaf, 0x0d, 0x05, 0xab, 0xa8, 0x47, 0xd7, 0xe5, 0xa0, 0xf9, 0xef, 0x30, 0xf1,
+	0x98, 0x1c, 0xc5, 0x47, 0xe1, 0xa4, 0xd2, 0x08, 0xf6, 0x80, 0x1c, 0xf1, 0xb4, 0x62, 0x49, 0x13,
+	0xe5, 0xad, 0xa2, 0x18, 0x4d, 0xc8, 0xcc, 0x3f, 0x1b, 0x30, 0xb2, 0x45, 0x5d, 0x59, 0xa6, 0x56,
+	0x03, 0x77, 0xf7, 0xc9, 0x1a, 0xfa, 0x4f, 0x18, 0xf4, 0xa9, 0xab, 0x0a, 0x5e, 0xd9, 0x48, 0x32,
+	0xd4, 0xb8, 0x28, 0x43, 0x0b, 0xbe, 0xc6, 0x41, 0x1b, 0x30, 0xb2, 0x27, 0x0e, 0xa1, 0x7b, 0xcd,
+	0x63, 0x0d, 0x93, 0xeb, 0x1c, 0x66, 0x58, 0xb0, 0xae, 0x35, 0x8f, 0x15, 0xd4, 0x5b, 0x30, 0x2a,
+	0xa1, 0x18, 0xf1, 0x3c, 0x8d, 0x95, 0xef, 0x1c, 0xab, 0x28, 0x78, 0x77, 0x88, 0xe7, 0x49, 0x30,
+	0xf3, 0xc7, 0x06, 0x0c, 0x54, 0x48, 0x23, 0x64, 0x94, 0xa3, 0x6d, 0x18, 0xc3, 0x87, 0x98, 0x7a,
+	0xa2, 0xc8, 0xd9, 0x7b, 0xd8, 0xc3, 0x41, 0xcb, 0x6a, 0x2f, 0xac, 0x47, 0xa5, 0x84, 0x7b, 0x4d,
+	0x31, 0xa3, 0x47, 0x50, 0xe4, 0x21, 0xc7, 0x5e, 0x82, 0x96, 0xeb, 0x1c, 0x6d, 0x58, 0x72, 0x6a,
+	0x24, 0xf3, 0x65, 0x98, 0xd8, 0x69, 0xee, 0x61, 0x47, 0x1e, 0xae, 0x45, 0x44, 0x91, 0xc7, 0xa1,
+	0x90, 0x30, 0x01, 0x7d, 0x41, 0x18, 0xeb, 0x59, 0xb4, 0xd4, 0x87, 0xf9, 0x4b, 0x03, 0x46, 0x53,
+	0x72, 0xb9, 0xa1, 0xa3, 0x7f, 0x85, 0xbe, 0x76, 0xff, 0x5d, 0xa8, 0x83, 0xe2, 0x40, 0xff, 0x01,
+	0x85, 0x83, 0x26, 0x0e, 0x38, 0xe5, 0xc7, 0xdd, 0xac, 0x20, 0x61, 0x42, 0x26, 0x0c, 0x53, 0xa6,
+	0xca, 0xb4, 0xa8, 0x68, 0xd2, 0x5f, 0x05, 0xab, 0x65, 0x0c, 0x95, 0x20, 0xef, 0x50, 0x57, 0x5d,
+	0xf0, 0x2d, 0xf1, 0xd3, 0x8c, 0x60, 0xbc, 0x6d, 0x11, 0x15, 0xcc, 0x31, 0xfa, 0x37, 0xe8, 0x93,
+	0x87, 0x1f, 0xdd, 0x44, 0x79, 0xe1, 0x8c, 0xf4, 0x6b, 0x63, 0xb5, 0x14, 0x93, 0x48, 0x71, 0xf9,
+	0xc3, 0xae, 0x63, 0x56, 0x97, 0xab, 0x19, 0xb6, 0x06, 0xe5, 0xc8, 0x23, 0xcc, 0xea, 0xe6, 0xaf,
+	0x72, 0x50, 0xd8, 0x16, 0xd1, 0x20, 0xea, 0xf6, 0x14, 0xf4, 0x53, 0xb6, 0x19, 0x06, 0x35, 0x29,
+	0xaa, 0x60, 0xe9, 0xaf, 0x6f, 0x6e, 0x8f, 0x0a, 0x0c, 0x91, 0x80, 0x47, 0xc7, 0x27, 0xc2, 0xf7,
+	0x42, 0x0c, 0x90, 0x7c, 0x2a, 0x11, 0xee, 0x41, 0xbf, 0xda, 0x3a, 0xbb, 0xe9, 0x8a, 0x68, 0x16,
+	0xf4, 0xdf, 0x50, 0x76, 0x9a, 0x7e, 0xd3, 0x53, 0xe7, 0xac, 0xb8, 0xac, 0x48, 0xf4, 0x6e, 0x7a,
+	0x1f, 0x53, 0x29, 0x88, 0xae, 0x37, 0x0f, 0x04, 0x84, 0xf9, 0xb1, 0x01, 0x03, 0x71, 0x16, 0x5c,
+	0x85, 0x22, 0x4b, 0x9c, 0x61, 0x53, 0x57, 0x57, 0xe6, 0xe1, 0x74, 0x70, 0xc3, 0x15, 0x81, 0xec,
+	0x92, 0x20, 0xf4, 0x95, 0x41, 0x2d, 0xf5, 0x81, 0xee, 0x42, 0xc1, 0x55, 0xd9, 0xc9, 0xa4, 0x95,
+	0x86, 0x6e, 0xcf, 0x9f, 0x55, 0x6d, 0x15, 0x99, 0x95, 0xd0, 0xdf, 0x2d, 0x7c, 0xeb, 0xd3, 0x85,
+	0x9e, 0xaf, 0x3f, 0x5d, 0xe8, 0x31, 0x7f, 0x68, 0x00, 0x4a, 0xcf, 0x88, 0x89, 0x7b, 0x3b, 0xd2,
+	0x6b, 0x0e, 0x06, 0xe3, 0x1b, 0x97, 0xab, 0x75, 0x2b, 0xa8, 0x81, 0x0d, 0x71, 0x10, 0x2a, 0x34,
+	0x34, 0x9a, 0x56, 0x6f, 0xe1, 0x0c, 0xf5, 0x62, 0xa1, 0x56, 0xc2, 0x90, 0xd1, 0x6f, 0x03, 0x26,
+	0x32, 0x47, 0xef, 0x8d, 0xc0, 0xa5, 0x0e, 0xe6, 0x61, 0xd4, 0x2a, 0xdb, 0x68, 0x93, 0x3d, 0x01,
+	0x7d, 0x94, 0xad, 0x35, 0x55, 0x04, 0x16, 0x2c, 0xf5, 0x61, 0xfe, 0x36, 0x07, 0x05, 0x59, 0x1e,
+	0x36, 0xc3, 0xd6, 0x38, 0x35, 0x9e, 0x27, 0x4e, 0x93, 0x9a, 0x91, 0xeb, 0xba, 0x66, 0x9c, 0x30,
+	0x6e, 0x5e, 0xa6, 0x5a, 0xab, 0x71, 0xef, 0x40, 0x5e, 0xdc, 0x5b, 0xbb, 0x08, 0x5f, 0x41, 0xdf,
+	0x96, 0xc3, 0x7d, 0x6d, 0x39, 0x8c, 0x5e, 0x87, 0x49, 0x79, 0x39, 0x21, 0x0e, 0x6d, 0x50, 0xd1,
+	0x47, 0xc0, 0xae, 0x1b, 0x11, 0xc6, 0xe4, 0x6e, 0x39, 0x2c, 0x2f, 0xc1, 0x86, 0x35, 0x5e, 0x25,
+	0xc4, 0x8a, 0x29, 0x56, 0x15, 0x41, 0x5c, 0x83, 0x06, 0xd2, 0x1a, 0xf4, 0xfd, 0x1c, 0x14, 0x63,
+	0xdf, 0x55, 0x88, 0xc7, 0x31, 0x9a, 0x86, 0x01, 0xca, 0x6c, 0xef, 0x64, 0x55, 0xb0, 0x00, 0x91,
+	0x23, 0xe2, 0x34, 0x05, 0xa9, 0xfd, 0x3c, 0xf5, 0x61, 0x2c, 0x61, 0x7f, 0x3b, 0x76, 0xc0, 0x63,
+	0x28, 0xa5, 0x98, 0x3a, 0xd9, 0xbb, 0xa8, 0x16, 0xa3, 0x09, 0xb3, 0x3a, 0x1d, 0xa3, 0x4d, 0x48,
+	0x87, 0x74, 0xf1, 0xe9, 0xc2, 0xf8, 0x23, 0x09, 0xaf, 0xda, 0x3c, 0x7f, 0x90, 0xcf, 0xe6, 0x55,
+	0x12, 0x76, 0xa7, 0xe6, 0x55, 0xbb, 0xeb, 0xdf, 0x82, 0x91, 0x38, 0x13, 0x6c, 0x57, 0x18, 0x56,
+	0xb7, 0xa9, 0xaf, 0x5d, 0x90, 0x40, 0xd2, 0x09, 0x56, 0xb1, 0xd1, 0xe2, 0x93, 0x7b, 0xd0, 0xdf,
+	0xc0, 0xc7, 0x61, 0x93, 0x77, 0x63, 0x1c, 0xcd, 0xf2, 0xcf, 0x1f, 0x84, 0x42, 0xc3, 0x46, 0xe0,
+	0x75, 0xd3, 0x4f, 0x15, 0xf4, 0xe6, 0x21, 0xa0, 0x74, 0x13, 0x4c, 0xaa, 0x5e, 0xb6, 0x66, 0x19,
+	0x5d, 0xd6, 0xac, 0x93, 0xae, 0xcd, 0x9d, 0x74, 0xad, 0x19, 0xc1, 0x58, 0x2a, 0x37, 0x3e, 0x5c,
+	0x75, 0x14, 0x14, 0xaf, 0xc3, 0x80, 0x2e, 0xdf, 0xe5, 0x5c, 0x47, 0xd5, 0x3e, 0x26, 0x37, 0xf7,
+	0xa1, 0xa8, 0xc7, 0xde, 0x69, 0xb8, 0x98, 0x93, 0x74, 0x3f, 0x31, 0xb2, 0xfb, 0x49, 0x25, 0xb3,
+	0x9f, 0xe4, 0x16, 0xf3, 0x4b, 0x43, 0xb7, 0x97, 0x2e, 0x3c, 0x3e, 0x9c, 0xd8, 0x59, 0xcc, 0xdf,
+	0x18, 0x50, 0xda, 0x0e, 0x69, 0xc0, 0x59, 0xa6, 0x47, 0xf2, 0x1e, 0x4c, 0xab, 0x27, 0x84, 0x86,
+	0x9c, 0xc9, 0xb6, 0x65, 0xba, 0xa8, 0xbd, 0x93, 0x12, 0xe3, 0x34, 0x70, 0x7e, 0x06, 0x78, 0x17,
+	0x05, 0x66, 0x92, 0x9f, 0x06, 0x6e, 0xfe, 0x35, 0x07, 0xf3, 0xbb, 0xd9, 0x5e, 0xe7, 0x7d, 0xec,
+	0x37, 0x30, 0xad, 0x05, 0x6b, 0x61, 0xc8, 0xf8, 0x46, 0x50, 0x0d, 0xd1, 0x1d, 0x98, 0xde, 0x13,
+	0x1f, 0xc4, 0xb5, 0x5b, 0x9e, 0xb6, 0x5c, 0x56, 0x36, 0x64, 0x73, 0x62, 0x42, 0x4f, 0xef, 0xa4,
+	0x0f, 0x56, 0xae, 0x78, 0x09, 0x9b, 0xce, 0x92, 0xa7, 0x5a, 0xc7, 0xd6, 0xbf, 0x71, 0x66, 0xe8,
+	0xb5, 0xea, 0xa8, 0x3b, 0x97, 0x93, 0xe9, 0x7b, 0x58, 0x3a, 0xc7, 0xd0, 0x2a, 0x5c, 0x8e, 0xb5,
+	0x3b, 0xe5, 0x45, 0xcc, 0x15, 0x47, 0x07, 0xa1, 0xe3, 0xac, 0x26, 0x6a, 0x6f, 0x17, 0x09, 0x4d,
+	0x0f, 0xe0, 0xf2, 0x49, 0xd6, 0xac, 0xbe, 0xbd, 0xcf, 0xa3, 0xef, 0x5c, 0xfb, 0x93, 0x5a, 0x46,
+	0x6b, 0xf3, 0xe7, 0x06, 0xa0, 0xd8, 0xd2, 0xca, 0xee, 0xdb, 0x61, 0xe8, 0x89, 0xfe, 0x8f, 0xba,
+	0xa6, 0xb6, 0x5f, 0xd3, 0x46, 0xe4, 0x70, 0x7a, 0x9d, 0xfb, 0x5f, 0x98, 0x50, 0x3d, 0x1f, 0x05,
+	0x11, 0xb7, 0xb3, 0xb5, 0x65, 0xcf, 0xe9, 0x02, 0xff, 0x8b, 0xd0, 0xed, 0xa7, 0x7f, 0x58, 0x58,
+	0xaa, 0x51, 0x5e, 0x6f, 0xee, 0x2d, 0x3b, 0xa1, 0xbf, 0xa2, 0x88, 0xf5, 0x7f, 0xaf, 0x30, 0x77,
+	0x7f, 0x85, 0x1f, 0x37, 0x08, 0x93, 0x0c, 0xcc, 0x42, 0xbe, 0x68, 0x12, 0x65, 0x55, 0x65, 0xe6,
+	0x8f, 0x72, 0x30, 0x73, 0x6a, 0xd4, 0xc8, 0x80, 0xb9, 0x0b, 0x33, 0x89, 0x62, 0x71, 0xbb, 0x24,
+	0x69, 0x8b, 0xa9, 0xf5, 0x4c, 0xc7, 0x04, 0x71, 0x9f, 0x24, 0xee, 0x80, 0x5d, 0x81, 0xe1, 0x83,
+	0x66, 0xc8, 0x89, 0x2d, 0x73, 0x56, 0x2d, 0x68, 0xd0, 0x1a, 0x92, 0x63, 0x15, 0x39, 0x84, 0x1a,
+	0x30, 0xd3, 0xda, 0xc5, 0xb7, 0xa5, 0x6f, 0x6d, 0x1a, 0x54, 0x43, 0x7d, 0x12, 0xbb, 0x73, 0x86,
+	0xab, 0xce, 0x8f, 0x74, 0x6b, 0xaa, 0xa5, 0xeb, 0x9f, 0x66, 0x80, 0xe8, 0x63, 0x50, 0x76, 0xd0,
+	0xc4, 0x1e, 0xad, 0x52, 0xe2, 0x66, 0xa3, 0xab, 0x57, 0xea, 0x37, 0x99, 0x9d, 0x4e, 0x02, 0xcb,
+	0xfc, 0x45, 0x0e, 0xc6, 0xd7, 0x09, 0xa9, 0x50, 0xa6, 0xae, 0x6e, 0x54, 0x1c, 0xf0, 0xaa, 0x21,
+	0xda, 0x81, 0x71, 0x55, 0x2e, 0x5c, 0x3d, 0xa3, 0x9a, 0xa9, 0x5d, 0x94, 0x8a, 0x31, 0xc9, 0x1f,
+	0x03, 0xcb, 0x3e, 0xea, 0x0e, 0x8c, 0xf3, 0x53, 0x40, 0xbb, 0x39, 0x83, 0xf0, 0x13, 0xa0, 0x6b,
+	0x50, 0xd4, 0x6f, 0x33, 0xba, 0x7b, 0x96, 0xef, 0xa4, 0xc3, 0x38, 0xac, 0x78, 0x74, 0x43, 0xed,
+	0x1e, 0xf4, 0x1f, 0x86, 0x5e, 0xd3, 0xef, 0x6a, 0x9b, 0xd5, 0x2c, 0xe6, 0xff, 0xb7, 0x9a, 0x70,
+	0xc7, 0xa9, 0x13, 0xb7, 0xe9, 0xc9, 0x86, 0xce, 0x5e, 0xd3, 0x11, 0x5e, 0x90, 0xe3, 0xd2, 0x76,
+	0xbd, 0xd6, 0x90, 0x1a, 0x53, 0x4f, 0x4c, 0x37, 0x60, 0x54, 0x93, 0x24, 0x3d, 0x3b, 0xd5, 0xf6,
+	0x19, 0x51, 0xc3, 0x49, 0x8b, 0xae, 0x3d, 0xe6, 0xf2, 0x27, 0x63, 0x6e, 0x03, 0x80, 0x53, 0x12,
+	0xc9, 0x18, 0x8b, 0xeb, 0xc1, 0x59, 0xbd, 0x9f, 0x53, 0x3c, 0x6e, 0x0d, 0x72, 0xfd, 0x8b, 0x9d,
+	0x17, 0x4c, 0x7d, 0xe7, 0x05, 0xd3, 0x16, 0xa0, 0x36, 0xe4, 0xdd, 0xdd, 0x4d, 0x84, 0xa0, 0x97,
+	0xc7, 0xdb, 0x4c, 0xaf, 0x25, 0x7f, 0x8b, 0xed, 0x96, 0x73, 0x2f, 0x53, 0x43, 0xd4, 0xb2, 0x87,
+	0x39, 0xf7, 0xd2, 0x4e, 0xcf, 0x77, 0x0c, 0x18, 0x59, 0x55, 0x9b, 0x9c, 0xce, 0x6a, 0x54, 0x86,
+	0x01, 0xbd, 0xed, 0xe9, 0x8d, 0x33, 0xfe, 0x44, 0x04, 0x06, 0xfe, 0x81, 0x15, 0x26, 0xc6, 0x36,
+	0xff, 0xcf, 0x80, 0x61, 0x79, 0x92, 0xb4, 0x88, 0x13, 0x0a, 0x8d, 0xce, 0xbd, 0x04, 0xed, 0xc2,
+	0x84, 0x87, 0xb9, 0xe8, 0x06, 0x89, 0xb4, 0x95, 0xc7, 0xad, 0x30, 0xd5, 0xd0, 0x3c, 0xa7, 0x04,
+	0x68, 0x7c, 0x0b, 0x29, 0xfe, 0xac, 0x48, 0xf3, 0x35, 0x28, 0xa6, 0xdb, 0xff, 0x46, 0x85, 0x89,
+	0xce, 0x59, 0xcb, 0xe1, 0x45, 0xed, 0x7a, 0xc3, 0x56, 0x31, 0x7b, 0x7a, 0x61, 0xe6, 0x4f, 0x0c,
+	0x18, 0xca, 0x00, 0xa1, 0x4b, 0x30, 0xd8, 0x5e, 0xc4, 0xd3, 0x81, 0x6f, 0x72, 0xb9, 0xca, 0x5e,
+	0xec, 0xf2, 0xcf, 0x71, 0xb1, 0x33, 0x7d, 0xe8, 0x53, 0x0f, 0x6d, 0xb7, 0xc0, 0x68, 0x74, 0x53,
+	0x74, 0x8c, 0x86, 0x60, 0x39, 0xe8, 0x46, 0x67, 0xe3, 0xc0, 0xfc, 0xae, 0x01, 0x0b, 0xab, 0xb5,
+	0x5a, 0x44, 0x6a, 0x98, 0x93, 0xd4, 0xb4, 0x4f, 0x65, 0x7e, 0x6b, 0x63, 0x75, 0x74, 0x1b, 0x7f,
+	0x13, 0x46, 0x74, 0x30, 0xa8, 0xda, 0x10, 0x7b, 0xfa, 0xea, 0x19, 0x9e, 0x56, 0xa9, 0xa3, 0xe5,
+	0x14, 0xfd, 0xcc, 0x17, 0x33, 0x3f, 0x32, 0xe0, 0x52, 0xa2, 0xd4, 0xea, 0x29, 0x1a, 0x9d, 0x9d,
+	0x0b, 0x7f, 0x4f, 0x35, 0x56, 0xc5, 0xc9, 0x35, 0x08, 0xfd, 0x0a, 0x71, 0xc4, 0xd3, 0x18, 0x3b,
+	0xe3, 0xe4, 0x2a, 0x3a, 0xdb, 0x9a, 0x42, 0x1a, 0xbf, 0xd7, 0x4a, 0xbe, 0x4d, 0x02, 0xe8, 0x61,
+	0x84, 0x03, 0xbe, 0xda, 0xe4, 0xf5, 0x30, 0xa2, 0x1f, 0xaa, 0x92, 0x56, 0x86, 0x81, 0x9a, 0x18,
+	0x25, 0x71, 0x2b, 0x3c, 0xfe, 0x44, 0x77, 0xa0, 0x5f, 0x97, 0xf2, 0x5c, 0x27, 0xa5, 0x5c, 0x13,
+	0x9b, 0xef, 0xc3, 0xd0, 0xaa, 0x5c, 0x9b, 0x14, 0x96, 0xe2, 0x47, 0xad, 0xf8, 0xd1, 0xf3, 0xe2,
+	0x7f, 0x62, 0xc0, 0xc8, 0x83, 0x6a, 0x95, 0x74, 0x24, 0x63, 0x03, 0xc6, 0x02, 0xc2, 0x6d, 0xf5,
+	0xa9, 0xff, 0x7a, 0xa0, 0x33, 0x71, 0xa3, 0x01, 0xe1, 0x0f, 0x15, 0x9b, 0xfc, 0x3b, 0x01, 0x34,
+	0x03, 0x05, 0xca, 0xec, 0x43, 0xec, 0xe9, 0x2e, 0x45, 0xc1, 0x1a, 0xa0, 0xec, 0xa9, 0xf8, 0x34,
+	0x1b, 0x50, 0x92, 0xce, 0xd9, 0xa2, 0xc1, 0xe3, 0x50, 0x58, 0x15, 0x7b, 0x67, 0xf8, 0x67, 0x1d,
+	0x86, 0xc5, 0x93, 0x51, 0xa0, 0xa9, 0xba, 0x49, 0x90, 0x21, 0x3f, 0x45, 0xbf, 0xf9, 0x6b, 0x03,
+	0x8a, 0x0f, 0xe2, 0x6b, 0xf6, 0xee, 0x71, 0x83, 0xa0, 0x4b, 0x50, 0x7e, 0x27, 0x60, 0x0d, 0xe2,
+	0xc8, 0xcd, 0xa0, 0x65, 0xae, 0xd4, 0x83, 0x00, 0xfa, 0x55, 0x74, 0x95, 0x0c, 0x54, 0x84, 0xc1,
+	0x4d, 0xea, 0x53, 0xbe, 0x4e, 0x3d, 0xaf, 0x94, 0x43, 0xb3, 0x30, 0x25, 0x3f, 0xb7, 0x30, 0x77,
+	0xea, 0x96, 0xfa, 0xf3, 0x05, 0xd9, 0x61, 0x2a, 0xe5, 0xd1, 0x14, 0xa0, 0x74, 0xee, 0x31, 0xf9,
+	0x40, 0x8d, 0xf7, 0xa2, 0x49, 0x18, 0xd3, 0x6f, 0xa8, 0xe9, 0xf3, 0x43, 0xa9, 0x4f, 0x40, 0x3d,
+	0x38, 0x6a, 0xd0, 0xe8, 0x58, 0x4d, 0xee, 0x10, 0xce, 0x3d, 0xf9, 0x87, 0x15, 0xa5, 0x7e, 0x01,
+	0xf5, 0xa4, 0x5a, 0x65, 0x84, 0x0b, 0xfc, 0xf8, 0xce, 0x58, 0x1a, 0xb8, 0x79, 0x1f, 0xc6, 0x4f,
+	0x79, 0x02, 0x41, 0x13, 0x50, 0x8a, 0x87, 0xe3, 0x47, 0xb3, 0x52, 0x8f, 0x00, 0x89, 0x47, 0xef,
+	0xe3, 0xc0, 0x21, 0x9e, 0x1c, 0x37, 0xd6, 0xf6, 0x3f, 0xfb, 0x72, 0xde, 0xf8, 0xfc, 0xcb, 0x79,
+	0xe3, 0x8f, 0x5f, 0xce, 0x1b, 0x9f, 0x7c, 0x35, 0xdf, 0xf3, 0xf9, 0x57, 0xf3, 0x3d, 0xbf, 0xfb,
+	0x6a, 0xbe, 0xe7, 0xdd, 0xb7, 0x33, 0x7b, 0xcb, 0x46, 0x9c, 0x75, 0x9b, 0x78, 0x8f, 0xad, 0x24,
+	0x39, 0xf8, 0x8a, 0x13, 0x46, 0x24, 0xfb, 0x59, 0xc7, 0x34, 0x58, 0xf1, 0x43, 0x71, 0x7a, 0x60,
+	0xe9, 0x5f, 0xf2, 0xc9, 0x7d, 0x68, 0xe5, 0xf0, 0xf6, 0x5e, 0xbf, 0x7c, 0xdc, 0x7e, 0xf5, 0x6f,
+	0x03, 0x00, 0xd5, 0x83, 0x12, 0xc7, 0xc0, 0x28, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -2521,6 +2687,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.PostOnlyModeBlocksAmountAfterDowntime != that1.PostOnlyModeBlocksAmountAfterDowntime {
 		return false
 	}
+	if this.DowntimeTradingMode != that1.DowntimeTradingMode {
+		return false
+	}
+	if this.DowntimePauseLiquidations != that1.DowntimePauseLiquidations {
+		return false
+	}
+	if this.DowntimeFreezeFunding != that1.DowntimeFreezeFunding {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -2543,6 +2718,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeFreezeFunding {
+		i--
+		if m.DowntimeFreezeFunding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DowntimePauseLiquidations {
+		i--
+		if m.DowntimePauseLiquidations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DowntimeTradingMode != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.DowntimeTradingMode))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.PostOnlyModeBlocksAmountAfterDowntime != 0 {
 		i = encodeVarintExchange(dAtA, i, uint64(m.PostOnlyModeBlocksAmountAfterDowntime))
 		i--
@@ -2875,6 +3081,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeGraceMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeGraceMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeGraceMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FundingFrozen {
+		i--
+		if m.FundingFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LiquidationsPaused {
+		i--
+		if m.LiquidationsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TradingMode != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.TradingMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintExchange(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Downtime) > 0 {
+		i -= len(m.Downtime)
+		copy(dAtA[i:], m.Downtime)
+		i = encodeVarintExchange(dAtA, i, uint64(len(m.Downtime)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NextFundingTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4691,6 +4962,43 @@ func (m *Params) Size() (n int) {
 	if m.PostOnlyModeBlocksAmountAfterDowntime != 0 {
 		n += 2 + sovExchange(uint64(m.PostOnlyModeBlocksAmountAfterDowntime))
 	}
+	if m.DowntimeTradingMode != 0 {
+		n += 2 + sovExchange(uint64(m.DowntimeTradingMode))
+	}
+	if m.DowntimePauseLiquidations {
+		n += 3
+	}
+	if m.DowntimeFreezeFunding {
+		n += 3
+	}
+	return n
+}
+
+func (m *DowntimeGraceMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Downtime)
+	if l > 0 {
+		n += 1 + l + sovExchange(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovExchange(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovExchange(uint64(m.EndHeight))
+	}
+	if m.TradingMode != 0 {
+		n += 1 + sovExchange(uint64(m.TradingMode))
+	}
+	if m.LiquidationsPaused {
+		n += 2
+	}
+	if m.FundingFrozen {
+		n += 2
+	}
 	return n
 }
 
@@ -6367,6 +6675,244 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeTradingMode", wireType)
+			}
+			m.DowntimeTradingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeTradingMode |= DowntimeTradingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePauseLiquidations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimePauseLiquidations = bool(v != 0)
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeFreezeFunding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeFreezeFunding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExchange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeGraceMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExchange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeGraceMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeGraceMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExchange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExchange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Downtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingMode", wireType)
+			}
+			m.TradingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradingMode |= DowntimeTradingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidationsPaused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExchange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FundingFrozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExchange(dAtA[iNdEx:])
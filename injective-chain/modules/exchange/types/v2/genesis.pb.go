@@ -105,6 +105,9 @@ type GenesisState struct {
 	GrantAuthorizations  []*FullGrantAuthorizations         `protobuf:"bytes,35,rep,name=grant_authorizations,json=grantAuthorizations,proto3" json:"grant_authorizations,omitempty"`
 	ActiveGrants         []*FullActiveGrant                 `protobuf:"bytes,36,rep,name=active_grants,json=activeGrants,proto3" json:"active_grants,omitempty"`
 	DenomMinNotionals    []*DenomMinNotional                `protobuf:"bytes,37,rep,name=denom_min_notionals,json=denomMinNotionals,proto3" json:"denom_min_notionals,omitempty"`
+	// downtime_grace_mode is the active grace mode following a chain downtime, if
+	// any
+	DowntimeGraceMode *DowntimeGraceMode `protobuf:"bytes,38,opt,name=downtime_grace_mode,json=downtimeGraceMode,proto3" json:"downtime_grace_mode,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,6 +402,13 @@ func (m *GenesisState) GetDenomMinNotionals() []*DenomMinNotional {
 	return nil
 }
 
+func (m *GenesisState) GetDowntimeGraceMode() *DowntimeGraceMode {
+	if m != nil {
+		return m.DowntimeGraceMode
+	}
+	return nil
+}
+
 type OrderbookSequence struct {
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -872,121 +882,124 @@ func init() {
 }
 
 var fileDescriptor_fff40080d86ae941 = []byte{
-	// 1823 bytes of a gzipped FileDescriptorProto
+	// 1857 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5b, 0x6f, 0x1c, 0x49,
-	0x15, 0x76, 0xc7, 0xc1, 0xb1, 0xcb, 0x76, 0xb2, 0x29, 0x5f, 0xd2, 0xb6, 0xe3, 0x19, 0x67, 0x9c,
-	0x84, 0x09, 0xb0, 0x33, 0x2b, 0x2f, 0x17, 0x2d, 0x0b, 0xd2, 0xfa, 0x36, 0x2b, 0x93, 0x64, 0xe3,
-	0x6d, 0x8f, 0x16, 0x81, 0x04, 0xbd, 0x35, 0xdd, 0x35, 0x33, 0x85, 0xbb, 0xbb, 0x7a, 0xab, 0xaa,
-	0x4d, 0x4c, 0xc4, 0x03, 0x08, 0x21, 0x84, 0x84, 0xb4, 0xbf, 0x00, 0xad, 0x04, 0x2f, 0xfc, 0x93,
-	0x7d, 0xe0, 0x61, 0x1f, 0x11, 0x0f, 0x11, 0x4a, 0x5e, 0xf8, 0x19, 0xa8, 0x2e, 0xdd, 0x3d, 0xb7,
-	0xee, 0x71, 0xd8, 0xb7, 0xe9, 0x3a, 0xe7, 0x7c, 0xdf, 0xa9, 0x3a, 0xa7, 0xce, 0x39, 0x35, 0x60,
-	0x97, 0x44, 0xbf, 0xc2, 0x9e, 0x20, 0x17, 0xb8, 0x89, 0x9f, 0x7b, 0x7d, 0x14, 0xf5, 0x70, 0xf3,
-	0x62, 0xaf, 0xd9, 0xc3, 0x11, 0xe6, 0x84, 0x37, 0x62, 0x46, 0x05, 0x85, 0x6b, 0x99, 0x52, 0x23,
-	0x55, 0x6a, 0x5c, 0xec, 0x6d, 0xae, 0xf6, 0x68, 0x8f, 0x2a, 0x8d, 0xa6, 0xfc, 0xa5, 0x95, 0x37,
-	0xef, 0x4f, 0x46, 0xcc, 0x0c, 0xb5, 0x56, 0x6d, 0xb2, 0x56, 0x88, 0xd8, 0x39, 0x16, 0x46, 0xe7,
-	0xc1, 0x64, 0x1d, 0xca, 0x7c, 0xcc, 0x3a, 0x94, 0x9e, 0x1b, 0xb5, 0xca, 0x64, 0x35, 0xf1, 0x5c,
-	0xcb, 0x6b, 0x7f, 0xdd, 0x06, 0x4b, 0x1f, 0xea, 0xfd, 0x9c, 0x09, 0x24, 0x30, 0x7c, 0x1f, 0xcc,
-	0xc5, 0x88, 0xa1, 0x90, 0xdb, 0xd6, 0x8e, 0x55, 0x5f, 0xdc, 0xdb, 0x6e, 0x4c, 0xdc, 0x5f, 0xe3,
-	0x54, 0x29, 0x1d, 0x5c, 0xff, 0xf2, 0x65, 0x75, 0xc6, 0x31, 0x26, 0xf0, 0x08, 0x2c, 0xf1, 0x98,
-	0x0a, 0x57, 0x7b, 0xca, 0xed, 0x6b, 0x3b, 0xb3, 0xf5, 0xc5, 0xbd, 0x7b, 0x05, 0x10, 0x67, 0x31,
-	0x15, 0x4f, 0x95, 0xa6, 0xb3, 0xc8, 0xb3, 0xdf, 0x1c, 0x7e, 0x02, 0xa0, 0x8f, 0x19, 0xb9, 0x40,
-	0xd2, 0x22, 0xc3, 0x9a, 0x55, 0x58, 0xdf, 0x2c, 0xc0, 0x3a, 0xca, 0x0c, 0x0c, 0xe2, 0x6d, 0x7f,
-	0x64, 0x85, 0xc3, 0x8f, 0xc1, 0x4d, 0xe5, 0x5d, 0x76, 0x46, 0xf6, 0x75, 0x85, 0x79, 0xbf, 0xc4,
-	0xbf, 0x67, 0x52, 0xf7, 0x80, 0xd2, 0x73, 0xb3, 0xd3, 0x65, 0x9e, 0x2e, 0x4a, 0x00, 0xe8, 0x81,
-	0xd5, 0x01, 0x57, 0x73, 0xe0, 0x6f, 0x28, 0xe0, 0x6f, 0x4d, 0x75, 0x76, 0x14, 0x7e, 0xc5, 0x1f,
-	0x16, 0x29, 0x92, 0x0f, 0xc0, 0x7c, 0x07, 0x05, 0x28, 0xf2, 0x30, 0xb7, 0xe7, 0x14, 0x70, 0xa5,
-	0x00, 0xf8, 0x40, 0xab, 0x19, 0xb0, 0xcc, 0x0a, 0x3e, 0x05, 0x0b, 0x31, 0xe5, 0x44, 0x10, 0x1a,
-	0x71, 0xfb, 0x86, 0x82, 0x78, 0x34, 0xd5, 0xb7, 0x53, 0x63, 0x61, 0xd0, 0x72, 0x04, 0xe8, 0x83,
-	0x3b, 0x3c, 0xe9, 0x20, 0xcf, 0xa3, 0x49, 0x24, 0x5c, 0xc1, 0x90, 0x8f, 0xdd, 0x88, 0x2a, 0xff,
-	0xe6, 0x15, 0xf8, 0xc3, 0xa2, 0x13, 0xcd, 0xac, 0x3e, 0xa2, 0xb9, 0x9f, 0x6b, 0x39, 0x58, 0x5b,
-	0x62, 0x29, 0x19, 0x87, 0xbf, 0xb3, 0xc0, 0x0e, 0x7e, 0x1e, 0x13, 0x76, 0xe9, 0x76, 0x13, 0x91,
-	0x30, 0xcc, 0x4d, 0x2e, 0xb8, 0x24, 0xea, 0x52, 0x97, 0xcb, 0x74, 0xb5, 0x17, 0x14, 0xdf, 0xbb,
-	0x05, 0x7c, 0xc7, 0xca, 0xbc, 0xa5, 0xad, 0x75, 0x1a, 0x9c, 0x44, 0x5d, 0xaa, 0x32, 0xdd, 0x90,
-	0xdf, 0xc5, 0x25, 0x3a, 0xd0, 0x07, 0x6b, 0x31, 0x66, 0x31, 0x16, 0x09, 0x0a, 0x06, 0xd9, 0x6d,
-	0x50, 0x1a, 0xe0, 0xd3, 0xd4, 0x26, 0xc7, 0x4b, 0x03, 0x1c, 0x8f, 0x8b, 0xe0, 0x6f, 0x41, 0x65,
-	0x8c, 0xa5, 0x9b, 0x44, 0x3e, 0x89, 0x7a, 0x66, 0x9b, 0x8b, 0x8a, 0x6e, 0xef, 0x6a, 0x74, 0x2d,
-	0x6d, 0x3a, 0xb8, 0xcb, 0xad, 0xb8, 0x58, 0x05, 0x7e, 0x6e, 0x81, 0x87, 0x63, 0x17, 0xce, 0xe5,
-	0x58, 0x88, 0x00, 0x87, 0x38, 0x12, 0x2e, 0xf7, 0xfa, 0xd8, 0x4f, 0x02, 0xec, 0xdb, 0x4b, 0xca,
-	0x8f, 0xef, 0x5d, 0xf1, 0x12, 0x9e, 0x65, 0x10, 0x03, 0x27, 0xb0, 0xeb, 0x17, 0x6a, 0x9d, 0xa5,
-	0x3c, 0xf0, 0x07, 0xc0, 0x26, 0xdc, 0x55, 0xb7, 0x35, 0x25, 0x70, 0x71, 0x84, 0x3a, 0xd2, 0x87,
-	0xe5, 0x1d, 0xab, 0x3e, 0xef, 0xac, 0x11, 0x2e, 0xef, 0xe7, 0xb1, 0x91, 0x1e, 0x6b, 0x21, 0x3c,
-	0x06, 0x55, 0xc2, 0xdd, 0x9c, 0x82, 0x8f, 0xdb, 0xdf, 0x54, 0xf6, 0x77, 0x09, 0xcf, 0xdd, 0xe5,
-	0xa3, 0x30, 0x9f, 0x81, 0xbb, 0x32, 0xad, 0x65, 0x00, 0x18, 0xfe, 0x35, 0x62, 0xbe, 0xeb, 0xa1,
-	0x30, 0x46, 0xa4, 0x17, 0xe9, 0xf0, 0xdf, 0x52, 0xb5, 0xf1, 0x9d, 0x82, 0x73, 0x68, 0x6b, 0x53,
-	0x47, 0x59, 0x1e, 0x1a, 0x43, 0x79, 0x04, 0xce, 0x86, 0x28, 0x12, 0xc1, 0x17, 0xe0, 0xc1, 0x08,
-	0x65, 0x4c, 0x69, 0x90, 0xf3, 0xa6, 0x41, 0xb0, 0xdf, 0x2a, 0xbd, 0xbf, 0x29, 0xa6, 0x66, 0x38,
-	0xa5, 0x34, 0x70, 0xee, 0x0d, 0x91, 0xca, 0xa5, 0x54, 0x29, 0x3d, 0x70, 0xf8, 0x17, 0x0b, 0x3c,
-	0x2c, 0xda, 0x70, 0x7a, 0xcf, 0x63, 0x4a, 0x22, 0xc1, 0xed, 0xdb, 0x8a, 0xfe, 0xbd, 0x37, 0xd9,
-	0xfa, 0xbe, 0x46, 0x38, 0x55, 0x00, 0x4e, 0x4d, 0x4c, 0xd5, 0x81, 0xbf, 0x04, 0x6b, 0x5d, 0x8c,
-	0x5d, 0x9f, 0x70, 0xcd, 0x9d, 0x6d, 0x1e, 0xaa, 0x83, 0x2f, 0xba, 0x77, 0x2d, 0x8c, 0x8f, 0x8c,
-	0x49, 0xba, 0x35, 0x67, 0xa5, 0x3b, 0xbe, 0x08, 0x19, 0xd8, 0x1e, 0xc2, 0xcf, 0x6a, 0x19, 0xc1,
-	0xcc, 0x15, 0x22, 0xb0, 0x57, 0xd4, 0x2e, 0xdf, 0x99, 0xce, 0x63, 0xfc, 0x6e, 0x13, 0xcc, 0xda,
-	0xed, 0x27, 0xce, 0x46, 0x77, 0xb2, 0x48, 0x04, 0xf0, 0x0f, 0x16, 0xd8, 0x1d, 0x22, 0xed, 0x24,
-	0x9e, 0xbc, 0x68, 0x17, 0x34, 0x48, 0x42, 0x9c, 0xba, 0xc0, 0xed, 0x55, 0x45, 0xfd, 0xfd, 0xe9,
-	0xd4, 0x07, 0xca, 0xfe, 0x13, 0x65, 0x6e, 0xb8, 0xb8, 0x53, 0xed, 0x96, 0x2b, 0xc0, 0x1f, 0x81,
-	0x2d, 0xc2, 0xdd, 0x2e, 0x61, 0x5c, 0xb8, 0xd2, 0x1d, 0xef, 0xd2, 0x0b, 0xb0, 0xdb, 0x25, 0x11,
-	0xe1, 0x7d, 0xec, 0xdb, 0x6b, 0xea, 0x76, 0xdc, 0x21, 0xbc, 0x25, 0x35, 0x5a, 0x18, 0x1f, 0x4a,
-	0x79, 0xcb, 0x88, 0xe1, 0x9f, 0x2d, 0xf0, 0x76, 0x8c, 0x75, 0x69, 0xba, 0x5a, 0xba, 0xae, 0xbf,
-	0x69, 0xba, 0xd6, 0x0d, 0x7e, 0x7b, 0x6a, 0xd6, 0xfe, 0xcd, 0x02, 0x8d, 0x02, 0x67, 0x8a, 0xb2,
-	0xf7, 0x8e, 0xf2, 0xe6, 0x83, 0xff, 0x27, 0x7b, 0x35, 0x91, 0x49, 0xe2, 0x47, 0x93, 0x9c, 0x9c,
-	0x9c, 0xcb, 0xef, 0x81, 0x0d, 0xed, 0x14, 0x77, 0x69, 0x2c, 0x5c, 0x9a, 0x08, 0x17, 0xf9, 0x3e,
-	0xc3, 0x9c, 0x63, 0x6e, 0xdb, 0x3b, 0xb3, 0xf5, 0x05, 0x67, 0xdd, 0x28, 0x3c, 0x8b, 0xc5, 0xb3,
-	0x44, 0xec, 0xa7, 0x52, 0xf8, 0x0b, 0x60, 0xf7, 0x09, 0x17, 0x94, 0x11, 0x0f, 0x05, 0xa6, 0xd1,
-	0x32, 0xec, 0x51, 0xe6, 0x73, 0x7b, 0x43, 0xed, 0x64, 0xb7, 0x64, 0x27, 0xd8, 0xd1, 0xaa, 0xce,
-	0x7a, 0x0e, 0x32, 0xb8, 0x0e, 0x3f, 0x05, 0xeb, 0x1d, 0x12, 0x21, 0x76, 0x29, 0x1d, 0x93, 0x9d,
-	0x3d, 0x1b, 0xb6, 0x36, 0x4b, 0xdb, 0xdb, 0x81, 0x32, 0x7a, 0xa6, 0x6d, 0xcc, 0xbc, 0xb5, 0xda,
-	0x19, 0x5f, 0xe4, 0xb0, 0x0f, 0xf6, 0x26, 0x32, 0xb8, 0xc4, 0xe7, 0x79, 0x5b, 0x71, 0xbb, 0x94,
-	0x0d, 0xf4, 0x1b, 0x7b, 0x4b, 0x1d, 0xca, 0x77, 0x26, 0x20, 0x9e, 0xf8, 0x3c, 0x6b, 0x12, 0x2d,
-	0xca, 0xf2, 0xd6, 0x01, 0xdb, 0xa0, 0x3e, 0x30, 0x7a, 0x8e, 0xe0, 0x0b, 0x2a, 0x29, 0x3c, 0xec,
-	0x7a, 0x01, 0xe5, 0xd8, 0xbe, 0xab, 0xf0, 0x6b, 0xf9, 0xcc, 0x39, 0x08, 0xdb, 0xa6, 0x2d, 0xa9,
-	0x7a, 0x28, 0x35, 0xe1, 0xef, 0x2d, 0x50, 0x47, 0x89, 0x27, 0x3d, 0xc8, 0x1b, 0x89, 0x60, 0x28,
-	0xe2, 0x5d, 0xcc, 0x5c, 0x1f, 0x47, 0x34, 0x74, 0x7d, 0xec, 0x91, 0x10, 0x05, 0xdc, 0xde, 0x2e,
-	0x9d, 0x26, 0x8f, 0xa4, 0xf2, 0x91, 0xd1, 0x35, 0xbd, 0xf0, 0xbe, 0xc1, 0x4e, 0xdb, 0x4f, 0xdb,
-	0x20, 0x0f, 0xe9, 0xca, 0x41, 0xe8, 0x9e, 0x47, 0x23, 0x5f, 0x4d, 0x5f, 0x28, 0x70, 0x27, 0x4d,
-	0x9c, 0xdc, 0xae, 0x94, 0xb6, 0xe6, 0xc3, 0xdc, 0x7e, 0xc2, 0xf4, 0xe9, 0x54, 0xbd, 0x42, 0xb9,
-	0x42, 0x97, 0xa9, 0x92, 0x0e, 0x26, 0x18, 0xbb, 0x61, 0x12, 0x08, 0x12, 0x07, 0x04, 0x33, 0x6e,
-	0x57, 0x4b, 0x53, 0xc5, 0x8c, 0x1b, 0x18, 0x3f, 0xcd, 0x4c, 0x9c, 0xd5, 0x70, 0x7c, 0x91, 0xc3,
-	0x9f, 0x81, 0x95, 0x6c, 0x37, 0x2e, 0xc7, 0x9f, 0x25, 0x58, 0x0d, 0x94, 0x3b, 0x0a, 0xbe, 0x5e,
-	0x00, 0x9f, 0x79, 0x78, 0x66, 0x0c, 0x1c, 0x48, 0x47, 0x97, 0x38, 0xc4, 0x00, 0x0e, 0xcc, 0xab,
-	0xba, 0xde, 0x72, 0xfb, 0x5e, 0x69, 0x9d, 0xdd, 0xef, 0xf5, 0x18, 0xee, 0x21, 0x81, 0xf3, 0x99,
-	0x55, 0x17, 0x52, 0x7d, 0x79, 0x9c, 0xdb, 0x7c, 0x64, 0x9d, 0xc3, 0x9f, 0x80, 0x9b, 0xe6, 0x8c,
-	0x52, 0x8a, 0x5a, 0xe9, 0x1d, 0xd5, 0x67, 0x63, 0x50, 0x97, 0xc3, 0x81, 0x2f, 0x0e, 0x11, 0x58,
-	0xed, 0x31, 0x24, 0x3b, 0x53, 0x22, 0xfa, 0x94, 0x91, 0xdf, 0x20, 0x3d, 0xbc, 0xef, 0x2a, 0xc4,
-	0x46, 0x51, 0x73, 0x48, 0x82, 0xe0, 0x43, 0x69, 0xb6, 0x3f, 0x64, 0xe5, 0xac, 0xf4, 0xc6, 0x17,
-	0xe1, 0x63, 0xb0, 0x8c, 0x14, 0x84, 0xab, 0xa4, 0xdc, 0xbe, 0x5f, 0x3a, 0xbb, 0x4b, 0xec, 0x7d,
-	0xb5, 0xac, 0x18, 0x9c, 0x25, 0x94, 0x7f, 0x70, 0xf8, 0x53, 0xb0, 0xa2, 0x6f, 0x43, 0x48, 0x22,
-	0x37, 0xa2, 0x3a, 0x93, 0xb8, 0xfd, 0x60, 0xca, 0xa3, 0x2d, 0xa2, 0xe1, 0x53, 0x12, 0x7d, 0x64,
-	0xf4, 0xe5, 0xa3, 0x6d, 0x78, 0x85, 0xd7, 0x9e, 0x80, 0xdb, 0x63, 0x41, 0x86, 0x9b, 0x60, 0x3e,
-	0xcd, 0x10, 0xf5, 0x4c, 0xbd, 0xee, 0x64, 0xdf, 0x70, 0x0b, 0x2c, 0x64, 0x35, 0xc0, 0xbe, 0xb6,
-	0x63, 0xd5, 0x17, 0x9c, 0xf9, 0xd0, 0xdc, 0xf2, 0xda, 0x0b, 0xb0, 0x51, 0xd8, 0xbb, 0xa1, 0x0d,
-	0x6e, 0x98, 0x88, 0x2a, 0xd0, 0x05, 0x27, 0xfd, 0x84, 0x47, 0x60, 0x3e, 0x9b, 0x0c, 0xae, 0xa9,
-	0x09, 0xe4, 0xd1, 0xf4, 0xf6, 0x9c, 0x8e, 0x04, 0x37, 0x84, 0x1e, 0x00, 0x6a, 0x7f, 0xb7, 0x40,
-	0x75, 0x4a, 0xfb, 0x86, 0xdf, 0x05, 0xeb, 0x66, 0x2c, 0xe0, 0x02, 0x31, 0x39, 0x90, 0x84, 0x98,
-	0x0b, 0x14, 0xc6, 0xca, 0xa5, 0x59, 0x67, 0x55, 0x4b, 0xcf, 0xa4, 0xb0, 0x9d, 0xca, 0xe0, 0x63,
-	0x70, 0x73, 0x38, 0xbb, 0xcd, 0xcb, 0xbb, 0xa8, 0x16, 0xed, 0x0f, 0x25, 0xf4, 0xf2, 0x50, 0x1e,
-	0xd7, 0xba, 0x60, 0x79, 0x48, 0x5e, 0x72, 0x2e, 0xef, 0x83, 0xb9, 0x8c, 0xcf, 0xaa, 0x2f, 0x1c,
-	0xec, 0xca, 0xaa, 0xf6, 0xef, 0x97, 0xd5, 0x2d, 0x8f, 0xf2, 0x90, 0x72, 0xee, 0x9f, 0x37, 0x08,
-	0x6d, 0x86, 0x48, 0xf4, 0x1b, 0x4f, 0x70, 0x0f, 0x79, 0x97, 0x47, 0xd8, 0x73, 0x8c, 0x49, 0xed,
-	0x05, 0xa8, 0x5d, 0xa1, 0x7b, 0x96, 0x92, 0x9b, 0xa6, 0xfe, 0x26, 0xe4, 0xda, 0xa4, 0xf6, 0x4f,
-	0x0b, 0x3c, 0xba, 0x72, 0xb7, 0x87, 0x3f, 0x06, 0x5b, 0x83, 0x43, 0xce, 0xe4, 0xd0, 0xd8, 0x2c,
-	0x9b, 0x54, 0x46, 0xc2, 0xf3, 0x69, 0x1e, 0x9e, 0xcc, 0xe3, 0xaf, 0x39, 0x44, 0xa7, 0x31, 0xd3,
-	0x9f, 0xb5, 0x7f, 0x58, 0xe0, 0xd6, 0xc8, 0xe3, 0x1a, 0xee, 0x82, 0xe5, 0x81, 0xaa, 0x47, 0x7c,
-	0x73, 0x7e, 0x4b, 0xf9, 0xe2, 0x89, 0x0f, 0x7b, 0x60, 0x7d, 0xf2, 0x53, 0xde, 0xe4, 0xf9, 0xb7,
-	0xa7, 0xbe, 0xe4, 0xf3, 0x27, 0xbb, 0x69, 0x6a, 0xab, 0x93, 0x9e, 0xf3, 0x3f, 0x9c, 0xff, 0xd3,
-	0x17, 0xd5, 0x99, 0xff, 0x7e, 0x51, 0x9d, 0xa9, 0xfd, 0xf1, 0x1a, 0xb8, 0x53, 0x50, 0xa8, 0x64,
-	0xb4, 0x55, 0x31, 0xc2, 0x2c, 0x8d, 0xb6, 0xf9, 0x84, 0x8f, 0x01, 0x14, 0x54, 0xa0, 0xc0, 0x35,
-	0x65, 0x31, 0x54, 0x29, 0xa1, 0x23, 0xbf, 0x6d, 0x22, 0xbf, 0x36, 0x1e, 0xf9, 0x93, 0x48, 0x38,
-	0x6f, 0x29, 0x43, 0x4d, 0xa7, 0xcc, 0xe0, 0x3e, 0xd8, 0x0e, 0x10, 0x17, 0xae, 0x8f, 0x03, 0x59,
-	0xe3, 0xd5, 0x60, 0xe2, 0xf5, 0xb1, 0x77, 0x2e, 0x67, 0x05, 0x12, 0x62, 0x7b, 0x56, 0x45, 0x74,
-	0x53, 0x2a, 0x1d, 0xe5, 0x3a, 0x87, 0x5a, 0x45, 0x06, 0x16, 0xee, 0x83, 0x39, 0x53, 0x36, 0xaf,
-	0x97, 0x0e, 0xb8, 0xe3, 0xbb, 0x74, 0x8c, 0x61, 0x8d, 0x81, 0x5b, 0x23, 0x45, 0x35, 0xdf, 0x3f,
-	0x1e, 0xde, 0x3f, 0x86, 0xc7, 0x60, 0x69, 0xb0, 0x5a, 0x9b, 0xf0, 0xd4, 0x0a, 0x2f, 0x78, 0x5e,
-	0xa8, 0x17, 0x07, 0x0a, 0xf5, 0xc1, 0xf9, 0x97, 0xaf, 0x2a, 0xd6, 0x57, 0xaf, 0x2a, 0xd6, 0x7f,
-	0x5e, 0x55, 0xac, 0xcf, 0x5f, 0x57, 0x66, 0xbe, 0x7a, 0x5d, 0x99, 0xf9, 0xd7, 0xeb, 0xca, 0xcc,
-	0xcf, 0x3f, 0xee, 0x11, 0xd1, 0x4f, 0x3a, 0x0d, 0x8f, 0x86, 0xcd, 0x93, 0x14, 0xf4, 0x09, 0xea,
-	0xf0, 0x66, 0x46, 0xf1, 0xb6, 0x47, 0x19, 0x1e, 0xfc, 0xec, 0x23, 0x12, 0x35, 0x43, 0x2a, 0xe7,
-	0x26, 0x9e, 0xff, 0xbf, 0x28, 0x2e, 0x63, 0xcc, 0x9b, 0x17, 0x7b, 0x9d, 0x39, 0xf5, 0x1f, 0xe3,
-	0xbb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x10, 0x54, 0x69, 0xce, 0x48, 0x15, 0x00, 0x00,
+	0x15, 0x76, 0xc7, 0xc1, 0xb1, 0xcb, 0x76, 0xb2, 0x2e, 0x5f, 0xd2, 0xb6, 0xe3, 0xf1, 0x64, 0x9c,
+	0x84, 0x09, 0xb0, 0x33, 0xc8, 0xcb, 0x45, 0xcb, 0x82, 0xb4, 0xb6, 0xc7, 0x8e, 0x4c, 0xe2, 0x8d,
+	0xb7, 0x3d, 0x5a, 0x2e, 0x12, 0xf4, 0xd6, 0x74, 0x9f, 0x99, 0x29, 0xdc, 0xdd, 0xd5, 0xdb, 0x55,
+	0xe3, 0x8d, 0x89, 0x78, 0x00, 0x21, 0x84, 0x90, 0x90, 0xf6, 0x27, 0xac, 0x04, 0x2f, 0xfc, 0x93,
+	0x7d, 0xe0, 0x61, 0x1f, 0x11, 0x0f, 0x2b, 0x94, 0xbc, 0xf0, 0x13, 0x78, 0x44, 0x55, 0x5d, 0x7d,
+	0x99, 0x4b, 0xb7, 0x1d, 0x78, 0x9b, 0xaa, 0x3a, 0xdf, 0xf7, 0x9d, 0xaa, 0x73, 0xaa, 0xce, 0x99,
+	0x46, 0x3b, 0x34, 0xf8, 0x15, 0x38, 0x82, 0x5e, 0x40, 0x13, 0x5e, 0x38, 0x7d, 0x12, 0xf4, 0xa0,
+	0x79, 0xb1, 0xdb, 0xec, 0x41, 0x00, 0x9c, 0xf2, 0x46, 0x18, 0x31, 0xc1, 0xf0, 0x6a, 0x6a, 0xd4,
+	0x48, 0x8c, 0x1a, 0x17, 0xbb, 0x1b, 0x2b, 0x3d, 0xd6, 0x63, 0xca, 0xa2, 0x29, 0x7f, 0xc5, 0xc6,
+	0x1b, 0x0f, 0x26, 0x33, 0xa6, 0xc0, 0xd8, 0xaa, 0x36, 0xd9, 0xca, 0x27, 0xd1, 0x39, 0x08, 0x6d,
+	0xf3, 0x70, 0xb2, 0x0d, 0x8b, 0x5c, 0x88, 0x3a, 0x8c, 0x9d, 0x6b, 0xb3, 0xca, 0x64, 0x33, 0xf1,
+	0x22, 0x5e, 0xaf, 0xfd, 0x67, 0x0b, 0x2d, 0x3c, 0x89, 0xf7, 0x73, 0x26, 0x88, 0x00, 0xfc, 0x1e,
+	0x9a, 0x09, 0x49, 0x44, 0x7c, 0x6e, 0x1a, 0x55, 0xa3, 0x3e, 0xbf, 0xbb, 0xd5, 0x98, 0xb8, 0xbf,
+	0xc6, 0xa9, 0x32, 0xda, 0xbf, 0xf9, 0xc5, 0x57, 0xdb, 0x53, 0x96, 0x86, 0xe0, 0x16, 0x5a, 0xe0,
+	0x21, 0x13, 0x76, 0xec, 0x29, 0x37, 0x6f, 0x54, 0xa7, 0xeb, 0xf3, 0xbb, 0xf7, 0x0b, 0x28, 0xce,
+	0x42, 0x26, 0x4e, 0x94, 0xa5, 0x35, 0xcf, 0xd3, 0xdf, 0x1c, 0x7f, 0x84, 0xb0, 0x0b, 0x11, 0xbd,
+	0x20, 0x12, 0x91, 0x72, 0x4d, 0x2b, 0xae, 0xaf, 0x17, 0x70, 0xb5, 0x52, 0x80, 0x66, 0x5c, 0x72,
+	0x47, 0x66, 0x38, 0xfe, 0x10, 0xdd, 0x56, 0xde, 0xa5, 0x67, 0x64, 0xde, 0x54, 0x9c, 0x0f, 0x4a,
+	0xfc, 0x7b, 0x2e, 0x6d, 0xf7, 0x19, 0x3b, 0xd7, 0x3b, 0x5d, 0xe4, 0xc9, 0xa4, 0x24, 0xc0, 0x0e,
+	0x5a, 0xc9, 0xb9, 0x9a, 0x11, 0x7f, 0x4d, 0x11, 0x7f, 0xe3, 0x4a, 0x67, 0x47, 0xe9, 0x97, 0xdd,
+	0xe1, 0x25, 0x25, 0xf2, 0x3e, 0x9a, 0xed, 0x10, 0x8f, 0x04, 0x0e, 0x70, 0x73, 0x46, 0x11, 0x57,
+	0x0a, 0x88, 0xf7, 0x63, 0x33, 0x4d, 0x96, 0xa2, 0xf0, 0x09, 0x9a, 0x0b, 0x19, 0xa7, 0x82, 0xb2,
+	0x80, 0x9b, 0xb7, 0x14, 0xc5, 0xe3, 0x2b, 0x7d, 0x3b, 0xd5, 0x08, 0xcd, 0x96, 0x31, 0x60, 0x17,
+	0xdd, 0xe5, 0x83, 0x0e, 0x71, 0x1c, 0x36, 0x08, 0x84, 0x2d, 0x22, 0xe2, 0x82, 0x1d, 0x30, 0xe5,
+	0xdf, 0xac, 0x22, 0x7f, 0x54, 0x74, 0xa2, 0x29, 0xea, 0x03, 0x96, 0xf9, 0xb9, 0x9a, 0x91, 0xb5,
+	0x25, 0x97, 0x5a, 0xe3, 0xf8, 0xb7, 0x06, 0xaa, 0xc2, 0x8b, 0x90, 0x46, 0x97, 0x76, 0x77, 0x20,
+	0x06, 0x11, 0x70, 0x9d, 0x0b, 0x36, 0x0d, 0xba, 0xcc, 0xe6, 0x82, 0x08, 0x30, 0xe7, 0x94, 0xde,
+	0x3b, 0x05, 0x7a, 0x87, 0x0a, 0x7e, 0x14, 0xa3, 0xe3, 0x34, 0x38, 0x0e, 0xba, 0x4c, 0x65, 0xba,
+	0x16, 0xbf, 0x07, 0x25, 0x36, 0xd8, 0x45, 0xab, 0x21, 0x44, 0x21, 0x88, 0x01, 0xf1, 0xf2, 0xea,
+	0x26, 0x2a, 0x0d, 0xf0, 0x69, 0x82, 0xc9, 0xf8, 0x92, 0x00, 0x87, 0xe3, 0x4b, 0xf8, 0x37, 0xa8,
+	0x32, 0xa6, 0xd2, 0x1d, 0x04, 0x2e, 0x0d, 0x7a, 0x7a, 0x9b, 0xf3, 0x4a, 0x6e, 0xf7, 0x7a, 0x72,
+	0x47, 0x31, 0x34, 0xbf, 0xcb, 0xcd, 0xb0, 0xd8, 0x04, 0x7f, 0x66, 0xa0, 0x47, 0x63, 0x17, 0xce,
+	0xe6, 0x20, 0x84, 0x07, 0x3e, 0x04, 0xc2, 0xe6, 0x4e, 0x1f, 0xdc, 0x81, 0x07, 0xae, 0xb9, 0xa0,
+	0xfc, 0xf8, 0xee, 0x35, 0x2f, 0xe1, 0x59, 0x4a, 0x91, 0x3b, 0x81, 0x1d, 0xb7, 0xd0, 0xea, 0x2c,
+	0xd1, 0xc1, 0xdf, 0x47, 0x26, 0xe5, 0xb6, 0xba, 0xad, 0x89, 0x80, 0x0d, 0x01, 0xe9, 0x48, 0x1f,
+	0x16, 0xab, 0x46, 0x7d, 0xd6, 0x5a, 0xa5, 0x5c, 0xde, 0xcf, 0x43, 0xbd, 0x7a, 0x18, 0x2f, 0xe2,
+	0x43, 0xb4, 0x4d, 0xb9, 0x9d, 0x49, 0xf0, 0x71, 0xfc, 0x6d, 0x85, 0xbf, 0x47, 0x79, 0xe6, 0x2e,
+	0x1f, 0xa5, 0xf9, 0x04, 0xdd, 0x93, 0x69, 0x2d, 0x03, 0x10, 0xc1, 0xa7, 0x24, 0x72, 0x6d, 0x87,
+	0xf8, 0x21, 0xa1, 0xbd, 0x20, 0x0e, 0xff, 0x1d, 0xf5, 0x36, 0x7e, 0xbb, 0xe0, 0x1c, 0xda, 0x31,
+	0xd4, 0x52, 0xc8, 0x03, 0x0d, 0x94, 0x47, 0x60, 0xad, 0x8b, 0xa2, 0x25, 0xfc, 0x12, 0x3d, 0x1c,
+	0x91, 0x0c, 0x19, 0xf3, 0x32, 0xdd, 0x24, 0x08, 0xe6, 0x5b, 0xa5, 0xf7, 0x37, 0xe1, 0x8c, 0x15,
+	0x4e, 0x19, 0xf3, 0xac, 0xfb, 0x43, 0xa2, 0x72, 0x2a, 0x31, 0x4a, 0x0e, 0x1c, 0xff, 0xd9, 0x40,
+	0x8f, 0x8a, 0x36, 0x9c, 0xdc, 0xf3, 0x90, 0xd1, 0x40, 0x70, 0x73, 0x49, 0xc9, 0xbf, 0xfb, 0x26,
+	0x5b, 0xdf, 0x8b, 0x19, 0x4e, 0x15, 0x81, 0x55, 0x13, 0x57, 0xda, 0xe0, 0x5f, 0xa2, 0xd5, 0x2e,
+	0x80, 0xed, 0x52, 0x1e, 0x6b, 0xa7, 0x9b, 0xc7, 0x55, 0xa3, 0xe4, 0xde, 0x1d, 0x01, 0xb4, 0x34,
+	0x24, 0xd9, 0x9a, 0xb5, 0xdc, 0x1d, 0x9f, 0xc4, 0x11, 0xda, 0x1a, 0xe2, 0x4f, 0xdf, 0x32, 0x0a,
+	0x91, 0x2d, 0x84, 0x67, 0x2e, 0x57, 0xa7, 0x4b, 0x02, 0x9c, 0xd3, 0xd1, 0x7e, 0xb7, 0x29, 0x44,
+	0xed, 0xf6, 0x33, 0x6b, 0xbd, 0x3b, 0x79, 0x49, 0x78, 0xf8, 0xf7, 0x06, 0xda, 0x19, 0x12, 0xed,
+	0x0c, 0x1c, 0x79, 0xd1, 0x2e, 0x98, 0x37, 0xf0, 0x21, 0x71, 0x81, 0x9b, 0x2b, 0x4a, 0xfa, 0x7b,
+	0x57, 0x4b, 0xef, 0x2b, 0xfc, 0x47, 0x0a, 0xae, 0xb5, 0xb8, 0xb5, 0xdd, 0x2d, 0x37, 0xc0, 0x3f,
+	0x44, 0x9b, 0x94, 0xdb, 0x5d, 0x1a, 0x71, 0x61, 0x4b, 0x77, 0x9c, 0x4b, 0xc7, 0x03, 0xbb, 0x4b,
+	0x03, 0xca, 0xfb, 0xe0, 0x9a, 0xab, 0xea, 0x76, 0xdc, 0xa5, 0xfc, 0x48, 0x5a, 0x1c, 0x01, 0x1c,
+	0xc8, 0xf5, 0x23, 0xbd, 0x8c, 0xff, 0x64, 0xa0, 0xb7, 0x43, 0x88, 0x9f, 0xa6, 0xeb, 0xa5, 0xeb,
+	0xda, 0x9b, 0xa6, 0x6b, 0x5d, 0xf3, 0xb7, 0xaf, 0xcc, 0xda, 0xbf, 0x18, 0xa8, 0x51, 0xe0, 0x4c,
+	0x51, 0xf6, 0xde, 0x55, 0xde, 0xbc, 0xff, 0xbf, 0x64, 0x6f, 0x2c, 0xa4, 0x93, 0xf8, 0xf1, 0x24,
+	0x27, 0x27, 0xe7, 0xf2, 0xbb, 0x68, 0x3d, 0x76, 0x8a, 0xdb, 0x2c, 0x14, 0x36, 0x1b, 0x08, 0x9b,
+	0xb8, 0x6e, 0x04, 0x9c, 0x03, 0x37, 0xcd, 0xea, 0x74, 0x7d, 0xce, 0x5a, 0xd3, 0x06, 0xcf, 0x43,
+	0xf1, 0x7c, 0x20, 0xf6, 0x92, 0x55, 0xfc, 0x0b, 0x64, 0xf6, 0x29, 0x17, 0x2c, 0xa2, 0x0e, 0xf1,
+	0x74, 0xa1, 0x8d, 0xc0, 0x61, 0x91, 0xcb, 0xcd, 0x75, 0xb5, 0x93, 0x9d, 0x92, 0x9d, 0x80, 0x15,
+	0x9b, 0x5a, 0x6b, 0x19, 0x49, 0x7e, 0x1e, 0x7f, 0x8c, 0xd6, 0x3a, 0x34, 0x20, 0xd1, 0xa5, 0x74,
+	0x4c, 0x56, 0xf6, 0xb4, 0xd9, 0xda, 0x28, 0x2d, 0x6f, 0xfb, 0x0a, 0xf4, 0x3c, 0xc6, 0xe8, 0x7e,
+	0x6b, 0xa5, 0x33, 0x3e, 0xc9, 0x71, 0x1f, 0xed, 0x4e, 0x54, 0xb0, 0xa9, 0xcb, 0xb3, 0xb2, 0x62,
+	0x77, 0x59, 0x94, 0xab, 0x37, 0xe6, 0xa6, 0x3a, 0x94, 0x6f, 0x4d, 0x60, 0x3c, 0x76, 0x79, 0x5a,
+	0x24, 0x8e, 0x58, 0x94, 0x95, 0x0e, 0xdc, 0x46, 0xf5, 0x5c, 0xeb, 0x39, 0xc2, 0x2f, 0x98, 0x94,
+	0x70, 0xc0, 0x76, 0x3c, 0xc6, 0xc1, 0xbc, 0xa7, 0xf8, 0x6b, 0x59, 0xcf, 0x99, 0xa7, 0x6d, 0xb3,
+	0x23, 0x69, 0x7a, 0x20, 0x2d, 0xf1, 0xef, 0x0c, 0x54, 0x27, 0x03, 0x47, 0x7a, 0x90, 0x15, 0x12,
+	0x11, 0x91, 0x80, 0x77, 0x21, 0xb2, 0x5d, 0x08, 0x98, 0x6f, 0xbb, 0xe0, 0x50, 0x9f, 0x78, 0xdc,
+	0xdc, 0x2a, 0xed, 0x26, 0x5b, 0xd2, 0xb8, 0xa5, 0x6d, 0x75, 0x2d, 0x7c, 0xa0, 0xb9, 0x93, 0xf2,
+	0xd3, 0xd6, 0xcc, 0x43, 0xb6, 0xb2, 0x11, 0xba, 0xef, 0xb0, 0xc0, 0x55, 0xdd, 0x17, 0xf1, 0xec,
+	0x49, 0x1d, 0x27, 0x37, 0x2b, 0xa5, 0xa5, 0xf9, 0x20, 0xc3, 0x4f, 0xe8, 0x3e, 0xad, 0x6d, 0xa7,
+	0x70, 0x5d, 0xb1, 0xcb, 0x54, 0x49, 0x1a, 0x13, 0x00, 0xdb, 0x1f, 0x78, 0x82, 0x86, 0x1e, 0x85,
+	0x88, 0x9b, 0xdb, 0xa5, 0xa9, 0xa2, 0xdb, 0x0d, 0x80, 0x93, 0x14, 0x62, 0xad, 0xf8, 0xe3, 0x93,
+	0x1c, 0xff, 0x0c, 0x2d, 0xa7, 0xbb, 0xb1, 0x39, 0x7c, 0x32, 0x00, 0xd5, 0x50, 0x56, 0x15, 0x7d,
+	0xbd, 0x80, 0x3e, 0xf5, 0xf0, 0x4c, 0x03, 0x2c, 0xcc, 0x46, 0xa7, 0x38, 0x06, 0x84, 0x73, 0xfd,
+	0x6a, 0xfc, 0xde, 0x72, 0xf3, 0x7e, 0xe9, 0x3b, 0xbb, 0xd7, 0xeb, 0x45, 0xd0, 0x23, 0x02, 0xb2,
+	0x9e, 0x35, 0x7e, 0x48, 0xe3, 0xcb, 0x63, 0x2d, 0xf1, 0x91, 0x79, 0x8e, 0x7f, 0x8c, 0x6e, 0xeb,
+	0x33, 0x4a, 0x24, 0x6a, 0xa5, 0x77, 0x34, 0x3e, 0x1b, 0xcd, 0xba, 0xe8, 0xe7, 0x46, 0x1c, 0x13,
+	0xb4, 0xd2, 0x8b, 0x88, 0xac, 0x4c, 0x03, 0xd1, 0x67, 0x11, 0xfd, 0x35, 0x89, 0x9b, 0xf7, 0x1d,
+	0xc5, 0xd8, 0x28, 0x2a, 0x0e, 0x03, 0xcf, 0x7b, 0x22, 0x61, 0x7b, 0x43, 0x28, 0x6b, 0xb9, 0x37,
+	0x3e, 0x89, 0x9f, 0xa2, 0x45, 0xa2, 0x28, 0x6c, 0xb5, 0xca, 0xcd, 0x07, 0xa5, 0xbd, 0xbb, 0xe4,
+	0xde, 0x53, 0xd3, 0x4a, 0xc1, 0x5a, 0x20, 0xd9, 0x80, 0xe3, 0x9f, 0xa0, 0xe5, 0xf8, 0x36, 0xf8,
+	0x34, 0xb0, 0x03, 0x16, 0x67, 0x12, 0x37, 0x1f, 0x5e, 0xf1, 0xa7, 0x2d, 0x60, 0xfe, 0x09, 0x0d,
+	0x3e, 0xd0, 0xf6, 0xf2, 0x4f, 0xdb, 0xf0, 0x0c, 0xc7, 0x3f, 0x45, 0xcb, 0x2e, 0xfb, 0x34, 0x10,
+	0xd4, 0x57, 0x7e, 0x3a, 0x60, 0xfb, 0xcc, 0x05, 0xf3, 0x51, 0xd5, 0x28, 0x49, 0x8b, 0x96, 0x46,
+	0x3c, 0x91, 0x80, 0x13, 0xe6, 0x82, 0xb5, 0xe4, 0x8e, 0x4e, 0xd5, 0x9e, 0xa1, 0xa5, 0xb1, 0xf4,
+	0xc1, 0x1b, 0x68, 0x36, 0xc9, 0x3d, 0xf5, 0x07, 0xf8, 0xa6, 0x95, 0x8e, 0xf1, 0x26, 0x9a, 0x4b,
+	0x5f, 0x17, 0xf3, 0x46, 0xd5, 0xa8, 0xcf, 0x59, 0xb3, 0xbe, 0x7e, 0x3f, 0x6a, 0x2f, 0xd1, 0x7a,
+	0x61, 0x57, 0x80, 0x4d, 0x74, 0x4b, 0xe7, 0x8a, 0x22, 0x9d, 0xb3, 0x92, 0x21, 0x6e, 0xa1, 0xd9,
+	0xb4, 0xe7, 0xb8, 0x51, 0x35, 0x4a, 0x2a, 0x65, 0x8e, 0x3d, 0x69, 0x36, 0x6e, 0x89, 0xb8, 0xb5,
+	0xa8, 0xfd, 0xd5, 0x40, 0xdb, 0x57, 0x34, 0x06, 0xf8, 0x3b, 0x68, 0x4d, 0x37, 0x1c, 0x5c, 0x90,
+	0x48, 0xb6, 0x3a, 0x3e, 0x70, 0x41, 0xfc, 0x50, 0xb9, 0x34, 0x6d, 0xad, 0xc4, 0xab, 0x67, 0x72,
+	0xb1, 0x9d, 0xac, 0xe1, 0xa7, 0xe8, 0xf6, 0xf0, 0xbd, 0x31, 0x6f, 0x94, 0xbe, 0x72, 0x7b, 0x43,
+	0x57, 0x65, 0x71, 0xe8, 0x86, 0xd4, 0xba, 0x68, 0x71, 0x68, 0xbd, 0xe4, 0x5c, 0xde, 0x43, 0x33,
+	0xa9, 0x9e, 0x51, 0x9f, 0xdb, 0xdf, 0x91, 0xef, 0xe5, 0x3f, 0xbf, 0xda, 0xde, 0x74, 0x18, 0xf7,
+	0x19, 0xe7, 0xee, 0x79, 0x83, 0xb2, 0xa6, 0x4f, 0x44, 0xbf, 0xf1, 0x0c, 0x7a, 0xc4, 0xb9, 0x6c,
+	0x81, 0x63, 0x69, 0x48, 0xed, 0x25, 0xaa, 0x5d, 0xa3, 0x2e, 0x97, 0x8a, 0xeb, 0x76, 0xe1, 0x4d,
+	0xc4, 0x63, 0x48, 0xed, 0xef, 0x06, 0x7a, 0x7c, 0xed, 0x3e, 0x02, 0xff, 0x08, 0x6d, 0xe6, 0xdb,
+	0xa7, 0xc9, 0xa1, 0x31, 0xa3, 0xb4, 0x07, 0x1a, 0x09, 0xcf, 0xc7, 0x59, 0x78, 0x52, 0x8f, 0xff,
+	0xcf, 0xf6, 0x7c, 0x91, 0xe4, 0x87, 0xb5, 0xbf, 0x19, 0xe8, 0xce, 0xc8, 0xdf, 0x76, 0xbc, 0x83,
+	0x16, 0x73, 0xef, 0x29, 0x75, 0xf5, 0xf9, 0x2d, 0x64, 0x93, 0xc7, 0x2e, 0xee, 0xa1, 0xb5, 0xc9,
+	0x1f, 0x09, 0x74, 0x9e, 0x7f, 0xf3, 0xca, 0x6f, 0x04, 0xd9, 0xc7, 0x00, 0x5d, 0x2e, 0x57, 0x26,
+	0x7d, 0x28, 0xf8, 0xc1, 0xec, 0x1f, 0x3f, 0xdf, 0x9e, 0xfa, 0xf7, 0xe7, 0xdb, 0x53, 0xb5, 0x3f,
+	0xdc, 0x40, 0x77, 0x0b, 0x9e, 0x40, 0x19, 0x6d, 0xf5, 0xcc, 0x41, 0x94, 0x44, 0x5b, 0x0f, 0xf1,
+	0x53, 0x84, 0x05, 0x13, 0xc4, 0xb3, 0xf5, 0x83, 0xeb, 0xab, 0x94, 0x88, 0x23, 0xbf, 0xa5, 0x23,
+	0xbf, 0x3a, 0x1e, 0xf9, 0xe3, 0x40, 0x58, 0x6f, 0x29, 0x60, 0x2c, 0xa7, 0x60, 0x78, 0x0f, 0x6d,
+	0x79, 0x84, 0x0b, 0xdb, 0x05, 0x0f, 0x7a, 0xb1, 0xb4, 0xed, 0xf4, 0xc1, 0x39, 0x97, 0x5d, 0x08,
+	0xf5, 0xc1, 0x9c, 0x56, 0x11, 0xdd, 0x90, 0x46, 0xad, 0xcc, 0xe6, 0x20, 0x36, 0x91, 0x81, 0xc5,
+	0x7b, 0x68, 0x46, 0x3f, 0xc8, 0x37, 0x4b, 0x5b, 0xe7, 0xf1, 0x5d, 0x5a, 0x1a, 0x58, 0x8b, 0xd0,
+	0x9d, 0x91, 0xe7, 0x3a, 0xdb, 0x3f, 0x0c, 0xef, 0x1f, 0xf0, 0x21, 0x5a, 0xc8, 0xd7, 0x01, 0x1d,
+	0x9e, 0x5a, 0xe1, 0x05, 0xcf, 0x4a, 0xc0, 0x7c, 0xae, 0x04, 0xec, 0x9f, 0x7f, 0xf1, 0xaa, 0x62,
+	0x7c, 0xf9, 0xaa, 0x62, 0xfc, 0xeb, 0x55, 0xc5, 0xf8, 0xec, 0x75, 0x65, 0xea, 0xcb, 0xd7, 0x95,
+	0xa9, 0x7f, 0xbc, 0xae, 0x4c, 0xfd, 0xfc, 0xc3, 0x1e, 0x15, 0xfd, 0x41, 0xa7, 0xe1, 0x30, 0xbf,
+	0x79, 0x9c, 0x90, 0x3e, 0x23, 0x1d, 0xde, 0x4c, 0x25, 0xde, 0x76, 0x58, 0x04, 0xf9, 0x61, 0x9f,
+	0xd0, 0xa0, 0xe9, 0x33, 0xd9, 0x91, 0xf1, 0xec, 0xcb, 0xa5, 0xb8, 0x0c, 0x81, 0x37, 0x2f, 0x76,
+	0x3b, 0x33, 0xea, 0xeb, 0xe5, 0x3b, 0xff, 0x1d, 0x00, 0x6b, 0xbb, 0x60, 0x0d, 0xa2, 0x15, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -1009,6 +1022,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeGraceMode != nil {
+		{
+			size, err := m.DowntimeGraceMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.DenomMinNotionals) > 0 {
 		for iNdEx := len(m.DenomMinNotionals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2143,6 +2170,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.DowntimeGraceMode != nil {
+		l = m.DowntimeGraceMode.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -3549,6 +3580,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeGraceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DowntimeGraceMode == nil {
+				m.DowntimeGraceMode = &DowntimeGraceMode{}
+			}
+			if err := m.DowntimeGraceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
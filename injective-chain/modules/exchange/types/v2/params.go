@@ -174,6 +174,9 @@ func DefaultParams() Params {
 		PostOnlyModeBlocksAmount:                     2000,           // default 2000 blocks
 		MinPostOnlyModeDowntimeDuration:              "DURATION_10M", // default 10 minutes
 		PostOnlyModeBlocksAmountAfterDowntime:        1000,           // default 1000 blocks
+		DowntimeTradingMode:                          DowntimeTradingMode_DowntimePostOnly,
+		DowntimePauseLiquidations:                    true,
+		DowntimeFreezeFunding:                        true,
 	}
 }
 
@@ -268,6 +271,10 @@ func (p Params) Validate() error {
 		return fmt.Errorf("post_only_mode_blocks_amount_after_downtime is incorrect: %w", err)
 	}
 
+	if err := ValidateDowntimeTradingMode(p.DowntimeTradingMode); err != nil {
+		return fmt.Errorf("downtime_trading_mode is incorrect: %w", err)
+	}
+
 	return nil
 }
 
@@ -325,6 +332,19 @@ func ValidatePostOnlyModeBlocksAmountAfterDowntime(blocksAmount any) error {
 	return nil
 }
 
+func ValidateDowntimeTradingMode(tradingMode any) error {
+	v, ok := tradingMode.(DowntimeTradingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", tradingMode)
+	}
+
+	if _, exists := DowntimeTradingMode_name[int32(v)]; !exists {
+		return fmt.Errorf("invalid DowntimeTradingMode value: %v", v)
+	}
+
+	return nil
+}
+
 func ValidateOpenNotionalCap(i any) error {
 	v, ok := i.(OpenNotionalCap)
 	if !ok {
@@ -768,7 +768,8 @@ func (p *OracleParams) ValidateBasic() error {
 	case oracletypes.OracleType_Band, oracletypes.OracleType_PriceFeed, oracletypes.OracleType_Coinbase,
 		oracletypes.OracleType_Chainlink, oracletypes.OracleType_Razor, oracletypes.OracleType_Dia,
 		oracletypes.OracleType_API3, oracletypes.OracleType_Uma, oracletypes.OracleType_Pyth,
-		oracletypes.OracleType_BandIBC, oracletypes.OracleType_Provider, oracletypes.OracleType_Stork:
+		oracletypes.OracleType_BandIBC, oracletypes.OracleType_Provider, oracletypes.OracleType_Stork,
+		oracletypes.OracleType_Composite, oracletypes.OracleType_ChainlinkDataStreams:
 
 	default:
 		return errors.Wrap(types.ErrInvalidOracleType, p.OracleType.String())
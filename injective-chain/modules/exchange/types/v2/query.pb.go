@@ -7608,6 +7608,97 @@ func (m *QueryOpenInterestResponse) GetAmount() *OpenInterest {
 	return nil
 }
 
+type QueryDowntimeGraceModeRequest struct {
+}
+
+func (m *QueryDowntimeGraceModeRequest) Reset()         { *m = QueryDowntimeGraceModeRequest{} }
+func (m *QueryDowntimeGraceModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeGraceModeRequest) ProtoMessage()    {}
+func (*QueryDowntimeGraceModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108a0f108cdd0cc4, []int{149}
+}
+func (m *QueryDowntimeGraceModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeGraceModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeGraceModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeGraceModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeGraceModeRequest.Merge(m, src)
+}
+func (m *QueryDowntimeGraceModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeGraceModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeGraceModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeGraceModeRequest proto.InternalMessageInfo
+
+type QueryDowntimeGraceModeResponse struct {
+	// grace_mode is the active grace mode, nil if the exchange is not in grace
+	// mode
+	GraceMode *DowntimeGraceMode `protobuf:"bytes,1,opt,name=grace_mode,json=graceMode,proto3" json:"grace_mode,omitempty"`
+	// blocks_remaining is the number of blocks until the grace mode ends
+	BlocksRemaining int64 `protobuf:"varint,2,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+}
+
+func (m *QueryDowntimeGraceModeResponse) Reset()         { *m = QueryDowntimeGraceModeResponse{} }
+func (m *QueryDowntimeGraceModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeGraceModeResponse) ProtoMessage()    {}
+func (*QueryDowntimeGraceModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_108a0f108cdd0cc4, []int{150}
+}
+func (m *QueryDowntimeGraceModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeGraceModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeGraceModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeGraceModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeGraceModeResponse.Merge(m, src)
+}
+func (m *QueryDowntimeGraceModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeGraceModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeGraceModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeGraceModeResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeGraceModeResponse) GetGraceMode() *DowntimeGraceMode {
+	if m != nil {
+		return m.GraceMode
+	}
+	return nil
+}
+
+func (m *QueryDowntimeGraceModeResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("injective.exchange.v2.OrderSide", OrderSide_name, OrderSide_value)
 	proto.RegisterEnum("injective.exchange.v2.CancellationStrategy", CancellationStrategy_name, CancellationStrategy_value)
@@ -7761,412 +7852,416 @@ func init() {
 	proto.RegisterType((*OpenInterest)(nil), "injective.exchange.v2.OpenInterest")
 	proto.RegisterType((*QueryOpenInterestRequest)(nil), "injective.exchange.v2.QueryOpenInterestRequest")
 	proto.RegisterType((*QueryOpenInterestResponse)(nil), "injective.exchange.v2.QueryOpenInterestResponse")
+	proto.RegisterType((*QueryDowntimeGraceModeRequest)(nil), "injective.exchange.v2.QueryDowntimeGraceModeRequest")
+	proto.RegisterType((*QueryDowntimeGraceModeResponse)(nil), "injective.exchange.v2.QueryDowntimeGraceModeResponse")
 }
 
 func init() { proto.RegisterFile("injective/exchange/v2/query.proto", fileDescriptor_108a0f108cdd0cc4) }
 
 var fileDescriptor_108a0f108cdd0cc4 = []byte{
-	// 6400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x6c, 0x1c, 0xc9,
-	0x71, 0xb0, 0x66, 0x49, 0x51, 0x64, 0x51, 0xe2, 0xa3, 0x29, 0x51, 0xd4, 0xde, 0x9d, 0x1e, 0xa3,
-	0xd3, 0x49, 0xba, 0x3b, 0x71, 0x25, 0x4a, 0x3a, 0xbd, 0x75, 0x47, 0x8a, 0xa2, 0xa4, 0xb3, 0x1e,
-	0xbc, 0x15, 0xef, 0xce, 0x9f, 0xbf, 0x8b, 0xd7, 0xc3, 0xdd, 0xe6, 0x72, 0xac, 0xdd, 0x9d, 0xd5,
-	0xce, 0x2c, 0x4f, 0x8c, 0xa0, 0x1f, 0x71, 0x12, 0xc3, 0xb0, 0x81, 0x3c, 0x7f, 0xf8, 0x87, 0x01,
-	0x07, 0x88, 0x83, 0x04, 0x79, 0x20, 0x01, 0x9c, 0x0b, 0x1c, 0xc4, 0x0e, 0xe2, 0x3c, 0xec, 0x18,
-	0x08, 0x90, 0xd8, 0xb8, 0x24, 0x76, 0x02, 0xe4, 0xe2, 0xf8, 0x02, 0x04, 0x31, 0x90, 0x38, 0xff,
-	0x03, 0x24, 0xc1, 0x74, 0x57, 0xf7, 0xce, 0xab, 0x7b, 0x66, 0x48, 0x1d, 0x64, 0x27, 0xbf, 0xc4,
-	0xed, 0xe9, 0xaa, 0xae, 0xaa, 0xae, 0xae, 0xaa, 0x7e, 0x54, 0x09, 0x0e, 0xd8, 0xad, 0x8f, 0xd3,
-	0xaa, 0x67, 0xaf, 0xd1, 0x12, 0x7d, 0x50, 0x5d, 0xb5, 0x5a, 0x75, 0x5a, 0x5a, 0x9b, 0x29, 0xdd,
-	0xef, 0xd2, 0xce, 0xfa, 0x74, 0xbb, 0xe3, 0x78, 0x0e, 0xd9, 0x25, 0xbb, 0x4c, 0x8b, 0x2e, 0xd3,
-	0x6b, 0x33, 0xc5, 0x9d, 0x75, 0xa7, 0xee, 0xb0, 0x1e, 0x25, 0xff, 0x2f, 0xde, 0xb9, 0xf8, 0x74,
-	0xdd, 0x71, 0xea, 0x0d, 0x5a, 0xb2, 0xda, 0x76, 0xc9, 0x6a, 0xb5, 0x1c, 0xcf, 0xf2, 0x6c, 0xa7,
-	0xe5, 0xe2, 0xd7, 0x67, 0x93, 0x47, 0x93, 0x68, 0x79, 0xaf, 0x43, 0xc9, 0xbd, 0x9c, 0x4e, 0x8d,
-	0x76, 0x96, 0x1d, 0xe7, 0x1e, 0x76, 0x33, 0x93, 0xbb, 0x35, 0xad, 0xce, 0x3d, 0xea, 0x61, 0x9f,
-	0x83, 0xc9, 0x7d, 0xea, 0xb4, 0x45, 0x5d, 0xdb, 0x8d, 0x8f, 0xe7, 0x74, 0xac, 0x6a, 0x83, 0x96,
-	0xd6, 0x4e, 0x2c, 0x53, 0xcf, 0x3a, 0x81, 0x3f, 0x79, 0x37, 0xf3, 0x0e, 0xc0, 0xdd, 0xee, 0xb2,
-	0x55, 0xad, 0x3a, 0xdd, 0x96, 0x47, 0x26, 0x61, 0xc0, 0xeb, 0x58, 0x35, 0xda, 0x99, 0x32, 0xf6,
-	0x1b, 0x47, 0x86, 0xca, 0xf8, 0x8b, 0x1c, 0x85, 0x31, 0x57, 0xf6, 0xaa, 0xb4, 0x9c, 0x56, 0x95,
-	0x4e, 0x15, 0xf6, 0x1b, 0x47, 0x76, 0x94, 0x47, 0x7b, 0xed, 0xb7, 0xfd, 0x66, 0xf3, 0x63, 0xf0,
-	0xf4, 0x6b, 0xbe, 0x9c, 0x7b, 0x58, 0xef, 0xf8, 0x2c, 0xba, 0x65, 0x7a, 0xbf, 0x4b, 0x5d, 0x8f,
-	0x1c, 0x84, 0x1d, 0x01, 0x54, 0x76, 0x0d, 0x47, 0xda, 0xde, 0x6b, 0xbc, 0x51, 0x23, 0x4f, 0xc1,
-	0x10, 0xe7, 0xd8, 0xef, 0x50, 0x60, 0x1d, 0x06, 0x79, 0xc3, 0x8d, 0x9a, 0xf9, 0x25, 0x03, 0x9e,
-	0x51, 0x0c, 0xe1, 0xb6, 0x9d, 0x96, 0x4b, 0xc9, 0x0d, 0x80, 0xe5, 0xee, 0x7a, 0x85, 0xc9, 0xd6,
-	0x9d, 0x32, 0xf6, 0xf7, 0x1d, 0x19, 0x9e, 0x79, 0x7e, 0x3a, 0x71, 0xc6, 0xa7, 0x23, 0x48, 0xe6,
-	0x2d, 0xcf, 0x2a, 0x0f, 0x2d, 0x77, 0xd7, 0x39, 0x4a, 0xf2, 0x21, 0x18, 0x76, 0x69, 0xa3, 0x21,
-	0x70, 0x15, 0x72, 0xe3, 0x02, 0x1f, 0x9c, 0x23, 0x33, 0x7f, 0xc3, 0x80, 0x43, 0x91, 0x3e, 0xfe,
-	0xd4, 0xdf, 0xa2, 0x9e, 0x55, 0xb3, 0x3c, 0xeb, 0x4d, 0xdb, 0x5b, 0xbd, 0xc5, 0xb8, 0x24, 0xb7,
-	0x61, 0xb0, 0x89, 0xad, 0x4c, 0x40, 0xc3, 0x33, 0x33, 0xd9, 0xc6, 0x0c, 0xe2, 0x2b, 0x4b, 0x1c,
-	0x5a, 0x81, 0x92, 0x9d, 0xb0, 0xd5, 0x76, 0xe7, 0xba, 0xeb, 0x53, 0x7d, 0xfb, 0x8d, 0x23, 0x83,
-	0x65, 0xfe, 0xc3, 0x7c, 0x1a, 0x8a, 0x4c, 0xca, 0x57, 0x71, 0xb0, 0x45, 0xab, 0x63, 0x35, 0xc5,
-	0x34, 0x9a, 0x1f, 0x81, 0xa7, 0x12, 0xbf, 0xe2, 0x0c, 0x5c, 0x80, 0x81, 0x36, 0x6b, 0x41, 0xea,
-	0x9f, 0x51, 0x50, 0xcf, 0xc1, 0xe6, 0xfa, 0xbf, 0xf1, 0xde, 0xbe, 0x2d, 0x65, 0x04, 0x31, 0x7f,
-	0xc6, 0x80, 0xbd, 0x91, 0x09, 0x9e, 0xa7, 0x6d, 0xc7, 0xb5, 0xbd, 0x7c, 0x5a, 0x74, 0x0d, 0xa0,
-	0xf7, 0x9b, 0x71, 0x3d, 0x3c, 0x73, 0x20, 0x55, 0x8c, 0x8c, 0x18, 0xa3, 0x1c, 0x00, 0x35, 0xbf,
-	0x6b, 0xc0, 0x3e, 0x25, 0x41, 0xc8, 0xf1, 0xc7, 0x60, 0xb0, 0x86, 0x6d, 0xa8, 0x71, 0xf3, 0x8a,
-	0xa1, 0x52, 0x30, 0x4d, 0x8b, 0x86, 0xab, 0x2d, 0xaf, 0xb3, 0x5e, 0x96, 0x58, 0x8b, 0xff, 0x1f,
-	0x76, 0x84, 0x3e, 0x91, 0x31, 0xe8, 0xbb, 0x47, 0xd7, 0x91, 0x75, 0xff, 0x4f, 0x72, 0x0a, 0xb6,
-	0xae, 0x59, 0x8d, 0x2e, 0x45, 0x66, 0xf7, 0x2a, 0x28, 0x40, 0x34, 0x65, 0xde, 0xf9, 0x7c, 0xe1,
-	0xac, 0x61, 0xee, 0xc5, 0x65, 0x2b, 0xe6, 0x73, 0xce, 0x6a, 0x58, 0xad, 0x2a, 0x95, 0xf3, 0x6d,
-	0xe1, 0x9a, 0x8b, 0x7f, 0x47, 0xfe, 0x5f, 0x81, 0xc1, 0x65, 0x6c, 0x43, 0xfe, 0x55, 0xa3, 0x23,
-	0x28, 0x4e, 0xba, 0x84, 0x32, 0xcf, 0xa0, 0x4a, 0xcd, 0xd6, 0xeb, 0x1d, 0x5a, 0xb7, 0x3c, 0xfa,
-	0x86, 0xd3, 0xe8, 0x36, 0xa9, 0x98, 0xf2, 0x29, 0xd8, 0x26, 0xa6, 0x92, 0x73, 0x2c, 0x7e, 0x9a,
-	0x6d, 0xa4, 0x3d, 0x06, 0x88, 0xa4, 0x2d, 0xc2, 0xb8, 0x25, 0x3e, 0x55, 0xd6, 0xd8, 0x37, 0x41,
-	0xe3, 0x41, 0x05, 0x8d, 0x7c, 0x19, 0x22, 0x9e, 0x31, 0x2b, 0x8c, 0xd8, 0x35, 0xff, 0x5f, 0xf2,
-	0x88, 0x52, 0x3d, 0x8b, 0x30, 0x88, 0xc4, 0xf1, 0x81, 0x86, 0xca, 0xf2, 0x37, 0x79, 0x06, 0x40,
-	0x2e, 0x45, 0x6e, 0x50, 0x86, 0xca, 0x43, 0x62, 0x2d, 0xba, 0xe6, 0x0f, 0x84, 0x75, 0x8b, 0xe3,
-	0x46, 0x76, 0x1c, 0xd8, 0xd3, 0x63, 0x47, 0x2c, 0x81, 0x30, 0x5b, 0x27, 0x15, 0x6c, 0x49, 0x9c,
-	0xb3, 0x1c, 0x4c, 0x08, 0xaa, 0xea, 0x74, 0x6a, 0xe5, 0xdd, 0x56, 0xe2, 0x57, 0x97, 0xfc, 0x18,
-	0x4c, 0xf5, 0x06, 0x44, 0xda, 0xc5, 0x78, 0x85, 0xec, 0x62, 0x9c, 0x94, 0x48, 0x82, 0xcd, 0xae,
-	0xf9, 0x0a, 0x1c, 0x08, 0x33, 0x1c, 0x82, 0x42, 0x89, 0x86, 0x0c, 0x98, 0x11, 0xf1, 0x08, 0x75,
-	0x30, 0x75, 0x18, 0x50, 0x6e, 0xb3, 0x30, 0xc0, 0xa9, 0x46, 0x9b, 0xa4, 0x22, 0x3a, 0x28, 0x14,
-	0x61, 0x99, 0x38, 0xa0, 0x79, 0x0d, 0x4a, 0x7c, 0xa0, 0x6e, 0xd5, 0x8f, 0x00, 0xc4, 0x62, 0x58,
-	0xea, 0x58, 0x2d, 0x77, 0x85, 0x76, 0xe6, 0x69, 0xcb, 0x69, 0xce, 0xd3, 0xaa, 0xdd, 0xb4, 0x1a,
-	0x82, 0xf0, 0x9d, 0xb0, 0xb5, 0xe6, 0x37, 0x23, 0xd1, 0xfc, 0x87, 0x79, 0x13, 0x8e, 0x67, 0x47,
-	0x84, 0xf4, 0x4f, 0xc1, 0xb6, 0x1a, 0x6f, 0x62, 0xb8, 0xfa, 0xcb, 0xe2, 0xa7, 0xf9, 0x6a, 0x76,
-	0x6c, 0x52, 0x45, 0x27, 0x61, 0x80, 0x91, 0x22, 0x14, 0x14, 0x7f, 0x99, 0x9f, 0x34, 0xe0, 0x44,
-	0x0e, 0x64, 0x48, 0xdb, 0x6b, 0x30, 0xc2, 0xe0, 0x2b, 0x48, 0x92, 0x50, 0xc4, 0x67, 0x95, 0x16,
-	0x28, 0x80, 0x05, 0x85, 0xbc, 0xa3, 0x16, 0x6c, 0x34, 0xaf, 0xe8, 0x26, 0x55, 0xb2, 0x11, 0x5e,
-	0x4d, 0x46, 0x74, 0x35, 0xd5, 0xe0, 0xa0, 0x16, 0x09, 0x92, 0x7f, 0x09, 0xb6, 0x6d, 0xc0, 0x2e,
-	0x08, 0x18, 0xf3, 0x23, 0xb1, 0x80, 0x44, 0x58, 0xd8, 0x3c, 0xee, 0x4a, 0x6a, 0x4a, 0x21, 0xa8,
-	0x29, 0x6f, 0xa9, 0x7c, 0xa1, 0x24, 0xfe, 0x7c, 0xc8, 0xf3, 0x64, 0xb1, 0xfb, 0xb2, 0xbf, 0xb9,
-	0x08, 0xbb, 0x39, 0xf6, 0xb6, 0xe3, 0x71, 0xde, 0x82, 0x0a, 0xe2, 0x7a, 0x96, 0xd7, 0x75, 0x45,
-	0x2c, 0xc8, 0x7f, 0xa5, 0xd9, 0xaf, 0x37, 0x61, 0x2a, 0x8e, 0x51, 0x46, 0x05, 0xdb, 0x78, 0x47,
-	0x21, 0x66, 0xa5, 0x37, 0x96, 0xc0, 0x65, 0x01, 0x61, 0x9e, 0x86, 0xc9, 0x08, 0xe2, 0x4c, 0xb6,
-	0x61, 0x29, 0xc6, 0xa1, 0x24, 0xe7, 0x1c, 0x0c, 0xf0, 0x6e, 0x28, 0xb6, 0x0c, 0xd4, 0x20, 0x80,
-	0xf9, 0xad, 0x02, 0xec, 0x91, 0x68, 0x65, 0xe0, 0x95, 0x85, 0x20, 0x7f, 0x9a, 0x1b, 0x76, 0xd3,
-	0xe6, 0x01, 0x49, 0x7f, 0x99, 0xff, 0x20, 0x2f, 0x03, 0xb0, 0x10, 0xb3, 0xe2, 0xda, 0x35, 0xca,
-	0x02, 0xb1, 0x91, 0x99, 0xfd, 0x0a, 0x7a, 0xd8, 0x78, 0x77, 0xed, 0x1a, 0x2d, 0x0f, 0x39, 0xe2,
-	0x4f, 0x52, 0x81, 0x3d, 0x0c, 0x53, 0xa5, 0xda, 0x6d, 0x76, 0x1b, 0x96, 0x0f, 0x54, 0x69, 0x39,
-	0xfe, 0x0a, 0xb6, 0x1a, 0x53, 0xfd, 0x3e, 0x0d, 0x73, 0x07, 0xfd, 0xc0, 0xe6, 0xef, 0xdf, 0xdb,
-	0xf7, 0x54, 0xd5, 0x71, 0x9b, 0x8e, 0xeb, 0xd6, 0xee, 0x4d, 0xdb, 0x4e, 0xa9, 0x69, 0x79, 0xab,
-	0xd3, 0x37, 0x69, 0xdd, 0xaa, 0xae, 0xcf, 0xd3, 0x6a, 0x79, 0x37, 0xc3, 0x72, 0x45, 0x22, 0xb9,
-	0x8d, 0x38, 0x12, 0x07, 0xb8, 0xdf, 0xb5, 0x5a, 0x9e, 0xed, 0xad, 0x4f, 0x6d, 0xdd, 0xf8, 0x00,
-	0xaf, 0x21, 0x0e, 0xf3, 0xab, 0x06, 0x46, 0x9c, 0x11, 0x99, 0xe2, 0x6c, 0x2d, 0xc0, 0xd8, 0x72,
-	0x77, 0xdd, 0xad, 0xb4, 0x3b, 0x76, 0x95, 0x56, 0x1a, 0x74, 0x8d, 0x36, 0x50, 0x8b, 0x9e, 0x56,
-	0xc8, 0xe9, 0xa6, 0xdf, 0xa7, 0x3c, 0xe2, 0x43, 0x2d, 0xfa, 0x40, 0xec, 0x37, 0xb9, 0x0e, 0xe3,
-	0x7e, 0x48, 0x1e, 0x46, 0x54, 0xc8, 0x80, 0x68, 0x94, 0x81, 0x05, 0x30, 0x8d, 0x41, 0x9f, 0x4b,
-	0xef, 0xb3, 0xc9, 0xea, 0x2f, 0xfb, 0x7f, 0x9a, 0x5f, 0x30, 0x60, 0x64, 0xa1, 0xdb, 0x68, 0xf4,
-	0x34, 0x66, 0x13, 0x4a, 0x46, 0xde, 0x80, 0xf1, 0xa6, 0x5d, 0x43, 0x3a, 0xad, 0x56, 0xad, 0xe2,
-	0x39, 0xcb, 0x18, 0xd9, 0x1d, 0x52, 0xd9, 0x27, 0xbb, 0xc6, 0x08, 0x9c, 0x6d, 0xd5, 0x96, 0xee,
-	0xcc, 0x61, 0x28, 0x3b, 0xd2, 0x0c, 0xb4, 0x3a, 0xcb, 0xe6, 0xa7, 0x0c, 0x8c, 0xb4, 0xc2, 0xa4,
-	0x6e, 0x72, 0xe5, 0x93, 0x19, 0x98, 0x7c, 0xdb, 0xf6, 0x56, 0x2b, 0x71, 0x9a, 0xf9, 0xbe, 0x82,
-	0xf8, 0x5f, 0x6f, 0x85, 0x49, 0xa9, 0x60, 0x20, 0x15, 0xa3, 0x04, 0x27, 0xfd, 0xe5, 0xa8, 0xc5,
-	0x50, 0x31, 0x1e, 0x46, 0xd0, 0xb3, 0x1a, 0x4d, 0xd4, 0xa9, 0xc8, 0xf7, 0x2c, 0x0b, 0x55, 0xcd,
-	0x4f, 0x41, 0xc9, 0xcf, 0x5b, 0x89, 0x92, 0x0d, 0xf8, 0x99, 0xb0, 0x32, 0x64, 0xe4, 0x46, 0x58,
-	0x9d, 0x9f, 0x96, 0x1b, 0x23, 0xb1, 0x42, 0xdc, 0xb9, 0xf5, 0xeb, 0x96, 0xbb, 0xda, 0xf3, 0x87,
-	0x5a, 0x8e, 0x62, 0x6e, 0xa8, 0x90, 0xe0, 0x86, 0x0e, 0xc0, 0x76, 0x6e, 0x89, 0x56, 0x19, 0xe2,
-	0xa9, 0x3e, 0x36, 0xcf, 0xc3, 0xac, 0x8d, 0x8f, 0x65, 0xd6, 0xc5, 0x76, 0x28, 0x81, 0x0c, 0xe4,
-	0x74, 0x1e, 0x06, 0x42, 0xdb, 0xef, 0x17, 0x15, 0x9c, 0x2e, 0x75, 0xec, 0x66, 0x93, 0xd6, 0x7c,
-	0x4c, 0x37, 0x7d, 0xbb, 0xc0, 0xd0, 0x95, 0x11, 0x56, 0x1e, 0x26, 0x2c, 0xb1, 0x63, 0x88, 0xde,
-	0x70, 0x8f, 0x8d, 0x5b, 0xb3, 0x01, 0xcf, 0xf2, 0x00, 0x81, 0xb7, 0xcc, 0xd6, 0x6a, 0x1d, 0xea,
-	0xba, 0x39, 0x47, 0x3a, 0x0c, 0xa3, 0x62, 0x18, 0x8b, 0x23, 0xc0, 0xb1, 0x46, 0xac, 0x10, 0x5a,
-	0xf3, 0x73, 0x05, 0xd8, 0x95, 0xc8, 0x31, 0x39, 0x07, 0x5b, 0x99, 0x8e, 0x71, 0xdc, 0xcc, 0x92,
-	0x6e, 0x49, 0xb3, 0xa4, 0x1c, 0x82, 0xbc, 0x0c, 0x83, 0xd2, 0x0e, 0x17, 0xb2, 0x43, 0x4b, 0x20,
-	0x1f, 0xc1, 0x8a, 0xdd, 0x68, 0x58, 0xcb, 0x0d, 0xee, 0x79, 0xb2, 0x22, 0x10, 0x40, 0xbd, 0x03,
-	0x84, 0xfe, 0xc0, 0x01, 0x82, 0x6f, 0x2e, 0x7a, 0x8a, 0xc4, 0x3d, 0x04, 0x3a, 0x2c, 0x5f, 0x57,
-	0x7c, 0xeb, 0x59, 0xb5, 0x6b, 0x53, 0x03, 0x7c, 0xf7, 0x5a, 0xb5, 0x6b, 0x26, 0xc5, 0x30, 0x2a,
-	0x3e, 0xdb, 0x8f, 0x55, 0xa9, 0x9a, 0x70, 0x28, 0x65, 0xca, 0x1f, 0xeb, 0x70, 0x97, 0x02, 0x6b,
-	0x36, 0x6c, 0x9e, 0x33, 0xc5, 0x2f, 0xff, 0x69, 0x04, 0x16, 0x5b, 0x14, 0x5e, 0xee, 0xbd, 0x87,
-	0xa4, 0x91, 0x0a, 0x28, 0x50, 0xaa, 0x2b, 0x1e, 0x14, 0x8e, 0x81, 0xdc, 0x80, 0x91, 0x65, 0xea,
-	0x7a, 0x95, 0xe5, 0xee, 0x3a, 0xa2, 0x29, 0x64, 0x47, 0xb3, 0xdd, 0x07, 0x9d, 0xeb, 0xae, 0x73,
-	0x54, 0x1f, 0x82, 0x51, 0x86, 0x8a, 0x1d, 0x9b, 0x71, 0x5c, 0x7d, 0xd9, 0x71, 0xed, 0xf0, 0x61,
-	0xef, 0xd2, 0x46, 0x83, 0x21, 0x33, 0xaf, 0xe0, 0xf2, 0x9c, 0xa7, 0x1d, 0x7b, 0x8d, 0x85, 0x0b,
-	0x1b, 0x10, 0xe1, 0x4f, 0x14, 0x70, 0xc6, 0xd5, 0x58, 0xfe, 0xd7, 0x0b, 0xf2, 0xf7, 0x84, 0x1a,
-	0xf5, 0x64, 0xf0, 0x38, 0xc2, 0x56, 0x6d, 0xd4, 0xd9, 0xb7, 0xf9, 0xa8, 0xd3, 0xfc, 0xba, 0x01,
-	0xfb, 0xd5, 0x74, 0xff, 0x08, 0x85, 0x86, 0x9f, 0xed, 0x83, 0xe9, 0x44, 0xeb, 0xb6, 0xe4, 0x5c,
-	0xb1, 0x5a, 0x55, 0xda, 0x78, 0xbd, 0xbd, 0xe4, 0xcc, 0x36, 0x7d, 0x8b, 0xf4, 0xf8, 0x7c, 0xf9,
-	0x3c, 0x0c, 0x2f, 0x5b, 0x2e, 0xad, 0x58, 0x0c, 0x6f, 0x1e, 0xe3, 0x0e, 0x3e, 0x1c, 0x27, 0x87,
-	0x2c, 0xc0, 0xf6, 0xfb, 0x5d, 0xc7, 0x93, 0x68, 0xfa, 0xb3, 0xa3, 0x19, 0x66, 0x80, 0x88, 0xe7,
-	0x1a, 0x0c, 0xba, 0x5e, 0xc7, 0xf2, 0x68, 0x9d, 0x6f, 0x18, 0x46, 0x66, 0x5e, 0x50, 0x48, 0x95,
-	0x4b, 0xa4, 0xc1, 0xae, 0x59, 0xee, 0x22, 0x48, 0x59, 0x02, 0x93, 0x9b, 0x30, 0xda, 0xa1, 0x2b,
-	0xb4, 0x43, 0x5b, 0x55, 0x8a, 0x2b, 0x63, 0x20, 0xbb, 0xae, 0x8d, 0x48, 0x58, 0xbe, 0x34, 0xbe,
-	0x5d, 0x80, 0x53, 0x81, 0x99, 0x89, 0x28, 0xda, 0x07, 0x3a, 0x3f, 0x51, 0xc9, 0xf6, 0x3d, 0x06,
-	0xc9, 0xf6, 0x3f, 0x66, 0xc9, 0x6e, 0xdd, 0xb8, 0x64, 0x57, 0xf0, 0x08, 0x27, 0x59, 0xb0, 0x8f,
-	0x2f, 0x88, 0xeb, 0xc0, 0xf3, 0x09, 0x1e, 0x7d, 0x43, 0xe3, 0x65, 0x0e, 0xe5, 0xfe, 0xb5, 0x00,
-	0x4f, 0xa1, 0xe3, 0xef, 0x0d, 0xf4, 0x43, 0x12, 0xd0, 0x5d, 0x60, 0xdb, 0x8c, 0xba, 0xdd, 0xca,
-	0xa3, 0x50, 0x08, 0x12, 0x8a, 0x06, 0xfb, 0x37, 0x12, 0x0d, 0xee, 0x13, 0xd1, 0xa0, 0xaf, 0x39,
-	0x83, 0x73, 0x43, 0xdf, 0x7f, 0x6f, 0x1f, 0x6f, 0x48, 0x0e, 0x0c, 0x07, 0x14, 0x81, 0xe1, 0xb6,
-	0x5e, 0x60, 0x78, 0x1f, 0x4f, 0xf1, 0x54, 0x7a, 0x84, 0x6e, 0xe0, 0xd5, 0x48, 0xbc, 0x36, 0xa3,
-	0x8f, 0xd7, 0x92, 0xa6, 0x4d, 0x46, 0x6d, 0xeb, 0xf0, 0x42, 0x26, 0x95, 0xfa, 0x00, 0x86, 0xfe,
-	0x8c, 0x11, 0x0b, 0x7a, 0x9e, 0xe0, 0x5e, 0xcf, 0x8d, 0xc5, 0x4e, 0x8a, 0x1d, 0xdf, 0xe3, 0x14,
-	0xc1, 0xa7, 0xc5, 0x25, 0x48, 0x20, 0x62, 0x7b, 0x62, 0x67, 0x14, 0x9f, 0x32, 0x00, 0x02, 0xae,
-	0xfd, 0x09, 0x2e, 0x6c, 0x3f, 0x8a, 0xdb, 0xb9, 0x48, 0x3b, 0x6d, 0xea, 0x75, 0xad, 0x06, 0x97,
-	0xc8, 0x5d, 0xcf, 0xf2, 0xfc, 0x58, 0x71, 0x58, 0xb0, 0xdd, 0x5a, 0x71, 0xf0, 0x74, 0x41, 0x75,
-	0x4d, 0x1d, 0xc1, 0x70, 0xa3, 0xb5, 0xe2, 0x94, 0x51, 0x6a, 0xfe, 0xdf, 0x64, 0x11, 0xb6, 0xaf,
-	0x74, 0x5b, 0x35, 0xbb, 0x55, 0xe7, 0xd8, 0xf8, 0x91, 0xd3, 0xb1, 0x6c, 0xd8, 0x16, 0x38, 0x64,
-	0x79, 0x18, 0x51, 0xf8, 0x18, 0xcd, 0x5f, 0xed, 0x83, 0x9d, 0x0b, 0xdd, 0x46, 0x23, 0x3a, 0x9d,
-	0xe4, 0xe5, 0xc8, 0x81, 0xc8, 0x61, 0xe5, 0xc9, 0x75, 0x18, 0x50, 0x9e, 0x91, 0x2d, 0xc1, 0x48,
-	0x5b, 0x10, 0x10, 0xa4, 0xf6, 0x85, 0x6c, 0xd4, 0x32, 0xe9, 0x5d, 0xdf, 0x52, 0xde, 0x21, 0x91,
-	0x30, 0x09, 0xdc, 0xf5, 0x25, 0xe0, 0x75, 0x3b, 0xd4, 0xe5, 0x38, 0xfb, 0x18, 0xce, 0x69, 0x05,
-	0xce, 0xab, 0x0f, 0xda, 0x76, 0x67, 0x7d, 0x81, 0x03, 0xf4, 0x64, 0x7a, 0x7d, 0x8b, 0x2f, 0x04,
-	0xd6, 0xc8, 0x90, 0xce, 0x71, 0xd5, 0x44, 0xb7, 0x9a, 0xc3, 0xb4, 0x32, 0xfd, 0xe5, 0x7b, 0x82,
-	0xc4, 0x23, 0xc1, 0xad, 0x9b, 0x3e, 0x12, 0x9c, 0x1b, 0x80, 0x7e, 0x9f, 0x51, 0xb3, 0x8e, 0x9b,
-	0xd5, 0x84, 0x75, 0x87, 0xcb, 0xfc, 0x6a, 0xf4, 0x44, 0xee, 0x05, 0xcd, 0x19, 0x56, 0x6c, 0xda,
-	0xe4, 0xb9, 0xdc, 0x05, 0x3c, 0xd9, 0x89, 0xf5, 0xc8, 0xb2, 0xa1, 0xab, 0x29, 0xac, 0x83, 0x24,
-	0xf2, 0x4a, 0x44, 0xad, 0x72, 0xd1, 0x28, 0x4e, 0xdb, 0xe6, 0xd0, 0xeb, 0x44, 0x3b, 0xa0, 0x2f,
-	0xc8, 0x44, 0x29, 0x8d, 0xef, 0x5f, 0xc3, 0x38, 0x7a, 0x77, 0x7b, 0x22, 0xdc, 0x10, 0x97, 0xdb,
-	0xfc, 0x67, 0xb6, 0x00, 0xe8, 0x1a, 0x6e, 0x92, 0x7a, 0x97, 0x44, 0xcc, 0x55, 0xb2, 0x17, 0x39,
-	0x79, 0xee, 0xa0, 0xcc, 0x8b, 0x28, 0xd9, 0x45, 0xc7, 0xb5, 0xd9, 0x1b, 0xa7, 0x1b, 0xad, 0x1c,
-	0xf3, 0x22, 0xb4, 0x27, 0x01, 0x5a, 0x6a, 0xcf, 0x56, 0xdf, 0x50, 0x53, 0xd4, 0x9d, 0xa3, 0xa9,
-	0xcb, 0x5d, 0xa0, 0x42, 0xbd, 0xe5, 0xd0, 0xe6, 0x42, 0xec, 0x3d, 0x86, 0x1c, 0x32, 0x17, 0xbb,
-	0x1f, 0x87, 0xe7, 0x14, 0x78, 0xa2, 0x7c, 0x6f, 0xfe, 0xd9, 0x92, 0x8b, 0x77, 0xc7, 0xbd, 0xb1,
-	0xae, 0xae, 0xac, 0x70, 0xde, 0x3f, 0xb8, 0x41, 0x5f, 0x45, 0x1d, 0x8e, 0xbc, 0x12, 0x92, 0x2f,
-	0x84, 0xf2, 0x08, 0xcb, 0x8e, 0x29, 0x59, 0x40, 0xe8, 0x8f, 0x65, 0x7e, 0xb7, 0x88, 0xf9, 0x5d,
-	0x81, 0xc3, 0xa9, 0xf3, 0x22, 0xef, 0x14, 0xe5, 0x88, 0xfe, 0x4a, 0xdf, 0xa7, 0xb2, 0xfb, 0x89,
-	0x7a, 0xf4, 0x93, 0x05, 0x18, 0x8f, 0xcd, 0x02, 0xd9, 0x0d, 0xdb, 0x6c, 0xb7, 0xd2, 0x70, 0x5a,
-	0x75, 0x86, 0x74, 0xb0, 0x3c, 0x60, 0xbb, 0x37, 0x9d, 0x56, 0x7d, 0xf3, 0x81, 0xf9, 0x3c, 0x0c,
-	0xd3, 0x96, 0xd7, 0x59, 0x8f, 0x1d, 0xe7, 0xa4, 0xef, 0xc7, 0x19, 0x1c, 0x77, 0x02, 0xb7, 0x61,
-	0x8c, 0x0a, 0xa2, 0x2b, 0x18, 0xe8, 0xe7, 0x70, 0x27, 0xa3, 0x12, 0xf8, 0x16, 0x83, 0x35, 0x1f,
-	0xe0, 0xf3, 0x81, 0x4c, 0x9a, 0x29, 0xcf, 0x46, 0x43, 0x62, 0x3f, 0xa2, 0x72, 0x8d, 0x51, 0x44,
-	0x61, 0xf9, 0x5f, 0xc6, 0x75, 0x9c, 0x14, 0x91, 0x64, 0x31, 0x38, 0xab, 0xa8, 0x92, 0x89, 0xf0,
-	0x92, 0xd2, 0xfe, 0x8d, 0xc5, 0x44, 0xa8, 0x92, 0xdc, 0x31, 0x0a, 0x67, 0xa0, 0xf0, 0xf5, 0x99,
-	0xa8, 0x6d, 0xa3, 0x33, 0x50, 0xe2, 0x40, 0x8a, 0xaf, 0x87, 0x28, 0xce, 0x19, 0x75, 0x84, 0xa8,
-	0x9e, 0xc5, 0x0d, 0xb8, 0x22, 0x46, 0xcb, 0x46, 0xf4, 0x41, 0x2d, 0x0a, 0xf9, 0xe4, 0x32, 0xa4,
-	0x0f, 0xf9, 0x82, 0xc5, 0xf0, 0xe2, 0xff, 0xa4, 0xd8, 0xff, 0x28, 0x8d, 0x16, 0x8e, 0xf9, 0xd1,
-	0xd0, 0x23, 0x49, 0xdf, 0xde, 0x5c, 0xcc, 0xff, 0x48, 0xb2, 0xf7, 0xe8, 0x52, 0x3c, 0x48, 0x13,
-	0x38, 0xcd, 0x73, 0xf8, 0x30, 0x29, 0xd9, 0xab, 0x22, 0x11, 0x3b, 0x61, 0x2b, 0x7f, 0x0f, 0x6b,
-	0xb0, 0xf7, 0xb0, 0xfc, 0x87, 0xb9, 0x07, 0x5f, 0x1d, 0xdc, 0x72, 0x6a, 0xdd, 0x06, 0x65, 0x51,
-	0xa6, 0x78, 0x49, 0xf7, 0x3a, 0x3e, 0x90, 0x08, 0x7d, 0x92, 0x2f, 0x12, 0x42, 0x52, 0x54, 0xbd,
-	0x42, 0xb9, 0xc6, 0x5f, 0xfa, 0x72, 0x58, 0x94, 0xda, 0x6e, 0xd8, 0x15, 0xf6, 0xbd, 0x62, 0xbc,
-	0x0a, 0xbe, 0x9b, 0xf8, 0xc0, 0x8c, 0xf5, 0xfd, 0xe0, 0xb5, 0x4d, 0x99, 0xbe, 0x6d, 0x75, 0x6a,
-	0x8b, 0x8e, 0xdd, 0xf2, 0x32, 0xbd, 0x86, 0x3b, 0x05, 0x93, 0x6d, 0xca, 0xf7, 0x1a, 0x6d, 0xc7,
-	0x69, 0x54, 0x3c, 0xbb, 0x49, 0x5d, 0xcf, 0x6a, 0xb6, 0x99, 0x81, 0xed, 0x2b, 0xef, 0xc4, 0xaf,
-	0x8b, 0x8e, 0xd3, 0x58, 0x12, 0xdf, 0xcc, 0x9f, 0x12, 0x17, 0xa1, 0x09, 0x63, 0x22, 0x73, 0xcb,
-	0xf0, 0x94, 0xf0, 0x67, 0xec, 0x11, 0x73, 0xa5, 0xc3, 0x7a, 0x55, 0xda, 0xac, 0x1b, 0xa7, 0x23,
-	0x9b, 0xbd, 0x9c, 0x0a, 0x4e, 0x7e, 0x70, 0x2c, 0xf3, 0x00, 0x9a, 0xaf, 0xc0, 0x97, 0x2b, 0x56,
-	0xb3, 0x6d, 0xd9, 0xf5, 0x96, 0x90, 0xfe, 0xbf, 0xf7, 0xa3, 0x89, 0x4a, 0xec, 0x83, 0xb4, 0xde,
-	0x87, 0xa7, 0x7d, 0x1a, 0x7d, 0x21, 0x20, 0x95, 0x55, 0xec, 0x12, 0xdc, 0xce, 0x1d, 0x57, 0x6e,
-	0xa8, 0x2d, 0xbe, 0x14, 0x83, 0xb8, 0x99, 0x41, 0xd9, 0xe3, 0xa9, 0x3e, 0x91, 0x87, 0x70, 0x28,
-	0x32, 0x24, 0x13, 0xbf, 0x1c, 0xd7, 0xad, 0xae, 0x52, 0x5f, 0x3f, 0xf1, 0xf8, 0xfb, 0xa8, 0xf2,
-	0x38, 0x51, 0xb0, 0xc2, 0xc5, 0xe2, 0x34, 0xca, 0x07, 0x42, 0x83, 0xfa, 0x4d, 0xa2, 0xd3, 0x5d,
-	0xc4, 0x49, 0x3e, 0x0a, 0x7b, 0x3c, 0xc7, 0xb3, 0x1a, 0x89, 0x33, 0x93, 0xc3, 0x29, 0x4e, 0x32,
-	0x2c, 0xb1, 0x79, 0x21, 0x9f, 0x36, 0xe0, 0x98, 0xd0, 0xaa, 0x6c, 0x5c, 0xf6, 0xe7, 0xe5, 0xf2,
-	0x08, 0xe2, 0x5f, 0x4a, 0x65, 0xb6, 0x09, 0x07, 0x24, 0x2d, 0x4a, 0xa6, 0xb7, 0x66, 0x57, 0xc7,
-	0x67, 0xc4, 0xc8, 0x89, 0xbc, 0x9b, 0x17, 0x50, 0x27, 0x6f, 0xb8, 0x77, 0xda, 0x1e, 0xad, 0xdd,
-	0xe9, 0x7a, 0x77, 0x56, 0x78, 0x07, 0x37, 0xfd, 0x25, 0xed, 0x3c, 0x2a, 0x6b, 0x22, 0x30, 0x2a,
-	0xeb, 0x7e, 0xd8, 0x6e, 0xbb, 0x15, 0xc7, 0xff, 0x5e, 0x71, 0xba, 0x1e, 0x86, 0x48, 0x60, 0x4b,
-	0x10, 0xf3, 0x30, 0x1e, 0x19, 0xc5, 0x70, 0xe0, 0x61, 0x9a, 0x34, 0x4d, 0xf3, 0x18, 0x7e, 0x6b,
-	0x3a, 0xe2, 0xa0, 0x1a, 0x13, 0x62, 0x5e, 0x46, 0x27, 0xb7, 0x40, 0xe9, 0xbc, 0xed, 0xf2, 0xe3,
-	0x3a, 0x0c, 0x5a, 0x03, 0x9e, 0x59, 0xcd, 0xf4, 0x3f, 0x1a, 0xe8, 0xe2, 0x54, 0x08, 0x90, 0x86,
-	0x67, 0x00, 0x3c, 0x9b, 0x76, 0xe4, 0xfd, 0x92, 0x71, 0xa4, 0xbf, 0x3c, 0xe4, 0xb7, 0xf0, 0x73,
-	0xa1, 0x5b, 0xb0, 0x5d, 0x06, 0xd0, 0xbd, 0x73, 0x08, 0x55, 0xbc, 0x11, 0x18, 0x6b, 0xc9, 0xa6,
-	0x1d, 0x36, 0xd0, 0xb0, 0xd5, 0x1b, 0x95, 0xbc, 0x0a, 0xc3, 0xd2, 0x7e, 0x79, 0x0d, 0x3c, 0x81,
-	0x38, 0x9a, 0x0d, 0xdb, 0xd2, 0xd2, 0xcd, 0x32, 0x08, 0xab, 0xe5, 0x35, 0xa4, 0x9d, 0x0a, 0x74,
-	0x13, 0xea, 0x29, 0xa6, 0xe2, 0x13, 0xe2, 0x9e, 0x2d, 0xb1, 0x8f, 0x74, 0xb8, 0xbb, 0x56, 0x28,
-	0xad, 0xd4, 0xf0, 0x7b, 0x6f, 0xf9, 0x18, 0x59, 0x79, 0x95, 0x28, 0x27, 0x56, 0xe2, 0x8d, 0xe6,
-	0x2b, 0xe8, 0x49, 0xf0, 0x85, 0xf8, 0x2d, 0xdb, 0x6d, 0x5a, 0x5e, 0x35, 0x70, 0xe2, 0xb9, 0x0f,
-	0x86, 0x6b, 0x5d, 0xd7, 0xab, 0xac, 0x58, 0x55, 0xcf, 0xe1, 0x49, 0x2a, 0x7d, 0x65, 0xf0, 0x9b,
-	0x16, 0x58, 0x8b, 0xf9, 0xcb, 0x7d, 0x30, 0x1a, 0x81, 0x26, 0x26, 0x84, 0xf6, 0x31, 0xd9, 0xdf,
-	0x5e, 0x92, 0x59, 0x18, 0xb2, 0xd6, 0x2c, 0x3b, 0xf7, 0xcb, 0x88, 0x1e, 0x94, 0xef, 0xd1, 0xd9,
-	0xaa, 0xcf, 0x13, 0xa0, 0x73, 0x08, 0xb2, 0x00, 0xdb, 0xf1, 0x6d, 0x7c, 0x65, 0xd5, 0x69, 0xd4,
-	0x02, 0x17, 0x31, 0xe9, 0x97, 0x43, 0x08, 0x78, 0xdd, 0x69, 0xd4, 0xc8, 0xab, 0x30, 0x42, 0x1f,
-	0xb4, 0x69, 0xd5, 0x5f, 0xb0, 0x9c, 0x96, 0x81, 0xec, 0x98, 0x76, 0x08, 0x50, 0x66, 0x6e, 0xc8,
-	0x15, 0x80, 0x9a, 0xbd, 0x82, 0x97, 0x3c, 0xfc, 0x88, 0x3e, 0xe3, 0xfe, 0xa5, 0x07, 0x66, 0xbe,
-	0x8d, 0xce, 0x3b, 0x61, 0x9a, 0x51, 0xd1, 0x5e, 0x07, 0x22, 0x58, 0x6f, 0xca, 0xaf, 0x18, 0xa6,
-	0x3c, 0xa7, 0x4f, 0x2b, 0x10, 0xd8, 0xca, 0xe3, 0xcb, 0x51, 0xf4, 0xe6, 0x21, 0x5c, 0xe8, 0xd8,
-	0xd5, 0x0f, 0xfd, 0xe6, 0x7a, 0x82, 0x92, 0x66, 0xe9, 0xb3, 0x05, 0xd8, 0x15, 0xe8, 0xc2, 0x77,
-	0x49, 0x4c, 0x94, 0xff, 0xc7, 0x55, 0xc9, 0xfc, 0x79, 0x11, 0x9a, 0x2b, 0x25, 0x88, 0x13, 0x68,
-	0x43, 0x51, 0x0c, 0xc8, 0xce, 0xdb, 0x83, 0xa3, 0xa7, 0x3d, 0xa7, 0x49, 0x14, 0x7d, 0x79, 0xf7,
-	0x72, 0xf2, 0x90, 0xd2, 0xdb, 0x44, 0x6c, 0xa0, 0x1f, 0x1c, 0xdb, 0xae, 0x67, 0x57, 0xe5, 0xb4,
-	0x9e, 0x83, 0x1d, 0xa1, 0x0f, 0x84, 0x40, 0xbf, 0x6f, 0xbe, 0xd1, 0x94, 0xb3, 0xbf, 0xfd, 0xd9,
-	0xeb, 0xa5, 0x0b, 0xf5, 0x97, 0xf9, 0x0f, 0xb3, 0x85, 0x8e, 0x4a, 0x33, 0x86, 0xdc, 0x6d, 0x82,
-	0x2b, 0x5b, 0x53, 0x1e, 0xc1, 0x87, 0x50, 0x94, 0x03, 0x70, 0x7e, 0x30, 0x7f, 0xcb, 0xf6, 0x9c,
-	0x37, 0xac, 0x6e, 0x83, 0x79, 0x03, 0xc9, 0xc3, 0x9f, 0x19, 0x30, 0x19, 0xfd, 0x82, 0x23, 0x1f,
-	0x85, 0xb1, 0xa6, 0xe5, 0x7a, 0xb4, 0x23, 0x2e, 0x2e, 0xa9, 0x70, 0x95, 0xa3, 0xbc, 0x7d, 0x56,
-	0x34, 0x93, 0x13, 0xb0, 0xb3, 0x26, 0x83, 0xfa, 0x40, 0x77, 0x7e, 0x5d, 0x32, 0xd1, 0xfb, 0xd6,
-	0x03, 0x39, 0x04, 0x23, 0x6e, 0xdb, 0xf1, 0x02, 0x9d, 0xf9, 0x5d, 0xd1, 0x0e, 0xbf, 0x35, 0xd4,
-	0xad, 0xfa, 0xf6, 0xcc, 0xf1, 0x40, 0xb7, 0x7e, 0xde, 0xcd, 0x6f, 0x95, 0xdd, 0xcc, 0x79, 0x34,
-	0xf4, 0xb8, 0x6d, 0x9d, 0x5f, 0xe8, 0x38, 0x4d, 0xc6, 0x52, 0xe0, 0x40, 0x6a, 0xcd, 0xff, 0x5d,
-	0x09, 0x9f, 0x8a, 0x6e, 0x67, 0x8d, 0xe2, 0x0a, 0x56, 0xbc, 0xac, 0x4a, 0xc0, 0x82, 0x32, 0xd1,
-	0xee, 0x6c, 0xc5, 0xe6, 0xf8, 0xba, 0xed, 0x7a, 0x4e, 0xc7, 0xae, 0xca, 0x68, 0xaa, 0xea, 0x04,
-	0x82, 0x25, 0x2d, 0x0a, 0x07, 0x0d, 0x8a, 0x0a, 0x85, 0xdc, 0xd0, 0xef, 0x10, 0x41, 0x1f, 0xfb,
-	0x90, 0x92, 0x64, 0x10, 0xc2, 0xb1, 0xdd, 0x0b, 0xfc, 0x32, 0xbf, 0x64, 0xc0, 0x04, 0xfb, 0xcc,
-	0x47, 0xf4, 0x23, 0x27, 0x7f, 0x4b, 0x47, 0x5e, 0x04, 0xc2, 0x47, 0xa8, 0x77, 0x9c, 0x6e, 0xdb,
-	0x8f, 0x35, 0x5d, 0x5a, 0x45, 0xc5, 0x1e, 0x63, 0x5f, 0xae, 0xe1, 0x87, 0xbb, 0xb4, 0x4a, 0x76,
-	0xc3, 0xb6, 0xa6, 0xf5, 0xa0, 0x62, 0xd5, 0x29, 0xaa, 0xf9, 0x40, 0xd3, 0x7a, 0x30, 0x5b, 0xa7,
-	0x64, 0x1a, 0x26, 0xec, 0x56, 0xb5, 0xd1, 0xf5, 0x49, 0xb5, 0xde, 0xae, 0xac, 0xf2, 0x41, 0xf0,
-	0x59, 0xdf, 0x38, 0x7e, 0x2a, 0x5b, 0x6f, 0xe3, 0xe8, 0xbe, 0xce, 0x89, 0xfe, 0x72, 0x27, 0xce,
-	0x6e, 0x7d, 0xcb, 0xa3, 0xd8, 0x2e, 0xb6, 0xd9, 0xe6, 0xe7, 0x0c, 0x3c, 0xf1, 0x97, 0x29, 0x14,
-	0x96, 0x67, 0x37, 0x6c, 0x6f, 0x3d, 0xd3, 0x6d, 0xe6, 0x47, 0x61, 0x17, 0xe7, 0x0f, 0x49, 0xf2,
-	0xe3, 0x4f, 0x9f, 0xf1, 0x94, 0x28, 0x2b, 0x41, 0x54, 0xe5, 0x09, 0x2f, 0xde, 0x68, 0xfe, 0x97,
-	0x11, 0xd2, 0xc8, 0x20, 0x75, 0xf2, 0x25, 0x32, 0xac, 0xc9, 0x56, 0xbc, 0xfb, 0xdb, 0x97, 0xea,
-	0xf5, 0x7a, 0x20, 0xe4, 0x4d, 0x18, 0x13, 0xc4, 0x4b, 0x59, 0x71, 0xea, 0x83, 0x86, 0x10, 0x93,
-	0x73, 0x31, 0x57, 0x77, 0x5a, 0x88, 0x2f, 0x60, 0x69, 0x46, 0x11, 0x8b, 0xf8, 0x44, 0xae, 0xc0,
-	0x70, 0x70, 0xb2, 0xfa, 0x98, 0x6e, 0x99, 0xe9, 0xba, 0x55, 0x86, 0x8e, 0x9c, 0x49, 0xf3, 0x24,
-	0xe6, 0x33, 0xcc, 0xd9, 0x2d, 0x4b, 0x48, 0x21, 0xed, 0xb2, 0xd5, 0x5c, 0xc6, 0xc7, 0xd5, 0x11,
-	0x20, 0x69, 0x0a, 0x23, 0x37, 0x45, 0xaa, 0x59, 0xe2, 0xe0, 0x38, 0x15, 0xd1, 0x8b, 0xa2, 0xfb,
-	0x70, 0x2c, 0xf1, 0xee, 0xff, 0x8a, 0xd3, 0xaa, 0xd9, 0xfc, 0x91, 0xd8, 0xe3, 0x4e, 0x30, 0xfe,
-	0x7c, 0x1f, 0x1c, 0x88, 0xdd, 0x52, 0x47, 0xc7, 0xfb, 0xd1, 0x7d, 0xe0, 0x71, 0x0d, 0xb6, 0x7b,
-	0x1d, 0xbb, 0x5e, 0xa7, 0x9d, 0xc5, 0xbc, 0x37, 0x91, 0x21, 0xc0, 0xf4, 0x87, 0x1e, 0x87, 0x60,
-	0x9b, 0xed, 0xb2, 0xeb, 0x7d, 0x16, 0x72, 0x0e, 0xce, 0x0d, 0x7f, 0xff, 0xbd, 0x7d, 0xa2, 0xa9,
-	0x2c, 0xfe, 0x88, 0xbc, 0x07, 0xd9, 0xa6, 0x78, 0x0f, 0x32, 0xd8, 0x7b, 0x0f, 0xf2, 0x09, 0x23,
-	0xf4, 0x96, 0x4e, 0xab, 0x14, 0x32, 0x07, 0x34, 0xfc, 0x3a, 0xe1, 0x6c, 0xd6, 0xd7, 0x09, 0x51,
-	0x94, 0xf2, 0x8d, 0x82, 0xb8, 0x2a, 0x13, 0x6f, 0xf5, 0x73, 0x3d, 0xa7, 0x34, 0x7f, 0x5b, 0x9c,
-	0x60, 0x25, 0x80, 0x23, 0xc9, 0x17, 0xa1, 0x7f, 0xce, 0x96, 0xce, 0xe2, 0x88, 0x9e, 0xe0, 0xc0,
-	0x23, 0x0a, 0x06, 0xe5, 0x43, 0xcf, 0xba, 0xf7, 0x44, 0x82, 0x66, 0x0e, 0x68, 0x1f, 0x2a, 0xe1,
-	0xfd, 0xa2, 0x38, 0x00, 0x0f, 0x5f, 0x99, 0xe6, 0x63, 0xfa, 0x1d, 0x11, 0x3e, 0x2a, 0x91, 0xfc,
-	0x50, 0xb2, 0xfe, 0xae, 0x01, 0xe3, 0xb1, 0xde, 0x4f, 0x74, 0xfd, 0x87, 0x57, 0x4c, 0x5f, 0x74,
-	0xc5, 0xc4, 0x4c, 0x60, 0x7f, 0xc2, 0x75, 0xde, 0x2d, 0x5c, 0x43, 0x78, 0x21, 0xed, 0x39, 0x4d,
-	0xbb, 0x7a, 0xf5, 0x01, 0xad, 0x76, 0x7d, 0x65, 0x5f, 0xa0, 0xf4, 0x56, 0xb7, 0xe1, 0xd9, 0xed,
-	0x86, 0x4d, 0x3b, 0x99, 0xe6, 0x76, 0x0d, 0xaf, 0x37, 0xb3, 0xa0, 0x93, 0xb7, 0xf4, 0xd0, 0x94,
-	0xad, 0x79, 0xc4, 0x18, 0x00, 0x33, 0xcf, 0x8a, 0x54, 0x6c, 0x36, 0xc3, 0x77, 0x3d, 0xeb, 0x1e,
-	0xbd, 0xd6, 0xb1, 0x7a, 0x8f, 0x34, 0xa7, 0x60, 0x5b, 0xdd, 0xff, 0x4d, 0xa9, 0x38, 0xf7, 0xc1,
-	0x9f, 0xe6, 0xaf, 0xcb, 0x4c, 0xeb, 0x18, 0x28, 0x12, 0x78, 0x16, 0xb6, 0xb2, 0xce, 0x78, 0xbe,
-	0xa1, 0xf2, 0xa9, 0x1c, 0x9e, 0x83, 0x72, 0x00, 0x72, 0x1b, 0x7a, 0xb7, 0x6c, 0x15, 0x8e, 0x43,
-	0x9f, 0xb8, 0x25, 0x2f, 0xca, 0x38, 0x9a, 0x11, 0x1a, 0xfa, 0x6d, 0x2e, 0xa1, 0xb5, 0x60, 0xbf,
-	0x66, 0xbb, 0xde, 0xaa, 0xd3, 0xb1, 0x7f, 0x9c, 0x3d, 0xe2, 0x8c, 0xf1, 0xd9, 0x09, 0xf3, 0xd9,
-	0x09, 0x4a, 0xa0, 0x10, 0x96, 0xc0, 0x87, 0xf1, 0x5c, 0x28, 0x09, 0x2b, 0x8a, 0xe0, 0x34, 0x0c,
-	0xe0, 0xdb, 0x54, 0x3e, 0x3f, 0xcf, 0xe0, 0xfc, 0xec, 0x8a, 0xcf, 0xcf, 0x8d, 0x96, 0x57, 0xc6,
-	0xce, 0xf2, 0x14, 0x32, 0x8e, 0xd9, 0x4d, 0x25, 0xd8, 0xfc, 0xa2, 0x38, 0x8b, 0x4a, 0x84, 0x46,
-	0xc2, 0x3e, 0x04, 0x84, 0x1f, 0xa7, 0x32, 0xa8, 0x4a, 0x1e, 0x22, 0xc7, 0x18, 0x20, 0x47, 0xce,
-	0xdf, 0xcf, 0xce, 0xc2, 0x00, 0x43, 0xe3, 0xa6, 0x1c, 0x77, 0x27, 0x08, 0x0a, 0x01, 0xcd, 0xb3,
-	0x18, 0x40, 0xe1, 0x5d, 0x12, 0xdf, 0xa1, 0x66, 0x5a, 0x39, 0x6f, 0x61, 0x14, 0x15, 0x81, 0x44,
-	0x3e, 0x2f, 0xc3, 0x36, 0xdc, 0xf9, 0xa2, 0x16, 0x3e, 0xab, 0x4d, 0x4d, 0x16, 0xe0, 0x02, 0x48,
-	0x96, 0xf1, 0x08, 0x7d, 0x0e, 0x5c, 0x0e, 0x3d, 0x95, 0xf8, 0x55, 0x59, 0xd4, 0x21, 0xdb, 0xe8,
-	0xbd, 0xa2, 0x0e, 0xf7, 0x60, 0x47, 0xe8, 0x93, 0x3e, 0xcc, 0xbf, 0xd4, 0x63, 0x36, 0x87, 0x5d,
-	0x94, 0xbc, 0x9e, 0x92, 0x8f, 0x8a, 0x5a, 0x4e, 0xf3, 0x96, 0xdd, 0x12, 0x59, 0x04, 0xfa, 0x5c,
-	0xfc, 0xb7, 0xe4, 0x6b, 0xa2, 0x28, 0x54, 0xaf, 0x98, 0x49, 0x48, 0xbd, 0xb2, 0x45, 0x5b, 0xb8,
-	0x12, 0xf6, 0x29, 0xb0, 0xcb, 0x29, 0x58, 0x97, 0x4f, 0xae, 0x62, 0x1d, 0x70, 0xfc, 0x37, 0x61,
-	0x82, 0x27, 0xd7, 0x37, 0xed, 0x96, 0xcc, 0xae, 0x10, 0x13, 0x72, 0x58, 0x97, 0x61, 0x1f, 0xe4,
-	0x66, 0xbc, 0x16, 0x1d, 0xc0, 0xfc, 0x38, 0x6c, 0xbf, 0xd3, 0xa6, 0xad, 0x1b, 0xfe, 0xaa, 0x4b,
-	0xdd, 0x82, 0x6d, 0x72, 0x6e, 0xce, 0xe0, 0xb5, 0x67, 0x70, 0xc0, 0x4c, 0xcb, 0xe3, 0xc3, 0xb8,
-	0xb0, 0xc2, 0x80, 0x89, 0x53, 0xa3, 0xde, 0x52, 0x87, 0x80, 0x11, 0xe4, 0xf9, 0x53, 0x30, 0x24,
-	0x53, 0xa9, 0xc9, 0x4e, 0x18, 0xf3, 0xff, 0xad, 0xbc, 0xde, 0x72, 0xdb, 0xb4, 0x6a, 0xaf, 0xd8,
-	0xb4, 0x36, 0xb6, 0x85, 0x6c, 0x83, 0xbe, 0xb9, 0xee, 0xfa, 0x98, 0x41, 0x06, 0xa1, 0xff, 0x2e,
-	0x6d, 0x34, 0xc6, 0x0a, 0xcf, 0xbf, 0x01, 0x3b, 0x93, 0x1e, 0xd1, 0xfb, 0x08, 0x02, 0xb0, 0x0c,
-	0xf1, 0xd8, 0x16, 0x32, 0x01, 0xa3, 0x0b, 0x1d, 0xa7, 0xf9, 0xa6, 0xd3, 0x71, 0xbd, 0x25, 0x67,
-	0x8e, 0xba, 0xde, 0x98, 0x21, 0x1a, 0xfd, 0x5f, 0x4b, 0x0e, 0xfb, 0x34, 0x56, 0x98, 0xf9, 0xbb,
-	0x7b, 0xb0, 0x95, 0x31, 0x4a, 0xde, 0x35, 0x60, 0xd7, 0xcd, 0x93, 0x91, 0xf8, 0x68, 0xce, 0x71,
-	0xee, 0x91, 0xf3, 0xba, 0x92, 0x32, 0xfa, 0xc8, 0xac, 0x78, 0x61, 0x43, 0xb0, 0x5c, 0xce, 0xe6,
-	0xec, 0x27, 0xde, 0xfd, 0xe7, 0x5f, 0x2c, 0x5c, 0x20, 0xe7, 0x4a, 0xc9, 0xb5, 0xa7, 0x7a, 0x47,
-	0x46, 0xa5, 0x9b, 0x27, 0x25, 0xbd, 0xa5, 0x87, 0x72, 0x5a, 0x1f, 0x91, 0x2f, 0x1b, 0x30, 0x7a,
-	0xf3, 0xa4, 0x0c, 0x75, 0x19, 0x3f, 0xa7, 0xd2, 0x68, 0x4a, 0x0a, 0xac, 0x8b, 0xa7, 0x73, 0x42,
-	0x21, 0x0f, 0x17, 0x18, 0x0f, 0xa7, 0xc9, 0x49, 0x05, 0x0f, 0x6e, 0xdb, 0xf1, 0x94, 0xd4, 0xff,
-	0xa6, 0x01, 0x13, 0x09, 0x05, 0x8f, 0xc8, 0x09, 0x1d, 0x2d, 0x89, 0xa5, 0x93, 0x8a, 0x33, 0x79,
-	0x40, 0x90, 0xf6, 0x63, 0x8c, 0xf6, 0xc3, 0xe4, 0x50, 0x49, 0x5f, 0x6c, 0x0c, 0xa9, 0xfa, 0x23,
-	0x03, 0x48, 0xbc, 0xc2, 0x10, 0x39, 0x9d, 0xb7, 0x22, 0x11, 0x27, 0xf8, 0xa5, 0x8d, 0x15, 0x32,
-	0x32, 0xcf, 0x33, 0xa2, 0x4f, 0x91, 0x99, 0x14, 0xa2, 0x4b, 0x6e, 0x9c, 0xd4, 0x2f, 0x1b, 0x30,
-	0x1e, 0x43, 0xad, 0xd7, 0x17, 0x55, 0xf5, 0x8d, 0xe2, 0xe9, 0x9c, 0x50, 0x48, 0xfe, 0x39, 0x46,
-	0xfe, 0x49, 0x72, 0x22, 0x37, 0xf9, 0xe4, 0x4b, 0x06, 0x8c, 0x45, 0x2b, 0x25, 0x91, 0x93, 0x59,
-	0xe6, 0x3d, 0xe2, 0xa0, 0x8b, 0xa7, 0xf2, 0x01, 0x21, 0xe9, 0x67, 0x19, 0xe9, 0x33, 0xe4, 0x78,
-	0x1a, 0xe9, 0x34, 0x4a, 0xe4, 0x1f, 0x1a, 0x30, 0x1a, 0xa9, 0x3c, 0x44, 0xb4, 0x0a, 0x9b, 0x5c,
-	0xad, 0xa9, 0x78, 0x32, 0x17, 0x4c, 0x46, 0x2b, 0x23, 0xff, 0x8e, 0x14, 0x64, 0x2a, 0x3d, 0x44,
-	0xf9, 0x3f, 0x62, 0x92, 0x8f, 0x56, 0x4e, 0x22, 0x79, 0x88, 0xc9, 0x26, 0x79, 0x55, 0x71, 0xa6,
-	0xec, 0x92, 0x8f, 0xd6, 0x94, 0x22, 0xdf, 0x36, 0x60, 0x57, 0x62, 0x99, 0x1a, 0x72, 0x36, 0x13,
-	0x25, 0x09, 0x55, 0x93, 0x8a, 0xe7, 0x36, 0x00, 0x89, 0x8c, 0xdc, 0x60, 0x8c, 0x5c, 0x21, 0xb3,
-	0x99, 0x19, 0x09, 0xa2, 0x09, 0xd9, 0xce, 0xbf, 0x32, 0x60, 0x32, 0xb9, 0x00, 0x0f, 0xc9, 0x4f,
-	0xa0, 0x9c, 0x9f, 0xf3, 0x1b, 0x01, 0x45, 0xe6, 0x2e, 0x33, 0xe6, 0xce, 0x92, 0x97, 0x36, 0xc4,
-	0x9c, 0x4b, 0x7e, 0xb6, 0x00, 0x07, 0x33, 0xd4, 0x47, 0x22, 0x0b, 0x5a, 0x1a, 0x33, 0x17, 0x91,
-	0x2a, 0x5e, 0xdb, 0x34, 0x1e, 0x64, 0xfc, 0x4d, 0xc6, 0xf8, 0x6b, 0xe4, 0x4e, 0x2a, 0xe3, 0x1c,
-	0x69, 0x45, 0x34, 0x54, 0x3c, 0x44, 0x5b, 0x09, 0xd5, 0x79, 0x2a, 0x3d, 0x64, 0x3f, 0x1f, 0x91,
-	0xcf, 0x14, 0xe0, 0xd9, 0x2c, 0x15, 0xa3, 0xc8, 0x66, 0x59, 0x91, 0xf3, 0x7f, 0x7d, 0xf3, 0x88,
-	0x50, 0x28, 0x8b, 0x4c, 0x28, 0xaf, 0x92, 0xeb, 0x8f, 0x49, 0x28, 0x2e, 0xf9, 0x9c, 0x01, 0xc3,
-	0x81, 0x72, 0x26, 0x64, 0x5a, 0xeb, 0x81, 0x62, 0x15, 0x58, 0x8a, 0xa5, 0xcc, 0xfd, 0x91, 0x85,
-	0x17, 0x18, 0x0b, 0x87, 0xc8, 0x41, 0x5d, 0x6c, 0x83, 0x47, 0xea, 0xe4, 0x57, 0x0c, 0x80, 0x40,
-	0x85, 0x9a, 0x63, 0xd9, 0x06, 0x13, 0xb4, 0x4d, 0x67, 0xed, 0x8e, 0xa4, 0x9d, 0x61, 0xa4, 0x9d,
-	0x20, 0xa5, 0x0c, 0xa4, 0x85, 0xcc, 0xc6, 0x6f, 0x19, 0x30, 0x1a, 0xa9, 0x0b, 0xa3, 0x77, 0x45,
-	0xc9, 0xe5, 0x6c, 0xf4, 0xae, 0x48, 0x51, 0x78, 0xc6, 0x3c, 0xce, 0xa8, 0x7e, 0x9e, 0x1c, 0xd1,
-	0x51, 0xbd, 0xd2, 0x6d, 0x34, 0x2a, 0x42, 0xaa, 0xef, 0xc4, 0x6b, 0xff, 0x9c, 0xc8, 0x3e, 0x72,
-	0xa6, 0xe0, 0x30, 0xb9, 0xaa, 0x4c, 0xb6, 0xc0, 0x36, 0x40, 0x6b, 0x48, 0xca, 0xbf, 0x63, 0xc0,
-	0x8e, 0x50, 0xbc, 0x4c, 0x8e, 0xa7, 0x4d, 0x70, 0x2c, 0x20, 0x3f, 0x91, 0x03, 0x22, 0x63, 0x70,
-	0xc5, 0x68, 0x96, 0xc5, 0x71, 0x43, 0x14, 0x7f, 0xcd, 0x80, 0xb1, 0x68, 0x12, 0xbd, 0xde, 0xc5,
-	0x2b, 0xca, 0xc7, 0xe8, 0x5d, 0xbc, 0xaa, 0x0a, 0x89, 0xb9, 0xc0, 0x48, 0x7f, 0x85, 0x5c, 0x4e,
-	0x25, 0x3d, 0xa4, 0xcf, 0xa5, 0x87, 0xa1, 0x33, 0xd9, 0x47, 0xe4, 0x5f, 0x0c, 0x98, 0x52, 0xd5,
-	0x20, 0x21, 0xda, 0xdd, 0x5a, 0x4a, 0xb1, 0x9a, 0xe2, 0xc5, 0x8d, 0x01, 0x67, 0x34, 0x87, 0x2a,
-	0xfe, 0x90, 0x37, 0x19, 0x8c, 0x89, 0xbb, 0xfb, 0x47, 0xe4, 0x6f, 0xfc, 0xed, 0x48, 0xac, 0x56,
-	0x50, 0xca, 0x76, 0x44, 0x55, 0xe2, 0x28, 0x65, 0x3b, 0xa2, 0x2c, 0x49, 0x94, 0x87, 0xaf, 0xca,
-	0xf2, 0x3a, 0xa6, 0xc4, 0x6a, 0x67, 0xf0, 0x1d, 0x03, 0xc6, 0xa2, 0x45, 0x88, 0xf5, 0x9a, 0xa8,
-	0xa8, 0x8a, 0x5c, 0x3c, 0x95, 0x0f, 0x08, 0x39, 0x3a, 0xcd, 0x38, 0x2a, 0x91, 0x63, 0x25, 0x4d,
-	0x71, 0x69, 0x37, 0x46, 0xf6, 0x77, 0x0c, 0xd8, 0xd3, 0xd3, 0x6e, 0xe6, 0x1b, 0x6d, 0xda, 0x7a,
-	0x02, 0x2b, 0x29, 0xd3, 0x8c, 0x78, 0x82, 0xbe, 0x4a, 0x86, 0x35, 0xf5, 0x75, 0xd4, 0xb4, 0x70,
-	0xd2, 0x63, 0xba, 0xa6, 0x25, 0x56, 0x95, 0x49, 0xd7, 0xb4, 0xe4, 0x32, 0x32, 0xa9, 0xfb, 0x18,
-	0xee, 0xf2, 0xa2, 0x79, 0x9c, 0x21, 0x23, 0xf7, 0x9e, 0x01, 0x53, 0xaa, 0x72, 0x35, 0x7a, 0xe3,
-	0x90, 0x52, 0x2a, 0x47, 0x6f, 0x1c, 0xd2, 0x2a, 0xe4, 0x98, 0xd7, 0x18, 0x6b, 0xb3, 0xe4, 0xe5,
-	0xf4, 0x83, 0x20, 0x3d, 0x83, 0x7f, 0x6e, 0xc0, 0x44, 0xc2, 0x89, 0x13, 0x79, 0x29, 0x1b, 0x79,
-	0x31, 0x1f, 0x74, 0x26, 0x37, 0x1c, 0x72, 0xf4, 0x32, 0xe3, 0xe8, 0x1c, 0x39, 0x93, 0xce, 0x51,
-	0xb2, 0x3f, 0xfa, 0x07, 0x03, 0x26, 0x93, 0x2b, 0x13, 0xe8, 0xb7, 0x37, 0xda, 0xaa, 0x18, 0xfa,
-	0xed, 0x8d, 0xbe, 0x10, 0x82, 0x79, 0x93, 0xb1, 0xb4, 0x40, 0xe6, 0x33, 0xb2, 0xa4, 0x5f, 0x53,
-	0xff, 0x6d, 0xc0, 0x5e, 0x7d, 0x19, 0x04, 0x32, 0x9b, 0xdd, 0xe1, 0xa8, 0xf8, 0x9d, 0xdb, 0x0c,
-	0x0a, 0xe4, 0xfb, 0x0d, 0xc6, 0xf7, 0x22, 0xb9, 0xbd, 0x21, 0xbe, 0xd5, 0xfe, 0xeb, 0xdf, 0x42,
-	0x8b, 0x31, 0xe2, 0xc5, 0x2e, 0xe4, 0x50, 0xbc, 0x98, 0x2f, 0xbb, 0xb8, 0x31, 0xe0, 0x8d, 0xf2,
-	0x9b, 0xd1, 0xaf, 0xfd, 0x87, 0x01, 0xfb, 0xa2, 0x2a, 0x16, 0x75, 0x13, 0x4f, 0x48, 0xb5, 0x73,
-	0xb0, 0x9c, 0xcb, 0x71, 0xfc, 0xae, 0x01, 0xe3, 0xb1, 0xa4, 0x77, 0xfd, 0x79, 0xa3, 0xaa, 0x36,
-	0x85, 0xfe, 0xbc, 0x51, 0x99, 0x59, 0x6f, 0x9e, 0x60, 0xac, 0xbd, 0x40, 0x8e, 0x66, 0x30, 0xad,
-	0x48, 0xdf, 0x57, 0x0c, 0x18, 0x8b, 0xd5, 0x54, 0x38, 0x99, 0x67, 0xf8, 0x4c, 0x0e, 0x5c, 0x95,
-	0x67, 0x6f, 0x5e, 0x62, 0x24, 0x9f, 0x21, 0xa7, 0x33, 0x93, 0x1c, 0xb2, 0x9c, 0xdf, 0x31, 0x60,
-	0xb7, 0x22, 0x33, 0x5e, 0x7f, 0xd5, 0xa1, 0x4f, 0xc9, 0x2f, 0x5e, 0xd8, 0x10, 0x2c, 0xf2, 0x34,
-	0xcf, 0x78, 0xba, 0x4c, 0x2e, 0x66, 0xe5, 0x49, 0xd8, 0x89, 0x10, 0x6b, 0x7f, 0x62, 0xc0, 0xce,
-	0xa4, 0xbc, 0x41, 0x72, 0x26, 0x5b, 0xa4, 0x17, 0xcb, 0xdf, 0x2f, 0x9e, 0xcd, 0x0f, 0x98, 0x71,
-	0x07, 0x2e, 0xff, 0x8e, 0x2e, 0x8a, 0x2f, 0x18, 0x30, 0x21, 0x8e, 0x50, 0x02, 0xe9, 0x8a, 0xfa,
-	0xe3, 0x8c, 0x78, 0xca, 0xa3, 0xfe, 0x38, 0x23, 0x21, 0x0f, 0x32, 0xf5, 0x38, 0xa3, 0xc9, 0x60,
-	0x2a, 0x2c, 0xff, 0x90, 0xfc, 0x82, 0x01, 0x43, 0x32, 0xb9, 0x91, 0xbc, 0xa8, 0x1b, 0x2b, 0x9a,
-	0x1c, 0x59, 0x3c, 0x96, 0xb1, 0x37, 0xd2, 0x75, 0x84, 0xd1, 0x65, 0x92, 0xfd, 0x0a, 0xba, 0xda,
-	0x92, 0x8c, 0xaf, 0x1a, 0x30, 0x1e, 0x2b, 0x83, 0xa0, 0xb7, 0x27, 0xaa, 0x9a, 0x0b, 0x7a, 0x7b,
-	0xa2, 0xac, 0xb5, 0x90, 0x7a, 0xc8, 0x29, 0x89, 0xad, 0xd8, 0xad, 0xa4, 0x93, 0x81, 0xbf, 0x30,
-	0x60, 0x22, 0x21, 0xd7, 0x9f, 0x64, 0xbc, 0x0e, 0x8a, 0xc9, 0xfa, 0x4c, 0x6e, 0x38, 0x64, 0xe4,
-	0x0a, 0x63, 0xe4, 0x12, 0xb9, 0xa0, 0x0a, 0xa7, 0x7b, 0x5a, 0x2b, 0x79, 0x8a, 0xe9, 0xf2, 0x3f,
-	0x19, 0x50, 0x54, 0x97, 0x13, 0x20, 0x97, 0xf2, 0x11, 0x17, 0x9d, 0xa2, 0xcb, 0x1b, 0x05, 0xcf,
-	0x68, 0x74, 0x94, 0x7c, 0x85, 0x66, 0xec, 0x93, 0x05, 0x38, 0x98, 0x21, 0x89, 0x5f, 0x7f, 0x2c,
-	0x9d, 0xbd, 0x3e, 0x85, 0xfe, 0x58, 0x3a, 0x47, 0x35, 0x01, 0xf3, 0x36, 0x63, 0xff, 0x3a, 0x59,
-	0x50, 0x59, 0x28, 0xf9, 0x96, 0x2a, 0x9b, 0x20, 0xbe, 0x61, 0xc0, 0x44, 0x42, 0x46, 0xbf, 0x5e,
-	0x75, 0xd5, 0x45, 0x08, 0xf4, 0xaa, 0xab, 0x29, 0x3e, 0x60, 0xbe, 0xc2, 0x18, 0x3b, 0x4f, 0xce,
-	0xaa, 0xe6, 0x55, 0xd6, 0x2e, 0x0a, 0x94, 0x6f, 0x0a, 0xb1, 0xf2, 0x2d, 0x03, 0x76, 0x2b, 0x52,
-	0xfd, 0xf5, 0x3e, 0x52, 0x5f, 0xa9, 0x40, 0xef, 0x23, 0x53, 0x2a, 0x14, 0xa4, 0xfa, 0x7d, 0xca,
-	0xe0, 0x95, 0x3c, 0xfd, 0xb5, 0x01, 0x93, 0xc9, 0x95, 0x00, 0xf4, 0x61, 0xa5, 0xb6, 0x8c, 0x81,
-	0x3e, 0xac, 0xd4, 0x97, 0x2f, 0x48, 0x35, 0x31, 0xb1, 0x79, 0xc2, 0xea, 0x56, 0xb1, 0xa9, 0x52,
-	0xd4, 0x2c, 0xd0, 0x4f, 0x95, 0xbe, 0x3a, 0x8b, 0x7e, 0xaa, 0x52, 0x8a, 0x24, 0xa4, 0x4e, 0x15,
-	0x7f, 0x47, 0x2a, 0x32, 0x12, 0x92, 0x8e, 0xb8, 0xc6, 0xe3, 0x09, 0xd6, 0xe9, 0xc7, 0x3d, 0x09,
-	0x75, 0x00, 0xf4, 0x7e, 0x4c, 0x99, 0xc9, 0x6f, 0xce, 0x30, 0x0e, 0x5e, 0x24, 0xcf, 0x2b, 0x38,
-	0x48, 0xc8, 0xa7, 0x26, 0x7f, 0x6a, 0xc0, 0xd4, 0x62, 0x2f, 0x43, 0xfb, 0x09, 0x52, 0x9f, 0xf6,
-	0x08, 0x22, 0x98, 0xa7, 0x1e, 0xe5, 0xe2, 0x2b, 0x22, 0xdb, 0x27, 0x9c, 0xc0, 0xaf, 0x37, 0x63,
-	0xea, 0x62, 0x04, 0x7a, 0x33, 0xa6, 0x29, 0x50, 0x60, 0x9e, 0x62, 0x4c, 0x4c, 0x93, 0x17, 0xb3,
-	0x4c, 0x81, 0xc8, 0xae, 0x27, 0xef, 0x1a, 0x30, 0x99, 0x9c, 0x53, 0xad, 0x5f, 0xe6, 0xda, 0x44,
-	0x6e, 0xfd, 0x32, 0xd7, 0xa7, 0x70, 0x9b, 0x73, 0x8c, 0x8f, 0x8b, 0xe4, 0xbc, 0x82, 0x8f, 0x50,
-	0x76, 0x73, 0x30, 0x9b, 0x3b, 0xf0, 0xc2, 0xc0, 0x9f, 0x94, 0x84, 0x8c, 0x66, 0xfd, 0xa4, 0xa8,
-	0x33, 0xaf, 0xf5, 0x93, 0xa2, 0xc9, 0xc6, 0x4e, 0x9d, 0x94, 0xc4, 0x54, 0x6d, 0xf2, 0xc7, 0x06,
-	0x8c, 0xc7, 0x12, 0x6f, 0xf5, 0x4b, 0x42, 0x95, 0x8e, 0xad, 0x5f, 0x12, 0xca, 0xec, 0xde, 0xd4,
-	0x13, 0xb7, 0x78, 0xea, 0x6f, 0xe9, 0x61, 0x20, 0xed, 0xfb, 0x11, 0xf9, 0x4b, 0x03, 0x76, 0x2b,
-	0x32, 0x50, 0xf5, 0x86, 0x56, 0x9f, 0xf8, 0xab, 0x37, 0xb4, 0x29, 0x29, 0xaf, 0xa9, 0x0b, 0x5d,
-	0x3c, 0x89, 0x4d, 0x48, 0x88, 0x25, 0xdf, 0x34, 0x60, 0x8f, 0x32, 0xb7, 0x94, 0x5c, 0xcc, 0xa8,
-	0x21, 0x89, 0x69, 0xaf, 0xc5, 0x4b, 0x1b, 0x84, 0x46, 0xb6, 0x5e, 0x62, 0x6c, 0x1d, 0x27, 0xd3,
-	0x59, 0xb4, 0x8c, 0xd5, 0x47, 0xf0, 0x77, 0x65, 0x2e, 0xf9, 0xbc, 0x01, 0x23, 0xe1, 0x4c, 0x55,
-	0xe5, 0xd6, 0x2c, 0x31, 0xd5, 0x55, 0xb9, 0x35, 0x4b, 0x4e, 0x7f, 0x35, 0x4b, 0x8c, 0xce, 0xa3,
-	0xe4, 0xb0, 0x6a, 0xcb, 0x68, 0x7b, 0x4e, 0x85, 0xe7, 0x94, 0xda, 0x8c, 0x9a, 0xaf, 0x19, 0x58,
-	0x18, 0x27, 0x96, 0x3e, 0xaa, 0x5f, 0x0d, 0xaa, 0x9c, 0x55, 0xfd, 0x6a, 0x50, 0xe6, 0xa8, 0xa6,
-	0x6e, 0xd3, 0x38, 0xcd, 0x32, 0xcc, 0x28, 0x3d, 0x0c, 0x25, 0xc6, 0xb2, 0x58, 0x77, 0x32, 0x39,
-	0xff, 0x54, 0x6f, 0x65, 0xb5, 0x69, 0xaf, 0x7a, 0x2b, 0xab, 0x4f, 0x77, 0x4d, 0x3d, 0x6f, 0x58,
-	0x95, 0xe0, 0x95, 0x50, 0x5a, 0x2c, 0x0b, 0xdb, 0x13, 0x4a, 0x8f, 0xe8, 0x4d, 0xab, 0xba, 0xd0,
-	0x89, 0xde, 0xb4, 0x6a, 0x6a, 0x9c, 0xa4, 0x86, 0xed, 0xc1, 0x02, 0x28, 0x15, 0x67, 0x05, 0x3d,
-	0x9f, 0x1b, 0xf0, 0x12, 0x7f, 0x6b, 0xc0, 0x1e, 0x65, 0x59, 0x13, 0xfd, 0x8a, 0x4e, 0x2b, 0x9b,
-	0xa2, 0x5f, 0xd1, 0xa9, 0xb5, 0x54, 0xcc, 0x8b, 0x8c, 0xb9, 0x97, 0xc8, 0x29, 0x55, 0x44, 0x98,
-	0xc0, 0x59, 0x45, 0x16, 0x6c, 0xfa, 0xb2, 0x01, 0x63, 0xd1, 0x24, 0x59, 0xfd, 0x91, 0xa3, 0x22,
-	0xe1, 0xb7, 0x78, 0x2a, 0x1f, 0x50, 0x46, 0xea, 0x7b, 0xff, 0x3f, 0x21, 0x42, 0x86, 0x42, 0xf4,
-	0x2f, 0x1a, 0xb0, 0x33, 0x21, 0xdd, 0xd4, 0xd5, 0x3f, 0x7a, 0x48, 0x4a, 0x8a, 0xd5, 0x3f, 0x7a,
-	0x48, 0xcc, 0x88, 0x4d, 0xbd, 0xaf, 0x5d, 0x66, 0x50, 0x22, 0xb7, 0x59, 0x9e, 0xf2, 0x7e, 0xba,
-	0x00, 0x07, 0x52, 0x33, 0x1d, 0xc9, 0x7c, 0x9e, 0x53, 0x75, 0x55, 0xf6, 0x6c, 0xf1, 0xea, 0x26,
-	0xb1, 0x20, 0xa7, 0x1f, 0x66, 0x9c, 0x96, 0xc9, 0x62, 0xe6, 0x9b, 0x98, 0x6a, 0x0f, 0x97, 0xf6,
-	0xa0, 0xfe, 0x07, 0x06, 0x98, 0xe9, 0x39, 0x66, 0xe4, 0x6a, 0xba, 0x72, 0x65, 0x48, 0x79, 0x2b,
-	0x2e, 0x6c, 0x16, 0x4d, 0xc6, 0xe0, 0xc0, 0x62, 0x48, 0xf8, 0x45, 0x45, 0xc5, 0x77, 0xa9, 0xbd,
-	0x0c, 0x37, 0xf2, 0x07, 0x06, 0x8c, 0x45, 0x53, 0xd4, 0x52, 0x9e, 0xb4, 0x26, 0xe7, 0xc2, 0xa5,
-	0x3c, 0x69, 0x55, 0x64, 0xc1, 0xa5, 0x3e, 0x2f, 0xb2, 0xf8, 0xc9, 0x8c, 0xeb, 0x43, 0xf2, 0x6c,
-	0xac, 0xd2, 0x43, 0xcc, 0x30, 0x63, 0x87, 0x88, 0x24, 0x9e, 0x35, 0xa5, 0xbf, 0x90, 0x57, 0x26,
-	0xb9, 0xe9, 0x2f, 0xe4, 0xd5, 0x59, 0x6c, 0xa9, 0x4f, 0x76, 0x30, 0x87, 0x2c, 0x08, 0x2b, 0x78,
-	0xe8, 0x3c, 0x0a, 0x70, 0xf3, 0x35, 0x03, 0x26, 0x12, 0x92, 0xd2, 0x48, 0x4e, 0xba, 0xb2, 0x39,
-	0x28, 0x4d, 0xf6, 0x5b, 0xea, 0xae, 0x3e, 0x81, 0x21, 0xb7, 0xc7, 0x11, 0x7b, 0xf4, 0x15, 0x4e,
-	0xcb, 0x3a, 0x9e, 0xae, 0xe8, 0xe1, 0x9c, 0x36, 0xbd, 0xfd, 0x4b, 0xcc, 0x65, 0x4b, 0x7d, 0xf4,
-	0x85, 0xcb, 0x1e, 0x63, 0xe3, 0x90, 0xe1, 0xfe, 0x35, 0x3f, 0x9c, 0x0c, 0x25, 0xa9, 0x91, 0xec,
-	0x04, 0x64, 0x4b, 0xbd, 0x48, 0xce, 0x81, 0x33, 0xa7, 0x19, 0xd1, 0x47, 0xc8, 0x73, 0x99, 0x88,
-	0x76, 0xc9, 0xef, 0xb3, 0x3b, 0xb9, 0x70, 0xaa, 0x55, 0xda, 0x9d, 0x5c, 0x62, 0xba, 0x5a, 0xda,
-	0x9d, 0x5c, 0x72, 0xb6, 0x5a, 0xaa, 0x90, 0xe3, 0xa9, 0x64, 0xf2, 0x11, 0xef, 0x3b, 0xec, 0x12,
-	0x34, 0x92, 0x25, 0x46, 0x72, 0x91, 0x91, 0xf5, 0x12, 0x54, 0x91, 0xeb, 0x96, 0x7a, 0xd8, 0x93,
-	0x90, 0x08, 0x47, 0x7e, 0xc9, 0x88, 0xe4, 0xb1, 0x95, 0xf4, 0x01, 0x52, 0x2c, 0x01, 0xad, 0x78,
-	0x3c, 0x3b, 0x00, 0xd2, 0xf9, 0x22, 0xa3, 0xf3, 0x39, 0xf2, 0xac, 0x32, 0x88, 0xa2, 0xad, 0x8a,
-	0x8d, 0x50, 0x73, 0xf7, 0xbe, 0xf1, 0xbd, 0xbd, 0xc6, 0x37, 0xbf, 0xb7, 0xd7, 0xf8, 0xee, 0xf7,
-	0xf6, 0x1a, 0x3f, 0xf7, 0xfe, 0xde, 0x2d, 0xdf, 0x7c, 0x7f, 0xef, 0x96, 0xef, 0xbc, 0xbf, 0x77,
-	0xcb, 0x47, 0x5e, 0xab, 0xdb, 0xde, 0x6a, 0x77, 0x79, 0xba, 0xea, 0x34, 0x4b, 0x37, 0x04, 0xa6,
-	0x9b, 0xd6, 0xb2, 0xdb, 0xc3, 0x7b, 0xac, 0xea, 0x74, 0x68, 0xf0, 0xe7, 0xaa, 0x65, 0xb7, 0xf0,
-	0x0a, 0xcc, 0xed, 0x0d, 0xea, 0xad, 0xb7, 0xa9, 0x5b, 0x5a, 0x9b, 0x59, 0x1e, 0x60, 0xff, 0xb3,
-	0xff, 0xc9, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xd4, 0x38, 0xa0, 0x06, 0x81, 0x00, 0x00,
+	// 6419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5d, 0x6b, 0x6c, 0x1c, 0xc9,
+	0x71, 0xd6, 0x2c, 0x29, 0x8a, 0x2c, 0x4a, 0x24, 0xd5, 0x92, 0x28, 0x6a, 0x4e, 0x12, 0xa5, 0xa1,
+	0x74, 0xd2, 0x3d, 0xc4, 0x95, 0x28, 0x52, 0x94, 0x44, 0x49, 0x77, 0xa4, 0x28, 0x4a, 0x3a, 0xeb,
+	0xc1, 0x5b, 0xf1, 0xee, 0x1c, 0xe7, 0xe2, 0xf1, 0x70, 0x77, 0xb8, 0x1c, 0x6b, 0x77, 0x67, 0xb5,
+	0x33, 0x4b, 0x89, 0x11, 0xf4, 0x23, 0x4e, 0x62, 0x18, 0x36, 0x90, 0x38, 0x0f, 0xc0, 0x3f, 0x0c,
+	0x38, 0x40, 0x1c, 0x24, 0xc8, 0x03, 0x09, 0xe0, 0x5c, 0xe0, 0x20, 0x76, 0x10, 0xe7, 0x61, 0xc7,
+	0x40, 0x80, 0xc4, 0x86, 0x93, 0xd8, 0x08, 0x90, 0x8b, 0xe3, 0x0b, 0x10, 0xc4, 0x40, 0xe2, 0xfc,
+	0x0f, 0x90, 0x04, 0xd3, 0x53, 0xdd, 0x3b, 0xaf, 0x9e, 0xe9, 0x59, 0xea, 0x20, 0x3b, 0xf9, 0x25,
+	0x6e, 0x4f, 0x7f, 0xd5, 0x55, 0xd5, 0xd5, 0xdd, 0xd5, 0x8f, 0x2a, 0xc1, 0x51, 0xab, 0xf1, 0x51,
+	0xb3, 0xec, 0x5a, 0x1b, 0x66, 0xd1, 0x7c, 0x54, 0x5e, 0x37, 0x1a, 0x55, 0xb3, 0xb8, 0x31, 0x55,
+	0x7c, 0xd0, 0x36, 0x5b, 0x9b, 0x93, 0xcd, 0x96, 0xed, 0xda, 0x64, 0x1f, 0xaf, 0x32, 0xc9, 0xaa,
+	0x4c, 0x6e, 0x4c, 0xa9, 0x7b, 0xab, 0x76, 0xd5, 0xa6, 0x35, 0x8a, 0xde, 0x5f, 0x7e, 0x65, 0xf5,
+	0x60, 0xd5, 0xb6, 0xab, 0x35, 0xb3, 0x68, 0x34, 0xad, 0xa2, 0xd1, 0x68, 0xd8, 0xae, 0xe1, 0x5a,
+	0x76, 0xc3, 0xc1, 0xaf, 0xc7, 0x92, 0x5b, 0xe3, 0x64, 0xfd, 0x5a, 0xc7, 0x93, 0x6b, 0xd9, 0xad,
+	0x8a, 0xd9, 0x5a, 0xb5, 0xed, 0xfb, 0x58, 0x4d, 0x4b, 0xae, 0x56, 0x37, 0x5a, 0xf7, 0x4d, 0x17,
+	0xeb, 0x4c, 0x24, 0xd7, 0xa9, 0x9a, 0x0d, 0xd3, 0xb1, 0x9c, 0x78, 0x7b, 0x76, 0xcb, 0x28, 0xd7,
+	0xcc, 0xe2, 0xc6, 0x99, 0x55, 0xd3, 0x35, 0xce, 0xe0, 0x4f, 0xbf, 0x9a, 0x76, 0x17, 0xe0, 0x5e,
+	0x7b, 0xd5, 0x28, 0x97, 0xed, 0x76, 0xc3, 0x25, 0xa3, 0xd0, 0xe7, 0xb6, 0x8c, 0x8a, 0xd9, 0x1a,
+	0x53, 0x8e, 0x28, 0x27, 0x07, 0x4a, 0xf8, 0x8b, 0xbc, 0x00, 0x23, 0x0e, 0xaf, 0xa5, 0x37, 0xec,
+	0x46, 0xd9, 0x1c, 0x2b, 0x1c, 0x51, 0x4e, 0xee, 0x2a, 0x0d, 0x77, 0xca, 0xef, 0x78, 0xc5, 0xda,
+	0x47, 0xe0, 0xe0, 0xeb, 0x9e, 0x9e, 0x3b, 0x54, 0xef, 0x7a, 0x22, 0x3a, 0x25, 0xf3, 0x41, 0xdb,
+	0x74, 0x5c, 0x32, 0x01, 0xbb, 0x02, 0xa4, 0xac, 0x0a, 0xb6, 0xb4, 0xb3, 0x53, 0x78, 0xb3, 0x42,
+	0x9e, 0x83, 0x01, 0x5f, 0x62, 0xaf, 0x42, 0x81, 0x56, 0xe8, 0xf7, 0x0b, 0x6e, 0x56, 0xb4, 0x2f,
+	0x2a, 0x70, 0x48, 0xd0, 0x84, 0xd3, 0xb4, 0x1b, 0x8e, 0x49, 0x6e, 0x02, 0xac, 0xb6, 0x37, 0x75,
+	0xaa, 0x5b, 0x67, 0x4c, 0x39, 0xd2, 0x73, 0x72, 0x70, 0xea, 0xc5, 0xc9, 0xc4, 0x1e, 0x9f, 0x8c,
+	0x10, 0x59, 0x34, 0x5c, 0xa3, 0x34, 0xb0, 0xda, 0xde, 0xf4, 0x49, 0x92, 0x0f, 0xc0, 0xa0, 0x63,
+	0xd6, 0x6a, 0x8c, 0x56, 0x21, 0x37, 0x2d, 0xf0, 0xe0, 0x3e, 0x31, 0xed, 0xb7, 0x14, 0x38, 0x1e,
+	0xa9, 0xe3, 0x75, 0xfd, 0x6d, 0xd3, 0x35, 0x2a, 0x86, 0x6b, 0xbc, 0x65, 0xb9, 0xeb, 0xb7, 0xa9,
+	0x94, 0xe4, 0x0e, 0xf4, 0xd7, 0xb1, 0x94, 0x2a, 0x68, 0x70, 0x6a, 0x4a, 0xae, 0xcd, 0x20, 0xbd,
+	0x12, 0xa7, 0x91, 0xaa, 0x50, 0xb2, 0x17, 0xb6, 0x5b, 0xce, 0x42, 0x7b, 0x73, 0xac, 0xe7, 0x88,
+	0x72, 0xb2, 0xbf, 0xe4, 0xff, 0xd0, 0x0e, 0x82, 0x4a, 0xb5, 0x7c, 0x0d, 0x1b, 0x5b, 0x36, 0x5a,
+	0x46, 0x9d, 0x75, 0xa3, 0xf6, 0x21, 0x78, 0x2e, 0xf1, 0x2b, 0xf6, 0xc0, 0x1c, 0xf4, 0x35, 0x69,
+	0x09, 0x72, 0x7f, 0x48, 0xc0, 0xbd, 0x0f, 0x5b, 0xe8, 0xfd, 0xfa, 0xbb, 0xe3, 0xdb, 0x4a, 0x08,
+	0xd1, 0x7e, 0x4e, 0x81, 0xc3, 0x91, 0x0e, 0x5e, 0x34, 0x9b, 0xb6, 0x63, 0xb9, 0xf9, 0xac, 0xe8,
+	0x3a, 0x40, 0xe7, 0x37, 0x95, 0x7a, 0x70, 0xea, 0x68, 0xa6, 0x1a, 0x29, 0x33, 0x4a, 0x29, 0x00,
+	0xd5, 0xbe, 0xab, 0xc0, 0xb8, 0x90, 0x21, 0x94, 0xf8, 0x23, 0xd0, 0x5f, 0xc1, 0x32, 0xb4, 0xb8,
+	0x45, 0x41, 0x53, 0x19, 0x94, 0x26, 0x59, 0xc1, 0xb5, 0x86, 0xdb, 0xda, 0x2c, 0x71, 0xaa, 0xea,
+	0x8f, 0xc3, 0xae, 0xd0, 0x27, 0x32, 0x02, 0x3d, 0xf7, 0xcd, 0x4d, 0x14, 0xdd, 0xfb, 0x93, 0x4c,
+	0xc3, 0xf6, 0x0d, 0xa3, 0xd6, 0x36, 0x51, 0xd8, 0xc3, 0x02, 0x0e, 0x90, 0x4c, 0xc9, 0xaf, 0x7c,
+	0xb1, 0x70, 0x5e, 0xd1, 0x0e, 0xc3, 0xc1, 0x50, 0x7f, 0x2e, 0x18, 0x35, 0xa3, 0x51, 0x36, 0x79,
+	0x7f, 0x1b, 0x70, 0x48, 0xf0, 0x1d, 0xe5, 0x7f, 0x15, 0xfa, 0x57, 0xb1, 0x0c, 0xe5, 0x17, 0xb5,
+	0x8e, 0x50, 0xec, 0x74, 0x8e, 0xd2, 0x66, 0xd1, 0xa4, 0xe6, 0xab, 0xd5, 0x96, 0x59, 0x35, 0x5c,
+	0xf3, 0x4d, 0xbb, 0xd6, 0xae, 0x9b, 0xac, 0xcb, 0xc7, 0x60, 0x07, 0xeb, 0x4a, 0x5f, 0x62, 0xf6,
+	0x53, 0x6b, 0xc2, 0xc1, 0x64, 0x20, 0xb2, 0xb6, 0x0c, 0xbb, 0x0d, 0xf6, 0x49, 0xdf, 0xa0, 0xdf,
+	0x18, 0x8f, 0x13, 0x02, 0x1e, 0xfd, 0x61, 0x88, 0x74, 0x46, 0x8c, 0x30, 0x61, 0x47, 0xfb, 0xb1,
+	0xe4, 0x16, 0xb9, 0x79, 0xaa, 0xd0, 0x8f, 0xcc, 0xf9, 0x0d, 0x0d, 0x94, 0xf8, 0x6f, 0x72, 0x08,
+	0x80, 0x0f, 0x45, 0x7f, 0x42, 0x19, 0x28, 0x0d, 0xb0, 0xb1, 0xe8, 0x68, 0x3f, 0x60, 0xb3, 0x5b,
+	0x9c, 0x36, 0x8a, 0x63, 0xc3, 0x81, 0x8e, 0x38, 0x6c, 0x08, 0x84, 0xc5, 0x3a, 0x2b, 0x10, 0x8b,
+	0xd3, 0x9c, 0xf7, 0x61, 0x4c, 0x51, 0x65, 0xbb, 0x55, 0x29, 0xed, 0x37, 0x12, 0xbf, 0x3a, 0xe4,
+	0x27, 0x60, 0xac, 0xd3, 0x20, 0xf2, 0xce, 0xda, 0x2b, 0xc8, 0xab, 0x71, 0x94, 0x13, 0x09, 0x16,
+	0x3b, 0xda, 0xab, 0x70, 0x34, 0x2c, 0x70, 0x08, 0x85, 0x1a, 0x0d, 0x4d, 0x60, 0x4a, 0x64, 0x45,
+	0xa8, 0x82, 0x96, 0x46, 0x01, 0xf5, 0x36, 0x0f, 0x7d, 0x3e, 0xd7, 0x38, 0x27, 0x89, 0x98, 0x0e,
+	0x2a, 0x85, 0xcd, 0x4c, 0x3e, 0x50, 0xbb, 0x0e, 0x45, 0xbf, 0xa1, 0x76, 0xd9, 0xf3, 0x00, 0xd8,
+	0x60, 0x58, 0x69, 0x19, 0x0d, 0x67, 0xcd, 0x6c, 0x2d, 0x9a, 0x0d, 0xbb, 0xbe, 0x68, 0x96, 0xad,
+	0xba, 0x51, 0x63, 0x8c, 0xef, 0x85, 0xed, 0x15, 0xaf, 0x18, 0x99, 0xf6, 0x7f, 0x68, 0xb7, 0xe0,
+	0xb4, 0x3c, 0x21, 0xe4, 0x7f, 0x0c, 0x76, 0x54, 0xfc, 0x22, 0x4a, 0xab, 0xb7, 0xc4, 0x7e, 0x6a,
+	0xaf, 0xc9, 0x53, 0xe3, 0x26, 0x3a, 0x0a, 0x7d, 0x94, 0x15, 0x66, 0xa0, 0xf8, 0x4b, 0xfb, 0xb8,
+	0x02, 0x67, 0x72, 0x10, 0x43, 0xde, 0x5e, 0x87, 0x21, 0x8a, 0xd7, 0x91, 0x25, 0x66, 0x88, 0xc7,
+	0x84, 0x33, 0x50, 0x80, 0x0a, 0x2a, 0x79, 0x57, 0x25, 0x58, 0xa8, 0x5d, 0x4d, 0xeb, 0x54, 0x2e,
+	0x46, 0x78, 0x34, 0x29, 0xd1, 0xd1, 0x54, 0x81, 0x89, 0x54, 0x22, 0xc8, 0xfe, 0x65, 0xd8, 0xd1,
+	0xc5, 0xbc, 0xc0, 0x30, 0xda, 0x87, 0x62, 0x0e, 0x09, 0x9b, 0x61, 0xf3, 0x2c, 0x57, 0xdc, 0x52,
+	0x0a, 0x41, 0x4b, 0x79, 0x5b, 0xb4, 0x16, 0x72, 0xe6, 0x2f, 0x86, 0x56, 0x1e, 0x99, 0x79, 0x9f,
+	0xd7, 0xd7, 0x96, 0x61, 0xbf, 0x4f, 0xbd, 0x69, 0xbb, 0xbe, 0x6c, 0x41, 0x03, 0x71, 0x5c, 0xc3,
+	0x6d, 0x3b, 0xcc, 0x17, 0xf4, 0x7f, 0x65, 0xcd, 0x5f, 0x6f, 0xc1, 0x58, 0x9c, 0x22, 0xf7, 0x0a,
+	0x76, 0xf8, 0x15, 0x99, 0x9a, 0x85, 0xab, 0x31, 0x07, 0x97, 0x18, 0x42, 0x9b, 0x81, 0xd1, 0x08,
+	0x61, 0xa9, 0xb9, 0x61, 0x25, 0x26, 0x21, 0x67, 0xe7, 0x02, 0xf4, 0xf9, 0xd5, 0x50, 0x6d, 0x12,
+	0xdc, 0x20, 0x40, 0xfb, 0x66, 0x01, 0x0e, 0x70, 0xb2, 0xdc, 0xf1, 0x92, 0x61, 0xc8, 0xeb, 0xe6,
+	0x9a, 0x55, 0xb7, 0x7c, 0x87, 0xa4, 0xb7, 0xe4, 0xff, 0x20, 0xaf, 0x00, 0x50, 0x17, 0x53, 0x77,
+	0xac, 0x8a, 0x49, 0x1d, 0xb1, 0xa1, 0xa9, 0x23, 0x02, 0x7e, 0x68, 0x7b, 0xf7, 0xac, 0x8a, 0x59,
+	0x1a, 0xb0, 0xd9, 0x9f, 0x44, 0x87, 0x03, 0x94, 0x92, 0x5e, 0x6e, 0xd7, 0xdb, 0x35, 0xc3, 0x03,
+	0xe9, 0x0d, 0xdb, 0x1b, 0xc1, 0x46, 0x6d, 0xac, 0xd7, 0xe3, 0x61, 0x61, 0xc2, 0x73, 0x6c, 0xfe,
+	0xe1, 0xdd, 0xf1, 0xe7, 0xca, 0xb6, 0x53, 0xb7, 0x1d, 0xa7, 0x72, 0x7f, 0xd2, 0xb2, 0x8b, 0x75,
+	0xc3, 0x5d, 0x9f, 0xbc, 0x65, 0x56, 0x8d, 0xf2, 0xe6, 0xa2, 0x59, 0x2e, 0xed, 0xa7, 0x54, 0xae,
+	0x72, 0x22, 0x77, 0x90, 0x46, 0x62, 0x03, 0x0f, 0xda, 0x46, 0xc3, 0xb5, 0xdc, 0xcd, 0xb1, 0xed,
+	0xdd, 0x37, 0xf0, 0x3a, 0xd2, 0xd0, 0xbe, 0xa2, 0x80, 0x9a, 0xa4, 0x53, 0xec, 0xad, 0x25, 0x18,
+	0x59, 0x6d, 0x6f, 0x3a, 0x7a, 0xb3, 0x65, 0x95, 0x4d, 0xbd, 0x66, 0x6e, 0x98, 0x35, 0xb4, 0xa2,
+	0x83, 0x02, 0x3d, 0xdd, 0xf2, 0xea, 0x94, 0x86, 0x3c, 0xd4, 0xb2, 0x07, 0xa2, 0xbf, 0xc9, 0x0d,
+	0xd8, 0xed, 0xb9, 0xe4, 0x61, 0x42, 0x05, 0x09, 0x42, 0xc3, 0x14, 0x16, 0xa0, 0x34, 0x02, 0x3d,
+	0x8e, 0xf9, 0x80, 0x76, 0x56, 0x6f, 0xc9, 0xfb, 0x53, 0xfb, 0xbc, 0x02, 0x43, 0x4b, 0xed, 0x5a,
+	0xad, 0x63, 0x31, 0x5b, 0x30, 0x32, 0xf2, 0x26, 0xec, 0xae, 0x5b, 0x15, 0xe4, 0xd3, 0x68, 0x54,
+	0x74, 0xd7, 0x5e, 0x45, 0xcf, 0xee, 0xb8, 0x68, 0x7e, 0xb2, 0x2a, 0x94, 0xc1, 0xf9, 0x46, 0x65,
+	0xe5, 0xee, 0x02, 0xba, 0xb2, 0x43, 0xf5, 0x40, 0xa9, 0xbd, 0xaa, 0x7d, 0x42, 0x41, 0x4f, 0x2b,
+	0xcc, 0xea, 0x16, 0x47, 0x3e, 0x99, 0x82, 0xd1, 0x87, 0x96, 0xbb, 0xae, 0xc7, 0x79, 0xf6, 0xf7,
+	0x15, 0xc4, 0xfb, 0x7a, 0x3b, 0xcc, 0x8a, 0x0e, 0x07, 0x93, 0x39, 0xc1, 0x4e, 0x7f, 0x25, 0x3a,
+	0x63, 0x88, 0x04, 0x0f, 0x13, 0xe8, 0xcc, 0x1a, 0x75, 0xb4, 0xa9, 0xc8, 0x77, 0x99, 0x81, 0x2a,
+	0x96, 0xa7, 0x20, 0x94, 0xe7, 0xed, 0x44, 0xcd, 0x06, 0xd6, 0x99, 0xb0, 0x31, 0x48, 0x4a, 0xc3,
+	0x66, 0x9d, 0x9f, 0xe5, 0x1b, 0x23, 0x36, 0x42, 0x9c, 0x85, 0xcd, 0x1b, 0x86, 0xb3, 0x6e, 0x3a,
+	0x52, 0x12, 0xc5, 0x96, 0xa1, 0x42, 0xc2, 0x32, 0x74, 0x14, 0x76, 0xfa, 0x33, 0xd1, 0x3a, 0x25,
+	0x3c, 0xd6, 0x43, 0xfb, 0x79, 0x90, 0x96, 0xf9, 0x6d, 0x69, 0x55, 0x18, 0x17, 0xb2, 0x81, 0x92,
+	0x2e, 0x42, 0x5f, 0x68, 0xfb, 0xfd, 0xb2, 0x40, 0xd2, 0x95, 0x96, 0x55, 0xaf, 0x9b, 0x15, 0x8f,
+	0xd2, 0x2d, 0x6f, 0x5e, 0xa0, 0xe4, 0x4a, 0x88, 0xe5, 0x87, 0x09, 0x2b, 0xf4, 0x18, 0xa2, 0xd3,
+	0xdc, 0x53, 0x93, 0x56, 0xab, 0xc1, 0x31, 0xdf, 0x41, 0xf0, 0x4b, 0xe6, 0x2b, 0x95, 0x96, 0xe9,
+	0x38, 0x39, 0x5b, 0x3a, 0x01, 0xc3, 0xac, 0x19, 0xc3, 0x27, 0x80, 0x6d, 0x0d, 0x19, 0x21, 0xb2,
+	0xda, 0x67, 0x0b, 0xb0, 0x2f, 0x51, 0x62, 0x72, 0x01, 0xb6, 0x53, 0x1b, 0x1b, 0x53, 0xf8, 0x4c,
+	0xba, 0x2d, 0x6b, 0x26, 0xf5, 0x11, 0xe4, 0x15, 0xe8, 0xe7, 0xf3, 0x70, 0x41, 0x1e, 0xcd, 0x41,
+	0x1e, 0x81, 0x35, 0xab, 0x56, 0x33, 0x56, 0x6b, 0xfe, 0xca, 0x23, 0x4b, 0x80, 0x81, 0x3a, 0x07,
+	0x08, 0xbd, 0x81, 0x03, 0x04, 0x6f, 0xba, 0xe8, 0x18, 0x92, 0xbf, 0x42, 0xe0, 0x82, 0xe5, 0xd9,
+	0x8a, 0x37, 0x7b, 0x96, 0xad, 0xca, 0x58, 0x9f, 0xbf, 0x7b, 0x2d, 0x5b, 0x15, 0xcd, 0x84, 0x43,
+	0x82, 0xde, 0x7e, 0xaa, 0x46, 0x55, 0x87, 0xe3, 0x19, 0x5d, 0xfe, 0x54, 0x9b, 0xbb, 0x1c, 0x18,
+	0xb3, 0xe1, 0xe9, 0x59, 0xca, 0x7f, 0xf9, 0x2f, 0x05, 0xc6, 0x85, 0x78, 0xbe, 0xf7, 0x1e, 0xe0,
+	0x93, 0xd4, 0x98, 0x22, 0xbf, 0x14, 0xf7, 0xb3, 0x85, 0x81, 0xdc, 0x84, 0xa1, 0x55, 0xd3, 0x71,
+	0x75, 0xef, 0xd8, 0xcc, 0x27, 0x53, 0x90, 0x27, 0xb3, 0xd3, 0x83, 0x2e, 0xb4, 0x37, 0x7d, 0x52,
+	0x1f, 0x80, 0x61, 0x4a, 0x8a, 0x1e, 0x9b, 0xf9, 0xb4, 0x7a, 0xe4, 0x69, 0xed, 0xf2, 0xb0, 0xf7,
+	0xcc, 0x5a, 0x8d, 0x12, 0xd3, 0xae, 0xe2, 0xf0, 0x5c, 0x34, 0x5b, 0xd6, 0x06, 0x75, 0x17, 0xba,
+	0x50, 0xe1, 0x4f, 0x15, 0xe0, 0x78, 0x06, 0x95, 0xff, 0xf3, 0x8a, 0xfc, 0x03, 0x66, 0x46, 0x1d,
+	0x1d, 0x3c, 0x0d, 0xb7, 0x35, 0xd5, 0xeb, 0xec, 0xd9, 0xba, 0xd7, 0xa9, 0x7d, 0x4d, 0x81, 0x23,
+	0x62, 0xbe, 0x7f, 0x84, 0x5c, 0xc3, 0xcf, 0xf4, 0xc0, 0x64, 0xe2, 0xec, 0xb6, 0x62, 0x5f, 0x35,
+	0x1a, 0x65, 0xb3, 0xf6, 0x46, 0x73, 0xc5, 0x9e, 0xaf, 0x7b, 0x33, 0xd2, 0xd3, 0x5b, 0xcb, 0x17,
+	0x61, 0x70, 0xd5, 0x70, 0x4c, 0xdd, 0xa0, 0x74, 0xf3, 0x4c, 0xee, 0xe0, 0xe1, 0x7c, 0x76, 0xc8,
+	0x12, 0xec, 0x7c, 0xd0, 0xb6, 0x5d, 0x4e, 0xa6, 0x57, 0x9e, 0xcc, 0x20, 0x05, 0x22, 0x9d, 0xeb,
+	0xd0, 0xef, 0xb8, 0x2d, 0xc3, 0x35, 0xab, 0xfe, 0x86, 0x61, 0x68, 0xea, 0x25, 0x81, 0x56, 0x7d,
+	0x8d, 0xd4, 0xe8, 0x35, 0xcb, 0x3d, 0x84, 0x94, 0x38, 0x98, 0xdc, 0x82, 0xe1, 0x96, 0xb9, 0x66,
+	0xb6, 0xcc, 0x46, 0xd9, 0xc4, 0x91, 0xd1, 0x27, 0x6f, 0x6b, 0x43, 0x1c, 0xeb, 0x0f, 0x8d, 0x6f,
+	0x17, 0x60, 0x3a, 0xd0, 0x33, 0x11, 0x43, 0x7b, 0x5f, 0xfb, 0x27, 0xaa, 0xd9, 0x9e, 0xa7, 0xa0,
+	0xd9, 0xde, 0xa7, 0xac, 0xd9, 0xed, 0xdd, 0x6b, 0x76, 0x0d, 0xb4, 0x14, 0xc5, 0x3e, 0x3d, 0x27,
+	0xae, 0x05, 0x2f, 0x26, 0xac, 0xe8, 0x5d, 0xb5, 0x27, 0xed, 0xca, 0xfd, 0x5b, 0x01, 0x9e, 0xc3,
+	0x85, 0xbf, 0xd3, 0xd0, 0x0f, 0x89, 0x43, 0x37, 0x47, 0xb7, 0x19, 0x55, 0xab, 0x91, 0xc7, 0xa0,
+	0x10, 0x12, 0xf2, 0x06, 0x7b, 0xbb, 0xf1, 0x06, 0xc7, 0x99, 0x37, 0xe8, 0x59, 0x4e, 0xff, 0xc2,
+	0xc0, 0xf7, 0xdf, 0x1d, 0xf7, 0x0b, 0x92, 0x1d, 0xc3, 0x3e, 0x81, 0x63, 0xb8, 0xa3, 0xe3, 0x18,
+	0x3e, 0x80, 0x89, 0x54, 0x3b, 0xc2, 0x65, 0xe0, 0xb5, 0x88, 0xbf, 0x36, 0x95, 0xee, 0xaf, 0x25,
+	0x75, 0x1b, 0xf7, 0xda, 0x36, 0xe1, 0x25, 0x29, 0x93, 0x7a, 0x1f, 0x9a, 0xfe, 0x94, 0x12, 0x73,
+	0x7a, 0x9e, 0xe1, 0x5e, 0xcf, 0x81, 0xe3, 0x19, 0xcc, 0xbc, 0x0f, 0x2a, 0xf8, 0x24, 0xbb, 0x04,
+	0xe9, 0xd4, 0x7a, 0x76, 0x67, 0x14, 0x9f, 0x50, 0x00, 0x02, 0x4b, 0xfb, 0x33, 0x1c, 0xd8, 0x9e,
+	0x17, 0xb7, 0x77, 0xd9, 0x6c, 0x35, 0x4d, 0xb7, 0x6d, 0xd4, 0x7c, 0x8d, 0xdc, 0x73, 0x0d, 0xd7,
+	0xf3, 0x15, 0x07, 0x99, 0xd8, 0x8d, 0x35, 0x1b, 0x4f, 0x17, 0x44, 0xd7, 0xd4, 0x11, 0x0a, 0x37,
+	0x1b, 0x6b, 0x76, 0x09, 0xea, 0xfc, 0x6f, 0xb2, 0x0c, 0x3b, 0xd7, 0xda, 0x8d, 0x8a, 0xd5, 0xa8,
+	0xfa, 0xd4, 0xfc, 0x23, 0xa7, 0x53, 0x72, 0xd4, 0x96, 0x7c, 0x64, 0x69, 0x10, 0x49, 0x78, 0x14,
+	0xb5, 0x5f, 0xef, 0x81, 0xbd, 0xde, 0x99, 0x46, 0xb4, 0x3b, 0xc9, 0x2b, 0x91, 0x03, 0x91, 0x13,
+	0xc2, 0x93, 0xeb, 0x30, 0x90, 0x9f, 0x91, 0xad, 0xc0, 0x50, 0x93, 0x31, 0x10, 0xe4, 0xf6, 0x25,
+	0x39, 0x6e, 0xa9, 0xf6, 0x6e, 0x6c, 0x2b, 0xed, 0xe2, 0x44, 0xa8, 0x06, 0xee, 0x79, 0x1a, 0x70,
+	0xdb, 0x2d, 0xd3, 0xf1, 0x69, 0xf6, 0x50, 0x9a, 0x93, 0x02, 0x9a, 0xd7, 0x1e, 0x35, 0x2d, 0xef,
+	0xc8, 0x87, 0x02, 0x3a, 0x3a, 0xbd, 0xb1, 0xcd, 0x53, 0x02, 0x2d, 0xa4, 0x44, 0x17, 0x7c, 0xd3,
+	0xc4, 0x65, 0x35, 0xc7, 0xd4, 0x4a, 0xed, 0xd7, 0xdf, 0x13, 0x24, 0x1e, 0x09, 0x6e, 0xdf, 0xf2,
+	0x91, 0xe0, 0x42, 0x1f, 0xf4, 0x7a, 0x82, 0x6a, 0x55, 0xdc, 0xac, 0x26, 0x8c, 0x3b, 0x1c, 0xe6,
+	0xd7, 0xa2, 0x27, 0x72, 0x2f, 0xa5, 0x9c, 0x61, 0xc5, 0xba, 0x8d, 0x61, 0xb5, 0x39, 0x3c, 0xd9,
+	0x89, 0xd5, 0x90, 0xd9, 0xd0, 0x55, 0x04, 0xb3, 0x03, 0x67, 0xf2, 0x6a, 0xc4, 0xac, 0x72, 0xf1,
+	0x88, 0x50, 0x6d, 0x01, 0x57, 0x9d, 0x68, 0x05, 0x5c, 0x0b, 0xa4, 0x38, 0x35, 0xe3, 0xfb, 0xd7,
+	0x30, 0x8d, 0xce, 0xdd, 0x1e, 0x73, 0x37, 0xd8, 0xe5, 0xb6, 0xff, 0x53, 0xce, 0x01, 0xba, 0x0e,
+	0x47, 0x22, 0x97, 0x44, 0x74, 0xa9, 0xa4, 0x2f, 0x72, 0xf2, 0xdc, 0x41, 0x69, 0x97, 0x50, 0xb3,
+	0xcb, 0xb6, 0x63, 0xd1, 0x37, 0x4e, 0x37, 0x1b, 0x39, 0xfa, 0x85, 0x59, 0x4f, 0x02, 0x9a, 0x5b,
+	0xcf, 0x76, 0x6f, 0xa2, 0x36, 0xd1, 0x76, 0x5e, 0xc8, 0x1c, 0xee, 0x8c, 0x14, 0xda, 0xad, 0x8f,
+	0xd6, 0x96, 0x62, 0xef, 0x31, 0x78, 0x93, 0xb9, 0xc4, 0xfd, 0x28, 0x3c, 0x2f, 0xa0, 0x13, 0x95,
+	0x7b, 0xeb, 0xcf, 0x96, 0x1c, 0x28, 0x46, 0xda, 0xba, 0xb6, 0xb6, 0xe6, 0xcb, 0xfe, 0xfe, 0x35,
+	0xfa, 0x1a, 0x4c, 0x44, 0x1a, 0xa5, 0x0b, 0x2d, 0x7f, 0x21, 0x94, 0x47, 0x59, 0x56, 0xcc, 0xc8,
+	0x02, 0x4a, 0x7f, 0x2a, 0xfd, 0xbb, 0x8d, 0xf5, 0xef, 0x1a, 0x9c, 0xc8, 0xec, 0x17, 0x7e, 0xa7,
+	0xc8, 0x5b, 0xf4, 0x46, 0xfa, 0xb8, 0x68, 0xde, 0x4f, 0xb4, 0xa3, 0x9f, 0x2e, 0xc0, 0xee, 0x58,
+	0x2f, 0x90, 0xfd, 0xb0, 0xc3, 0x72, 0xf4, 0x9a, 0xdd, 0xa8, 0x52, 0xa2, 0xfd, 0xa5, 0x3e, 0xcb,
+	0xb9, 0x65, 0x37, 0xaa, 0x5b, 0x77, 0xcc, 0x17, 0x61, 0xd0, 0xf4, 0x9e, 0xee, 0xc4, 0x8e, 0x73,
+	0xb2, 0xf7, 0xe3, 0x14, 0xe7, 0x2f, 0x02, 0x77, 0x60, 0xc4, 0x64, 0x4c, 0xeb, 0xe8, 0xe8, 0xe7,
+	0x58, 0x4e, 0x86, 0x39, 0xf8, 0x36, 0xc5, 0x6a, 0x8f, 0xe0, 0x74, 0x44, 0xdb, 0x29, 0x96, 0xc9,
+	0xcf, 0x46, 0x43, 0x6a, 0x3f, 0x29, 0x5a, 0x1a, 0xa3, 0x84, 0xc2, 0xfa, 0xbf, 0x82, 0xe3, 0x38,
+	0xc9, 0x23, 0x91, 0x99, 0x70, 0xd6, 0xe1, 0x88, 0x18, 0xcf, 0x39, 0xed, 0xed, 0xce, 0x27, 0x42,
+	0x93, 0xf4, 0x17, 0x46, 0xb6, 0x18, 0x08, 0xd6, 0x7a, 0x29, 0x6e, 0x9b, 0x70, 0x2c, 0x9d, 0x06,
+	0x72, 0x7c, 0x23, 0xc4, 0x71, 0x4e, 0xaf, 0x23, 0xc4, 0xf5, 0x3c, 0x6e, 0xc0, 0x05, 0x3e, 0x9a,
+	0x1c, 0xd3, 0x13, 0xa9, 0x24, 0xf8, 0x93, 0xcb, 0x90, 0x3d, 0xe4, 0x73, 0x16, 0xc3, 0x83, 0xff,
+	0xe3, 0x6c, 0xff, 0x23, 0x9c, 0xb4, 0xb0, 0xcd, 0x0f, 0x87, 0x1e, 0x49, 0x7a, 0xf3, 0xcd, 0xa5,
+	0xfc, 0x8f, 0x24, 0x3b, 0x8f, 0x2e, 0xd9, 0x83, 0x34, 0x46, 0x53, 0xbb, 0x80, 0x0f, 0x93, 0x92,
+	0x57, 0x55, 0x64, 0x62, 0x2f, 0x6c, 0xf7, 0xdf, 0xc3, 0x2a, 0xf4, 0x3d, 0xac, 0xff, 0x43, 0x3b,
+	0x80, 0xaf, 0x0e, 0x6e, 0xdb, 0x95, 0x76, 0xcd, 0xa4, 0x5e, 0x26, 0x7b, 0x49, 0xf7, 0x06, 0x8c,
+	0xc5, 0x3f, 0xf1, 0x17, 0x09, 0x21, 0x2d, 0x8a, 0x5e, 0xa1, 0x5c, 0xf7, 0x5f, 0xfa, 0xfa, 0x58,
+	0xd4, 0xda, 0x7e, 0xd8, 0x17, 0x5e, 0x7b, 0x59, 0x7b, 0x3a, 0x8c, 0x46, 0x3f, 0x3c, 0xdd, 0xc9,
+	0xfa, 0x41, 0xf0, 0xda, 0xa6, 0x64, 0x3e, 0x34, 0x5a, 0x95, 0x65, 0xdb, 0x6a, 0xb8, 0x52, 0xaf,
+	0xe1, 0xa6, 0x61, 0xb4, 0x69, 0xfa, 0x7b, 0x8d, 0xa6, 0x6d, 0xd7, 0x74, 0xd7, 0xaa, 0x9b, 0x8e,
+	0x6b, 0xd4, 0x9b, 0x74, 0x82, 0xed, 0x29, 0xed, 0xc5, 0xaf, 0xcb, 0xb6, 0x5d, 0x5b, 0x61, 0xdf,
+	0xb4, 0x9f, 0x61, 0x17, 0xa1, 0x09, 0x6d, 0xa2, 0x70, 0xab, 0xf0, 0x1c, 0x5b, 0xcf, 0xe8, 0x23,
+	0x66, 0xbd, 0x45, 0x6b, 0xe9, 0x4d, 0xdb, 0xe2, 0x7c, 0xc8, 0xcd, 0x97, 0x63, 0xc1, 0xce, 0x0f,
+	0xb6, 0xa5, 0x1d, 0xc5, 0xe9, 0x2b, 0xf0, 0xe5, 0xaa, 0x51, 0x6f, 0x1a, 0x56, 0xb5, 0xc1, 0xb4,
+	0xff, 0x1f, 0xbd, 0x70, 0x44, 0x5c, 0x07, 0x79, 0x7d, 0x00, 0x07, 0x3d, 0x1e, 0x3d, 0x25, 0x20,
+	0x97, 0x65, 0xac, 0x12, 0xdc, 0xce, 0x9d, 0x16, 0x6e, 0xa8, 0x0d, 0x7f, 0x28, 0x06, 0x69, 0xd3,
+	0x09, 0xe5, 0x80, 0x2b, 0xfa, 0x44, 0x1e, 0xc3, 0xf1, 0x48, 0x93, 0x54, 0xfd, 0xbc, 0x5d, 0xa7,
+	0xbc, 0x6e, 0x7a, 0xf6, 0x39, 0x56, 0x48, 0xb5, 0x8d, 0x8e, 0x28, 0xbe, 0x5a, 0xec, 0x5a, 0xe9,
+	0x68, 0xa8, 0x51, 0xaf, 0x88, 0x55, 0xba, 0x87, 0x34, 0xc9, 0x87, 0xe1, 0x80, 0x6b, 0xbb, 0x46,
+	0x2d, 0xb1, 0x67, 0x72, 0x2c, 0x8a, 0xa3, 0x94, 0x4a, 0xac, 0x5f, 0xc8, 0x27, 0x15, 0x38, 0xc5,
+	0xac, 0x4a, 0x4e, 0xca, 0xde, 0xbc, 0x52, 0x9e, 0x44, 0xfa, 0x2b, 0x99, 0xc2, 0xd6, 0xe1, 0x28,
+	0xe7, 0x45, 0x28, 0xf4, 0x76, 0x79, 0x73, 0x3c, 0xc4, 0x5a, 0x4e, 0x94, 0x5d, 0x9b, 0x43, 0x9b,
+	0xbc, 0xe9, 0xdc, 0x6d, 0xba, 0x66, 0xe5, 0x6e, 0xdb, 0xbd, 0xbb, 0xe6, 0x57, 0x70, 0xb2, 0x5f,
+	0xd2, 0x2e, 0xc2, 0x11, 0x31, 0x18, 0x8d, 0xf5, 0x08, 0xec, 0xb4, 0x1c, 0xdd, 0xf6, 0xbe, 0xeb,
+	0x76, 0xdb, 0x45, 0x17, 0x09, 0x2c, 0x0e, 0xd1, 0x4e, 0xe0, 0x91, 0x51, 0x8c, 0x06, 0x1e, 0xa6,
+	0xf1, 0xa9, 0x69, 0x11, 0x9e, 0xcf, 0xaa, 0x88, 0x8d, 0xa6, 0x4c, 0x21, 0xda, 0x15, 0x5c, 0xe4,
+	0x96, 0x4c, 0x73, 0xd1, 0x72, 0x68, 0x21, 0xe2, 0x83, 0x2b, 0xb3, 0x58, 0xe8, 0x7f, 0x52, 0x60,
+	0x22, 0x95, 0x00, 0xf2, 0x70, 0x08, 0xc0, 0xb5, 0xcc, 0x16, 0xbf, 0x5f, 0xf2, 0xae, 0x76, 0x06,
+	0xbc, 0x12, 0xff, 0x5c, 0xe8, 0x36, 0xec, 0xe4, 0x0e, 0x74, 0xe7, 0x1c, 0x42, 0xe4, 0x6f, 0x04,
+	0xda, 0x5a, 0xb1, 0xcc, 0x16, 0x6d, 0x68, 0xd0, 0xe8, 0xb4, 0x4a, 0x5e, 0x03, 0xf6, 0x53, 0x77,
+	0xdd, 0x1a, 0x9e, 0x40, 0xbc, 0x20, 0x47, 0x6d, 0x65, 0xe5, 0x56, 0x09, 0xd8, 0xac, 0xe5, 0xd6,
+	0xf8, 0x3c, 0x15, 0xa8, 0xc6, 0xcc, 0x93, 0x75, 0xc5, 0xc7, 0xd8, 0x3d, 0x5b, 0x62, 0x1d, 0xbe,
+	0xe0, 0xee, 0x5b, 0x33, 0x4d, 0xbd, 0x82, 0xdf, 0x3b, 0xc3, 0x47, 0x91, 0x95, 0x95, 0x93, 0xdc,
+	0xb3, 0x16, 0x2f, 0xd4, 0x5e, 0xc5, 0x95, 0x04, 0x5f, 0x88, 0xdf, 0xb6, 0x9c, 0xba, 0xe1, 0x96,
+	0x03, 0x27, 0x9e, 0xe3, 0x30, 0x58, 0x69, 0x3b, 0xae, 0xbe, 0x66, 0x94, 0x5d, 0xdb, 0x0f, 0x52,
+	0xe9, 0x29, 0x81, 0x57, 0xb4, 0x44, 0x4b, 0xb4, 0x5f, 0xed, 0x81, 0xe1, 0x08, 0x9a, 0x68, 0x10,
+	0xda, 0xc7, 0xc8, 0xbf, 0xbd, 0x24, 0xf3, 0x30, 0x60, 0x6c, 0x18, 0x56, 0xee, 0x97, 0x11, 0x1d,
+	0x94, 0xb7, 0xa2, 0xd3, 0x51, 0x9f, 0xc7, 0x41, 0xf7, 0x11, 0xde, 0xe5, 0x10, 0xbe, 0x8d, 0xd7,
+	0xd7, 0xed, 0x5a, 0x65, 0x6c, 0xbb, 0x3c, 0x85, 0x41, 0x04, 0xde, 0xb0, 0x6b, 0x15, 0xf2, 0x1a,
+	0x0c, 0x99, 0x8f, 0x9a, 0x66, 0xd9, 0x1b, 0xb0, 0x3e, 0x2f, 0x7d, 0xf2, 0x94, 0x76, 0x31, 0x28,
+	0x9d, 0x6e, 0xc8, 0x55, 0x80, 0x8a, 0xb5, 0x86, 0x97, 0x3c, 0x63, 0x3b, 0xe4, 0xe9, 0x04, 0x60,
+	0xda, 0x43, 0x5c, 0xbc, 0x13, 0xba, 0x19, 0x0d, 0xed, 0x0d, 0x20, 0x4c, 0xf4, 0x3a, 0xff, 0x8a,
+	0x6e, 0xca, 0xf3, 0xe9, 0x61, 0x05, 0x8c, 0x5a, 0x69, 0xf7, 0x6a, 0x94, 0xbc, 0x76, 0x1c, 0x07,
+	0x3a, 0x56, 0xf5, 0x5c, 0xbf, 0x85, 0x8e, 0xa2, 0xf8, 0xb4, 0xf4, 0x99, 0x02, 0xec, 0x0b, 0x54,
+	0xf1, 0x77, 0x49, 0x54, 0x95, 0xff, 0xcf, 0x4d, 0x49, 0xfb, 0x05, 0xe6, 0x9a, 0x0b, 0x35, 0x88,
+	0x1d, 0x68, 0x81, 0xca, 0x1a, 0xa4, 0xe7, 0xed, 0xc1, 0xd6, 0xb3, 0x9e, 0xd3, 0x24, 0xaa, 0xbe,
+	0xb4, 0x7f, 0x35, 0xb9, 0x49, 0xbe, 0xda, 0x44, 0xe6, 0x40, 0xcf, 0x39, 0xb6, 0x1c, 0xd7, 0x2a,
+	0xf3, 0x6e, 0xbd, 0x00, 0xbb, 0x42, 0x1f, 0x08, 0x81, 0x5e, 0xd7, 0xc2, 0x58, 0xb7, 0xde, 0x12,
+	0xfd, 0xdb, 0xeb, 0xbd, 0x4e, 0xb8, 0x50, 0x6f, 0xc9, 0xff, 0xa1, 0x35, 0xe0, 0xf9, 0xac, 0x36,
+	0xf8, 0x6e, 0x13, 0x1c, 0x5e, 0x9a, 0xf1, 0x08, 0x3e, 0x44, 0xa2, 0x14, 0xc0, 0x79, 0xce, 0xfc,
+	0x6d, 0xcb, 0xb5, 0xdf, 0x34, 0xda, 0x35, 0xba, 0x1a, 0x70, 0x19, 0xfe, 0x42, 0x81, 0xd1, 0xe8,
+	0x17, 0x6c, 0xf9, 0x05, 0x18, 0xa9, 0x1b, 0x8e, 0x6b, 0xb6, 0xd8, 0xc5, 0xa5, 0xc9, 0x96, 0xca,
+	0x61, 0xbf, 0x7c, 0x9e, 0x15, 0x93, 0x33, 0xb0, 0xb7, 0xc2, 0x9d, 0xfa, 0x40, 0x75, 0xff, 0xba,
+	0x64, 0x4f, 0xe7, 0x5b, 0x07, 0x72, 0x1c, 0x86, 0x9c, 0xa6, 0xed, 0x06, 0x2a, 0xfb, 0x77, 0x45,
+	0xbb, 0xbc, 0xd2, 0x50, 0xb5, 0xf2, 0xc3, 0xa9, 0xd3, 0x81, 0x6a, 0xbd, 0x7e, 0x35, 0xaf, 0x94,
+	0x57, 0xd3, 0x16, 0x71, 0xa2, 0xc7, 0x6d, 0xeb, 0xe2, 0x52, 0xcb, 0xae, 0x53, 0x91, 0x02, 0x07,
+	0x52, 0x1b, 0xde, 0x6f, 0x3d, 0x7c, 0x2a, 0xba, 0x93, 0x16, 0xb2, 0x2b, 0x58, 0xf6, 0xb2, 0x2a,
+	0x81, 0x0a, 0xea, 0x24, 0x75, 0x67, 0xcb, 0x36, 0xc7, 0x37, 0x2c, 0xc7, 0xb5, 0x5b, 0x56, 0x99,
+	0x7b, 0x53, 0x5e, 0xe0, 0x87, 0xdc, 0xf1, 0xae, 0x0d, 0x13, 0xa9, 0x24, 0xf8, 0x86, 0x7e, 0x17,
+	0x73, 0xfa, 0xe8, 0x87, 0x8c, 0x20, 0x83, 0x10, 0x8d, 0x9d, 0x6e, 0xe0, 0x97, 0x17, 0xfb, 0xb8,
+	0x87, 0x7e, 0xf6, 0x5b, 0xf4, 0x3c, 0x27, 0x6f, 0x4b, 0x47, 0x5e, 0x06, 0xe2, 0xb7, 0x50, 0x6d,
+	0xd9, 0xed, 0xa6, 0xe7, 0x6b, 0x3a, 0x66, 0x19, 0x0d, 0x7b, 0x84, 0x7e, 0xb9, 0x8e, 0x1f, 0xee,
+	0x99, 0x65, 0xef, 0x80, 0xab, 0x6e, 0x3c, 0xd2, 0x8d, 0xaa, 0x89, 0x66, 0xde, 0x57, 0x37, 0x1e,
+	0xcd, 0x57, 0x4d, 0x32, 0x09, 0x7b, 0xac, 0x46, 0xb9, 0xd6, 0xf6, 0x58, 0x35, 0x1e, 0xea, 0xeb,
+	0x7e, 0x23, 0xf8, 0xac, 0x6f, 0x37, 0x7e, 0x2a, 0x19, 0x0f, 0xb1, 0x75, 0xcf, 0xe6, 0x58, 0x7d,
+	0xbe, 0x13, 0xa7, 0xb7, 0xbe, 0xa5, 0x61, 0x2c, 0x67, 0xdb, 0x6c, 0xed, 0xb3, 0x0a, 0x1c, 0x0c,
+	0xf4, 0xd6, 0x9b, 0x76, 0xcd, 0x70, 0xad, 0x9a, 0xe5, 0x6e, 0x4a, 0xdd, 0x66, 0x7e, 0x18, 0xf6,
+	0xf9, 0xf2, 0x21, 0x4b, 0xba, 0xed, 0x0b, 0x9e, 0xe1, 0x65, 0x25, 0xa8, 0xaa, 0xb4, 0xc7, 0x8d,
+	0x17, 0x6a, 0xff, 0xad, 0xc0, 0x21, 0x01, 0x77, 0xfc, 0x25, 0x32, 0x6c, 0xf0, 0x52, 0xbc, 0xfb,
+	0x1b, 0xcf, 0x5c, 0xf5, 0x3a, 0x10, 0xf2, 0x16, 0x8c, 0x30, 0xe6, 0xb9, 0xae, 0x7c, 0xee, 0x83,
+	0x13, 0x21, 0x06, 0xe7, 0x62, 0xac, 0xee, 0x24, 0x53, 0x5f, 0x60, 0xa6, 0x19, 0x46, 0x2a, 0xec,
+	0x13, 0xb9, 0x0a, 0x83, 0xc1, 0xce, 0xea, 0xa1, 0xb6, 0xa5, 0x65, 0xdb, 0x56, 0x09, 0x5a, 0xbc,
+	0x27, 0xb5, 0xb3, 0x18, 0xcf, 0xb0, 0x60, 0x35, 0x0c, 0xa6, 0x85, 0xac, 0xcb, 0x56, 0x6d, 0x15,
+	0xd4, 0x24, 0x10, 0x9f, 0x0a, 0x23, 0x37, 0x45, 0xa2, 0x5e, 0xf2, 0xe1, 0xd8, 0x15, 0xd1, 0x8b,
+	0xa2, 0x07, 0x70, 0x2a, 0xf1, 0xee, 0xff, 0xaa, 0xdd, 0xa8, 0x58, 0xfe, 0x23, 0xb1, 0xa7, 0x1d,
+	0x60, 0xfc, 0xb9, 0x1e, 0x38, 0x1a, 0xbb, 0xa5, 0x8e, 0xb6, 0xf7, 0xa3, 0xfb, 0xc0, 0xe3, 0x3a,
+	0xec, 0x74, 0x5b, 0x56, 0xb5, 0x6a, 0xb6, 0x96, 0xf3, 0xde, 0x44, 0x86, 0x80, 0xd9, 0x0f, 0x3d,
+	0x8e, 0x7b, 0x07, 0xe9, 0xf4, 0x7a, 0x9f, 0xba, 0x9c, 0xfd, 0x0b, 0x83, 0xdf, 0x7f, 0x77, 0x9c,
+	0x15, 0x95, 0xd8, 0x1f, 0x91, 0xf7, 0x20, 0x3b, 0x04, 0xef, 0x41, 0xfa, 0x3b, 0xef, 0x41, 0x3e,
+	0xa6, 0x84, 0xde, 0xd2, 0xa5, 0x1a, 0x05, 0x8f, 0x01, 0x0d, 0xbf, 0x4e, 0x38, 0x2f, 0xfb, 0x3a,
+	0x21, 0x4a, 0x92, 0xbf, 0x51, 0x60, 0x57, 0x65, 0xec, 0xad, 0x7e, 0xae, 0xe7, 0x94, 0xda, 0xef,
+	0xb2, 0x13, 0xac, 0x04, 0x38, 0xb2, 0x7c, 0x09, 0x7a, 0x17, 0x2c, 0xbe, 0x58, 0x9c, 0x4c, 0x67,
+	0x38, 0xf0, 0x88, 0x82, 0xa2, 0x3c, 0xf4, 0xbc, 0x73, 0x9f, 0x05, 0x68, 0xe6, 0x40, 0x7b, 0xa8,
+	0x84, 0xf7, 0x8b, 0xec, 0x00, 0x3c, 0x7c, 0x65, 0x9a, 0x4f, 0xe8, 0x77, 0x98, 0xfb, 0x28, 0x24,
+	0xf2, 0x43, 0x29, 0xfa, 0xb7, 0x14, 0xd8, 0x1d, 0xab, 0xfd, 0x4c, 0xc7, 0x7f, 0x78, 0xc4, 0xf4,
+	0x44, 0x47, 0x4c, 0x6c, 0x0a, 0xec, 0x4d, 0xb8, 0xce, 0xbb, 0x8d, 0x63, 0x08, 0x2f, 0xa4, 0x5d,
+	0xbb, 0x6e, 0x95, 0xaf, 0x3d, 0x32, 0xcb, 0x6d, 0xcf, 0xd8, 0x97, 0x4c, 0xf3, 0x76, 0xbb, 0xe6,
+	0x5a, 0xcd, 0x9a, 0x65, 0xb6, 0xa4, 0xfa, 0x76, 0x03, 0x8a, 0xd2, 0xe4, 0xf8, 0x2d, 0x3d, 0xd4,
+	0x79, 0x69, 0x1e, 0x35, 0x06, 0x60, 0xda, 0x79, 0x16, 0x8a, 0x4d, 0x7b, 0xf8, 0x9e, 0x6b, 0xdc,
+	0x37, 0xaf, 0xb7, 0x8c, 0xce, 0x23, 0xcd, 0x31, 0xd8, 0x51, 0xf5, 0x7e, 0x9b, 0x26, 0x3b, 0xf7,
+	0xc1, 0x9f, 0xda, 0x6f, 0xf2, 0x48, 0xeb, 0x18, 0x14, 0x19, 0x3c, 0x0f, 0xdb, 0x69, 0x65, 0x3c,
+	0xdf, 0x10, 0xad, 0xa9, 0x3e, 0xde, 0x87, 0xfa, 0x00, 0x72, 0x07, 0x3a, 0xb7, 0x6c, 0xba, 0x4f,
+	0x23, 0x3d, 0x70, 0x8b, 0x5f, 0x94, 0xf9, 0x64, 0x86, 0xcc, 0xd0, 0x6f, 0x6d, 0x05, 0x67, 0x0b,
+	0xfa, 0x6b, 0xbe, 0xed, 0xae, 0xdb, 0x2d, 0xeb, 0x27, 0xe9, 0x23, 0xce, 0x98, 0x9c, 0xad, 0xb0,
+	0x9c, 0xad, 0xa0, 0x06, 0x0a, 0x61, 0x0d, 0x7c, 0x10, 0xc6, 0x85, 0x54, 0x51, 0x05, 0x33, 0xd0,
+	0x87, 0x6f, 0x53, 0xfd, 0xfe, 0x39, 0x84, 0xfd, 0xb3, 0x2f, 0xde, 0x3f, 0x37, 0x1b, 0x6e, 0x09,
+	0x2b, 0x6b, 0x73, 0x42, 0xca, 0x4e, 0x26, 0xc3, 0xda, 0x17, 0xd8, 0x59, 0x54, 0x22, 0x1a, 0x19,
+	0xfb, 0x00, 0x10, 0xff, 0x38, 0x95, 0xa2, 0xf4, 0x3c, 0x4c, 0x8e, 0x50, 0xa0, 0x4f, 0x9c, 0xc2,
+	0xbc, 0xd0, 0x70, 0x4a, 0xc6, 0xc9, 0x38, 0xee, 0x4e, 0x50, 0x14, 0x02, 0xb5, 0xf3, 0x70, 0x20,
+	0x60, 0xff, 0xb8, 0x43, 0x95, 0x1a, 0x39, 0x6f, 0x83, 0x9a, 0x84, 0x44, 0x39, 0xaf, 0xc0, 0x0e,
+	0xdc, 0xf9, 0xa2, 0x15, 0x1e, 0x4b, 0x0d, 0x4d, 0x66, 0x70, 0x06, 0xe2, 0x69, 0x3c, 0x42, 0x9f,
+	0x03, 0x97, 0x43, 0xcf, 0x25, 0x7e, 0x15, 0x26, 0x75, 0x90, 0x6b, 0x9d, 0xa3, 0xb4, 0xfb, 0xb0,
+	0x2b, 0xf4, 0x29, 0xdd, 0xcd, 0xbf, 0xdc, 0x11, 0x36, 0xc7, 0xbc, 0xc8, 0x65, 0x9d, 0xe6, 0x8f,
+	0x8a, 0x1a, 0x76, 0xfd, 0xb6, 0xd5, 0x60, 0x51, 0x04, 0xe9, 0xb1, 0xf8, 0x6f, 0xc3, 0x21, 0x01,
+	0xaa, 0x93, 0xcc, 0x24, 0x64, 0x5e, 0x72, 0xde, 0x16, 0x8e, 0x84, 0x71, 0x01, 0x75, 0xde, 0x05,
+	0x9b, 0x70, 0x58, 0x54, 0x01, 0xdb, 0x7f, 0x0b, 0xf6, 0xf8, 0xc1, 0xf5, 0x75, 0xab, 0xc1, 0xa3,
+	0x2b, 0x58, 0x87, 0x9c, 0x48, 0x8b, 0xb0, 0x0f, 0x4a, 0xb3, 0xbb, 0x12, 0x6d, 0x40, 0xfb, 0x28,
+	0xec, 0xbc, 0xdb, 0x34, 0x1b, 0x37, 0xbd, 0x51, 0x97, 0xb9, 0x05, 0xdb, 0x62, 0xdf, 0xcc, 0xe2,
+	0xb5, 0x67, 0xb0, 0x41, 0xa9, 0xe1, 0xf1, 0x41, 0x38, 0x90, 0x00, 0x4c, 0xec, 0x1a, 0xf1, 0x96,
+	0x3a, 0x04, 0x8e, 0x75, 0x8d, 0xfd, 0xb0, 0xe1, 0x5a, 0x75, 0x6f, 0xaa, 0x2d, 0x9b, 0xb7, 0xed,
+	0x0a, 0x3f, 0x14, 0xff, 0x65, 0xe6, 0xa4, 0x25, 0xd4, 0x40, 0x06, 0xae, 0x03, 0x54, 0xbd, 0x42,
+	0xbd, 0x6e, 0x57, 0xb2, 0x1e, 0x43, 0xc4, 0xa9, 0x0c, 0x54, 0xd9, 0x9f, 0xde, 0x56, 0x7a, 0xb5,
+	0x66, 0x97, 0xef, 0x3b, 0x7a, 0xcb, 0xac, 0x1b, 0x56, 0xc3, 0x6a, 0x54, 0xf1, 0x0a, 0x74, 0xd8,
+	0x2f, 0x2f, 0xb1, 0xe2, 0x17, 0xa7, 0x61, 0x80, 0x87, 0x80, 0x93, 0xbd, 0x30, 0xe2, 0xfd, 0xab,
+	0xbf, 0xd1, 0x70, 0x9a, 0x66, 0xd9, 0x5a, 0xb3, 0xcc, 0xca, 0xc8, 0x36, 0xb2, 0x03, 0x7a, 0x16,
+	0xda, 0x9b, 0x23, 0x0a, 0xe9, 0x87, 0x5e, 0x2f, 0x08, 0x68, 0xa4, 0xf0, 0xe2, 0x9b, 0xb0, 0x37,
+	0xe9, 0xf1, 0xbf, 0x47, 0x20, 0x80, 0xa5, 0x84, 0x47, 0xb6, 0x91, 0x3d, 0x30, 0xec, 0x1d, 0xa7,
+	0xbc, 0x65, 0xb7, 0x1c, 0x77, 0xc5, 0x5e, 0x30, 0x1d, 0x77, 0x44, 0x61, 0x85, 0xde, 0xaf, 0x15,
+	0x9b, 0x7e, 0x1a, 0x29, 0x4c, 0x7d, 0xba, 0x0e, 0xdb, 0xa9, 0x92, 0xc8, 0xb7, 0x14, 0xd8, 0x77,
+	0xeb, 0x6c, 0xc4, 0xaf, 0x5b, 0xb0, 0xed, 0xfb, 0xe4, 0x62, 0x5a, 0x2a, 0x9c, 0x74, 0x8f, 0x52,
+	0x9d, 0xeb, 0x0a, 0xeb, 0x77, 0x8f, 0x36, 0xff, 0xb1, 0x6f, 0xfd, 0xcb, 0x2f, 0x15, 0xe6, 0xc8,
+	0x85, 0x62, 0x72, 0xce, 0xac, 0xce, 0x51, 0x57, 0xf1, 0xd6, 0x59, 0xce, 0x6f, 0xf1, 0x31, 0x37,
+	0xc7, 0x27, 0xe4, 0x4b, 0x0a, 0x0c, 0xdf, 0x3a, 0xcb, 0x5d, 0x74, 0x2a, 0xcf, 0x74, 0x16, 0x4f,
+	0x49, 0x1b, 0x02, 0x75, 0x26, 0x27, 0x0a, 0x65, 0x98, 0xa3, 0x32, 0xcc, 0x90, 0xb3, 0x02, 0x19,
+	0xbc, 0x13, 0x38, 0x21, 0xf7, 0xbf, 0xad, 0xc0, 0x9e, 0x84, 0x44, 0x4d, 0xe4, 0x4c, 0x1a, 0x2f,
+	0x89, 0x29, 0x9f, 0xd4, 0xa9, 0x3c, 0x10, 0xe4, 0xfd, 0x14, 0xe5, 0xfd, 0x04, 0x39, 0x5e, 0x4c,
+	0x4f, 0x92, 0x86, 0x5c, 0xfd, 0x89, 0x02, 0x24, 0x9e, 0x19, 0x89, 0xcc, 0xe4, 0xcd, 0xa4, 0xe4,
+	0x33, 0x7c, 0xae, 0xbb, 0x04, 0x4c, 0xda, 0x45, 0xca, 0xf4, 0x34, 0x99, 0xca, 0x60, 0xba, 0xe8,
+	0xc4, 0x59, 0xfd, 0x92, 0x02, 0xbb, 0x63, 0xa4, 0xd3, 0xed, 0x45, 0x94, 0x35, 0x44, 0x9d, 0xc9,
+	0x89, 0x42, 0xf6, 0x2f, 0x50, 0xf6, 0xcf, 0x92, 0x33, 0xb9, 0xd9, 0x27, 0x5f, 0x54, 0x60, 0x24,
+	0x9a, 0xe1, 0x89, 0x9c, 0x95, 0xe9, 0xf7, 0x88, 0x63, 0xa1, 0x4e, 0xe7, 0x03, 0x21, 0xeb, 0xe7,
+	0x29, 0xeb, 0x53, 0xe4, 0x74, 0x16, 0xeb, 0x66, 0x94, 0xc9, 0x3f, 0x56, 0x60, 0x38, 0x92, 0x31,
+	0x89, 0xa4, 0x1a, 0x6c, 0x72, 0x96, 0x29, 0xf5, 0x6c, 0x2e, 0x8c, 0xe4, 0x2c, 0xc3, 0xff, 0x8e,
+	0x24, 0x92, 0x2a, 0x3e, 0x46, 0xfd, 0x3f, 0xa1, 0x9a, 0x8f, 0x90, 0xcf, 0xd0, 0xbc, 0x20, 0xf7,
+	0x94, 0x3a, 0x9d, 0x0f, 0x94, 0x57, 0xf3, 0x46, 0x94, 0xc9, 0x6f, 0x2b, 0xb0, 0x2f, 0x31, 0xbd,
+	0x0e, 0x39, 0x2f, 0xc5, 0x49, 0x42, 0xb6, 0x27, 0xf5, 0x42, 0x17, 0x48, 0x14, 0xe4, 0x26, 0x15,
+	0xe4, 0x2a, 0x99, 0x97, 0x16, 0x24, 0x48, 0x26, 0x34, 0x77, 0xfe, 0x8d, 0x02, 0xa3, 0x89, 0x8d,
+	0x39, 0x24, 0x3f, 0x83, 0xbc, 0x7f, 0x2e, 0x76, 0x03, 0x45, 0xe1, 0xae, 0x50, 0xe1, 0xce, 0x93,
+	0x73, 0x5d, 0x09, 0xe7, 0x90, 0x9f, 0x2f, 0xc0, 0x84, 0x44, 0x5e, 0x27, 0xb2, 0x94, 0xca, 0xa3,
+	0x74, 0xf2, 0x2b, 0xf5, 0xfa, 0x96, 0xe9, 0xa0, 0xe0, 0x6f, 0x51, 0xc1, 0x5f, 0x27, 0x77, 0x33,
+	0x05, 0xf7, 0x89, 0xea, 0xac, 0x40, 0x77, 0x91, 0xac, 0x1e, 0xca, 0x4f, 0x55, 0x7c, 0x4c, 0x7f,
+	0x3e, 0x21, 0x9f, 0x2a, 0xc0, 0x31, 0x09, 0x46, 0x1c, 0xb2, 0x55, 0x51, 0x78, 0xff, 0xdf, 0xd8,
+	0x3a, 0x21, 0x54, 0xca, 0x32, 0x55, 0xca, 0x6b, 0xe4, 0xc6, 0x53, 0x52, 0x8a, 0x43, 0x3e, 0xab,
+	0xc0, 0x60, 0x20, 0x0d, 0x0b, 0x99, 0x4c, 0x5d, 0x81, 0x62, 0x99, 0x63, 0xd4, 0xa2, 0x74, 0x7d,
+	0x14, 0xe1, 0x25, 0x2a, 0xc2, 0x71, 0x32, 0x91, 0xe6, 0xdb, 0xe0, 0x55, 0x00, 0xf9, 0x35, 0x05,
+	0xa0, 0x43, 0x84, 0x9c, 0x92, 0x6b, 0x8c, 0xf1, 0x36, 0x29, 0x5b, 0x1d, 0x59, 0x9b, 0xa5, 0xac,
+	0x9d, 0x21, 0x45, 0x09, 0xd6, 0x42, 0xd3, 0xc6, 0xef, 0x28, 0x30, 0x1c, 0xc9, 0x67, 0x93, 0xbe,
+	0x14, 0x25, 0xa7, 0xe1, 0x51, 0xcf, 0xe6, 0xc2, 0x20, 0xd7, 0xa7, 0x29, 0xd7, 0x2f, 0x92, 0x93,
+	0x69, 0x5c, 0xaf, 0xb5, 0x6b, 0x35, 0x9d, 0x69, 0xf5, 0x9d, 0x78, 0xce, 0xa2, 0x33, 0xf2, 0x2d,
+	0x4b, 0x39, 0x87, 0xc9, 0xd9, 0x70, 0xe4, 0x1c, 0xdb, 0x00, 0xaf, 0x21, 0x2d, 0xff, 0x9e, 0x02,
+	0xbb, 0x42, 0xfe, 0x32, 0x39, 0x9d, 0xd5, 0xc1, 0x31, 0x87, 0xfc, 0x4c, 0x0e, 0x84, 0xa4, 0x73,
+	0x45, 0x79, 0xe6, 0x49, 0x7d, 0x43, 0x1c, 0x7f, 0x55, 0x81, 0x91, 0x68, 0xf0, 0x7f, 0xfa, 0x12,
+	0x2f, 0x48, 0x7b, 0xa3, 0x4e, 0xe7, 0x03, 0x21, 0xeb, 0x4b, 0x94, 0xf5, 0x57, 0xc9, 0x95, 0x4c,
+	0xd6, 0x43, 0xf6, 0x5c, 0x7c, 0x1c, 0x3a, 0x4b, 0x7e, 0x42, 0xfe, 0x55, 0x81, 0x31, 0x51, 0xee,
+	0x14, 0x92, 0xba, 0x5b, 0xcb, 0x48, 0xb2, 0xa3, 0x5e, 0xea, 0x0e, 0x2c, 0x39, 0x1d, 0x8a, 0xe4,
+	0x43, 0xd9, 0xb8, 0x33, 0xc6, 0xde, 0x1c, 0x3c, 0x21, 0x7f, 0xe7, 0x6d, 0x47, 0x62, 0x39, 0x8e,
+	0x32, 0xb6, 0x23, 0xa2, 0xd4, 0x4c, 0xea, 0xb9, 0xbc, 0xb0, 0xfc, 0x72, 0xe9, 0xab, 0x9b, 0x18,
+	0xca, 0x9b, 0xda, 0x83, 0xef, 0x28, 0x30, 0x12, 0x4d, 0x9e, 0x9c, 0x6e, 0x89, 0x82, 0x6c, 0xce,
+	0xea, 0x74, 0x3e, 0x10, 0x4a, 0x34, 0x43, 0x25, 0x2a, 0x92, 0x53, 0xc5, 0x94, 0xa4, 0xd8, 0x4e,
+	0x8c, 0xed, 0xef, 0x28, 0x70, 0xa0, 0x63, 0xdd, 0x74, 0x6d, 0xb4, 0xcc, 0xc6, 0x33, 0x18, 0x49,
+	0x52, 0x3d, 0xe2, 0x32, 0xfe, 0x74, 0x89, 0x31, 0xf5, 0x35, 0xb4, 0xb4, 0x70, 0xb0, 0x66, 0xb6,
+	0xa5, 0x25, 0x66, 0xc3, 0x51, 0xcf, 0xe5, 0x85, 0x49, 0xee, 0x63, 0xfc, 0x25, 0x2f, 0x1a, 0x7f,
+	0x1a, 0x9a, 0xe4, 0xde, 0x55, 0x60, 0x4c, 0x94, 0x66, 0x27, 0x7d, 0x72, 0xc8, 0x48, 0xf1, 0xa3,
+	0x5e, 0xea, 0x0e, 0x8c, 0xa2, 0x5d, 0xa7, 0xa2, 0xcd, 0x93, 0x57, 0xb2, 0x0f, 0x82, 0xd2, 0x05,
+	0xfc, 0x4b, 0x05, 0xf6, 0x24, 0x9c, 0x38, 0x91, 0x73, 0x72, 0xec, 0xc5, 0xd6, 0xa0, 0xd9, 0xdc,
+	0x38, 0x94, 0xe8, 0x15, 0x2a, 0xd1, 0x05, 0x32, 0x9b, 0x2d, 0x51, 0xf2, 0x7a, 0xf4, 0x8f, 0x0a,
+	0x8c, 0x26, 0x67, 0x54, 0x48, 0xdf, 0xde, 0xa4, 0x66, 0xf3, 0x50, 0x2f, 0x76, 0x03, 0x45, 0x91,
+	0x6e, 0x51, 0x91, 0x96, 0xc8, 0xa2, 0xa4, 0x48, 0xe9, 0x63, 0xea, 0x7f, 0x14, 0x38, 0x9c, 0x9e,
+	0xbe, 0x81, 0xcc, 0xcb, 0x2f, 0x38, 0x22, 0x79, 0x17, 0xb6, 0x42, 0x02, 0xe5, 0x7e, 0x93, 0xca,
+	0xbd, 0x4c, 0xee, 0x74, 0x25, 0xb7, 0x78, 0xfd, 0xfa, 0xf7, 0xd0, 0x60, 0x8c, 0xac, 0x62, 0x73,
+	0x39, 0x0c, 0x2f, 0xb6, 0x96, 0x5d, 0xea, 0x0e, 0xdc, 0xad, 0xbc, 0x92, 0xeb, 0xda, 0x7f, 0x2a,
+	0x30, 0x1e, 0x35, 0xb1, 0xe8, 0x32, 0xf1, 0x8c, 0x4c, 0x3b, 0x87, 0xc8, 0xb9, 0x16, 0x8e, 0xdf,
+	0x57, 0x60, 0x77, 0x2c, 0x58, 0x3f, 0xfd, 0xbc, 0x51, 0x94, 0x53, 0x43, 0x9d, 0xc9, 0x89, 0x42,
+	0xd1, 0xce, 0x50, 0xd1, 0x5e, 0x22, 0x2f, 0x48, 0x4c, 0xad, 0xc8, 0xdf, 0x97, 0x15, 0x18, 0x89,
+	0x12, 0x4c, 0x5f, 0xc0, 0x05, 0x79, 0x02, 0xd4, 0xe9, 0x7c, 0x20, 0x64, 0xf9, 0x32, 0x65, 0x79,
+	0x96, 0xcc, 0x48, 0xb3, 0x1c, 0x9a, 0x39, 0xbf, 0xa3, 0xc0, 0x7e, 0x41, 0x44, 0x7f, 0xfa, 0x55,
+	0x47, 0x7a, 0x2a, 0x01, 0x75, 0xae, 0x2b, 0x2c, 0xca, 0xb4, 0x48, 0x65, 0xba, 0x42, 0x2e, 0xc9,
+	0xca, 0xc4, 0xe6, 0x89, 0x90, 0x68, 0x7f, 0xa6, 0xc0, 0xde, 0xa4, 0x78, 0x47, 0x32, 0x2b, 0xe7,
+	0xe9, 0xc5, 0xf2, 0x0e, 0xa8, 0xe7, 0xf3, 0x03, 0x25, 0x77, 0xe0, 0xfc, 0xef, 0xe8, 0xa0, 0xf8,
+	0xbc, 0x02, 0x7b, 0xd8, 0x11, 0x4a, 0x20, 0xcc, 0x32, 0xfd, 0x38, 0x23, 0x1e, 0xaa, 0xa9, 0x16,
+	0xa5, 0xeb, 0x4b, 0x1e, 0x67, 0xd4, 0x29, 0x46, 0xa7, 0x71, 0x93, 0xe4, 0x17, 0x15, 0x18, 0xe0,
+	0x41, 0x99, 0xe4, 0xe5, 0xb4, 0xb6, 0xa2, 0x41, 0x9d, 0xea, 0x29, 0xc9, 0xda, 0xc8, 0xd7, 0x49,
+	0xca, 0x97, 0x46, 0x8e, 0x08, 0xf8, 0x6a, 0x72, 0x36, 0xbe, 0xa2, 0xc0, 0xee, 0x58, 0xfa, 0x86,
+	0xf4, 0xf9, 0x44, 0x94, 0x2b, 0x42, 0x9d, 0xc9, 0x89, 0x92, 0x3c, 0xe4, 0xe4, 0xcc, 0xea, 0x56,
+	0x23, 0xe9, 0x64, 0xe0, 0xaf, 0x14, 0xd8, 0x93, 0x90, 0xa3, 0x80, 0x48, 0x5e, 0x07, 0xc5, 0x74,
+	0x3d, 0x9b, 0x1b, 0x87, 0x82, 0x5c, 0xa5, 0x82, 0x5c, 0x26, 0x73, 0x22, 0x77, 0xba, 0x63, 0xb5,
+	0x5c, 0xa6, 0x98, 0x2d, 0xff, 0xb3, 0x02, 0xaa, 0x38, 0x0d, 0x02, 0xb9, 0x9c, 0x8f, 0xb9, 0x68,
+	0x17, 0x5d, 0xe9, 0x16, 0x2e, 0x39, 0xe9, 0x08, 0xe5, 0x0a, 0xf5, 0xd8, 0xc7, 0x0b, 0x30, 0x21,
+	0x91, 0x7c, 0x20, 0xfd, 0x58, 0x5a, 0x3e, 0xaf, 0x86, 0x7a, 0x7d, 0xcb, 0x74, 0x50, 0xfc, 0x3b,
+	0x54, 0xfc, 0x1b, 0x64, 0x49, 0x20, 0x7e, 0xe7, 0x0d, 0x98, 0x9c, 0x22, 0xbe, 0xae, 0xc0, 0x9e,
+	0x84, 0x4c, 0x04, 0xe9, 0xa6, 0x2b, 0x4e, 0x9e, 0xa0, 0xce, 0xe6, 0xc6, 0xa1, 0x60, 0xaf, 0x52,
+	0xc1, 0x2e, 0x92, 0xf3, 0xa2, 0x7e, 0x65, 0x58, 0x3d, 0x90, 0x76, 0x2a, 0x24, 0xca, 0x37, 0x15,
+	0xd8, 0x2f, 0x48, 0x51, 0x90, 0xbe, 0x46, 0xa6, 0x67, 0x58, 0x50, 0xe7, 0xba, 0xc2, 0x4a, 0xae,
+	0xfb, 0x26, 0xc5, 0x0b, 0x65, 0xfa, 0x5b, 0x05, 0x46, 0x93, 0x33, 0x18, 0xa4, 0xbb, 0x95, 0xa9,
+	0xe9, 0x17, 0xd4, 0x8b, 0xdd, 0x40, 0x25, 0xa7, 0x98, 0x58, 0x3f, 0x61, 0x56, 0xae, 0x58, 0x57,
+	0x09, 0x72, 0x2d, 0xa4, 0x77, 0x55, 0x7a, 0x56, 0x19, 0x75, 0xae, 0x2b, 0xac, 0x64, 0x57, 0xf9,
+	0xef, 0x5f, 0x59, 0x24, 0x45, 0xd2, 0x11, 0xd7, 0xee, 0x78, 0x60, 0x78, 0xf6, 0x71, 0x4f, 0x42,
+	0xfe, 0x02, 0x75, 0x26, 0x27, 0x0a, 0x25, 0x98, 0xa2, 0x12, 0xbc, 0x4c, 0x5e, 0x14, 0x48, 0x90,
+	0x10, 0x07, 0x4e, 0xfe, 0x5c, 0x81, 0xb1, 0xe5, 0x4e, 0x64, 0xf9, 0x33, 0xe4, 0x3e, 0xeb, 0x11,
+	0x44, 0x30, 0xbe, 0x3e, 0x2a, 0xc5, 0x97, 0x59, 0x94, 0x52, 0x38, 0xf1, 0x40, 0xfa, 0x34, 0x26,
+	0x4e, 0xa2, 0xa0, 0xce, 0xe6, 0xc6, 0xa1, 0x10, 0xd3, 0x54, 0x88, 0x49, 0xf2, 0xb2, 0x4c, 0x17,
+	0xb0, 0xac, 0x00, 0xde, 0x3b, 0xa6, 0xd1, 0xe4, 0x58, 0xf0, 0xf4, 0x61, 0x9e, 0x1a, 0x80, 0xae,
+	0x5e, 0xec, 0x06, 0x8a, 0x72, 0x2c, 0x50, 0x39, 0x2e, 0x91, 0x8b, 0x02, 0x39, 0x42, 0x51, 0xd9,
+	0xc1, 0x28, 0xf4, 0xc0, 0x0b, 0x03, 0xaf, 0x53, 0x12, 0x22, 0xb1, 0xd3, 0x3b, 0x45, 0x1c, 0x31,
+	0xae, 0xce, 0xe6, 0xc6, 0x49, 0x76, 0x4a, 0x62, 0x88, 0x39, 0xf9, 0x53, 0x05, 0x76, 0xc7, 0x02,
+	0x86, 0xd3, 0x87, 0x84, 0x28, 0x8c, 0x5c, 0x9d, 0xc9, 0x89, 0x92, 0x3c, 0x71, 0x8b, 0x87, 0x2c,
+	0x17, 0x1f, 0x07, 0xc2, 0xd5, 0x9f, 0x90, 0xbf, 0x56, 0x60, 0xbf, 0x20, 0x72, 0x36, 0x7d, 0xa2,
+	0x4d, 0x0f, 0x58, 0x4e, 0x9f, 0x68, 0x33, 0x42, 0x75, 0x33, 0x07, 0x3a, 0x4a, 0xe5, 0x24, 0x04,
+	0xf2, 0x92, 0x6f, 0x28, 0x70, 0x40, 0x18, 0x13, 0x4b, 0x2e, 0x49, 0x5a, 0x48, 0x62, 0xb8, 0xae,
+	0x7a, 0xb9, 0x4b, 0x34, 0x8a, 0x75, 0x8e, 0x8a, 0x75, 0x9a, 0x4c, 0xca, 0x58, 0x19, 0xcd, 0xeb,
+	0xe0, 0xb8, 0x86, 0xeb, 0x90, 0xcf, 0x29, 0x30, 0x14, 0x8e, 0xb0, 0x15, 0x6e, 0xcd, 0x12, 0x43,
+	0x74, 0xd5, 0x53, 0x92, 0xb5, 0x91, 0xcf, 0x22, 0xe5, 0xf3, 0x05, 0x72, 0x42, 0xb4, 0x65, 0xb4,
+	0x5c, 0x5b, 0xf7, 0x63, 0x61, 0x2d, 0xca, 0xcd, 0x57, 0x15, 0x4c, 0xe8, 0x13, 0x0b, 0x7b, 0x4d,
+	0x1f, 0x0d, 0xa2, 0x58, 0x5b, 0x75, 0x26, 0x27, 0x4a, 0x72, 0x9b, 0xe6, 0xf3, 0xcc, 0xdd, 0x8c,
+	0xe2, 0xe3, 0x50, 0x40, 0x2f, 0xf5, 0x75, 0x47, 0x93, 0xe3, 0x66, 0xd3, 0x67, 0xd9, 0xd4, 0x70,
+	0x5d, 0xf5, 0x62, 0x37, 0x50, 0xc9, 0xf3, 0x86, 0x75, 0x0e, 0xd7, 0x43, 0xe1, 0xbc, 0xd4, 0x6d,
+	0x4f, 0x48, 0x99, 0x92, 0x3e, 0xb5, 0x8a, 0x13, 0xb4, 0xa8, 0xb3, 0xb9, 0x71, 0x92, 0x6e, 0x7b,
+	0x30, 0x71, 0x8b, 0x6e, 0xaf, 0xe1, 0xca, 0xe7, 0x04, 0x56, 0x89, 0xbf, 0x57, 0xe0, 0x80, 0x30,
+	0x1d, 0x4b, 0xfa, 0x88, 0xce, 0x4a, 0xf7, 0xa2, 0x5e, 0xee, 0x12, 0x8d, 0xc2, 0x5d, 0xa2, 0xc2,
+	0x9d, 0x23, 0xd3, 0x22, 0x8f, 0x30, 0x41, 0x32, 0x9d, 0x27, 0x9a, 0xfa, 0x92, 0x02, 0x23, 0xd1,
+	0xe0, 0xde, 0xf4, 0x23, 0x47, 0x41, 0xa0, 0xb2, 0x3a, 0x9d, 0x0f, 0x24, 0xc9, 0x7d, 0xe7, 0xff,
+	0x55, 0x44, 0x64, 0xc8, 0x45, 0xff, 0x82, 0x02, 0x7b, 0x13, 0xc2, 0x64, 0x9d, 0xf4, 0x47, 0x0f,
+	0x49, 0xc1, 0xbc, 0xea, 0x99, 0x1c, 0x08, 0xc9, 0xfb, 0xda, 0x55, 0x8a, 0x62, 0x31, 0xd9, 0xfc,
+	0x94, 0xf7, 0x93, 0x05, 0x38, 0x1a, 0x3d, 0x16, 0x8f, 0x45, 0x68, 0x92, 0xc5, 0x3c, 0xa7, 0xea,
+	0xa2, 0xa8, 0x5f, 0xf5, 0xda, 0x16, 0xa9, 0xa0, 0xa4, 0x1f, 0xa4, 0x92, 0x96, 0xc8, 0xb2, 0xf4,
+	0x4d, 0x4c, 0xb9, 0x43, 0x2b, 0xf5, 0xa0, 0xfe, 0x07, 0x0a, 0x68, 0xd9, 0xb1, 0x71, 0xe4, 0x5a,
+	0xb6, 0x71, 0x49, 0x84, 0xea, 0xa9, 0x4b, 0x5b, 0x25, 0x23, 0xe9, 0x1c, 0x18, 0x94, 0x88, 0x7f,
+	0x51, 0xa1, 0x7b, 0x4b, 0x6a, 0x27, 0x32, 0x8f, 0xfc, 0x91, 0xf7, 0xa4, 0x35, 0x12, 0x5a, 0x97,
+	0xf1, 0xa4, 0x35, 0x39, 0x86, 0x4f, 0x9d, 0xce, 0x07, 0x92, 0x7c, 0x5e, 0x64, 0xd0, 0x22, 0x6f,
+	0xd9, 0xbf, 0x8f, 0x31, 0x7a, 0xc5, 0xc7, 0xf4, 0x1f, 0xd3, 0xa4, 0x87, 0x88, 0x24, 0x1e, 0xed,
+	0x95, 0x7e, 0x21, 0x2f, 0x0c, 0xce, 0x53, 0xcf, 0xe5, 0x85, 0x49, 0x3e, 0xd9, 0xc1, 0xd8, 0xb7,
+	0x20, 0x96, 0xc9, 0xd0, 0x7a, 0x12, 0x90, 0xe6, 0xab, 0x0a, 0xec, 0x89, 0x37, 0x93, 0xb1, 0x40,
+	0x89, 0x63, 0xf7, 0xd4, 0xd9, 0xdc, 0x38, 0xc9, 0x5d, 0x7d, 0x82, 0x40, 0x4e, 0x47, 0x22, 0xfa,
+	0xe8, 0x2b, 0x1c, 0x4e, 0x76, 0x3a, 0xdb, 0xd0, 0xc3, 0xb1, 0x78, 0xea, 0x99, 0x1c, 0x08, 0xc9,
+	0x47, 0x5f, 0x38, 0xec, 0xd1, 0x37, 0x0e, 0x4d, 0xdc, 0xbf, 0xe1, 0xb9, 0x93, 0x41, 0xa2, 0x19,
+	0xa1, 0x17, 0x89, 0x61, 0x7a, 0xea, 0x54, 0x1e, 0x08, 0x32, 0x3d, 0x49, 0x99, 0x3e, 0x49, 0x9e,
+	0x97, 0x62, 0xda, 0x21, 0x7f, 0x48, 0xef, 0xe4, 0xc2, 0x21, 0x62, 0x59, 0x77, 0x72, 0x89, 0x61,
+	0x76, 0xea, 0x74, 0x3e, 0x90, 0xa4, 0x92, 0xe3, 0x21, 0x70, 0xfc, 0x11, 0xef, 0x3b, 0xf4, 0x12,
+	0x34, 0x4c, 0x37, 0xf3, 0x12, 0x34, 0x39, 0x1c, 0x4f, 0x9d, 0xc9, 0x89, 0x92, 0x3c, 0xec, 0x89,
+	0x73, 0xef, 0x90, 0x5f, 0x51, 0x22, 0xf1, 0x77, 0xc5, 0x74, 0x07, 0x29, 0x16, 0x38, 0xa7, 0x9e,
+	0x96, 0x07, 0x20, 0x9f, 0x2f, 0x53, 0x3e, 0x9f, 0x27, 0xc7, 0x84, 0x4e, 0x94, 0xe9, 0x25, 0x9d,
+	0x44, 0x86, 0xa8, 0x62, 0xa3, 0x51, 0x6b, 0x19, 0x8a, 0x15, 0x04, 0xd3, 0xa9, 0x33, 0x39, 0x51,
+	0xb2, 0x8a, 0x45, 0xa4, 0xde, 0x09, 0xc3, 0x5b, 0xb8, 0xff, 0xf5, 0xef, 0x1d, 0x56, 0xbe, 0xf1,
+	0xbd, 0xc3, 0xca, 0x77, 0xbf, 0x77, 0x58, 0xf9, 0xf4, 0x7b, 0x87, 0xb7, 0x7d, 0xe3, 0xbd, 0xc3,
+	0xdb, 0xbe, 0xf3, 0xde, 0xe1, 0x6d, 0x1f, 0x7a, 0xbd, 0x6a, 0xb9, 0xeb, 0xed, 0xd5, 0xc9, 0xb2,
+	0x5d, 0x2f, 0xde, 0x64, 0xf4, 0x6e, 0x19, 0xab, 0x4e, 0x87, 0xfa, 0xa9, 0xb2, 0xdd, 0x32, 0x83,
+	0x3f, 0xd7, 0x0d, 0xab, 0x81, 0x37, 0x77, 0x4e, 0xa7, 0x69, 0x77, 0xb3, 0x69, 0x3a, 0xc5, 0x8d,
+	0xa9, 0xd5, 0xbe, 0x66, 0xcb, 0x76, 0xed, 0xb3, 0xff, 0x3b, 0x00, 0xad, 0xd7, 0x49, 0xd0, 0x75,
+	0x82, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -8319,6 +8414,8 @@ type QueryClient interface {
 	DenomMinNotionals(ctx context.Context, in *QueryDenomMinNotionalsRequest, opts ...grpc.CallOption) (*QueryDenomMinNotionalsResponse, error)
 	// Retrieves a market's open interest
 	OpenInterest(ctx context.Context, in *QueryOpenInterestRequest, opts ...grpc.CallOption) (*QueryOpenInterestResponse, error)
+	// Retrieves the grace mode the exchange entered after a chain downtime
+	DowntimeGraceMode(ctx context.Context, in *QueryDowntimeGraceModeRequest, opts ...grpc.CallOption) (*QueryDowntimeGraceModeResponse, error)
 }
 
 type queryClient struct {
@@ -8941,6 +9038,15 @@ func (c *queryClient) OpenInterest(ctx context.Context, in *QueryOpenInterestReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeGraceMode(ctx context.Context, in *QueryDowntimeGraceModeRequest, opts ...grpc.CallOption) (*QueryDowntimeGraceModeResponse, error) {
+	out := new(QueryDowntimeGraceModeResponse)
+	err := c.cc.Invoke(ctx, "/injective.exchange.v2.Query/DowntimeGraceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	L3DerivativeOrderBook(context.Context, *QueryFullDerivativeOrderbookRequest) (*QueryFullDerivativeOrderbookResponse, error)
@@ -9081,6 +9187,8 @@ type QueryServer interface {
 	DenomMinNotionals(context.Context, *QueryDenomMinNotionalsRequest) (*QueryDenomMinNotionalsResponse, error)
 	// Retrieves a market's open interest
 	OpenInterest(context.Context, *QueryOpenInterestRequest) (*QueryOpenInterestResponse, error)
+	// Retrieves the grace mode the exchange entered after a chain downtime
+	DowntimeGraceMode(context.Context, *QueryDowntimeGraceModeRequest) (*QueryDowntimeGraceModeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -9291,6 +9399,9 @@ func (*UnimplementedQueryServer) DenomMinNotionals(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) OpenInterest(ctx context.Context, req *QueryOpenInterestRequest) (*QueryOpenInterestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenInterest not implemented")
 }
+func (*UnimplementedQueryServer) DowntimeGraceMode(ctx context.Context, req *QueryDowntimeGraceModeRequest) (*QueryDowntimeGraceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeGraceMode not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -10520,6 +10631,24 @@ func _Query_OpenInterest_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeGraceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeGraceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeGraceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.exchange.v2.Query/DowntimeGraceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeGraceMode(ctx, req.(*QueryDowntimeGraceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.exchange.v2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -10796,6 +10925,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenInterest",
 			Handler:    _Query_OpenInterest_Handler,
 		},
+		{
+			MethodName: "DowntimeGraceMode",
+			Handler:    _Query_DowntimeGraceMode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/exchange/v2/query.proto",
@@ -16702,6 +16835,69 @@ func (m *QueryOpenInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimeGraceModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeGraceModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeGraceModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeGraceModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeGraceModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeGraceModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GraceMode != nil {
+		{
+			size, err := m.GraceMode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -19099,6 +19295,31 @@ func (m *QueryOpenInterestResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimeGraceModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDowntimeGraceModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GraceMode != nil {
+		l = m.GraceMode.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -34712,6 +34933,161 @@ func (m *QueryOpenInterestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeGraceModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeGraceModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeGraceModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeGraceModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeGraceModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeGraceModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceMode == nil {
+				m.GraceMode = &DowntimeGraceMode{}
+			}
+			if err := m.GraceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,10 +2,12 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
@@ -25,6 +27,10 @@ func GetQueryCmd() *cobra.Command {
 		GetInsuranceParamsCmd(),
 		GetEstimatedRedemptionsCmd(),
 		GetPendingRedemptionsCmd(),
+		GetSharedInsuranceFundMarketsCmd(),
+		GetMarketInsuranceFundCmd(),
+		GetInsuranceFundSnapshotsCmd(),
+		GetInsuranceFundAPYCmd(),
 	)
 	return cmd
 }
@@ -115,3 +121,136 @@ func GetPendingRedemptionsCmd() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetSharedInsuranceFundMarketsCmd queries the markets backed by a shared insurance fund
+func GetSharedInsuranceFundMarketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shared-insurance-fund-markets [fundId]",
+		Short: "Get the markets backed by a shared insurance fund.",
+		Long:  "Get the markets backed by a shared insurance fund. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySharedInsuranceFundMarketsRequest{
+				FundId: args[0],
+			}
+			res, err := queryClient.SharedInsuranceFundMarkets(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMarketInsuranceFundCmd queries the insurance fund backing a market
+func GetMarketInsuranceFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-insurance-fund [marketId]",
+		Short: "Get the insurance fund backing a market.",
+		Long:  "Get the insurance fund backing a market and the amount the market can draw from it. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMarketInsuranceFundRequest{
+				MarketId: args[0],
+			}
+			res, err := queryClient.MarketInsuranceFund(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetInsuranceFundSnapshotsCmd queries the historical snapshots of an insurance fund
+func GetInsuranceFundSnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-snapshots [marketId]",
+		Short: "Get the historical snapshots of an insurance fund.",
+		Long:  "Get the historical share price, balance and payouts of an insurance fund. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInsuranceFundSnapshotsRequest{
+				MarketId:   args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.InsuranceFundSnapshots(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "insurance-fund-snapshots")
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetInsuranceFundAPYCmd queries the annualized yield of an insurance fund share
+func GetInsuranceFundAPYCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-apy [marketId] [windows...]",
+		Short: "Get the annualized yield of an insurance fund share.",
+		Long:  "Get the annualized yield of an insurance fund share over windows in seconds, 7, 30 and 90 days by default. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			windows := make([]int64, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				window, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+				windows = append(windows, window)
+			}
+
+			req := &types.QueryInsuranceFundAPYRequest{
+				MarketId: args[0],
+				Windows:  windows,
+			}
+			res, err := queryClient.InsuranceFundAPY(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -25,6 +25,7 @@ func NewTxCmd() *cobra.Command {
 		NewCreateInsuranceFundTxCmd(),
 		NewUnderwriteInsuranceFundTxCmd(),
 		NewRequestRedemptionTxCmd(),
+		NewCreateSharedInsuranceFundTxCmd(),
 	)
 	return txCmd
 }
@@ -256,3 +257,59 @@ func NewRequestRedemptionTxCmd() *cobra.Command {
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCreateSharedInsuranceFundTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-shared-insurance-fund [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Create and broadcast a message to create a shared insurance fund",
+		Long: `Create and broadcast a message to create an insurance fund that can back multiple markets quoted in the deposit denom.
+		The markets backed by the fund are set by governance.
+
+		Example:
+		$ %s tx insurance create-shared-insurance-fund
+			--ticker="USDT pool"
+			--initial-deposit="1000usdt"
+			--from=genesis --keyring-backend=file --yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ticker, err := cmd.Flags().GetString(FlagTicker)
+			if err != nil {
+				return err
+			}
+
+			initialDepositStr, err := cmd.Flags().GetString(FlagInitialDeposit)
+			if err != nil {
+				return err
+			}
+
+			initialDeposit, err := sdk.ParseCoinNormalized(initialDepositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateSharedInsuranceFund{
+				Sender:         clientCtx.GetFromAddress().String(),
+				Ticker:         ticker,
+				InitialDeposit: initialDeposit,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTicker, "", "shared insurance fund ticker")
+	cmd.Flags().String(FlagInitialDeposit, "", "shared insurance fund initial deposit")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -16,6 +16,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, schedule := range data.RedemptionSchedule {
 		k.SetRedemptionSchedule(ctx, schedule)
 	}
+	for i := range data.SharedInsuranceFundMarkets {
+		k.SetSharedInsuranceFundMarket(ctx, &data.SharedInsuranceFundMarkets[i])
+	}
+	for i := range data.InsuranceFundSnapshots {
+		k.SetInsuranceFundSnapshot(ctx, &data.InsuranceFundSnapshots[i])
+	}
 	k.SetNextShareDenomId(ctx, data.NextShareDenomId)
 	k.SetNextRedemptionScheduleId(ctx, data.NextRedemptionScheduleId)
 
@@ -25,10 +31,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 // ExportGenesis export the state of module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:                   k.GetParams(ctx),
-		InsuranceFunds:           k.GetAllInsuranceFunds(ctx),
-		RedemptionSchedule:       k.GetAllInsuranceFundRedemptions(ctx),
-		NextShareDenomId:         k.ExportNextShareDenomId(ctx),
-		NextRedemptionScheduleId: k.ExportNextRedemptionScheduleId(ctx),
+		Params:                     k.GetParams(ctx),
+		InsuranceFunds:             k.GetAllInsuranceFunds(ctx),
+		RedemptionSchedule:         k.GetAllInsuranceFundRedemptions(ctx),
+		NextShareDenomId:           k.ExportNextShareDenomId(ctx),
+		NextRedemptionScheduleId:   k.ExportNextRedemptionScheduleId(ctx),
+		SharedInsuranceFundMarkets: k.GetAllSharedInsuranceFundMarkets(ctx),
+		InsuranceFundSnapshots:     k.GetAllInsuranceFundSnapshots(ctx),
 	}
 }
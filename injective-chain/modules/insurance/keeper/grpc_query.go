@@ -115,3 +115,73 @@ func (k *Keeper) InsuranceModuleState(c context.Context, req *types.QueryModuleS
 
 	return res, nil
 }
+
+// SharedInsuranceFundMarkets is grpc implementation to return the markets backed by a shared insurance fund
+func (k *Keeper) SharedInsuranceFundMarkets(c context.Context, request *types.QuerySharedInsuranceFundMarketsRequest) (*types.QuerySharedInsuranceFundMarketsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QuerySharedInsuranceFundMarketsResponse{
+		Markets: k.GetSharedInsuranceFundMarkets(ctx, common.HexToHash(request.FundId)),
+	}
+
+	return res, nil
+}
+
+// MarketInsuranceFund is grpc implementation to return the insurance fund backing a market
+func (k *Keeper) MarketInsuranceFund(c context.Context, request *types.QueryMarketInsuranceFundRequest) (*types.QueryMarketInsuranceFundResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	marketID := common.HexToHash(request.MarketId)
+
+	res := &types.QueryMarketInsuranceFundResponse{
+		Fund:             k.GetMarketInsuranceFund(ctx, marketID),
+		SharedMarket:     k.GetSharedInsuranceFundMarket(ctx, marketID),
+		AvailableBalance: k.GetAvailableInsuranceBalance(ctx, marketID),
+	}
+
+	return res, nil
+}
+
+// InsuranceFundSnapshots is grpc implementation to return the historical snapshots of an insurance fund
+func (k *Keeper) InsuranceFundSnapshots(c context.Context, request *types.QueryInsuranceFundSnapshotsRequest) (*types.QueryInsuranceFundSnapshotsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshots, pageRes, err := k.GetInsuranceFundSnapshotsPage(ctx, common.HexToHash(request.MarketId), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryInsuranceFundSnapshotsResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}
+
+	return res, nil
+}
+
+// InsuranceFundAPY is grpc implementation to return the annualized yield of an insurance fund share
+func (k *Keeper) InsuranceFundAPY(c context.Context, request *types.QueryInsuranceFundAPYRequest) (*types.QueryInsuranceFundAPYResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	apys, err := k.GetInsuranceFundAPY(ctx, common.HexToHash(request.MarketId), request.Windows)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryInsuranceFundAPYResponse{
+		Apys: apys,
+	}
+
+	return res, nil
+}
@@ -114,10 +114,11 @@ func (k *Keeper) globalRedemptionIterator(ctx sdk.Context) db.Iterator {
 }
 
 func (k *Keeper) getRedemptionAmountFromShare(ctx sdk.Context, marketID common.Hash, fund types.InsuranceFund, shareAmount math.Int) sdk.Coin {
-	marketBalance := k.exchangeKeeper.GetMarketBalance(ctx, marketID)
 	fundBalance := fund.Balance.ToLegacyDec()
 
-	if marketBalance.IsNegative() {
+	if fund.IsShared() {
+		fundBalance = fundBalance.Sub(k.getSharedInsuranceFundDeficit(ctx, marketID))
+	} else if marketBalance := k.exchangeKeeper.GetMarketBalance(ctx, marketID); marketBalance.IsNegative() {
 		fundBalance = fundBalance.Add(marketBalance)
 	}
 
@@ -185,14 +186,15 @@ func (k *Keeper) IterateInsuranceFunds(ctx sdk.Context, process func(*types.Insu
 	}
 }
 
-// HasInsuranceFund returns true if InsuranceFund for the given marketID exists.
+// HasInsuranceFund returns true if InsuranceFund for the given marketID exists or if the market is backed by a shared
+// insurance fund.
 func (k *Keeper) HasInsuranceFund(ctx sdk.Context, marketID common.Hash) bool {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
 	store := ctx.KVStore(k.storeKey)
 	fundStore := prefix.NewStore(store, types.InsuranceFundPrefixKey)
-	return fundStore.Has(marketID.Bytes())
+	return fundStore.Has(marketID.Bytes()) || store.Has(types.GetSharedInsuranceFundMarketKey(marketID))
 }
 
 // GetAllInsuranceFunds returns all of the Insurance Funds.
@@ -234,24 +236,24 @@ func (k *Keeper) GetInsuranceFund(ctx sdk.Context, marketID common.Hash) *types.
 	return &fund
 }
 
-// DepositIntoInsuranceFund increments the insurance fund balance by amount.
+// DepositIntoInsuranceFund increments the balance of the insurance fund backing the market by amount.
 func (k *Keeper) DepositIntoInsuranceFund(ctx sdk.Context, marketID common.Hash, amount math.Int) error {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
 	fund := k.GetInsuranceFund(ctx, marketID)
-
 	if fund == nil {
-		metrics.ReportFuncError(k.svcTags)
-		return types.ErrInsuranceFundNotFound
+		return k.depositIntoSharedInsuranceFund(ctx, marketID, amount)
 	}
 
 	fund.Balance = fund.Balance.Add(amount)
 	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, math.ZeroInt())
 	return nil
 }
 
-// WithdrawFromInsuranceFund decrements the insurance fund balance by amount and sends tokens from the insurance module to the exchange module.
+// WithdrawFromInsuranceFund decrements the balance of the insurance fund backing the market by amount and sends tokens
+// from the insurance module to the exchange module.
 func (k *Keeper) WithdrawFromInsuranceFund(ctx sdk.Context, marketID common.Hash, amount math.Int) error {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
@@ -259,8 +261,7 @@ func (k *Keeper) WithdrawFromInsuranceFund(ctx sdk.Context, marketID common.Hash
 	fund := k.GetInsuranceFund(ctx, marketID)
 
 	if fund == nil {
-		metrics.ReportFuncError(k.svcTags)
-		return types.ErrInsuranceFundNotFound
+		return k.withdrawFromSharedInsuranceFund(ctx, marketID, amount)
 	} else if amount.GT(fund.Balance) {
 		metrics.ReportFuncError(k.svcTags)
 		return types.ErrPayoutTooLarge
@@ -268,6 +269,7 @@ func (k *Keeper) WithdrawFromInsuranceFund(ctx sdk.Context, marketID common.Hash
 
 	fund.Balance = fund.Balance.Sub(amount)
 	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, amount)
 	coinAmount := sdk.NewCoin(fund.DepositDenom, amount)
 
 	// nolint:errcheck //ignored on purpose
@@ -323,6 +325,11 @@ func (k *Keeper) CreateInsuranceFund(
 		return errors.Wrapf(types.ErrInsuranceFundAlreadyExists, "insurance fund %s already exist", marketID.Hex())
 	}
 
+	if sharedFundMarket := k.GetSharedInsuranceFundMarket(ctx, marketID); sharedFundMarket != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrInsuranceFundAlreadyExists, "market %s is backed by the shared insurance fund %s", marketID.Hex(), sharedFundMarket.FundId)
+	}
+
 	// create insurance fund object
 	shareBaseDenom := types.ShareDenomFromId(k.getNextShareDenomId(ctx))
 
@@ -333,6 +340,11 @@ func (k *Keeper) CreateInsuranceFund(
 	}
 	fund = types.NewInsuranceFund(marketID, deposit.Denom, shareBaseDenom, redemptionNoticePeriodDuration, ticker, oracleBase, oracleQuote, oracleType, expiry)
 
+	return k.createInsuranceFund(ctx, sender, deposit, fund)
+}
+
+// createInsuranceFund funds the new insurance fund with the initial deposit and mints its first share tokens
+func (k *Keeper) createInsuranceFund(ctx sdk.Context, sender sdk.AccAddress, deposit sdk.Coin, fund *types.InsuranceFund) error {
 	// initial deposit shouldn't be zero always as we mint tokens for the first user that deposits
 	if deposit.Amount.Equal(math.ZeroInt()) {
 		metrics.ReportFuncError(k.svcTags)
@@ -368,11 +380,13 @@ func (k *Keeper) CreateInsuranceFund(
 	}
 
 	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, math.ZeroInt())
 
 	// set metadata for share denom
-	shareDisplayDenom := fmt.Sprintf("INSURANCE-%s", marketID.String())
+	shareBaseDenom := fund.ShareDenom()
+	shareDisplayDenom := fmt.Sprintf("INSURANCE-%s", fund.MarketId)
 	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
-		Description: fmt.Sprintf("The share token of the insurance fund %s", marketID.Hex()),
+		Description: fmt.Sprintf("The share token of the insurance fund %s", fund.MarketId),
 		DenomUnits: []*banktypes.DenomUnit{
 			{
 				Denom:    shareBaseDenom,
@@ -387,8 +401,8 @@ func (k *Keeper) CreateInsuranceFund(
 		},
 		Base:    shareBaseDenom,
 		Display: shareDisplayDenom,
-		Name:    fmt.Sprintf("%s share token", ticker),
-		Symbol:  fmt.Sprintf("INSURANCE-%s", ticker),
+		Name:    fmt.Sprintf("%s share token", fund.MarketTicker),
+		Symbol:  fmt.Sprintf("INSURANCE-%s", fund.MarketTicker),
 	})
 
 	return nil
@@ -439,6 +453,8 @@ func (k *Keeper) UnderwriteInsuranceFund(ctx sdk.Context, underwriter sdk.AccAdd
 	}
 
 	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, math.ZeroInt())
+
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventUnderwrite{
 		Underwriter: underwriter.String(),
@@ -678,6 +694,7 @@ func (k *Keeper) withdrawRedemption(ctx sdk.Context, schedule *types.RedemptionS
 	fund.Balance = fund.Balance.Sub(redeemCoin.Amount)
 
 	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, math.ZeroInt())
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRedemption{
@@ -122,3 +122,60 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 
 	return &types.MsgRequestRedemptionResponse{}, nil
 }
+
+// CreateSharedInsuranceFund is wrapper of keeper.CreateSharedInsuranceFund
+func (k msgServer) CreateSharedInsuranceFund(goCtx context.Context, msg *types.MsgCreateSharedInsuranceFund) (*types.MsgCreateSharedInsuranceFundResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	fundID, err := k.Keeper.CreateSharedInsuranceFund(ctx, sender, msg.InitialDeposit, msg.Ticker)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("shared insurance fund creation failed", err)
+		return nil, err
+	}
+
+	return &types.MsgCreateSharedInsuranceFundResponse{FundId: fundID.Hex()}, nil
+}
+
+func (k msgServer) SetSharedInsuranceFundMarket(c context.Context, msg *types.MsgSetSharedInsuranceFundMarket) (*types.MsgSetSharedInsuranceFundMarketResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.Keeper.AddSharedInsuranceFundMarket(ctx, common.HexToHash(msg.FundId), common.HexToHash(msg.MarketId), msg.ExposureCap); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgSetSharedInsuranceFundMarketResponse{}, nil
+}
+
+func (k msgServer) RemoveSharedInsuranceFundMarket(c context.Context, msg *types.MsgRemoveSharedInsuranceFundMarket) (*types.MsgRemoveSharedInsuranceFundMarketResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.Keeper.RemoveSharedInsuranceFundMarket(ctx, common.HexToHash(msg.MarketId)); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgRemoveSharedInsuranceFundMarketResponse{}, nil
+}
@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
+)
+
+// CreateSharedInsuranceFund creates an insurance fund that can back multiple markets quoted in the deposit denom and
+// mints its pool tokens. It returns the id of the fund, which is used in place of the market id to underwrite and
+// redeem from the fund.
+func (k *Keeper) CreateSharedInsuranceFund(ctx sdk.Context, sender sdk.AccAddress, deposit sdk.Coin, ticker string) (common.Hash, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	fundID := types.NewSharedInsuranceFundID(ticker, deposit.Denom)
+	if k.GetInsuranceFund(ctx, fundID) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return common.Hash{}, errors.Wrapf(types.ErrInsuranceFundAlreadyExists, "insurance fund %s already exist", fundID.Hex())
+	}
+
+	shareBaseDenom := types.ShareDenomFromId(k.getNextShareDenomId(ctx))
+	redemptionNoticePeriodDuration := k.GetParams(ctx).DefaultRedemptionNoticePeriodDuration
+	fund := types.NewSharedInsuranceFund(fundID, deposit.Denom, shareBaseDenom, redemptionNoticePeriodDuration, ticker)
+
+	if err := k.createInsuranceFund(ctx, sender, deposit, fund); err != nil {
+		return common.Hash{}, err
+	}
+
+	return fundID, nil
+}
+
+// GetSharedInsuranceFundMarket returns the shared insurance fund link of the market, or nil if the market is not
+// backed by a shared insurance fund.
+func (k *Keeper) GetSharedInsuranceFundMarket(ctx sdk.Context, marketID common.Hash) *types.SharedInsuranceFundMarket {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSharedInsuranceFundMarketKey(marketID))
+	if bz == nil {
+		return nil
+	}
+
+	var market types.SharedInsuranceFundMarket
+	k.cdc.MustUnmarshal(bz, &market)
+	return &market
+}
+
+// SetSharedInsuranceFundMarket stores the shared insurance fund link of the market
+func (k *Keeper) SetSharedInsuranceFundMarket(ctx sdk.Context, market *types.SharedInsuranceFundMarket) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(market)
+	ctx.KVStore(k.storeKey).Set(types.GetSharedInsuranceFundMarketKey(common.HexToHash(market.MarketId)), bz)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSharedInsuranceFundMarketUpdate{Market: market})
+}
+
+func (k *Keeper) deleteSharedInsuranceFundMarket(ctx sdk.Context, marketID common.Hash) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSharedInsuranceFundMarketKey(marketID))
+}
+
+// GetAllSharedInsuranceFundMarkets returns the markets backed by shared insurance funds
+func (k *Keeper) GetAllSharedInsuranceFundMarkets(ctx sdk.Context) []types.SharedInsuranceFundMarket {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	markets := make([]types.SharedInsuranceFundMarket, 0)
+	k.iterateSharedInsuranceFundMarkets(ctx, func(market *types.SharedInsuranceFundMarket) (stop bool) {
+		markets = append(markets, *market)
+		return false
+	})
+
+	return markets
+}
+
+// GetSharedInsuranceFundMarkets returns the markets backed by the given shared insurance fund
+func (k *Keeper) GetSharedInsuranceFundMarkets(ctx sdk.Context, fundID common.Hash) []types.SharedInsuranceFundMarket {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	markets := make([]types.SharedInsuranceFundMarket, 0)
+	k.iterateSharedInsuranceFundMarkets(ctx, func(market *types.SharedInsuranceFundMarket) (stop bool) {
+		if common.HexToHash(market.FundId) == fundID {
+			markets = append(markets, *market)
+		}
+		return false
+	})
+
+	return markets
+}
+
+func (k *Keeper) iterateSharedInsuranceFundMarkets(ctx sdk.Context, process func(*types.SharedInsuranceFundMarket) (stop bool)) {
+	marketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SharedInsuranceFundMarketPrefixKey)
+
+	iterator := marketStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var market types.SharedInsuranceFundMarket
+		k.cdc.MustUnmarshal(iterator.Value(), &market)
+		if process(&market) {
+			return
+		}
+	}
+}
+
+// AddSharedInsuranceFundMarket makes the shared insurance fund back the market up to the exposure cap. If the market is
+// already backed by the fund, only its exposure cap is updated.
+func (k *Keeper) AddSharedInsuranceFundMarket(ctx sdk.Context, fundID, marketID common.Hash, exposureCap math.Int) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	fund := k.GetInsuranceFund(ctx, fundID)
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrInsuranceFundNotFound, "insurance fund %s does not exist", fundID.Hex())
+	}
+
+	if !fund.IsShared() {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrap(types.ErrNotSharedInsuranceFund, fundID.Hex())
+	}
+
+	if k.GetInsuranceFund(ctx, marketID) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrInsuranceFundAlreadyExists, "market %s has its own insurance fund", marketID.Hex())
+	}
+
+	if quoteDenom, found := k.getMarketQuoteDenom(ctx, marketID); found && quoteDenom != fund.DepositDenom {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrInvalidQuoteDenom, "market quote denom %s does not match insurance fund deposit denom %s", quoteDenom, fund.DepositDenom)
+	}
+
+	market := k.GetSharedInsuranceFundMarket(ctx, marketID)
+	if market == nil {
+		market = types.NewSharedInsuranceFundMarket(marketID, fundID, exposureCap)
+	} else if common.HexToHash(market.FundId) != fundID {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrInsuranceFundAlreadyExists, "market %s is backed by the shared insurance fund %s", marketID.Hex(), market.FundId)
+	} else {
+		market.ExposureCap = exposureCap
+	}
+
+	k.SetSharedInsuranceFundMarket(ctx, market)
+	return nil
+}
+
+// RemoveSharedInsuranceFundMarket stops the shared insurance fund from backing the market. Only markets that don't
+// exist or are demolished or expired can be removed.
+func (k *Keeper) RemoveSharedInsuranceFundMarket(ctx sdk.Context, marketID common.Hash) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	market := k.GetSharedInsuranceFundMarket(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrap(types.ErrSharedInsuranceFundMarketNotFound, marketID.Hex())
+	}
+
+	if _, found := k.getMarketQuoteDenom(ctx, marketID); found && !k.isMarketDemolishedOrExpired(ctx, marketID) {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrap(types.ErrSharedInsuranceFundMarketInUse, marketID.Hex())
+	}
+
+	k.deleteSharedInsuranceFundMarket(ctx, marketID)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventRemoveSharedInsuranceFundMarket{
+		MarketId: market.MarketId,
+		FundId:   market.FundId,
+	})
+	return nil
+}
+
+// GetMarketInsuranceFund returns the insurance fund backing the market, which is either the insurance fund of the market
+// or the shared insurance fund the market belongs to.
+func (k *Keeper) GetMarketInsuranceFund(ctx sdk.Context, marketID common.Hash) *types.InsuranceFund {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	if fund := k.GetInsuranceFund(ctx, marketID); fund != nil {
+		return fund
+	}
+
+	market := k.GetSharedInsuranceFundMarket(ctx, marketID)
+	if market == nil {
+		return nil
+	}
+
+	return k.GetInsuranceFund(ctx, common.HexToHash(market.FundId))
+}
+
+// GetAvailableInsuranceBalance returns the amount the market can draw from the insurance fund backing it. For markets
+// backed by a shared insurance fund, this is limited by the remaining exposure of the market.
+func (k *Keeper) GetAvailableInsuranceBalance(ctx sdk.Context, marketID common.Hash) math.Int {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	if fund := k.GetInsuranceFund(ctx, marketID); fund != nil {
+		return fund.Balance
+	}
+
+	market := k.GetSharedInsuranceFundMarket(ctx, marketID)
+	if market == nil {
+		return math.ZeroInt()
+	}
+
+	fund := k.GetInsuranceFund(ctx, common.HexToHash(market.FundId))
+	if fund == nil {
+		return math.ZeroInt()
+	}
+
+	return math.MinInt(fund.Balance, market.AvailableExposure())
+}
+
+func (k *Keeper) depositIntoSharedInsuranceFund(ctx sdk.Context, marketID common.Hash, amount math.Int) error {
+	market := k.GetSharedInsuranceFundMarket(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInsuranceFundNotFound
+	}
+
+	fund := k.GetInsuranceFund(ctx, common.HexToHash(market.FundId))
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInsuranceFundNotFound
+	}
+
+	fund.Balance = fund.Balance.Add(amount)
+	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, math.ZeroInt())
+
+	market.SubExposure(amount)
+	k.SetSharedInsuranceFundMarket(ctx, market)
+	return nil
+}
+
+func (k *Keeper) withdrawFromSharedInsuranceFund(ctx sdk.Context, marketID common.Hash, amount math.Int) error {
+	market := k.GetSharedInsuranceFundMarket(ctx, marketID)
+	if market == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInsuranceFundNotFound
+	}
+
+	fund := k.GetInsuranceFund(ctx, common.HexToHash(market.FundId))
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return types.ErrInsuranceFundNotFound
+	}
+
+	if amount.GT(fund.Balance) || amount.GT(market.AvailableExposure()) {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrPayoutTooLarge, "market %s can withdraw at most %s", marketID.Hex(), math.MinInt(fund.Balance, market.AvailableExposure()))
+	}
+
+	fund.Balance = fund.Balance.Sub(amount)
+	k.SetInsuranceFund(ctx, fund)
+	k.recordInsuranceFundSnapshot(ctx, fund, amount)
+
+	market.AddExposure(amount)
+	k.SetSharedInsuranceFundMarket(ctx, market)
+
+	coinAmount := sdk.NewCoin(fund.DepositDenom, amount)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventInsuranceWithdraw{
+		MarketId:     marketID.Hex(),
+		MarketTicker: fund.MarketTicker,
+		Withdrawal:   coinAmount,
+	})
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, exchangetypes.ModuleName, sdk.NewCoins(coinAmount))
+}
+
+// getSharedInsuranceFundDeficit returns the sum of the negative balances of the markets backed by the shared insurance
+// fund, each limited by the amount the market can still draw from the fund.
+func (k *Keeper) getSharedInsuranceFundDeficit(ctx sdk.Context, fundID common.Hash) math.LegacyDec {
+	deficit := math.LegacyZeroDec()
+
+	for _, market := range k.GetSharedInsuranceFundMarkets(ctx, fundID) {
+		marketBalance := k.exchangeKeeper.GetMarketBalance(ctx, common.HexToHash(market.MarketId))
+		if !marketBalance.IsNegative() {
+			continue
+		}
+
+		deficit = deficit.Add(math.LegacyMinDec(marketBalance.Neg(), market.AvailableExposure().ToLegacyDec()))
+	}
+
+	return deficit
+}
+
+// getMarketQuoteDenom returns the quote denom of the derivative or binary options market, if it exists.
+func (k *Keeper) getMarketQuoteDenom(ctx sdk.Context, marketID common.Hash) (string, bool) {
+	if market := k.exchangeKeeper.GetDerivativeMarketByID(ctx, marketID); market != nil {
+		return market.QuoteDenom, true
+	} else if market := k.exchangeKeeper.GetBinaryOptionsMarketByID(ctx, marketID); market != nil {
+		return market.QuoteDenom, true
+	}
+	return "", false
+}
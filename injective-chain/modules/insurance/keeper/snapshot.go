@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
+)
+
+// recordInsuranceFundSnapshot stores a snapshot of the insurance fund after its balance changed. The payout is the
+// amount paid out by the fund to cover market deficits in this change.
+func (k *Keeper) recordInsuranceFundSnapshot(ctx sdk.Context, fund *types.InsuranceFund, payout math.Int) {
+	fundID := common.HexToHash(fund.MarketId)
+
+	cumulativePayouts := payout
+	if latest := k.getLatestInsuranceFundSnapshot(ctx, fundID); latest != nil {
+		cumulativePayouts = cumulativePayouts.Add(latest.CumulativePayouts)
+	}
+
+	snapshot := types.NewInsuranceFundSnapshot(fund, ctx.BlockHeight(), ctx.BlockTime().Unix(), cumulativePayouts)
+	k.SetInsuranceFundSnapshot(ctx, snapshot)
+	k.pruneInsuranceFundSnapshots(ctx, fundID)
+}
+
+// SetInsuranceFundSnapshot stores the insurance fund snapshot, replacing the one taken in the same second if any
+func (k *Keeper) SetInsuranceFundSnapshot(ctx sdk.Context, snapshot *types.InsuranceFundSnapshot) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	key := types.GetInsuranceFundSnapshotKey(common.HexToHash(snapshot.MarketId), snapshot.Timestamp)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(snapshot))
+}
+
+func (k *Keeper) getLatestInsuranceFundSnapshot(ctx sdk.Context, fundID common.Hash) *types.InsuranceFundSnapshot {
+	snapshotStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetInsuranceFundSnapshotPrefix(fundID))
+
+	iterator := snapshotStore.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+
+	var snapshot types.InsuranceFundSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return &snapshot
+}
+
+// pruneInsuranceFundSnapshots deletes the snapshots older than the retention period, except the latest one of them
+// which is still needed to compute the yield over the whole retention period.
+func (k *Keeper) pruneInsuranceFundSnapshots(ctx sdk.Context, fundID common.Hash) {
+	retentionPeriod := k.GetParams(ctx).SnapshotRetentionPeriod
+	if retentionPeriod == 0 {
+		return
+	}
+
+	cutoff := ctx.BlockTime().Add(-retentionPeriod).Unix()
+	if cutoff <= 0 {
+		return
+	}
+
+	snapshotStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetInsuranceFundSnapshotPrefix(fundID))
+	iterator := snapshotStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(cutoff)))
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keys) <= 1 {
+		return
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		snapshotStore.Delete(key)
+	}
+}
+
+// GetAllInsuranceFundSnapshots returns the snapshots of all insurance funds
+func (k *Keeper) GetAllInsuranceFundSnapshots(ctx sdk.Context) []types.InsuranceFundSnapshot {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	snapshots := make([]types.InsuranceFundSnapshot, 0)
+	snapshotStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.InsuranceFundSnapshotPrefixKey)
+
+	iterator := snapshotStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.InsuranceFundSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// GetInsuranceFundSnapshotsPage returns a page of the snapshots of the insurance fund, ordered by time
+func (k *Keeper) GetInsuranceFundSnapshotsPage(
+	ctx sdk.Context,
+	fundID common.Hash,
+	pagination *query.PageRequest,
+) ([]types.InsuranceFundSnapshot, *query.PageResponse, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	snapshots := make([]types.InsuranceFundSnapshot, 0)
+	snapshotStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetInsuranceFundSnapshotPrefix(fundID))
+
+	pageRes, err := query.Paginate(snapshotStore, pagination, func(_, value []byte) error {
+		var snapshot types.InsuranceFundSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, nil, err
+	}
+
+	return snapshots, pageRes, nil
+}
+
+// GetInsuranceFundAPY returns the yield of the insurance fund share over each window in seconds, annualized without
+// compounding. The yield is computed from the latest snapshot taken before the window start to the current share price.
+// Snapshots of earlier share denoms are ignored since the share price restarts when the fund is refreshed.
+func (k *Keeper) GetInsuranceFundAPY(ctx sdk.Context, fundID common.Hash, windows []int64) ([]types.InsuranceFundAPY, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	fund := k.GetInsuranceFund(ctx, fundID)
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInsuranceFundNotFound, "insurance fund %s does not exist", fundID.Hex())
+	}
+
+	if len(windows) == 0 {
+		windows = types.DefaultInsuranceFundAPYWindows
+	}
+
+	if len(windows) > types.MaxInsuranceFundAPYWindows {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalidAPYWindow, "at most %d windows can be queried", types.MaxInsuranceFundAPYWindows)
+	}
+
+	now := ctx.BlockTime().Unix()
+	endSharePrice := fund.SharePrice()
+	apys := make([]types.InsuranceFundAPY, 0, len(windows))
+
+	for _, window := range windows {
+		if window <= 0 {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, errors.Wrapf(types.ErrInvalidAPYWindow, "window must be positive: %d", window)
+		}
+
+		apy := types.InsuranceFundAPY{
+			Window:          window,
+			StartTimestamp:  now,
+			StartSharePrice: endSharePrice,
+			EndSharePrice:   endSharePrice,
+			Apy:             math.LegacyZeroDec(),
+		}
+
+		start := k.getInsuranceFundSnapshotBefore(ctx, fundID, fund.ShareDenom(), now-window)
+		if start != nil {
+			apy.StartTimestamp = start.Timestamp
+			apy.StartSharePrice = start.SharePrice
+
+			if elapsed := now - start.Timestamp; elapsed > 0 && start.SharePrice.IsPositive() {
+				apy.Apy = endSharePrice.Quo(start.SharePrice).Sub(math.LegacyOneDec()).MulInt64(types.SecondsPerYear).QuoInt64(elapsed)
+			}
+		}
+
+		apys = append(apys, apy)
+	}
+
+	return apys, nil
+}
+
+// getInsuranceFundSnapshotBefore returns the latest snapshot of the share denom taken at or before the timestamp, or
+// the earliest snapshot of the share denom if there is none.
+func (k *Keeper) getInsuranceFundSnapshotBefore(ctx sdk.Context, fundID common.Hash, shareDenom string, timestamp int64) *types.InsuranceFundSnapshot {
+	snapshotStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetInsuranceFundSnapshotPrefix(fundID))
+
+	var start []byte
+	if timestamp >= 0 {
+		reverseIterator := snapshotStore.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(timestamp)+1))
+		if reverseIterator.Valid() {
+			var snapshot types.InsuranceFundSnapshot
+			k.cdc.MustUnmarshal(reverseIterator.Value(), &snapshot)
+			if snapshot.ShareDenom == shareDenom {
+				reverseIterator.Close()
+				return &snapshot
+			}
+			start = reverseIterator.Key()
+		}
+		reverseIterator.Close()
+	}
+
+	// the share denom changed after the timestamp, so the yield starts at the first snapshot of the current denom
+	iterator := snapshotStore.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.InsuranceFundSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if snapshot.ShareDenom == shareDenom {
+			return &snapshot
+		}
+	}
+
+	return nil
+}
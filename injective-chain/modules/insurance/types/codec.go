@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnderwrite{}, "insurance/MsgUnderwrite", nil)
 	cdc.RegisterConcrete(&MsgRequestRedemption{}, "insurance/MsgRequestRedemption", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "insurance/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgCreateSharedInsuranceFund{}, "insurance/MsgCreateSharedInsuranceFund", nil)
+	cdc.RegisterConcrete(&MsgSetSharedInsuranceFundMarket{}, "insurance/MsgSetSharedInsuranceFundMarket", nil)
+	cdc.RegisterConcrete(&MsgRemoveSharedInsuranceFundMarket{}, "insurance/MsgRemoveSharedInsuranceFundMarket", nil)
 	cdc.RegisterConcrete(&Params{}, "insurance/Params", nil)
 
 }
@@ -26,6 +29,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUnderwrite{},
 		&MsgRequestRedemption{},
 		&MsgUpdateParams{},
+		&MsgCreateSharedInsuranceFund{},
+		&MsgSetSharedInsuranceFundMarket{},
+		&MsgRemoveSharedInsuranceFundMarket{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -17,4 +17,11 @@ var (
 	ErrInvalidExpirationTime      = errors.Register(ModuleName, 10, "invalid expiration time")
 	ErrInvalidMarketID            = errors.Register(ModuleName, 11, "invalid marketID")
 	ErrInvalidShareDenom          = errors.Register(ModuleName, 12, "invalid share denom")
+
+	ErrNotSharedInsuranceFund            = errors.Register(ModuleName, 13, "insurance fund is not a shared insurance fund")
+	ErrSharedInsuranceFundMarketNotFound = errors.Register(ModuleName, 14, "market is not backed by a shared insurance fund")
+	ErrInvalidExposureCap                = errors.Register(ModuleName, 15, "invalid exposure cap")
+	ErrSharedInsuranceFundMarketInUse    = errors.Register(ModuleName, 16, "market backed by the shared insurance fund is still active")
+	ErrInvalidAPYWindow                  = errors.Register(ModuleName, 17, "invalid APY window")
+	ErrInvalidInsuranceFund              = errors.Register(ModuleName, 18, "invalid insurance fund ID")
 )
@@ -298,12 +298,110 @@ func (m *EventInsuranceWithdraw) GetWithdrawal() types.Coin {
 	return types.Coin{}
 }
 
+type EventSharedInsuranceFundMarketUpdate struct {
+	Market *SharedInsuranceFundMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (m *EventSharedInsuranceFundMarketUpdate) Reset()         { *m = EventSharedInsuranceFundMarketUpdate{} }
+func (m *EventSharedInsuranceFundMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventSharedInsuranceFundMarketUpdate) ProtoMessage()    {}
+func (*EventSharedInsuranceFundMarketUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_238c43c591e30770, []int{5}
+}
+func (m *EventSharedInsuranceFundMarketUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSharedInsuranceFundMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSharedInsuranceFundMarketUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSharedInsuranceFundMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSharedInsuranceFundMarketUpdate.Merge(m, src)
+}
+func (m *EventSharedInsuranceFundMarketUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSharedInsuranceFundMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSharedInsuranceFundMarketUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSharedInsuranceFundMarketUpdate proto.InternalMessageInfo
+
+func (m *EventSharedInsuranceFundMarketUpdate) GetMarket() *SharedInsuranceFundMarket {
+	if m != nil {
+		return m.Market
+	}
+	return nil
+}
+
+type EventRemoveSharedInsuranceFundMarket struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	FundId   string `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+}
+
+func (m *EventRemoveSharedInsuranceFundMarket) Reset()         { *m = EventRemoveSharedInsuranceFundMarket{} }
+func (m *EventRemoveSharedInsuranceFundMarket) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveSharedInsuranceFundMarket) ProtoMessage()    {}
+func (*EventRemoveSharedInsuranceFundMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_238c43c591e30770, []int{6}
+}
+func (m *EventRemoveSharedInsuranceFundMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveSharedInsuranceFundMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveSharedInsuranceFundMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveSharedInsuranceFundMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveSharedInsuranceFundMarket.Merge(m, src)
+}
+func (m *EventRemoveSharedInsuranceFundMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveSharedInsuranceFundMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveSharedInsuranceFundMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveSharedInsuranceFundMarket proto.InternalMessageInfo
+
+func (m *EventRemoveSharedInsuranceFundMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *EventRemoveSharedInsuranceFundMarket) GetFundId() string {
+	if m != nil {
+		return m.FundId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventInsuranceFundUpdate)(nil), "injective.insurance.v1beta1.EventInsuranceFundUpdate")
 	proto.RegisterType((*EventRequestRedemption)(nil), "injective.insurance.v1beta1.EventRequestRedemption")
 	proto.RegisterType((*EventWithdrawRedemption)(nil), "injective.insurance.v1beta1.EventWithdrawRedemption")
 	proto.RegisterType((*EventUnderwrite)(nil), "injective.insurance.v1beta1.EventUnderwrite")
 	proto.RegisterType((*EventInsuranceWithdraw)(nil), "injective.insurance.v1beta1.EventInsuranceWithdraw")
+	proto.RegisterType((*EventSharedInsuranceFundMarketUpdate)(nil), "injective.insurance.v1beta1.EventSharedInsuranceFundMarketUpdate")
+	proto.RegisterType((*EventRemoveSharedInsuranceFundMarket)(nil), "injective.insurance.v1beta1.EventRemoveSharedInsuranceFundMarket")
 }
 
 func init() {
@@ -311,37 +409,40 @@ func init() {
 }
 
 var fileDescriptor_238c43c591e30770 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x8b, 0xd4, 0x4c,
-	0x10, 0x9d, 0x7c, 0xdf, 0xb0, 0xee, 0xd4, 0x28, 0x42, 0x23, 0x1a, 0x67, 0x21, 0x2e, 0xf1, 0xb2,
-	0x28, 0xa6, 0x59, 0x3d, 0x88, 0x17, 0x95, 0x15, 0x85, 0x41, 0x41, 0x88, 0x2e, 0xc2, 0x5e, 0x96,
-	0x4e, 0xba, 0x9c, 0xb4, 0xbb, 0xe9, 0x8e, 0xdd, 0x9d, 0x19, 0xfc, 0x17, 0xde, 0xfd, 0x03, 0xfe,
-	0x0f, 0x2f, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0x99, 0x3f, 0x22, 0xe9, 0xf4, 0x64, 0xc6, 0xcb, 0x30,
-	0x17, 0x6f, 0xa9, 0xca, 0x7b, 0xaf, 0x5e, 0xf5, 0xeb, 0x86, 0x03, 0x21, 0x3f, 0x61, 0x6e, 0xc5,
-	0x14, 0xa9, 0x90, 0xa6, 0xd6, 0x4c, 0xe6, 0x48, 0xa7, 0x87, 0x19, 0x5a, 0x76, 0x48, 0x71, 0x8a,
-	0xd2, 0x9a, 0xa4, 0xd2, 0xca, 0x2a, 0xb2, 0xd7, 0x21, 0x93, 0x0e, 0x99, 0x78, 0xe4, 0xe8, 0xc6,
-	0x44, 0x4d, 0x94, 0xc3, 0xd1, 0xe6, 0xab, 0xa5, 0x8c, 0xa2, 0x5c, 0x99, 0x52, 0x19, 0x9a, 0x31,
-	0xb3, 0x12, 0xcd, 0x95, 0x90, 0xfe, 0xff, 0xfd, 0x4d, 0xc3, 0x57, 0x43, 0x1c, 0x38, 0x3e, 0x81,
-	0xf0, 0x65, 0xe3, 0x67, 0xbc, 0xec, 0xbf, 0xaa, 0x25, 0x3f, 0xae, 0x38, 0xb3, 0x48, 0x9e, 0x42,
-	0xff, 0x63, 0x2d, 0x79, 0x18, 0xec, 0x07, 0x07, 0xc3, 0x87, 0xf7, 0x92, 0x0d, 0x56, 0x93, 0xbf,
-	0xf8, 0xa9, 0xe3, 0xc5, 0x08, 0x37, 0x9d, 0x76, 0x8a, 0x9f, 0x6b, 0x34, 0x36, 0x45, 0x8e, 0x65,
-	0x65, 0x85, 0x92, 0xe4, 0x35, 0xec, 0x9a, 0xbc, 0x40, 0x5e, 0x9f, 0xa3, 0x57, 0xa7, 0x1b, 0xd5,
-	0x57, 0xd4, 0x77, 0x9e, 0x96, 0x76, 0x02, 0xf1, 0xf7, 0x00, 0x6e, 0xb9, 0x39, 0x1f, 0x84, 0x2d,
-	0xb8, 0x66, 0xb3, 0x7f, 0x34, 0x88, 0x3c, 0x87, 0xa1, 0x46, 0x8e, 0x58, 0x9e, 0x36, 0xa7, 0x1d,
-	0xfe, 0xe7, 0xf4, 0x6e, 0x27, 0x6d, 0x1c, 0x49, 0x13, 0x47, 0xa7, 0xf3, 0x42, 0x09, 0x79, 0xd4,
-	0xbf, 0xf8, 0x75, 0xa7, 0x97, 0x42, 0xcb, 0x69, 0x3a, 0xf1, 0x8f, 0x00, 0xae, 0x3b, 0xab, 0xc7,
-	0x92, 0xa3, 0x9e, 0x69, 0x61, 0x91, 0xec, 0xc3, 0xb0, 0xee, 0x2a, 0xed, 0x5c, 0x0e, 0xd2, 0xf5,
-	0x16, 0x19, 0xc1, 0x6e, 0xc9, 0xf4, 0x19, 0xda, 0x31, 0x77, 0x43, 0x07, 0x69, 0x57, 0x93, 0x27,
-	0x70, 0x85, 0x63, 0xa5, 0x8c, 0xb0, 0xe1, 0xff, 0xdb, 0xf9, 0x59, 0xe2, 0xc9, 0x63, 0xd8, 0x31,
-	0x05, 0xd3, 0x68, 0xc2, 0xfe, 0x76, 0x4c, 0x0f, 0x8f, 0xbf, 0x05, 0x3e, 0xd8, 0x2e, 0xf4, 0xe5,
-	0xc9, 0x93, 0x3d, 0x18, 0xb4, 0xd6, 0x4e, 0x05, 0xf7, 0xab, 0xac, 0xbc, 0xde, 0x85, 0x6b, 0xfe,
-	0xa7, 0x15, 0xf9, 0x19, 0x6a, 0xbf, 0xcc, 0xd5, 0xb6, 0xf9, 0xde, 0xf5, 0xc8, 0x33, 0x80, 0x99,
-	0x57, 0x63, 0xe7, 0xdb, 0xee, 0xb4, 0x46, 0x39, 0x12, 0x17, 0xf3, 0x28, 0xb8, 0x9c, 0x47, 0xc1,
-	0xef, 0x79, 0x14, 0x7c, 0x5d, 0x44, 0xbd, 0xcb, 0x45, 0xd4, 0xfb, 0xb9, 0x88, 0x7a, 0x27, 0x6f,
-	0x27, 0xc2, 0x16, 0x75, 0x96, 0xe4, 0xaa, 0xa4, 0xe3, 0xe5, 0x25, 0x78, 0xc3, 0x32, 0x43, 0xbb,
-	0x2b, 0xf1, 0x20, 0x57, 0x1a, 0xd7, 0xcb, 0x82, 0x09, 0x49, 0x4b, 0xd5, 0xe4, 0x6f, 0xd6, 0x9e,
-	0x93, 0xfd, 0x52, 0xa1, 0xc9, 0x76, 0xdc, 0x1b, 0x7a, 0xf4, 0x27, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0x1c, 0x0d, 0x6e, 0xef, 0x03, 0x00, 0x00,
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xcf, 0x6a, 0x48, 0x9b, 0x17, 0x45, 0x58, 0xc4, 0xae, 0x29, 0xac, 0x65, 0xf5, 0x50, 0x14,
+	0x77, 0xa9, 0x82, 0xe2, 0x45, 0xa5, 0xa2, 0x10, 0xfc, 0x07, 0x5b, 0x8b, 0x50, 0x84, 0x32, 0xd9,
+	0x79, 0x26, 0x63, 0xbb, 0x33, 0x71, 0x66, 0x36, 0xc1, 0x6f, 0xe1, 0xdd, 0x2f, 0xe0, 0xf7, 0xf0,
+	0xd2, 0x63, 0x8f, 0x9e, 0x44, 0x92, 0x2f, 0x22, 0x33, 0x3b, 0xd9, 0xa4, 0x42, 0x97, 0x5c, 0x7a,
+	0xcb, 0xbc, 0xf9, 0xfd, 0x9b, 0xf7, 0x5e, 0x16, 0xb6, 0x19, 0xff, 0x82, 0x99, 0x66, 0x63, 0x4c,
+	0x18, 0x57, 0x85, 0x24, 0x3c, 0xc3, 0x64, 0xbc, 0xd3, 0x47, 0x4d, 0x76, 0x12, 0x1c, 0x23, 0xd7,
+	0x2a, 0x1e, 0x49, 0xa1, 0x85, 0xbf, 0x59, 0x21, 0xe3, 0x0a, 0x19, 0x3b, 0x64, 0xf7, 0xfa, 0x40,
+	0x0c, 0x84, 0xc5, 0x25, 0xe6, 0x57, 0x49, 0xe9, 0x86, 0x99, 0x50, 0xb9, 0x50, 0x49, 0x9f, 0xa8,
+	0x85, 0x68, 0x26, 0x18, 0x77, 0xf7, 0xf7, 0xea, 0xcc, 0x17, 0x26, 0x16, 0x1c, 0x1d, 0x40, 0xf0,
+	0xd2, 0xe4, 0xe9, 0xcd, 0xeb, 0xaf, 0x0a, 0x4e, 0xf7, 0x47, 0x94, 0x68, 0xf4, 0x9f, 0x42, 0xf3,
+	0x73, 0xc1, 0x69, 0xe0, 0x6d, 0x79, 0xdb, 0x9d, 0x07, 0x77, 0xe3, 0x9a, 0xa8, 0xf1, 0x19, 0x7e,
+	0x6a, 0x79, 0x11, 0xc2, 0x0d, 0xab, 0x9d, 0xe2, 0xd7, 0x02, 0x95, 0x4e, 0x91, 0x62, 0x3e, 0xd2,
+	0x4c, 0x70, 0xff, 0x35, 0xac, 0xab, 0x6c, 0x88, 0xb4, 0x38, 0x46, 0xa7, 0x9e, 0xd4, 0xaa, 0x2f,
+	0xa8, 0x7b, 0x8e, 0x96, 0x56, 0x02, 0xd1, 0x4f, 0x0f, 0x36, 0xac, 0xcf, 0x47, 0xa6, 0x87, 0x54,
+	0x92, 0xc9, 0x05, 0x19, 0xf9, 0xcf, 0xa1, 0x23, 0x91, 0x22, 0xe6, 0x87, 0xa6, 0xdb, 0xc1, 0x25,
+	0xab, 0x77, 0x33, 0x2e, 0xc7, 0x11, 0x9b, 0x71, 0x54, 0x3a, 0x2f, 0x04, 0xe3, 0xbb, 0xcd, 0x93,
+	0x3f, 0xb7, 0x1a, 0x29, 0x94, 0x1c, 0x53, 0x89, 0x7e, 0x79, 0x70, 0xcd, 0x46, 0xdd, 0xe7, 0x14,
+	0xe5, 0x44, 0x32, 0x8d, 0xfe, 0x16, 0x74, 0x8a, 0xea, 0x24, 0x6d, 0xca, 0x76, 0xba, 0x5c, 0xf2,
+	0xbb, 0xb0, 0x9e, 0x13, 0x79, 0x84, 0xba, 0x47, 0xad, 0x69, 0x3b, 0xad, 0xce, 0xfe, 0x13, 0x58,
+	0xa3, 0x38, 0x12, 0x8a, 0xe9, 0xe0, 0xf2, 0x6a, 0x79, 0xe6, 0x78, 0xff, 0x31, 0xb4, 0xd4, 0x90,
+	0x48, 0x54, 0x41, 0x73, 0x35, 0xa6, 0x83, 0x47, 0x3f, 0x3c, 0x37, 0xd8, 0x6a, 0xe8, 0xf3, 0xce,
+	0xfb, 0x9b, 0xd0, 0x2e, 0xa3, 0x1d, 0x32, 0x1a, 0x78, 0xff, 0x65, 0xbd, 0x0d, 0x57, 0xdd, 0xa5,
+	0x66, 0xd9, 0x11, 0x4a, 0xf7, 0x98, 0x2b, 0x65, 0xf1, 0x83, 0xad, 0xf9, 0xcf, 0x00, 0x26, 0x4e,
+	0x8d, 0x1c, 0xaf, 0xfa, 0xa6, 0x25, 0x4a, 0x34, 0x86, 0x3b, 0x36, 0xdc, 0x9e, 0x09, 0x4b, 0xcf,
+	0xec, 0xe5, 0x5b, 0x6b, 0xe4, 0xb6, 0xfb, 0x1d, 0xb4, 0x4a, 0x63, 0xb7, 0x18, 0x8f, 0x6a, 0x17,
+	0xe3, 0x5c, 0xb5, 0xd4, 0xa9, 0x44, 0x9f, 0x9c, 0x6f, 0x8a, 0xb9, 0x18, 0xe3, 0xb9, 0xf8, 0xfa,
+	0x16, 0x6d, 0xc0, 0x9a, 0xf9, 0xeb, 0x98, 0xab, 0xb2, 0x39, 0x2d, 0x73, 0xec, 0xd1, 0x5d, 0x76,
+	0x32, 0x0d, 0xbd, 0xd3, 0x69, 0xe8, 0xfd, 0x9d, 0x86, 0xde, 0xf7, 0x59, 0xd8, 0x38, 0x9d, 0x85,
+	0x8d, 0xdf, 0xb3, 0xb0, 0x71, 0xf0, 0x7e, 0xc0, 0xf4, 0xb0, 0xe8, 0xc7, 0x99, 0xc8, 0x93, 0xde,
+	0xfc, 0x05, 0x6f, 0x48, 0x5f, 0x25, 0xd5, 0x7b, 0xee, 0x67, 0x42, 0xe2, 0xf2, 0x71, 0x48, 0x18,
+	0x4f, 0x72, 0x61, 0xb6, 0x5a, 0x2d, 0x7d, 0x24, 0xf4, 0xb7, 0x11, 0xaa, 0x7e, 0xcb, 0x7e, 0x19,
+	0x1e, 0xfe, 0x1b, 0x00, 0xfe, 0x8d, 0x6d, 0xa5, 0xc5, 0x04, 0x00, 0x00,
 }
 
 func (m *EventInsuranceFundUpdate) Marshal() (dAtA []byte, err error) {
@@ -563,6 +664,78 @@ func (m *EventInsuranceWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSharedInsuranceFundMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSharedInsuranceFundMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSharedInsuranceFundMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Market != nil {
+		{
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveSharedInsuranceFundMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveSharedInsuranceFundMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveSharedInsuranceFundMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundId) > 0 {
+		i -= len(m.FundId)
+		copy(dAtA[i:], m.FundId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FundId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -655,6 +828,36 @@ func (m *EventInsuranceWithdraw) Size() (n int) {
 	return n
 }
 
+func (m *EventSharedInsuranceFundMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveSharedInsuranceFundMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FundId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1279,6 +1482,206 @@ func (m *EventInsuranceWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSharedInsuranceFundMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSharedInsuranceFundMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSharedInsuranceFundMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Market == nil {
+				m.Market = &SharedInsuranceFundMarket{}
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemoveSharedInsuranceFundMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemoveSharedInsuranceFundMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemoveSharedInsuranceFundMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
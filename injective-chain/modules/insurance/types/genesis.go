@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 )
 
 func NewGenesisState() GenesisState {
@@ -19,15 +20,36 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	sharedFundMarkets := make(map[string]struct{}, len(gs.SharedInsuranceFundMarkets))
+	for idx := range gs.SharedInsuranceFundMarkets {
+		market := gs.SharedInsuranceFundMarkets[idx]
+		if err := market.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := sharedFundMarkets[market.MarketId]; ok {
+			return fmt.Errorf("duplicate shared insurance fund market %s", market.MarketId)
+		}
+		sharedFundMarkets[market.MarketId] = struct{}{}
+	}
+
+	for idx := range gs.InsuranceFundSnapshots {
+		if err := gs.InsuranceFundSnapshots[idx].Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                   DefaultParams(),
-		NextShareDenomId:         1,
-		NextRedemptionScheduleId: 1,
-		RedemptionSchedule:       []RedemptionSchedule{},
-		InsuranceFunds:           []InsuranceFund{},
+		Params:                     DefaultParams(),
+		NextShareDenomId:           1,
+		NextRedemptionScheduleId:   1,
+		RedemptionSchedule:         []RedemptionSchedule{},
+		InsuranceFunds:             []InsuranceFund{},
+		SharedInsuranceFundMarkets: []SharedInsuranceFundMarket{},
+		InsuranceFundSnapshots:     []InsuranceFundSnapshot{},
 	}
 }
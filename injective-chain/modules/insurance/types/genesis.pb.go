@@ -38,6 +38,12 @@ type GenesisState struct {
 	// next_redemption_schedule_id describes next redemption schedule id to be
 	// used for next schedule incremented by 1 per redemption request
 	NextRedemptionScheduleId uint64 `protobuf:"varint,5,opt,name=next_redemption_schedule_id,json=nextRedemptionScheduleId,proto3" json:"next_redemption_schedule_id,omitempty"`
+	// shared_insurance_fund_markets describes the markets backed by shared
+	// insurance funds
+	SharedInsuranceFundMarkets []SharedInsuranceFundMarket `protobuf:"bytes,6,rep,name=shared_insurance_fund_markets,json=sharedInsuranceFundMarkets,proto3" json:"shared_insurance_fund_markets"`
+	// insurance_fund_snapshots describes the historical snapshots of the
+	// insurance funds
+	InsuranceFundSnapshots []InsuranceFundSnapshot `protobuf:"bytes,7,rep,name=insurance_fund_snapshots,json=insuranceFundSnapshots,proto3" json:"insurance_fund_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +114,20 @@ func (m *GenesisState) GetNextRedemptionScheduleId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSharedInsuranceFundMarkets() []SharedInsuranceFundMarket {
+	if m != nil {
+		return m.SharedInsuranceFundMarkets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceFundSnapshots() []InsuranceFundSnapshot {
+	if m != nil {
+		return m.InsuranceFundSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.insurance.v1beta1.GenesisState")
 }
@@ -117,30 +137,34 @@ func init() {
 }
 
 var fileDescriptor_293324fee7d3f3b1 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xdb, 0xde, 0x2e, 0xd2, 0xcb, 0x55, 0x52, 0x17, 0xa1, 0x85, 0x58, 0x74, 0x53,
-	0x95, 0x66, 0x68, 0x5d, 0xbb, 0xb0, 0x88, 0x12, 0x10, 0x94, 0x76, 0xa5, 0x9b, 0x30, 0xc9, 0x9c,
-	0x26, 0x23, 0x66, 0x26, 0x64, 0x26, 0x45, 0xb7, 0x3e, 0x81, 0x8f, 0xd5, 0x65, 0x97, 0xae, 0x44,
-	0xda, 0x17, 0x91, 0x4c, 0xd3, 0x58, 0xa8, 0x64, 0x77, 0x72, 0xfe, 0xef, 0xff, 0xcf, 0x39, 0x64,
-	0x8c, 0x13, 0xca, 0x9e, 0x20, 0x90, 0x74, 0x06, 0x88, 0x32, 0x91, 0xa5, 0x98, 0x05, 0x80, 0x66,
-	0x03, 0x1f, 0x24, 0x1e, 0xa0, 0x10, 0x18, 0x08, 0x2a, 0x9c, 0x24, 0xe5, 0x92, 0x9b, 0x9d, 0x12,
-	0x75, 0x4a, 0xd4, 0x29, 0xd0, 0xf6, 0x59, 0x55, 0xce, 0x0f, 0xae, 0x92, 0xda, 0x07, 0x21, 0x0f,
-	0xb9, 0x2a, 0x51, 0x5e, 0xad, 0xbb, 0x47, 0x6f, 0x35, 0xe3, 0xdf, 0xcd, 0x7a, 0xe2, 0x44, 0x62,
-	0x09, 0xe6, 0xa5, 0xd1, 0x48, 0x70, 0x8a, 0x63, 0x61, 0xe9, 0x5d, 0xbd, 0xd7, 0x1c, 0x1e, 0x3b,
-	0x15, 0x1b, 0x38, 0xf7, 0x0a, 0x1d, 0xd5, 0xe7, 0x9f, 0x87, 0xda, 0xb8, 0x30, 0x9a, 0x0f, 0xc6,
-	0x5e, 0x49, 0x7a, 0xd3, 0x8c, 0x11, 0x61, 0xfd, 0xe9, 0xd6, 0x7a, 0xcd, 0xe1, 0x69, 0x65, 0x96,
-	0xbb, 0xe9, 0x5c, 0x67, 0x8c, 0x14, 0x91, 0xff, 0xe9, 0x76, 0x53, 0x98, 0x53, 0xa3, 0x95, 0x02,
-	0x81, 0x38, 0x91, 0x94, 0x33, 0x4f, 0x04, 0x11, 0x90, 0xec, 0x19, 0xac, 0x9a, 0x8a, 0x47, 0x95,
-	0xf1, 0xe3, 0xd2, 0x37, 0x29, 0x6c, 0xc5, 0x0c, 0x33, 0xdd, 0x51, 0xcc, 0xbe, 0xd1, 0x62, 0xf0,
-	0x22, 0x3d, 0x11, 0xe1, 0x14, 0x3c, 0x02, 0x8c, 0xc7, 0x1e, 0x25, 0x56, 0xbd, 0xab, 0xf7, 0xea,
-	0xe3, 0xfd, 0x5c, 0x9a, 0xe4, 0xca, 0x55, 0x2e, 0xb8, 0xc4, 0xbc, 0x30, 0x3a, 0x0a, 0xff, 0x65,
-	0xb7, 0xdc, 0xf6, 0x57, 0xd9, 0xac, 0x1c, 0xd9, 0xdd, 0xc2, 0x25, 0x23, 0x3a, 0x5f, 0xda, 0xfa,
-	0x62, 0x69, 0xeb, 0x5f, 0x4b, 0x5b, 0x7f, 0x5f, 0xd9, 0xda, 0x62, 0x65, 0x6b, 0x1f, 0x2b, 0x5b,
-	0x7b, 0xbc, 0x0b, 0xa9, 0x8c, 0x32, 0xdf, 0x09, 0x78, 0x8c, 0xdc, 0xcd, 0x71, 0xb7, 0xd8, 0x17,
-	0xa8, 0x3c, 0xb5, 0x1f, 0xf0, 0x14, 0xb6, 0x3f, 0x23, 0x4c, 0x19, 0x8a, 0x79, 0x9e, 0x2d, 0xb6,
-	0xde, 0x85, 0x7c, 0x4d, 0x40, 0xf8, 0x0d, 0xf5, 0xdb, 0xcf, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x41, 0xfa, 0xec, 0x9b, 0x83, 0x02, 0x00, 0x00,
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0x58, 0x29, 0x92, 0x87, 0x00, 0x79, 0x08, 0x59, 0x9d, 0x08, 0x15, 0x5c, 0x0a, 0x68,
+	0xb1, 0x56, 0x24, 0x6e, 0x1c, 0x98, 0x10, 0xa8, 0x12, 0x08, 0xd4, 0x9e, 0xe0, 0x12, 0xb9, 0xf1,
+	0x5b, 0x62, 0x20, 0x76, 0xe4, 0xe7, 0x4c, 0x70, 0xe2, 0x2b, 0xf0, 0xb1, 0x76, 0xdc, 0x91, 0x13,
+	0x42, 0xed, 0x87, 0xe0, 0x8a, 0xe2, 0x79, 0xa1, 0x63, 0x25, 0xda, 0xcd, 0xf1, 0xef, 0xef, 0x7b,
+	0x49, 0xc8, 0x43, 0xa5, 0x3f, 0x42, 0xe6, 0xd4, 0x11, 0x70, 0xa5, 0xb1, 0xb6, 0x42, 0x67, 0xc0,
+	0x8f, 0xf6, 0x17, 0xe0, 0xc4, 0x3e, 0xcf, 0x41, 0x03, 0x2a, 0x4c, 0x2a, 0x6b, 0x9c, 0xa1, 0xbb,
+	0x2d, 0x35, 0x69, 0xa9, 0x49, 0xa0, 0x0e, 0x1f, 0x77, 0xf9, 0xfc, 0xa5, 0x7b, 0xa7, 0xe1, 0xed,
+	0xdc, 0xe4, 0xc6, 0x1f, 0x79, 0x73, 0x3a, 0xbd, 0xbd, 0xff, 0xbb, 0x4f, 0xae, 0xbf, 0x3a, 0x4d,
+	0x9c, 0x3b, 0xe1, 0x80, 0x3e, 0x27, 0x83, 0x4a, 0x58, 0x51, 0x22, 0x8b, 0x46, 0xd1, 0x78, 0x7b,
+	0xf2, 0x20, 0xe9, 0x68, 0x90, 0xbc, 0xf3, 0xd4, 0x83, 0xfe, 0xf1, 0xcf, 0x7b, 0xbd, 0x59, 0x10,
+	0xd2, 0xf7, 0xe4, 0x66, 0xcb, 0x4c, 0x0f, 0x6b, 0x2d, 0x91, 0x5d, 0x19, 0x6d, 0x8d, 0xb7, 0x27,
+	0x8f, 0x3a, 0xbd, 0xa6, 0x67, 0x37, 0x2f, 0x6b, 0x2d, 0x83, 0xe5, 0x0d, 0xb5, 0x7e, 0x89, 0xf4,
+	0x90, 0xec, 0x58, 0x90, 0x50, 0x56, 0x4e, 0x19, 0x9d, 0x62, 0x56, 0x80, 0xac, 0x3f, 0x03, 0xdb,
+	0xf2, 0xf6, 0xbc, 0xd3, 0x7e, 0xd6, 0xea, 0xe6, 0x41, 0x16, 0x32, 0xa8, 0xbd, 0x80, 0xd0, 0x3d,
+	0xb2, 0xa3, 0xe1, 0x8b, 0x4b, 0xb1, 0x10, 0x16, 0x52, 0x09, 0xda, 0x94, 0xa9, 0x92, 0xac, 0x3f,
+	0x8a, 0xc6, 0xfd, 0xd9, 0xad, 0x06, 0x9a, 0x37, 0xc8, 0x8b, 0x06, 0x98, 0x4a, 0xfa, 0x8c, 0xec,
+	0x7a, 0xfa, 0x86, 0x6e, 0x8d, 0xec, 0xaa, 0x97, 0xb1, 0x86, 0x72, 0xb1, 0xc5, 0x54, 0xd2, 0x6f,
+	0xe4, 0xae, 0x0f, 0x92, 0xe9, 0xf9, 0xbd, 0xa5, 0xa5, 0xb0, 0x9f, 0xc0, 0x21, 0x1b, 0xf8, 0xf9,
+	0x9e, 0x76, 0xce, 0xe7, 0x0b, 0xc9, 0x73, 0x4b, 0x7c, 0xe3, 0xe5, 0x61, 0xcc, 0x21, 0xfe, 0x8f,
+	0x80, 0xd4, 0x12, 0xf6, 0x4f, 0x32, 0x6a, 0x51, 0x61, 0x61, 0x1c, 0xb2, 0x6b, 0x3e, 0x7b, 0x72,
+	0xf9, 0x57, 0x37, 0x0f, 0xd2, 0x90, 0x7b, 0x47, 0x6d, 0x02, 0xf1, 0x40, 0x1d, 0x2f, 0xe3, 0xe8,
+	0x64, 0x19, 0x47, 0xbf, 0x96, 0x71, 0xf4, 0x7d, 0x15, 0xf7, 0x4e, 0x56, 0x71, 0xef, 0xc7, 0x2a,
+	0xee, 0x7d, 0x78, 0x9b, 0x2b, 0x57, 0xd4, 0x8b, 0x24, 0x33, 0x25, 0x9f, 0x9e, 0xa5, 0xbe, 0x16,
+	0x0b, 0xe4, 0x6d, 0x87, 0xbd, 0xcc, 0x58, 0x58, 0x7f, 0x2c, 0x84, 0xd2, 0xbc, 0x34, 0xcd, 0x42,
+	0x71, 0xed, 0x67, 0x70, 0x5f, 0x2b, 0xc0, 0xc5, 0xc0, 0x7f, 0xeb, 0x4f, 0xfe, 0x0c, 0x00, 0x32,
+	0x84, 0x1e, 0xb6, 0x78, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -163,6 +187,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InsuranceFundSnapshots) > 0 {
+		for iNdEx := len(m.InsuranceFundSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFundSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SharedInsuranceFundMarkets) > 0 {
+		for iNdEx := len(m.SharedInsuranceFundMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharedInsuranceFundMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NextRedemptionScheduleId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedemptionScheduleId))
 		i--
@@ -251,6 +303,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextRedemptionScheduleId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextRedemptionScheduleId))
 	}
+	if len(m.SharedInsuranceFundMarkets) > 0 {
+		for _, e := range m.SharedInsuranceFundMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceFundSnapshots) > 0 {
+		for _, e := range m.InsuranceFundSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -428,6 +492,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedInsuranceFundMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedInsuranceFundMarkets = append(m.SharedInsuranceFundMarkets, SharedInsuranceFundMarket{})
+			if err := m.SharedInsuranceFundMarkets[len(m.SharedInsuranceFundMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFundSnapshots = append(m.InsuranceFundSnapshots, InsuranceFundSnapshot{})
+			if err := m.InsuranceFundSnapshots[len(m.InsuranceFundSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,11 +1,15 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 )
@@ -39,6 +43,26 @@ func NewInsuranceFund(
 
 }
 
+// NewSharedInsuranceFundID returns the id of a shared insurance fund, which is used in place of the market id to
+// underwrite and redeem from the fund.
+func NewSharedInsuranceFundID(ticker, depositDenom string) common.Hash {
+	return crypto.Keccak256Hash([]byte("shared/" + ticker + depositDenom))
+}
+
+func NewSharedInsuranceFund(
+	fundID common.Hash,
+	depositDenom, poolTokenDenom string,
+	redemptionNoticePeriodDuration time.Duration,
+	ticker string,
+) *InsuranceFund {
+	return NewInsuranceFund(fundID, depositDenom, poolTokenDenom, redemptionNoticePeriodDuration, ticker, "", "", oracletypes.OracleType_Unspecified, SharedInsuranceFundExpiryFlag)
+}
+
+// IsShared returns true if the insurance fund backs multiple markets.
+func (fund InsuranceFund) IsShared() bool {
+	return fund.Expiry == SharedInsuranceFundExpiryFlag
+}
+
 func (fund InsuranceFund) ShareDenom() string {
 	return fund.InsurancePoolTokenDenom
 }
@@ -54,3 +78,91 @@ func (fund *InsuranceFund) SubTotalShare(shares math.Int) {
 func ShareDenomFromId(id uint64) string {
 	return fmt.Sprintf("share%d", id)
 }
+
+func NewSharedInsuranceFundMarket(marketID, fundID common.Hash, exposureCap math.Int) *SharedInsuranceFundMarket {
+	return &SharedInsuranceFundMarket{
+		MarketId:    marketID.Hex(),
+		FundId:      fundID.Hex(),
+		ExposureCap: exposureCap,
+		Exposure:    math.ZeroInt(),
+	}
+}
+
+// AvailableExposure returns the amount the market can still draw from the shared insurance fund.
+func (m *SharedInsuranceFundMarket) AvailableExposure() math.Int {
+	if m.Exposure.GTE(m.ExposureCap) {
+		return math.ZeroInt()
+	}
+	return m.ExposureCap.Sub(m.Exposure)
+}
+
+// AddExposure increases the exposure of the market by the amount drawn from the shared insurance fund.
+func (m *SharedInsuranceFundMarket) AddExposure(amount math.Int) {
+	m.Exposure = m.Exposure.Add(amount)
+}
+
+// SubExposure decreases the exposure of the market by the amount paid into the shared insurance fund, down to zero.
+func (m *SharedInsuranceFundMarket) SubExposure(amount math.Int) {
+	m.Exposure = math.MaxInt(m.Exposure.Sub(amount), math.ZeroInt())
+}
+
+func (m *SharedInsuranceFundMarket) Validate() error {
+	if !isHexHash(m.MarketId) {
+		return errors.Wrap(ErrInvalidMarketID, m.MarketId)
+	}
+	if !isHexHash(m.FundId) {
+		return errors.Wrap(ErrInvalidInsuranceFund, m.FundId)
+	}
+	if m.ExposureCap.IsNil() || !m.ExposureCap.IsPositive() || m.ExposureCap.GT(MaxUnderwritingAmount) {
+		return errors.Wrap(ErrInvalidExposureCap, m.ExposureCap.String())
+	}
+	if m.Exposure.IsNil() || m.Exposure.IsNegative() {
+		return errors.Wrapf(ErrInvalidExposureCap, "invalid exposure %s", m.Exposure.String())
+	}
+	return nil
+}
+
+func isHexHash(s string) bool {
+	if !strings.HasPrefix(s, "0x") || len(s) != 2*common.HashLength+2 {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
+
+// SharePrice returns the balance of the insurance fund per share token.
+func (fund InsuranceFund) SharePrice() math.LegacyDec {
+	if !fund.TotalShare.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return fund.Balance.ToLegacyDec().QuoInt(fund.TotalShare)
+}
+
+func NewInsuranceFundSnapshot(fund *InsuranceFund, blockHeight, timestamp int64, cumulativePayouts math.Int) *InsuranceFundSnapshot {
+	return &InsuranceFundSnapshot{
+		MarketId:          fund.MarketId,
+		ShareDenom:        fund.ShareDenom(),
+		BlockHeight:       blockHeight,
+		Timestamp:         timestamp,
+		Balance:           fund.Balance,
+		TotalShare:        fund.TotalShare,
+		SharePrice:        fund.SharePrice(),
+		CumulativePayouts: cumulativePayouts,
+	}
+}
+
+func (s *InsuranceFundSnapshot) Validate() error {
+	if !isHexHash(s.MarketId) {
+		return errors.Wrap(ErrInvalidMarketID, s.MarketId)
+	}
+	if s.ShareDenom == "" {
+		return errors.Wrap(ErrInvalidShareDenom, "share denom should not be empty")
+	}
+	if s.BlockHeight <= 0 || s.Timestamp < 0 {
+		return fmt.Errorf("invalid snapshot block height %d or timestamp %d", s.BlockHeight, s.Timestamp)
+	}
+	if s.Balance.IsNil() || s.TotalShare.IsNil() || s.SharePrice.IsNil() || s.CumulativePayouts.IsNil() {
+		return fmt.Errorf("snapshot of insurance fund %s has nil values", s.MarketId)
+	}
+	return nil
+}
@@ -37,6 +37,9 @@ type Params struct {
 	// notice period duration that must pass after an underwriter sends a
 	// redemption request before the underwriter can claim his tokens
 	DefaultRedemptionNoticePeriodDuration time.Duration `protobuf:"bytes,1,opt,name=default_redemption_notice_period_duration,json=defaultRedemptionNoticePeriodDuration,proto3,stdduration" json:"default_redemption_notice_period_duration" yaml:"default_redemption_notice_period_duration"`
+	// snapshot_retention_period defines how long insurance fund snapshots are
+	// kept. Zero disables the pruning of snapshots
+	SnapshotRetentionPeriod time.Duration `protobuf:"bytes,2,opt,name=snapshot_retention_period,json=snapshotRetentionPeriod,proto3,stdduration" json:"snapshot_retention_period" yaml:"snapshot_retention_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +82,13 @@ func (m *Params) GetDefaultRedemptionNoticePeriodDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSnapshotRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.SnapshotRetentionPeriod
+	}
+	return 0
+}
+
 type InsuranceFund struct {
 	// deposit denomination for the given insurance fund
 	DepositDenom string `protobuf:"bytes,1,opt,name=deposit_denom,json=depositDenom,proto3" json:"deposit_denom,omitempty"`
@@ -286,10 +296,155 @@ func (m *RedemptionSchedule) GetRedemptionAmount() types1.Coin {
 	return types1.Coin{}
 }
 
+// SharedInsuranceFundMarket links a market to the shared insurance fund backing
+// it
+type SharedInsuranceFundMarket struct {
+	// market_id of the derivative or binary options market
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// fund_id of the shared insurance fund
+	FundId string `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	// exposure_cap is the maximum net amount the market can draw from the fund
+	ExposureCap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=exposure_cap,json=exposureCap,proto3,customtype=cosmossdk.io/math.Int" json:"exposure_cap"`
+	// exposure is the net amount the market currently draws from the fund.
+	// Payments of the market into the fund reduce it down to zero
+	Exposure cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=exposure,proto3,customtype=cosmossdk.io/math.Int" json:"exposure"`
+}
+
+func (m *SharedInsuranceFundMarket) Reset()         { *m = SharedInsuranceFundMarket{} }
+func (m *SharedInsuranceFundMarket) String() string { return proto.CompactTextString(m) }
+func (*SharedInsuranceFundMarket) ProtoMessage()    {}
+func (*SharedInsuranceFundMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbc47a7b76393948, []int{3}
+}
+func (m *SharedInsuranceFundMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharedInsuranceFundMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharedInsuranceFundMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharedInsuranceFundMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedInsuranceFundMarket.Merge(m, src)
+}
+func (m *SharedInsuranceFundMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharedInsuranceFundMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedInsuranceFundMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedInsuranceFundMarket proto.InternalMessageInfo
+
+func (m *SharedInsuranceFundMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *SharedInsuranceFundMarket) GetFundId() string {
+	if m != nil {
+		return m.FundId
+	}
+	return ""
+}
+
+// InsuranceFundSnapshot records the state of an insurance fund after its
+// balance changed. Only the last snapshot within the same second is kept
+type InsuranceFundSnapshot struct {
+	// market_id of the insurance fund
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// share_denom of the insurance fund at the time of the snapshot
+	ShareDenom  string `protobuf:"bytes,2,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp of the block in seconds
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// balance of the insurance fund
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// total_share of the insurance fund
+	TotalShare cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_share,json=totalShare,proto3,customtype=cosmossdk.io/math.Int" json:"total_share"`
+	// share_price is the balance of the insurance fund per share token
+	SharePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=share_price,json=sharePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_price"`
+	// cumulative_payouts is the total amount paid out by the insurance fund to
+	// cover market deficits since the first snapshot
+	CumulativePayouts cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=cumulative_payouts,json=cumulativePayouts,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_payouts"`
+}
+
+func (m *InsuranceFundSnapshot) Reset()         { *m = InsuranceFundSnapshot{} }
+func (m *InsuranceFundSnapshot) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundSnapshot) ProtoMessage()    {}
+func (*InsuranceFundSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbc47a7b76393948, []int{4}
+}
+func (m *InsuranceFundSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundSnapshot.Merge(m, src)
+}
+func (m *InsuranceFundSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundSnapshot proto.InternalMessageInfo
+
+func (m *InsuranceFundSnapshot) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *InsuranceFundSnapshot) GetShareDenom() string {
+	if m != nil {
+		return m.ShareDenom
+	}
+	return ""
+}
+
+func (m *InsuranceFundSnapshot) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *InsuranceFundSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.insurance.v1beta1.Params")
 	proto.RegisterType((*InsuranceFund)(nil), "injective.insurance.v1beta1.InsuranceFund")
 	proto.RegisterType((*RedemptionSchedule)(nil), "injective.insurance.v1beta1.RedemptionSchedule")
+	proto.RegisterType((*SharedInsuranceFundMarket)(nil), "injective.insurance.v1beta1.SharedInsuranceFundMarket")
+	proto.RegisterType((*InsuranceFundSnapshot)(nil), "injective.insurance.v1beta1.InsuranceFundSnapshot")
 }
 
 func init() {
@@ -297,55 +452,68 @@ func init() {
 }
 
 var fileDescriptor_dbc47a7b76393948 = []byte{
-	// 759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x48,
-	0x14, 0x8e, 0xd3, 0x6c, 0xda, 0x4c, 0xda, 0xaa, 0x1d, 0xed, 0x6e, 0xdd, 0x54, 0xeb, 0xa4, 0xd9,
-	0xad, 0x94, 0xdd, 0x05, 0x9b, 0x16, 0x24, 0xa4, 0x82, 0x90, 0x08, 0x05, 0x29, 0x52, 0x45, 0x8b,
-	0x9b, 0x13, 0x17, 0x6b, 0x6c, 0x4f, 0x93, 0x21, 0xb6, 0xc7, 0xd8, 0xe3, 0x8a, 0xdc, 0x39, 0x71,
-	0xea, 0x11, 0x71, 0xe2, 0x27, 0xd0, 0x7f, 0xd1, 0x63, 0x6f, 0x20, 0x0e, 0x05, 0xb5, 0x07, 0x38,
-	0x70, 0xe2, 0x17, 0xa0, 0x19, 0x8f, 0xed, 0x08, 0x04, 0xed, 0xc5, 0xf2, 0x7b, 0xef, 0x7b, 0x6f,
-	0xde, 0xfb, 0xde, 0x37, 0x03, 0xfe, 0x27, 0xc1, 0x13, 0xec, 0x30, 0x72, 0x80, 0x0d, 0x12, 0xc4,
-	0x49, 0x84, 0x02, 0x07, 0x1b, 0x07, 0xeb, 0x36, 0x66, 0x68, 0xbd, 0xf0, 0xe8, 0x61, 0x44, 0x19,
-	0x85, 0x2b, 0x39, 0x58, 0x2f, 0x42, 0x12, 0xdc, 0xf8, 0x7d, 0x40, 0x07, 0x54, 0xe0, 0x0c, 0xfe,
-	0x97, 0xa6, 0x34, 0xb4, 0x01, 0xa5, 0x03, 0x0f, 0x1b, 0xc2, 0xb2, 0x93, 0x7d, 0xc3, 0x4d, 0x22,
-	0xc4, 0x08, 0x0d, 0x64, 0xbc, 0xf9, 0x7d, 0x9c, 0x11, 0x1f, 0xc7, 0x0c, 0xf9, 0x61, 0x56, 0xc0,
-	0xa1, 0xb1, 0x4f, 0x63, 0xc3, 0x46, 0x71, 0xd1, 0x98, 0x43, 0x49, 0x56, 0x60, 0xad, 0x18, 0x80,
-	0x46, 0xc8, 0xf1, 0x0a, 0x50, 0x6a, 0x4a, 0xd8, 0x22, 0xf2, 0x49, 0x40, 0x0d, 0xf1, 0x4d, 0x5d,
-	0xed, 0xb7, 0x0a, 0xa8, 0xee, 0xa2, 0x08, 0xf9, 0x31, 0x3c, 0x52, 0xc0, 0xbf, 0x2e, 0xde, 0x47,
-	0x89, 0xc7, 0xac, 0x08, 0xbb, 0xd8, 0x0f, 0x79, 0x8b, 0x56, 0x40, 0x19, 0x71, 0xb0, 0x15, 0xe2,
-	0x88, 0x50, 0xd7, 0xca, 0x3a, 0x57, 0x95, 0x96, 0xd2, 0xa9, 0x6f, 0x2c, 0xeb, 0x69, 0xeb, 0x7a,
-	0xd6, 0xba, 0xbe, 0x25, 0x01, 0xdd, 0xdb, 0xc7, 0xa7, 0xcd, 0xd2, 0xd7, 0xd3, 0xe6, 0xb5, 0x31,
-	0xf2, 0xbd, 0xcd, 0xf6, 0xa5, 0x2b, 0xb7, 0x5f, 0x7e, 0x68, 0x2a, 0xe6, 0x9a, 0xc4, 0x9b, 0x39,
-	0xfc, 0xa1, 0x40, 0xef, 0x0a, 0x70, 0x76, 0xc8, 0xe6, 0xf2, 0xe7, 0xd7, 0x4d, 0xe5, 0xc5, 0xa7,
-	0x37, 0xff, 0x2d, 0x14, 0x8b, 0x4b, 0xc7, 0x69, 0x7f, 0xa9, 0x80, 0xb9, 0x5e, 0xe6, 0x7c, 0x90,
-	0x04, 0x2e, 0xfc, 0x1b, 0xcc, 0xb9, 0x38, 0xa4, 0x31, 0x61, 0x96, 0x8b, 0x03, 0xea, 0x8b, 0x19,
-	0x6a, 0xe6, 0xac, 0x74, 0x6e, 0x71, 0x1f, 0xbc, 0x05, 0x1a, 0x79, 0x29, 0x2b, 0xa4, 0xd4, 0xb3,
-	0x18, 0x1d, 0xe1, 0x40, 0x66, 0x94, 0x45, 0xc6, 0x52, 0x8e, 0xd8, 0xa5, 0xd4, 0xeb, 0xf3, 0x78,
-	0x9a, 0xfc, 0x4a, 0x01, 0xab, 0x17, 0x53, 0x37, 0x75, 0x11, 0x75, 0x37, 0x24, 0x75, 0x9d, 0x94,
-	0xba, 0x4b, 0x52, 0xa6, 0x45, 0xbf, 0xe4, 0x0a, 0xde, 0x04, 0xd3, 0x36, 0xf2, 0x78, 0xd7, 0x6a,
-	0x85, 0x8f, 0xd1, 0xfd, 0x8b, 0x1f, 0xf3, 0xfe, 0xb4, 0xf9, 0x47, 0xaa, 0xae, 0xd8, 0x1d, 0xe9,
-	0x84, 0x1a, 0x3e, 0x62, 0x43, 0xbd, 0x17, 0x30, 0x33, 0x43, 0xc3, 0x3b, 0xa0, 0xce, 0x28, 0x43,
-	0x9e, 0x15, 0x0f, 0x51, 0x84, 0xd5, 0xdf, 0x2e, 0x93, 0x0c, 0x44, 0xc6, 0x1e, 0x4f, 0x80, 0x2b,
-	0xa0, 0xe6, 0xa3, 0x68, 0x84, 0x99, 0x45, 0x5c, 0xb5, 0x2a, 0x18, 0x9c, 0x49, 0x1d, 0x3d, 0xb1,
-	0x14, 0x19, 0x64, 0xc4, 0x19, 0xe1, 0x48, 0x9d, 0x4e, 0x97, 0x92, 0x3a, 0xfb, 0xc2, 0x07, 0x9b,
-	0xa0, 0x9e, 0x0a, 0xd9, 0xe2, 0x37, 0x40, 0x9d, 0x11, 0x10, 0x90, 0xba, 0xba, 0x28, 0xc6, 0x70,
-	0x15, 0xcc, 0x4a, 0xc0, 0xd3, 0x84, 0x32, 0xac, 0xd6, 0x04, 0x42, 0x26, 0x3d, 0xe2, 0x2e, 0x78,
-	0x3f, 0xaf, 0xc1, 0xc6, 0x21, 0x56, 0x41, 0x4b, 0xe9, 0xcc, 0x6f, 0xfc, 0xa3, 0x17, 0xb7, 0x59,
-	0x5e, 0x15, 0x79, 0x73, 0xf4, 0x1d, 0x61, 0xf6, 0xc7, 0x21, 0xce, 0x4e, 0xe2, 0xff, 0xf0, 0x4f,
-	0x50, 0xc5, 0xcf, 0x42, 0x12, 0x8d, 0xd5, 0x7a, 0x4b, 0xe9, 0x4c, 0x99, 0xd2, 0x6a, 0x1f, 0x95,
-	0x01, 0x2c, 0xc4, 0xba, 0xe7, 0x0c, 0xb1, 0x9b, 0x78, 0x18, 0xce, 0x83, 0x32, 0x71, 0x85, 0xd0,
-	0x2a, 0x66, 0x99, 0xb8, 0xb0, 0x01, 0xf2, 0xd1, 0xa5, 0x98, 0x0a, 0x2a, 0x1a, 0x60, 0x86, 0xaf,
-	0x10, 0xfb, 0x38, 0x12, 0x1a, 0xa9, 0x99, 0xb9, 0x0d, 0x9f, 0x2b, 0x60, 0xd9, 0xf1, 0x10, 0xf1,
-	0x91, 0xed, 0xe1, 0xc9, 0x4b, 0xc4, 0x9f, 0x0a, 0xb1, 0xcf, 0xfa, 0x46, 0xe3, 0x07, 0x45, 0xf5,
-	0xb3, 0x77, 0xa4, 0x7b, 0x45, 0x4a, 0xaa, 0x95, 0x4a, 0xea, 0xa7, 0xa5, 0xda, 0x87, 0x5c, 0x4a,
-	0x4b, 0x79, 0xbc, 0x18, 0x89, 0xd7, 0x82, 0xdb, 0x60, 0x71, 0x22, 0x01, 0xf9, 0x34, 0x09, 0x98,
-	0x10, 0x04, 0xd7, 0x73, 0xaa, 0x04, 0x9d, 0xaf, 0x28, 0x67, 0xf1, 0x1e, 0x25, 0x41, 0xb7, 0xc2,
-	0x0f, 0x37, 0x17, 0x8a, 0xcc, 0xbb, 0x22, 0xb1, 0x4b, 0x8e, 0xcf, 0x34, 0xe5, 0xe4, 0x4c, 0x53,
-	0x3e, 0x9e, 0x69, 0xca, 0xe1, 0xb9, 0x56, 0x3a, 0x39, 0xd7, 0x4a, 0xef, 0xce, 0xb5, 0xd2, 0xe3,
-	0x9d, 0x01, 0x61, 0xc3, 0xc4, 0xd6, 0x1d, 0xea, 0x1b, 0xbd, 0x6c, 0x43, 0xdb, 0xc8, 0x8e, 0x8d,
-	0x7c, 0x5f, 0x57, 0x1d, 0x1a, 0xe1, 0x49, 0x73, 0x88, 0x48, 0x60, 0xf8, 0x94, 0xd3, 0x1e, 0x4f,
-	0xbc, 0xe3, 0x7c, 0xdb, 0xb1, 0x5d, 0x15, 0x9c, 0x5c, 0xff, 0x16, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x5b, 0xe9, 0xd1, 0xeb, 0x05, 0x00, 0x00,
+	// 976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0x93, 0x36, 0x6d, 0x26, 0xdd, 0xd5, 0x76, 0xc4, 0x52, 0x27, 0x85, 0xa4, 0xcd, 0xb2,
+	0x52, 0xf9, 0xb2, 0xd9, 0x82, 0x84, 0x58, 0x10, 0x82, 0x6c, 0x41, 0x44, 0x2a, 0x6c, 0x70, 0x7b,
+	0xe2, 0x62, 0x8d, 0xed, 0xb7, 0xc9, 0x10, 0xdb, 0x63, 0xec, 0xf1, 0x6a, 0x73, 0x86, 0x13, 0xa7,
+	0x3d, 0x22, 0x4e, 0x48, 0xfc, 0x01, 0xf6, 0x5f, 0xec, 0x09, 0xed, 0x11, 0x21, 0x51, 0x50, 0x7b,
+	0x80, 0x03, 0x27, 0x7e, 0x01, 0x9a, 0x0f, 0xdb, 0x69, 0x57, 0xbb, 0x0d, 0xe2, 0x52, 0x79, 0xde,
+	0x79, 0x9e, 0x77, 0xde, 0xaf, 0xe7, 0x6d, 0xd0, 0xab, 0x34, 0xfe, 0x12, 0x7c, 0x4e, 0xef, 0x81,
+	0x4d, 0xe3, 0x2c, 0x4f, 0x49, 0xec, 0x83, 0x7d, 0xef, 0x96, 0x07, 0x9c, 0xdc, 0xaa, 0x2c, 0x56,
+	0x92, 0x32, 0xce, 0xf0, 0x56, 0x09, 0xb6, 0xaa, 0x2b, 0x0d, 0xee, 0x3c, 0x37, 0x66, 0x63, 0x26,
+	0x71, 0xb6, 0xf8, 0x52, 0x94, 0x4e, 0x77, 0xcc, 0xd8, 0x38, 0x04, 0x5b, 0x9e, 0xbc, 0xfc, 0xd8,
+	0x0e, 0xf2, 0x94, 0x70, 0xca, 0x62, 0x7d, 0xdf, 0xbb, 0x78, 0xcf, 0x69, 0x04, 0x19, 0x27, 0x51,
+	0x52, 0x38, 0xf0, 0x59, 0x16, 0xb1, 0xcc, 0xf6, 0x48, 0x56, 0x05, 0xe6, 0x33, 0x5a, 0x38, 0xb8,
+	0x59, 0x25, 0xc0, 0x52, 0xe2, 0x87, 0x15, 0x48, 0x1d, 0x35, 0x6c, 0x83, 0x44, 0x34, 0x66, 0xb6,
+	0xfc, 0xab, 0x4c, 0xfd, 0xdf, 0x6a, 0xa8, 0x31, 0x22, 0x29, 0x89, 0x32, 0xfc, 0xd0, 0x40, 0x2f,
+	0x07, 0x70, 0x4c, 0xf2, 0x90, 0xbb, 0x29, 0x04, 0x10, 0x25, 0x22, 0x44, 0x37, 0x66, 0x9c, 0xfa,
+	0xe0, 0x26, 0x90, 0x52, 0x16, 0xb8, 0x45, 0xe4, 0xa6, 0xb1, 0x6d, 0xec, 0xb6, 0xf6, 0xda, 0x96,
+	0x0a, 0xdd, 0x2a, 0x42, 0xb7, 0xf6, 0x35, 0x60, 0xf0, 0xde, 0xa3, 0x93, 0xde, 0xd2, 0x3f, 0x27,
+	0xbd, 0x37, 0x66, 0x24, 0x0a, 0x6f, 0xf7, 0x17, 0xf6, 0xdc, 0xff, 0xee, 0xf7, 0x9e, 0xe1, 0xdc,
+	0xd4, 0x78, 0xa7, 0x84, 0x7f, 0x26, 0xd1, 0x23, 0x09, 0x2e, 0x1e, 0xc1, 0x5f, 0x1b, 0xa8, 0x9d,
+	0xc5, 0x24, 0xc9, 0x26, 0x4c, 0xb8, 0xe6, 0x10, 0x4b, 0xcf, 0xca, 0xa5, 0x59, 0xbb, 0x2c, 0xc6,
+	0xd7, 0x74, 0x8c, 0xdb, 0x2a, 0xc6, 0xa7, 0x7a, 0x52, 0x31, 0x6d, 0x16, 0xf7, 0x4e, 0x71, 0xad,
+	0xa2, 0xb9, 0xdd, 0xfe, 0xeb, 0x87, 0x9e, 0xf1, 0xed, 0x9f, 0x3f, 0xbd, 0x72, 0xad, 0x1a, 0x1f,
+	0x55, 0xd4, 0xfe, 0xdf, 0xcb, 0xe8, 0xca, 0xb0, 0x30, 0x7e, 0x9c, 0xc7, 0x01, 0xbe, 0x81, 0xae,
+	0x04, 0x90, 0xb0, 0x8c, 0x72, 0x37, 0x80, 0x98, 0x45, 0xb2, 0x92, 0x4d, 0x67, 0x5d, 0x1b, 0xf7,
+	0x85, 0x0d, 0xbf, 0x8b, 0x3a, 0xa5, 0x2b, 0x37, 0x61, 0x2c, 0x74, 0x39, 0x9b, 0x42, 0xac, 0x19,
+	0x35, 0xc9, 0xd8, 0x2c, 0x11, 0x23, 0xc6, 0xc2, 0x23, 0x71, 0xaf, 0xc8, 0xdf, 0x1b, 0x68, 0xe7,
+	0xf2, 0x06, 0xd6, 0x2f, 0x2b, 0xce, 0x5b, 0xba, 0x38, 0xbb, 0xaa, 0x38, 0x0b, 0x36, 0xae, 0x9b,
+	0x3e, 0xbb, 0x63, 0x6f, 0xa3, 0x55, 0x8f, 0x84, 0x22, 0x6a, 0x73, 0x59, 0xa4, 0x31, 0x78, 0x51,
+	0x3c, 0xf3, 0xeb, 0x49, 0xef, 0xba, 0x9a, 0xf1, 0x2c, 0x98, 0x5a, 0x94, 0xd9, 0x11, 0xe1, 0x13,
+	0x6b, 0x18, 0x73, 0xa7, 0x40, 0xe3, 0xf7, 0x51, 0x8b, 0x33, 0x4e, 0x42, 0x37, 0x9b, 0x90, 0x14,
+	0xcc, 0x95, 0x45, 0xc8, 0x48, 0x32, 0x0e, 0x05, 0x01, 0x6f, 0xa1, 0x66, 0x44, 0xd2, 0x29, 0x70,
+	0x97, 0x06, 0x66, 0x43, 0x56, 0x70, 0x4d, 0x19, 0x86, 0xb2, 0x29, 0xfa, 0x92, 0x53, 0x7f, 0x0a,
+	0xa9, 0xb9, 0xaa, 0x9a, 0xa2, 0x8c, 0x47, 0xd2, 0x86, 0x7b, 0xa8, 0xa5, 0xe4, 0xe4, 0x0a, 0x1d,
+	0x9a, 0x6b, 0x12, 0x82, 0x94, 0x69, 0x40, 0x32, 0xc0, 0x3b, 0x68, 0x5d, 0x03, 0xbe, 0xca, 0x19,
+	0x07, 0xb3, 0x29, 0x11, 0x9a, 0xf4, 0xb9, 0x30, 0xe1, 0x8f, 0x4a, 0x1f, 0x7c, 0x96, 0x80, 0x89,
+	0xb6, 0x8d, 0xdd, 0xab, 0x7b, 0x2f, 0x59, 0xd5, 0x4e, 0xd1, 0x82, 0xd5, 0xfa, 0xb5, 0xee, 0xca,
+	0xe3, 0xd1, 0x2c, 0x81, 0xe2, 0x25, 0xf1, 0x8d, 0x9f, 0x47, 0x0d, 0xb8, 0x9f, 0xd0, 0x74, 0x66,
+	0xb6, 0xb6, 0x8d, 0xdd, 0xba, 0xa3, 0x4f, 0xfd, 0x87, 0x35, 0x84, 0x2b, 0xc9, 0x1c, 0xfa, 0x13,
+	0x08, 0xf2, 0x10, 0xf0, 0x55, 0x54, 0xa3, 0x81, 0x1c, 0xb4, 0x65, 0xa7, 0x46, 0x03, 0xdc, 0x41,
+	0x65, 0xea, 0x7a, 0x98, 0xaa, 0x52, 0x74, 0xd0, 0x9a, 0x68, 0x21, 0x44, 0x90, 0xca, 0x19, 0x69,
+	0x3a, 0xe5, 0x19, 0x7f, 0x63, 0xa0, 0xb6, 0x1f, 0x12, 0x1a, 0x11, 0x2f, 0x84, 0x79, 0x29, 0x8b,
+	0x85, 0x25, 0xfb, 0xd9, 0xda, 0xeb, 0x3c, 0x31, 0x51, 0x47, 0xc5, 0x36, 0xbb, 0xa8, 0xb7, 0xa7,
+	0xba, 0xea, 0x3f, 0x90, 0x7a, 0x2b, 0xef, 0xab, 0x94, 0x84, 0x2f, 0x7c, 0x80, 0x36, 0xe6, 0x08,
+	0x24, 0x62, 0x79, 0xcc, 0xcd, 0x15, 0x3d, 0xcf, 0x6a, 0x12, 0x2c, 0xd1, 0xa2, 0xb2, 0x8a, 0x77,
+	0x18, 0x8d, 0x07, 0xcb, 0xe2, 0x71, 0xe7, 0x5a, 0xc5, 0xfc, 0x50, 0x12, 0xfb, 0x3f, 0x1b, 0xa8,
+	0x2d, 0x47, 0x24, 0x38, 0x27, 0xd4, 0x4f, 0x65, 0x41, 0xce, 0x8f, 0x8d, 0x71, 0xa1, 0x56, 0x9b,
+	0x68, 0xf5, 0x38, 0x8f, 0x03, 0x97, 0x16, 0x65, 0x6c, 0x88, 0xe3, 0x30, 0xc0, 0x1f, 0xa0, 0x75,
+	0xb8, 0x9f, 0xb0, 0x2c, 0x4f, 0xc1, 0xf5, 0x49, 0x62, 0xd6, 0x17, 0x99, 0xd6, 0x56, 0x41, 0xb9,
+	0x43, 0x12, 0xfc, 0x0e, 0x5a, 0x2b, 0x8e, 0x8b, 0x09, 0xa5, 0x84, 0xf7, 0x7f, 0xac, 0xa3, 0xeb,
+	0xe7, 0x52, 0x39, 0xd4, 0x7b, 0xeb, 0xd9, 0xc9, 0xf4, 0x50, 0x4b, 0x4a, 0xeb, 0xdc, 0x92, 0x41,
+	0xd2, 0xa4, 0xf6, 0xca, 0x0e, 0x5a, 0xf7, 0x42, 0xe6, 0x4f, 0xdd, 0x09, 0xd0, 0xf1, 0x84, 0xcb,
+	0xa4, 0xea, 0x4e, 0x4b, 0xda, 0x3e, 0x91, 0x26, 0xfc, 0x02, 0x6a, 0x96, 0xff, 0xbb, 0x64, 0xd8,
+	0x75, 0xa7, 0x32, 0xcc, 0x6b, 0x7f, 0xe5, 0xff, 0x68, 0xbf, 0xf1, 0x5f, 0xb5, 0xbf, 0x5f, 0xa4,
+	0x96, 0xa4, 0xd4, 0x07, 0x25, 0xee, 0xc1, 0x0d, 0xcd, 0xdf, 0x7a, 0x92, 0x7f, 0x00, 0x63, 0xe2,
+	0xcf, 0xf6, 0xc1, 0xd7, 0xf9, 0x8f, 0x04, 0x0d, 0x1f, 0x20, 0xec, 0xe7, 0x51, 0x1e, 0x12, 0x21,
+	0x54, 0x37, 0x21, 0x33, 0x96, 0xf3, 0xcc, 0x5c, 0x5b, 0x24, 0x98, 0x8d, 0x8a, 0x38, 0x52, 0xbc,
+	0x01, 0x7d, 0x74, 0xda, 0x35, 0x1e, 0x9f, 0x76, 0x8d, 0x3f, 0x4e, 0xbb, 0xc6, 0x83, 0xb3, 0xee,
+	0xd2, 0xe3, 0xb3, 0xee, 0xd2, 0x2f, 0x67, 0xdd, 0xa5, 0x2f, 0xee, 0x8e, 0x29, 0x9f, 0xe4, 0x9e,
+	0xe5, 0xb3, 0xc8, 0x1e, 0x16, 0x8b, 0xe1, 0x80, 0x78, 0x99, 0x5d, 0xae, 0x89, 0xd7, 0x7d, 0x96,
+	0xc2, 0xfc, 0x71, 0x42, 0x68, 0x6c, 0x47, 0x4c, 0xa8, 0x3d, 0x9b, 0xfb, 0x11, 0x23, 0x96, 0x4c,
+	0xe6, 0x35, 0xa4, 0x14, 0xdf, 0xfc, 0x77, 0x00, 0xb5, 0xf6, 0xa3, 0x67, 0xe8, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -370,6 +538,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DefaultRedemptionNoticePeriodDuration != that1.DefaultRedemptionNoticePeriodDuration {
 		return false
 	}
+	if this.SnapshotRetentionPeriod != that1.SnapshotRetentionPeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -392,13 +563,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DefaultRedemptionNoticePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultRedemptionNoticePeriodDuration):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SnapshotRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SnapshotRetentionPeriod):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintInsurance(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DefaultRedemptionNoticePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultRedemptionNoticePeriodDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintInsurance(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -481,12 +660,12 @@ func (m *InsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RedemptionNoticePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RedemptionNoticePeriodDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RedemptionNoticePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RedemptionNoticePeriodDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintInsurance(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintInsurance(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.InsurancePoolTokenDenom) > 0 {
@@ -536,12 +715,12 @@ func (m *RedemptionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x2a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimableRedemptionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimableRedemptionTime):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClaimableRedemptionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClaimableRedemptionTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintInsurance(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintInsurance(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Redeemer) > 0 {
@@ -566,6 +745,150 @@ func (m *RedemptionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SharedInsuranceFundMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharedInsuranceFundMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharedInsuranceFundMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Exposure.Size()
+		i -= size
+		if _, err := m.Exposure.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExposureCap.Size()
+		i -= size
+		if _, err := m.ExposureCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FundId) > 0 {
+		i -= len(m.FundId)
+		copy(dAtA[i:], m.FundId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.FundId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePayouts.Size()
+		i -= size
+		if _, err := m.CumulativePayouts.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalShare.Size()
+		i -= size
+		if _, err := m.TotalShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Timestamp != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInsurance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInsurance(v)
 	base := offset
@@ -585,6 +908,8 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultRedemptionNoticePeriodDuration)
 	n += 1 + l + sovInsurance(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SnapshotRetentionPeriod)
+	n += 1 + l + sovInsurance(uint64(l))
 	return n
 }
 
@@ -657,6 +982,58 @@ func (m *RedemptionSchedule) Size() (n int) {
 	return n
 }
 
+func (m *SharedInsuranceFundMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.FundId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = m.ExposureCap.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	l = m.Exposure.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovInsurance(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovInsurance(uint64(m.Timestamp))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	l = m.TotalShare.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	l = m.SharePrice.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	l = m.CumulativePayouts.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	return n
+}
+
 func sovInsurance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -725,16 +1102,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInsurance(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInsurance
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionPeriod", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SnapshotRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -1326,6 +1736,476 @@ func (m *RedemptionSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SharedInsuranceFundMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharedInsuranceFundMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharedInsuranceFundMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExposureCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExposureCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exposure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Exposure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePayouts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePayouts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInsurance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -21,6 +22,12 @@ var (
 	GlobalShareDenomIdPrefixKey         = []byte{0x04, 0x00}
 	GlobalRedemptionScheduleIdPrefixKey = []byte{0x05, 0x00}
 
+	// Key for the markets backed by shared insurance funds
+	SharedInsuranceFundMarketPrefixKey = []byte{0x06}
+
+	// Key for the historical snapshots of insurance funds
+	InsuranceFundSnapshotPrefixKey = []byte{0x07}
+
 	ParamsKey = []byte{0x10}
 )
 
@@ -36,3 +43,18 @@ func GetRedemptionScheduleKey(redemptionID uint64, claimTime time.Time) []byte {
 func (sh RedemptionSchedule) GetRedemptionScheduleKey() []byte {
 	return GetRedemptionScheduleKey(sh.Id, sh.ClaimableRedemptionTime)
 }
+
+// GetSharedInsuranceFundMarketKey provides the key to store the shared insurance fund link of a market
+func GetSharedInsuranceFundMarketKey(marketID common.Hash) []byte {
+	return append(SharedInsuranceFundMarketPrefixKey, marketID.Bytes()...)
+}
+
+// GetInsuranceFundSnapshotPrefix provides the prefix of the snapshots of an insurance fund
+func GetInsuranceFundSnapshotPrefix(marketID common.Hash) []byte {
+	return append(InsuranceFundSnapshotPrefixKey, marketID.Bytes()...)
+}
+
+// GetInsuranceFundSnapshotKey provides the key to store the snapshot of an insurance fund at a timestamp in seconds
+func GetInsuranceFundSnapshotKey(marketID common.Hash, timestamp int64) []byte {
+	return append(GetInsuranceFundSnapshotPrefix(marketID), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -15,6 +16,9 @@ var (
 	_ sdk.Msg = &MsgUnderwrite{}
 	_ sdk.Msg = &MsgRequestRedemption{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateSharedInsuranceFund{}
+	_ sdk.Msg = &MsgSetSharedInsuranceFundMarket{}
+	_ sdk.Msg = &MsgRemoveSharedInsuranceFundMarket{}
 )
 
 // Route implements the sdk.Msg interface. It should return the name of the module
@@ -182,3 +186,106 @@ func (msg MsgRequestRedemption) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgCreateSharedInsuranceFund) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgCreateSharedInsuranceFund) Type() string { return "createSharedInsuranceFund" }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgCreateSharedInsuranceFund) ValidateBasic() error {
+	if msg.Sender == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.Ticker == "" || len(msg.Ticker) > 40 {
+		return errors.Wrapf(ErrInvalidTicker, "ticker should not be empty or exceed 40 characters")
+	}
+	if !msg.InitialDeposit.IsValid() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.InitialDeposit.String())
+	}
+	if !msg.InitialDeposit.IsPositive() || msg.InitialDeposit.Amount.GT(MaxUnderwritingAmount) {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.InitialDeposit.String())
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCreateSharedInsuranceFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgCreateSharedInsuranceFund) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgSetSharedInsuranceFundMarket) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgSetSharedInsuranceFundMarket) Type() string { return "setSharedInsuranceFundMarket" }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgSetSharedInsuranceFundMarket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	market := SharedInsuranceFundMarket{
+		MarketId:    msg.MarketId,
+		FundId:      msg.FundId,
+		ExposureCap: msg.ExposureCap,
+		Exposure:    math.ZeroInt(),
+	}
+	return market.Validate()
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgSetSharedInsuranceFundMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgSetSharedInsuranceFundMarket) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgRemoveSharedInsuranceFundMarket) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgRemoveSharedInsuranceFundMarket) Type() string { return "removeSharedInsuranceFundMarket" }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgRemoveSharedInsuranceFundMarket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if !isHexHash(msg.MarketId) {
+		return errors.Wrap(ErrInvalidMarketID, msg.MarketId)
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgRemoveSharedInsuranceFundMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgRemoveSharedInsuranceFundMarket) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
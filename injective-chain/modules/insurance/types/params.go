@@ -15,13 +15,28 @@ const (
 	// DefaultInsurancePeriodDurationSeconds represents the number of seconds in two weeks
 	DefaultInsurancePeriod              = time.Hour * 24 * 14
 	DefaultBinaryOptionsInsurancePeriod = time.Minute
+	// DefaultSnapshotRetentionPeriod represents 90 days
+	DefaultSnapshotRetentionPeriod = time.Hour * 24 * 90
 )
 
+// DefaultInsuranceFundAPYWindows are the windows in seconds the APY of insurance funds is computed over by default,
+// 7, 30 and 90 days
+var DefaultInsuranceFundAPYWindows = []int64{7 * 24 * 3600, 30 * 24 * 3600, 90 * 24 * 3600}
+
+// MaxInsuranceFundAPYWindows is the maximum number of windows in a single APY query
+const MaxInsuranceFundAPYWindows = 10
+
+// SecondsPerYear is used to annualize the yield of insurance funds
+const SecondsPerYear int64 = 365 * 24 * 3600
+
 // MaxUnderwritingAmount equals 1 trillion * 1e18
 var MaxUnderwritingAmount, _ = math.NewIntFromString("1000000000000000000000000000")
 var PerpetualExpiryFlag int64 = -1
 var BinaryOptionsExpiryFlag int64 = -2
 
+// SharedInsuranceFundExpiryFlag marks insurance funds that back multiple markets instead of a single one
+var SharedInsuranceFundExpiryFlag int64 = -3
+
 // Parameter keys
 var (
 	KeyDefaultRedemptionNoticePeriodDuration = []byte("defaultRedemptionNoticePeriodDuration")
@@ -35,9 +50,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	defaultRedemptionNoticePeriodDuration time.Duration,
+	snapshotRetentionPeriod time.Duration,
 ) Params {
 	return Params{
 		DefaultRedemptionNoticePeriodDuration: defaultRedemptionNoticePeriodDuration,
+		SnapshotRetentionPeriod:               snapshotRetentionPeriod,
 	}
 }
 
@@ -52,6 +69,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return Params{
 		DefaultRedemptionNoticePeriodDuration: DefaultInsurancePeriod,
+		SnapshotRetentionPeriod:               DefaultSnapshotRetentionPeriod,
 	}
 }
 
@@ -61,6 +79,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSnapshotRetentionPeriod(p.SnapshotRetentionPeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -76,3 +98,16 @@ func validateNoticePeriodDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateSnapshotRetentionPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("SnapshotRetentionPeriod must not be negative: %d", v)
+	}
+
+	return nil
+}
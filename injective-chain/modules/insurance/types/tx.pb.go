@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -378,6 +379,291 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCreateSharedInsuranceFund a message to create an insurance fund that can
+// back multiple markets
+type MsgCreateSharedInsuranceFund struct {
+	// Creator of the insurance fund.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Ticker of the shared insurance fund.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Initial deposit of the insurance fund. Its denom must be the quote denom of
+	// the markets backed by the fund
+	InitialDeposit types1.Coin `protobuf:"bytes,3,opt,name=initial_deposit,json=initialDeposit,proto3" json:"initial_deposit"`
+}
+
+func (m *MsgCreateSharedInsuranceFund) Reset()         { *m = MsgCreateSharedInsuranceFund{} }
+func (m *MsgCreateSharedInsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSharedInsuranceFund) ProtoMessage()    {}
+func (*MsgCreateSharedInsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{8}
+}
+func (m *MsgCreateSharedInsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSharedInsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSharedInsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSharedInsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSharedInsuranceFund.Merge(m, src)
+}
+func (m *MsgCreateSharedInsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSharedInsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSharedInsuranceFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSharedInsuranceFund proto.InternalMessageInfo
+
+type MsgCreateSharedInsuranceFundResponse struct {
+	// fund_id of the created insurance fund, used as market id to underwrite
+	// and redeem from the fund
+	FundId string `protobuf:"bytes,1,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+}
+
+func (m *MsgCreateSharedInsuranceFundResponse) Reset()         { *m = MsgCreateSharedInsuranceFundResponse{} }
+func (m *MsgCreateSharedInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSharedInsuranceFundResponse) ProtoMessage()    {}
+func (*MsgCreateSharedInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{9}
+}
+func (m *MsgCreateSharedInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSharedInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSharedInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSharedInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSharedInsuranceFundResponse.Merge(m, src)
+}
+func (m *MsgCreateSharedInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSharedInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSharedInsuranceFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSharedInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSharedInsuranceFundResponse) GetFundId() string {
+	if m != nil {
+		return m.FundId
+	}
+	return ""
+}
+
+type MsgSetSharedInsuranceFundMarket struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	FundId    string `protobuf:"bytes,2,opt,name=fund_id,json=fundId,proto3" json:"fund_id,omitempty"`
+	MarketId  string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// exposure_cap is the maximum net amount the market can draw from the fund
+	ExposureCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=exposure_cap,json=exposureCap,proto3,customtype=cosmossdk.io/math.Int" json:"exposure_cap"`
+}
+
+func (m *MsgSetSharedInsuranceFundMarket) Reset()         { *m = MsgSetSharedInsuranceFundMarket{} }
+func (m *MsgSetSharedInsuranceFundMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSharedInsuranceFundMarket) ProtoMessage()    {}
+func (*MsgSetSharedInsuranceFundMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{10}
+}
+func (m *MsgSetSharedInsuranceFundMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSharedInsuranceFundMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSharedInsuranceFundMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSharedInsuranceFundMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSharedInsuranceFundMarket.Merge(m, src)
+}
+func (m *MsgSetSharedInsuranceFundMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSharedInsuranceFundMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSharedInsuranceFundMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSharedInsuranceFundMarket proto.InternalMessageInfo
+
+func (m *MsgSetSharedInsuranceFundMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSharedInsuranceFundMarket) GetFundId() string {
+	if m != nil {
+		return m.FundId
+	}
+	return ""
+}
+
+func (m *MsgSetSharedInsuranceFundMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+type MsgSetSharedInsuranceFundMarketResponse struct {
+}
+
+func (m *MsgSetSharedInsuranceFundMarketResponse) Reset() {
+	*m = MsgSetSharedInsuranceFundMarketResponse{}
+}
+func (m *MsgSetSharedInsuranceFundMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSharedInsuranceFundMarketResponse) ProtoMessage()    {}
+func (*MsgSetSharedInsuranceFundMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{11}
+}
+func (m *MsgSetSharedInsuranceFundMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSharedInsuranceFundMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSharedInsuranceFundMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSharedInsuranceFundMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSharedInsuranceFundMarketResponse.Merge(m, src)
+}
+func (m *MsgSetSharedInsuranceFundMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSharedInsuranceFundMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSharedInsuranceFundMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSharedInsuranceFundMarketResponse proto.InternalMessageInfo
+
+type MsgRemoveSharedInsuranceFundMarket struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MarketId  string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarket) Reset()         { *m = MsgRemoveSharedInsuranceFundMarket{} }
+func (m *MsgRemoveSharedInsuranceFundMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSharedInsuranceFundMarket) ProtoMessage()    {}
+func (*MsgRemoveSharedInsuranceFundMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{12}
+}
+func (m *MsgRemoveSharedInsuranceFundMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSharedInsuranceFundMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSharedInsuranceFundMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSharedInsuranceFundMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSharedInsuranceFundMarket.Merge(m, src)
+}
+func (m *MsgRemoveSharedInsuranceFundMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSharedInsuranceFundMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSharedInsuranceFundMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSharedInsuranceFundMarket proto.InternalMessageInfo
+
+func (m *MsgRemoveSharedInsuranceFundMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarket) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+type MsgRemoveSharedInsuranceFundMarketResponse struct {
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) Reset() {
+	*m = MsgRemoveSharedInsuranceFundMarketResponse{}
+}
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRemoveSharedInsuranceFundMarketResponse) ProtoMessage() {}
+func (*MsgRemoveSharedInsuranceFundMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{13}
+}
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSharedInsuranceFundMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSharedInsuranceFundMarketResponse.Merge(m, src)
+}
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSharedInsuranceFundMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSharedInsuranceFundMarketResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateInsuranceFund)(nil), "injective.insurance.v1beta1.MsgCreateInsuranceFund")
 	proto.RegisterType((*MsgCreateInsuranceFundResponse)(nil), "injective.insurance.v1beta1.MsgCreateInsuranceFundResponse")
@@ -387,6 +673,12 @@ func init() {
 	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "injective.insurance.v1beta1.MsgRequestRedemptionResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "injective.insurance.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.insurance.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateSharedInsuranceFund)(nil), "injective.insurance.v1beta1.MsgCreateSharedInsuranceFund")
+	proto.RegisterType((*MsgCreateSharedInsuranceFundResponse)(nil), "injective.insurance.v1beta1.MsgCreateSharedInsuranceFundResponse")
+	proto.RegisterType((*MsgSetSharedInsuranceFundMarket)(nil), "injective.insurance.v1beta1.MsgSetSharedInsuranceFundMarket")
+	proto.RegisterType((*MsgSetSharedInsuranceFundMarketResponse)(nil), "injective.insurance.v1beta1.MsgSetSharedInsuranceFundMarketResponse")
+	proto.RegisterType((*MsgRemoveSharedInsuranceFundMarket)(nil), "injective.insurance.v1beta1.MsgRemoveSharedInsuranceFundMarket")
+	proto.RegisterType((*MsgRemoveSharedInsuranceFundMarketResponse)(nil), "injective.insurance.v1beta1.MsgRemoveSharedInsuranceFundMarketResponse")
 }
 
 func init() {
@@ -394,56 +686,71 @@ func init() {
 }
 
 var fileDescriptor_7e1fa941c3fd0dc4 = []byte{
-	// 779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x1b, 0x08, 0x30, 0x50, 0x10, 0x2e, 0x25, 0x4e, 0xa8, 0x9c, 0x34, 0xa5, 0x52, 0x94,
-	0x82, 0xad, 0x84, 0x8a, 0x96, 0xf4, 0x44, 0xa0, 0x55, 0x91, 0x1a, 0xd1, 0xa6, 0xed, 0xa5, 0x97,
-	0x68, 0x62, 0x8f, 0xcc, 0x94, 0xd8, 0x63, 0x3c, 0x63, 0x96, 0xdc, 0x76, 0xf7, 0xb2, 0xab, 0x3d,
-	0xf1, 0x11, 0xf8, 0x08, 0x39, 0xec, 0x69, 0xb5, 0x1f, 0x80, 0x23, 0xda, 0xd3, 0x9e, 0x56, 0x2b,
-	0x38, 0xb0, 0x1f, 0x62, 0x0f, 0x2b, 0x7b, 0xc6, 0x76, 0xb2, 0x84, 0xbf, 0x17, 0xf0, 0xfb, 0xbd,
-	0xdf, 0x7b, 0xef, 0xf7, 0xf3, 0x9b, 0x8c, 0xc1, 0x32, 0x76, 0xfe, 0x47, 0x06, 0xc3, 0x87, 0x48,
-	0xc7, 0x0e, 0xf5, 0x3d, 0xe8, 0x18, 0x48, 0x3f, 0xac, 0x76, 0x10, 0x83, 0x55, 0x9d, 0x1d, 0x69,
-	0xae, 0x47, 0x18, 0x91, 0x97, 0x62, 0x96, 0x16, 0xb3, 0x34, 0xc1, 0xca, 0x2f, 0x58, 0xc4, 0x22,
-	0x21, 0x4f, 0x0f, 0x9e, 0x78, 0x49, 0x5e, 0x35, 0x08, 0xb5, 0x09, 0xd5, 0x3b, 0x90, 0x26, 0x0d,
-	0x0d, 0x82, 0x1d, 0x91, 0xcf, 0x8a, 0xbc, 0x4d, 0x2d, 0xfd, 0xb0, 0x1a, 0xfc, 0x13, 0x89, 0x1c,
-	0x4f, 0xb4, 0x79, 0x47, 0x1e, 0x88, 0xd4, 0x0f, 0x37, 0x89, 0x4d, 0x84, 0x71, 0xf2, 0xf7, 0x09,
-	0x99, 0x78, 0xd0, 0xe8, 0x26, 0x4c, 0x1e, 0x0a, 0xda, 0x3c, 0xb4, 0xb1, 0x43, 0xf4, 0xf0, 0x2f,
-	0x87, 0x4a, 0xc7, 0x69, 0xb0, 0xd8, 0xa4, 0xd6, 0x96, 0x87, 0x20, 0x43, 0x3b, 0x51, 0xdb, 0xdf,
-	0x7c, 0xc7, 0x94, 0x17, 0x41, 0x86, 0x22, 0xc7, 0x44, 0x9e, 0x22, 0x15, 0xa5, 0xf2, 0x54, 0x4b,
-	0x44, 0x01, 0xce, 0xb0, 0xb1, 0x8f, 0x3c, 0xe5, 0x0b, 0x8e, 0xf3, 0x48, 0x2e, 0x80, 0xe9, 0x03,
-	0x9f, 0x30, 0xd4, 0x36, 0x91, 0x43, 0x6c, 0x25, 0x1d, 0x26, 0x41, 0x08, 0x6d, 0x07, 0x48, 0x40,
-	0xe0, 0x72, 0xda, 0xc1, 0x8b, 0x52, 0xc6, 0x38, 0x81, 0x43, 0x0d, 0x48, 0x91, 0xfc, 0x2d, 0x98,
-	0x11, 0x84, 0xb0, 0x4a, 0x19, 0x0f, 0x19, 0xa2, 0xe8, 0xaf, 0x00, 0x92, 0x7f, 0x8d, 0x7b, 0xb0,
-	0x9e, 0x8b, 0x94, 0x4c, 0x51, 0x2a, 0xcf, 0xd6, 0x96, 0xb5, 0x64, 0x67, 0xc2, 0xb0, 0xf0, 0xaf,
-	0xed, 0x86, 0xe1, 0x3f, 0x3d, 0x17, 0x45, 0x93, 0x82, 0xe7, 0xc0, 0x03, 0x3a, 0x72, 0xb1, 0xd7,
-	0x53, 0x26, 0x8a, 0x52, 0x39, 0xdd, 0x12, 0x91, 0xfc, 0x3b, 0x98, 0xc3, 0x0e, 0x66, 0x18, 0x76,
-	0xdb, 0x26, 0x72, 0x09, 0xc5, 0x4c, 0x99, 0x2c, 0x4a, 0xe5, 0xe9, 0x5a, 0x4e, 0x13, 0xdb, 0x09,
-	0xa4, 0xc7, 0xdd, 0xb7, 0x08, 0x76, 0x1a, 0x63, 0xa7, 0xef, 0x0a, 0xa9, 0xd6, 0xac, 0xa8, 0xdb,
-	0xe6, 0x65, 0xf5, 0x9f, 0x9f, 0x9f, 0x14, 0x52, 0x1f, 0x4e, 0x0a, 0xa9, 0xa7, 0x97, 0xfd, 0x8a,
-	0x78, 0x75, 0x2f, 0x2e, 0xfb, 0x95, 0x62, 0xb2, 0xcd, 0xd1, 0xef, 0xbd, 0x54, 0x04, 0xea, 0xe8,
-	0x4c, 0x0b, 0x51, 0x97, 0x38, 0x14, 0x95, 0xfa, 0x12, 0xf8, 0xb2, 0x49, 0xad, 0x7f, 0x83, 0x9e,
-	0x8f, 0x3c, 0xcc, 0xd0, 0xb5, 0xbb, 0x5a, 0x02, 0x53, 0x36, 0xf4, 0xf6, 0x11, 0x6b, 0x63, 0x53,
-	0xac, 0x6b, 0x92, 0x03, 0x3b, 0xa6, 0xbc, 0x01, 0x26, 0x22, 0x93, 0xe9, 0xbb, 0x99, 0x8c, 0xf8,
-	0x75, 0xfd, 0x1a, 0x77, 0xd9, 0x21, 0x77, 0x89, 0xc0, 0x52, 0x16, 0x7c, 0x3d, 0x04, 0xc4, 0x5e,
-	0x5e, 0x4b, 0x60, 0xa1, 0x49, 0xad, 0x16, 0x3a, 0xf0, 0x11, 0x65, 0x2d, 0x64, 0x22, 0xdb, 0x65,
-	0x98, 0x38, 0x0f, 0xb3, 0xf4, 0x13, 0xc8, 0x40, 0x9b, 0xf8, 0xce, 0x9d, 0x1d, 0x09, 0x7a, 0x7d,
-	0xfd, 0x1a, 0x43, 0xea, 0x90, 0xa1, 0x2b, 0x2a, 0x4b, 0x2a, 0xf8, 0x66, 0x14, 0x1e, 0xdb, 0x7b,
-	0x25, 0x81, 0xb9, 0xc0, 0xb8, 0x6b, 0x42, 0x86, 0xfe, 0x84, 0x1e, 0xb4, 0xa9, 0xbc, 0x0e, 0xa6,
-	0xa0, 0xcf, 0xf6, 0x88, 0x87, 0x59, 0x8f, 0x9b, 0x6b, 0x28, 0x6f, 0x5e, 0xae, 0x2e, 0x08, 0xa9,
-	0x9b, 0xa6, 0xe9, 0x21, 0x4a, 0xff, 0x66, 0x1e, 0x76, 0xac, 0x56, 0x42, 0x95, 0x37, 0x41, 0xc6,
-	0x0d, 0x3b, 0x84, 0xb6, 0xa7, 0x6b, 0xdf, 0x69, 0x37, 0x5c, 0x55, 0x1a, 0x1f, 0x16, 0xd9, 0xe4,
-	0x85, 0xf5, 0x95, 0xc0, 0x5e, 0xd2, 0x32, 0x70, 0x98, 0x1b, 0x5e, 0xd9, 0x80, 0xd0, 0x52, 0x0e,
-	0x64, 0x3f, 0x83, 0x22, 0x5f, 0xb5, 0x8f, 0x69, 0x90, 0x6e, 0x52, 0x4b, 0x7e, 0x26, 0x81, 0xaf,
-	0x46, 0x5d, 0x1e, 0x6b, 0x37, 0x6a, 0x1b, 0x7d, 0xbe, 0xf3, 0xbf, 0x3c, 0xa0, 0x28, 0x52, 0x24,
-	0x77, 0x01, 0x18, 0xf8, 0x41, 0x54, 0x6e, 0x6b, 0x95, 0x70, 0xf3, 0xb5, 0xbb, 0x73, 0xe3, 0x69,
-	0x4f, 0x24, 0x30, 0x7f, 0xf5, 0xcc, 0x56, 0x6f, 0xeb, 0x74, 0xa5, 0x24, 0xbf, 0x71, 0xef, 0x92,
-	0x58, 0x83, 0x07, 0x66, 0x86, 0xce, 0xd5, 0xca, 0xad, 0x3e, 0x06, 0xd8, 0xf9, 0x1f, 0xef, 0xc3,
-	0x8e, 0x66, 0xe6, 0xc7, 0x1f, 0x5f, 0xf6, 0x2b, 0x52, 0x03, 0x9f, 0x9e, 0xab, 0xd2, 0xd9, 0xb9,
-	0x2a, 0xbd, 0x3f, 0x57, 0xa5, 0xe3, 0x0b, 0x35, 0x75, 0x76, 0xa1, 0xa6, 0xde, 0x5e, 0xa8, 0xa9,
-	0xff, 0x76, 0x2d, 0xcc, 0xf6, 0xfc, 0x8e, 0x66, 0x10, 0x5b, 0xdf, 0x89, 0x06, 0xfc, 0x01, 0x3b,
-	0x54, 0x8f, 0xc7, 0xad, 0x1a, 0xc4, 0x43, 0x83, 0xe1, 0x1e, 0xc4, 0x8e, 0x6e, 0x13, 0xd3, 0xef,
-	0x22, 0x3a, 0xf0, 0xb5, 0x0b, 0x6e, 0x78, 0xda, 0xc9, 0x84, 0x1f, 0xaa, 0xb5, 0x4f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x13, 0xc4, 0xc0, 0xbe, 0x07, 0x00, 0x00,
+	// 1013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0xea, 0x36, 0x69, 0x36, 0xa1, 0x9d, 0x8a, 0xb4, 0xb1, 0xd5, 0x20, 0x1b, 0x11, 0x20,
+	0x35, 0xa9, 0x34, 0x71, 0x99, 0x42, 0x4c, 0x87, 0x10, 0x27, 0xfc, 0xf1, 0x0c, 0x9e, 0x82, 0x03,
+	0x17, 0x2e, 0x9e, 0xb5, 0xb5, 0xc8, 0x4b, 0x22, 0xad, 0xaa, 0x5d, 0x85, 0xe4, 0x06, 0x5c, 0x60,
+	0xb8, 0xd0, 0x2b, 0xb7, 0x0e, 0x57, 0x2e, 0x39, 0x70, 0x62, 0xf8, 0x00, 0x3d, 0x76, 0x38, 0x75,
+	0x38, 0x74, 0x98, 0xe4, 0x10, 0x66, 0xf8, 0x12, 0x8c, 0xb4, 0x2b, 0xc9, 0x8e, 0x65, 0x39, 0x75,
+	0xb8, 0x24, 0x7a, 0xef, 0xfd, 0xde, 0x9f, 0xdf, 0xbe, 0x7d, 0x4f, 0x32, 0x58, 0xc2, 0xce, 0x57,
+	0xa8, 0xcb, 0xf0, 0x1e, 0x32, 0xb0, 0x43, 0x7d, 0x0f, 0x3a, 0x5d, 0x64, 0xec, 0xad, 0x76, 0x10,
+	0x83, 0xab, 0x06, 0xdb, 0xd7, 0x5d, 0x8f, 0x30, 0x22, 0xdf, 0x8c, 0x51, 0x7a, 0x8c, 0xd2, 0x05,
+	0x4a, 0x99, 0xb7, 0x88, 0x45, 0x42, 0x9c, 0x11, 0x3c, 0x71, 0x17, 0x45, 0xed, 0x12, 0x6a, 0x13,
+	0x6a, 0x74, 0x20, 0x4d, 0x02, 0x76, 0x09, 0x76, 0x84, 0x7d, 0x41, 0xd8, 0x6d, 0x6a, 0x19, 0x7b,
+	0xab, 0xc1, 0x3f, 0x61, 0x28, 0x72, 0x43, 0x9b, 0x47, 0xe4, 0x82, 0x30, 0xbd, 0x91, 0x55, 0x6c,
+	0x52, 0x18, 0x07, 0xbf, 0x9a, 0x80, 0x89, 0x07, 0xbb, 0xbb, 0x09, 0x92, 0x8b, 0x02, 0x76, 0x0d,
+	0xda, 0xd8, 0x21, 0x46, 0xf8, 0x97, 0xab, 0xb4, 0x87, 0x79, 0x70, 0xa3, 0x49, 0xad, 0x4d, 0x0f,
+	0x41, 0x86, 0x1a, 0x51, 0xd8, 0x0f, 0x7c, 0xc7, 0x94, 0x6f, 0x80, 0x29, 0x8a, 0x1c, 0x13, 0x79,
+	0x05, 0xa9, 0x2c, 0x2d, 0xcf, 0xb4, 0x84, 0x14, 0xe8, 0x19, 0xee, 0xee, 0x20, 0xaf, 0x70, 0x81,
+	0xeb, 0xb9, 0x24, 0x97, 0xc0, 0xec, 0x03, 0x9f, 0x30, 0xd4, 0x36, 0x91, 0x43, 0xec, 0x42, 0x3e,
+	0x34, 0x82, 0x50, 0xb5, 0x15, 0x68, 0x02, 0x00, 0x2f, 0xa7, 0x1d, 0x1c, 0x54, 0xe1, 0x22, 0x07,
+	0x70, 0x55, 0x1d, 0x52, 0x24, 0xbf, 0x0c, 0xe6, 0x04, 0x20, 0xf4, 0x2a, 0x5c, 0x0a, 0x11, 0xc2,
+	0xe9, 0xd3, 0x40, 0x25, 0xbf, 0x1f, 0xc7, 0x60, 0x07, 0x2e, 0x2a, 0x4c, 0x95, 0xa5, 0xe5, 0x2b,
+	0xd5, 0x25, 0x3d, 0xe9, 0x99, 0x20, 0x2c, 0xf8, 0xeb, 0xf7, 0x43, 0xf1, 0xb3, 0x03, 0x17, 0x45,
+	0x99, 0x82, 0xe7, 0x80, 0x03, 0xda, 0x77, 0xb1, 0x77, 0x50, 0x98, 0x2e, 0x4b, 0xcb, 0xf9, 0x96,
+	0x90, 0xe4, 0x8f, 0xc0, 0x55, 0xec, 0x60, 0x86, 0xe1, 0x6e, 0xdb, 0x44, 0x2e, 0xa1, 0x98, 0x15,
+	0x2e, 0x97, 0xa5, 0xe5, 0xd9, 0x6a, 0x51, 0x17, 0xdd, 0x09, 0x4a, 0x8f, 0xa3, 0x6f, 0x12, 0xec,
+	0xd4, 0x2f, 0x3e, 0x7e, 0x56, 0xca, 0xb5, 0xae, 0x08, 0xbf, 0x2d, 0xee, 0x56, 0x7b, 0xfb, 0x87,
+	0x47, 0xa5, 0xdc, 0x3f, 0x8f, 0x4a, 0xb9, 0xef, 0x4e, 0x0e, 0x2b, 0xe2, 0xe8, 0x7e, 0x3c, 0x39,
+	0xac, 0x94, 0x93, 0x6e, 0xa6, 0x9f, 0xbb, 0x56, 0x06, 0x6a, 0xba, 0xa5, 0x85, 0xa8, 0x4b, 0x1c,
+	0x8a, 0xb4, 0x43, 0x09, 0xbc, 0xd0, 0xa4, 0xd6, 0xe7, 0x41, 0xcc, 0xaf, 0x3d, 0xcc, 0xd0, 0xc8,
+	0x5e, 0xdd, 0x04, 0x33, 0x36, 0xf4, 0x76, 0x10, 0x6b, 0x63, 0x53, 0xb4, 0xeb, 0x32, 0x57, 0x34,
+	0x4c, 0x79, 0x0d, 0x4c, 0x47, 0x24, 0xf3, 0x67, 0x23, 0x19, 0xe1, 0x6b, 0xc6, 0x08, 0x76, 0x0b,
+	0x03, 0xec, 0x92, 0x02, 0xb5, 0x05, 0x70, 0x7d, 0x40, 0x11, 0x73, 0xf9, 0x43, 0x02, 0xf3, 0x4d,
+	0x6a, 0xb5, 0xd0, 0x03, 0x1f, 0x51, 0xd6, 0x42, 0x26, 0xb2, 0x5d, 0x86, 0x89, 0x33, 0x19, 0xa5,
+	0xb7, 0xc0, 0x14, 0xb4, 0x89, 0xef, 0x9c, 0x99, 0x91, 0x80, 0xd7, 0xee, 0x8e, 0x20, 0xa4, 0x0e,
+	0x10, 0x1a, 0xaa, 0x52, 0x53, 0xc1, 0x62, 0x9a, 0x3e, 0xa6, 0xf7, 0xbb, 0x04, 0xae, 0x06, 0xc4,
+	0x5d, 0x13, 0x32, 0xf4, 0x09, 0xf4, 0xa0, 0x4d, 0xe5, 0xbb, 0x60, 0x06, 0xfa, 0xac, 0x47, 0x3c,
+	0xcc, 0x0e, 0x38, 0xb9, 0x7a, 0xe1, 0xcf, 0xdf, 0x6e, 0xcf, 0x8b, 0x52, 0x37, 0x4c, 0xd3, 0x43,
+	0x94, 0x6e, 0x33, 0x0f, 0x3b, 0x56, 0x2b, 0x81, 0xca, 0x1b, 0x60, 0xca, 0x0d, 0x23, 0x84, 0xb4,
+	0x67, 0xab, 0xaf, 0xe8, 0x19, 0xab, 0x4a, 0xe7, 0xc9, 0x22, 0x9a, 0xdc, 0xb1, 0xb6, 0x12, 0xd0,
+	0x4b, 0x42, 0x06, 0x0c, 0x8b, 0x83, 0x2d, 0xeb, 0x2b, 0x54, 0x2b, 0x82, 0x85, 0x53, 0xaa, 0x98,
+	0xd7, 0x53, 0x09, 0x2c, 0xc6, 0xb7, 0x74, 0xbb, 0x07, 0x3d, 0x64, 0x9e, 0x6f, 0x7b, 0xa4, 0x4c,
+	0x5e, 0x7e, 0xb2, 0xc9, 0x7b, 0x77, 0x44, 0x2b, 0x5f, 0x4b, 0x99, 0xbc, 0x94, 0xca, 0xb5, 0x75,
+	0xb0, 0x94, 0x65, 0x8f, 0x8e, 0x40, 0x5e, 0x00, 0xd3, 0x5f, 0xfa, 0x8e, 0x19, 0x5c, 0x43, 0x41,
+	0x31, 0x10, 0x1b, 0xa6, 0xf6, 0xd3, 0x05, 0x50, 0x6a, 0x52, 0x6b, 0x1b, 0xb1, 0x14, 0xf7, 0x66,
+	0x78, 0x55, 0x27, 0xbe, 0x03, 0x7d, 0x49, 0x2f, 0xf4, 0x27, 0x1d, 0x1c, 0x8b, 0xfc, 0xa9, 0xb1,
+	0x78, 0x0f, 0xcc, 0xa1, 0x7d, 0x97, 0x50, 0xdf, 0x43, 0xed, 0x2e, 0x74, 0xf9, 0xea, 0xad, 0xbf,
+	0x14, 0x1c, 0xdf, 0x5f, 0xcf, 0x4a, 0xd7, 0x79, 0x52, 0x6a, 0xee, 0xe8, 0x98, 0x18, 0x36, 0x64,
+	0x3d, 0xbd, 0xe1, 0xb0, 0xd6, 0x6c, 0xe4, 0xb2, 0x09, 0xdd, 0xda, 0xbd, 0xe1, 0x8b, 0x73, 0x6b,
+	0xe0, 0x3c, 0xb3, 0xd8, 0x6a, 0xb7, 0xc0, 0xeb, 0x63, 0x20, 0xfd, 0x03, 0xa3, 0x85, 0x13, 0x65,
+	0x93, 0x3d, 0xf4, 0xff, 0x9f, 0x5f, 0xd6, 0xf6, 0xa8, 0xad, 0x0f, 0x93, 0x5c, 0x39, 0x35, 0xff,
+	0x99, 0x55, 0x69, 0x2b, 0xa0, 0x32, 0x1e, 0x15, 0x51, 0xad, 0xfe, 0x3b, 0x0d, 0xf2, 0x4d, 0x6a,
+	0xc9, 0xdf, 0x4b, 0xe0, 0xc5, 0xb4, 0x17, 0xf0, 0x9d, 0xcc, 0xf9, 0x4e, 0x7f, 0x47, 0x28, 0xef,
+	0x4c, 0xe0, 0x14, 0x5f, 0xe9, 0x5d, 0x00, 0xfa, 0x5e, 0x2a, 0x95, 0x71, 0xa1, 0x12, 0xac, 0x52,
+	0x3d, 0x3b, 0x36, 0xce, 0xf6, 0xad, 0x04, 0xae, 0x0d, 0xef, 0xfd, 0xd5, 0x71, 0x91, 0x86, 0x5c,
+	0x94, 0xb5, 0xe7, 0x76, 0x89, 0x6b, 0xf0, 0xc0, 0xdc, 0xc0, 0x6e, 0x5e, 0x19, 0xcb, 0xa3, 0x0f,
+	0xad, 0xbc, 0xf9, 0x3c, 0xe8, 0x38, 0xe7, 0xcf, 0x12, 0x28, 0x8e, 0x5e, 0x9c, 0x6b, 0x67, 0x6b,
+	0x60, 0x8a, 0xab, 0xb2, 0x31, 0xb1, 0x6b, 0x5c, 0xdb, 0x2f, 0x12, 0x58, 0xcc, 0x5c, 0x5c, 0xf7,
+	0xc6, 0xe5, 0xc8, 0xf2, 0x56, 0xb6, 0xce, 0xe3, 0x1d, 0x17, 0xf9, 0xab, 0x04, 0x4a, 0xe3, 0x16,
+	0xc4, 0xfa, 0xf8, 0x3b, 0x91, 0x19, 0x40, 0xf9, 0xf0, 0x9c, 0x01, 0xa2, 0x6a, 0x95, 0x4b, 0xdf,
+	0x9c, 0x1c, 0x56, 0xa4, 0x3a, 0x7e, 0x7c, 0xa4, 0x4a, 0x4f, 0x8e, 0x54, 0xe9, 0xef, 0x23, 0x55,
+	0x7a, 0x78, 0xac, 0xe6, 0x9e, 0x1c, 0xab, 0xb9, 0xa7, 0xc7, 0x6a, 0xee, 0x8b, 0xfb, 0x16, 0x66,
+	0x3d, 0xbf, 0xa3, 0x77, 0x89, 0x6d, 0x34, 0xa2, 0x9c, 0x1f, 0xc3, 0x0e, 0x35, 0xe2, 0x0a, 0x6e,
+	0x77, 0x89, 0x87, 0xfa, 0xc5, 0x1e, 0xc4, 0x8e, 0x61, 0x13, 0xd3, 0xdf, 0x45, 0xb4, 0xef, 0x07,
+	0x42, 0xf0, 0x51, 0x4c, 0x3b, 0x53, 0xe1, 0xb7, 0xfd, 0x9d, 0xff, 0x06, 0x00, 0x33, 0x38, 0x18,
+	0x89, 0xf1, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -467,6 +774,15 @@ type MsgClient interface {
 	// sender's insurance fund tokens
 	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateSharedInsuranceFund defines a method for creating an insurance fund
+	// that can back multiple markets sharing its deposit denom
+	CreateSharedInsuranceFund(ctx context.Context, in *MsgCreateSharedInsuranceFund, opts ...grpc.CallOption) (*MsgCreateSharedInsuranceFundResponse, error)
+	// SetSharedInsuranceFundMarket adds a market to a shared insurance fund or
+	// updates its exposure cap via governance
+	SetSharedInsuranceFundMarket(ctx context.Context, in *MsgSetSharedInsuranceFundMarket, opts ...grpc.CallOption) (*MsgSetSharedInsuranceFundMarketResponse, error)
+	// RemoveSharedInsuranceFundMarket removes a market from its shared insurance
+	// fund via governance
+	RemoveSharedInsuranceFundMarket(ctx context.Context, in *MsgRemoveSharedInsuranceFundMarket, opts ...grpc.CallOption) (*MsgRemoveSharedInsuranceFundMarketResponse, error)
 }
 
 type msgClient struct {
@@ -513,6 +829,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CreateSharedInsuranceFund(ctx context.Context, in *MsgCreateSharedInsuranceFund, opts ...grpc.CallOption) (*MsgCreateSharedInsuranceFundResponse, error) {
+	out := new(MsgCreateSharedInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/CreateSharedInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSharedInsuranceFundMarket(ctx context.Context, in *MsgSetSharedInsuranceFundMarket, opts ...grpc.CallOption) (*MsgSetSharedInsuranceFundMarketResponse, error) {
+	out := new(MsgSetSharedInsuranceFundMarketResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/SetSharedInsuranceFundMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSharedInsuranceFundMarket(ctx context.Context, in *MsgRemoveSharedInsuranceFundMarket, opts ...grpc.CallOption) (*MsgRemoveSharedInsuranceFundMarketResponse, error) {
+	out := new(MsgRemoveSharedInsuranceFundMarketResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/RemoveSharedInsuranceFundMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateInsuranceFund defines a method for creating an insurance fund
@@ -524,6 +867,15 @@ type MsgServer interface {
 	// sender's insurance fund tokens
 	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateSharedInsuranceFund defines a method for creating an insurance fund
+	// that can back multiple markets sharing its deposit denom
+	CreateSharedInsuranceFund(context.Context, *MsgCreateSharedInsuranceFund) (*MsgCreateSharedInsuranceFundResponse, error)
+	// SetSharedInsuranceFundMarket adds a market to a shared insurance fund or
+	// updates its exposure cap via governance
+	SetSharedInsuranceFundMarket(context.Context, *MsgSetSharedInsuranceFundMarket) (*MsgSetSharedInsuranceFundMarketResponse, error)
+	// RemoveSharedInsuranceFundMarket removes a market from its shared insurance
+	// fund via governance
+	RemoveSharedInsuranceFundMarket(context.Context, *MsgRemoveSharedInsuranceFundMarket) (*MsgRemoveSharedInsuranceFundMarketResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -542,6 +894,15 @@ func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRe
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CreateSharedInsuranceFund(ctx context.Context, req *MsgCreateSharedInsuranceFund) (*MsgCreateSharedInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSharedInsuranceFund not implemented")
+}
+func (*UnimplementedMsgServer) SetSharedInsuranceFundMarket(ctx context.Context, req *MsgSetSharedInsuranceFundMarket) (*MsgSetSharedInsuranceFundMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSharedInsuranceFundMarket not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSharedInsuranceFundMarket(ctx context.Context, req *MsgRemoveSharedInsuranceFundMarket) (*MsgRemoveSharedInsuranceFundMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSharedInsuranceFundMarket not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -619,6 +980,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSharedInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSharedInsuranceFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSharedInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Msg/CreateSharedInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSharedInsuranceFund(ctx, req.(*MsgCreateSharedInsuranceFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSharedInsuranceFundMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSharedInsuranceFundMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSharedInsuranceFundMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Msg/SetSharedInsuranceFundMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSharedInsuranceFundMarket(ctx, req.(*MsgSetSharedInsuranceFundMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSharedInsuranceFundMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSharedInsuranceFundMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSharedInsuranceFundMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Msg/RemoveSharedInsuranceFundMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSharedInsuranceFundMarket(ctx, req.(*MsgRemoveSharedInsuranceFundMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.insurance.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -639,6 +1054,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CreateSharedInsuranceFund",
+			Handler:    _Msg_CreateSharedInsuranceFund_Handler,
+		},
+		{
+			MethodName: "SetSharedInsuranceFundMarket",
+			Handler:    _Msg_SetSharedInsuranceFundMarket_Handler,
+		},
+		{
+			MethodName: "RemoveSharedInsuranceFundMarket",
+			Handler:    _Msg_RemoveSharedInsuranceFundMarket_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/insurance/v1beta1/tx.proto",
@@ -948,24 +1375,238 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateSharedInsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateInsuranceFund) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
+
+func (m *MsgCreateSharedInsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSharedInsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InitialDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSharedInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSharedInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSharedInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundId) > 0 {
+		i -= len(m.FundId)
+		copy(dAtA[i:], m.FundId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FundId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSharedInsuranceFundMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSharedInsuranceFundMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSharedInsuranceFundMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExposureCap.Size()
+		i -= size
+		if _, err := m.ExposureCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FundId) > 0 {
+		i -= len(m.FundId)
+		copy(dAtA[i:], m.FundId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FundId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSharedInsuranceFundMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSharedInsuranceFundMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSharedInsuranceFundMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1085,43 +1726,133 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCreateSharedInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InitialDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCreateSharedInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFund: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
+
+func (m *MsgSetSharedInsuranceFundMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FundId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExposureCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSharedInsuranceFundMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
@@ -1207,50 +1938,647 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnderwrite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnderwrite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnderwriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnderwriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleBase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1278,49 +2606,11 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
-			}
-			m.OracleType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OracleType |= types.OracleType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-			}
-			m.Expiry = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Expiry |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1347,7 +2637,7 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1372,7 +2662,7 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1395,10 +2685,10 @@ func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1422,7 +2712,7 @@ func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSharedInsuranceFund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1445,10 +2735,10 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnderwrite: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSharedInsuranceFund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnderwrite: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSharedInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1485,7 +2775,7 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1513,11 +2803,11 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketId = string(dAtA[iNdEx:postIndex])
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1544,7 +2834,7 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1569,7 +2859,7 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSharedInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1592,12 +2882,44 @@ func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnderwriteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSharedInsuranceFundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnderwriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSharedInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1619,7 +2941,7 @@ func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSharedInsuranceFundMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1642,15 +2964,15 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSharedInsuranceFundMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSharedInsuranceFundMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1678,9 +3000,41 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -1712,11 +3066,11 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 			}
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExposureCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1726,22 +3080,23 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExposureCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1766,7 +3121,7 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSharedInsuranceFundMarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1789,10 +3144,10 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSharedInsuranceFundMarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSharedInsuranceFundMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1816,7 +3171,7 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSharedInsuranceFundMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1839,10 +3194,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSharedInsuranceFundMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSharedInsuranceFundMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1879,9 +3234,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1891,24 +3246,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1931,7 +3285,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSharedInsuranceFundMarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,10 +3308,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSharedInsuranceFundMarketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSharedInsuranceFundMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20,7 +20,8 @@ func (am AppModule) BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	feedConfigs := k.GetAllFeedConfigs(ctx)
 	for _, feedConfig := range feedConfigs {
-		k.ProcessRewardPayout(ctx, feedConfig)
+		isPayoutSettled := k.ProcessRewardPayout(ctx, feedConfig)
+		k.ProcessOracleLiveness(ctx, feedConfig, isPayoutSettled)
 	}
 }
 
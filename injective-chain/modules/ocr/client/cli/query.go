@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetLatestRoundCmd(),
 		GetLatestTransmissionDetailsCmd(),
 		GetOwedAmountCmd(),
+		GetFeedLivenessCmd(),
 		GetOcrModuleStateCmd(),
 	)
 	return cmd
@@ -174,6 +175,35 @@ func GetLatestTransmissionDetailsCmd() *cobra.Command {
 	return cmd
 }
 
+// GetFeedLivenessCmd queries the liveness of a feed and its oracles by feed id
+func GetFeedLivenessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed-liveness [feed_id]",
+		Short: "Gets ocr feed liveness",
+		Long:  "Gets the liveness of an ocr feed and the participation of its oracles. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeedLivenessRequest{
+				FeedId: args[0],
+			}
+			res, err := queryClient.FeedLiveness(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetOwedAmountCmd queries owed amount by transmitter address
 func GetOwedAmountCmd() *cobra.Command {
 	cmd := &cobra.Command{
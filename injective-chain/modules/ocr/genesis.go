@@ -59,6 +59,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		}
 	}
 
+	for _, v := range data.FeedLiveness {
+		k.SetFeedLiveness(ctx, v)
+	}
+
+	for _, v := range data.OracleLiveness {
+		k.SetOracleLiveness(ctx, v)
+	}
+
 	k.CreateModuleAccount(ctx)
 }
 
@@ -73,5 +81,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		FeedObservationCounts:    k.GetAllFeedObservationCounts(ctx),
 		FeedTransmissionCounts:   k.GetAllFeedTransmissionCounts(ctx),
 		PendingPayeeships:        k.GetAllPendingPayeeships(ctx),
+		FeedLiveness:             k.GetAllFeedLiveness(ctx),
+		OracleLiveness:           k.GetAllOracleLiveness(ctx),
 	}
 }
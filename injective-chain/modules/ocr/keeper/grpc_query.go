@@ -108,6 +108,33 @@ func (k *Keeper) LatestTransmissionDetails(c context.Context, req *types.QueryLa
 	return res, nil
 }
 
+// FeedLiveness retrieves the liveness of the feed and its oracles
+func (k *Keeper) FeedLiveness(c context.Context, req *types.QueryFeedLivenessRequest) (*types.QueryFeedLivenessResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feedId := req.FeedId
+	if feedId == "" {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to read feed_id")
+	}
+
+	res := &types.QueryFeedLivenessResponse{
+		FeedLiveness: k.GetFeedLiveness(ctx, feedId),
+		Oracles:      k.GetFeedOracleLiveness(ctx, feedId),
+	}
+
+	if res.FeedLiveness != nil {
+		res.SecondsSinceLastRound = ctx.BlockTime().Unix() - res.FeedLiveness.LastRoundTimestamp
+
+		stalenessThreshold := k.GetParams(ctx).FeedStalenessThreshold
+		res.Stale = stalenessThreshold > 0 && res.SecondsSinceLastRound > stalenessThreshold
+	}
+
+	return res, nil
+}
+
 // OwedAmount retrieves transmitter's owed amount
 func (k *Keeper) OwedAmount(c context.Context, req *types.QueryOwedAmountRequest) (*types.QueryOwedAmountResponse, error) {
 	panic("not implemented")
@@ -131,6 +158,8 @@ func (k *Keeper) OcrModuleState(c context.Context, _ *types.QueryModuleStateRequ
 			FeedObservationCounts:    k.GetAllFeedObservationCounts(ctx),
 			FeedTransmissionCounts:   k.GetAllFeedTransmissionCounts(ctx),
 			PendingPayeeships:        k.GetAllPendingPayeeships(ctx),
+			FeedLiveness:             k.GetAllFeedLiveness(ctx),
+			OracleLiveness:           k.GetAllOracleLiveness(ctx),
 		},
 	}
 
@@ -22,6 +22,7 @@ type Keeper struct {
 	RewardPool
 	FeedObservations
 	FeedTransmissions
+	OracleLiveness
 	OcrHooks
 
 	bankKeeper types.BankKeeper
@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ocr/types"
+)
+
+type OracleLiveness interface {
+	RecordFeedRound(
+		ctx sdk.Context,
+		feedConfig *types.FeedConfig,
+		observers []byte,
+	)
+
+	GetFeedLiveness(
+		ctx sdk.Context,
+		feedId string,
+	) *types.FeedLiveness
+
+	SetFeedLiveness(
+		ctx sdk.Context,
+		liveness *types.FeedLiveness,
+	)
+
+	GetAllFeedLiveness(
+		ctx sdk.Context,
+	) []*types.FeedLiveness
+
+	GetOracleLiveness(
+		ctx sdk.Context,
+		feedId string,
+		oracle sdk.AccAddress,
+	) *types.OracleLiveness
+
+	SetOracleLiveness(
+		ctx sdk.Context,
+		liveness *types.OracleLiveness,
+	)
+
+	DeleteOracleLiveness(
+		ctx sdk.Context,
+		feedId string,
+		oracle sdk.AccAddress,
+	)
+
+	GetFeedOracleLiveness(
+		ctx sdk.Context,
+		feedId string,
+	) []*types.OracleLiveness
+
+	GetAllOracleLiveness(
+		ctx sdk.Context,
+	) []*types.OracleLiveness
+
+	ProcessOracleLiveness(
+		ctx sdk.Context,
+		feedConfig *types.FeedConfig,
+		isPayoutSettled bool,
+	)
+}
+
+// RecordFeedRound records a transmitted round of the feed, where observers holds the indexes of the oracles whose
+// observations are part of the report. All other oracles of the feed missed the round.
+func (k *Keeper) RecordFeedRound(
+	ctx sdk.Context,
+	feedConfig *types.FeedConfig,
+	observers []byte,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	feedId := feedConfig.ModuleParams.FeedId
+	blockTime := ctx.BlockTime().Unix()
+
+	feedLiveness := k.GetFeedLiveness(ctx, feedId)
+	if feedLiveness == nil {
+		feedLiveness = &types.FeedLiveness{FeedId: feedId}
+	}
+
+	feedLiveness.LastRoundTimestamp = blockTime
+	feedLiveness.LastRoundHeight = ctx.BlockHeight()
+	feedLiveness.TotalRounds++
+	k.SetFeedLiveness(ctx, feedLiveness)
+
+	observed := make(map[int]struct{}, len(observers))
+	for _, observer := range observers {
+		observed[int(observer)] = struct{}{}
+	}
+
+	for idx, transmitter := range feedConfig.Transmitters {
+		oracle, err := sdk.AccAddressFromBech32(transmitter)
+		if err != nil {
+			continue
+		}
+
+		liveness := k.GetOracleLiveness(ctx, feedId, oracle)
+		if liveness == nil {
+			liveness = types.NewOracleLiveness(feedId, oracle)
+		}
+
+		if _, ok := observed[idx]; ok {
+			liveness.RecordObservation(blockTime)
+		} else {
+			liveness.RecordMiss()
+		}
+
+		k.SetOracleLiveness(ctx, liveness)
+	}
+}
+
+func (k *Keeper) GetFeedLiveness(
+	ctx sdk.Context,
+	feedId string,
+) *types.FeedLiveness {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetFeedLivenessKey(feedId))
+	if bz == nil {
+		return nil
+	}
+
+	var liveness types.FeedLiveness
+	k.cdc.MustUnmarshal(bz, &liveness)
+	return &liveness
+}
+
+func (k *Keeper) SetFeedLiveness(
+	ctx sdk.Context,
+	liveness *types.FeedLiveness,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(liveness)
+	k.getStore(ctx).Set(types.GetFeedLivenessKey(liveness.FeedId), bz)
+}
+
+func (k *Keeper) GetAllFeedLiveness(
+	ctx sdk.Context,
+) []*types.FeedLiveness {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	livenessStore := prefix.NewStore(k.getStore(ctx), types.FeedLivenessPrefix)
+
+	iterator := livenessStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	feedLiveness := make([]*types.FeedLiveness, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var liveness types.FeedLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &liveness)
+		feedLiveness = append(feedLiveness, &liveness)
+	}
+
+	return feedLiveness
+}
+
+func (k *Keeper) GetOracleLiveness(
+	ctx sdk.Context,
+	feedId string,
+	oracle sdk.AccAddress,
+) *types.OracleLiveness {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetOracleLivenessKey(feedId, oracle))
+	if bz == nil {
+		return nil
+	}
+
+	var liveness types.OracleLiveness
+	k.cdc.MustUnmarshal(bz, &liveness)
+	return &liveness
+}
+
+func (k *Keeper) SetOracleLiveness(
+	ctx sdk.Context,
+	liveness *types.OracleLiveness,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	oracle, _ := sdk.AccAddressFromBech32(liveness.Oracle)
+	bz := k.cdc.MustMarshal(liveness)
+	k.getStore(ctx).Set(types.GetOracleLivenessKey(liveness.FeedId, oracle), bz)
+}
+
+func (k *Keeper) DeleteOracleLiveness(
+	ctx sdk.Context,
+	feedId string,
+	oracle sdk.AccAddress,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.getStore(ctx).Delete(types.GetOracleLivenessKey(feedId, oracle))
+}
+
+func (k *Keeper) GetFeedOracleLiveness(
+	ctx sdk.Context,
+	feedId string,
+) []*types.OracleLiveness {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	return k.iterateOracleLiveness(ctx, types.GetOracleLivenessPrefix(feedId))
+}
+
+func (k *Keeper) GetAllOracleLiveness(
+	ctx sdk.Context,
+) []*types.OracleLiveness {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	return k.iterateOracleLiveness(ctx, types.OracleLivenessPrefix)
+}
+
+func (k *Keeper) iterateOracleLiveness(ctx sdk.Context, prefixKey []byte) []*types.OracleLiveness {
+	livenessStore := prefix.NewStore(k.getStore(ctx), prefixKey)
+
+	iterator := livenessStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	oracleLiveness := make([]*types.OracleLiveness, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var liveness types.OracleLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &liveness)
+		oracleLiveness = append(oracleLiveness, &liveness)
+	}
+
+	return oracleLiveness
+}
+
+// getPenalizedOracles returns the participation rate of the oracles of the feed which observed less than the
+// MinOracleParticipationRate of the rounds since the last payout, keyed by transmitter address.
+func (k *Keeper) getPenalizedOracles(
+	ctx sdk.Context,
+	feedId string,
+) map[string]math.LegacyDec {
+	penalized := make(map[string]math.LegacyDec)
+
+	minParticipationRate := k.GetParams(ctx).MinOracleParticipationRate
+	if minParticipationRate.IsNil() || !minParticipationRate.IsPositive() {
+		return penalized
+	}
+
+	for _, liveness := range k.GetFeedOracleLiveness(ctx, feedId) {
+		if rate := liveness.PeriodParticipationRate(); rate.LT(minParticipationRate) {
+			penalized[liveness.Oracle] = rate
+		}
+	}
+
+	return penalized
+}
+
+// ProcessOracleLiveness starts a new payout period for the oracles of the feed once the payout of the current one is
+// settled, and reports the oracles which missed MaxConsecutiveMissedRounds rounds in a row. Absent oracles are not
+// removed from the feed config, since its offchain config holds per-oracle settings, so their removal is left to
+// governance or the feed admin.
+func (k *Keeper) ProcessOracleLiveness(
+	ctx sdk.Context,
+	feedConfig *types.FeedConfig,
+	isPayoutSettled bool,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	feedId := feedConfig.ModuleParams.FeedId
+	if isPayoutSettled {
+		for _, liveness := range k.GetFeedOracleLiveness(ctx, feedId) {
+			liveness.ResetPeriod()
+			k.SetOracleLiveness(ctx, liveness)
+		}
+	}
+
+	maxConsecutiveMissedRounds := k.GetParams(ctx).MaxConsecutiveMissedRounds
+	if maxConsecutiveMissedRounds == 0 {
+		return
+	}
+
+	for idx, transmitter := range feedConfig.Transmitters {
+		oracle, _ := sdk.AccAddressFromBech32(transmitter)
+		liveness := k.GetOracleLiveness(ctx, feedId, oracle)
+		if liveness == nil || liveness.ConsecutiveMissedRounds < maxConsecutiveMissedRounds {
+			continue
+		}
+
+		k.Logger(ctx).Info("absent oracle should be removed", "feed_id", feedId, "oracle", transmitter, "consecutive_missed_rounds", liveness.ConsecutiveMissedRounds)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventOracleRemoved{
+			FeedId:                  feedId,
+			Oracle:                  transmitter,
+			Signer:                  feedConfig.Signers[idx],
+			ConsecutiveMissedRounds: liveness.ConsecutiveMissedRounds,
+		})
+	}
+}
+
+// pruneOracleLiveness deletes the liveness of the oracles which are no longer part of the feed
+func (k *Keeper) pruneOracleLiveness(
+	ctx sdk.Context,
+	feedConfig *types.FeedConfig,
+) {
+	feedId := feedConfig.ModuleParams.FeedId
+	validTransmitters := feedConfig.ValidTransmitters()
+
+	for _, liveness := range k.GetFeedOracleLiveness(ctx, feedId) {
+		if _, ok := validTransmitters[liveness.Oracle]; ok {
+			continue
+		}
+
+		oracle, _ := sdk.AccAddressFromBech32(liveness.Oracle)
+		k.DeleteOracleLiveness(ctx, feedId, oracle)
+	}
+}
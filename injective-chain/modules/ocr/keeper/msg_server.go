@@ -278,6 +278,7 @@ func (k msgServer) Transmit(goCtx context.Context, msg *types.MsgTransmit) (*typ
 	}
 
 	k.IncrementFeedTransmissionCount(ctx, msg.FeedId, transmitter)
+	k.RecordFeedRound(ctx, feedConfig, msg.Report.Observers)
 
 	k.Logger(ctx).Debug("transmit accepted", "from", msg.Transmitter)
 
@@ -111,6 +111,8 @@ func (k *Keeper) SetFeedConfig(
 		ConfigInfo:                configInfo,
 	})
 
+	k.pruneOracleLiveness(ctx, feedConfig)
+
 	if k.hooks != nil {
 		k.hooks.AfterSetFeedConfig(ctx, feedConfig)
 	}
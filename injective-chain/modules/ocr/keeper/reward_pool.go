@@ -46,7 +46,7 @@ type RewardPool interface {
 	ProcessRewardPayout(
 		ctx sdk.Context,
 		feedConfig *types.FeedConfig,
-	)
+	) bool
 
 	GetPayee(
 		ctx sdk.Context,
@@ -268,7 +268,9 @@ func (k *Keeper) GetAllRewardPools(
 	return rewardPools
 }
 
-func (k *Keeper) ProcessRewardPayout(ctx sdk.Context, feedConfig *types.FeedConfig) {
+// ProcessRewardPayout pays out the rewards accrued by the oracles of the feed and returns whether the payout was settled,
+// which is not the case while the reward pool of the feed is missing or underfunded.
+func (k *Keeper) ProcessRewardPayout(ctx sdk.Context, feedConfig *types.FeedConfig) bool {
 	feedId := feedConfig.ModuleParams.FeedId
 	transmissionCounts := k.GetFeedTransmissionCounts(ctx, feedId)
 	observationCounts := k.GetFeedObservationCounts(ctx, feedId)
@@ -276,10 +278,19 @@ func (k *Keeper) ProcessRewardPayout(ctx sdk.Context, feedConfig *types.FeedConf
 	linkRewards := make(map[string]math.Int, len(feedConfig.Transmitters))
 	totalRewards := math.ZeroInt()
 
+	// oracles below the min participation rate forfeit their rewards, which stay in the reward pool
+	penalizedOracles := k.getPenalizedOracles(ctx, feedId)
+	forfeitedRewards := make(map[string]math.Int, len(penalizedOracles))
+
 	for _, c := range transmissionCounts.Counts {
 		count := c.Count
 		reward := feedConfig.ModuleParams.LinkPerTransmission.Mul(math.NewInt(int64(count)))
 
+		if _, ok := penalizedOracles[c.Address]; ok {
+			forfeitedRewards[c.Address] = reward
+			continue
+		}
+
 		// calculate recipient from transmitter
 		transmitter, _ := sdk.AccAddressFromBech32(c.Address)
 		payee := k.GetPayee(ctx, feedId, transmitter)
@@ -298,6 +309,15 @@ func (k *Keeper) ProcessRewardPayout(ctx sdk.Context, feedConfig *types.FeedConf
 		observer := c.Address
 		reward := feedConfig.ModuleParams.LinkPerObservation.Mul(math.NewInt(int64(count)))
 
+		if _, ok := penalizedOracles[observer]; ok {
+			if v, ok := forfeitedRewards[observer]; ok {
+				forfeitedRewards[observer] = v.Add(reward)
+			} else {
+				forfeitedRewards[observer] = reward
+			}
+			continue
+		}
+
 		v, ok := linkRewards[observer]
 		if ok {
 			linkRewards[observer] = v.Add(reward)
@@ -309,17 +329,18 @@ func (k *Keeper) ProcessRewardPayout(ctx sdk.Context, feedConfig *types.FeedConf
 	}
 
 	if totalRewards.IsZero() {
-		// nothing to reward yet
-		return
+		// nothing to reward yet, penalized oracles still forfeit what they accrued
+		k.forfeitOracleRewards(ctx, feedConfig, penalizedOracles, forfeitedRewards)
+		return true
 	}
 
 	rewardPool := k.GetRewardPoolAmount(ctx, feedId)
 	if rewardPool == nil || rewardPool.Amount.IsNil() {
 		// no reward pool initialized for this feed yet
-		return
+		return false
 	} else if totalRewards.GT(rewardPool.Amount) {
 		// just skip for now and hopefully by next interval the pool will be funded
-		return
+		return false
 	}
 
 	rewards := make([]*types.Reward, 0, len(linkRewards))
@@ -342,14 +363,59 @@ func (k *Keeper) ProcessRewardPayout(ctx sdk.Context, feedConfig *types.FeedConf
 	}
 
 	if err := k.DisburseFromRewardPool(ctx, feedId, rewards); err != nil {
-		return
+		return false
 	}
 
+	// penalties only apply with a payout, so that all oracles keep their counts while the pool is underfunded
+	k.forfeitOracleRewards(ctx, feedConfig, penalizedOracles, forfeitedRewards)
+
 	for _, recipient := range recipients {
 		addr, _ := sdk.AccAddressFromBech32(recipient)
 		k.SetFeedTransmissionsCount(ctx, feedId, addr, 1)
 		k.SetFeedObservationsCount(ctx, feedId, addr, 1)
 	}
+
+	return true
+}
+
+// forfeitOracleRewards resets the transmission and observation counts of the penalized oracles, so their rewards are
+// not paid out with the next payout either.
+func (k *Keeper) forfeitOracleRewards(
+	ctx sdk.Context,
+	feedConfig *types.FeedConfig,
+	penalizedOracles map[string]math.LegacyDec,
+	forfeitedRewards map[string]math.Int,
+) {
+	feedId := feedConfig.ModuleParams.FeedId
+
+	oracles := make([]string, 0, len(penalizedOracles))
+	for oracle := range penalizedOracles {
+		oracles = append(oracles, oracle)
+	}
+
+	// must sort map keys since map iteration is non-deterministic
+	sort.StringSlice(oracles).Sort()
+
+	for _, oracle := range oracles {
+		addr, _ := sdk.AccAddressFromBech32(oracle)
+		k.SetFeedTransmissionsCount(ctx, feedId, addr, 1)
+		k.SetFeedObservationsCount(ctx, feedId, addr, 1)
+
+		forfeited, ok := forfeitedRewards[oracle]
+		if !ok {
+			forfeited = math.ZeroInt()
+		}
+
+		k.Logger(ctx).Info("oracle rewards forfeited", "feed_id", feedId, "oracle", oracle, "participation_rate", penalizedOracles[oracle].String())
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventOracleRewardPenalized{
+			FeedId:            feedId,
+			Oracle:            oracle,
+			ParticipationRate: penalizedOracles[oracle],
+			Forfeited:         sdk.NewCoin(k.LinkDenom(ctx), forfeited),
+		})
+	}
 }
 
 func (k *Keeper) GetPayee(
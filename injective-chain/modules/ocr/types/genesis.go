@@ -1,11 +1,29 @@
 package types
 
+import "fmt"
+
 func NewGenesisState() GenesisState {
 	return GenesisState{}
 }
 
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, liveness := range gs.FeedLiveness {
+		if liveness == nil || liveness.FeedId == "" {
+			return fmt.Errorf("feed liveness is missing a feed id")
+		}
+	}
+
+	for _, liveness := range gs.OracleLiveness {
+		if err := liveness.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func DefaultGenesisState() *GenesisState {
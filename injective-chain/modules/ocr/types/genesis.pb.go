@@ -45,6 +45,10 @@ type GenesisState struct {
 	FeedTransmissionCounts []*FeedCounts `protobuf:"bytes,8,rep,name=feed_transmission_counts,json=feedTransmissionCounts,proto3" json:"feed_transmission_counts,omitempty"`
 	// pending_payeeships stores the pending payeeships
 	PendingPayeeships []*PendingPayeeship `protobuf:"bytes,9,rep,name=pending_payeeships,json=pendingPayeeships,proto3" json:"pending_payeeships,omitempty"`
+	// feed_liveness stores the round liveness of each feed
+	FeedLiveness []*FeedLiveness `protobuf:"bytes,10,rep,name=feed_liveness,json=feedLiveness,proto3" json:"feed_liveness,omitempty"`
+	// oracle_liveness stores the participation of each oracle in the feed rounds
+	OracleLiveness []*OracleLiveness `protobuf:"bytes,11,rep,name=oracle_liveness,json=oracleLiveness,proto3" json:"oracle_liveness,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -143,6 +147,20 @@ func (m *GenesisState) GetPendingPayeeships() []*PendingPayeeship {
 	return nil
 }
 
+func (m *GenesisState) GetFeedLiveness() []*FeedLiveness {
+	if m != nil {
+		return m.FeedLiveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleLiveness() []*OracleLiveness {
+	if m != nil {
+		return m.OracleLiveness
+	}
+	return nil
+}
+
 type FeedTransmission struct {
 	FeedId       string        `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
 	Transmission *Transmission `protobuf:"bytes,2,opt,name=transmission,proto3" json:"transmission,omitempty"`
@@ -531,52 +549,55 @@ func init() {
 }
 
 var fileDescriptor_918762553ccd204f = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x4e, 0x1b, 0x3b,
-	0x18, 0x4d, 0x20, 0x84, 0x8b, 0x03, 0xf7, 0xde, 0xf8, 0xc2, 0x65, 0x4a, 0x69, 0xa0, 0xa1, 0x55,
-	0xd9, 0x74, 0x46, 0x40, 0x25, 0x16, 0x5d, 0xf1, 0xd3, 0xa2, 0x48, 0x48, 0x8d, 0xdc, 0x0a, 0xa9,
-	0x45, 0xea, 0xc8, 0x19, 0x3b, 0x13, 0x57, 0x89, 0x3d, 0xf2, 0xe7, 0x50, 0xd1, 0xa7, 0xe8, 0xa2,
-	0x0f, 0xc5, 0x92, 0x65, 0x57, 0x55, 0x05, 0x2f, 0x52, 0x8d, 0x67, 0x32, 0x24, 0x21, 0x13, 0xb1,
-	0xb3, 0xbf, 0x39, 0xe7, 0x7c, 0x3f, 0x3e, 0xf6, 0xa0, 0x2d, 0x21, 0xbf, 0xf0, 0xc0, 0x88, 0x0b,
-	0xee, 0xa9, 0x40, 0x7b, 0x17, 0x3b, 0x2d, 0x6e, 0xe8, 0x8e, 0x17, 0x72, 0xc9, 0x41, 0x80, 0x1b,
-	0x69, 0x65, 0x14, 0x5e, 0xc9, 0x40, 0xae, 0x0a, 0xb4, 0x9b, 0x82, 0xd6, 0x36, 0x26, 0x73, 0x63,
-	0x88, 0xe5, 0xad, 0x2d, 0x87, 0x2a, 0x54, 0x76, 0xe9, 0xc5, 0xab, 0x34, 0x5a, 0x0b, 0x14, 0xf4,
-	0x14, 0x78, 0x2d, 0x0a, 0x3c, 0x23, 0x05, 0x4a, 0xc8, 0xe4, 0x7b, 0xfd, 0x47, 0x19, 0x2d, 0x9e,
-	0x24, 0xf9, 0xdf, 0x1b, 0x6a, 0x38, 0x7e, 0x8d, 0xca, 0x11, 0xd5, 0xb4, 0x07, 0x4e, 0x71, 0xb3,
-	0xb8, 0x5d, 0xd9, 0x7d, 0xe2, 0x4e, 0xac, 0xc7, 0x6d, 0x5a, 0xd0, 0x61, 0xe9, 0xea, 0xd7, 0x46,
-	0x81, 0xa4, 0x14, 0x7c, 0x8c, 0x16, 0xdb, 0x9c, 0x33, 0x3f, 0x50, 0xb2, 0x2d, 0x42, 0x70, 0x66,
-	0x36, 0x67, 0xb7, 0x2b, 0xbb, 0x4f, 0x73, 0x24, 0xde, 0x72, 0xce, 0x8e, 0x2c, 0x92, 0x54, 0xda,
-	0xd9, 0x1a, 0xb0, 0x8f, 0x56, 0xbb, 0xd4, 0x70, 0x30, 0x3e, 0x8f, 0x54, 0xd0, 0xf1, 0xa9, 0x64,
-	0xbe, 0x56, 0x7d, 0xc9, 0xc0, 0x99, 0xb5, 0x82, 0xdb, 0x53, 0x04, 0xdf, 0xc4, 0x94, 0x03, 0xc9,
-	0x48, 0x4c, 0x20, 0xcb, 0x89, 0xd0, 0x48, 0x10, 0xf0, 0x19, 0xc2, 0xb6, 0x4c, 0xa3, 0xa9, 0x84,
-	0x9e, 0x00, 0x10, 0x4a, 0x82, 0x53, 0xb2, 0xda, 0x2f, 0xa6, 0x68, 0x7f, 0x18, 0xc2, 0x93, 0x6a,
-	0x7b, 0x2c, 0x02, 0x58, 0xa3, 0xc7, 0x69, 0xe1, 0x34, 0x0c, 0x35, 0x0f, 0xa9, 0x51, 0x3a, 0xa9,
-	0xdc, 0x17, 0x0c, 0x9c, 0x39, 0x9b, 0x60, 0x6f, 0x4a, 0x82, 0x53, 0xcb, 0x3e, 0xc8, 0xc8, 0xb6,
-	0xde, 0xc6, 0x31, 0x10, 0xa7, 0x3b, 0xf1, 0x0b, 0xb3, 0x23, 0xd7, 0xfc, 0x2b, 0xd5, 0xcc, 0x8f,
-	0x94, 0xea, 0x82, 0x53, 0x9e, 0x3a, 0x72, 0x62, 0xa1, 0x4d, 0xa5, 0xba, 0xa4, 0xa2, 0xb3, 0x35,
-	0xe0, 0x8f, 0x68, 0xd5, 0x4e, 0x44, 0xb5, 0x80, 0xeb, 0x0b, 0x6a, 0x84, 0x92, 0x7e, 0xa0, 0xfa,
-	0xd2, 0x80, 0x33, 0xff, 0x80, 0x33, 0x8c, 0x81, 0x64, 0x25, 0x56, 0x78, 0x77, 0x27, 0x90, 0x84,
-	0xf1, 0x39, 0x72, 0xee, 0x0d, 0x7b, 0xa0, 0xfd, 0xd7, 0x43, 0xb5, 0xff, 0x1f, 0x1f, 0x76, 0x2a,
-	0x7e, 0x86, 0x70, 0xc4, 0x25, 0x13, 0x32, 0xf4, 0x23, 0x7a, 0xc9, 0x39, 0x74, 0x44, 0x04, 0xce,
-	0xc2, 0xd4, 0x93, 0x6c, 0x26, 0x84, 0xe6, 0x00, 0x4f, 0xaa, 0xd1, 0x58, 0x04, 0xea, 0x06, 0xfd,
-	0x3b, 0x7e, 0xe0, 0x78, 0x15, 0xcd, 0xdb, 0x46, 0x04, 0xb3, 0x57, 0x63, 0x81, 0x94, 0xe3, 0x6d,
-	0x83, 0xe1, 0x13, 0xb4, 0x38, 0xdc, 0x9c, 0x33, 0x63, 0x2f, 0xce, 0x56, 0x4e, 0xfa, 0x11, 0x13,
-	0x8d, 0x10, 0xeb, 0xdf, 0x50, 0xf5, 0x9e, 0x85, 0xf3, 0xd3, 0x9e, 0xa2, 0x7f, 0xc6, 0xee, 0x47,
-	0x9a, 0xf9, 0x59, 0x4e, 0xe6, 0xd1, 0xab, 0xb1, 0xc4, 0x87, 0xb7, 0xf5, 0x10, 0xad, 0x4f, 0x73,
-	0x60, 0x7e, 0x19, 0x2e, 0xfa, 0x6f, 0x82, 0xdb, 0x6d, 0x29, 0x25, 0x52, 0xa5, 0xe3, 0x8e, 0xad,
-	0x7f, 0x46, 0xe8, 0xce, 0x85, 0xf9, 0xb2, 0xfb, 0xa8, 0x4c, 0x7b, 0xf1, 0x21, 0xa7, 0x4d, 0x3d,
-	0x72, 0x93, 0x97, 0xcc, 0x8d, 0x5f, 0xb2, 0xac, 0xa5, 0x23, 0x25, 0xe4, 0xe0, 0x0d, 0x4a, 0xe0,
-	0xf5, 0x73, 0x84, 0xee, 0x8c, 0x93, 0xaf, 0xff, 0x0a, 0x95, 0x53, 0x13, 0x26, 0x8f, 0xd4, 0x7a,
-	0xce, 0xd0, 0xac, 0x0e, 0x49, 0xb1, 0xf5, 0x7d, 0x34, 0x67, 0x03, 0xd8, 0x41, 0xf3, 0x94, 0x31,
-	0xcd, 0x01, 0x52, 0xdd, 0xc1, 0x16, 0x2f, 0xa3, 0xb9, 0x20, 0xab, 0xbb, 0x44, 0x92, 0x4d, 0x6c,
-	0xa8, 0x71, 0xdf, 0xe5, 0xd7, 0xb6, 0x89, 0x2a, 0xa9, 0x2f, 0x8c, 0xe1, 0xda, 0x0a, 0x2d, 0x90,
-	0xe1, 0x10, 0x7e, 0x8e, 0xfe, 0x8e, 0xb4, 0x8a, 0x14, 0x70, 0x96, 0x18, 0xdf, 0x99, 0xb5, 0xa0,
-	0xa5, 0x41, 0xd4, 0x66, 0x39, 0x0c, 0xae, 0x6e, 0x6a, 0xc5, 0xeb, 0x9b, 0x5a, 0xf1, 0xf7, 0x4d,
-	0xad, 0xf8, 0xfd, 0xb6, 0x56, 0xb8, 0xbe, 0xad, 0x15, 0x7e, 0xde, 0xd6, 0x0a, 0x9f, 0x1a, 0xa1,
-	0x30, 0x9d, 0x7e, 0xcb, 0x0d, 0x54, 0xcf, 0x6b, 0x0c, 0x1a, 0x3f, 0xa5, 0x2d, 0xf0, 0xb2, 0x31,
-	0xbc, 0x0c, 0x94, 0xe6, 0xc3, 0xdb, 0x0e, 0x15, 0xd2, 0xeb, 0x29, 0xd6, 0xef, 0x72, 0xb0, 0x3f,
-	0x21, 0x73, 0x19, 0x71, 0x68, 0x95, 0xed, 0x9f, 0x64, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x4a, 0x42, 0x30, 0xba, 0xde, 0x06, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdf, 0x4e, 0x3b, 0x45,
+	0x14, 0x6e, 0xa1, 0xb4, 0x32, 0x2d, 0x60, 0x47, 0x90, 0x15, 0xb1, 0xd4, 0x22, 0x91, 0x1b, 0x77,
+	0x03, 0x98, 0x70, 0xe1, 0x15, 0x7f, 0x14, 0x9b, 0x10, 0x69, 0x46, 0x43, 0xa2, 0x24, 0x6e, 0xa6,
+	0xbb, 0xd3, 0xed, 0x98, 0x76, 0x66, 0x33, 0x67, 0x5a, 0x83, 0x4f, 0xe1, 0x93, 0xf8, 0x1c, 0x5c,
+	0x72, 0xe9, 0x95, 0x31, 0xf0, 0x22, 0xbf, 0xec, 0xec, 0x76, 0xfb, 0x87, 0xee, 0x86, 0xbb, 0x39,
+	0xa7, 0xdf, 0xf9, 0xce, 0x37, 0x67, 0xcf, 0x37, 0x45, 0x87, 0x5c, 0xfc, 0xc1, 0x3c, 0xcd, 0xc7,
+	0xcc, 0x91, 0x9e, 0x72, 0xc6, 0x27, 0x5d, 0xa6, 0xe9, 0x89, 0x13, 0x30, 0xc1, 0x80, 0x83, 0x1d,
+	0x2a, 0xa9, 0x25, 0xde, 0x49, 0x41, 0xb6, 0xf4, 0x94, 0x9d, 0x80, 0xf6, 0x0e, 0x96, 0xd7, 0x46,
+	0x10, 0x53, 0xb7, 0xb7, 0x1d, 0xc8, 0x40, 0x9a, 0xa3, 0x13, 0x9d, 0x92, 0x6c, 0xc3, 0x93, 0x30,
+	0x94, 0xe0, 0x74, 0x29, 0xb0, 0xb4, 0xc8, 0x93, 0x5c, 0xc4, 0xbf, 0xb7, 0xfe, 0xa9, 0xa0, 0xda,
+	0x4d, 0xdc, 0xff, 0x67, 0x4d, 0x35, 0xc3, 0xdf, 0xa1, 0x72, 0x48, 0x15, 0x1d, 0x82, 0x55, 0x6c,
+	0x16, 0x8f, 0xab, 0xa7, 0x5f, 0xd8, 0x4b, 0xf5, 0xd8, 0x1d, 0x03, 0xba, 0x2c, 0x3d, 0xfd, 0x77,
+	0x50, 0x20, 0x49, 0x09, 0xbe, 0x46, 0xb5, 0x1e, 0x63, 0xbe, 0xeb, 0x49, 0xd1, 0xe3, 0x01, 0x58,
+	0x2b, 0xcd, 0xd5, 0xe3, 0xea, 0xe9, 0x97, 0x19, 0x14, 0x3f, 0x30, 0xe6, 0x5f, 0x19, 0x24, 0xa9,
+	0xf6, 0xd2, 0x33, 0x60, 0x17, 0xed, 0x0e, 0xa8, 0x66, 0xa0, 0x5d, 0x16, 0x4a, 0xaf, 0xef, 0x52,
+	0xe1, 0xbb, 0x4a, 0x8e, 0x84, 0x0f, 0xd6, 0xaa, 0x21, 0x3c, 0xce, 0x21, 0xfc, 0x3e, 0x2a, 0xb9,
+	0x10, 0x3e, 0x89, 0x0a, 0xc8, 0x76, 0x4c, 0x34, 0x97, 0x04, 0x7c, 0x8f, 0xb0, 0x91, 0xa9, 0x15,
+	0x15, 0x30, 0xe4, 0x00, 0x5c, 0x0a, 0xb0, 0x4a, 0x86, 0xfb, 0xeb, 0x1c, 0xee, 0x5f, 0x66, 0xf0,
+	0xa4, 0xde, 0x5b, 0xc8, 0x00, 0x56, 0xe8, 0xf3, 0x44, 0x38, 0x0d, 0x02, 0xc5, 0x02, 0xaa, 0xa5,
+	0x8a, 0x95, 0xbb, 0xdc, 0x07, 0x6b, 0xcd, 0x34, 0x38, 0xcb, 0x69, 0x70, 0x6b, 0xaa, 0x2f, 0xd2,
+	0x62, 0xa3, 0xb7, 0x7d, 0x0d, 0xc4, 0x1a, 0x2c, 0xfd, 0xc5, 0x37, 0x23, 0x57, 0xec, 0x4f, 0xaa,
+	0x7c, 0x37, 0x94, 0x72, 0x00, 0x56, 0x39, 0x77, 0xe4, 0xc4, 0x40, 0x3b, 0x52, 0x0e, 0x48, 0x55,
+	0xa5, 0x67, 0xc0, 0xbf, 0xa2, 0x5d, 0x33, 0x11, 0xd9, 0x05, 0xa6, 0xc6, 0x54, 0x73, 0x29, 0x5c,
+	0x4f, 0x8e, 0x84, 0x06, 0xab, 0xf2, 0x8e, 0x6f, 0x18, 0x01, 0xc9, 0x4e, 0xc4, 0x70, 0x37, 0x25,
+	0x88, 0xd3, 0xf8, 0x01, 0x59, 0x6f, 0x86, 0x3d, 0xe1, 0xfe, 0xe8, 0xbd, 0xdc, 0x9f, 0x2e, 0x0e,
+	0x3b, 0x21, 0xbf, 0x47, 0x38, 0x64, 0xc2, 0xe7, 0x22, 0x70, 0x43, 0xfa, 0xc8, 0x18, 0xf4, 0x79,
+	0x08, 0xd6, 0x7a, 0xee, 0x97, 0xec, 0xc4, 0x05, 0x9d, 0x09, 0x9e, 0xd4, 0xc3, 0x85, 0x0c, 0xe0,
+	0x1f, 0xd1, 0x86, 0x11, 0x3d, 0xe0, 0x63, 0x26, 0x18, 0x80, 0x85, 0x0c, 0xe5, 0x61, 0xde, 0xb7,
+	0x4b, 0xa0, 0xa4, 0xd6, 0x9b, 0x89, 0xf0, 0x4f, 0x68, 0x4b, 0x2a, 0xea, 0x0d, 0xd8, 0x94, 0xab,
+	0x6a, 0xb8, 0x8e, 0x32, 0xb8, 0xee, 0x0c, 0x3a, 0x65, 0xdb, 0x94, 0x73, 0x71, 0x4b, 0xa3, 0x8f,
+	0x17, 0x57, 0x11, 0xef, 0xa2, 0x8a, 0x51, 0xcb, 0x7d, 0x63, 0xda, 0x75, 0x52, 0x8e, 0xc2, 0xb6,
+	0x8f, 0x6f, 0x50, 0x6d, 0x76, 0xec, 0xd6, 0x4a, 0xb3, 0x98, 0x73, 0x8b, 0xb9, 0xf5, 0x9e, 0x2b,
+	0x6c, 0xfd, 0x85, 0xea, 0x6f, 0xcc, 0x95, 0xdd, 0xf6, 0x16, 0x6d, 0x2d, 0x38, 0x37, 0xe9, 0xfc,
+	0x55, 0x46, 0xe7, 0x79, 0xd3, 0x6e, 0xb0, 0xd9, 0xb0, 0x15, 0xa0, 0xfd, 0x3c, 0x6f, 0x64, 0xcb,
+	0xb0, 0xd1, 0x27, 0x4b, 0x7c, 0x68, 0xa4, 0x94, 0x48, 0x9d, 0x2e, 0x7a, 0xa9, 0xf5, 0x3b, 0x42,
+	0x53, 0x7f, 0x64, 0xd3, 0x9e, 0xa3, 0x32, 0x1d, 0x46, 0xeb, 0x97, 0x5c, 0xea, 0x33, 0x3b, 0x7e,
+	0x63, 0xed, 0xe8, 0x8d, 0x4d, 0xaf, 0x74, 0x25, 0xb9, 0x98, 0xbc, 0x8e, 0x31, 0xbc, 0xf5, 0x80,
+	0xd0, 0x74, 0xa5, 0xb3, 0xf9, 0xbf, 0x45, 0xe5, 0xc4, 0x1e, 0xf1, 0xf3, 0xb9, 0x9f, 0x31, 0x34,
+	0xc3, 0x43, 0x12, 0x6c, 0xeb, 0x1c, 0xad, 0x99, 0x04, 0xb6, 0x50, 0x85, 0xfa, 0xbe, 0x8a, 0x16,
+	0x2d, 0xe6, 0x9d, 0x84, 0x78, 0x1b, 0xad, 0x79, 0xa9, 0xee, 0x12, 0x89, 0x83, 0x68, 0xa1, 0x16,
+	0x1d, 0x91, 0xad, 0xad, 0x89, 0xaa, 0xc9, 0x5e, 0x68, 0xcd, 0x94, 0x21, 0x5a, 0x27, 0xb3, 0x29,
+	0x7c, 0x84, 0x36, 0x43, 0x25, 0x43, 0x09, 0xcc, 0x8f, 0x2d, 0x69, 0xad, 0x1a, 0xd0, 0xc6, 0x24,
+	0x6b, 0xba, 0x5c, 0x7a, 0x4f, 0x2f, 0x8d, 0xe2, 0xf3, 0x4b, 0xa3, 0xf8, 0xff, 0x4b, 0xa3, 0xf8,
+	0xf7, 0x6b, 0xa3, 0xf0, 0xfc, 0xda, 0x28, 0xfc, 0xfb, 0xda, 0x28, 0xfc, 0xd6, 0x0e, 0xb8, 0xee,
+	0x8f, 0xba, 0xb6, 0x27, 0x87, 0x4e, 0x7b, 0x72, 0xf1, 0x5b, 0xda, 0x05, 0x27, 0x1d, 0xc3, 0x37,
+	0x9e, 0x54, 0x6c, 0x36, 0xec, 0x53, 0x2e, 0x9c, 0xa1, 0xf4, 0x47, 0x03, 0x06, 0xe6, 0xef, 0x51,
+	0x3f, 0x86, 0x0c, 0xba, 0x65, 0xf3, 0x1f, 0x77, 0xf6, 0x61, 0x00, 0x82, 0xa5, 0x24, 0x8e, 0x78,
+	0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -599,6 +620,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleLiveness) > 0 {
+		for iNdEx := len(m.OracleLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeedLiveness) > 0 {
+		for iNdEx := len(m.FeedLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeedLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.PendingPayeeships) > 0 {
 		for iNdEx := len(m.PendingPayeeships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1073,6 +1122,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FeedLiveness) > 0 {
+		for _, e := range m.FeedLiveness {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleLiveness) > 0 {
+		for _, e := range m.OracleLiveness {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1537,6 +1598,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedLiveness = append(m.FeedLiveness, &FeedLiveness{})
+			if err := m.FeedLiveness[len(m.FeedLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleLiveness = append(m.OracleLiveness, &OracleLiveness{})
+			if err := m.OracleLiveness[len(m.OracleLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,6 +36,8 @@ var (
 	PayeePrefix                = []byte{0x09}
 	PendingPayeeTransferPrefix = []byte{0x10}
 	ParamsKey                  = []byte{0x11}
+	FeedLivenessPrefix         = []byte{0x12}
+	OracleLivenessPrefix       = []byte{0x13}
 )
 
 func GetFeedConfigKey(feedId string) []byte {
@@ -157,6 +159,35 @@ func GetPendingPayeeshipTransferPrefix(feedId string, transmitter sdk.AccAddress
 	return buf
 }
 
+func GetFeedLivenessKey(feedId string) []byte {
+	feedIdBz := getPaddedFeedIdBz(feedId)
+
+	buf := make([]byte, 0, len(FeedLivenessPrefix)+len(feedIdBz))
+	buf = append(buf, FeedLivenessPrefix...)
+	buf = append(buf, feedIdBz...)
+	return buf
+}
+
+func GetOracleLivenessKey(feedId string, oracle sdk.AccAddress) []byte {
+	feedIdBz := getPaddedFeedIdBz(feedId)
+	oracleBz := oracle.Bytes()
+
+	buf := make([]byte, 0, len(OracleLivenessPrefix)+len(feedIdBz)+len(oracleBz))
+	buf = append(buf, OracleLivenessPrefix...)
+	buf = append(buf, feedIdBz...)
+	buf = append(buf, oracleBz...)
+	return buf
+}
+
+func GetOracleLivenessPrefix(feedId string) []byte {
+	feedIdBz := getPaddedFeedIdBz(feedId)
+
+	buf := make([]byte, 0, len(OracleLivenessPrefix)+len(feedIdBz))
+	buf = append(buf, OracleLivenessPrefix...)
+	buf = append(buf, feedIdBz...)
+	return buf
+}
+
 func getPaddedFeedIdBz(feedId string) string {
 	return fmt.Sprintf("%20s", feedId)
 }
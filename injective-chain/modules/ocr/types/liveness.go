@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewOracleLiveness(feedId string, oracle sdk.AccAddress) *OracleLiveness {
+	return &OracleLiveness{
+		FeedId: feedId,
+		Oracle: oracle.String(),
+	}
+}
+
+// RecordObservation records that the oracle observed a round transmitted at the timestamp
+func (l *OracleLiveness) RecordObservation(timestamp int64) {
+	l.ObservedRounds++
+	l.PeriodObservedRounds++
+	l.ConsecutiveMissedRounds = 0
+	l.LastObservedTimestamp = timestamp
+}
+
+// RecordMiss records that the oracle missed a round
+func (l *OracleLiveness) RecordMiss() {
+	l.MissedRounds++
+	l.PeriodMissedRounds++
+	l.ConsecutiveMissedRounds++
+}
+
+// PeriodParticipationRate returns the share of the rounds observed by the oracle since the last payout, or 1 if
+// there was no round.
+func (l *OracleLiveness) PeriodParticipationRate() math.LegacyDec {
+	periodRounds := l.PeriodObservedRounds + l.PeriodMissedRounds
+	if periodRounds == 0 {
+		return math.LegacyOneDec()
+	}
+
+	return math.LegacyNewDec(int64(l.PeriodObservedRounds)).QuoInt64(int64(periodRounds))
+}
+
+// ResetPeriod starts a new payout period
+func (l *OracleLiveness) ResetPeriod() {
+	l.PeriodObservedRounds = 0
+	l.PeriodMissedRounds = 0
+}
+
+func (l *OracleLiveness) Validate() error {
+	if l == nil || l.FeedId == "" {
+		return fmt.Errorf("oracle liveness is missing a feed id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(l.Oracle); err != nil {
+		return fmt.Errorf("oracle liveness of feed %s has an invalid oracle address %s", l.FeedId, l.Oracle)
+	}
+
+	return nil
+}
@@ -38,6 +38,15 @@ type Params struct {
 	PayoutBlockInterval uint64 `protobuf:"varint,2,opt,name=payout_block_interval,json=payoutBlockInterval,proto3" json:"payout_block_interval,omitempty"`
 	// The admin for the OCR module
 	ModuleAdmin string `protobuf:"bytes,3,opt,name=module_admin,json=moduleAdmin,proto3" json:"module_admin,omitempty"`
+	// Oracles observing a smaller fraction of the rounds of a feed since the
+	// last payout forfeit their rewards for the period, 0 disables the penalty
+	MinOracleParticipationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_oracle_participation_rate,json=minOracleParticipationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_oracle_participation_rate"`
+	// Oracles missing this many consecutive rounds of a feed are reported for
+	// removal at each payout, 0 disables the report
+	MaxConsecutiveMissedRounds uint64 `protobuf:"varint,5,opt,name=max_consecutive_missed_rounds,json=maxConsecutiveMissedRounds,proto3" json:"max_consecutive_missed_rounds,omitempty"`
+	// Feeds without a new round for this many seconds are reported as stale, 0
+	// disables the staleness check
+	FeedStalenessThreshold int64 `protobuf:"varint,6,opt,name=feed_staleness_threshold,json=feedStalenessThreshold,proto3" json:"feed_staleness_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,6 +103,20 @@ func (m *Params) GetModuleAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMaxConsecutiveMissedRounds() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveMissedRounds
+	}
+	return 0
+}
+
+func (m *Params) GetFeedStalenessThreshold() int64 {
+	if m != nil {
+		return m.FeedStalenessThreshold
+	}
+	return 0
+}
+
 type FeedConfig struct {
 	// signers ith element is address ith oracle uses to sign a report
 	Signers []string `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
@@ -374,6 +397,186 @@ func (m *ModuleParams) GetBillingAdmin() string {
 	return ""
 }
 
+// FeedLiveness tracks the rounds transmitted for a feed
+type FeedLiveness struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// block time of the latest transmitted round
+	LastRoundTimestamp int64 `protobuf:"varint,2,opt,name=last_round_timestamp,json=lastRoundTimestamp,proto3" json:"last_round_timestamp,omitempty"`
+	// block height of the latest transmitted round
+	LastRoundHeight int64 `protobuf:"varint,3,opt,name=last_round_height,json=lastRoundHeight,proto3" json:"last_round_height,omitempty"`
+	// number of rounds transmitted since the liveness tracking started
+	TotalRounds uint64 `protobuf:"varint,4,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+}
+
+func (m *FeedLiveness) Reset()         { *m = FeedLiveness{} }
+func (m *FeedLiveness) String() string { return proto.CompactTextString(m) }
+func (*FeedLiveness) ProtoMessage()    {}
+func (*FeedLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{4}
+}
+func (m *FeedLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedLiveness.Merge(m, src)
+}
+func (m *FeedLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedLiveness proto.InternalMessageInfo
+
+func (m *FeedLiveness) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *FeedLiveness) GetLastRoundTimestamp() int64 {
+	if m != nil {
+		return m.LastRoundTimestamp
+	}
+	return 0
+}
+
+func (m *FeedLiveness) GetLastRoundHeight() int64 {
+	if m != nil {
+		return m.LastRoundHeight
+	}
+	return 0
+}
+
+func (m *FeedLiveness) GetTotalRounds() uint64 {
+	if m != nil {
+		return m.TotalRounds
+	}
+	return 0
+}
+
+// OracleLiveness tracks the participation of an oracle in the rounds of a feed
+type OracleLiveness struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// transmitter address of the oracle
+	Oracle string `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// number of rounds the oracle observed
+	ObservedRounds uint64 `protobuf:"varint,3,opt,name=observed_rounds,json=observedRounds,proto3" json:"observed_rounds,omitempty"`
+	// number of rounds the oracle missed
+	MissedRounds uint64 `protobuf:"varint,4,opt,name=missed_rounds,json=missedRounds,proto3" json:"missed_rounds,omitempty"`
+	// number of rounds the oracle missed since its latest observation
+	ConsecutiveMissedRounds uint64 `protobuf:"varint,5,opt,name=consecutive_missed_rounds,json=consecutiveMissedRounds,proto3" json:"consecutive_missed_rounds,omitempty"`
+	// number of rounds the oracle observed since the last payout
+	PeriodObservedRounds uint64 `protobuf:"varint,6,opt,name=period_observed_rounds,json=periodObservedRounds,proto3" json:"period_observed_rounds,omitempty"`
+	// number of rounds the oracle missed since the last payout
+	PeriodMissedRounds uint64 `protobuf:"varint,7,opt,name=period_missed_rounds,json=periodMissedRounds,proto3" json:"period_missed_rounds,omitempty"`
+	// block time of the latest round observed by the oracle
+	LastObservedTimestamp int64 `protobuf:"varint,8,opt,name=last_observed_timestamp,json=lastObservedTimestamp,proto3" json:"last_observed_timestamp,omitempty"`
+}
+
+func (m *OracleLiveness) Reset()         { *m = OracleLiveness{} }
+func (m *OracleLiveness) String() string { return proto.CompactTextString(m) }
+func (*OracleLiveness) ProtoMessage()    {}
+func (*OracleLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{5}
+}
+func (m *OracleLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleLiveness.Merge(m, src)
+}
+func (m *OracleLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleLiveness proto.InternalMessageInfo
+
+func (m *OracleLiveness) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *OracleLiveness) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+func (m *OracleLiveness) GetObservedRounds() uint64 {
+	if m != nil {
+		return m.ObservedRounds
+	}
+	return 0
+}
+
+func (m *OracleLiveness) GetMissedRounds() uint64 {
+	if m != nil {
+		return m.MissedRounds
+	}
+	return 0
+}
+
+func (m *OracleLiveness) GetConsecutiveMissedRounds() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedRounds
+	}
+	return 0
+}
+
+func (m *OracleLiveness) GetPeriodObservedRounds() uint64 {
+	if m != nil {
+		return m.PeriodObservedRounds
+	}
+	return 0
+}
+
+func (m *OracleLiveness) GetPeriodMissedRounds() uint64 {
+	if m != nil {
+		return m.PeriodMissedRounds
+	}
+	return 0
+}
+
+func (m *OracleLiveness) GetLastObservedTimestamp() int64 {
+	if m != nil {
+		return m.LastObservedTimestamp
+	}
+	return 0
+}
+
 type ContractConfig struct {
 	// config_count ordinal number of this config setting among all config
 	// settings
@@ -400,7 +603,7 @@ func (m *ContractConfig) Reset()         { *m = ContractConfig{} }
 func (m *ContractConfig) String() string { return proto.CompactTextString(m) }
 func (*ContractConfig) ProtoMessage()    {}
 func (*ContractConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{4}
+	return fileDescriptor_0acb79560f1720fa, []int{6}
 }
 func (m *ContractConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +691,7 @@ func (m *SetConfigProposal) Reset()         { *m = SetConfigProposal{} }
 func (m *SetConfigProposal) String() string { return proto.CompactTextString(m) }
 func (*SetConfigProposal) ProtoMessage()    {}
 func (*SetConfigProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{5}
+	return fileDescriptor_0acb79560f1720fa, []int{7}
 }
 func (m *SetConfigProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +753,7 @@ func (m *FeedProperties) Reset()         { *m = FeedProperties{} }
 func (m *FeedProperties) String() string { return proto.CompactTextString(m) }
 func (*FeedProperties) ProtoMessage()    {}
 func (*FeedProperties) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{6}
+	return fileDescriptor_0acb79560f1720fa, []int{8}
 }
 func (m *FeedProperties) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,7 +849,7 @@ func (m *SetBatchConfigProposal) Reset()         { *m = SetBatchConfigProposal{}
 func (m *SetBatchConfigProposal) String() string { return proto.CompactTextString(m) }
 func (*SetBatchConfigProposal) ProtoMessage()    {}
 func (*SetBatchConfigProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{7}
+	return fileDescriptor_0acb79560f1720fa, []int{9}
 }
 func (m *SetBatchConfigProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +886,7 @@ func (m *OracleObservationsCounts) Reset()         { *m = OracleObservationsCoun
 func (m *OracleObservationsCounts) String() string { return proto.CompactTextString(m) }
 func (*OracleObservationsCounts) ProtoMessage()    {}
 func (*OracleObservationsCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{8}
+	return fileDescriptor_0acb79560f1720fa, []int{10}
 }
 func (m *OracleObservationsCounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +931,7 @@ func (m *GasReimbursements) Reset()         { *m = GasReimbursements{} }
 func (m *GasReimbursements) String() string { return proto.CompactTextString(m) }
 func (*GasReimbursements) ProtoMessage()    {}
 func (*GasReimbursements) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{9}
+	return fileDescriptor_0acb79560f1720fa, []int{11}
 }
 func (m *GasReimbursements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,7 +976,7 @@ func (m *Payee) Reset()         { *m = Payee{} }
 func (m *Payee) String() string { return proto.CompactTextString(m) }
 func (*Payee) ProtoMessage()    {}
 func (*Payee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{10}
+	return fileDescriptor_0acb79560f1720fa, []int{12}
 }
 func (m *Payee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +1031,7 @@ func (m *Transmission) Reset()         { *m = Transmission{} }
 func (m *Transmission) String() string { return proto.CompactTextString(m) }
 func (*Transmission) ProtoMessage()    {}
 func (*Transmission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{11}
+	return fileDescriptor_0acb79560f1720fa, []int{13}
 }
 func (m *Transmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1083,7 @@ func (m *EpochAndRound) Reset()         { *m = EpochAndRound{} }
 func (m *EpochAndRound) String() string { return proto.CompactTextString(m) }
 func (*EpochAndRound) ProtoMessage()    {}
 func (*EpochAndRound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{12}
+	return fileDescriptor_0acb79560f1720fa, []int{14}
 }
 func (m *EpochAndRound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1136,7 @@ func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{13}
+	return fileDescriptor_0acb79560f1720fa, []int{15}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1192,7 @@ func (m *ReportToSign) Reset()         { *m = ReportToSign{} }
 func (m *ReportToSign) String() string { return proto.CompactTextString(m) }
 func (*ReportToSign) ProtoMessage()    {}
 func (*ReportToSign) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{14}
+	return fileDescriptor_0acb79560f1720fa, []int{16}
 }
 func (m *ReportToSign) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1266,7 @@ func (m *EventOraclePaid) Reset()         { *m = EventOraclePaid{} }
 func (m *EventOraclePaid) String() string { return proto.CompactTextString(m) }
 func (*EventOraclePaid) ProtoMessage()    {}
 func (*EventOraclePaid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{15}
+	return fileDescriptor_0acb79560f1720fa, []int{17}
 }
 func (m *EventOraclePaid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1326,7 @@ func (m *EventAnswerUpdated) Reset()         { *m = EventAnswerUpdated{} }
 func (m *EventAnswerUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAnswerUpdated) ProtoMessage()    {}
 func (*EventAnswerUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{16}
+	return fileDescriptor_0acb79560f1720fa, []int{18}
 }
 func (m *EventAnswerUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1373,7 @@ func (m *EventNewRound) Reset()         { *m = EventNewRound{} }
 func (m *EventNewRound) String() string { return proto.CompactTextString(m) }
 func (*EventNewRound) ProtoMessage()    {}
 func (*EventNewRound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{17}
+	return fileDescriptor_0acb79560f1720fa, []int{19}
 }
 func (m *EventNewRound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,7 +1425,7 @@ func (m *EventTransmitted) Reset()         { *m = EventTransmitted{} }
 func (m *EventTransmitted) String() string { return proto.CompactTextString(m) }
 func (*EventTransmitted) ProtoMessage()    {}
 func (*EventTransmitted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{18}
+	return fileDescriptor_0acb79560f1720fa, []int{20}
 }
 func (m *EventTransmitted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1484,7 @@ func (m *EventNewTransmission) Reset()         { *m = EventNewTransmission{} }
 func (m *EventNewTransmission) String() string { return proto.CompactTextString(m) }
 func (*EventNewTransmission) ProtoMessage()    {}
 func (*EventNewTransmission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{19}
+	return fileDescriptor_0acb79560f1720fa, []int{21}
 }
 func (m *EventNewTransmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1373,7 +1576,7 @@ func (m *EventConfigSet) Reset()         { *m = EventConfigSet{} }
 func (m *EventConfigSet) String() string { return proto.CompactTextString(m) }
 func (*EventConfigSet) ProtoMessage()    {}
 func (*EventConfigSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0acb79560f1720fa, []int{20}
+	return fileDescriptor_0acb79560f1720fa, []int{22}
 }
 func (m *EventConfigSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1430,11 +1633,149 @@ func (m *EventConfigSet) GetConfigInfo() *FeedConfigInfo {
 	return nil
 }
 
+type EventOracleRewardPenalized struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// transmitter address of the oracle
+	Oracle string `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// share of the rounds observed by the oracle since the last payout
+	ParticipationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=participation_rate,json=participationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"participation_rate"`
+	// rewards forfeited by the oracle, which stay in the reward pool
+	Forfeited types.Coin `protobuf:"bytes,4,opt,name=forfeited,proto3" json:"forfeited"`
+}
+
+func (m *EventOracleRewardPenalized) Reset()         { *m = EventOracleRewardPenalized{} }
+func (m *EventOracleRewardPenalized) String() string { return proto.CompactTextString(m) }
+func (*EventOracleRewardPenalized) ProtoMessage()    {}
+func (*EventOracleRewardPenalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{23}
+}
+func (m *EventOracleRewardPenalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOracleRewardPenalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOracleRewardPenalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOracleRewardPenalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOracleRewardPenalized.Merge(m, src)
+}
+func (m *EventOracleRewardPenalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOracleRewardPenalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOracleRewardPenalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOracleRewardPenalized proto.InternalMessageInfo
+
+func (m *EventOracleRewardPenalized) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *EventOracleRewardPenalized) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+func (m *EventOracleRewardPenalized) GetForfeited() types.Coin {
+	if m != nil {
+		return m.Forfeited
+	}
+	return types.Coin{}
+}
+
+// EventOracleRemoved reports an absent oracle which should be removed from the
+// feed config by governance or the feed admin
+type EventOracleRemoved struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// transmitter address of the absent oracle
+	Oracle string `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// signer address of the absent oracle
+	Signer                  string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	ConsecutiveMissedRounds uint64 `protobuf:"varint,4,opt,name=consecutive_missed_rounds,json=consecutiveMissedRounds,proto3" json:"consecutive_missed_rounds,omitempty"`
+}
+
+func (m *EventOracleRemoved) Reset()         { *m = EventOracleRemoved{} }
+func (m *EventOracleRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventOracleRemoved) ProtoMessage()    {}
+func (*EventOracleRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{24}
+}
+func (m *EventOracleRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOracleRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOracleRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOracleRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOracleRemoved.Merge(m, src)
+}
+func (m *EventOracleRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOracleRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOracleRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOracleRemoved proto.InternalMessageInfo
+
+func (m *EventOracleRemoved) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *EventOracleRemoved) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+func (m *EventOracleRemoved) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *EventOracleRemoved) GetConsecutiveMissedRounds() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedRounds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.ocr.v1beta1.Params")
 	proto.RegisterType((*FeedConfig)(nil), "injective.ocr.v1beta1.FeedConfig")
 	proto.RegisterType((*FeedConfigInfo)(nil), "injective.ocr.v1beta1.FeedConfigInfo")
 	proto.RegisterType((*ModuleParams)(nil), "injective.ocr.v1beta1.ModuleParams")
+	proto.RegisterType((*FeedLiveness)(nil), "injective.ocr.v1beta1.FeedLiveness")
+	proto.RegisterType((*OracleLiveness)(nil), "injective.ocr.v1beta1.OracleLiveness")
 	proto.RegisterType((*ContractConfig)(nil), "injective.ocr.v1beta1.ContractConfig")
 	proto.RegisterType((*SetConfigProposal)(nil), "injective.ocr.v1beta1.SetConfigProposal")
 	proto.RegisterType((*FeedProperties)(nil), "injective.ocr.v1beta1.FeedProperties")
@@ -1452,115 +1793,139 @@ func init() {
 	proto.RegisterType((*EventTransmitted)(nil), "injective.ocr.v1beta1.EventTransmitted")
 	proto.RegisterType((*EventNewTransmission)(nil), "injective.ocr.v1beta1.EventNewTransmission")
 	proto.RegisterType((*EventConfigSet)(nil), "injective.ocr.v1beta1.EventConfigSet")
+	proto.RegisterType((*EventOracleRewardPenalized)(nil), "injective.ocr.v1beta1.EventOracleRewardPenalized")
+	proto.RegisterType((*EventOracleRemoved)(nil), "injective.ocr.v1beta1.EventOracleRemoved")
 }
 
 func init() { proto.RegisterFile("injective/ocr/v1beta1/ocr.proto", fileDescriptor_0acb79560f1720fa) }
 
 var fileDescriptor_0acb79560f1720fa = []byte{
-	// 1648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x25, 0x1e, 0x3e, 0x64, 0xad, 0x24, 0x9b, 0xd6, 0xb5, 0x44, 0x7a, 0x7d,
-	0x8d, 0xab, 0x7b, 0x01, 0x93, 0xd7, 0x0a, 0x12, 0x27, 0x76, 0x91, 0x88, 0xf2, 0x4b, 0x80, 0x1f,
-	0xca, 0x4a, 0x76, 0x91, 0x66, 0x31, 0xdc, 0x1d, 0x92, 0x1b, 0x73, 0x67, 0x98, 0xd9, 0xa1, 0x6c,
-	0xfd, 0x82, 0x04, 0xa9, 0x0c, 0x24, 0x48, 0xed, 0x2a, 0x45, 0x80, 0x00, 0x29, 0xdc, 0xa4, 0x49,
-	0x95, 0xc2, 0x08, 0x02, 0xc4, 0x65, 0x90, 0xc2, 0x09, 0xec, 0x22, 0xfe, 0x0b, 0xa9, 0x12, 0xcc,
-	0x83, 0xe4, 0xf2, 0x21, 0x5a, 0xb4, 0xd5, 0x10, 0x3b, 0xdf, 0x79, 0xcc, 0xd9, 0xf3, 0xf8, 0x66,
-	0x96, 0x50, 0xf0, 0xc9, 0xc7, 0xd8, 0xe5, 0xfe, 0x1e, 0x2e, 0x53, 0x97, 0x95, 0xf7, 0xce, 0x57,
-	0x31, 0x47, 0xe7, 0xc5, 0x73, 0xa9, 0xc5, 0x28, 0xa7, 0xe6, 0x52, 0x57, 0xa1, 0x24, 0x40, 0xad,
-	0xb0, 0xbc, 0xea, 0xd2, 0x30, 0xa0, 0x61, 0xb9, 0x8a, 0x42, 0xdc, 0xb5, 0x72, 0xa9, 0x4f, 0x94,
-	0xd9, 0xf2, 0x49, 0x25, 0x77, 0xe4, 0xaa, 0xac, 0x16, 0x5a, 0xb4, 0x58, 0xa7, 0x75, 0xaa, 0x70,
-	0xf1, 0xa4, 0xd1, 0x42, 0x9d, 0xd2, 0x7a, 0x13, 0x97, 0xe5, 0xaa, 0xda, 0xae, 0x95, 0xb9, 0x1f,
-	0xe0, 0x90, 0xa3, 0xa0, 0xa5, 0x15, 0xe6, 0x51, 0xe0, 0x13, 0x5a, 0x96, 0xbf, 0x0a, 0xb2, 0xbe,
-	0x30, 0x20, 0xb9, 0x8d, 0x18, 0x0a, 0x42, 0x73, 0x05, 0xa0, 0xe9, 0x93, 0x7b, 0x8e, 0x87, 0x09,
-	0x0d, 0xf2, 0x46, 0xd1, 0x58, 0x4b, 0xd9, 0x29, 0x81, 0x5c, 0x16, 0x80, 0xb9, 0x0e, 0x4b, 0x2d,
-	0xb4, 0x4f, 0xdb, 0xdc, 0xa9, 0x36, 0xa9, 0x7b, 0xcf, 0xf1, 0x09, 0xc7, 0x6c, 0x0f, 0x35, 0xf3,
-	0xb1, 0xa2, 0xb1, 0x96, 0xb0, 0x17, 0x94, 0xb0, 0x22, 0x64, 0x5b, 0x5a, 0x64, 0x9e, 0x86, 0x4c,
-	0x40, 0xbd, 0x76, 0x13, 0x3b, 0xc8, 0x0b, 0x7c, 0x92, 0x8f, 0x4b, 0xa7, 0x69, 0x85, 0x6d, 0x08,
-	0xe8, 0xe2, 0xc2, 0xcb, 0x47, 0x05, 0xe3, 0xf3, 0x3f, 0xbf, 0xfb, 0x1f, 0x88, 0xe4, 0xa9, 0x50,
-	0xac, 0x6f, 0x62, 0x00, 0x57, 0x31, 0xf6, 0x36, 0x29, 0xa9, 0xf9, 0x75, 0x33, 0x0f, 0x33, 0xa1,
-	0x5f, 0x27, 0x98, 0x85, 0x79, 0xa3, 0x18, 0x5f, 0x4b, 0xd9, 0x9d, 0xa5, 0x69, 0x41, 0x86, 0x33,
-	0x44, 0xc2, 0xc0, 0xe7, 0x5c, 0x88, 0x63, 0x52, 0xdc, 0x87, 0x99, 0x19, 0x30, 0x6a, 0x72, 0xe7,
-	0xac, 0x6d, 0xd4, 0xcc, 0xb3, 0x90, 0xa3, 0xc4, 0x6d, 0x20, 0x9f, 0x38, 0xae, 0xf4, 0x9e, 0x4f,
-	0x14, 0x8d, 0xb5, 0x8c, 0x9d, 0xd5, 0xa8, 0xde, 0xf2, 0x1d, 0x38, 0x41, 0x6b, 0xb5, 0xa8, 0x9e,
-	0xb3, 0x87, 0x59, 0xe8, 0x53, 0x92, 0x9f, 0x96, 0xef, 0xbb, 0xd4, 0x11, 0x2b, 0x83, 0xbb, 0x4a,
-	0x68, 0xfe, 0x07, 0xe6, 0x06, 0xec, 0xf2, 0x49, 0xe9, 0x3f, 0xd7, 0xaf, 0x6f, 0x5e, 0x87, 0xac,
-	0x4e, 0x4d, 0x4b, 0xbe, 0x73, 0x7e, 0xa6, 0x68, 0xac, 0xa5, 0xd7, 0xcf, 0x94, 0x46, 0x36, 0x4b,
-	0xe9, 0xa6, 0xd4, 0x55, 0xe9, 0xb1, 0x75, 0x52, 0x75, 0xb2, 0x7e, 0x30, 0x20, 0xd7, 0x4b, 0xd6,
-	0x16, 0xa9, 0x51, 0xf3, 0xff, 0xb0, 0xd8, 0x44, 0x1c, 0x87, 0xbc, 0x13, 0xbb, 0xe7, 0xd7, 0x71,
-	0xc8, 0x65, 0x51, 0x33, 0xb6, 0xa9, 0x64, 0x4a, 0xff, 0xb2, 0x94, 0xa8, 0x24, 0xc5, 0x3a, 0x49,
-	0xca, 0x80, 0x41, 0x3a, 0x29, 0x23, 0xa2, 0x8a, 0xda, 0x8d, 0x4b, 0xdb, 0x84, 0xcb, 0x84, 0x25,
-	0xec, 0xb4, 0xc2, 0x36, 0x05, 0x64, 0x5e, 0x82, 0xe5, 0xfe, 0x0d, 0x55, 0x8f, 0x90, 0x76, 0x50,
-	0xc5, 0x4c, 0x66, 0x2c, 0x6e, 0x9f, 0x88, 0x6e, 0x2b, 0xfb, 0xe4, 0x96, 0x14, 0x5b, 0x7f, 0xc7,
-	0x21, 0x13, 0x7d, 0x3f, 0xf3, 0x04, 0xcc, 0xd4, 0x30, 0xf6, 0x1c, 0xdf, 0xd3, 0x6d, 0x98, 0x14,
-	0xcb, 0x2d, 0xcf, 0xac, 0x00, 0x04, 0x3e, 0x71, 0x10, 0x09, 0xef, 0x63, 0x26, 0xc3, 0x4d, 0x55,
-	0xce, 0x3c, 0x79, 0x56, 0x98, 0xfa, 0xed, 0x59, 0xe1, 0x5f, 0x6a, 0x42, 0x42, 0xef, 0x5e, 0xc9,
-	0xa7, 0xe5, 0x00, 0xf1, 0x46, 0xe9, 0x06, 0xae, 0x23, 0x77, 0xff, 0x32, 0x76, 0xed, 0x54, 0xe0,
-	0x93, 0x0d, 0x69, 0x25, 0x7d, 0xa0, 0x07, 0x1d, 0x1f, 0xf1, 0x49, 0x7c, 0xa0, 0x07, 0xda, 0xc7,
-	0x6d, 0x58, 0x94, 0xa3, 0xd2, 0xc2, 0xcc, 0xa1, 0xd5, 0x50, 0x34, 0x3b, 0x17, 0xad, 0x91, 0x90,
-	0xde, 0x56, 0xb4, 0xb7, 0xa5, 0x61, 0x6f, 0x5b, 0x84, 0xdb, 0xa6, 0x30, 0xdd, 0xc6, 0xec, 0x76,
-	0xcf, 0xd0, 0xfc, 0x10, 0x96, 0xba, 0x0e, 0x75, 0xf3, 0x86, 0xdd, 0x66, 0x7b, 0xa5, 0xc7, 0x05,
-	0xed, 0x71, 0x37, 0x62, 0x39, 0x30, 0xce, 0xc9, 0xc1, 0x71, 0x3e, 0x0b, 0xb9, 0x36, 0xf1, 0x3f,
-	0x69, 0x63, 0x87, 0xe1, 0x16, 0x65, 0x5c, 0x35, 0xe0, 0xac, 0x9d, 0x55, 0xa8, 0xad, 0x40, 0xb3,
-	0x08, 0x69, 0x0f, 0x87, 0x2e, 0xf3, 0x5b, 0xf2, 0x05, 0x67, 0xd5, 0x00, 0x47, 0x20, 0xb1, 0x8f,
-	0x2c, 0x96, 0x9a, 0xf0, 0x94, 0xda, 0x47, 0x20, 0x72, 0xbe, 0xcd, 0x33, 0x90, 0xad, 0xfa, 0xcd,
-	0xa6, 0x4f, 0xea, 0x5a, 0x03, 0xa4, 0x46, 0x46, 0x83, 0x52, 0xc9, 0xfa, 0x34, 0x06, 0xb9, 0x4d,
-	0x4a, 0x38, 0x43, 0xae, 0xee, 0x8f, 0xa1, 0xa6, 0x33, 0x86, 0x9b, 0x2e, 0x42, 0x0b, 0xb1, 0xf1,
-	0xb4, 0x10, 0x3f, 0x88, 0x16, 0x12, 0x07, 0xd3, 0xc2, 0xf4, 0x84, 0xb4, 0x90, 0x9c, 0x90, 0x16,
-	0x66, 0x46, 0xd1, 0x82, 0xf5, 0xc4, 0x80, 0xf9, 0x1d, 0xac, 0x93, 0xb0, 0xcd, 0x68, 0x8b, 0x86,
-	0xa8, 0x69, 0x2e, 0xc2, 0x34, 0xf7, 0x79, 0x13, 0xeb, 0x71, 0x50, 0x8b, 0xc1, 0xda, 0xc4, 0x86,
-	0x6b, 0xf3, 0x1e, 0x24, 0xf5, 0x6e, 0x71, 0xc9, 0x2e, 0xa7, 0x0f, 0x60, 0x97, 0x1e, 0x7d, 0xd8,
-	0xda, 0xe0, 0xe2, 0x07, 0x9f, 0x3d, 0x2a, 0x4c, 0xbd, 0x7c, 0x54, 0x98, 0xfa, 0xe9, 0xf1, 0xb9,
-	0x65, 0x7d, 0xf8, 0xd4, 0xe9, 0x5e, 0xd7, 0x44, 0x94, 0x0b, 0x13, 0x2e, 0x98, 0x7b, 0x49, 0x30,
-	0xf7, 0x50, 0xd0, 0xd6, 0xe3, 0x84, 0xe2, 0x25, 0x01, 0x60, 0xc6, 0x7d, 0x3c, 0x66, 0xb0, 0xfb,
-	0xe9, 0x67, 0xb8, 0x18, 0xf1, 0x09, 0x8b, 0x91, 0x98, 0xb0, 0x18, 0xd3, 0x23, 0x39, 0xba, 0x9f,
-	0x6e, 0x92, 0x47, 0x40, 0x37, 0x33, 0x47, 0x4a, 0x37, 0xb3, 0x47, 0x4e, 0x37, 0xa9, 0xd7, 0xa6,
-	0x9b, 0x61, 0x3e, 0x81, 0x43, 0xf0, 0x49, 0x7a, 0xa8, 0x67, 0xad, 0x1f, 0x63, 0x70, 0x7c, 0x07,
-	0xf3, 0x0a, 0xe2, 0x6e, 0xe3, 0x88, 0xc6, 0x20, 0x42, 0x14, 0xf1, 0xf1, 0x44, 0x91, 0x18, 0x41,
-	0x14, 0xfd, 0x44, 0x3a, 0x3d, 0x48, 0xa4, 0xb7, 0x60, 0x4e, 0xf6, 0x74, 0xab, 0xdb, 0xe6, 0xf9,
-	0x64, 0x31, 0xbe, 0x96, 0x5e, 0x3f, 0x3b, 0x66, 0xd8, 0x7a, 0x33, 0x61, 0xe7, 0x6a, 0x7d, 0xeb,
-	0x8b, 0x57, 0x0e, 0x3f, 0x78, 0xcb, 0x7a, 0xf0, 0x46, 0xe4, 0xca, 0x5a, 0x87, 0xfc, 0x6d, 0x86,
-	0xdc, 0x26, 0x8e, 0xd4, 0x3d, 0x94, 0xbc, 0x19, 0x9a, 0xc7, 0x05, 0x2d, 0x88, 0x27, 0x79, 0x9d,
-	0xca, 0xda, 0x7a, 0x65, 0xdd, 0x85, 0xf9, 0x6b, 0x28, 0xb4, 0xb1, 0x1f, 0x54, 0xdb, 0x2c, 0xc4,
-	0x01, 0x16, 0xca, 0x1b, 0x90, 0x63, 0x7d, 0x88, 0x34, 0x4a, 0xaf, 0x9f, 0x2c, 0xe9, 0xe0, 0xc4,
-	0xfd, 0x35, 0x12, 0x9d, 0x4f, 0xec, 0x01, 0x03, 0xeb, 0x0e, 0x4c, 0x6f, 0xa3, 0x7d, 0x8c, 0xcd,
-	0xff, 0xc2, 0xb1, 0x48, 0x6a, 0x1d, 0xe4, 0x79, 0x4c, 0xd7, 0x72, 0x2e, 0x82, 0x6f, 0x78, 0x1e,
-	0x13, 0xfc, 0xdf, 0x42, 0xfb, 0xc2, 0x5e, 0xa9, 0xe9, 0xb2, 0x6a, 0x4c, 0xa8, 0x58, 0xdf, 0x1b,
-	0x90, 0xe9, 0xeb, 0xc1, 0x4b, 0x90, 0xd4, 0x73, 0x66, 0x1c, 0x7e, 0xce, 0xb4, 0x89, 0xf9, 0x36,
-	0x1c, 0x8f, 0xcc, 0x56, 0xe8, 0x74, 0x2f, 0xcf, 0x72, 0xeb, 0xb8, 0xbd, 0x14, 0x95, 0xee, 0x76,
-	0x84, 0xc2, 0x2c, 0x3a, 0x41, 0x11, 0xb3, 0xb8, 0x32, 0x8b, 0x4a, 0xbb, 0x66, 0xd6, 0x25, 0xc8,
-	0x5e, 0x69, 0x51, 0xb7, 0xb1, 0x41, 0x3c, 0x9b, 0xb6, 0x89, 0x27, 0x7a, 0x1b, 0x0b, 0x40, 0x1f,
-	0x74, 0x6a, 0x21, 0x50, 0x26, 0xc4, 0xfa, 0x92, 0xad, 0x16, 0xd6, 0xd7, 0x06, 0x24, 0xd5, 0x40,
-	0x8d, 0x89, 0xda, 0x18, 0x17, 0xf5, 0x29, 0x48, 0x29, 0x81, 0x3a, 0x3c, 0x05, 0xf9, 0xf5, 0x00,
-	0xf3, 0x1a, 0x64, 0xa2, 0x66, 0x6a, 0x68, 0x0e, 0x97, 0xcd, 0x3e, 0x43, 0xeb, 0x2b, 0x03, 0x32,
-	0x2a, 0xd0, 0x5d, 0xba, 0xe3, 0xd7, 0xe5, 0x6d, 0x60, 0xd4, 0x8d, 0x54, 0x1f, 0xf5, 0xfa, 0x2e,
-	0xda, 0x4d, 0x45, 0x6c, 0x64, 0x2a, 0xe2, 0x91, 0x54, 0x88, 0xe1, 0xc4, 0x0f, 0x38, 0x43, 0x4e,
-	0x03, 0x85, 0x0d, 0x7d, 0x95, 0x4f, 0x49, 0xe4, 0x3a, 0x0a, 0x1b, 0xa2, 0xd3, 0x15, 0x1d, 0x69,
-	0x86, 0xd7, 0x2b, 0xeb, 0x4b, 0x03, 0xe6, 0xae, 0xec, 0x61, 0xc2, 0xd5, 0x8c, 0x6c, 0x23, 0xdf,
-	0x9b, 0xa4, 0x39, 0x57, 0x00, 0x5a, 0xa2, 0xa1, 0xa3, 0xad, 0x99, 0x92, 0x88, 0x14, 0x5f, 0x80,
-	0x24, 0x0a, 0xe4, 0xad, 0x45, 0x1d, 0xbb, 0x07, 0x8f, 0x4a, 0x25, 0x21, 0x92, 0x6a, 0x6b, 0x75,
-	0xeb, 0x67, 0x03, 0x4c, 0x19, 0x96, 0x22, 0xfe, 0x3b, 0x2d, 0x0f, 0x71, 0xec, 0x99, 0x17, 0x60,
-	0xc6, 0x6d, 0x33, 0x86, 0xf5, 0x35, 0xe8, 0x95, 0x04, 0xdd, 0xd1, 0x36, 0xdf, 0x85, 0x59, 0x99,
-	0x26, 0x71, 0xe0, 0xc6, 0x0e, 0x65, 0x29, 0xd5, 0xb7, 0x3c, 0x73, 0x13, 0xa0, 0xad, 0x76, 0x77,
-	0x50, 0xe7, 0x35, 0x96, 0x4b, 0xea, 0x03, 0xb3, 0xd4, 0xf9, 0xc0, 0x2c, 0x75, 0x1b, 0xaa, 0x32,
-	0x2b, 0xfc, 0x3e, 0xfc, 0xbd, 0x60, 0xd8, 0x29, 0x6d, 0xb7, 0xc1, 0xad, 0x6f, 0x0d, 0xc8, 0xca,
-	0xd7, 0xb9, 0x85, 0xef, 0xab, 0x2e, 0x8f, 0x06, 0x64, 0x4c, 0x14, 0xd0, 0x0a, 0x40, 0xc8, 0x11,
-	0x13, 0x01, 0x55, 0xf7, 0x3b, 0x29, 0xd7, 0x48, 0x65, 0x5f, 0xc4, 0xdb, 0x11, 0x4f, 0x1a, 0xaf,
-	0xb6, 0xdb, 0xe0, 0xd6, 0x4d, 0x38, 0x26, 0xc3, 0xdd, 0xed, 0x96, 0xdb, 0x7b, 0x83, 0x8e, 0xb5,
-	0x7e, 0x89, 0xc3, 0x62, 0xe7, 0xf5, 0xfb, 0x78, 0xea, 0xc0, 0x6b, 0x50, 0x09, 0x16, 0x50, 0xbd,
-	0xce, 0x70, 0x1d, 0x71, 0xca, 0x9c, 0xbe, 0xd2, 0x65, 0xed, 0xf9, 0x9e, 0xc8, 0xd6, 0x49, 0xe9,
-	0x11, 0x5e, 0x7c, 0x72, 0xc2, 0x2b, 0x42, 0x3a, 0xd2, 0xd7, 0xea, 0xdb, 0xc5, 0x8e, 0x42, 0x63,
-	0xc8, 0x65, 0x7a, 0x1c, 0xb9, 0x0c, 0xd2, 0x47, 0xf2, 0x35, 0xe9, 0xa3, 0x9f, 0xa5, 0x66, 0x06,
-	0x59, 0x6a, 0xa8, 0x32, 0xb3, 0x23, 0x2a, 0x73, 0x03, 0xe6, 0x64, 0x31, 0x1c, 0x44, 0x3c, 0x95,
-	0x50, 0x79, 0xc7, 0x49, 0xaf, 0xff, 0xfb, 0x80, 0xd3, 0xb9, 0x8f, 0x95, 0xed, 0x2c, 0x8e, 0x2e,
-	0xad, 0xbf, 0x0c, 0xc8, 0xc9, 0x8a, 0xaa, 0xc3, 0x76, 0x07, 0xf3, 0xc3, 0xf5, 0xc7, 0xfb, 0x70,
-	0xaa, 0xc5, 0xf0, 0x9e, 0x4f, 0xdb, 0xe1, 0xc8, 0x0f, 0x64, 0x75, 0xc2, 0x9c, 0xec, 0xe8, 0x0c,
-	0x7d, 0x22, 0xbf, 0xc1, 0x45, 0xde, 0xbc, 0x0a, 0xfa, 0xa3, 0xc9, 0xf1, 0x49, 0x8d, 0xca, 0x32,
-	0x8f, 0xbf, 0x9b, 0xf4, 0xfe, 0x47, 0xb0, 0xc1, 0xed, 0x3e, 0x57, 0xdc, 0x27, 0xcf, 0x57, 0x8d,
-	0xa7, 0xcf, 0x57, 0x8d, 0x3f, 0x9e, 0xaf, 0x1a, 0x0f, 0x5f, 0xac, 0x4e, 0x3d, 0x7d, 0xb1, 0x3a,
-	0xf5, 0xeb, 0x8b, 0xd5, 0xa9, 0x8f, 0xb6, 0xea, 0x3e, 0x6f, 0xb4, 0xab, 0x25, 0x97, 0x06, 0xe5,
-	0xad, 0x8e, 0xdb, 0x1b, 0xa8, 0x1a, 0x96, 0xbb, 0x9b, 0x9c, 0x73, 0x29, 0xc3, 0xd1, 0xa5, 0xb8,
-	0x65, 0x97, 0xd5, 0xdf, 0x18, 0xa1, 0xfc, 0xdb, 0x8c, 0xef, 0xb7, 0x70, 0x58, 0x4d, 0xca, 0x51,
-	0x7d, 0xeb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x77, 0x1a, 0x63, 0x54, 0x13, 0x00, 0x00,
+	// 1999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x23, 0x49,
+	0x19, 0x4f, 0xdb, 0x8e, 0x13, 0x7f, 0xb1, 0x9d, 0x4d, 0x4f, 0x1e, 0x9e, 0xb0, 0x79, 0x6c, 0x0f,
+	0x23, 0xc2, 0x4a, 0x6b, 0xef, 0x06, 0xf6, 0xc1, 0x8c, 0x10, 0x24, 0x99, 0xd9, 0x9d, 0x48, 0xf3,
+	0x08, 0x9d, 0xec, 0x1e, 0xb8, 0xb4, 0xca, 0xdd, 0x65, 0xbb, 0x18, 0x77, 0x95, 0xa9, 0x2a, 0x67,
+	0x12, 0xfe, 0x01, 0x10, 0xa7, 0x3d, 0x20, 0x24, 0x6e, 0x73, 0x42, 0x08, 0x09, 0x89, 0xc3, 0x5e,
+	0xb8, 0x70, 0xe2, 0x30, 0x42, 0x48, 0xec, 0x11, 0x71, 0x58, 0x56, 0x33, 0x07, 0x56, 0xe2, 0x1f,
+	0x80, 0x13, 0xab, 0x7a, 0xb4, 0xdd, 0x7e, 0xc4, 0x13, 0xcf, 0xcc, 0xc5, 0x72, 0x7d, 0x8f, 0x7a,
+	0x7c, 0x8f, 0xdf, 0xaf, 0xaa, 0x61, 0x8b, 0xd0, 0x9f, 0xe0, 0x50, 0x92, 0x53, 0x5c, 0x63, 0x21,
+	0xaf, 0x9d, 0xbe, 0x53, 0xc7, 0x12, 0xbd, 0xa3, 0xfe, 0x57, 0x3b, 0x9c, 0x49, 0xe6, 0xae, 0xf4,
+	0x0c, 0xaa, 0x4a, 0x68, 0x0d, 0xd6, 0x37, 0x43, 0x26, 0x62, 0x26, 0x6a, 0x75, 0x24, 0x70, 0xcf,
+	0x2b, 0x64, 0x84, 0x1a, 0xb7, 0xf5, 0xab, 0x46, 0x1f, 0xe8, 0x51, 0xcd, 0x0c, 0xac, 0x6a, 0xb9,
+	0xc9, 0x9a, 0xcc, 0xc8, 0xd5, 0x3f, 0x2b, 0xdd, 0x6a, 0x32, 0xd6, 0x6c, 0xe3, 0x9a, 0x1e, 0xd5,
+	0xbb, 0x8d, 0x9a, 0x24, 0x31, 0x16, 0x12, 0xc5, 0x1d, 0x6b, 0xb0, 0x84, 0x62, 0x42, 0x59, 0x4d,
+	0xff, 0x1a, 0x91, 0xf7, 0xdf, 0x0c, 0xe4, 0x8f, 0x10, 0x47, 0xb1, 0x70, 0x37, 0x00, 0xda, 0x84,
+	0x3e, 0x0c, 0x22, 0x4c, 0x59, 0x5c, 0x71, 0xb6, 0x9d, 0x9d, 0x82, 0x5f, 0x50, 0x92, 0x5b, 0x4a,
+	0xe0, 0xee, 0xc2, 0x4a, 0x07, 0x9d, 0xb3, 0xae, 0x0c, 0xea, 0x6d, 0x16, 0x3e, 0x0c, 0x08, 0x95,
+	0x98, 0x9f, 0xa2, 0x76, 0x25, 0xb3, 0xed, 0xec, 0xe4, 0xfc, 0x2b, 0x46, 0xb9, 0xaf, 0x74, 0x87,
+	0x56, 0xe5, 0xbe, 0x01, 0xc5, 0x98, 0x45, 0xdd, 0x36, 0x0e, 0x50, 0x14, 0x13, 0x5a, 0xc9, 0xea,
+	0x49, 0x17, 0x8c, 0x6c, 0x4f, 0x89, 0xdc, 0x06, 0x6c, 0xc4, 0x84, 0x06, 0x8c, 0xa3, 0xb0, 0x8d,
+	0x83, 0x0e, 0xe2, 0x92, 0x84, 0xa4, 0x83, 0x24, 0x61, 0x34, 0xe0, 0x48, 0xe2, 0x4a, 0x4e, 0xf9,
+	0xec, 0x5f, 0x7b, 0xf2, 0xc5, 0xd6, 0xcc, 0x3f, 0xbf, 0xd8, 0xfa, 0x86, 0x89, 0x83, 0x88, 0x1e,
+	0x56, 0x09, 0xab, 0xc5, 0x48, 0xb6, 0xaa, 0x77, 0x71, 0x13, 0x85, 0xe7, 0xb7, 0x70, 0xe8, 0xaf,
+	0xc7, 0x84, 0x3e, 0xd0, 0x13, 0x1d, 0xa5, 0xe7, 0xf1, 0x91, 0xc4, 0xee, 0x1e, 0x6c, 0xc4, 0xe8,
+	0x2c, 0x08, 0x19, 0x15, 0x38, 0xec, 0xaa, 0x64, 0x04, 0x31, 0x11, 0x02, 0x47, 0x01, 0x67, 0x5d,
+	0x1a, 0x89, 0xca, 0xac, 0x3e, 0xc6, 0x7a, 0x8c, 0xce, 0x0e, 0xfa, 0x36, 0xf7, 0xb4, 0x89, 0xaf,
+	0x2d, 0xdc, 0x0f, 0xa0, 0xd2, 0xc0, 0x38, 0x0a, 0x84, 0x44, 0x6d, 0x4c, 0xb1, 0x10, 0x81, 0x6c,
+	0x71, 0x2c, 0x5a, 0xac, 0x1d, 0x55, 0xf2, 0xdb, 0xce, 0x4e, 0xd6, 0x5f, 0x55, 0xfa, 0xe3, 0x44,
+	0x7d, 0x92, 0x68, 0x6f, 0x5c, 0xf9, 0xea, 0xf1, 0x96, 0xf3, 0xcb, 0x7f, 0xff, 0xf1, 0x4d, 0x50,
+	0x15, 0x62, 0xe2, 0xed, 0xfd, 0x3e, 0x03, 0xf0, 0x21, 0xc6, 0xd1, 0x01, 0xa3, 0x0d, 0xd2, 0x74,
+	0x2b, 0x30, 0x27, 0x48, 0x93, 0x62, 0x2e, 0x2a, 0xce, 0x76, 0x76, 0xa7, 0xe0, 0x27, 0x43, 0xd7,
+	0x83, 0xa2, 0xe4, 0x88, 0x8a, 0x98, 0x48, 0xa9, 0xd4, 0x19, 0xad, 0x1e, 0x90, 0xb9, 0x45, 0x70,
+	0x1a, 0x3a, 0xbc, 0x25, 0xdf, 0x69, 0xb8, 0xd7, 0xa1, 0xcc, 0x68, 0xd8, 0x42, 0x84, 0xaa, 0x03,
+	0x37, 0x48, 0x53, 0x47, 0xb1, 0xe8, 0x97, 0xac, 0xd4, 0x2e, 0xf9, 0x1e, 0xac, 0xb1, 0x46, 0x23,
+	0x6d, 0x17, 0x9c, 0x62, 0x2e, 0x08, 0xa3, 0x36, 0x1a, 0x2b, 0x89, 0xda, 0x38, 0x7c, 0x62, 0x94,
+	0xee, 0xb7, 0x60, 0x71, 0xc8, 0x4f, 0x9f, 0xbf, 0xe8, 0x97, 0x07, 0xed, 0xdd, 0x3b, 0x50, 0xb2,
+	0xf9, 0xef, 0xe8, 0x33, 0x57, 0xe6, 0xb6, 0x9d, 0x9d, 0x85, 0xdd, 0x6b, 0xd5, 0xb1, 0x1d, 0x51,
+	0xbd, 0xa7, 0x6d, 0x4d, 0x78, 0xfc, 0x62, 0x9c, 0x1a, 0x79, 0x7f, 0x76, 0xa0, 0xdc, 0x0f, 0xd6,
+	0x21, 0x6d, 0x30, 0xf7, 0x6d, 0x58, 0x6e, 0x23, 0x89, 0x85, 0x4c, 0xf6, 0x1e, 0x91, 0x26, 0x16,
+	0x52, 0x57, 0x6e, 0xd1, 0x77, 0x8d, 0xce, 0xd8, 0xdf, 0xd2, 0x1a, 0x13, 0xa4, 0x4c, 0x12, 0xa4,
+	0x22, 0x38, 0x34, 0x09, 0x19, 0x55, 0xa5, 0x6a, 0xa7, 0x09, 0x59, 0x97, 0x4a, 0x1d, 0xb0, 0x9c,
+	0xbf, 0x60, 0x64, 0x07, 0x4a, 0xe4, 0xde, 0x84, 0xf5, 0xc1, 0x05, 0x4d, 0x23, 0xd0, 0x6e, 0x5c,
+	0xc7, 0x5c, 0x47, 0x2c, 0xeb, 0xaf, 0xa5, 0x97, 0xd5, 0xcd, 0x70, 0x5f, 0xab, 0xbd, 0xff, 0x67,
+	0xa1, 0x98, 0x3e, 0x9f, 0xbb, 0x06, 0x73, 0xba, 0x9a, 0x48, 0x64, 0x7b, 0x2d, 0xaf, 0x86, 0x87,
+	0x91, 0xbb, 0x0f, 0xa0, 0x3a, 0x02, 0x51, 0xf1, 0x08, 0xf3, 0x4a, 0xe6, 0xf2, 0xe5, 0x5f, 0x88,
+	0x09, 0xdd, 0xd3, 0x5e, 0x7a, 0x0e, 0x74, 0x96, 0xcc, 0x91, 0x9d, 0x66, 0x0e, 0x74, 0x66, 0xe7,
+	0x78, 0x00, 0xcb, 0x1a, 0x0f, 0x3a, 0x98, 0x07, 0xac, 0x2e, 0x54, 0x47, 0xab, 0x6e, 0xb2, 0x0d,
+	0xb9, 0x61, 0x67, 0x5b, 0x19, 0x9d, 0xed, 0x90, 0x4a, 0xdf, 0x55, 0xae, 0x47, 0x98, 0x3f, 0xe8,
+	0x3b, 0xba, 0x3f, 0x82, 0x95, 0xde, 0x84, 0xb6, 0x78, 0x45, 0xaf, 0xd8, 0x9e, 0x3b, 0xe3, 0x15,
+	0x3b, 0xe3, 0x49, 0xca, 0x73, 0x08, 0xb3, 0xf2, 0xc3, 0x98, 0x75, 0x1d, 0xca, 0x5d, 0x4a, 0x7e,
+	0xda, 0xc5, 0x01, 0xc7, 0x1d, 0xc6, 0xa5, 0x29, 0xc0, 0x79, 0xbf, 0x64, 0xa4, 0xbe, 0x11, 0xba,
+	0xdb, 0xb0, 0x10, 0x61, 0x11, 0x72, 0xd2, 0xd1, 0x07, 0x9c, 0x37, 0x28, 0x95, 0x12, 0xa9, 0x75,
+	0x74, 0xb2, 0x0c, 0x8c, 0x15, 0xcc, 0x3a, 0x4a, 0x62, 0x40, 0xec, 0x1a, 0x94, 0xea, 0xa4, 0xdd,
+	0x26, 0xb4, 0x69, 0x2d, 0x40, 0x5b, 0x14, 0xad, 0x50, 0x1b, 0x79, 0xbf, 0x73, 0xa0, 0xa8, 0x4a,
+	0xf8, 0x2e, 0x39, 0xd5, 0xf0, 0x70, 0x71, 0x05, 0xe8, 0xca, 0x16, 0xd2, 0x20, 0x53, 0xd0, 0x43,
+	0x71, 0x5d, 0x0b, 0x59, 0x55, 0xd9, 0x42, 0x6a, 0x48, 0x3a, 0x49, 0x34, 0xee, 0x9b, 0xb0, 0x94,
+	0xf2, 0x68, 0x61, 0xd2, 0x6c, 0x49, 0x9d, 0xf6, 0xac, 0xbf, 0xd8, 0x33, 0xbf, 0xa3, 0xc5, 0xaa,
+	0xd2, 0x25, 0x93, 0xa8, 0x9d, 0x00, 0x9f, 0xad, 0x74, 0x2d, 0xd3, 0x76, 0xc2, 0xfb, 0x4f, 0x06,
+	0xca, 0x06, 0x48, 0x9f, 0xbf, 0xd9, 0x55, 0xc8, 0x1b, 0xf0, 0x36, 0xa5, 0xea, 0xdb, 0x91, 0x06,
+	0x09, 0x9d, 0xfc, 0x3e, 0xc4, 0x66, 0xf5, 0x4a, 0xe5, 0x44, 0x6c, 0x61, 0xf5, 0x1a, 0x94, 0x06,
+	0x91, 0xd8, 0x6c, 0xa8, 0x18, 0xa7, 0xb1, 0xf7, 0x06, 0x5c, 0x7d, 0x1e, 0x74, 0xaf, 0x85, 0x17,
+	0xe0, 0xf6, 0x77, 0x61, 0xb5, 0x83, 0x39, 0x61, 0x51, 0x30, 0xbc, 0xa1, 0xbc, 0x76, 0x5c, 0x36,
+	0xda, 0x07, 0x83, 0xdb, 0x7a, 0x1b, 0xac, 0x7c, 0x68, 0xb1, 0x39, 0xed, 0xe3, 0x1a, 0xdd, 0xc0,
+	0x3a, 0xef, 0xc1, 0x9a, 0x4e, 0x42, 0x6f, 0x95, 0x7e, 0xe6, 0xe6, 0x75, 0x2a, 0x56, 0x94, 0x3a,
+	0x59, 0xa6, 0x97, 0x3c, 0xef, 0xe7, 0x19, 0x28, 0x1f, 0x30, 0x2a, 0x39, 0x0a, 0x2d, 0x70, 0x8c,
+	0xa0, 0x91, 0x33, 0x8a, 0x46, 0x29, 0xbe, 0xc8, 0x4c, 0xe6, 0x8b, 0xec, 0x45, 0x7c, 0x91, 0xbb,
+	0x98, 0x2f, 0x66, 0xa7, 0xe4, 0x8b, 0xfc, 0x94, 0x7c, 0x31, 0x37, 0x8e, 0x2f, 0xbc, 0x27, 0x0e,
+	0x2c, 0x1d, 0x63, 0x1b, 0x84, 0x23, 0xce, 0x3a, 0x4c, 0xa0, 0xb6, 0xbb, 0x0c, 0xb3, 0x92, 0xc8,
+	0x36, 0xb6, 0x85, 0x67, 0x06, 0xc3, 0x4d, 0x9b, 0x19, 0x6d, 0xda, 0xef, 0x41, 0xde, 0xae, 0x96,
+	0xd5, 0xb4, 0xf3, 0xc6, 0x05, 0xb4, 0xd3, 0xe7, 0x15, 0xdf, 0x3a, 0xdc, 0xf8, 0xe1, 0x2f, 0x1e,
+	0x6f, 0xcd, 0x7c, 0xf5, 0x78, 0x6b, 0xe6, 0xaf, 0x9f, 0xbd, 0xb5, 0x6e, 0xaf, 0x5e, 0x4d, 0x76,
+	0xda, 0x73, 0x51, 0xe9, 0xc2, 0x54, 0x2a, 0x4a, 0x5f, 0x51, 0x94, 0x3e, 0xb2, 0x69, 0xef, 0xb3,
+	0x9c, 0x21, 0x2c, 0x25, 0xc0, 0x5c, 0x12, 0x3c, 0xa1, 0x85, 0x06, 0x79, 0x69, 0x34, 0x19, 0xd9,
+	0x29, 0x93, 0x91, 0x9b, 0x32, 0x19, 0xb3, 0x63, 0xc9, 0x7b, 0x90, 0x87, 0xf2, 0xaf, 0x80, 0x87,
+	0xe6, 0x5e, 0x29, 0x0f, 0xcd, 0xbf, 0x72, 0x1e, 0x2a, 0xbc, 0x30, 0x0f, 0x8d, 0x12, 0x0d, 0x5c,
+	0x82, 0x68, 0x16, 0x46, 0x6a, 0xd6, 0xfb, 0x4b, 0x06, 0x56, 0x8f, 0xb1, 0xdc, 0x47, 0x32, 0x6c,
+	0xbd, 0xa2, 0x36, 0x48, 0x01, 0x45, 0x76, 0x32, 0x50, 0xe4, 0xc6, 0x00, 0xc5, 0x20, 0xc3, 0xce,
+	0x0e, 0x33, 0xec, 0x7d, 0x58, 0xd4, 0x35, 0xdd, 0xe9, 0x95, 0x79, 0x25, 0xbf, 0x9d, 0xdd, 0x59,
+	0xd8, 0xbd, 0x3e, 0xa1, 0xd9, 0xfa, 0x3d, 0xe1, 0x97, 0x1b, 0x03, 0xe3, 0x1b, 0xb7, 0x2f, 0xdf,
+	0x78, 0xeb, 0xb6, 0xf1, 0xc6, 0xc4, 0xca, 0xdb, 0x85, 0x8a, 0xe1, 0xaf, 0x54, 0xde, 0x85, 0xc6,
+	0x4d, 0xa1, 0x08, 0x4b, 0x83, 0xaa, 0xb9, 0x67, 0x97, 0x7c, 0x3b, 0xf2, 0x3e, 0x81, 0xa5, 0x8f,
+	0x90, 0xf0, 0x31, 0x89, 0xeb, 0x5d, 0x2e, 0x70, 0x8c, 0x95, 0xf1, 0x1e, 0x94, 0xf9, 0x80, 0x44,
+	0x3b, 0x2d, 0xec, 0x5e, 0xad, 0xda, 0xcd, 0xa9, 0xd7, 0x5b, 0x6a, 0x77, 0x84, 0xfa, 0x43, 0x0e,
+	0xde, 0xc7, 0x30, 0x7b, 0x84, 0xce, 0x31, 0x76, 0xbf, 0x0d, 0xaf, 0xa5, 0x42, 0x1b, 0xa0, 0x28,
+	0xe2, 0x36, 0x97, 0x8b, 0x29, 0xf9, 0x5e, 0x14, 0x71, 0x85, 0xff, 0x1d, 0x74, 0xae, 0xfc, 0x8d,
+	0x99, 0x4d, 0xab, 0x95, 0x29, 0x13, 0xef, 0x4f, 0x0e, 0x14, 0x07, 0x6a, 0xf0, 0x26, 0xe4, 0x6d,
+	0x9f, 0x39, 0x97, 0xef, 0x33, 0xeb, 0xe2, 0xbe, 0x0b, 0xab, 0xa9, 0xde, 0x12, 0x23, 0x97, 0x8e,
+	0x95, 0xb4, 0xb6, 0x7f, 0xef, 0x78, 0x17, 0x56, 0xd3, 0x1d, 0x94, 0x72, 0x33, 0x97, 0x8f, 0x95,
+	0xb4, 0xb6, 0xcf, 0x78, 0x37, 0xa1, 0x74, 0xbb, 0xc3, 0xc2, 0xd6, 0x1e, 0x35, 0xdc, 0xa9, 0x6a,
+	0x1b, 0x2b, 0x81, 0x25, 0x3a, 0x33, 0x50, 0x52, 0x4d, 0xba, 0xf6, 0x89, 0x69, 0x06, 0xde, 0x6f,
+	0x1d, 0xc8, 0x9b, 0x86, 0x9a, 0xb0, 0x6b, 0x67, 0xd2, 0xae, 0x5f, 0x87, 0x82, 0x51, 0x18, 0xf2,
+	0x54, 0xe0, 0xd7, 0x17, 0xb8, 0x1f, 0x41, 0x31, 0xed, 0x66, 0x9a, 0xe6, 0x72, 0xd1, 0x1c, 0x70,
+	0xf4, 0x7e, 0xed, 0x40, 0xd1, 0x6c, 0xf4, 0x84, 0x1d, 0x93, 0xa6, 0xbe, 0x26, 0x8e, 0x7b, 0xaa,
+	0x14, 0xc3, 0xf4, 0x23, 0xa5, 0x17, 0x8a, 0xcc, 0xd8, 0x50, 0x64, 0x53, 0xa1, 0x50, 0xcd, 0x89,
+	0xcf, 0x24, 0x47, 0x41, 0x0b, 0x89, 0x96, 0x7d, 0xe3, 0x15, 0xb4, 0xe4, 0x0e, 0x12, 0x2d, 0x55,
+	0xe9, 0x06, 0x8e, 0x2c, 0xc2, 0xdb, 0x91, 0xf7, 0x2b, 0x07, 0x16, 0x6f, 0x9f, 0x62, 0x2a, 0x93,
+	0xc7, 0x32, 0x89, 0xa6, 0x29, 0xce, 0x0d, 0x80, 0x8e, 0x2a, 0xe8, 0x74, 0x69, 0x16, 0xb4, 0x44,
+	0xab, 0xdf, 0x87, 0x3c, 0x8a, 0xf5, 0xad, 0xc5, 0xd0, 0xee, 0xc5, 0xad, 0xb2, 0x9f, 0x53, 0x41,
+	0xf5, 0xad, 0xb9, 0xf7, 0x37, 0x07, 0x5c, 0xbd, 0x2d, 0x03, 0xfc, 0x1f, 0x77, 0x22, 0x24, 0x71,
+	0xe4, 0xbe, 0x0f, 0x73, 0x61, 0x97, 0x73, 0x6c, 0xaf, 0x41, 0xcf, 0x05, 0xe8, 0xc4, 0xda, 0xfd,
+	0x00, 0xe6, 0xcd, 0x7d, 0x98, 0x44, 0x95, 0xcc, 0xa5, 0x3c, 0xb5, 0xf9, 0x61, 0xe4, 0x1e, 0x00,
+	0x74, 0xcd, 0xea, 0x01, 0x4a, 0x8e, 0xb1, 0x5e, 0x35, 0x9f, 0x57, 0xaa, 0xc9, 0xe7, 0x95, 0x6a,
+	0xaf, 0xa0, 0xf6, 0xe7, 0xd5, 0xbc, 0x9f, 0xfe, 0x6b, 0xcb, 0xf1, 0x0b, 0xd6, 0x6f, 0x4f, 0x7a,
+	0x7f, 0x70, 0xa0, 0xa4, 0x8f, 0x73, 0x1f, 0x3f, 0x32, 0x55, 0x9e, 0xde, 0x90, 0x33, 0xd5, 0x86,
+	0x36, 0x00, 0x84, 0x44, 0x5c, 0x6d, 0xa8, 0x7e, 0x9e, 0x84, 0xdc, 0x4a, 0xf6, 0xcf, 0xd5, 0x7e,
+	0x13, 0xf5, 0xb4, 0xfb, 0xb5, 0x7e, 0x7b, 0xd2, 0xbb, 0x07, 0xaf, 0xe9, 0xed, 0x9e, 0xf4, 0xd2,
+	0x1d, 0xbd, 0x44, 0xc5, 0x7a, 0x7f, 0xcf, 0xc2, 0x72, 0x72, 0xfc, 0x01, 0x9c, 0xba, 0xf0, 0x1a,
+	0x54, 0x85, 0x2b, 0xa8, 0xd9, 0xe4, 0xb8, 0x89, 0x24, 0xe3, 0xc1, 0x40, 0xea, 0x4a, 0xfe, 0x52,
+	0x5f, 0xe5, 0xdb, 0xa0, 0xf4, 0x01, 0x2f, 0x3b, 0x3d, 0xe0, 0x6d, 0xc3, 0x42, 0xaa, 0xae, 0xcd,
+	0xa3, 0xd6, 0x4f, 0x8b, 0x26, 0x80, 0xcb, 0xec, 0x24, 0x70, 0x19, 0x86, 0x8f, 0xfc, 0x0b, 0xc2,
+	0xc7, 0x20, 0x4a, 0xcd, 0x0d, 0xa3, 0xd4, 0x48, 0x66, 0xe6, 0xc7, 0x64, 0xe6, 0x2e, 0x2c, 0xea,
+	0x64, 0x04, 0x88, 0xda, 0xf7, 0x8b, 0xbe, 0xe3, 0x2c, 0xec, 0x7e, 0xf3, 0x02, 0x76, 0x1e, 0x40,
+	0x65, 0xbf, 0x84, 0xd3, 0x43, 0xef, 0x7f, 0x0e, 0x94, 0x75, 0x46, 0x0d, 0xd9, 0x1e, 0x63, 0x79,
+	0xb9, 0xfa, 0xf8, 0x01, 0xbc, 0xde, 0xe1, 0xf8, 0x94, 0xb0, 0xae, 0x18, 0xfb, 0xe5, 0xc4, 0x30,
+	0xcc, 0xd5, 0xc4, 0x66, 0xe4, 0xdb, 0xc9, 0x4b, 0x5c, 0xe4, 0xdd, 0x0f, 0xc1, 0x3e, 0x9a, 0x02,
+	0x42, 0x1b, 0x4c, 0xa7, 0x79, 0xf2, 0xdd, 0xa4, 0xff, 0x81, 0xc9, 0x87, 0xb0, 0xf7, 0xdf, 0xfb,
+	0xd2, 0x81, 0xf5, 0x14, 0x64, 0xfa, 0xf8, 0x11, 0xe2, 0xd1, 0x11, 0xa6, 0xa8, 0x4d, 0x7e, 0x86,
+	0xa3, 0xe9, 0x5f, 0xc7, 0x3e, 0xb8, 0x63, 0xbe, 0x75, 0x4e, 0x51, 0xc7, 0x4b, 0x9d, 0x91, 0x4f,
+	0x9c, 0xdf, 0x87, 0x42, 0x83, 0xf1, 0x06, 0x26, 0x12, 0x47, 0x95, 0xdc, 0xe5, 0xb0, 0xb7, 0xef,
+	0xe1, 0xfd, 0x26, 0x81, 0xdf, 0xe4, 0x88, 0x31, 0x3b, 0x7d, 0x91, 0xa3, 0xad, 0x42, 0xde, 0x5c,
+	0x30, 0xcd, 0x71, 0x7c, 0x3b, 0x9a, 0xfc, 0x84, 0xcf, 0x4d, 0x7c, 0xc2, 0xef, 0x87, 0x4f, 0x9e,
+	0x6e, 0x3a, 0x9f, 0x3f, 0xdd, 0x74, 0xbe, 0x7c, 0xba, 0xe9, 0x7c, 0xfa, 0x6c, 0x73, 0xe6, 0xf3,
+	0x67, 0x9b, 0x33, 0xff, 0x78, 0xb6, 0x39, 0xf3, 0xe3, 0xc3, 0x26, 0x91, 0xad, 0x6e, 0xbd, 0x1a,
+	0xb2, 0xb8, 0x76, 0x98, 0x64, 0xf5, 0x2e, 0xaa, 0x8b, 0x5a, 0x2f, 0xc7, 0x6f, 0x85, 0x8c, 0xe3,
+	0xf4, 0x50, 0x3d, 0x72, 0x6a, 0xe6, 0xf3, 0xa2, 0xd0, 0xdf, 0xec, 0xe5, 0x79, 0x07, 0x8b, 0x7a,
+	0x5e, 0x23, 0xe5, 0x77, 0xbe, 0x1e, 0x00, 0x91, 0x16, 0x29, 0x75, 0xd1, 0x17, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1591,6 +1956,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ModuleAdmin != that1.ModuleAdmin {
 		return false
 	}
+	if !this.MinOracleParticipationRate.Equal(that1.MinOracleParticipationRate) {
+		return false
+	}
+	if this.MaxConsecutiveMissedRounds != that1.MaxConsecutiveMissedRounds {
+		return false
+	}
+	if this.FeedStalenessThreshold != that1.FeedStalenessThreshold {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1613,6 +1987,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeedStalenessThreshold != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.FeedStalenessThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxConsecutiveMissedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.MaxConsecutiveMissedRounds))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinOracleParticipationRate.Size()
+		i -= size
+		if _, err := m.MinOracleParticipationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.ModuleAdmin) > 0 {
 		i -= len(m.ModuleAdmin)
 		copy(dAtA[i:], m.ModuleAdmin)
@@ -1870,7 +2264,7 @@ func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractConfig) Marshal() (dAtA []byte, err error) {
+func (m *FeedLiveness) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1880,22 +2274,134 @@ func (m *ContractConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeedLiveness) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeedLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OffchainConfig) > 0 {
-		i -= len(m.OffchainConfig)
-		copy(dAtA[i:], m.OffchainConfig)
-		i = encodeVarintOcr(dAtA, i, uint64(len(m.OffchainConfig)))
+	if m.TotalRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.TotalRounds))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x20
+	}
+	if m.LastRoundHeight != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.LastRoundHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastRoundTimestamp != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.LastRoundTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObservedTimestamp != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.LastObservedTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeriodMissedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.PeriodMissedRounds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PeriodObservedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.PeriodObservedRounds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConsecutiveMissedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.ConsecutiveMissedRounds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.MissedRounds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.ObservedRounds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OffchainConfig) > 0 {
+		i -= len(m.OffchainConfig)
+		copy(dAtA[i:], m.OffchainConfig)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.OffchainConfig)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.OffchainConfigVersion != 0 {
 		i = encodeVarintOcr(dAtA, i, uint64(m.OffchainConfigVersion))
@@ -2807,6 +3313,112 @@ func (m *EventConfigSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOracleRewardPenalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOracleRewardPenalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOracleRewardPenalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Forfeited.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ParticipationRate.Size()
+		i -= size
+		if _, err := m.ParticipationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOracleRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOracleRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOracleRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveMissedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.ConsecutiveMissedRounds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOcr(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOcr(v)
 	base := offset
@@ -2835,6 +3447,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOcr(uint64(l))
 	}
+	l = m.MinOracleParticipationRate.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	if m.MaxConsecutiveMissedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.MaxConsecutiveMissedRounds))
+	}
+	if m.FeedStalenessThreshold != 0 {
+		n += 1 + sovOcr(uint64(m.FeedStalenessThreshold))
+	}
 	return n
 }
 
@@ -2942,6 +3562,63 @@ func (m *ModuleParams) Size() (n int) {
 	return n
 }
 
+func (m *FeedLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.LastRoundTimestamp != 0 {
+		n += 1 + sovOcr(uint64(m.LastRoundTimestamp))
+	}
+	if m.LastRoundHeight != 0 {
+		n += 1 + sovOcr(uint64(m.LastRoundHeight))
+	}
+	if m.TotalRounds != 0 {
+		n += 1 + sovOcr(uint64(m.TotalRounds))
+	}
+	return n
+}
+
+func (m *OracleLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.ObservedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.ObservedRounds))
+	}
+	if m.MissedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.MissedRounds))
+	}
+	if m.ConsecutiveMissedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.ConsecutiveMissedRounds))
+	}
+	if m.PeriodObservedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.PeriodObservedRounds))
+	}
+	if m.PeriodMissedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.PeriodMissedRounds))
+	}
+	if m.LastObservedTimestamp != 0 {
+		n += 1 + sovOcr(uint64(m.LastObservedTimestamp))
+	}
+	return n
+}
+
 func (m *ContractConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3345,6 +4022,51 @@ func (m *EventConfigSet) Size() (n int) {
 	return n
 }
 
+func (m *EventOracleRewardPenalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = m.ParticipationRate.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = m.Forfeited.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	return n
+}
+
+func (m *EventOracleRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.ConsecutiveMissedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.ConsecutiveMissedRounds))
+	}
+	return n
+}
+
 func sovOcr(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3463,13 +4185,85 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ModuleAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracleParticipationRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOracleParticipationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveMissedRounds", wireType)
+			}
+			m.MaxConsecutiveMissedRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveMissedRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedStalenessThreshold", wireType)
+			}
+			m.FeedStalenessThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeedStalenessThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if (iNdEx + skippy) > l {
@@ -4266,7 +5060,7 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractConfig) Unmarshal(dAtA []byte) error {
+func (m *FeedLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4289,66 +5083,15 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
-			}
-			m.ConfigCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfigCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4376,32 +5119,13 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
-			}
-			m.F = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.F |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoundTimestamp", wireType)
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
-			}
-			var byteLen int
+			m.LastRoundTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4411,31 +5135,16 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LastRoundTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
-			if m.OnchainConfig == nil {
-				m.OnchainConfig = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoundHeight", wireType)
 			}
-			m.OffchainConfigVersion = 0
+			m.LastRoundHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4445,16 +5154,16 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				m.LastRoundHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRounds", wireType)
 			}
-			var byteLen int
+			m.TotalRounds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4464,26 +5173,11 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalRounds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
-			if m.OffchainConfig == nil {
-				m.OffchainConfig = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -4505,7 +5199,7 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
+func (m *OracleLiveness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4528,15 +5222,15 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetConfigProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleLiveness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4564,11 +5258,11 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4596,13 +5290,13 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedRounds", wireType)
 			}
-			var msglen int
+			m.ObservedRounds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4612,36 +5306,114 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObservedRounds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRounds", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MissedRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Config == nil {
-				m.Config = &FeedConfig{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedRounds", wireType)
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsecutiveMissedRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodObservedRounds", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcr
+			m.PeriodObservedRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodObservedRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMissedRounds", wireType)
+			}
+			m.PeriodMissedRounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodMissedRounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedTimestamp", wireType)
+			}
+			m.LastObservedTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -4655,7 +5427,7 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedProperties) Unmarshal(dAtA []byte) error {
+func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4678,15 +5450,34 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedProperties: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedProperties: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			}
+			m.ConfigCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4714,9 +5505,41 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
 			}
@@ -4735,7 +5558,7 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
 			}
@@ -4769,7 +5592,7 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				m.OnchainConfig = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
 			}
@@ -4788,7 +5611,7 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
 			}
@@ -4822,43 +5645,59 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				m.OffchainConfig = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetConfigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4886,13 +5725,11 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerObservation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4920,15 +5757,13 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LinkPerObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerTransmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4938,89 +5773,39 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LinkPerTransmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &FeedConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniqueReports", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
 			}
-			m.UniqueReports = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -5031,7 +5816,7 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
+func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5054,15 +5839,15 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetBatchConfigProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedProperties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBatchConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedProperties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5090,11 +5875,117 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OnchainConfig == nil {
+				m.OnchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+			}
+			m.OffchainConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OffchainConfig == nil {
+				m.OffchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5122,11 +6013,13 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5154,11 +6047,13 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerObservation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5186,11 +6081,13 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			if err := m.LinkPerObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerTransmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5218,13 +6115,35 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.LinkPerTransmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueReports", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UniqueReports = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5234,25 +6153,23 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedProperties = append(m.FeedProperties, &FeedProperties{})
-			if err := m.FeedProperties[len(m.FeedProperties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5275,7 +6192,7 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
+func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5298,33 +6215,277 @@ func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleObservationsCounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBatchConfigProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleObservationsCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBatchConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOcr
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
 				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedProperties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedProperties = append(m.FeedProperties, &FeedProperties{})
+			if err := m.FeedProperties[len(m.FeedProperties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleObservationsCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleObservationsCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcr
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowOcr
 					}
@@ -5355,30 +6516,350 @@ func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
 						count++
 					}
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Counts) == 0 {
-					m.Counts = make([]uint32, 0, elementCount)
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOcr
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasReimbursements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasReimbursements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reimbursements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reimbursements = append(m.Reimbursements, &types.Coin{})
+			if err := m.Reimbursements[len(m.Reimbursements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+			}
+			m.ObservationsTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmissionTimestamp", wireType)
+			}
+			m.TransmissionTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOcr
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransmissionTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5401,7 +6882,7 @@ func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
+func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5424,17 +6905,17 @@ func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasReimbursements: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochAndRound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasReimbursements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochAndRound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reimbursements", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5444,26 +6925,30 @@ func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.Reimbursements = append(m.Reimbursements, &types.Coin{})
-			if err := m.Reimbursements[len(m.Reimbursements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -5485,7 +6970,7 @@ func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payee) Unmarshal(dAtA []byte) error {
+func (m *Report) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5508,17 +6993,36 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payee: wiretype end group for non-group")
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+			}
+			m.ObservationsTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5528,27 +7032,29 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
+			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
+			if m.Observers == nil {
+				m.Observers = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5576,7 +7082,11 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentAddr = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.LegacyDec
+			m.Observations = append(m.Observations, v)
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5599,7 +7109,7 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transmission) Unmarshal(dAtA []byte) error {
+func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5622,17 +7132,17 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportToSign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportToSign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5642,31 +7152,31 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.ObservationsTimestamp = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5676,16 +7186,16 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransmissionTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.TransmissionTimestamp = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5695,66 +7205,16 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransmissionTimestamp |= int64(b&0x7F) << shift
+				m.Round |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcr
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EpochAndRound: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochAndRound: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraHash", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5764,16 +7224,31 @@ func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
 			}
-			m.Round = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraHash = append(m.ExtraHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtraHash == nil {
+				m.ExtraHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5783,11 +7258,26 @@ func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
+			if m.Report == nil {
+				m.Report = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -5809,7 +7299,7 @@ func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Report) Unmarshal(dAtA []byte) error {
+func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5832,17 +7322,17 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOraclePaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOraclePaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
 			}
-			m.ObservationsTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5852,16 +7342,29 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5871,31 +7374,29 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
-			if m.Observers == nil {
-				m.Observers = []byte{}
-			}
+			m.PayeeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5905,25 +7406,22 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Observations = append(m.Observations, v)
-			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5948,7 +7446,7 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportToSign) Unmarshal(dAtA []byte) error {
+func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,17 +7469,17 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportToSign: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAnswerUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportToSign: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAnswerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5991,69 +7489,31 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConfigDigest == nil {
-				m.ConfigDigest = []byte{}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6063,31 +7523,31 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraHash = append(m.ExtraHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExtraHash == nil {
-				m.ExtraHash = []byte{}
+			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6097,24 +7557,23 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
-			if m.Report == nil {
-				m.Report = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6138,7 +7597,7 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
+func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6161,15 +7620,15 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOraclePaid: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewRound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOraclePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewRound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6197,11 +7656,13 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6229,13 +7690,96 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayeeAddr = string(dAtA[iNdEx:postIndex])
+			m.StartedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTransmitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTransmitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6245,25 +7789,45 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -6285,7 +7849,7 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6308,15 +7872,15 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAnswerUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewTransmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAnswerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewTransmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6344,15 +7908,13 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorRoundId", wireType)
 			}
-			var stringLen uint64
+			m.AggregatorRoundId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6362,31 +7924,16 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AggregatorRoundId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6396,78 +7943,29 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventNewRound) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcr
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewRound: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewRound: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6495,13 +7993,30 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Transmitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+			}
+			m.ObservationsTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6529,13 +8044,17 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartedBy = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.LegacyDec
+			m.Observations = append(m.Observations, v)
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6545,76 +8064,27 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
+			if m.Observers == nil {
+				m.Observers = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcr
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventTransmitted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTransmitted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
@@ -6648,11 +8118,11 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 				m.ConfigDigest = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAndRound", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6662,11 +8132,28 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochAndRound == nil {
+				m.EpochAndRound = &EpochAndRound{}
+			}
+			if err := m.EpochAndRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -6688,7 +8175,7 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
+func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6711,17 +8198,17 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewTransmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConfigSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewTransmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6731,29 +8218,31 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorRoundId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConfigBlockNumber", wireType)
 			}
-			m.AggregatorRoundId = 0
+			m.PreviousConfigBlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6763,16 +8252,16 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AggregatorRoundId |= uint32(b&0x7F) << shift
+				m.PreviousConfigBlockNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6782,31 +8271,33 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &FeedConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transmitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6816,46 +8307,81 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transmitter = string(dAtA[iNdEx:postIndex])
+			if m.ConfigInfo == nil {
+				m.ConfigInfo = &FeedConfigInfo{}
+			}
+			if err := m.ConfigInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ObservationsTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationsTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOracleRewardPenalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOracleRewardPenalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOracleRewardPenalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6883,17 +8409,13 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Observations = append(m.Observations, v)
-			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6903,31 +8425,29 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
-			if m.Observers == nil {
-				m.Observers = []byte{}
-			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6937,29 +8457,29 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConfigDigest == nil {
-				m.ConfigDigest = []byte{}
+			if err := m.ParticipationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAndRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6986,10 +8506,7 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EpochAndRound == nil {
-				m.EpochAndRound = &EpochAndRound{}
-			}
-			if err := m.EpochAndRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Forfeited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7014,7 +8531,7 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
+func (m *EventOracleRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7037,17 +8554,17 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConfigSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOracleRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOracleRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -7057,31 +8574,29 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConfigDigest == nil {
-				m.ConfigDigest = []byte{}
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConfigBlockNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
-			m.PreviousConfigBlockNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -7091,16 +8606,29 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousConfigBlockNumber |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -7110,33 +8638,29 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &FeedConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedRounds", wireType)
 			}
-			var msglen int
+			m.ConsecutiveMissedRounds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -7146,28 +8670,11 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConsecutiveMissedRounds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConfigInfo == nil {
-				m.ConfigInfo = &FeedConfigInfo{}
-			}
-			if err := m.ConfigInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
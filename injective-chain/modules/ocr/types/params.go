@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
@@ -13,6 +14,9 @@ var _ paramtypes.ParamSet = &Params{}
 // MaxNumOracles denotes number of oracles the offchain reporting protocol is designed for
 var MaxNumOracles = 31
 
+// DefaultFeedStalenessThreshold represents the number of seconds in 1 day
+var DefaultFeedStalenessThreshold int64 = 60 * 60 * 24
+
 // Parameter keys
 var (
 	KeyLinkDenom      = []byte("LinkDenom")
@@ -53,6 +57,10 @@ func DefaultParams() Params {
 		LinkDenom:           "peggy0x514910771AF9Ca656af840dff83E8264EcF986CA",
 		PayoutBlockInterval: 100000,
 		ModuleAdmin:         "",
+
+		MinOracleParticipationRate: math.LegacyZeroDec(),
+		MaxConsecutiveMissedRounds: 0,
+		FeedStalenessThreshold:     DefaultFeedStalenessThreshold,
 	}
 }
 
@@ -62,7 +70,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := validateMinOracleParticipationRate(p.MinOracleParticipationRate); err != nil {
+		return err
+	}
+
+	return validateFeedStalenessThreshold(p.FeedStalenessThreshold)
 }
 
 func validateLinkDenom(i any) error {
@@ -104,3 +116,34 @@ func validateModuleAdmin(i any) error {
 	_, err := sdk.AccAddressFromBech32(v)
 	return err
 }
+
+func validateMinOracleParticipationRate(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// unset rate disables the penalty
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("MinOracleParticipationRate must be between 0 and 1: %s", v.String())
+	}
+
+	return nil
+}
+
+func validateFeedStalenessThreshold(i any) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("FeedStalenessThreshold cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -672,6 +672,120 @@ func (m *QueryModuleStateResponse) GetState() *GenesisState {
 	return nil
 }
 
+type QueryFeedLivenessRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+}
+
+func (m *QueryFeedLivenessRequest) Reset()         { *m = QueryFeedLivenessRequest{} }
+func (m *QueryFeedLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedLivenessRequest) ProtoMessage()    {}
+func (*QueryFeedLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9921480020d97b2c, []int{14}
+}
+func (m *QueryFeedLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedLivenessRequest.Merge(m, src)
+}
+func (m *QueryFeedLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryFeedLivenessRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+type QueryFeedLivenessResponse struct {
+	FeedLiveness *FeedLiveness     `protobuf:"bytes,1,opt,name=feed_liveness,json=feedLiveness,proto3" json:"feed_liveness,omitempty"`
+	Oracles      []*OracleLiveness `protobuf:"bytes,2,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	// seconds elapsed since the latest transmitted round
+	SecondsSinceLastRound int64 `protobuf:"varint,3,opt,name=seconds_since_last_round,json=secondsSinceLastRound,proto3" json:"seconds_since_last_round,omitempty"`
+	// whether the feed has no new round for longer than the staleness threshold
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (m *QueryFeedLivenessResponse) Reset()         { *m = QueryFeedLivenessResponse{} }
+func (m *QueryFeedLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedLivenessResponse) ProtoMessage()    {}
+func (*QueryFeedLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9921480020d97b2c, []int{15}
+}
+func (m *QueryFeedLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedLivenessResponse.Merge(m, src)
+}
+func (m *QueryFeedLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryFeedLivenessResponse) GetFeedLiveness() *FeedLiveness {
+	if m != nil {
+		return m.FeedLiveness
+	}
+	return nil
+}
+
+func (m *QueryFeedLivenessResponse) GetOracles() []*OracleLiveness {
+	if m != nil {
+		return m.Oracles
+	}
+	return nil
+}
+
+func (m *QueryFeedLivenessResponse) GetSecondsSinceLastRound() int64 {
+	if m != nil {
+		return m.SecondsSinceLastRound
+	}
+	return 0
+}
+
+func (m *QueryFeedLivenessResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.ocr.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.ocr.v1beta1.QueryParamsResponse")
@@ -687,68 +801,79 @@ func init() {
 	proto.RegisterType((*QueryOwedAmountResponse)(nil), "injective.ocr.v1beta1.QueryOwedAmountResponse")
 	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.ocr.v1beta1.QueryModuleStateRequest")
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.ocr.v1beta1.QueryModuleStateResponse")
+	proto.RegisterType((*QueryFeedLivenessRequest)(nil), "injective.ocr.v1beta1.QueryFeedLivenessRequest")
+	proto.RegisterType((*QueryFeedLivenessResponse)(nil), "injective.ocr.v1beta1.QueryFeedLivenessResponse")
 }
 
 func init() { proto.RegisterFile("injective/ocr/v1beta1/query.proto", fileDescriptor_9921480020d97b2c) }
 
 var fileDescriptor_9921480020d97b2c = []byte{
-	// 891 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x23, 0x35,
-	0x14, 0xce, 0x2c, 0xdd, 0xa0, 0x75, 0xb6, 0x5d, 0x64, 0x16, 0xb6, 0x1d, 0xd8, 0xb4, 0x9d, 0x90,
-	0x8a, 0x5f, 0x9d, 0x21, 0x29, 0x55, 0x29, 0x14, 0x44, 0x7f, 0x00, 0x8a, 0x54, 0x54, 0x18, 0xe0,
-	0xc2, 0x25, 0x72, 0x66, 0x9c, 0xa9, 0x21, 0xb1, 0xd3, 0xb1, 0xd3, 0xaa, 0xaa, 0x7a, 0xe1, 0xc0,
-	0x19, 0x89, 0x3b, 0x12, 0x07, 0xae, 0x1c, 0xb8, 0xc2, 0x1f, 0xd0, 0x63, 0x25, 0x38, 0x70, 0xaa,
-	0x50, 0xcb, 0x1f, 0x82, 0xc6, 0x76, 0x12, 0x87, 0xc9, 0x24, 0x29, 0x62, 0x6f, 0xf1, 0x9b, 0xf7,
-	0x7d, 0xef, 0x7b, 0xcf, 0xcf, 0x9f, 0x02, 0x96, 0x09, 0xfd, 0x0a, 0x07, 0x82, 0x1c, 0x63, 0x8f,
-	0x05, 0xb1, 0x77, 0x5c, 0x69, 0x60, 0x81, 0x2a, 0xde, 0x51, 0x17, 0xc7, 0xa7, 0x6e, 0x27, 0x66,
-	0x82, 0xc1, 0xe7, 0xfa, 0x29, 0x2e, 0x0b, 0x62, 0x57, 0xa7, 0xd8, 0x2f, 0x46, 0x8c, 0x45, 0x2d,
-	0xec, 0xa1, 0x0e, 0xf1, 0x10, 0xa5, 0x4c, 0x20, 0x41, 0x18, 0xe5, 0x0a, 0x64, 0x2f, 0x8e, 0xe6,
-	0x4d, 0x08, 0x54, 0xc2, 0xc3, 0x88, 0x45, 0x4c, 0xfe, 0xf4, 0x92, 0x5f, 0x3a, 0x5a, 0x0c, 0x18,
-	0x6f, 0x33, 0xee, 0x35, 0x10, 0xc7, 0x7d, 0x50, 0xc0, 0x08, 0xd5, 0xdf, 0x4b, 0xa3, 0x69, 0x23,
-	0x4c, 0x31, 0x27, 0xba, 0xb6, 0xf3, 0x10, 0xc0, 0x4f, 0x13, 0xfd, 0x9f, 0xa0, 0x18, 0xb5, 0xb9,
-	0x8f, 0x8f, 0xba, 0x98, 0x0b, 0xc7, 0x07, 0xcf, 0x0e, 0x45, 0x79, 0x87, 0x51, 0x8e, 0xe1, 0x3b,
-	0x20, 0xdf, 0x91, 0x91, 0x79, 0x6b, 0xc9, 0x7a, 0xb9, 0x50, 0x7d, 0xec, 0x8e, 0x6c, 0xd7, 0x55,
-	0xb0, 0x9d, 0x99, 0x8b, 0xab, 0xc5, 0x9c, 0xaf, 0x21, 0x4e, 0x05, 0x3c, 0x2f, 0x39, 0x3f, 0xc4,
-	0x38, 0xdc, 0x65, 0xb4, 0x49, 0x22, 0x5d, 0x0d, 0x3e, 0x02, 0x4f, 0x37, 0x31, 0x0e, 0xeb, 0x24,
-	0x94, 0xbc, 0xf7, 0xfc, 0x7c, 0x72, 0xac, 0x85, 0xce, 0xcf, 0x16, 0x78, 0x94, 0xc2, 0x68, 0x2d,
-	0x07, 0xe0, 0x19, 0x09, 0x0a, 0x64, 0xb8, 0x4e, 0x68, 0x93, 0x69, 0x55, 0xe5, 0x0c, 0x55, 0x03,
-	0x92, 0x1a, 0x6d, 0x32, 0x7f, 0xae, 0x39, 0x74, 0x86, 0x3b, 0xa0, 0x60, 0x10, 0xce, 0xdf, 0x91,
-	0x5c, 0xcb, 0x13, 0xb9, 0x7c, 0x30, 0xe0, 0x71, 0xd6, 0x81, 0xfd, 0x2f, 0xbd, 0xb2, 0xd4, 0xa4,
-	0x3e, 0x7f, 0xb3, 0xc0, 0x0b, 0x23, 0x71, 0x4f, 0xaa, 0xd7, 0x7d, 0xf0, 0x00, 0x77, 0x58, 0x70,
-	0x58, 0x47, 0x34, 0xac, 0xc7, 0xac, 0x4b, 0x43, 0xdd, 0xef, 0x4b, 0x19, 0x7c, 0x1f, 0x24, 0xd9,
-	0xdb, 0x34, 0xf4, 0x93, 0x5c, 0x7f, 0x16, 0x9b, 0x47, 0xa7, 0xaa, 0x6f, 0x69, 0x1f, 0x09, 0xcc,
-	0x85, 0x4a, 0x99, 0xd4, 0xf2, 0x19, 0x98, 0x4f, 0x63, 0x74, 0xbb, 0x2b, 0xe0, 0x41, 0x4b, 0x86,
-	0x95, 0xb4, 0x1e, 0x78, 0xc6, 0x9f, 0x6d, 0x0d, 0xb2, 0x6b, 0x21, 0xdc, 0x00, 0x33, 0x21, 0x12,
-	0x48, 0x4b, 0x2f, 0x65, 0x48, 0xff, 0x3c, 0x46, 0x94, 0xb7, 0x09, 0xe7, 0x84, 0x51, 0x5f, 0x02,
-	0x9c, 0xf7, 0x41, 0xd9, 0x28, 0x6e, 0x26, 0xec, 0x61, 0x81, 0x48, 0x8b, 0x4f, 0x94, 0xff, 0x87,
-	0x05, 0x56, 0x26, 0x51, 0xe8, 0x6e, 0x4a, 0x60, 0x56, 0xdf, 0x5b, 0x48, 0x22, 0xcc, 0x85, 0x64,
-	0xba, 0xef, 0xdf, 0x57, 0xc1, 0x3d, 0x19, 0xfb, 0x7f, 0x2f, 0xa4, 0x3f, 0x98, 0xa7, 0x6e, 0x3b,
-	0x98, 0xb7, 0xf5, 0x1b, 0x3d, 0x38, 0xc1, 0xe1, 0x76, 0x9b, 0x75, 0xa9, 0xe8, 0x4d, 0x62, 0x09,
-	0x14, 0x84, 0xca, 0x17, 0x02, 0xc7, 0x7a, 0x1a, 0x66, 0xc8, 0xf1, 0xf5, 0x16, 0x98, 0x58, 0x3d,
-	0x82, 0x0d, 0x90, 0x47, 0x32, 0xa2, 0xb7, 0x76, 0xc1, 0x55, 0xd6, 0xe5, 0x26, 0xd6, 0xd5, 0xd7,
-	0xb3, 0xcb, 0x08, 0xed, 0x79, 0x86, 0x4a, 0x77, 0x16, 0x34, 0xe7, 0xc7, 0x2c, 0xec, 0xb6, 0xf0,
-	0x67, 0x02, 0x09, 0xdc, 0xb3, 0xa8, 0x2f, 0xf4, 0x02, 0x0d, 0x7d, 0xd2, 0xf5, 0x36, 0xc1, 0x5d,
-	0x9e, 0x04, 0x74, 0xb9, 0xac, 0x01, 0x7c, 0xa4, 0x9c, 0x50, 0x61, 0x15, 0xa2, 0xfa, 0xeb, 0x3d,
-	0x70, 0x57, 0xf2, 0xc2, 0x6f, 0x2d, 0x90, 0x57, 0x46, 0x06, 0x5f, 0xc9, 0x20, 0x48, 0x3b, 0xa7,
-	0xfd, 0xea, 0x34, 0xa9, 0x4a, 0xa6, 0x53, 0xfe, 0xe6, 0xf7, 0xbf, 0xbf, 0xbf, 0xb3, 0x08, 0x1f,
-	0x7b, 0xc1, 0x21, 0x22, 0xb4, 0x45, 0xe8, 0xd7, 0x43, 0x4e, 0xad, 0x8c, 0x13, 0xfe, 0x68, 0x01,
-	0x30, 0x78, 0xcf, 0x70, 0x75, 0x5c, 0x85, 0x94, 0xb9, 0xda, 0xee, 0xb4, 0xe9, 0x5a, 0xd4, 0x9b,
-	0x52, 0x94, 0x0b, 0x5f, 0xcf, 0x10, 0x65, 0x18, 0x91, 0x77, 0xa6, 0x1f, 0xc7, 0x39, 0xfc, 0xc5,
-	0x02, 0x73, 0xc3, 0x9e, 0x03, 0x2b, 0xd3, 0x15, 0x36, 0x0c, 0xd2, 0xae, 0xde, 0x06, 0xa2, 0xf5,
-	0x6e, 0x4a, 0xbd, 0x6b, 0xb0, 0x32, 0x59, 0xaf, 0x34, 0x4e, 0x43, 0xf4, 0x4f, 0x16, 0x28, 0x18,
-	0xfe, 0x03, 0xc7, 0x8e, 0x2a, 0x6d, 0x6e, 0xb6, 0x37, 0x75, 0xbe, 0xd6, 0xba, 0x2e, 0xb5, 0x7a,
-	0x70, 0x35, 0x43, 0xab, 0xe9, 0x7a, 0x86, 0xce, 0x2b, 0x0b, 0x2c, 0x64, 0xfa, 0x0c, 0xdc, 0x9a,
-	0xac, 0x22, 0xdb, 0xe1, 0xec, 0x77, 0xff, 0x23, 0x5a, 0x77, 0xb4, 0x27, 0x3b, 0x7a, 0x0f, 0x6e,
-	0x8d, 0xef, 0x48, 0x18, 0x14, 0xf5, 0x50, 0x71, 0x0c, 0x5f, 0x04, 0x18, 0xd8, 0xc6, 0xf8, 0x0d,
-	0x4f, 0x59, 0xd3, 0xf8, 0x0d, 0x4f, 0xbb, 0x91, 0xf3, 0x96, 0xd4, 0x5c, 0x85, 0x6f, 0x64, 0x68,
-	0x66, 0x27, 0x38, 0xac, 0x2b, 0x03, 0xf2, 0xce, 0x0c, 0x87, 0x3b, 0x87, 0x3f, 0x58, 0x60, 0xee,
-	0x20, 0x88, 0x0d, 0xcb, 0x19, 0xbf, 0x33, 0x69, 0xdb, 0x1a, 0xbf, 0x33, 0x23, 0xbc, 0xcc, 0x79,
-	0x4d, 0xaa, 0x2d, 0xc3, 0x52, 0x86, 0xda, 0xb6, 0xc4, 0xd4, 0xa5, 0x7b, 0xed, 0x04, 0x17, 0xd7,
-	0x45, 0xeb, 0xf2, 0xba, 0x68, 0xfd, 0x75, 0x5d, 0xb4, 0xbe, 0xbb, 0x29, 0xe6, 0x2e, 0x6f, 0x8a,
-	0xb9, 0x3f, 0x6f, 0x8a, 0xb9, 0x2f, 0x6b, 0x11, 0x11, 0x87, 0xdd, 0x86, 0x1b, 0xb0, 0xb6, 0x57,
-	0xeb, 0x29, 0xd8, 0x47, 0x0d, 0xee, 0xf5, 0xf5, 0xac, 0x06, 0x2c, 0xc6, 0xe6, 0x31, 0xa9, 0xa7,
-	0xf9, 0xb9, 0xac, 0x29, 0x4e, 0x3b, 0x98, 0x37, 0xf2, 0xf2, 0x9f, 0xe3, 0xda, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x43, 0xc1, 0x8b, 0x1b, 0x0f, 0x0b, 0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0x26, 0xa9, 0xfb, 0xfb, 0xbd, 0xf9, 0x28, 0x1a, 0x52, 0xe2, 0x2c, 0xd4, 0x49, 0x37,
+	0xa4, 0x2a, 0x1f, 0xd9, 0x8d, 0x1d, 0x4a, 0x28, 0x94, 0x8f, 0xa6, 0xe1, 0xc3, 0x92, 0x51, 0x60,
+	0x0b, 0x17, 0x2e, 0xd6, 0x78, 0x77, 0xec, 0x0c, 0xac, 0x67, 0xdc, 0x9d, 0x71, 0xaa, 0x2a, 0xea,
+	0x85, 0x03, 0x67, 0x24, 0xee, 0x48, 0x1c, 0x38, 0x70, 0xe1, 0xc0, 0x99, 0x3f, 0xa0, 0xc7, 0x4a,
+	0x70, 0xe0, 0x54, 0xa1, 0x04, 0x89, 0xbf, 0x80, 0x3b, 0xda, 0xd9, 0xb1, 0x3d, 0xae, 0xbd, 0x5e,
+	0x17, 0xc1, 0xcd, 0xf3, 0xce, 0xfb, 0x3c, 0xf3, 0xbc, 0xef, 0xce, 0x3c, 0xaf, 0x0c, 0x97, 0x29,
+	0xfb, 0x9c, 0x04, 0x92, 0x1e, 0x13, 0x8f, 0x07, 0xb1, 0x77, 0x5c, 0x6e, 0x10, 0x89, 0xcb, 0xde,
+	0x9d, 0x2e, 0x89, 0xef, 0xb9, 0x9d, 0x98, 0x4b, 0x8e, 0x2e, 0xf6, 0x53, 0x5c, 0x1e, 0xc4, 0xae,
+	0x4e, 0xb1, 0x9f, 0x6b, 0x71, 0xde, 0x8a, 0x88, 0x87, 0x3b, 0xd4, 0xc3, 0x8c, 0x71, 0x89, 0x25,
+	0xe5, 0x4c, 0xa4, 0x20, 0x7b, 0x7d, 0x3c, 0x6f, 0x42, 0x90, 0x26, 0xac, 0xb4, 0x78, 0x8b, 0xab,
+	0x9f, 0x5e, 0xf2, 0x4b, 0x47, 0x4b, 0x01, 0x17, 0x6d, 0x2e, 0xbc, 0x06, 0x16, 0xa4, 0x0f, 0x0a,
+	0x38, 0x65, 0x7a, 0x7f, 0x73, 0x3c, 0x6d, 0x8b, 0x30, 0x22, 0xa8, 0x3e, 0xdb, 0x59, 0x01, 0xf4,
+	0x71, 0xa2, 0xff, 0x23, 0x1c, 0xe3, 0xb6, 0xf0, 0xc9, 0x9d, 0x2e, 0x11, 0xd2, 0xf1, 0xe1, 0xe9,
+	0xa1, 0xa8, 0xe8, 0x70, 0x26, 0x08, 0x7a, 0x03, 0x0a, 0x1d, 0x15, 0x29, 0x5a, 0x1b, 0xd6, 0xd5,
+	0x85, 0xca, 0x25, 0x77, 0x6c, 0xb9, 0x6e, 0x0a, 0xdb, 0x9f, 0x7f, 0xf0, 0x68, 0x7d, 0xc6, 0xd7,
+	0x10, 0xa7, 0x0c, 0xcf, 0x28, 0xce, 0xf7, 0x08, 0x09, 0x6f, 0x71, 0xd6, 0xa4, 0x2d, 0x7d, 0x1a,
+	0x5a, 0x85, 0xf3, 0x4d, 0x42, 0xc2, 0x3a, 0x0d, 0x15, 0xef, 0xff, 0xfd, 0x42, 0xb2, 0xac, 0x86,
+	0xce, 0x8f, 0x16, 0xac, 0x8e, 0x60, 0xb4, 0x96, 0x43, 0x78, 0x4a, 0x81, 0x02, 0x15, 0xae, 0x53,
+	0xd6, 0xe4, 0x5a, 0xd5, 0x56, 0x86, 0xaa, 0x01, 0x49, 0x95, 0x35, 0xb9, 0xbf, 0xdc, 0x1c, 0x5a,
+	0xa3, 0x7d, 0x58, 0x30, 0x08, 0x8b, 0xb3, 0x8a, 0xeb, 0x72, 0x2e, 0x97, 0x0f, 0x03, 0x1e, 0xe7,
+	0x1a, 0xd8, 0x8f, 0xe9, 0x55, 0x47, 0xe5, 0xd5, 0xf9, 0xb3, 0x05, 0xcf, 0x8e, 0xc5, 0xfd, 0x57,
+	0xb5, 0xd6, 0xe0, 0x02, 0xe9, 0xf0, 0xe0, 0xa8, 0x8e, 0x59, 0x58, 0x8f, 0x79, 0x97, 0x85, 0xba,
+	0xde, 0xe7, 0x33, 0xf8, 0xde, 0x4d, 0xb2, 0x6f, 0xb2, 0xd0, 0x4f, 0x72, 0xfd, 0x25, 0x62, 0x2e,
+	0x9d, 0x8a, 0xfe, 0x4a, 0x35, 0x2c, 0x89, 0x90, 0x69, 0x4a, 0x5e, 0xc9, 0x27, 0x50, 0x1c, 0xc5,
+	0xe8, 0x72, 0xaf, 0xc0, 0x85, 0x48, 0x85, 0x53, 0x69, 0x3d, 0xf0, 0xbc, 0xbf, 0x14, 0x0d, 0xb2,
+	0xab, 0x21, 0xda, 0x83, 0xf9, 0x10, 0x4b, 0xac, 0xa5, 0x6f, 0x66, 0x48, 0xff, 0x24, 0xc6, 0x4c,
+	0xb4, 0xa9, 0x10, 0x94, 0x33, 0x5f, 0x01, 0x9c, 0x77, 0x60, 0xcb, 0x38, 0xdc, 0x4c, 0x38, 0x20,
+	0x12, 0xd3, 0x48, 0xe4, 0xca, 0xff, 0xd5, 0x82, 0x2b, 0x79, 0x14, 0xba, 0x9a, 0x4d, 0x58, 0xd2,
+	0xdf, 0x2d, 0xa4, 0x2d, 0x22, 0xa4, 0x62, 0x5a, 0xf4, 0x17, 0xd3, 0xe0, 0x81, 0x8a, 0xfd, 0xbb,
+	0x1f, 0xa4, 0xdf, 0x98, 0xb9, 0x27, 0x6d, 0xcc, 0xeb, 0xfa, 0x8d, 0x1e, 0xde, 0x25, 0xe1, 0xcd,
+	0x36, 0xef, 0x32, 0xd9, 0xeb, 0xc4, 0x06, 0x2c, 0xc8, 0x34, 0x5f, 0x4a, 0x12, 0xeb, 0x6e, 0x98,
+	0x21, 0xc7, 0x87, 0xd5, 0x11, 0xac, 0x6e, 0xc1, 0x1e, 0x14, 0xb0, 0x8a, 0xe8, 0x5b, 0xbb, 0xe6,
+	0xa6, 0xd6, 0xe5, 0x26, 0xd6, 0xd5, 0xd7, 0x73, 0x8b, 0x53, 0xd6, 0xf3, 0x8c, 0x34, 0xdd, 0x59,
+	0xd3, 0x9c, 0x1f, 0xf2, 0xb0, 0x1b, 0x91, 0xdb, 0x12, 0x4b, 0xd2, 0xb3, 0xa8, 0x4f, 0xa1, 0x38,
+	0xba, 0xa5, 0xcf, 0xbb, 0x0e, 0xe7, 0x44, 0x12, 0x28, 0x5a, 0x13, 0x1b, 0xf0, 0x7e, 0xea, 0x84,
+	0x29, 0x36, 0x45, 0x38, 0xbb, 0x9a, 0x36, 0x79, 0x40, 0x35, 0x7a, 0x9c, 0x64, 0xe4, 0xdf, 0x86,
+	0xbf, 0x2c, 0x58, 0x1b, 0x83, 0xd2, 0x6a, 0x3e, 0x80, 0x25, 0x05, 0x8b, 0xf4, 0x46, 0x8e, 0xaa,
+	0x21, 0x8e, 0xc5, 0xa6, 0xb1, 0x42, 0x6f, 0xc3, 0x79, 0x1e, 0xe3, 0x20, 0x22, 0xa2, 0x38, 0xbb,
+	0x31, 0x37, 0xe1, 0xf9, 0x1f, 0xaa, 0xac, 0x3e, 0x4b, 0x0f, 0x85, 0xf6, 0xa0, 0x28, 0x48, 0xc0,
+	0x59, 0x28, 0xea, 0x82, 0xb2, 0x80, 0xd4, 0x23, 0xdc, 0x7b, 0x65, 0xea, 0xb2, 0xcc, 0xf9, 0x17,
+	0xf5, 0xfe, 0xed, 0x64, 0xbb, 0x86, 0xf5, 0x63, 0x43, 0x2b, 0xaa, 0xa3, 0x11, 0x29, 0xce, 0x6f,
+	0x58, 0x57, 0xff, 0xe7, 0xa7, 0x8b, 0xca, 0x9f, 0x00, 0xe7, 0x54, 0xdd, 0xe8, 0x2b, 0x0b, 0x0a,
+	0xa9, 0xeb, 0xa3, 0x17, 0x32, 0x34, 0x8d, 0x8e, 0x19, 0xfb, 0xc5, 0x69, 0x52, 0xd3, 0x2e, 0x3a,
+	0x5b, 0x5f, 0xfe, 0xf2, 0xc7, 0x37, 0xb3, 0xeb, 0xe8, 0x92, 0x17, 0x1c, 0x61, 0xca, 0x22, 0xca,
+	0xbe, 0x18, 0x1a, 0x6b, 0xe9, 0x94, 0x41, 0xdf, 0x59, 0x00, 0x03, 0xf3, 0x43, 0xdb, 0x93, 0x4e,
+	0x18, 0x99, 0x44, 0xb6, 0x3b, 0x6d, 0xba, 0x16, 0xf5, 0x8a, 0x12, 0xe5, 0xa2, 0x97, 0x33, 0x44,
+	0x19, 0xae, 0xed, 0x9d, 0xe8, 0xbb, 0x73, 0x1f, 0xfd, 0x64, 0xc1, 0xf2, 0xb0, 0x41, 0xa3, 0xf2,
+	0x74, 0x07, 0x1b, 0xd3, 0xc4, 0xae, 0x3c, 0x09, 0x44, 0xeb, 0xbd, 0xae, 0xf4, 0xee, 0xa2, 0x72,
+	0xbe, 0x5e, 0x35, 0x65, 0x0c, 0xd1, 0xdf, 0x5b, 0xb0, 0x60, 0x98, 0x35, 0x9a, 0xd8, 0xaa, 0xd1,
+	0x49, 0x60, 0x7b, 0x53, 0xe7, 0x6b, 0xad, 0xd7, 0x94, 0x56, 0x0f, 0x6d, 0x67, 0x68, 0x35, 0x47,
+	0x84, 0xa1, 0xf3, 0x91, 0x05, 0x6b, 0x99, 0xa6, 0x8c, 0x6e, 0xe4, 0xab, 0xc8, 0x1e, 0x07, 0xf6,
+	0x9b, 0xff, 0x10, 0xad, 0x2b, 0x3a, 0x50, 0x15, 0xbd, 0x85, 0x6e, 0x4c, 0xae, 0x48, 0x1a, 0x14,
+	0xf5, 0x30, 0xe5, 0x18, 0xfe, 0x10, 0x30, 0xf0, 0xd8, 0xc9, 0x37, 0x7c, 0xc4, 0xc7, 0x6d, 0x77,
+	0xda, 0x74, 0xad, 0xf9, 0x35, 0xa5, 0xb9, 0x82, 0x76, 0x32, 0x34, 0xf3, 0xbb, 0x24, 0xac, 0xa7,
+	0x6e, 0xed, 0x9d, 0x18, 0xe3, 0xe0, 0x3e, 0xfa, 0xc1, 0x82, 0x45, 0xd3, 0xcb, 0x90, 0x97, 0x77,
+	0x61, 0x1f, 0xf3, 0x5b, 0x7b, 0x67, 0x7a, 0x80, 0x56, 0xfb, 0xaa, 0x52, 0xbb, 0x83, 0xdc, 0x49,
+	0xf7, 0xbb, 0xe7, 0xc3, 0x46, 0x4f, 0xbf, 0xb5, 0x60, 0xf9, 0x30, 0x88, 0x8d, 0x59, 0x32, 0xf9,
+	0x7e, 0x8f, 0xce, 0x23, 0xdb, 0x9b, 0x3a, 0x5f, 0x6b, 0x7d, 0x49, 0x69, 0xdd, 0x42, 0x9b, 0x19,
+	0x5a, 0xdb, 0x0a, 0x53, 0x57, 0x63, 0x69, 0x3f, 0x78, 0x70, 0x5a, 0xb2, 0x1e, 0x9e, 0x96, 0xac,
+	0xdf, 0x4f, 0x4b, 0xd6, 0xd7, 0x67, 0xa5, 0x99, 0x87, 0x67, 0xa5, 0x99, 0xdf, 0xce, 0x4a, 0x33,
+	0x9f, 0x55, 0x5b, 0x54, 0x1e, 0x75, 0x1b, 0x6e, 0xc0, 0xdb, 0x5e, 0xb5, 0xa7, 0xa0, 0x86, 0x1b,
+	0xc2, 0xeb, 0xeb, 0xd9, 0x0e, 0x78, 0x4c, 0xcc, 0x65, 0x72, 0x9e, 0xe6, 0x17, 0xea, 0x4c, 0x79,
+	0xaf, 0x43, 0x44, 0xa3, 0xa0, 0xfe, 0x12, 0xec, 0xfe, 0x3d, 0x00, 0x11, 0x46, 0x2f, 0x5d, 0xe8,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -775,6 +900,9 @@ type QueryClient interface {
 	LatestTransmissionDetails(ctx context.Context, in *QueryLatestTransmissionDetailsRequest, opts ...grpc.CallOption) (*QueryLatestTransmissionDetailsResponse, error)
 	// Retrieves transmitter's owed amount
 	OwedAmount(ctx context.Context, in *QueryOwedAmountRequest, opts ...grpc.CallOption) (*QueryOwedAmountResponse, error)
+	// Retrieves the round liveness of a feed and the participation of its
+	// oracles
+	FeedLiveness(ctx context.Context, in *QueryFeedLivenessRequest, opts ...grpc.CallOption) (*QueryFeedLivenessResponse, error)
 	// Retrieves the entire OCR module's state
 	OcrModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -841,6 +969,15 @@ func (c *queryClient) OwedAmount(ctx context.Context, in *QueryOwedAmountRequest
 	return out, nil
 }
 
+func (c *queryClient) FeedLiveness(ctx context.Context, in *QueryFeedLivenessRequest, opts ...grpc.CallOption) (*QueryFeedLivenessResponse, error) {
+	out := new(QueryFeedLivenessResponse)
+	err := c.cc.Invoke(ctx, "/injective.ocr.v1beta1.Query/FeedLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OcrModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/injective.ocr.v1beta1.Query/OcrModuleState", in, out, opts...)
@@ -864,6 +1001,9 @@ type QueryServer interface {
 	LatestTransmissionDetails(context.Context, *QueryLatestTransmissionDetailsRequest) (*QueryLatestTransmissionDetailsResponse, error)
 	// Retrieves transmitter's owed amount
 	OwedAmount(context.Context, *QueryOwedAmountRequest) (*QueryOwedAmountResponse, error)
+	// Retrieves the round liveness of a feed and the participation of its
+	// oracles
+	FeedLiveness(context.Context, *QueryFeedLivenessRequest) (*QueryFeedLivenessResponse, error)
 	// Retrieves the entire OCR module's state
 	OcrModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -890,6 +1030,9 @@ func (*UnimplementedQueryServer) LatestTransmissionDetails(ctx context.Context,
 func (*UnimplementedQueryServer) OwedAmount(ctx context.Context, req *QueryOwedAmountRequest) (*QueryOwedAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OwedAmount not implemented")
 }
+func (*UnimplementedQueryServer) FeedLiveness(ctx context.Context, req *QueryFeedLivenessRequest) (*QueryFeedLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedLiveness not implemented")
+}
 func (*UnimplementedQueryServer) OcrModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OcrModuleState not implemented")
 }
@@ -1006,6 +1149,24 @@ func _Query_OwedAmount_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeedLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeedLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.ocr.v1beta1.Query/FeedLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeedLiveness(ctx, req.(*QueryFeedLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OcrModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -1052,6 +1213,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OwedAmount",
 			Handler:    _Query_OwedAmount_Handler,
 		},
+		{
+			MethodName: "FeedLiveness",
+			Handler:    _Query_FeedLiveness_Handler,
+		},
 		{
 			MethodName: "OcrModuleState",
 			Handler:    _Query_OcrModuleState_Handler,
@@ -1546,6 +1711,100 @@ func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeedLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecondsSinceLastRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsSinceLastRound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Oracles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.FeedLiveness != nil {
+		{
+			size, err := m.FeedLiveness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1746,6 +2005,44 @@ func (m *QueryModuleStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeedLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeedLiveness != nil {
+		l = m.FeedLiveness.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, e := range m.Oracles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SecondsSinceLastRound != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsSinceLastRound))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3019,6 +3316,247 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeedLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeedLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeedLiveness == nil {
+				m.FeedLiveness = &FeedLiveness{}
+			}
+			if err := m.FeedLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, &OracleLiveness{})
+			if err := m.Oracles[len(m.Oracles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsSinceLastRound", wireType)
+			}
+			m.SecondsSinceLastRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsSinceLastRound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -61,6 +61,9 @@ func (h *BlockHandler) RequestAllBandIBCRates(ctx sdk.Context) {
 	}
 }
 
-func EndBlocker(ctx sdk.Context) {
+func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
+	defer doneFn()
 
+	h.k.UpdateCompositePrices(ctx)
 }
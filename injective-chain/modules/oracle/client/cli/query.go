@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -32,6 +33,13 @@ func GetQueryCmd() *cobra.Command {
 		GetStorkPriceStates(),
 		GetStorkPublishers(),
 		GetCoinbasePriceStates(),
+		GetCompositeOraclesCmd(),
+		GetCompositeOracleCmd(),
+		GetCircuitBreakersCmd(),
+		GetCircuitBreakerStateCmd(),
+		GetOracleTWAPCmd(),
+		GetChainlinkDataStreamsDONsCmd(),
+		GetChainlinkDataStreamsPriceStatesCmd(),
 	)
 	return cmd
 }
@@ -223,3 +231,163 @@ func GetCoinbasePriceStates() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCompositeOraclesCmd queries all composite oracles
+func GetCompositeOraclesCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"composite-oracles",
+		"Gets composite oracles",
+		types.NewQueryClient,
+		&types.QueryCompositeOraclesRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+}
+
+// GetCompositeOracleCmd queries a composite oracle and its price state
+func GetCompositeOracleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "composite-oracle [symbol]",
+		Short: "Gets a composite oracle",
+		Long:  "Gets a composite oracle, its last price state and the reason its price can't be resolved, if any",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCompositeOracleRequest{
+				Symbol: args[0],
+			}
+			res, err := queryClient.CompositeOracle(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCircuitBreakersCmd queries all circuit breakers and halted symbols
+func GetCircuitBreakersCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"circuit-breakers",
+		"Gets circuit breakers and the symbols they halt",
+		types.NewQueryClient,
+		&types.QueryCircuitBreakersRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+}
+
+// GetCircuitBreakerStateCmd queries the circuit breaker state of a symbol
+func GetCircuitBreakerStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker-state [oracle-type] [base] [quote]",
+		Short: "Gets the circuit breaker state of a symbol",
+		Long:  "Gets the circuit breaker state of a symbol. Quote is the provider for provider oracles and is omitted for pyth and stork oracles",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			oracleType, err := types.GetOracleType(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCircuitBreakerStateRequest{
+				OracleType: oracleType,
+				Base:       args[1],
+			}
+			if len(args) == 3 {
+				req.Quote = args[2]
+			}
+
+			res, err := queryClient.CircuitBreakerState(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetOracleTWAPCmd queries the time-weighted average price of a symbol
+func GetOracleTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [oracle-type] [symbol] [start-time] [end-time] [max-gap]",
+		Short: "Gets the time-weighted average price of a symbol",
+		Long:  "Gets the time-weighted average price of a symbol between two unix timestamps. End time defaults to the block time and max gap is disabled when omitted",
+		Args:  cobra.RangeArgs(3, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			oracleType, err := types.GetOracleType(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOracleTWAPRequest{
+				BaseInfo: &types.OracleInfo{
+					Symbol:     args[1],
+					OracleType: oracleType,
+				},
+			}
+
+			if req.StartTime, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+				return err
+			}
+			if len(args) > 3 {
+				if req.EndTime, err = strconv.ParseInt(args[3], 10, 64); err != nil {
+					return err
+				}
+			}
+			if len(args) > 4 {
+				if req.MaxGap, err = strconv.ParseInt(args[4], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.OracleTWAP(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetChainlinkDataStreamsDONsCmd queries the registered Chainlink Data Streams DON signer sets
+func GetChainlinkDataStreamsDONsCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"chainlink-data-streams-dons",
+		"Gets the registered Chainlink Data Streams DON signer sets",
+		types.NewQueryClient,
+		&types.QueryChainlinkDataStreamsDONsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+}
+
+// GetChainlinkDataStreamsPriceStatesCmd queries the Chainlink Data Streams price states
+func GetChainlinkDataStreamsPriceStatesCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"chainlink-data-streams-price-states",
+		"Gets Chainlink Data Streams price states",
+		types.NewQueryClient,
+		&types.QueryChainlinkDataStreamsPriceStatesRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+}
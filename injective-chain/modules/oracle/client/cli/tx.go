@@ -53,6 +53,7 @@ func NewTxCmd() *cobra.Command {
 		NewRelayBandRatesTxCmd(),
 		NewRelayPriceFeedPriceTxCmd(),
 		NewRelayCoinbaseMessagesTxCmd(),
+		NewRelayChainlinkPricesTxCmd(),
 		NewGrantBandOraclePrivilegeProposalTxCmd(),
 		NewRevokeBandOraclePrivilegeProposalTxCmd(),
 		NewGrantPriceFeederPrivilegeProposalTxCmd(),
@@ -427,6 +428,44 @@ func NewRelayCoinbaseMessagesTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewRelayChainlinkPricesTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-chainlink-prices [report] [report] [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Relay signed Chainlink Data Streams reports",
+		Long: `Relay signed Chainlink Data Streams reports, hex encoded as returned by the Data Streams API.
+
+		Example:
+		$ %s tx oracle relay-chainlink-prices 0x0006f9b553e393ced311551efd30d1decedb63d76ad41737462e2cdbbdff1578... --from=genesis --keyring-backend=file --yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reports := make([][]byte, 0, len(args))
+			for _, arg := range args {
+				reports = append(reports, common.FromHex(arg))
+			}
+
+			msg := &types.MsgRelayChainlinkPrices{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Reports: reports,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRequestBandIBCRatesTxCmd implements the request command handler.
 func NewRequestBandIBCRatesTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type ChainlinkDataStreamsKeeper interface {
+	GetChainlinkDataStreamsPrice(ctx sdk.Context, base, quote string) *math.LegacyDec
+	GetChainlinkDataStreamsPriceState(ctx sdk.Context, feedID common.Hash) *types.ChainlinkDataStreamsPriceState
+	SetChainlinkDataStreamsPriceState(ctx sdk.Context, priceState *types.ChainlinkDataStreamsPriceState)
+	GetAllChainlinkDataStreamsPriceStates(ctx sdk.Context) []*types.ChainlinkDataStreamsPriceState
+
+	StoreChainlinkDataStreamsDON(ctx sdk.Context, don *types.ChainlinkDataStreamsDON)
+	GetChainlinkDataStreamsDON(ctx sdk.Context, configDigest common.Hash) *types.ChainlinkDataStreamsDON
+	DeleteChainlinkDataStreamsDON(ctx sdk.Context, configDigest common.Hash)
+	GetAllChainlinkDataStreamsDONs(ctx sdk.Context) []types.ChainlinkDataStreamsDON
+}
+
+// ProcessChainlinkDataStreamsReports verifies the signed reports against the registered DON signer sets and stores
+// their prices. Reports that fail verification abort the relay, while outdated or expired reports are skipped.
+func (k *Keeper) ProcessChainlinkDataStreamsReports(ctx sdk.Context, fullReports [][]byte) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	blockTime := ctx.BlockTime().Unix()
+	priceStates := make([]*types.ChainlinkDataStreamsPriceState, 0, len(fullReports))
+
+	for _, fullReport := range fullReports {
+		report, err := k.verifyChainlinkDataStreamsReport(ctx, fullReport)
+		if err != nil {
+			return err
+		}
+
+		if int64(report.ExpiresAt) < blockTime {
+			k.Logger(ctx).Debug("skipping expired chainlink report", "feed_id", report.FeedID.Hex(), "expires_at", report.ExpiresAt)
+			continue
+		}
+
+		priceState := k.GetChainlinkDataStreamsPriceState(ctx, report.FeedID)
+
+		// don't update prices with an older report
+		if priceState != nil && priceState.ObservationsTimestamp >= uint64(report.ObservationsTimestamp) {
+			continue
+		}
+
+		// skip price update if the price changes beyond 100x or less than 1% of the last price
+		if priceState != nil && types.CheckPriceFeedThreshold(priceState.PriceState.Price, report.BenchmarkPrice) {
+			continue
+		}
+
+		var lastPrice *math.LegacyDec
+		if priceState != nil {
+			lastPrice = &priceState.PriceState.Price
+		}
+
+		feedID := report.FeedID.Hex()
+		if !k.checkCircuitBreaker(ctx, types.OracleType_ChainlinkDataStreams, feedID, "", lastPrice, report.BenchmarkPrice, int64(report.ObservationsTimestamp)) {
+			continue
+		}
+
+		if priceState == nil {
+			priceState = types.NewChainlinkDataStreamsPriceState(report, blockTime)
+		} else {
+			priceState.Update(report, blockTime)
+		}
+
+		k.SetChainlinkDataStreamsPriceState(ctx, priceState)
+		priceStates = append(priceStates, priceState)
+	}
+
+	if len(priceStates) > 0 {
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventSetChainlinkDataStreamsPrices{
+			Prices: priceStates,
+		})
+	}
+
+	return nil
+}
+
+// verifyChainlinkDataStreamsReport checks that the report is signed by exactly f+1 distinct signers of the DON
+// configuration it was generated under, the same way the Chainlink verifier contract does, and decodes it.
+func (k *Keeper) verifyChainlinkDataStreamsReport(ctx sdk.Context, bz []byte) (*types.ChainlinkReportV3, error) {
+	fullReport, err := types.DecodeChainlinkFullReport(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	configDigest := fullReport.ConfigDigest()
+	don := k.GetChainlinkDataStreamsDON(ctx, configDigest)
+	if don == nil {
+		return nil, errors.Wrapf(types.ErrChainlinkDONNotFound, "config digest %s", configDigest.Hex())
+	}
+
+	if len(fullReport.Rs) != int(don.F)+1 {
+		return nil, errors.Wrapf(types.ErrInvalidChainlinkReport, "expected %d signatures, got %d", don.F+1, len(fullReport.Rs))
+	}
+
+	signers, err := fullReport.RecoverSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	donSigners := make(map[common.Address]struct{}, len(don.Signers))
+	for _, signer := range don.Signers {
+		donSigners[common.HexToAddress(signer)] = struct{}{}
+	}
+
+	for _, signer := range signers {
+		if _, ok := donSigners[signer]; !ok {
+			return nil, errors.Wrapf(types.ErrInvalidChainlinkReport, "%s is not a signer of config digest %s", signer.Hex(), configDigest.Hex())
+		}
+
+		// each signer can only sign once
+		delete(donSigners, signer)
+	}
+
+	return types.DecodeChainlinkReportV3(fullReport.ReportData)
+}
+
+// GetChainlinkDataStreamsPrice gets the price for a given base quote pair, where base and quote are feed ids.
+func (k *Keeper) GetChainlinkDataStreamsPrice(ctx sdk.Context, base, quote string) *math.LegacyDec {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	basePriceState := k.GetChainlinkDataStreamsPriceState(ctx, common.HexToHash(base))
+	if basePriceState == nil {
+		return nil
+	}
+
+	if quote == types.QuoteUSD {
+		return &basePriceState.PriceState.Price
+	}
+
+	quotePriceState := k.GetChainlinkDataStreamsPriceState(ctx, common.HexToHash(quote))
+	if quotePriceState == nil {
+		return nil
+	}
+
+	basePrice := basePriceState.PriceState.Price
+	quotePrice := quotePriceState.PriceState.Price
+
+	if basePrice.IsNil() || quotePrice.IsNil() || !basePrice.IsPositive() || !quotePrice.IsPositive() {
+		return nil
+	}
+
+	price := basePrice.Quo(quotePrice)
+	return &price
+}
+
+func (k *Keeper) GetChainlinkDataStreamsPriceState(ctx sdk.Context, feedID common.Hash) *types.ChainlinkDataStreamsPriceState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetChainlinkDataStreamsPriceStoreKey(feedID))
+	if bz == nil {
+		return nil
+	}
+
+	var priceState types.ChainlinkDataStreamsPriceState
+	k.cdc.MustUnmarshal(bz, &priceState)
+	return &priceState
+}
+
+func (k *Keeper) SetChainlinkDataStreamsPriceState(ctx sdk.Context, priceState *types.ChainlinkDataStreamsPriceState) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	feedID := common.HexToHash(priceState.FeedId)
+	bz := k.cdc.MustMarshal(priceState)
+	k.getStore(ctx).Set(types.GetChainlinkDataStreamsPriceStoreKey(feedID), bz)
+
+	k.AppendPriceRecord(ctx, types.OracleType_ChainlinkDataStreams, feedID.Hex(), &types.PriceRecord{
+		Timestamp: priceState.PriceState.Timestamp,
+		Price:     priceState.PriceState.Price,
+	})
+}
+
+func (k *Keeper) GetAllChainlinkDataStreamsPriceStates(ctx sdk.Context) []*types.ChainlinkDataStreamsPriceState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	priceStates := make([]*types.ChainlinkDataStreamsPriceState, 0)
+	priceStore := prefix.NewStore(k.getStore(ctx), types.ChainlinkDataStreamsPriceKey)
+
+	iterator := priceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var priceState types.ChainlinkDataStreamsPriceState
+		k.cdc.MustUnmarshal(iterator.Value(), &priceState)
+		priceStates = append(priceStates, &priceState)
+	}
+
+	return priceStates
+}
+
+// StoreChainlinkDataStreamsDON registers the signer set of a DON configuration, replacing the existing one if any.
+func (k *Keeper) StoreChainlinkDataStreamsDON(ctx sdk.Context, don *types.ChainlinkDataStreamsDON) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(don)
+	k.getStore(ctx).Set(types.GetChainlinkDataStreamsDONKey(common.HexToHash(don.ConfigDigest)), bz)
+}
+
+func (k *Keeper) GetChainlinkDataStreamsDON(ctx sdk.Context, configDigest common.Hash) *types.ChainlinkDataStreamsDON {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetChainlinkDataStreamsDONKey(configDigest))
+	if bz == nil {
+		return nil
+	}
+
+	var don types.ChainlinkDataStreamsDON
+	k.cdc.MustUnmarshal(bz, &don)
+	return &don
+}
+
+func (k *Keeper) DeleteChainlinkDataStreamsDON(ctx sdk.Context, configDigest common.Hash) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.getStore(ctx).Delete(types.GetChainlinkDataStreamsDONKey(configDigest))
+}
+
+func (k *Keeper) GetAllChainlinkDataStreamsDONs(ctx sdk.Context) []types.ChainlinkDataStreamsDON {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	dons := make([]types.ChainlinkDataStreamsDON, 0)
+	donStore := prefix.NewStore(k.getStore(ctx), types.ChainlinkDataStreamsDONKey)
+
+	iterator := donStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var don types.ChainlinkDataStreamsDON
+		k.cdc.MustUnmarshal(iterator.Value(), &don)
+		dons = append(dons, don)
+	}
+
+	return dons
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type ChainlinkDataStreamsMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewChainlinkDataStreamsMsgServerImpl returns an implementation of the Chainlink Data Streams MsgServer interface for the provided Keeper.
+func NewChainlinkDataStreamsMsgServerImpl(keeper Keeper) ChainlinkDataStreamsMsgServer {
+	return ChainlinkDataStreamsMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "chainlink_data_streams_msg_h",
+		},
+	}
+}
+
+func (k ChainlinkDataStreamsMsgServer) RelayChainlinkPrices(c context.Context, msg *types.MsgRelayChainlinkPrices) (*types.MsgRelayChainlinkPricesResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := k.ProcessChainlinkDataStreamsReports(ctx, msg.Reports); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgRelayChainlinkPricesResponse{}, nil
+}
+
+func (k ChainlinkDataStreamsMsgServer) SetChainlinkDataStreamsDON(c context.Context, msg *types.MsgSetChainlinkDataStreamsDON) (*types.MsgSetChainlinkDataStreamsDONResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Don.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.StoreChainlinkDataStreamsDON(ctx, &msg.Don)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSetChainlinkDataStreamsDON{
+		Don: &msg.Don,
+	})
+
+	return &types.MsgSetChainlinkDataStreamsDONResponse{}, nil
+}
+
+func (k ChainlinkDataStreamsMsgServer) RemoveChainlinkDataStreamsDON(c context.Context, msg *types.MsgRemoveChainlinkDataStreamsDON) (*types.MsgRemoveChainlinkDataStreamsDONResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	configDigest := common.HexToHash(msg.ConfigDigest)
+	if k.GetChainlinkDataStreamsDON(ctx, configDigest) == nil {
+		return nil, errors.Wrapf(types.ErrChainlinkDONNotFound, "config digest %s", msg.ConfigDigest)
+	}
+
+	k.DeleteChainlinkDataStreamsDON(ctx, configDigest)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventRemoveChainlinkDataStreamsDON{
+		ConfigDigest: msg.ConfigDigest,
+	})
+
+	return &types.MsgRemoveChainlinkDataStreamsDONResponse{}, nil
+}
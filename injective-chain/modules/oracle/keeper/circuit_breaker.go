@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type CircuitBreakerKeeper interface {
+	IsPriceHalted(ctx sdk.Context, oracleType types.OracleType, base, quote string) bool
+	IsSymbolHalted(ctx sdk.Context, oracleType types.OracleType, base, quote string) (halted, stale bool)
+
+	StoreCircuitBreaker(ctx sdk.Context, circuitBreaker *types.CircuitBreaker)
+	GetCircuitBreaker(ctx sdk.Context, oracleType types.OracleType, base, quote string) *types.CircuitBreaker
+	DeleteCircuitBreaker(ctx sdk.Context, oracleType types.OracleType, base, quote string)
+	GetAllCircuitBreakers(ctx sdk.Context) []types.CircuitBreaker
+
+	SetCircuitBreakerHalt(ctx sdk.Context, halt *types.CircuitBreakerHalt)
+	GetCircuitBreakerHalt(ctx sdk.Context, oracleType types.OracleType, base, quote string) *types.CircuitBreakerHalt
+	DeleteCircuitBreakerHalt(ctx sdk.Context, oracleType types.OracleType, base, quote string)
+	GetAllCircuitBreakerHalts(ctx sdk.Context) []types.CircuitBreakerHalt
+}
+
+// IsPriceHalted returns true if a circuit breaker halted any of the prices the given pair depends on. Markets
+// referencing the pair should not rely on its price until the feed recovers.
+func (k *Keeper) IsPriceHalted(ctx sdk.Context, oracleType types.OracleType, base, quote string) bool {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	switch oracleType {
+	case types.OracleType_Pyth, types.OracleType_Stork, types.OracleType_ChainlinkDataStreams:
+		if halted, _ := k.IsSymbolHalted(ctx, oracleType, base, ""); halted {
+			return true
+		}
+
+		if quote == types.QuoteUSD {
+			return false
+		}
+
+		halted, _ := k.IsSymbolHalted(ctx, oracleType, quote, "")
+		return halted
+	case types.OracleType_PriceFeed, types.OracleType_Provider:
+		// base is used for symbol and quote is used for provider for provider oracles
+		halted, _ := k.IsSymbolHalted(ctx, oracleType, base, quote)
+		return halted
+	}
+
+	return false
+}
+
+// IsSymbolHalted returns whether the symbol is halted by its circuit breaker, either because a relayed price breached
+// it or because the last price is older than the max staleness.
+func (k *Keeper) IsSymbolHalted(ctx sdk.Context, oracleType types.OracleType, base, quote string) (halted, stale bool) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	circuitBreaker := k.GetCircuitBreaker(ctx, oracleType, base, quote)
+	if circuitBreaker == nil {
+		return false, false
+	}
+
+	if circuitBreaker.MaxStaleness > 0 {
+		timestamp := k.getCircuitBreakerPriceTimestamp(ctx, oracleType, base, quote)
+		stale = timestamp > 0 && ctx.BlockTime().Unix()-timestamp > circuitBreaker.MaxStaleness
+	}
+
+	halted = stale || k.GetCircuitBreakerHalt(ctx, oracleType, base, quote) != nil
+	return halted, stale
+}
+
+// getCircuitBreakerPriceTimestamp returns the block time of the last price update of the symbol, or 0 if there is none.
+func (k *Keeper) getCircuitBreakerPriceTimestamp(ctx sdk.Context, oracleType types.OracleType, base, quote string) int64 {
+	var priceState *types.PriceState
+
+	switch oracleType {
+	case types.OracleType_Pyth:
+		if pythPriceState := k.GetPythPriceState(ctx, common.HexToHash(base)); pythPriceState != nil {
+			priceState = &pythPriceState.PriceState
+		}
+	case types.OracleType_Stork:
+		if storkPriceState := k.GetStorkPriceState(ctx, base); storkPriceState != nil {
+			priceState = &storkPriceState.PriceState
+		}
+	case types.OracleType_ChainlinkDataStreams:
+		if chainlinkPriceState := k.GetChainlinkDataStreamsPriceState(ctx, common.HexToHash(base)); chainlinkPriceState != nil {
+			priceState = &chainlinkPriceState.PriceState
+		}
+	case types.OracleType_PriceFeed:
+		priceState = k.GetPriceFeedPriceState(ctx, base, quote)
+	case types.OracleType_Provider:
+		if providerPriceState := k.GetProviderPriceState(ctx, quote, base); providerPriceState != nil {
+			priceState = providerPriceState.State
+		}
+	}
+
+	if priceState == nil {
+		return 0
+	}
+
+	return priceState.Timestamp
+}
+
+// checkCircuitBreaker checks a relayed price against the circuit breaker of its symbol, if any, and returns whether
+// the price should be stored. The publish time is the time at which the price was published by the oracle, or 0 if
+// the oracle doesn't provide it. A breaching price halts the symbol, whether it is stored or not, and a halted symbol
+// recovers once a price within the limits is relayed. Since rejected prices don't move the last price, a RejectPrice
+// circuit breaker also re-anchors the price once enough consecutive consistent prices breaching the max deviation
+// are relayed.
+func (k *Keeper) checkCircuitBreaker(
+	ctx sdk.Context,
+	oracleType types.OracleType,
+	base, quote string,
+	lastPrice *math.LegacyDec,
+	price math.LegacyDec,
+	publishTime int64,
+) bool {
+	circuitBreaker := k.GetCircuitBreaker(ctx, oracleType, base, quote)
+	if circuitBreaker == nil {
+		return true
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	var (
+		reason    string
+		deviation bool
+	)
+	switch {
+	case circuitBreaker.MaxStaleness > 0 && publishTime > 0 && blockTime-publishTime > circuitBreaker.MaxStaleness:
+		reason = fmt.Sprintf("price was published %ds ago, max staleness is %ds", blockTime-publishTime, circuitBreaker.MaxStaleness)
+	case hasMaxDeviation(circuitBreaker) && lastPrice != nil && !lastPrice.IsNil() && lastPrice.IsPositive():
+		if d := priceDeviation(*lastPrice, price); d.GT(circuitBreaker.MaxDeviation) {
+			reason = fmt.Sprintf("price deviates %s from the last price, max deviation is %s", d.String(), circuitBreaker.MaxDeviation.String())
+			deviation = true
+		}
+	}
+
+	halt := k.GetCircuitBreakerHalt(ctx, oracleType, base, quote)
+
+	if reason == "" {
+		if halt != nil {
+			k.recoverCircuitBreakerHalt(ctx, oracleType, base, quote)
+		}
+
+		return true
+	}
+
+	event := &types.EventCircuitBreakerTriggered{
+		OracleType: oracleType,
+		Base:       base,
+		Quote:      quote,
+		Action:     circuitBreaker.Action,
+		Reason:     reason,
+		LastPrice:  math.LegacyZeroDec(),
+		Price:      price,
+	}
+	if lastPrice != nil && !lastPrice.IsNil() {
+		event.LastPrice = *lastPrice
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(event)
+
+	if halt == nil {
+		halt = &types.CircuitBreakerHalt{
+			OracleType:     oracleType,
+			Base:           base,
+			Quote:          quote,
+			HaltedAt:       blockTime,
+			CandidatePrice: math.LegacyZeroDec(),
+		}
+	}
+	halt.Reason = reason
+
+	if circuitBreaker.Action == types.CircuitBreakerAction_HaltPrice {
+		k.SetCircuitBreakerHalt(ctx, halt)
+		return true
+	}
+
+	if !deviation {
+		k.SetCircuitBreakerHalt(ctx, halt)
+		return false
+	}
+
+	// the rejected price becomes the candidate for re-anchoring, and counts towards it if consistent with the previous one
+	if !halt.CandidatePrice.IsNil() && halt.CandidatePrice.IsPositive() && halt.ConsistentPrints > 0 &&
+		!priceDeviation(halt.CandidatePrice, price).GT(circuitBreaker.MaxDeviation) {
+		halt.ConsistentPrints++
+	} else {
+		halt.ConsistentPrints = 1
+	}
+	halt.CandidatePrice = price
+
+	if circuitBreaker.RecoveryPrints > 0 && halt.ConsistentPrints >= circuitBreaker.RecoveryPrints {
+		k.recoverCircuitBreakerHalt(ctx, oracleType, base, quote)
+		return true
+	}
+
+	k.SetCircuitBreakerHalt(ctx, halt)
+	return false
+}
+
+func (k *Keeper) recoverCircuitBreakerHalt(ctx sdk.Context, oracleType types.OracleType, base, quote string) {
+	k.DeleteCircuitBreakerHalt(ctx, oracleType, base, quote)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCircuitBreakerRecovered{
+		OracleType: oracleType,
+		Base:       base,
+		Quote:      quote,
+	})
+}
+
+func hasMaxDeviation(circuitBreaker *types.CircuitBreaker) bool {
+	return !circuitBreaker.MaxDeviation.IsNil() && circuitBreaker.MaxDeviation.IsPositive()
+}
+
+// priceDeviation returns the relative change of the price from the reference price, which must be positive
+func priceDeviation(reference, price math.LegacyDec) math.LegacyDec {
+	return price.Sub(reference).Abs().Quo(reference)
+}
+
+// StoreCircuitBreaker stores a given circuit breaker.
+func (k *Keeper) StoreCircuitBreaker(ctx sdk.Context, circuitBreaker *types.CircuitBreaker) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(circuitBreaker)
+	k.getStore(ctx).Set(types.GetCircuitBreakerKey(circuitBreaker.OracleType, circuitBreaker.Base, circuitBreaker.Quote), bz)
+}
+
+// GetCircuitBreaker returns the circuit breaker of a given symbol, or nil if there is none.
+func (k *Keeper) GetCircuitBreaker(ctx sdk.Context, oracleType types.OracleType, base, quote string) *types.CircuitBreaker {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetCircuitBreakerKey(oracleType, base, quote))
+	if bz == nil {
+		return nil
+	}
+
+	var circuitBreaker types.CircuitBreaker
+	k.cdc.MustUnmarshal(bz, &circuitBreaker)
+	return &circuitBreaker
+}
+
+// DeleteCircuitBreaker deletes the circuit breaker of a given symbol and lifts its halt.
+func (k *Keeper) DeleteCircuitBreaker(ctx sdk.Context, oracleType types.OracleType, base, quote string) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := k.getStore(ctx)
+	store.Delete(types.GetCircuitBreakerKey(oracleType, base, quote))
+	store.Delete(types.GetCircuitBreakerHaltKey(oracleType, base, quote))
+}
+
+// GetAllCircuitBreakers fetches all circuit breakers.
+func (k *Keeper) GetAllCircuitBreakers(ctx sdk.Context) []types.CircuitBreaker {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	circuitBreakers := make([]types.CircuitBreaker, 0)
+	circuitBreakerStore := prefix.NewStore(k.getStore(ctx), types.CircuitBreakerKey)
+
+	iter := circuitBreakerStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var circuitBreaker types.CircuitBreaker
+		k.cdc.MustUnmarshal(iter.Value(), &circuitBreaker)
+		circuitBreakers = append(circuitBreakers, circuitBreaker)
+	}
+
+	return circuitBreakers
+}
+
+// SetCircuitBreakerHalt halts a symbol.
+func (k *Keeper) SetCircuitBreakerHalt(ctx sdk.Context, halt *types.CircuitBreakerHalt) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(halt)
+	k.getStore(ctx).Set(types.GetCircuitBreakerHaltKey(halt.OracleType, halt.Base, halt.Quote), bz)
+}
+
+// GetCircuitBreakerHalt returns the halt of a given symbol, or nil if the symbol is not halted.
+func (k *Keeper) GetCircuitBreakerHalt(ctx sdk.Context, oracleType types.OracleType, base, quote string) *types.CircuitBreakerHalt {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetCircuitBreakerHaltKey(oracleType, base, quote))
+	if bz == nil {
+		return nil
+	}
+
+	var halt types.CircuitBreakerHalt
+	k.cdc.MustUnmarshal(bz, &halt)
+	return &halt
+}
+
+// DeleteCircuitBreakerHalt lifts the halt of a given symbol.
+func (k *Keeper) DeleteCircuitBreakerHalt(ctx sdk.Context, oracleType types.OracleType, base, quote string) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.getStore(ctx).Delete(types.GetCircuitBreakerHaltKey(oracleType, base, quote))
+}
+
+// GetAllCircuitBreakerHalts fetches the halts of all halted symbols.
+func (k *Keeper) GetAllCircuitBreakerHalts(ctx sdk.Context) []types.CircuitBreakerHalt {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	halts := make([]types.CircuitBreakerHalt, 0)
+	haltStore := prefix.NewStore(k.getStore(ctx), types.CircuitBreakerHaltKey)
+
+	iter := haltStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var halt types.CircuitBreakerHalt
+		k.cdc.MustUnmarshal(iter.Value(), &halt)
+		halts = append(halts, halt)
+	}
+
+	return halts
+}
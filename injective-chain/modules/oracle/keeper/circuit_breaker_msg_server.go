@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type CircuitBreakerMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewCircuitBreakerMsgServerImpl returns an implementation of the circuit breaker MsgServer interface for the provided Keeper.
+func NewCircuitBreakerMsgServerImpl(keeper Keeper) CircuitBreakerMsgServer {
+	return CircuitBreakerMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "circuit_breaker_msg_h",
+		},
+	}
+}
+
+func (k CircuitBreakerMsgServer) SetCircuitBreaker(c context.Context, msg *types.MsgSetCircuitBreaker) (*types.MsgSetCircuitBreakerResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.CircuitBreaker.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.StoreCircuitBreaker(ctx, &msg.CircuitBreaker)
+
+	// replacing a circuit breaker resets its symbol, so that governance can lift a halt that prices can't recover from
+	cb := msg.CircuitBreaker
+	if k.GetCircuitBreakerHalt(ctx, cb.OracleType, cb.Base, cb.Quote) != nil {
+		k.recoverCircuitBreakerHalt(ctx, cb.OracleType, cb.Base, cb.Quote)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSetCircuitBreaker{
+		CircuitBreaker: &msg.CircuitBreaker,
+	})
+
+	return &types.MsgSetCircuitBreakerResponse{}, nil
+}
+
+func (k CircuitBreakerMsgServer) RemoveCircuitBreaker(c context.Context, msg *types.MsgRemoveCircuitBreaker) (*types.MsgRemoveCircuitBreakerResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetCircuitBreaker(ctx, msg.OracleType, msg.Base, msg.Quote) == nil {
+		return nil, errors.Wrapf(types.ErrCircuitBreakerNotFound, "type %s base %s quote %s", msg.OracleType.String(), msg.Base, msg.Quote)
+	}
+
+	k.DeleteCircuitBreaker(ctx, msg.OracleType, msg.Base, msg.Quote)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventRemoveCircuitBreaker{
+		OracleType: msg.OracleType,
+		Base:       msg.Base,
+		Quote:      msg.Quote,
+	})
+
+	return &types.MsgRemoveCircuitBreakerResponse{}, nil
+}
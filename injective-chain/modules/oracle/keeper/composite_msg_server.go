@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type CompositeMsgServer struct {
+	Keeper
+	svcTags metrics.Tags
+}
+
+// NewCompositeMsgServerImpl returns an implementation of the composite oracle MsgServer interface for the provided Keeper.
+func NewCompositeMsgServerImpl(keeper Keeper) CompositeMsgServer {
+	return CompositeMsgServer{
+		Keeper: keeper,
+		svcTags: metrics.Tags{
+			"svc": "composite_msg_h",
+		},
+	}
+}
+
+func (k CompositeMsgServer) SetCompositeOracle(c context.Context, msg *types.MsgSetCompositeOracle) (*types.MsgSetCompositeOracleResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.CompositeOracle.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.StoreCompositeOracle(ctx, &msg.CompositeOracle)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSetCompositeOracle{
+		CompositeOracle: &msg.CompositeOracle,
+	})
+
+	return &types.MsgSetCompositeOracleResponse{}, nil
+}
+
+func (k CompositeMsgServer) RemoveCompositeOracle(c context.Context, msg *types.MsgRemoveCompositeOracle) (*types.MsgRemoveCompositeOracleResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetCompositeOracle(ctx, msg.Symbol) == nil {
+		return nil, errors.Wrap(types.ErrCompositeOracleNotFound, msg.Symbol)
+	}
+
+	k.DeleteCompositeOracle(ctx, msg.Symbol)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventRemoveCompositeOracle{
+		Symbol: msg.Symbol,
+	})
+
+	return &types.MsgRemoveCompositeOracleResponse{}, nil
+}
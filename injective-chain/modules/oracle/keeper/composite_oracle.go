@@ -0,0 +1,350 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type CompositeKeeper interface {
+	GetCompositePrice(ctx sdk.Context, base string, quote string) *math.LegacyDec
+	ResolveCompositePrice(ctx sdk.Context, compositeOracle *types.CompositeOracle) (price math.LegacyDec, sourcesUsed uint32, err error)
+	UpdateCompositePrices(ctx sdk.Context)
+
+	StoreCompositeOracle(ctx sdk.Context, compositeOracle *types.CompositeOracle)
+	GetCompositeOracle(ctx sdk.Context, symbol string) *types.CompositeOracle
+	DeleteCompositeOracle(ctx sdk.Context, symbol string)
+	GetAllCompositeOracles(ctx sdk.Context) []types.CompositeOracle
+
+	SetCompositePriceState(ctx sdk.Context, priceState *types.CompositePriceState)
+	GetCompositePriceState(ctx sdk.Context, symbol string) *types.CompositePriceState
+	GetAllCompositePriceStates(ctx sdk.Context) []*types.CompositePriceState
+}
+
+// GetCompositePrice resolves the price for a given base quote pair of composite oracles at the current block.
+// It returns nil when any of the composite oracles does not meet its quorum.
+func (k *Keeper) GetCompositePrice(ctx sdk.Context, base, quote string) *math.LegacyDec {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	basePrice := k.getCompositePrice(ctx, base)
+	if basePrice == nil {
+		return nil
+	}
+
+	if quote == types.QuoteUSD {
+		return basePrice
+	}
+
+	quotePrice := k.getCompositePrice(ctx, quote)
+	if quotePrice == nil {
+		return nil
+	}
+
+	price := basePrice.Quo(*quotePrice)
+	return &price
+}
+
+func (k *Keeper) getCompositePrice(ctx sdk.Context, symbol string) *math.LegacyDec {
+	compositeOracle := k.GetCompositeOracle(ctx, symbol)
+	if compositeOracle == nil {
+		return nil
+	}
+
+	price, _, err := k.ResolveCompositePrice(ctx, compositeOracle)
+	if err != nil {
+		return nil
+	}
+
+	return &price
+}
+
+type compositeSourcePrice struct {
+	price  math.LegacyDec
+	weight math.LegacyDec
+}
+
+// ResolveCompositePrice aggregates the current prices of the composite oracle's sources. Stale, halted and
+// non-positive source prices are ignored, as well as prices deviating from the median of the remaining
+// ones by more than the max deviation. An error is returned if fewer than min quorum sources are left.
+func (k *Keeper) ResolveCompositePrice(
+	ctx sdk.Context, compositeOracle *types.CompositeOracle,
+) (price math.LegacyDec, sourcesUsed uint32, err error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	prices := make([]compositeSourcePrice, 0, len(compositeOracle.Sources))
+	for i := range compositeOracle.Sources {
+		source := &compositeOracle.Sources[i]
+
+		sourcePrice, timestamp := k.getCompositeSourcePrice(ctx, source)
+		if sourcePrice == nil || sourcePrice.IsNil() || !sourcePrice.IsPositive() {
+			continue
+		}
+
+		if source.MaxStaleness > 0 && blockTime-timestamp > source.MaxStaleness {
+			continue
+		}
+
+		if k.IsPriceHalted(ctx, source.OracleType, source.Base, source.Quote) {
+			continue
+		}
+
+		prices = append(prices, compositeSourcePrice{
+			price:  *sourcePrice,
+			weight: source.Weight,
+		})
+	}
+
+	if len(prices) < int(compositeOracle.MinQuorum) {
+		return math.LegacyDec{}, 0, errors.Wrapf(
+			types.ErrCompositeQuorumNotMet, "%d of %d sources have a valid price, %d needed",
+			len(prices), len(compositeOracle.Sources), compositeOracle.MinQuorum,
+		)
+	}
+
+	if !compositeOracle.MaxDeviation.IsNil() && compositeOracle.MaxDeviation.IsPositive() {
+		median := medianCompositePrice(prices)
+		maxDelta := median.Mul(compositeOracle.MaxDeviation)
+
+		withinDeviation := prices[:0]
+		for _, p := range prices {
+			if p.price.Sub(median).Abs().LTE(maxDelta) {
+				withinDeviation = append(withinDeviation, p)
+			}
+		}
+		prices = withinDeviation
+
+		if len(prices) < int(compositeOracle.MinQuorum) {
+			return math.LegacyDec{}, 0, errors.Wrapf(
+				types.ErrCompositeQuorumNotMet, "%d of %d sources are within the max deviation, %d needed",
+				len(prices), len(compositeOracle.Sources), compositeOracle.MinQuorum,
+			)
+		}
+	}
+
+	switch compositeOracle.Aggregation {
+	case types.CompositeAggregation_WeightedMean:
+		weightedSum, totalWeight := math.LegacyZeroDec(), math.LegacyZeroDec()
+		for _, p := range prices {
+			weightedSum = weightedSum.Add(p.price.Mul(p.weight))
+			totalWeight = totalWeight.Add(p.weight)
+		}
+
+		if !totalWeight.IsPositive() {
+			return math.LegacyDec{}, 0, errors.Wrap(types.ErrInvalidCompositeOracle, "total weight of sources is zero")
+		}
+
+		price = weightedSum.Quo(totalWeight)
+	default:
+		price = medianCompositePrice(prices)
+	}
+
+	return price, uint32(len(prices)), nil
+}
+
+// getCompositeSourcePrice returns the current price of the source and the timestamp of its oldest component.
+func (k *Keeper) getCompositeSourcePrice(ctx sdk.Context, source *types.CompositeOracleSource) (*math.LegacyDec, int64) {
+	if source.OracleType == types.OracleType_Provider {
+		// base is used for symbol and quote is used for provider for provider oracles
+		priceState := k.GetProviderPriceState(ctx, source.Quote, source.Base)
+		if priceState == nil || priceState.State == nil {
+			return nil, 0
+		}
+
+		return &priceState.State.Price, priceState.State.Timestamp
+	}
+
+	pricePairState := k.GetPricePairState(ctx, source.OracleType, source.Base, source.Quote, nil)
+	if pricePairState == nil {
+		return nil, 0
+	}
+
+	timestamp := pricePairState.BaseTimestamp
+	if pricePairState.QuoteTimestamp != 0 && pricePairState.QuoteTimestamp < timestamp {
+		timestamp = pricePairState.QuoteTimestamp
+	}
+
+	return &pricePairState.PairPrice, timestamp
+}
+
+func medianCompositePrice(prices []compositeSourcePrice) math.LegacyDec {
+	// sort a copy so the order of the given prices is kept
+	values := make([]math.LegacyDec, 0, len(prices))
+	for _, p := range prices {
+		values = append(values, p.price)
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
+
+	count := len(values)
+	median := values[count/2]
+	if count%2 == 0 {
+		median = median.Add(values[count/2-1]).Quo(math.LegacyNewDec(2))
+	}
+
+	return median
+}
+
+// UpdateCompositePrices resolves the price of every composite oracle and stores it, so that cumulative prices and
+// historical price records are available for composite oracles. Composite oracles that don't meet their quorum keep
+// their last price state.
+func (k *Keeper) UpdateCompositePrices(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	blockTime := ctx.BlockTime().Unix()
+	updatedStates := make([]*types.CompositePriceState, 0)
+
+	compositeOracles := k.GetAllCompositeOracles(ctx)
+	for i := range compositeOracles {
+		compositeOracle := &compositeOracles[i]
+
+		price, sourcesUsed, err := k.ResolveCompositePrice(ctx, compositeOracle)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+
+			// nolint:errcheck //ignored on purpose
+			ctx.EventManager().EmitTypedEvent(&types.EventCompositePriceUnavailable{
+				Symbol: compositeOracle.Symbol,
+				Reason: err.Error(),
+			})
+			continue
+		}
+
+		priceState := k.GetCompositePriceState(ctx, compositeOracle.Symbol)
+		if priceState == nil {
+			priceState = &types.CompositePriceState{
+				Symbol:     compositeOracle.Symbol,
+				PriceState: *types.NewPriceState(price, blockTime),
+			}
+		} else {
+			priceState.PriceState.UpdatePrice(price, blockTime)
+		}
+		priceState.SourcesUsed = sourcesUsed
+
+		k.SetCompositePriceState(ctx, priceState)
+		updatedStates = append(updatedStates, priceState)
+	}
+
+	if len(updatedStates) > 0 {
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventSetCompositePrices{
+			Prices: updatedStates,
+		})
+	}
+}
+
+// StoreCompositeOracle stores a given composite oracle definition.
+func (k *Keeper) StoreCompositeOracle(ctx sdk.Context, compositeOracle *types.CompositeOracle) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(compositeOracle)
+	k.getStore(ctx).Set(types.GetCompositeOracleKey(compositeOracle.Symbol), bz)
+}
+
+// GetCompositeOracle returns the composite oracle definition for a given symbol, or nil if there is none.
+func (k *Keeper) GetCompositeOracle(ctx sdk.Context, symbol string) *types.CompositeOracle {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetCompositeOracleKey(symbol))
+	if bz == nil {
+		return nil
+	}
+
+	var compositeOracle types.CompositeOracle
+	k.cdc.MustUnmarshal(bz, &compositeOracle)
+	return &compositeOracle
+}
+
+// DeleteCompositeOracle deletes the composite oracle definition and its price state.
+func (k *Keeper) DeleteCompositeOracle(ctx sdk.Context, symbol string) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := k.getStore(ctx)
+	store.Delete(types.GetCompositeOracleKey(symbol))
+	store.Delete(types.GetCompositePriceStoreKey(symbol))
+}
+
+// GetAllCompositeOracles fetches all composite oracle definitions.
+func (k *Keeper) GetAllCompositeOracles(ctx sdk.Context) []types.CompositeOracle {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	compositeOracles := make([]types.CompositeOracle, 0)
+	compositeStore := prefix.NewStore(k.getStore(ctx), types.CompositeOracleKey)
+
+	iter := compositeStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var compositeOracle types.CompositeOracle
+		k.cdc.MustUnmarshal(iter.Value(), &compositeOracle)
+		compositeOracles = append(compositeOracles, compositeOracle)
+	}
+
+	return compositeOracles
+}
+
+// SetCompositePriceState stores a given composite price state and records it in the historical price records.
+func (k *Keeper) SetCompositePriceState(ctx sdk.Context, priceState *types.CompositePriceState) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(priceState)
+	k.getStore(ctx).Set(types.GetCompositePriceStoreKey(priceState.Symbol), bz)
+
+	k.AppendPriceRecord(ctx, types.OracleType_Composite, priceState.Symbol, &types.PriceRecord{
+		Timestamp: priceState.PriceState.Timestamp,
+		Price:     priceState.PriceState.Price,
+	})
+}
+
+// GetCompositePriceState returns the last resolved price state of a composite oracle, or nil if there is none.
+func (k *Keeper) GetCompositePriceState(ctx sdk.Context, symbol string) *types.CompositePriceState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetCompositePriceStoreKey(symbol))
+	if bz == nil {
+		return nil
+	}
+
+	var priceState types.CompositePriceState
+	k.cdc.MustUnmarshal(bz, &priceState)
+	return &priceState
+}
+
+// GetAllCompositePriceStates fetches all composite price states.
+func (k *Keeper) GetAllCompositePriceStates(ctx sdk.Context) []*types.CompositePriceState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	priceStates := make([]*types.CompositePriceState, 0)
+	priceStore := prefix.NewStore(k.getStore(ctx), types.CompositePriceKey)
+
+	iter := priceStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var priceState types.CompositePriceState
+		k.cdc.MustUnmarshal(iter.Value(), &priceState)
+		priceStates = append(priceStates, &priceState)
+	}
+
+	return priceStates
+}
@@ -115,26 +115,56 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	for _, storkPublisher := range data.StorkPublishers {
 		k.SetStorkPublisher(ctx, storkPublisher)
 	}
+
+	for i := range data.CompositeOracles {
+		k.StoreCompositeOracle(ctx, &data.CompositeOracles[i])
+	}
+
+	for _, compositePriceState := range data.CompositePriceStates {
+		k.SetCompositePriceState(ctx, compositePriceState)
+	}
+
+	for i := range data.CircuitBreakers {
+		k.StoreCircuitBreaker(ctx, &data.CircuitBreakers[i])
+	}
+
+	for i := range data.CircuitBreakerHalts {
+		k.SetCircuitBreakerHalt(ctx, &data.CircuitBreakerHalts[i])
+	}
+
+	for i := range data.ChainlinkDataStreamsDons {
+		k.StoreChainlinkDataStreamsDON(ctx, &data.ChainlinkDataStreamsDons[i])
+	}
+
+	for _, priceState := range data.ChainlinkDataStreamsPriceStates {
+		k.SetChainlinkDataStreamsPriceState(ctx, priceState)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:                 k.GetParams(ctx),
-		BandRelayers:           k.GetAllBandRelayers(ctx),
-		BandPriceStates:        k.GetAllBandPriceStates(ctx),
-		PriceFeedPriceStates:   k.GetAllPriceFeedStates(ctx),
-		CoinbasePriceStates:    k.GetAllCoinbasePriceStates(ctx),
-		BandIbcPriceStates:     k.GetAllBandIBCPriceStates(ctx),
-		BandIbcOracleRequests:  k.GetAllBandIBCOracleRequests(ctx),
-		BandIbcParams:          k.GetBandIBCParams(ctx),
-		BandIbcLatestClientId:  k.GetBandIBCLatestClientID(ctx),
-		CalldataRecords:        k.GetAllBandCalldataRecords(ctx),
-		BandIbcLatestRequestId: k.GetBandIBCLatestRequestID(ctx),
-		ChainlinkPriceStates:   k.GetAllChainlinkPriceStates(ctx),
-		HistoricalPriceRecords: k.GetAllHistoricalPriceRecords(ctx),
-		ProviderStates:         k.GetAllProviderStates(ctx),
-		PythPriceStates:        k.GetAllPythPriceStates(ctx),
-		StorkPriceStates:       k.GetAllStorkPriceStates(ctx),
-		StorkPublishers:        k.GetAllStorkPublishers(ctx),
+		Params:                          k.GetParams(ctx),
+		BandRelayers:                    k.GetAllBandRelayers(ctx),
+		BandPriceStates:                 k.GetAllBandPriceStates(ctx),
+		PriceFeedPriceStates:            k.GetAllPriceFeedStates(ctx),
+		CoinbasePriceStates:             k.GetAllCoinbasePriceStates(ctx),
+		BandIbcPriceStates:              k.GetAllBandIBCPriceStates(ctx),
+		BandIbcOracleRequests:           k.GetAllBandIBCOracleRequests(ctx),
+		BandIbcParams:                   k.GetBandIBCParams(ctx),
+		BandIbcLatestClientId:           k.GetBandIBCLatestClientID(ctx),
+		CalldataRecords:                 k.GetAllBandCalldataRecords(ctx),
+		BandIbcLatestRequestId:          k.GetBandIBCLatestRequestID(ctx),
+		ChainlinkPriceStates:            k.GetAllChainlinkPriceStates(ctx),
+		HistoricalPriceRecords:          k.GetAllHistoricalPriceRecords(ctx),
+		ProviderStates:                  k.GetAllProviderStates(ctx),
+		PythPriceStates:                 k.GetAllPythPriceStates(ctx),
+		StorkPriceStates:                k.GetAllStorkPriceStates(ctx),
+		StorkPublishers:                 k.GetAllStorkPublishers(ctx),
+		CompositeOracles:                k.GetAllCompositeOracles(ctx),
+		CompositePriceStates:            k.GetAllCompositePriceStates(ctx),
+		CircuitBreakers:                 k.GetAllCircuitBreakers(ctx),
+		CircuitBreakerHalts:             k.GetAllCircuitBreakerHalts(ctx),
+		ChainlinkDataStreamsDons:        k.GetAllChainlinkDataStreamsDONs(ctx),
+		ChainlinkDataStreamsPriceStates: k.GetAllChainlinkDataStreamsPriceStates(ctx),
 	}
 }
@@ -294,3 +294,121 @@ func (k *Keeper) PythPrice(c context.Context, req *types.QueryPythPriceRequest)
 
 	return &types.QueryPythPriceResponse{PriceState: priceState}, nil
 }
+
+func (k *Keeper) CompositeOracles(c context.Context, _ *types.QueryCompositeOraclesRequest) (*types.QueryCompositeOraclesResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryCompositeOraclesResponse{
+		CompositeOracles: k.GetAllCompositeOracles(ctx),
+	}
+
+	return res, nil
+}
+
+// CompositeOracle returns the definition of a composite oracle along with its last stored price state. If the
+// composite oracle can't be resolved at the current block, the reason is returned in the error field.
+func (k *Keeper) CompositeOracle(c context.Context, req *types.QueryCompositeOracleRequest) (*types.QueryCompositeOracleResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	compositeOracle := k.GetCompositeOracle(ctx, req.Symbol)
+	if compositeOracle == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrCompositeOracleNotFound, "symbol %s", req.Symbol)
+	}
+
+	res := &types.QueryCompositeOracleResponse{
+		CompositeOracle: compositeOracle,
+		PriceState:      k.GetCompositePriceState(ctx, req.Symbol),
+	}
+
+	if _, _, err := k.ResolveCompositePrice(ctx, compositeOracle); err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) CircuitBreakers(c context.Context, _ *types.QueryCircuitBreakersRequest) (*types.QueryCircuitBreakersResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryCircuitBreakersResponse{
+		CircuitBreakers: k.GetAllCircuitBreakers(ctx),
+		Halts:           k.GetAllCircuitBreakerHalts(ctx),
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) CircuitBreakerState(c context.Context, req *types.QueryCircuitBreakerStateRequest) (*types.QueryCircuitBreakerStateResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	circuitBreaker := k.GetCircuitBreaker(ctx, req.OracleType, req.Base, req.Quote)
+	if circuitBreaker == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrCircuitBreakerNotFound, "type %s base %s quote %s", req.OracleType.String(), req.Base, req.Quote)
+	}
+
+	halted, stale := k.IsSymbolHalted(ctx, req.OracleType, req.Base, req.Quote)
+
+	res := &types.QueryCircuitBreakerStateResponse{
+		CircuitBreaker: circuitBreaker,
+		Halt:           k.GetCircuitBreakerHalt(ctx, req.OracleType, req.Base, req.Quote),
+		Stale:          stale,
+		Halted:         halted,
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) OracleTWAP(c context.Context, req *types.QueryOracleTWAPRequest) (*types.QueryOracleTWAPResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	twap, err := k.GetOracleTWAP(ctx, req.BaseInfo, req.QuoteInfo, req.StartTime, req.EndTime, req.MaxGap)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.QueryOracleTWAPResponse{Twap: *twap}, nil
+}
+
+func (k *Keeper) ChainlinkDataStreamsDONs(c context.Context, _ *types.QueryChainlinkDataStreamsDONsRequest) (*types.QueryChainlinkDataStreamsDONsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryChainlinkDataStreamsDONsResponse{
+		Dons: k.GetAllChainlinkDataStreamsDONs(ctx),
+	}
+
+	return res, nil
+}
+
+func (k *Keeper) ChainlinkDataStreamsPriceStates(c context.Context, _ *types.QueryChainlinkDataStreamsPriceStatesRequest) (*types.QueryChainlinkDataStreamsPriceStatesResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryChainlinkDataStreamsPriceStatesResponse{
+		PriceStates: k.GetAllChainlinkDataStreamsPriceStates(ctx),
+	}
+
+	return res, nil
+}
@@ -22,6 +22,9 @@ type MsgServer struct {
 	ProviderMsgServer
 	PythMsgServer
 	StorkMsgServer
+	CompositeMsgServer
+	CircuitBreakerMsgServer
+	ChainlinkDataStreamsMsgServer
 
 	Keeper
 	svcTags metrics.Tags
@@ -31,14 +34,17 @@ type MsgServer struct {
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &MsgServer{
-		BandMsgServer:      NewBandMsgServerImpl(keeper),
-		BandIBCMsgServer:   NewBandIBCMsgServerImpl(keeper),
-		PricefeedMsgServer: NewPricefeedMsgServerImpl(keeper),
-		CoinbaseMsgServer:  NewCoinbaseMsgServerImpl(keeper),
-		ProviderMsgServer:  NewProviderMsgServerImpl(keeper),
-		PythMsgServer:      NewPythMsgServerImpl(keeper),
-		StorkMsgServer:     NewStorkMsgServerImpl(keeper),
-		Keeper:             keeper,
+		BandMsgServer:                 NewBandMsgServerImpl(keeper),
+		BandIBCMsgServer:              NewBandIBCMsgServerImpl(keeper),
+		PricefeedMsgServer:            NewPricefeedMsgServerImpl(keeper),
+		CoinbaseMsgServer:             NewCoinbaseMsgServerImpl(keeper),
+		ProviderMsgServer:             NewProviderMsgServerImpl(keeper),
+		PythMsgServer:                 NewPythMsgServerImpl(keeper),
+		StorkMsgServer:                NewStorkMsgServerImpl(keeper),
+		CompositeMsgServer:            NewCompositeMsgServerImpl(keeper),
+		CircuitBreakerMsgServer:       NewCircuitBreakerMsgServerImpl(keeper),
+		ChainlinkDataStreamsMsgServer: NewChainlinkDataStreamsMsgServerImpl(keeper),
+		Keeper:                        keeper,
 		svcTags: metrics.Tags{
 			"svc": "oracle_h",
 		},
@@ -244,6 +244,9 @@ func (k *Keeper) ProcessPriceFeedPrice(ctx sdk.Context, msg *types.MsgRelayPrice
 			if types.CheckPriceFeedThreshold(priceState.Price, price) {
 				continue
 			}
+			if !k.checkCircuitBreaker(ctx, types.OracleType_PriceFeed, base, quote, &priceState.Price, price, 0) {
+				continue
+			}
 			priceState.UpdatePrice(price, blockTime)
 		}
 
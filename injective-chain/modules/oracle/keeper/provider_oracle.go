@@ -287,6 +287,9 @@ func (k *Keeper) ProcessProviderPrices(ctx sdk.Context, msg *types.MsgRelayProvi
 			if types.CheckPriceFeedThreshold(providerPriceState.State.Price, price) {
 				continue
 			}
+			if !k.checkCircuitBreaker(ctx, types.OracleType_Provider, symbol, msg.Provider, &providerPriceState.State.Price, price, 0) {
+				continue
+			}
 			providerPriceState.State.UpdatePrice(price, blockTime)
 		}
 
@@ -44,6 +44,15 @@ func (k *Keeper) ProcessPythPriceAttestations(ctx sdk.Context, priceAttestations
 			continue
 		}
 
+		var lastPrice *math.LegacyDec
+		if pythPriceState != nil {
+			lastPrice = &pythPriceState.PriceState.Price
+		}
+
+		if !k.checkCircuitBreaker(ctx, types.OracleType_Pyth, priceID.Hex(), "", lastPrice, price, publishTime) {
+			continue
+		}
+
 		blockTime := ctx.BlockTime().Unix()
 
 		if pythPriceState == nil {
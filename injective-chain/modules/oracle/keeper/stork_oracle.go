@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"sort"
+	"time"
 
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -189,6 +190,16 @@ func (k *Keeper) ProcessStorkAssetPairsData(ctx sdk.Context, assetPairs []*types
 			continue
 		}
 
+		var lastPrice *math.LegacyDec
+		if storkPriceState != nil {
+			lastPrice = &storkPriceState.PriceState.Price
+		}
+
+		publishTime := int64(latestTimestamp / uint64(time.Second))
+		if !k.checkCircuitBreaker(ctx, types.OracleType_Stork, pair.AssetId, "", lastPrice, price, publishTime) {
+			continue
+		}
+
 		blockTime := ctx.BlockTime().Unix()
 
 		if storkPriceState == nil {
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// GetOracleTWAP returns the time-weighted average price of the base/quote pair between startTime and endTime, using
+// the historical price records. An endTime of 0 means the current block time. The window must lie within the
+// retained price history, i.e. not start more than types.MaxHistoricalPriceRecordAge seconds before the block time.
+//
+// The price of a record is used until the next record, so gaps without price updates are filled with the last known
+// price. The price at the start of the window is the one of the last record at or before it, and if there is no such
+// record the TWAP can't be computed. If maxGap is positive, the TWAP is rejected when a price was used for longer than
+// maxGap seconds without being updated.
+func (k *Keeper) GetOracleTWAP(
+	ctx sdk.Context,
+	base, quote *types.OracleInfo,
+	startTime, endTime, maxGap int64,
+) (*types.PriceTWAP, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	if base == nil {
+		return nil, errors.Wrap(types.ErrInvalidOracleRequest, "base oracle info should not be empty")
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if endTime == 0 {
+		endTime = blockTime
+	}
+
+	if startTime >= endTime || endTime > blockTime {
+		return nil, errors.Wrapf(types.ErrInvalidTWAPWindow, "window [%d, %d] must be non-empty and end at or before %d", startTime, endTime, blockTime)
+	}
+
+	if startTime < blockTime-types.MaxHistoricalPriceRecordAge {
+		return nil, errors.Wrapf(types.ErrInvalidTWAPWindow, "price records are only kept for %d seconds", types.MaxHistoricalPriceRecordAge)
+	}
+
+	var priceRecords *types.PriceRecords
+	if quote == nil || quote.Symbol == "" || quote.Symbol == types.QuoteUSD {
+		priceRecords, _ = k.GetHistoricalPriceRecords(ctx, base.OracleType, base.Symbol, 0)
+		if len(priceRecords.LatestPriceRecords) == 0 {
+			return nil, errors.Wrapf(types.ErrInsufficientPriceHistory, "no price records found for %s", base.Symbol)
+		}
+	} else {
+		var ok bool
+		priceRecords, ok = k.GetMixedHistoricalPriceRecords(ctx, base.OracleType, quote.OracleType, base.Symbol, quote.Symbol, 0)
+		if !ok {
+			return nil, errors.Wrapf(types.ErrInsufficientPriceHistory, "no overlapping price records found for %s/%s", base.Symbol, quote.Symbol)
+		}
+	}
+
+	twap, err := CalculateTWAP(priceRecords.LatestPriceRecords, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxGap > 0 && twap.LargestGap > maxGap {
+		return nil, errors.Wrapf(types.ErrPriceGapTooLarge, "price was not updated for %d seconds, max gap is %d", twap.LargestGap, maxGap)
+	}
+
+	return twap, nil
+}
+
+// CalculateTWAP returns the time-weighted average price between startTime and endTime over the given price records,
+// which must be sorted by timestamp. Each record's price is weighted by the time until the next record or endTime.
+func CalculateTWAP(priceRecords []*types.PriceRecord, startTime, endTime int64) (*types.PriceTWAP, error) {
+	// find the last record at or before the window start, which defines the price at the start of the window
+	startIdx := -1
+	for idx, r := range priceRecords {
+		if r.Timestamp > startTime {
+			break
+		}
+		startIdx = idx
+	}
+
+	if startIdx < 0 {
+		return nil, errors.Wrapf(types.ErrInsufficientPriceHistory, "no price record at or before %d", startTime)
+	}
+
+	twap := &types.PriceTWAP{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+
+	weightedSum := math.LegacyZeroDec()
+	for idx := startIdx; idx < len(priceRecords) && priceRecords[idx].Timestamp < endTime; idx++ {
+		r := priceRecords[idx]
+
+		// the price is used until the next record, or the end of the window for the last one
+		until := endTime
+		if idx+1 < len(priceRecords) && priceRecords[idx+1].Timestamp < endTime {
+			until = priceRecords[idx+1].Timestamp
+		}
+
+		if gap := until - r.Timestamp; gap > twap.LargestGap {
+			twap.LargestGap = gap
+		}
+
+		from := r.Timestamp
+		if from < startTime {
+			from = startTime
+		}
+
+		// twapSum += p * ∆t
+		weightedSum = weightedSum.Add(r.Price.MulInt64(until - from))
+		twap.RecordsCount++
+	}
+
+	twap.Twap = weightedSum.QuoInt64(endTime - startTime)
+
+	return twap, nil
+}
@@ -79,6 +79,18 @@ func (k *Keeper) GetPriceState(ctx sdk.Context, key string, oracletype types.Ora
 			return nil
 		}
 		return &priceState.PriceState
+	case types.OracleType_Composite:
+		priceState := k.GetCompositePriceState(ctx, key)
+		if priceState == nil {
+			return nil
+		}
+		return &priceState.PriceState
+	case types.OracleType_ChainlinkDataStreams:
+		priceState := k.GetChainlinkDataStreamsPriceState(ctx, common.HexToHash(key))
+		if priceState == nil {
+			return nil
+		}
+		return &priceState.PriceState
 	}
 
 	return nil
@@ -50,6 +50,10 @@ func (k *Keeper) GetPrice(ctx sdk.Context, oracletype types.OracleType, base, qu
 		return nil
 	case types.OracleType_Stork:
 		return k.GetStorkPrice(ctx, base, quote)
+	case types.OracleType_Composite:
+		return k.GetCompositePrice(ctx, base, quote)
+	case types.OracleType_ChainlinkDataStreams:
+		return k.GetChainlinkDataStreamsPrice(ctx, base, quote)
 	}
 
 	return nil
@@ -204,6 +208,20 @@ func (k *Keeper) getPriceStatesForOracle(
 			}
 			return nil
 		}
+	case types.OracleType_Composite:
+		priceStateGetter = func(symbol string) *types.PriceState {
+			if state := k.GetCompositePriceState(ctx, symbol); state != nil {
+				return &state.PriceState
+			}
+			return nil
+		}
+	case types.OracleType_ChainlinkDataStreams:
+		priceStateGetter = func(symbol string) *types.PriceState {
+			if state := k.GetChainlinkDataStreamsPriceState(ctx, common.HexToHash(symbol)); state != nil {
+				return &state.PriceState
+			}
+			return nil
+		}
 	default:
 		return nil, nil
 	}
@@ -159,7 +159,7 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
-	EndBlocker(sdk.UnwrapSDKContext(ctx))
+	am.blockHandler.EndBlocker(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
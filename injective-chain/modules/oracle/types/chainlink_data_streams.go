@@ -0,0 +1,233 @@
+package types
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// MaxChainlinkDONSigners is the maximum number of signers of a DON, as enforced by the Chainlink verifier contract.
+	MaxChainlinkDONSigners = 31
+
+	// ChainlinkReportSchemaV3 is the schema version of crypto price reports, encoded in the first two bytes of the
+	// feed id.
+	ChainlinkReportSchemaV3 = 3
+
+	// chainlinkPriceDecimals is the number of decimals of the prices in v3 reports
+	chainlinkPriceDecimals = 18
+)
+
+var (
+	chainlinkFullReportArgs = abi.Arguments{
+		{Name: "reportContext", Type: mustNewABIType("bytes32[3]")},
+		{Name: "reportBlob", Type: mustNewABIType("bytes")},
+		{Name: "rawRs", Type: mustNewABIType("bytes32[]")},
+		{Name: "rawSs", Type: mustNewABIType("bytes32[]")},
+		{Name: "rawVs", Type: mustNewABIType("bytes32")},
+	}
+
+	chainlinkReportV3Args = abi.Arguments{
+		{Name: "feedId", Type: mustNewABIType("bytes32")},
+		{Name: "validFromTimestamp", Type: mustNewABIType("uint32")},
+		{Name: "observationsTimestamp", Type: mustNewABIType("uint32")},
+		{Name: "nativeFee", Type: mustNewABIType("uint192")},
+		{Name: "linkFee", Type: mustNewABIType("uint192")},
+		{Name: "expiresAt", Type: mustNewABIType("uint32")},
+		{Name: "benchmarkPrice", Type: mustNewABIType("int192")},
+		{Name: "bid", Type: mustNewABIType("int192")},
+		{Name: "ask", Type: mustNewABIType("int192")},
+	}
+)
+
+func mustNewABIType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// ChainlinkFullReport is a signed Data Streams report, as returned by the Data Streams API.
+type ChainlinkFullReport struct {
+	// ReportContext holds the config digest, the epoch and round and extra hash
+	ReportContext [3][32]byte
+	ReportData    []byte
+	Rs            [][32]byte
+	Ss            [][32]byte
+	Vs            [32]byte
+}
+
+// ChainlinkReportV3 is the report data of crypto price feeds.
+type ChainlinkReportV3 struct {
+	FeedID                common.Hash
+	ValidFromTimestamp    uint32
+	ObservationsTimestamp uint32
+	ExpiresAt             uint32
+	BenchmarkPrice        math.LegacyDec
+	Bid                   math.LegacyDec
+	Ask                   math.LegacyDec
+}
+
+func DecodeChainlinkFullReport(bz []byte) (*ChainlinkFullReport, error) {
+	values, err := chainlinkFullReportArgs.Unpack(bz)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidChainlinkReport, "failed to decode report: %s", err.Error())
+	}
+
+	report := &ChainlinkFullReport{
+		ReportContext: values[0].([3][32]byte),
+		ReportData:    values[1].([]byte),
+		Rs:            values[2].([][32]byte),
+		Ss:            values[3].([][32]byte),
+		Vs:            values[4].([32]byte),
+	}
+
+	if len(report.Rs) != len(report.Ss) {
+		return nil, errors.Wrap(ErrInvalidChainlinkReport, "mismatched number of signature components")
+	}
+
+	if len(report.Rs) > MaxChainlinkDONSigners {
+		return nil, errors.Wrapf(ErrInvalidChainlinkReport, "too many signatures: %d", len(report.Rs))
+	}
+
+	return report, nil
+}
+
+func (r *ChainlinkFullReport) ConfigDigest() common.Hash {
+	return r.ReportContext[0]
+}
+
+// SigningHash returns the hash signed by the DON signers, keccak256(keccak256(reportData) || reportContext).
+func (r *ChainlinkFullReport) SigningHash() common.Hash {
+	buf := make([]byte, 0, common.HashLength*4)
+	buf = append(buf, crypto.Keccak256(r.ReportData)...)
+	for i := range r.ReportContext {
+		buf = append(buf, r.ReportContext[i][:]...)
+	}
+
+	return crypto.Keccak256Hash(buf)
+}
+
+// RecoverSigners returns the addresses that signed the report.
+func (r *ChainlinkFullReport) RecoverSigners() ([]common.Address, error) {
+	hash := r.SigningHash()
+	signers := make([]common.Address, 0, len(r.Rs))
+
+	for i := range r.Rs {
+		v := r.Vs[i]
+		if !crypto.ValidateSignatureValues(v, new(big.Int).SetBytes(r.Rs[i][:]), new(big.Int).SetBytes(r.Ss[i][:]), true) {
+			return nil, errors.Wrapf(ErrInvalidChainlinkReport, "invalid signature %d", i)
+		}
+
+		sig := make([]byte, 0, crypto.SignatureLength)
+		sig = append(sig, r.Rs[i][:]...)
+		sig = append(sig, r.Ss[i][:]...)
+		sig = append(sig, v)
+
+		pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidChainlinkReport, "failed to recover signer %d: %s", i, err.Error())
+		}
+
+		signers = append(signers, crypto.PubkeyToAddress(*pubKey))
+	}
+
+	return signers, nil
+}
+
+// GetChainlinkReportSchema returns the report schema version encoded in the feed id.
+func GetChainlinkReportSchema(feedID common.Hash) uint16 {
+	return uint16(feedID[0])<<8 | uint16(feedID[1])
+}
+
+func DecodeChainlinkReportV3(bz []byte) (*ChainlinkReportV3, error) {
+	values, err := chainlinkReportV3Args.Unpack(bz)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidChainlinkReport, "failed to decode report data: %s", err.Error())
+	}
+
+	feedID := common.Hash(values[0].([32]byte))
+	if schema := GetChainlinkReportSchema(feedID); schema != ChainlinkReportSchemaV3 {
+		return nil, errors.Wrapf(ErrInvalidChainlinkReport, "unsupported report schema %d for feed %s", schema, feedID.Hex())
+	}
+
+	report := &ChainlinkReportV3{
+		FeedID:                feedID,
+		ValidFromTimestamp:    values[1].(uint32),
+		ObservationsTimestamp: values[2].(uint32),
+		ExpiresAt:             values[5].(uint32),
+		BenchmarkPrice:        math.LegacyNewDecFromBigIntWithPrec(values[6].(*big.Int), chainlinkPriceDecimals),
+		Bid:                   math.LegacyNewDecFromBigIntWithPrec(values[7].(*big.Int), chainlinkPriceDecimals),
+		Ask:                   math.LegacyNewDecFromBigIntWithPrec(values[8].(*big.Int), chainlinkPriceDecimals),
+	}
+
+	if !report.BenchmarkPrice.IsPositive() {
+		return nil, errors.Wrapf(ErrInvalidChainlinkReport, "non-positive price for feed %s", feedID.Hex())
+	}
+
+	if report.BenchmarkPrice.GT(LargestDecPrice) {
+		return nil, errors.Wrapf(ErrPriceTooLarge, "feed %s", feedID.Hex())
+	}
+
+	return report, nil
+}
+
+func (d *ChainlinkDataStreamsDON) Validate() error {
+	if len(common.FromHex(d.ConfigDigest)) != common.HashLength {
+		return errors.Wrapf(ErrInvalidChainlinkDON, "invalid config digest %s", d.ConfigDigest)
+	}
+
+	if d.F == 0 {
+		return errors.Wrap(ErrInvalidChainlinkDON, "f must be positive")
+	}
+
+	// the Chainlink verifier requires more than 3f signers to tolerate f faulty ones
+	if len(d.Signers) <= 3*int(d.F) || len(d.Signers) > MaxChainlinkDONSigners {
+		return errors.Wrapf(ErrInvalidChainlinkDON, "number of signers must be between 3f+1 (%d) and %d", 3*d.F+1, MaxChainlinkDONSigners)
+	}
+
+	signers := make(map[common.Address]struct{}, len(d.Signers))
+	for _, signer := range d.Signers {
+		if !common.IsHexAddress(signer) {
+			return errors.Wrapf(ErrInvalidChainlinkDON, "invalid signer address %s", signer)
+		}
+
+		address := common.HexToAddress(signer)
+		if address == (common.Address{}) {
+			return errors.Wrap(ErrInvalidChainlinkDON, "signer address should not be empty")
+		}
+
+		if _, ok := signers[address]; ok {
+			return errors.Wrapf(ErrInvalidChainlinkDON, "duplicate signer %s", signer)
+		}
+		signers[address] = struct{}{}
+	}
+
+	return nil
+}
+
+func NewChainlinkDataStreamsPriceState(report *ChainlinkReportV3, blockTime int64) *ChainlinkDataStreamsPriceState {
+	return &ChainlinkDataStreamsPriceState{
+		FeedId:                report.FeedID.Hex(),
+		ObservationsTimestamp: uint64(report.ObservationsTimestamp),
+		ExpiresAt:             uint64(report.ExpiresAt),
+		BenchmarkPrice:        report.BenchmarkPrice,
+		Bid:                   report.Bid,
+		Ask:                   report.Ask,
+		PriceState:            *NewPriceState(report.BenchmarkPrice, blockTime),
+	}
+}
+
+func (s *ChainlinkDataStreamsPriceState) Update(report *ChainlinkReportV3, blockTime int64) {
+	s.ObservationsTimestamp = uint64(report.ObservationsTimestamp)
+	s.ExpiresAt = uint64(report.ExpiresAt)
+	s.BenchmarkPrice = report.BenchmarkPrice
+	s.Bid = report.Bid
+	s.Ask = report.Ask
+	s.PriceState.UpdatePrice(report.BenchmarkPrice, blockTime)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"math"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (b *CircuitBreaker) Validate() error {
+	switch b.OracleType {
+	case OracleType_Pyth, OracleType_ChainlinkDataStreams:
+		if len(common.FromHex(b.Base)) != common.HashLength {
+			return errors.Wrapf(ErrInvalidCircuitBreaker, "invalid %s price id %s", b.OracleType.String(), b.Base)
+		}
+
+		if b.Quote != "" {
+			return errors.Wrapf(ErrInvalidCircuitBreaker, "quote must be empty for %s circuit breakers", b.OracleType.String())
+		}
+	case OracleType_Stork:
+		if b.Base == "" {
+			return errors.Wrap(ErrInvalidCircuitBreaker, "asset id should not be empty")
+		}
+
+		if b.Quote != "" {
+			return errors.Wrap(ErrInvalidCircuitBreaker, "quote must be empty for Stork circuit breakers")
+		}
+	case OracleType_PriceFeed, OracleType_Provider:
+		if b.Base == "" || b.Quote == "" {
+			return errors.Wrap(ErrInvalidCircuitBreaker, "base and quote should not be empty")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidCircuitBreaker, "unsupported oracle type %s", b.OracleType.String())
+	}
+
+	// the base is length prefixed in the store keys
+	if len(b.Base) > math.MaxUint16 {
+		return errors.Wrap(ErrInvalidCircuitBreaker, "base is too long")
+	}
+
+	if _, ok := CircuitBreakerAction_name[int32(b.Action)]; !ok {
+		return errors.Wrapf(ErrInvalidCircuitBreaker, "unknown action %d", b.Action)
+	}
+
+	hasMaxDeviation := !b.MaxDeviation.IsNil() && !b.MaxDeviation.IsZero()
+	if hasMaxDeviation && b.MaxDeviation.IsNegative() {
+		return errors.Wrap(ErrInvalidCircuitBreaker, "max deviation must be non-negative")
+	}
+
+	if b.MaxStaleness < 0 {
+		return errors.Wrap(ErrInvalidCircuitBreaker, "max staleness must be non-negative")
+	}
+
+	if !hasMaxDeviation && b.MaxStaleness == 0 {
+		return errors.Wrap(ErrInvalidCircuitBreaker, "either max deviation or max staleness must be set")
+	}
+
+	if b.RecoveryPrints > 0 && (b.Action != CircuitBreakerAction_RejectPrice || !hasMaxDeviation) {
+		return errors.Wrap(ErrInvalidCircuitBreaker, "recovery prints only apply to RejectPrice circuit breakers with a max deviation")
+	}
+
+	return nil
+}
@@ -23,6 +23,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelayPythPrices{}, "oracle/MsgRelayPythPrices", nil)
 	cdc.RegisterConcrete(&MsgRelayStorkPrices{}, "oracle/MsgRelayStorkPrices", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "oracle/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetCompositeOracle{}, "oracle/MsgSetCompositeOracle", nil)
+	cdc.RegisterConcrete(&MsgRemoveCompositeOracle{}, "oracle/MsgRemoveCompositeOracle", nil)
+	cdc.RegisterConcrete(&MsgSetCircuitBreaker{}, "oracle/MsgSetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgRemoveCircuitBreaker{}, "oracle/MsgRemoveCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgRelayChainlinkPrices{}, "oracle/MsgRelayChainlinkPrices", nil)
+	cdc.RegisterConcrete(&MsgSetChainlinkDataStreamsDON{}, "oracle/MsgSetChainlinkDataStreamsDON", nil)
+	cdc.RegisterConcrete(&MsgRemoveChainlinkDataStreamsDON{}, "oracle/MsgRemoveChainlinkDataStreamsDON", nil)
 
 	cdc.RegisterConcrete(&GrantBandOraclePrivilegeProposal{}, "oracle/GrantBandOraclePrivilegeProposal", nil)
 	cdc.RegisterConcrete(&RevokeBandOraclePrivilegeProposal{}, "oracle/RevokeBandOraclePrivilegeProposal", nil)
@@ -48,6 +55,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRelayPythPrices{},
 		&MsgRelayStorkPrices{},
 		&MsgUpdateParams{},
+		&MsgSetCompositeOracle{},
+		&MsgRemoveCompositeOracle{},
+		&MsgSetCircuitBreaker{},
+		&MsgRemoveCircuitBreaker{},
+		&MsgRelayChainlinkPrices{},
+		&MsgSetChainlinkDataStreamsDON{},
+		&MsgRemoveChainlinkDataStreamsDON{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
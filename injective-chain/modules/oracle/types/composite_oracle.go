@@ -0,0 +1,73 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// MaxCompositeOracleSources is the maximum number of sources a composite oracle can aggregate.
+const MaxCompositeOracleSources = 10
+
+func (o *CompositeOracle) Validate() error {
+	if o.Symbol == "" {
+		return errors.Wrap(ErrInvalidCompositeOracle, "symbol should not be empty")
+	}
+
+	if _, ok := CompositeAggregation_name[int32(o.Aggregation)]; !ok {
+		return errors.Wrapf(ErrInvalidCompositeOracle, "unknown aggregation %d", o.Aggregation)
+	}
+
+	if len(o.Sources) == 0 || len(o.Sources) > MaxCompositeOracleSources {
+		return errors.Wrapf(ErrInvalidCompositeOracle, "number of sources must be between 1 and %d", MaxCompositeOracleSources)
+	}
+
+	if o.MinQuorum == 0 || int(o.MinQuorum) > len(o.Sources) {
+		return errors.Wrapf(ErrInvalidCompositeOracle, "min quorum must be between 1 and the number of sources (%d)", len(o.Sources))
+	}
+
+	if !o.MaxDeviation.IsNil() && o.MaxDeviation.IsNegative() {
+		return errors.Wrap(ErrInvalidCompositeOracle, "max deviation must be non-negative")
+	}
+
+	seen := make(map[string]struct{}, len(o.Sources))
+	for i := range o.Sources {
+		source := &o.Sources[i]
+		if err := source.Validate(o.Aggregation); err != nil {
+			return err
+		}
+
+		key := source.OracleType.String() + "/" + source.Base + "/" + source.Quote
+		if _, ok := seen[key]; ok {
+			return errors.Wrapf(ErrInvalidCompositeOracle, "duplicate source %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s *CompositeOracleSource) Validate(aggregation CompositeAggregation) error {
+	switch s.OracleType {
+	case OracleType_Band, OracleType_PriceFeed, OracleType_Coinbase, OracleType_Chainlink, OracleType_Pyth,
+		OracleType_BandIBC, OracleType_Provider, OracleType_Stork, OracleType_ChainlinkDataStreams:
+	default:
+		return errors.Wrapf(ErrInvalidCompositeOracle, "unsupported source oracle type %s", s.OracleType.String())
+	}
+
+	if s.Base == "" || s.Quote == "" {
+		return errors.Wrap(ErrInvalidCompositeOracle, "source base and quote should not be empty")
+	}
+
+	if !s.Weight.IsNil() && s.Weight.IsNegative() {
+		return errors.Wrap(ErrInvalidCompositeOracle, "source weight must be non-negative")
+	}
+
+	if aggregation == CompositeAggregation_WeightedMean && (s.Weight.IsNil() || !s.Weight.IsPositive()) {
+		return errors.Wrap(ErrInvalidCompositeOracle, "source weight must be positive for weighted mean aggregation")
+	}
+
+	if s.MaxStaleness < 0 {
+		return errors.Wrap(ErrInvalidCompositeOracle, "source max staleness must be non-negative")
+	}
+
+	return nil
+}
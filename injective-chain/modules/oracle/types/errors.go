@@ -48,4 +48,15 @@ var (
 	ErrEmptyStorkSender            = errors.Register(ModuleName, 41, "sender stork is empty")
 	ErrInvalidStorkSignature       = errors.Register(ModuleName, 42, "invalid stork signature")
 	ErrStorkAssetIdNotUnique       = errors.Register(ModuleName, 43, "stork asset id not unique")
+	ErrInvalidCompositeOracle      = errors.Register(ModuleName, 44, "invalid composite oracle")
+	ErrCompositeOracleNotFound     = errors.Register(ModuleName, 45, "composite oracle not found")
+	ErrCompositeQuorumNotMet       = errors.Register(ModuleName, 46, "composite oracle quorum not met")
+	ErrInvalidCircuitBreaker       = errors.Register(ModuleName, 47, "invalid circuit breaker")
+	ErrCircuitBreakerNotFound      = errors.Register(ModuleName, 48, "circuit breaker not found")
+	ErrInvalidTWAPWindow           = errors.Register(ModuleName, 49, "invalid twap window")
+	ErrInsufficientPriceHistory    = errors.Register(ModuleName, 50, "insufficient price history")
+	ErrPriceGapTooLarge            = errors.Register(ModuleName, 51, "price gap too large")
+	ErrInvalidChainlinkReport      = errors.Register(ModuleName, 52, "invalid chainlink report")
+	ErrInvalidChainlinkDON         = errors.Register(ModuleName, 53, "invalid chainlink data streams DON")
+	ErrChainlinkDONNotFound        = errors.Register(ModuleName, 54, "chainlink data streams DON not found")
 )
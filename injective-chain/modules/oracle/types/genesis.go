@@ -1,5 +1,10 @@
 package types
 
+import (
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -12,6 +17,47 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	symbols := make(map[string]struct{}, len(gs.CompositeOracles))
+	for i := range gs.CompositeOracles {
+		if err := gs.CompositeOracles[i].Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := symbols[gs.CompositeOracles[i].Symbol]; ok {
+			return errors.Wrapf(ErrInvalidCompositeOracle, "duplicate composite oracle %s", gs.CompositeOracles[i].Symbol)
+		}
+		symbols[gs.CompositeOracles[i].Symbol] = struct{}{}
+	}
+
+	circuitBreakers := make(map[string]struct{}, len(gs.CircuitBreakers))
+	for i := range gs.CircuitBreakers {
+		circuitBreaker := &gs.CircuitBreakers[i]
+		if err := circuitBreaker.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetCircuitBreakerKey(circuitBreaker.OracleType, circuitBreaker.Base, circuitBreaker.Quote))
+		if _, ok := circuitBreakers[key]; ok {
+			return errors.Wrapf(ErrInvalidCircuitBreaker, "duplicate circuit breaker %s %s %s", circuitBreaker.OracleType.String(), circuitBreaker.Base, circuitBreaker.Quote)
+		}
+		circuitBreakers[key] = struct{}{}
+	}
+
+	configDigests := make(map[common.Hash]struct{}, len(gs.ChainlinkDataStreamsDons))
+	for i := range gs.ChainlinkDataStreamsDons {
+		don := &gs.ChainlinkDataStreamsDons[i]
+		if err := don.Validate(); err != nil {
+			return err
+		}
+
+		configDigest := common.HexToHash(don.ConfigDigest)
+		if _, ok := configDigests[configDigest]; ok {
+			return errors.Wrapf(ErrInvalidChainlinkDON, "duplicate config digest %s", don.ConfigDigest)
+		}
+		configDigests[configDigest] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -26,23 +26,29 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the oracle module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to oracle.
-	Params                 Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	BandRelayers           []string               `protobuf:"bytes,2,rep,name=band_relayers,json=bandRelayers,proto3" json:"band_relayers,omitempty"`
-	BandPriceStates        []*BandPriceState      `protobuf:"bytes,3,rep,name=band_price_states,json=bandPriceStates,proto3" json:"band_price_states,omitempty"`
-	PriceFeedPriceStates   []*PriceFeedState      `protobuf:"bytes,4,rep,name=price_feed_price_states,json=priceFeedPriceStates,proto3" json:"price_feed_price_states,omitempty"`
-	CoinbasePriceStates    []*CoinbasePriceState  `protobuf:"bytes,5,rep,name=coinbase_price_states,json=coinbasePriceStates,proto3" json:"coinbase_price_states,omitempty"`
-	BandIbcPriceStates     []*BandPriceState      `protobuf:"bytes,6,rep,name=band_ibc_price_states,json=bandIbcPriceStates,proto3" json:"band_ibc_price_states,omitempty"`
-	BandIbcOracleRequests  []*BandOracleRequest   `protobuf:"bytes,7,rep,name=band_ibc_oracle_requests,json=bandIbcOracleRequests,proto3" json:"band_ibc_oracle_requests,omitempty"`
-	BandIbcParams          BandIBCParams          `protobuf:"bytes,8,opt,name=band_ibc_params,json=bandIbcParams,proto3" json:"band_ibc_params"`
-	BandIbcLatestClientId  uint64                 `protobuf:"varint,9,opt,name=band_ibc_latest_client_id,json=bandIbcLatestClientId,proto3" json:"band_ibc_latest_client_id,omitempty"`
-	CalldataRecords        []*CalldataRecord      `protobuf:"bytes,10,rep,name=calldata_records,json=calldataRecords,proto3" json:"calldata_records,omitempty"`
-	BandIbcLatestRequestId uint64                 `protobuf:"varint,11,opt,name=band_ibc_latest_request_id,json=bandIbcLatestRequestId,proto3" json:"band_ibc_latest_request_id,omitempty"`
-	ChainlinkPriceStates   []*ChainlinkPriceState `protobuf:"bytes,12,rep,name=chainlink_price_states,json=chainlinkPriceStates,proto3" json:"chainlink_price_states,omitempty"`
-	HistoricalPriceRecords []*PriceRecords        `protobuf:"bytes,13,rep,name=historical_price_records,json=historicalPriceRecords,proto3" json:"historical_price_records,omitempty"`
-	ProviderStates         []*ProviderState       `protobuf:"bytes,14,rep,name=provider_states,json=providerStates,proto3" json:"provider_states,omitempty"`
-	PythPriceStates        []*PythPriceState      `protobuf:"bytes,15,rep,name=pyth_price_states,json=pythPriceStates,proto3" json:"pyth_price_states,omitempty"`
-	StorkPriceStates       []*StorkPriceState     `protobuf:"bytes,16,rep,name=stork_price_states,json=storkPriceStates,proto3" json:"stork_price_states,omitempty"`
-	StorkPublishers        []string               `protobuf:"bytes,17,rep,name=stork_publishers,json=storkPublishers,proto3" json:"stork_publishers,omitempty"`
+	Params                          Params                            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BandRelayers                    []string                          `protobuf:"bytes,2,rep,name=band_relayers,json=bandRelayers,proto3" json:"band_relayers,omitempty"`
+	BandPriceStates                 []*BandPriceState                 `protobuf:"bytes,3,rep,name=band_price_states,json=bandPriceStates,proto3" json:"band_price_states,omitempty"`
+	PriceFeedPriceStates            []*PriceFeedState                 `protobuf:"bytes,4,rep,name=price_feed_price_states,json=priceFeedPriceStates,proto3" json:"price_feed_price_states,omitempty"`
+	CoinbasePriceStates             []*CoinbasePriceState             `protobuf:"bytes,5,rep,name=coinbase_price_states,json=coinbasePriceStates,proto3" json:"coinbase_price_states,omitempty"`
+	BandIbcPriceStates              []*BandPriceState                 `protobuf:"bytes,6,rep,name=band_ibc_price_states,json=bandIbcPriceStates,proto3" json:"band_ibc_price_states,omitempty"`
+	BandIbcOracleRequests           []*BandOracleRequest              `protobuf:"bytes,7,rep,name=band_ibc_oracle_requests,json=bandIbcOracleRequests,proto3" json:"band_ibc_oracle_requests,omitempty"`
+	BandIbcParams                   BandIBCParams                     `protobuf:"bytes,8,opt,name=band_ibc_params,json=bandIbcParams,proto3" json:"band_ibc_params"`
+	BandIbcLatestClientId           uint64                            `protobuf:"varint,9,opt,name=band_ibc_latest_client_id,json=bandIbcLatestClientId,proto3" json:"band_ibc_latest_client_id,omitempty"`
+	CalldataRecords                 []*CalldataRecord                 `protobuf:"bytes,10,rep,name=calldata_records,json=calldataRecords,proto3" json:"calldata_records,omitempty"`
+	BandIbcLatestRequestId          uint64                            `protobuf:"varint,11,opt,name=band_ibc_latest_request_id,json=bandIbcLatestRequestId,proto3" json:"band_ibc_latest_request_id,omitempty"`
+	ChainlinkPriceStates            []*ChainlinkPriceState            `protobuf:"bytes,12,rep,name=chainlink_price_states,json=chainlinkPriceStates,proto3" json:"chainlink_price_states,omitempty"`
+	HistoricalPriceRecords          []*PriceRecords                   `protobuf:"bytes,13,rep,name=historical_price_records,json=historicalPriceRecords,proto3" json:"historical_price_records,omitempty"`
+	ProviderStates                  []*ProviderState                  `protobuf:"bytes,14,rep,name=provider_states,json=providerStates,proto3" json:"provider_states,omitempty"`
+	PythPriceStates                 []*PythPriceState                 `protobuf:"bytes,15,rep,name=pyth_price_states,json=pythPriceStates,proto3" json:"pyth_price_states,omitempty"`
+	StorkPriceStates                []*StorkPriceState                `protobuf:"bytes,16,rep,name=stork_price_states,json=storkPriceStates,proto3" json:"stork_price_states,omitempty"`
+	StorkPublishers                 []string                          `protobuf:"bytes,17,rep,name=stork_publishers,json=storkPublishers,proto3" json:"stork_publishers,omitempty"`
+	CompositeOracles                []CompositeOracle                 `protobuf:"bytes,18,rep,name=composite_oracles,json=compositeOracles,proto3" json:"composite_oracles"`
+	CompositePriceStates            []*CompositePriceState            `protobuf:"bytes,19,rep,name=composite_price_states,json=compositePriceStates,proto3" json:"composite_price_states,omitempty"`
+	CircuitBreakers                 []CircuitBreaker                  `protobuf:"bytes,20,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	CircuitBreakerHalts             []CircuitBreakerHalt              `protobuf:"bytes,21,rep,name=circuit_breaker_halts,json=circuitBreakerHalts,proto3" json:"circuit_breaker_halts"`
+	ChainlinkDataStreamsDons        []ChainlinkDataStreamsDON         `protobuf:"bytes,22,rep,name=chainlink_data_streams_dons,json=chainlinkDataStreamsDons,proto3" json:"chainlink_data_streams_dons"`
+	ChainlinkDataStreamsPriceStates []*ChainlinkDataStreamsPriceState `protobuf:"bytes,23,rep,name=chainlink_data_streams_price_states,json=chainlinkDataStreamsPriceStates,proto3" json:"chainlink_data_streams_price_states,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -197,6 +203,48 @@ func (m *GenesisState) GetStorkPublishers() []string {
 	return nil
 }
 
+func (m *GenesisState) GetCompositeOracles() []CompositeOracle {
+	if m != nil {
+		return m.CompositeOracles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompositePriceStates() []*CompositePriceState {
+	if m != nil {
+		return m.CompositePriceStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerHalts() []CircuitBreakerHalt {
+	if m != nil {
+		return m.CircuitBreakerHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainlinkDataStreamsDons() []ChainlinkDataStreamsDON {
+	if m != nil {
+		return m.ChainlinkDataStreamsDons
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainlinkDataStreamsPriceStates() []*ChainlinkDataStreamsPriceState {
+	if m != nil {
+		return m.ChainlinkDataStreamsPriceStates
+	}
+	return nil
+}
+
 type CalldataRecord struct {
 	ClientId uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
@@ -259,50 +307,60 @@ func init() {
 }
 
 var fileDescriptor_f7e14cf80151b4d2 = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0xbb, 0x80, 0x08, 0x43, 0xa1, 0x65, 0x04, 0x5c, 0x6b, 0x52, 0x1b, 0x8c, 0x50, 0xa2,
-	0x74, 0x03, 0x5e, 0x8c, 0x07, 0x0f, 0x6d, 0xa2, 0x69, 0x42, 0x22, 0x59, 0x34, 0x26, 0x7a, 0x58,
-	0x67, 0x67, 0x87, 0x76, 0x74, 0xd9, 0x5d, 0x67, 0xa6, 0x24, 0xfd, 0x2f, 0xfc, 0xb3, 0x38, 0x72,
-	0xf4, 0x64, 0x0c, 0xf8, 0x87, 0x98, 0xf9, 0xb1, 0xcb, 0x0e, 0xa4, 0x6d, 0xbc, 0xed, 0x7b, 0xfb,
-	0xde, 0xe7, 0x7d, 0xdf, 0xdb, 0xb7, 0x33, 0x60, 0x87, 0x26, 0xdf, 0x08, 0x16, 0xf4, 0x9c, 0x78,
-	0x29, 0x43, 0x38, 0x26, 0xde, 0xf9, 0x41, 0x48, 0x04, 0x3a, 0xf0, 0x06, 0x24, 0x21, 0x9c, 0xf2,
-	0x4e, 0xc6, 0x52, 0x91, 0x42, 0xb7, 0x88, 0xeb, 0xe8, 0xb8, 0x8e, 0x89, 0x6b, 0x3c, 0x9b, 0x48,
-	0x30, 0x81, 0x0a, 0xd0, 0xd8, 0x18, 0xa4, 0x83, 0x54, 0x3d, 0x7a, 0xf2, 0x49, 0x7b, 0xb7, 0xff,
-	0x02, 0x50, 0x7d, 0xa7, 0x0b, 0x9d, 0x08, 0x24, 0x08, 0x7c, 0x03, 0x16, 0x33, 0xc4, 0xd0, 0x19,
-	0x77, 0x9d, 0x96, 0xd3, 0x5e, 0x39, 0x6c, 0x75, 0x26, 0x15, 0xee, 0x1c, 0xab, 0xb8, 0xee, 0xc2,
-	0xc5, 0xef, 0x27, 0x15, 0xdf, 0x64, 0xc1, 0xa7, 0x60, 0x35, 0x44, 0x49, 0x14, 0x30, 0x12, 0xa3,
-	0x31, 0x61, 0xdc, 0x9d, 0x6b, 0xcd, 0xb7, 0x97, 0xfd, 0xaa, 0x74, 0xfa, 0xc6, 0x07, 0x3f, 0x80,
-	0x75, 0x15, 0x94, 0x31, 0x8a, 0x49, 0xc0, 0x65, 0x61, 0xee, 0xce, 0xb7, 0xe6, 0xdb, 0x2b, 0x87,
-	0xed, 0xc9, 0xf5, 0xba, 0x28, 0x89, 0x8e, 0x65, 0x86, 0x52, 0xea, 0xd7, 0x42, 0xcb, 0xe6, 0x30,
-	0x00, 0x0f, 0x35, 0xf0, 0x94, 0x90, 0x5b, 0xec, 0x85, 0x59, 0x6c, 0xc5, 0x79, 0x4b, 0x48, 0xa4,
-	0xd9, 0x1b, 0x59, 0x6e, 0x97, 0x0b, 0x7c, 0x05, 0x9b, 0x38, 0xa5, 0x49, 0x88, 0x38, 0xb1, 0xf1,
-	0xf7, 0x14, 0xfe, 0xc5, 0x64, 0x7c, 0xcf, 0xa4, 0x95, 0xe4, 0x3f, 0xc0, 0x77, 0x7c, 0x1c, 0x7e,
-	0x01, 0x9b, 0x6a, 0x30, 0x34, 0xc4, 0x76, 0x85, 0xc5, 0xff, 0x1c, 0x0e, 0x94, 0x98, 0x7e, 0x88,
-	0xcb, 0xf0, 0x08, 0xb8, 0x05, 0x5c, 0x67, 0x07, 0x8c, 0xfc, 0x18, 0x11, 0x2e, 0xb8, 0x7b, 0x5f,
-	0xf1, 0x9f, 0x4f, 0xe7, 0xbf, 0x57, 0x2e, 0x5f, 0xe7, 0xf8, 0x9b, 0xa6, 0x84, 0xe5, 0xe5, 0xf0,
-	0x23, 0xa8, 0xdd, 0xb4, 0xa0, 0x37, 0x69, 0x49, 0x6d, 0xd2, 0xee, 0x74, 0x78, 0xbf, 0xdb, 0xb3,
-	0x16, 0x6a, 0x35, 0xef, 0x40, 0xef, 0xd5, 0x2b, 0xf0, 0xa8, 0xc0, 0xc6, 0xb2, 0x1d, 0x11, 0xe0,
-	0x98, 0x92, 0x44, 0x04, 0x34, 0x72, 0x97, 0x5b, 0x4e, 0x7b, 0xa1, 0x10, 0x74, 0xa4, 0x5e, 0xf7,
-	0xd4, 0xdb, 0x7e, 0x04, 0x4f, 0x40, 0x1d, 0xa3, 0x38, 0x8e, 0x90, 0x40, 0x01, 0x23, 0x38, 0x65,
-	0x11, 0x77, 0xc1, 0xac, 0x71, 0xf6, 0x4c, 0x86, 0xaf, 0x12, 0xfc, 0x1a, 0xb6, 0x6c, 0x0e, 0x5f,
-	0x83, 0xc6, 0x6d, 0x39, 0x66, 0x96, 0x52, 0xcf, 0x8a, 0xd2, 0xb3, 0x65, 0xe9, 0x31, 0x03, 0xea,
-	0x47, 0x10, 0x83, 0x2d, 0x3c, 0x44, 0x34, 0x89, 0x69, 0xf2, 0xdd, 0xfe, 0xca, 0x55, 0x25, 0x6b,
-	0x7f, 0x8a, 0xac, 0x3c, 0xaf, 0xf4, 0xa9, 0x37, 0xf0, 0x5d, 0xa7, 0xdc, 0x55, 0x77, 0x48, 0xb9,
-	0x48, 0x19, 0xc5, 0x28, 0x36, 0x55, 0xf2, 0xee, 0x57, 0x55, 0x99, 0x9d, 0x19, 0x7f, 0x83, 0x69,
-	0xd5, 0xdf, 0xba, 0xe1, 0x94, 0xfd, 0xf0, 0x18, 0xd4, 0x32, 0x96, 0x9e, 0xd3, 0x88, 0xb0, 0x5c,
-	0xff, 0x9a, 0x02, 0xef, 0x4e, 0x03, 0xeb, 0x04, 0xad, 0x7c, 0x2d, 0x2b, 0x9b, 0xea, 0x58, 0xc8,
-	0xc6, 0x62, 0x68, 0xcf, 0xa4, 0x36, 0xf3, 0xd7, 0x1d, 0x8b, 0x61, 0xf9, 0x58, 0xc8, 0x2c, 0x9b,
-	0xc3, 0x4f, 0x00, 0x4a, 0xfd, 0xb7, 0x46, 0x5d, 0x57, 0xd8, 0xbd, 0xc9, 0xd8, 0x13, 0x99, 0x53,
-	0xe2, 0xd6, 0xb9, 0xed, 0xe0, 0x70, 0x0f, 0xd4, 0x0d, 0x78, 0x14, 0xc6, 0x94, 0x0f, 0xe5, 0x69,
-	0xb7, 0xae, 0x4e, 0xbb, 0x9a, 0x8e, 0x2d, 0xdc, 0xdb, 0x7d, 0xb0, 0x66, 0x6f, 0x14, 0x7c, 0x0c,
-	0x96, 0x6f, 0xf6, 0xd7, 0x51, 0xfb, 0xb2, 0x84, 0xf3, 0x95, 0x6d, 0x80, 0xa5, 0x7c, 0xe1, 0xdc,
-	0xb9, 0x96, 0xd3, 0xae, 0xfa, 0x85, 0xdd, 0x3d, 0xbd, 0xb8, 0x6a, 0x3a, 0x97, 0x57, 0x4d, 0xe7,
-	0xcf, 0x55, 0xd3, 0xf9, 0x79, 0xdd, 0xac, 0x5c, 0x5e, 0x37, 0x2b, 0xbf, 0xae, 0x9b, 0x95, 0xcf,
-	0x47, 0x03, 0x2a, 0x86, 0xa3, 0xb0, 0x83, 0xd3, 0x33, 0xaf, 0x9f, 0xb7, 0x75, 0x84, 0x42, 0xee,
-	0x15, 0x4d, 0xee, 0xe3, 0x94, 0x91, 0xb2, 0x29, 0x57, 0xc7, 0x3b, 0x4b, 0xa3, 0x51, 0x4c, 0x78,
-	0x7e, 0x7d, 0x88, 0x71, 0x46, 0x78, 0xb8, 0xa8, 0x2e, 0x88, 0x97, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0xf9, 0xcd, 0x82, 0xa1, 0x06, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x63, 0xd7, 0xb5, 0xd7, 0x3f, 0x94, 0xd7, 0x96, 0xc3, 0x3a, 0x80, 0x22, 0x24, 0x68,
+	0xaa, 0xa0, 0x8d, 0x04, 0xa7, 0x97, 0xa0, 0x87, 0x1e, 0xa4, 0xa0, 0xad, 0x00, 0xa3, 0x31, 0xe8,
+	0x16, 0x45, 0x7f, 0x00, 0x76, 0xb9, 0x5c, 0x5b, 0xdb, 0x50, 0x5c, 0x76, 0x67, 0x25, 0x40, 0x2f,
+	0xd0, 0x73, 0x1f, 0x2b, 0xc7, 0x1c, 0x7b, 0x2a, 0x0a, 0xfb, 0xd8, 0x97, 0x28, 0xb8, 0x5c, 0x52,
+	0x5c, 0xa9, 0x12, 0x93, 0x1b, 0x77, 0x76, 0xbe, 0x6f, 0xbe, 0x99, 0x9d, 0x19, 0x10, 0x3d, 0xe1,
+	0xc9, 0x6f, 0x8c, 0x2a, 0x3e, 0x65, 0x3d, 0x21, 0x09, 0x8d, 0x59, 0x6f, 0x7a, 0x1e, 0x32, 0x45,
+	0xce, 0x7b, 0x37, 0x2c, 0x61, 0xc0, 0xa1, 0x9b, 0x4a, 0xa1, 0x04, 0xf6, 0x4a, 0xbf, 0x6e, 0xee,
+	0xd7, 0x35, 0x7e, 0x67, 0x1f, 0xaf, 0x64, 0x30, 0x8e, 0x9a, 0xe0, 0xec, 0xe4, 0x46, 0xdc, 0x08,
+	0xfd, 0xd9, 0xcb, 0xbe, 0x72, 0xeb, 0xa3, 0x7f, 0x5d, 0xb4, 0xff, 0x75, 0x1e, 0xe8, 0x4a, 0x11,
+	0xc5, 0xf0, 0x97, 0x68, 0x3b, 0x25, 0x92, 0x8c, 0xc1, 0x73, 0xda, 0x4e, 0x67, 0xef, 0x79, 0xbb,
+	0xbb, 0x2a, 0x70, 0xf7, 0x52, 0xfb, 0xf5, 0xb7, 0xde, 0xfc, 0xfd, 0x70, 0xc3, 0x37, 0x28, 0xfc,
+	0x18, 0x1d, 0x84, 0x24, 0x89, 0x02, 0xc9, 0x62, 0x32, 0x63, 0x12, 0xbc, 0x7b, 0xed, 0xcd, 0xce,
+	0xae, 0xbf, 0x9f, 0x19, 0x7d, 0x63, 0xc3, 0xdf, 0xa1, 0x23, 0xed, 0x94, 0x4a, 0x4e, 0x59, 0x00,
+	0x59, 0x60, 0xf0, 0x36, 0xdb, 0x9b, 0x9d, 0xbd, 0xe7, 0x9d, 0xd5, 0xf1, 0xfa, 0x24, 0x89, 0x2e,
+	0x33, 0x84, 0x56, 0xea, 0xbb, 0xa1, 0x75, 0x06, 0x1c, 0xa0, 0xfb, 0x39, 0xe1, 0x35, 0x63, 0x0b,
+	0xdc, 0x5b, 0x75, 0xdc, 0x9a, 0xe7, 0x2b, 0xc6, 0xa2, 0x9c, 0xfb, 0x24, 0x2d, 0xce, 0xd5, 0x00,
+	0xbf, 0xa2, 0x26, 0x15, 0x3c, 0x09, 0x09, 0x30, 0x9b, 0xfe, 0x03, 0x4d, 0xff, 0xd9, 0x6a, 0xfa,
+	0x81, 0x81, 0x55, 0xe4, 0x1f, 0xd3, 0x25, 0x1b, 0xe0, 0x9f, 0x51, 0x53, 0x17, 0x86, 0x87, 0xd4,
+	0x8e, 0xb0, 0xfd, 0x9e, 0xc5, 0xc1, 0x19, 0xcd, 0x30, 0xa4, 0x55, 0xf2, 0x08, 0x79, 0x25, 0x79,
+	0x8e, 0x0e, 0x24, 0xfb, 0x7d, 0xc2, 0x40, 0x81, 0xf7, 0xa1, 0xe6, 0xff, 0x74, 0x3d, 0xff, 0x2b,
+	0x6d, 0xf2, 0x73, 0x8c, 0xdf, 0x34, 0x21, 0x2c, 0x2b, 0xe0, 0xef, 0x91, 0x3b, 0x4f, 0x21, 0xef,
+	0xa4, 0x1d, 0xdd, 0x49, 0x9f, 0xac, 0x27, 0x1f, 0xf6, 0x07, 0x56, 0x43, 0x1d, 0x14, 0x19, 0xe4,
+	0x7d, 0xf5, 0x02, 0x7d, 0x54, 0xd2, 0xc6, 0x59, 0x3a, 0x2a, 0xa0, 0x31, 0x67, 0x89, 0x0a, 0x78,
+	0xe4, 0xed, 0xb6, 0x9d, 0xce, 0x56, 0x29, 0xe8, 0x42, 0x5f, 0x0f, 0xf4, 0xed, 0x30, 0xc2, 0x57,
+	0xa8, 0x41, 0x49, 0x1c, 0x47, 0x44, 0x91, 0x40, 0x32, 0x2a, 0x64, 0x04, 0x1e, 0xaa, 0x2b, 0xe7,
+	0xc0, 0x20, 0x7c, 0x0d, 0xf0, 0x5d, 0x6a, 0x9d, 0x01, 0x7f, 0x81, 0xce, 0x16, 0xe5, 0x98, 0x5a,
+	0x66, 0x7a, 0xf6, 0xb4, 0x9e, 0x53, 0x4b, 0x8f, 0x29, 0xd0, 0x30, 0xc2, 0x14, 0x9d, 0xd2, 0x11,
+	0xe1, 0x49, 0xcc, 0x93, 0xd7, 0xf6, 0x2b, 0xef, 0x6b, 0x59, 0xcf, 0xd6, 0xc8, 0x2a, 0x70, 0x95,
+	0xa7, 0x3e, 0xa1, 0xcb, 0xc6, 0xac, 0x57, 0xbd, 0x11, 0x07, 0x25, 0x24, 0xa7, 0x24, 0x36, 0x51,
+	0x8a, 0xec, 0x0f, 0x74, 0x98, 0x27, 0x35, 0xd3, 0x60, 0x52, 0xf5, 0x4f, 0xe7, 0x3c, 0x55, 0x3b,
+	0xbe, 0x44, 0x6e, 0x2a, 0xc5, 0x94, 0x47, 0x4c, 0x16, 0xfa, 0x0f, 0xdb, 0x9b, 0xeb, 0x1f, 0xfa,
+	0xd2, 0x00, 0x72, 0xe5, 0x87, 0x69, 0xf5, 0xa8, 0xd7, 0x42, 0x3a, 0x53, 0x23, 0xbb, 0x26, 0x6e,
+	0xed, 0xe8, 0xce, 0xd4, 0xa8, 0xba, 0x16, 0x52, 0xeb, 0x0c, 0xf8, 0x07, 0x84, 0x33, 0xfd, 0x0b,
+	0xa5, 0x6e, 0x68, 0xda, 0xa7, 0xab, 0x69, 0xaf, 0x32, 0x4c, 0x85, 0xb7, 0x01, 0xb6, 0x01, 0xf0,
+	0x53, 0xd4, 0x30, 0xc4, 0x93, 0x30, 0xe6, 0x30, 0xca, 0xb6, 0xdd, 0x91, 0xde, 0x76, 0x6e, 0xee,
+	0x5b, 0x9a, 0xf1, 0x2f, 0xe8, 0x88, 0x8a, 0x71, 0x2a, 0x80, 0x2b, 0x66, 0x66, 0x0f, 0x3c, 0x5c,
+	0x27, 0x61, 0x50, 0x40, 0xf2, 0x11, 0x33, 0x83, 0xd1, 0xa0, 0xb6, 0x19, 0x74, 0x43, 0x95, 0xec,
+	0x56, 0x96, 0xc7, 0xb5, 0x0d, 0x55, 0xe0, 0xac, 0x86, 0x5a, 0x36, 0x02, 0xfe, 0x11, 0x35, 0x28,
+	0x97, 0x74, 0xc2, 0x55, 0x10, 0x4a, 0x46, 0x5e, 0x67, 0xd9, 0x9e, 0xd4, 0x8e, 0x51, 0x8e, 0xe8,
+	0xe7, 0x00, 0x93, 0x80, 0x4b, 0x2d, 0x2b, 0xe0, 0x6b, 0xd4, 0x5c, 0xa0, 0x0e, 0x46, 0x24, 0x56,
+	0xe0, 0x35, 0x6b, 0xf7, 0xaa, 0xc5, 0xf4, 0x0d, 0x89, 0x95, 0x89, 0x71, 0x4c, 0x97, 0x6e, 0x00,
+	0x4f, 0xd1, 0x83, 0xf9, 0xe0, 0xe9, 0x7d, 0x00, 0x4a, 0x32, 0x32, 0x86, 0x20, 0x12, 0x09, 0x78,
+	0xa7, 0x3a, 0xda, 0xf9, 0x3b, 0x4c, 0xdf, 0x4b, 0xa2, 0xc8, 0x55, 0x0e, 0x7d, 0xf9, 0xea, 0x5b,
+	0x13, 0xd2, 0xa3, 0xff, 0x77, 0x2d, 0x12, 0xc0, 0x7f, 0x38, 0xe8, 0xf1, 0x8a, 0xc0, 0xd6, 0x6b,
+	0xdd, 0xd7, 0x02, 0x5e, 0xbc, 0x9f, 0x80, 0xca, 0xc3, 0x3d, 0xa4, 0x6b, 0xef, 0xe1, 0xd1, 0x10,
+	0x1d, 0xda, 0x8b, 0x0d, 0x3f, 0x40, 0xbb, 0xf3, 0x35, 0xea, 0xe8, 0xb5, 0xb5, 0x43, 0x8b, 0xcd,
+	0x79, 0x86, 0x76, 0x8a, 0xbd, 0xe7, 0xdd, 0x6b, 0x3b, 0x9d, 0x7d, 0xbf, 0x3c, 0xf7, 0xaf, 0xdf,
+	0xdc, 0xb6, 0x9c, 0xb7, 0xb7, 0x2d, 0xe7, 0x9f, 0xdb, 0x96, 0xf3, 0xe7, 0x5d, 0x6b, 0xe3, 0xed,
+	0x5d, 0x6b, 0xe3, 0xaf, 0xbb, 0xd6, 0xc6, 0x4f, 0x17, 0x37, 0x5c, 0x8d, 0x26, 0x61, 0x97, 0x8a,
+	0x71, 0x6f, 0x58, 0x64, 0x72, 0x41, 0x42, 0xe8, 0x95, 0x79, 0x3d, 0xa3, 0x42, 0xb2, 0xea, 0x31,
+	0xd3, 0xdd, 0x1b, 0x8b, 0x68, 0x12, 0x33, 0x28, 0xfe, 0x62, 0xd4, 0x2c, 0x65, 0x10, 0x6e, 0xeb,
+	0xff, 0x94, 0xcf, 0xff, 0x1b, 0x00, 0x8f, 0x82, 0xd1, 0x6a, 0x28, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +383,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainlinkDataStreamsPriceStates) > 0 {
+		for iNdEx := len(m.ChainlinkDataStreamsPriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainlinkDataStreamsPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.ChainlinkDataStreamsDons) > 0 {
+		for iNdEx := len(m.ChainlinkDataStreamsDons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainlinkDataStreamsDons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.CircuitBreakerHalts) > 0 {
+		for iNdEx := len(m.CircuitBreakerHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.CompositePriceStates) > 0 {
+		for iNdEx := len(m.CompositePriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompositePriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.CompositeOracles) > 0 {
+		for iNdEx := len(m.CompositeOracles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompositeOracles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.StorkPublishers) > 0 {
 		for iNdEx := len(m.StorkPublishers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.StorkPublishers[iNdEx])
@@ -674,6 +828,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CompositeOracles) > 0 {
+		for _, e := range m.CompositeOracles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompositePriceStates) > 0 {
+		for _, e := range m.CompositePriceStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerHalts) > 0 {
+		for _, e := range m.CircuitBreakerHalts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainlinkDataStreamsDons) > 0 {
+		for _, e := range m.ChainlinkDataStreamsDons {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainlinkDataStreamsPriceStates) > 0 {
+		for _, e := range m.ChainlinkDataStreamsPriceStates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1270,6 +1460,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.StorkPublishers = append(m.StorkPublishers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeOracles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompositeOracles = append(m.CompositeOracles, CompositeOracle{})
+			if err := m.CompositeOracles[len(m.CompositeOracles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositePriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompositePriceStates = append(m.CompositePriceStates, &CompositePriceState{})
+			if err := m.CompositePriceStates[len(m.CompositePriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerHalts = append(m.CircuitBreakerHalts, CircuitBreakerHalt{})
+			if err := m.CircuitBreakerHalts[len(m.CircuitBreakerHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkDataStreamsDons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainlinkDataStreamsDons = append(m.ChainlinkDataStreamsDons, ChainlinkDataStreamsDON{})
+			if err := m.ChainlinkDataStreamsDons[len(m.ChainlinkDataStreamsDons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkDataStreamsPriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainlinkDataStreamsPriceStates = append(m.ChainlinkDataStreamsPriceStates, &ChainlinkDataStreamsPriceState{})
+			if err := m.ChainlinkDataStreamsPriceStates[len(m.ChainlinkDataStreamsPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
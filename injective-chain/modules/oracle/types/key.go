@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -62,6 +63,21 @@ var (
 	// StorkPriceKey is the prefix for the priceID => StorkPriceState store.
 	StorkPriceKey     = []byte{0x81}
 	StorkPublisherKey = []byte{0x82}
+
+	// CompositeOracleKey is the prefix for the symbol => CompositeOracle store.
+	CompositeOracleKey = []byte{0x91}
+	// CompositePriceKey is the prefix for the symbol => CompositePriceState store.
+	CompositePriceKey = []byte{0x92}
+
+	// CircuitBreakerKey is the prefix for the oracle type + base + quote => CircuitBreaker store.
+	CircuitBreakerKey = []byte{0xa1}
+	// CircuitBreakerHaltKey is the prefix for the oracle type + base + quote => CircuitBreakerHalt store.
+	CircuitBreakerHaltKey = []byte{0xa2}
+
+	// ChainlinkDataStreamsDONKey is the prefix for the config digest => ChainlinkDataStreamsDON store.
+	ChainlinkDataStreamsDONKey = []byte{0xb1}
+	// ChainlinkDataStreamsPriceKey is the prefix for the feed id => ChainlinkDataStreamsPriceState store.
+	ChainlinkDataStreamsPriceKey = []byte{0xb2}
 )
 
 func GetBandPriceStoreKey(symbol string) []byte {
@@ -117,6 +133,43 @@ func GetStorkPriceStoreKey(key string) []byte {
 	return append(StorkPriceKey, []byte(key)...)
 }
 
+func GetCompositeOracleKey(symbol string) []byte {
+	return append(CompositeOracleKey, []byte(symbol)...)
+}
+
+func GetCompositePriceStoreKey(symbol string) []byte {
+	return append(CompositePriceKey, []byte(symbol)...)
+}
+
+func getCircuitBreakerSymbolKey(oracleType OracleType, base, quote string) []byte {
+	if oracleType == OracleType_Pyth || oracleType == OracleType_ChainlinkDataStreams {
+		// the same price id can be hex encoded with different cases
+		base = common.HexToHash(base).Hex()
+	}
+
+	// the oracle type and the base are length prefixed, so that no two symbols share a key whatever they contain
+	key := sdk.Uint64ToBigEndian(uint64(oracleType))
+	key = binary.BigEndian.AppendUint16(key, uint16(len(base)))
+	key = append(key, base...)
+	return append(key, quote...)
+}
+
+func GetCircuitBreakerKey(oracleType OracleType, base, quote string) []byte {
+	return append(CircuitBreakerKey, getCircuitBreakerSymbolKey(oracleType, base, quote)...)
+}
+
+func GetCircuitBreakerHaltKey(oracleType OracleType, base, quote string) []byte {
+	return append(CircuitBreakerHaltKey, getCircuitBreakerSymbolKey(oracleType, base, quote)...)
+}
+
+func GetChainlinkDataStreamsDONKey(configDigest common.Hash) []byte {
+	return append(ChainlinkDataStreamsDONKey, configDigest.Bytes()...)
+}
+
+func GetChainlinkDataStreamsPriceStoreKey(feedID common.Hash) []byte {
+	return append(ChainlinkDataStreamsPriceKey, feedID.Bytes()...)
+}
+
 func GetChainlinkPriceStoreKey(feedId string) []byte {
 	feedIdBz := getPaddedFeedIdBz(feedId)
 
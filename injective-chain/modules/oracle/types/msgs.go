@@ -25,6 +25,14 @@ const (
 	TypeMsgRelayPythPrices       = "relayPythPrices"
 	TypeMsgRelayStorkPrices      = "relayStorkPrices"
 	TypeMsgUpdateParams          = "updateParams"
+	TypeMsgSetCompositeOracle    = "setCompositeOracle"
+	TypeMsgRemoveCompositeOracle = "removeCompositeOracle"
+	TypeMsgSetCircuitBreaker     = "setCircuitBreaker"
+	TypeMsgRemoveCircuitBreaker  = "removeCircuitBreaker"
+
+	TypeMsgRelayChainlinkPrices          = "relayChainlinkPrices"
+	TypeMsgSetChainlinkDataStreamsDON    = "setChainlinkDataStreamsDON"
+	TypeMsgRemoveChainlinkDataStreamsDON = "removeChainlinkDataStreamsDON"
 )
 
 var (
@@ -36,6 +44,13 @@ var (
 	_ sdk.Msg = &MsgRelayPythPrices{}
 	_ sdk.Msg = &MsgRelayStorkPrices{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetCompositeOracle{}
+	_ sdk.Msg = &MsgRemoveCompositeOracle{}
+	_ sdk.Msg = &MsgSetCircuitBreaker{}
+	_ sdk.Msg = &MsgRemoveCircuitBreaker{}
+	_ sdk.Msg = &MsgRelayChainlinkPrices{}
+	_ sdk.Msg = &MsgSetChainlinkDataStreamsDON{}
+	_ sdk.Msg = &MsgRemoveChainlinkDataStreamsDON{}
 )
 
 func (msg MsgUpdateParams) Route() string { return RouterKey }
@@ -63,6 +78,184 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (msg MsgSetCompositeOracle) Route() string { return RouterKey }
+
+func (msg MsgSetCompositeOracle) Type() string { return TypeMsgSetCompositeOracle }
+
+func (msg MsgSetCompositeOracle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.CompositeOracle.Validate()
+}
+
+func (msg *MsgSetCompositeOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgSetCompositeOracle) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRemoveCompositeOracle) Route() string { return RouterKey }
+
+func (msg MsgRemoveCompositeOracle) Type() string { return TypeMsgRemoveCompositeOracle }
+
+func (msg MsgRemoveCompositeOracle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Symbol == "" {
+		return ErrInvalidSymbol
+	}
+
+	return nil
+}
+
+func (msg *MsgRemoveCompositeOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgRemoveCompositeOracle) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSetCircuitBreaker) Route() string { return RouterKey }
+
+func (msg MsgSetCircuitBreaker) Type() string { return TypeMsgSetCircuitBreaker }
+
+func (msg MsgSetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.CircuitBreaker.Validate()
+}
+
+func (msg *MsgSetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgSetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRemoveCircuitBreaker) Route() string { return RouterKey }
+
+func (msg MsgRemoveCircuitBreaker) Type() string { return TypeMsgRemoveCircuitBreaker }
+
+func (msg MsgRemoveCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if msg.Base == "" {
+		return ErrInvalidSymbol
+	}
+
+	return nil
+}
+
+func (msg *MsgRemoveCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgRemoveCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRelayChainlinkPrices) Route() string { return RouterKey }
+
+func (msg MsgRelayChainlinkPrices) Type() string { return TypeMsgRelayChainlinkPrices }
+
+// ValidateBasic decodes the reports. The signatures are verified against the registered DON signer sets when the
+// message is executed.
+func (msg MsgRelayChainlinkPrices) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if len(msg.Reports) == 0 {
+		return errors.Wrap(ErrInvalidChainlinkReport, "reports should not be empty")
+	}
+
+	for _, fullReport := range msg.Reports {
+		report, err := DecodeChainlinkFullReport(fullReport)
+		if err != nil {
+			return err
+		}
+
+		if _, err := DecodeChainlinkReportV3(report.ReportData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (msg *MsgRelayChainlinkPrices) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRelayChainlinkPrices) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgSetChainlinkDataStreamsDON) Route() string { return RouterKey }
+
+func (msg MsgSetChainlinkDataStreamsDON) Type() string { return TypeMsgSetChainlinkDataStreamsDON }
+
+func (msg MsgSetChainlinkDataStreamsDON) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Don.Validate()
+}
+
+func (msg *MsgSetChainlinkDataStreamsDON) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgSetChainlinkDataStreamsDON) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRemoveChainlinkDataStreamsDON) Route() string { return RouterKey }
+
+func (msg MsgRemoveChainlinkDataStreamsDON) Type() string {
+	return TypeMsgRemoveChainlinkDataStreamsDON
+}
+
+func (msg MsgRemoveChainlinkDataStreamsDON) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if len(common.FromHex(msg.ConfigDigest)) != common.HashLength {
+		return errors.Wrapf(ErrInvalidChainlinkDON, "invalid config digest %s", msg.ConfigDigest)
+	}
+
+	return nil
+}
+
+func (msg *MsgRemoveChainlinkDataStreamsDON) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgRemoveChainlinkDataStreamsDON) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 // Route implements the sdk.Msg interface. It should return the name of the module
 func (msg MsgRelayPriceFeedPrice) Route() string { return RouterKey }
 
@@ -341,7 +534,7 @@ func (msg MsgRelayStorkPrices) ValidateBasic() error {
 		oldestTimestamp := ^uint64(0) // max uint64
 		for i := range assetPair.SignedPrices {
 			p := assetPair.SignedPrices[i]
-			// convert timestamp to nanoseconds to validate conditions	
+			// convert timestamp to nanoseconds to validate conditions
 			timestamp := ConvertTimestampToNanoSecond(p.Timestamp)
 			if timestamp > newestTimestamp {
 				newestTimestamp = timestamp
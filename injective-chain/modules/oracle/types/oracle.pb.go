@@ -32,19 +32,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type OracleType int32
 
 const (
-	OracleType_Unspecified OracleType = 0
-	OracleType_Band        OracleType = 1
-	OracleType_PriceFeed   OracleType = 2
-	OracleType_Coinbase    OracleType = 3
-	OracleType_Chainlink   OracleType = 4
-	OracleType_Razor       OracleType = 5
-	OracleType_Dia         OracleType = 6
-	OracleType_API3        OracleType = 7
-	OracleType_Uma         OracleType = 8
-	OracleType_Pyth        OracleType = 9
-	OracleType_BandIBC     OracleType = 10
-	OracleType_Provider    OracleType = 11
-	OracleType_Stork       OracleType = 12
+	OracleType_Unspecified          OracleType = 0
+	OracleType_Band                 OracleType = 1
+	OracleType_PriceFeed            OracleType = 2
+	OracleType_Coinbase             OracleType = 3
+	OracleType_Chainlink            OracleType = 4
+	OracleType_Razor                OracleType = 5
+	OracleType_Dia                  OracleType = 6
+	OracleType_API3                 OracleType = 7
+	OracleType_Uma                  OracleType = 8
+	OracleType_Pyth                 OracleType = 9
+	OracleType_BandIBC              OracleType = 10
+	OracleType_Provider             OracleType = 11
+	OracleType_Stork                OracleType = 12
+	OracleType_Composite            OracleType = 13
+	OracleType_ChainlinkDataStreams OracleType = 14
 )
 
 var OracleType_name = map[int32]string{
@@ -61,22 +63,26 @@ var OracleType_name = map[int32]string{
 	10: "BandIBC",
 	11: "Provider",
 	12: "Stork",
+	13: "Composite",
+	14: "ChainlinkDataStreams",
 }
 
 var OracleType_value = map[string]int32{
-	"Unspecified": 0,
-	"Band":        1,
-	"PriceFeed":   2,
-	"Coinbase":    3,
-	"Chainlink":   4,
-	"Razor":       5,
-	"Dia":         6,
-	"API3":        7,
-	"Uma":         8,
-	"Pyth":        9,
-	"BandIBC":     10,
-	"Provider":    11,
-	"Stork":       12,
+	"Unspecified":          0,
+	"Band":                 1,
+	"PriceFeed":            2,
+	"Coinbase":             3,
+	"Chainlink":            4,
+	"Razor":                5,
+	"Dia":                  6,
+	"API3":                 7,
+	"Uma":                  8,
+	"Pyth":                 9,
+	"BandIBC":              10,
+	"Provider":             11,
+	"Stork":                12,
+	"Composite":            13,
+	"ChainlinkDataStreams": 14,
 }
 
 func (x OracleType) String() string {
@@ -87,6 +93,63 @@ func (OracleType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c8fbf1e7a765423, []int{0}
 }
 
+// CompositeAggregation defines how the prices of a composite oracle's sources
+// are combined
+type CompositeAggregation int32
+
+const (
+	CompositeAggregation_Median       CompositeAggregation = 0
+	CompositeAggregation_WeightedMean CompositeAggregation = 1
+)
+
+var CompositeAggregation_name = map[int32]string{
+	0: "Median",
+	1: "WeightedMean",
+}
+
+var CompositeAggregation_value = map[string]int32{
+	"Median":       0,
+	"WeightedMean": 1,
+}
+
+func (x CompositeAggregation) String() string {
+	return proto.EnumName(CompositeAggregation_name, int32(x))
+}
+
+func (CompositeAggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{1}
+}
+
+type CircuitBreakerAction int32
+
+const (
+	// RejectPrice drops relayed prices breaching the circuit breaker and halts
+	// the symbol until a price within the limits is relayed or the price is
+	// re-anchored
+	CircuitBreakerAction_RejectPrice CircuitBreakerAction = 0
+	// HaltPrice stores relayed prices breaching the circuit breaker but halts
+	// the symbol until a price within the limits is relayed
+	CircuitBreakerAction_HaltPrice CircuitBreakerAction = 1
+)
+
+var CircuitBreakerAction_name = map[int32]string{
+	0: "RejectPrice",
+	1: "HaltPrice",
+}
+
+var CircuitBreakerAction_value = map[string]int32{
+	"RejectPrice": 0,
+	"HaltPrice":   1,
+}
+
+func (x CircuitBreakerAction) String() string {
+	return proto.EnumName(CircuitBreakerAction_name, int32(x))
+}
+
+func (CircuitBreakerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{2}
+}
+
 type Params struct {
 	PythContract string `protobuf:"bytes,1,opt,name=pyth_contract,json=pythContract,proto3" json:"pyth_contract,omitempty"`
 }
@@ -1303,6 +1366,84 @@ func (m *PriceRecord) GetTimestamp() int64 {
 	return 0
 }
 
+// PriceTWAP is the time-weighted average price of an oracle over a time window.
+// Each price record is weighted by the time until the next record, or the end
+// of the window for the last one
+type PriceTWAP struct {
+	Twap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"twap"`
+	// start_time is the unix timestamp in seconds of the window start
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix timestamp in seconds of the window end
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// records_count is the number of price records used, including the last
+	// record before the window start
+	RecordsCount uint32 `protobuf:"varint,4,opt,name=records_count,json=recordsCount,proto3" json:"records_count,omitempty"`
+	// largest_gap is the longest time in seconds a price was used without being
+	// updated
+	LargestGap int64 `protobuf:"varint,5,opt,name=largest_gap,json=largestGap,proto3" json:"largest_gap,omitempty"`
+}
+
+func (m *PriceTWAP) Reset()         { *m = PriceTWAP{} }
+func (m *PriceTWAP) String() string { return proto.CompactTextString(m) }
+func (*PriceTWAP) ProtoMessage()    {}
+func (*PriceTWAP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{20}
+}
+func (m *PriceTWAP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceTWAP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceTWAP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceTWAP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceTWAP.Merge(m, src)
+}
+func (m *PriceTWAP) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceTWAP) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceTWAP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceTWAP proto.InternalMessageInfo
+
+func (m *PriceTWAP) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *PriceTWAP) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *PriceTWAP) GetRecordsCount() uint32 {
+	if m != nil {
+		return m.RecordsCount
+	}
+	return 0
+}
+
+func (m *PriceTWAP) GetLargestGap() int64 {
+	if m != nil {
+		return m.LargestGap
+	}
+	return 0
+}
+
 // MetadataStatistics refers to the metadata summary statistics of the
 // historical sample considered
 type MetadataStatistics struct {
@@ -1335,7 +1476,7 @@ func (m *MetadataStatistics) Reset()         { *m = MetadataStatistics{} }
 func (m *MetadataStatistics) String() string { return proto.CompactTextString(m) }
 func (*MetadataStatistics) ProtoMessage()    {}
 func (*MetadataStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{20}
+	return fileDescriptor_1c8fbf1e7a765423, []int{21}
 }
 func (m *MetadataStatistics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1549,7 @@ func (m *PriceAttestation) Reset()         { *m = PriceAttestation{} }
 func (m *PriceAttestation) String() string { return proto.CompactTextString(m) }
 func (*PriceAttestation) ProtoMessage()    {}
 func (*PriceAttestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{21}
+	return fileDescriptor_1c8fbf1e7a765423, []int{22}
 }
 func (m *PriceAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1643,7 @@ func (m *AssetPair) Reset()         { *m = AssetPair{} }
 func (m *AssetPair) String() string { return proto.CompactTextString(m) }
 func (*AssetPair) ProtoMessage()    {}
 func (*AssetPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{22}
+	return fileDescriptor_1c8fbf1e7a765423, []int{23}
 }
 func (m *AssetPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1697,7 @@ func (m *SignedPriceOfAssetPair) Reset()         { *m = SignedPriceOfAssetPair{}
 func (m *SignedPriceOfAssetPair) String() string { return proto.CompactTextString(m) }
 func (*SignedPriceOfAssetPair) ProtoMessage()    {}
 func (*SignedPriceOfAssetPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c8fbf1e7a765423, []int{23}
+	return fileDescriptor_1c8fbf1e7a765423, []int{24}
 }
 func (m *SignedPriceOfAssetPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,534 +1747,811 @@ func (m *SignedPriceOfAssetPair) GetSignature() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
-	golang_proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
-	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
-	golang_proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
-	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
-	golang_proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
-	proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
-	golang_proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
-	proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
-	golang_proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
-	proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
-	golang_proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
-	proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
-	golang_proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
-	proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
-	golang_proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
-	proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
-	golang_proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
-	proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
-	golang_proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
-	proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
-	golang_proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
-	proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
-	golang_proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
-	proto.RegisterType((*StorkPriceState)(nil), "injective.oracle.v1beta1.StorkPriceState")
-	golang_proto.RegisterType((*StorkPriceState)(nil), "injective.oracle.v1beta1.StorkPriceState")
-	proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
-	golang_proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
-	proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
-	golang_proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
-	proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
-	golang_proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
-	proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
-	golang_proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
-	proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
-	golang_proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
-	proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
-	golang_proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
-	proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
-	golang_proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
-	proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
-	golang_proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
-	proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
-	golang_proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
-	proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
-	golang_proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
-	proto.RegisterType((*AssetPair)(nil), "injective.oracle.v1beta1.AssetPair")
-	golang_proto.RegisterType((*AssetPair)(nil), "injective.oracle.v1beta1.AssetPair")
-	proto.RegisterType((*SignedPriceOfAssetPair)(nil), "injective.oracle.v1beta1.SignedPriceOfAssetPair")
-	golang_proto.RegisterType((*SignedPriceOfAssetPair)(nil), "injective.oracle.v1beta1.SignedPriceOfAssetPair")
+// CompositeOracleSource is an underlying price of a composite oracle
+type CompositeOracleSource struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// base is the base symbol of the price, or the symbol for Provider oracles
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// quote is the quote symbol of the price, or the provider for Provider
+	// oracles
+	Quote string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	// weight is the relative weight of the source for weighted mean aggregation
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+	// max_staleness is the maximum age of the source price in seconds. Older
+	// prices are ignored. Zero means no limit
+	MaxStaleness int64 `protobuf:"varint,5,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+}
+
+func (m *CompositeOracleSource) Reset()         { *m = CompositeOracleSource{} }
+func (m *CompositeOracleSource) String() string { return proto.CompactTextString(m) }
+func (*CompositeOracleSource) ProtoMessage()    {}
+func (*CompositeOracleSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{25}
+}
+func (m *CompositeOracleSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+func (m *CompositeOracleSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompositeOracleSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func init() {
-	golang_proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+func (m *CompositeOracleSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeOracleSource.Merge(m, src)
 }
-
-var fileDescriptor_1c8fbf1e7a765423 = []byte{
-	// 1794 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x8f, 0x1c, 0x47,
-	0x15, 0xdf, 0x9e, 0xef, 0x7e, 0xf3, 0xb1, 0xed, 0xda, 0xb5, 0x19, 0x3b, 0xc9, 0xec, 0xd2, 0xc1,
-	0x30, 0xb2, 0x92, 0x19, 0xdb, 0x39, 0x20, 0x07, 0x84, 0xe2, 0x5d, 0xdb, 0x68, 0xe4, 0x85, 0xac,
-	0x7a, 0x6d, 0x90, 0xb8, 0x0c, 0x35, 0xdd, 0x35, 0x3b, 0x95, 0xed, 0xaf, 0x74, 0xf5, 0x6c, 0x3c,
-	0x96, 0xb8, 0xe6, 0xc0, 0x05, 0xfe, 0x01, 0x24, 0xce, 0x39, 0x71, 0x80, 0x13, 0x12, 0x42, 0x9c,
-	0x72, 0x23, 0xa7, 0x08, 0x71, 0x08, 0x60, 0x1f, 0x40, 0x5c, 0xf9, 0x07, 0xd0, 0xab, 0xaa, 0xee,
-	0xe9, 0xdd, 0x8d, 0xbd, 0x3b, 0x04, 0x5f, 0x76, 0xab, 0x5e, 0xbd, 0xf7, 0xea, 0xf7, 0x3e, 0xea,
-	0xbd, 0xd7, 0x03, 0xd7, 0x79, 0xf8, 0x01, 0x73, 0x53, 0x7e, 0xcc, 0x86, 0x51, 0x42, 0x5d, 0x9f,
-	0x0d, 0x8f, 0x6f, 0x4d, 0x58, 0x4a, 0x6f, 0xe9, 0xed, 0x20, 0x4e, 0xa2, 0x34, 0x22, 0xdd, 0x9c,
-	0x6d, 0xa0, 0xe9, 0x9a, 0xed, 0xda, 0xe6, 0x61, 0x74, 0x18, 0x49, 0xa6, 0x21, 0xae, 0x14, 0xff,
-	0xb5, 0x9e, 0x1b, 0x89, 0x20, 0x12, 0xc3, 0x09, 0x15, 0x4b, 0x8d, 0x6e, 0xc4, 0x43, 0x7d, 0x7e,
-	0x89, 0x06, 0x3c, 0x8c, 0x86, 0xf2, 0xaf, 0x22, 0xd9, 0xf7, 0xa1, 0xb6, 0x4f, 0x13, 0x1a, 0x08,
-	0xf2, 0x26, 0xb4, 0xe3, 0x45, 0x3a, 0x1b, 0xbb, 0x51, 0x98, 0x26, 0xd4, 0x4d, 0xbb, 0xc6, 0xb6,
-	0xd1, 0x37, 0x9d, 0x16, 0x12, 0x77, 0x35, 0xed, 0xdd, 0x2b, 0xff, 0xfa, 0xf5, 0x96, 0xf1, 0xf3,
-	0x7f, 0xfe, 0xe6, 0x46, 0x5b, 0xe3, 0x56, 0xc2, 0xf6, 0x11, 0xc0, 0xfb, 0x92, 0x30, 0x0a, 0xa7,
-	0x11, 0xb9, 0x02, 0x35, 0xb1, 0x08, 0x26, 0x91, 0xaf, 0x75, 0xe8, 0x1d, 0xb9, 0x0f, 0x4d, 0x25,
-	0x36, 0x4e, 0x17, 0x31, 0xeb, 0x96, 0xb6, 0x8d, 0x7e, 0xe7, 0xf6, 0x37, 0x06, 0x2f, 0xb2, 0x72,
-	0xa0, 0x54, 0x3e, 0x5a, 0xc4, 0xcc, 0x81, 0x28, 0x5f, 0xdb, 0x9f, 0x1b, 0xb0, 0xb1, 0x3b, 0xa3,
-	0x3c, 0xf4, 0x79, 0x78, 0xb4, 0x9f, 0x70, 0x97, 0x1d, 0xa4, 0x34, 0x65, 0xe4, 0x6b, 0x50, 0x9f,
-	0x32, 0xe6, 0x8d, 0xb9, 0x97, 0xdd, 0x8b, 0xdb, 0x91, 0x47, 0xbe, 0x03, 0x35, 0x1a, 0x8a, 0x8f,
-	0x58, 0x22, 0xaf, 0x34, 0x77, 0xde, 0xfc, 0xf4, 0x8b, 0xad, 0xb5, 0xbf, 0x7e, 0xb1, 0xf5, 0x9a,
-	0xf2, 0x97, 0xf0, 0x8e, 0x06, 0x3c, 0x1a, 0x06, 0x34, 0x9d, 0x0d, 0xf6, 0xd8, 0x21, 0x75, 0x17,
-	0xf7, 0x98, 0xeb, 0x68, 0x11, 0xf2, 0x3a, 0x98, 0x29, 0x0f, 0x98, 0x48, 0x69, 0x10, 0x77, 0xcb,
-	0xdb, 0x46, 0xbf, 0xe2, 0x2c, 0x09, 0xe4, 0x21, 0x34, 0x63, 0x44, 0x30, 0x16, 0x08, 0xa1, 0x5b,
-	0xd9, 0x36, 0xfa, 0xcd, 0x97, 0x99, 0xb4, 0x84, 0xbb, 0x53, 0x41, 0x14, 0x0e, 0xc4, 0x39, 0xc5,
-	0xfe, 0xb7, 0x01, 0x9d, 0x1d, 0x1a, 0x7a, 0x05, 0x9b, 0x5e, 0xe4, 0xca, 0x5b, 0x50, 0x49, 0xf0,
-	0x42, 0x65, 0xd0, 0x1b, 0xda, 0xa0, 0xcb, 0x67, 0x0d, 0x1a, 0x85, 0xa9, 0x23, 0x59, 0xc9, 0xd7,
-	0xa1, 0x95, 0x30, 0x11, 0xf9, 0xc7, 0x6c, 0x8c, 0xf8, 0xb5, 0x2d, 0x4d, 0x4d, 0x7b, 0xc4, 0x03,
-	0x46, 0xde, 0x00, 0x48, 0xd8, 0x87, 0x73, 0x26, 0xd2, 0xf1, 0xe8, 0x9e, 0x34, 0xa6, 0xe2, 0x98,
-	0x9a, 0x32, 0xba, 0x77, 0xda, 0xd8, 0xea, 0x57, 0x32, 0xf6, 0x57, 0x06, 0x74, 0x24, 0xc3, 0x03,
-	0xc6, 0x3c, 0x65, 0x2c, 0x81, 0x0a, 0xa6, 0xae, 0x36, 0x55, 0xae, 0xc9, 0x26, 0x54, 0x3f, 0x9c,
-	0x47, 0x99, 0xa5, 0x8e, 0xda, 0x60, 0x26, 0x15, 0x91, 0x94, 0x2f, 0x8e, 0xa4, 0x88, 0x81, 0x5c,
-	0x83, 0x46, 0xc2, 0x7c, 0xba, 0x60, 0x89, 0xe8, 0x56, 0xb6, 0xcb, 0x7d, 0xd3, 0xc9, 0xf7, 0xf6,
-	0x03, 0x68, 0xed, 0x27, 0xd1, 0x31, 0xf7, 0x58, 0x22, 0x93, 0xfa, 0x1a, 0x34, 0x62, 0xbd, 0xd7,
-	0x00, 0xf3, 0xfd, 0x09, 0x3d, 0xa5, 0x53, 0x7a, 0xfe, 0x60, 0x40, 0x3b, 0x53, 0xa4, 0x6e, 0x7d,
-	0x08, 0xed, 0x4c, 0x72, 0xcc, 0xc3, 0x69, 0x24, 0xd5, 0x35, 0x6f, 0x7f, 0xf3, 0x65, 0xf0, 0x97,
-	0x40, 0x9c, 0x56, 0x5c, 0x84, 0xf5, 0x53, 0xb8, 0x9c, 0x2b, 0x2b, 0xb8, 0x44, 0xe1, 0x68, 0xde,
-	0x7e, 0xeb, 0x7c, 0xa5, 0x05, 0xdf, 0x6c, 0xc4, 0x67, 0x68, 0xc2, 0x9e, 0x01, 0x39, 0xcb, 0xfa,
-	0xc2, 0xc4, 0x7c, 0x17, 0xaa, 0x2a, 0x26, 0xa5, 0x15, 0x62, 0xa2, 0x44, 0xec, 0x3b, 0xe8, 0x29,
-	0x9d, 0x11, 0xd2, 0xb8, 0x0b, 0x27, 0x84, 0xfd, 0xb0, 0x90, 0x4c, 0x72, 0x41, 0xee, 0x40, 0x55,
-	0xfa, 0x43, 0x09, 0x5f, 0xec, 0xcd, 0x2b, 0x09, 0xfb, 0xf7, 0x06, 0x90, 0xdd, 0x88, 0x87, 0x78,
-	0x5f, 0xc1, 0x64, 0x02, 0x95, 0x23, 0x1e, 0x66, 0xc5, 0x45, 0xae, 0x4f, 0x56, 0x87, 0xd2, 0xe9,
-	0xea, 0x60, 0x41, 0xf9, 0x88, 0x2d, 0x64, 0x7a, 0x9a, 0x0e, 0x2e, 0x11, 0xfd, 0x31, 0xf5, 0xe7,
-	0x4c, 0x3f, 0x2e, 0xb5, 0xf9, 0xff, 0x3e, 0xac, 0x3f, 0x1b, 0xb0, 0x7e, 0x90, 0x46, 0x49, 0xb1,
-	0x34, 0x9e, 0x80, 0x69, 0x9c, 0x86, 0xb9, 0x8c, 0x65, 0xe9, 0x44, 0x2c, 0xef, 0x64, 0x60, 0xcb,
-	0x2b, 0xb8, 0xf0, 0x15, 0x58, 0xf4, 0x3b, 0x03, 0xa0, 0x60, 0xcc, 0xff, 0x1e, 0x59, 0xf2, 0x43,
-	0xb0, 0xdc, 0x79, 0x30, 0xf7, 0x29, 0x62, 0x50, 0xef, 0x65, 0x95, 0x9e, 0xb0, 0xbe, 0x14, 0x56,
-	0x49, 0x76, 0xa6, 0x39, 0x94, 0x0b, 0x7e, 0xb5, 0x3f, 0x2f, 0x41, 0x67, 0x7f, 0x91, 0xce, 0x0a,
-	0xd8, 0xaf, 0x62, 0x15, 0x41, 0xbf, 0xe4, 0x4d, 0xaa, 0x2e, 0xf7, 0x23, 0x8f, 0xbc, 0x07, 0x26,
-	0x0b, 0xe8, 0xea, 0xa0, 0x1a, 0x2c, 0xa0, 0x0a, 0xcd, 0xf7, 0x00, 0xd7, 0xd8, 0xc1, 0xa7, 0xab,
-	0x84, 0xac, 0xce, 0x02, 0xba, 0x1b, 0x85, 0x53, 0xf2, 0x6d, 0xa8, 0x48, 0xd9, 0xca, 0xc5, 0x65,
-	0xa5, 0x00, 0xb6, 0x96, 0x78, 0x3e, 0xf1, 0xb9, 0x98, 0xa9, 0xd6, 0x52, 0x55, 0xad, 0x45, 0xd3,
-	0x64, 0x6b, 0x39, 0x95, 0x10, 0xb5, 0xaf, 0x94, 0x10, 0x1f, 0x97, 0xe1, 0x12, 0x36, 0x4a, 0x35,
-	0x20, 0x38, 0xaa, 0x41, 0x15, 0xbb, 0x97, 0xf6, 0x6e, 0xa1, 0x7b, 0x79, 0xa4, 0x0f, 0x96, 0x9e,
-	0x3e, 0x84, 0x9b, 0xf0, 0x58, 0x32, 0x95, 0x64, 0xc8, 0x3a, 0x8a, 0x7e, 0x20, 0xc9, 0x23, 0x8f,
-	0x74, 0xa1, 0xae, 0x5e, 0x80, 0xe8, 0x96, 0x65, 0x35, 0xcf, 0xb6, 0xe4, 0x35, 0x30, 0xa9, 0x38,
-	0x1a, 0xbb, 0xd1, 0x3c, 0x4c, 0xf5, 0x13, 0x6e, 0x50, 0x71, 0xb4, 0x8b, 0x7b, 0x3c, 0x0c, 0x78,
-	0xa8, 0x0f, 0x95, 0x0b, 0x1a, 0x01, 0x0f, 0xd5, 0xe1, 0x0c, 0xcc, 0x29, 0x63, 0x63, 0x9f, 0x07,
-	0x3c, 0xed, 0xd6, 0x64, 0x6d, 0xbe, 0x3a, 0x50, 0x9e, 0x1d, 0x60, 0x9d, 0xc9, 0x0d, 0xc7, 0xc2,
-	0xb3, 0x73, 0x13, 0x4d, 0xfe, 0xe4, 0x6f, 0x5b, 0xfd, 0x43, 0x9e, 0xce, 0xe6, 0x93, 0x81, 0x1b,
-	0x05, 0x43, 0x3d, 0xdc, 0xa9, 0x7f, 0x6f, 0x0b, 0xef, 0x68, 0x88, 0x53, 0x94, 0x90, 0x02, 0xc2,
-	0x69, 0x4c, 0x19, 0xdb, 0x43, 0xe5, 0x64, 0x0b, 0x3d, 0xcd, 0x62, 0x9a, 0xb0, 0xf1, 0x21, 0x15,
-	0xdd, 0xba, 0x04, 0x02, 0x9a, 0xf4, 0x7d, 0x2a, 0x90, 0x81, 0x3d, 0x61, 0xee, 0x3c, 0x55, 0x0c,
-	0x0d, 0xc5, 0xa0, 0x49, 0xc8, 0xd0, 0x07, 0x0b, 0x0d, 0x11, 0xd1, 0x3c, 0x71, 0x99, 0xb6, 0xc7,
-	0x94, 0x5c, 0x9d, 0x80, 0x87, 0x07, 0x92, 0x2c, 0xad, 0xb2, 0x3f, 0x2e, 0x41, 0x1b, 0x03, 0x31,
-	0xda, 0xd9, 0xd5, 0x63, 0x64, 0x1f, 0xac, 0x09, 0x0d, 0xbd, 0x31, 0x9f, 0xb8, 0x63, 0x16, 0xd2,
-	0x89, 0xcf, 0x54, 0x28, 0x1a, 0x4e, 0x07, 0xe9, 0xa3, 0x89, 0x7b, 0x5f, 0x51, 0xc9, 0x4d, 0xd8,
-	0x44, 0xa6, 0x3c, 0x64, 0x61, 0xca, 0x92, 0x63, 0xea, 0xeb, 0x98, 0x10, 0x3e, 0x71, 0x75, 0x60,
-	0x47, 0xfa, 0x84, 0xbc, 0x05, 0x48, 0xcd, 0x71, 0xcd, 0x68, 0x18, 0x32, 0x5f, 0x57, 0x57, 0x8b,
-	0x4f, 0x5c, 0x8d, 0x4c, 0xd1, 0xd1, 0x4c, 0xe4, 0x3e, 0x66, 0x89, 0xe0, 0x51, 0xa8, 0x92, 0xda,
-	0x01, 0x3e, 0x71, 0x7f, 0xa4, 0x28, 0xa4, 0xa7, 0x18, 0xe2, 0x28, 0x91, 0xb9, 0x50, 0x95, 0x0c,
-	0x26, 0x9f, 0xb8, 0xfb, 0x51, 0x82, 0x69, 0x70, 0x03, 0x2e, 0xf9, 0x32, 0xd1, 0xc7, 0x3a, 0x6f,
-	0xb8, 0x27, 0x64, 0xe8, 0xca, 0xce, 0xba, 0x3a, 0xd0, 0x33, 0xaf, 0x27, 0xec, 0x5f, 0x18, 0xb0,
-	0x79, 0x20, 0x93, 0x44, 0x26, 0xee, 0xa3, 0xbc, 0xb6, 0x7e, 0x17, 0x6a, 0x4a, 0x5a, 0x7a, 0xe1,
-	0xa2, 0xe3, 0xae, 0x96, 0xc1, 0x94, 0x52, 0xa9, 0x97, 0x25, 0xab, 0xe9, 0x34, 0x14, 0x61, 0xe4,
-	0x9d, 0x53, 0x7c, 0x16, 0xb0, 0xb1, 0x47, 0x45, 0x7a, 0x12, 0x8e, 0x20, 0x13, 0xb8, 0xec, 0x53,
-	0x91, 0xea, 0x59, 0x21, 0x67, 0x17, 0x5d, 0x43, 0xe6, 0xe4, 0xe0, 0xc5, 0xf0, 0xbe, 0xcc, 0x3c,
-	0x67, 0xc3, 0x3f, 0x7b, 0x87, 0xfd, 0x27, 0x03, 0x67, 0x27, 0xee, 0x32, 0x87, 0xb9, 0x51, 0xe2,
-	0x89, 0x57, 0xe9, 0x84, 0x1f, 0xc3, 0xa6, 0x8f, 0x63, 0x4a, 0x66, 0x51, 0xa2, 0xae, 0x94, 0x0f,
-	0xb7, 0x79, 0xfb, 0xfa, 0x39, 0x05, 0x46, 0x01, 0x74, 0x88, 0x52, 0x51, 0xc4, 0x6c, 0x4f, 0xa1,
-	0x59, 0xd8, 0x9f, 0xed, 0xa0, 0x45, 0x67, 0x2f, 0x5b, 0x52, 0x69, 0xe5, 0x61, 0xe3, 0x3f, 0x65,
-	0x20, 0x3f, 0x60, 0x29, 0xf5, 0x68, 0x4a, 0xb1, 0xba, 0x71, 0x91, 0x72, 0x57, 0x3e, 0xd2, 0xc3,
-	0x24, 0x9a, 0xc7, 0xfa, 0xf9, 0xe1, 0x8d, 0x6d, 0x07, 0x24, 0x49, 0x15, 0x94, 0x01, 0x6c, 0x68,
-	0x5b, 0xc7, 0x82, 0x06, 0x31, 0x96, 0x35, 0xfe, 0x54, 0x01, 0x68, 0x3b, 0x97, 0xf4, 0xd1, 0x81,
-	0x3c, 0x39, 0xe0, 0x4f, 0x19, 0x16, 0xf7, 0x80, 0xd1, 0x70, 0x95, 0xc6, 0x20, 0x05, 0x50, 0x30,
-	0xfd, 0x88, 0xc6, 0x2b, 0x75, 0x05, 0x14, 0x20, 0xdf, 0x82, 0xf5, 0x29, 0x4f, 0x44, 0xba, 0xcc,
-	0x32, 0xf9, 0xc6, 0xca, 0x4e, 0x47, 0x92, 0x97, 0x6f, 0xe4, 0x3a, 0x74, 0x64, 0x4e, 0x2e, 0xf9,
-	0x6a, 0x92, 0xaf, 0x8d, 0xd4, 0x25, 0xdb, 0x7b, 0xaa, 0xbe, 0x2a, 0x47, 0xd7, 0x57, 0x68, 0x90,
-	0x01, 0x0f, 0x55, 0x83, 0x44, 0x0d, 0xf4, 0x89, 0xd6, 0xd0, 0x58, 0x45, 0x03, 0x7d, 0xa2, 0x34,
-	0x3c, 0x80, 0x56, 0xc0, 0x3c, 0x4e, 0x33, 0x18, 0xe6, 0xc5, 0x95, 0x34, 0x95, 0xa0, 0xd4, 0x63,
-	0xff, 0xc3, 0x00, 0x4b, 0xae, 0xee, 0xa6, 0x98, 0x79, 0x34, 0xc5, 0x82, 0xf4, 0x92, 0xe1, 0x60,
-	0xb3, 0x98, 0x60, 0xe5, 0x6c, 0x9c, 0x21, 0xba, 0x61, 0xab, 0x4f, 0x39, 0xd5, 0x8b, 0x09, 0x54,
-	0xd8, 0x93, 0x38, 0x92, 0xe1, 0xaa, 0x3a, 0x72, 0x8d, 0x2f, 0x68, 0x39, 0x5a, 0xa8, 0x18, 0x2c,
-	0xa7, 0x86, 0xab, 0x85, 0xa9, 0xa1, 0x26, 0x15, 0xe5, 0x03, 0x81, 0x3e, 0x92, 0xfa, 0xea, 0x52,
-	0x1f, 0x1e, 0xdd, 0x47, 0x95, 0xa7, 0x5b, 0x7e, 0x43, 0x6a, 0x2d, 0xb6, 0x7c, 0xfb, 0x67, 0x60,
-	0xde, 0x15, 0x82, 0xa5, 0xfb, 0x94, 0x27, 0xa8, 0x8a, 0xe2, 0xa6, 0x60, 0x9b, 0xdc, 0x8f, 0x3c,
-	0xf2, 0x18, 0xda, 0x82, 0x1f, 0x86, 0xcc, 0x53, 0x00, 0xb3, 0x4f, 0x97, 0x9b, 0x2f, 0x29, 0x45,
-	0x92, 0x5d, 0xc2, 0x7f, 0x7f, 0x9a, 0xdf, 0xe1, 0xb4, 0xc4, 0x92, 0x2e, 0xec, 0xdf, 0x1a, 0x70,
-	0xe5, 0xcb, 0x19, 0xe5, 0x6f, 0x1d, 0x0a, 0x28, 0x4b, 0xc6, 0x38, 0xa1, 0x67, 0xbf, 0x75, 0x64,
-	0xc4, 0x87, 0x6c, 0x71, 0xce, 0x68, 0x9f, 0xbf, 0xf8, 0xf2, 0xca, 0x43, 0xe8, 0xeb, 0x60, 0x22,
-	0x50, 0x9a, 0xce, 0x13, 0xf5, 0x1d, 0xd0, 0x72, 0x96, 0x84, 0x1b, 0x9f, 0x18, 0xd9, 0x6f, 0x29,
-	0x58, 0x04, 0xc9, 0x3a, 0x34, 0x1f, 0x87, 0x22, 0x66, 0x2e, 0x9f, 0x72, 0xe6, 0x59, 0x6b, 0xa4,
-	0x01, 0x15, 0xec, 0xb8, 0x96, 0x41, 0xda, 0x60, 0xe6, 0xdf, 0x3c, 0x56, 0x89, 0xb4, 0xa0, 0x91,
-	0x7d, 0xb4, 0x58, 0x65, 0x3c, 0xcc, 0x7f, 0x23, 0xb1, 0x2a, 0xc4, 0x84, 0xaa, 0x43, 0x9f, 0x46,
-	0x89, 0x55, 0x25, 0x75, 0x28, 0xdf, 0xe3, 0xd4, 0xaa, 0xa1, 0xa6, 0xbb, 0xfb, 0xa3, 0x77, 0xac,
-	0x3a, 0x92, 0x1e, 0x07, 0xd4, 0x6a, 0x20, 0x09, 0x07, 0x56, 0xcb, 0x24, 0x4d, 0xa8, 0xeb, 0xc6,
-	0x6e, 0x01, 0xaa, 0xce, 0x3e, 0x01, 0xad, 0x26, 0xea, 0x92, 0xdf, 0x17, 0x56, 0x6b, 0xe7, 0x83,
-	0x4f, 0x9f, 0xf5, 0x8c, 0xcf, 0x9e, 0xf5, 0x8c, 0xbf, 0x3f, 0xeb, 0x19, 0xbf, 0x7c, 0xde, 0x5b,
-	0xfb, 0xe3, 0xf3, 0x9e, 0xf1, 0xd9, 0xf3, 0xde, 0xda, 0x5f, 0x9e, 0xf7, 0xd6, 0x7e, 0xb2, 0x57,
-	0x98, 0x5e, 0x46, 0x59, 0x2c, 0xf7, 0xe8, 0x44, 0x0c, 0xf3, 0xc8, 0xbe, 0xed, 0x46, 0x09, 0x2b,
-	0x6e, 0x11, 0xf3, 0x30, 0x88, 0xbc, 0xb9, 0xcf, 0x44, 0xf6, 0xe3, 0x98, 0x9c, 0x73, 0x26, 0x35,
-	0xf9, 0x8b, 0xd5, 0x3b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xe6, 0xeb, 0x89, 0x3d, 0x13,
-	0x00, 0x00,
+func (m *CompositeOracleSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositeOracleSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeOracleSource.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_CompositeOracleSource proto.InternalMessageInfo
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PythContract != that1.PythContract {
-		return false
+func (m *CompositeOracleSource) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
 	}
-	return true
+	return OracleType_Unspecified
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *CompositeOracleSource) GetBase() string {
+	if m != nil {
+		return m.Base
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompositeOracleSource) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PythContract) > 0 {
-		i -= len(m.PythContract)
-		copy(dAtA[i:], m.PythContract)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PythContract)))
-		i--
-		dAtA[i] = 0xa
+func (m *CompositeOracleSource) GetMaxStaleness() int64 {
+	if m != nil {
+		return m.MaxStaleness
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OracleInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// CompositeOracle resolves a price from several underlying sources. Markets
+// reference it with the Composite oracle type and the composite symbol as
+// oracle base.
+type CompositeOracle struct {
+	Symbol      string                  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Aggregation CompositeAggregation    `protobuf:"varint,2,opt,name=aggregation,proto3,enum=injective.oracle.v1beta1.CompositeAggregation" json:"aggregation,omitempty"`
+	Sources     []CompositeOracleSource `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources"`
+	// max_deviation is the maximum relative deviation of a source price from
+	// the median of all valid source prices. Sources beyond it are ignored.
+	// Zero disables the check
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation"`
+	// min_quorum is the minimum number of valid source prices needed to resolve
+	// the price
+	MinQuorum uint32 `protobuf:"varint,5,opt,name=min_quorum,json=minQuorum,proto3" json:"min_quorum,omitempty"`
+}
+
+func (m *CompositeOracle) Reset()         { *m = CompositeOracle{} }
+func (m *CompositeOracle) String() string { return proto.CompactTextString(m) }
+func (*CompositeOracle) ProtoMessage()    {}
+func (*CompositeOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{26}
+}
+func (m *CompositeOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompositeOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompositeOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *OracleInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompositeOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeOracle.Merge(m, src)
+}
+func (m *CompositeOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositeOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeOracle.DiscardUnknown(m)
 }
 
-func (m *OracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleType != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_CompositeOracle proto.InternalMessageInfo
+
+func (m *CompositeOracle) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *CompositeOracle) GetAggregation() CompositeAggregation {
+	if m != nil {
+		return m.Aggregation
 	}
-	return len(dAtA) - i, nil
+	return CompositeAggregation_Median
 }
 
-func (m *ChainlinkPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CompositeOracle) GetSources() []CompositeOracleSource {
+	if m != nil {
+		return m.Sources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChainlinkPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompositeOracle) GetMinQuorum() uint32 {
+	if m != nil {
+		return m.MinQuorum
+	}
+	return 0
 }
 
-func (m *ChainlinkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+type CompositePriceState struct {
+	Symbol     string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PriceState PriceState `protobuf:"bytes,2,opt,name=price_state,json=priceState,proto3" json:"price_state"`
+	// number of sources used to resolve the last price
+	SourcesUsed uint32 `protobuf:"varint,3,opt,name=sources_used,json=sourcesUsed,proto3" json:"sources_used,omitempty"`
+}
+
+func (m *CompositePriceState) Reset()         { *m = CompositePriceState{} }
+func (m *CompositePriceState) String() string { return proto.CompactTextString(m) }
+func (*CompositePriceState) ProtoMessage()    {}
+func (*CompositePriceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{27}
+}
+func (m *CompositePriceState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompositePriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompositePriceState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Answer.Size()
-		i -= size
-		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CompositePriceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositePriceState.Merge(m, src)
+}
+func (m *CompositePriceState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositePriceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositePriceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompositePriceState proto.InternalMessageInfo
+
+func (m *CompositePriceState) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BandPriceState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CompositePriceState) GetPriceState() PriceState {
+	if m != nil {
+		return m.PriceState
 	}
-	return dAtA[:n], nil
+	return PriceState{}
 }
 
-func (m *BandPriceState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompositePriceState) GetSourcesUsed() uint32 {
+	if m != nil {
+		return m.SourcesUsed
+	}
+	return 0
 }
 
-func (m *BandPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+// CircuitBreaker guards the prices relayed for a symbol of a Pyth, Stork,
+// Provider or PriceFeed oracle
+type CircuitBreaker struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	// base is the price id for Pyth, the asset id for Stork, the base for
+	// PriceFeed or the symbol for Provider oracles
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// quote is the quote for PriceFeed or the provider for Provider oracles,
+	// empty otherwise
+	Quote string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	// max_deviation is the maximum relative change of a relayed price from the
+	// last price. Zero disables the check
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation"`
+	// max_staleness is the maximum age of the price in seconds. Zero disables
+	// the check
+	MaxStaleness int64                `protobuf:"varint,5,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+	Action       CircuitBreakerAction `protobuf:"varint,6,opt,name=action,proto3,enum=injective.oracle.v1beta1.CircuitBreakerAction" json:"action,omitempty"`
+	// recovery_prints is the number of consecutive prices breaching the max
+	// deviation, each within the max deviation of the previous one, after which
+	// a RejectPrice circuit breaker accepts the new price level. Zero leaves the
+	// symbol halted until governance removes or replaces the circuit breaker
+	RecoveryPrints uint32 `protobuf:"varint,7,opt,name=recovery_prints,json=recoveryPrints,proto3" json:"recovery_prints,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{28}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Request_ID != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Request_ID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ResolveTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
 }
 
-func (m *PriceFeedState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
 	}
-	return dAtA[:n], nil
+	return OracleType_Unspecified
 }
 
-func (m *PriceFeedState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CircuitBreaker) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
 }
 
-func (m *PriceFeedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.PriceState != nil {
-		{
-			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
-		i--
-		dAtA[i] = 0x12
+func (m *CircuitBreaker) GetQuote() string {
+	if m != nil {
+		return m.Quote
 	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *CircuitBreaker) GetMaxStaleness() int64 {
+	if m != nil {
+		return m.MaxStaleness
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CircuitBreaker) GetAction() CircuitBreakerAction {
+	if m != nil {
+		return m.Action
 	}
-	return dAtA[:n], nil
+	return CircuitBreakerAction_RejectPrice
 }
 
-func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CircuitBreaker) GetRecoveryPrints() uint32 {
+	if m != nil {
+		return m.RecoveryPrints
+	}
+	return 0
 }
 
-func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+// CircuitBreakerHalt is stored while a circuit breaker halts its symbol
+type CircuitBreakerHalt struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Base       string     `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote      string     `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	Reason     string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block time at which the symbol was halted
+	HaltedAt int64 `protobuf:"varint,5,opt,name=halted_at,json=haltedAt,proto3" json:"halted_at,omitempty"`
+	// last price rejected for breaching the max deviation, which the next
+	// rejected prices are compared against to re-anchor the price
+	CandidatePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=candidate_price,json=candidatePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"candidate_price"`
+	// number of consecutive rejected prices consistent with the candidate price
+	ConsistentPrints uint32 `protobuf:"varint,7,opt,name=consistent_prints,json=consistentPrints,proto3" json:"consistent_prints,omitempty"`
+}
+
+func (m *CircuitBreakerHalt) Reset()         { *m = CircuitBreakerHalt{} }
+func (m *CircuitBreakerHalt) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerHalt) ProtoMessage()    {}
+func (*CircuitBreakerHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{29}
+}
+func (m *CircuitBreakerHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CircuitBreakerHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerHalt.Merge(m, src)
+}
+func (m *CircuitBreakerHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerHalt proto.InternalMessageInfo
+
+func (m *CircuitBreakerHalt) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
 	}
-	return len(dAtA) - i, nil
+	return OracleType_Unspecified
 }
 
-func (m *ProviderState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CircuitBreakerHalt) GetBase() string {
+	if m != nil {
+		return m.Base
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProviderState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CircuitBreakerHalt) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
 }
 
-func (m *ProviderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderPriceStates) > 0 {
-		for iNdEx := len(m.ProviderPriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProviderPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *CircuitBreakerHalt) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CircuitBreakerHalt) GetHaltedAt() int64 {
+	if m != nil {
+		return m.HaltedAt
+	}
+	return 0
+}
+
+func (m *CircuitBreakerHalt) GetConsistentPrints() uint32 {
+	if m != nil {
+		return m.ConsistentPrints
+	}
+	return 0
+}
+
+// ChainlinkDataStreamsDON is the signer set of a Chainlink Data Streams DON
+// configuration, as registered in the Chainlink verifier contracts
+type ChainlinkDataStreamsDON struct {
+	// config_digest identifies the DON configuration the reports are signed
+	// under, hex encoded
+	ConfigDigest string `protobuf:"bytes,1,opt,name=config_digest,json=configDigest,proto3" json:"config_digest,omitempty"`
+	// signers are the ethereum addresses of the DON's oracle signers
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// f is the maximum number of faulty signers. Reports must be signed by
+	// exactly f+1 distinct signers
+	F uint32 `protobuf:"varint,3,opt,name=f,proto3" json:"f,omitempty"`
+}
+
+func (m *ChainlinkDataStreamsDON) Reset()         { *m = ChainlinkDataStreamsDON{} }
+func (m *ChainlinkDataStreamsDON) String() string { return proto.CompactTextString(m) }
+func (*ChainlinkDataStreamsDON) ProtoMessage()    {}
+func (*ChainlinkDataStreamsDON) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{30}
+}
+func (m *ChainlinkDataStreamsDON) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainlinkDataStreamsDON) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainlinkDataStreamsDON.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ProviderInfo != nil {
-		{
-			size, err := m.ProviderInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
+}
+func (m *ChainlinkDataStreamsDON) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainlinkDataStreamsDON.Merge(m, src)
+}
+func (m *ChainlinkDataStreamsDON) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainlinkDataStreamsDON) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainlinkDataStreamsDON.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainlinkDataStreamsDON proto.InternalMessageInfo
+
+func (m *ChainlinkDataStreamsDON) GetConfigDigest() string {
+	if m != nil {
+		return m.ConfigDigest
+	}
+	return ""
+}
+
+func (m *ChainlinkDataStreamsDON) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ChainlinkDataStreamsDON) GetF() uint32 {
+	if m != nil {
+		return m.F
+	}
+	return 0
+}
+
+type ChainlinkDataStreamsPriceState struct {
+	// feed_id is the Data Streams feed id, hex encoded
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// unix timestamp at which the price was observed by the DON
+	ObservationsTimestamp uint64 `protobuf:"varint,2,opt,name=observations_timestamp,json=observationsTimestamp,proto3" json:"observations_timestamp,omitempty"`
+	// unix timestamp after which the report can no longer be verified
+	ExpiresAt uint64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// the benchmark price of the report scaled by 1e18
+	BenchmarkPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=benchmark_price,json=benchmarkPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"benchmark_price"`
+	// the bid price of the report scaled by 1e18
+	Bid cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=bid,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bid"`
+	// the ask price of the report scaled by 1e18
+	Ask        cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=ask,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ask"`
+	PriceState PriceState                  `protobuf:"bytes,7,opt,name=price_state,json=priceState,proto3" json:"price_state"`
+}
+
+func (m *ChainlinkDataStreamsPriceState) Reset()         { *m = ChainlinkDataStreamsPriceState{} }
+func (m *ChainlinkDataStreamsPriceState) String() string { return proto.CompactTextString(m) }
+func (*ChainlinkDataStreamsPriceState) ProtoMessage()    {}
+func (*ChainlinkDataStreamsPriceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{31}
+}
+func (m *ChainlinkDataStreamsPriceState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainlinkDataStreamsPriceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainlinkDataStreamsPriceState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ChainlinkDataStreamsPriceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainlinkDataStreamsPriceState.Merge(m, src)
+}
+func (m *ChainlinkDataStreamsPriceState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainlinkDataStreamsPriceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainlinkDataStreamsPriceState.DiscardUnknown(m)
 }
 
-func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_ChainlinkDataStreamsPriceState proto.InternalMessageInfo
+
+func (m *ChainlinkDataStreamsPriceState) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *ChainlinkDataStreamsPriceState) GetObservationsTimestamp() uint64 {
+	if m != nil {
+		return m.ObservationsTimestamp
+	}
+	return 0
+}
+
+func (m *ChainlinkDataStreamsPriceState) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *ChainlinkDataStreamsPriceState) GetPriceState() PriceState {
+	if m != nil {
+		return m.PriceState
+	}
+	return PriceState{}
+}
+
+func init() {
+	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
+	proto.RegisterEnum("injective.oracle.v1beta1.CompositeAggregation", CompositeAggregation_name, CompositeAggregation_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.CompositeAggregation", CompositeAggregation_name, CompositeAggregation_value)
+	proto.RegisterEnum("injective.oracle.v1beta1.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.CircuitBreakerAction", CircuitBreakerAction_name, CircuitBreakerAction_value)
+	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
+	golang_proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
+	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
+	golang_proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
+	proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
+	golang_proto.RegisterType((*ChainlinkPriceState)(nil), "injective.oracle.v1beta1.ChainlinkPriceState")
+	proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
+	golang_proto.RegisterType((*BandPriceState)(nil), "injective.oracle.v1beta1.BandPriceState")
+	proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
+	golang_proto.RegisterType((*PriceFeedState)(nil), "injective.oracle.v1beta1.PriceFeedState")
+	proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
+	golang_proto.RegisterType((*ProviderInfo)(nil), "injective.oracle.v1beta1.ProviderInfo")
+	proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
+	golang_proto.RegisterType((*ProviderState)(nil), "injective.oracle.v1beta1.ProviderState")
+	proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
+	golang_proto.RegisterType((*ProviderPriceState)(nil), "injective.oracle.v1beta1.ProviderPriceState")
+	proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
+	golang_proto.RegisterType((*PriceFeedInfo)(nil), "injective.oracle.v1beta1.PriceFeedInfo")
+	proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
+	golang_proto.RegisterType((*PriceFeedPrice)(nil), "injective.oracle.v1beta1.PriceFeedPrice")
+	proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
+	golang_proto.RegisterType((*CoinbasePriceState)(nil), "injective.oracle.v1beta1.CoinbasePriceState")
+	proto.RegisterType((*StorkPriceState)(nil), "injective.oracle.v1beta1.StorkPriceState")
+	golang_proto.RegisterType((*StorkPriceState)(nil), "injective.oracle.v1beta1.StorkPriceState")
+	proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
+	golang_proto.RegisterType((*PriceState)(nil), "injective.oracle.v1beta1.PriceState")
+	proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
+	golang_proto.RegisterType((*PythPriceState)(nil), "injective.oracle.v1beta1.PythPriceState")
+	proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
+	golang_proto.RegisterType((*BandOracleRequest)(nil), "injective.oracle.v1beta1.BandOracleRequest")
+	proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
+	golang_proto.RegisterType((*BandIBCParams)(nil), "injective.oracle.v1beta1.BandIBCParams")
+	proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
+	golang_proto.RegisterType((*SymbolPriceTimestamp)(nil), "injective.oracle.v1beta1.SymbolPriceTimestamp")
+	proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
+	golang_proto.RegisterType((*LastPriceTimestamps)(nil), "injective.oracle.v1beta1.LastPriceTimestamps")
+	proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
+	golang_proto.RegisterType((*PriceRecords)(nil), "injective.oracle.v1beta1.PriceRecords")
+	proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
+	golang_proto.RegisterType((*PriceRecord)(nil), "injective.oracle.v1beta1.PriceRecord")
+	proto.RegisterType((*PriceTWAP)(nil), "injective.oracle.v1beta1.PriceTWAP")
+	golang_proto.RegisterType((*PriceTWAP)(nil), "injective.oracle.v1beta1.PriceTWAP")
+	proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
+	golang_proto.RegisterType((*MetadataStatistics)(nil), "injective.oracle.v1beta1.MetadataStatistics")
+	proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+	golang_proto.RegisterType((*PriceAttestation)(nil), "injective.oracle.v1beta1.PriceAttestation")
+	proto.RegisterType((*AssetPair)(nil), "injective.oracle.v1beta1.AssetPair")
+	golang_proto.RegisterType((*AssetPair)(nil), "injective.oracle.v1beta1.AssetPair")
+	proto.RegisterType((*SignedPriceOfAssetPair)(nil), "injective.oracle.v1beta1.SignedPriceOfAssetPair")
+	golang_proto.RegisterType((*SignedPriceOfAssetPair)(nil), "injective.oracle.v1beta1.SignedPriceOfAssetPair")
+	proto.RegisterType((*CompositeOracleSource)(nil), "injective.oracle.v1beta1.CompositeOracleSource")
+	golang_proto.RegisterType((*CompositeOracleSource)(nil), "injective.oracle.v1beta1.CompositeOracleSource")
+	proto.RegisterType((*CompositeOracle)(nil), "injective.oracle.v1beta1.CompositeOracle")
+	golang_proto.RegisterType((*CompositeOracle)(nil), "injective.oracle.v1beta1.CompositeOracle")
+	proto.RegisterType((*CompositePriceState)(nil), "injective.oracle.v1beta1.CompositePriceState")
+	golang_proto.RegisterType((*CompositePriceState)(nil), "injective.oracle.v1beta1.CompositePriceState")
+	proto.RegisterType((*CircuitBreaker)(nil), "injective.oracle.v1beta1.CircuitBreaker")
+	golang_proto.RegisterType((*CircuitBreaker)(nil), "injective.oracle.v1beta1.CircuitBreaker")
+	proto.RegisterType((*CircuitBreakerHalt)(nil), "injective.oracle.v1beta1.CircuitBreakerHalt")
+	golang_proto.RegisterType((*CircuitBreakerHalt)(nil), "injective.oracle.v1beta1.CircuitBreakerHalt")
+	proto.RegisterType((*ChainlinkDataStreamsDON)(nil), "injective.oracle.v1beta1.ChainlinkDataStreamsDON")
+	golang_proto.RegisterType((*ChainlinkDataStreamsDON)(nil), "injective.oracle.v1beta1.ChainlinkDataStreamsDON")
+	proto.RegisterType((*ChainlinkDataStreamsPriceState)(nil), "injective.oracle.v1beta1.ChainlinkDataStreamsPriceState")
+	golang_proto.RegisterType((*ChainlinkDataStreamsPriceState)(nil), "injective.oracle.v1beta1.ChainlinkDataStreamsPriceState")
+}
+
+func init() {
+	proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+}
+func init() {
+	golang_proto.RegisterFile("injective/oracle/v1beta1/oracle.proto", fileDescriptor_1c8fbf1e7a765423)
+}
+
+var fileDescriptor_1c8fbf1e7a765423 = []byte{
+	// 2377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4d, 0x8f, 0x1c, 0x47,
+	0x75, 0x7b, 0x66, 0x76, 0x3e, 0xde, 0x7c, 0x6c, 0xbb, 0x76, 0xed, 0x8c, 0x9d, 0x64, 0xd7, 0x4c,
+	0x08, 0xac, 0x4c, 0xb2, 0x6b, 0x3b, 0x04, 0xe4, 0x04, 0xa1, 0xec, 0x87, 0x9d, 0x8c, 0xbc, 0x8e,
+	0x97, 0x5e, 0x1b, 0x4b, 0x5c, 0x86, 0x9a, 0xee, 0x9a, 0x99, 0xca, 0xf6, 0x97, 0xbb, 0x6a, 0xd6,
+	0xbb, 0x96, 0xb8, 0xe6, 0xc0, 0x05, 0x8e, 0x5c, 0x90, 0x38, 0x73, 0xe2, 0x40, 0x4e, 0x48, 0x08,
+	0x21, 0x0e, 0xb9, 0x11, 0x09, 0x29, 0x42, 0x1c, 0x02, 0xb1, 0x0f, 0x20, 0xae, 0xfc, 0x01, 0xf4,
+	0xaa, 0xaa, 0x7b, 0x7a, 0x3f, 0x6c, 0xef, 0xc4, 0x49, 0x2e, 0xbb, 0x5d, 0xaf, 0xde, 0x7b, 0xf5,
+	0xbe, 0xea, 0xbd, 0x57, 0x6f, 0xe0, 0x55, 0x1e, 0x7e, 0xc0, 0x5c, 0xc9, 0xf7, 0xd8, 0x6a, 0x94,
+	0x50, 0xd7, 0x67, 0xab, 0x7b, 0x57, 0xfa, 0x4c, 0xd2, 0x2b, 0x66, 0xb9, 0x12, 0x27, 0x91, 0x8c,
+	0x48, 0x3b, 0x43, 0x5b, 0x31, 0x70, 0x83, 0x76, 0x61, 0x61, 0x18, 0x0d, 0x23, 0x85, 0xb4, 0x8a,
+	0x5f, 0x1a, 0xff, 0xc2, 0xa2, 0x1b, 0x89, 0x20, 0x12, 0xab, 0x7d, 0x2a, 0x26, 0x1c, 0xdd, 0x88,
+	0x87, 0x66, 0xff, 0x0c, 0x0d, 0x78, 0x18, 0xad, 0xaa, 0xbf, 0x1a, 0xd4, 0xb9, 0x0e, 0xe5, 0x6d,
+	0x9a, 0xd0, 0x40, 0x90, 0x57, 0xa0, 0x19, 0x1f, 0xc8, 0x51, 0xcf, 0x8d, 0x42, 0x99, 0x50, 0x57,
+	0xb6, 0xad, 0x8b, 0xd6, 0x72, 0xcd, 0x69, 0x20, 0x70, 0xc3, 0xc0, 0xde, 0x3a, 0xf7, 0x9f, 0xdf,
+	0x2c, 0x59, 0x3f, 0xff, 0xf7, 0xef, 0x2e, 0x35, 0x8d, 0xdc, 0x9a, 0xb8, 0xb3, 0x0b, 0x70, 0x5b,
+	0x01, 0xba, 0xe1, 0x20, 0x22, 0xe7, 0xa0, 0x2c, 0x0e, 0x82, 0x7e, 0xe4, 0x1b, 0x1e, 0x66, 0x45,
+	0xae, 0x43, 0x5d, 0x93, 0xf5, 0xe4, 0x41, 0xcc, 0xda, 0x85, 0x8b, 0xd6, 0x72, 0xeb, 0xea, 0x37,
+	0x57, 0x9e, 0xa4, 0xe5, 0x8a, 0x66, 0x79, 0xe7, 0x20, 0x66, 0x0e, 0x44, 0xd9, 0x77, 0xe7, 0x53,
+	0x0b, 0xe6, 0x37, 0x46, 0x94, 0x87, 0x3e, 0x0f, 0x77, 0xb7, 0x13, 0xee, 0xb2, 0x1d, 0x49, 0x25,
+	0x23, 0x2f, 0x40, 0x65, 0xc0, 0x98, 0xd7, 0xe3, 0x5e, 0x7a, 0x2e, 0x2e, 0xbb, 0x1e, 0x79, 0x1b,
+	0xca, 0x34, 0x14, 0x0f, 0x58, 0xa2, 0x8e, 0xac, 0xad, 0xbf, 0xf2, 0xf1, 0x67, 0x4b, 0x33, 0xff,
+	0xf8, 0x6c, 0xe9, 0x45, 0x6d, 0x2f, 0xe1, 0xed, 0xae, 0xf0, 0x68, 0x35, 0xa0, 0x72, 0xb4, 0xb2,
+	0xc5, 0x86, 0xd4, 0x3d, 0xd8, 0x64, 0xae, 0x63, 0x48, 0xc8, 0x4b, 0x50, 0x93, 0x3c, 0x60, 0x42,
+	0xd2, 0x20, 0x6e, 0x17, 0x2f, 0x5a, 0xcb, 0x25, 0x67, 0x02, 0x20, 0x37, 0xa1, 0x1e, 0xa3, 0x04,
+	0x3d, 0x81, 0x22, 0xb4, 0x4b, 0x17, 0xad, 0xe5, 0xfa, 0xd3, 0x54, 0x9a, 0x88, 0xbb, 0x5e, 0x42,
+	0x29, 0x1c, 0x88, 0x33, 0x48, 0xe7, 0xbf, 0x16, 0xb4, 0xd6, 0x69, 0xe8, 0xe5, 0x74, 0x7a, 0x92,
+	0x29, 0xaf, 0x40, 0x29, 0xc1, 0x03, 0xb5, 0x42, 0x2f, 0x1b, 0x85, 0xce, 0x1e, 0x57, 0xa8, 0x1b,
+	0x4a, 0x47, 0xa1, 0x92, 0x6f, 0x40, 0x23, 0x61, 0x22, 0xf2, 0xf7, 0x58, 0x0f, 0xe5, 0x37, 0xba,
+	0xd4, 0x0d, 0xec, 0x0e, 0x0f, 0x18, 0x79, 0x19, 0x20, 0x61, 0xf7, 0xc7, 0x4c, 0xc8, 0x5e, 0x77,
+	0x53, 0x29, 0x53, 0x72, 0x6a, 0x06, 0xd2, 0xdd, 0x3c, 0xaa, 0xec, 0xec, 0x73, 0x29, 0xfb, 0x6b,
+	0x0b, 0x5a, 0x0a, 0xe1, 0x06, 0x63, 0x9e, 0x56, 0x96, 0x40, 0x09, 0x43, 0xd7, 0xa8, 0xaa, 0xbe,
+	0xc9, 0x02, 0xcc, 0xde, 0x1f, 0x47, 0xa9, 0xa6, 0x8e, 0x5e, 0x60, 0x24, 0xe5, 0x25, 0x29, 0x9e,
+	0x5e, 0x92, 0xbc, 0x0c, 0xe4, 0x02, 0x54, 0x13, 0xe6, 0xd3, 0x03, 0x96, 0x88, 0x76, 0xe9, 0x62,
+	0x71, 0xb9, 0xe6, 0x64, 0xeb, 0xce, 0x0d, 0x68, 0x6c, 0x27, 0xd1, 0x1e, 0xf7, 0x58, 0xa2, 0x82,
+	0xfa, 0x02, 0x54, 0x63, 0xb3, 0x36, 0x02, 0x66, 0xeb, 0x43, 0x7c, 0x0a, 0x47, 0xf8, 0xfc, 0xd1,
+	0x82, 0x66, 0xca, 0x48, 0x9f, 0x7a, 0x13, 0x9a, 0x29, 0x65, 0x8f, 0x87, 0x83, 0x48, 0xb1, 0xab,
+	0x5f, 0xfd, 0xd6, 0xd3, 0xc4, 0x9f, 0x08, 0xe2, 0x34, 0xe2, 0xbc, 0x58, 0x3f, 0x85, 0xb3, 0x19,
+	0xb3, 0x9c, 0x49, 0xb4, 0x1c, 0xf5, 0xab, 0xaf, 0x3d, 0x9b, 0x69, 0xce, 0x36, 0xf3, 0xf1, 0x31,
+	0x98, 0xe8, 0x8c, 0x80, 0x1c, 0x47, 0x7d, 0x62, 0x60, 0xbe, 0x05, 0xb3, 0xda, 0x27, 0x85, 0x29,
+	0x7c, 0xa2, 0x49, 0x3a, 0xd7, 0xa0, 0x99, 0x45, 0x84, 0x52, 0xee, 0xd4, 0x01, 0xd1, 0xb9, 0x99,
+	0x0b, 0x26, 0xf5, 0x41, 0xae, 0xc1, 0xac, 0xb2, 0x47, 0xdb, 0x3a, 0xfd, 0x9d, 0xd7, 0x14, 0x9d,
+	0x3f, 0x58, 0x40, 0x36, 0x22, 0x1e, 0xe2, 0x79, 0x39, 0x95, 0x09, 0x94, 0x76, 0x79, 0x98, 0x26,
+	0x17, 0xf5, 0x7d, 0x38, 0x3b, 0x14, 0x8e, 0x66, 0x07, 0x1b, 0x8a, 0xbb, 0xec, 0x40, 0x85, 0x67,
+	0xcd, 0xc1, 0x4f, 0x94, 0x7e, 0x8f, 0xfa, 0x63, 0x66, 0x2e, 0x97, 0x5e, 0x7c, 0xb9, 0x17, 0xeb,
+	0xaf, 0x16, 0xcc, 0xed, 0xc8, 0x28, 0xc9, 0xa7, 0xc6, 0x43, 0x62, 0x5a, 0x47, 0xc5, 0x9c, 0xf8,
+	0xb2, 0x70, 0xc8, 0x97, 0xd7, 0x52, 0x61, 0x8b, 0x53, 0x98, 0xf0, 0x2b, 0xd0, 0xe8, 0x23, 0x0b,
+	0x20, 0xa7, 0xcc, 0x17, 0xf7, 0x2c, 0x79, 0x1f, 0x6c, 0x77, 0x1c, 0x8c, 0x7d, 0x8a, 0x32, 0xe8,
+	0xfb, 0x32, 0x4d, 0x4d, 0x98, 0x9b, 0x10, 0xeb, 0x20, 0x3b, 0x56, 0x1c, 0x8a, 0x39, 0xbb, 0x76,
+	0x3e, 0x2d, 0x40, 0x6b, 0xfb, 0x40, 0x8e, 0x72, 0xb2, 0x9f, 0x87, 0xaa, 0xb6, 0x4b, 0x56, 0xa4,
+	0x2a, 0x6a, 0xdd, 0xf5, 0xc8, 0x3b, 0x50, 0x63, 0x01, 0x9d, 0x5e, 0xa8, 0x2a, 0x0b, 0xa8, 0x96,
+	0xe6, 0x87, 0x80, 0xdf, 0x58, 0xc1, 0x07, 0xd3, 0xb8, 0xac, 0xc2, 0x02, 0xba, 0x11, 0x85, 0x03,
+	0xf2, 0x7d, 0x28, 0x29, 0xda, 0xd2, 0xe9, 0x69, 0x15, 0x01, 0x96, 0x96, 0x78, 0xdc, 0xf7, 0xb9,
+	0x18, 0xe9, 0xd2, 0x32, 0xab, 0x4b, 0x8b, 0x81, 0xa9, 0xd2, 0x72, 0x24, 0x20, 0xca, 0xcf, 0x15,
+	0x10, 0x1f, 0x16, 0xe1, 0x0c, 0x16, 0x4a, 0xdd, 0x20, 0x38, 0xba, 0x40, 0xe5, 0xab, 0x97, 0xb1,
+	0x6e, 0xae, 0x7a, 0x79, 0x64, 0x19, 0x6c, 0xd3, 0x7d, 0x08, 0x37, 0xe1, 0xb1, 0x42, 0x2a, 0x28,
+	0x97, 0xb5, 0x34, 0x7c, 0x47, 0x81, 0xbb, 0x1e, 0x69, 0x43, 0x45, 0xdf, 0x00, 0xd1, 0x2e, 0xaa,
+	0x6c, 0x9e, 0x2e, 0xc9, 0x8b, 0x50, 0xa3, 0x62, 0xb7, 0xe7, 0x46, 0xe3, 0x50, 0x9a, 0x2b, 0x5c,
+	0xa5, 0x62, 0x77, 0x03, 0xd7, 0xb8, 0x19, 0xf0, 0xd0, 0x6c, 0x6a, 0x13, 0x54, 0x03, 0x1e, 0xea,
+	0xcd, 0x11, 0xd4, 0x06, 0x8c, 0xf5, 0x7c, 0x1e, 0x70, 0xd9, 0x2e, 0xab, 0xdc, 0x7c, 0x7e, 0x45,
+	0x5b, 0x76, 0x05, 0xf3, 0x4c, 0xa6, 0x38, 0x26, 0x9e, 0xf5, 0xcb, 0xa8, 0xf2, 0x6f, 0xff, 0xb9,
+	0xb4, 0x3c, 0xe4, 0x72, 0x34, 0xee, 0xaf, 0xb8, 0x51, 0xb0, 0x6a, 0x9a, 0x3b, 0xfd, 0xef, 0x75,
+	0xe1, 0xed, 0xae, 0x62, 0x17, 0x25, 0x14, 0x81, 0x70, 0xaa, 0x03, 0xc6, 0xb6, 0x90, 0x39, 0x59,
+	0x42, 0x4b, 0xb3, 0x98, 0x26, 0xac, 0x37, 0xa4, 0xa2, 0x5d, 0x51, 0x82, 0x80, 0x01, 0xbd, 0x4b,
+	0x05, 0x22, 0xb0, 0x7d, 0xe6, 0x8e, 0xa5, 0x46, 0xa8, 0x6a, 0x04, 0x03, 0x42, 0x84, 0x65, 0xb0,
+	0x51, 0x11, 0x11, 0x8d, 0x13, 0x97, 0x19, 0x7d, 0x6a, 0x0a, 0xab, 0x15, 0xf0, 0x70, 0x47, 0x81,
+	0x95, 0x56, 0x9d, 0x0f, 0x0b, 0xd0, 0x44, 0x47, 0x74, 0xd7, 0x37, 0x4c, 0x1b, 0xb9, 0x0c, 0x76,
+	0x9f, 0x86, 0x5e, 0x8f, 0xf7, 0xdd, 0x1e, 0x0b, 0x69, 0xdf, 0x67, 0xda, 0x15, 0x55, 0xa7, 0x85,
+	0xf0, 0x6e, 0xdf, 0xbd, 0xae, 0xa1, 0xe4, 0x32, 0x2c, 0x20, 0x52, 0xe6, 0xb2, 0x50, 0xb2, 0x64,
+	0x8f, 0xfa, 0xc6, 0x27, 0x84, 0xf7, 0x5d, 0xe3, 0xd8, 0xae, 0xd9, 0x21, 0xaf, 0x01, 0x42, 0x33,
+	0xb9, 0x46, 0x34, 0x0c, 0x99, 0x6f, 0xb2, 0xab, 0xcd, 0xfb, 0xae, 0x91, 0x4c, 0xc3, 0x51, 0x4d,
+	0xc4, 0xde, 0x63, 0x89, 0xe0, 0x51, 0xa8, 0x83, 0xda, 0x01, 0xde, 0x77, 0x7f, 0xac, 0x21, 0x64,
+	0x51, 0x23, 0xc4, 0x51, 0xa2, 0x62, 0x61, 0x56, 0x21, 0xd4, 0x78, 0xdf, 0xdd, 0x8e, 0x12, 0x0c,
+	0x83, 0x4b, 0x70, 0xc6, 0x57, 0x81, 0xde, 0x33, 0x71, 0xc3, 0x3d, 0xa1, 0x5c, 0x57, 0x74, 0xe6,
+	0xf4, 0x86, 0xe9, 0x79, 0x3d, 0xd1, 0xf9, 0x85, 0x05, 0x0b, 0x3b, 0x2a, 0x48, 0x54, 0xe0, 0xde,
+	0xc9, 0x72, 0xeb, 0x0f, 0xa0, 0xac, 0xa9, 0xdb, 0xd6, 0x14, 0xed, 0xae, 0xa1, 0xc1, 0x90, 0xd2,
+	0xa1, 0x97, 0x06, 0x6b, 0xcd, 0xa9, 0x6a, 0x40, 0xd7, 0x7b, 0x46, 0xf2, 0x39, 0x80, 0xf9, 0x2d,
+	0x2a, 0xe4, 0x61, 0x71, 0x04, 0xe9, 0xc3, 0x59, 0x9f, 0x0a, 0x69, 0x7a, 0x85, 0x0c, 0x5d, 0xb4,
+	0x2d, 0x15, 0x93, 0x2b, 0x4f, 0x16, 0xef, 0x24, 0xf5, 0x9c, 0x79, 0xff, 0xf8, 0x19, 0x9d, 0x3f,
+	0x5b, 0xd8, 0x3b, 0x71, 0x97, 0x39, 0xcc, 0x8d, 0x12, 0x4f, 0x7c, 0x95, 0x46, 0xb8, 0x07, 0x0b,
+	0x3e, 0x95, 0x2c, 0xd3, 0x28, 0xd1, 0x47, 0xaa, 0x8b, 0x5b, 0xbf, 0xfa, 0xea, 0x33, 0x12, 0x8c,
+	0x16, 0xd0, 0x21, 0x9a, 0x45, 0x5e, 0xe6, 0xce, 0x00, 0xea, 0xb9, 0xf5, 0xf1, 0x0a, 0x9a, 0x37,
+	0xf6, 0xa4, 0x24, 0x15, 0xa6, 0x6e, 0x36, 0xfe, 0x62, 0x41, 0x4d, 0x1b, 0xf0, 0xde, 0xda, 0x36,
+	0xa6, 0x60, 0xf9, 0x80, 0xc6, 0xd3, 0x94, 0x36, 0x45, 0x80, 0xc9, 0x4f, 0x48, 0x9a, 0x48, 0x9d,
+	0x80, 0xf5, 0x1d, 0xaa, 0x29, 0x88, 0x4a, 0xbf, 0xe7, 0xa1, 0xca, 0x42, 0x6f, 0xd2, 0xf8, 0x17,
+	0x9d, 0x0a, 0x0b, 0x3d, 0xb5, 0xf5, 0x0a, 0x34, 0x8d, 0xd1, 0x72, 0x79, 0xad, 0xe9, 0x34, 0x0c,
+	0x50, 0xa7, 0xaf, 0x25, 0xa8, 0xfb, 0x34, 0x19, 0xa2, 0x9d, 0x87, 0x34, 0x56, 0x77, 0xa5, 0xe8,
+	0x80, 0x01, 0xbd, 0x4b, 0xe3, 0xce, 0xff, 0x8a, 0x40, 0x6e, 0x31, 0x49, 0x3d, 0x2a, 0x29, 0x26,
+	0x69, 0x2e, 0x24, 0x77, 0x55, 0xae, 0x19, 0x26, 0xd1, 0x38, 0x36, 0xac, 0x2d, 0xc5, 0x1a, 0x14,
+	0x48, 0x33, 0x5e, 0x81, 0xf9, 0xf4, 0x74, 0x41, 0x83, 0x18, 0xb3, 0x33, 0x7f, 0xa8, 0x15, 0x68,
+	0x3a, 0x67, 0xcc, 0xd6, 0x8e, 0xda, 0xd9, 0xe1, 0x0f, 0x19, 0x1a, 0x28, 0x60, 0x34, 0x9c, 0xa6,
+	0xbe, 0x29, 0x82, 0xcc, 0xb2, 0xa5, 0x69, 0x2d, 0xfb, 0x6d, 0x98, 0x1b, 0xf0, 0x44, 0xc8, 0xc9,
+	0x65, 0x31, 0xea, 0xb7, 0x14, 0x78, 0x72, 0xd5, 0x5f, 0x85, 0x96, 0x4f, 0x0f, 0xe1, 0x95, 0x15,
+	0x5e, 0xd3, 0xa7, 0x79, 0xb4, 0x77, 0x74, 0x99, 0xd0, 0xf1, 0x52, 0x99, 0xa2, 0xce, 0x07, 0x3c,
+	0xd4, 0x75, 0x1e, 0x39, 0xd0, 0x7d, 0xc3, 0xa1, 0x3a, 0x0d, 0x07, 0xba, 0xaf, 0x39, 0xdc, 0x80,
+	0x46, 0xc0, 0x3c, 0x4e, 0x53, 0x31, 0x6a, 0xa7, 0x67, 0x52, 0xd7, 0x84, 0x8a, 0x4f, 0xe7, 0x73,
+	0x0b, 0x6c, 0xf5, 0xb5, 0x26, 0xf1, 0x02, 0x51, 0x89, 0x79, 0xf5, 0x29, 0x3d, 0xce, 0x42, 0xfe,
+	0x9e, 0x14, 0xd3, 0xae, 0x8c, 0x98, 0xbe, 0x43, 0xbf, 0x48, 0xd5, 0x37, 0xc2, 0xd8, 0x7e, 0x1c,
+	0x29, 0x77, 0xcd, 0x3a, 0xea, 0x1b, 0x13, 0xc1, 0xa4, 0x43, 0xd2, 0x3e, 0x98, 0x34, 0x3f, 0xe7,
+	0x73, 0xcd, 0x4f, 0x59, 0x31, 0xca, 0xfa, 0x1a, 0xb3, 0xa5, 0xf8, 0x55, 0x14, 0x3f, 0xdc, 0xba,
+	0x8e, 0x2c, 0x8f, 0x76, 0x2e, 0x55, 0xc5, 0x35, 0xdf, 0xb9, 0x74, 0x7e, 0x06, 0xb5, 0x35, 0x21,
+	0x98, 0xdc, 0xa6, 0x3c, 0x41, 0x56, 0x14, 0x17, 0x39, 0xdd, 0xd4, 0xba, 0xeb, 0x91, 0xbb, 0xd0,
+	0x14, 0x7c, 0x18, 0x32, 0x4f, 0x0b, 0x98, 0xbe, 0xc0, 0x2e, 0x3f, 0x25, 0xa3, 0x2a, 0x74, 0x25,
+	0xfe, 0xed, 0x41, 0x76, 0x86, 0xd3, 0x10, 0x13, 0xb8, 0xe8, 0xfc, 0xde, 0x82, 0x73, 0x27, 0x23,
+	0xaa, 0x91, 0x8d, 0x16, 0x94, 0x25, 0x3d, 0x7c, 0x68, 0xa4, 0x23, 0x9b, 0x14, 0x78, 0x93, 0x1d,
+	0x3c, 0xe3, 0x85, 0x92, 0x25, 0xae, 0xe2, 0xd4, 0xbd, 0xf4, 0x4b, 0x50, 0x43, 0x41, 0xa9, 0x1c,
+	0x27, 0xfa, 0x39, 0xd3, 0x70, 0x26, 0x00, 0x9c, 0x65, 0x9c, 0xdd, 0x88, 0x82, 0x38, 0x12, 0x5c,
+	0x32, 0x9d, 0xd4, 0x75, 0x79, 0x3e, 0x3a, 0x05, 0xb2, 0xbe, 0xd8, 0x14, 0x28, 0x7b, 0x1b, 0x16,
+	0x4e, 0x7a, 0x1b, 0x16, 0xf3, 0xc3, 0x82, 0xb7, 0xa1, 0xfc, 0x80, 0xf1, 0xe1, 0x48, 0x4e, 0x73,
+	0xf7, 0x0d, 0x09, 0xda, 0x18, 0xef, 0x9a, 0x90, 0xd4, 0x67, 0x21, 0x13, 0xc2, 0xc4, 0x5d, 0x23,
+	0xa0, 0xfb, 0x3b, 0x29, 0xac, 0xf3, 0x51, 0x01, 0xe6, 0x8e, 0x28, 0xfb, 0xc4, 0x07, 0xf2, 0x36,
+	0xd4, 0xe9, 0x70, 0x98, 0xb0, 0xa1, 0xba, 0x2c, 0x66, 0x08, 0xf6, 0x94, 0xb2, 0x9b, 0xf1, 0x5d,
+	0x9b, 0x50, 0x39, 0x79, 0x16, 0xe4, 0x36, 0x54, 0x74, 0x4b, 0x94, 0x56, 0xbd, 0xd5, 0x53, 0x70,
+	0xcb, 0xbb, 0xc4, 0x74, 0xd8, 0x29, 0x17, 0xf2, 0x9e, 0xd6, 0xd9, 0x63, 0x7b, 0x5c, 0x0b, 0x39,
+	0x85, 0xdd, 0xd0, 0x30, 0x9b, 0x29, 0x21, 0x56, 0x25, 0xcc, 0x75, 0xf7, 0xc7, 0x51, 0x32, 0x0e,
+	0x94, 0xe9, 0x9a, 0x0e, 0x66, 0xbf, 0x1f, 0x29, 0x00, 0xce, 0x80, 0xe6, 0x33, 0x89, 0x4e, 0x31,
+	0x5c, 0x38, 0xf2, 0x88, 0x28, 0x3c, 0xcf, 0x23, 0x02, 0xaf, 0xbe, 0x51, 0xb8, 0x37, 0x16, 0xcc,
+	0x53, 0x31, 0xd3, 0x74, 0xea, 0x06, 0x76, 0x57, 0x30, 0xaf, 0xf3, 0x79, 0x01, 0x5a, 0x1b, 0x3c,
+	0x71, 0xc7, 0x5c, 0xae, 0x27, 0x8c, 0xee, 0xb2, 0xe4, 0xeb, 0x8f, 0xde, 0x2f, 0xcf, 0x19, 0xa7,
+	0x09, 0x65, 0x72, 0x03, 0xca, 0xd4, 0x55, 0xe7, 0x94, 0x9f, 0x19, 0x99, 0x87, 0x2c, 0xb3, 0xa6,
+	0xa8, 0x1c, 0x43, 0x8d, 0x55, 0x13, 0x8b, 0xf7, 0x1e, 0x4b, 0x0e, 0x30, 0x1f, 0x86, 0x52, 0xbf,
+	0x44, 0x9a, 0x4e, 0x2b, 0x05, 0x6f, 0x2b, 0x28, 0xde, 0x1d, 0x72, 0x98, 0xd3, 0x7b, 0xd4, 0x97,
+	0x5f, 0xbf, 0x9d, 0xcf, 0x41, 0x39, 0x61, 0x54, 0x64, 0x2f, 0x05, 0xb3, 0xc2, 0xa2, 0x33, 0xa2,
+	0xbe, 0x64, 0x5e, 0x8f, 0xca, 0xb4, 0xe8, 0x68, 0xc0, 0x9a, 0x24, 0x5b, 0x30, 0xe7, 0xd2, 0xd0,
+	0xe3, 0x1e, 0x95, 0xe9, 0x38, 0xa1, 0x7c, 0x7a, 0xf7, 0xb4, 0x32, 0x5a, 0x5d, 0xc2, 0xbe, 0x03,
+	0x67, 0xdc, 0x28, 0x14, 0x5c, 0x48, 0x16, 0xca, 0xc3, 0x56, 0xb3, 0x27, 0x1b, 0xc6, 0x6e, 0x03,
+	0x78, 0x21, 0x1b, 0x82, 0x6f, 0xaa, 0xa6, 0x2b, 0x61, 0x34, 0x10, 0x9b, 0xb7, 0xdf, 0x47, 0x47,
+	0x63, 0x19, 0xe4, 0xc3, 0x9e, 0xc7, 0xb1, 0x3f, 0x4b, 0xeb, 0x82, 0x06, 0x6e, 0x2a, 0x98, 0x7a,
+	0xe4, 0x62, 0x59, 0xc9, 0x46, 0x96, 0xe9, 0x92, 0x34, 0xc0, 0x1a, 0x98, 0xdb, 0x60, 0x0d, 0x3a,
+	0xbf, 0x2a, 0xc2, 0xe2, 0x49, 0x07, 0x9d, 0x66, 0xf0, 0xfe, 0x26, 0x9c, 0x8b, 0xfa, 0x02, 0x1f,
+	0x6f, 0x18, 0x13, 0xa2, 0x77, 0xb4, 0x10, 0x9d, 0xcd, 0xef, 0x4e, 0x3a, 0xa4, 0x97, 0x01, 0xd8,
+	0x7e, 0xcc, 0x13, 0x26, 0xd0, 0xe6, 0x66, 0xe6, 0x6e, 0x20, 0xda, 0xe8, 0x7d, 0x16, 0xba, 0xa3,
+	0x80, 0x26, 0xbb, 0xc6, 0xe8, 0x53, 0xdc, 0x89, 0x56, 0x46, 0xab, 0x8d, 0xfe, 0x26, 0x14, 0xfb,
+	0xe9, 0xeb, 0xef, 0x74, 0x1c, 0x10, 0x1f, 0xc9, 0xa8, 0xd8, 0x9d, 0xc6, 0xdb, 0x88, 0x7f, 0x34,
+	0x83, 0x55, 0x9e, 0x27, 0x83, 0x5d, 0xfa, 0x9b, 0x95, 0xfe, 0xec, 0xa2, 0x62, 0x7d, 0x0e, 0xea,
+	0x77, 0x43, 0x11, 0x33, 0x97, 0x0f, 0x38, 0xf3, 0xec, 0x19, 0x52, 0x85, 0x12, 0x3e, 0xce, 0x6d,
+	0x8b, 0x34, 0xa1, 0x96, 0x8d, 0x47, 0xed, 0x02, 0x69, 0x40, 0x35, 0x9d, 0x6f, 0xda, 0x45, 0xdc,
+	0xcc, 0x1c, 0x6c, 0x97, 0x48, 0x0d, 0x66, 0x1d, 0xfa, 0x30, 0x4a, 0xec, 0x59, 0x52, 0x81, 0xe2,
+	0x26, 0xa7, 0x76, 0x19, 0x39, 0xad, 0x6d, 0x77, 0xdf, 0xb0, 0x2b, 0x08, 0xba, 0x1b, 0x50, 0xbb,
+	0x8a, 0x20, 0x9c, 0x6d, 0xd9, 0x35, 0x52, 0x87, 0x8a, 0x99, 0x01, 0xd8, 0x80, 0xac, 0xd3, 0x69,
+	0xb1, 0x5d, 0x47, 0x5e, 0x6a, 0x14, 0x69, 0x37, 0xd4, 0x29, 0x69, 0xaa, 0xb7, 0x9b, 0xa4, 0x0d,
+	0x0b, 0x27, 0x45, 0x95, 0xdd, 0xba, 0xf4, 0x5d, 0x58, 0x38, 0xa9, 0xe6, 0x11, 0x80, 0xf2, 0x2d,
+	0xd5, 0x7a, 0xda, 0x33, 0xc4, 0x86, 0xc6, 0x3d, 0x55, 0x9f, 0x99, 0x77, 0x8b, 0xd1, 0xd0, 0xb6,
+	0x2e, 0x7d, 0x0f, 0x16, 0x4e, 0xca, 0x47, 0x68, 0x14, 0x87, 0xa1, 0x71, 0x95, 0xfe, 0xf6, 0x0c,
+	0xca, 0x81, 0x09, 0x46, 0x2f, 0xad, 0xf5, 0x0f, 0x3e, 0x7e, 0xb4, 0x68, 0x7d, 0xf2, 0x68, 0xd1,
+	0xfa, 0xd7, 0xa3, 0x45, 0xeb, 0x97, 0x8f, 0x17, 0x67, 0xfe, 0xf4, 0x78, 0xd1, 0xfa, 0xe4, 0xf1,
+	0xe2, 0xcc, 0xdf, 0x1f, 0x2f, 0xce, 0xfc, 0x64, 0x2b, 0x37, 0x7f, 0xe9, 0xa6, 0x3e, 0xda, 0xa2,
+	0x7d, 0xb1, 0x9a, 0x79, 0xec, 0x75, 0x37, 0x4a, 0x58, 0x7e, 0x89, 0x5a, 0xad, 0x06, 0x91, 0x37,
+	0xf6, 0x99, 0x48, 0x7f, 0xde, 0x53, 0x93, 0x9a, 0x7e, 0x59, 0xfd, 0xe6, 0xf6, 0xc6, 0xff, 0x07,
+	0x00, 0x52, 0xde, 0x00, 0x1b, 0xff, 0x1b, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PythContract != that1.PythContract {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2143,39 +2561,27 @@ func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProviderPriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOracle(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+	if len(m.PythContract) > 0 {
+		i -= len(m.PythContract)
+		copy(dAtA[i:], m.PythContract)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PythContract)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
+func (m *OracleInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2185,34 +2591,32 @@ func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceFeedInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceFeedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
+func (m *ChainlinkPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2222,30 +2626,52 @@ func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceFeedPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainlinkPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainlinkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Price.Size()
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
+func (m *BandPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2255,12 +2681,12 @@ func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CoinbasePriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *BandPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BandPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2275,34 +2701,37 @@ func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x2a
-	if m.Value != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Value))
+	if m.Request_ID != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Request_ID))
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Key)))
+	if m.ResolveTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResolveTime))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StorkPriceState) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2312,52 +2741,55 @@ func (m *StorkPriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StorkPriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StorkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Value.Size()
-		i -= size
-		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.PriceState != nil {
+		{
+			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceState) Marshal() (dAtA []byte, err error) {
+func (m *ProviderInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2367,45 +2799,36 @@ func (m *PriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.CumulativePrice.Size()
-		i -= size
-		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
+func (m *ProviderState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2415,72 +2838,46 @@ func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PythPriceState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PythPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.PublishTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Conf.Size()
-		i -= size
-		if _, err := m.Conf.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.EmaConf.Size()
-		i -= size
-		if _, err := m.EmaConf.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ProviderPriceStates) > 0 {
+		for iNdEx := len(m.ProviderPriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.EmaPrice.Size()
-		i -= size
-		if _, err := m.EmaPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ProviderInfo != nil {
+		{
+			size, err := m.ProviderInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProviderPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2490,78 +2887,39 @@ func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BandOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MinSourceCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinSourceCount))
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x48
-	}
-	if m.ExecuteGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.PrepareGas != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.FeeLimit) > 0 {
-		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.MinCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.AskCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbols) > 0 {
-		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Symbols[iNdEx])
-			copy(dAtA[i:], m.Symbols[iNdEx])
-			i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbols[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.OracleScriptId != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptId))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.RequestId != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2571,75 +2929,34 @@ func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BandIBCParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LegacyOracleIds) > 0 {
-		dAtA10 := make([]byte, len(m.LegacyOracleIds)*10)
-		var j9 int
-		for _, num1 := range m.LegacyOracleIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintOracle(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IbcPortId) > 0 {
-		i -= len(m.IbcPortId)
-		copy(dAtA[i:], m.IbcPortId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcPortId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.IbcVersion) > 0 {
-		i -= len(m.IbcVersion)
-		copy(dAtA[i:], m.IbcVersion)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcVersion)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IbcSourceChannel) > 0 {
-		i -= len(m.IbcSourceChannel)
-		copy(dAtA[i:], m.IbcSourceChannel)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcSourceChannel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.IbcRequestInterval != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.IbcRequestInterval))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.BandIbcEnabled {
-		i--
-		if m.BandIbcEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
+func (m *PriceFeedPrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2649,37 +2966,30 @@ func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SymbolPriceTimestamp) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceFeedPrice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SymbolPriceTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceFeedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Oracle != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
+func (m *CoinbasePriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2689,34 +2999,54 @@ func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LastPriceTimestamps) MarshalTo(dAtA []byte) (int, error) {
+func (m *CoinbasePriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LastPriceTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CoinbasePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LastPriceTimestamps) > 0 {
-		for iNdEx := len(m.LastPriceTimestamps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LastPriceTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Value != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
+func (m *StorkPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2726,46 +3056,52 @@ func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceRecords) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorkPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorkPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LatestPriceRecords) > 0 {
-		for iNdEx := len(m.LatestPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Oracle != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+func (m *PriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2775,35 +3111,45 @@ func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
-		size := m.Price.Size()
+		size := m.CumulativePrice.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
+func (m *PythPriceState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2813,90 +3159,72 @@ func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MetadataStatistics) MarshalTo(dAtA []byte) (int, error) {
+func (m *PythPriceState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MetadataStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PythPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.MedianPrice.Size()
-		i -= size
-		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.MaxPrice.Size()
 		i -= size
-		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x32
+	if m.PublishTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
-		size := m.MinPrice.Size()
+		size := m.Conf.Size()
 		i -= size
-		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Conf.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if m.LastTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LastTimestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.FirstTimestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.FirstTimestamp))
-		i--
-		dAtA[i] = 0x28
-	}
+	dAtA[i] = 0x22
 	{
-		size := m.Twap.Size()
+		size := m.EmaConf.Size()
 		i -= size
-		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EmaConf.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x1a
 	{
-		size := m.Mean.Size()
+		size := m.EmaPrice.Size()
 		i -= size
-		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EmaPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.RecordsSampleSize != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsSampleSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupCount != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.GroupCount))
+	dAtA[i] = 0x12
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+func (m *BandOracleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2906,62 +3234,78 @@ func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+func (m *BandOracleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BandOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PublishTime != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+	if m.MinSourceCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinSourceCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExecuteGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExecuteGas))
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.EmaExpo != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaExpo))
+	if m.PrepareGas != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PrepareGas))
 		i--
 		dAtA[i] = 0x38
 	}
-	if m.EmaConf != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaConf))
-		i--
-		dAtA[i] = 0x30
+	if len(m.FeeLimit) > 0 {
+		for iNdEx := len(m.FeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.EmaPrice != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.EmaPrice))
+	if m.MinCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinCount))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.Expo != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Expo))
+	if m.AskCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AskCount))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.Conf != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Conf))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Symbols) > 0 {
+		for iNdEx := len(m.Symbols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Symbols[iNdEx])
+			copy(dAtA[i:], m.Symbols[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbols[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Price != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Price))
+	if m.OracleScriptId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleScriptId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+	if m.RequestId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RequestId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetPair) Marshal() (dAtA []byte, err error) {
+func (m *BandIBCParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2971,41 +3315,75 @@ func (m *AssetPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *BandIBCParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BandIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SignedPrices) > 0 {
-		for iNdEx := len(m.SignedPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SignedPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
+	if len(m.LegacyOracleIds) > 0 {
+		dAtA10 := make([]byte, len(m.LegacyOracleIds)*10)
+		var j9 int
+		for _, num1 := range m.LegacyOracleIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
 			}
-			i--
-			dAtA[i] = 0x12
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintOracle(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.AssetId) > 0 {
-		i -= len(m.AssetId)
-		copy(dAtA[i:], m.AssetId)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.AssetId)))
+	if len(m.IbcPortId) > 0 {
+		i -= len(m.IbcPortId)
+		copy(dAtA[i:], m.IbcPortId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcPortId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if len(m.IbcVersion) > 0 {
+		i -= len(m.IbcVersion)
+		copy(dAtA[i:], m.IbcVersion)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IbcSourceChannel) > 0 {
+		i -= len(m.IbcSourceChannel)
+		copy(dAtA[i:], m.IbcSourceChannel)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.IbcSourceChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IbcRequestInterval != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IbcRequestInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BandIbcEnabled {
+		i--
+		if m.BandIbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SignedPriceOfAssetPair) Marshal() (dAtA []byte, err error) {
+func (m *SymbolPriceTimestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3015,585 +3393,2821 @@ func (m *SignedPriceOfAssetPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignedPriceOfAssetPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *SymbolPriceTimestamp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignedPriceOfAssetPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SymbolPriceTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Signature)))
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
 		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Timestamp != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.PublisherKey) > 0 {
-		i -= len(m.PublisherKey)
-		copy(dAtA[i:], m.PublisherKey)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.PublisherKey)))
+	if m.Oracle != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LastPriceTimestamps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LastPriceTimestamps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastPriceTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PythContract)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.LastPriceTimestamps) > 0 {
+		for iNdEx := len(m.LastPriceTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPriceTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.LatestPriceRecords) > 0 {
+		for iNdEx := len(m.LatestPriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestPriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.OracleType != 0 {
-		n += 1 + sovOracle(uint64(m.OracleType))
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.SymbolId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Oracle != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainlinkPriceState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.Answer.Size()
-	n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x12
 	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BandPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.ResolveTime != 0 {
-		n += 1 + sovOracle(uint64(m.ResolveTime))
-	}
-	if m.Request_ID != 0 {
-		n += 1 + sovOracle(uint64(m.Request_ID))
+func (m *PriceTWAP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PriceFeedState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceTWAP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceTWAP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.LargestGap != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LargestGap))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.RecordsCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PriceState != nil {
-		l = m.PriceState.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	if m.EndTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	if m.StartTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ProviderInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MetadataStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.MedianPrice.Size()
+		i -= size
+		if _, err := m.MedianPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ProviderState) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.ProviderInfo != nil {
-		l = m.ProviderInfo.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if m.LastTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.ProviderPriceStates) > 0 {
-		for _, e := range m.ProviderPriceStates {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if m.FirstTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FirstTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ProviderPriceState) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Mean.Size()
+		i -= size
+		if _, err := m.Mean.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.RecordsSampleSize != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RecordsSampleSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovOracle(uint64(l))
+	if m.GroupCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GroupCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceFeedInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+func (m *PriceAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PriceFeedPrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+func (m *PriceAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinbasePriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PriceAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.PublishTime != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PublishTime))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	if m.EmaExpo != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaExpo))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.EmaConf != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaConf))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Value != 0 {
-		n += 1 + sovOracle(uint64(m.Value))
+	if m.EmaPrice != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaPrice))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
-
-func (m *StorkPriceState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Expo != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Expo))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	if m.Conf != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Conf))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Price != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PriceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.CumulativePrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+func (m *AssetPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PythPriceState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	l = m.EmaPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.EmaConf.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Conf.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.PublishTime != 0 {
-		n += 1 + sovOracle(uint64(m.PublishTime))
-	}
-	l = m.PriceState.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+func (m *AssetPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BandOracleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AssetPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RequestId != 0 {
-		n += 1 + sovOracle(uint64(m.RequestId))
-	}
-	if m.OracleScriptId != 0 {
-		n += 1 + sovOracle(uint64(m.OracleScriptId))
-	}
-	if len(m.Symbols) > 0 {
-		for _, s := range m.Symbols {
-			l = len(s)
-			n += 1 + l + sovOracle(uint64(l))
-		}
-	}
-	if m.AskCount != 0 {
-		n += 1 + sovOracle(uint64(m.AskCount))
-	}
-	if m.MinCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinCount))
-	}
-	if len(m.FeeLimit) > 0 {
-		for _, e := range m.FeeLimit {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	if len(m.SignedPrices) > 0 {
+		for iNdEx := len(m.SignedPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.PrepareGas != 0 {
-		n += 1 + sovOracle(uint64(m.PrepareGas))
-	}
-	if m.ExecuteGas != 0 {
-		n += 1 + sovOracle(uint64(m.ExecuteGas))
-	}
-	if m.MinSourceCount != 0 {
-		n += 1 + sovOracle(uint64(m.MinSourceCount))
+	if len(m.AssetId) > 0 {
+		i -= len(m.AssetId)
+		copy(dAtA[i:], m.AssetId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AssetId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BandIBCParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignedPriceOfAssetPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedPriceOfAssetPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedPriceOfAssetPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BandIbcEnabled {
-		n += 2
-	}
-	if m.IbcRequestInterval != 0 {
-		n += 1 + sovOracle(uint64(m.IbcRequestInterval))
-	}
-	l = len(m.IbcSourceChannel)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.IbcVersion)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = len(m.IbcPortId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.Timestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.LegacyOracleIds) > 0 {
-		l = 0
-		for _, e := range m.LegacyOracleIds {
-			l += sovOracle(uint64(e))
-		}
-		n += 1 + sovOracle(uint64(l)) + l
+	if len(m.PublisherKey) > 0 {
+		i -= len(m.PublisherKey)
+		copy(dAtA[i:], m.PublisherKey)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PublisherKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SymbolPriceTimestamp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CompositeOracleSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositeOracleSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeOracleSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovOracle(uint64(m.Oracle))
+	if m.MaxStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *LastPriceTimestamps) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if len(m.LastPriceTimestamps) > 0 {
-		for _, e := range m.LastPriceTimestamps {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriceRecords) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CompositeOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositeOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovOracle(uint64(m.Oracle))
+	if m.MinQuorum != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinQuorum))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if len(m.LatestPriceRecords) > 0 {
-		for _, e := range m.LatestPriceRecords {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
-}
-
-func (m *PriceRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Aggregation != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Aggregation))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MetadataStatistics) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CompositePriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositePriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositePriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupCount != 0 {
-		n += 1 + sovOracle(uint64(m.GroupCount))
+	if m.SourcesUsed != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SourcesUsed))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RecordsSampleSize != 0 {
-		n += 1 + sovOracle(uint64(m.RecordsSampleSize))
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	l = m.Mean.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.Twap.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.FirstTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.FirstTimestamp))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.LastTimestamp != 0 {
-		n += 1 + sovOracle(uint64(m.LastTimestamp))
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.MinPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.MaxPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.MedianPrice.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PriceAttestation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecoveryPrints != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RecoveryPrints))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Action != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.ConsistentPrints != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ConsistentPrints))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Price != 0 {
-		n += 1 + sovOracle(uint64(m.Price))
+	{
+		size := m.CandidatePrice.Size()
+		i -= size
+		if _, err := m.CandidatePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.Conf != 0 {
-		n += 1 + sovOracle(uint64(m.Conf))
+	i--
+	dAtA[i] = 0x32
+	if m.HaltedAt != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HaltedAt))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Expo != 0 {
-		n += 1 + sovOracle(uint64(m.Expo))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.EmaPrice != 0 {
-		n += 1 + sovOracle(uint64(m.EmaPrice))
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.EmaConf != 0 {
-		n += 1 + sovOracle(uint64(m.EmaConf))
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EmaExpo != 0 {
-		n += 1 + sovOracle(uint64(m.EmaExpo))
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.PublishTime != 0 {
-		n += 1 + sovOracle(uint64(m.PublishTime))
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainlinkDataStreamsDON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainlinkDataStreamsDON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainlinkDataStreamsDON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.F != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.F))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConfigDigest) > 0 {
+		i -= len(m.ConfigDigest)
+		copy(dAtA[i:], m.ConfigDigest)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConfigDigest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainlinkDataStreamsPriceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainlinkDataStreamsPriceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainlinkDataStreamsPriceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Ask.Size()
+		i -= size
+		if _, err := m.Ask.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Bid.Size()
+		i -= size
+		if _, err := m.Bid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BenchmarkPrice.Size()
+		i -= size
+		if _, err := m.BenchmarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExpiresAt != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ObservationsTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ObservationsTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PythContract)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	return n
+}
+
+func (m *ChainlinkPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Answer.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *BandPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ResolveTime != 0 {
+		n += 1 + sovOracle(uint64(m.ResolveTime))
+	}
+	if m.Request_ID != 0 {
+		n += 1 + sovOracle(uint64(m.Request_ID))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceFeedState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProviderInfo != nil {
+		l = m.ProviderInfo.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.ProviderPriceStates) > 0 {
+		for _, e := range m.ProviderPriceStates {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeedInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceFeedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *CoinbasePriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovOracle(uint64(m.Value))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *StorkPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *PythPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.EmaPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.EmaConf.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Conf.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.PublishTime != 0 {
+		n += 1 + sovOracle(uint64(m.PublishTime))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *BandOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestId != 0 {
+		n += 1 + sovOracle(uint64(m.RequestId))
+	}
+	if m.OracleScriptId != 0 {
+		n += 1 + sovOracle(uint64(m.OracleScriptId))
+	}
+	if len(m.Symbols) > 0 {
+		for _, s := range m.Symbols {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.AskCount != 0 {
+		n += 1 + sovOracle(uint64(m.AskCount))
+	}
+	if m.MinCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinCount))
+	}
+	if len(m.FeeLimit) > 0 {
+		for _, e := range m.FeeLimit {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.PrepareGas != 0 {
+		n += 1 + sovOracle(uint64(m.PrepareGas))
+	}
+	if m.ExecuteGas != 0 {
+		n += 1 + sovOracle(uint64(m.ExecuteGas))
+	}
+	if m.MinSourceCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinSourceCount))
+	}
+	return n
+}
+
+func (m *BandIBCParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BandIbcEnabled {
+		n += 2
+	}
+	if m.IbcRequestInterval != 0 {
+		n += 1 + sovOracle(uint64(m.IbcRequestInterval))
+	}
+	l = len(m.IbcSourceChannel)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.IbcVersion)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.IbcPortId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LegacyOracleIds) > 0 {
+		l = 0
+		for _, e := range m.LegacyOracleIds {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SymbolPriceTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovOracle(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *LastPriceTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastPriceTimestamps) > 0 {
+		for _, e := range m.LastPriceTimestamps {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovOracle(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LatestPriceRecords) > 0 {
+		for _, e := range m.LatestPriceRecords {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceTWAP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovOracle(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovOracle(uint64(m.EndTime))
+	}
+	if m.RecordsCount != 0 {
+		n += 1 + sovOracle(uint64(m.RecordsCount))
+	}
+	if m.LargestGap != 0 {
+		n += 1 + sovOracle(uint64(m.LargestGap))
+	}
+	return n
+}
+
+func (m *MetadataStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupCount != 0 {
+		n += 1 + sovOracle(uint64(m.GroupCount))
+	}
+	if m.RecordsSampleSize != 0 {
+		n += 1 + sovOracle(uint64(m.RecordsSampleSize))
+	}
+	l = m.Mean.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.FirstTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.FirstTimestamp))
+	}
+	if m.LastTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.LastTimestamp))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MedianPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PriceAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovOracle(uint64(m.Price))
+	}
+	if m.Conf != 0 {
+		n += 1 + sovOracle(uint64(m.Conf))
+	}
+	if m.Expo != 0 {
+		n += 1 + sovOracle(uint64(m.Expo))
+	}
+	if m.EmaPrice != 0 {
+		n += 1 + sovOracle(uint64(m.EmaPrice))
+	}
+	if m.EmaConf != 0 {
+		n += 1 + sovOracle(uint64(m.EmaConf))
+	}
+	if m.EmaExpo != 0 {
+		n += 1 + sovOracle(uint64(m.EmaExpo))
+	}
+	if m.PublishTime != 0 {
+		n += 1 + sovOracle(uint64(m.PublishTime))
+	}
+	return n
+}
+
+func (m *AssetPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.SignedPrices) > 0 {
+		for _, e := range m.SignedPrices {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignedPriceOfAssetPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublisherKey)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOracle(uint64(m.Timestamp))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *CompositeOracleSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MaxStaleness != 0 {
+		n += 1 + sovOracle(uint64(m.MaxStaleness))
+	}
+	return n
+}
+
+func (m *CompositeOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Aggregation != 0 {
+		n += 1 + sovOracle(uint64(m.Aggregation))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MinQuorum != 0 {
+		n += 1 + sovOracle(uint64(m.MinQuorum))
+	}
+	return n
+}
+
+func (m *CompositePriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SourcesUsed != 0 {
+		n += 1 + sovOracle(uint64(m.SourcesUsed))
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MaxStaleness != 0 {
+		n += 1 + sovOracle(uint64(m.MaxStaleness))
+	}
+	if m.Action != 0 {
+		n += 1 + sovOracle(uint64(m.Action))
+	}
+	if m.RecoveryPrints != 0 {
+		n += 1 + sovOracle(uint64(m.RecoveryPrints))
+	}
+	return n
+}
+
+func (m *CircuitBreakerHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.HaltedAt != 0 {
+		n += 1 + sovOracle(uint64(m.HaltedAt))
+	}
+	l = m.CandidatePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ConsistentPrints != 0 {
+		n += 1 + sovOracle(uint64(m.ConsistentPrints))
+	}
+	return n
+}
+
+func (m *ChainlinkDataStreamsDON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigDigest)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.F != 0 {
+		n += 1 + sovOracle(uint64(m.F))
+	}
+	return n
+}
+
+func (m *ChainlinkDataStreamsPriceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ObservationsTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.ObservationsTimestamp))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovOracle(uint64(m.ExpiresAt))
+	}
+	l = m.BenchmarkPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Bid.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Ask.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.PriceState.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PythContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PythContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainlinkPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainlinkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BandPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
+			}
+			m.ResolveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request_ID", wireType)
+			}
+			m.Request_ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Request_ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeedState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeedState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceState == nil {
+				m.PriceState = &PriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderInfo == nil {
+				m.ProviderInfo = &ProviderInfo{}
+			}
+			if err := m.ProviderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPriceStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPriceStates = append(m.ProviderPriceStates, &ProviderPriceState{})
+			if err := m.ProviderPriceStates[len(m.ProviderPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &PriceState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *AssetPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssetId)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.SignedPrices) > 0 {
-		for _, e := range m.SignedPrices {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	return nil
+}
+func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceFeedInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceFeedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *SignedPriceOfAssetPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PublisherKey)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovOracle(uint64(m.Timestamp))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3616,15 +6230,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceFeedPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceFeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PythContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3652,7 +6266,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PythContract = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3675,7 +6291,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleInfo) Unmarshal(dAtA []byte) error {
+func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3698,15 +6314,15 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoinbasePriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoinbasePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3734,13 +6350,83 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.OracleType = 0
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -3750,11 +6436,25 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleType |= OracleType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -3776,7 +6476,7 @@ func (m *OracleInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
+func (m *StorkPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3799,15 +6499,34 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainlinkPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorkPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainlinkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3835,11 +6554,11 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3867,30 +6586,11 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
@@ -3944,7 +6644,7 @@ func (m *ChainlinkPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandPriceState) Unmarshal(dAtA []byte) error {
+func (m *PriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3967,15 +6667,15 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BandPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4003,11 +6703,13 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4035,53 +6737,15 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolveTime", wireType)
-			}
-			m.ResolveTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResolveTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request_ID", wireType)
-			}
-			m.Request_ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Request_ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var msglen int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4091,25 +6755,11 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4131,7 +6781,7 @@ func (m *BandPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
+func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4154,15 +6804,15 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PythPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PythPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4190,11 +6840,11 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			m.PriceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4222,13 +6872,15 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
+			if err := m.EmaPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4238,31 +6890,29 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PriceState == nil {
-				m.PriceState = &PriceState{}
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmaConf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4290,63 +6940,15 @@ func (m *PriceFeedState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Conf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
 			}
-			var stringLen uint64
+			m.PublishTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4355,30 +6957,17 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				iNdEx++
+				m.PublishTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4388,23 +6977,24 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4427,7 +7017,7 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderState) Unmarshal(dAtA []byte) error {
+func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4450,17 +7040,55 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderState: wiretype end group for non-group")
+			return fmt.Errorf("proto: BandOracleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BandOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+			}
+			m.OracleScriptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4470,31 +7098,65 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderInfo == nil {
-				m.ProviderInfo = &ProviderInfo{}
+			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
 			}
-			if err := m.ProviderInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AskCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+			}
+			m.MinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPriceStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4521,11 +7183,68 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderPriceStates = append(m.ProviderPriceStates, &ProviderPriceState{})
-			if err := m.ProviderPriceStates[len(m.ProviderPriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeLimit = append(m.FeeLimit, types.Coin{})
+			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+			}
+			m.PrepareGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
+			}
+			m.ExecuteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSourceCount", wireType)
+			}
+			m.MinSourceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSourceCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4547,7 +7266,7 @@ func (m *ProviderState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
+func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4570,15 +7289,86 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: BandIBCParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BandIBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandIbcEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BandIbcEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcRequestInterval", wireType)
+			}
+			m.IbcRequestInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcRequestInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4606,13 +7396,13 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.IbcVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4622,28 +7412,100 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &PriceState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcPortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LegacyOracleIds = append(m.LegacyOracleIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LegacyOracleIds) == 0 {
+					m.LegacyOracleIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LegacyOracleIds = append(m.LegacyOracleIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyOracleIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4665,7 +7527,7 @@ func (m *ProviderPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
+func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4688,17 +7550,17 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SymbolPriceTimestamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SymbolPriceTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
-			var stringLen uint64
+			m.Oracle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4708,27 +7570,14 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Oracle |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Base = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4756,8 +7605,27 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = string(dAtA[iNdEx:postIndex])
+			m.SymbolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -4779,7 +7647,7 @@ func (m *PriceFeedInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
+func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4802,17 +7670,17 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceFeedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastPriceTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceFeedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastPriceTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceTimestamps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4822,23 +7690,23 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastPriceTimestamps = append(m.LastPriceTimestamps, &SymbolPriceTimestamp{})
+			if err := m.LastPriceTimestamps[len(m.LastPriceTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4863,7 +7731,7 @@ func (m *PriceFeedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
+func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4886,49 +7754,17 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinbasePriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinbasePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
-			m.Timestamp = 0
+			m.Oracle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -4938,14 +7774,14 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.Oracle |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4963,40 +7799,21 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			m.Value = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 5:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPriceRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5023,7 +7840,8 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestPriceRecords = append(m.LatestPriceRecords, &PriceRecord{})
+			if err := m.LatestPriceRecords[len(m.LatestPriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5048,7 +7866,7 @@ func (m *CoinbasePriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorkPriceState) Unmarshal(dAtA []byte) error {
+func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5071,10 +7889,10 @@ func (m *StorkPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorkPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorkPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5091,46 +7909,14 @@ func (m *StorkPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5158,40 +7944,7 @@ func (m *StorkPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5216,7 +7969,7 @@ func (m *StorkPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceState) Unmarshal(dAtA []byte) error {
+func (m *PriceTWAP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5239,15 +7992,15 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceTWAP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceTWAP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5275,15 +8028,15 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5293,31 +8046,54 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsCount", wireType)
 			}
-			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RecordsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LargestGap", wireType)
 			}
-			m.Timestamp = 0
+			m.LargestGap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5327,7 +8103,7 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.LargestGap |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5353,7 +8129,7 @@ func (m *PriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PythPriceState) Unmarshal(dAtA []byte) error {
+func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5376,17 +8152,17 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PythPriceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PythPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupCount", wireType)
 			}
-			var stringLen uint64
+			m.GroupCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5396,27 +8172,33 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GroupCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsSampleSize", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RecordsSampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsSampleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PriceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5444,13 +8226,13 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmaPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5478,13 +8260,51 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmaConf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstTimestamp", wireType)
+			}
+			m.FirstTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+			}
+			m.LastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5512,15 +8332,15 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Conf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
-			m.PublishTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5530,16 +8350,31 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PublishTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5549,22 +8384,23 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MedianPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5589,7 +8425,7 @@ func (m *PythPriceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
+func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5612,17 +8448,17 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BandOracleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
 			}
-			m.RequestId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5632,16 +8468,29 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.OracleScriptId = 0
+			m.Price = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5651,16 +8500,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OracleScriptId |= int64(b&0x7F) << shift
+				m.Price |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbols", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
 			}
-			var stringLen uint64
+			m.Conf = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5670,29 +8519,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Conf |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbols = append(m.Symbols, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expo", wireType)
 			}
-			m.AskCount = 0
+			m.Expo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5702,16 +8538,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AskCount |= uint64(b&0x7F) << shift
+				m.Expo |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
 			}
-			m.MinCount = 0
+			m.EmaPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5721,16 +8557,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinCount |= uint64(b&0x7F) << shift
+				m.EmaPrice |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeLimit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
 			}
-			var msglen int
+			m.EmaConf = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5740,31 +8576,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EmaConf |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeeLimit = append(m.FeeLimit, types.Coin{})
-			if err := m.FeeLimit[len(m.FeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaExpo", wireType)
 			}
-			m.PrepareGas = 0
+			m.EmaExpo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5774,35 +8595,16 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareGas |= uint64(b&0x7F) << shift
+				m.EmaExpo |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteGas", wireType)
-			}
-			m.ExecuteGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecuteGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSourceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
 			}
-			m.MinSourceCount = 0
+			m.PublishTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5812,7 +8614,7 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSourceCount |= uint64(b&0x7F) << shift
+				m.PublishTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5838,7 +8640,7 @@ func (m *BandOracleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
+func (m *AssetPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5861,17 +8663,17 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BandIBCParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BandIBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BandIbcEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5881,17 +8683,29 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BandIbcEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcRequestInterval", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrices", wireType)
 			}
-			m.IbcRequestInterval = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5901,14 +8715,79 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IbcRequestInterval |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedPrices = append(m.SignedPrices, &SignedPriceOfAssetPair{})
+			if err := m.SignedPrices[len(m.SignedPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedPriceOfAssetPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedPriceOfAssetPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSourceChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5936,11 +8815,30 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSourceChannel = string(dAtA[iNdEx:postIndex])
+			m.PublisherKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5968,13 +8866,15 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcVersion = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -5984,100 +8884,26 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcPortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LegacyOracleIds = append(m.LegacyOracleIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthOracle
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthOracle
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LegacyOracleIds) == 0 {
-					m.LegacyOracleIds = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LegacyOracleIds = append(m.LegacyOracleIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LegacyOracleIds", wireType)
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6099,7 +8925,7 @@ func (m *BandIBCParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
+func (m *CompositeOracleSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6122,17 +8948,17 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SymbolPriceTimestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeOracleSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SymbolPriceTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeOracleSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
 			}
-			m.Oracle = 0
+			m.OracleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6142,14 +8968,14 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Oracle |= OracleType(b&0x7F) << shift
+				m.OracleType |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6177,13 +9003,13 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6193,66 +9019,29 @@ func (m *SymbolPriceTimestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastPriceTimestamps: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastPriceTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceTimestamps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6262,26 +9051,45 @@ func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastPriceTimestamps = append(m.LastPriceTimestamps, &SymbolPriceTimestamp{})
-			if err := m.LastPriceTimestamps[len(m.LastPriceTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6303,7 +9111,7 @@ func (m *LastPriceTimestamps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceRecords) Unmarshal(dAtA []byte) error {
+func (m *CompositeOracle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6326,17 +9134,49 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeOracle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeOracle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 			}
-			m.Oracle = 0
+			m.Aggregation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6346,16 +9186,16 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Oracle |= OracleType(b&0x7F) << shift
+				m.Aggregation |= CompositeAggregation(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymbolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6365,29 +9205,31 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SymbolId = string(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, CompositeOracleSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestPriceRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6397,26 +9239,45 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestPriceRecords = append(m.LatestPriceRecords, &PriceRecord{})
-			if err := m.LatestPriceRecords[len(m.LatestPriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuorum", wireType)
+			}
+			m.MinQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6438,7 +9299,7 @@ func (m *PriceRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceRecord) Unmarshal(dAtA []byte) error {
+func (m *CompositePriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6461,17 +9322,17 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositePriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositePriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6481,16 +9342,29 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6500,26 +9374,44 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcesUsed", wireType)
+			}
+			m.SourcesUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcesUsed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6541,7 +9433,7 @@ func (m *PriceRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6564,17 +9456,17 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataStatistics: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
 			}
-			m.GroupCount = 0
+			m.OracleType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6584,16 +9476,16 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupCount |= uint32(b&0x7F) << shift
+				m.OracleType |= OracleType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsSampleSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			m.RecordsSampleSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6603,14 +9495,27 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordsSampleSize |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6638,13 +9543,11 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Mean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6672,15 +9575,15 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
 			}
-			m.FirstTimestamp = 0
+			m.MaxStaleness = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6690,16 +9593,16 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FirstTimestamp |= int64(b&0x7F) << shift
+				m.MaxStaleness |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.LastTimestamp = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6709,84 +9612,16 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastTimestamp |= int64(b&0x7F) << shift
+				m.Action |= CircuitBreakerAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryPrints", wireType)
 			}
-			var stringLen uint64
+			m.RecoveryPrints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6796,26 +9631,11 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RecoveryPrints |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MedianPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -6837,7 +9657,7 @@ func (m *MetadataStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6860,15 +9680,34 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceAttestation: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6896,13 +9735,13 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceId = string(dAtA[iNdEx:postIndex])
+			m.Base = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
 			}
-			m.Price = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6912,35 +9751,29 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conf", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.Conf = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Conf |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expo", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Expo = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6950,16 +9783,29 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Expo |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedAt", wireType)
 			}
-			m.EmaPrice = 0
+			m.HaltedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6969,16 +9815,16 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EmaPrice |= int64(b&0x7F) << shift
+				m.HaltedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaConf", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidatePrice", wireType)
 			}
-			m.EmaConf = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -6988,35 +9834,31 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EmaConf |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmaExpo", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.EmaExpo = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EmaExpo |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CandidatePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsistentPrints", wireType)
 			}
-			m.PublishTime = 0
+			m.ConsistentPrints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7026,7 +9868,7 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PublishTime |= int64(b&0x7F) << shift
+				m.ConsistentPrints |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7052,7 +9894,7 @@ func (m *PriceAttestation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetPair) Unmarshal(dAtA []byte) error {
+func (m *ChainlinkDataStreamsDON) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7075,15 +9917,15 @@ func (m *AssetPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainlinkDataStreamsDON: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainlinkDataStreamsDON: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7111,13 +9953,13 @@ func (m *AssetPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetId = string(dAtA[iNdEx:postIndex])
+			m.ConfigDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7127,26 +9969,43 @@ func (m *AssetPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignedPrices = append(m.SignedPrices, &SignedPriceOfAssetPair{})
-			if err := m.SignedPrices[len(m.SignedPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -7168,7 +10027,7 @@ func (m *AssetPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
+func (m *ChainlinkDataStreamsPriceState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7191,15 +10050,15 @@ func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignedPriceOfAssetPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainlinkDataStreamsPriceState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignedPriceOfAssetPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainlinkDataStreamsPriceState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7227,13 +10086,13 @@ func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublisherKey = string(dAtA[iNdEx:postIndex])
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
 			}
-			m.Timestamp = 0
+			m.ObservationsTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7243,14 +10102,33 @@ func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.ObservationsTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BenchmarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7278,15 +10156,15 @@ func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BenchmarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -7296,24 +10174,91 @@ func (m *SignedPriceOfAssetPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -118,23 +118,21 @@ func (m *QueryPythPriceResponse) GetPriceState() *PythPriceState {
 	return nil
 }
 
-// QueryOracleParamsRequest is the request type for the Query/OracleParams RPC
-// method.
-type QueryParamsRequest struct {
+type QueryCompositeOraclesRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCompositeOraclesRequest) Reset()         { *m = QueryCompositeOraclesRequest{} }
+func (m *QueryCompositeOraclesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeOraclesRequest) ProtoMessage()    {}
+func (*QueryCompositeOraclesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCompositeOraclesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCompositeOraclesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCompositeOraclesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -144,36 +142,34 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryCompositeOraclesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeOraclesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryCompositeOraclesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryCompositeOraclesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeOraclesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCompositeOraclesRequest proto.InternalMessageInfo
 
-// QueryOracleParamsResponse is the response type for the Query/OracleParams RPC
-// method.
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+type QueryCompositeOraclesResponse struct {
+	CompositeOracles []CompositeOracle `protobuf:"bytes,1,rep,name=composite_oracles,json=compositeOracles,proto3" json:"composite_oracles"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCompositeOraclesResponse) Reset()         { *m = QueryCompositeOraclesResponse{} }
+func (m *QueryCompositeOraclesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeOraclesResponse) ProtoMessage()    {}
+func (*QueryCompositeOraclesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCompositeOraclesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCompositeOraclesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCompositeOraclesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -183,42 +179,41 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryCompositeOraclesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeOraclesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryCompositeOraclesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryCompositeOraclesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeOraclesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCompositeOraclesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryCompositeOraclesResponse) GetCompositeOracles() []CompositeOracle {
 	if m != nil {
-		return m.Params
+		return m.CompositeOracles
 	}
-	return Params{}
+	return nil
 }
 
-// QueryBandRelayersRequest is the request type for the Query/BandRelayers RPC
-// method.
-type QueryBandRelayersRequest struct {
+type QueryCompositeOracleRequest struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
-func (m *QueryBandRelayersRequest) Reset()         { *m = QueryBandRelayersRequest{} }
-func (m *QueryBandRelayersRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBandRelayersRequest) ProtoMessage()    {}
-func (*QueryBandRelayersRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCompositeOracleRequest) Reset()         { *m = QueryCompositeOracleRequest{} }
+func (m *QueryCompositeOracleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeOracleRequest) ProtoMessage()    {}
+func (*QueryCompositeOracleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{4}
 }
-func (m *QueryBandRelayersRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCompositeOracleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBandRelayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCompositeOracleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBandRelayersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCompositeOracleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -228,36 +223,46 @@ func (m *QueryBandRelayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryBandRelayersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBandRelayersRequest.Merge(m, src)
+func (m *QueryCompositeOracleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeOracleRequest.Merge(m, src)
 }
-func (m *QueryBandRelayersRequest) XXX_Size() int {
+func (m *QueryCompositeOracleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBandRelayersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBandRelayersRequest.DiscardUnknown(m)
+func (m *QueryCompositeOracleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeOracleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBandRelayersRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCompositeOracleRequest proto.InternalMessageInfo
 
-// QueryBandRelayersResponse is the response type for the Query/BandRelayers RPC
-// method.
-type QueryBandRelayersResponse struct {
-	Relayers []string `protobuf:"bytes,1,rep,name=relayers,proto3" json:"relayers,omitempty"`
+func (m *QueryCompositeOracleRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *QueryBandRelayersResponse) Reset()         { *m = QueryBandRelayersResponse{} }
-func (m *QueryBandRelayersResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBandRelayersResponse) ProtoMessage()    {}
-func (*QueryBandRelayersResponse) Descriptor() ([]byte, []int) {
+type QueryCompositeOracleResponse struct {
+	CompositeOracle *CompositeOracle `protobuf:"bytes,1,opt,name=composite_oracle,json=compositeOracle,proto3" json:"composite_oracle,omitempty"`
+	// price_state is the last successfully resolved price
+	PriceState *CompositePriceState `protobuf:"bytes,2,opt,name=price_state,json=priceState,proto3" json:"price_state,omitempty"`
+	// error describes why the price can't be resolved at the current block,
+	// empty if it can
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QueryCompositeOracleResponse) Reset()         { *m = QueryCompositeOracleResponse{} }
+func (m *QueryCompositeOracleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCompositeOracleResponse) ProtoMessage()    {}
+func (*QueryCompositeOracleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{5}
 }
-func (m *QueryBandRelayersResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCompositeOracleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBandRelayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCompositeOracleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBandRelayersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCompositeOracleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -267,42 +272,54 @@ func (m *QueryBandRelayersResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryBandRelayersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBandRelayersResponse.Merge(m, src)
+func (m *QueryCompositeOracleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCompositeOracleResponse.Merge(m, src)
 }
-func (m *QueryBandRelayersResponse) XXX_Size() int {
+func (m *QueryCompositeOracleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBandRelayersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBandRelayersResponse.DiscardUnknown(m)
+func (m *QueryCompositeOracleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCompositeOracleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBandRelayersResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCompositeOracleResponse proto.InternalMessageInfo
 
-func (m *QueryBandRelayersResponse) GetRelayers() []string {
+func (m *QueryCompositeOracleResponse) GetCompositeOracle() *CompositeOracle {
 	if m != nil {
-		return m.Relayers
+		return m.CompositeOracle
 	}
 	return nil
 }
 
-// QueryBandPriceStatesRequest is the request type for the Query/BandPriceStates
-// RPC method.
-type QueryBandPriceStatesRequest struct {
+func (m *QueryCompositeOracleResponse) GetPriceState() *CompositePriceState {
+	if m != nil {
+		return m.PriceState
+	}
+	return nil
 }
 
-func (m *QueryBandPriceStatesRequest) Reset()         { *m = QueryBandPriceStatesRequest{} }
-func (m *QueryBandPriceStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBandPriceStatesRequest) ProtoMessage()    {}
-func (*QueryBandPriceStatesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCompositeOracleResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type QueryCircuitBreakersRequest struct {
+}
+
+func (m *QueryCircuitBreakersRequest) Reset()         { *m = QueryCircuitBreakersRequest{} }
+func (m *QueryCircuitBreakersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{6}
 }
-func (m *QueryBandPriceStatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCircuitBreakersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBandPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCircuitBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBandPriceStatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCircuitBreakersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -312,36 +329,35 @@ func (m *QueryBandPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryBandPriceStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBandPriceStatesRequest.Merge(m, src)
+func (m *QueryCircuitBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersRequest.Merge(m, src)
 }
-func (m *QueryBandPriceStatesRequest) XXX_Size() int {
+func (m *QueryCircuitBreakersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBandPriceStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBandPriceStatesRequest.DiscardUnknown(m)
+func (m *QueryCircuitBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBandPriceStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCircuitBreakersRequest proto.InternalMessageInfo
 
-// QueryBandPriceStatesResponse is the response type for the
-// Query/BandPriceStates RPC method.
-type QueryBandPriceStatesResponse struct {
-	PriceStates []*BandPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
+type QueryCircuitBreakersResponse struct {
+	CircuitBreakers []CircuitBreaker     `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	Halts           []CircuitBreakerHalt `protobuf:"bytes,2,rep,name=halts,proto3" json:"halts"`
 }
 
-func (m *QueryBandPriceStatesResponse) Reset()         { *m = QueryBandPriceStatesResponse{} }
-func (m *QueryBandPriceStatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBandPriceStatesResponse) ProtoMessage()    {}
-func (*QueryBandPriceStatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCircuitBreakersResponse) Reset()         { *m = QueryCircuitBreakersResponse{} }
+func (m *QueryCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{7}
 }
-func (m *QueryBandPriceStatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBandPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBandPriceStatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCircuitBreakersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -351,42 +367,50 @@ func (m *QueryBandPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryBandPriceStatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBandPriceStatesResponse.Merge(m, src)
+func (m *QueryCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersResponse.Merge(m, src)
 }
-func (m *QueryBandPriceStatesResponse) XXX_Size() int {
+func (m *QueryCircuitBreakersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBandPriceStatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBandPriceStatesResponse.DiscardUnknown(m)
+func (m *QueryCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBandPriceStatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCircuitBreakersResponse proto.InternalMessageInfo
 
-func (m *QueryBandPriceStatesResponse) GetPriceStates() []*BandPriceState {
+func (m *QueryCircuitBreakersResponse) GetCircuitBreakers() []CircuitBreaker {
 	if m != nil {
-		return m.PriceStates
+		return m.CircuitBreakers
 	}
 	return nil
 }
 
-// QueryBandIBCPriceStatesRequest is the request type for the
-// Query/BandIBCPriceStates RPC method.
-type QueryBandIBCPriceStatesRequest struct {
+func (m *QueryCircuitBreakersResponse) GetHalts() []CircuitBreakerHalt {
+	if m != nil {
+		return m.Halts
+	}
+	return nil
 }
 
-func (m *QueryBandIBCPriceStatesRequest) Reset()         { *m = QueryBandIBCPriceStatesRequest{} }
-func (m *QueryBandIBCPriceStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBandIBCPriceStatesRequest) ProtoMessage()    {}
-func (*QueryBandIBCPriceStatesRequest) Descriptor() ([]byte, []int) {
+type QueryCircuitBreakerStateRequest struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Base       string     `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote      string     `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *QueryCircuitBreakerStateRequest) Reset()         { *m = QueryCircuitBreakerStateRequest{} }
+func (m *QueryCircuitBreakerStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerStateRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerStateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{8}
 }
-func (m *QueryBandIBCPriceStatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCircuitBreakerStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBandIBCPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCircuitBreakerStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBandIBCPriceStatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCircuitBreakerStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -396,36 +420,61 @@ func (m *QueryBandIBCPriceStatesRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryBandIBCPriceStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBandIBCPriceStatesRequest.Merge(m, src)
+func (m *QueryCircuitBreakerStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerStateRequest.Merge(m, src)
 }
-func (m *QueryBandIBCPriceStatesRequest) XXX_Size() int {
+func (m *QueryCircuitBreakerStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBandIBCPriceStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBandIBCPriceStatesRequest.DiscardUnknown(m)
+func (m *QueryCircuitBreakerStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBandIBCPriceStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCircuitBreakerStateRequest proto.InternalMessageInfo
 
-// QueryBandIBCPriceStatesResponse is the response type for the
-// Query/BandIBCPriceStates RPC method.
-type QueryBandIBCPriceStatesResponse struct {
-	PriceStates []*BandPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
+func (m *QueryCircuitBreakerStateRequest) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
+	}
+	return OracleType_Unspecified
 }
 
-func (m *QueryBandIBCPriceStatesResponse) Reset()         { *m = QueryBandIBCPriceStatesResponse{} }
-func (m *QueryBandIBCPriceStatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBandIBCPriceStatesResponse) ProtoMessage()    {}
-func (*QueryBandIBCPriceStatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCircuitBreakerStateRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *QueryCircuitBreakerStateRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+type QueryCircuitBreakerStateResponse struct {
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// halt is set while the circuit breaker halts the symbol
+	Halt *CircuitBreakerHalt `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt,omitempty"`
+	// stale is true if the last price is older than the max staleness
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	// halted is true if the symbol is halted or its price is stale
+	Halted bool `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (m *QueryCircuitBreakerStateResponse) Reset()         { *m = QueryCircuitBreakerStateResponse{} }
+func (m *QueryCircuitBreakerStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerStateResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerStateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{9}
 }
-func (m *QueryBandIBCPriceStatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCircuitBreakerStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBandIBCPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCircuitBreakerStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBandIBCPriceStatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCircuitBreakerStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -435,42 +484,61 @@ func (m *QueryBandIBCPriceStatesResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryBandIBCPriceStatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBandIBCPriceStatesResponse.Merge(m, src)
+func (m *QueryCircuitBreakerStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerStateResponse.Merge(m, src)
 }
-func (m *QueryBandIBCPriceStatesResponse) XXX_Size() int {
+func (m *QueryCircuitBreakerStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBandIBCPriceStatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBandIBCPriceStatesResponse.DiscardUnknown(m)
+func (m *QueryCircuitBreakerStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBandIBCPriceStatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCircuitBreakerStateResponse proto.InternalMessageInfo
 
-func (m *QueryBandIBCPriceStatesResponse) GetPriceStates() []*BandPriceState {
+func (m *QueryCircuitBreakerStateResponse) GetCircuitBreaker() *CircuitBreaker {
 	if m != nil {
-		return m.PriceStates
+		return m.CircuitBreaker
 	}
 	return nil
 }
 
-// QueryPriceFeedPriceStatesRequest is the request type for the
-// Query/PriceFeedPriceStates RPC method.
-type QueryPriceFeedPriceStatesRequest struct {
+func (m *QueryCircuitBreakerStateResponse) GetHalt() *CircuitBreakerHalt {
+	if m != nil {
+		return m.Halt
+	}
+	return nil
 }
 
-func (m *QueryPriceFeedPriceStatesRequest) Reset()         { *m = QueryPriceFeedPriceStatesRequest{} }
-func (m *QueryPriceFeedPriceStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPriceFeedPriceStatesRequest) ProtoMessage()    {}
-func (*QueryPriceFeedPriceStatesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCircuitBreakerStateResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *QueryCircuitBreakerStateResponse) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+type QueryChainlinkDataStreamsDONsRequest struct {
+}
+
+func (m *QueryChainlinkDataStreamsDONsRequest) Reset()         { *m = QueryChainlinkDataStreamsDONsRequest{} }
+func (m *QueryChainlinkDataStreamsDONsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainlinkDataStreamsDONsRequest) ProtoMessage()    {}
+func (*QueryChainlinkDataStreamsDONsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{10}
 }
-func (m *QueryPriceFeedPriceStatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainlinkDataStreamsDONsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPriceFeedPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainlinkDataStreamsDONsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPriceFeedPriceStatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainlinkDataStreamsDONsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -480,36 +548,34 @@ func (m *QueryPriceFeedPriceStatesRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryPriceFeedPriceStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPriceFeedPriceStatesRequest.Merge(m, src)
+func (m *QueryChainlinkDataStreamsDONsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainlinkDataStreamsDONsRequest.Merge(m, src)
 }
-func (m *QueryPriceFeedPriceStatesRequest) XXX_Size() int {
+func (m *QueryChainlinkDataStreamsDONsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPriceFeedPriceStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPriceFeedPriceStatesRequest.DiscardUnknown(m)
+func (m *QueryChainlinkDataStreamsDONsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainlinkDataStreamsDONsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPriceFeedPriceStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryChainlinkDataStreamsDONsRequest proto.InternalMessageInfo
 
-// QueryPriceFeedPriceStatesResponse is the response type for the
-// Query/PriceFeedPriceStates RPC method.
-type QueryPriceFeedPriceStatesResponse struct {
-	PriceStates []*PriceFeedState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
+type QueryChainlinkDataStreamsDONsResponse struct {
+	Dons []ChainlinkDataStreamsDON `protobuf:"bytes,1,rep,name=dons,proto3" json:"dons"`
 }
 
-func (m *QueryPriceFeedPriceStatesResponse) Reset()         { *m = QueryPriceFeedPriceStatesResponse{} }
-func (m *QueryPriceFeedPriceStatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPriceFeedPriceStatesResponse) ProtoMessage()    {}
-func (*QueryPriceFeedPriceStatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryChainlinkDataStreamsDONsResponse) Reset()         { *m = QueryChainlinkDataStreamsDONsResponse{} }
+func (m *QueryChainlinkDataStreamsDONsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainlinkDataStreamsDONsResponse) ProtoMessage()    {}
+func (*QueryChainlinkDataStreamsDONsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{11}
 }
-func (m *QueryPriceFeedPriceStatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainlinkDataStreamsDONsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPriceFeedPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainlinkDataStreamsDONsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPriceFeedPriceStatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainlinkDataStreamsDONsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -519,42 +585,44 @@ func (m *QueryPriceFeedPriceStatesResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryPriceFeedPriceStatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPriceFeedPriceStatesResponse.Merge(m, src)
+func (m *QueryChainlinkDataStreamsDONsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainlinkDataStreamsDONsResponse.Merge(m, src)
 }
-func (m *QueryPriceFeedPriceStatesResponse) XXX_Size() int {
+func (m *QueryChainlinkDataStreamsDONsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPriceFeedPriceStatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPriceFeedPriceStatesResponse.DiscardUnknown(m)
+func (m *QueryChainlinkDataStreamsDONsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainlinkDataStreamsDONsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPriceFeedPriceStatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryChainlinkDataStreamsDONsResponse proto.InternalMessageInfo
 
-func (m *QueryPriceFeedPriceStatesResponse) GetPriceStates() []*PriceFeedState {
+func (m *QueryChainlinkDataStreamsDONsResponse) GetDons() []ChainlinkDataStreamsDON {
 	if m != nil {
-		return m.PriceStates
+		return m.Dons
 	}
 	return nil
 }
 
-// QueryCoinbasePriceStatesRequest is the request type for the
-// Query/CoinbasePriceStates RPC method.
-type QueryCoinbasePriceStatesRequest struct {
+type QueryChainlinkDataStreamsPriceStatesRequest struct {
 }
 
-func (m *QueryCoinbasePriceStatesRequest) Reset()         { *m = QueryCoinbasePriceStatesRequest{} }
-func (m *QueryCoinbasePriceStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCoinbasePriceStatesRequest) ProtoMessage()    {}
-func (*QueryCoinbasePriceStatesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) Reset() {
+	*m = QueryChainlinkDataStreamsPriceStatesRequest{}
+}
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryChainlinkDataStreamsPriceStatesRequest) ProtoMessage() {}
+func (*QueryChainlinkDataStreamsPriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{12}
 }
-func (m *QueryCoinbasePriceStatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCoinbasePriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCoinbasePriceStatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -564,36 +632,38 @@ func (m *QueryCoinbasePriceStatesRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryCoinbasePriceStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCoinbasePriceStatesRequest.Merge(m, src)
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesRequest.Merge(m, src)
 }
-func (m *QueryCoinbasePriceStatesRequest) XXX_Size() int {
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCoinbasePriceStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCoinbasePriceStatesRequest.DiscardUnknown(m)
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCoinbasePriceStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesRequest proto.InternalMessageInfo
 
-// QueryCoinbasePriceStatesResponse is the response type for the
-// Query/CoinbasePriceStates RPC method.
-type QueryCoinbasePriceStatesResponse struct {
-	PriceStates []*CoinbasePriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
+type QueryChainlinkDataStreamsPriceStatesResponse struct {
+	PriceStates []*ChainlinkDataStreamsPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryCoinbasePriceStatesResponse) Reset()         { *m = QueryCoinbasePriceStatesResponse{} }
-func (m *QueryCoinbasePriceStatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCoinbasePriceStatesResponse) ProtoMessage()    {}
-func (*QueryCoinbasePriceStatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) Reset() {
+	*m = QueryChainlinkDataStreamsPriceStatesResponse{}
+}
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryChainlinkDataStreamsPriceStatesResponse) ProtoMessage() {}
+func (*QueryChainlinkDataStreamsPriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{13}
 }
-func (m *QueryCoinbasePriceStatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCoinbasePriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCoinbasePriceStatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -603,42 +673,42 @@ func (m *QueryCoinbasePriceStatesResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryCoinbasePriceStatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCoinbasePriceStatesResponse.Merge(m, src)
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesResponse.Merge(m, src)
 }
-func (m *QueryCoinbasePriceStatesResponse) XXX_Size() int {
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCoinbasePriceStatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCoinbasePriceStatesResponse.DiscardUnknown(m)
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCoinbasePriceStatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryChainlinkDataStreamsPriceStatesResponse proto.InternalMessageInfo
 
-func (m *QueryCoinbasePriceStatesResponse) GetPriceStates() []*CoinbasePriceState {
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) GetPriceStates() []*ChainlinkDataStreamsPriceState {
 	if m != nil {
 		return m.PriceStates
 	}
 	return nil
 }
 
-// QueryPythPriceStatesRequest is the request type for the
-// Query/CoinbasePriceStates RPC method.
-type QueryPythPriceStatesRequest struct {
+// QueryOracleParamsRequest is the request type for the Query/OracleParams RPC
+// method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryPythPriceStatesRequest) Reset()         { *m = QueryPythPriceStatesRequest{} }
-func (m *QueryPythPriceStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPythPriceStatesRequest) ProtoMessage()    {}
-func (*QueryPythPriceStatesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{14}
 }
-func (m *QueryPythPriceStatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPythPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPythPriceStatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -648,36 +718,36 @@ func (m *QueryPythPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPythPriceStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPythPriceStatesRequest.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *QueryPythPriceStatesRequest) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPythPriceStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPythPriceStatesRequest.DiscardUnknown(m)
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPythPriceStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-// QueryPythPriceStatesResponse is the response type for the
-// Query/CoinbasePriceStates RPC method.
-type QueryPythPriceStatesResponse struct {
-	PriceStates []*PythPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
+// QueryOracleParamsResponse is the response type for the Query/OracleParams RPC
+// method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryPythPriceStatesResponse) Reset()         { *m = QueryPythPriceStatesResponse{} }
-func (m *QueryPythPriceStatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPythPriceStatesResponse) ProtoMessage()    {}
-func (*QueryPythPriceStatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{15}
 }
-func (m *QueryPythPriceStatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPythPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPythPriceStatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -687,42 +757,42 @@ func (m *QueryPythPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPythPriceStatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPythPriceStatesResponse.Merge(m, src)
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-func (m *QueryPythPriceStatesResponse) XXX_Size() int {
+func (m *QueryParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPythPriceStatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPythPriceStatesResponse.DiscardUnknown(m)
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPythPriceStatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
-func (m *QueryPythPriceStatesResponse) GetPriceStates() []*PythPriceState {
+func (m *QueryParamsResponse) GetParams() Params {
 	if m != nil {
-		return m.PriceStates
+		return m.Params
 	}
-	return nil
+	return Params{}
 }
 
-// QueryStorkPriceStatesRequest is the request type for the
-// Query/StorkPriceStates RPC method.
-type QueryStorkPriceStatesRequest struct {
+// QueryBandRelayersRequest is the request type for the Query/BandRelayers RPC
+// method.
+type QueryBandRelayersRequest struct {
 }
 
-func (m *QueryStorkPriceStatesRequest) Reset()         { *m = QueryStorkPriceStatesRequest{} }
-func (m *QueryStorkPriceStatesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStorkPriceStatesRequest) ProtoMessage()    {}
-func (*QueryStorkPriceStatesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBandRelayersRequest) Reset()         { *m = QueryBandRelayersRequest{} }
+func (m *QueryBandRelayersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBandRelayersRequest) ProtoMessage()    {}
+func (*QueryBandRelayersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{16}
 }
-func (m *QueryStorkPriceStatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBandRelayersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStorkPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBandRelayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStorkPriceStatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBandRelayersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -732,36 +802,36 @@ func (m *QueryStorkPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryStorkPriceStatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStorkPriceStatesRequest.Merge(m, src)
+func (m *QueryBandRelayersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandRelayersRequest.Merge(m, src)
 }
-func (m *QueryStorkPriceStatesRequest) XXX_Size() int {
+func (m *QueryBandRelayersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStorkPriceStatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStorkPriceStatesRequest.DiscardUnknown(m)
+func (m *QueryBandRelayersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandRelayersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStorkPriceStatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBandRelayersRequest proto.InternalMessageInfo
 
-// QueryStorkPriceStatesResponse is the response type for the
-// Query/StorkPriceStates RPC method.
-type QueryStorkPriceStatesResponse struct {
-	PriceStates []*StorkPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
+// QueryBandRelayersResponse is the response type for the Query/BandRelayers RPC
+// method.
+type QueryBandRelayersResponse struct {
+	Relayers []string `protobuf:"bytes,1,rep,name=relayers,proto3" json:"relayers,omitempty"`
 }
 
-func (m *QueryStorkPriceStatesResponse) Reset()         { *m = QueryStorkPriceStatesResponse{} }
-func (m *QueryStorkPriceStatesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStorkPriceStatesResponse) ProtoMessage()    {}
-func (*QueryStorkPriceStatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBandRelayersResponse) Reset()         { *m = QueryBandRelayersResponse{} }
+func (m *QueryBandRelayersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBandRelayersResponse) ProtoMessage()    {}
+func (*QueryBandRelayersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{17}
 }
-func (m *QueryStorkPriceStatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBandRelayersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStorkPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBandRelayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStorkPriceStatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBandRelayersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -771,42 +841,42 @@ func (m *QueryStorkPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryStorkPriceStatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStorkPriceStatesResponse.Merge(m, src)
+func (m *QueryBandRelayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandRelayersResponse.Merge(m, src)
 }
-func (m *QueryStorkPriceStatesResponse) XXX_Size() int {
+func (m *QueryBandRelayersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStorkPriceStatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStorkPriceStatesResponse.DiscardUnknown(m)
+func (m *QueryBandRelayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandRelayersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStorkPriceStatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBandRelayersResponse proto.InternalMessageInfo
 
-func (m *QueryStorkPriceStatesResponse) GetPriceStates() []*StorkPriceState {
+func (m *QueryBandRelayersResponse) GetRelayers() []string {
 	if m != nil {
-		return m.PriceStates
+		return m.Relayers
 	}
 	return nil
 }
 
-// QueryStorkPublishersRequest is the request type for the
-// Query/StorkPublishers RPC method.
-type QueryStorkPublishersRequest struct {
+// QueryBandPriceStatesRequest is the request type for the Query/BandPriceStates
+// RPC method.
+type QueryBandPriceStatesRequest struct {
 }
 
-func (m *QueryStorkPublishersRequest) Reset()         { *m = QueryStorkPublishersRequest{} }
-func (m *QueryStorkPublishersRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStorkPublishersRequest) ProtoMessage()    {}
-func (*QueryStorkPublishersRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBandPriceStatesRequest) Reset()         { *m = QueryBandPriceStatesRequest{} }
+func (m *QueryBandPriceStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBandPriceStatesRequest) ProtoMessage()    {}
+func (*QueryBandPriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{18}
 }
-func (m *QueryStorkPublishersRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBandPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStorkPublishersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBandPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStorkPublishersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBandPriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -816,36 +886,36 @@ func (m *QueryStorkPublishersRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryStorkPublishersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStorkPublishersRequest.Merge(m, src)
+func (m *QueryBandPriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandPriceStatesRequest.Merge(m, src)
 }
-func (m *QueryStorkPublishersRequest) XXX_Size() int {
+func (m *QueryBandPriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStorkPublishersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStorkPublishersRequest.DiscardUnknown(m)
+func (m *QueryBandPriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandPriceStatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStorkPublishersRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBandPriceStatesRequest proto.InternalMessageInfo
 
-// QueryStorkPublishersResponse is the response type for the
-// Query/StorkPublishers RPC method.
-type QueryStorkPublishersResponse struct {
-	Publishers []string `protobuf:"bytes,1,rep,name=publishers,proto3" json:"publishers,omitempty"`
+// QueryBandPriceStatesResponse is the response type for the
+// Query/BandPriceStates RPC method.
+type QueryBandPriceStatesResponse struct {
+	PriceStates []*BandPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryStorkPublishersResponse) Reset()         { *m = QueryStorkPublishersResponse{} }
-func (m *QueryStorkPublishersResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStorkPublishersResponse) ProtoMessage()    {}
-func (*QueryStorkPublishersResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBandPriceStatesResponse) Reset()         { *m = QueryBandPriceStatesResponse{} }
+func (m *QueryBandPriceStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBandPriceStatesResponse) ProtoMessage()    {}
+func (*QueryBandPriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{19}
 }
-func (m *QueryStorkPublishersResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBandPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStorkPublishersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBandPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStorkPublishersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBandPriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -855,44 +925,42 @@ func (m *QueryStorkPublishersResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryStorkPublishersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStorkPublishersResponse.Merge(m, src)
+func (m *QueryBandPriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandPriceStatesResponse.Merge(m, src)
 }
-func (m *QueryStorkPublishersResponse) XXX_Size() int {
+func (m *QueryBandPriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStorkPublishersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStorkPublishersResponse.DiscardUnknown(m)
+func (m *QueryBandPriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandPriceStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStorkPublishersResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBandPriceStatesResponse proto.InternalMessageInfo
 
-func (m *QueryStorkPublishersResponse) GetPublishers() []string {
+func (m *QueryBandPriceStatesResponse) GetPriceStates() []*BandPriceState {
 	if m != nil {
-		return m.Publishers
+		return m.PriceStates
 	}
 	return nil
 }
 
-// QueryProviderPriceStateRequest is the request type for the
-// Query/ProviderPriceState RPC method.
-type QueryProviderPriceStateRequest struct {
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Symbol   string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+// QueryBandIBCPriceStatesRequest is the request type for the
+// Query/BandIBCPriceStates RPC method.
+type QueryBandIBCPriceStatesRequest struct {
 }
 
-func (m *QueryProviderPriceStateRequest) Reset()         { *m = QueryProviderPriceStateRequest{} }
-func (m *QueryProviderPriceStateRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProviderPriceStateRequest) ProtoMessage()    {}
-func (*QueryProviderPriceStateRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBandIBCPriceStatesRequest) Reset()         { *m = QueryBandIBCPriceStatesRequest{} }
+func (m *QueryBandIBCPriceStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBandIBCPriceStatesRequest) ProtoMessage()    {}
+func (*QueryBandIBCPriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{20}
 }
-func (m *QueryProviderPriceStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBandIBCPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProviderPriceStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBandIBCPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProviderPriceStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBandIBCPriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -902,50 +970,36 @@ func (m *QueryProviderPriceStateRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryProviderPriceStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProviderPriceStateRequest.Merge(m, src)
+func (m *QueryBandIBCPriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandIBCPriceStatesRequest.Merge(m, src)
 }
-func (m *QueryProviderPriceStateRequest) XXX_Size() int {
+func (m *QueryBandIBCPriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProviderPriceStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProviderPriceStateRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryProviderPriceStateRequest proto.InternalMessageInfo
-
-func (m *QueryProviderPriceStateRequest) GetProvider() string {
-	if m != nil {
-		return m.Provider
-	}
-	return ""
+func (m *QueryBandIBCPriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandIBCPriceStatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProviderPriceStateRequest) GetSymbol() string {
-	if m != nil {
-		return m.Symbol
-	}
-	return ""
-}
+var xxx_messageInfo_QueryBandIBCPriceStatesRequest proto.InternalMessageInfo
 
-// QueryProviderPriceStatesResponse is the response type for the
-// Query/ProviderPriceStates RPC method.
-type QueryProviderPriceStateResponse struct {
-	PriceState *PriceState `protobuf:"bytes,1,opt,name=price_state,json=priceState,proto3" json:"price_state,omitempty"`
+// QueryBandIBCPriceStatesResponse is the response type for the
+// Query/BandIBCPriceStates RPC method.
+type QueryBandIBCPriceStatesResponse struct {
+	PriceStates []*BandPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryProviderPriceStateResponse) Reset()         { *m = QueryProviderPriceStateResponse{} }
-func (m *QueryProviderPriceStateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProviderPriceStateResponse) ProtoMessage()    {}
-func (*QueryProviderPriceStateResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBandIBCPriceStatesResponse) Reset()         { *m = QueryBandIBCPriceStatesResponse{} }
+func (m *QueryBandIBCPriceStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBandIBCPriceStatesResponse) ProtoMessage()    {}
+func (*QueryBandIBCPriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{21}
 }
-func (m *QueryProviderPriceStateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBandIBCPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProviderPriceStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBandIBCPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProviderPriceStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBandIBCPriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -955,42 +1009,42 @@ func (m *QueryProviderPriceStateResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryProviderPriceStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProviderPriceStateResponse.Merge(m, src)
+func (m *QueryBandIBCPriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandIBCPriceStatesResponse.Merge(m, src)
 }
-func (m *QueryProviderPriceStateResponse) XXX_Size() int {
+func (m *QueryBandIBCPriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProviderPriceStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProviderPriceStateResponse.DiscardUnknown(m)
+func (m *QueryBandIBCPriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandIBCPriceStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProviderPriceStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBandIBCPriceStatesResponse proto.InternalMessageInfo
 
-func (m *QueryProviderPriceStateResponse) GetPriceState() *PriceState {
+func (m *QueryBandIBCPriceStatesResponse) GetPriceStates() []*BandPriceState {
 	if m != nil {
-		return m.PriceState
+		return m.PriceStates
 	}
 	return nil
 }
 
-// QueryModuleStateRequest is the request type for the Query/OracleModuleState
-// RPC method.
-type QueryModuleStateRequest struct {
+// QueryPriceFeedPriceStatesRequest is the request type for the
+// Query/PriceFeedPriceStates RPC method.
+type QueryPriceFeedPriceStatesRequest struct {
 }
 
-func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest{} }
-func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryModuleStateRequest) ProtoMessage()    {}
-func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceFeedPriceStatesRequest) Reset()         { *m = QueryPriceFeedPriceStatesRequest{} }
+func (m *QueryPriceFeedPriceStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceFeedPriceStatesRequest) ProtoMessage()    {}
+func (*QueryPriceFeedPriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{22}
 }
-func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceFeedPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryModuleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceFeedPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryModuleStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceFeedPriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1000,36 +1054,36 @@ func (m *QueryModuleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryModuleStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryModuleStateRequest.Merge(m, src)
+func (m *QueryPriceFeedPriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceFeedPriceStatesRequest.Merge(m, src)
 }
-func (m *QueryModuleStateRequest) XXX_Size() int {
+func (m *QueryPriceFeedPriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryModuleStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryModuleStateRequest.DiscardUnknown(m)
+func (m *QueryPriceFeedPriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceFeedPriceStatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryModuleStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceFeedPriceStatesRequest proto.InternalMessageInfo
 
-// QueryModuleStateResponse is the response type for the Query/OracleModuleState
-// RPC method.
-type QueryModuleStateResponse struct {
-	State *GenesisState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+// QueryPriceFeedPriceStatesResponse is the response type for the
+// Query/PriceFeedPriceStates RPC method.
+type QueryPriceFeedPriceStatesResponse struct {
+	PriceStates []*PriceFeedState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateResponse{} }
-func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryModuleStateResponse) ProtoMessage()    {}
-func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceFeedPriceStatesResponse) Reset()         { *m = QueryPriceFeedPriceStatesResponse{} }
+func (m *QueryPriceFeedPriceStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceFeedPriceStatesResponse) ProtoMessage()    {}
+func (*QueryPriceFeedPriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{23}
 }
-func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceFeedPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryModuleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceFeedPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryModuleStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceFeedPriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1039,42 +1093,42 @@ func (m *QueryModuleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryModuleStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryModuleStateResponse.Merge(m, src)
+func (m *QueryPriceFeedPriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceFeedPriceStatesResponse.Merge(m, src)
 }
-func (m *QueryModuleStateResponse) XXX_Size() int {
+func (m *QueryPriceFeedPriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryModuleStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryModuleStateResponse.DiscardUnknown(m)
+func (m *QueryPriceFeedPriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceFeedPriceStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryModuleStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceFeedPriceStatesResponse proto.InternalMessageInfo
 
-func (m *QueryModuleStateResponse) GetState() *GenesisState {
+func (m *QueryPriceFeedPriceStatesResponse) GetPriceStates() []*PriceFeedState {
 	if m != nil {
-		return m.State
+		return m.PriceStates
 	}
 	return nil
 }
 
-type QueryHistoricalPriceRecordsRequest struct {
-	Oracle   OracleType `protobuf:"varint,1,opt,name=oracle,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle,omitempty"`
-	SymbolId string     `protobuf:"bytes,2,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
+// QueryCoinbasePriceStatesRequest is the request type for the
+// Query/CoinbasePriceStates RPC method.
+type QueryCoinbasePriceStatesRequest struct {
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) Reset()         { *m = QueryHistoricalPriceRecordsRequest{} }
-func (m *QueryHistoricalPriceRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryHistoricalPriceRecordsRequest) ProtoMessage()    {}
-func (*QueryHistoricalPriceRecordsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCoinbasePriceStatesRequest) Reset()         { *m = QueryCoinbasePriceStatesRequest{} }
+func (m *QueryCoinbasePriceStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinbasePriceStatesRequest) ProtoMessage()    {}
+func (*QueryCoinbasePriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{24}
 }
-func (m *QueryHistoricalPriceRecordsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCoinbasePriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHistoricalPriceRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCoinbasePriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHistoricalPriceRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCoinbasePriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1084,48 +1138,36 @@ func (m *QueryHistoricalPriceRecordsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryHistoricalPriceRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHistoricalPriceRecordsRequest.Merge(m, src)
+func (m *QueryCoinbasePriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinbasePriceStatesRequest.Merge(m, src)
 }
-func (m *QueryHistoricalPriceRecordsRequest) XXX_Size() int {
+func (m *QueryCoinbasePriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHistoricalPriceRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHistoricalPriceRecordsRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryHistoricalPriceRecordsRequest proto.InternalMessageInfo
-
-func (m *QueryHistoricalPriceRecordsRequest) GetOracle() OracleType {
-	if m != nil {
-		return m.Oracle
-	}
-	return OracleType_Unspecified
+func (m *QueryCoinbasePriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinbasePriceStatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) GetSymbolId() string {
-	if m != nil {
-		return m.SymbolId
-	}
-	return ""
-}
+var xxx_messageInfo_QueryCoinbasePriceStatesRequest proto.InternalMessageInfo
 
-type QueryHistoricalPriceRecordsResponse struct {
-	PriceRecords []*PriceRecords `protobuf:"bytes,1,rep,name=price_records,json=priceRecords,proto3" json:"price_records,omitempty"`
+// QueryCoinbasePriceStatesResponse is the response type for the
+// Query/CoinbasePriceStates RPC method.
+type QueryCoinbasePriceStatesResponse struct {
+	PriceStates []*CoinbasePriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryHistoricalPriceRecordsResponse) Reset()         { *m = QueryHistoricalPriceRecordsResponse{} }
-func (m *QueryHistoricalPriceRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryHistoricalPriceRecordsResponse) ProtoMessage()    {}
-func (*QueryHistoricalPriceRecordsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCoinbasePriceStatesResponse) Reset()         { *m = QueryCoinbasePriceStatesResponse{} }
+func (m *QueryCoinbasePriceStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoinbasePriceStatesResponse) ProtoMessage()    {}
+func (*QueryCoinbasePriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{25}
 }
-func (m *QueryHistoricalPriceRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCoinbasePriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHistoricalPriceRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCoinbasePriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHistoricalPriceRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCoinbasePriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1135,50 +1177,42 @@ func (m *QueryHistoricalPriceRecordsResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryHistoricalPriceRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHistoricalPriceRecordsResponse.Merge(m, src)
+func (m *QueryCoinbasePriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoinbasePriceStatesResponse.Merge(m, src)
 }
-func (m *QueryHistoricalPriceRecordsResponse) XXX_Size() int {
+func (m *QueryCoinbasePriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHistoricalPriceRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHistoricalPriceRecordsResponse.DiscardUnknown(m)
+func (m *QueryCoinbasePriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoinbasePriceStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHistoricalPriceRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCoinbasePriceStatesResponse proto.InternalMessageInfo
 
-func (m *QueryHistoricalPriceRecordsResponse) GetPriceRecords() []*PriceRecords {
+func (m *QueryCoinbasePriceStatesResponse) GetPriceStates() []*CoinbasePriceState {
 	if m != nil {
-		return m.PriceRecords
+		return m.PriceStates
 	}
 	return nil
 }
 
-type OracleHistoryOptions struct {
-	// MaxAge restricts the oracle price records oldest age in seconds from the
-	// current block time to consider. A value of 0 means use all the records
-	// present on the chain.
-	MaxAge uint64 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
-	// If IncludeRawHistory is true, the raw underlying data used for the
-	// computation is included in the response
-	IncludeRawHistory bool `protobuf:"varint,2,opt,name=include_raw_history,json=includeRawHistory,proto3" json:"include_raw_history,omitempty"`
-	// If IncludeMetadata is true, metadata on the computation is included in the
-	// response
-	IncludeMetadata bool `protobuf:"varint,3,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
+// QueryPythPriceStatesRequest is the request type for the
+// Query/CoinbasePriceStates RPC method.
+type QueryPythPriceStatesRequest struct {
 }
 
-func (m *OracleHistoryOptions) Reset()         { *m = OracleHistoryOptions{} }
-func (m *OracleHistoryOptions) String() string { return proto.CompactTextString(m) }
-func (*OracleHistoryOptions) ProtoMessage()    {}
-func (*OracleHistoryOptions) Descriptor() ([]byte, []int) {
+func (m *QueryPythPriceStatesRequest) Reset()         { *m = QueryPythPriceStatesRequest{} }
+func (m *QueryPythPriceStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPythPriceStatesRequest) ProtoMessage()    {}
+func (*QueryPythPriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{26}
 }
-func (m *OracleHistoryOptions) XXX_Unmarshal(b []byte) error {
+func (m *QueryPythPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OracleHistoryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPythPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OracleHistoryOptions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPythPriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1188,59 +1222,36 @@ func (m *OracleHistoryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *OracleHistoryOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OracleHistoryOptions.Merge(m, src)
+func (m *QueryPythPriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPythPriceStatesRequest.Merge(m, src)
 }
-func (m *OracleHistoryOptions) XXX_Size() int {
+func (m *QueryPythPriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *OracleHistoryOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_OracleHistoryOptions.DiscardUnknown(m)
+func (m *QueryPythPriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPythPriceStatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OracleHistoryOptions proto.InternalMessageInfo
-
-func (m *OracleHistoryOptions) GetMaxAge() uint64 {
-	if m != nil {
-		return m.MaxAge
-	}
-	return 0
-}
+var xxx_messageInfo_QueryPythPriceStatesRequest proto.InternalMessageInfo
 
-func (m *OracleHistoryOptions) GetIncludeRawHistory() bool {
-	if m != nil {
-		return m.IncludeRawHistory
-	}
-	return false
-}
-
-func (m *OracleHistoryOptions) GetIncludeMetadata() bool {
-	if m != nil {
-		return m.IncludeMetadata
-	}
-	return false
-}
-
-// QueryOracleVolatilityRequest is the request type for Query/OracleVolatility
-// RPC method.
-type QueryOracleVolatilityRequest struct {
-	BaseInfo             *OracleInfo           `protobuf:"bytes,1,opt,name=base_info,json=baseInfo,proto3" json:"base_info,omitempty"`
-	QuoteInfo            *OracleInfo           `protobuf:"bytes,2,opt,name=quote_info,json=quoteInfo,proto3" json:"quote_info,omitempty"`
-	OracleHistoryOptions *OracleHistoryOptions `protobuf:"bytes,3,opt,name=oracle_history_options,json=oracleHistoryOptions,proto3" json:"oracle_history_options,omitempty"`
+// QueryPythPriceStatesResponse is the response type for the
+// Query/CoinbasePriceStates RPC method.
+type QueryPythPriceStatesResponse struct {
+	PriceStates []*PythPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryOracleVolatilityRequest) Reset()         { *m = QueryOracleVolatilityRequest{} }
-func (m *QueryOracleVolatilityRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOracleVolatilityRequest) ProtoMessage()    {}
-func (*QueryOracleVolatilityRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPythPriceStatesResponse) Reset()         { *m = QueryPythPriceStatesResponse{} }
+func (m *QueryPythPriceStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPythPriceStatesResponse) ProtoMessage()    {}
+func (*QueryPythPriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{27}
 }
-func (m *QueryOracleVolatilityRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPythPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOracleVolatilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPythPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOracleVolatilityRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPythPriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1250,59 +1261,42 @@ func (m *QueryOracleVolatilityRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryOracleVolatilityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOracleVolatilityRequest.Merge(m, src)
+func (m *QueryPythPriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPythPriceStatesResponse.Merge(m, src)
 }
-func (m *QueryOracleVolatilityRequest) XXX_Size() int {
+func (m *QueryPythPriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOracleVolatilityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOracleVolatilityRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryOracleVolatilityRequest proto.InternalMessageInfo
-
-func (m *QueryOracleVolatilityRequest) GetBaseInfo() *OracleInfo {
-	if m != nil {
-		return m.BaseInfo
-	}
-	return nil
+func (m *QueryPythPriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPythPriceStatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOracleVolatilityRequest) GetQuoteInfo() *OracleInfo {
-	if m != nil {
-		return m.QuoteInfo
-	}
-	return nil
-}
+var xxx_messageInfo_QueryPythPriceStatesResponse proto.InternalMessageInfo
 
-func (m *QueryOracleVolatilityRequest) GetOracleHistoryOptions() *OracleHistoryOptions {
+func (m *QueryPythPriceStatesResponse) GetPriceStates() []*PythPriceState {
 	if m != nil {
-		return m.OracleHistoryOptions
+		return m.PriceStates
 	}
 	return nil
 }
 
-// QueryOracleVolatilityResponse is the response type for Query/OracleVolatility
-// RPC method.
-type QueryOracleVolatilityResponse struct {
-	Volatility      *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility,omitempty"`
-	HistoryMetadata *MetadataStatistics          `protobuf:"bytes,2,opt,name=history_metadata,json=historyMetadata,proto3" json:"history_metadata,omitempty"`
-	RawHistory      []*PriceRecord               `protobuf:"bytes,3,rep,name=raw_history,json=rawHistory,proto3" json:"raw_history,omitempty"`
+// QueryStorkPriceStatesRequest is the request type for the
+// Query/StorkPriceStates RPC method.
+type QueryStorkPriceStatesRequest struct {
 }
 
-func (m *QueryOracleVolatilityResponse) Reset()         { *m = QueryOracleVolatilityResponse{} }
-func (m *QueryOracleVolatilityResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOracleVolatilityResponse) ProtoMessage()    {}
-func (*QueryOracleVolatilityResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStorkPriceStatesRequest) Reset()         { *m = QueryStorkPriceStatesRequest{} }
+func (m *QueryStorkPriceStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorkPriceStatesRequest) ProtoMessage()    {}
+func (*QueryStorkPriceStatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{28}
 }
-func (m *QueryOracleVolatilityResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorkPriceStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOracleVolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorkPriceStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOracleVolatilityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorkPriceStatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1312,47 +1306,36 @@ func (m *QueryOracleVolatilityResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryOracleVolatilityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOracleVolatilityResponse.Merge(m, src)
+func (m *QueryStorkPriceStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorkPriceStatesRequest.Merge(m, src)
 }
-func (m *QueryOracleVolatilityResponse) XXX_Size() int {
+func (m *QueryStorkPriceStatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOracleVolatilityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOracleVolatilityResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryOracleVolatilityResponse proto.InternalMessageInfo
-
-func (m *QueryOracleVolatilityResponse) GetHistoryMetadata() *MetadataStatistics {
-	if m != nil {
-		return m.HistoryMetadata
-	}
-	return nil
+func (m *QueryStorkPriceStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorkPriceStatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOracleVolatilityResponse) GetRawHistory() []*PriceRecord {
-	if m != nil {
-		return m.RawHistory
-	}
-	return nil
-}
+var xxx_messageInfo_QueryStorkPriceStatesRequest proto.InternalMessageInfo
 
-type QueryOracleProvidersInfoRequest struct {
+// QueryStorkPriceStatesResponse is the response type for the
+// Query/StorkPriceStates RPC method.
+type QueryStorkPriceStatesResponse struct {
+	PriceStates []*StorkPriceState `protobuf:"bytes,1,rep,name=price_states,json=priceStates,proto3" json:"price_states,omitempty"`
 }
 
-func (m *QueryOracleProvidersInfoRequest) Reset()         { *m = QueryOracleProvidersInfoRequest{} }
-func (m *QueryOracleProvidersInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOracleProvidersInfoRequest) ProtoMessage()    {}
-func (*QueryOracleProvidersInfoRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStorkPriceStatesResponse) Reset()         { *m = QueryStorkPriceStatesResponse{} }
+func (m *QueryStorkPriceStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorkPriceStatesResponse) ProtoMessage()    {}
+func (*QueryStorkPriceStatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{29}
 }
-func (m *QueryOracleProvidersInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorkPriceStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOracleProvidersInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorkPriceStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOracleProvidersInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorkPriceStatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1362,34 +1345,42 @@ func (m *QueryOracleProvidersInfoRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryOracleProvidersInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOracleProvidersInfoRequest.Merge(m, src)
+func (m *QueryStorkPriceStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorkPriceStatesResponse.Merge(m, src)
 }
-func (m *QueryOracleProvidersInfoRequest) XXX_Size() int {
+func (m *QueryStorkPriceStatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOracleProvidersInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOracleProvidersInfoRequest.DiscardUnknown(m)
+func (m *QueryStorkPriceStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorkPriceStatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOracleProvidersInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorkPriceStatesResponse proto.InternalMessageInfo
 
-type QueryOracleProvidersInfoResponse struct {
-	Providers []*ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+func (m *QueryStorkPriceStatesResponse) GetPriceStates() []*StorkPriceState {
+	if m != nil {
+		return m.PriceStates
+	}
+	return nil
 }
 
-func (m *QueryOracleProvidersInfoResponse) Reset()         { *m = QueryOracleProvidersInfoResponse{} }
-func (m *QueryOracleProvidersInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOracleProvidersInfoResponse) ProtoMessage()    {}
-func (*QueryOracleProvidersInfoResponse) Descriptor() ([]byte, []int) {
+// QueryStorkPublishersRequest is the request type for the
+// Query/StorkPublishers RPC method.
+type QueryStorkPublishersRequest struct {
+}
+
+func (m *QueryStorkPublishersRequest) Reset()         { *m = QueryStorkPublishersRequest{} }
+func (m *QueryStorkPublishersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorkPublishersRequest) ProtoMessage()    {}
+func (*QueryStorkPublishersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{30}
 }
-func (m *QueryOracleProvidersInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorkPublishersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOracleProvidersInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorkPublishersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOracleProvidersInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorkPublishersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1399,41 +1390,36 @@ func (m *QueryOracleProvidersInfoResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryOracleProvidersInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOracleProvidersInfoResponse.Merge(m, src)
+func (m *QueryStorkPublishersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorkPublishersRequest.Merge(m, src)
 }
-func (m *QueryOracleProvidersInfoResponse) XXX_Size() int {
+func (m *QueryStorkPublishersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOracleProvidersInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOracleProvidersInfoResponse.DiscardUnknown(m)
+func (m *QueryStorkPublishersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorkPublishersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOracleProvidersInfoResponse proto.InternalMessageInfo
-
-func (m *QueryOracleProvidersInfoResponse) GetProviders() []*ProviderInfo {
-	if m != nil {
-		return m.Providers
-	}
-	return nil
-}
+var xxx_messageInfo_QueryStorkPublishersRequest proto.InternalMessageInfo
 
-type QueryOracleProviderPricesRequest struct {
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+// QueryStorkPublishersResponse is the response type for the
+// Query/StorkPublishers RPC method.
+type QueryStorkPublishersResponse struct {
+	Publishers []string `protobuf:"bytes,1,rep,name=publishers,proto3" json:"publishers,omitempty"`
 }
 
-func (m *QueryOracleProviderPricesRequest) Reset()         { *m = QueryOracleProviderPricesRequest{} }
-func (m *QueryOracleProviderPricesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOracleProviderPricesRequest) ProtoMessage()    {}
-func (*QueryOracleProviderPricesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStorkPublishersResponse) Reset()         { *m = QueryStorkPublishersResponse{} }
+func (m *QueryStorkPublishersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorkPublishersResponse) ProtoMessage()    {}
+func (*QueryStorkPublishersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{31}
 }
-func (m *QueryOracleProviderPricesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorkPublishersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOracleProviderPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorkPublishersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOracleProviderPricesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorkPublishersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1443,41 +1429,44 @@ func (m *QueryOracleProviderPricesRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryOracleProviderPricesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOracleProviderPricesRequest.Merge(m, src)
+func (m *QueryStorkPublishersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorkPublishersResponse.Merge(m, src)
 }
-func (m *QueryOracleProviderPricesRequest) XXX_Size() int {
+func (m *QueryStorkPublishersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOracleProviderPricesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOracleProviderPricesRequest.DiscardUnknown(m)
+func (m *QueryStorkPublishersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorkPublishersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOracleProviderPricesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorkPublishersResponse proto.InternalMessageInfo
 
-func (m *QueryOracleProviderPricesRequest) GetProvider() string {
+func (m *QueryStorkPublishersResponse) GetPublishers() []string {
 	if m != nil {
-		return m.Provider
+		return m.Publishers
 	}
-	return ""
+	return nil
 }
 
-type QueryOracleProviderPricesResponse struct {
-	ProviderState []*ProviderState `protobuf:"bytes,1,rep,name=providerState,proto3" json:"providerState,omitempty"`
+// QueryProviderPriceStateRequest is the request type for the
+// Query/ProviderPriceState RPC method.
+type QueryProviderPriceStateRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Symbol   string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
-func (m *QueryOracleProviderPricesResponse) Reset()         { *m = QueryOracleProviderPricesResponse{} }
-func (m *QueryOracleProviderPricesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOracleProviderPricesResponse) ProtoMessage()    {}
-func (*QueryOracleProviderPricesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryProviderPriceStateRequest) Reset()         { *m = QueryProviderPriceStateRequest{} }
+func (m *QueryProviderPriceStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPriceStateRequest) ProtoMessage()    {}
+func (*QueryProviderPriceStateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{32}
 }
-func (m *QueryOracleProviderPricesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProviderPriceStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOracleProviderPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProviderPriceStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOracleProviderPricesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProviderPriceStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1487,45 +1476,50 @@ func (m *QueryOracleProviderPricesResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryOracleProviderPricesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOracleProviderPricesResponse.Merge(m, src)
+func (m *QueryProviderPriceStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPriceStateRequest.Merge(m, src)
 }
-func (m *QueryOracleProviderPricesResponse) XXX_Size() int {
+func (m *QueryProviderPriceStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOracleProviderPricesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOracleProviderPricesResponse.DiscardUnknown(m)
+func (m *QueryProviderPriceStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPriceStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOracleProviderPricesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProviderPriceStateRequest proto.InternalMessageInfo
 
-func (m *QueryOracleProviderPricesResponse) GetProviderState() []*ProviderState {
+func (m *QueryProviderPriceStateRequest) GetProvider() string {
 	if m != nil {
-		return m.ProviderState
+		return m.Provider
 	}
-	return nil
+	return ""
 }
 
-// ScalingOptions defines optional configuration to avoid precision loss. The
-// oracle result will be returned as base_price * 10^base_decimals / quote_price
-// * 10^quote_decimals
-type ScalingOptions struct {
-	BaseDecimals  uint32 `protobuf:"varint,1,opt,name=base_decimals,json=baseDecimals,proto3" json:"base_decimals,omitempty"`
-	QuoteDecimals uint32 `protobuf:"varint,2,opt,name=quote_decimals,json=quoteDecimals,proto3" json:"quote_decimals,omitempty"`
+func (m *QueryProviderPriceStateRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *ScalingOptions) Reset()         { *m = ScalingOptions{} }
-func (m *ScalingOptions) String() string { return proto.CompactTextString(m) }
-func (*ScalingOptions) ProtoMessage()    {}
-func (*ScalingOptions) Descriptor() ([]byte, []int) {
+// QueryProviderPriceStatesResponse is the response type for the
+// Query/ProviderPriceStates RPC method.
+type QueryProviderPriceStateResponse struct {
+	PriceState *PriceState `protobuf:"bytes,1,opt,name=price_state,json=priceState,proto3" json:"price_state,omitempty"`
+}
+
+func (m *QueryProviderPriceStateResponse) Reset()         { *m = QueryProviderPriceStateResponse{} }
+func (m *QueryProviderPriceStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPriceStateResponse) ProtoMessage()    {}
+func (*QueryProviderPriceStateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{33}
 }
-func (m *ScalingOptions) XXX_Unmarshal(b []byte) error {
+func (m *QueryProviderPriceStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScalingOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProviderPriceStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScalingOptions.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProviderPriceStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1535,53 +1529,42 @@ func (m *ScalingOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ScalingOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScalingOptions.Merge(m, src)
+func (m *QueryProviderPriceStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPriceStateResponse.Merge(m, src)
 }
-func (m *ScalingOptions) XXX_Size() int {
+func (m *QueryProviderPriceStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScalingOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScalingOptions.DiscardUnknown(m)
+func (m *QueryProviderPriceStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPriceStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScalingOptions proto.InternalMessageInfo
-
-func (m *ScalingOptions) GetBaseDecimals() uint32 {
-	if m != nil {
-		return m.BaseDecimals
-	}
-	return 0
-}
+var xxx_messageInfo_QueryProviderPriceStateResponse proto.InternalMessageInfo
 
-func (m *ScalingOptions) GetQuoteDecimals() uint32 {
+func (m *QueryProviderPriceStateResponse) GetPriceState() *PriceState {
 	if m != nil {
-		return m.QuoteDecimals
+		return m.PriceState
 	}
-	return 0
+	return nil
 }
 
-// QueryOraclePriceRequest is the request type for the Query/OraclePrice RPC
-// method.
-type QueryOraclePriceRequest struct {
-	OracleType     OracleType      `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
-	Base           string          `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
-	Quote          string          `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
-	ScalingOptions *ScalingOptions `protobuf:"bytes,4,opt,name=scaling_options,json=scalingOptions,proto3" json:"scaling_options,omitempty"`
+// QueryModuleStateRequest is the request type for the Query/OracleModuleState
+// RPC method.
+type QueryModuleStateRequest struct {
 }
 
-func (m *QueryOraclePriceRequest) Reset()         { *m = QueryOraclePriceRequest{} }
-func (m *QueryOraclePriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryOraclePriceRequest) ProtoMessage()    {}
-func (*QueryOraclePriceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest{} }
+func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStateRequest) ProtoMessage()    {}
+func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_52f5d6f9962923ad, []int{34}
 }
-func (m *QueryOraclePriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOraclePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryModuleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOraclePriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryModuleStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1591,68 +1574,81 @@ func (m *QueryOraclePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryOraclePriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOraclePriceRequest.Merge(m, src)
+func (m *QueryModuleStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStateRequest.Merge(m, src)
 }
-func (m *QueryOraclePriceRequest) XXX_Size() int {
+func (m *QueryModuleStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOraclePriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOraclePriceRequest.DiscardUnknown(m)
+func (m *QueryModuleStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOraclePriceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryModuleStateRequest proto.InternalMessageInfo
 
-func (m *QueryOraclePriceRequest) GetOracleType() OracleType {
-	if m != nil {
-		return m.OracleType
-	}
-	return OracleType_Unspecified
+// QueryModuleStateResponse is the response type for the Query/OracleModuleState
+// RPC method.
+type QueryModuleStateResponse struct {
+	State *GenesisState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (m *QueryOraclePriceRequest) GetBase() string {
-	if m != nil {
-		return m.Base
-	}
-	return ""
+func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateResponse{} }
+func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleStateResponse) ProtoMessage()    {}
+func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{35}
 }
-
-func (m *QueryOraclePriceRequest) GetQuote() string {
-	if m != nil {
-		return m.Quote
+func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryModuleStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleStateResponse.Merge(m, src)
+}
+func (m *QueryModuleStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOraclePriceRequest) GetScalingOptions() *ScalingOptions {
+var xxx_messageInfo_QueryModuleStateResponse proto.InternalMessageInfo
+
+func (m *QueryModuleStateResponse) GetState() *GenesisState {
 	if m != nil {
-		return m.ScalingOptions
+		return m.State
 	}
 	return nil
 }
 
-type PricePairState struct {
-	PairPrice            cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=pair_price,json=pairPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pair_price"`
-	BasePrice            cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_price"`
-	QuotePrice           cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=quote_price,json=quotePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_price"`
-	BaseCumulativePrice  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=base_cumulative_price,json=baseCumulativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_cumulative_price"`
-	QuoteCumulativePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=quote_cumulative_price,json=quoteCumulativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_cumulative_price"`
-	BaseTimestamp        int64                       `protobuf:"varint,6,opt,name=base_timestamp,json=baseTimestamp,proto3" json:"base_timestamp,omitempty"`
-	QuoteTimestamp       int64                       `protobuf:"varint,7,opt,name=quote_timestamp,json=quoteTimestamp,proto3" json:"quote_timestamp,omitempty"`
+type QueryHistoricalPriceRecordsRequest struct {
+	Oracle   OracleType `protobuf:"varint,1,opt,name=oracle,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle,omitempty"`
+	SymbolId string     `protobuf:"bytes,2,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
 }
 
-func (m *PricePairState) Reset()         { *m = PricePairState{} }
-func (m *PricePairState) String() string { return proto.CompactTextString(m) }
-func (*PricePairState) ProtoMessage()    {}
-func (*PricePairState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{35}
+func (m *QueryHistoricalPriceRecordsRequest) Reset()         { *m = QueryHistoricalPriceRecordsRequest{} }
+func (m *QueryHistoricalPriceRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalPriceRecordsRequest) ProtoMessage()    {}
+func (*QueryHistoricalPriceRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{36}
 }
-func (m *PricePairState) XXX_Unmarshal(b []byte) error {
+func (m *QueryHistoricalPriceRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PricePairState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHistoricalPriceRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PricePairState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHistoricalPriceRecordsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1662,50 +1658,48 @@ func (m *PricePairState) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PricePairState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PricePairState.Merge(m, src)
+func (m *QueryHistoricalPriceRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalPriceRecordsRequest.Merge(m, src)
 }
-func (m *PricePairState) XXX_Size() int {
+func (m *QueryHistoricalPriceRecordsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PricePairState) XXX_DiscardUnknown() {
-	xxx_messageInfo_PricePairState.DiscardUnknown(m)
+func (m *QueryHistoricalPriceRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalPriceRecordsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PricePairState proto.InternalMessageInfo
+var xxx_messageInfo_QueryHistoricalPriceRecordsRequest proto.InternalMessageInfo
 
-func (m *PricePairState) GetBaseTimestamp() int64 {
+func (m *QueryHistoricalPriceRecordsRequest) GetOracle() OracleType {
 	if m != nil {
-		return m.BaseTimestamp
+		return m.Oracle
 	}
-	return 0
+	return OracleType_Unspecified
 }
 
-func (m *PricePairState) GetQuoteTimestamp() int64 {
+func (m *QueryHistoricalPriceRecordsRequest) GetSymbolId() string {
 	if m != nil {
-		return m.QuoteTimestamp
+		return m.SymbolId
 	}
-	return 0
+	return ""
 }
 
-// QueryOraclePriceResponse is the response type for the Query/OraclePrice RPC
-// method.
-type QueryOraclePriceResponse struct {
-	PricePairState *PricePairState `protobuf:"bytes,1,opt,name=price_pair_state,json=pricePairState,proto3" json:"price_pair_state,omitempty"`
+type QueryHistoricalPriceRecordsResponse struct {
+	PriceRecords []*PriceRecords `protobuf:"bytes,1,rep,name=price_records,json=priceRecords,proto3" json:"price_records,omitempty"`
 }
 
-func (m *QueryOraclePriceResponse) Reset()         { *m = QueryOraclePriceResponse{} }
-func (m *QueryOraclePriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryOraclePriceResponse) ProtoMessage()    {}
-func (*QueryOraclePriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52f5d6f9962923ad, []int{36}
+func (m *QueryHistoricalPriceRecordsResponse) Reset()         { *m = QueryHistoricalPriceRecordsResponse{} }
+func (m *QueryHistoricalPriceRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalPriceRecordsResponse) ProtoMessage()    {}
+func (*QueryHistoricalPriceRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{37}
 }
-func (m *QueryOraclePriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHistoricalPriceRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryOraclePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHistoricalPriceRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryOraclePriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHistoricalPriceRecordsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1715,2047 +1709,1899 @@ func (m *QueryOraclePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryOraclePriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryOraclePriceResponse.Merge(m, src)
+func (m *QueryHistoricalPriceRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalPriceRecordsResponse.Merge(m, src)
 }
-func (m *QueryOraclePriceResponse) XXX_Size() int {
+func (m *QueryHistoricalPriceRecordsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryOraclePriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryOraclePriceResponse.DiscardUnknown(m)
+func (m *QueryHistoricalPriceRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalPriceRecordsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryOraclePriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHistoricalPriceRecordsResponse proto.InternalMessageInfo
 
-func (m *QueryOraclePriceResponse) GetPricePairState() *PricePairState {
+func (m *QueryHistoricalPriceRecordsResponse) GetPriceRecords() []*PriceRecords {
 	if m != nil {
-		return m.PricePairState
+		return m.PriceRecords
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryPythPriceRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceRequest")
-	proto.RegisterType((*QueryPythPriceResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "injective.oracle.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "injective.oracle.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryBandRelayersRequest)(nil), "injective.oracle.v1beta1.QueryBandRelayersRequest")
-	proto.RegisterType((*QueryBandRelayersResponse)(nil), "injective.oracle.v1beta1.QueryBandRelayersResponse")
-	proto.RegisterType((*QueryBandPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryBandPriceStatesRequest")
-	proto.RegisterType((*QueryBandPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryBandPriceStatesResponse")
-	proto.RegisterType((*QueryBandIBCPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryBandIBCPriceStatesRequest")
-	proto.RegisterType((*QueryBandIBCPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryBandIBCPriceStatesResponse")
-	proto.RegisterType((*QueryPriceFeedPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryPriceFeedPriceStatesRequest")
-	proto.RegisterType((*QueryPriceFeedPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryPriceFeedPriceStatesResponse")
-	proto.RegisterType((*QueryCoinbasePriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryCoinbasePriceStatesRequest")
-	proto.RegisterType((*QueryCoinbasePriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryCoinbasePriceStatesResponse")
-	proto.RegisterType((*QueryPythPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceStatesRequest")
-	proto.RegisterType((*QueryPythPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceStatesResponse")
-	proto.RegisterType((*QueryStorkPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryStorkPriceStatesRequest")
-	proto.RegisterType((*QueryStorkPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryStorkPriceStatesResponse")
-	proto.RegisterType((*QueryStorkPublishersRequest)(nil), "injective.oracle.v1beta1.QueryStorkPublishersRequest")
-	proto.RegisterType((*QueryStorkPublishersResponse)(nil), "injective.oracle.v1beta1.QueryStorkPublishersResponse")
-	proto.RegisterType((*QueryProviderPriceStateRequest)(nil), "injective.oracle.v1beta1.QueryProviderPriceStateRequest")
-	proto.RegisterType((*QueryProviderPriceStateResponse)(nil), "injective.oracle.v1beta1.QueryProviderPriceStateResponse")
-	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.oracle.v1beta1.QueryModuleStateRequest")
-	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.oracle.v1beta1.QueryModuleStateResponse")
-	proto.RegisterType((*QueryHistoricalPriceRecordsRequest)(nil), "injective.oracle.v1beta1.QueryHistoricalPriceRecordsRequest")
-	proto.RegisterType((*QueryHistoricalPriceRecordsResponse)(nil), "injective.oracle.v1beta1.QueryHistoricalPriceRecordsResponse")
-	proto.RegisterType((*OracleHistoryOptions)(nil), "injective.oracle.v1beta1.OracleHistoryOptions")
-	proto.RegisterType((*QueryOracleVolatilityRequest)(nil), "injective.oracle.v1beta1.QueryOracleVolatilityRequest")
-	proto.RegisterType((*QueryOracleVolatilityResponse)(nil), "injective.oracle.v1beta1.QueryOracleVolatilityResponse")
-	proto.RegisterType((*QueryOracleProvidersInfoRequest)(nil), "injective.oracle.v1beta1.QueryOracleProvidersInfoRequest")
-	proto.RegisterType((*QueryOracleProvidersInfoResponse)(nil), "injective.oracle.v1beta1.QueryOracleProvidersInfoResponse")
-	proto.RegisterType((*QueryOracleProviderPricesRequest)(nil), "injective.oracle.v1beta1.QueryOracleProviderPricesRequest")
-	proto.RegisterType((*QueryOracleProviderPricesResponse)(nil), "injective.oracle.v1beta1.QueryOracleProviderPricesResponse")
-	proto.RegisterType((*ScalingOptions)(nil), "injective.oracle.v1beta1.ScalingOptions")
-	proto.RegisterType((*QueryOraclePriceRequest)(nil), "injective.oracle.v1beta1.QueryOraclePriceRequest")
-	proto.RegisterType((*PricePairState)(nil), "injective.oracle.v1beta1.PricePairState")
-	proto.RegisterType((*QueryOraclePriceResponse)(nil), "injective.oracle.v1beta1.QueryOraclePriceResponse")
+type OracleHistoryOptions struct {
+	// MaxAge restricts the oracle price records oldest age in seconds from the
+	// current block time to consider. A value of 0 means use all the records
+	// present on the chain.
+	MaxAge uint64 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// If IncludeRawHistory is true, the raw underlying data used for the
+	// computation is included in the response
+	IncludeRawHistory bool `protobuf:"varint,2,opt,name=include_raw_history,json=includeRawHistory,proto3" json:"include_raw_history,omitempty"`
+	// If IncludeMetadata is true, metadata on the computation is included in the
+	// response
+	IncludeMetadata bool `protobuf:"varint,3,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("injective/oracle/v1beta1/query.proto", fileDescriptor_52f5d6f9962923ad)
+func (m *OracleHistoryOptions) Reset()         { *m = OracleHistoryOptions{} }
+func (m *OracleHistoryOptions) String() string { return proto.CompactTextString(m) }
+func (*OracleHistoryOptions) ProtoMessage()    {}
+func (*OracleHistoryOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{38}
 }
-
-var fileDescriptor_52f5d6f9962923ad = []byte{
-	// 1846 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x6f, 0xdb, 0x46,
-	0x12, 0x37, 0xfd, 0x15, 0x6b, 0x14, 0x7f, 0x64, 0xad, 0x38, 0x8a, 0x92, 0xc8, 0x0e, 0x1d, 0x7f,
-	0x5d, 0x1c, 0x29, 0x56, 0x72, 0x49, 0x2e, 0x97, 0xcb, 0x21, 0xb6, 0x93, 0x3b, 0xdf, 0x39, 0x88,
-	0x8f, 0xce, 0x35, 0x6d, 0x51, 0x40, 0x58, 0x51, 0xb4, 0xc4, 0x5a, 0x22, 0x15, 0x92, 0x72, 0x22,
-	0x04, 0x41, 0xd1, 0x3e, 0x16, 0x05, 0x5a, 0xa0, 0xaf, 0xed, 0x7b, 0xd1, 0xb7, 0x02, 0xed, 0x43,
-	0x5e, 0x0b, 0x14, 0x48, 0x1f, 0x0a, 0xa4, 0x28, 0x0a, 0x14, 0x7d, 0x08, 0x0a, 0xbb, 0x7f, 0x48,
-	0xc1, 0xdd, 0x21, 0x4d, 0x4a, 0xa4, 0x48, 0x19, 0xe8, 0x9b, 0x76, 0x77, 0xe6, 0xb7, 0xbf, 0x99,
-	0x9d, 0x99, 0xdd, 0xa1, 0xe0, 0x82, 0xaa, 0xbd, 0xab, 0xc8, 0x96, 0xba, 0xa7, 0xe4, 0x75, 0x83,
-	0xca, 0x35, 0x25, 0xbf, 0xb7, 0x52, 0x52, 0x2c, 0xba, 0x92, 0x7f, 0xdc, 0x54, 0x8c, 0x56, 0xae,
-	0x61, 0xe8, 0x96, 0x4e, 0xd2, 0xae, 0x54, 0x8e, 0x4b, 0xe5, 0x50, 0x2a, 0x73, 0xb6, 0xa2, 0xeb,
-	0x95, 0x9a, 0x92, 0xa7, 0x0d, 0x35, 0x4f, 0x35, 0x4d, 0xb7, 0xa8, 0xa5, 0xea, 0x9a, 0xc9, 0xf5,
-	0x32, 0x73, 0xa1, 0xe8, 0x08, 0xc3, 0xc5, 0xe6, 0x43, 0xc5, 0x2a, 0x8a, 0xa6, 0x98, 0xaa, 0x03,
-	0x97, 0xaa, 0xe8, 0x15, 0x9d, 0xfd, 0xcc, 0xdb, 0xbf, 0xf8, 0xac, 0x58, 0x80, 0x93, 0xff, 0xb3,
-	0xb9, 0x6e, 0xb5, 0xac, 0xea, 0x96, 0xa1, 0xca, 0x8a, 0xa4, 0x3c, 0x6e, 0x2a, 0xa6, 0x45, 0x4e,
-	0xc3, 0x48, 0xc3, 0x1e, 0x17, 0xd5, 0x72, 0x5a, 0x98, 0x11, 0x16, 0x13, 0xd2, 0x31, 0x36, 0xde,
-	0x28, 0x8b, 0x32, 0x4c, 0xb5, 0xeb, 0x98, 0x0d, 0x5d, 0x33, 0x15, 0xb2, 0x01, 0x49, 0xae, 0x64,
-	0x5a, 0xd4, 0x52, 0x98, 0x5e, 0xb2, 0xb0, 0x98, 0x0b, 0x73, 0x40, 0xce, 0x45, 0xd8, 0xb6, 0xe5,
-	0x25, 0x68, 0xb8, 0xbf, 0xc5, 0x14, 0x10, 0xbe, 0x09, 0x35, 0x68, 0xdd, 0x44, 0x56, 0xe2, 0xff,
-	0x61, 0xd2, 0x37, 0x8b, 0xfb, 0xde, 0x86, 0xe1, 0x06, 0x9b, 0xc1, 0x2d, 0x67, 0xba, 0x6c, 0xc9,
-	0xe4, 0x56, 0x07, 0x5f, 0xbe, 0x9e, 0xee, 0x93, 0x50, 0x4b, 0xcc, 0x40, 0x9a, 0xc1, 0xae, 0x52,
-	0xad, 0x2c, 0x29, 0x35, 0xda, 0x52, 0x0c, 0x77, 0xcb, 0xeb, 0x70, 0x3a, 0x60, 0x0d, 0x37, 0xce,
-	0xc0, 0x88, 0x81, 0x73, 0x69, 0x61, 0x66, 0x60, 0x31, 0x21, 0xb9, 0x63, 0xf1, 0x1c, 0x9c, 0x71,
-	0x15, 0x0f, 0x8d, 0x74, 0x71, 0x77, 0xe1, 0x6c, 0xf0, 0x32, 0x42, 0xff, 0x17, 0x8e, 0x7b, 0x7c,
-	0xc9, 0xe1, 0xbb, 0x3a, 0xd3, 0x0f, 0x24, 0x25, 0x0f, 0x9d, 0x69, 0x8a, 0x33, 0x90, 0x75, 0x37,
-	0xdb, 0x58, 0x5d, 0x0b, 0xa0, 0xa3, 0xc1, 0x74, 0xa8, 0xc4, 0x9f, 0xc1, 0x48, 0x84, 0x19, 0x7e,
-	0x92, 0xf6, 0xdc, 0x3d, 0x45, 0x09, 0x72, 0x51, 0x03, 0xce, 0x77, 0x91, 0x39, 0x2a, 0x2b, 0x17,
-	0x2d, 0x80, 0xd5, 0x79, 0xf4, 0xc2, 0x9a, 0xae, 0x6a, 0x25, 0x6a, 0x2a, 0x01, 0xa4, 0x4c, 0x24,
-	0x1e, 0x28, 0x82, 0x9c, 0x1e, 0x04, 0x72, 0x5a, 0x0e, 0xe7, 0xd4, 0x09, 0xe6, 0xe7, 0xe5, 0xc4,
-	0x92, 0x3f, 0x61, 0x3a, 0x62, 0xa9, 0x63, 0xf9, 0xc8, 0x3e, 0xf2, 0x27, 0xa6, 0x8f, 0x4b, 0x16,
-	0x37, 0xdb, 0xb6, 0x74, 0x63, 0x37, 0x80, 0x4c, 0x1d, 0xce, 0x85, 0xac, 0x23, 0x9b, 0xcd, 0x40,
-	0x36, 0x4b, 0xe1, 0x6c, 0xda, 0x90, 0x82, 0x5d, 0xc3, 0x85, 0x9a, 0xa5, 0x9a, 0x6a, 0x56, 0x3d,
-	0xe9, 0x7b, 0xdb, 0xc7, 0xd6, 0xb3, 0x8c, 0x64, 0xb2, 0x00, 0x0d, 0x77, 0x16, 0x73, 0xd8, 0x33,
-	0x23, 0x3e, 0xc4, 0xcc, 0xd9, 0x32, 0xf4, 0x3d, 0xb5, 0xac, 0x18, 0x1e, 0x1a, 0x58, 0x29, 0x33,
-	0x76, 0xa5, 0xe4, 0x8b, 0x58, 0x29, 0xdd, 0x31, 0x99, 0x82, 0x61, 0xb3, 0x55, 0x2f, 0xe9, 0xb5,
-	0x74, 0x3f, 0x5b, 0xc1, 0x91, 0x58, 0xc5, 0x38, 0x0b, 0x42, 0x45, 0x62, 0x77, 0x83, 0x6a, 0xe9,
-	0x85, 0x88, 0xb0, 0xee, 0xac, 0xa3, 0xa7, 0xe1, 0x14, 0xdb, 0xe9, 0xbe, 0x5e, 0x6e, 0xd6, 0x7c,
-	0xc4, 0xc5, 0x37, 0xb1, 0xea, 0xf9, 0x96, 0x70, 0xf7, 0x5b, 0x30, 0xe4, 0xdd, 0x77, 0x3e, 0x7c,
-	0xdf, 0x7f, 0xf1, 0x5b, 0x86, 0xab, 0x73, 0x25, 0xf1, 0x3d, 0x10, 0x19, 0xf2, 0xbf, 0x55, 0xd3,
-	0xd2, 0x0d, 0x55, 0xa6, 0x35, 0xbc, 0x27, 0x64, 0xdd, 0x28, 0x3b, 0x47, 0x43, 0x6e, 0xc1, 0x30,
-	0xc7, 0x62, 0x9b, 0x8c, 0x75, 0x33, 0xee, 0x01, 0x1b, 0x3e, 0x6c, 0x35, 0x14, 0x09, 0x75, 0xc8,
-	0x19, 0x48, 0x70, 0x67, 0xda, 0x37, 0x14, 0xf7, 0xee, 0x08, 0x9f, 0xd8, 0x28, 0x8b, 0x06, 0xcc,
-	0x76, 0x25, 0xe0, 0xe6, 0xc5, 0x28, 0xf7, 0xb1, 0xc1, 0x17, 0x30, 0x14, 0xe7, 0x23, 0xbc, 0xec,
-	0xc0, 0xf0, 0x30, 0xc6, 0x91, 0xf8, 0xa1, 0x00, 0x29, 0xce, 0x93, 0xef, 0xda, 0x7a, 0xd0, 0x60,
-	0xd7, 0x39, 0x39, 0x05, 0xc7, 0xea, 0xf4, 0x69, 0x91, 0x56, 0xb8, 0xa1, 0x83, 0xd2, 0x70, 0x9d,
-	0x3e, 0xbd, 0x53, 0x51, 0x48, 0x0e, 0x26, 0x55, 0x4d, 0xae, 0x35, 0xcb, 0x4a, 0xd1, 0xa0, 0x4f,
-	0x8a, 0x55, 0xae, 0xc6, 0x8c, 0x19, 0x91, 0x4e, 0xe0, 0x92, 0x44, 0x9f, 0x20, 0x1e, 0x59, 0x82,
-	0x09, 0x47, 0xbe, 0xae, 0x58, 0xb4, 0x4c, 0x2d, 0x9a, 0x1e, 0x60, 0xc2, 0xe3, 0x38, 0x7f, 0x1f,
-	0xa7, 0xc5, 0x8f, 0xfa, 0x31, 0xee, 0x39, 0xa3, 0x37, 0xf4, 0x1a, 0xb5, 0xd4, 0x9a, 0x6a, 0xb5,
-	0x1c, 0xe7, 0xdf, 0x81, 0x84, 0x5d, 0x70, 0x8a, 0xaa, 0xb6, 0xa3, 0x47, 0x07, 0x17, 0x47, 0xd9,
-	0xd0, 0x76, 0x74, 0x69, 0xc4, 0x56, 0xb3, 0x7f, 0x91, 0x35, 0x80, 0xc7, 0x4d, 0xdd, 0x42, 0x8c,
-	0xfe, 0x1e, 0x30, 0x12, 0x4c, 0x8f, 0x81, 0x94, 0x61, 0x8a, 0xcb, 0x39, 0xe6, 0x17, 0x75, 0xee,
-	0x36, 0x66, 0x59, 0xb2, 0x90, 0x8b, 0x02, 0xf4, 0x3b, 0x5b, 0x4a, 0xe9, 0x01, 0xb3, 0xe2, 0xfb,
-	0xfd, 0x58, 0x94, 0x3a, 0xdd, 0x81, 0xa1, 0xf0, 0x4f, 0x80, 0x3d, 0x77, 0x96, 0xe7, 0xf1, 0xea,
-	0xf4, 0xaf, 0xaf, 0xa7, 0xcf, 0xc8, 0xba, 0x59, 0xd7, 0x4d, 0xb3, 0xbc, 0x9b, 0x53, 0xf5, 0x7c,
-	0x9d, 0x5a, 0xd5, 0xdc, 0xa6, 0x52, 0xa1, 0x72, 0x6b, 0x5d, 0x91, 0x25, 0x8f, 0x0a, 0x79, 0x04,
-	0x13, 0x8e, 0x05, 0xee, 0xe1, 0x70, 0x9f, 0x74, 0xa9, 0xfb, 0xce, 0x79, 0xd9, 0xd9, 0xa3, 0x9a,
-	0x96, 0x2a, 0x9b, 0xd2, 0x38, 0xa2, 0x38, 0x4b, 0xe4, 0x1e, 0x24, 0xbd, 0xd1, 0x31, 0xc0, 0x42,
-	0x74, 0x2e, 0x56, 0x88, 0x4a, 0x60, 0xb8, 0xd1, 0xe3, 0xde, 0x6d, 0xdc, 0x05, 0x4e, 0xe5, 0x31,
-	0xd9, 0x81, 0x60, 0x45, 0xa8, 0xe2, 0xdd, 0x16, 0x28, 0x82, 0x8e, 0x5a, 0x87, 0x84, 0x53, 0xde,
-	0x62, 0xe5, 0x0b, 0x17, 0xe5, 0xc7, 0xee, 0x2a, 0x8a, 0xb7, 0x03, 0x77, 0x62, 0xd4, 0xcd, 0x18,
-	0x85, 0x55, 0x34, 0xf0, 0x69, 0x10, 0xac, 0x8f, 0x54, 0xef, 0xdb, 0xe9, 0xcd, 0x57, 0xb6, 0xb1,
-	0x98, 0xd9, 0x74, 0x17, 0xa2, 0xe9, 0xf2, 0x6a, 0xe6, 0xd7, 0x16, 0xdf, 0x81, 0xb1, 0x6d, 0x99,
-	0xd6, 0x54, 0xad, 0xe2, 0x64, 0xf6, 0x2c, 0x8c, 0xb2, 0x24, 0x2a, 0x2b, 0xb2, 0x5a, 0xa7, 0x35,
-	0xfe, 0xfc, 0x1c, 0x95, 0x8e, 0xdb, 0x93, 0xeb, 0x38, 0x47, 0xe6, 0x60, 0x8c, 0xa7, 0x89, 0x2b,
-	0xd5, 0xcf, 0xa4, 0x46, 0xd9, 0xac, 0x23, 0x26, 0x1e, 0x08, 0x58, 0xa9, 0x1d, 0x93, 0x3c, 0x8f,
-	0xf1, 0xbb, 0x90, 0xc4, 0x24, 0xb1, 0x5a, 0x8d, 0xde, 0xca, 0x25, 0xe8, 0xee, 0x6f, 0x42, 0x60,
-	0xd0, 0x66, 0x86, 0xd5, 0x92, 0xfd, 0x26, 0x29, 0x18, 0x62, 0x3c, 0x58, 0xba, 0x25, 0x24, 0x3e,
-	0x20, 0x8f, 0x60, 0xdc, 0xe4, 0xa6, 0xba, 0xe9, 0x38, 0x18, 0xf5, 0x98, 0xf7, 0xfb, 0x86, 0xbd,
-	0xb0, 0x05, 0x69, 0xcc, 0xf4, 0xcd, 0x8a, 0xfb, 0x03, 0x30, 0xc6, 0x4c, 0xdb, 0xa2, 0x2a, 0x77,
-	0x2b, 0x59, 0x05, 0x68, 0x50, 0xd5, 0x28, 0xb2, 0x62, 0x8a, 0x99, 0x37, 0x6b, 0x3f, 0xcf, 0xa3,
-	0xb2, 0x2f, 0x61, 0xab, 0x31, 0x30, 0x1b, 0x83, 0x1d, 0x04, 0xc7, 0xe8, 0xef, 0x01, 0xc3, 0x7d,
-	0x75, 0x91, 0x75, 0x48, 0xf2, 0x73, 0xe2, 0x20, 0x03, 0xf1, 0x41, 0x78, 0x19, 0xe4, 0x28, 0x8f,
-	0xe0, 0x24, 0x63, 0x22, 0x37, 0xeb, 0x4d, 0xbb, 0x36, 0xec, 0x39, 0x78, 0x83, 0xf1, 0xf1, 0x26,
-	0x6d, 0x84, 0x35, 0x17, 0x80, 0x03, 0xbf, 0x05, 0x53, 0x9c, 0x5e, 0x07, 0xf2, 0x50, 0x7c, 0xe4,
-	0x14, 0x83, 0x68, 0x87, 0x9e, 0x83, 0x31, 0xc6, 0xd9, 0x52, 0xeb, 0x8a, 0x69, 0xd1, 0x7a, 0x23,
-	0x3d, 0x3c, 0x23, 0x2c, 0x0e, 0x48, 0x2c, 0xb8, 0x1f, 0x3a, 0x93, 0x64, 0x01, 0xc6, 0x39, 0x83,
-	0x43, 0xb9, 0x63, 0x4c, 0x8e, 0xc7, 0xb7, 0x2b, 0x28, 0x6a, 0xf8, 0xb0, 0xf0, 0x45, 0x32, 0xe6,
-	0xa4, 0x04, 0x13, 0xfc, 0xca, 0x65, 0x67, 0x1e, 0xb7, 0x4f, 0xf4, 0x45, 0x8c, 0x34, 0xd6, 0xf0,
-	0x8d, 0x0b, 0x3f, 0xa4, 0x61, 0x88, 0x6d, 0x48, 0x3e, 0x16, 0x60, 0x98, 0x77, 0x78, 0xa4, 0x4b,
-	0xd5, 0xed, 0x6c, 0x2c, 0x33, 0x97, 0x62, 0x4a, 0x73, 0x2b, 0xc4, 0xc5, 0x0f, 0x7e, 0xfa, 0xfd,
-	0xd3, 0x7e, 0x91, 0xcc, 0xe4, 0x43, 0xbb, 0x6f, 0xde, 0x5a, 0x92, 0x2f, 0x04, 0x38, 0xee, 0x6d,
-	0x1d, 0x49, 0x21, 0x62, 0xa7, 0x80, 0x1e, 0x34, 0x73, 0xa5, 0x27, 0x1d, 0xe4, 0x98, 0x67, 0x1c,
-	0x97, 0xc8, 0x42, 0x38, 0xc7, 0x12, 0xd5, 0xca, 0x45, 0xa7, 0x61, 0x25, 0xdf, 0x08, 0x30, 0xde,
-	0xd6, 0x8d, 0x92, 0xbf, 0xc6, 0xd8, 0xb9, 0xb3, 0x07, 0xc8, 0x5c, 0xeb, 0x55, 0x0d, 0x39, 0x5f,
-	0x61, 0x9c, 0x2f, 0x91, 0x8b, 0x11, 0x9c, 0xbd, 0xfd, 0x03, 0xf9, 0x56, 0x00, 0xd2, 0xd9, 0xb6,
-	0x92, 0x1b, 0x31, 0x38, 0x04, 0xf6, 0xc2, 0x99, 0xbf, 0x1d, 0x41, 0x13, 0x0d, 0xb8, 0xce, 0x0c,
-	0x58, 0x21, 0xf9, 0x08, 0x03, 0xd4, 0x92, 0xec, 0x37, 0xe2, 0x7b, 0x01, 0x52, 0x41, 0x7d, 0x2e,
-	0xb9, 0x19, 0x15, 0x99, 0xe1, 0x0d, 0x74, 0xe6, 0xef, 0x47, 0xd2, 0x45, 0x53, 0x6e, 0x30, 0x53,
-	0x0a, 0xe4, 0x72, 0x97, 0x18, 0xb7, 0xd5, 0x76, 0x14, 0xa5, 0xed, 0x40, 0xbe, 0x13, 0x60, 0x32,
-	0xa0, 0x3d, 0x26, 0x51, 0x7e, 0x0d, 0xef, 0xba, 0x33, 0x37, 0x8f, 0xa2, 0x1a, 0xff, 0x4c, 0x64,
-	0x54, 0xf7, 0xdb, 0x61, 0x27, 0x44, 0x5b, 0x4b, 0x1d, 0x99, 0x10, 0xc1, 0x1d, 0x7a, 0x64, 0x42,
-	0x84, 0x74, 0xee, 0x71, 0x12, 0xa2, 0xd1, 0xb2, 0xaa, 0x7e, 0xde, 0x2f, 0x04, 0x98, 0x68, 0xef,
-	0xbe, 0x49, 0x14, 0x83, 0x90, 0x76, 0x3e, 0x73, 0xbd, 0x67, 0x3d, 0xa4, 0x7e, 0x95, 0x51, 0xcf,
-	0x91, 0xe5, 0x70, 0xea, 0xf6, 0xc3, 0x74, 0xd7, 0xcf, 0xfd, 0x6b, 0x01, 0xc6, 0xdb, 0x7a, 0xf5,
-	0x48, 0x9f, 0x07, 0xb7, 0xfe, 0x99, 0x6b, 0xbd, 0xaa, 0x21, 0xf1, 0x02, 0x23, 0xbe, 0x4c, 0xfe,
-	0x12, 0x49, 0xfc, 0x90, 0xe2, 0xcf, 0x02, 0x90, 0xce, 0x66, 0x3e, 0xb2, 0x06, 0x85, 0x7e, 0x55,
-	0x88, 0xac, 0x41, 0xe1, 0x5f, 0x0e, 0xc4, 0xff, 0x30, 0xfe, 0xeb, 0x64, 0xb5, 0x5b, 0xe2, 0x72,
-	0x6d, 0xaf, 0xef, 0xf3, 0xcf, 0x9c, 0xd9, 0xe7, 0xf9, 0x67, 0xbc, 0x93, 0x7e, 0x4e, 0xbe, 0x14,
-	0xe0, 0x04, 0xbf, 0xc6, 0x3d, 0x5f, 0x09, 0xc8, 0x4a, 0x04, 0xb9, 0xce, 0x8f, 0x0d, 0x99, 0x42,
-	0x2f, 0x2a, 0x68, 0x48, 0x8e, 0x19, 0xb2, 0x48, 0xe6, 0xc3, 0x0d, 0xa9, 0x33, 0x35, 0x6e, 0x00,
-	0xf9, 0x51, 0x80, 0xa9, 0xe0, 0x8e, 0x9f, 0xdc, 0x8a, 0xd8, 0xbe, 0xeb, 0x97, 0x8a, 0xcc, 0x3f,
-	0x8e, 0xa8, 0x8d, 0x76, 0xdc, 0x64, 0x76, 0x5c, 0x25, 0x85, 0x70, 0x3b, 0xaa, 0x2e, 0x42, 0xd1,
-	0xf7, 0x45, 0x82, 0x7c, 0x25, 0xc0, 0x44, 0x7b, 0xd3, 0x1a, 0x99, 0xcb, 0x21, 0x4d, 0x7f, 0x64,
-	0x2e, 0x87, 0x75, 0xc7, 0xe2, 0x32, 0xb3, 0x60, 0x9e, 0x5c, 0x08, 0xb7, 0xc0, 0xd3, 0x0a, 0xbf,
-	0x10, 0x60, 0x32, 0xa0, 0x85, 0x8c, 0xac, 0xff, 0xe1, 0x9d, 0x69, 0x64, 0xfd, 0xef, 0xd2, 0xb1,
-	0x8a, 0x17, 0x19, 0xf9, 0x39, 0x32, 0x1b, 0x9d, 0x0f, 0xec, 0x31, 0x91, 0x0a, 0x6a, 0x2a, 0x49,
-	0x6f, 0x0c, 0x7c, 0x9d, 0x6c, 0xe4, 0x3d, 0xdc, 0xad, 0x8b, 0x15, 0x57, 0x18, 0xfd, 0x8b, 0x64,
-	0x29, 0x6e, 0x3a, 0x9b, 0xe4, 0x73, 0x01, 0x92, 0x9e, 0xc7, 0x77, 0x64, 0xbe, 0x76, 0xb6, 0x9c,
-	0x91, 0xf9, 0x1a, 0xf0, 0xb6, 0x17, 0x17, 0x18, 0xd3, 0xf3, 0x64, 0x3a, 0xe2, 0xc5, 0x40, 0x3e,
-	0x13, 0x20, 0xe1, 0xde, 0x78, 0x24, 0x1f, 0xf7, 0x6e, 0x74, 0xb8, 0x5d, 0x8e, 0xaf, 0x10, 0x3f,
-	0x7e, 0x0f, 0xaf, 0xd1, 0xd5, 0x9d, 0x97, 0xfb, 0x59, 0xe1, 0xd5, 0x7e, 0x56, 0xf8, 0x6d, 0x3f,
-	0x2b, 0x7c, 0x72, 0x90, 0xed, 0x7b, 0x75, 0x90, 0xed, 0xfb, 0xe5, 0x20, 0xdb, 0xf7, 0xf6, 0x66,
-	0x45, 0xb5, 0xaa, 0xcd, 0x52, 0x4e, 0xd6, 0xeb, 0xf9, 0x0d, 0x07, 0x69, 0x93, 0x96, 0xcc, 0x43,
-	0xdc, 0x4b, 0xb2, 0x6e, 0x28, 0xde, 0x61, 0x95, 0xaa, 0x1a, 0xd6, 0x29, 0xd3, 0xd9, 0xd4, 0x6e,
-	0xe3, 0xcd, 0xd2, 0x30, 0xfb, 0x0f, 0xee, 0xca, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0x50,
-	0x4b, 0xd5, 0x48, 0x1c, 0x00, 0x00,
+func (m *OracleHistoryOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Retrieves oracle params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Retrieves the band relayers
-	BandRelayers(ctx context.Context, in *QueryBandRelayersRequest, opts ...grpc.CallOption) (*QueryBandRelayersResponse, error)
-	// Retrieves the state for all band price feeds
-	BandPriceStates(ctx context.Context, in *QueryBandPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandPriceStatesResponse, error)
-	// Retrieves the state for all band ibc price feeds
-	BandIBCPriceStates(ctx context.Context, in *QueryBandIBCPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandIBCPriceStatesResponse, error)
-	// Retrieves the state for all price feeds
-	PriceFeedPriceStates(ctx context.Context, in *QueryPriceFeedPriceStatesRequest, opts ...grpc.CallOption) (*QueryPriceFeedPriceStatesResponse, error)
-	// Retrieves the state for all coinbase price feeds
-	CoinbasePriceStates(ctx context.Context, in *QueryCoinbasePriceStatesRequest, opts ...grpc.CallOption) (*QueryCoinbasePriceStatesResponse, error)
-	// Retrieves the state for all pyth price feeds
-	PythPriceStates(ctx context.Context, in *QueryPythPriceStatesRequest, opts ...grpc.CallOption) (*QueryPythPriceStatesResponse, error)
-	// Retrieves the state for all stork price feeds
-	StorkPriceStates(ctx context.Context, in *QueryStorkPriceStatesRequest, opts ...grpc.CallOption) (*QueryStorkPriceStatesResponse, error)
-	// Retrieves all stork publishers
-	StorkPublishers(ctx context.Context, in *QueryStorkPublishersRequest, opts ...grpc.CallOption) (*QueryStorkPublishersResponse, error)
-	// Retrieves the state for all provider price feeds
-	ProviderPriceState(ctx context.Context, in *QueryProviderPriceStateRequest, opts ...grpc.CallOption) (*QueryProviderPriceStateResponse, error)
-	// Retrieves the entire oracle module's state
-	OracleModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
-	// Retrieves historical price records for a given OracleType and Symbol
-	HistoricalPriceRecords(ctx context.Context, in *QueryHistoricalPriceRecordsRequest, opts ...grpc.CallOption) (*QueryHistoricalPriceRecordsResponse, error)
-	// Retrieves mixed volatility value for the specified pair of base/quote
-	OracleVolatility(ctx context.Context, in *QueryOracleVolatilityRequest, opts ...grpc.CallOption) (*QueryOracleVolatilityResponse, error)
-	OracleProvidersInfo(ctx context.Context, in *QueryOracleProvidersInfoRequest, opts ...grpc.CallOption) (*QueryOracleProvidersInfoResponse, error)
-	OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error)
-	OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error)
-	PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error)
+func (m *OracleHistoryOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleHistoryOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *OracleHistoryOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleHistoryOptions.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *OracleHistoryOptions) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OracleHistoryOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleHistoryOptions.DiscardUnknown(m)
 }
 
-func (c *queryClient) BandRelayers(ctx context.Context, in *QueryBandRelayersRequest, opts ...grpc.CallOption) (*QueryBandRelayersResponse, error) {
-	out := new(QueryBandRelayersResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/BandRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_OracleHistoryOptions proto.InternalMessageInfo
 
-func (c *queryClient) BandPriceStates(ctx context.Context, in *QueryBandPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandPriceStatesResponse, error) {
-	out := new(QueryBandPriceStatesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/BandPriceStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OracleHistoryOptions) GetMaxAge() uint64 {
+	if m != nil {
+		return m.MaxAge
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BandIBCPriceStates(ctx context.Context, in *QueryBandIBCPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandIBCPriceStatesResponse, error) {
-	out := new(QueryBandIBCPriceStatesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/BandIBCPriceStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OracleHistoryOptions) GetIncludeRawHistory() bool {
+	if m != nil {
+		return m.IncludeRawHistory
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) PriceFeedPriceStates(ctx context.Context, in *QueryPriceFeedPriceStatesRequest, opts ...grpc.CallOption) (*QueryPriceFeedPriceStatesResponse, error) {
-	out := new(QueryPriceFeedPriceStatesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PriceFeedPriceStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OracleHistoryOptions) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) CoinbasePriceStates(ctx context.Context, in *QueryCoinbasePriceStatesRequest, opts ...grpc.CallOption) (*QueryCoinbasePriceStatesResponse, error) {
-	out := new(QueryCoinbasePriceStatesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CoinbasePriceStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryOracleVolatilityRequest is the request type for Query/OracleVolatility
+// RPC method.
+type QueryOracleVolatilityRequest struct {
+	BaseInfo             *OracleInfo           `protobuf:"bytes,1,opt,name=base_info,json=baseInfo,proto3" json:"base_info,omitempty"`
+	QuoteInfo            *OracleInfo           `protobuf:"bytes,2,opt,name=quote_info,json=quoteInfo,proto3" json:"quote_info,omitempty"`
+	OracleHistoryOptions *OracleHistoryOptions `protobuf:"bytes,3,opt,name=oracle_history_options,json=oracleHistoryOptions,proto3" json:"oracle_history_options,omitempty"`
 }
 
-func (c *queryClient) PythPriceStates(ctx context.Context, in *QueryPythPriceStatesRequest, opts ...grpc.CallOption) (*QueryPythPriceStatesResponse, error) {
-	out := new(QueryPythPriceStatesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PythPriceStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOracleVolatilityRequest) Reset()         { *m = QueryOracleVolatilityRequest{} }
+func (m *QueryOracleVolatilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleVolatilityRequest) ProtoMessage()    {}
+func (*QueryOracleVolatilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{39}
 }
-
-func (c *queryClient) StorkPriceStates(ctx context.Context, in *QueryStorkPriceStatesRequest, opts ...grpc.CallOption) (*QueryStorkPriceStatesResponse, error) {
-	out := new(QueryStorkPriceStatesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/StorkPriceStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryOracleVolatilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) StorkPublishers(ctx context.Context, in *QueryStorkPublishersRequest, opts ...grpc.CallOption) (*QueryStorkPublishersResponse, error) {
-	out := new(QueryStorkPublishersResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/StorkPublishers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleVolatilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleVolatilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOracleVolatilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleVolatilityRequest.Merge(m, src)
+}
+func (m *QueryOracleVolatilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleVolatilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleVolatilityRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProviderPriceState(ctx context.Context, in *QueryProviderPriceStateRequest, opts ...grpc.CallOption) (*QueryProviderPriceStateResponse, error) {
-	out := new(QueryProviderPriceStateResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/ProviderPriceState", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOracleVolatilityRequest proto.InternalMessageInfo
+
+func (m *QueryOracleVolatilityRequest) GetBaseInfo() *OracleInfo {
+	if m != nil {
+		return m.BaseInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OracleModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
-	out := new(QueryModuleStateResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleModuleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleVolatilityRequest) GetQuoteInfo() *OracleInfo {
+	if m != nil {
+		return m.QuoteInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HistoricalPriceRecords(ctx context.Context, in *QueryHistoricalPriceRecordsRequest, opts ...grpc.CallOption) (*QueryHistoricalPriceRecordsResponse, error) {
-	out := new(QueryHistoricalPriceRecordsResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/HistoricalPriceRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleVolatilityRequest) GetOracleHistoryOptions() *OracleHistoryOptions {
+	if m != nil {
+		return m.OracleHistoryOptions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OracleVolatility(ctx context.Context, in *QueryOracleVolatilityRequest, opts ...grpc.CallOption) (*QueryOracleVolatilityResponse, error) {
-	out := new(QueryOracleVolatilityResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleVolatility", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryOracleVolatilityResponse is the response type for Query/OracleVolatility
+// RPC method.
+type QueryOracleVolatilityResponse struct {
+	Volatility      *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility,omitempty"`
+	HistoryMetadata *MetadataStatistics          `protobuf:"bytes,2,opt,name=history_metadata,json=historyMetadata,proto3" json:"history_metadata,omitempty"`
+	RawHistory      []*PriceRecord               `protobuf:"bytes,3,rep,name=raw_history,json=rawHistory,proto3" json:"raw_history,omitempty"`
+}
+
+func (m *QueryOracleVolatilityResponse) Reset()         { *m = QueryOracleVolatilityResponse{} }
+func (m *QueryOracleVolatilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleVolatilityResponse) ProtoMessage()    {}
+func (*QueryOracleVolatilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{40}
+}
+func (m *QueryOracleVolatilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleVolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleVolatilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOracleVolatilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleVolatilityResponse.Merge(m, src)
+}
+func (m *QueryOracleVolatilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleVolatilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleVolatilityResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OracleProvidersInfo(ctx context.Context, in *QueryOracleProvidersInfoRequest, opts ...grpc.CallOption) (*QueryOracleProvidersInfoResponse, error) {
-	out := new(QueryOracleProvidersInfoResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleProvidersInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOracleVolatilityResponse proto.InternalMessageInfo
+
+func (m *QueryOracleVolatilityResponse) GetHistoryMetadata() *MetadataStatistics {
+	if m != nil {
+		return m.HistoryMetadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error) {
-	out := new(QueryOracleProviderPricesResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleProviderPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleVolatilityResponse) GetRawHistory() []*PriceRecord {
+	if m != nil {
+		return m.RawHistory
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error) {
-	out := new(QueryOraclePriceResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OraclePrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryOracleTWAPRequest is the request type for Query/OracleTWAP RPC method.
+type QueryOracleTWAPRequest struct {
+	BaseInfo *OracleInfo `protobuf:"bytes,1,opt,name=base_info,json=baseInfo,proto3" json:"base_info,omitempty"`
+	// quote_info is optional, the base price is used as is if it's empty or USD
+	QuoteInfo *OracleInfo `protobuf:"bytes,2,opt,name=quote_info,json=quoteInfo,proto3" json:"quote_info,omitempty"`
+	// start_time is the unix timestamp in seconds of the window start
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the unix timestamp in seconds of the window end, the current
+	// block time is used if zero
+	EndTime int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// max_gap is the maximum time in seconds a price may be used without being
+	// updated. Zero disables the check
+	MaxGap int64 `protobuf:"varint,5,opt,name=max_gap,json=maxGap,proto3" json:"max_gap,omitempty"`
+}
+
+func (m *QueryOracleTWAPRequest) Reset()         { *m = QueryOracleTWAPRequest{} }
+func (m *QueryOracleTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleTWAPRequest) ProtoMessage()    {}
+func (*QueryOracleTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{41}
+}
+func (m *QueryOracleTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOracleTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleTWAPRequest.Merge(m, src)
+}
+func (m *QueryOracleTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleTWAPRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error) {
-	out := new(QueryPythPriceResponse)
-	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PythPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOracleTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryOracleTWAPRequest) GetBaseInfo() *OracleInfo {
+	if m != nil {
+		return m.BaseInfo
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Retrieves oracle params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Retrieves the band relayers
-	BandRelayers(context.Context, *QueryBandRelayersRequest) (*QueryBandRelayersResponse, error)
-	// Retrieves the state for all band price feeds
-	BandPriceStates(context.Context, *QueryBandPriceStatesRequest) (*QueryBandPriceStatesResponse, error)
-	// Retrieves the state for all band ibc price feeds
-	BandIBCPriceStates(context.Context, *QueryBandIBCPriceStatesRequest) (*QueryBandIBCPriceStatesResponse, error)
-	// Retrieves the state for all price feeds
-	PriceFeedPriceStates(context.Context, *QueryPriceFeedPriceStatesRequest) (*QueryPriceFeedPriceStatesResponse, error)
-	// Retrieves the state for all coinbase price feeds
-	CoinbasePriceStates(context.Context, *QueryCoinbasePriceStatesRequest) (*QueryCoinbasePriceStatesResponse, error)
-	// Retrieves the state for all pyth price feeds
-	PythPriceStates(context.Context, *QueryPythPriceStatesRequest) (*QueryPythPriceStatesResponse, error)
-	// Retrieves the state for all stork price feeds
-	StorkPriceStates(context.Context, *QueryStorkPriceStatesRequest) (*QueryStorkPriceStatesResponse, error)
-	// Retrieves all stork publishers
-	StorkPublishers(context.Context, *QueryStorkPublishersRequest) (*QueryStorkPublishersResponse, error)
-	// Retrieves the state for all provider price feeds
-	ProviderPriceState(context.Context, *QueryProviderPriceStateRequest) (*QueryProviderPriceStateResponse, error)
-	// Retrieves the entire oracle module's state
-	OracleModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
-	// Retrieves historical price records for a given OracleType and Symbol
-	HistoricalPriceRecords(context.Context, *QueryHistoricalPriceRecordsRequest) (*QueryHistoricalPriceRecordsResponse, error)
-	// Retrieves mixed volatility value for the specified pair of base/quote
-	OracleVolatility(context.Context, *QueryOracleVolatilityRequest) (*QueryOracleVolatilityResponse, error)
-	OracleProvidersInfo(context.Context, *QueryOracleProvidersInfoRequest) (*QueryOracleProvidersInfoResponse, error)
-	OracleProviderPrices(context.Context, *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error)
-	OraclePrice(context.Context, *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error)
-	PythPrice(context.Context, *QueryPythPriceRequest) (*QueryPythPriceResponse, error)
+func (m *QueryOracleTWAPRequest) GetQuoteInfo() *OracleInfo {
+	if m != nil {
+		return m.QuoteInfo
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryOracleTWAPRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryOracleTWAPRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) BandRelayers(ctx context.Context, req *QueryBandRelayersRequest) (*QueryBandRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BandRelayers not implemented")
+
+func (m *QueryOracleTWAPRequest) GetMaxGap() int64 {
+	if m != nil {
+		return m.MaxGap
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) BandPriceStates(ctx context.Context, req *QueryBandPriceStatesRequest) (*QueryBandPriceStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BandPriceStates not implemented")
+
+// QueryOracleTWAPResponse is the response type for Query/OracleTWAP RPC method.
+type QueryOracleTWAPResponse struct {
+	Twap PriceTWAP `protobuf:"bytes,1,opt,name=twap,proto3" json:"twap"`
 }
-func (*UnimplementedQueryServer) BandIBCPriceStates(ctx context.Context, req *QueryBandIBCPriceStatesRequest) (*QueryBandIBCPriceStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BandIBCPriceStates not implemented")
+
+func (m *QueryOracleTWAPResponse) Reset()         { *m = QueryOracleTWAPResponse{} }
+func (m *QueryOracleTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleTWAPResponse) ProtoMessage()    {}
+func (*QueryOracleTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{42}
 }
-func (*UnimplementedQueryServer) PriceFeedPriceStates(ctx context.Context, req *QueryPriceFeedPriceStatesRequest) (*QueryPriceFeedPriceStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PriceFeedPriceStates not implemented")
+func (m *QueryOracleTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CoinbasePriceStates(ctx context.Context, req *QueryCoinbasePriceStatesRequest) (*QueryCoinbasePriceStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CoinbasePriceStates not implemented")
+func (m *QueryOracleTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PythPriceStates(ctx context.Context, req *QueryPythPriceStatesRequest) (*QueryPythPriceStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PythPriceStates not implemented")
+func (m *QueryOracleTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleTWAPResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StorkPriceStates(ctx context.Context, req *QueryStorkPriceStatesRequest) (*QueryStorkPriceStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorkPriceStates not implemented")
+func (m *QueryOracleTWAPResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) StorkPublishers(ctx context.Context, req *QueryStorkPublishersRequest) (*QueryStorkPublishersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorkPublishers not implemented")
+func (m *QueryOracleTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleTWAPResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ProviderPriceState(ctx context.Context, req *QueryProviderPriceStateRequest) (*QueryProviderPriceStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderPriceState not implemented")
+
+var xxx_messageInfo_QueryOracleTWAPResponse proto.InternalMessageInfo
+
+func (m *QueryOracleTWAPResponse) GetTwap() PriceTWAP {
+	if m != nil {
+		return m.Twap
+	}
+	return PriceTWAP{}
 }
-func (*UnimplementedQueryServer) OracleModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleModuleState not implemented")
+
+type QueryOracleProvidersInfoRequest struct {
 }
-func (*UnimplementedQueryServer) HistoricalPriceRecords(ctx context.Context, req *QueryHistoricalPriceRecordsRequest) (*QueryHistoricalPriceRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HistoricalPriceRecords not implemented")
+
+func (m *QueryOracleProvidersInfoRequest) Reset()         { *m = QueryOracleProvidersInfoRequest{} }
+func (m *QueryOracleProvidersInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProvidersInfoRequest) ProtoMessage()    {}
+func (*QueryOracleProvidersInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{43}
 }
-func (*UnimplementedQueryServer) OracleVolatility(ctx context.Context, req *QueryOracleVolatilityRequest) (*QueryOracleVolatilityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleVolatility not implemented")
+func (m *QueryOracleProvidersInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) OracleProvidersInfo(ctx context.Context, req *QueryOracleProvidersInfoRequest) (*QueryOracleProvidersInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleProvidersInfo not implemented")
+func (m *QueryOracleProvidersInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProvidersInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OracleProviderPrices(ctx context.Context, req *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OracleProviderPrices not implemented")
+func (m *QueryOracleProvidersInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProvidersInfoRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OraclePrice(ctx context.Context, req *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OraclePrice not implemented")
+func (m *QueryOracleProvidersInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PythPrice(ctx context.Context, req *QueryPythPriceRequest) (*QueryPythPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PythPrice not implemented")
+func (m *QueryOracleProvidersInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProvidersInfoRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryOracleProvidersInfoRequest proto.InternalMessageInfo
+
+type QueryOracleProvidersInfoResponse struct {
+	Providers []*ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryOracleProvidersInfoResponse) Reset()         { *m = QueryOracleProvidersInfoResponse{} }
+func (m *QueryOracleProvidersInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProvidersInfoResponse) ProtoMessage()    {}
+func (*QueryOracleProvidersInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{44}
+}
+func (m *QueryOracleProvidersInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProvidersInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProvidersInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_BandRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBandRelayersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BandRelayers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/BandRelayers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BandRelayers(ctx, req.(*QueryBandRelayersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryOracleProvidersInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProvidersInfoResponse.Merge(m, src)
 }
-
-func _Query_BandPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBandPriceStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BandPriceStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/BandPriceStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BandPriceStates(ctx, req.(*QueryBandPriceStatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryOracleProvidersInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_BandIBCPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBandIBCPriceStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BandIBCPriceStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/BandIBCPriceStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BandIBCPriceStates(ctx, req.(*QueryBandIBCPriceStatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryOracleProvidersInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProvidersInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_PriceFeedPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPriceFeedPriceStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PriceFeedPriceStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/PriceFeedPriceStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PriceFeedPriceStates(ctx, req.(*QueryPriceFeedPriceStatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryOracleProvidersInfoResponse proto.InternalMessageInfo
 
-func _Query_CoinbasePriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCoinbasePriceStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CoinbasePriceStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/CoinbasePriceStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CoinbasePriceStates(ctx, req.(*QueryCoinbasePriceStatesRequest))
+func (m *QueryOracleProvidersInfoResponse) GetProviders() []*ProviderInfo {
+	if m != nil {
+		return m.Providers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PythPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPythPriceStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PythPriceStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/PythPriceStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PythPriceStates(ctx, req.(*QueryPythPriceStatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryOracleProviderPricesRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func _Query_StorkPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorkPriceStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorkPriceStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/StorkPriceStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorkPriceStates(ctx, req.(*QueryStorkPriceStatesRequest))
+func (m *QueryOracleProviderPricesRequest) Reset()         { *m = QueryOracleProviderPricesRequest{} }
+func (m *QueryOracleProviderPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProviderPricesRequest) ProtoMessage()    {}
+func (*QueryOracleProviderPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{45}
+}
+func (m *QueryOracleProviderPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProviderPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProviderPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOracleProviderPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProviderPricesRequest.Merge(m, src)
+}
+func (m *QueryOracleProviderPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleProviderPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProviderPricesRequest.DiscardUnknown(m)
 }
 
-func _Query_StorkPublishers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorkPublishersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorkPublishers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/StorkPublishers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorkPublishers(ctx, req.(*QueryStorkPublishersRequest))
+var xxx_messageInfo_QueryOracleProviderPricesRequest proto.InternalMessageInfo
+
+func (m *QueryOracleProviderPricesRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProviderPriceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderPriceStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderPriceState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/ProviderPriceState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderPriceState(ctx, req.(*QueryProviderPriceStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryOracleProviderPricesResponse struct {
+	ProviderState []*ProviderState `protobuf:"bytes,1,rep,name=providerState,proto3" json:"providerState,omitempty"`
 }
 
-func _Query_OracleModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleModuleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/OracleModuleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleModuleState(ctx, req.(*QueryModuleStateRequest))
+func (m *QueryOracleProviderPricesResponse) Reset()         { *m = QueryOracleProviderPricesResponse{} }
+func (m *QueryOracleProviderPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleProviderPricesResponse) ProtoMessage()    {}
+func (*QueryOracleProviderPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{46}
+}
+func (m *QueryOracleProviderPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleProviderPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleProviderPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOracleProviderPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleProviderPricesResponse.Merge(m, src)
+}
+func (m *QueryOracleProviderPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleProviderPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleProviderPricesResponse.DiscardUnknown(m)
 }
 
-func _Query_HistoricalPriceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHistoricalPriceRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HistoricalPriceRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/HistoricalPriceRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HistoricalPriceRecords(ctx, req.(*QueryHistoricalPriceRecordsRequest))
+var xxx_messageInfo_QueryOracleProviderPricesResponse proto.InternalMessageInfo
+
+func (m *QueryOracleProviderPricesResponse) GetProviderState() []*ProviderState {
+	if m != nil {
+		return m.ProviderState
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OracleVolatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleVolatilityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleVolatility(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/OracleVolatility",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleVolatility(ctx, req.(*QueryOracleVolatilityRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ScalingOptions defines optional configuration to avoid precision loss. The
+// oracle result will be returned as base_price * 10^base_decimals / quote_price
+// * 10^quote_decimals
+type ScalingOptions struct {
+	BaseDecimals  uint32 `protobuf:"varint,1,opt,name=base_decimals,json=baseDecimals,proto3" json:"base_decimals,omitempty"`
+	QuoteDecimals uint32 `protobuf:"varint,2,opt,name=quote_decimals,json=quoteDecimals,proto3" json:"quote_decimals,omitempty"`
 }
 
-func _Query_OracleProvidersInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleProvidersInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleProvidersInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/OracleProvidersInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleProvidersInfo(ctx, req.(*QueryOracleProvidersInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ScalingOptions) Reset()         { *m = ScalingOptions{} }
+func (m *ScalingOptions) String() string { return proto.CompactTextString(m) }
+func (*ScalingOptions) ProtoMessage()    {}
+func (*ScalingOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{47}
 }
-
-func _Query_OracleProviderPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOracleProviderPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OracleProviderPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/OracleProviderPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OracleProviderPrices(ctx, req.(*QueryOracleProviderPricesRequest))
+func (m *ScalingOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScalingOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingOptions.Merge(m, src)
+}
+func (m *ScalingOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingOptions.DiscardUnknown(m)
 }
 
-func _Query_OraclePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOraclePriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OraclePrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/OraclePrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OraclePrice(ctx, req.(*QueryOraclePriceRequest))
+var xxx_messageInfo_ScalingOptions proto.InternalMessageInfo
+
+func (m *ScalingOptions) GetBaseDecimals() uint32 {
+	if m != nil {
+		return m.BaseDecimals
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PythPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPythPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PythPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.oracle.v1beta1.Query/PythPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PythPrice(ctx, req.(*QueryPythPriceRequest))
+func (m *ScalingOptions) GetQuoteDecimals() uint32 {
+	if m != nil {
+		return m.QuoteDecimals
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.oracle.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BandRelayers",
-			Handler:    _Query_BandRelayers_Handler,
-		},
-		{
-			MethodName: "BandPriceStates",
-			Handler:    _Query_BandPriceStates_Handler,
-		},
-		{
-			MethodName: "BandIBCPriceStates",
-			Handler:    _Query_BandIBCPriceStates_Handler,
-		},
-		{
-			MethodName: "PriceFeedPriceStates",
-			Handler:    _Query_PriceFeedPriceStates_Handler,
-		},
-		{
-			MethodName: "CoinbasePriceStates",
-			Handler:    _Query_CoinbasePriceStates_Handler,
-		},
-		{
-			MethodName: "PythPriceStates",
-			Handler:    _Query_PythPriceStates_Handler,
-		},
-		{
-			MethodName: "StorkPriceStates",
-			Handler:    _Query_StorkPriceStates_Handler,
-		},
-		{
-			MethodName: "StorkPublishers",
-			Handler:    _Query_StorkPublishers_Handler,
-		},
-		{
-			MethodName: "ProviderPriceState",
-			Handler:    _Query_ProviderPriceState_Handler,
-		},
-		{
-			MethodName: "OracleModuleState",
-			Handler:    _Query_OracleModuleState_Handler,
-		},
-		{
-			MethodName: "HistoricalPriceRecords",
-			Handler:    _Query_HistoricalPriceRecords_Handler,
-		},
-		{
-			MethodName: "OracleVolatility",
-			Handler:    _Query_OracleVolatility_Handler,
-		},
-		{
-			MethodName: "OracleProvidersInfo",
-			Handler:    _Query_OracleProvidersInfo_Handler,
-		},
-		{
-			MethodName: "OracleProviderPrices",
-			Handler:    _Query_OracleProviderPrices_Handler,
-		},
-		{
-			MethodName: "OraclePrice",
-			Handler:    _Query_OraclePrice_Handler,
-		},
-		{
-			MethodName: "PythPrice",
-			Handler:    _Query_PythPrice_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "injective/oracle/v1beta1/query.proto",
+// QueryOraclePriceRequest is the request type for the Query/OraclePrice RPC
+// method.
+type QueryOraclePriceRequest struct {
+	OracleType     OracleType      `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Base           string          `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Quote          string          `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	ScalingOptions *ScalingOptions `protobuf:"bytes,4,opt,name=scaling_options,json=scalingOptions,proto3" json:"scaling_options,omitempty"`
 }
 
-func (m *QueryPythPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOraclePriceRequest) Reset()         { *m = QueryOraclePriceRequest{} }
+func (m *QueryOraclePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOraclePriceRequest) ProtoMessage()    {}
+func (*QueryOraclePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{48}
+}
+func (m *QueryOraclePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOraclePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOraclePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryPythPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOraclePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOraclePriceRequest.Merge(m, src)
+}
+func (m *QueryOraclePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOraclePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOraclePriceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPythPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceId) > 0 {
-		i -= len(m.PriceId)
-		copy(dAtA[i:], m.PriceId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryOraclePriceRequest proto.InternalMessageInfo
+
+func (m *QueryOraclePriceRequest) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
 	}
-	return len(dAtA) - i, nil
+	return OracleType_Unspecified
 }
 
-func (m *QueryPythPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOraclePriceRequest) GetBase() string {
+	if m != nil {
+		return m.Base
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPythPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryPythPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PriceState != nil {
-		{
-			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOraclePriceRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOraclePriceRequest) GetScalingOptions() *ScalingOptions {
+	if m != nil {
+		return m.ScalingOptions
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type PricePairState struct {
+	PairPrice            cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=pair_price,json=pairPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pair_price"`
+	BasePrice            cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_price"`
+	QuotePrice           cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=quote_price,json=quotePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_price"`
+	BaseCumulativePrice  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=base_cumulative_price,json=baseCumulativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_cumulative_price"`
+	QuoteCumulativePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=quote_cumulative_price,json=quoteCumulativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_cumulative_price"`
+	BaseTimestamp        int64                       `protobuf:"varint,6,opt,name=base_timestamp,json=baseTimestamp,proto3" json:"base_timestamp,omitempty"`
+	QuoteTimestamp       int64                       `protobuf:"varint,7,opt,name=quote_timestamp,json=quoteTimestamp,proto3" json:"quote_timestamp,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PricePairState) Reset()         { *m = PricePairState{} }
+func (m *PricePairState) String() string { return proto.CompactTextString(m) }
+func (*PricePairState) ProtoMessage()    {}
+func (*PricePairState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{49}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PricePairState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *PricePairState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricePairState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBandRelayersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryBandRelayersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PricePairState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePairState.Merge(m, src)
 }
-
-func (m *QueryBandRelayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *PricePairState) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBandRelayersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PricePairState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePairState.DiscardUnknown(m)
 }
 
-func (m *QueryBandRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_PricePairState proto.InternalMessageInfo
 
-func (m *QueryBandRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *PricePairState) GetBaseTimestamp() int64 {
+	if m != nil {
+		return m.BaseTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBandPriceStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PricePairState) GetQuoteTimestamp() int64 {
+	if m != nil {
+		return m.QuoteTimestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBandPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOraclePriceResponse is the response type for the Query/OraclePrice RPC
+// method.
+type QueryOraclePriceResponse struct {
+	PricePairState *PricePairState `protobuf:"bytes,1,opt,name=price_pair_state,json=pricePairState,proto3" json:"price_pair_state,omitempty"`
 }
 
-func (m *QueryBandPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryOraclePriceResponse) Reset()         { *m = QueryOraclePriceResponse{} }
+func (m *QueryOraclePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOraclePriceResponse) ProtoMessage()    {}
+func (*QueryOraclePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{50}
 }
-
-func (m *QueryBandPriceStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOraclePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOraclePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOraclePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryBandPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOraclePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOraclePriceResponse.Merge(m, src)
+}
+func (m *QueryOraclePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOraclePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOraclePriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBandPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryOraclePriceResponse proto.InternalMessageInfo
+
+func (m *QueryOraclePriceResponse) GetPricePairState() *PricePairState {
+	if m != nil {
+		return m.PricePairState
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBandIBCPriceStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryPythPriceRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceRequest")
+	proto.RegisterType((*QueryPythPriceResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceResponse")
+	proto.RegisterType((*QueryCompositeOraclesRequest)(nil), "injective.oracle.v1beta1.QueryCompositeOraclesRequest")
+	proto.RegisterType((*QueryCompositeOraclesResponse)(nil), "injective.oracle.v1beta1.QueryCompositeOraclesResponse")
+	proto.RegisterType((*QueryCompositeOracleRequest)(nil), "injective.oracle.v1beta1.QueryCompositeOracleRequest")
+	proto.RegisterType((*QueryCompositeOracleResponse)(nil), "injective.oracle.v1beta1.QueryCompositeOracleResponse")
+	proto.RegisterType((*QueryCircuitBreakersRequest)(nil), "injective.oracle.v1beta1.QueryCircuitBreakersRequest")
+	proto.RegisterType((*QueryCircuitBreakersResponse)(nil), "injective.oracle.v1beta1.QueryCircuitBreakersResponse")
+	proto.RegisterType((*QueryCircuitBreakerStateRequest)(nil), "injective.oracle.v1beta1.QueryCircuitBreakerStateRequest")
+	proto.RegisterType((*QueryCircuitBreakerStateResponse)(nil), "injective.oracle.v1beta1.QueryCircuitBreakerStateResponse")
+	proto.RegisterType((*QueryChainlinkDataStreamsDONsRequest)(nil), "injective.oracle.v1beta1.QueryChainlinkDataStreamsDONsRequest")
+	proto.RegisterType((*QueryChainlinkDataStreamsDONsResponse)(nil), "injective.oracle.v1beta1.QueryChainlinkDataStreamsDONsResponse")
+	proto.RegisterType((*QueryChainlinkDataStreamsPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryChainlinkDataStreamsPriceStatesRequest")
+	proto.RegisterType((*QueryChainlinkDataStreamsPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryChainlinkDataStreamsPriceStatesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "injective.oracle.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "injective.oracle.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBandRelayersRequest)(nil), "injective.oracle.v1beta1.QueryBandRelayersRequest")
+	proto.RegisterType((*QueryBandRelayersResponse)(nil), "injective.oracle.v1beta1.QueryBandRelayersResponse")
+	proto.RegisterType((*QueryBandPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryBandPriceStatesRequest")
+	proto.RegisterType((*QueryBandPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryBandPriceStatesResponse")
+	proto.RegisterType((*QueryBandIBCPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryBandIBCPriceStatesRequest")
+	proto.RegisterType((*QueryBandIBCPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryBandIBCPriceStatesResponse")
+	proto.RegisterType((*QueryPriceFeedPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryPriceFeedPriceStatesRequest")
+	proto.RegisterType((*QueryPriceFeedPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryPriceFeedPriceStatesResponse")
+	proto.RegisterType((*QueryCoinbasePriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryCoinbasePriceStatesRequest")
+	proto.RegisterType((*QueryCoinbasePriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryCoinbasePriceStatesResponse")
+	proto.RegisterType((*QueryPythPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceStatesRequest")
+	proto.RegisterType((*QueryPythPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceStatesResponse")
+	proto.RegisterType((*QueryStorkPriceStatesRequest)(nil), "injective.oracle.v1beta1.QueryStorkPriceStatesRequest")
+	proto.RegisterType((*QueryStorkPriceStatesResponse)(nil), "injective.oracle.v1beta1.QueryStorkPriceStatesResponse")
+	proto.RegisterType((*QueryStorkPublishersRequest)(nil), "injective.oracle.v1beta1.QueryStorkPublishersRequest")
+	proto.RegisterType((*QueryStorkPublishersResponse)(nil), "injective.oracle.v1beta1.QueryStorkPublishersResponse")
+	proto.RegisterType((*QueryProviderPriceStateRequest)(nil), "injective.oracle.v1beta1.QueryProviderPriceStateRequest")
+	proto.RegisterType((*QueryProviderPriceStateResponse)(nil), "injective.oracle.v1beta1.QueryProviderPriceStateResponse")
+	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.oracle.v1beta1.QueryModuleStateRequest")
+	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.oracle.v1beta1.QueryModuleStateResponse")
+	proto.RegisterType((*QueryHistoricalPriceRecordsRequest)(nil), "injective.oracle.v1beta1.QueryHistoricalPriceRecordsRequest")
+	proto.RegisterType((*QueryHistoricalPriceRecordsResponse)(nil), "injective.oracle.v1beta1.QueryHistoricalPriceRecordsResponse")
+	proto.RegisterType((*OracleHistoryOptions)(nil), "injective.oracle.v1beta1.OracleHistoryOptions")
+	proto.RegisterType((*QueryOracleVolatilityRequest)(nil), "injective.oracle.v1beta1.QueryOracleVolatilityRequest")
+	proto.RegisterType((*QueryOracleVolatilityResponse)(nil), "injective.oracle.v1beta1.QueryOracleVolatilityResponse")
+	proto.RegisterType((*QueryOracleTWAPRequest)(nil), "injective.oracle.v1beta1.QueryOracleTWAPRequest")
+	proto.RegisterType((*QueryOracleTWAPResponse)(nil), "injective.oracle.v1beta1.QueryOracleTWAPResponse")
+	proto.RegisterType((*QueryOracleProvidersInfoRequest)(nil), "injective.oracle.v1beta1.QueryOracleProvidersInfoRequest")
+	proto.RegisterType((*QueryOracleProvidersInfoResponse)(nil), "injective.oracle.v1beta1.QueryOracleProvidersInfoResponse")
+	proto.RegisterType((*QueryOracleProviderPricesRequest)(nil), "injective.oracle.v1beta1.QueryOracleProviderPricesRequest")
+	proto.RegisterType((*QueryOracleProviderPricesResponse)(nil), "injective.oracle.v1beta1.QueryOracleProviderPricesResponse")
+	proto.RegisterType((*ScalingOptions)(nil), "injective.oracle.v1beta1.ScalingOptions")
+	proto.RegisterType((*QueryOraclePriceRequest)(nil), "injective.oracle.v1beta1.QueryOraclePriceRequest")
+	proto.RegisterType((*PricePairState)(nil), "injective.oracle.v1beta1.PricePairState")
+	proto.RegisterType((*QueryOraclePriceResponse)(nil), "injective.oracle.v1beta1.QueryOraclePriceResponse")
 }
 
-func (m *QueryBandIBCPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("injective/oracle/v1beta1/query.proto", fileDescriptor_52f5d6f9962923ad)
 }
 
-func (m *QueryBandIBCPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_52f5d6f9962923ad = []byte{
+	// 2440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xfb, 0x2b, 0xf6, 0x73, 0xe2, 0x71, 0x2a, 0x5e, 0xef, 0xa4, 0x93, 0x8c, 0x9d, 0x76,
+	0xec, 0x24, 0x24, 0x99, 0x89, 0x27, 0x89, 0x9d, 0xcd, 0xe6, 0x83, 0x38, 0x4e, 0x36, 0x86, 0x64,
+	0x93, 0xed, 0x18, 0xb2, 0x0b, 0x2b, 0x8d, 0x6a, 0x7a, 0x3a, 0x33, 0x8d, 0x67, 0xba, 0x3b, 0xdd,
+	0x3d, 0xce, 0x8e, 0x56, 0x11, 0x82, 0x23, 0x20, 0x81, 0xe0, 0x84, 0x04, 0x77, 0xc4, 0x0d, 0x09,
+	0x24, 0x10, 0x12, 0x07, 0x10, 0xd2, 0x72, 0x5b, 0x84, 0x90, 0x50, 0x0e, 0x2b, 0x94, 0xc0, 0x5f,
+	0xc0, 0x81, 0x2b, 0xaa, 0xaa, 0xd7, 0xed, 0xee, 0x99, 0xee, 0xe9, 0x9e, 0x41, 0xa0, 0xbd, 0x75,
+	0x57, 0xd5, 0xfb, 0xd5, 0xef, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0x53, 0xc1, 0x49, 0xc3, 0xfc, 0x86,
+	0xae, 0x79, 0xc6, 0xae, 0x5e, 0xb2, 0x1c, 0xaa, 0x35, 0xf5, 0xd2, 0xee, 0x6a, 0x55, 0xf7, 0xe8,
+	0x6a, 0xe9, 0x59, 0x5b, 0x77, 0x3a, 0x45, 0xdb, 0xb1, 0x3c, 0x8b, 0xe4, 0x83, 0x51, 0x45, 0x31,
+	0xaa, 0x88, 0xa3, 0xe4, 0x63, 0x75, 0xcb, 0xaa, 0x37, 0xf5, 0x12, 0xb5, 0x8d, 0x12, 0x35, 0x4d,
+	0xcb, 0xa3, 0x9e, 0x61, 0x99, 0xae, 0x90, 0x93, 0x97, 0x13, 0xd1, 0x11, 0x46, 0x0c, 0x5b, 0x49,
+	0x1c, 0x56, 0xd7, 0x4d, 0xdd, 0x35, 0x7c, 0xb8, 0xb9, 0xba, 0x55, 0xb7, 0xf8, 0x67, 0x89, 0x7d,
+	0x89, 0x56, 0xa5, 0x0c, 0x6f, 0xbc, 0xc7, 0xb8, 0x3e, 0xea, 0x78, 0x8d, 0x47, 0x8e, 0xa1, 0xe9,
+	0xaa, 0xfe, 0xac, 0xad, 0xbb, 0x1e, 0x39, 0x02, 0x93, 0x36, 0xfb, 0xaf, 0x18, 0xb5, 0xbc, 0xb4,
+	0x28, 0x9d, 0x9e, 0x52, 0xf7, 0xf3, 0xff, 0xad, 0x9a, 0xa2, 0xc1, 0x7c, 0xb7, 0x8c, 0x6b, 0x5b,
+	0xa6, 0xab, 0x93, 0x2d, 0x98, 0x16, 0x42, 0xae, 0x47, 0x3d, 0x9d, 0xcb, 0x4d, 0x97, 0x4f, 0x17,
+	0x93, 0x0c, 0x50, 0x0c, 0x10, 0x1e, 0xb3, 0xf1, 0x2a, 0xd8, 0xc1, 0xb7, 0x52, 0x80, 0x63, 0x7c,
+	0x92, 0xdb, 0x56, 0xcb, 0xb6, 0x5c, 0xc3, 0xd3, 0x1f, 0x72, 0x51, 0x17, 0xf9, 0x29, 0x2f, 0xe0,
+	0x78, 0x42, 0x3f, 0x72, 0xf9, 0x10, 0x0e, 0x69, 0x7e, 0x5f, 0x45, 0xcc, 0xeb, 0xe6, 0xa5, 0xc5,
+	0xd1, 0xd3, 0xd3, 0xe5, 0x33, 0xc9, 0x8c, 0xba, 0xe0, 0x36, 0xc6, 0x3e, 0xf9, 0x6c, 0x61, 0x9f,
+	0x3a, 0xab, 0x75, 0xcd, 0xa2, 0x5c, 0x86, 0xa3, 0x71, 0xd3, 0xfb, 0xd6, 0x9b, 0x87, 0x09, 0xb7,
+	0xd3, 0xaa, 0x5a, 0x4d, 0xb4, 0x1d, 0xfe, 0x29, 0x2f, 0xa5, 0x78, 0xb5, 0x02, 0xd6, 0xdb, 0x30,
+	0xdb, 0xcd, 0x1a, 0xcd, 0x98, 0x9d, 0xb4, 0x9a, 0xeb, 0xa2, 0x4b, 0xde, 0x8d, 0xae, 0xcb, 0x08,
+	0x07, 0x3c, 0x9f, 0x01, 0x30, 0x7e, 0x71, 0xc8, 0x1c, 0x8c, 0xeb, 0x8e, 0x63, 0x39, 0xf9, 0x51,
+	0xae, 0x9d, 0xf8, 0x51, 0x8e, 0xfb, 0x36, 0x31, 0x1c, 0xad, 0x6d, 0x78, 0x1b, 0x8e, 0x4e, 0x77,
+	0x74, 0x27, 0x58, 0xb1, 0x3f, 0x04, 0xba, 0x77, 0xf7, 0xa3, 0xee, 0x1f, 0xc0, 0xac, 0x26, 0xba,
+	0x2a, 0x55, 0xec, 0xc3, 0x05, 0xeb, 0xe3, 0x42, 0x51, 0x30, 0x5c, 0xaf, 0x9c, 0x16, 0x9d, 0x82,
+	0xdc, 0x83, 0xf1, 0x06, 0x6d, 0x7a, 0x6e, 0x7e, 0x84, 0xe3, 0x9d, 0xcb, 0x8a, 0x77, 0x8f, 0x36,
+	0x3d, 0xc4, 0x14, 0x00, 0xca, 0x0f, 0x25, 0x58, 0x88, 0xd1, 0x42, 0xd8, 0x08, 0x57, 0xff, 0x0e,
+	0x4c, 0x0b, 0xd4, 0x8a, 0xd7, 0xb1, 0xc5, 0xfa, 0xcd, 0x94, 0x4f, 0x26, 0xcf, 0x29, 0x56, 0x69,
+	0xbb, 0x63, 0xeb, 0x2a, 0x58, 0xc1, 0x37, 0x21, 0x30, 0x56, 0xa5, 0xae, 0x58, 0xae, 0x29, 0x95,
+	0x7f, 0x33, 0xcb, 0x3f, 0x6b, 0x5b, 0x9e, 0xee, 0x5b, 0x9e, 0xff, 0x28, 0xff, 0x94, 0x60, 0x31,
+	0x99, 0x14, 0x9a, 0xf7, 0x3d, 0xc8, 0x75, 0x99, 0x37, 0x7d, 0x83, 0x46, 0xf1, 0xd4, 0x99, 0xa8,
+	0x5d, 0xc9, 0x17, 0x61, 0x8c, 0x59, 0x05, 0x1d, 0x6a, 0x20, 0xab, 0xaa, 0x5c, 0x92, 0xe9, 0xe3,
+	0x7a, 0xb4, 0x29, 0xf4, 0x99, 0x54, 0xc5, 0x0f, 0xdb, 0x3e, 0xac, 0x57, 0xaf, 0xe5, 0xc7, 0x78,
+	0x33, 0xfe, 0x29, 0x2b, 0x70, 0x52, 0xa8, 0xd9, 0xa0, 0x86, 0xd9, 0x34, 0xcc, 0x9d, 0x4d, 0xea,
+	0xd1, 0xc7, 0x9e, 0xa3, 0xd3, 0x96, 0xbb, 0xf9, 0xf0, 0xdd, 0xc0, 0xd5, 0x3c, 0x58, 0x4e, 0x19,
+	0x87, 0x36, 0xf9, 0x32, 0x8c, 0xd5, 0x2c, 0xd3, 0x77, 0xb3, 0xd5, 0x3e, 0x0a, 0xc4, 0x23, 0xa1,
+	0x6f, 0x70, 0x10, 0xe5, 0x3c, 0x9c, 0x4d, 0x9c, 0x75, 0x6f, 0x23, 0x05, 0x24, 0xbf, 0x2b, 0xc1,
+	0xb9, 0x6c, 0xe3, 0x91, 0xec, 0xd7, 0xe1, 0x40, 0x68, 0x17, 0xfb, 0xa4, 0xaf, 0x0c, 0x46, 0x3a,
+	0xb4, 0xa3, 0xa7, 0xf7, 0x76, 0xb4, 0xab, 0xcc, 0x01, 0x11, 0x41, 0x9d, 0x3a, 0xb4, 0x15, 0x70,
+	0xfc, 0x0a, 0x1c, 0x8e, 0xb4, 0x22, 0x93, 0x1b, 0x30, 0x61, 0xf3, 0x16, 0xf4, 0xa0, 0xc5, 0x3e,
+	0x21, 0x9e, 0x8f, 0x43, 0x3b, 0xa1, 0x94, 0x22, 0x43, 0x9e, 0xc3, 0x6e, 0x50, 0xb3, 0xa6, 0xea,
+	0x4d, 0xda, 0x09, 0x85, 0x89, 0x75, 0x38, 0x12, 0xd3, 0x87, 0x13, 0xcb, 0x30, 0xe9, 0x60, 0x1b,
+	0x57, 0x7f, 0x4a, 0x0d, 0xfe, 0x83, 0xf0, 0xc3, 0x04, 0x63, 0xcc, 0xbd, 0x03, 0xc7, 0xe2, 0xbb,
+	0x03, 0x57, 0x88, 0xb3, 0x6e, 0x9f, 0xbd, 0x11, 0x05, 0x8a, 0x5a, 0x73, 0x11, 0x0a, 0xc1, 0x64,
+	0x5b, 0x1b, 0xb7, 0x63, 0xe8, 0x98, 0xb0, 0x90, 0x38, 0xe2, 0x7f, 0xc1, 0x48, 0xc1, 0x08, 0xc1,
+	0xfb, 0xef, 0xea, 0x7a, 0x9c, 0x89, 0x6c, 0x38, 0xd1, 0x67, 0xcc, 0xb0, 0xac, 0x02, 0xb4, 0x18,
+	0x56, 0x27, 0xfc, 0x60, 0x6a, 0x19, 0x26, 0x8b, 0x6f, 0x31, 0xa4, 0x5c, 0x58, 0x4c, 0x1e, 0x82,
+	0x9c, 0x1e, 0xc6, 0x72, 0xea, 0x17, 0x8f, 0x7a, 0xc0, 0xa2, 0xbc, 0x7c, 0x5f, 0x8a, 0x26, 0x28,
+	0x3d, 0xbe, 0xd4, 0xd3, 0x3d, 0xb4, 0x8d, 0xa2, 0x89, 0x50, 0x84, 0x8b, 0x9f, 0x09, 0x3d, 0xf6,
+	0x2c, 0x67, 0x27, 0x86, 0x4c, 0x0b, 0x8e, 0x27, 0xf4, 0x23, 0x9b, 0xfb, 0xb1, 0x6c, 0xfa, 0xe4,
+	0x13, 0x5d, 0x48, 0xf1, 0xa6, 0x11, 0x83, 0xda, 0xd5, 0xa6, 0xe1, 0x36, 0x42, 0xdb, 0xf7, 0x06,
+	0x1c, 0x8b, 0xef, 0x46, 0x32, 0x05, 0x00, 0x3b, 0x68, 0xc5, 0x3d, 0x1c, 0x6a, 0x51, 0xb6, 0x71,
+	0xe7, 0x3c, 0x72, 0xac, 0x5d, 0xa3, 0xa6, 0x3b, 0x21, 0x1a, 0x78, 0xba, 0xca, 0x2c, 0x33, 0x15,
+	0x9d, 0x98, 0x5d, 0x05, 0xff, 0xa1, 0xbc, 0x6b, 0x24, 0x92, 0x77, 0x35, 0x60, 0x21, 0x11, 0x15,
+	0x89, 0xdd, 0x89, 0xcb, 0x5d, 0x4f, 0xa6, 0xb8, 0x75, 0x6f, 0xde, 0x7a, 0x04, 0xde, 0xe4, 0x33,
+	0x3d, 0xb0, 0x6a, 0xed, 0x66, 0x84, 0xb8, 0xf2, 0x3e, 0xe4, 0x7b, 0xbb, 0x70, 0xf6, 0x6b, 0x30,
+	0x1e, 0x9e, 0x77, 0x25, 0x79, 0xde, 0x77, 0x44, 0x56, 0x2f, 0xc4, 0x85, 0x90, 0xf2, 0x4d, 0x50,
+	0x38, 0xf2, 0x3d, 0xc3, 0xf5, 0x2c, 0xc7, 0xd0, 0x68, 0x13, 0xf3, 0x72, 0xcd, 0x72, 0x6a, 0xfe,
+	0xd2, 0x90, 0x6b, 0x30, 0x11, 0xca, 0x28, 0xb3, 0x66, 0x24, 0x28, 0x43, 0x8e, 0xc2, 0x94, 0x30,
+	0x26, 0xbb, 0x11, 0x08, 0xeb, 0x4e, 0x8a, 0x86, 0xad, 0x9a, 0xe2, 0xc0, 0x52, 0x5f, 0x02, 0xc1,
+	0xbe, 0x38, 0x28, 0x6c, 0xec, 0x88, 0x0e, 0x74, 0xc5, 0x95, 0x14, 0x2b, 0xfb, 0x30, 0x07, 0xec,
+	0xd0, 0x9f, 0xf2, 0x1d, 0x09, 0xe6, 0x04, 0x4f, 0x31, 0x6b, 0xe7, 0xa1, 0xcd, 0xaf, 0x4f, 0xe4,
+	0x4d, 0xd8, 0xdf, 0xa2, 0x1f, 0x55, 0x68, 0x5d, 0x28, 0x3a, 0xa6, 0x4e, 0xb4, 0xe8, 0x47, 0xb7,
+	0xea, 0x3a, 0x29, 0xc2, 0x61, 0xc3, 0xd4, 0x9a, 0xed, 0x9a, 0x5e, 0x71, 0xe8, 0xf3, 0x4a, 0x43,
+	0x88, 0x71, 0x65, 0x26, 0xd5, 0x43, 0xd8, 0xa5, 0xd2, 0xe7, 0x88, 0x47, 0xce, 0xc0, 0xac, 0x3f,
+	0xbe, 0xa5, 0x7b, 0xb4, 0x46, 0x3d, 0x8a, 0x79, 0x4a, 0x0e, 0xdb, 0x1f, 0x60, 0xb3, 0xf2, 0xbd,
+	0x11, 0xf4, 0x7b, 0xc1, 0xe8, 0xab, 0x56, 0x93, 0x7a, 0x46, 0xd3, 0xf0, 0x3a, 0xbe, 0xf1, 0x6f,
+	0xc1, 0x14, 0x0b, 0x38, 0x15, 0xc3, 0x7c, 0x6a, 0xa5, 0x3b, 0x97, 0x40, 0xd9, 0x32, 0x9f, 0x5a,
+	0xea, 0x24, 0x13, 0x63, 0x5f, 0xe4, 0x36, 0x00, 0x4f, 0xf7, 0x04, 0xc6, 0xc8, 0x00, 0x18, 0x53,
+	0x5c, 0x8e, 0x83, 0xd4, 0x60, 0x1e, 0x73, 0x53, 0x54, 0xbf, 0x62, 0x09, 0xb3, 0x71, 0xcd, 0xa6,
+	0xcb, 0xc5, 0x34, 0xc0, 0xa8, 0xb1, 0xd5, 0x39, 0x2b, 0xa6, 0x55, 0xf9, 0xd6, 0x08, 0x06, 0xa5,
+	0x5e, 0x73, 0xa0, 0x2b, 0xdc, 0x04, 0xd8, 0x0d, 0x5a, 0xc5, 0x3e, 0xde, 0x58, 0x78, 0xf9, 0xd9,
+	0xc2, 0x51, 0xcd, 0x72, 0x5b, 0x96, 0xeb, 0xd6, 0x76, 0x8a, 0x86, 0x55, 0x6a, 0x51, 0xaf, 0x51,
+	0xbc, 0xaf, 0xd7, 0xa9, 0xd6, 0xd9, 0xd4, 0x35, 0x35, 0x24, 0x42, 0x9e, 0xc0, 0xac, 0xaf, 0x41,
+	0xb0, 0x38, 0xa9, 0x79, 0xa8, 0xbf, 0x5e, 0x6c, 0xf7, 0x18, 0xae, 0x67, 0x68, 0xae, 0x9a, 0x43,
+	0x14, 0xbf, 0x8b, 0xdc, 0x85, 0xe9, 0xb0, 0x77, 0x8c, 0x72, 0x17, 0x5d, 0xce, 0xe4, 0xa2, 0x2a,
+	0x38, 0x81, 0xf7, 0x28, 0xff, 0x92, 0x60, 0x3e, 0x64, 0x83, 0xed, 0x27, 0xb7, 0x1e, 0x7d, 0xde,
+	0x9c, 0xe1, 0x38, 0x80, 0xeb, 0x51, 0xc7, 0xab, 0x78, 0x46, 0x4b, 0xa4, 0xe0, 0xa3, 0xea, 0x14,
+	0x6f, 0xd9, 0x36, 0x5a, 0x3a, 0xab, 0x01, 0xe8, 0x66, 0x4d, 0x74, 0x8e, 0xf1, 0xce, 0xfd, 0xba,
+	0x59, 0xe3, 0x5d, 0xb8, 0xc7, 0xea, 0xd4, 0xce, 0x8f, 0xf3, 0x1e, 0xb6, 0xc7, 0xde, 0xa1, 0xb6,
+	0xf2, 0x3e, 0xc6, 0xbf, 0xb0, 0xd2, 0xb8, 0xe4, 0xd7, 0x61, 0xcc, 0x7b, 0x4e, 0x6d, 0x54, 0x78,
+	0x29, 0xc5, 0xa2, 0x4c, 0xd4, 0x4f, 0xaf, 0x99, 0x58, 0x90, 0x2b, 0x08, 0x64, 0x3f, 0x92, 0xbb,
+	0x5c, 0x27, 0x8c, 0xb0, 0x0d, 0x58, 0x4c, 0x1e, 0x82, 0x2c, 0x36, 0x61, 0xca, 0x3f, 0x2e, 0x32,
+	0xc5, 0x1f, 0x31, 0x54, 0x58, 0x2e, 0x10, 0x54, 0x6e, 0xc4, 0xce, 0xc4, 0x89, 0xbb, 0x19, 0x0e,
+	0x2a, 0xc5, 0x81, 0x13, 0x7d, 0xe4, 0x91, 0xea, 0x03, 0x16, 0x2e, 0x45, 0xcf, 0x63, 0x3c, 0x1c,
+	0x18, 0xdd, 0x53, 0xe9, 0x74, 0xc5, 0xe9, 0x10, 0x95, 0x56, 0x3e, 0x84, 0x99, 0xc7, 0x1a, 0x6d,
+	0x1a, 0x66, 0xdd, 0x8f, 0x94, 0x4b, 0x70, 0x90, 0xfb, 0x61, 0x4d, 0xd7, 0x8c, 0x16, 0x6d, 0x8a,
+	0x74, 0xfe, 0xa0, 0x7a, 0x80, 0x35, 0x6e, 0x62, 0x1b, 0x59, 0x86, 0x19, 0xe1, 0x69, 0xc1, 0xa8,
+	0x11, 0x3e, 0xea, 0x20, 0x6f, 0xf5, 0x87, 0x29, 0xaf, 0xa5, 0xc8, 0xca, 0x47, 0x8a, 0x49, 0xff,
+	0xef, 0x0b, 0x31, 0x79, 0x02, 0x39, 0x57, 0xa8, 0x1a, 0x84, 0xb7, 0xb1, 0xb4, 0xbb, 0x6e, 0xd4,
+	0x36, 0xdc, 0xf5, 0x24, 0x75, 0xc6, 0x8d, 0xb4, 0x2a, 0xaf, 0x46, 0x61, 0x86, 0xab, 0xf6, 0x88,
+	0x1a, 0xc2, 0xac, 0x64, 0x03, 0xc0, 0xa6, 0x86, 0x53, 0xe1, 0x87, 0x13, 0x46, 0xb2, 0x25, 0xe6,
+	0xb7, 0x69, 0xd1, 0x6c, 0x8a, 0x89, 0x71, 0x30, 0x86, 0xc1, 0x17, 0x42, 0x60, 0x8c, 0x0c, 0x80,
+	0x11, 0x64, 0xb1, 0x64, 0x13, 0xa6, 0xc5, 0x3a, 0x09, 0x90, 0xd1, 0xec, 0x20, 0x22, 0x92, 0x08,
+	0x94, 0x27, 0xf0, 0x06, 0x67, 0xa2, 0xb5, 0x5b, 0x6d, 0x16, 0x6b, 0x77, 0x7d, 0xbc, 0xb1, 0xec,
+	0x78, 0x87, 0x19, 0xc2, 0xed, 0x00, 0x40, 0x00, 0x7f, 0x00, 0xf3, 0x82, 0x5e, 0x0f, 0xf2, 0x78,
+	0x76, 0xe4, 0x39, 0x0e, 0xd1, 0x0d, 0xbd, 0x0c, 0x33, 0x9c, 0x33, 0x0b, 0x54, 0xae, 0x47, 0x5b,
+	0x76, 0x7e, 0x82, 0xc7, 0x24, 0xee, 0xdc, 0xdb, 0x7e, 0x23, 0x39, 0x05, 0x39, 0xc1, 0x60, 0x6f,
+	0xdc, 0x7e, 0x3e, 0x4e, 0xf8, 0x77, 0x30, 0x50, 0x31, 0x31, 0x51, 0x8b, 0x78, 0x32, 0xee, 0x49,
+	0x15, 0x66, 0x45, 0x0a, 0xc3, 0xd7, 0x3c, 0x6b, 0x9d, 0x33, 0xe2, 0x31, 0xea, 0x8c, 0x1d, 0xf9,
+	0x2f, 0xff, 0x76, 0x09, 0xc6, 0xf9, 0x84, 0xe4, 0xfb, 0x12, 0x4c, 0x88, 0x1b, 0x33, 0xe9, 0x73,
+	0x8a, 0xf5, 0x5e, 0xd4, 0xe5, 0xf3, 0x19, 0x47, 0x0b, 0x2d, 0x94, 0xd3, 0xdf, 0xfe, 0xcb, 0x3f,
+	0x7e, 0x34, 0xa2, 0x90, 0xc5, 0x52, 0x62, 0xf5, 0x58, 0x5c, 0xd5, 0xc9, 0xcf, 0x24, 0x38, 0x10,
+	0xbe, 0x8a, 0x93, 0x72, 0xca, 0x4c, 0x31, 0x77, 0x7a, 0xf9, 0xe2, 0x40, 0x32, 0xc8, 0xb1, 0xc4,
+	0x39, 0x9e, 0x21, 0xa7, 0x92, 0x39, 0x56, 0xa9, 0x59, 0xab, 0xf8, 0x05, 0x00, 0xf2, 0x2b, 0x09,
+	0x72, 0x5d, 0xb7, 0x7b, 0x72, 0x39, 0xc3, 0xcc, 0xbd, 0x77, 0x2a, 0x79, 0x6d, 0x50, 0x31, 0xe4,
+	0x7c, 0x91, 0x73, 0x3e, 0x4f, 0xce, 0xa6, 0x70, 0x0e, 0xdf, 0xc7, 0xc8, 0xef, 0x25, 0x20, 0xbd,
+	0x65, 0x00, 0x72, 0x25, 0x03, 0x87, 0xd8, 0xda, 0x82, 0xfc, 0xd6, 0x10, 0x92, 0xa8, 0xc0, 0x3a,
+	0x57, 0x60, 0x95, 0x94, 0x52, 0x14, 0x30, 0xaa, 0x5a, 0x54, 0x89, 0x3f, 0x49, 0x30, 0x17, 0x57,
+	0x37, 0x20, 0x57, 0xd3, 0x3c, 0x33, 0xb9, 0x20, 0x21, 0xbf, 0x3d, 0x94, 0x2c, 0xaa, 0x72, 0x85,
+	0xab, 0x52, 0x26, 0x17, 0xfa, 0xf8, 0x38, 0x13, 0x7b, 0xaa, 0xeb, 0x5d, 0x0b, 0xf2, 0x47, 0x09,
+	0x0e, 0xc7, 0x94, 0x1b, 0x48, 0x9a, 0x5d, 0x93, 0xab, 0x18, 0xf2, 0xd5, 0x61, 0x44, 0xb3, 0xaf,
+	0x89, 0x86, 0xe2, 0x51, 0x3d, 0xd8, 0x86, 0xe8, 0x2a, 0x51, 0xa4, 0x6e, 0x88, 0xf8, 0x8a, 0x87,
+	0xbc, 0x36, 0xa8, 0x58, 0xf6, 0x0d, 0x61, 0x77, 0xbc, 0x46, 0x94, 0xf7, 0x6f, 0x24, 0x98, 0xed,
+	0xae, 0x66, 0x90, 0x34, 0x06, 0x09, 0xe5, 0x11, 0x79, 0x7d, 0x60, 0x39, 0xa4, 0x7e, 0x89, 0x53,
+	0x2f, 0x92, 0x73, 0xc9, 0xd4, 0x59, 0xa2, 0xbf, 0x13, 0xe5, 0xfe, 0x4b, 0x09, 0x72, 0x5d, 0xb5,
+	0x8f, 0x54, 0x9b, 0xc7, 0x97, 0x52, 0xe4, 0xb5, 0x41, 0xc5, 0x90, 0x78, 0x99, 0x13, 0x3f, 0x47,
+	0xbe, 0x90, 0x4a, 0x7c, 0x8f, 0xe2, 0x5f, 0x25, 0x20, 0xbd, 0xc5, 0x91, 0xd4, 0x18, 0x94, 0x58,
+	0xa5, 0x91, 0xdf, 0x1a, 0x42, 0x12, 0xf9, 0x7f, 0x89, 0xf3, 0xdf, 0x24, 0x1b, 0xfd, 0x36, 0xae,
+	0x90, 0x0e, 0xdb, 0xbe, 0xf4, 0xb1, 0xdf, 0xfa, 0xa2, 0xf4, 0xb1, 0xa8, 0x4c, 0xbc, 0x20, 0x3f,
+	0x97, 0xe0, 0x90, 0x38, 0xc6, 0x43, 0x55, 0x17, 0xb2, 0x9a, 0x42, 0xae, 0xb7, 0x78, 0x23, 0x97,
+	0x07, 0x11, 0x41, 0x45, 0x8a, 0x5c, 0x91, 0xd3, 0x64, 0x25, 0x59, 0x91, 0x16, 0x17, 0x13, 0x0a,
+	0x90, 0x3f, 0x4b, 0x30, 0x1f, 0x5f, 0x41, 0x21, 0xd7, 0x52, 0xa6, 0xef, 0x5b, 0xf9, 0x91, 0xaf,
+	0x0f, 0x29, 0x8d, 0x7a, 0x5c, 0xe5, 0x7a, 0x5c, 0x22, 0xe5, 0x64, 0x3d, 0x1a, 0x01, 0x42, 0x25,
+	0x52, 0xe1, 0x21, 0xbf, 0x90, 0x60, 0xb6, 0xbb, 0x08, 0x90, 0xba, 0x97, 0x13, 0x8a, 0x28, 0xf2,
+	0xfa, 0xc0, 0x72, 0xa8, 0xc1, 0x39, 0xae, 0xc1, 0x0a, 0x39, 0x99, 0xac, 0x41, 0xa8, 0xb4, 0xf0,
+	0x63, 0x09, 0x60, 0xef, 0xfe, 0x4a, 0x2e, 0x64, 0x9a, 0x35, 0x74, 0xbf, 0x97, 0x57, 0x07, 0x90,
+	0x40, 0x86, 0x2b, 0x9c, 0xe1, 0x22, 0x29, 0x24, 0x33, 0x64, 0xb7, 0x60, 0x16, 0x1b, 0x0f, 0xc7,
+	0x5c, 0x6f, 0x53, 0xcf, 0xa6, 0xe4, 0x5b, 0xb3, 0x7c, 0x75, 0x18, 0x51, 0xa4, 0x7d, 0x96, 0xd3,
+	0x5e, 0x26, 0x4b, 0xe9, 0x7b, 0x95, 0x27, 0x3a, 0x73, 0x71, 0x17, 0x5e, 0x32, 0x18, 0x83, 0xc8,
+	0x2d, 0x5b, 0x7e, 0x7b, 0x28, 0x59, 0xa4, 0xbf, 0xca, 0xe9, 0x9f, 0x25, 0x67, 0xb2, 0x86, 0x1a,
+	0x97, 0xfc, 0x54, 0x82, 0xe9, 0xd0, 0xc5, 0x80, 0xac, 0x66, 0x9c, 0xdf, 0xd0, 0x32, 0xc7, 0x92,
+	0x98, 0x7b, 0x87, 0x72, 0x8a, 0x33, 0x3d, 0x41, 0x16, 0x52, 0xb2, 0x19, 0xf2, 0x13, 0x09, 0xa6,
+	0x82, 0xd3, 0x98, 0x94, 0xb2, 0x9e, 0xdb, 0x3e, 0xb7, 0x0b, 0xd9, 0x05, 0xb2, 0xef, 0xad, 0xbd,
+	0x23, 0x9e, 0xfc, 0x5a, 0x82, 0xd9, 0xee, 0x37, 0x1b, 0xa9, 0xf1, 0x20, 0xe1, 0x11, 0x88, 0xbc,
+	0x3e, 0xb0, 0x5c, 0xf6, 0xb4, 0xa4, 0xe7, 0xf1, 0x08, 0xf9, 0x9d, 0x04, 0xb9, 0x2e, 0xc4, 0xd4,
+	0xa3, 0x3d, 0xfe, 0x7d, 0x88, 0xbc, 0x36, 0xa8, 0x18, 0xf2, 0xbe, 0xc6, 0x79, 0xaf, 0x91, 0x4b,
+	0x03, 0xf0, 0xde, 0x3b, 0x0c, 0x59, 0x6e, 0xd2, 0xf5, 0xf8, 0x22, 0x5d, 0x81, 0xd8, 0xc7, 0x1c,
+	0xf2, 0xda, 0xa0, 0x62, 0xd9, 0x73, 0x93, 0xee, 0x37, 0x20, 0x22, 0x1d, 0xef, 0x7d, 0xd8, 0x90,
+	0x9e, 0x8e, 0x27, 0xbe, 0xd0, 0x90, 0xaf, 0x0e, 0x23, 0x3a, 0x40, 0x3a, 0x1e, 0x55, 0x01, 0x8f,
+	0xf7, 0x97, 0x12, 0xe4, 0x93, 0x5e, 0x24, 0x90, 0x1b, 0x69, 0x8c, 0xfa, 0x3f, 0x79, 0x90, 0x6f,
+	0x0e, 0x2d, 0x8f, 0x6a, 0x5d, 0xe7, 0x6a, 0xad, 0x93, 0xcb, 0x7d, 0xd4, 0xf2, 0x31, 0x2a, 0xac,
+	0x50, 0x5e, 0x71, 0x05, 0x4a, 0x85, 0x3d, 0x7e, 0x20, 0xff, 0x96, 0x60, 0x21, 0xe5, 0x21, 0x03,
+	0xb9, 0x33, 0x04, 0xc7, 0x98, 0x8c, 0xfe, 0xee, 0x7f, 0x0b, 0x83, 0x1a, 0xdf, 0xe1, 0x1a, 0xdf,
+	0x24, 0xd7, 0x07, 0xd6, 0x38, 0x9c, 0xf1, 0x6f, 0x3c, 0xfd, 0xe4, 0x55, 0x41, 0xfa, 0xf4, 0x55,
+	0x41, 0xfa, 0xfb, 0xab, 0x82, 0xf4, 0x83, 0xd7, 0x85, 0x7d, 0x9f, 0xbe, 0x2e, 0xec, 0xfb, 0xdb,
+	0xeb, 0xc2, 0xbe, 0xaf, 0xdd, 0xaf, 0x1b, 0x5e, 0xa3, 0x5d, 0x2d, 0x6a, 0x56, 0xab, 0xb4, 0xe5,
+	0x4f, 0x71, 0x9f, 0x56, 0xdd, 0xbd, 0x09, 0xcf, 0x6b, 0x96, 0xa3, 0x87, 0x7f, 0xd9, 0x84, 0x98,
+	0x15, 0xba, 0x3e, 0x1b, 0x56, 0x34, 0x75, 0xab, 0x13, 0xfc, 0xc5, 0xde, 0xc5, 0xff, 0x0c, 0x00,
+	0x34, 0x7c, 0xb4, 0xfd, 0x76, 0x28, 0x00, 0x00,
 }
 
-func (m *QueryBandIBCPriceStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Retrieves oracle params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Retrieves the band relayers
+	BandRelayers(ctx context.Context, in *QueryBandRelayersRequest, opts ...grpc.CallOption) (*QueryBandRelayersResponse, error)
+	// Retrieves the state for all band price feeds
+	BandPriceStates(ctx context.Context, in *QueryBandPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandPriceStatesResponse, error)
+	// Retrieves the state for all band ibc price feeds
+	BandIBCPriceStates(ctx context.Context, in *QueryBandIBCPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandIBCPriceStatesResponse, error)
+	// Retrieves the state for all price feeds
+	PriceFeedPriceStates(ctx context.Context, in *QueryPriceFeedPriceStatesRequest, opts ...grpc.CallOption) (*QueryPriceFeedPriceStatesResponse, error)
+	// Retrieves the state for all coinbase price feeds
+	CoinbasePriceStates(ctx context.Context, in *QueryCoinbasePriceStatesRequest, opts ...grpc.CallOption) (*QueryCoinbasePriceStatesResponse, error)
+	// Retrieves the state for all pyth price feeds
+	PythPriceStates(ctx context.Context, in *QueryPythPriceStatesRequest, opts ...grpc.CallOption) (*QueryPythPriceStatesResponse, error)
+	// Retrieves the state for all stork price feeds
+	StorkPriceStates(ctx context.Context, in *QueryStorkPriceStatesRequest, opts ...grpc.CallOption) (*QueryStorkPriceStatesResponse, error)
+	// Retrieves all stork publishers
+	StorkPublishers(ctx context.Context, in *QueryStorkPublishersRequest, opts ...grpc.CallOption) (*QueryStorkPublishersResponse, error)
+	// Retrieves the state for all provider price feeds
+	ProviderPriceState(ctx context.Context, in *QueryProviderPriceStateRequest, opts ...grpc.CallOption) (*QueryProviderPriceStateResponse, error)
+	// Retrieves the entire oracle module's state
+	OracleModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
+	// Retrieves historical price records for a given OracleType and Symbol
+	HistoricalPriceRecords(ctx context.Context, in *QueryHistoricalPriceRecordsRequest, opts ...grpc.CallOption) (*QueryHistoricalPriceRecordsResponse, error)
+	// Retrieves mixed volatility value for the specified pair of base/quote
+	OracleVolatility(ctx context.Context, in *QueryOracleVolatilityRequest, opts ...grpc.CallOption) (*QueryOracleVolatilityResponse, error)
+	// Retrieves the time-weighted average price of the specified pair of
+	// base/quote over a time window
+	OracleTWAP(ctx context.Context, in *QueryOracleTWAPRequest, opts ...grpc.CallOption) (*QueryOracleTWAPResponse, error)
+	OracleProvidersInfo(ctx context.Context, in *QueryOracleProvidersInfoRequest, opts ...grpc.CallOption) (*QueryOracleProvidersInfoResponse, error)
+	OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error)
+	OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error)
+	PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error)
+	// Retrieves all composite oracles
+	CompositeOracles(ctx context.Context, in *QueryCompositeOraclesRequest, opts ...grpc.CallOption) (*QueryCompositeOraclesResponse, error)
+	// Retrieves a composite oracle along with its last resolved price
+	CompositeOracle(ctx context.Context, in *QueryCompositeOracleRequest, opts ...grpc.CallOption) (*QueryCompositeOracleResponse, error)
+	// Retrieves all circuit breakers and the symbols they currently halt
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
+	// Retrieves the circuit breaker state of a symbol
+	CircuitBreakerState(ctx context.Context, in *QueryCircuitBreakerStateRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerStateResponse, error)
+	// Retrieves the registered Chainlink Data Streams DON signer sets
+	ChainlinkDataStreamsDONs(ctx context.Context, in *QueryChainlinkDataStreamsDONsRequest, opts ...grpc.CallOption) (*QueryChainlinkDataStreamsDONsResponse, error)
+	// Retrieves the state for all Chainlink Data Streams price feeds
+	ChainlinkDataStreamsPriceStates(ctx context.Context, in *QueryChainlinkDataStreamsPriceStatesRequest, opts ...grpc.CallOption) (*QueryChainlinkDataStreamsPriceStatesResponse, error)
 }
 
-func (m *QueryBandIBCPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryBandIBCPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPriceFeedPriceStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BandRelayers(ctx context.Context, in *QueryBandRelayersRequest, opts ...grpc.CallOption) (*QueryBandRelayersResponse, error) {
+	out := new(QueryBandRelayersResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/BandRelayers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPriceFeedPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BandPriceStates(ctx context.Context, in *QueryBandPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandPriceStatesResponse, error) {
+	out := new(QueryBandPriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/BandPriceStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPriceFeedPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) BandIBCPriceStates(ctx context.Context, in *QueryBandIBCPriceStatesRequest, opts ...grpc.CallOption) (*QueryBandIBCPriceStatesResponse, error) {
+	out := new(QueryBandIBCPriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/BandIBCPriceStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPriceFeedPriceStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PriceFeedPriceStates(ctx context.Context, in *QueryPriceFeedPriceStatesRequest, opts ...grpc.CallOption) (*QueryPriceFeedPriceStatesResponse, error) {
+	out := new(QueryPriceFeedPriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PriceFeedPriceStates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPriceFeedPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CoinbasePriceStates(ctx context.Context, in *QueryCoinbasePriceStatesRequest, opts ...grpc.CallOption) (*QueryCoinbasePriceStatesResponse, error) {
+	out := new(QueryCoinbasePriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CoinbasePriceStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPriceFeedPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PythPriceStates(ctx context.Context, in *QueryPythPriceStatesRequest, opts ...grpc.CallOption) (*QueryPythPriceStatesResponse, error) {
+	out := new(QueryPythPriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PythPriceStates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCoinbasePriceStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorkPriceStates(ctx context.Context, in *QueryStorkPriceStatesRequest, opts ...grpc.CallOption) (*QueryStorkPriceStatesResponse, error) {
+	out := new(QueryStorkPriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/StorkPriceStates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCoinbasePriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorkPublishers(ctx context.Context, in *QueryStorkPublishersRequest, opts ...grpc.CallOption) (*QueryStorkPublishersResponse, error) {
+	out := new(QueryStorkPublishersResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/StorkPublishers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCoinbasePriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) ProviderPriceState(ctx context.Context, in *QueryProviderPriceStateRequest, opts ...grpc.CallOption) (*QueryProviderPriceStateResponse, error) {
+	out := new(QueryProviderPriceStateResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/ProviderPriceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCoinbasePriceStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
+	out := new(QueryModuleStateResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleModuleState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCoinbasePriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryCoinbasePriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) HistoricalPriceRecords(ctx context.Context, in *QueryHistoricalPriceRecordsRequest, opts ...grpc.CallOption) (*QueryHistoricalPriceRecordsResponse, error) {
+	out := new(QueryHistoricalPriceRecordsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/HistoricalPriceRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPythPriceStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleVolatility(ctx context.Context, in *QueryOracleVolatilityRequest, opts ...grpc.CallOption) (*QueryOracleVolatilityResponse, error) {
+	out := new(QueryOracleVolatilityResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleVolatility", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPythPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPythPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPythPriceStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleTWAP(ctx context.Context, in *QueryOracleTWAPRequest, opts ...grpc.CallOption) (*QueryOracleTWAPResponse, error) {
+	out := new(QueryOracleTWAPResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleTWAP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPythPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPythPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) OracleProvidersInfo(ctx context.Context, in *QueryOracleProvidersInfoRequest, opts ...grpc.CallOption) (*QueryOracleProvidersInfoResponse, error) {
+	out := new(QueryOracleProvidersInfoResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleProvidersInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorkPriceStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error) {
+	out := new(QueryOracleProviderPricesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OracleProviderPrices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStorkPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryStorkPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorkPriceStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error) {
+	out := new(QueryOraclePriceResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/OraclePrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStorkPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryStorkPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error) {
+	out := new(QueryPythPriceResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PythPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorkPublishersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CompositeOracles(ctx context.Context, in *QueryCompositeOraclesRequest, opts ...grpc.CallOption) (*QueryCompositeOraclesResponse, error) {
+	out := new(QueryCompositeOraclesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CompositeOracles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStorkPublishersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryStorkPublishersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStorkPublishersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CompositeOracle(ctx context.Context, in *QueryCompositeOracleRequest, opts ...grpc.CallOption) (*QueryCompositeOracleResponse, error) {
+	out := new(QueryCompositeOracleResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CompositeOracle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStorkPublishersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryStorkPublishersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Publishers) > 0 {
-		for iNdEx := len(m.Publishers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Publishers[iNdEx])
-			copy(dAtA[i:], m.Publishers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Publishers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakersRequest, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProviderPriceStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CircuitBreakerState(ctx context.Context, in *QueryCircuitBreakerStateRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerStateResponse, error) {
+	out := new(QueryCircuitBreakerStateResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/CircuitBreakerState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryProviderPriceStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryProviderPriceStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ChainlinkDataStreamsDONs(ctx context.Context, in *QueryChainlinkDataStreamsDONsRequest, opts ...grpc.CallOption) (*QueryChainlinkDataStreamsDONsResponse, error) {
+	out := new(QueryChainlinkDataStreamsDONsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/ChainlinkDataStreamsDONs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProviderPriceStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainlinkDataStreamsPriceStates(ctx context.Context, in *QueryChainlinkDataStreamsPriceStatesRequest, opts ...grpc.CallOption) (*QueryChainlinkDataStreamsPriceStatesResponse, error) {
+	out := new(QueryChainlinkDataStreamsPriceStatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/ChainlinkDataStreamsPriceStates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryProviderPriceStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryProviderPriceStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PriceState != nil {
-		{
-			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Retrieves oracle params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Retrieves the band relayers
+	BandRelayers(context.Context, *QueryBandRelayersRequest) (*QueryBandRelayersResponse, error)
+	// Retrieves the state for all band price feeds
+	BandPriceStates(context.Context, *QueryBandPriceStatesRequest) (*QueryBandPriceStatesResponse, error)
+	// Retrieves the state for all band ibc price feeds
+	BandIBCPriceStates(context.Context, *QueryBandIBCPriceStatesRequest) (*QueryBandIBCPriceStatesResponse, error)
+	// Retrieves the state for all price feeds
+	PriceFeedPriceStates(context.Context, *QueryPriceFeedPriceStatesRequest) (*QueryPriceFeedPriceStatesResponse, error)
+	// Retrieves the state for all coinbase price feeds
+	CoinbasePriceStates(context.Context, *QueryCoinbasePriceStatesRequest) (*QueryCoinbasePriceStatesResponse, error)
+	// Retrieves the state for all pyth price feeds
+	PythPriceStates(context.Context, *QueryPythPriceStatesRequest) (*QueryPythPriceStatesResponse, error)
+	// Retrieves the state for all stork price feeds
+	StorkPriceStates(context.Context, *QueryStorkPriceStatesRequest) (*QueryStorkPriceStatesResponse, error)
+	// Retrieves all stork publishers
+	StorkPublishers(context.Context, *QueryStorkPublishersRequest) (*QueryStorkPublishersResponse, error)
+	// Retrieves the state for all provider price feeds
+	ProviderPriceState(context.Context, *QueryProviderPriceStateRequest) (*QueryProviderPriceStateResponse, error)
+	// Retrieves the entire oracle module's state
+	OracleModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
+	// Retrieves historical price records for a given OracleType and Symbol
+	HistoricalPriceRecords(context.Context, *QueryHistoricalPriceRecordsRequest) (*QueryHistoricalPriceRecordsResponse, error)
+	// Retrieves mixed volatility value for the specified pair of base/quote
+	OracleVolatility(context.Context, *QueryOracleVolatilityRequest) (*QueryOracleVolatilityResponse, error)
+	// Retrieves the time-weighted average price of the specified pair of
+	// base/quote over a time window
+	OracleTWAP(context.Context, *QueryOracleTWAPRequest) (*QueryOracleTWAPResponse, error)
+	OracleProvidersInfo(context.Context, *QueryOracleProvidersInfoRequest) (*QueryOracleProvidersInfoResponse, error)
+	OracleProviderPrices(context.Context, *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error)
+	OraclePrice(context.Context, *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error)
+	PythPrice(context.Context, *QueryPythPriceRequest) (*QueryPythPriceResponse, error)
+	// Retrieves all composite oracles
+	CompositeOracles(context.Context, *QueryCompositeOraclesRequest) (*QueryCompositeOraclesResponse, error)
+	// Retrieves a composite oracle along with its last resolved price
+	CompositeOracle(context.Context, *QueryCompositeOracleRequest) (*QueryCompositeOracleResponse, error)
+	// Retrieves all circuit breakers and the symbols they currently halt
+	CircuitBreakers(context.Context, *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error)
+	// Retrieves the circuit breaker state of a symbol
+	CircuitBreakerState(context.Context, *QueryCircuitBreakerStateRequest) (*QueryCircuitBreakerStateResponse, error)
+	// Retrieves the registered Chainlink Data Streams DON signer sets
+	ChainlinkDataStreamsDONs(context.Context, *QueryChainlinkDataStreamsDONsRequest) (*QueryChainlinkDataStreamsDONsResponse, error)
+	// Retrieves the state for all Chainlink Data Streams price feeds
+	ChainlinkDataStreamsPriceStates(context.Context, *QueryChainlinkDataStreamsPriceStatesRequest) (*QueryChainlinkDataStreamsPriceStatesResponse, error)
 }
 
-func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BandRelayers(ctx context.Context, req *QueryBandRelayersRequest) (*QueryBandRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BandRelayers not implemented")
+}
+func (*UnimplementedQueryServer) BandPriceStates(ctx context.Context, req *QueryBandPriceStatesRequest) (*QueryBandPriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BandPriceStates not implemented")
+}
+func (*UnimplementedQueryServer) BandIBCPriceStates(ctx context.Context, req *QueryBandIBCPriceStatesRequest) (*QueryBandIBCPriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BandIBCPriceStates not implemented")
+}
+func (*UnimplementedQueryServer) PriceFeedPriceStates(ctx context.Context, req *QueryPriceFeedPriceStatesRequest) (*QueryPriceFeedPriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceFeedPriceStates not implemented")
+}
+func (*UnimplementedQueryServer) CoinbasePriceStates(ctx context.Context, req *QueryCoinbasePriceStatesRequest) (*QueryCoinbasePriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoinbasePriceStates not implemented")
+}
+func (*UnimplementedQueryServer) PythPriceStates(ctx context.Context, req *QueryPythPriceStatesRequest) (*QueryPythPriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PythPriceStates not implemented")
+}
+func (*UnimplementedQueryServer) StorkPriceStates(ctx context.Context, req *QueryStorkPriceStatesRequest) (*QueryStorkPriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorkPriceStates not implemented")
+}
+func (*UnimplementedQueryServer) StorkPublishers(ctx context.Context, req *QueryStorkPublishersRequest) (*QueryStorkPublishersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorkPublishers not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPriceState(ctx context.Context, req *QueryProviderPriceStateRequest) (*QueryProviderPriceStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPriceState not implemented")
+}
+func (*UnimplementedQueryServer) OracleModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleModuleState not implemented")
+}
+func (*UnimplementedQueryServer) HistoricalPriceRecords(ctx context.Context, req *QueryHistoricalPriceRecordsRequest) (*QueryHistoricalPriceRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalPriceRecords not implemented")
+}
+func (*UnimplementedQueryServer) OracleVolatility(ctx context.Context, req *QueryOracleVolatilityRequest) (*QueryOracleVolatilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleVolatility not implemented")
+}
+func (*UnimplementedQueryServer) OracleTWAP(ctx context.Context, req *QueryOracleTWAPRequest) (*QueryOracleTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleTWAP not implemented")
+}
+func (*UnimplementedQueryServer) OracleProvidersInfo(ctx context.Context, req *QueryOracleProvidersInfoRequest) (*QueryOracleProvidersInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleProvidersInfo not implemented")
+}
+func (*UnimplementedQueryServer) OracleProviderPrices(ctx context.Context, req *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleProviderPrices not implemented")
+}
+func (*UnimplementedQueryServer) OraclePrice(ctx context.Context, req *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OraclePrice not implemented")
+}
+func (*UnimplementedQueryServer) PythPrice(ctx context.Context, req *QueryPythPriceRequest) (*QueryPythPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PythPrice not implemented")
+}
+func (*UnimplementedQueryServer) CompositeOracles(ctx context.Context, req *QueryCompositeOraclesRequest) (*QueryCompositeOraclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompositeOracles not implemented")
+}
+func (*UnimplementedQueryServer) CompositeOracle(ctx context.Context, req *QueryCompositeOracleRequest) (*QueryCompositeOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompositeOracle not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *QueryCircuitBreakersRequest) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakerState(ctx context.Context, req *QueryCircuitBreakerStateRequest) (*QueryCircuitBreakerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakerState not implemented")
+}
+func (*UnimplementedQueryServer) ChainlinkDataStreamsDONs(ctx context.Context, req *QueryChainlinkDataStreamsDONsRequest) (*QueryChainlinkDataStreamsDONsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainlinkDataStreamsDONs not implemented")
+}
+func (*UnimplementedQueryServer) ChainlinkDataStreamsPriceStates(ctx context.Context, req *QueryChainlinkDataStreamsPriceStatesRequest) (*QueryChainlinkDataStreamsPriceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainlinkDataStreamsPriceStates not implemented")
 }
 
-func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_BandRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBandRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BandRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/BandRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BandRelayers(ctx, req.(*QueryBandRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BandPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBandPriceStatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BandPriceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/BandPriceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BandPriceStates(ctx, req.(*QueryBandPriceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BandIBCPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBandIBCPriceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BandIBCPriceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/BandIBCPriceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BandIBCPriceStates(ctx, req.(*QueryBandIBCPriceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SymbolId) > 0 {
-		i -= len(m.SymbolId)
-		copy(dAtA[i:], m.SymbolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SymbolId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_PriceFeedPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceFeedPriceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Oracle != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Oracle))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).PriceFeedPriceStates(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/PriceFeedPriceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceFeedPriceStates(ctx, req.(*QueryPriceFeedPriceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHistoricalPriceRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CoinbasePriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoinbasePriceStatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CoinbasePriceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CoinbasePriceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoinbasePriceStates(ctx, req.(*QueryCoinbasePriceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHistoricalPriceRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryHistoricalPriceRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceRecords) > 0 {
-		for iNdEx := len(m.PriceRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_PythPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPythPriceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PythPriceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/PythPriceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PythPriceStates(ctx, req.(*QueryPythPriceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OracleHistoryOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StorkPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorkPriceStatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).StorkPriceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/StorkPriceStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorkPriceStates(ctx, req.(*QueryStorkPriceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OracleHistoryOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StorkPublishers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorkPublishersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorkPublishers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/StorkPublishers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorkPublishers(ctx, req.(*QueryStorkPublishersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OracleHistoryOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeMetadata {
-		i--
-		if m.IncludeMetadata {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func _Query_ProviderPriceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPriceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IncludeRawHistory {
-		i--
-		if m.IncludeRawHistory {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPriceState(ctx, in)
 	}
-	if m.MaxAge != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAge))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/ProviderPriceState",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPriceState(ctx, req.(*QueryProviderPriceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleVolatilityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OracleModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OracleModuleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OracleModuleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleModuleState(ctx, req.(*QueryModuleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleVolatilityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HistoricalPriceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalPriceRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalPriceRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/HistoricalPriceRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalPriceRecords(ctx, req.(*QueryHistoricalPriceRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleVolatilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OracleHistoryOptions != nil {
-		{
-			size, err := m.OracleHistoryOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_OracleVolatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleVolatilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.QuoteInfo != nil {
-		{
-			size, err := m.QuoteInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).OracleVolatility(ctx, in)
 	}
-	if m.BaseInfo != nil {
-		{
-			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OracleVolatility",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleVolatility(ctx, req.(*QueryOracleVolatilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleVolatilityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OracleTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleTWAPRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OracleTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OracleTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleTWAP(ctx, req.(*QueryOracleTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleVolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OracleProvidersInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleProvidersInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleProvidersInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OracleProvidersInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleProvidersInfo(ctx, req.(*QueryOracleProvidersInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleVolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RawHistory) > 0 {
-		for iNdEx := len(m.RawHistory) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Query_OracleProviderPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleProviderPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.HistoryMetadata != nil {
-		{
-			size, err := m.HistoryMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).OracleProviderPrices(ctx, in)
 	}
-	if m.Volatility != nil {
-		{
-			size := m.Volatility.Size()
-			i -= size
-			if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OracleProviderPrices",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleProviderPrices(ctx, req.(*QueryOracleProviderPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleProvidersInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OraclePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOraclePriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleProvidersInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOracleProvidersInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OraclePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/OraclePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OraclePrice(ctx, req.(*QueryOraclePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleProvidersInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PythPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPythPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleProvidersInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOracleProvidersInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PythPrice(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/PythPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PythPrice(ctx, req.(*QueryPythPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleProviderPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CompositeOracles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompositeOraclesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleProviderPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOracleProviderPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).CompositeOracles(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CompositeOracles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompositeOracles(ctx, req.(*QueryCompositeOraclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOracleProviderPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CompositeOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCompositeOracleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryOracleProviderPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOracleProviderPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderState) > 0 {
-		for iNdEx := len(m.ProviderState) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProviderState[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).CompositeOracle(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CompositeOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CompositeOracle(ctx, req.(*QueryCompositeOracleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ScalingOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ScalingOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ScalingOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QuoteDecimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteDecimals))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
 	}
-	if m.BaseDecimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BaseDecimals))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CircuitBreakers",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOraclePriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CircuitBreakerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/CircuitBreakerState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakerState(ctx, req.(*QueryCircuitBreakerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOraclePriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainlinkDataStreamsDONs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainlinkDataStreamsDONsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainlinkDataStreamsDONs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/ChainlinkDataStreamsDONs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainlinkDataStreamsDONs(ctx, req.(*QueryChainlinkDataStreamsDONsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOraclePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScalingOptions != nil {
-		{
-			size, err := m.ScalingOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func _Query_ChainlinkDataStreamsPriceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainlinkDataStreamsPriceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Quote) > 0 {
-		i -= len(m.Quote)
-		copy(dAtA[i:], m.Quote)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).ChainlinkDataStreamsPriceStates(ctx, in)
 	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/ChainlinkDataStreamsPriceStates",
 	}
-	if m.OracleType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OracleType))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainlinkDataStreamsPriceStates(ctx, req.(*QueryChainlinkDataStreamsPriceStatesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PricePairState) Marshal() (dAtA []byte, err error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.oracle.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BandRelayers",
+			Handler:    _Query_BandRelayers_Handler,
+		},
+		{
+			MethodName: "BandPriceStates",
+			Handler:    _Query_BandPriceStates_Handler,
+		},
+		{
+			MethodName: "BandIBCPriceStates",
+			Handler:    _Query_BandIBCPriceStates_Handler,
+		},
+		{
+			MethodName: "PriceFeedPriceStates",
+			Handler:    _Query_PriceFeedPriceStates_Handler,
+		},
+		{
+			MethodName: "CoinbasePriceStates",
+			Handler:    _Query_CoinbasePriceStates_Handler,
+		},
+		{
+			MethodName: "PythPriceStates",
+			Handler:    _Query_PythPriceStates_Handler,
+		},
+		{
+			MethodName: "StorkPriceStates",
+			Handler:    _Query_StorkPriceStates_Handler,
+		},
+		{
+			MethodName: "StorkPublishers",
+			Handler:    _Query_StorkPublishers_Handler,
+		},
+		{
+			MethodName: "ProviderPriceState",
+			Handler:    _Query_ProviderPriceState_Handler,
+		},
+		{
+			MethodName: "OracleModuleState",
+			Handler:    _Query_OracleModuleState_Handler,
+		},
+		{
+			MethodName: "HistoricalPriceRecords",
+			Handler:    _Query_HistoricalPriceRecords_Handler,
+		},
+		{
+			MethodName: "OracleVolatility",
+			Handler:    _Query_OracleVolatility_Handler,
+		},
+		{
+			MethodName: "OracleTWAP",
+			Handler:    _Query_OracleTWAP_Handler,
+		},
+		{
+			MethodName: "OracleProvidersInfo",
+			Handler:    _Query_OracleProvidersInfo_Handler,
+		},
+		{
+			MethodName: "OracleProviderPrices",
+			Handler:    _Query_OracleProviderPrices_Handler,
+		},
+		{
+			MethodName: "OraclePrice",
+			Handler:    _Query_OraclePrice_Handler,
+		},
+		{
+			MethodName: "PythPrice",
+			Handler:    _Query_PythPrice_Handler,
+		},
+		{
+			MethodName: "CompositeOracles",
+			Handler:    _Query_CompositeOracles_Handler,
+		},
+		{
+			MethodName: "CompositeOracle",
+			Handler:    _Query_CompositeOracle_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+		{
+			MethodName: "CircuitBreakerState",
+			Handler:    _Query_CircuitBreakerState_Handler,
+		},
+		{
+			MethodName: "ChainlinkDataStreamsDONs",
+			Handler:    _Query_ChainlinkDataStreamsDONs_Handler,
+		},
+		{
+			MethodName: "ChainlinkDataStreamsPriceStates",
+			Handler:    _Query_ChainlinkDataStreamsPriceStates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/oracle/v1beta1/query.proto",
+}
+
+func (m *QueryPythPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3765,80 +3611,27 @@ func (m *PricePairState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PricePairState) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPythPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PricePairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPythPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.QuoteTimestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteTimestamp))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.BaseTimestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BaseTimestamp))
+	if len(m.PriceId) > 0 {
+		i -= len(m.PriceId)
+		copy(dAtA[i:], m.PriceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceId)))
 		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.QuoteCumulativePrice.Size()
-		i -= size
-		if _, err := m.QuoteCumulativePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BaseCumulativePrice.Size()
-		i -= size
-		if _, err := m.BaseCumulativePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.QuotePrice.Size()
-		i -= size
-		if _, err := m.QuotePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BasePrice.Size()
-		i -= size
-		if _, err := m.BasePrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.PairPrice.Size()
-		i -= size
-		if _, err := m.PairPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOraclePriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPythPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3848,19 +3641,19 @@ func (m *QueryOraclePriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOraclePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPythPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOraclePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPythPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PricePairState != nil {
+	if m.PriceState != nil {
 		{
-			size, err := m.PricePairState.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3873,536 +3666,3573 @@ func (m *QueryOraclePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCompositeOraclesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPythPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PriceId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryCompositeOraclesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPythPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCompositeOraclesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PriceState != nil {
-		l = m.PriceState.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCompositeOraclesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBandRelayersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCompositeOraclesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBandRelayersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCompositeOraclesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.CompositeOracles) > 0 {
+		for iNdEx := len(m.CompositeOracles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompositeOracles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBandPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCompositeOracleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBandPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCompositeOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompositeOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBandIBCPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCompositeOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBandIBCPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCompositeOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCompositeOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PriceState != nil {
+		{
+			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.CompositeOracle != nil {
+		{
+			size, err := m.CompositeOracle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPriceFeedPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPriceFeedPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryCircuitBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCoinbasePriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCircuitBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCoinbasePriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPythPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPythPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Halts) > 0 {
+		for iNdEx := len(m.Halts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Halts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorkPriceStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakerStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStorkPriceStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PriceStates) > 0 {
-		for _, e := range m.PriceStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryCircuitBreakerStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorkPublishersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCircuitBreakerStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorkPublishersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCircuitBreakerStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Publishers) > 0 {
-		for _, s := range m.Publishers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QueryProviderPriceStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Halt != nil {
+		{
+			size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProviderPriceStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PriceState != nil {
-		l = m.PriceState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryChainlinkDataStreamsDONsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryModuleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChainlinkDataStreamsDONsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainlinkDataStreamsDONsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainlinkDataStreamsDONsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainlinkDataStreamsDONsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainlinkDataStreamsDONsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Dons) > 0 {
+		for iNdEx := len(m.Dons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHistoricalPriceRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Oracle != 0 {
-		n += 1 + sovQuery(uint64(m.Oracle))
-	}
-	l = len(m.SymbolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHistoricalPriceRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PriceRecords) > 0 {
-		for _, e := range m.PriceRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OracleHistoryOptions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxAge != 0 {
-		n += 1 + sovQuery(uint64(m.MaxAge))
-	}
-	if m.IncludeRawHistory {
-		n += 2
-	}
-	if m.IncludeMetadata {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOracleVolatilityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BaseInfo != nil {
-		l = m.BaseInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.QuoteInfo != nil {
-		l = m.QuoteInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandRelayersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.OracleHistoryOptions != nil {
-		l = m.OracleHistoryOptions.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandRelayersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandRelayersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandRelayersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOracleVolatilityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBandRelayersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandRelayersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandPriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Volatility != nil {
-		l = m.Volatility.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandPriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HistoryMetadata != nil {
-		l = m.HistoryMetadata.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if len(m.RawHistory) > 0 {
-		for _, e := range m.RawHistory {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandIBCPriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandIBCPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandIBCPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandIBCPriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandIBCPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandIBCPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceFeedPriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceFeedPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceFeedPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceFeedPriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceFeedPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceFeedPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinbasePriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinbasePriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinbasePriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoinbasePriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoinbasePriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoinbasePriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPythPriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPythPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPythPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPythPriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPythPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPythPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorkPriceStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorkPriceStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorkPriceStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorkPriceStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorkPriceStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorkPriceStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for iNdEx := len(m.PriceStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorkPublishersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorkPublishersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorkPublishersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorkPublishersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorkPublishersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorkPublishersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Publishers) > 0 {
+		for iNdEx := len(m.Publishers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Publishers[iNdEx])
+			copy(dAtA[i:], m.Publishers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Publishers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPriceStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPriceStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPriceStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPriceStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPriceStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPriceStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceState != nil {
+		{
+			size, err := m.PriceState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalPriceRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalPriceRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalPriceRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SymbolId) > 0 {
+		i -= len(m.SymbolId)
+		copy(dAtA[i:], m.SymbolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SymbolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Oracle != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalPriceRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalPriceRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalPriceRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceRecords) > 0 {
+		for iNdEx := len(m.PriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleHistoryOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleHistoryOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleHistoryOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncludeRawHistory {
+		i--
+		if m.IncludeRawHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleVolatilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleVolatilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleVolatilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleHistoryOptions != nil {
+		{
+			size, err := m.OracleHistoryOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QuoteInfo != nil {
+		{
+			size, err := m.QuoteInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseInfo != nil {
+		{
+			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleVolatilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleVolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleVolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawHistory) > 0 {
+		for iNdEx := len(m.RawHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HistoryMetadata != nil {
+		{
+			size, err := m.HistoryMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Volatility != nil {
+		{
+			size := m.Volatility.Size()
+			i -= size
+			if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxGap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QuoteInfo != nil {
+		{
+			size, err := m.QuoteInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseInfo != nil {
+		{
+			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Twap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleProvidersInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProvidersInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProvidersInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleProvidersInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProvidersInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProvidersInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleProviderPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProviderPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProviderPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleProviderPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleProviderPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleProviderPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderState) > 0 {
+		for iNdEx := len(m.ProviderState) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderState[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScalingOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteDecimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BaseDecimals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOraclePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOraclePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScalingOptions != nil {
+		{
+			size, err := m.ScalingOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricePairState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricePairState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuoteTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QuoteTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BaseTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BaseTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.QuoteCumulativePrice.Size()
+		i -= size
+		if _, err := m.QuoteCumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BaseCumulativePrice.Size()
+		i -= size
+		if _, err := m.BaseCumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.QuotePrice.Size()
+		i -= size
+		if _, err := m.QuotePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BasePrice.Size()
+		i -= size
+		if _, err := m.BasePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PairPrice.Size()
+		i -= size
+		if _, err := m.PairPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOraclePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOraclePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOraclePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PricePairState != nil {
+		{
+			size, err := m.PricePairState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPythPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPythPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCompositeOraclesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCompositeOraclesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CompositeOracles) > 0 {
+		for _, e := range m.CompositeOracles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCompositeOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCompositeOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompositeOracle != nil {
+		l = m.CompositeOracle.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Halts) > 0 {
+		for _, e := range m.Halts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovQuery(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCircuitBreakerStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Halt != nil {
+		l = m.Halt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryChainlinkDataStreamsDONsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainlinkDataStreamsDONsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dons) > 0 {
+		for _, e := range m.Dons {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBandRelayersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBandRelayersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBandPriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBandPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBandIBCPriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBandIBCPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceFeedPriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceFeedPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCoinbasePriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCoinbasePriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPythPriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPythPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorkPriceStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStorkPriceStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceStates) > 0 {
+		for _, e := range m.PriceStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorkPublishersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStorkPublishersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Publishers) > 0 {
+		for _, s := range m.Publishers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPriceStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPriceStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceState != nil {
+		l = m.PriceState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalPriceRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Oracle != 0 {
+		n += 1 + sovQuery(uint64(m.Oracle))
+	}
+	l = len(m.SymbolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoricalPriceRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceRecords) > 0 {
+		for _, e := range m.PriceRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleHistoryOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAge != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAge))
+	}
+	if m.IncludeRawHistory {
+		n += 2
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOracleVolatilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QuoteInfo != nil {
+		l = m.QuoteInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OracleHistoryOptions != nil {
+		l = m.OracleHistoryOptions.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleVolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Volatility != nil {
+		l = m.Volatility.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HistoryMetadata != nil {
+		l = m.HistoryMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RawHistory) > 0 {
+		for _, e := range m.RawHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QuoteInfo != nil {
+		l = m.QuoteInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.MaxGap != 0 {
+		n += 1 + sovQuery(uint64(m.MaxGap))
+	}
+	return n
+}
+
+func (m *QueryOracleTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleProvidersInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOracleProvidersInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOracleProviderPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleProviderPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderState) > 0 {
+		for _, e := range m.ProviderState {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScalingOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.BaseDecimals))
+	}
+	if m.QuoteDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.QuoteDecimals))
+	}
+	return n
+}
+
+func (m *QueryOraclePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovQuery(uint64(m.OracleType))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScalingOptions != nil {
+		l = m.ScalingOptions.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PricePairState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PairPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BasePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuotePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseCumulativePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteCumulativePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BaseTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.BaseTimestamp))
+	}
+	if m.QuoteTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.QuoteTimestamp))
+	}
+	return n
+}
+
+func (m *QueryOraclePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PricePairState != nil {
+		l = m.PricePairState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPythPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPythPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPythPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPythPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceState == nil {
+				m.PriceState = &PythPriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeOraclesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeOraclesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeOraclesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeOraclesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeOracles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompositeOracles = append(m.CompositeOracles, CompositeOracle{})
+			if err := m.CompositeOracles[len(m.CompositeOracles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeOracleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeOracleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCompositeOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCompositeOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCompositeOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeOracle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompositeOracle == nil {
+				m.CompositeOracle = &CompositeOracle{}
+			}
+			if err := m.CompositeOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceState == nil {
+				m.PriceState = &CompositePriceState{}
+			}
+			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Halts = append(m.Halts, CircuitBreakerHalt{})
+			if err := m.Halts[len(m.Halts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Halt == nil {
+				m.Halt = &CircuitBreakerHalt{}
+			}
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryOracleProvidersInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryOracleProvidersInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryChainlinkDataStreamsDONsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryOracleProviderPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryOracleProviderPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ProviderState) > 0 {
-		for _, e := range m.ProviderState {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsDONsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsDONsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *ScalingOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseDecimals != 0 {
-		n += 1 + sovQuery(uint64(m.BaseDecimals))
-	}
-	if m.QuoteDecimals != 0 {
-		n += 1 + sovQuery(uint64(m.QuoteDecimals))
-	}
-	return n
-}
-
-func (m *QueryOraclePriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OracleType != 0 {
-		n += 1 + sovQuery(uint64(m.OracleType))
-	}
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Quote)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ScalingOptions != nil {
-		l = m.ScalingOptions.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *PricePairState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PairPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BasePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QuotePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BaseCumulativePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QuoteCumulativePrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BaseTimestamp != 0 {
-		n += 1 + sovQuery(uint64(m.BaseTimestamp))
-	}
-	if m.QuoteTimestamp != 0 {
-		n += 1 + sovQuery(uint64(m.QuoteTimestamp))
-	}
-	return n
-}
 
-func (m *QueryOraclePriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PricePairState != nil {
-		l = m.PricePairState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChainlinkDataStreamsDONsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4425,17 +7255,17 @@ func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPythPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsDONsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPythPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsDONsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dons", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4445,23 +7275,25 @@ func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceId = string(dAtA[iNdEx:postIndex])
+			m.Dons = append(m.Dons, ChainlinkDataStreamsDON{})
+			if err := m.Dons[len(m.Dons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4484,7 +7316,57 @@ func (m *QueryPythPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChainlinkDataStreamsPriceStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsPriceStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsPriceStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainlinkDataStreamsPriceStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4507,15 +7389,15 @@ func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPythPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsPriceStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPythPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainlinkDataStreamsPriceStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4542,10 +7424,8 @@ func (m *QueryPythPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PriceState == nil {
-				m.PriceState = &PythPriceState{}
-			}
-			if err := m.PriceState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceStates = append(m.PriceStates, &ChainlinkDataStreamsPriceState{})
+			if err := m.PriceStates[len(m.PriceStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6715,6 +9595,268 @@ func (m *QueryOracleVolatilityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOracleTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseInfo == nil {
+				m.BaseInfo = &OracleInfo{}
+			}
+			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuoteInfo == nil {
+				m.QuoteInfo = &OracleInfo{}
+			}
+			if err := m.QuoteInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGap", wireType)
+			}
+			m.MaxGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryOracleProvidersInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38,6 +38,7 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSetOrchestratorAddress(),
 		GetUnsafeTestingCmd(),
 		NewCancelSendToEth(),
+		NewBumpSendToEthFee(),
 		BlacklistEthereumAddresses(),
 		RevokeBlacklistEthereumAddresses(),
 		CmdCreateRateLimit(),
@@ -176,6 +177,41 @@ func NewCancelSendToEth() *cobra.Command {
 	return cmd
 }
 
+func NewBumpSendToEthFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bump-send-to-eth-fee [id] [bridge-fee]",
+		Short: "Adds to the bridge fee of an unbatched send to eth",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "transaction id")
+			}
+
+			bridgeFee, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "bridge fee")
+			}
+
+			// Make the message
+			msg := types.NewMsgBumpSendToEthFee(cosmosAddr, id, bridgeFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatch() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "build-batch [denom]",
@@ -20,7 +20,8 @@ const OutgoingTxBatchSize = 100
 //   - find bridged denominator for given voucher type
 //   - determine if a an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 //     have a higher total fees. If not exit without creating a batch
-//   - select available transactions from the outgoing transaction pool sorted by fee desc
+//   - select available transactions from the outgoing transaction pool, transactions older than the
+//     priority inclusion age first, then sorted by fee desc
 //   - persist an outgoing batch object with an incrementing ID = nonce
 //   - emit an event
 func (k *Keeper) BuildOutgoingTXBatch(ctx sdk.Context, contractAddress common.Address, maxElements int) (*types.OutgoingTxBatch, error) {
@@ -39,7 +40,7 @@ func (k *Keeper) BuildOutgoingTXBatch(ctx sdk.Context, contractAddress common.Ad
 	if lastBatch != nil {
 		// this traverses the current tx pool for this token type and determines what
 		// fees a hypothetical batch would have if created
-		currentFees, err := k.GetBatchFeesByTokenType(ctx, contractAddress)
+		currentFees, err := k.GetBatchFeesByTokenType(ctx, contractAddress, maxElements)
 		if err != nil {
 			metrics.ReportFuncError(k.svcTags)
 			return nil, err
@@ -212,24 +213,19 @@ func (k *Keeper) pickUnbatchedTX(ctx sdk.Context, contractAddress common.Address
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
-	selectedTx := make([]*types.OutgoingTransferTx, 0)
-	var err error
-
-	k.IterateOutgoingPoolByFee(ctx, contractAddress, func(txID uint64, tx *types.OutgoingTransferTx) bool {
-		if tx != nil && tx.Erc20Fee != nil {
-			selectedTx = append(selectedTx, tx)
-			err = k.removeFromUnbatchedTXIndex(ctx, contractAddress, tx.Erc20Fee, txID)
-			return err != nil || len(selectedTx) == maxElements
-		} else {
-			// we found a nil, exit
-			return true
-		}
-	})
-
+	selectedTx := k.GetOutgoingTxQueue(ctx, contractAddress, maxElements)
 	if len(selectedTx) == 0 {
 		return nil, types.ErrNoUnbatchedTxsFound
 	}
 
+	for _, tx := range selectedTx {
+		if err := k.removeFromUnbatchedTXIndex(ctx, contractAddress, tx.Erc20Fee, tx.Id); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+		k.deleteUnbatchedTXTimeIndex(ctx, contractAddress, tx)
+	}
+
 	return selectedTx, nil
 }
 
@@ -268,6 +264,7 @@ func (k *Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract common.Add
 	for _, tx := range batch.Transactions {
 		tx.Erc20Fee.Contract = tokenContract.Hex()
 		k.prependToUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, tx.Id)
+		k.setUnbatchedTXTimeIndex(ctx, tokenContract, tx)
 	}
 
 	// Delete batch since it is finished
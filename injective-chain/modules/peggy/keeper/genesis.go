@@ -73,11 +73,20 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 		k.SetBatchConfirm(ctx, batchConfirm)
 	}
 
-	// reset pool transactions in state
+	// reset pool transactions in state and index them by fee and creation time. Transactions exported before
+	// their creation time was recorded are considered created at genesis, so that they can be prioritized.
 	for _, tx := range data.UnbatchedTransfers {
+		if tx.CreatedAt <= 0 {
+			tx.CreatedAt = ctx.BlockTime().Unix()
+		}
+
 		if err := k.setPoolEntry(ctx, tx); err != nil {
 			panic(err)
 		}
+
+		tokenContract := common.HexToAddress(tx.Erc20Token.Contract)
+		k.appendToUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, tx.Id)
+		k.setUnbatchedTXTimeIndex(ctx, tokenContract, tx)
 	}
 
 	// reset attestations in state
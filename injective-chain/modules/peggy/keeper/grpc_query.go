@@ -479,3 +479,45 @@ func (k *Keeper) MissingPeggoNonces(
 
 	return &types.MissingNoncesResponse{OperatorAddresses: res}, nil
 }
+
+// SendToEthQueuePosition returns the position of an outgoing transfer in its token's batch queue
+func (k *Keeper) SendToEthQueuePosition(c context.Context, req *types.QuerySendToEthQueuePositionRequest) (*types.QuerySendToEthQueuePositionResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.grpcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tx, err := k.getPoolEntry(ctx, req.TransactionId)
+	if err != nil {
+		metrics.ReportFuncError(k.grpcTags)
+		return nil, errors.Wrap(sdkerrors.ErrUnknownRequest, "Can not find outgoing tx")
+	}
+
+	tokenContract := gethcommon.HexToAddress(tx.Erc20Token.Contract)
+	res := &types.QuerySendToEthQueuePositionResponse{
+		TokenContract: tokenContract.Hex(),
+	}
+
+	queue := k.GetOutgoingTxQueue(ctx, tokenContract, 0)
+	res.QueueLength = uint64(len(queue))
+
+	for i, queuedTx := range queue {
+		if queuedTx.Id == tx.Id {
+			res.Position = uint64(i + 1)
+			res.Priority = k.isPriorityOutgoingTx(ctx, tx, k.GetParams(ctx).PriorityInclusionAge)
+			return res, nil
+		}
+	}
+
+	// txs stay in the pool while batched, so look for the batch containing it
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		for _, batchedTx := range batch.Transactions {
+			if batchedTx.Id == tx.Id {
+				res.BatchNonce = batch.BatchNonce
+				return res, nil
+			}
+		}
+	}
+
+	return res, nil
+}
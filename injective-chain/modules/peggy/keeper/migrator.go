@@ -28,3 +28,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 		m.keeper.cdc,
 	)
 }
+
+// Migrate2to3 migrates peggy's consensus version from 2 to 3. Specifically, it backfills the creation time
+// of the outgoing txs queued before it was recorded, and indexes the unbatched ones by creation time
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.backfillOutgoingTxCreationTimes(ctx)
+}
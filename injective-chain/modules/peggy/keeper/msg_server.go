@@ -506,6 +506,34 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 	return &types.MsgCancelSendToEthResponse{}, nil
 }
 
+func (k msgServer) BumpSendToEthFee(c context.Context, msg *types.MsgBumpSendToEthFee) (*types.MsgBumpSendToEthFeeResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	tx, err := k.BumpOutgoingTxFee(ctx, msg.TransactionId, sender, msg.BridgeFee)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventBumpSendToEthFee{
+		OutgoingTxId:   msg.TransactionId,
+		Sender:         msg.Sender,
+		BridgeFee:      msg.BridgeFee,
+		TotalBridgeFee: tx.Erc20Fee.Amount,
+	})
+
+	return &types.MsgBumpSendToEthFeeResponse{}, nil
+}
+
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
 	defer doneFn()
@@ -2,8 +2,8 @@ package keeper
 
 import (
 	"encoding/binary"
-	"math/big"
 	"sort"
+	"time"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -67,6 +67,7 @@ func (k *Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, count
 		DestAddress: counterpartReceiver.Hex(),
 		Erc20Token:  types.NewSDKIntERC20Token(amount.Amount, tokenContract),
 		Erc20Fee:    erc20Fee,
+		CreatedAt:   ctx.BlockTime().Unix(),
 	}
 
 	// set the outgoing tx in the pool index
@@ -76,6 +77,7 @@ func (k *Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, count
 
 	// add a second index with the fee
 	k.appendToUnbatchedTXIndex(ctx, tokenContract, erc20Fee, nextID)
+	k.setUnbatchedTXTimeIndex(ctx, tokenContract, outgoing)
 
 	// todo: add second index for sender so that we can easily query: give pending Tx by sender
 	// todo: what about a second index for receiver?
@@ -134,6 +136,7 @@ func (k *Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, s
 		metrics.ReportFuncError(k.svcTags)
 		return errors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
 	}
+	k.deleteUnbatchedTXTimeIndex(ctx, common.HexToAddress(tx.Erc20Token.Contract), tx)
 	k.removePoolEntry(ctx, txId)
 
 	// reissue the amount and the fee
@@ -180,6 +183,69 @@ func (k *Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, s
 	return nil
 }
 
+// BumpOutgoingTxFee
+// - checks that the provided tx is unbatched and belongs to the sender
+// - burns the voucher for the added fee
+// - increases the tx fee and moves it to its new place in the fee index
+func (k *Keeper) BumpOutgoingTxFee(ctx sdk.Context, txId uint64, sender sdk.AccAddress, fee sdk.Coin) (*types.OutgoingTransferTx, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	tx, err := k.getPoolEntry(ctx, txId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if tx.Sender != sender.String() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid, "Invalid sender address")
+	}
+
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, fee.Denom)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if isCosmosOriginated {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrUnsupported, "withdrawing Injective-native tokens is disabled")
+	}
+
+	if tokenContract != common.HexToAddress(tx.Erc20Fee.Contract) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid, "fee denom %s does not match the transferred token %s", fee.Denom, tx.Erc20Fee.Contract)
+	}
+
+	// only unbatched txs are in the fee index, so this also rejects batched ones
+	if err := k.removeFromUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, txId); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
+	}
+
+	feeInVouchers := sdk.Coins{fee}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, feeInVouchers); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, feeInVouchers); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		panic(err)
+	}
+
+	tx.Erc20Fee = types.NewSDKIntERC20Token(tx.Erc20Fee.Amount.Add(fee.Amount), tokenContract)
+	if err := k.setPoolEntry(ctx, tx); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	k.appendToUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, txId)
+
+	return tx, nil
+}
+
 // appendToUnbatchedTXIndex add at the end when tx with same fee exists
 func (k *Keeper) appendToUnbatchedTXIndex(ctx sdk.Context, tokenContract common.Address, fee *types.ERC20Token, txID uint64) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
@@ -248,6 +314,68 @@ func (k *Keeper) removeFromUnbatchedTXIndex(ctx sdk.Context, tokenContract commo
 	return errors.Wrap(types.ErrUnknown, "tx id")
 }
 
+// setUnbatchedTXTimeIndex indexes the unbatched tx by its creation time, so that the txs old enough to be
+// included ahead of higher fee txs are found without scanning the pool. Txs without a creation time are
+// not indexed, their creation time is backfilled by the store migration and on genesis import.
+func (k *Keeper) setUnbatchedTXTimeIndex(ctx sdk.Context, tokenContract common.Address, tx *types.OutgoingTransferTx) {
+	if tx.CreatedAt <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTimeSecondIndexKey(tokenContract, tx.CreatedAt, tx.Id), []byte{})
+}
+
+func (k *Keeper) deleteUnbatchedTXTimeIndex(ctx sdk.Context, tokenContract common.Address, tx *types.OutgoingTransferTx) {
+	if tx.CreatedAt <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTimeSecondIndexKey(tokenContract, tx.CreatedAt, tx.Id))
+}
+
+// backfillOutgoingTxCreationTimes sets the creation time of the outgoing txs queued before it was recorded
+// to the block time, and indexes the unbatched ones by creation time, so that they can be prioritized.
+// The txs of pending batches are backfilled too, so that they are indexed if their batch is canceled.
+func (k *Keeper) backfillOutgoingTxCreationTimes(ctx sdk.Context) error {
+	createdAt := ctx.BlockTime().Unix()
+
+	for _, tokenContract := range k.getUnbatchedTokenContracts(ctx) {
+		txs := make([]*types.OutgoingTransferTx, 0)
+		k.IterateOutgoingPoolByFee(ctx, tokenContract, func(_ uint64, tx *types.OutgoingTransferTx) bool {
+			if tx.CreatedAt <= 0 {
+				txs = append(txs, tx)
+			}
+			return false
+		})
+
+		for _, tx := range txs {
+			tx.CreatedAt = createdAt
+			if err := k.setPoolEntry(ctx, tx); err != nil {
+				return err
+			}
+			k.setUnbatchedTXTimeIndex(ctx, tokenContract, tx)
+		}
+	}
+
+	for _, batch := range k.GetOutgoingTxBatches(ctx) {
+		isBackfilled := false
+		for _, tx := range batch.Transactions {
+			if tx.CreatedAt <= 0 {
+				tx.CreatedAt = createdAt
+				isBackfilled = true
+			}
+		}
+
+		if isBackfilled {
+			k.StoreBatchUnsafe(ctx, batch)
+		}
+	}
+
+	return nil
+}
+
 func (k *Keeper) setPoolEntry(ctx sdk.Context, outgoingTransferTx *types.OutgoingTransferTx) error {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
@@ -354,15 +482,106 @@ func (k *Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, tokenContract common.
 	}
 }
 
+// IterateOutgoingPoolByCreationTime iterates over the unbatched txs of the given token created at or before
+// createdBefore, oldest first
+func (k *Keeper) IterateOutgoingPoolByCreationTime(
+	ctx sdk.Context,
+	tokenContract common.Address,
+	createdBefore int64,
+	cb func(*types.OutgoingTransferTx) bool,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	if createdBefore <= 0 {
+		return
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.SecondIndexOutgoingTXTimeKey, tokenContract.Bytes()...))
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(uint64(createdBefore)+1))
+
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// skip the creation time
+		txID := binary.BigEndian.Uint64(iter.Key()[8:])
+		tx, err := k.getPoolEntry(ctx, txID)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			panic("Invalid id in tx index!")
+		}
+
+		// cb returns true to stop early
+		if cb(tx) {
+			return
+		}
+	}
+}
+
+// GetOutgoingTxQueue returns the unbatched txs of the given token in the order they are picked into
+// batches: txs older than the priority inclusion age come first (oldest first), followed by the rest
+// sorted by fee desc. A non-positive limit returns the whole queue.
+func (k *Keeper) GetOutgoingTxQueue(ctx sdk.Context, tokenContract common.Address, limit int) []*types.OutgoingTransferTx {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	queue := make([]*types.OutgoingTransferTx, 0)
+	isFull := func() bool {
+		return limit > 0 && len(queue) == limit
+	}
+
+	priorityTxIDs := make(map[uint64]struct{})
+	if priorityAge := k.GetParams(ctx).PriorityInclusionAge; priorityAge > 0 {
+		k.IterateOutgoingPoolByCreationTime(ctx, tokenContract, priorityCutoff(ctx, priorityAge), func(tx *types.OutgoingTransferTx) bool {
+			queue = append(queue, tx)
+			priorityTxIDs[tx.Id] = struct{}{}
+			return isFull()
+		})
+	}
+
+	if isFull() {
+		return queue
+	}
+
+	k.IterateOutgoingPoolByFee(ctx, tokenContract, func(_ uint64, tx *types.OutgoingTransferTx) bool {
+		if tx == nil || tx.Erc20Fee == nil {
+			// we found a nil, exit
+			return true
+		}
+
+		if _, ok := priorityTxIDs[tx.Id]; !ok {
+			queue = append(queue, tx)
+		}
+
+		return isFull()
+	})
+
+	return queue
+}
+
+// isPriorityOutgoingTx reports whether the tx waited long enough to be included ahead of higher fee txs.
+// Txs without a creation time are not prioritized.
+func (k *Keeper) isPriorityOutgoingTx(ctx sdk.Context, tx *types.OutgoingTransferTx, priorityAge uint64) bool {
+	if priorityAge == 0 || tx.CreatedAt <= 0 {
+		return false
+	}
+
+	return tx.CreatedAt <= priorityCutoff(ctx, priorityAge)
+}
+
+// priorityCutoff returns the latest creation time of the txs that are old enough to be prioritized
+func priorityCutoff(ctx sdk.Context, priorityAge uint64) int64 {
+	return ctx.BlockTime().Add(-time.Duration(priorityAge) * time.Millisecond).Unix()
+}
+
 // GetBatchFeesByTokenType gets the fees the next batch of a given token type would
 // have if created. This info is both presented to relayers for the purpose of determining
 // when to request batches and also used by the batch creation process to decide not to create
 // a new batch
-func (k *Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address) (*types.BatchFees, error) {
+func (k *Keeper) GetBatchFeesByTokenType(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) (*types.BatchFees, error) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
-	return k.getBatchFeesForToken(ctx, tokenContractAddr)
+	return k.getBatchFeesForToken(ctx, tokenContractAddr, maxElements)
 }
 
 // GetAllBatchFees creates a fee entry for every batch type currently in the store
@@ -386,94 +605,70 @@ func (k *Keeper) GetAllBatchFees(ctx sdk.Context) (batchFees []*types.BatchFees)
 	return batchFees
 }
 
-func (k *Keeper) getBatchFeesForToken(ctx sdk.Context, tokenContractAddr common.Address) (*types.BatchFees, error) {
+// getBatchFeesForToken sums the fees of the txs the next batch of the token would include, taken from
+// the same queue the batch is built from
+func (k *Keeper) getBatchFeesForToken(ctx sdk.Context, tokenContractAddr common.Address, maxElements int) (*types.BatchFees, error) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.SecondIndexOutgoingTXFeeKey, tokenContractAddr.Bytes()...))
-	iter := prefixStore.Iterator(nil, nil)
-	defer iter.Close()
-
 	totalFee := math.ZeroInt()
-
-	// the entry for a given key is actually a list of tx IDs that have the same fee
-	// meaning that it's possible for a single key to actually represent multiple fee amounts
-	// e.g. for some token contract ABC:
-	//
-	// [fee_of_20_something] -> [tx1, tx2, tx3] where each tx had a fee of 20
-	// [fee_of_5_something] -> [tx4, tx5] where each tx had a fee of 5
-	//
-	// total fees for contract ABC is 20x3 + 5x2 = 70
-	for ; iter.Valid(); iter.Next() {
-		var txIDs types.IDSet
-		k.cdc.MustUnmarshal(iter.Value(), &txIDs)
-
-		feeAmountBytes := iter.Key()
-		feeAmount := math.NewIntFromBigInt(big.NewInt(0).SetBytes(feeAmountBytes))
-
-		for range txIDs.Ids {
-			// they all have the same fee amount
-			fee, err := totalFee.SafeAdd(feeAmount)
-			if err != nil {
-				metrics.ReportFuncError(k.svcTags)
-				return nil, errors.Wrapf(err, "failed to sum batch fees")
-			}
-
-			totalFee = fee
+	for _, tx := range k.GetOutgoingTxQueue(ctx, tokenContractAddr, maxElements) {
+		fee, err := totalFee.SafeAdd(tx.Erc20Fee.Amount)
+		if err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, errors.Wrapf(err, "failed to sum batch fees")
 		}
+
+		totalFee = fee
 	}
 
 	return &types.BatchFees{Token: tokenContractAddr.Hex(), TotalFees: totalFee}, nil
 }
 
-// CreateBatchFees iterates over the outgoing pool and creates batch token fee map
+// CreateBatchFees creates the batch token fee map for every token with unbatched txs in the outgoing pool
 func (k *Keeper) createBatchFees(ctx sdk.Context) map[common.Address]*types.BatchFees {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
-	iter := prefixStore.Iterator(nil, nil)
-	defer iter.Close()
-
 	batchFeesMap := make(map[common.Address]*types.BatchFees)
-	txCountMap := make(map[common.Address]int)
+	for _, tokenContractAddr := range k.getUnbatchedTokenContracts(ctx) {
+		batchFees, err := k.getBatchFeesForToken(ctx, tokenContractAddr, OutgoingTxBatchSize)
+		if err != nil {
+			continue
+		}
 
-	for ; iter.Valid(); iter.Next() {
-		var ids types.IDSet
-		k.cdc.MustUnmarshal(iter.Value(), &ids)
+		batchFeesMap[tokenContractAddr] = batchFees
+	}
+
+	return batchFeesMap
+}
+
+// getUnbatchedTokenContracts returns the token contracts with unbatched txs, seeking past the fee index
+// entries of each token instead of iterating over all of them
+func (k *Keeper) getUnbatchedTokenContracts(ctx sdk.Context) []common.Address {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
 
-		// create a map to store the token contract address and its total fee
-		// Parse the iterator key to get contract address & fee
-		// If len(ids.Ids) > 1, multiply fee amount with len(ids.Ids) and add it to total fee amount
+	tokenContracts := make([]common.Address, 0)
+	var start []byte
+	for {
+		iter := prefixStore.Iterator(start, nil)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
 
-		key := iter.Key()
-		tokenContractBytes := key[:types.ETHContractAddressLen]
-		tokenContractAddr := common.BytesToAddress(tokenContractBytes)
+		tokenContract := common.BytesToAddress(iter.Key()[:types.ETHContractAddressLen])
+		iter.Close()
 
-		feeAmountBytes := key[len(tokenContractBytes):]
-		feeAmount := big.NewInt(0).SetBytes(feeAmountBytes)
+		tokenContracts = append(tokenContracts, tokenContract)
 
-		for i := 0; i < len(ids.Ids); i++ {
-			if txCountMap[tokenContractAddr] >= OutgoingTxBatchSize {
-				break
-			} else {
-				// add fee amount
-				if _, ok := batchFeesMap[tokenContractAddr]; ok {
-					totalFees := batchFeesMap[tokenContractAddr].TotalFees
-					totalFees = totalFees.Add(math.NewIntFromBigInt(feeAmount))
-					batchFeesMap[tokenContractAddr].TotalFees = totalFees
-				} else {
-					batchFeesMap[tokenContractAddr] = &types.BatchFees{
-						Token:     tokenContractAddr.Hex(),
-						TotalFees: math.NewIntFromBigInt(feeAmount)}
-				}
-
-				txCountMap[tokenContractAddr]++
-			}
+		_, start = PrefixRange(tokenContract.Bytes())
+		if start == nil {
+			break
 		}
 	}
 
-	return batchFeesMap
+	return tokenContracts
 }
 
 func (k *Keeper) AutoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
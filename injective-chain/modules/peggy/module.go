@@ -40,7 +40,7 @@ var (
 	_ appmodule.HasEndBlocker = AppModule{}
 )
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // AppModuleBasic object for module implementation
 type AppModuleBasic struct{}
@@ -150,6 +150,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate peggy from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate peggy from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis initializes the genesis state for this module and implements app module.
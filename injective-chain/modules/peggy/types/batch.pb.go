@@ -106,6 +106,8 @@ type OutgoingTransferTx struct {
 	DestAddress string      `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  *ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token,omitempty"`
 	Erc20Fee    *ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee,omitempty"`
+	// unix timestamp (in seconds) of the block in which the transfer was queued
+	CreatedAt int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
@@ -176,6 +178,13 @@ func (m *OutgoingTransferTx) GetErc20Fee() *ERC20Token {
 	return nil
 }
 
+func (m *OutgoingTransferTx) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "injective.peggy.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "injective.peggy.v1.OutgoingTransferTx")
@@ -184,33 +193,34 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/batch.proto", fileDescriptor_48fd09581f1b5901) }
 
 var fileDescriptor_48fd09581f1b5901 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x6b, 0xdc, 0x30,
-	0x10, 0x86, 0x57, 0x9b, 0x0f, 0xba, 0xf2, 0x26, 0x05, 0x51, 0x8a, 0xe9, 0x41, 0xdd, 0xa6, 0x1f,
-	0xec, 0xa5, 0x76, 0xe2, 0x1e, 0x7b, 0x28, 0x4d, 0x68, 0xa1, 0x1f, 0xb4, 0x60, 0x7c, 0xea, 0xc5,
-	0xc8, 0xf2, 0xc4, 0xab, 0x26, 0x2b, 0x2d, 0xd2, 0x78, 0x49, 0xfe, 0x45, 0x7f, 0x56, 0x8f, 0x39,
-	0xf6, 0x52, 0x08, 0xbb, 0x7f, 0xa4, 0x48, 0x76, 0x97, 0x96, 0x04, 0x72, 0xd3, 0x3c, 0x33, 0xef,
-	0xab, 0x77, 0x60, 0x28, 0x57, 0xfa, 0x3b, 0x48, 0x54, 0x4b, 0x48, 0x17, 0xd0, 0x34, 0x97, 0xe9,
-	0xf2, 0x28, 0xad, 0x04, 0xca, 0x59, 0xb2, 0xb0, 0x06, 0x0d, 0x63, 0x9b, 0x7e, 0x12, 0xfa, 0xc9,
-	0xf2, 0xe8, 0xd1, 0xb3, 0x5b, 0x34, 0x02, 0x11, 0x1c, 0x0a, 0x54, 0x46, 0x77, 0xca, 0x83, 0x6b,
-	0x42, 0xef, 0x7f, 0x6d, 0xb1, 0x31, 0x4a, 0x37, 0xc5, 0xc5, 0xb1, 0xf7, 0x64, 0x8f, 0x69, 0x14,
-	0xcc, 0x4b, 0x6d, 0xb4, 0x84, 0x98, 0x4c, 0xc8, 0x74, 0x3b, 0xa7, 0x01, 0x7d, 0xf1, 0x84, 0x3d,
-	0xa5, 0x7b, 0xdd, 0x00, 0xaa, 0x39, 0x98, 0x16, 0xe3, 0x61, 0x18, 0x19, 0x07, 0x58, 0x74, 0x8c,
-	0x7d, 0xa4, 0x63, 0xb4, 0x42, 0x3b, 0x21, 0xfd, 0x77, 0x2e, 0xde, 0x9a, 0x6c, 0x4d, 0xa3, 0xec,
-	0x45, 0x72, 0x33, 0x6a, 0xb2, 0x09, 0xe0, 0xe7, 0x4f, 0xc1, 0x16, 0x17, 0xf9, 0x7f, 0x5a, 0xf6,
-	0x9c, 0xee, 0xa3, 0x39, 0x03, 0x5d, 0x4a, 0xa3, 0xd1, 0x0a, 0x89, 0xf1, 0xf6, 0x84, 0x4c, 0x47,
-	0xf9, 0x5e, 0xa0, 0x27, 0x3d, 0x64, 0x0f, 0xe8, 0x4e, 0x75, 0x6e, 0xe4, 0x59, 0xbc, 0x13, 0xf2,
-	0x74, 0xc5, 0xc1, 0x6f, 0x42, 0xd9, 0xcd, 0x1f, 0xd8, 0x3e, 0x1d, 0xaa, 0xba, 0x5f, 0x6e, 0xa8,
-	0x6a, 0xf6, 0x90, 0xee, 0x3a, 0xd0, 0x35, 0xd8, 0xb0, 0xcd, 0x28, 0xef, 0x2b, 0xf6, 0x84, 0x8e,
-	0x6b, 0x70, 0x58, 0x8a, 0xba, 0xb6, 0xe0, 0xfc, 0x1e, 0xbe, 0x1b, 0x79, 0xf6, 0xb6, 0x43, 0xec,
-	0x0d, 0x8d, 0xc0, 0xca, 0xec, 0xb0, 0x0c, 0x71, 0x42, 0xb6, 0x28, 0xe3, 0xb7, 0x6d, 0xfa, 0x2e,
-	0x3f, 0xc9, 0x0e, 0x0b, 0x3f, 0x95, 0xd3, 0x20, 0x09, 0x6f, 0xf6, 0x9a, 0x8e, 0x3a, 0x83, 0x53,
-	0x80, 0x10, 0xfe, 0x6e, 0xf9, 0xbd, 0x20, 0x78, 0x0f, 0x70, 0x0c, 0x3f, 0x57, 0x9c, 0x5c, 0xad,
-	0x38, 0xb9, 0x5e, 0x71, 0xf2, 0x63, 0xcd, 0x07, 0x57, 0x6b, 0x3e, 0xf8, 0xb5, 0xe6, 0x83, 0x6f,
-	0x9f, 0x1a, 0x85, 0xb3, 0xb6, 0x4a, 0xa4, 0x99, 0xa7, 0x1f, 0xfe, 0xba, 0x7d, 0x16, 0x95, 0x4b,
-	0x37, 0xde, 0x2f, 0xa5, 0xb1, 0xf0, 0x6f, 0x39, 0x13, 0x4a, 0xa7, 0x73, 0x53, 0xb7, 0xe7, 0xe0,
-	0xfa, 0xc3, 0xc1, 0xcb, 0x05, 0xb8, 0x6a, 0x37, 0x1c, 0xcc, 0xab, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x24, 0x11, 0x91, 0x29, 0x8c, 0x02, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xb3, 0x49, 0x13, 0x91, 0x71, 0x5a, 0xa4, 0x15, 0x42, 0x16, 0x12, 0x26, 0x94, 0x3f,
+	0xca, 0x05, 0xbb, 0x0d, 0x47, 0x0e, 0xa8, 0xad, 0x40, 0xe2, 0x8f, 0x40, 0xb2, 0x72, 0xe2, 0x62,
+	0xad, 0xd7, 0x53, 0x67, 0x69, 0xb3, 0x1b, 0xed, 0x8e, 0xa3, 0xf6, 0x2d, 0x78, 0x2c, 0x8e, 0x3d,
+	0x72, 0xac, 0x92, 0xd7, 0xe0, 0x80, 0xbc, 0x36, 0x11, 0xa8, 0x3d, 0xf4, 0xb6, 0xf3, 0x9b, 0xf9,
+	0x66, 0xbe, 0x4f, 0x5a, 0x88, 0x94, 0xfe, 0x8e, 0x92, 0xd4, 0x0a, 0x93, 0x25, 0x96, 0xe5, 0x65,
+	0xb2, 0x3a, 0x4c, 0x72, 0x41, 0x72, 0x1e, 0x2f, 0xad, 0x21, 0xc3, 0xf9, 0xb6, 0x1f, 0xfb, 0x7e,
+	0xbc, 0x3a, 0x7c, 0xf4, 0xfc, 0x16, 0x8d, 0x20, 0x42, 0x47, 0x82, 0x94, 0xd1, 0x8d, 0x72, 0xff,
+	0x9a, 0xc1, 0xfd, 0xaf, 0x15, 0x95, 0x46, 0xe9, 0x72, 0x76, 0x71, 0x5c, 0xef, 0xe4, 0x4f, 0x20,
+	0xf0, 0xcb, 0x33, 0x6d, 0xb4, 0xc4, 0x90, 0x8d, 0xd9, 0x64, 0x27, 0x05, 0x8f, 0xbe, 0xd4, 0x84,
+	0x3f, 0x83, 0xdd, 0x66, 0x80, 0xd4, 0x02, 0x4d, 0x45, 0x61, 0xd7, 0x8f, 0x8c, 0x3c, 0x9c, 0x35,
+	0x8c, 0x7f, 0x84, 0x11, 0x59, 0xa1, 0x9d, 0x90, 0xf5, 0x39, 0x17, 0xf6, 0xc6, 0xbd, 0x49, 0x30,
+	0x7d, 0x19, 0xdf, 0xb4, 0x1a, 0x6f, 0x0d, 0xd4, 0xf3, 0xa7, 0x68, 0x67, 0x17, 0xe9, 0x7f, 0x5a,
+	0xfe, 0x02, 0xf6, 0xc8, 0x9c, 0xa1, 0xce, 0xa4, 0xd1, 0x64, 0x85, 0xa4, 0x70, 0x67, 0xcc, 0x26,
+	0xc3, 0x74, 0xd7, 0xd3, 0x93, 0x16, 0xf2, 0x07, 0xd0, 0xcf, 0xcf, 0x8d, 0x3c, 0x0b, 0xfb, 0xde,
+	0x4f, 0x53, 0xec, 0xff, 0x66, 0xc0, 0x6f, 0x5e, 0xe0, 0x7b, 0xd0, 0x55, 0x45, 0x1b, 0xae, 0xab,
+	0x0a, 0xfe, 0x10, 0x06, 0x0e, 0x75, 0x81, 0xd6, 0xa7, 0x19, 0xa6, 0x6d, 0xc5, 0x9f, 0xc2, 0xa8,
+	0x40, 0x47, 0x99, 0x28, 0x0a, 0x8b, 0xae, 0xce, 0x51, 0x77, 0x83, 0x9a, 0x1d, 0x35, 0x88, 0xbf,
+	0x85, 0x00, 0xad, 0x9c, 0x1e, 0x64, 0xde, 0x8e, 0xf7, 0x16, 0x4c, 0xa3, 0xdb, 0x92, 0xbe, 0x4b,
+	0x4f, 0xa6, 0x07, 0xb3, 0x7a, 0x2a, 0x05, 0x2f, 0xf1, 0x6f, 0xfe, 0x06, 0x86, 0xcd, 0x82, 0x53,
+	0xc4, 0xb0, 0x7f, 0x27, 0xf9, 0x3d, 0x2f, 0x78, 0x8f, 0xc8, 0x1f, 0x03, 0x48, 0x8b, 0x82, 0xb0,
+	0xc8, 0x04, 0x85, 0x83, 0x31, 0x9b, 0xf4, 0xd2, 0x61, 0x4b, 0x8e, 0xe8, 0x18, 0x7f, 0xae, 0x23,
+	0x76, 0xb5, 0x8e, 0xd8, 0xf5, 0x3a, 0x62, 0x3f, 0x36, 0x51, 0xe7, 0x6a, 0x13, 0x75, 0x7e, 0x6d,
+	0xa2, 0xce, 0xb7, 0x4f, 0xa5, 0xa2, 0x79, 0x95, 0xc7, 0xd2, 0x2c, 0x92, 0x0f, 0x7f, 0x8f, 0x7d,
+	0x16, 0xb9, 0x4b, 0xb6, 0xa7, 0x5f, 0x49, 0x63, 0xf1, 0xdf, 0x72, 0x2e, 0x94, 0x4e, 0x16, 0xa6,
+	0xa8, 0xce, 0xd1, 0xb5, 0xff, 0x8a, 0x2e, 0x97, 0xe8, 0xf2, 0x81, 0xff, 0x4f, 0xaf, 0xff, 0x0c,
+	0x00, 0xf8, 0x4c, 0xb5, 0xff, 0xab, 0x02, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -292,6 +302,11 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Erc20Fee != nil {
 		{
 			size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
@@ -402,6 +417,9 @@ func (m *OutgoingTransferTx) Size() (n int) {
 		l = m.Erc20Fee.Size()
 		n += 1 + l + sovBatch(uint64(l))
 	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBatch(uint64(m.CreatedAt))
+	}
 	return n
 }
 
@@ -768,6 +786,25 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetOrchestratorAddresses{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
+		&MsgBumpSendToEthFee{},
 		&MsgSubmitBadSignatureEvidence{},
 		&MsgUpdateParams{},
 		&MsgBlacklistEthereumAddresses{},
@@ -60,6 +61,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgValsetConfirm{}, "peggy/MsgValsetConfirm", nil)
 	cdc.RegisterConcrete(&MsgSendToEth{}, "peggy/MsgSendToEth", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "peggy/MsgCancelSendToEth", nil)
+	cdc.RegisterConcrete(&MsgBumpSendToEthFee{}, "peggy/MsgBumpSendToEthFee", nil)
 	cdc.RegisterConcrete(&MsgRequestBatch{}, "peggy/MsgRequestBatch", nil)
 	cdc.RegisterConcrete(&MsgConfirmBatch{}, "peggy/MsgConfirmBatch", nil)
 	cdc.RegisterConcrete(&Valset{}, "peggy/Valset", nil)
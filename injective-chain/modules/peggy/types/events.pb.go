@@ -1107,6 +1107,60 @@ func (m *EventCancelSendToEth) GetOutgoingTxId() uint64 {
 	return 0
 }
 
+type EventBumpSendToEthFee struct {
+	OutgoingTxId   uint64                                  `protobuf:"varint,1,opt,name=outgoing_tx_id,json=outgoingTxId,proto3" json:"outgoing_tx_id,omitempty"`
+	Sender         string                                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	BridgeFee      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=bridge_fee,json=bridgeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"bridge_fee"`
+	TotalBridgeFee cosmossdk_io_math.Int                   `protobuf:"bytes,4,opt,name=total_bridge_fee,json=totalBridgeFee,proto3,customtype=cosmossdk.io/math.Int" json:"total_bridge_fee"`
+}
+
+func (m *EventBumpSendToEthFee) Reset()         { *m = EventBumpSendToEthFee{} }
+func (m *EventBumpSendToEthFee) String() string { return proto.CompactTextString(m) }
+func (*EventBumpSendToEthFee) ProtoMessage()    {}
+func (*EventBumpSendToEthFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{15}
+}
+func (m *EventBumpSendToEthFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBumpSendToEthFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBumpSendToEthFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBumpSendToEthFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBumpSendToEthFee.Merge(m, src)
+}
+func (m *EventBumpSendToEthFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBumpSendToEthFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBumpSendToEthFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBumpSendToEthFee proto.InternalMessageInfo
+
+func (m *EventBumpSendToEthFee) GetOutgoingTxId() uint64 {
+	if m != nil {
+		return m.OutgoingTxId
+	}
+	return 0
+}
+
+func (m *EventBumpSendToEthFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 type EventSubmitBadSignatureEvidence struct {
 	BadEthSignature        string `protobuf:"bytes,1,opt,name=bad_eth_signature,json=badEthSignature,proto3" json:"bad_eth_signature,omitempty"`
 	BadEthSignatureSubject string `protobuf:"bytes,2,opt,name=bad_eth_signature_subject,json=badEthSignatureSubject,proto3" json:"bad_eth_signature_subject,omitempty"`
@@ -1116,7 +1170,7 @@ func (m *EventSubmitBadSignatureEvidence) Reset()         { *m = EventSubmitBadS
 func (m *EventSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*EventSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*EventSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{15}
+	return fileDescriptor_95f217691d2f42c2, []int{16}
 }
 func (m *EventSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1225,7 @@ func (m *EventValidatorSlash) Reset()         { *m = EventValidatorSlash{} }
 func (m *EventValidatorSlash) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorSlash) ProtoMessage()    {}
 func (*EventValidatorSlash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{16}
+	return fileDescriptor_95f217691d2f42c2, []int{17}
 }
 func (m *EventValidatorSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1302,7 @@ func (m *EventDepositReceived) Reset()         { *m = EventDepositReceived{} }
 func (m *EventDepositReceived) String() string { return proto.CompactTextString(m) }
 func (*EventDepositReceived) ProtoMessage()    {}
 func (*EventDepositReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{17}
+	return fileDescriptor_95f217691d2f42c2, []int{18}
 }
 func (m *EventDepositReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,7 +1356,7 @@ func (m *EventWithdrawalsCompleted) Reset()         { *m = EventWithdrawalsCompl
 func (m *EventWithdrawalsCompleted) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawalsCompleted) ProtoMessage()    {}
 func (*EventWithdrawalsCompleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{18}
+	return fileDescriptor_95f217691d2f42c2, []int{19}
 }
 func (m *EventWithdrawalsCompleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1358,7 +1412,7 @@ func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
 func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
 func (*Withdrawal) ProtoMessage()    {}
 func (*Withdrawal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{19}
+	return fileDescriptor_95f217691d2f42c2, []int{20}
 }
 func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1467,7 @@ func (m *EventValidatorJailed) Reset()         { *m = EventValidatorJailed{} }
 func (m *EventValidatorJailed) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorJailed) ProtoMessage()    {}
 func (*EventValidatorJailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{20}
+	return fileDescriptor_95f217691d2f42c2, []int{21}
 }
 func (m *EventValidatorJailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1494,6 +1548,7 @@ func init() {
 	proto.RegisterType((*EventERC20DeployedClaim)(nil), "injective.peggy.v1.EventERC20DeployedClaim")
 	proto.RegisterType((*EventValsetUpdateClaim)(nil), "injective.peggy.v1.EventValsetUpdateClaim")
 	proto.RegisterType((*EventCancelSendToEth)(nil), "injective.peggy.v1.EventCancelSendToEth")
+	proto.RegisterType((*EventBumpSendToEthFee)(nil), "injective.peggy.v1.EventBumpSendToEthFee")
 	proto.RegisterType((*EventSubmitBadSignatureEvidence)(nil), "injective.peggy.v1.EventSubmitBadSignatureEvidence")
 	proto.RegisterType((*EventValidatorSlash)(nil), "injective.peggy.v1.EventValidatorSlash")
 	proto.RegisterType((*EventDepositReceived)(nil), "injective.peggy.v1.EventDepositReceived")
@@ -1505,95 +1560,97 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/events.proto", fileDescriptor_95f217691d2f42c2) }
 
 var fileDescriptor_95f217691d2f42c2 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xc1, 0x6f, 0x1b, 0x45,
-	0x17, 0xcf, 0xda, 0x4e, 0xd2, 0xbc, 0x38, 0x4e, 0x3a, 0xcd, 0xd7, 0xba, 0xf9, 0x54, 0xc7, 0xdd,
-	0xb6, 0x5f, 0xf2, 0x15, 0xd5, 0x6e, 0x8b, 0x8a, 0x84, 0x84, 0x04, 0x8d, 0x1b, 0x68, 0x0a, 0x6d,
-	0xa5, 0x4d, 0x28, 0x12, 0x17, 0x6b, 0xec, 0x79, 0xf5, 0x4e, 0xe3, 0xdd, 0x31, 0x3b, 0x63, 0xa7,
-	0x39, 0x73, 0xe1, 0xc0, 0x01, 0x09, 0x71, 0xe5, 0x7f, 0xe0, 0xc0, 0x9f, 0x80, 0x54, 0x6e, 0x3d,
-	0x21, 0xc4, 0xa1, 0x42, 0xed, 0x7f, 0x80, 0xc4, 0x85, 0x13, 0xda, 0x99, 0xd9, 0xb5, 0x93, 0xb5,
-	0x45, 0x9a, 0x42, 0x39, 0xc5, 0xf3, 0xe6, 0xbd, 0x37, 0x6f, 0x7e, 0xef, 0x37, 0xef, 0xbd, 0x0d,
-	0xac, 0xf2, 0xf0, 0x11, 0xb6, 0x15, 0x1f, 0x60, 0xbd, 0x87, 0x9d, 0xce, 0x7e, 0x7d, 0x70, 0xad,
-	0x8e, 0x03, 0x0c, 0x95, 0xac, 0xf5, 0x22, 0xa1, 0x04, 0x21, 0xa9, 0x42, 0x4d, 0x2b, 0xd4, 0x06,
-	0xd7, 0x56, 0x96, 0x3b, 0xa2, 0x23, 0xf4, 0x76, 0x3d, 0xfe, 0x65, 0x34, 0x57, 0x2e, 0x8e, 0x71,
-	0x45, 0x95, 0x42, 0xa9, 0xa8, 0xe2, 0x22, 0xb4, 0x5a, 0x95, 0x31, 0x5a, 0x6a, 0xbf, 0x87, 0xf6,
-	0x3c, 0xf7, 0x37, 0x07, 0xca, 0x9b, 0x71, 0x00, 0x37, 0x87, 0xa6, 0xf7, 0x5b, 0x12, 0xa3, 0x01,
-	0x32, 0x72, 0x1b, 0x96, 0x46, 0x3c, 0x36, 0x63, 0xbb, 0xb2, 0x53, 0x75, 0xd6, 0x4b, 0xd7, 0xcf,
-	0xd5, 0xb2, 0x71, 0xd6, 0x1a, 0x5d, 0xca, 0x83, 0x9d, 0xfd, 0x1e, 0x7a, 0x8b, 0x23, 0x66, 0xb1,
-	0x80, 0xac, 0xc1, 0x62, 0x2b, 0xe2, 0xac, 0x83, 0xcd, 0xb6, 0x08, 0x55, 0x44, 0xdb, 0xaa, 0x9c,
-	0xab, 0x3a, 0xeb, 0x73, 0x5e, 0xc9, 0x88, 0x1b, 0x56, 0x4a, 0xfe, 0x37, 0x54, 0xf4, 0x29, 0x0f,
-	0x9b, 0x9c, 0x95, 0xf3, 0x55, 0x67, 0xbd, 0xe0, 0x2d, 0x58, 0xc5, 0x58, 0xba, 0xc5, 0xc8, 0x25,
-	0x28, 0x8d, 0x86, 0xc6, 0x59, 0xb9, 0x50, 0x75, 0xd6, 0x8b, 0xde, 0xc2, 0x88, 0x74, 0x8b, 0x91,
-	0x65, 0x98, 0x0e, 0x45, 0xd8, 0xc6, 0xf2, 0xb4, 0x76, 0x62, 0x16, 0x6e, 0x08, 0xff, 0xd5, 0x77,
-	0xde, 0xd0, 0x2e, 0x3f, 0xe1, 0xca, 0x67, 0x11, 0xdd, 0x6b, 0xd0, 0xb0, 0x8d, 0x5d, 0x64, 0xe3,
-	0x82, 0x75, 0x8e, 0x1a, 0x6c, 0x6e, 0x4c, 0xb0, 0xee, 0x0f, 0x0e, 0x10, 0x7d, 0xe0, 0xfd, 0xbe,
-	0xea, 0x08, 0x1e, 0x76, 0x36, 0xa8, 0x6a, 0xfb, 0x71, 0x70, 0x0c, 0x43, 0x11, 0x58, 0xef, 0x66,
-	0x41, 0xae, 0xc1, 0xb2, 0x88, 0xda, 0x3e, 0x4a, 0x15, 0x51, 0x25, 0xa2, 0x26, 0x65, 0x2c, 0x42,
-	0x29, 0x2d, 0x5e, 0xa7, 0x46, 0xf7, 0x6e, 0x9a, 0x2d, 0xb2, 0x0a, 0xf3, 0xad, 0xd8, 0x63, 0xd3,
-	0xdc, 0xd5, 0x00, 0x06, 0x5a, 0x74, 0x2f, 0x96, 0x90, 0x0b, 0xb0, 0x60, 0x14, 0x14, 0x0f, 0x50,
-	0xf4, 0x95, 0x06, 0xab, 0xe0, 0x15, 0xb5, 0x70, 0xc7, 0xc8, 0x48, 0x15, 0x8a, 0x56, 0xe9, 0x71,
-	0x93, 0x33, 0x59, 0x9e, 0xae, 0xe6, 0x53, 0x37, 0x3b, 0x8f, 0xb7, 0x98, 0x74, 0xbf, 0x75, 0x60,
-	0x25, 0x7b, 0x8f, 0x7f, 0x0c, 0x37, 0x72, 0x16, 0x4e, 0x98, 0x88, 0x52, 0x16, 0xcc, 0xea, 0xf5,
-	0x68, 0x62, 0x0b, 0xa3, 0x89, 0xfd, 0x26, 0x67, 0xd9, 0xfc, 0x80, 0x76, 0x25, 0xaa, 0x8f, 0x7b,
-	0x8c, 0x2a, 0xf4, 0xf0, 0xb3, 0x3e, 0x4a, 0x45, 0xce, 0x43, 0x71, 0xa0, 0xc5, 0x16, 0x26, 0x47,
-	0x5b, 0xce, 0x1b, 0x59, 0x8a, 0x93, 0x55, 0xf1, 0x91, 0x77, 0x7c, 0x65, 0xc3, 0xb2, 0x76, 0xb7,
-	0xb5, 0x8c, 0xdc, 0x81, 0x92, 0x55, 0x0a, 0x30, 0x68, 0x61, 0x24, 0xcb, 0xf9, 0x6a, 0x7e, 0x7d,
-	0xfe, 0xfa, 0x85, 0x71, 0x6f, 0xc2, 0x50, 0xec, 0x01, 0xed, 0x72, 0x16, 0x67, 0xcc, 0xb3, 0xfe,
-	0xef, 0x1a, 0x4b, 0xb2, 0x01, 0x0b, 0x11, 0xee, 0xd1, 0x88, 0x35, 0x69, 0x20, 0xfa, 0xa1, 0x49,
-	0xcc, 0xdc, 0xc6, 0xb9, 0x27, 0xcf, 0x56, 0xa7, 0x7e, 0x79, 0xb6, 0xfa, 0x9f, 0xb6, 0x90, 0x81,
-	0x90, 0x92, 0xed, 0xd6, 0xb8, 0xa8, 0x07, 0x54, 0xf9, 0xb5, 0xad, 0x50, 0x79, 0x45, 0x63, 0x73,
-	0x53, 0x9b, 0xc4, 0xf7, 0xb2, 0x3e, 0x94, 0xd8, 0xc5, 0x50, 0x53, 0x7d, 0xce, 0x9b, 0x37, 0xb2,
-	0x9d, 0x58, 0xe4, 0x7e, 0xe7, 0xc0, 0x39, 0x8d, 0xcb, 0x36, 0xaa, 0xfb, 0x59, 0x02, 0xa1, 0x24,
-	0x6f, 0xc0, 0xc9, 0x41, 0x12, 0x64, 0x4a, 0x39, 0x93, 0xbd, 0xa5, 0x74, 0x23, 0xe1, 0xdb, 0x31,
-	0x28, 0x7a, 0x15, 0x96, 0x45, 0x0f, 0x8d, 0x3a, 0x2a, 0x3f, 0x35, 0xc9, 0x6b, 0x13, 0x92, 0xec,
-	0x6d, 0x2a, 0xdf, 0x5a, 0xb8, 0x8f, 0xec, 0x9b, 0x31, 0xa9, 0x6c, 0x88, 0xf0, 0x21, 0x8f, 0x82,
-	0xa3, 0x24, 0xf1, 0xe5, 0xa3, 0x73, 0x3f, 0xcf, 0x41, 0xc9, 0xe2, 0x13, 0xb2, 0x1d, 0xb1, 0xa9,
-	0x7c, 0x72, 0x11, 0x4a, 0xc2, 0xb2, 0xdc, 0x3c, 0x08, 0x7b, 0x54, 0x31, 0x91, 0xc6, 0x4f, 0x82,
-	0x9c, 0x86, 0x19, 0x89, 0x21, 0xc3, 0xc8, 0x7a, 0xb7, 0x2b, 0xb2, 0x02, 0x27, 0x22, 0x6c, 0x23,
-	0x1f, 0x60, 0x64, 0xaf, 0x98, 0xae, 0xc9, 0x07, 0x30, 0x73, 0x20, 0xd9, 0x75, 0x9b, 0xec, 0xb5,
-	0x0e, 0x57, 0x7e, 0xbf, 0x55, 0x6b, 0x8b, 0xa0, 0x6e, 0xf2, 0x6e, 0xff, 0x5c, 0x91, 0x6c, 0xd7,
-	0x16, 0xed, 0x86, 0xe0, 0xa1, 0x67, 0xcd, 0xc9, 0x3d, 0x00, 0xfb, 0x8c, 0x1e, 0xa2, 0xa9, 0x70,
-	0xc7, 0x70, 0x36, 0x67, 0x5c, 0xbc, 0x8f, 0xe8, 0x76, 0xe0, 0xa4, 0x06, 0xc1, 0x62, 0x6d, 0x8a,
-	0xd4, 0xa1, 0xda, 0xe2, 0x64, 0x6a, 0xcb, 0x31, 0xe0, 0x56, 0xb0, 0x7c, 0xb8, 0xe7, 0x3c, 0x10,
-	0x0a, 0xe3, 0xb3, 0x74, 0x33, 0x3c, 0x78, 0x96, 0x16, 0x99, 0xb3, 0xb2, 0x55, 0x3f, 0x37, 0xa1,
-	0xea, 0x0f, 0x84, 0x4a, 0xa1, 0x37, 0x0b, 0xf7, 0xf7, 0x9c, 0xbd, 0xdf, 0x2d, 0xec, 0x09, 0xc9,
-	0x95, 0x6e, 0x57, 0x7f, 0x7d, 0xe6, 0x79, 0x28, 0x1a, 0x85, 0x03, 0x25, 0xc1, 0x18, 0xd9, 0x8a,
-	0x90, 0x0d, 0x2b, 0x3f, 0x2e, 0xac, 0x35, 0x58, 0x44, 0xe5, 0x63, 0x84, 0xfd, 0xa0, 0x69, 0x59,
-	0x53, 0x30, 0xf5, 0x31, 0x11, 0x6f, 0x1b, 0xf6, 0xac, 0xc1, 0xa2, 0x49, 0x56, 0x33, 0x25, 0x91,
-	0x79, 0xd4, 0x25, 0x23, 0xf6, 0x12, 0x2a, 0x5d, 0x82, 0x92, 0x7e, 0xf3, 0xc3, 0x82, 0x3b, 0xa3,
-	0xf5, 0x16, 0xb4, 0x34, 0xad, 0xb7, 0x37, 0x52, 0xc6, 0xcd, 0x1e, 0xa5, 0xbc, 0x24, 0xfc, 0x9a,
-	0x94, 0xd9, 0x13, 0x93, 0x9f, 0x39, 0x81, 0x02, 0xa3, 0x8a, 0x96, 0xe7, 0xb4, 0x8a, 0xfe, 0xed,
-	0xfe, 0x91, 0x74, 0xbf, 0xb4, 0xd1, 0xbe, 0x6e, 0xe0, 0x0f, 0x71, 0xb8, 0x90, 0xe1, 0x70, 0x16,
-	0xc7, 0xe9, 0x71, 0x38, 0x4e, 0x02, 0x64, 0x66, 0x32, 0xd5, 0x7f, 0xcc, 0xc1, 0x19, 0x7d, 0xf9,
-	0x4d, 0xaf, 0x71, 0xfd, 0xea, 0x2d, 0xec, 0x75, 0xc5, 0x3e, 0xb2, 0xd7, 0x8e, 0xc0, 0x79, 0x28,
-	0x5a, 0x46, 0x99, 0x89, 0xc3, 0xf0, 0x6e, 0xde, 0xc8, 0x6e, 0xe9, 0xb9, 0xe3, 0x88, 0x18, 0x10,
-	0x28, 0x84, 0x34, 0x40, 0x7b, 0x67, 0xfd, 0x5b, 0x57, 0xc1, 0xfd, 0xa0, 0x25, 0xba, 0x86, 0x5f,
-	0x9e, 0x5d, 0xc5, 0x55, 0x90, 0x61, 0x9b, 0x07, 0xb4, 0x6b, 0x48, 0x53, 0xf0, 0xd2, 0xf5, 0x44,
-	0x2c, 0xe7, 0x26, 0x63, 0xf9, 0x65, 0x1e, 0x4e, 0x67, 0xba, 0xfb, 0xbf, 0x01, 0xe5, 0x81, 0x0e,
-	0x54, 0xc8, 0x76, 0xa0, 0xec, 0x84, 0x30, 0xfd, 0xf7, 0x4d, 0x08, 0x33, 0xaf, 0x3e, 0x21, 0xcc,
-	0x66, 0x26, 0x84, 0x63, 0xbc, 0x75, 0xf7, 0x1d, 0x5b, 0xc5, 0xcd, 0xfc, 0xf7, 0x92, 0x9d, 0xd3,
-	0xfd, 0xc2, 0x81, 0x55, 0xd3, 0x72, 0xfb, 0xad, 0x80, 0xab, 0x0d, 0xca, 0xb6, 0x79, 0x27, 0xa4,
-	0xaa, 0x1f, 0xe1, 0xe6, 0x80, 0x33, 0x8c, 0x71, 0xbc, 0x0c, 0x27, 0x5b, 0x94, 0xe9, 0x79, 0x41,
-	0x26, 0x9b, 0x76, 0x28, 0x59, 0x6c, 0x51, 0xb6, 0xa9, 0xfc, 0xd4, 0x86, 0xbc, 0x0d, 0x67, 0x33,
-	0xba, 0x4d, 0xd9, 0x6f, 0xc5, 0x78, 0xdb, 0x5e, 0x74, 0xfa, 0x90, 0xcd, 0xb6, 0xd9, 0x75, 0xbf,
-	0x77, 0xe0, 0x54, 0xc2, 0x2b, 0x93, 0x84, 0xed, 0x2e, 0x95, 0x7a, 0x3e, 0xef, 0x89, 0x3d, 0x8c,
-	0xf4, 0x91, 0x79, 0xcf, 0x2c, 0x62, 0xb2, 0x47, 0x48, 0xa5, 0x08, 0x93, 0x96, 0x6f, 0x56, 0xf1,
-	0x04, 0xd5, 0x16, 0xa1, 0xc4, 0x50, 0xf6, 0xe5, 0xa1, 0xf1, 0x66, 0x29, 0xdd, 0x48, 0xea, 0xe4,
-	0xff, 0x61, 0x29, 0x1d, 0x87, 0x12, 0x5d, 0xf3, 0x26, 0x17, 0x13, 0x79, 0xa2, 0x5a, 0x86, 0xd9,
-	0x40, 0x84, 0x7c, 0x37, 0x6d, 0x02, 0xc9, 0xd2, 0xfd, 0xda, 0xb1, 0x19, 0xb0, 0x0d, 0xcd, 0xb6,
-	0x85, 0xd1, 0xa9, 0xc4, 0x99, 0x38, 0x95, 0xe4, 0x26, 0x4e, 0x25, 0xf9, 0x57, 0x9a, 0x4a, 0x5c,
-	0x09, 0x67, 0x0f, 0x54, 0x7b, 0xda, 0x95, 0x0d, 0x11, 0xf4, 0xba, 0xa8, 0x90, 0x4d, 0xf8, 0xe4,
-	0x79, 0x0f, 0xe6, 0xf7, 0x86, 0xda, 0xe5, 0x9c, 0x7e, 0x2c, 0x95, 0x71, 0x8f, 0x65, 0xe8, 0xd4,
-	0x1b, 0x35, 0x71, 0xf7, 0x00, 0x86, 0x5b, 0xc7, 0xba, 0xff, 0x8d, 0x43, 0xf7, 0x3f, 0x5a, 0x8f,
-	0x74, 0x7f, 0x4a, 0x72, 0x90, 0x72, 0xe7, 0x0e, 0xe5, 0xf1, 0xc7, 0xd0, 0x5b, 0x29, 0x4d, 0xcc,
-	0x17, 0xf3, 0xd8, 0xeb, 0xc4, 0xba, 0x9e, 0xd6, 0x4a, 0x69, 0x94, 0x92, 0x2e, 0x37, 0x4a, 0xba,
-	0xd7, 0x4e, 0xae, 0xcb, 0xef, 0x02, 0x0c, 0xa3, 0x23, 0x65, 0x58, 0xbe, 0xcb, 0xa5, 0xe4, 0x61,
-	0xe7, 0xc0, 0x38, 0xbe, 0x34, 0x45, 0xce, 0xc0, 0x29, 0xbb, 0x63, 0x3e, 0x06, 0xed, 0x86, 0xb3,
-	0x81, 0x4f, 0x9e, 0x57, 0x9c, 0xa7, 0xcf, 0x2b, 0xce, 0xaf, 0xcf, 0x2b, 0xce, 0x57, 0x2f, 0x2a,
-	0x53, 0x4f, 0x5f, 0x54, 0xa6, 0x7e, 0x7e, 0x51, 0x99, 0xfa, 0xf4, 0xc3, 0x11, 0x4a, 0x6d, 0x25,
-	0xa0, 0x7c, 0x44, 0x5b, 0xb2, 0x9e, 0x42, 0x74, 0xa5, 0x2d, 0x22, 0x1c, 0x5d, 0xc6, 0x5f, 0x84,
-	0xf5, 0x40, 0xb0, 0x7e, 0x17, 0xa5, 0xfd, 0x4f, 0x86, 0xe6, 0x5e, 0x6b, 0x46, 0xff, 0x1f, 0xe3,
-	0xcd, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x16, 0xfe, 0x9f, 0x5a, 0x11, 0x00, 0x00,
+	// 1440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x4e, 0xd2, 0xbc, 0x38, 0x4e, 0x3a, 0x4d, 0x5b, 0x37, 0x7f, 0xd5, 0x49, 0xb7,
+	0xfd, 0xd3, 0x50, 0x54, 0xbb, 0x2d, 0x2a, 0x12, 0x12, 0x12, 0xd4, 0x6e, 0xda, 0xa6, 0xd0, 0x56,
+	0xda, 0x84, 0x22, 0x71, 0xb1, 0xc6, 0x9e, 0x57, 0x7b, 0x1a, 0xef, 0x8e, 0xd9, 0x19, 0x3b, 0xcd,
+	0x99, 0x0b, 0x07, 0x0e, 0x48, 0x88, 0x2b, 0xdf, 0x81, 0x03, 0x1f, 0x01, 0xa9, 0xdc, 0x7a, 0x42,
+	0x88, 0x43, 0x85, 0xda, 0x3b, 0x07, 0x24, 0x2e, 0x9c, 0xd0, 0xce, 0xcc, 0xae, 0xd7, 0xb1, 0x2d,
+	0xd2, 0x04, 0xca, 0x29, 0x99, 0x37, 0xef, 0xbd, 0x79, 0xf3, 0x7b, 0xbf, 0x79, 0xef, 0xad, 0x61,
+	0x95, 0x07, 0x8f, 0xb1, 0xa9, 0x78, 0x1f, 0x2b, 0x5d, 0x6c, 0xb5, 0xf6, 0x2a, 0xfd, 0xab, 0x15,
+	0xec, 0x63, 0xa0, 0x64, 0xb9, 0x1b, 0x0a, 0x25, 0x08, 0x49, 0x14, 0xca, 0x5a, 0xa1, 0xdc, 0xbf,
+	0xba, 0xb2, 0xdc, 0x12, 0x2d, 0xa1, 0xb7, 0x2b, 0xd1, 0x7f, 0x46, 0x73, 0xe5, 0xc2, 0x18, 0x57,
+	0x54, 0x29, 0x94, 0x8a, 0x2a, 0x2e, 0x02, 0xab, 0x55, 0x1a, 0xa3, 0xa5, 0xf6, 0xba, 0x68, 0xcf,
+	0x73, 0x7f, 0x77, 0xa0, 0xb8, 0x11, 0x05, 0x70, 0x63, 0x60, 0xfa, 0xa0, 0x21, 0x31, 0xec, 0x23,
+	0x23, 0x77, 0x60, 0x29, 0xe5, 0xb1, 0x1e, 0xd9, 0x15, 0x9d, 0x35, 0x67, 0xbd, 0x70, 0xed, 0x6c,
+	0x79, 0x34, 0xce, 0x72, 0xad, 0x43, 0xb9, 0xbf, 0xbd, 0xd7, 0x45, 0x6f, 0x31, 0x65, 0x16, 0x09,
+	0xc8, 0x45, 0x58, 0x6c, 0x84, 0x9c, 0xb5, 0xb0, 0xde, 0x14, 0x81, 0x0a, 0x69, 0x53, 0x15, 0x33,
+	0x6b, 0xce, 0xfa, 0x9c, 0x57, 0x30, 0xe2, 0x9a, 0x95, 0x92, 0x37, 0x06, 0x8a, 0x6d, 0xca, 0x83,
+	0x3a, 0x67, 0xc5, 0xec, 0x9a, 0xb3, 0x9e, 0xf3, 0x16, 0xac, 0x62, 0x24, 0xdd, 0x64, 0xe4, 0xff,
+	0x50, 0x48, 0x87, 0xc6, 0x59, 0x31, 0xb7, 0xe6, 0xac, 0xe7, 0xbd, 0x85, 0x94, 0x74, 0x93, 0x91,
+	0x65, 0x98, 0x0e, 0x44, 0xd0, 0xc4, 0xe2, 0xb4, 0x76, 0x62, 0x16, 0x6e, 0x00, 0xff, 0xd3, 0x77,
+	0xae, 0x6a, 0x97, 0x9f, 0x70, 0xd5, 0x66, 0x21, 0xdd, 0xad, 0xd1, 0xa0, 0x89, 0x1d, 0x64, 0xe3,
+	0x82, 0x75, 0x0e, 0x1a, 0x6c, 0x66, 0x4c, 0xb0, 0xee, 0x0f, 0x0e, 0x10, 0x7d, 0xe0, 0x83, 0x9e,
+	0x6a, 0x09, 0x1e, 0xb4, 0xaa, 0x54, 0x35, 0xdb, 0x51, 0x70, 0x0c, 0x03, 0xe1, 0x5b, 0xef, 0x66,
+	0x41, 0xae, 0xc2, 0xb2, 0x08, 0x9b, 0x6d, 0x94, 0x2a, 0xa4, 0x4a, 0x84, 0x75, 0xca, 0x58, 0x88,
+	0x52, 0x5a, 0xbc, 0x4e, 0xa4, 0xf7, 0x6e, 0x98, 0x2d, 0xb2, 0x0a, 0xf3, 0x8d, 0xc8, 0x63, 0xdd,
+	0xdc, 0xd5, 0x00, 0x06, 0x5a, 0x74, 0x3f, 0x92, 0x90, 0xf3, 0xb0, 0x60, 0x14, 0x14, 0xf7, 0x51,
+	0xf4, 0x94, 0x06, 0x2b, 0xe7, 0xe5, 0xb5, 0x70, 0xdb, 0xc8, 0xc8, 0x1a, 0xe4, 0xad, 0xd2, 0x93,
+	0x3a, 0x67, 0xb2, 0x38, 0xbd, 0x96, 0x4d, 0xdc, 0x6c, 0x3f, 0xd9, 0x64, 0xd2, 0xfd, 0xd6, 0x81,
+	0x95, 0xd1, 0x7b, 0xfc, 0x6b, 0xb8, 0x91, 0x33, 0x70, 0xcc, 0x44, 0x94, 0xb0, 0x60, 0x56, 0xaf,
+	0xd3, 0x89, 0xcd, 0xa5, 0x13, 0xfb, 0x4d, 0xc6, 0xb2, 0xf9, 0x21, 0xed, 0x48, 0x54, 0x1f, 0x77,
+	0x19, 0x55, 0xe8, 0xe1, 0x67, 0x3d, 0x94, 0x8a, 0x9c, 0x83, 0x7c, 0x5f, 0x8b, 0x2d, 0x4c, 0x8e,
+	0xb6, 0x9c, 0x37, 0xb2, 0x04, 0x27, 0xab, 0xd2, 0x46, 0xde, 0x6a, 0x2b, 0x1b, 0x96, 0xb5, 0xbb,
+	0xa3, 0x65, 0xe4, 0x2e, 0x14, 0xac, 0x92, 0x8f, 0x7e, 0x03, 0x43, 0x59, 0xcc, 0xae, 0x65, 0xd7,
+	0xe7, 0xaf, 0x9d, 0x1f, 0xf7, 0x26, 0x0c, 0xc5, 0x1e, 0xd2, 0x0e, 0x67, 0x51, 0xc6, 0x3c, 0xeb,
+	0xff, 0x9e, 0xb1, 0x24, 0x55, 0x58, 0x08, 0x71, 0x97, 0x86, 0xac, 0x4e, 0x7d, 0xd1, 0x0b, 0x4c,
+	0x62, 0xe6, 0xaa, 0x67, 0x9f, 0x3e, 0x5f, 0x9d, 0xfa, 0xe5, 0xf9, 0xea, 0xc9, 0xa6, 0x90, 0xbe,
+	0x90, 0x92, 0xed, 0x94, 0xb9, 0xa8, 0xf8, 0x54, 0xb5, 0xcb, 0x9b, 0x81, 0xf2, 0xf2, 0xc6, 0xe6,
+	0x86, 0x36, 0x89, 0xee, 0x65, 0x7d, 0x28, 0xb1, 0x83, 0x81, 0xa6, 0xfa, 0x9c, 0x37, 0x6f, 0x64,
+	0xdb, 0x91, 0xc8, 0xfd, 0xce, 0x81, 0xb3, 0x1a, 0x97, 0x2d, 0x54, 0x0f, 0x46, 0x09, 0x84, 0x92,
+	0xbc, 0x05, 0xc7, 0xfb, 0x71, 0x90, 0x09, 0xe5, 0x4c, 0xf6, 0x96, 0x92, 0x8d, 0x98, 0x6f, 0x87,
+	0xa0, 0xe8, 0x15, 0x58, 0x16, 0x5d, 0x34, 0xea, 0xa8, 0xda, 0x89, 0x49, 0x56, 0x9b, 0x90, 0x78,
+	0x6f, 0x43, 0xb5, 0xad, 0x85, 0xfb, 0x18, 0x48, 0x2a, 0x95, 0x35, 0x11, 0x3c, 0xe2, 0xa1, 0x7f,
+	0x90, 0x24, 0xbe, 0x7a, 0x74, 0xee, 0xe7, 0x19, 0x28, 0x58, 0x7c, 0x02, 0xb6, 0x2d, 0x36, 0x54,
+	0x9b, 0x5c, 0x80, 0x82, 0xb0, 0x2c, 0x37, 0x0f, 0xc2, 0x1e, 0x95, 0x8f, 0xa5, 0xd1, 0x93, 0x20,
+	0xa7, 0x60, 0x46, 0x62, 0xc0, 0x30, 0xb4, 0xde, 0xed, 0x8a, 0xac, 0xc0, 0xb1, 0x10, 0x9b, 0xc8,
+	0xfb, 0x18, 0xda, 0x2b, 0x26, 0x6b, 0x72, 0x1b, 0x66, 0x86, 0x92, 0x5d, 0xb1, 0xc9, 0xbe, 0xd8,
+	0xe2, 0xaa, 0xdd, 0x6b, 0x94, 0x9b, 0xc2, 0xaf, 0x98, 0xbc, 0xdb, 0x3f, 0x97, 0x25, 0xdb, 0xb1,
+	0x45, 0xbb, 0x26, 0x78, 0xe0, 0x59, 0x73, 0x72, 0x1f, 0xc0, 0x3e, 0xa3, 0x47, 0x68, 0x2a, 0xdc,
+	0x21, 0x9c, 0xcd, 0x19, 0x17, 0xb7, 0x10, 0xdd, 0x16, 0x1c, 0xd7, 0x20, 0x58, 0xac, 0x4d, 0x91,
+	0xda, 0x57, 0x5b, 0x9c, 0x91, 0xda, 0x72, 0x08, 0xb8, 0x15, 0x2c, 0xef, 0xef, 0x39, 0x0f, 0x85,
+	0xc2, 0xe8, 0x2c, 0xdd, 0x0c, 0x87, 0xcf, 0xd2, 0x22, 0x73, 0xd6, 0x68, 0xd5, 0xcf, 0x4c, 0xa8,
+	0xfa, 0x7d, 0xa1, 0x12, 0xe8, 0xcd, 0xc2, 0xfd, 0x23, 0x63, 0xef, 0x77, 0x13, 0xbb, 0x42, 0x72,
+	0xa5, 0xdb, 0xd5, 0xdf, 0x9f, 0x79, 0x0e, 0xf2, 0x46, 0x61, 0xa8, 0x24, 0x18, 0x23, 0x5b, 0x11,
+	0x46, 0xc3, 0xca, 0x8e, 0x0b, 0xeb, 0x22, 0x2c, 0xa2, 0x6a, 0x63, 0x88, 0x3d, 0xbf, 0x6e, 0x59,
+	0x93, 0x33, 0xf5, 0x31, 0x16, 0x6f, 0x69, 0x69, 0xa4, 0x68, 0x92, 0x55, 0x4f, 0x48, 0x64, 0x1e,
+	0x75, 0xc1, 0x88, 0x3d, 0x2b, 0x8d, 0x0e, 0xd6, 0x6f, 0x7e, 0x50, 0x70, 0x67, 0xb4, 0xde, 0x82,
+	0x96, 0x26, 0xf5, 0xf6, 0x7a, 0xc2, 0xb8, 0xd9, 0x83, 0x94, 0x97, 0x98, 0x5f, 0x93, 0x32, 0x7b,
+	0x6c, 0xf2, 0x33, 0x27, 0x90, 0x63, 0x54, 0xd1, 0xe2, 0x9c, 0x56, 0xd1, 0xff, 0xbb, 0x7f, 0xc6,
+	0xdd, 0x2f, 0x69, 0xb4, 0xaf, 0x1b, 0xf8, 0x7d, 0x1c, 0xce, 0x8d, 0x70, 0x78, 0x14, 0xc7, 0xe9,
+	0x71, 0x38, 0x4e, 0x02, 0x64, 0x66, 0x32, 0xd5, 0x7f, 0xcc, 0xc0, 0x69, 0x7d, 0xf9, 0x0d, 0xaf,
+	0x76, 0xed, 0xca, 0x4d, 0xec, 0x76, 0xc4, 0x1e, 0xb2, 0xd7, 0x8e, 0xc0, 0x39, 0xc8, 0x5b, 0x46,
+	0x99, 0x89, 0xc3, 0xf0, 0x6e, 0xde, 0xc8, 0x6e, 0x46, 0xa2, 0x83, 0x62, 0x40, 0x20, 0x17, 0x50,
+	0x1f, 0xed, 0x9d, 0xf5, 0xff, 0xba, 0x0a, 0xee, 0xf9, 0x0d, 0xd1, 0x31, 0xfc, 0xf2, 0xec, 0x2a,
+	0xaa, 0x82, 0x0c, 0x9b, 0xdc, 0xa7, 0x1d, 0x43, 0x9a, 0x9c, 0x97, 0xac, 0x27, 0x62, 0x39, 0x37,
+	0x19, 0xcb, 0x2f, 0xb3, 0x70, 0x6a, 0xa4, 0xbb, 0xff, 0x17, 0x50, 0x0e, 0x75, 0xa0, 0xdc, 0x68,
+	0x07, 0x1a, 0x9d, 0x10, 0xa6, 0xff, 0xb9, 0x09, 0x61, 0xe6, 0xe8, 0x13, 0xc2, 0xec, 0xc8, 0x84,
+	0x70, 0x88, 0xb7, 0xee, 0xbe, 0x67, 0xab, 0xb8, 0x99, 0xff, 0x5e, 0xb1, 0x73, 0xba, 0xbf, 0x39,
+	0x70, 0xd2, 0x0c, 0xe1, 0x3d, 0xbf, 0x9b, 0x18, 0xdf, 0x42, 0x3c, 0x62, 0xe7, 0x1d, 0x6e, 0x8a,
+	0xd9, 0xa3, 0x36, 0x45, 0x72, 0x1b, 0x96, 0x94, 0x50, 0xb4, 0x53, 0x4f, 0x79, 0x3d, 0xd0, 0x90,
+	0x56, 0xd0, 0x66, 0xd5, 0xa4, 0xbb, 0x7e, 0xe1, 0xc0, 0xaa, 0x99, 0x31, 0x7a, 0x0d, 0x9f, 0xab,
+	0x2a, 0x65, 0x5b, 0xbc, 0x15, 0x50, 0xd5, 0x0b, 0x71, 0xa3, 0xcf, 0x19, 0x46, 0xc4, 0xb9, 0x04,
+	0xc7, 0x1b, 0x94, 0xe9, 0x01, 0x49, 0xc6, 0x9b, 0x76, 0x0a, 0x5b, 0x6c, 0x50, 0xb6, 0xa1, 0xda,
+	0x89, 0x0d, 0x79, 0x17, 0xce, 0x8c, 0xe8, 0xd6, 0x65, 0xaf, 0x11, 0x11, 0xcc, 0x62, 0x72, 0x6a,
+	0x9f, 0xcd, 0x96, 0xd9, 0x75, 0xbf, 0x77, 0xe0, 0x44, 0xfc, 0x90, 0x0c, 0xeb, 0xb6, 0x3a, 0x54,
+	0xea, 0x0f, 0x92, 0xae, 0xd8, 0xc5, 0x50, 0x1f, 0x99, 0xf5, 0xcc, 0x22, 0x42, 0x3a, 0x44, 0x2a,
+	0x45, 0x10, 0x23, 0x6d, 0x56, 0xd1, 0xc8, 0xd8, 0x14, 0x81, 0xc4, 0x40, 0xf6, 0xe4, 0xbe, 0x79,
+	0x6e, 0x29, 0xd9, 0x88, 0x1b, 0xc3, 0x9b, 0xb0, 0x94, 0xcc, 0x7f, 0xb1, 0xae, 0x29, 0x42, 0x8b,
+	0xb1, 0x3c, 0x56, 0x2d, 0xc2, 0xac, 0x2f, 0x02, 0xbe, 0x93, 0x74, 0xbd, 0x78, 0xe9, 0x7e, 0xed,
+	0xc0, 0x72, 0xba, 0x83, 0xdb, 0x3e, 0x98, 0x26, 0x83, 0x33, 0x71, 0x0c, 0xcb, 0x4c, 0x1c, 0xc3,
+	0xb2, 0x47, 0x1a, 0xc3, 0x5c, 0x09, 0x67, 0x86, 0xda, 0x1b, 0xed, 0xc8, 0x9a, 0xf0, 0xbb, 0x1d,
+	0x54, 0xc8, 0x26, 0x7c, 0xe3, 0x7d, 0x00, 0xf3, 0xbb, 0x03, 0xed, 0x62, 0x46, 0x57, 0x87, 0xd2,
+	0xb8, 0xea, 0x30, 0x70, 0xea, 0xa5, 0x4d, 0xdc, 0x5d, 0x80, 0xc1, 0xd6, 0xa1, 0xee, 0x7f, 0x7d,
+	0xdf, 0xfd, 0x0f, 0x36, 0x14, 0xb8, 0x3f, 0xc5, 0x39, 0x48, 0xb8, 0x73, 0x97, 0xf2, 0xe8, 0xeb,
+	0xef, 0x9d, 0x84, 0x26, 0xe6, 0x27, 0x82, 0xb1, 0xd7, 0x89, 0x74, 0x3d, 0xad, 0x95, 0xd0, 0x28,
+	0x21, 0x5d, 0x26, 0x4d, 0xba, 0xd7, 0x4e, 0xae, 0x4b, 0xef, 0x03, 0x0c, 0xa2, 0x23, 0x45, 0x58,
+	0xbe, 0xc7, 0xa5, 0xe4, 0x41, 0x6b, 0xe8, 0xfb, 0x63, 0x69, 0x8a, 0x9c, 0x86, 0x13, 0x76, 0xc7,
+	0x7c, 0xfd, 0xda, 0x0d, 0xa7, 0x8a, 0x4f, 0x5f, 0x94, 0x9c, 0x67, 0x2f, 0x4a, 0xce, 0xaf, 0x2f,
+	0x4a, 0xce, 0x57, 0x2f, 0x4b, 0x53, 0xcf, 0x5e, 0x96, 0xa6, 0x7e, 0x7e, 0x59, 0x9a, 0xfa, 0xf4,
+	0xc3, 0x14, 0xa5, 0x36, 0x63, 0x50, 0x3e, 0xa2, 0x0d, 0x59, 0x49, 0x20, 0xba, 0xdc, 0x14, 0x21,
+	0xa6, 0x97, 0xd1, 0x27, 0x70, 0xc5, 0x17, 0xac, 0xd7, 0x41, 0x69, 0x7f, 0xba, 0xd1, 0xdc, 0x6b,
+	0xcc, 0xe8, 0x1f, 0x6e, 0xde, 0xfe, 0x6b, 0x00, 0xa8, 0x82, 0xee, 0x41, 0x4b, 0x12, 0x00, 0x00,
 }
 
 func (m *EventAttestationObserved) Marshal() (dAtA []byte, err error) {
@@ -2410,6 +2467,61 @@ func (m *EventCancelSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBumpSendToEthFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBumpSendToEthFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBumpSendToEthFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBridgeFee.Size()
+		i -= size
+		if _, err := m.TotalBridgeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BridgeFee.Size()
+		i -= size
+		if _, err := m.BridgeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OutgoingTxId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutgoingTxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3087,6 +3199,26 @@ func (m *EventCancelSendToEth) Size() (n int) {
 	return n
 }
 
+func (m *EventBumpSendToEthFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OutgoingTxId != 0 {
+		n += 1 + sovEvents(uint64(m.OutgoingTxId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TotalBridgeFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EventSubmitBadSignatureEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5899,6 +6031,175 @@ func (m *EventCancelSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBumpSendToEthFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBumpSendToEthFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBumpSendToEthFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
+			}
+			m.OutgoingTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBridgeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
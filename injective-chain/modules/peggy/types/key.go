@@ -119,6 +119,9 @@ var (
 
 	RateLimitsKey       = []byte{0x1e}
 	MintAmountsERC20Key = []byte{0x1f}
+
+	// SecondIndexOutgoingTXTimeKey indexes unbatched txs by token contract address and creation time
+	SecondIndexOutgoingTXTimeKey = []byte{0x20}
 )
 
 func GetEthereumBlacklistStoreKey(addr common.Address) []byte {
@@ -251,6 +254,19 @@ func GetFeeSecondIndexKey(tokenContract common.Address, fee *ERC20Token) []byte
 	return buf
 }
 
+// GetTimeSecondIndexKey returns the following key format
+// prefix            eth-contract-address            					created_at          tx_id
+// [0x20][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 0][0 0 0 0 0 0 0 0]
+func GetTimeSecondIndexKey(tokenContract common.Address, createdAt int64, txID uint64) []byte {
+	buf := make([]byte, 0, len(SecondIndexOutgoingTXTimeKey)+ETHContractAddressLen+16)
+	buf = append(buf, SecondIndexOutgoingTXTimeKey...)
+	buf = append(buf, tokenContract.Bytes()...)
+	buf = append(buf, UInt64Bytes(uint64(createdAt))...)
+	buf = append(buf, UInt64Bytes(txID)...)
+
+	return buf
+}
+
 // GetLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // GetLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
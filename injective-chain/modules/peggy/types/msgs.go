@@ -21,6 +21,7 @@ var (
 	_ sdk.Msg = &MsgDepositClaim{}
 	_ sdk.Msg = &MsgWithdrawClaim{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
+	_ sdk.Msg = &MsgBumpSendToEthFee{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
 	_ sdk.Msg = &MsgUpdateParams{}
@@ -593,6 +594,46 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgBumpSendToEthFee returns a new MsgBumpSendToEthFee
+func NewMsgBumpSendToEthFee(sender sdk.AccAddress, id uint64, fee sdk.Coin) *MsgBumpSendToEthFee {
+	return &MsgBumpSendToEthFee{
+		TransactionId: id,
+		Sender:        sender.String(),
+		BridgeFee:     fee,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgBumpSendToEthFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgBumpSendToEthFee) Type() string { return "bump_send_to_eth_fee" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgBumpSendToEthFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if !msg.BridgeFee.IsValid() || msg.BridgeFee.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBumpSendToEthFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgBumpSendToEthFee) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
 // MsgSubmitBadSignatureEvidence
 // ======================================================
 
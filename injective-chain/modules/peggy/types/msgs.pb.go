@@ -1080,6 +1080,107 @@ func (m *MsgCancelSendToEthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthResponse proto.InternalMessageInfo
 
+// This call allows the sender (and only the sender) to increase the bridge fee
+// of a given MsgSendToEth that has not been batched yet, so it is picked into a
+// batch sooner. The added fee is taken from the sender's balance.
+type MsgBumpSendToEthFee struct {
+	TransactionId uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Sender        string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The fee to add to the transfer's bridge fee, must be of the transferred
+	// token's denom
+	BridgeFee types.Coin `protobuf:"bytes,3,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+}
+
+func (m *MsgBumpSendToEthFee) Reset()         { *m = MsgBumpSendToEthFee{} }
+func (m *MsgBumpSendToEthFee) String() string { return proto.CompactTextString(m) }
+func (*MsgBumpSendToEthFee) ProtoMessage()    {}
+func (*MsgBumpSendToEthFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{18}
+}
+func (m *MsgBumpSendToEthFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBumpSendToEthFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBumpSendToEthFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBumpSendToEthFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBumpSendToEthFee.Merge(m, src)
+}
+func (m *MsgBumpSendToEthFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBumpSendToEthFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBumpSendToEthFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBumpSendToEthFee proto.InternalMessageInfo
+
+func (m *MsgBumpSendToEthFee) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *MsgBumpSendToEthFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBumpSendToEthFee) GetBridgeFee() types.Coin {
+	if m != nil {
+		return m.BridgeFee
+	}
+	return types.Coin{}
+}
+
+type MsgBumpSendToEthFeeResponse struct {
+}
+
+func (m *MsgBumpSendToEthFeeResponse) Reset()         { *m = MsgBumpSendToEthFeeResponse{} }
+func (m *MsgBumpSendToEthFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBumpSendToEthFeeResponse) ProtoMessage()    {}
+func (*MsgBumpSendToEthFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{19}
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBumpSendToEthFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBumpSendToEthFeeResponse.Merge(m, src)
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBumpSendToEthFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBumpSendToEthFeeResponse proto.InternalMessageInfo
+
 // This call allows anyone to submit evidence that a
 // validator has signed a valset, batch, or logic call that never
 // existed. Subject contains the batch, valset, or logic call.
@@ -1093,7 +1194,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{18}
+	return fileDescriptor_751daa04abed7ef4, []int{20}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1150,7 +1251,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{19}
+	return fileDescriptor_751daa04abed7ef4, []int{21}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1296,7 @@ func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{20}
+	return fileDescriptor_751daa04abed7ef4, []int{22}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1374,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{21}
+	return fileDescriptor_751daa04abed7ef4, []int{23}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1416,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{22}
+	return fileDescriptor_751daa04abed7ef4, []int{24}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,7 +1466,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{23}
+	return fileDescriptor_751daa04abed7ef4, []int{25}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1508,7 @@ func (m *MsgBlacklistEthereumAddresses) Reset()         { *m = MsgBlacklistEther
 func (m *MsgBlacklistEthereumAddresses) String() string { return proto.CompactTextString(m) }
 func (*MsgBlacklistEthereumAddresses) ProtoMessage()    {}
 func (*MsgBlacklistEthereumAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{24}
+	return fileDescriptor_751daa04abed7ef4, []int{26}
 }
 func (m *MsgBlacklistEthereumAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1459,7 +1560,7 @@ func (m *MsgBlacklistEthereumAddressesResponse) Reset()         { *m = MsgBlackl
 func (m *MsgBlacklistEthereumAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBlacklistEthereumAddressesResponse) ProtoMessage()    {}
 func (*MsgBlacklistEthereumAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{25}
+	return fileDescriptor_751daa04abed7ef4, []int{27}
 }
 func (m *MsgBlacklistEthereumAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1501,7 +1602,7 @@ func (m *MsgRevokeEthereumBlacklist) Reset()         { *m = MsgRevokeEthereumBla
 func (m *MsgRevokeEthereumBlacklist) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeEthereumBlacklist) ProtoMessage()    {}
 func (*MsgRevokeEthereumBlacklist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{26}
+	return fileDescriptor_751daa04abed7ef4, []int{28}
 }
 func (m *MsgRevokeEthereumBlacklist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1553,7 +1654,7 @@ func (m *MsgRevokeEthereumBlacklistResponse) Reset()         { *m = MsgRevokeEth
 func (m *MsgRevokeEthereumBlacklistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeEthereumBlacklistResponse) ProtoMessage()    {}
 func (*MsgRevokeEthereumBlacklistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{27}
+	return fileDescriptor_751daa04abed7ef4, []int{29}
 }
 func (m *MsgRevokeEthereumBlacklistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,7 +1707,7 @@ func (m *MsgCreateRateLimit) Reset()         { *m = MsgCreateRateLimit{} }
 func (m *MsgCreateRateLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRateLimit) ProtoMessage()    {}
 func (*MsgCreateRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{28}
+	return fileDescriptor_751daa04abed7ef4, []int{30}
 }
 func (m *MsgCreateRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,7 +1778,7 @@ func (m *MsgCreateRateLimitResponse) Reset()         { *m = MsgCreateRateLimitRe
 func (m *MsgCreateRateLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRateLimitResponse) ProtoMessage()    {}
 func (*MsgCreateRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{29}
+	return fileDescriptor_751daa04abed7ef4, []int{31}
 }
 func (m *MsgCreateRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +1824,7 @@ func (m *MsgUpdateRateLimit) Reset()         { *m = MsgUpdateRateLimit{} }
 func (m *MsgUpdateRateLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimit) ProtoMessage()    {}
 func (*MsgUpdateRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{30}
+	return fileDescriptor_751daa04abed7ef4, []int{32}
 }
 func (m *MsgUpdateRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1787,7 +1888,7 @@ func (m *MsgUpdateRateLimitResponse) Reset()         { *m = MsgUpdateRateLimitRe
 func (m *MsgUpdateRateLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimitResponse) ProtoMessage()    {}
 func (*MsgUpdateRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{31}
+	return fileDescriptor_751daa04abed7ef4, []int{33}
 }
 func (m *MsgUpdateRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,7 +1928,7 @@ func (m *MsgRemoveRateLimit) Reset()         { *m = MsgRemoveRateLimit{} }
 func (m *MsgRemoveRateLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRateLimit) ProtoMessage()    {}
 func (*MsgRemoveRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{32}
+	return fileDescriptor_751daa04abed7ef4, []int{34}
 }
 func (m *MsgRemoveRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,7 +1978,7 @@ func (m *MsgRemoveRateLimitResponse) Reset()         { *m = MsgRemoveRateLimitRe
 func (m *MsgRemoveRateLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRateLimitResponse) ProtoMessage()    {}
 func (*MsgRemoveRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{33}
+	return fileDescriptor_751daa04abed7ef4, []int{35}
 }
 func (m *MsgRemoveRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1925,6 +2026,8 @@ func init() {
 	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "injective.peggy.v1.MsgERC20DeployedClaimResponse")
 	proto.RegisterType((*MsgCancelSendToEth)(nil), "injective.peggy.v1.MsgCancelSendToEth")
 	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "injective.peggy.v1.MsgCancelSendToEthResponse")
+	proto.RegisterType((*MsgBumpSendToEthFee)(nil), "injective.peggy.v1.MsgBumpSendToEthFee")
+	proto.RegisterType((*MsgBumpSendToEthFeeResponse)(nil), "injective.peggy.v1.MsgBumpSendToEthFeeResponse")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "injective.peggy.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "injective.peggy.v1.MsgSubmitBadSignatureEvidenceResponse")
 	proto.RegisterType((*MsgValsetUpdatedClaim)(nil), "injective.peggy.v1.MsgValsetUpdatedClaim")
@@ -1946,137 +2049,141 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/msgs.proto", fileDescriptor_751daa04abed7ef4) }
 
 var fileDescriptor_751daa04abed7ef4 = []byte{
-	// 2076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0xdb, 0xe3, 0x24, 0x2e, 0xdb, 0x31, 0xe9, 0x75, 0x92, 0x49, 0x27, 0xb1, 0xe3, 0x76,
-	0x7e, 0xbc, 0x4e, 0xb6, 0x27, 0xe3, 0xc0, 0x2e, 0x6b, 0x09, 0xa4, 0x8c, 0x1d, 0x84, 0x45, 0xbc,
-	0x44, 0xed, 0xec, 0xae, 0xc4, 0xa5, 0xa9, 0xe9, 0x7e, 0xe9, 0x69, 0x3c, 0xdd, 0x35, 0x74, 0xd5,
-	0x8c, 0xf1, 0x01, 0x69, 0xd9, 0xe3, 0x72, 0x00, 0x89, 0x03, 0xe2, 0x00, 0x17, 0xb8, 0xae, 0x14,
-	0x24, 0x2e, 0xec, 0x19, 0xa4, 0x3d, 0x46, 0x70, 0x41, 0x08, 0x45, 0x28, 0x41, 0x8a, 0xc4, 0x89,
-	0x3b, 0x07, 0x50, 0x57, 0x55, 0xd7, 0x74, 0xf7, 0x74, 0x8f, 0x27, 0xab, 0x68, 0x2f, 0xd6, 0xd4,
-	0xab, 0xf7, 0xaa, 0xbe, 0xf7, 0x5b, 0xef, 0xb5, 0xd1, 0x95, 0x20, 0xfa, 0x01, 0xb8, 0x2c, 0x18,
-	0x40, 0xa3, 0x07, 0xbe, 0x7f, 0xd4, 0x18, 0x34, 0x1b, 0x21, 0xf5, 0xa9, 0xd5, 0x8b, 0x09, 0x23,
-	0xba, 0xae, 0xb6, 0x2d, 0xbe, 0x6d, 0x0d, 0x9a, 0xc6, 0x92, 0x4f, 0x7c, 0xc2, 0xb7, 0x1b, 0xc9,
-	0x2f, 0xc1, 0x69, 0x5c, 0xf6, 0x09, 0xf1, 0xbb, 0xd0, 0xc0, 0xbd, 0xa0, 0x81, 0xa3, 0x88, 0x30,
-	0xcc, 0x02, 0x12, 0xc9, 0x73, 0x8c, 0x8b, 0x72, 0x97, 0xaf, 0xda, 0xfd, 0xc7, 0x0d, 0x1c, 0x1d,
-	0xc9, 0xad, 0xb3, 0x38, 0x0c, 0x22, 0xd2, 0xe0, 0x7f, 0x25, 0x69, 0xd9, 0x25, 0x34, 0x24, 0xb4,
-	0xd1, 0xc6, 0x14, 0x1a, 0x83, 0x66, 0x1b, 0x18, 0x6e, 0x36, 0x5c, 0x12, 0x44, 0x72, 0xff, 0x82,
-	0xdc, 0x0f, 0xa9, 0x2f, 0xf1, 0xa6, 0xd7, 0x88, 0x0d, 0x47, 0xa0, 0x13, 0x8b, 0xf4, 0xcc, 0x12,
-	0x45, 0xd9, 0x51, 0x0f, 0xd2, 0xfd, 0x95, 0x92, 0xfd, 0x1e, 0x8e, 0x71, 0x98, 0x32, 0xac, 0x95,
-	0x30, 0xc4, 0x98, 0x81, 0xd3, 0x0d, 0xc2, 0x80, 0x09, 0x26, 0xf3, 0x53, 0x0d, 0x5d, 0xda, 0xa3,
-	0xfe, 0x3e, 0xb0, 0xef, 0xc6, 0x6e, 0x07, 0x28, 0x8b, 0x31, 0x23, 0xf1, 0x3d, 0xcf, 0x8b, 0x81,
-	0x52, 0xa0, 0xfa, 0x79, 0x74, 0x92, 0x42, 0xe4, 0x41, 0x5c, 0xd7, 0xae, 0x6a, 0xeb, 0xb3, 0xb6,
-	0x5c, 0xe9, 0x26, 0x9a, 0x27, 0x19, 0x81, 0xfa, 0x14, 0xdf, 0xcd, 0xd1, 0xf4, 0x15, 0x34, 0x07,
-	0xac, 0xe3, 0x60, 0x71, 0x58, 0x7d, 0x9a, 0xb3, 0x20, 0x60, 0x1d, 0x79, 0xfc, 0x56, 0xf3, 0xe3,
-	0x97, 0x4f, 0x36, 0xe4, 0x89, 0x9f, 0xbc, 0x7c, 0xb2, 0xb1, 0x2a, 0x70, 0x8e, 0xc1, 0x63, 0x5e,
-	0x47, 0x6b, 0x63, 0xb6, 0x6d, 0xa0, 0x3d, 0x12, 0x51, 0x30, 0xff, 0xa8, 0xa1, 0xaf, 0xec, 0x51,
-	0xff, 0x03, 0xdc, 0xa5, 0xc0, 0xb6, 0x49, 0xf4, 0x38, 0x88, 0x43, 0x7d, 0x09, 0xcd, 0x44, 0x24,
-	0x72, 0x81, 0xab, 0x52, 0xb3, 0xc5, 0xe2, 0xb5, 0x68, 0xa2, 0x5f, 0x46, 0xb3, 0x34, 0xf0, 0x23,
-	0xcc, 0xfa, 0x31, 0xd4, 0x6b, 0x7c, 0x7b, 0x48, 0xd8, 0xba, 0x9d, 0xe8, 0x99, 0x3b, 0x31, 0xd1,
-	0xf6, 0xbc, 0xd2, 0x36, 0x07, 0xd3, 0x34, 0x50, 0xbd, 0x48, 0x53, 0x7a, 0x3d, 0xd3, 0xd0, 0x3c,
-	0xd7, 0x3f, 0xf2, 0x1e, 0x91, 0xfb, 0xac, 0x53, 0xe9, 0x9f, 0x8b, 0xe8, 0x74, 0x82, 0xd8, 0x03,
-	0xca, 0xa4, 0x46, 0xa7, 0x80, 0x75, 0x76, 0x80, 0x32, 0xfd, 0x1d, 0x74, 0x12, 0x87, 0xa4, 0x1f,
-	0x31, 0xae, 0xc7, 0xdc, 0xe6, 0x45, 0x4b, 0xc6, 0x5d, 0x12, 0xbd, 0x96, 0x8c, 0x5e, 0x6b, 0x9b,
-	0x04, 0x51, 0xab, 0xf6, 0xf9, 0xb3, 0x95, 0x13, 0xb6, 0x64, 0xd7, 0xbf, 0x89, 0x50, 0x3b, 0x0e,
-	0x3c, 0x1f, 0x9c, 0xc7, 0x20, 0xb4, 0x9c, 0x40, 0x78, 0x56, 0x88, 0x7c, 0x0b, 0x60, 0xcb, 0x2c,
-	0xb8, 0x5b, 0xcf, 0xb8, 0x5b, 0xea, 0x63, 0x9e, 0x47, 0x4b, 0xd9, 0xb5, 0x52, 0xfc, 0x47, 0x68,
-	0x71, 0x8f, 0xfa, 0x36, 0xfc, 0xb0, 0x0f, 0x94, 0xb5, 0x30, 0x73, 0x3b, 0x23, 0x8e, 0xd3, 0x4a,
-	0x1c, 0xb7, 0x84, 0x66, 0x3c, 0x88, 0x48, 0x28, 0x6d, 0x20, 0x16, 0x5b, 0xb7, 0x4a, 0xfd, 0x71,
-	0x4e, 0xc1, 0xc9, 0x5e, 0x63, 0x5e, 0x44, 0x17, 0x0a, 0x24, 0x05, 0xea, 0x1f, 0x1a, 0x47, 0x25,
-	0x9d, 0x24, 0x50, 0x95, 0x07, 0xd9, 0x75, 0x74, 0x86, 0x91, 0x03, 0x88, 0x1c, 0x97, 0x44, 0x2c,
-	0xc6, 0x6e, 0xea, 0x94, 0x05, 0x4e, 0xdd, 0x96, 0x44, 0xfd, 0x0a, 0x4a, 0x82, 0xca, 0x49, 0x22,
-	0x07, 0x62, 0x19, 0x66, 0xb3, 0xc0, 0x3a, 0xfb, 0x9c, 0x30, 0xa2, 0x71, 0xad, 0x44, 0xe3, 0x5c,
-	0x24, 0xce, 0x14, 0x23, 0xf1, 0x38, 0xcd, 0xb3, 0xaa, 0x48, 0xcd, 0xb3, 0x24, 0xa5, 0xf9, 0xbf,
-	0xa7, 0xb8, 0xe6, 0x3b, 0xd0, 0x23, 0x34, 0x60, 0xdb, 0x5d, 0x1c, 0x84, 0x3c, 0x49, 0x06, 0x10,
-	0x31, 0x27, 0xab, 0x3f, 0xe2, 0xa4, 0xf7, 0xb8, 0x11, 0x56, 0xd1, 0x7c, 0xbb, 0x4b, 0xdc, 0x03,
-	0xa7, 0x03, 0x81, 0xdf, 0x11, 0x26, 0xa8, 0xd9, 0x73, 0x9c, 0xf6, 0x6d, 0x4e, 0x2a, 0xb1, 0xd3,
-	0x74, 0x99, 0x9d, 0xbe, 0xa6, 0x42, 0x98, 0x9b, 0xa0, 0x75, 0x25, 0x09, 0xb5, 0xbf, 0x3f, 0x5b,
-	0x39, 0x27, 0x82, 0x91, 0x7a, 0x07, 0x56, 0x40, 0x1a, 0x21, 0x66, 0x1d, 0x6b, 0x37, 0x62, 0x2a,
-	0x80, 0x6f, 0xa2, 0x45, 0x60, 0x1d, 0x88, 0xa1, 0x1f, 0x3a, 0x32, 0x6b, 0x84, 0x85, 0xce, 0xa4,
-	0xe4, 0x7d, 0x91, 0x3d, 0x37, 0xd1, 0xa2, 0x2c, 0xcc, 0x31, 0xb8, 0x10, 0x0c, 0x20, 0xae, 0x9f,
-	0x14, 0x8c, 0x82, 0x6c, 0x4b, 0xea, 0x88, 0x47, 0x4e, 0x95, 0x78, 0x44, 0x47, 0x35, 0x0f, 0x33,
-	0x5c, 0x3f, 0xcd, 0xf7, 0xf8, 0xef, 0x63, 0xfd, 0x90, 0x35, 0xac, 0xf4, 0x43, 0x96, 0xa4, 0xfc,
-	0xf0, 0x1f, 0x51, 0xe7, 0x3e, 0x0c, 0x58, 0xc7, 0x8b, 0xf1, 0xe1, 0xeb, 0x73, 0xc4, 0x0a, 0x9a,
-	0x6b, 0x27, 0x1e, 0x97, 0x67, 0x4c, 0x8b, 0x33, 0x38, 0xe9, 0xbd, 0x8a, 0x88, 0xae, 0x95, 0x79,
-	0xaa, 0x68, 0xa0, 0x99, 0x51, 0x03, 0x1d, 0x5b, 0x1e, 0x73, 0xda, 0xc9, 0xf2, 0x98, 0xa3, 0x29,
-	0x73, 0xfc, 0x69, 0x0a, 0x9d, 0xdb, 0xa3, 0xfe, 0x7d, 0x7b, 0x7b, 0xf3, 0xce, 0x0e, 0xf4, 0xba,
-	0xe4, 0x08, 0xbc, 0xd7, 0x67, 0x93, 0x55, 0x34, 0x2f, 0xa3, 0x42, 0xd4, 0x14, 0x11, 0x9a, 0x73,
-	0x82, 0xb6, 0x93, 0x90, 0x26, 0xb5, 0x8a, 0x8e, 0x6a, 0x11, 0x0e, 0xd3, 0xfc, 0xe4, 0xbf, 0x79,
-	0x25, 0x3f, 0x0a, 0xdb, 0xa4, 0x2b, 0x43, 0x4d, 0xae, 0x74, 0x03, 0x9d, 0xf6, 0xc0, 0x0d, 0x42,
-	0xdc, 0xa5, 0x3c, 0xbc, 0x6a, 0xb6, 0x5a, 0x8f, 0x58, 0xf7, 0x74, 0x89, 0x75, 0x9b, 0xa5, 0xd6,
-	0xbd, 0xa4, 0xac, 0x3b, 0x6a, 0x2c, 0x73, 0x05, 0x5d, 0x29, 0xdd, 0x50, 0x76, 0xfe, 0x31, 0xd2,
-	0x93, 0xca, 0x80, 0x23, 0x17, 0xba, 0xc3, 0xb7, 0x28, 0x51, 0x3e, 0xc6, 0x11, 0xc5, 0x6e, 0xd2,
-	0x49, 0x39, 0x81, 0x27, 0xcd, 0xbc, 0x90, 0xa1, 0xee, 0x7a, 0x99, 0x27, 0x6b, 0x2a, 0xfb, 0x64,
-	0x6d, 0xad, 0x17, 0x9e, 0x87, 0xfa, 0xb0, 0x2a, 0xe5, 0x2f, 0x32, 0x2f, 0x23, 0x63, 0x94, 0xaa,
-	0xc0, 0x7d, 0xa6, 0x71, 0xf8, 0xfb, 0xfd, 0x76, 0x18, 0xb0, 0x16, 0xf6, 0xf6, 0xd3, 0xea, 0x77,
-	0x7f, 0x10, 0x78, 0x90, 0xf8, 0xda, 0x42, 0xa7, 0x68, 0xbf, 0x9d, 0x34, 0x47, 0x1c, 0xe1, 0xdc,
-	0xe6, 0x92, 0x25, 0xda, 0x3d, 0x2b, 0x6d, 0xf7, 0xac, 0x7b, 0xd1, 0x91, 0x9d, 0x32, 0xe5, 0x6b,
-	0xea, 0x54, 0xa1, 0xa6, 0x66, 0xf4, 0x99, 0xce, 0xe9, 0x73, 0xb7, 0xa0, 0xcf, 0xda, 0xf0, 0xb9,
-	0xab, 0x84, 0x66, 0xde, 0x44, 0xd7, 0xc7, 0x32, 0x28, 0x2d, 0xff, 0x2b, 0x42, 0x5d, 0xb4, 0x09,
-	0xef, 0xf7, 0x3c, 0xcc, 0x5e, 0x25, 0xd4, 0x07, 0x5c, 0x4c, 0x72, 0xc8, 0x50, 0x17, 0xb4, 0xf2,
-	0x6c, 0x98, 0x1e, 0xcd, 0x86, 0x6f, 0xa0, 0x53, 0x21, 0x84, 0x6d, 0x88, 0x69, 0xbd, 0x76, 0x75,
-	0x7a, 0x7d, 0x6e, 0x73, 0xcd, 0x1a, 0xed, 0xbd, 0xad, 0x16, 0x7f, 0xfd, 0x3f, 0xc0, 0xdd, 0xc0,
-	0x4b, 0x42, 0xcf, 0x4e, 0x65, 0xf4, 0x16, 0x5a, 0x88, 0xe1, 0x10, 0xc7, 0x9e, 0x23, 0x2b, 0xf9,
-	0xcc, 0x24, 0x95, 0x7c, 0x5e, 0xc8, 0xdc, 0x13, 0xf5, 0x7c, 0x15, 0xc9, 0xb5, 0xc3, 0xd3, 0x4b,
-	0x26, 0xce, 0x9c, 0xa0, 0x3d, 0x4a, 0x48, 0x93, 0x14, 0xe8, 0x63, 0x33, 0x64, 0xd4, 0xc6, 0x32,
-	0x43, 0x46, 0x37, 0x94, 0x7b, 0x3e, 0x15, 0xad, 0x81, 0xd8, 0x7b, 0xc8, 0xdb, 0x72, 0xfd, 0x6d,
-	0x34, 0x8b, 0xfb, 0xac, 0x43, 0xe2, 0x80, 0x1d, 0x89, 0x6e, 0xa5, 0x55, 0xff, 0xcb, 0x1f, 0xde,
-	0x5a, 0x92, 0x1d, 0x94, 0xec, 0x25, 0xf7, 0x59, 0x1c, 0x44, 0xbe, 0x3d, 0x64, 0xd5, 0xbf, 0x8e,
-	0x4e, 0x8a, 0xc6, 0x9e, 0x7b, 0x6a, 0x6e, 0xd3, 0x28, 0x33, 0xb4, 0xb8, 0x23, 0xed, 0xd8, 0x04,
-	0xbf, 0x48, 0xa9, 0xe1, 0x49, 0xf9, 0x37, 0x26, 0x8b, 0x4d, 0xbe, 0x31, 0x59, 0x92, 0x52, 0xe5,
-	0xd7, 0x22, 0x9f, 0x5a, 0x5d, 0xec, 0x1e, 0x74, 0x03, 0xca, 0xee, 0xcb, 0xb7, 0x32, 0x3f, 0x24,
-	0x88, 0x96, 0x25, 0x6d, 0x42, 0x45, 0xbf, 0xd2, 0x40, 0x6f, 0xb4, 0x53, 0xa9, 0xb4, 0x79, 0x86,
-	0x44, 0x8b, 0xe9, 0xf5, 0x59, 0x5b, 0x57, 0x5b, 0xea, 0xa0, 0x34, 0x65, 0xb8, 0x74, 0x3e, 0x65,
-	0xaa, 0x6f, 0x97, 0x29, 0x53, 0xcd, 0xa0, 0x14, 0xf9, 0xa5, 0xc6, 0xeb, 0x86, 0x0d, 0x03, 0x72,
-	0x00, 0x29, 0x9b, 0x92, 0x7b, 0x7d, 0x5a, 0xdc, 0x29, 0x68, 0x71, 0x35, 0xd3, 0x58, 0x96, 0x5e,
-	0x6d, 0x5e, 0x43, 0x66, 0xf5, 0xae, 0xc2, 0xff, 0xfb, 0x69, 0x51, 0x76, 0x63, 0xc0, 0x0c, 0x6c,
-	0xcc, 0xe0, 0x41, 0x32, 0xc8, 0x7d, 0xe1, 0xb0, 0x5a, 0x43, 0xe2, 0x55, 0x52, 0x63, 0x8d, 0x9c,
-	0x7c, 0x38, 0x31, 0x1d, 0x6c, 0xd4, 0x83, 0xa6, 0xde, 0xa0, 0xa4, 0x14, 0x2c, 0xc8, 0x07, 0x6d,
-	0x27, 0x7d, 0x88, 0xae, 0xa5, 0x6c, 0xbd, 0x38, 0x70, 0x21, 0x29, 0xfd, 0xb5, 0xcc, 0x61, 0x0f,
-	0x13, 0xe2, 0xae, 0xa7, 0xef, 0xa2, 0x33, 0xc3, 0x01, 0xd4, 0xe9, 0x53, 0x4f, 0x26, 0xfd, 0x9a,
-	0x4c, 0xfa, 0x4b, 0xa3, 0x49, 0xff, 0x00, 0x7c, 0xec, 0x1e, 0xed, 0x80, 0x6b, 0xcf, 0xc7, 0xa9,
-	0xc6, 0xef, 0x53, 0x4f, 0xdf, 0x43, 0x6f, 0xe0, 0x36, 0x25, 0xdd, 0x3e, 0x03, 0x27, 0x0c, 0x22,
-	0x26, 0xce, 0x14, 0x15, 0xe0, 0xb8, 0x22, 0x72, 0x36, 0x95, 0xdc, 0x0b, 0x22, 0x26, 0x6c, 0xb8,
-	0x81, 0xce, 0x66, 0x90, 0x1d, 0x06, 0x91, 0x47, 0x0e, 0xe5, 0x6b, 0xbb, 0xa8, 0xee, 0xfd, 0x90,
-	0x93, 0x45, 0xef, 0x96, 0x4f, 0xaa, 0xcc, 0x53, 0x95, 0x77, 0x4e, 0xfa, 0x54, 0xe5, 0xa9, 0xca,
-	0xa3, 0x4f, 0xa7, 0xb8, 0x47, 0x45, 0xda, 0x7d, 0x49, 0x1e, 0xbd, 0x85, 0xf4, 0x08, 0x0e, 0x9d,
-	0x82, 0xbb, 0xc4, 0xd3, 0xb5, 0x18, 0xc1, 0xe1, 0xa3, 0xac, 0xc7, 0x1e, 0x0a, 0xe6, 0x82, 0xd7,
-	0x6a, 0x93, 0x7b, 0x2d, 0x39, 0xd1, 0xce, 0x3a, 0xae, 0x89, 0xce, 0x15, 0x4e, 0x94, 0xd6, 0x9e,
-	0xe1, 0xd6, 0xd6, 0xb3, 0xfc, 0x93, 0x18, 0xbc, 0x60, 0x3b, 0x69, 0xf0, 0x02, 0x55, 0x19, 0xfc,
-	0x37, 0x1a, 0x37, 0xb8, 0x0d, 0x21, 0x19, 0x7c, 0x49, 0x06, 0x1f, 0x0f, 0xbf, 0x80, 0x44, 0xc2,
-	0x2f, 0x50, 0x53, 0xf8, 0x9b, 0xff, 0xd3, 0xd1, 0xf4, 0x1e, 0xf5, 0xf5, 0x9f, 0x69, 0x68, 0x21,
-	0xff, 0x6d, 0xe3, 0x5a, 0xd9, 0x9b, 0x50, 0xfc, 0x8c, 0x60, 0xdc, 0x9e, 0x84, 0x4b, 0x19, 0x6b,
-	0xe3, 0xe3, 0xbf, 0xfe, 0xeb, 0x17, 0x53, 0xd7, 0x4c, 0xb3, 0x51, 0xf2, 0x25, 0x49, 0x76, 0x10,
-	0xae, 0xbc, 0xff, 0x23, 0x0d, 0xcd, 0x0e, 0x3b, 0xc1, 0xab, 0x15, 0xf7, 0x28, 0x0e, 0x63, 0xfd,
-	0x38, 0x0e, 0x85, 0xe2, 0x26, 0x47, 0xb1, 0x6a, 0xae, 0x94, 0xa1, 0x48, 0xba, 0x2b, 0x87, 0x11,
-	0x07, 0x58, 0x47, 0xff, 0xa9, 0x86, 0xe6, 0x73, 0x1f, 0x08, 0xd6, 0x2a, 0xee, 0xc8, 0x32, 0x19,
-	0xb7, 0x26, 0x60, 0x52, 0x58, 0xde, 0xe4, 0x58, 0xd6, 0xcc, 0xd5, 0x32, 0x2c, 0xb1, 0x90, 0x70,
-	0xf8, 0x90, 0xc4, 0xd1, 0xe4, 0x3e, 0x0c, 0x54, 0xa1, 0xc9, 0x32, 0x55, 0xa2, 0x29, 0x1d, 0xc2,
-	0xc7, 0xa2, 0x91, 0x8e, 0xc9, 0xa0, 0xc9, 0x0d, 0xeb, 0x55, 0x68, 0xb2, 0x4c, 0x95, 0x68, 0x4a,
-	0x47, 0xd1, 0xb1, 0x68, 0x3c, 0x21, 0xe1, 0xb8, 0xfc, 0xf2, 0x24, 0x7c, 0xf3, 0x23, 0x6b, 0x55,
-	0xf8, 0xe6, 0xb8, 0x2a, 0xc3, 0xb7, 0x7c, 0x18, 0x1c, 0x1b, 0xbe, 0x87, 0x52, 0x44, 0x22, 0xfa,
-	0xad, 0x86, 0xce, 0x66, 0xbb, 0x39, 0x81, 0xea, 0xcd, 0xb1, 0xe9, 0x92, 0xed, 0xfb, 0x8c, 0xe6,
-	0xc4, 0xac, 0x0a, 0xdf, 0x1d, 0x8e, 0x6f, 0xc3, 0x5c, 0x1f, 0x93, 0x5e, 0x7d, 0x21, 0x28, 0x51,
-	0xfe, 0x4e, 0x43, 0x7a, 0xc9, 0x6c, 0x5b, 0x05, 0x73, 0x94, 0xb5, 0x12, 0xe6, 0x98, 0x59, 0x6f,
-	0x2c, 0x4c, 0x88, 0xdd, 0xcd, 0x3b, 0x8e, 0x27, 0x05, 0x25, 0xcc, 0xcf, 0x34, 0x54, 0xaf, 0xfc,
-	0xa0, 0xdc, 0xa8, 0x4c, 0xfc, 0x72, 0x01, 0xe3, 0x9d, 0x57, 0x14, 0x50, 0xc0, 0xbf, 0xca, 0x81,
-	0x5b, 0xe6, 0xed, 0xf2, 0xc2, 0xc1, 0x9c, 0x6c, 0xc7, 0x9f, 0x56, 0x70, 0xfd, 0x57, 0x1a, 0x5a,
-	0x2c, 0x0e, 0xb6, 0x37, 0xaa, 0xb2, 0x32, 0xcf, 0x67, 0x58, 0x93, 0xf1, 0x29, 0x84, 0x16, 0x47,
-	0xb8, 0x6e, 0xde, 0x28, 0x4d, 0x60, 0x2e, 0xe4, 0x64, 0x2b, 0xdc, 0x9f, 0x35, 0x64, 0x8c, 0x19,
-	0x6b, 0xab, 0x9c, 0x5b, 0x2d, 0x62, 0xbc, 0xfb, 0xca, 0x22, 0x0a, 0xfc, 0xbb, 0x1c, 0xfc, 0x5d,
-	0xb3, 0x59, 0x6a, 0x5e, 0x2e, 0xef, 0xb4, 0xb1, 0xe7, 0xa8, 0x41, 0xd9, 0x81, 0x14, 0xe8, 0xf7,
-	0xd1, 0x7c, 0x6e, 0x30, 0xaa, 0x2a, 0x46, 0x59, 0xa6, 0xca, 0x62, 0x54, 0x36, 0xb3, 0xe8, 0x9f,
-	0x68, 0xc8, 0x18, 0x33, 0xb0, 0x54, 0x59, 0xaa, 0x5a, 0xa4, 0xd2, 0x52, 0xc7, 0xcf, 0x1d, 0xfa,
-	0x4f, 0x34, 0x74, 0xa1, 0x6a, 0xe8, 0xb0, 0x2a, 0x9f, 0x9f, 0x52, 0x7e, 0xe3, 0xed, 0x57, 0xe3,
-	0x57, 0x18, 0x02, 0xb4, 0x58, 0x9c, 0x1b, 0x2a, 0xa3, 0x3a, 0xcf, 0x57, 0x1d, 0xd5, 0xe5, 0x4d,
-	0x6d, 0x72, 0x55, 0xb1, 0xa1, 0xbd, 0x31, 0xd6, 0x77, 0xc7, 0x5f, 0x55, 0xd1, 0xce, 0x25, 0x57,
-	0x15, 0x5b, 0xb9, 0x1b, 0x95, 0x06, 0xca, 0xf1, 0x19, 0xd6, 0x64, 0x7c, 0xe9, 0x55, 0xc6, 0xcc,
-	0x47, 0x2f, 0x9f, 0x6c, 0x68, 0x2d, 0xf8, 0xfc, 0xf9, 0xb2, 0xf6, 0xf4, 0xf9, 0xb2, 0xf6, 0xcf,
-	0xe7, 0xcb, 0xda, 0xcf, 0x5f, 0x2c, 0x9f, 0x78, 0xfa, 0x62, 0xf9, 0xc4, 0xdf, 0x5e, 0x2c, 0x9f,
-	0xf8, 0xde, 0x77, 0xfc, 0x80, 0x75, 0xfa, 0x6d, 0xcb, 0x25, 0x61, 0x63, 0x37, 0x3d, 0xfa, 0x01,
-	0x6e, 0xd3, 0x61, 0x7e, 0xbc, 0xe5, 0x92, 0x18, 0xb2, 0xcb, 0x0e, 0x0e, 0xa2, 0x46, 0x48, 0xbc,
-	0x7e, 0x17, 0xa8, 0x4c, 0x1e, 0xfe, 0x2f, 0xbe, 0xf6, 0x49, 0xfe, 0x21, 0xea, 0xee, 0xff, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xad, 0x9f, 0x5c, 0x78, 0xee, 0x1c, 0x00, 0x00,
+	// 2136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6c, 0xdc, 0x58,
+	0x19, 0xaf, 0x33, 0x93, 0xb4, 0x79, 0x49, 0x36, 0x5b, 0x37, 0x6d, 0x27, 0x6e, 0x93, 0x34, 0x4e,
+	0xdb, 0xa4, 0x49, 0xd7, 0xd3, 0x49, 0x61, 0x97, 0x46, 0x02, 0xa9, 0x93, 0x74, 0x45, 0x44, 0xb3,
+	0x54, 0x93, 0xee, 0xae, 0xc4, 0xc5, 0xbc, 0xb1, 0xbf, 0x7a, 0x4c, 0xc6, 0xf6, 0xe0, 0xf7, 0x66,
+	0x42, 0x0e, 0x48, 0xcb, 0x1e, 0x97, 0x03, 0x48, 0x1c, 0x10, 0x07, 0x56, 0x48, 0x70, 0x5d, 0xa9,
+	0x48, 0x5c, 0xd8, 0x0b, 0x17, 0x90, 0xf6, 0x58, 0xc1, 0x05, 0x21, 0x54, 0xa1, 0x16, 0xa9, 0x12,
+	0x27, 0xee, 0x5c, 0x90, 0xdf, 0x7b, 0x7e, 0x63, 0x7b, 0xec, 0xc4, 0x81, 0x6a, 0x2f, 0xd1, 0xbc,
+	0xef, 0x7d, 0xdf, 0x7b, 0xbf, 0xef, 0xdf, 0xfb, 0xbe, 0xcf, 0x41, 0x0b, 0xae, 0xff, 0x3d, 0xb0,
+	0xa8, 0x3b, 0x80, 0x7a, 0x0f, 0x1c, 0xe7, 0xa8, 0x3e, 0x68, 0xd4, 0x3d, 0xe2, 0x10, 0xa3, 0x17,
+	0x06, 0x34, 0x50, 0x55, 0xb9, 0x6d, 0xb0, 0x6d, 0x63, 0xd0, 0xd0, 0xe6, 0x9c, 0xc0, 0x09, 0xd8,
+	0x76, 0x3d, 0xfa, 0xc5, 0x39, 0xb5, 0xab, 0x4e, 0x10, 0x38, 0x5d, 0xa8, 0xe3, 0x9e, 0x5b, 0xc7,
+	0xbe, 0x1f, 0x50, 0x4c, 0xdd, 0xc0, 0x17, 0xe7, 0x68, 0xf3, 0x62, 0x97, 0xad, 0xda, 0xfd, 0x27,
+	0x75, 0xec, 0x1f, 0x89, 0xad, 0xf3, 0xd8, 0x73, 0xfd, 0xa0, 0xce, 0xfe, 0x0a, 0xd2, 0xa2, 0x15,
+	0x10, 0x2f, 0x20, 0xf5, 0x36, 0x26, 0x50, 0x1f, 0x34, 0xda, 0x40, 0x71, 0xa3, 0x6e, 0x05, 0xae,
+	0x2f, 0xf6, 0x2f, 0x8b, 0x7d, 0x8f, 0x38, 0x02, 0x6f, 0x7c, 0x0d, 0xdf, 0x30, 0x39, 0x3a, 0xbe,
+	0x88, 0xcf, 0xcc, 0x51, 0x94, 0x1e, 0xf5, 0x20, 0xde, 0x5f, 0xca, 0xd9, 0xef, 0xe1, 0x10, 0x7b,
+	0x31, 0xc3, 0x4a, 0x0e, 0x43, 0x88, 0x29, 0x98, 0x5d, 0xd7, 0x73, 0x29, 0x67, 0xd2, 0x3f, 0x53,
+	0xd0, 0x95, 0x3d, 0xe2, 0xec, 0x03, 0xfd, 0x76, 0x68, 0x75, 0x80, 0xd0, 0x10, 0xd3, 0x20, 0xbc,
+	0x6f, 0xdb, 0x21, 0x10, 0x02, 0x44, 0xbd, 0x84, 0x26, 0x08, 0xf8, 0x36, 0x84, 0x35, 0xe5, 0x9a,
+	0xb2, 0x36, 0xd9, 0x12, 0x2b, 0x55, 0x47, 0xd3, 0x41, 0x42, 0xa0, 0x36, 0xc6, 0x76, 0x53, 0x34,
+	0x75, 0x09, 0x4d, 0x01, 0xed, 0x98, 0x98, 0x1f, 0x56, 0xab, 0x30, 0x16, 0x04, 0xb4, 0x23, 0x8e,
+	0xdf, 0x6a, 0x7c, 0xfc, 0xea, 0xe9, 0xba, 0x38, 0xf1, 0x93, 0x57, 0x4f, 0xd7, 0x97, 0x39, 0xce,
+	0x63, 0xf0, 0xe8, 0x37, 0xd0, 0xca, 0x31, 0xdb, 0x2d, 0x20, 0xbd, 0xc0, 0x27, 0xa0, 0xff, 0x5e,
+	0x41, 0x6f, 0xee, 0x11, 0xe7, 0x03, 0xdc, 0x25, 0x40, 0xb7, 0x03, 0xff, 0x89, 0x1b, 0x7a, 0xea,
+	0x1c, 0x1a, 0xf7, 0x03, 0xdf, 0x02, 0xa6, 0x4a, 0xb5, 0xc5, 0x17, 0xaf, 0x45, 0x13, 0xf5, 0x2a,
+	0x9a, 0x24, 0xae, 0xe3, 0x63, 0xda, 0x0f, 0xa1, 0x56, 0x65, 0xdb, 0x43, 0xc2, 0xd6, 0xed, 0x48,
+	0xcf, 0xd4, 0x89, 0x91, 0xb6, 0x97, 0xa4, 0xb6, 0x29, 0x98, 0xba, 0x86, 0x6a, 0x59, 0x9a, 0xd4,
+	0xeb, 0xb9, 0x82, 0xa6, 0x99, 0xfe, 0xbe, 0xfd, 0x38, 0x78, 0x40, 0x3b, 0x85, 0xfe, 0x99, 0x47,
+	0xe7, 0x22, 0xc4, 0x36, 0x10, 0x2a, 0x34, 0x3a, 0x0b, 0xb4, 0xb3, 0x03, 0x84, 0xaa, 0xef, 0xa0,
+	0x09, 0xec, 0x05, 0x7d, 0x9f, 0x32, 0x3d, 0xa6, 0x36, 0xe7, 0x0d, 0x11, 0x77, 0x51, 0xf4, 0x1a,
+	0x22, 0x7a, 0x8d, 0xed, 0xc0, 0xf5, 0x9b, 0xd5, 0x2f, 0x9e, 0x2f, 0x9d, 0x69, 0x09, 0x76, 0xf5,
+	0x1b, 0x08, 0xb5, 0x43, 0xd7, 0x76, 0xc0, 0x7c, 0x02, 0x5c, 0xcb, 0x12, 0xc2, 0x93, 0x5c, 0xe4,
+	0x5d, 0x80, 0x2d, 0x3d, 0xe3, 0x6e, 0x35, 0xe1, 0x6e, 0xa1, 0x8f, 0x7e, 0x09, 0xcd, 0x25, 0xd7,
+	0x52, 0xf1, 0x1f, 0xa0, 0xd9, 0x3d, 0xe2, 0xb4, 0xe0, 0xfb, 0x7d, 0x20, 0xb4, 0x89, 0xa9, 0xd5,
+	0x19, 0x71, 0x9c, 0x92, 0xe3, 0xb8, 0x39, 0x34, 0x6e, 0x83, 0x1f, 0x78, 0xc2, 0x06, 0x7c, 0xb1,
+	0xb5, 0x91, 0xeb, 0x8f, 0x8b, 0x12, 0x4e, 0xf2, 0x1a, 0x7d, 0x1e, 0x5d, 0xce, 0x90, 0x24, 0xa8,
+	0xbf, 0x2b, 0x0c, 0x95, 0x70, 0x12, 0x47, 0x95, 0x1f, 0x64, 0x37, 0xd0, 0x1b, 0x34, 0x38, 0x00,
+	0xdf, 0xb4, 0x02, 0x9f, 0x86, 0xd8, 0x8a, 0x9d, 0x32, 0xc3, 0xa8, 0xdb, 0x82, 0xa8, 0x2e, 0xa0,
+	0x28, 0xa8, 0xcc, 0x28, 0x72, 0x20, 0x14, 0x61, 0x36, 0x09, 0xb4, 0xb3, 0xcf, 0x08, 0x23, 0x1a,
+	0x57, 0x73, 0x34, 0x4e, 0x45, 0xe2, 0x78, 0x36, 0x12, 0x4f, 0xd2, 0x3c, 0xa9, 0x8a, 0xd0, 0x3c,
+	0x49, 0x92, 0x9a, 0xff, 0x6b, 0x8c, 0x69, 0xbe, 0x03, 0xbd, 0x80, 0xb8, 0x74, 0xbb, 0x8b, 0x5d,
+	0x8f, 0x25, 0xc9, 0x00, 0x7c, 0x6a, 0x26, 0xf5, 0x47, 0x8c, 0xf4, 0x1e, 0x33, 0xc2, 0x32, 0x9a,
+	0x6e, 0x77, 0x03, 0xeb, 0xc0, 0xec, 0x80, 0xeb, 0x74, 0xb8, 0x09, 0xaa, 0xad, 0x29, 0x46, 0xfb,
+	0x26, 0x23, 0xe5, 0xd8, 0xa9, 0x92, 0x67, 0xa7, 0xaf, 0xca, 0x10, 0x66, 0x26, 0x68, 0x2e, 0x44,
+	0xa1, 0xf6, 0xb7, 0xe7, 0x4b, 0x17, 0x79, 0x30, 0x12, 0xfb, 0xc0, 0x70, 0x83, 0xba, 0x87, 0x69,
+	0xc7, 0xd8, 0xf5, 0xa9, 0x0c, 0xe0, 0x55, 0x34, 0x0b, 0xb4, 0x03, 0x21, 0xf4, 0x3d, 0x53, 0x64,
+	0x0d, 0xb7, 0xd0, 0x1b, 0x31, 0x79, 0x9f, 0x67, 0xcf, 0x2a, 0x9a, 0x15, 0x0f, 0x73, 0x08, 0x16,
+	0xb8, 0x03, 0x08, 0x6b, 0x13, 0x9c, 0x91, 0x93, 0x5b, 0x82, 0x3a, 0xe2, 0x91, 0xb3, 0x39, 0x1e,
+	0x51, 0x51, 0xd5, 0xc6, 0x14, 0xd7, 0xce, 0xb1, 0x3d, 0xf6, 0xfb, 0x44, 0x3f, 0x24, 0x0d, 0x2b,
+	0xfc, 0x90, 0x24, 0x49, 0x3f, 0xfc, 0x9b, 0xbf, 0x73, 0x1f, 0xba, 0xb4, 0x63, 0x87, 0xf8, 0xf0,
+	0xf5, 0x39, 0x62, 0x09, 0x4d, 0xb5, 0x23, 0x8f, 0x8b, 0x33, 0x2a, 0xfc, 0x0c, 0x46, 0x7a, 0xaf,
+	0x20, 0xa2, 0xab, 0x79, 0x9e, 0xca, 0x1a, 0x68, 0x7c, 0xd4, 0x40, 0x27, 0x3e, 0x8f, 0x29, 0xed,
+	0xc4, 0xf3, 0x98, 0xa2, 0x49, 0x73, 0xfc, 0x71, 0x0c, 0x5d, 0xdc, 0x23, 0xce, 0x83, 0xd6, 0xf6,
+	0xe6, 0x9d, 0x1d, 0xe8, 0x75, 0x83, 0x23, 0xb0, 0x5f, 0x9f, 0x4d, 0x96, 0xd1, 0xb4, 0x88, 0x0a,
+	0xfe, 0xa6, 0xf0, 0xd0, 0x9c, 0xe2, 0xb4, 0x9d, 0x88, 0x54, 0xd6, 0x2a, 0x2a, 0xaa, 0xfa, 0xd8,
+	0x8b, 0xf3, 0x93, 0xfd, 0x66, 0x2f, 0xf9, 0x91, 0xd7, 0x0e, 0xba, 0x22, 0xd4, 0xc4, 0x4a, 0xd5,
+	0xd0, 0x39, 0x1b, 0x2c, 0xd7, 0xc3, 0x5d, 0xc2, 0xc2, 0xab, 0xda, 0x92, 0xeb, 0x11, 0xeb, 0x9e,
+	0xcb, 0xb1, 0x6e, 0x23, 0xd7, 0xba, 0x57, 0xa4, 0x75, 0x47, 0x8d, 0xa5, 0x2f, 0xa1, 0x85, 0xdc,
+	0x0d, 0x69, 0xe7, 0x1f, 0x22, 0x35, 0x7a, 0x19, 0xb0, 0x6f, 0x41, 0x77, 0x58, 0x8b, 0x22, 0xe5,
+	0x43, 0xec, 0x13, 0x6c, 0x45, 0x9d, 0x94, 0xe9, 0xda, 0xc2, 0xcc, 0x33, 0x09, 0xea, 0xae, 0x9d,
+	0x28, 0x59, 0x63, 0xc9, 0x92, 0xb5, 0xb5, 0x96, 0x29, 0x0f, 0xb5, 0xe1, 0xab, 0x94, 0xbe, 0x48,
+	0xbf, 0x8a, 0xb4, 0x51, 0xaa, 0x04, 0xf7, 0x07, 0x05, 0x5d, 0xd8, 0x23, 0x4e, 0xb3, 0xef, 0xf5,
+	0xe4, 0xe6, 0xbb, 0x00, 0xff, 0x27, 0xbc, 0x4c, 0xf5, 0xab, 0x9c, 0xba, 0xfa, 0xdd, 0xca, 0xa8,
+	0x37, 0x2f, 0xd5, 0xcb, 0x22, 0xd5, 0x17, 0xd0, 0x95, 0x1c, 0xb2, 0x54, 0xf0, 0x73, 0x85, 0xf9,
+	0x67, 0xbf, 0xdf, 0xf6, 0x5c, 0xda, 0xc4, 0xf6, 0x7e, 0xfc, 0xbc, 0x3f, 0x18, 0xb8, 0x36, 0x44,
+	0xc1, 0x6c, 0xa0, 0xb3, 0xa4, 0xdf, 0x8e, 0xba, 0x3f, 0xa6, 0xe3, 0xd4, 0xe6, 0x9c, 0xc1, 0xfb,
+	0x59, 0x23, 0xee, 0x67, 0x8d, 0xfb, 0xfe, 0x51, 0x2b, 0x66, 0x4a, 0x17, 0x8d, 0xb1, 0x4c, 0xd1,
+	0x48, 0x58, 0xa4, 0x92, 0x72, 0xd8, 0xdd, 0x8c, 0x46, 0x2b, 0xc3, 0x7a, 0x5e, 0x08, 0x4d, 0x5f,
+	0x45, 0x37, 0x8e, 0x65, 0x90, 0x5a, 0xfe, 0x87, 0xe7, 0x32, 0xef, 0x83, 0xde, 0xef, 0xd9, 0x98,
+	0x9e, 0x26, 0x97, 0x07, 0x4c, 0x4c, 0x70, 0x88, 0x5c, 0xe6, 0xb4, 0xfc, 0x74, 0xaf, 0x8c, 0xa6,
+	0xfb, 0xd7, 0xd1, 0x59, 0x0f, 0xbc, 0x36, 0x84, 0xa4, 0x56, 0xbd, 0x56, 0x59, 0x9b, 0xda, 0x5c,
+	0x31, 0x46, 0x87, 0x0b, 0xa3, 0xc9, 0x1c, 0xfc, 0x01, 0xee, 0xba, 0x76, 0x94, 0x5b, 0xad, 0x58,
+	0x46, 0x6d, 0xa2, 0x99, 0x10, 0x0e, 0x71, 0x68, 0x9b, 0xa2, 0x54, 0x8d, 0x97, 0x29, 0x55, 0xd3,
+	0x5c, 0xe6, 0x3e, 0x2f, 0x58, 0xcb, 0x48, 0xac, 0x4d, 0xf6, 0x7e, 0x88, 0x97, 0x61, 0x8a, 0xd3,
+	0x1e, 0x47, 0xa4, 0x32, 0x15, 0xe8, 0xc4, 0x27, 0x60, 0xd4, 0xc6, 0xe2, 0x09, 0x18, 0xdd, 0x90,
+	0xee, 0xf9, 0x8c, 0xf7, 0x3e, 0x7c, 0xef, 0x11, 0x9b, 0x3b, 0xd4, 0xb7, 0xd1, 0x24, 0xee, 0xd3,
+	0x4e, 0x10, 0xba, 0xf4, 0x88, 0xb7, 0x63, 0xcd, 0xda, 0x9f, 0x7f, 0xf7, 0xd6, 0x9c, 0x48, 0x12,
+	0xd1, 0x2c, 0xef, 0xd3, 0xd0, 0xf5, 0x9d, 0xd6, 0x90, 0x55, 0xfd, 0x1a, 0x9a, 0xe0, 0x93, 0x0b,
+	0xf3, 0xd4, 0xd4, 0xa6, 0x96, 0x67, 0x68, 0x7e, 0x47, 0xdc, 0x92, 0x72, 0x7e, 0xfe, 0x66, 0x0c,
+	0x4f, 0x4a, 0x17, 0xd1, 0x24, 0x36, 0x51, 0x44, 0x93, 0x24, 0xa9, 0xca, 0x2f, 0x79, 0x3e, 0x35,
+	0xbb, 0xd8, 0x3a, 0xe8, 0xba, 0x84, 0x3e, 0x10, 0xcd, 0x40, 0x7a, 0x0a, 0xe2, 0x3d, 0x59, 0xdc,
+	0x65, 0xb3, 0x95, 0x5a, 0x47, 0x17, 0xda, 0xb1, 0x54, 0x3c, 0x1d, 0x40, 0xa4, 0x45, 0x65, 0x6d,
+	0xb2, 0xa5, 0xca, 0x2d, 0x79, 0x50, 0x9c, 0x32, 0x4c, 0x3a, 0x9d, 0x32, 0xc5, 0xb7, 0x8b, 0x94,
+	0x29, 0x66, 0x90, 0x8a, 0xfc, 0x5c, 0x61, 0x0f, 0x63, 0x0b, 0x06, 0xc1, 0x01, 0xc4, 0x6c, 0x52,
+	0xee, 0xf5, 0x69, 0x71, 0x27, 0xa3, 0xc5, 0xb5, 0x44, 0xe7, 0x9c, 0x7b, 0xb5, 0x7e, 0x1d, 0xe9,
+	0xc5, 0xbb, 0x12, 0xff, 0x6f, 0x2b, 0xbc, 0xae, 0x84, 0x80, 0x29, 0xb4, 0x30, 0x85, 0x87, 0xd1,
+	0xa4, 0xfa, 0x3f, 0x87, 0xd5, 0x0a, 0xe2, 0x65, 0x57, 0xce, 0x6d, 0x62, 0xb4, 0x63, 0x44, 0x21,
+	0x35, 0xac, 0xd8, 0xb2, 0xc8, 0x46, 0x4f, 0xc1, 0x8c, 0xa8, 0xd8, 0x3b, 0x82, 0xa8, 0x5e, 0x8f,
+	0xd9, 0x7a, 0xa1, 0x6b, 0x41, 0x54, 0x3c, 0xaa, 0x89, 0xc3, 0x1e, 0x45, 0xc4, 0x5d, 0x5b, 0xdd,
+	0x45, 0x6f, 0x0c, 0x27, 0x6c, 0xb3, 0x4f, 0x6c, 0x91, 0xf4, 0x2b, 0x22, 0xe9, 0xaf, 0x8c, 0x26,
+	0xfd, 0x43, 0x70, 0xb0, 0x75, 0xb4, 0x03, 0x56, 0x6b, 0x3a, 0x8c, 0x35, 0x7e, 0x9f, 0xd8, 0xea,
+	0x1e, 0xba, 0x80, 0xdb, 0x24, 0xe8, 0xf6, 0x29, 0x98, 0x9e, 0xeb, 0x53, 0x7e, 0x66, 0x6d, 0xa2,
+	0xcc, 0x23, 0x72, 0x3e, 0x96, 0xdc, 0x73, 0x7d, 0xca, 0x6d, 0xb8, 0x8e, 0xce, 0x27, 0x90, 0x1d,
+	0xba, 0xbe, 0x1d, 0x1c, 0x8a, 0x76, 0x62, 0x56, 0xde, 0xfb, 0x21, 0x23, 0xf3, 0xe6, 0x34, 0x9d,
+	0x54, 0x89, 0x5a, 0x9c, 0x76, 0x4e, 0x5c, 0x8b, 0xd3, 0x54, 0xe9, 0xd1, 0x67, 0x63, 0x48, 0x95,
+	0x69, 0xf7, 0x25, 0x79, 0x74, 0x03, 0xa9, 0x3e, 0x1c, 0x9a, 0x19, 0x77, 0xf1, 0xd2, 0x35, 0xeb,
+	0xc3, 0xe1, 0xe3, 0xa4, 0xc7, 0x1e, 0x71, 0xe6, 0x8c, 0xd7, 0xaa, 0xe5, 0xbd, 0x16, 0x9d, 0xd8,
+	0x4a, 0x3a, 0xae, 0x81, 0x2e, 0x66, 0x4e, 0x14, 0xd6, 0x1e, 0x67, 0xd6, 0x56, 0x93, 0xfc, 0x65,
+	0x0c, 0x9e, 0xb1, 0x9d, 0x30, 0x78, 0x86, 0x2a, 0x0d, 0xfe, 0xa9, 0xc2, 0x0c, 0xde, 0x02, 0x2f,
+	0x18, 0x7c, 0x49, 0x06, 0x3f, 0x1e, 0x7e, 0x06, 0x89, 0x80, 0x9f, 0xa1, 0xc6, 0xf0, 0x37, 0x7f,
+	0x35, 0x87, 0x2a, 0x7b, 0xc4, 0x51, 0x7f, 0xa2, 0xa0, 0x99, 0xf4, 0xc7, 0x9b, 0xeb, 0x79, 0x35,
+	0x21, 0xfb, 0x9d, 0x44, 0xbb, 0x5d, 0x86, 0x4b, 0x1a, 0x6b, 0xfd, 0xe3, 0xbf, 0xfc, 0xf3, 0x67,
+	0x63, 0xd7, 0x75, 0xbd, 0x9e, 0xf3, 0xa9, 0x4c, 0x74, 0x10, 0x96, 0xb8, 0xff, 0x23, 0x05, 0x4d,
+	0x0e, 0x5b, 0xdd, 0x6b, 0x05, 0xf7, 0x48, 0x0e, 0x6d, 0xed, 0x24, 0x0e, 0x89, 0x62, 0x95, 0xa1,
+	0x58, 0xd6, 0x97, 0xf2, 0x50, 0x10, 0xf0, 0xa3, 0xe2, 0x6f, 0x02, 0xed, 0xa8, 0x3f, 0x56, 0xd0,
+	0x74, 0xea, 0x0b, 0xc8, 0x4a, 0xc1, 0x1d, 0x49, 0x26, 0x6d, 0xa3, 0x04, 0x93, 0xc4, 0x72, 0x8b,
+	0x61, 0x59, 0xd1, 0x97, 0xf3, 0xb0, 0x84, 0x5c, 0xc2, 0x64, 0x53, 0x20, 0x43, 0x93, 0xfa, 0xf2,
+	0x51, 0x84, 0x26, 0xc9, 0xa4, 0x6d, 0x94, 0x60, 0x2a, 0x87, 0x46, 0x38, 0x26, 0x81, 0x26, 0xf5,
+	0x35, 0xa2, 0x08, 0x4d, 0x92, 0x49, 0xdb, 0x28, 0xc1, 0x54, 0x0e, 0x8d, 0xcd, 0x25, 0x4c, 0x8b,
+	0x5d, 0x1e, 0x85, 0x6f, 0x7a, 0x26, 0x2f, 0x0a, 0xdf, 0x14, 0x97, 0x76, 0xbb, 0x0c, 0x57, 0xb9,
+	0xf0, 0x3d, 0x14, 0x22, 0x02, 0xd1, 0xaf, 0x15, 0x74, 0x3e, 0xd9, 0xcd, 0x71, 0x54, 0xb7, 0x8e,
+	0x4d, 0x97, 0x64, 0xdf, 0xa7, 0x35, 0x4a, 0xb3, 0x4a, 0x7c, 0x77, 0x18, 0xbe, 0x75, 0x7d, 0xed,
+	0x98, 0xf4, 0xea, 0x73, 0x41, 0x81, 0xf2, 0x37, 0x0a, 0x52, 0x73, 0x86, 0xf7, 0x22, 0x98, 0xa3,
+	0xac, 0x5a, 0xa3, 0x34, 0x6b, 0x39, 0x98, 0x10, 0x5a, 0x9b, 0x77, 0x4c, 0x5b, 0x08, 0x0a, 0x98,
+	0x9f, 0x2b, 0xa8, 0x56, 0xf8, 0xc5, 0xbc, 0x5e, 0x98, 0xf8, 0xf9, 0x02, 0xda, 0x3b, 0xa7, 0x14,
+	0x90, 0xc0, 0xbf, 0xc2, 0x80, 0x1b, 0xfa, 0xed, 0xfc, 0x87, 0x83, 0x9a, 0xc9, 0x8e, 0x3f, 0x7e,
+	0xc1, 0xd5, 0x5f, 0x28, 0x68, 0x36, 0x3b, 0xb9, 0xdf, 0x2c, 0xca, 0xca, 0x34, 0x9f, 0x66, 0x94,
+	0xe3, 0x93, 0x08, 0x0d, 0x86, 0x70, 0x4d, 0xbf, 0x99, 0x9b, 0xc0, 0x4c, 0xc8, 0x4c, 0xbe, 0x70,
+	0x9f, 0x2a, 0xe8, 0xcd, 0x91, 0xb9, 0x7d, 0xb5, 0xe0, 0xd2, 0x2c, 0xa3, 0x56, 0x2f, 0xc9, 0x58,
+	0xce, 0xf3, 0xed, 0xbe, 0xd7, 0x4b, 0x82, 0x8b, 0xc6, 0x7e, 0xf5, 0x4f, 0x0a, 0xd2, 0x8e, 0x99,
+	0xbb, 0x8b, 0xa2, 0xaf, 0x58, 0x44, 0xbb, 0x77, 0x6a, 0x11, 0x09, 0xff, 0x1e, 0x83, 0x7f, 0x57,
+	0x6f, 0xe4, 0xfa, 0x9f, 0xc9, 0x9b, 0x6d, 0x6c, 0x9b, 0x72, 0x92, 0x37, 0x21, 0x06, 0xfa, 0x5d,
+	0x34, 0x9d, 0x9a, 0xdc, 0x8a, 0x5e, 0xcb, 0x24, 0x93, 0xb6, 0x51, 0x82, 0x29, 0x06, 0xa7, 0x7e,
+	0xa2, 0x20, 0xed, 0x98, 0x89, 0xaa, 0xc8, 0x52, 0xc5, 0x22, 0xda, 0xbd, 0x53, 0x8b, 0x48, 0x30,
+	0x3f, 0x52, 0xd0, 0xe5, 0xa2, 0xa9, 0xc8, 0x28, 0xac, 0x8f, 0xb9, 0xfc, 0xda, 0xdb, 0xa7, 0xe3,
+	0x97, 0x18, 0x5c, 0x34, 0x9b, 0x1d, 0x6c, 0x0a, 0xd3, 0x2e, 0xcd, 0xa7, 0x19, 0xe5, 0xf8, 0x92,
+	0x57, 0x65, 0x3b, 0xee, 0x9b, 0xc7, 0xfa, 0xee, 0xe4, 0xab, 0x0a, 0xfa, 0xcd, 0xe8, 0xaa, 0x6c,
+	0xaf, 0x79, 0xb3, 0xd0, 0x40, 0x29, 0x3e, 0xcd, 0x28, 0xc7, 0x17, 0x5f, 0xa5, 0x8d, 0x7f, 0xf4,
+	0xea, 0xe9, 0xba, 0xd2, 0x84, 0x2f, 0x5e, 0x2c, 0x2a, 0xcf, 0x5e, 0x2c, 0x2a, 0xff, 0x78, 0xb1,
+	0xa8, 0xfc, 0xf4, 0xe5, 0xe2, 0x99, 0x67, 0x2f, 0x17, 0xcf, 0xfc, 0xf5, 0xe5, 0xe2, 0x99, 0xef,
+	0x7c, 0xcb, 0x71, 0x69, 0xa7, 0xdf, 0x36, 0xac, 0xc0, 0xab, 0xef, 0xc6, 0x47, 0x3f, 0xc4, 0x6d,
+	0x32, 0xcc, 0x8f, 0xb7, 0xac, 0x20, 0x84, 0xe4, 0xb2, 0x83, 0x5d, 0xbf, 0xee, 0x05, 0x76, 0xbf,
+	0x0b, 0x44, 0x24, 0x0f, 0xfb, 0x27, 0x6b, 0x7b, 0x82, 0x7d, 0x29, 0xbb, 0xfb, 0xdf, 0x01, 0x00,
+	0x06, 0xd8, 0x8a, 0x39, 0x70, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2101,6 +2208,8 @@ type MsgClient interface {
 	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
 	SetOrchestratorAddresses(ctx context.Context, in *MsgSetOrchestratorAddresses, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressesResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
+	// BumpSendToEthFee adds to the bridge fee of an unbatched outgoing transfer
+	BumpSendToEthFee(ctx context.Context, in *MsgBumpSendToEthFee, opts ...grpc.CallOption) (*MsgBumpSendToEthFeeResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// BlacklistEthereumAddresses adds Ethereum addresses to the peggy blacklist.
@@ -2216,6 +2325,15 @@ func (c *msgClient) CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth,
 	return out, nil
 }
 
+func (c *msgClient) BumpSendToEthFee(ctx context.Context, in *MsgBumpSendToEthFee, opts ...grpc.CallOption) (*MsgBumpSendToEthFeeResponse, error) {
+	out := new(MsgBumpSendToEthFeeResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/BumpSendToEthFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	out := new(MsgSubmitBadSignatureEvidenceResponse)
 	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
@@ -2291,6 +2409,8 @@ type MsgServer interface {
 	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
 	SetOrchestratorAddresses(context.Context, *MsgSetOrchestratorAddresses) (*MsgSetOrchestratorAddressesResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
+	// BumpSendToEthFee adds to the bridge fee of an unbatched outgoing transfer
+	BumpSendToEthFee(context.Context, *MsgBumpSendToEthFee) (*MsgBumpSendToEthFeeResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// BlacklistEthereumAddresses adds Ethereum addresses to the peggy blacklist.
@@ -2342,6 +2462,9 @@ func (*UnimplementedMsgServer) SetOrchestratorAddresses(ctx context.Context, req
 func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEth not implemented")
 }
+func (*UnimplementedMsgServer) BumpSendToEthFee(ctx context.Context, req *MsgBumpSendToEthFee) (*MsgBumpSendToEthFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpSendToEthFee not implemented")
+}
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
@@ -2548,6 +2671,24 @@ func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BumpSendToEthFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBumpSendToEthFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BumpSendToEthFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/BumpSendToEthFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BumpSendToEthFee(ctx, req.(*MsgBumpSendToEthFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitBadSignatureEvidence)
 	if err := dec(in); err != nil {
@@ -2718,6 +2859,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSendToEth",
 			Handler:    _Msg_CancelSendToEth_Handler,
 		},
+		{
+			MethodName: "BumpSendToEthFee",
+			Handler:    _Msg_BumpSendToEthFee_Handler,
+		},
 		{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
@@ -3439,6 +3584,74 @@ func (m *MsgCancelSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBumpSendToEthFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBumpSendToEthFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBumpSendToEthFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransactionId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TransactionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBumpSendToEthFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBumpSendToEthFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBumpSendToEthFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4356,6 +4569,33 @@ func (m *MsgCancelSendToEthResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBumpSendToEthFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionId != 0 {
+		n += 1 + sovMsgs(uint64(m.TransactionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgBumpSendToEthFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6689,6 +6929,190 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBumpSendToEthFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBumpSendToEthFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBumpSendToEthFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			m.TransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBumpSendToEthFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBumpSendToEthFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBumpSendToEthFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,7 @@ func DefaultParams() *Params {
 		ClaimSlashingEnabled:          false,
 		Admins:                        nil,
 		SegregatedWalletAddress:       "",
+		PriorityInclusionAge:          0,
 	}
 }
 
@@ -108,6 +109,10 @@ func (p Params) ValidateBasic() error {
 		return errors.Wrap(err, "segregated wallet address")
 	}
 
+	if err := validatePriorityInclusionAge(p.PriorityInclusionAge); err != nil {
+		return errors.Wrap(err, "priority inclusion age")
+	}
+
 	return nil
 }
 
@@ -342,3 +347,13 @@ func validateSegregatedWallet(i interface{}) error {
 
 	return nil
 }
+
+func validatePriorityInclusionAge(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if val != 0 && val < 60000 {
+		return fmt.Errorf("invalid priority inclusion age, less than 60 seconds is too short")
+	}
+	return nil
+}
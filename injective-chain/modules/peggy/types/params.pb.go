@@ -51,6 +51,9 @@ type Params struct {
 	Admins                        []string                    `protobuf:"bytes,22,rep,name=admins,proto3" json:"admins,omitempty"`
 	// address for receiving Peggy Deposits from sanctioned Ethereum addresses
 	SegregatedWalletAddress string `protobuf:"bytes,23,opt,name=segregated_wallet_address,json=segregatedWalletAddress,proto3" json:"segregated_wallet_address,omitempty"`
+	// unbatched transfers older than this (in milliseconds) are included in the
+	// next batch of their token ahead of higher-fee transfers, 0 disables it
+	PriorityInclusionAge uint64 `protobuf:"varint,24,opt,name=priority_inclusion_age,json=priorityInclusionAge,proto3" json:"priority_inclusion_age,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -212,6 +215,13 @@ func (m *Params) GetSegregatedWalletAddress() string {
 	return ""
 }
 
+func (m *Params) GetPriorityInclusionAge() uint64 {
+	if m != nil {
+		return m.PriorityInclusionAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.peggy.v1.Params")
 }
@@ -219,59 +229,61 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/params.proto", fileDescriptor_f21ffdf8d29783da) }
 
 var fileDescriptor_f21ffdf8d29783da = []byte{
-	// 830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x23, 0x35,
-	0x18, 0xcd, 0xb0, 0xa5, 0xdb, 0xba, 0x2d, 0xdd, 0xba, 0x49, 0xeb, 0xb6, 0x4b, 0x3a, 0x02, 0x09,
-	0x45, 0x2b, 0x98, 0x69, 0x0b, 0xe2, 0xb0, 0x08, 0x21, 0x92, 0x16, 0x6d, 0xc5, 0x1e, 0x50, 0x0a,
-	0x54, 0xe2, 0x62, 0x79, 0xc6, 0xdf, 0xce, 0x98, 0xce, 0x8c, 0xab, 0xb1, 0x93, 0xaa, 0x37, 0xce,
-	0x9c, 0xf8, 0x2b, 0x38, 0xf3, 0x67, 0xec, 0x71, 0x8f, 0x08, 0xa1, 0x0a, 0xb5, 0x07, 0xfe, 0x0d,
-	0xe4, 0x1f, 0x93, 0xa4, 0x81, 0x43, 0xc5, 0x25, 0x8a, 0xfd, 0xde, 0xfb, 0x3e, 0xe7, 0xd9, 0x79,
-	0x1f, 0xda, 0x17, 0xd5, 0x8f, 0x90, 0x6a, 0x31, 0x86, 0xf8, 0x12, 0xb2, 0xec, 0x3a, 0x1e, 0x1f,
-	0xc6, 0x97, 0xac, 0x66, 0xa5, 0x8a, 0x2e, 0x6b, 0xa9, 0x25, 0xc6, 0x13, 0x42, 0x64, 0x09, 0xd1,
-	0xf8, 0x70, 0xb7, 0x9d, 0xc9, 0x4c, 0x5a, 0x38, 0x36, 0xdf, 0x1c, 0x73, 0xb7, 0x9b, 0x4a, 0x55,
-	0x4a, 0x15, 0x27, 0x4c, 0x41, 0x3c, 0x3e, 0x4c, 0x40, 0xb3, 0xc3, 0x38, 0x95, 0xa2, 0xf2, 0xf8,
-	0x06, 0x2b, 0x45, 0x25, 0x63, 0xfb, 0xe9, 0xb6, 0xde, 0xfb, 0x75, 0x05, 0x2d, 0x7e, 0x63, 0xbb,
-	0xe1, 0x1d, 0xb4, 0x64, 0xeb, 0x53, 0xc1, 0x49, 0x10, 0x06, 0xbd, 0xe5, 0xe1, 0x63, 0xbb, 0x3e,
-	0xe5, 0xf8, 0x00, 0xb5, 0x53, 0x59, 0xe9, 0x9a, 0xa5, 0x9a, 0x2a, 0x39, 0xaa, 0x53, 0xa0, 0x39,
-	0x53, 0x39, 0x79, 0xcb, 0xd2, 0x70, 0x83, 0x9d, 0x59, 0xe8, 0x05, 0x53, 0x39, 0xfe, 0x14, 0x6d,
-	0x27, 0xb5, 0xe0, 0x19, 0x50, 0xd0, 0x39, 0xd4, 0x30, 0x2a, 0x29, 0xe3, 0xbc, 0x06, 0xa5, 0xc8,
-	0x23, 0x2b, 0xea, 0x38, 0xf8, 0xc4, 0xa3, 0x5f, 0x3a, 0x10, 0x7f, 0x80, 0xd6, 0xbd, 0x2e, 0xcd,
-	0x99, 0xa8, 0xcc, 0x59, 0x16, 0xc2, 0xa0, 0xb7, 0x30, 0x5c, 0x73, 0xdb, 0x03, 0xb3, 0x7b, 0xca,
-	0xf1, 0x11, 0xea, 0x28, 0x91, 0x55, 0xc0, 0xe9, 0x98, 0x15, 0x0a, 0xb4, 0xa2, 0x57, 0xa2, 0xe2,
-	0xf2, 0x8a, 0xbc, 0x6d, 0xd9, 0x9b, 0x0e, 0xfc, 0xde, 0x61, 0xe7, 0x16, 0x9a, 0xd1, 0x24, 0x4c,
-	0xa7, 0x39, 0x4c, 0x34, 0x8b, 0xb3, 0x9a, 0xbe, 0xc3, 0xbc, 0xe6, 0x00, 0xb5, 0xbd, 0x26, 0x2d,
-	0x98, 0x28, 0x27, 0x92, 0xc7, 0x56, 0x82, 0x1d, 0x36, 0xb0, 0xd0, 0x54, 0xa1, 0x59, 0x9d, 0x81,
-	0x76, 0x5d, 0xa8, 0x16, 0x25, 0xc8, 0x91, 0x26, 0x4b, 0x4e, 0xe1, 0x30, 0xdb, 0xe4, 0x5b, 0x87,
-	0xe0, 0x0f, 0x11, 0x66, 0x63, 0xa8, 0x59, 0x06, 0x34, 0x29, 0x64, 0x7a, 0x61, 0x25, 0x64, 0xd9,
-	0xf2, 0x9f, 0x78, 0xa4, 0x6f, 0x00, 0x23, 0xc0, 0x9f, 0xa3, 0xbd, 0x86, 0x3d, 0xb1, 0x76, 0x46,
-	0x86, 0xac, 0x8c, 0x78, 0x4a, 0x63, 0xef, 0x54, 0x7e, 0x8e, 0x3a, 0xaa, 0x60, 0x2a, 0xa7, 0xaf,
-	0xcc, 0x8d, 0x09, 0x59, 0x79, 0x03, 0xc9, 0x4a, 0x18, 0xf4, 0x56, 0xfb, 0xef, 0xbf, 0xbe, 0xd9,
-	0x6f, 0xfd, 0x71, 0xb3, 0xbf, 0xe7, 0x9e, 0x92, 0xe2, 0x17, 0x91, 0x90, 0x71, 0xc9, 0x74, 0x1e,
-	0xbd, 0x84, 0x8c, 0xa5, 0xd7, 0xc7, 0x90, 0x0e, 0x37, 0x6d, 0x85, 0xaf, 0x7c, 0x01, 0x67, 0x32,
-	0xfe, 0x0e, 0xb5, 0xe7, 0x0a, 0xdb, 0xdf, 0x4f, 0x56, 0x1f, 0x5e, 0x17, 0xdf, 0xab, 0x6b, 0x3d,
-	0xfa, 0x8f, 0xb2, 0xf6, 0x22, 0xc8, 0xda, 0xff, 0x2d, 0x6b, 0x2f, 0x0b, 0x17, 0x28, 0x9c, 0x2f,
-	0x2b, 0xab, 0x57, 0x85, 0x48, 0xb5, 0xa8, 0x32, 0xdf, 0xe2, 0x9d, 0x87, 0xb7, 0x78, 0xf7, 0x7e,
-	0x8b, 0x69, 0x29, 0xd7, 0x6d, 0x80, 0xba, 0xa3, 0x2a, 0x91, 0x15, 0xa7, 0x96, 0x67, 0x5a, 0xcc,
-	0x3d, 0xdb, 0x75, 0x7b, 0x6d, 0x7b, 0x8e, 0x75, 0xe6, 0x49, 0xf7, 0x9f, 0xef, 0xc5, 0xbf, 0x8e,
-	0x9c, 0x30, 0x6e, 0xde, 0x00, 0x35, 0xaf, 0x90, 0xe9, 0x51, 0x0d, 0xe4, 0xc9, 0xc3, 0x8f, 0xfc,
-	0x74, 0xce, 0x6c, 0x7e, 0xa2, 0xf3, 0xb3, 0xa6, 0x10, 0x7e, 0x86, 0x36, 0x9c, 0x98, 0x9a, 0xfc,
-	0xa0, 0x1c, 0x2a, 0x59, 0x92, 0x0d, 0xfb, 0xcf, 0x5d, 0x77, 0xc0, 0x40, 0x8a, 0xea, 0xd8, 0x6c,
-	0xe3, 0xcf, 0xd0, 0xee, 0x2c, 0x17, 0xea, 0xf4, 0xe8, 0x80, 0x36, 0x99, 0x40, 0xb0, 0x15, 0x6d,
-	0x4f, 0x45, 0x27, 0x06, 0x1f, 0x78, 0x18, 0x7f, 0x82, 0xb6, 0xac, 0xdb, 0x53, 0x67, 0xa0, 0x62,
-	0x49, 0x01, 0x9c, 0x6c, 0x86, 0x41, 0x6f, 0x69, 0xd8, 0xb6, 0x68, 0xe3, 0xc8, 0x89, 0xc3, 0xf0,
-	0x17, 0xe8, 0x69, 0x13, 0x13, 0x93, 0x5c, 0xd2, 0xac, 0xd6, 0x34, 0x07, 0x91, 0xe5, 0x9a, 0xb4,
-	0xad, 0x9d, 0x3b, 0x3e, 0x33, 0x9a, 0x78, 0x32, 0x8c, 0x17, 0x96, 0x80, 0x8f, 0xd1, 0x9a, 0xbb,
-	0x01, 0x5a, 0xc3, 0x15, 0xab, 0x39, 0xe9, 0x84, 0x41, 0x6f, 0xe5, 0x68, 0x27, 0x72, 0xe7, 0x8c,
-	0x4c, 0x84, 0x46, 0x3e, 0x42, 0x23, 0x73, 0xea, 0xfe, 0x82, 0x31, 0x75, 0xb8, 0xea, 0x54, 0x43,
-	0x2b, 0xc2, 0x5b, 0x68, 0x91, 0xf1, 0x52, 0x54, 0x8a, 0x6c, 0x85, 0x8f, 0x7a, 0xcb, 0x43, 0xbf,
-	0xc2, 0xcf, 0xd1, 0x8e, 0x82, 0xac, 0x86, 0x8c, 0x69, 0xe0, 0xf4, 0x8a, 0x15, 0x05, 0xe8, 0x49,
-	0xfe, 0x6d, 0x3b, 0x43, 0xa6, 0x84, 0x73, 0x8b, 0xfb, 0x04, 0x7c, 0xde, 0xf9, 0xe9, 0xcf, 0xb0,
-	0xf5, 0xf3, 0xdf, 0xbf, 0x3d, 0x5b, 0x75, 0xe3, 0xc0, 0xa5, 0x73, 0x1f, 0x5e, 0xdf, 0x76, 0x83,
-	0x37, 0xb7, 0xdd, 0xe0, 0xaf, 0xdb, 0x6e, 0xf0, 0xcb, 0x5d, 0xb7, 0xf5, 0xe6, 0xae, 0xdb, 0xfa,
-	0xfd, 0xae, 0xdb, 0xfa, 0xe1, 0xeb, 0x4c, 0xe8, 0x7c, 0x94, 0x44, 0xa9, 0x2c, 0xe3, 0xd3, 0x66,
-	0x54, 0xbc, 0x64, 0x89, 0x8a, 0x27, 0x83, 0xe3, 0xa3, 0x54, 0xd6, 0x30, 0xbb, 0x34, 0x29, 0x1a,
-	0x97, 0x92, 0x8f, 0x0a, 0x50, 0x7e, 0xec, 0xe8, 0xeb, 0x4b, 0x50, 0xc9, 0xa2, 0x1d, 0x0b, 0x1f,
-	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xec, 0xc3, 0x94, 0xd9, 0x96, 0x06, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x6f, 0x23, 0x35,
+	0x14, 0xc7, 0x13, 0xb6, 0x74, 0x5b, 0xb7, 0xa5, 0x5b, 0x37, 0x69, 0xdd, 0x76, 0x49, 0x23, 0x90,
+	0x50, 0xb4, 0x82, 0x99, 0xb6, 0xac, 0x38, 0x2c, 0x42, 0x68, 0x93, 0x16, 0x6d, 0xc5, 0x1e, 0x50,
+	0x0a, 0x54, 0xe2, 0x62, 0x79, 0xc6, 0x6f, 0x67, 0x4c, 0x67, 0xec, 0xc8, 0x76, 0x52, 0xf5, 0xc6,
+	0x99, 0x13, 0x1f, 0x85, 0x8f, 0xb1, 0xc7, 0x3d, 0x22, 0xb4, 0x5a, 0xa1, 0xf6, 0xc0, 0xd7, 0x40,
+	0xb6, 0x67, 0x92, 0xb4, 0x70, 0xa8, 0xb8, 0x54, 0xb5, 0x7f, 0xff, 0xff, 0x7b, 0x9e, 0xf7, 0x9c,
+	0x67, 0xb4, 0x2f, 0xe4, 0xcf, 0x90, 0x5a, 0x31, 0x81, 0x78, 0x04, 0x59, 0x76, 0x15, 0x4f, 0x0e,
+	0xe3, 0x11, 0xd3, 0xac, 0x34, 0xd1, 0x48, 0x2b, 0xab, 0x30, 0x9e, 0x0a, 0x22, 0x2f, 0x88, 0x26,
+	0x87, 0xbb, 0xad, 0x4c, 0x65, 0xca, 0xe3, 0xd8, 0xfd, 0x17, 0x94, 0xbb, 0x9d, 0x54, 0x99, 0x52,
+	0x99, 0x38, 0x61, 0x06, 0xe2, 0xc9, 0x61, 0x02, 0x96, 0x1d, 0xc6, 0xa9, 0x12, 0xb2, 0xe2, 0x1b,
+	0xac, 0x14, 0x52, 0xc5, 0xfe, 0x6f, 0xd8, 0xfa, 0xe8, 0xed, 0x0a, 0x5a, 0xfc, 0xce, 0x67, 0xc3,
+	0x3b, 0x68, 0xc9, 0xc7, 0xa7, 0x82, 0x93, 0x66, 0xb7, 0xd9, 0x5b, 0x1e, 0x3e, 0xf4, 0xeb, 0x53,
+	0x8e, 0x0f, 0x50, 0x2b, 0x55, 0xd2, 0x6a, 0x96, 0x5a, 0x6a, 0xd4, 0x58, 0xa7, 0x40, 0x73, 0x66,
+	0x72, 0xf2, 0x9e, 0x97, 0xe1, 0x9a, 0x9d, 0x79, 0xf4, 0x82, 0x99, 0x1c, 0x7f, 0x81, 0xb6, 0x13,
+	0x2d, 0x78, 0x06, 0x14, 0x6c, 0x0e, 0x1a, 0xc6, 0x25, 0x65, 0x9c, 0x6b, 0x30, 0x86, 0x3c, 0xf0,
+	0xa6, 0x76, 0xc0, 0x27, 0x15, 0x7d, 0x1e, 0x20, 0xfe, 0x04, 0xad, 0x57, 0xbe, 0x34, 0x67, 0x42,
+	0xba, 0xb3, 0x2c, 0x74, 0x9b, 0xbd, 0x85, 0xe1, 0x5a, 0xd8, 0x1e, 0xb8, 0xdd, 0x53, 0x8e, 0x8f,
+	0x50, 0xdb, 0x88, 0x4c, 0x02, 0xa7, 0x13, 0x56, 0x18, 0xb0, 0x86, 0x5e, 0x0a, 0xc9, 0xd5, 0x25,
+	0x79, 0xdf, 0xab, 0x37, 0x03, 0xfc, 0x31, 0xb0, 0x73, 0x8f, 0xe6, 0x3c, 0x09, 0xb3, 0x69, 0x0e,
+	0x53, 0xcf, 0xe2, 0xbc, 0xa7, 0x1f, 0x58, 0xe5, 0x39, 0x40, 0xad, 0xca, 0x93, 0x16, 0x4c, 0x94,
+	0x53, 0xcb, 0x43, 0x6f, 0xc1, 0x81, 0x0d, 0x3c, 0x9a, 0x39, 0x2c, 0xd3, 0x19, 0xd8, 0x90, 0x85,
+	0x5a, 0x51, 0x82, 0x1a, 0x5b, 0xb2, 0x14, 0x1c, 0x81, 0xf9, 0x24, 0xdf, 0x07, 0x82, 0x3f, 0x45,
+	0x98, 0x4d, 0x40, 0xb3, 0x0c, 0x68, 0x52, 0xa8, 0xf4, 0xc2, 0x5b, 0xc8, 0xb2, 0xd7, 0x3f, 0xaa,
+	0x48, 0xdf, 0x01, 0x67, 0xc0, 0x5f, 0xa1, 0xbd, 0x5a, 0x3d, 0x2d, 0xed, 0x9c, 0x0d, 0x79, 0x1b,
+	0xa9, 0x24, 0x75, 0x79, 0x67, 0xf6, 0x73, 0xd4, 0x36, 0x05, 0x33, 0x39, 0x7d, 0xe5, 0x3a, 0x26,
+	0x94, 0xac, 0x0a, 0x48, 0x56, 0xba, 0xcd, 0xde, 0x6a, 0xff, 0xe3, 0xd7, 0xef, 0xf6, 0x1b, 0x7f,
+	0xbe, 0xdb, 0xdf, 0x0b, 0x57, 0xc9, 0xf0, 0x8b, 0x48, 0xa8, 0xb8, 0x64, 0x36, 0x8f, 0x5e, 0x42,
+	0xc6, 0xd2, 0xab, 0x63, 0x48, 0x87, 0x9b, 0x3e, 0xc2, 0x37, 0x55, 0x80, 0x50, 0x64, 0xfc, 0x03,
+	0x6a, 0xdd, 0x09, 0xec, 0xbf, 0x9f, 0xac, 0xde, 0x3f, 0x2e, 0xbe, 0x15, 0xd7, 0xd7, 0xe8, 0x3f,
+	0xc2, 0xfa, 0x46, 0x90, 0xb5, 0xff, 0x1b, 0xd6, 0x37, 0x0b, 0x17, 0xa8, 0x7b, 0x37, 0xac, 0x92,
+	0xaf, 0x0a, 0x91, 0x5a, 0x21, 0xb3, 0x2a, 0xc5, 0x07, 0xf7, 0x4f, 0xf1, 0xe1, 0xed, 0x14, 0xb3,
+	0x50, 0x21, 0xdb, 0x00, 0x75, 0xc6, 0x32, 0x51, 0x92, 0x53, 0xaf, 0x73, 0x29, 0xee, 0x5c, 0xdb,
+	0x75, 0xdf, 0xb6, 0xbd, 0xa0, 0x3a, 0xab, 0x44, 0xb7, 0xaf, 0xef, 0xc5, 0xbf, 0x8e, 0x9c, 0x30,
+	0xee, 0xee, 0x00, 0x75, 0xb7, 0x90, 0xd9, 0xb1, 0x06, 0xf2, 0xe8, 0xfe, 0x47, 0x7e, 0x7c, 0xa7,
+	0xd8, 0xfc, 0xc4, 0xe6, 0x67, 0x75, 0x20, 0xfc, 0x04, 0x6d, 0x04, 0x33, 0x75, 0xf3, 0x83, 0x72,
+	0x90, 0xaa, 0x24, 0x1b, 0xfe, 0x97, 0xbb, 0x1e, 0xc0, 0x40, 0x09, 0x79, 0xec, 0xb6, 0xf1, 0x97,
+	0x68, 0x77, 0x5e, 0x0b, 0x3a, 0x3d, 0x3a, 0xa0, 0xf5, 0x4c, 0x20, 0xd8, 0x9b, 0xb6, 0x67, 0xa6,
+	0x13, 0xc7, 0x07, 0x15, 0xc6, 0x4f, 0xd1, 0x96, 0xaf, 0xf6, 0xac, 0x32, 0x20, 0x59, 0x52, 0x00,
+	0x27, 0x9b, 0xdd, 0x66, 0x6f, 0x69, 0xd8, 0xf2, 0xb4, 0xae, 0xc8, 0x49, 0x60, 0xf8, 0x6b, 0xf4,
+	0xb8, 0x1e, 0x13, 0xd3, 0xb9, 0x64, 0x99, 0xb6, 0x34, 0x07, 0x91, 0xe5, 0x96, 0xb4, 0x7c, 0x39,
+	0x77, 0xaa, 0x99, 0x51, 0x8f, 0x27, 0xa7, 0x78, 0xe1, 0x05, 0xf8, 0x18, 0xad, 0x85, 0x0e, 0x50,
+	0x0d, 0x97, 0x4c, 0x73, 0xd2, 0xee, 0x36, 0x7b, 0x2b, 0x47, 0x3b, 0x51, 0x38, 0x67, 0xe4, 0x46,
+	0x68, 0x54, 0x8d, 0xd0, 0xc8, 0x9d, 0xba, 0xbf, 0xe0, 0x8a, 0x3a, 0x5c, 0x0d, 0xae, 0xa1, 0x37,
+	0xe1, 0x2d, 0xb4, 0xc8, 0x78, 0x29, 0xa4, 0x21, 0x5b, 0xdd, 0x07, 0xbd, 0xe5, 0x61, 0xb5, 0xc2,
+	0xcf, 0xd0, 0x8e, 0x81, 0x4c, 0x43, 0xc6, 0x2c, 0x70, 0x7a, 0xc9, 0x8a, 0x02, 0xec, 0x74, 0xfe,
+	0x6d, 0x87, 0x82, 0xcc, 0x04, 0xe7, 0x9e, 0xd7, 0x13, 0xf0, 0x29, 0xda, 0x1a, 0x69, 0xa1, 0xb4,
+	0xb0, 0x57, 0x54, 0xc8, 0xb4, 0x18, 0x1b, 0xd7, 0x6a, 0x96, 0x01, 0x21, 0xfe, 0xa3, 0x5a, 0x35,
+	0x3d, 0xad, 0xe1, 0xf3, 0x0c, 0x9e, 0xb5, 0x7f, 0x79, 0xdb, 0x6d, 0xfc, 0xfa, 0xf7, 0xef, 0x4f,
+	0x56, 0xc3, 0x23, 0x12, 0x66, 0x7a, 0x1f, 0x5e, 0x5f, 0x77, 0x9a, 0x6f, 0xae, 0x3b, 0xcd, 0xbf,
+	0xae, 0x3b, 0xcd, 0xdf, 0x6e, 0x3a, 0x8d, 0x37, 0x37, 0x9d, 0xc6, 0x1f, 0x37, 0x9d, 0xc6, 0x4f,
+	0xdf, 0x66, 0xc2, 0xe6, 0xe3, 0x24, 0x4a, 0x55, 0x19, 0x9f, 0xd6, 0x0f, 0xcc, 0x4b, 0x96, 0x98,
+	0x78, 0xfa, 0xdc, 0x7c, 0x96, 0x2a, 0x0d, 0xf3, 0x4b, 0x37, 0x7b, 0xe3, 0x52, 0xf1, 0x71, 0x01,
+	0xa6, 0x7a, 0xac, 0xec, 0xd5, 0x08, 0x4c, 0xb2, 0xe8, 0x1f, 0x93, 0xcf, 0xff, 0x19, 0x00, 0x7f,
+	0xac, 0x97, 0x7a, 0xcc, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -294,6 +306,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PriorityInclusionAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriorityInclusionAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	if len(m.SegregatedWalletAddress) > 0 {
 		i -= len(m.SegregatedWalletAddress)
 		copy(dAtA[i:], m.SegregatedWalletAddress)
@@ -568,6 +587,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.PriorityInclusionAge != 0 {
+		n += 2 + sovParams(uint64(m.PriorityInclusionAge))
+	}
 	return n
 }
 
@@ -1219,6 +1241,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SegregatedWalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityInclusionAge", wireType)
+			}
+			m.PriorityInclusionAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityInclusionAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
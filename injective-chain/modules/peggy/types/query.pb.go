@@ -1923,6 +1923,131 @@ func (m *MissingNoncesResponse) GetOperatorAddresses() []string {
 	return nil
 }
 
+type QuerySendToEthQueuePositionRequest struct {
+	TransactionId uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (m *QuerySendToEthQueuePositionRequest) Reset()         { *m = QuerySendToEthQueuePositionRequest{} }
+func (m *QuerySendToEthQueuePositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendToEthQueuePositionRequest) ProtoMessage()    {}
+func (*QuerySendToEthQueuePositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{42}
+}
+func (m *QuerySendToEthQueuePositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendToEthQueuePositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendToEthQueuePositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendToEthQueuePositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendToEthQueuePositionRequest.Merge(m, src)
+}
+func (m *QuerySendToEthQueuePositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendToEthQueuePositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendToEthQueuePositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendToEthQueuePositionRequest proto.InternalMessageInfo
+
+func (m *QuerySendToEthQueuePositionRequest) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+type QuerySendToEthQueuePositionResponse struct {
+	TokenContract string `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// position of the transfer in the queue, starting at 1 for the transfer that
+	// is picked first. Zero if the transfer is already batched.
+	Position uint64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// number of unbatched transfers of the same token
+	QueueLength uint64 `protobuf:"varint,3,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	// whether the transfer is old enough to be included ahead of higher fees
+	Priority bool `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// nonce of the batch containing the transfer, zero if not batched yet
+	BatchNonce uint64 `protobuf:"varint,5,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+}
+
+func (m *QuerySendToEthQueuePositionResponse) Reset()         { *m = QuerySendToEthQueuePositionResponse{} }
+func (m *QuerySendToEthQueuePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendToEthQueuePositionResponse) ProtoMessage()    {}
+func (*QuerySendToEthQueuePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_702b8e5c1503495b, []int{43}
+}
+func (m *QuerySendToEthQueuePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendToEthQueuePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendToEthQueuePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendToEthQueuePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendToEthQueuePositionResponse.Merge(m, src)
+}
+func (m *QuerySendToEthQueuePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendToEthQueuePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendToEthQueuePositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendToEthQueuePositionResponse proto.InternalMessageInfo
+
+func (m *QuerySendToEthQueuePositionResponse) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *QuerySendToEthQueuePositionResponse) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *QuerySendToEthQueuePositionResponse) GetQueueLength() uint64 {
+	if m != nil {
+		return m.QueueLength
+	}
+	return 0
+}
+
+func (m *QuerySendToEthQueuePositionResponse) GetPriority() bool {
+	if m != nil {
+		return m.Priority
+	}
+	return false
+}
+
+func (m *QuerySendToEthQueuePositionResponse) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.peggy.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.peggy.v1.QueryParamsResponse")
@@ -1966,123 +2091,134 @@ func init() {
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.peggy.v1.QueryModuleStateResponse")
 	proto.RegisterType((*MissingNoncesRequest)(nil), "injective.peggy.v1.MissingNoncesRequest")
 	proto.RegisterType((*MissingNoncesResponse)(nil), "injective.peggy.v1.MissingNoncesResponse")
+	proto.RegisterType((*QuerySendToEthQueuePositionRequest)(nil), "injective.peggy.v1.QuerySendToEthQueuePositionRequest")
+	proto.RegisterType((*QuerySendToEthQueuePositionResponse)(nil), "injective.peggy.v1.QuerySendToEthQueuePositionResponse")
 }
 
 func init() { proto.RegisterFile("injective/peggy/v1/query.proto", fileDescriptor_702b8e5c1503495b) }
 
 var fileDescriptor_702b8e5c1503495b = []byte{
-	// 1767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6f, 0xdb, 0x46,
-	0x16, 0xc7, 0x4d, 0x6f, 0x6c, 0xc7, 0x2f, 0x9b, 0xc4, 0x1e, 0xcb, 0x89, 0x4c, 0xdb, 0xb2, 0xc2,
-	0xfc, 0x58, 0x3b, 0xb1, 0x45, 0x5b, 0xf9, 0xb5, 0xd9, 0x45, 0x9c, 0xc4, 0x5e, 0x27, 0x08, 0x92,
-	0xac, 0xbd, 0x8a, 0xb1, 0x59, 0x6c, 0xd3, 0x12, 0x94, 0x34, 0xa6, 0xd9, 0xca, 0x1c, 0x85, 0xa4,
-	0x8d, 0x08, 0x41, 0x0a, 0xb4, 0x97, 0x16, 0x28, 0x0a, 0x04, 0xe8, 0xa9, 0x3d, 0xa4, 0x3d, 0xf7,
-	0xd6, 0x7b, 0x0f, 0x3d, 0x15, 0xe9, 0x2d, 0x68, 0x51, 0xa0, 0xa7, 0xa2, 0x48, 0xfa, 0x87, 0x14,
-	0x9c, 0x19, 0x52, 0x22, 0x39, 0xa4, 0x28, 0xa7, 0xa7, 0x98, 0x33, 0xef, 0xc7, 0xe7, 0x0d, 0x87,
-	0x6f, 0xe6, 0x1b, 0x41, 0xc1, 0xb4, 0xde, 0xc5, 0x35, 0xd7, 0xdc, 0xc3, 0x6a, 0x13, 0x1b, 0x46,
-	0x4b, 0xdd, 0x5b, 0x52, 0x1f, 0xed, 0x62, 0xbb, 0x55, 0x6a, 0xda, 0xc4, 0x25, 0x08, 0x05, 0xf3,
-	0x25, 0x3a, 0x5f, 0xda, 0x5b, 0x92, 0x8b, 0x02, 0x1f, 0x03, 0x5b, 0xd8, 0x31, 0x1d, 0xe6, 0x25,
-	0xcf, 0x08, 0x2c, 0x9a, 0xba, 0xad, 0xef, 0xf8, 0x06, 0xa2, 0xb4, 0x6e, 0xab, 0x89, 0xfd, 0xf9,
-	0x69, 0xc1, 0xfc, 0x8e, 0x63, 0xa4, 0x4d, 0x37, 0x09, 0x69, 0xa4, 0x44, 0xaf, 0xea, 0x6e, 0x6d,
-	0x9b, 0xcf, 0x4f, 0x19, 0x84, 0x18, 0x0d, 0xac, 0xea, 0x4d, 0x53, 0xd5, 0x2d, 0x8b, 0xb8, 0xba,
-	0x6b, 0x12, 0xcb, 0x0f, 0x9e, 0x33, 0x88, 0x41, 0xe8, 0x9f, 0xaa, 0xf7, 0x17, 0x1b, 0x55, 0x72,
-	0x80, 0xfe, 0xe3, 0xad, 0xcb, 0x06, 0x2d, 0xa3, 0x82, 0x1f, 0xed, 0x62, 0xc7, 0x55, 0xd6, 0x61,
-	0x2c, 0x34, 0xea, 0x34, 0x89, 0xe5, 0x60, 0xf4, 0x77, 0x18, 0x64, 0xe5, 0xe6, 0xa5, 0xa2, 0x34,
-	0x7b, 0xa8, 0x2c, 0x97, 0xe2, 0xcb, 0x58, 0x62, 0x3e, 0x2b, 0x07, 0x5e, 0xfc, 0x3a, 0xd3, 0x57,
-	0xe1, 0xf6, 0xca, 0x24, 0x4c, 0xd0, 0x80, 0xab, 0xbb, 0xb6, 0x8d, 0x2d, 0xf7, 0xbf, 0x7a, 0xc3,
-	0xc1, 0xae, 0x9f, 0x6d, 0x03, 0x64, 0xd1, 0x24, 0x4f, 0x5a, 0x86, 0xc1, 0x3d, 0x3a, 0x92, 0x96,
-	0x94, 0xfb, 0x70, 0x4b, 0x65, 0x89, 0xa7, 0x0b, 0xe5, 0xe1, 0xff, 0xa0, 0x1c, 0x0c, 0x58, 0xc4,
-	0xaa, 0x61, 0x1a, 0xef, 0x40, 0x85, 0x3d, 0x04, 0x10, 0x11, 0x97, 0x37, 0x80, 0xb8, 0x13, 0x82,
-	0x58, 0x25, 0xd6, 0x96, 0x69, 0xef, 0xa4, 0x42, 0xa0, 0x3c, 0x0c, 0xe9, 0xf5, 0xba, 0x8d, 0x1d,
-	0x27, 0xdf, 0x5f, 0x94, 0x66, 0x87, 0x2b, 0xfe, 0xa3, 0xf2, 0x30, 0x84, 0x17, 0x04, 0xe3, 0x78,
-	0xcb, 0x30, 0x54, 0x63, 0x43, 0x9c, 0xef, 0x94, 0x88, 0xef, 0x9e, 0x63, 0x84, 0xdd, 0x7d, 0x27,
-	0xe5, 0x0a, 0x9c, 0x88, 0x47, 0x77, 0x56, 0x5a, 0xff, 0xf6, 0xa8, 0xd2, 0xd7, 0x6d, 0x0b, 0x94,
-	0x34, 0x57, 0x0e, 0x78, 0x1d, 0x0e, 0xf2, 0x5c, 0xde, 0xde, 0xf9, 0x4b, 0x66, 0xc2, 0xc0, 0x4b,
-	0x29, 0x42, 0x81, 0xe6, 0xb9, 0xab, 0x3b, 0xe1, 0xed, 0x13, 0x6c, 0xda, 0x07, 0x30, 0x93, 0x68,
-	0xc1, 0x31, 0x2e, 0xc0, 0x10, 0x7b, 0x39, 0x3e, 0x45, 0xda, 0x7b, 0xf4, 0x4d, 0x95, 0x9b, 0x70,
-	0x36, 0x08, 0xbc, 0x81, 0xad, 0xba, 0x69, 0x19, 0xa1, 0xf8, 0x2b, 0xad, 0x1b, 0xf5, 0xba, 0xed,
-	0x2f, 0x53, 0xc7, 0x3b, 0x94, 0xc2, 0xef, 0xb0, 0x06, 0xe7, 0x32, 0xc5, 0x79, 0x23, 0xd8, 0x63,
-	0x90, 0xa3, 0x49, 0x56, 0xbc, 0xc6, 0x70, 0x13, 0xfb, 0x6f, 0x4f, 0xd9, 0x84, 0xf1, 0xc8, 0x38,
-	0x4f, 0xf3, 0x4f, 0x18, 0xae, 0xf2, 0x31, 0x3f, 0xd1, 0xb4, 0x28, 0x91, 0xef, 0xe8, 0x54, 0xda,
-	0xf6, 0xca, 0x1a, 0xcc, 0x45, 0x4b, 0xa2, 0x76, 0x3d, 0xae, 0x8c, 0x11, 0x5f, 0x61, 0x51, 0x18,
-	0x4e, 0x7c, 0x05, 0x06, 0x28, 0x01, 0xdf, 0xeb, 0x27, 0x45, 0xb4, 0xeb, 0xbb, 0xae, 0x41, 0x4c,
-	0xcb, 0xd8, 0x7c, 0xcc, 0x02, 0x31, 0x0f, 0x65, 0x06, 0xa6, 0x69, 0xa2, 0xc8, 0x34, 0x0e, 0x36,
-	0x91, 0xc6, 0xb7, 0x99, 0xc0, 0x80, 0x67, 0xbf, 0x0a, 0x43, 0x55, 0x36, 0xc4, 0x57, 0x2b, 0x53,
-	0x7e, 0xdf, 0x47, 0xa9, 0xf2, 0x5d, 0x1a, 0xae, 0xaf, 0xfb, 0x87, 0x86, 0xe6, 0x60, 0xa4, 0x46,
-	0x2c, 0xd7, 0xd6, 0x6b, 0xae, 0x16, 0x6e, 0x12, 0x47, 0xfd, 0xf1, 0x1b, 0x7c, 0x39, 0xdf, 0x86,
-	0x62, 0x72, 0x8e, 0x37, 0x5f, 0xc4, 0x87, 0xbc, 0xb1, 0xd1, 0x41, 0xff, 0x8b, 0xff, 0x13, 0xe1,
-	0x65, 0x51, 0x74, 0x8e, 0x7d, 0x2d, 0xd6, 0x48, 0x4e, 0x26, 0x34, 0x12, 0xee, 0xca, 0xc8, 0xdb,
-	0x7d, 0xe4, 0x32, 0x4c, 0x06, 0x5b, 0x6d, 0x6d, 0x0f, 0x5b, 0x99, 0xf7, 0x68, 0x03, 0xa6, 0xc4,
-	0x8e, 0x9c, 0xec, 0x2e, 0x8c, 0x34, 0x74, 0xc7, 0xd5, 0x6a, 0x0d, 0xdd, 0xdc, 0xd1, 0xb0, 0x67,
-	0xc1, 0xd7, 0x56, 0x11, 0x11, 0x7a, 0x61, 0x56, 0x3d, 0x53, 0x1a, 0xab, 0x72, 0xa4, 0x11, 0x7a,
-	0x56, 0x16, 0x21, 0x4f, 0xb3, 0xad, 0x55, 0x56, 0xcb, 0x8b, 0x9b, 0xe4, 0x5f, 0xd8, 0x22, 0x9d,
-	0x67, 0x07, 0xb6, 0x6b, 0xe5, 0x45, 0x4e, 0xc8, 0x1e, 0x94, 0x77, 0xf8, 0x5b, 0x09, 0x7b, 0x70,
-	0xb8, 0x1c, 0x0c, 0xd4, 0xbd, 0x01, 0xdf, 0x85, 0x3e, 0xa0, 0x73, 0x30, 0x5a, 0x23, 0xce, 0x0e,
-	0x71, 0x34, 0x62, 0x9b, 0x86, 0x69, 0xe9, 0x2e, 0xae, 0xd3, 0xd7, 0x72, 0xb0, 0x32, 0xc2, 0x26,
-	0xd6, 0x83, 0xf1, 0x80, 0x88, 0x06, 0xde, 0x24, 0x34, 0x4d, 0x07, 0x51, 0x3c, 0x7c, 0x40, 0x14,
-	0xf6, 0x68, 0x13, 0xc5, 0x8b, 0xe8, 0x8d, 0xa8, 0x02, 0x27, 0x79, 0xfc, 0x06, 0x36, 0x74, 0x17,
-	0xdf, 0xc1, 0x2d, 0x67, 0xc5, 0x3b, 0x88, 0xcc, 0xba, 0xee, 0x12, 0x9b, 0x6f, 0x28, 0x2f, 0xe6,
-	0x9e, 0x3f, 0xa6, 0x85, 0x5f, 0xee, 0xc8, 0x5e, 0xc4, 0x58, 0xf9, 0x40, 0xe2, 0x4d, 0x3a, 0x3d,
-	0x68, 0x50, 0xc6, 0x0c, 0x1c, 0xc2, 0xee, 0x76, 0x24, 0x2c, 0x60, 0x77, 0xdb, 0xcf, 0xbe, 0x04,
-	0x39, 0x62, 0x7b, 0x5f, 0xbe, 0x6b, 0x87, 0x00, 0xd8, 0xee, 0x1f, 0xeb, 0x9c, 0xf3, 0x19, 0xae,
-	0xf3, 0x26, 0x15, 0x46, 0x58, 0x6b, 0xc7, 0xec, 0x96, 0x54, 0xf9, 0x48, 0x82, 0xd3, 0xa9, 0x21,
-	0x02, 0xfe, 0x5e, 0x16, 0x67, 0x3f, 0xb5, 0xbc, 0x05, 0x67, 0x04, 0x20, 0xeb, 0x71, 0xcb, 0xc4,
-	0xe0, 0x52, 0x72, 0xf0, 0xf7, 0xa1, 0x94, 0x2d, 0xf8, 0xfe, 0xca, 0x8d, 0x2c, 0x73, 0x7f, 0x6c,
-	0x99, 0x97, 0xf9, 0x99, 0xca, 0x8f, 0xac, 0xfb, 0xd8, 0xaa, 0x6f, 0x92, 0x35, 0x77, 0x1b, 0x9d,
-	0x86, 0x23, 0x0e, 0xb6, 0xea, 0x38, 0x9a, 0xe3, 0x30, 0x1b, 0xf5, 0xfd, 0x7f, 0x94, 0xf8, 0x9b,
-	0x8e, 0x06, 0x08, 0x78, 0xff, 0x07, 0x39, 0xd7, 0xd6, 0x2d, 0x67, 0x0b, 0xdb, 0x8e, 0x66, 0x5a,
-	0x5a, 0xf8, 0xe4, 0x39, 0x93, 0xda, 0xb4, 0xb9, 0xdf, 0xe6, 0xe3, 0x0a, 0x0a, 0x62, 0xdc, 0xb6,
-	0xf8, 0x71, 0x86, 0x1e, 0xc0, 0xd8, 0xae, 0xc5, 0xc2, 0xd5, 0xb5, 0x60, 0x3e, 0xdf, 0xdf, 0x5b,
-	0xe0, 0x20, 0x84, 0x3f, 0xe8, 0x28, 0x13, 0x70, 0x9c, 0xd6, 0x74, 0x8f, 0xd4, 0x77, 0x1b, 0xf8,
-	0xbe, 0xab, 0xbb, 0xc1, 0x1d, 0xa4, 0xc2, 0x5b, 0x48, 0x68, 0x8a, 0x57, 0x7a, 0x09, 0x06, 0x1c,
-	0x6f, 0x80, 0xf7, 0xcc, 0xa2, 0x88, 0xe0, 0x16, 0x53, 0x63, 0xcc, 0x91, 0x99, 0x7b, 0xf7, 0x9d,
-	0x7b, 0xa6, 0xe3, 0x98, 0x96, 0x41, 0xcf, 0xb7, 0xe0, 0x20, 0xbf, 0x09, 0xe3, 0x91, 0x71, 0x9e,
-	0x68, 0x01, 0x10, 0x69, 0xe2, 0xd0, 0x1e, 0xe3, 0x0b, 0x3a, 0x5c, 0x19, 0xf5, 0x67, 0x6e, 0xf8,
-	0x13, 0xe5, 0xef, 0x65, 0x18, 0xa0, 0xd0, 0xc8, 0x81, 0x41, 0xa6, 0x6d, 0x90, 0x70, 0x79, 0xe2,
-	0x32, 0x4a, 0xfe, 0x5b, 0x57, 0x3b, 0xc6, 0xa4, 0xe4, 0x3f, 0xfc, 0xe9, 0xf7, 0xcf, 0xfa, 0x11,
-	0x1a, 0x89, 0xea, 0x4a, 0xf4, 0x4c, 0x82, 0xc3, 0x21, 0x5d, 0x84, 0x16, 0x12, 0x83, 0x8a, 0xc4,
-	0x95, 0x5c, 0xca, 0x6a, 0xce, 0x51, 0x8a, 0x14, 0x45, 0x46, 0xf9, 0x36, 0x0a, 0xbb, 0x5a, 0xaa,
-	0x35, 0x66, 0x8f, 0x3e, 0x96, 0xe0, 0x70, 0x28, 0x47, 0x0a, 0x92, 0x48, 0x80, 0xa5, 0x20, 0x09,
-	0xc5, 0x97, 0x68, 0x75, 0x18, 0x12, 0x5d, 0x9d, 0x90, 0x60, 0xe8, 0x8a, 0x12, 0x96, 0x61, 0x5d,
-	0x51, 0x22, 0x42, 0x2b, 0x6d, 0x75, 0x38, 0xc0, 0xd7, 0x12, 0x8c, 0x0b, 0xb5, 0x10, 0xba, 0x98,
-	0x2d, 0x57, 0x44, 0x76, 0xc9, 0x97, 0x7a, 0x75, 0xe3, 0xa8, 0x0a, 0x45, 0x9d, 0x42, 0x72, 0x1b,
-	0xd5, 0xbf, 0x04, 0xa9, 0x4f, 0xe8, 0xad, 0xec, 0x29, 0xfa, 0x4a, 0x02, 0x14, 0x97, 0x4b, 0xa8,
-	0x9c, 0x98, 0x32, 0x51, 0x7d, 0xc9, 0xe7, 0x7b, 0xf2, 0xe1, 0x8c, 0x27, 0x28, 0xe3, 0x24, 0x9a,
-	0x88, 0x2d, 0xa7, 0xed, 0xb3, 0x7c, 0x27, 0x41, 0x21, 0x5d, 0x30, 0xa1, 0xe5, 0xd4, 0xd4, 0x5d,
-	0x15, 0x9b, 0x7c, 0x6d, 0xdf, 0xfe, 0xbc, 0x8c, 0x69, 0x5a, 0xc6, 0x71, 0x34, 0x1e, 0x2b, 0xc3,
-	0xbb, 0xd5, 0xa1, 0xe7, 0x12, 0x1c, 0x8d, 0xdc, 0x1a, 0x91, 0x9a, 0x9a, 0x33, 0x7e, 0x31, 0x95,
-	0x17, 0xb3, 0x3b, 0x70, 0xaa, 0x59, 0x4a, 0xa5, 0xa0, 0x62, 0x9b, 0x8a, 0xd8, 0x7a, 0xad, 0x81,
-	0x55, 0x7a, 0x39, 0x55, 0x9f, 0xf0, 0xee, 0xf7, 0x14, 0x7d, 0x21, 0xc1, 0xd8, 0x2d, 0xec, 0xc6,
-	0x8e, 0xb1, 0xb9, 0xe4, 0xfe, 0x15, 0x31, 0x95, 0x97, 0x32, 0x9b, 0x06, 0x7c, 0xa7, 0x29, 0xdf,
-	0x0c, 0x9a, 0xee, 0x68, 0x7a, 0xcc, 0x56, 0xf3, 0x8e, 0x49, 0xcd, 0x25, 0x1a, 0x76, 0xb7, 0xd1,
-	0x53, 0x18, 0x0e, 0xa4, 0x27, 0x9a, 0x4d, 0x4c, 0x13, 0xd1, 0xbb, 0xf2, 0x5c, 0x06, 0x4b, 0x0e,
-	0x32, 0x49, 0x41, 0xc6, 0xd1, 0x58, 0xe4, 0xbf, 0xd5, 0xb6, 0xbc, 0x8c, 0xcf, 0x25, 0x18, 0x8d,
-	0x89, 0x41, 0x94, 0x5c, 0x6e, 0x92, 0xb2, 0x94, 0xcb, 0xbd, 0xb8, 0x24, 0x7f, 0xc3, 0x94, 0x4c,
-	0x25, 0xdc, 0xc5, 0x7d, 0x8c, 0xbe, 0x95, 0x60, 0x3a, 0x55, 0x37, 0xa3, 0xab, 0x59, 0xf6, 0x77,
-	0xa2, 0x6c, 0x97, 0x97, 0xf7, 0xeb, 0xce, 0x8b, 0x98, 0xa2, 0x45, 0x1c, 0x43, 0xb9, 0x68, 0x11,
-	0xf4, 0xe3, 0xf8, 0x5c, 0x82, 0x31, 0x81, 0x4e, 0x45, 0xe7, 0xd3, 0xdf, 0x9f, 0x50, 0x39, 0xcb,
-	0x17, 0x7a, 0x73, 0xe2, 0x80, 0xc7, 0x29, 0xe0, 0x28, 0x3a, 0x1a, 0x01, 0xa4, 0xc7, 0x4b, 0x48,
-	0x86, 0xa6, 0x1c, 0x2f, 0x22, 0x31, 0x9c, 0x72, 0xbc, 0x08, 0xd5, 0xad, 0xe8, 0x78, 0x61, 0x4b,
-	0xe5, 0x77, 0x6e, 0xf4, 0xa5, 0x04, 0x7f, 0xed, 0x54, 0x78, 0x68, 0x3e, 0x31, 0x85, 0x40, 0x3a,
-	0xca, 0x0b, 0x19, 0xad, 0x39, 0x4f, 0x99, 0xf2, 0xcc, 0xa3, 0xb3, 0x9d, 0x67, 0x48, 0x44, 0x9e,
-	0xa9, 0x54, 0xb9, 0x79, 0x5f, 0x2b, 0x13, 0x95, 0x1e, 0x61, 0xa7, 0xe2, 0x4b, 0x21, 0x14, 0x48,
-	0xc9, 0x14, 0x42, 0x91, 0x8c, 0xcc, 0x46, 0x48, 0xc1, 0x68, 0x3f, 0xa1, 0x22, 0xf3, 0x07, 0x09,
-	0x26, 0x6e, 0x61, 0xb7, 0x43, 0x35, 0x74, 0x08, 0x3c, 0x74, 0x39, 0x05, 0x20, 0x4d, 0x12, 0xa6,
-	0x1c, 0x23, 0xd9, 0xb4, 0xa4, 0xa8, 0x16, 0xfa, 0x9b, 0x85, 0x56, 0xe7, 0xfe, 0xda, 0x7b, 0xb8,
-	0xe5, 0x68, 0xd5, 0x96, 0x16, 0x48, 0x15, 0xf4, 0x0d, 0x6b, 0xdd, 0xa1, 0x5a, 0xbc, 0xd6, 0xbd,
-	0x94, 0x11, 0xa6, 0x2d, 0x09, 0xe5, 0x2b, 0x3d, 0xbb, 0x04, 0xe4, 0xf3, 0x94, 0xfc, 0x0c, 0x3a,
-	0xd5, 0x95, 0xdc, 0xeb, 0xe8, 0x3f, 0x4b, 0x30, 0x15, 0x65, 0xee, 0x14, 0x6d, 0xe8, 0x1f, 0x19,
-	0x49, 0x04, 0x4a, 0x4f, 0x5e, 0xd9, 0xbf, 0x6f, 0x50, 0xce, 0x45, 0x5a, 0x8e, 0x8a, 0x16, 0xba,
-	0x96, 0xd3, 0xa9, 0x4a, 0xd1, 0xa7, 0x12, 0x8c, 0x6c, 0x78, 0x0e, 0x1d, 0xfa, 0x06, 0x9d, 0x4b,
-	0xe4, 0x89, 0x0b, 0x24, 0x79, 0x3e, 0x9b, 0x31, 0xc7, 0x2c, 0x50, 0xcc, 0x3c, 0x3a, 0xd6, 0xf1,
-	0x63, 0x12, 0x35, 0xd3, 0xa8, 0x34, 0x42, 0x9f, 0x48, 0x80, 0xb8, 0x06, 0xf2, 0xb0, 0x08, 0x13,
-	0x42, 0xe2, 0x33, 0x54, 0xa4, 0xa1, 0xc4, 0x67, 0xa8, 0x50, 0x55, 0x89, 0x3a, 0xd7, 0x0e, 0x33,
-	0xd4, 0xe8, 0x5d, 0xd3, 0x59, 0xc1, 0x2f, 0x5e, 0x15, 0xa4, 0x97, 0xaf, 0x0a, 0xd2, 0x6f, 0xaf,
-	0x0a, 0xd2, 0xb3, 0xd7, 0x85, 0xbe, 0x97, 0xaf, 0x0b, 0x7d, 0xbf, 0xbc, 0x2e, 0xf4, 0xfd, 0xff,
-	0x8e, 0x61, 0xba, 0xdb, 0xbb, 0xd5, 0x52, 0x8d, 0xec, 0xa8, 0xb7, 0xfd, 0x84, 0x77, 0xf5, 0xaa,
-	0xa3, 0x06, 0xe9, 0x17, 0x6a, 0xc4, 0xc6, 0x9d, 0x8f, 0xdb, 0xba, 0x69, 0xf1, 0x6a, 0x1d, 0x9e,
-	0x92, 0xfe, 0xd0, 0x56, 0x1d, 0xa4, 0xbf, 0x6b, 0x9d, 0xff, 0x23, 0x00, 0x00, 0xff, 0xff, 0x32,
-	0x01, 0x57, 0x21, 0x02, 0x1c, 0x00, 0x00,
+	// 1912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0xc7, 0xc3, 0x6c, 0x9c, 0x1f, 0x2f, 0x9b, 0xc4, 0x19, 0xcb, 0x89, 0xcc, 0x58, 0xb2, 0xc2,
+	0x6c, 0x52, 0x3b, 0xb6, 0x45, 0x5b, 0xd9, 0xdd, 0x34, 0x2d, 0x62, 0x6f, 0xec, 0x3a, 0x41, 0x60,
+	0xa7, 0xf6, 0x6a, 0x8d, 0x6e, 0xd1, 0x6e, 0x4b, 0x50, 0xe2, 0x98, 0x62, 0x57, 0xe6, 0x28, 0x1c,
+	0xca, 0x88, 0x10, 0xb8, 0x40, 0x7b, 0x69, 0x81, 0xa2, 0xc0, 0x02, 0x3d, 0x6d, 0x0f, 0xdb, 0x9e,
+	0x7b, 0xeb, 0xbd, 0x87, 0x1e, 0xd3, 0x5b, 0xd0, 0xa2, 0x40, 0x4f, 0x45, 0x91, 0xf4, 0x0f, 0x29,
+	0x38, 0x33, 0xa4, 0xf8, 0x5b, 0x94, 0xd3, 0x93, 0xcd, 0x37, 0xef, 0xc7, 0xe7, 0x0d, 0x87, 0x33,
+	0xfc, 0x52, 0x50, 0xb5, 0xec, 0x9f, 0xe1, 0xb6, 0x6b, 0x1d, 0x61, 0xb5, 0x87, 0x4d, 0x73, 0xa0,
+	0x1e, 0xad, 0xaa, 0xcf, 0xfb, 0xd8, 0x19, 0xd4, 0x7b, 0x0e, 0x71, 0x09, 0x42, 0xc1, 0x78, 0x9d,
+	0x8d, 0xd7, 0x8f, 0x56, 0xe5, 0x5a, 0x4a, 0x8c, 0x89, 0x6d, 0x4c, 0x2d, 0xca, 0xa3, 0xe4, 0xb9,
+	0x14, 0x8f, 0x9e, 0xee, 0xe8, 0x87, 0xbe, 0x43, 0x5a, 0x59, 0x77, 0xd0, 0xc3, 0xfe, 0x78, 0x25,
+	0x65, 0xfc, 0x90, 0x9a, 0x79, 0xc3, 0x3d, 0x42, 0xba, 0x39, 0xd9, 0x5b, 0xba, 0xdb, 0xee, 0x88,
+	0xf1, 0x59, 0x93, 0x10, 0xb3, 0x8b, 0x55, 0xbd, 0x67, 0xa9, 0xba, 0x6d, 0x13, 0x57, 0x77, 0x2d,
+	0x62, 0xfb, 0xc9, 0x4b, 0x26, 0x31, 0x09, 0xfb, 0x57, 0xf5, 0xfe, 0xe3, 0x56, 0xa5, 0x04, 0xe8,
+	0x53, 0x6f, 0x5e, 0xf6, 0x58, 0x1b, 0x4d, 0xfc, 0xbc, 0x8f, 0xa9, 0xab, 0xec, 0xc2, 0x54, 0xc4,
+	0x4a, 0x7b, 0xc4, 0xa6, 0x18, 0x7d, 0x1b, 0xce, 0xf2, 0x76, 0xcb, 0x52, 0x4d, 0x9a, 0xbf, 0xd8,
+	0x90, 0xeb, 0xc9, 0x69, 0xac, 0xf3, 0x98, 0x8d, 0x33, 0xaf, 0xfe, 0x3d, 0x77, 0xaa, 0x29, 0xfc,
+	0x95, 0x1b, 0x30, 0xc3, 0x12, 0x6e, 0xf6, 0x1d, 0x07, 0xdb, 0xee, 0x0f, 0xf4, 0x2e, 0xc5, 0xae,
+	0x5f, 0x6d, 0x0f, 0xe4, 0xb4, 0x41, 0x51, 0xb4, 0x01, 0x67, 0x8f, 0x98, 0x25, 0xaf, 0xa8, 0x88,
+	0x11, 0x9e, 0xca, 0xaa, 0x28, 0x17, 0xa9, 0x23, 0xfe, 0xa0, 0x12, 0x4c, 0xd8, 0xc4, 0x6e, 0x63,
+	0x96, 0xef, 0x4c, 0x93, 0x5f, 0x04, 0x10, 0xb1, 0x90, 0x77, 0x80, 0xd8, 0x8e, 0x40, 0x6c, 0x12,
+	0xfb, 0xc0, 0x72, 0x0e, 0x73, 0x21, 0x50, 0x19, 0xce, 0xe9, 0x86, 0xe1, 0x60, 0x4a, 0xcb, 0xa7,
+	0x6b, 0xd2, 0xfc, 0x85, 0xa6, 0x7f, 0xa9, 0x7c, 0x01, 0x72, 0x5a, 0x32, 0x81, 0xb7, 0x06, 0xe7,
+	0xda, 0xdc, 0x24, 0xf8, 0x3e, 0x48, 0xe3, 0x7b, 0x46, 0xcd, 0x68, 0xb8, 0x1f, 0xa4, 0x3c, 0x80,
+	0x9b, 0xc9, 0xec, 0x74, 0x63, 0xf0, 0x7d, 0x8f, 0x2a, 0x7f, 0xde, 0x0e, 0x40, 0xc9, 0x0b, 0x15,
+	0x80, 0x9f, 0xc0, 0x79, 0x51, 0xcb, 0x5b, 0x3b, 0xef, 0x15, 0x26, 0x0c, 0xa2, 0x94, 0x1a, 0x54,
+	0x59, 0x9d, 0x1d, 0x9d, 0x46, 0x97, 0x4f, 0xb0, 0x68, 0x3f, 0x87, 0xb9, 0x4c, 0x0f, 0x81, 0xf1,
+	0x21, 0x9c, 0xe3, 0x37, 0xc7, 0xa7, 0xc8, 0xbb, 0x8f, 0xbe, 0xab, 0xf2, 0x18, 0xee, 0x06, 0x89,
+	0xf7, 0xb0, 0x6d, 0x58, 0xb6, 0x19, 0xc9, 0xbf, 0x31, 0x78, 0x64, 0x18, 0x8e, 0x3f, 0x4d, 0xa1,
+	0x7b, 0x28, 0x45, 0xef, 0x61, 0x1b, 0x16, 0x0b, 0xe5, 0x79, 0x27, 0xd8, 0x6b, 0x50, 0x62, 0x45,
+	0x36, 0xbc, 0x8d, 0xe1, 0x31, 0xf6, 0xef, 0x9e, 0xb2, 0x0f, 0xd3, 0x31, 0xbb, 0x28, 0xf3, 0x5d,
+	0xb8, 0xd0, 0x12, 0x36, 0xbf, 0x50, 0x25, 0xad, 0x90, 0x1f, 0x48, 0x9b, 0x43, 0x7f, 0x65, 0x0b,
+	0x16, 0xe2, 0x2d, 0x31, 0xbf, 0x31, 0x67, 0xc6, 0x84, 0xbb, 0x45, 0xd2, 0x08, 0xe2, 0x07, 0x30,
+	0xc1, 0x08, 0xc4, 0x5a, 0xbf, 0x95, 0x46, 0xbb, 0xdb, 0x77, 0x4d, 0x62, 0xd9, 0xe6, 0xfe, 0x0b,
+	0x9e, 0x88, 0x47, 0x28, 0x73, 0x50, 0x61, 0x85, 0x62, 0xc3, 0x38, 0x58, 0x44, 0x1a, 0x54, 0xb3,
+	0x1c, 0x44, 0xf5, 0x87, 0x70, 0xae, 0xc5, 0x4d, 0x62, 0xb6, 0x0a, 0xd5, 0xf7, 0x63, 0x94, 0x96,
+	0x58, 0xa5, 0xd1, 0xfe, 0x46, 0x3f, 0x68, 0x68, 0x01, 0x26, 0xdb, 0xc4, 0x76, 0x1d, 0xbd, 0xed,
+	0x6a, 0xd1, 0x4d, 0xe2, 0x8a, 0x6f, 0x7f, 0x24, 0xa6, 0xf3, 0x27, 0x50, 0xcb, 0xae, 0xf1, 0xee,
+	0x93, 0xf8, 0x85, 0xd8, 0xd8, 0x98, 0xd1, 0x7f, 0xe2, 0xff, 0x8f, 0xf0, 0x72, 0x5a, 0x76, 0x81,
+	0xbd, 0x9e, 0xd8, 0x48, 0x6e, 0x65, 0x6c, 0x24, 0x22, 0x94, 0x93, 0x0f, 0xf7, 0x91, 0xfb, 0x70,
+	0x23, 0x58, 0x6a, 0x5b, 0x47, 0xd8, 0x2e, 0xbc, 0x46, 0xbb, 0x30, 0x9b, 0x1e, 0x28, 0xc8, 0x76,
+	0x60, 0xb2, 0xab, 0x53, 0x57, 0x6b, 0x77, 0x75, 0xeb, 0x50, 0xc3, 0x9e, 0x87, 0x98, 0x5b, 0x25,
+	0x8d, 0xd0, 0x4b, 0xb3, 0xe9, 0xb9, 0xb2, 0x5c, 0xcd, 0xcb, 0xdd, 0xc8, 0xb5, 0xb2, 0x02, 0x65,
+	0x56, 0x6d, 0xab, 0xb9, 0xd9, 0x58, 0xd9, 0x27, 0xdf, 0xc3, 0x36, 0x09, 0x9f, 0x1d, 0xd8, 0x69,
+	0x37, 0x56, 0x04, 0x21, 0xbf, 0x50, 0x7e, 0x0a, 0x33, 0x29, 0x11, 0x02, 0xae, 0x04, 0x13, 0x86,
+	0x67, 0xf0, 0x43, 0xd8, 0x05, 0x5a, 0x84, 0xab, 0x6d, 0x42, 0x0f, 0x09, 0xd5, 0x88, 0x63, 0x99,
+	0x96, 0xad, 0xbb, 0xd8, 0x60, 0xb7, 0xe5, 0x7c, 0x73, 0x92, 0x0f, 0xec, 0x06, 0xf6, 0x80, 0x88,
+	0x25, 0xde, 0x27, 0xac, 0x4c, 0x88, 0x28, 0x99, 0x3e, 0x20, 0x8a, 0x46, 0x0c, 0x89, 0x92, 0x4d,
+	0x8c, 0x47, 0xd4, 0x84, 0x5b, 0x22, 0x7f, 0x17, 0x9b, 0xba, 0x8b, 0xb7, 0xf1, 0x80, 0x6e, 0x78,
+	0x07, 0x91, 0x65, 0xe8, 0x2e, 0x71, 0xc4, 0x82, 0xf2, 0x72, 0x1e, 0xf9, 0x36, 0x2d, 0x7a, 0x73,
+	0x27, 0x8f, 0x62, 0xce, 0xca, 0x2f, 0x24, 0x58, 0x2c, 0x90, 0x34, 0x68, 0x63, 0x0e, 0x2e, 0x62,
+	0xb7, 0x13, 0x4b, 0x0b, 0xd8, 0xed, 0xf8, 0xd5, 0x57, 0xa1, 0x44, 0x1c, 0xef, 0xc9, 0x77, 0x9d,
+	0x08, 0x00, 0x5f, 0xfd, 0x53, 0xe1, 0x31, 0x9f, 0xe1, 0x13, 0xa8, 0xa4, 0x20, 0x6c, 0x0d, 0x73,
+	0x8e, 0x2a, 0xaa, 0xfc, 0x4a, 0x82, 0xdb, 0xb9, 0x29, 0x02, 0xfe, 0x71, 0x26, 0xe7, 0x24, 0xbd,
+	0xfc, 0x18, 0xee, 0xa4, 0x80, 0xec, 0x26, 0x3d, 0x33, 0x93, 0x4b, 0xd9, 0xc9, 0x7f, 0x0e, 0xf5,
+	0x62, 0xc9, 0x4f, 0xd6, 0x6e, 0x6c, 0x9a, 0x4f, 0x27, 0xa6, 0x79, 0x4d, 0x9c, 0xa9, 0xe2, 0xc8,
+	0xfa, 0x0c, 0xdb, 0xc6, 0x3e, 0xd9, 0x72, 0x3b, 0xe8, 0x36, 0x5c, 0xa6, 0xd8, 0x36, 0x70, 0xbc,
+	0xc6, 0x25, 0x6e, 0xf5, 0xe3, 0xff, 0x2e, 0x41, 0x25, 0x35, 0x41, 0xc0, 0xfb, 0x43, 0x28, 0xb9,
+	0x8e, 0x6e, 0xd3, 0x03, 0xec, 0x50, 0xcd, 0xb2, 0xb5, 0xe8, 0xc9, 0x73, 0x27, 0x77, 0xd3, 0x16,
+	0x71, 0xfb, 0x2f, 0x9a, 0x28, 0xc8, 0xf1, 0xd4, 0x16, 0xc7, 0x19, 0xfa, 0x1c, 0xa6, 0xfa, 0x36,
+	0x4f, 0x67, 0x68, 0xc1, 0x78, 0xf9, 0xf4, 0x78, 0x89, 0x83, 0x14, 0xbe, 0x91, 0x2a, 0x33, 0x70,
+	0x9d, 0xf5, 0xf4, 0x8c, 0x18, 0xfd, 0x2e, 0xfe, 0xcc, 0xd5, 0xdd, 0xe0, 0x1d, 0xa4, 0x09, 0xe5,
+	0xe4, 0x90, 0xe8, 0xf4, 0x63, 0x98, 0xa0, 0x9e, 0x41, 0xec, 0x99, 0xb5, 0x34, 0x82, 0x27, 0x5c,
+	0x8d, 0xf1, 0x40, 0xee, 0xee, 0xbd, 0xef, 0x3c, 0xb3, 0x28, 0xb5, 0x6c, 0x93, 0x9d, 0x6f, 0xc1,
+	0x41, 0xfe, 0x18, 0xa6, 0x63, 0x76, 0x51, 0x68, 0x19, 0x10, 0xe9, 0xe1, 0xc8, 0x1a, 0x13, 0x13,
+	0x7a, 0xa1, 0x79, 0xd5, 0x1f, 0x79, 0xe4, 0x0f, 0x28, 0xdb, 0xe2, 0xfd, 0x36, 0xb8, 0x37, 0x9f,
+	0xf6, 0x71, 0x1f, 0xef, 0x11, 0x6a, 0x79, 0xe2, 0xca, 0xdf, 0x00, 0x6f, 0xc3, 0x65, 0x36, 0x87,
+	0x7a, 0xdb, 0xb3, 0x6a, 0x96, 0x21, 0x8e, 0xbf, 0x4b, 0x21, 0xeb, 0x53, 0x43, 0x79, 0x25, 0xc1,
+	0xad, 0xdc, 0x6c, 0x82, 0xd1, 0x4b, 0x47, 0xbe, 0xc4, 0xb6, 0xe6, 0x1f, 0x8e, 0xfe, 0xfa, 0x61,
+	0xd6, 0x4d, 0x61, 0x44, 0x32, 0x9c, 0xef, 0x89, 0x50, 0xb6, 0x3a, 0xcf, 0x34, 0x83, 0x6b, 0x74,
+	0x13, 0xde, 0x7f, 0xee, 0xe5, 0xd6, 0xba, 0xd8, 0x36, 0xdd, 0x4e, 0xf9, 0x3d, 0x36, 0x7e, 0x91,
+	0xd9, 0x76, 0x98, 0x89, 0x85, 0x3b, 0x16, 0x71, 0x2c, 0x77, 0x50, 0x3e, 0xc3, 0xf6, 0xd8, 0xe0,
+	0xda, 0x5b, 0xfb, 0xec, 0xce, 0x6a, 0xfc, 0x30, 0x9f, 0x60, 0xd1, 0xc0, 0x4c, 0x6c, 0x3e, 0x1b,
+	0x5f, 0xcf, 0xc2, 0x04, 0x6b, 0x05, 0x51, 0x38, 0xcb, 0x35, 0x1f, 0x4a, 0x5d, 0x36, 0x49, 0x79,
+	0x29, 0x7f, 0x6b, 0xa4, 0x1f, 0x9f, 0x07, 0xa5, 0xfc, 0xcb, 0x7f, 0xfc, 0xf7, 0x77, 0xa7, 0x11,
+	0x9a, 0x8c, 0xeb, 0x6d, 0xf4, 0x95, 0x04, 0x97, 0x22, 0x7a, 0x11, 0x2d, 0x67, 0x26, 0x4d, 0x13,
+	0x9d, 0x72, 0xbd, 0xa8, 0xbb, 0x40, 0xa9, 0x31, 0x14, 0x19, 0x95, 0x87, 0x28, 0xfc, 0x95, 0x5b,
+	0x6d, 0x73, 0x7f, 0xf4, 0x6b, 0x09, 0x2e, 0x45, 0x6a, 0xe4, 0x20, 0xa5, 0x09, 0x53, 0xb9, 0x5e,
+	0xd4, 0x3d, 0x7b, 0x76, 0x38, 0x12, 0x9b, 0x9d, 0x88, 0x90, 0x1a, 0x89, 0x12, 0x95, 0xa7, 0x72,
+	0xbd, 0xa8, 0xfb, 0xe8, 0xd9, 0x11, 0x00, 0x7f, 0x92, 0x60, 0x3a, 0x55, 0x23, 0xa2, 0x8f, 0x8a,
+	0xd5, 0x8a, 0xc9, 0x51, 0xf9, 0xe3, 0x71, 0xc3, 0x04, 0xaa, 0xc2, 0x50, 0x67, 0x91, 0x3c, 0x44,
+	0x15, 0x8c, 0x54, 0x7d, 0xc9, 0x56, 0xfb, 0x31, 0xfa, 0xa3, 0x04, 0x28, 0x29, 0x23, 0x51, 0x23,
+	0xb3, 0x64, 0xa6, 0x2a, 0x95, 0xef, 0x8d, 0x15, 0x23, 0x18, 0x6f, 0x32, 0xc6, 0x1b, 0x68, 0x26,
+	0x31, 0x9d, 0x8e, 0xcf, 0xf2, 0x57, 0x09, 0xaa, 0xf9, 0x42, 0x12, 0xad, 0xe5, 0x96, 0x1e, 0xa9,
+	0x64, 0xe5, 0xf5, 0x13, 0xc7, 0x8b, 0x36, 0x2a, 0xac, 0x8d, 0xeb, 0x68, 0x3a, 0xd1, 0x86, 0xf7,
+	0xb6, 0x8b, 0xbe, 0x91, 0xe0, 0x4a, 0xec, 0x6d, 0x1a, 0xa9, 0xb9, 0x35, 0x93, 0x2f, 0xec, 0xf2,
+	0x4a, 0xf1, 0x00, 0x41, 0x35, 0xcf, 0xa8, 0x14, 0x54, 0x1b, 0x52, 0x11, 0x47, 0x6f, 0x77, 0xb1,
+	0xca, 0x5e, 0xda, 0xd5, 0x97, 0xe2, 0x54, 0x38, 0x46, 0xbf, 0x97, 0x60, 0xea, 0x09, 0x76, 0x13,
+	0xc7, 0xfb, 0x42, 0xf6, 0xfe, 0x15, 0x73, 0x95, 0x57, 0x0b, 0xbb, 0x06, 0x7c, 0xb7, 0x19, 0xdf,
+	0x1c, 0xaa, 0x84, 0x36, 0x3d, 0xee, 0xab, 0x51, 0x6c, 0x1b, 0x9a, 0x4b, 0x34, 0xec, 0x76, 0xd0,
+	0x31, 0x5c, 0x08, 0x24, 0x39, 0x9a, 0xcf, 0x2c, 0x13, 0xfb, 0x0e, 0x20, 0x2f, 0x14, 0xf0, 0x14,
+	0x20, 0x37, 0x18, 0xc8, 0x34, 0x9a, 0x8a, 0x7d, 0x6e, 0x3c, 0xf0, 0x2a, 0x7e, 0x23, 0xc1, 0xd5,
+	0x84, 0x48, 0x46, 0xd9, 0xed, 0x66, 0x29, 0x6e, 0xb9, 0x31, 0x4e, 0x48, 0xf6, 0x33, 0xcc, 0xc8,
+	0x54, 0x22, 0x42, 0xdc, 0x17, 0xe8, 0x2f, 0x12, 0x54, 0x72, 0xbf, 0x27, 0xa0, 0x87, 0x45, 0xd6,
+	0x77, 0xe6, 0xe7, 0x0c, 0x79, 0xed, 0xa4, 0xe1, 0xa2, 0x89, 0x59, 0xd6, 0xc4, 0x35, 0x54, 0x8a,
+	0x37, 0xc1, 0x1e, 0x8e, 0xaf, 0x25, 0x98, 0x4a, 0xd1, 0xef, 0xe8, 0x5e, 0xfe, 0xfd, 0x4b, 0xfd,
+	0xa2, 0x20, 0x7f, 0x38, 0x5e, 0x90, 0x00, 0xbc, 0xce, 0x00, 0xaf, 0xa2, 0x2b, 0x31, 0x40, 0x76,
+	0xbc, 0x44, 0xe4, 0x79, 0xce, 0xf1, 0x92, 0xf6, 0x91, 0x40, 0xae, 0x17, 0x75, 0xcf, 0x3e, 0x5e,
+	0xf8, 0x54, 0xf9, 0x3b, 0x37, 0xfa, 0x83, 0x04, 0xef, 0x87, 0x95, 0x2f, 0x5a, 0xca, 0x2c, 0x91,
+	0x22, 0xa9, 0xe5, 0xe5, 0x82, 0xde, 0x82, 0xa7, 0xc1, 0x78, 0x96, 0xd0, 0xdd, 0xf0, 0x19, 0x12,
+	0x93, 0xad, 0x2a, 0x53, 0xb4, 0xde, 0xd3, 0xca, 0xc5, 0xb6, 0x47, 0x18, 0x56, 0xc2, 0x39, 0x84,
+	0x29, 0x12, 0x5b, 0x5e, 0x2e, 0xe8, 0x3d, 0x0e, 0x21, 0x03, 0xf3, 0x08, 0xb9, 0xf8, 0xfe, 0x9b,
+	0x04, 0x33, 0x4f, 0xb0, 0x1b, 0x52, 0x53, 0x21, 0xe1, 0x8b, 0xee, 0xe7, 0x00, 0xe4, 0x49, 0x65,
+	0x79, 0xfd, 0x84, 0x81, 0x79, 0xbd, 0xb0, 0xdf, 0x72, 0x34, 0x43, 0xc4, 0x6b, 0x5f, 0xe2, 0x01,
+	0xd5, 0x5a, 0x03, 0x2d, 0x90, 0x70, 0xe8, 0xcf, 0x7c, 0xeb, 0x8e, 0xf4, 0xe2, 0x6d, 0xdd, 0xab,
+	0x05, 0x61, 0x86, 0x52, 0x59, 0x7e, 0x30, 0x76, 0x48, 0x40, 0xbe, 0xc4, 0xc8, 0xef, 0xa0, 0x0f,
+	0x46, 0x92, 0x7b, 0x3b, 0xfa, 0x3f, 0x25, 0x98, 0x8d, 0x33, 0x87, 0xc5, 0x2c, 0xfa, 0x4e, 0x41,
+	0x92, 0x14, 0x05, 0x2c, 0x6f, 0x9c, 0x3c, 0x36, 0x68, 0xe7, 0x23, 0xd6, 0x8e, 0x8a, 0x96, 0x47,
+	0xb6, 0x13, 0x56, 0xeb, 0xe8, 0xb7, 0x12, 0x4c, 0xee, 0x79, 0x01, 0x21, 0xdd, 0x87, 0x16, 0x33,
+	0x79, 0x92, 0xc2, 0x51, 0x5e, 0x2a, 0xe6, 0x2c, 0x30, 0xab, 0x0c, 0xb3, 0x8c, 0xae, 0x85, 0x7e,
+	0x64, 0x63, 0x6e, 0x1a, 0x93, 0x8c, 0xe8, 0x37, 0x12, 0x20, 0xa1, 0x0d, 0x3d, 0x2c, 0xc2, 0x05,
+	0x62, 0xfa, 0x19, 0x9a, 0xa6, 0x2d, 0xe5, 0x85, 0x02, 0x9e, 0xd9, 0x3b, 0xd7, 0x21, 0x77, 0xe4,
+	0xca, 0x8a, 0xa2, 0xd7, 0x12, 0x5c, 0x4b, 0x97, 0x83, 0x28, 0xfb, 0x15, 0x37, 0x57, 0x8d, 0xca,
+	0xf7, 0xc7, 0x8e, 0x13, 0xb4, 0x5b, 0x8c, 0x76, 0x1d, 0x3d, 0xcc, 0x7d, 0xf5, 0x50, 0x5f, 0x46,
+	0xb5, 0xee, 0xb1, 0xca, 0x95, 0xa6, 0xaf, 0x3d, 0x37, 0xf0, 0xab, 0x37, 0x55, 0xe9, 0xf5, 0x9b,
+	0xaa, 0xf4, 0x9f, 0x37, 0x55, 0xe9, 0xab, 0xb7, 0xd5, 0x53, 0xaf, 0xdf, 0x56, 0x4f, 0xfd, 0xeb,
+	0x6d, 0xf5, 0xd4, 0x8f, 0xb6, 0x4d, 0xcb, 0xed, 0xf4, 0x5b, 0xf5, 0x36, 0x39, 0x54, 0x9f, 0xfa,
+	0x8c, 0x3b, 0x7a, 0x8b, 0xaa, 0x01, 0xf1, 0x72, 0x9b, 0x38, 0x38, 0x7c, 0xd9, 0xd1, 0x2d, 0x5b,
+	0xdc, 0x40, 0x2a, 0xb8, 0xd8, 0x6f, 0xaa, 0xad, 0xb3, 0xec, 0x27, 0xcc, 0x7b, 0xff, 0x1b, 0x00,
+	0xaf, 0xf1, 0x75, 0xb0, 0xed, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2123,6 +2259,8 @@ type QueryClient interface {
 	// Retrieves the entire peggy module's state
 	PeggyModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 	MissingPeggoNonces(ctx context.Context, in *MissingNoncesRequest, opts ...grpc.CallOption) (*MissingNoncesResponse, error)
+	// Retrieves the position of an outgoing transfer in its token's batch queue
+	SendToEthQueuePosition(ctx context.Context, in *QuerySendToEthQueuePositionRequest, opts ...grpc.CallOption) (*QuerySendToEthQueuePositionResponse, error)
 }
 
 type queryClient struct {
@@ -2322,6 +2460,15 @@ func (c *queryClient) MissingPeggoNonces(ctx context.Context, in *MissingNoncesR
 	return out, nil
 }
 
+func (c *queryClient) SendToEthQueuePosition(ctx context.Context, in *QuerySendToEthQueuePositionRequest, opts ...grpc.CallOption) (*QuerySendToEthQueuePositionResponse, error) {
+	out := new(QuerySendToEthQueuePositionResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Query/SendToEthQueuePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Deployments queries deployments
@@ -2350,6 +2497,8 @@ type QueryServer interface {
 	// Retrieves the entire peggy module's state
 	PeggyModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 	MissingPeggoNonces(context.Context, *MissingNoncesRequest) (*MissingNoncesResponse, error)
+	// Retrieves the position of an outgoing transfer in its token's batch queue
+	SendToEthQueuePosition(context.Context, *QuerySendToEthQueuePositionRequest) (*QuerySendToEthQueuePositionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2419,6 +2568,9 @@ func (*UnimplementedQueryServer) PeggyModuleState(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) MissingPeggoNonces(ctx context.Context, req *MissingNoncesRequest) (*MissingNoncesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MissingPeggoNonces not implemented")
 }
+func (*UnimplementedQueryServer) SendToEthQueuePosition(ctx context.Context, req *QuerySendToEthQueuePositionRequest) (*QuerySendToEthQueuePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEthQueuePosition not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2802,6 +2954,24 @@ func _Query_MissingPeggoNonces_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SendToEthQueuePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendToEthQueuePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendToEthQueuePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Query/SendToEthQueuePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendToEthQueuePosition(ctx, req.(*QuerySendToEthQueuePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.peggy.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2890,6 +3060,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MissingPeggoNonces",
 			Handler:    _Query_MissingPeggoNonces_Handler,
 		},
+		{
+			MethodName: "SendToEthQueuePosition",
+			Handler:    _Query_SendToEthQueuePosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/peggy/v1/query.proto",
@@ -4261,6 +4435,89 @@ func (m *MissingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySendToEthQueuePositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendToEthQueuePositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendToEthQueuePositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransactionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TransactionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendToEthQueuePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendToEthQueuePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendToEthQueuePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Priority {
+		i--
+		if m.Priority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueueLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueueLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4835,6 +5092,43 @@ func (m *MissingNoncesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySendToEthQueuePositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionId != 0 {
+		n += 1 + sovQuery(uint64(m.TransactionId))
+	}
+	return n
+}
+
+func (m *QuerySendToEthQueuePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	if m.QueueLength != 0 {
+		n += 1 + sovQuery(uint64(m.QueueLength))
+	}
+	if m.Priority {
+		n += 2
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8305,6 +8599,234 @@ func (m *MissingNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySendToEthQueuePositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendToEthQueuePositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendToEthQueuePositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			m.TransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySendToEthQueuePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendToEthQueuePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendToEthQueuePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueLength", wireType)
+			}
+			m.QueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,10 @@ func GetQueryCmd() *cobra.Command {
 		GetNamespaceRoleActors(),
 		GetNamespaceAddressRoles(),
 		GetVouchersForAddress(),
+		GetVouchersByReceiver(),
+		GetVouchersBySender(),
+		GetActorQuotas(),
+		GetFrozenAccounts(),
 	)
 
 	return cmd
@@ -70,3 +74,35 @@ func GetVouchersForAddress() *cobra.Command {
 		&types.QueryVouchersRequest{}, nil, nil,
 	)
 }
+
+func GetVouchersByReceiver() *cobra.Command {
+	return cli.QueryCmd("vouchers-by-receiver <address>",
+		"Returns the vouchers claimable by the address along with their senders and expiry times",
+		types.NewQueryClient,
+		&types.QueryVouchersByReceiverRequest{}, nil, nil,
+	)
+}
+
+func GetVouchersBySender() *cobra.Command {
+	return cli.QueryCmd("vouchers-by-sender <address>",
+		"Returns the parts of vouchers sent by the address that have not been claimed yet",
+		types.NewQueryClient,
+		&types.QueryVouchersBySenderRequest{}, nil, nil,
+	)
+}
+
+func GetActorQuotas() *cobra.Command {
+	return cli.QueryCmd("actor-quotas <denom> <actor>",
+		"Returns the quotas of the actor in denom's namespace along with the remaining amounts",
+		types.NewQueryClient,
+		&types.QueryActorQuotasRequest{}, nil, nil,
+	)
+}
+
+func GetFrozenAccounts() *cobra.Command {
+	return cli.QueryCmd("frozen-accounts <denom>",
+		"Returns the accounts whose balances of denom are frozen",
+		types.NewQueryClient,
+		&types.QueryFrozenAccountsRequest{}, nil, nil,
+	)
+}
@@ -21,6 +21,10 @@ func GetTxCmd() *cobra.Command {
 		UpdateNamespaceCmd(),
 		UpdateNamespaceRolesCmd(),
 		ClaimVoucherCmd(),
+		ClaimVouchersCmd(),
+		FreezeAccountCmd(),
+		UnfreezeAccountCmd(),
+		ClawbackCmd(),
 	)
 
 	return cmd
@@ -150,6 +154,11 @@ func UpdateNamespaceRolesCmd() *cobra.Command {
 						"role": "whitelisted"
 						"actors": ["inj122qtfcjfx9suvgr5s7rtqgfy8xvtjhm8uc4x9f"],
 					},
+					{
+						"role": "minter"
+						"actors": ["inj1cml96vmptgw99syqrrz8az79xer2pcgp0a885r"],
+						"expires_at_timestamp": 1767225600,
+					},
 				],
 				"role_actors_to_revoke": [
 					{
@@ -203,3 +212,51 @@ func ClaimVoucherCmd() *cobra.Command {
 
 	return cmd
 }
+
+func ClaimVouchersCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"claim-vouchers <denoms>",
+		"Claims the vouchers of multiple denoms at once",
+		&types.MsgClaimVouchers{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions claim-vouchers factory/inj1address/denom1,factory/inj1address/denom2`
+
+	return cmd
+}
+
+func FreezeAccountCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"freeze-account <denom> <account> <reason_code>",
+		"Freezes the balance of denom held by the account in its bank and exchange subaccounts",
+		&types.MsgFreezeAccount{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions freeze-account factory/inj1address/denom inj1holder 1`
+
+	return cmd
+}
+
+func UnfreezeAccountCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"unfreeze-account <denom> <account>",
+		"Unfreezes the balance of denom held by the account",
+		&types.MsgUnfreezeAccount{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions unfreeze-account factory/inj1address/denom inj1holder`
+
+	return cmd
+}
+
+func ClawbackCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"clawback <account> <recovery_address> <amount> <reason_code>",
+		"Claws back the amount from the account's bank balance and exchange subaccounts to the recovery address",
+		&types.MsgClawback{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions clawback inj1holder inj1recovery 1000factory/inj1address/denom 1`
+
+	return cmd
+}
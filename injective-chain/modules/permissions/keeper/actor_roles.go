@@ -44,13 +44,13 @@ func (k Keeper) CheckPermissionsForAction(ctx sdk.Context, denom string, actor s
 
 // getTotalAllowedActionsForAddress returns the total allowed actions for the given address and denom
 func (k Keeper) getTotalAllowedActionsForAddress(ctx sdk.Context, denom string, actor sdk.AccAddress) (ActionBitMask, error) {
-	// check that action is allowed for address
-	roleIDs, err := k.GetActorRoleIDs(ctx, denom, actor)
+	// check that action is allowed for address, ignoring the roles which have expired
+	roleIDs, err := k.getActiveActorRoleIDs(ctx, denom, actor)
 	if err != nil {
 		return 0, err
 	}
 
-	// if no explicit roles are assigned to the actor, then the EVERYONE role applies
+	// if no explicit roles are assigned to the actor (or all of them have expired), then the EVERYONE role applies
 	if len(roleIDs) == 0 {
 		everyoneRoleId, _ := k.GetRoleID(ctx, denom, types.EVERYONE)
 		roleIDs = []uint32{everyoneRoleId}
@@ -74,26 +74,20 @@ func (k Keeper) getTotalAllowedActionsForAddress(ctx sdk.Context, denom string,
 	return totalAllowedActions, nil
 }
 
-// GetAddressRoleNames returns all the assigned roles for this address. Returns EVERYONE role if no roles found for this address.
+// GetAddressRoleNames returns all the assigned roles for this address which haven't expired. Returns EVERYONE role if no roles found for this address.
 func (k Keeper) GetAddressRoleNames(ctx sdk.Context, denom string, addr sdk.AccAddress) ([]string, error) {
-	store := k.getActorRolesStore(ctx, denom)
-	bz := store.Get(addr.Bytes())
-	if len(bz) == 0 {
-		return []string{types.EVERYONE}, nil
-	}
-	roleIDs := &types.RoleIDs{}
-
-	if err := proto.Unmarshal(bz, roleIDs); err != nil {
+	roleIDs, err := k.getActiveActorRoleIDs(ctx, denom, addr)
+	if err != nil {
 		return nil, err
 	}
 
-	if len(roleIDs.RoleIds) == 0 {
+	if len(roleIDs) == 0 {
 		return []string{types.EVERYONE}, nil
 	}
 
-	roleNames := make([]string, 0, len(roleIDs.RoleIds))
+	roleNames := make([]string, 0, len(roleIDs))
 
-	for _, roleID := range roleIDs.RoleIds {
+	for _, roleID := range roleIDs {
 		role, _ := k.GetRoleByID(ctx, denom, roleID)
 		roleNames = append(roleNames, role.Name)
 	}
@@ -271,6 +265,12 @@ func (k Keeper) addActorRoles(ctx sdk.Context, denom string, addr sdk.AccAddress
 		return err
 	}
 
+	// revoke the roles which have expired since the last update of the actor roles
+	existingRoleIDs, err = k.pruneExpiredActorRoleIDs(ctx, denom, addr, existingRoleIDs)
+	if err != nil {
+		return err
+	}
+
 	mergedRoles := existingRoleIDs
 	mergedRoles = append(mergedRoles, roleIDs...)
 	mergedRoles = slices.Compact(mergedRoles)
@@ -283,11 +283,20 @@ func (k Keeper) revokeActorRoles(ctx sdk.Context, denom string, addr sdk.AccAddr
 		return err
 	}
 
+	existingRoleIDs, err = k.pruneExpiredActorRoleIDs(ctx, denom, addr, existingRoleIDs)
+	if err != nil {
+		return err
+	}
+
 	// remove roleIDs from existingRoleIDs
 	newRoleIDs := slices.DeleteFunc(existingRoleIDs, func(roleId uint32) bool {
 		return slices.Contains(roleIDs, roleId)
 	})
 
+	for _, roleID := range roleIDs {
+		k.deleteActorRoleExpiry(ctx, denom, addr, roleID)
+	}
+
 	return k.setActorRoles(ctx, denom, addr, newRoleIDs)
 }
 
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// clawbackContextKey is set on the context of the transfers made by a clawback, so that the send restrictions of the
+// namespace (frozen accounts, role permissions, quotas and contract hook) don't apply to them
+type clawbackContextKey struct{}
+
+func isClawbackTransfer(ctx context.Context) bool {
+	return ctx.Value(clawbackContextKey{}) != nil
+}
+
+// clawback force transfers the amount from the account to the recovery address, taking it from the spendable bank
+// balance of the account first and from the available balances of its exchange subaccounts afterwards. If the bank
+// balance falls short, the exchange orders of the account using the denom are cancelled first to release the balances
+// they hold. It fails with ErrInsufficientClawback if the account doesn't hold the full amount.
+func (k Keeper) clawback(
+	ctx sdk.Context,
+	account, recoveryAddr sdk.AccAddress,
+	amount sdk.Coin,
+	reasonCode uint32,
+) error {
+	clawbackCtx := ctx.WithValue(clawbackContextKey{}, true)
+
+	spendable := k.bankKeeper.SpendableCoin(ctx, account, amount.Denom)
+	if spendable.Amount.LT(amount.Amount) {
+		// release the balances held by orders, the ones of the default subaccount are refunded to the bank
+		k.exchangeKeeper.CancelAllOrdersForDenom(ctx, account, amount.Denom)
+		spendable = k.bankKeeper.SpendableCoin(ctx, account, amount.Denom)
+	}
+
+	bankAmount := math.MinInt(spendable.Amount, amount.Amount)
+
+	if bankAmount.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(amount.Denom, bankAmount))
+		if err := k.bankKeeper.SendCoins(clawbackCtx, account, recoveryAddr, coins); err != nil {
+			return err
+		}
+	}
+
+	subaccountsAmount := math.ZeroInt()
+	if remaining := amount.Amount.Sub(bankAmount); remaining.IsPositive() {
+		var err error
+		subaccountsAmount, err = k.exchangeKeeper.ClawbackSubaccountDeposits(clawbackCtx, account, recoveryAddr, amount.Denom, remaining)
+		if err != nil {
+			return err
+		}
+	}
+
+	if total := bankAmount.Add(subaccountsAmount); total.LT(amount.Amount) {
+		return types.ErrInsufficientClawback.Wrapf("%s holds %s%s, %s requested", account, total, amount.Denom, amount)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventClawback{
+		Account:           account.String(),
+		RecoveryAddress:   recoveryAddr.String(),
+		Amount:            amount,
+		BankAmount:        bankAmount,
+		SubaccountsAmount: subaccountsAmount,
+		ReasonCode:        reasonCode,
+	})
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"strings"
@@ -10,10 +11,40 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
 )
 
+// validateContractHook checks that the contract hook, either a CosmWasm or an EVM contract, exists and satisfies the
+// expected interface. It returns the contract hook in its canonical form.
+func (k Keeper) validateContractHook(ctx context.Context, contractHook string) (string, error) {
+	if types.IsEVMContractHook(contractHook) {
+		contract := common.HexToAddress(contractHook)
+		if err := k.validateEVMHook(sdk.UnwrapSDKContext(ctx), contract); err != nil {
+			return "", err
+		}
+		return contract.Hex(), nil
+	}
+
+	wasmContract := sdk.MustAccAddressFromBech32(contractHook)
+	if err := k.validateWasmHook(ctx, wasmContract); err != nil {
+		return "", err
+	}
+	return wasmContract.String(), nil
+}
+
+// executeContractHook invokes the contract hook of the namespace, if any
+func (k Keeper) executeContractHook(sdkCtx sdk.Context, namespace *types.Namespace, fromAddr, toAddr sdk.AccAddress, action types.Action, amount sdk.Coin) error {
+	if types.IsEVMContractHook(namespace.ContractHook) {
+		return k.executeEVMHook(sdkCtx, namespace, fromAddr, toAddr, action, amount)
+	}
+
+	return k.executeWasmHook(sdkCtx, namespace, fromAddr, toAddr, action, amount)
+}
+
 // validateWasmHook checks that contract exists and satisfies the expected interface
 func (k Keeper) validateWasmHook(ctx context.Context, contract sdk.AccAddress) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -95,3 +126,68 @@ func (k Keeper) executeWasmHook(sdkCtx sdk.Context, namespace *types.Namespace,
 
 	return nil
 }
+
+// validateEVMHook checks that the EVM contract exists. Since reverting is how the contract restricts an action, a
+// contract which doesn't implement the expected interface can't be told apart by calling it.
+func (k Keeper) validateEVMHook(ctx sdk.Context, contract common.Address) error {
+	if acc := k.evmKeeper.GetAccount(ctx, contract); acc == nil || bytes.Equal(acc.CodeHash, evmtypes.EmptyCodeHash) {
+		return errors.Wrapf(types.ErrInvalidContractHook, "EVM contract %s doesn't exist", contract.Hex())
+	}
+
+	return nil
+}
+
+func (k Keeper) executeEVMHook(sdkCtx sdk.Context, namespace *types.Namespace, fromAddr, toAddr sdk.AccAddress, action types.Action, amount sdk.Coin) error {
+	contractAddr := common.HexToAddress(namespace.ContractHook)
+
+	input, err := types.GetEVMHookCallInput(fromAddr, toAddr, action, amount)
+	if err != nil {
+		return types.ErrEVMHookError.Wrap(err.Error())
+	}
+
+	// same as for wasm hooks, the call is gas capped since the transfer hook can be called in EndBlocker. The call is
+	// static, so the hook can't alter the state.
+	maxGas := k.GetParams(sdkCtx).EffectiveEVMHookQueryMaxGas()
+	sdkCtxMetered := sdkCtx.WithGasMeter(storetypes.NewGasMeter(maxGas))
+
+	var resp *evmtypes.MsgEthereumTxResponse
+
+	// call evm hook contract inside a closure to catch out of gas panics, if any
+	func() {
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				if _, ok := panicErr.(storetypes.ErrorOutOfGas); ok {
+					err = errors.Wrapf(types.ErrEVMHookError, "panic during evm hook: out of gas, gas used = %d, gas limit = %d", sdkCtxMetered.GasMeter().GasConsumed(), maxGas)
+				} else {
+					err = errors.Wrapf(types.ErrEVMHookError, "panic during evm hook: %v", panicErr)
+				}
+			}
+		}()
+
+		if resp, err = k.evmKeeper.StaticCall(sdkCtxMetered, common.Address{}, contractAddr, input, maxGas); err != nil {
+			err = errors.Wrap(types.ErrEVMHookError, err.Error())
+		}
+	}()
+
+	gasUsed := sdkCtxMetered.GasMeter().GasConsumed()
+	if resp != nil {
+		gasUsed += resp.GasUsed
+	}
+	sdkCtx.GasMeter().ConsumeGas(gasUsed, "permissions evm hook: "+amount.Denom)
+
+	if err == nil && resp.Failed() {
+		if resp.VmError == vm.ErrExecutionReverted.Error() { // if the call reverts -> means permissions check failed
+			return errors.Wrap(types.ErrRestrictedAction, evmtypes.NewExecErrorWithReason(resp.Ret).Error())
+		}
+
+		err = errors.Wrapf(types.ErrEVMHookError, "evm hook call failed: %s", resp.VmError)
+	}
+
+	if err != nil {
+		// same as for wasm hooks, in case of a technical error (like out-of-gas, invalid opcode, state modification) we
+		// pretend that the evm hook is invalid and treat it like it doesn't exist at all
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// GetFrozenAccount returns the frozen account entry of the address for the denom, or nil if the account is not frozen
+func (k Keeper) GetFrozenAccount(ctx sdk.Context, denom string, addr sdk.AccAddress) (*types.FrozenAccount, error) {
+	store := k.getFrozenAccountsStore(ctx, denom)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	frozenAccount := &types.FrozenAccount{}
+	if err := proto.Unmarshal(bz, frozenAccount); err != nil {
+		return nil, err
+	}
+
+	return frozenAccount, nil
+}
+
+// IsAccountFrozen returns true if the balance of the denom held by the address is frozen
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	store := k.getFrozenAccountsStore(ctx, denom)
+	return store.Has(addr.Bytes())
+}
+
+func (k Keeper) setFrozenAccount(ctx sdk.Context, denom string, frozenAccount *types.FrozenAccount) error {
+	addr := sdk.MustAccAddressFromBech32(frozenAccount.Address)
+
+	bz, err := proto.Marshal(frozenAccount)
+	if err != nil {
+		return err
+	}
+
+	store := k.getFrozenAccountsStore(ctx, denom)
+	store.Set(addr.Bytes(), bz)
+	return nil
+}
+
+func (k Keeper) deleteFrozenAccount(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := k.getFrozenAccountsStore(ctx, denom)
+	store.Delete(addr.Bytes())
+}
+
+// GetAllFrozenAccounts returns all the frozen accounts inside namespace for this denom
+func (k Keeper) GetAllFrozenAccounts(ctx sdk.Context, denom string) ([]*types.FrozenAccount, error) {
+	frozenAccounts := make([]*types.FrozenAccount, 0)
+	store := k.getFrozenAccountsStore(ctx, denom)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		frozenAccount := &types.FrozenAccount{}
+		if err := proto.Unmarshal(iter.Value(), frozenAccount); err != nil {
+			return nil, err
+		}
+		frozenAccounts = append(frozenAccounts, frozenAccount)
+	}
+	return frozenAccounts, nil
+}
+
+// freezeAccount freezes the balance of the denom held by the address, both in the bank and in exchange subaccounts.
+// The exchange orders of the address using the denom are cancelled, since their settlement would move the frozen
+// balance. Freezing an already frozen account updates its reason code.
+func (k Keeper) freezeAccount(ctx sdk.Context, denom string, addr sdk.AccAddress, reasonCode uint32) error {
+	frozenAccount := &types.FrozenAccount{
+		Address:    addr.String(),
+		ReasonCode: reasonCode,
+		FrozenAt:   ctx.BlockTime().Unix(),
+	}
+
+	if err := k.setFrozenAccount(ctx, denom, frozenAccount); err != nil {
+		return err
+	}
+
+	k.exchangeKeeper.CancelAllOrdersForDenom(ctx, addr, denom)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventAccountFrozen{
+		Denom:      denom,
+		Account:    addr.String(),
+		ReasonCode: reasonCode,
+	})
+	return nil
+}
+
+func (k Keeper) unfreezeAccount(ctx sdk.Context, denom string, addr sdk.AccAddress) error {
+	if !k.IsAccountFrozen(ctx, denom, addr) {
+		return types.ErrAccountNotFrozen.Wrapf("account %s for %s", addr, denom)
+	}
+
+	k.deleteFrozenAccount(ctx, denom, addr)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventAccountUnfrozen{
+		Denom:   denom,
+		Account: addr.String(),
+	})
+	return nil
+}
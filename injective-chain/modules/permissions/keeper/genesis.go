@@ -28,6 +28,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, entry := range genState.VoucherEntries {
+		if err := k.setVoucherEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, usage := range genState.QuotaUsages {
+		if err := k.setActorQuotaUsage(ctx, usage); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the permissions module's exported genesis.
@@ -51,5 +63,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	gs.Vouchers = vouchers
+
+	voucherEntries, err := k.getAllVoucherEntries(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.VoucherEntries = voucherEntries
+
+	quotaUsages, err := k.getAllActorQuotaUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.QuotaUsages = quotaUsages
 	return gs
 }
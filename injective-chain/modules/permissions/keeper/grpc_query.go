@@ -82,7 +82,15 @@ func (q queryServer) ActorsByRole(c context.Context, req *types.QueryActorsByRol
 			if roleID != role.RoleId {
 				continue
 			}
-			actors = append(actors, actor.String())
+
+			isExpired, err := q.isActorRoleExpired(ctx, req.Denom, actor, roleID)
+			if err != nil {
+				return err
+			}
+
+			if !isExpired {
+				actors = append(actors, actor.String())
+			}
 			return nil
 		}
 		return nil
@@ -207,6 +215,83 @@ func (q queryServer) Voucher(c context.Context, req *types.QueryVoucherRequest)
 	}, nil
 }
 
+func (q queryServer) VouchersByReceiver(c context.Context, req *types.QueryVouchersByReceiverRequest) (*types.QueryVouchersByReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	vouchers, err := q.getVouchersForAddress(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := q.GetVoucherEntriesForReceiver(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVouchersByReceiverResponse{
+		Vouchers: vouchers,
+		Entries:  entries,
+	}, nil
+}
+
+func (q queryServer) VouchersBySender(c context.Context, req *types.QueryVouchersBySenderRequest) (*types.QueryVouchersBySenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := q.GetVoucherEntriesForSender(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVouchersBySenderResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (q queryServer) ActorQuotas(c context.Context, req *types.QueryActorQuotasRequest) (*types.QueryActorQuotasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	actor, err := sdk.AccAddressFromBech32(req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := q.GetActorQuotaStatuses(ctx, req.Denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActorQuotasResponse{
+		Quotas: quotas,
+	}, nil
+}
+
+func (q queryServer) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	frozenAccounts, err := q.GetAllFrozenAccounts(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAccountsResponse{
+		FrozenAccounts: frozenAccounts,
+	}, nil
+}
+
 func (q queryServer) PermissionsModuleState(c context.Context, req *types.QueryModuleStateRequest) (*types.QueryModuleStateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryModuleStateResponse{State: q.ExportGenesis(ctx)}, nil
@@ -16,6 +16,9 @@ type Keeper struct {
 	bankKeeper types.BankKeeper
 	tfKeeper   types.TokenFactoryKeeper
 	wasmKeeper types.WasmKeeper
+	evmKeeper  types.EVMKeeper
+
+	exchangeKeeper types.ExchangeKeeper
 
 	tfModuleAddress string
 	moduleAccounts  map[string]bool
@@ -28,6 +31,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	tfKeeper types.TokenFactoryKeeper,
 	wasmKeeper types.WasmKeeper,
+	evmKeeper types.EVMKeeper,
+	exchangeKeeper types.ExchangeKeeper,
 	tfModuleAddress string,
 	moduleAccounts map[string]bool,
 	authority string,
@@ -37,6 +42,8 @@ func NewKeeper(
 		bankKeeper:      bankKeeper,
 		tfKeeper:        tfKeeper,
 		wasmKeeper:      wasmKeeper,
+		evmKeeper:       evmKeeper,
+		exchangeKeeper:  exchangeKeeper,
 		tfModuleAddress: tfModuleAddress,
 		moduleAccounts:  moduleAccounts,
 		authority:       authority,
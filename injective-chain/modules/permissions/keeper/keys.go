@@ -4,6 +4,9 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
 )
 
 var (
@@ -16,6 +19,14 @@ var (
 	policyStatusKey              = []byte{0x07} // denom + action => PolicyStatus
 	policyManagerCapabilitiesKey = []byte{0x08} // denom + policyManager + Action => PolicyCapability
 	vouchersKey                  = []byte{0x09} // toAddr + fromAddr => Coins
+	actorRoleExpiriesKey         = []byte{0x0a} // denom + address + role_id => ActorRoleExpiry
+	actorQuotasKey               = []byte{0x0b} // denom + address + action => ActorQuota
+	actorQuotaUsagesKey          = []byte{0x0c} // denom + address + action => ActorQuotaUsage
+	frozenAccountsKey            = []byte{0x0d} // denom + address => FrozenAccount
+	voucherEntriesKey            = []byte{0x0e} // receiver + denom + sender => VoucherEntry
+	senderVoucherEntriesKey      = []byte{0x0f} // sender + receiver + denom => voucher entry key
+	voucherExpiriesKey           = []byte{0x10} // expires_at + voucher entry key => nil
+	receiverVouchersKey          = []byte{0x11} // receiver + denom => nil
 	delim                        = []byte("|")
 )
 
@@ -105,3 +116,107 @@ func (k Keeper) getVouchersStoreForDenom(ctx sdk.Context, denom string) storetyp
 func getVoucherKey(denom string, address sdk.AccAddress) []byte {
 	return append(denomWithDelim(denom), address.Bytes()...)
 }
+
+// getReceiverVouchersStore returns the store prefix indexing the vouchers by receiver
+func (k Keeper) getReceiverVouchersStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, receiverVouchersKey)
+}
+
+// receivers are length prefixed, since they can be either 20 or 32 bytes long
+func getReceiverVouchersPrefix(receiver sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(receiver.Bytes())
+}
+
+func getReceiverVoucherKey(receiver sdk.AccAddress, denom string) []byte {
+	return append(getReceiverVouchersPrefix(receiver), []byte(denom)...)
+}
+
+// getVoucherEntriesStore returns the store prefix where the parts of the vouchers sent by each sender reside
+func (k Keeper) getVoucherEntriesStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, voucherEntriesKey)
+}
+
+// getSenderVoucherEntriesStore returns the store prefix indexing the voucher entries by sender
+func (k Keeper) getSenderVoucherEntriesStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, senderVoucherEntriesKey)
+}
+
+// getVoucherExpiriesStore returns the store prefix indexing the voucher entries by expiry time
+func (k Keeper) getVoucherExpiriesStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, voucherExpiriesKey)
+}
+
+// receivers and senders are length prefixed, since they can be either 20 or 32 bytes long
+func getReceiverVoucherEntriesPrefix(receiver sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(receiver.Bytes())
+}
+
+func getVoucherEntryKey(receiver sdk.AccAddress, denom string, sender sdk.AccAddress) []byte {
+	key := getReceiverVoucherEntriesPrefix(receiver)
+	key = append(key, denomWithDelim(denom)...)
+	return append(key, sender.Bytes()...)
+}
+
+func getSenderVoucherEntriesPrefix(sender sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(sender.Bytes())
+}
+
+func getSenderVoucherEntryKey(sender, receiver sdk.AccAddress, denom string) []byte {
+	key := getSenderVoucherEntriesPrefix(sender)
+	key = append(key, address.MustLengthPrefix(receiver.Bytes())...)
+	return append(key, []byte(denom)...)
+}
+
+func getVoucherExpiryKey(expiresAt int64, entryKey []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiresAt)), entryKey...)
+}
+
+// getActorRoleExpiriesStore returns the store prefix where the role expiries of the actors reside for specified denom
+func (k Keeper) getActorRoleExpiriesStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := actorRoleExpiriesKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+func getActorRoleExpiryKey(actor sdk.AccAddress, roleID uint32) []byte {
+	return append(actor.Bytes(), types.Uint32ToLittleEndian(roleID)...)
+}
+
+// getActorQuotasStore returns the store prefix where the actor quotas reside for specified denom
+func (k Keeper) getActorQuotasStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := actorQuotasKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getActorQuotaUsagesStore returns the store prefix where the actor quota usages reside for specified denom
+func (k Keeper) getActorQuotaUsagesStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := actorQuotaUsagesKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getAllActorQuotaUsagesStore returns the store prefix where the actor quota usages of all denoms reside
+func (k Keeper) getAllActorQuotaUsagesStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, actorQuotaUsagesKey)
+}
+
+func getActorQuotaKey(actor sdk.AccAddress, action types.Action) []byte {
+	return append(actor.Bytes(), types.Uint32ToLittleEndian(uint32(action))...)
+}
+
+// getFrozenAccountsStore returns the store prefix where the frozen accounts reside for specified denom
+func (k Keeper) getFrozenAccountsStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := frozenAccountsKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
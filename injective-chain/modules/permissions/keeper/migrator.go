@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 seeds the FREEZE and CLAWBACK policies of the namespaces created before these actions were introduced.
+// The actions are disabled but not sealed, and the denom admin gets the capabilities to enable and seal them, like the
+// creator of a new namespace. Namespaces whose denom admin was renounced get no policy manager for them.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	issuerActions := []types.Action{types.Action_FREEZE, types.Action_CLAWBACK}
+
+	for _, denom := range m.keeper.GetAllNamespaceDenoms(ctx) {
+		capabilities, err := m.keeper.GetAllPolicyManagerCapabilities(ctx, denom)
+		if err != nil {
+			return err
+		}
+
+		hasCapability := make(map[types.Action]bool, len(issuerActions))
+		for _, capability := range capabilities {
+			hasCapability[capability.Action] = true
+		}
+
+		admin, err := m.keeper.tfKeeper.GetDenomAdmin(ctx, denom)
+		if err != nil || admin.Empty() {
+			m.keeper.Logger(ctx).Info("no denom admin to manage the issuer policies of the namespace", "denom", denom)
+			admin = nil
+		}
+
+		for _, action := range issuerActions {
+			if _, err := m.keeper.GetPolicyStatus(ctx, denom, action); err == nil {
+				continue
+			}
+
+			if err := m.keeper.setPolicyStatus(ctx, denom, types.NewPolicyStatus(action, true, false)); err != nil {
+				return err
+			}
+
+			if admin == nil || hasCapability[action] {
+				continue
+			}
+
+			if err := m.keeper.setPolicyManagerCapability(ctx, denom, types.NewPolicyManagerCapability(admin, action, true, true)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 indexes the existing vouchers by receiver and seeds their voucher entries, so that they expire like the
+// vouchers created afterwards
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.indexVouchersByReceiver(ctx)
+	return m.keeper.seedLegacyVoucherEntries(ctx)
+}
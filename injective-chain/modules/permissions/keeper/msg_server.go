@@ -61,13 +61,13 @@ func (k msgServer) CreateNamespace(c context.Context, msg *types.MsgCreateNamesp
 		return nil, err
 	}
 
-	// existing wasm hook contract that satisfies the expected interface
-	contractHook := namespace.ContractHook
-	if contractHook != "" {
-		wasmContract := sdk.MustAccAddressFromBech32(contractHook)
-		if err := k.validateWasmHook(c, wasmContract); err != nil {
+	// existing wasm or evm hook contract that satisfies the expected interface
+	if namespace.ContractHook != "" {
+		contractHook, err := k.validateContractHook(c, namespace.ContractHook)
+		if err != nil {
 			return nil, err
 		}
+		namespace.ContractHook = contractHook
 	}
 
 	// pre-populate the namespace with permissive default values in the event role managers, policy statuses and/or
@@ -98,8 +98,8 @@ func (k msgServer) UpdateNamespace(c context.Context, msg *types.MsgUpdateNamesp
 	}
 
 	if namespaceChanges.HasContractHookChange {
-		wasmContract := sdk.MustAccAddressFromBech32(msg.ContractHook.NewValue)
-		if err := k.validateWasmHook(c, wasmContract); err != nil {
+		contractHook, err := k.validateContractHook(c, msg.ContractHook.NewValue)
+		if err != nil {
 			return nil, err
 		}
 
@@ -108,7 +108,22 @@ func (k msgServer) UpdateNamespace(c context.Context, msg *types.MsgUpdateNamesp
 			return nil, err
 		}
 
-		namespace.ContractHook = wasmContract.String()
+		namespace.ContractHook = contractHook
+		err = k.setNamespace(ctx, *namespace)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't store updated namespace")
+		}
+	}
+
+	if namespaceChanges.HasVoucherPolicyChange {
+		namespace, err := k.GetNamespace(ctx, denom, false)
+		if err != nil {
+			return nil, err
+		}
+
+		// only applies to the amounts rerouted to vouchers afterwards
+		namespace.VoucherExpiry = msg.VoucherPolicy.Expiry
+		namespace.VoucherRecoveryAddress = msg.VoucherPolicy.RecoveryAddress
 		err = k.setNamespace(ctx, *namespace)
 		if err != nil {
 			return nil, errors.Wrap(err, "can't store updated namespace")
@@ -148,6 +163,14 @@ func (k msgServer) UpdateNamespace(c context.Context, msg *types.MsgUpdateNamesp
 		}
 	}
 
+	if namespaceChanges.HasActorQuotasChange {
+		for _, quota := range msg.ActorQuotas {
+			if err := k.updateActorQuota(ctx, denom, quota); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return &types.MsgUpdateNamespaceResponse{}, nil
 }
 
@@ -179,6 +202,27 @@ func (k msgServer) UpdateActorRoles(c context.Context, msg *types.MsgUpdateActor
 		}
 	}
 
+	// roles granted again without an expiry become permanent
+	for _, roleActors := range msg.RoleActorsToAdd {
+		if roleActors.IsExpired(ctx.BlockTime().Unix(), ctx.BlockHeight()) {
+			return nil, types.ErrInvalidRoleExpiry.Wrapf("role %s would already be expired", roleActors.Role)
+		}
+
+		for _, actorAddr := range roleActors.Actors {
+			actor := sdk.MustAccAddressFromBech32(actorAddr)
+			expiry := &types.ActorRoleExpiry{
+				Actor:              actor.String(),
+				Role:               roleActors.Role,
+				ExpiresAtTimestamp: roleActors.ExpiresAtTimestamp,
+				ExpiresAtHeight:    roleActors.ExpiresAtHeight,
+			}
+
+			if err := k.setActorRoleExpiry(ctx, denom, actor, roleIDs[roleActors.Role], expiry); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	actorRolesToRevoke := types.RoleActorsToActorRoles(msg.RoleActorsToRevoke)
 
 	for _, roleActors := range actorRolesToRevoke {
@@ -201,20 +245,115 @@ func (k msgServer) ClaimVoucher(c context.Context, msg *types.MsgClaimVoucher) (
 
 	receiver := sdk.MustAccAddressFromBech32(msg.Sender)
 
-	voucher, err := k.GetVoucherForAddress(ctx, msg.Denom, receiver)
-	if err != nil {
+	if _, err := k.claimVoucher(ctx, receiver, msg.Denom); err != nil {
 		return nil, err
 	}
-	if voucher.IsZero() {
-		return nil, types.ErrVoucherNotFound
+
+	return &types.MsgClaimVoucherResponse{}, nil
+}
+
+func (k msgServer) ClaimVouchers(c context.Context, msg *types.MsgClaimVouchers) (*types.MsgClaimVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receiver := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	// claim all the vouchers of the receiver if no denom is specified
+	denoms := msg.Denoms
+	if len(denoms) == 0 {
+		vouchers, err := k.getVouchersForAddress(ctx, receiver)
+		if err != nil {
+			return nil, err
+		}
+		if vouchers.IsZero() {
+			return nil, types.ErrVoucherNotFound
+		}
+
+		denoms = vouchers.Denoms()
 	}
 
-	// now claim voucher by sending funds from permissions module to receiver and then removing the voucher
-	// please note the user will not be able to claim if he still does not have permissions, since transfer hook will be called on this send again
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(voucher)); err != nil {
+	claimed := sdk.NewCoins()
+	for _, denom := range denoms {
+		voucher, err := k.claimVoucher(ctx, receiver, denom)
+		if err != nil {
+			return nil, err
+		}
+		claimed = claimed.Add(voucher)
+	}
+
+	return &types.MsgClaimVouchersResponse{
+		Claimed: claimed,
+	}, nil
+}
+
+func (k msgServer) FreezeAccount(c context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	account := sdk.MustAccAddressFromBech32(msg.Account)
+
+	if !k.HasNamespace(ctx, msg.Denom) {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "namespace for %s does not exist", msg.Denom)
+	}
+
+	if err := k.CheckPermissionsForAction(ctx, msg.Denom, sender, types.Action_FREEZE); err != nil {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s unauthorized for action %s: %s", sender, types.Action_FREEZE, err)
+	}
+
+	if k.IsModuleAcc(account) {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "cannot freeze module account %s", account)
+	}
+
+	if err := k.freezeAccount(ctx, msg.Denom, account, msg.ReasonCode); err != nil {
 		return nil, err
 	}
-	k.deleteVoucher(ctx, receiver, msg.Denom)
 
-	return &types.MsgClaimVoucherResponse{}, nil
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (k msgServer) UnfreezeAccount(c context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	account := sdk.MustAccAddressFromBech32(msg.Account)
+
+	if !k.HasNamespace(ctx, msg.Denom) {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "namespace for %s does not exist", msg.Denom)
+	}
+
+	if err := k.CheckPermissionsForAction(ctx, msg.Denom, sender, types.Action_FREEZE); err != nil {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s unauthorized for action %s: %s", sender, types.Action_FREEZE, err)
+	}
+
+	if err := k.unfreezeAccount(ctx, msg.Denom, account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+func (k msgServer) Clawback(c context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	account := sdk.MustAccAddressFromBech32(msg.Account)
+	recoveryAddr := sdk.MustAccAddressFromBech32(msg.RecoveryAddress)
+	denom := msg.Amount.Denom
+
+	if !k.HasNamespace(ctx, denom) {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "namespace for %s does not exist", denom)
+	}
+
+	if err := k.CheckPermissionsForAction(ctx, denom, sender, types.Action_CLAWBACK); err != nil {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s unauthorized for action %s: %s", sender, types.Action_CLAWBACK, err)
+	}
+
+	if k.IsModuleAcc(account) {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "cannot claw back from module account %s", account)
+	}
+
+	if err := k.clawback(ctx, account, recoveryAddr, msg.Amount, msg.ReasonCode); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackResponse{}, nil
 }
@@ -54,11 +54,29 @@ func (k Keeper) GetNamespace(ctx sdk.Context, denom string, includeFull bool) (*
 		return nil, err
 	}
 
+	actorRoleExpiries, err := k.GetAllActorRoleExpiries(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	actorQuotas, err := k.GetAllActorQuotas(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	frozenAccounts, err := k.GetAllFrozenAccounts(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
 	namespace.RolePermissions = roles
 	namespace.ActorRoles = actorRoles
 	namespace.RoleManagers = roleManagers
 	namespace.PolicyStatuses = policyStatuses
 	namespace.PolicyManagerCapabilities = policyManagerCapabilities
+	namespace.ActorRoleExpiries = actorRoleExpiries
+	namespace.ActorQuotas = actorQuotas
+	namespace.FrozenAccounts = frozenAccounts
 	return namespace, nil
 }
 
@@ -132,12 +150,42 @@ func (k Keeper) createNamespace(ctx sdk.Context, ns types.Namespace) error {
 		}
 	}
 
+	// store actor role expiries
+	for _, expiry := range ns.ActorRoleExpiries {
+		actor := sdk.MustAccAddressFromBech32(expiry.Actor)
+		roleID, ok := roleNameToRoleID[expiry.Role]
+		if !ok {
+			return types.ErrUnknownRole.Wrapf("role %s not found", expiry.Role)
+		}
+
+		if err := k.setActorRoleExpiry(ctx, denom, actor, roleID, expiry); err != nil {
+			return err
+		}
+	}
+
+	// store actor quotas
+	for _, quota := range ns.ActorQuotas {
+		if err := k.setActorQuota(ctx, denom, quota); err != nil {
+			return err
+		}
+	}
+
+	// store frozen accounts
+	for _, frozenAccount := range ns.FrozenAccounts {
+		if err := k.setFrozenAccount(ctx, denom, frozenAccount); err != nil {
+			return err
+		}
+	}
+
 	// nil the values to not store it inside namespace storage
 	ns.RolePermissions = nil
 	ns.ActorRoles = nil
 	ns.RoleManagers = nil
 	ns.PolicyStatuses = nil
 	ns.PolicyManagerCapabilities = nil
+	ns.ActorRoleExpiries = nil
+	ns.ActorQuotas = nil
+	ns.FrozenAccounts = nil
 
 	// store namespace itself
 	return k.setNamespace(ctx, ns)
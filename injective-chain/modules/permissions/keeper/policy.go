@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"slices"
+
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -44,7 +47,12 @@ func (k Keeper) GetPolicyStatus(ctx sdk.Context, denom string, action types.Acti
 func (k Keeper) TryUpdatePolicyStatus(ctx sdk.Context, sender sdk.AccAddress, denom string, newPolicyStatus *types.PolicyStatus) error {
 	action := newPolicyStatus.Action
 	oldPolicyStatus, err := k.GetPolicyStatus(ctx, denom, action)
-	if err != nil {
+	switch {
+	case errors.IsOf(err, types.ErrUnknownPolicy) && slices.Contains(types.Actions, action):
+		// namespaces created before an action was introduced have no policy for it, which leaves the action disabled
+		// until a policy manager with the capability enables it
+		oldPolicyStatus = types.NewPolicyStatus(action, true, false)
+	case err != nil:
 		return err
 	}
 
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// GetActorQuota returns the quota of the actor for the action, or nil if the actor has no quota
+func (k Keeper) GetActorQuota(ctx sdk.Context, denom string, actor sdk.AccAddress, action types.Action) (*types.ActorQuota, error) {
+	store := k.getActorQuotasStore(ctx, denom)
+	bz := store.Get(getActorQuotaKey(actor, action))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	quota := &types.ActorQuota{}
+	if err := proto.Unmarshal(bz, quota); err != nil {
+		return nil, err
+	}
+
+	return quota, nil
+}
+
+// updateActorQuota creates, updates or removes (when the limit is zero) the quota of an actor. The amount already used
+// by the actor is kept when the quota is updated, so that lowering a limit takes effect immediately.
+func (k Keeper) updateActorQuota(ctx sdk.Context, denom string, quota *types.ActorQuota) error {
+	actor := sdk.MustAccAddressFromBech32(quota.Actor)
+
+	if quota.Limit.IsZero() {
+		k.getActorQuotasStore(ctx, denom).Delete(getActorQuotaKey(actor, quota.Action))
+		k.getActorQuotaUsagesStore(ctx, denom).Delete(getActorQuotaKey(actor, quota.Action))
+		return nil
+	}
+
+	return k.setActorQuota(ctx, denom, quota)
+}
+
+func (k Keeper) setActorQuota(ctx sdk.Context, denom string, quota *types.ActorQuota) error {
+	actor := sdk.MustAccAddressFromBech32(quota.Actor)
+
+	bz, err := proto.Marshal(quota)
+	if err != nil {
+		return err
+	}
+
+	store := k.getActorQuotasStore(ctx, denom)
+	store.Set(getActorQuotaKey(actor, quota.Action), bz)
+	return nil
+}
+
+// GetActorQuotas returns all the quotas of the actor inside namespace for this denom
+func (k Keeper) GetActorQuotas(ctx sdk.Context, denom string, actor sdk.AccAddress) ([]*types.ActorQuota, error) {
+	quotas := make([]*types.ActorQuota, 0)
+
+	for _, action := range types.QuotaActions {
+		quota, err := k.GetActorQuota(ctx, denom, actor, action)
+		if err != nil {
+			return nil, err
+		}
+
+		if quota != nil {
+			quotas = append(quotas, quota)
+		}
+	}
+
+	return quotas, nil
+}
+
+// GetAllActorQuotas returns all the actor quotas inside namespace for this denom
+func (k Keeper) GetAllActorQuotas(ctx sdk.Context, denom string) ([]*types.ActorQuota, error) {
+	quotas := make([]*types.ActorQuota, 0)
+	store := k.getActorQuotasStore(ctx, denom)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		quota := &types.ActorQuota{}
+		if err := proto.Unmarshal(iter.Value(), quota); err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas, nil
+}
+
+// GetActorQuotaUsage returns the amount used by the actor against its quota for the action, or nil if nothing was used
+func (k Keeper) GetActorQuotaUsage(ctx sdk.Context, denom string, actor sdk.AccAddress, action types.Action) (*types.ActorQuotaUsage, error) {
+	store := k.getActorQuotaUsagesStore(ctx, denom)
+	bz := store.Get(getActorQuotaKey(actor, action))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	usage := &types.ActorQuotaUsage{}
+	if err := proto.Unmarshal(bz, usage); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func (k Keeper) setActorQuotaUsage(ctx sdk.Context, usage *types.ActorQuotaUsage) error {
+	actor := sdk.MustAccAddressFromBech32(usage.Actor)
+
+	bz, err := proto.Marshal(usage)
+	if err != nil {
+		return err
+	}
+
+	store := k.getActorQuotaUsagesStore(ctx, usage.Denom)
+	store.Set(getActorQuotaKey(actor, usage.Action), bz)
+	return nil
+}
+
+// getAllActorQuotaUsages returns the quota usages of all actors in all namespaces
+func (k Keeper) getAllActorQuotaUsages(ctx sdk.Context) ([]*types.ActorQuotaUsage, error) {
+	usages := make([]*types.ActorQuotaUsage, 0)
+	store := k.getAllActorQuotaUsagesStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		usage := &types.ActorQuotaUsage{}
+		if err := proto.Unmarshal(iter.Value(), usage); err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// getCurrentQuotaUsage returns the usage of the quota within the sliding window ending at the block time
+func (k Keeper) getCurrentQuotaUsage(ctx sdk.Context, denom string, actor sdk.AccAddress, quota *types.ActorQuota) (*types.ActorQuotaUsage, error) {
+	usage, err := k.GetActorQuotaUsage(ctx, denom, actor, quota.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	if usage == nil {
+		usage = &types.ActorQuotaUsage{
+			Denom:  denom,
+			Actor:  actor.String(),
+			Action: quota.Action,
+			Used:   math.ZeroInt(),
+		}
+	}
+
+	usage.PruneBuckets(quota, ctx.BlockTime().Unix())
+	return usage, nil
+}
+
+// ConsumeActionQuota records the amount used by the actor for the action against its quota, failing with
+// ErrRestrictedAction if the quota would be exceeded. Actors without a quota for the action are not limited.
+func (k Keeper) ConsumeActionQuota(ctx sdk.Context, denom string, actor sdk.AccAddress, action types.Action, amount math.Int) error {
+	quota, err := k.GetActorQuota(ctx, denom, actor, action)
+	if err != nil || quota == nil {
+		return err
+	}
+
+	usage, err := k.getCurrentQuotaUsage(ctx, denom, actor, quota)
+	if err != nil {
+		return err
+	}
+
+	used := usage.Used.Add(amount)
+	if used.GT(quota.Limit) {
+		return types.ErrRestrictedAction.Wrapf(
+			"%s quota on %s exceeded for %s: %s remaining, %s requested",
+			action, denom, actor, quota.Remaining(usage.Used), amount,
+		)
+	}
+
+	usage.AddUsage(quota, ctx.BlockTime().Unix(), amount)
+	return k.setActorQuotaUsage(ctx, usage)
+}
+
+// GetActorQuotaStatuses returns the quotas of the actor along with the amounts used and remaining within their
+// sliding windows
+func (k Keeper) GetActorQuotaStatuses(ctx sdk.Context, denom string, actor sdk.AccAddress) ([]types.ActorQuotaStatus, error) {
+	quotas, err := k.GetActorQuotas(ctx, denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]types.ActorQuotaStatus, 0, len(quotas))
+	for _, quota := range quotas {
+		usage, err := k.getCurrentQuotaUsage(ctx, denom, actor, quota)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, types.NewActorQuotaStatus(quota, usage))
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// GetActorRoleExpiry returns the expiry of the role assigned to the actor, or nil if the role doesn't expire
+func (k Keeper) GetActorRoleExpiry(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32) (*types.ActorRoleExpiry, error) {
+	store := k.getActorRoleExpiriesStore(ctx, denom)
+	bz := store.Get(getActorRoleExpiryKey(actor, roleID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	expiry := &types.ActorRoleExpiry{}
+	if err := proto.Unmarshal(bz, expiry); err != nil {
+		return nil, err
+	}
+
+	return expiry, nil
+}
+
+// setActorRoleExpiry stores the expiry of the role assigned to the actor. A role without an expiry timestamp nor height
+// never expires, so its entry is deleted.
+func (k Keeper) setActorRoleExpiry(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32, expiry *types.ActorRoleExpiry) error {
+	if expiry.ExpiresAtTimestamp == 0 && expiry.ExpiresAtHeight == 0 {
+		k.deleteActorRoleExpiry(ctx, denom, actor, roleID)
+		return nil
+	}
+
+	bz, err := proto.Marshal(expiry)
+	if err != nil {
+		return err
+	}
+
+	store := k.getActorRoleExpiriesStore(ctx, denom)
+	store.Set(getActorRoleExpiryKey(actor, roleID), bz)
+	return nil
+}
+
+func (k Keeper) deleteActorRoleExpiry(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32) {
+	store := k.getActorRoleExpiriesStore(ctx, denom)
+	store.Delete(getActorRoleExpiryKey(actor, roleID))
+}
+
+// GetAllActorRoleExpiries returns the expiries of all the roles assigned to actors inside namespace for this denom
+func (k Keeper) GetAllActorRoleExpiries(ctx sdk.Context, denom string) ([]*types.ActorRoleExpiry, error) {
+	expiries := make([]*types.ActorRoleExpiry, 0)
+	store := k.getActorRoleExpiriesStore(ctx, denom)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		expiry := &types.ActorRoleExpiry{}
+		if err := proto.Unmarshal(iter.Value(), expiry); err != nil {
+			return nil, err
+		}
+		expiries = append(expiries, expiry)
+	}
+	return expiries, nil
+}
+
+// isActorRoleExpired returns true if the role assigned to the actor has expired
+func (k Keeper) isActorRoleExpired(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32) (bool, error) {
+	expiry, err := k.GetActorRoleExpiry(ctx, denom, actor, roleID)
+	if err != nil || expiry == nil {
+		return false, err
+	}
+
+	return expiry.IsExpired(ctx.BlockTime().Unix(), ctx.BlockHeight()), nil
+}
+
+// getActiveActorRoleIDs returns the role ids assigned to the actor which haven't expired yet. It doesn't write to the
+// store, the expired roles are revoked the next time the roles of the actor are updated.
+func (k Keeper) getActiveActorRoleIDs(ctx sdk.Context, denom string, actor sdk.AccAddress) ([]uint32, error) {
+	roleIDs, err := k.GetActorRoleIDs(ctx, denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.filterExpiredRoleIDs(ctx, denom, actor, roleIDs)
+}
+
+// pruneExpiredActorRoleIDs returns the given role ids of the actor which haven't expired yet and deletes the expiries of
+// the expired ones, which are meant to be revoked by the caller.
+func (k Keeper) pruneExpiredActorRoleIDs(ctx sdk.Context, denom string, actor sdk.AccAddress, roleIDs []uint32) ([]uint32, error) {
+	activeRoleIDs, err := k.filterExpiredRoleIDs(ctx, denom, actor, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, roleID := range roleIDs {
+		if !slices.Contains(activeRoleIDs, roleID) {
+			k.deleteActorRoleExpiry(ctx, denom, actor, roleID)
+		}
+	}
+
+	return activeRoleIDs, nil
+}
+
+func (k Keeper) filterExpiredRoleIDs(ctx sdk.Context, denom string, actor sdk.AccAddress, roleIDs []uint32) ([]uint32, error) {
+	activeRoleIDs := make([]uint32, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		isExpired, err := k.isActorRoleExpired(ctx, denom, actor, roleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isExpired {
+			activeRoleIDs = append(activeRoleIDs, roleID)
+		}
+	}
+
+	return activeRoleIDs, nil
+}
@@ -29,11 +29,11 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 		switch {
 		case errors.IsOf(err, types.ErrRestrictedAction):
 			// should replace address with permissions module address and error with nil
-			newToAddr, err = k.rerouteToVoucherOnFail(ctx, newToAddr, amount, err)
+			newToAddr, err = k.rerouteToVoucherOnFail(ctx, fromAddr, newToAddr, amount, err)
 
-		// defensive programming: this should not be possible since we never return such error from executeWasmHook,
-		// wasm hook misbehaving (out-of-gas, max-query-stack-depth, etc.)
-		case errors.IsOf(err, types.ErrWasmHookError):
+		// defensive programming: this should not be possible since we never return such error from executeWasmHook or
+		// executeEVMHook, contract hook misbehaving (out-of-gas, max-query-stack-depth, etc.)
+		case errors.IsOf(err, types.ErrWasmHookError, types.ErrEVMHookError):
 			// if we can't fail, just proceed with the send as if it was successful
 			if doNotFailFast := ctx.Value(baseapp.DoNotFailFastSendContextKey); doNotFailFast != nil {
 				newToAddr, err = toAddr, nil
@@ -42,8 +42,8 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 
 	}()
 
-	// module to module sends should not be restricted
-	if k.IsModuleAcc(fromAddr) && k.IsModuleAcc(toAddr) {
+	// module to module sends should not be restricted, nor should the force transfers of a clawback
+	if (k.IsModuleAcc(fromAddr) && k.IsModuleAcc(toAddr)) || isClawbackTransfer(ctx) {
 		return toAddr, nil
 	}
 
@@ -60,20 +60,34 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 	isRecipientTfModule := toAddr.String() == k.tfModuleAddress
 	canSkipSendPermissionsCheck := isRecipientTfModule || k.IsModuleAcc(fromAddr)
 
+	// frozen balances can only leave the account by being burned or clawed back. ErrAccountFrozen is deliberately not
+	// an ErrRestrictedAction, so that the send is never rerouted into a voucher.
+	if !isRecipientTfModule && k.IsAccountFrozen(sdkCtx, namespace.Denom, fromAddr) {
+		return toAddr, types.ErrAccountFrozen.Wrapf("%s is frozen for %s", fromAddr, namespace.Denom)
+	}
+
 	if !canSkipSendPermissionsCheck {
 		if err := k.CheckPermissionsForAction(sdkCtx, namespace.Denom, fromAddr, types.Action_SEND); err != nil {
 			return toAddr, err
 		}
+
+		if err := k.ConsumeActionQuota(sdkCtx, namespace.Denom, fromAddr, types.Action_SEND, amount.Amount); err != nil {
+			return toAddr, err
+		}
 	}
 
 	if !isRecipientTfModule {
 		if err := k.CheckPermissionsForAction(sdkCtx, namespace.Denom, toAddr, types.Action_RECEIVE); err != nil {
 			return toAddr, err
 		}
+
+		if err := k.ConsumeActionQuota(sdkCtx, namespace.Denom, toAddr, types.Action_RECEIVE, amount.Amount); err != nil {
+			return toAddr, err
+		}
 	}
 
-	// invoke wasm hook
-	if err := k.executeWasmHook(sdkCtx, namespace, fromAddr, toAddr, types.Action_RECEIVE, amount); err != nil {
+	// invoke contract hook
+	if err := k.executeContractHook(sdkCtx, namespace, fromAddr, toAddr, types.Action_RECEIVE, amount); err != nil {
 		return toAddr, err
 	}
 
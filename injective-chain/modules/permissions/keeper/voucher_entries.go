@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// GetVoucherEntry returns the part of the voucher of the receiver sent by the sender, or nil if there is none
+func (k Keeper) GetVoucherEntry(ctx sdk.Context, receiver sdk.AccAddress, denom string, sender sdk.AccAddress) (*types.VoucherEntry, error) {
+	return k.getVoucherEntryByKey(ctx, getVoucherEntryKey(receiver, denom, sender))
+}
+
+func (k Keeper) getVoucherEntryByKey(ctx sdk.Context, entryKey []byte) (*types.VoucherEntry, error) {
+	store := k.getVoucherEntriesStore(ctx)
+	bz := store.Get(entryKey)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	entry := &types.VoucherEntry{}
+	if err := proto.Unmarshal(bz, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (k Keeper) setVoucherEntry(ctx sdk.Context, entry *types.VoucherEntry) error {
+	receiver := sdk.MustAccAddressFromBech32(entry.Receiver)
+	sender := sdk.MustAccAddressFromBech32(entry.Sender)
+	entryKey := getVoucherEntryKey(receiver, entry.Denom, sender)
+
+	bz, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	k.getVoucherEntriesStore(ctx).Set(entryKey, bz)
+	k.getSenderVoucherEntriesStore(ctx).Set(getSenderVoucherEntryKey(sender, receiver, entry.Denom), entryKey)
+
+	if entry.ExpiresAt > 0 {
+		k.getVoucherExpiriesStore(ctx).Set(getVoucherExpiryKey(entry.ExpiresAt, entryKey), []byte{})
+	}
+	return nil
+}
+
+func (k Keeper) deleteVoucherEntry(ctx sdk.Context, entry *types.VoucherEntry) {
+	receiver := sdk.MustAccAddressFromBech32(entry.Receiver)
+	sender := sdk.MustAccAddressFromBech32(entry.Sender)
+	entryKey := getVoucherEntryKey(receiver, entry.Denom, sender)
+
+	k.getVoucherEntriesStore(ctx).Delete(entryKey)
+	k.getSenderVoucherEntriesStore(ctx).Delete(getSenderVoucherEntryKey(sender, receiver, entry.Denom))
+
+	if entry.ExpiresAt > 0 {
+		k.getVoucherExpiriesStore(ctx).Delete(getVoucherExpiryKey(entry.ExpiresAt, entryKey))
+	}
+}
+
+// addVoucherEntry records the amount rerouted into the voucher of the receiver as sent by the sender. The entry expires
+// after the voucher expiry of the namespace, counted from the last amount added to it.
+func (k Keeper) addVoucherEntry(ctx sdk.Context, sender, receiver sdk.AccAddress, amount sdk.Coin) error {
+	entry, err := k.GetVoucherEntry(ctx, receiver, amount.Denom, sender)
+	if err != nil {
+		return err
+	}
+
+	if entry != nil {
+		k.deleteVoucherEntry(ctx, entry)
+		entry.Amount = entry.Amount.Add(amount.Amount)
+	} else {
+		entry = &types.VoucherEntry{
+			Denom:    amount.Denom,
+			Receiver: receiver.String(),
+			Sender:   sender.String(),
+			Amount:   amount.Amount,
+		}
+	}
+
+	entry.ExpiresAt = 0
+	namespace, err := k.GetNamespace(ctx, amount.Denom, false)
+	if err != nil {
+		return err
+	}
+	if namespace != nil && namespace.VoucherExpiry > 0 {
+		entry.ExpiresAt = ctx.BlockTime().Unix() + namespace.VoucherExpiry
+	}
+
+	return k.setVoucherEntry(ctx, entry)
+}
+
+// deleteVoucherEntriesForDenom deletes all the parts of the voucher of the receiver for the denom
+func (k Keeper) deleteVoucherEntriesForDenom(ctx sdk.Context, receiver sdk.AccAddress, denom string) error {
+	entries, err := k.getVoucherEntries(ctx, append(getReceiverVoucherEntriesPrefix(receiver), denomWithDelim(denom)...))
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		k.deleteVoucherEntry(ctx, entry)
+	}
+	return nil
+}
+
+// GetVoucherEntriesForReceiver returns the parts of the vouchers of the receiver sent by each sender
+func (k Keeper) GetVoucherEntriesForReceiver(ctx sdk.Context, receiver sdk.AccAddress) ([]*types.VoucherEntry, error) {
+	return k.getVoucherEntries(ctx, getReceiverVoucherEntriesPrefix(receiver))
+}
+
+// GetVoucherEntriesForSender returns the parts of vouchers sent by the sender
+func (k Keeper) GetVoucherEntriesForSender(ctx sdk.Context, sender sdk.AccAddress) ([]*types.VoucherEntry, error) {
+	store := prefix.NewStore(k.getSenderVoucherEntriesStore(ctx), getSenderVoucherEntriesPrefix(sender))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	entries := make([]*types.VoucherEntry, 0)
+	for ; iter.Valid(); iter.Next() {
+		entry, err := k.getVoucherEntryByKey(ctx, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		if entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (k Keeper) getAllVoucherEntries(ctx sdk.Context) ([]*types.VoucherEntry, error) {
+	return k.getVoucherEntries(ctx, nil)
+}
+
+func (k Keeper) getVoucherEntries(ctx sdk.Context, keyPrefix []byte) ([]*types.VoucherEntry, error) {
+	store := prefix.NewStore(k.getVoucherEntriesStore(ctx), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	entries := make([]*types.VoucherEntry, 0)
+	for ; iter.Valid(); iter.Next() {
+		entry := &types.VoucherEntry{}
+		if err := proto.Unmarshal(iter.Value(), entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// seedLegacyVoucherEntries adds a voucher entry for the part of each voucher which isn't covered by its entries, e.g.
+// the vouchers created before voucher entries were introduced. As their sender is unknown, the entry is recorded as sent
+// by the permissions module account, so that it expires to the voucher recovery address of the namespace, if set, and
+// stays claimable by its receiver otherwise.
+func (k Keeper) seedLegacyVoucherEntries(ctx sdk.Context) error {
+	vouchers, err := k.getAllVouchers(ctx)
+	if err != nil {
+		return err
+	}
+
+	unknownSender := authtypes.NewModuleAddress(types.ModuleName)
+	for _, voucher := range vouchers {
+		receiver := sdk.MustAccAddressFromBech32(voucher.Address)
+
+		entries, err := k.getVoucherEntries(ctx, append(getReceiverVoucherEntriesPrefix(receiver), denomWithDelim(voucher.Voucher.Denom)...))
+		if err != nil {
+			return err
+		}
+
+		uncovered := voucher.Voucher.Amount
+		for _, entry := range entries {
+			uncovered = uncovered.Sub(entry.Amount)
+		}
+
+		if !uncovered.IsPositive() {
+			continue
+		}
+
+		if err := k.addVoucherEntry(ctx, unknownSender, receiver, sdk.NewCoin(voucher.Voucher.Denom, uncovered)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProcessExpiredVouchers returns the funds of the expired voucher entries to their sender, or sends them to the voucher
+// recovery address of the namespace if set. An entry whose funds can't be sent stays claimable by its receiver, without
+// expiry. Up to MaxExpiredVouchersPerBlock entries are processed, the remaining ones are processed in the next blocks.
+func (k Keeper) ProcessExpiredVouchers(ctx sdk.Context) {
+	store := k.getVoucherExpiriesStore(ctx)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().Unix()+1)))
+
+	entryKeys := make([][]byte, 0, types.MaxExpiredVouchersPerBlock)
+	for ; iter.Valid() && len(entryKeys) < types.MaxExpiredVouchersPerBlock; iter.Next() {
+		// skip the expiry timestamp
+		entryKeys = append(entryKeys, append([]byte{}, iter.Key()[8:]...))
+	}
+	iter.Close()
+
+	for _, entryKey := range entryKeys {
+		entry, err := k.getVoucherEntryByKey(ctx, entryKey)
+		if err != nil || entry == nil {
+			k.Logger(ctx).Error("failed to get expired voucher entry", "error", err)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.expireVoucherEntry(cacheCtx, entry); err != nil {
+			k.Logger(ctx).Error("failed to expire voucher", "denom", entry.Denom, "receiver", entry.Receiver, "sender", entry.Sender, "error", err)
+
+			k.deleteVoucherEntry(ctx, entry)
+			entry.ExpiresAt = 0
+			if err := k.setVoucherEntry(ctx, entry); err != nil {
+				k.Logger(ctx).Error("failed to store voucher entry", "error", err)
+			}
+			continue
+		}
+		writeCache()
+	}
+}
+
+func (k Keeper) expireVoucherEntry(ctx sdk.Context, entry *types.VoucherEntry) error {
+	receiver := sdk.MustAccAddressFromBech32(entry.Receiver)
+	sender := sdk.MustAccAddressFromBech32(entry.Sender)
+
+	recipient := sender
+	namespace, err := k.GetNamespace(ctx, entry.Denom, false)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case namespace != nil && namespace.VoucherRecoveryAddress != "":
+		recipient = sdk.MustAccAddressFromBech32(namespace.VoucherRecoveryAddress)
+	case k.IsModuleAcc(sender):
+		// returning funds to a module account would break the accounting of the module
+		return types.ErrInvalidVoucherPolicy.Wrapf("can't return voucher to module account %s", sender)
+	}
+
+	voucher, err := k.GetVoucherForAddress(ctx, entry.Denom, receiver)
+	if err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoin(entry.Denom, entry.Amount)
+	if voucher.IsLT(amount) {
+		return types.ErrVoucherNotFound.Wrapf("voucher %s of %s is lower than the expired amount %s", voucher, receiver, amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	if remaining := voucher.Sub(amount); remaining.IsZero() {
+		k.deleteVoucher(ctx, receiver, entry.Denom)
+	} else if err := k.setVoucher(ctx, receiver, remaining); err != nil {
+		return err
+	}
+
+	k.deleteVoucherEntry(ctx, entry)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventVoucherExpired{
+		Denom:     entry.Denom,
+		Receiver:  entry.Receiver,
+		Sender:    entry.Sender,
+		Recipient: recipient.String(),
+		Amount:    amount,
+	})
+	return nil
+}
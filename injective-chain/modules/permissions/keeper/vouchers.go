@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"cosmossdk.io/store/prefix"
@@ -20,7 +21,13 @@ import (
 // when their permissions allow the claim.
 // This is needed to not fail bank transfers from module to accounts and couple other cases in consensus code,
 // since our old codebase does not expect it to fail.
-func (k Keeper) rerouteToVoucherOnFail(ctx context.Context, toAddr sdk.AccAddress, amount sdk.Coin, origErr error) (newToAddr sdk.AccAddress, err error) {
+// The rerouted amount is also recorded per sender, so that it can be returned to them if the voucher expires.
+func (k Keeper) rerouteToVoucherOnFail(
+	ctx context.Context,
+	fromAddr, toAddr sdk.AccAddress,
+	amount sdk.Coin,
+	origErr error,
+) (newToAddr sdk.AccAddress, err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	if doNotFailFast := ctx.Value(baseapp.DoNotFailFastSendContextKey); doNotFailFast == nil {
@@ -39,9 +46,35 @@ func (k Keeper) rerouteToVoucherOnFail(ctx context.Context, toAddr sdk.AccAddres
 		return toAddr, errors.Wrapf(err, "can't set voucher for address, tried to reroute token send after error: %s", origErr.Error())
 	}
 
+	if err := k.addVoucherEntry(sdkCtx, fromAddr, toAddr, amount); err != nil {
+		return toAddr, errors.Wrapf(err, "can't set voucher entry for address, tried to reroute token send after error: %s", origErr.Error())
+	}
+
 	return authtypes.NewModuleAddress(types.ModuleName), nil
 }
 
+// claimVoucher sends the voucher of the denom to the receiver and removes it. The receiver will not be able to claim if
+// they still don't have the permissions, since the send restriction applies to this send again.
+func (k Keeper) claimVoucher(ctx sdk.Context, receiver sdk.AccAddress, denom string) (sdk.Coin, error) {
+	voucher, err := k.GetVoucherForAddress(ctx, denom, receiver)
+	if err != nil {
+		return voucher, err
+	}
+	if voucher.IsZero() {
+		return voucher, types.ErrVoucherNotFound.Wrapf("no voucher for %s", denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(voucher)); err != nil {
+		return voucher, err
+	}
+	k.deleteVoucher(ctx, receiver, denom)
+
+	if err := k.deleteVoucherEntriesForDenom(ctx, receiver, denom); err != nil {
+		return voucher, err
+	}
+	return voucher, nil
+}
+
 func (k Keeper) GetVoucherForAddress(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coin, error) {
 	store := k.getVouchersStore(ctx)
 	key := getVoucherKey(denom, addr)
@@ -65,6 +98,7 @@ func (k Keeper) setVoucher(ctx sdk.Context, addr sdk.AccAddress, voucher sdk.Coi
 
 	key := getVoucherKey(voucher.Denom, addr)
 	store.Set(key, bz)
+	k.getReceiverVouchersStore(ctx).Set(getReceiverVoucherKey(addr, voucher.Denom), []byte{})
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventSetVoucher{
@@ -78,6 +112,7 @@ func (k Keeper) deleteVoucher(ctx sdk.Context, addr sdk.AccAddress, denom string
 	store := k.getVouchersStore(ctx)
 	key := getVoucherKey(denom, addr)
 	store.Delete(key)
+	k.getReceiverVouchersStore(ctx).Delete(getReceiverVoucherKey(addr, denom))
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventSetVoucher{
@@ -133,3 +168,36 @@ func (k Keeper) getVouchersForDenom(ctx sdk.Context, denom string) ([]*types.Add
 	}
 	return vouchers, nil
 }
+
+// getVouchersForAddress returns the vouchers of all denoms held by the address
+func (k Keeper) getVouchersForAddress(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	store := prefix.NewStore(k.getReceiverVouchersStore(ctx), getReceiverVouchersPrefix(addr))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	vouchers := sdk.NewCoins()
+	for ; iter.Valid(); iter.Next() {
+		voucher, err := k.GetVoucherForAddress(ctx, string(iter.Key()), addr)
+		if err != nil {
+			return nil, err
+		}
+		vouchers = vouchers.Add(voucher)
+	}
+	return vouchers, nil
+}
+
+// indexVouchersByReceiver indexes all the stored vouchers by receiver
+func (k Keeper) indexVouchersByReceiver(ctx sdk.Context) {
+	store := k.getVouchersStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	receiverVouchersStore := k.getReceiverVouchersStore(ctx)
+	for ; iter.Valid(); iter.Next() {
+		// denoms can't contain the delimiter, unlike addresses
+		delimIdx := bytes.Index(iter.Key(), delim)
+		denom, addr := string(iter.Key()[:delimIdx]), sdk.AccAddress(iter.Key()[delimIdx+len(delim):])
+
+		receiverVouchersStore.Set(getReceiverVoucherKey(addr, denom), []byte{})
+	}
+}
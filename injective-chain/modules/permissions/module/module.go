@@ -37,10 +37,11 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 3
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -148,6 +149,14 @@ func (AppModule) QuerierRoute() string { return QuerierRoute }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate permissions from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate permissions from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis performs the x/permissions module's genesis initialization. It
@@ -166,5 +175,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock returns the funds of the expired vouchers
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.ProcessExpiredVouchers(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
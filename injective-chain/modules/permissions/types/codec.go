@@ -16,6 +16,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateNamespace{}, "permissions/MsgCreateNamespace", nil)
 	cdc.RegisterConcrete(&MsgUpdateNamespace{}, "permissions/MsgUpdateNamespace", nil)
 	cdc.RegisterConcrete(&MsgClaimVoucher{}, "permissions/MsgClaimVoucher", nil)
+	cdc.RegisterConcrete(&MsgClaimVouchers{}, "permissions/MsgClaimVouchers", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "permissions/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "permissions/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "permissions/MsgClawback", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -24,6 +28,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateNamespace{},
 		&MsgUpdateNamespace{},
 		&MsgClaimVoucher{},
+		&MsgClaimVouchers{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgClawback{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -23,4 +23,11 @@ var (
 	ErrInvalidContractHook      = errors.Register(ModuleName, 14, "invalid contract hook")
 	ErrUnknownPolicy            = errors.Register(ModuleName, 15, "unknown policy")
 	ErrUnauthorizedPolicyChange = errors.Register(ModuleName, 16, "unauthorized policy change")
+	ErrInvalidActorQuota        = errors.Register(ModuleName, 17, "invalid actor quota")
+	ErrInvalidRoleExpiry        = errors.Register(ModuleName, 18, "invalid role expiry")
+	ErrEVMHookError             = errors.Register(ModuleName, 19, "evm hook call error")
+	ErrAccountFrozen            = errors.Register(ModuleName, 20, "account is frozen")
+	ErrAccountNotFrozen         = errors.Register(ModuleName, 21, "account is not frozen")
+	ErrInsufficientClawback     = errors.Register(ModuleName, 22, "insufficient funds to claw back")
+	ErrInvalidVoucherPolicy     = errors.Register(ModuleName, 23, "invalid voucher policy")
 )
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EVMHookABIJSON is the interface an EVM contract hook must implement. The call reverts if the action is restricted.
+//
+//	interface IPermissionsHook {
+//	    function sendRestriction(address from, address to, uint32 action, string calldata denom, uint256 amount) external view;
+//	}
+const EVMHookABIJSON = `[{
+	"name": "sendRestriction",
+	"stateMutability": "view",
+	"type": "function",
+	"inputs": [
+		{ "internalType": "address", "name": "from",   "type": "address" },
+		{ "internalType": "address", "name": "to",     "type": "address" },
+		{ "internalType": "uint32",  "name": "action", "type": "uint32"  },
+		{ "internalType": "string",  "name": "denom",  "type": "string"  },
+		{ "internalType": "uint256", "name": "amount", "type": "uint256" }
+	],
+	"outputs": []
+}]`
+
+var evmHookABI abi.ABI
+
+func init() {
+	var err error
+	evmHookABI, err = abi.JSON(strings.NewReader(EVMHookABIJSON))
+	if err != nil {
+		panic("Bad ABI constant!")
+	}
+}
+
+// IsEVMContractHook returns true if the contract hook is an EVM contract, i.e. a hex address, rather than a bech32
+// CosmWasm contract address
+func IsEVMContractHook(contractHook string) bool {
+	return common.IsHexAddress(contractHook)
+}
+
+// ValidateContractHook checks that the contract hook is either a bech32 CosmWasm contract address or a hex EVM
+// contract address
+func ValidateContractHook(contractHook string) error {
+	if IsEVMContractHook(contractHook) {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(contractHook); err != nil {
+		return ErrInvalidContractHook
+	}
+
+	return nil
+}
+
+// GetEVMHookCallInput returns the ABI encoded sendRestriction call of the EVM contract hook
+func GetEVMHookCallInput(fromAddr, toAddr sdk.AccAddress, action Action, amount sdk.Coin) ([]byte, error) {
+	return evmHookABI.Pack(
+		"sendRestriction",
+		common.BytesToAddress(fromAddr.Bytes()),
+		common.BytesToAddress(toAddr.Bytes()),
+		uint32(action),
+		amount.Denom,
+		amount.Amount.BigInt(),
+	)
+}
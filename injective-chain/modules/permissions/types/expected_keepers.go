@@ -3,8 +3,14 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/statedb"
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
 )
 
 type BankKeeper interface {
@@ -12,6 +18,8 @@ type BankKeeper interface {
 	PrependSendRestriction(restriction banktypes.SendRestrictionFn)
 	ClearSendRestriction()
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 type TokenFactoryKeeper interface {
@@ -22,3 +30,13 @@ type WasmKeeper interface {
 	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
 	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 }
+
+type ExchangeKeeper interface {
+	CancelAllOrdersForDenom(ctx sdk.Context, account sdk.AccAddress, denom string)
+	ClawbackSubaccountDeposits(ctx sdk.Context, account, recipient sdk.AccAddress, denom string, amount math.Int) (math.Int, error)
+}
+
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	StaticCall(ctx sdk.Context, from, contract common.Address, data []byte, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error)
+}
@@ -2,14 +2,18 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default Permissions genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		Namespaces: []Namespace{},
-		Vouchers:   []*AddressVoucher{},
+		Params:         DefaultParams(),
+		Namespaces:     []Namespace{},
+		Vouchers:       []*AddressVoucher{},
+		QuotaUsages:    []*ActorQuotaUsage{},
+		VoucherEntries: []*VoucherEntry{},
 	}
 }
 
@@ -29,6 +33,76 @@ func (gs GenesisState) Validate() error {
 			return errors.Wrapf(ErrInvalidGenesis, "duplicate denom: %s", ns.GetDenom())
 		}
 		seenDenoms[ns.GetDenom()] = struct{}{}
+
+		if err := ns.ValidateActorLimits(); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range gs.QuotaUsages {
+		if _, ok := seenDenoms[usage.Denom]; !ok {
+			return errors.Wrapf(ErrInvalidGenesis, "quota usage for unknown namespace: %s", usage.Denom)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(usage.Actor); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid quota usage actor %s", usage.Actor)
+		}
+
+		if usage.Used.IsNil() || usage.Used.IsNegative() {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid quota usage amount for actor %s", usage.Actor)
+		}
+
+		for idx, bucket := range usage.Buckets {
+			if bucket.Amount.IsNil() || bucket.Amount.IsNegative() {
+				return errors.Wrapf(ErrInvalidGenesis, "invalid quota usage bucket amount for actor %s", usage.Actor)
+			}
+
+			if idx > 0 && bucket.Start <= usage.Buckets[idx-1].Start {
+				return errors.Wrapf(ErrInvalidGenesis, "quota usage buckets of actor %s must be sorted by start", usage.Actor)
+			}
+		}
+	}
+
+	return gs.validateVoucherEntries()
+}
+
+// validateVoucherEntries checks that the parts of a voucher sent by each sender don't exceed the voucher
+func (gs GenesisState) validateVoucherEntries() error {
+	vouchers := make(map[[2]string]math.Int, len(gs.Vouchers))
+	for _, voucher := range gs.Vouchers {
+		vouchers[[2]string{voucher.Address, voucher.Voucher.Denom}] = voucher.Voucher.Amount
+	}
+
+	seenEntries := make(map[[3]string]struct{}, len(gs.VoucherEntries))
+	for _, entry := range gs.VoucherEntries {
+		if _, err := sdk.AccAddressFromBech32(entry.Receiver); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher entry receiver %s", entry.Receiver)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(entry.Sender); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher entry sender %s", entry.Sender)
+		}
+
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher entry amount for receiver %s", entry.Receiver)
+		}
+
+		if entry.ExpiresAt < 0 {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher entry expiry for receiver %s", entry.Receiver)
+		}
+
+		entryKey := [3]string{entry.Receiver, entry.Denom, entry.Sender}
+		if _, ok := seenEntries[entryKey]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "duplicate voucher entry of %s from %s to %s", entry.Denom, entry.Sender, entry.Receiver)
+		}
+		seenEntries[entryKey] = struct{}{}
+
+		voucherKey := [2]string{entry.Receiver, entry.Denom}
+		remaining, ok := vouchers[voucherKey]
+		if !ok || remaining.LT(entry.Amount) {
+			return errors.Wrapf(ErrInvalidGenesis, "voucher entries of %s for receiver %s exceed the voucher", entry.Denom, entry.Receiver)
+		}
+		vouchers[voucherKey] = remaining.Sub(entry.Amount)
 	}
 
 	return nil
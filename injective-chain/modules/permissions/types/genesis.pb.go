@@ -31,6 +31,10 @@ type GenesisState struct {
 	Namespaces []Namespace `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces"`
 	// vouchers defines the vouchers of the module
 	Vouchers []*AddressVoucher `protobuf:"bytes,3,rep,name=vouchers,proto3" json:"vouchers,omitempty"`
+	// quota_usages defines the amounts used by actors against their quotas
+	QuotaUsages []*ActorQuotaUsage `protobuf:"bytes,4,rep,name=quota_usages,json=quotaUsages,proto3" json:"quota_usages,omitempty"`
+	// voucher_entries defines the parts of the vouchers sent by each sender
+	VoucherEntries []*VoucherEntry `protobuf:"bytes,5,rep,name=voucher_entries,json=voucherEntries,proto3" json:"voucher_entries,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetVouchers() []*AddressVoucher {
 	return nil
 }
 
+func (m *GenesisState) GetQuotaUsages() []*ActorQuotaUsage {
+	if m != nil {
+		return m.QuotaUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoucherEntries() []*VoucherEntry {
+	if m != nil {
+		return m.VoucherEntries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.permissions.v1beta1.GenesisState")
 }
@@ -96,26 +114,30 @@ func init() {
 }
 
 var fileDescriptor_5ff1982ce1793022 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0x80, 0x1b, 0x27, 0x43, 0x32, 0x4f, 0xc5, 0xc3, 0x18, 0x18, 0x87, 0x20, 0x0c, 0x65, 0x09,
-	0x9b, 0xbf, 0xc0, 0x79, 0x90, 0x81, 0x0c, 0x9d, 0xe0, 0xc1, 0x5b, 0xda, 0x3d, 0xba, 0xa8, 0x6d,
-	0x4a, 0x5e, 0x5a, 0xf0, 0x5f, 0xf8, 0xb3, 0x76, 0xdc, 0xd1, 0x93, 0x48, 0x7b, 0xf7, 0x37, 0x88,
-	0x6d, 0x37, 0xeb, 0x65, 0xbd, 0x25, 0xf0, 0x7d, 0xdf, 0x7b, 0x3c, 0x7a, 0xa1, 0xa2, 0x67, 0xf0,
-	0xad, 0x4a, 0x41, 0xc4, 0x60, 0x42, 0x85, 0xa8, 0x74, 0x84, 0x22, 0x1d, 0x79, 0x60, 0xe5, 0x48,
-	0x04, 0x10, 0x01, 0x2a, 0xe4, 0xb1, 0xd1, 0x56, 0xbb, 0xc7, 0x5b, 0x98, 0xd7, 0x60, 0x5e, 0xc1,
-	0xbd, 0xa3, 0x40, 0x07, 0xba, 0x20, 0xc5, 0xef, 0xab, 0x94, 0x7a, 0xe7, 0xbb, 0x27, 0xc4, 0xd2,
-	0xc8, 0xb0, 0x1a, 0xd0, 0x13, 0x0d, 0x6c, 0x6d, 0x68, 0x21, 0x9c, 0x7e, 0x13, 0x7a, 0x78, 0x53,
-	0xee, 0xf8, 0x60, 0xa5, 0x05, 0xf7, 0x9a, 0xb6, 0xcb, 0x62, 0x97, 0xf4, 0xc9, 0xa0, 0x33, 0x3e,
-	0xe3, 0x3b, 0x77, 0xe6, 0x77, 0x05, 0x3c, 0xd9, 0x5f, 0x7d, 0x9e, 0x38, 0xf3, 0x4a, 0x75, 0x67,
-	0x94, 0x46, 0x32, 0x04, 0x8c, 0xa5, 0x0f, 0xd8, 0xdd, 0xeb, 0xb7, 0x06, 0x9d, 0xf1, 0xa0, 0x21,
-	0x34, 0xdb, 0x08, 0x55, 0xab, 0x56, 0x70, 0xa7, 0xf4, 0x20, 0xd5, 0x89, 0xbf, 0x04, 0x83, 0xdd,
-	0x56, 0x51, 0x1b, 0x36, 0xd4, 0xae, 0x16, 0x0b, 0x03, 0x88, 0x8f, 0xa5, 0x35, 0xdf, 0xea, 0x93,
-	0x97, 0x55, 0xc6, 0xc8, 0x3a, 0x63, 0xe4, 0x2b, 0x63, 0xe4, 0x3d, 0x67, 0xce, 0x3a, 0x67, 0xce,
-	0x47, 0xce, 0x9c, 0xa7, 0xfb, 0x40, 0xd9, 0x65, 0xe2, 0x71, 0x5f, 0x87, 0x62, 0xba, 0x89, 0xdf,
-	0x4a, 0x0f, 0xff, 0x8e, 0x3a, 0xf4, 0xb5, 0x81, 0xfa, 0x77, 0x29, 0x55, 0x24, 0x42, 0xbd, 0x48,
-	0x5e, 0x01, 0xff, 0x5d, 0xdc, 0xbe, 0xc5, 0x80, 0x5e, 0xbb, 0x38, 0xf2, 0xe5, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0x97, 0x25, 0xeb, 0x25, 0x02, 0x00, 0x00,
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6a, 0xf2, 0x40,
+	0x14, 0x86, 0x93, 0x4f, 0x3f, 0x29, 0xa3, 0xb4, 0x10, 0xba, 0x08, 0x42, 0x53, 0x29, 0x14, 0xa4,
+	0x62, 0x82, 0xf6, 0x0a, 0x6a, 0x29, 0x45, 0x28, 0x52, 0xd3, 0x9f, 0x45, 0x37, 0x32, 0x89, 0x87,
+	0x38, 0x6d, 0x93, 0x89, 0x73, 0x26, 0x01, 0xef, 0xa2, 0xbb, 0xde, 0x92, 0x4b, 0x97, 0x5d, 0x95,
+	0xa2, 0x37, 0x52, 0x4c, 0xa2, 0xa6, 0x1b, 0xb3, 0x9b, 0x03, 0xef, 0xf3, 0xbc, 0x07, 0xe6, 0x90,
+	0x16, 0x0b, 0x5e, 0xc1, 0x95, 0x2c, 0x06, 0x2b, 0x04, 0xe1, 0x33, 0x44, 0xc6, 0x03, 0xb4, 0xe2,
+	0x8e, 0x03, 0x92, 0x76, 0x2c, 0x0f, 0x02, 0x40, 0x86, 0x66, 0x28, 0xb8, 0xe4, 0xda, 0xc9, 0x36,
+	0x6c, 0xe6, 0xc2, 0x66, 0x16, 0xae, 0x1f, 0x7b, 0xdc, 0xe3, 0x49, 0xd2, 0x5a, 0xbf, 0x52, 0xa8,
+	0x7e, 0xb1, 0xbf, 0x21, 0xa4, 0x82, 0xfa, 0x59, 0x41, 0xdd, 0x2a, 0xc8, 0xe6, 0x4a, 0x13, 0xe0,
+	0xec, 0xb3, 0x44, 0x6a, 0xb7, 0xe9, 0x8e, 0x0f, 0x92, 0x4a, 0xd0, 0xae, 0x49, 0x25, 0x35, 0xea,
+	0x6a, 0x43, 0x6d, 0x56, 0xbb, 0xe7, 0xe6, 0xde, 0x9d, 0xcd, 0xfb, 0x24, 0xdc, 0x2b, 0xcf, 0xbf,
+	0x4f, 0x15, 0x3b, 0x43, 0xb5, 0x01, 0x21, 0x01, 0xf5, 0x01, 0x43, 0xea, 0x02, 0xea, 0xff, 0x1a,
+	0xa5, 0x66, 0xb5, 0xdb, 0x2c, 0x10, 0x0d, 0x36, 0x40, 0xe6, 0xca, 0x19, 0xb4, 0x3e, 0x39, 0x88,
+	0x79, 0xe4, 0x4e, 0x40, 0xa0, 0x5e, 0x4a, 0x6c, 0xed, 0x02, 0xdb, 0xd5, 0x78, 0x2c, 0x00, 0xf1,
+	0x39, 0xa5, 0xec, 0x2d, 0xae, 0x0d, 0x49, 0x6d, 0x1a, 0x71, 0x49, 0x47, 0x11, 0x52, 0x0f, 0x50,
+	0x2f, 0x27, 0x3a, 0xb3, 0x48, 0xe7, 0x4a, 0x2e, 0x86, 0x6b, 0xee, 0x69, 0x8d, 0xd9, 0xd5, 0xe9,
+	0xf6, 0x8d, 0xda, 0x23, 0x39, 0xca, 0xf4, 0x23, 0x08, 0xa4, 0x60, 0x80, 0xfa, 0xff, 0xc4, 0xda,
+	0x2a, 0xb0, 0x66, 0xdb, 0xdd, 0x04, 0x52, 0xcc, 0xec, 0xc3, 0x78, 0x37, 0x31, 0xc0, 0xde, 0xdb,
+	0x7c, 0x69, 0xa8, 0x8b, 0xa5, 0xa1, 0xfe, 0x2c, 0x0d, 0xf5, 0x63, 0x65, 0x28, 0x8b, 0x95, 0xa1,
+	0x7c, 0xad, 0x0c, 0xe5, 0x65, 0xe8, 0x31, 0x39, 0x89, 0x1c, 0xd3, 0xe5, 0xbe, 0xd5, 0xdf, 0x14,
+	0xdc, 0x51, 0x07, 0x77, 0xbf, 0xdf, 0x76, 0xb9, 0x80, 0xfc, 0x38, 0xa1, 0x2c, 0xb0, 0x7c, 0x3e,
+	0x8e, 0xde, 0x01, 0xff, 0x9c, 0x86, 0x9c, 0x85, 0x80, 0x4e, 0x25, 0xb9, 0x86, 0xcb, 0xdf, 0x01,
+	0x00, 0x9c, 0xd1, 0x0a, 0x9b, 0xce, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +160,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoucherEntries) > 0 {
+		for iNdEx := len(m.VoucherEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.QuotaUsages) > 0 {
+		for iNdEx := len(m.QuotaUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuotaUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Vouchers) > 0 {
 		for iNdEx := len(m.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -210,6 +260,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.QuotaUsages) > 0 {
+		for _, e := range m.QuotaUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoucherEntries) > 0 {
+		for _, e := range m.VoucherEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -349,6 +411,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotaUsages = append(m.QuotaUsages, &ActorQuotaUsage{})
+			if err := m.QuotaUsages[len(m.QuotaUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherEntries = append(m.VoucherEntries, &VoucherEntry{})
+			if err := m.VoucherEntries[len(m.VoucherEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,6 +17,10 @@ const (
 	TypeMsgCreateNamespace = "create_namespace"
 	TypeUpdateNamespace    = "update_namespace"
 	TypeMsgClaimVoucher    = "claim_voucher"
+	TypeMsgClaimVouchers   = "claim_vouchers"
+	TypeMsgFreezeAccount   = "freeze_account"
+	TypeMsgUnfreezeAccount = "unfreeze_account"
+	TypeMsgClawback        = "clawback"
 )
 
 var (
@@ -25,6 +29,10 @@ var (
 	_ sdk.Msg = &MsgUpdateNamespace{}
 	_ sdk.Msg = &MsgUpdateActorRoles{}
 	_ sdk.Msg = &MsgClaimVoucher{}
+	_ sdk.Msg = &MsgClaimVouchers{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+	_ sdk.Msg = &MsgClawback{}
 )
 
 func (m MsgUpdateParams) Route() string { return routerKey }
@@ -68,8 +76,8 @@ func (msg MsgCreateNamespace) ValidateBasic() error {
 
 	// existing contract hook contract
 	if n.ContractHook != "" {
-		if _, err := sdk.AccAddressFromBech32(n.ContractHook); err != nil {
-			return ErrInvalidContractHook
+		if err := ValidateContractHook(n.ContractHook); err != nil {
+			return err
 		}
 	}
 
@@ -80,6 +88,10 @@ func (msg MsgCreateNamespace) ValidateBasic() error {
 	if err := n.ValidatePolicies(); err != nil {
 		return err
 	}
+
+	if err := n.ValidateActorLimits(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -102,8 +114,8 @@ func (msg MsgUpdateNamespace) ValidateBasic() error {
 	}
 
 	if msg.ContractHook != nil {
-		if _, err := sdk.AccAddressFromBech32(msg.ContractHook.NewValue); err != nil {
-			return ErrInvalidContractHook
+		if err := ValidateContractHook(msg.ContractHook.NewValue); err != nil {
+			return err
 		}
 	}
 
@@ -124,6 +136,16 @@ func (msg MsgUpdateNamespace) ValidateBasic() error {
 	if err := namespace.ValidatePolicies(); err != nil {
 		return err
 	}
+
+	if err := ValidateActorQuotas(msg.ActorQuotas, true); err != nil {
+		return err
+	}
+
+	if msg.VoucherPolicy != nil {
+		if err := ValidateVoucherPolicy(msg.VoucherPolicy.Expiry, msg.VoucherPolicy.RecoveryAddress); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -142,6 +164,8 @@ type NamespaceUpdates struct {
 	HasRoleManagersChange    bool
 	HasPolicyStatusesChange  bool
 	HasPolicyManagersChange  bool
+	HasActorQuotasChange     bool
+	HasVoucherPolicyChange   bool
 	ChangeActions            []Action
 }
 
@@ -166,6 +190,21 @@ func (msg MsgUpdateNamespace) GetNamespaceUpdates() NamespaceUpdates {
 		changes.HasRolePermissionsChange = true
 	}
 
+	// quotas restrict the actions granted by roles, so updating them requires the same permission as updating roles
+	if len(msg.ActorQuotas) > 0 {
+		if !changes.HasRolePermissionsChange {
+			actions = append(actions, Action_MODIFY_ROLE_PERMISSIONS)
+		}
+		changes.HasActorQuotasChange = true
+	}
+
+	// expired vouchers can be sent to the recovery address instead of their sender, which is a clawback of the funds
+	// owed to the receivers, so updating the voucher policy requires the clawback permission
+	if msg.VoucherPolicy != nil {
+		actions = append(actions, Action_CLAWBACK)
+		changes.HasVoucherPolicyChange = true
+	}
+
 	if len(msg.RoleManagers) > 0 {
 		actions = append(actions, Action_MODIFY_ROLE_MANAGERS)
 		changes.HasRoleManagersChange = true
@@ -209,6 +248,10 @@ func (msg MsgUpdateActorRoles) ValidateBasic() error {
 		}
 		roles[roleName] = struct{}{}
 
+		if err := ValidateRoleExpiry(role.ExpiresAtTimestamp, role.ExpiresAtHeight); err != nil {
+			return err
+		}
+
 		for _, actor := range role.Actors {
 			if _, err := sdk.AccAddressFromBech32(actor); err != nil {
 				return err
@@ -317,3 +360,129 @@ func (m MsgClaimVoucher) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{addr}
 }
+
+func (m MsgClaimVouchers) Route() string { return routerKey }
+
+func (m MsgClaimVouchers) Type() string { return TypeMsgClaimVouchers }
+
+func (msg MsgClaimVouchers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	seenDenoms := make(map[string]struct{}, len(msg.Denoms))
+	for _, denom := range msg.Denoms {
+		if denom == "" {
+			return fmt.Errorf("invalid denom")
+		}
+
+		if _, ok := seenDenoms[denom]; ok {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seenDenoms[denom] = struct{}{}
+	}
+	return nil
+}
+
+func (m *MsgClaimVouchers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgClaimVouchers) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgFreezeAccount) Route() string { return routerKey }
+
+func (m MsgFreezeAccount) Type() string { return TypeMsgFreezeAccount }
+
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Denom == "" {
+		return fmt.Errorf("invalid denom")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgUnfreezeAccount) Route() string { return routerKey }
+
+func (m MsgUnfreezeAccount) Type() string { return TypeMsgUnfreezeAccount }
+
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Denom == "" {
+		return fmt.Errorf("invalid denom")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgClawback) Route() string { return routerKey }
+
+func (m MsgClawback) Type() string { return TypeMsgClawback }
+
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return err
+	}
+
+	recoveryAddress, err := sdk.AccAddressFromBech32(msg.RecoveryAddress)
+	if err != nil {
+		return err
+	}
+
+	if account.Equals(recoveryAddress) {
+		return fmt.Errorf("recovery address cannot be the clawed back account")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid clawback amount %s", msg.Amount)
+	}
+	return nil
+}
+
+func (m *MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgClawback) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
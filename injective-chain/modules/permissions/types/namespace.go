@@ -1,6 +1,8 @@
 package types
 
 import (
+	"slices"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -186,6 +188,141 @@ func (n *Namespace) ValidatePolicies() error {
 	return nil
 }
 
+// ValidateActorLimits validates the role expiries, quotas and frozen accounts of the actors of the namespace
+func (n *Namespace) ValidateActorLimits() error {
+	actorRoles := make(map[string]map[string]struct{}, len(n.ActorRoles))
+	for _, actorRole := range n.ActorRoles {
+		roles := make(map[string]struct{}, len(actorRole.Roles))
+		for _, role := range actorRole.Roles {
+			roles[role] = struct{}{}
+		}
+		actorRoles[actorRole.Actor] = roles
+	}
+
+	foundExpiries := make(map[string]map[string]struct{}, len(n.ActorRoleExpiries))
+	for _, expiry := range n.ActorRoleExpiries {
+		if err := ValidateRoleExpiry(expiry.ExpiresAtTimestamp, expiry.ExpiresAtHeight); err != nil {
+			return err
+		}
+
+		if expiry.ExpiresAtTimestamp == 0 && expiry.ExpiresAtHeight == 0 {
+			return errors.Wrapf(ErrInvalidRoleExpiry, "expiry of role %s for actor %s must be set", expiry.Role, expiry.Actor)
+		}
+
+		if _, ok := actorRoles[expiry.Actor][expiry.Role]; !ok {
+			return errors.Wrapf(ErrInvalidRoleExpiry, "role %s is not assigned to actor %s", expiry.Role, expiry.Actor)
+		}
+
+		foundRoles, ok := foundExpiries[expiry.Actor]
+		if !ok {
+			foundRoles = make(map[string]struct{})
+			foundExpiries[expiry.Actor] = foundRoles
+		}
+
+		if _, exists := foundRoles[expiry.Role]; exists {
+			return errors.Wrapf(ErrInvalidRoleExpiry, "repeated expiry of role %s for actor %s", expiry.Role, expiry.Actor)
+		}
+		foundRoles[expiry.Role] = struct{}{}
+	}
+
+	if err := ValidateActorQuotas(n.ActorQuotas, false); err != nil {
+		return err
+	}
+
+	if err := n.validateFrozenAccounts(); err != nil {
+		return err
+	}
+
+	return ValidateVoucherPolicy(n.VoucherExpiry, n.VoucherRecoveryAddress)
+}
+
+func (n *Namespace) validateFrozenAccounts() error {
+	foundAccounts := make(map[string]struct{}, len(n.FrozenAccounts))
+
+	for _, frozenAccount := range n.FrozenAccounts {
+		account, err := sdk.AccAddressFromBech32(frozenAccount.Address)
+		if err != nil {
+			return errors.Wrapf(err, "invalid frozen account address %s", frozenAccount.Address)
+		}
+
+		if _, ok := foundAccounts[account.String()]; ok {
+			return errors.Wrapf(ErrInvalidNamespace, "repeated frozen account %s", account)
+		}
+		foundAccounts[account.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateVoucherPolicy validates the optional expiry duration of vouchers and the address receiving expired vouchers
+func ValidateVoucherPolicy(expiry int64, recoveryAddress string) error {
+	if expiry < 0 {
+		return ErrInvalidVoucherPolicy.Wrapf("voucher expiry %d cannot be negative", expiry)
+	}
+
+	if recoveryAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(recoveryAddress); err != nil {
+			return errors.Wrapf(ErrInvalidVoucherPolicy, "invalid voucher recovery address %s", recoveryAddress)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRoleExpiry validates the optional expiry timestamp and height of a role assigned to actors
+func ValidateRoleExpiry(expiresAtTimestamp, expiresAtHeight int64) error {
+	if expiresAtTimestamp < 0 {
+		return ErrInvalidRoleExpiry.Wrapf("expiry timestamp %d cannot be negative", expiresAtTimestamp)
+	}
+
+	if expiresAtHeight < 0 {
+		return ErrInvalidRoleExpiry.Wrapf("expiry height %d cannot be negative", expiresAtHeight)
+	}
+
+	return nil
+}
+
+// ValidateActorQuotas validates the actor quotas. A zero limit is only allowed for updates, where it removes the quota.
+func ValidateActorQuotas(quotas []*ActorQuota, isForUpdate bool) error {
+	foundQuotas := make(map[string]map[Action]struct{}, len(quotas))
+
+	for _, quota := range quotas {
+		actor, err := sdk.AccAddressFromBech32(quota.Actor)
+		if err != nil {
+			return errors.Wrapf(err, "invalid actor address %s", quota.Actor)
+		}
+
+		if !slices.Contains(QuotaActions, quota.Action) {
+			return ErrInvalidActorQuota.Wrapf("action %s cannot be limited by a quota", quota.Action)
+		}
+
+		if quota.Limit.IsNil() || quota.Limit.IsNegative() {
+			return ErrInvalidActorQuota.Wrapf("limit of %s quota for actor %s must be positive", quota.Action, actor)
+		}
+
+		if quota.Limit.IsZero() && !isForUpdate {
+			return ErrInvalidActorQuota.Wrapf("limit of %s quota for actor %s must be positive", quota.Action, actor)
+		}
+
+		if quota.Window < 0 {
+			return ErrInvalidActorQuota.Wrapf("window of %s quota for actor %s cannot be negative", quota.Action, actor)
+		}
+
+		foundActions, ok := foundQuotas[actor.String()]
+		if !ok {
+			foundActions = make(map[Action]struct{})
+			foundQuotas[actor.String()] = foundActions
+		}
+
+		if _, exists := foundActions[quota.Action]; exists {
+			return errors.Wrapf(ErrInvalidActorQuota, "repeated %s quota for actor %s", quota.Action, actor)
+		}
+		foundActions[quota.Action] = struct{}{}
+	}
+
+	return nil
+}
+
 func (n *Namespace) validateEveryoneRole(isForUpdate bool) error {
 	// role_permissions
 	for _, rolePerm := range n.RolePermissions {
@@ -9,17 +9,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(wasmHookQueryMaxGas uint64) Params {
+func NewParams(wasmHookQueryMaxGas, evmHookQueryMaxGas uint64) Params {
 	return Params{
 		WasmHookQueryMaxGas: wasmHookQueryMaxGas,
+		EvmHookQueryMaxGas:  evmHookQueryMaxGas,
 	}
 }
 
+// DefaultEVMHookQueryMaxGas is the max amount of gas allowed for EVM hook calls when unset in the params
+const DefaultEVMHookQueryMaxGas uint64 = 300_000
+
 // default module parameters.
 func DefaultParams() Params {
 	return Params{
 		WasmHookQueryMaxGas: 200_000,
+		EvmHookQueryMaxGas:  DefaultEVMHookQueryMaxGas,
+	}
+}
+
+// EffectiveEVMHookQueryMaxGas returns the max amount of gas allowed for EVM hook calls, falling back to the default for
+// params set before EVM hooks were supported
+func (p Params) EffectiveEVMHookQueryMaxGas() uint64 {
+	if p.EvmHookQueryMaxGas == 0 {
+		return DefaultEVMHookQueryMaxGas
 	}
+	return p.EvmHookQueryMaxGas
 }
 
 // validate params.
@@ -30,6 +30,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// Max amount of gas allowed for wasm hook queries
 	WasmHookQueryMaxGas uint64 `protobuf:"varint,1,opt,name=wasm_hook_query_max_gas,json=wasmHookQueryMaxGas,proto3" json:"wasm_hook_query_max_gas,omitempty"`
+	// Max amount of gas allowed for EVM hook calls
+	EvmHookQueryMaxGas uint64 `protobuf:"varint,2,opt,name=evm_hook_query_max_gas,json=evmHookQueryMaxGas,proto3" json:"evm_hook_query_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,6 +74,13 @@ func (m *Params) GetWasmHookQueryMaxGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetEvmHookQueryMaxGas() uint64 {
+	if m != nil {
+		return m.EvmHookQueryMaxGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.permissions.v1beta1.Params")
 }
@@ -81,25 +90,26 @@ func init() {
 }
 
 var fileDescriptor_4a7ea0496163621f = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x6b, 0x09, 0x75, 0xc8, 0x46, 0x40, 0x02, 0x8a, 0x30, 0x88, 0x09, 0x55, 0x22, 0x56,
-	0x05, 0x13, 0x23, 0x0b, 0x20, 0x81, 0x44, 0x19, 0x61, 0x88, 0x5e, 0x82, 0x95, 0x98, 0xe0, 0xbc,
-	0x90, 0xe7, 0x94, 0xf6, 0x0a, 0x4c, 0x1c, 0x81, 0x23, 0x70, 0x0c, 0xc6, 0x8e, 0x8c, 0x28, 0x19,
-	0xe0, 0x18, 0x28, 0x71, 0x88, 0xc2, 0x62, 0xf9, 0xf7, 0xf7, 0xdb, 0x7e, 0xff, 0xef, 0x8c, 0x55,
-	0xfa, 0x20, 0x43, 0xa3, 0x66, 0x52, 0x64, 0x32, 0xd7, 0x8a, 0x48, 0x61, 0x4a, 0x62, 0x36, 0x09,
-	0xa4, 0x81, 0x89, 0xc8, 0x20, 0x07, 0x4d, 0x5e, 0x96, 0xa3, 0x41, 0x77, 0xa7, 0xf3, 0x7a, 0x3d,
-	0xaf, 0xd7, 0x7a, 0x47, 0xeb, 0x11, 0x46, 0xd8, 0x38, 0x45, 0xbd, 0xb3, 0x97, 0x46, 0x5b, 0x21,
-	0x92, 0x46, 0xf2, 0x2d, 0xb0, 0xa2, 0x45, 0xdc, 0x2a, 0x11, 0x00, 0xc9, 0xee, 0xc7, 0x10, 0x55,
-	0xda, 0xf2, 0x55, 0xd0, 0x2a, 0x45, 0xd1, 0xac, 0xf6, 0x68, 0xff, 0xce, 0x19, 0x5e, 0x37, 0x23,
-	0xb9, 0xc7, 0xce, 0xc6, 0x33, 0x90, 0xf6, 0x63, 0xc4, 0xc4, 0x7f, 0x2a, 0x64, 0xbe, 0xf0, 0x35,
-	0xcc, 0xfd, 0x08, 0x68, 0x93, 0xed, 0xb1, 0x83, 0x95, 0x9b, 0xb5, 0x1a, 0x9f, 0x23, 0x26, 0xd3,
-	0x1a, 0x5e, 0xc1, 0xfc, 0x0c, 0xe8, 0x64, 0xfb, 0xe7, 0x6d, 0x97, 0xbd, 0x7c, 0xbf, 0x8f, 0xdd,
-	0x7e, 0x5a, 0xfb, 0xe4, 0x69, 0xf2, 0x51, 0x72, 0xb6, 0x2c, 0x39, 0xfb, 0x2a, 0x39, 0x7b, 0xad,
-	0xf8, 0x60, 0x59, 0xf1, 0xc1, 0x67, 0xc5, 0x07, 0xb7, 0xd3, 0x48, 0x99, 0xb8, 0x08, 0xbc, 0x10,
-	0xb5, 0xb8, 0xf8, 0x2b, 0xe1, 0x12, 0x02, 0x12, 0x5d, 0x25, 0x87, 0x21, 0xe6, 0xb2, 0x2f, 0x63,
-	0x50, 0xa9, 0xd0, 0x78, 0x5f, 0x3c, 0x4a, 0xfa, 0xd7, 0xad, 0x59, 0x64, 0x92, 0x82, 0x61, 0x13,
-	0xe8, 0xe8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x42, 0x3f, 0x45, 0x81, 0x01, 0x00, 0x00,
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4a, 0xf4, 0x40,
+	0x14, 0xc5, 0x77, 0x3e, 0x3e, 0xb6, 0x48, 0x67, 0x14, 0xff, 0xac, 0x38, 0x8a, 0x95, 0x2c, 0x98,
+	0x61, 0xd5, 0xca, 0xd2, 0x46, 0x05, 0x05, 0xd7, 0xd2, 0x26, 0xdc, 0xc4, 0x21, 0x3b, 0xc6, 0xc9,
+	0x8d, 0xb9, 0x49, 0xdc, 0x7d, 0x05, 0x1b, 0x7d, 0x04, 0x1f, 0xc1, 0xc7, 0xb0, 0xdc, 0xd2, 0x52,
+	0x92, 0x42, 0x1f, 0x43, 0x92, 0x89, 0x21, 0x8a, 0xcd, 0x30, 0x67, 0x7e, 0xe7, 0x30, 0x97, 0x73,
+	0xad, 0xa1, 0x8a, 0x6e, 0xa4, 0x9f, 0xaa, 0x5c, 0x8a, 0x58, 0x26, 0x5a, 0x11, 0x29, 0x8c, 0x48,
+	0xe4, 0x23, 0x4f, 0xa6, 0x30, 0x12, 0x31, 0x24, 0xa0, 0xc9, 0x89, 0x13, 0x4c, 0xd1, 0xde, 0x68,
+	0xbd, 0x4e, 0xc7, 0xeb, 0x34, 0xde, 0xc1, 0x52, 0x80, 0x01, 0xd6, 0x4e, 0x51, 0xdd, 0x4c, 0x68,
+	0xb0, 0xe6, 0x23, 0x69, 0x24, 0xd7, 0x00, 0x23, 0x1a, 0xc4, 0x8d, 0x12, 0x1e, 0x90, 0x6c, 0x7f,
+	0xf4, 0x51, 0x45, 0x0d, 0x5f, 0x00, 0xad, 0x22, 0x14, 0xf5, 0x69, 0x9e, 0xb6, 0x1f, 0x99, 0xd5,
+	0xbf, 0xa8, 0x67, 0xb2, 0x0f, 0xac, 0x95, 0x7b, 0x20, 0xed, 0x4e, 0x10, 0x43, 0xf7, 0x2e, 0x93,
+	0xc9, 0xcc, 0xd5, 0x30, 0x75, 0x03, 0xa0, 0x55, 0xb6, 0xc5, 0x76, 0xfe, 0x5f, 0x2e, 0x56, 0xf8,
+	0x04, 0x31, 0x1c, 0x57, 0xf0, 0x1c, 0xa6, 0xc7, 0x40, 0xf6, 0x9e, 0xb5, 0x2c, 0xf3, 0x3f, 0x43,
+	0xff, 0xea, 0x90, 0x2d, 0xf3, 0xdf, 0x99, 0xc3, 0xf5, 0xcf, 0xe7, 0x4d, 0xf6, 0xf0, 0xf1, 0x32,
+	0xb4, 0xbb, 0x15, 0x99, 0x31, 0x8e, 0xc2, 0xd7, 0x82, 0xb3, 0x79, 0xc1, 0xd9, 0x7b, 0xc1, 0xd9,
+	0x53, 0xc9, 0x7b, 0xf3, 0x92, 0xf7, 0xde, 0x4a, 0xde, 0xbb, 0x1a, 0x07, 0x2a, 0x9d, 0x64, 0x9e,
+	0xe3, 0xa3, 0x16, 0xa7, 0xdf, 0xcd, 0x9d, 0x81, 0x47, 0xa2, 0xed, 0x71, 0xd7, 0xc7, 0x44, 0x76,
+	0xe5, 0x04, 0x54, 0x24, 0x34, 0x5e, 0x67, 0xb7, 0x92, 0x7e, 0x2c, 0x24, 0x9d, 0xc5, 0x92, 0xbc,
+	0x7e, 0xdd, 0xc2, 0xfe, 0xd7, 0x00, 0xc1, 0xf4, 0xb8, 0xe3, 0xb6, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -124,6 +134,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WasmHookQueryMaxGas != that1.WasmHookQueryMaxGas {
 		return false
 	}
+	if this.EvmHookQueryMaxGas != that1.EvmHookQueryMaxGas {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -146,6 +159,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EvmHookQueryMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EvmHookQueryMaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.WasmHookQueryMaxGas != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.WasmHookQueryMaxGas))
 		i--
@@ -174,6 +192,9 @@ func (m *Params) Size() (n int) {
 	if m.WasmHookQueryMaxGas != 0 {
 		n += 1 + sovParams(uint64(m.WasmHookQueryMaxGas))
 	}
+	if m.EvmHookQueryMaxGas != 0 {
+		n += 1 + sovParams(uint64(m.EvmHookQueryMaxGas))
+	}
 	return n
 }
 
@@ -231,6 +252,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmHookQueryMaxGas", wireType)
+			}
+			m.EvmHookQueryMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmHookQueryMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +42,10 @@ const (
 	Action_SEND Action = 8
 	// 16 is reserved for SUPER_BURN
 	Action_SUPER_BURN Action = 16
+	// 32 is reserved for FREEZE
+	Action_FREEZE Action = 32
+	// 64 is reserved for CLAWBACK
+	Action_CLAWBACK Action = 64
 	// 2^27 is reserved for MODIFY_POLICY_MANAGERS
 	Action_MODIFY_POLICY_MANAGERS Action = 134217728
 	// 2^28 is reserved for MODIFY_CONTRACT_HOOK
@@ -58,6 +63,8 @@ var Action_name = map[int32]string{
 	4:          "BURN",
 	8:          "SEND",
 	16:         "SUPER_BURN",
+	32:         "FREEZE",
+	64:         "CLAWBACK",
 	134217728:  "MODIFY_POLICY_MANAGERS",
 	268435456:  "MODIFY_CONTRACT_HOOK",
 	536870912:  "MODIFY_ROLE_PERMISSIONS",
@@ -71,6 +78,8 @@ var Action_value = map[string]int32{
 	"BURN":                    4,
 	"SEND":                    8,
 	"SUPER_BURN":              16,
+	"FREEZE":                  32,
+	"CLAWBACK":                64,
 	"MODIFY_POLICY_MANAGERS":  134217728,
 	"MODIFY_CONTRACT_HOOK":    268435456,
 	"MODIFY_ROLE_PERMISSIONS": 536870912,
@@ -101,6 +110,19 @@ type Namespace struct {
 	PolicyStatuses []*PolicyStatus `protobuf:"bytes,6,rep,name=policy_statuses,json=policyStatuses,proto3" json:"policy_statuses,omitempty"`
 	// capabilities for each manager for each policy
 	PolicyManagerCapabilities []*PolicyManagerCapability `protobuf:"bytes,7,rep,name=policy_manager_capabilities,json=policyManagerCapabilities,proto3" json:"policy_manager_capabilities,omitempty"`
+	// expiries of the roles assigned to actors
+	ActorRoleExpiries []*ActorRoleExpiry `protobuf:"bytes,8,rep,name=actor_role_expiries,json=actorRoleExpiries,proto3" json:"actor_role_expiries,omitempty"`
+	// quotas limiting the amount each actor can use for an action
+	ActorQuotas []*ActorQuota `protobuf:"bytes,9,rep,name=actor_quotas,json=actorQuotas,proto3" json:"actor_quotas,omitempty"`
+	// accounts whose balances of the denom are frozen
+	FrozenAccounts []*FrozenAccount `protobuf:"bytes,10,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty"`
+	// The duration (in seconds) after which the funds of a voucher are returned
+	// to their sender, or sent to the voucher recovery address if set. 0 if
+	// vouchers don't expire.
+	VoucherExpiry int64 `protobuf:"varint,11,opt,name=voucher_expiry,json=voucherExpiry,proto3" json:"voucher_expiry,omitempty"`
+	// Optional address receiving the funds of expired vouchers instead of their
+	// sender
+	VoucherRecoveryAddress string `protobuf:"bytes,12,opt,name=voucher_recovery_address,json=voucherRecoveryAddress,proto3" json:"voucher_recovery_address,omitempty"`
 }
 
 func (m *Namespace) Reset()         { *m = Namespace{} }
@@ -185,6 +207,41 @@ func (m *Namespace) GetPolicyManagerCapabilities() []*PolicyManagerCapability {
 	return nil
 }
 
+func (m *Namespace) GetActorRoleExpiries() []*ActorRoleExpiry {
+	if m != nil {
+		return m.ActorRoleExpiries
+	}
+	return nil
+}
+
+func (m *Namespace) GetActorQuotas() []*ActorQuota {
+	if m != nil {
+		return m.ActorQuotas
+	}
+	return nil
+}
+
+func (m *Namespace) GetFrozenAccounts() []*FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *Namespace) GetVoucherExpiry() int64 {
+	if m != nil {
+		return m.VoucherExpiry
+	}
+	return 0
+}
+
+func (m *Namespace) GetVoucherRecoveryAddress() string {
+	if m != nil {
+		return m.VoucherRecoveryAddress
+	}
+	return ""
+}
+
 // AddressRoles defines roles for an actor
 type ActorRoles struct {
 	// The actor name
@@ -246,6 +303,12 @@ type RoleActors struct {
 	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	// List of actor names associated with the role
 	Actors []string `protobuf:"bytes,2,rep,name=actors,proto3" json:"actors,omitempty"`
+	// Optional unix timestamp (in seconds) after which the role is no longer
+	// granted to the actors, 0 if the role doesn't expire by time
+	ExpiresAtTimestamp int64 `protobuf:"varint,3,opt,name=expires_at_timestamp,json=expiresAtTimestamp,proto3" json:"expires_at_timestamp,omitempty"`
+	// Optional block height after which the role is no longer granted to the
+	// actors, 0 if the role doesn't expire by height
+	ExpiresAtHeight int64 `protobuf:"varint,4,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *RoleActors) Reset()         { *m = RoleActors{} }
@@ -295,6 +358,287 @@ func (m *RoleActors) GetActors() []string {
 	return nil
 }
 
+func (m *RoleActors) GetExpiresAtTimestamp() int64 {
+	if m != nil {
+		return m.ExpiresAtTimestamp
+	}
+	return 0
+}
+
+func (m *RoleActors) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// ActorRoleExpiry defines when a role assigned to an actor expires
+type ActorRoleExpiry struct {
+	// The actor name
+	Actor string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The role name
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// The unix timestamp (in seconds) after which the role expires, 0 if unset
+	ExpiresAtTimestamp int64 `protobuf:"varint,3,opt,name=expires_at_timestamp,json=expiresAtTimestamp,proto3" json:"expires_at_timestamp,omitempty"`
+	// The block height after which the role expires, 0 if unset
+	ExpiresAtHeight int64 `protobuf:"varint,4,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *ActorRoleExpiry) Reset()         { *m = ActorRoleExpiry{} }
+func (m *ActorRoleExpiry) String() string { return proto.CompactTextString(m) }
+func (*ActorRoleExpiry) ProtoMessage()    {}
+func (*ActorRoleExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{3}
+}
+func (m *ActorRoleExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorRoleExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorRoleExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorRoleExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorRoleExpiry.Merge(m, src)
+}
+func (m *ActorRoleExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorRoleExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorRoleExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorRoleExpiry proto.InternalMessageInfo
+
+func (m *ActorRoleExpiry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ActorRoleExpiry) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ActorRoleExpiry) GetExpiresAtTimestamp() int64 {
+	if m != nil {
+		return m.ExpiresAtTimestamp
+	}
+	return 0
+}
+
+func (m *ActorRoleExpiry) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// ActorQuota defines the maximum amount of the namespace denom an actor can
+// use for an action
+type ActorQuota struct {
+	// The actor name
+	Actor string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The action code number
+	Action Action `protobuf:"varint,2,opt,name=action,proto3,enum=injective.permissions.v1beta1.Action" json:"action,omitempty"`
+	// The maximum amount the actor can use for the action within the window
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// The duration of the quota window in seconds, 0 for a lifetime quota
+	Window int64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *ActorQuota) Reset()         { *m = ActorQuota{} }
+func (m *ActorQuota) String() string { return proto.CompactTextString(m) }
+func (*ActorQuota) ProtoMessage()    {}
+func (*ActorQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{4}
+}
+func (m *ActorQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorQuota.Merge(m, src)
+}
+func (m *ActorQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorQuota proto.InternalMessageInfo
+
+func (m *ActorQuota) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ActorQuota) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_UNSPECIFIED
+}
+
+func (m *ActorQuota) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// ActorQuotaUsage defines the amount used by an actor against its quota for an
+// action
+type ActorQuotaUsage struct {
+	// The namespace denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The actor name
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The action code number
+	Action Action `protobuf:"varint,3,opt,name=action,proto3,enum=injective.permissions.v1beta1.Action" json:"action,omitempty"`
+	// The amount used within the sliding window ending at the last action, or
+	// in total for a lifetime quota
+	Used cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	// The amounts used within the buckets the quota window is split into, oldest
+	// first. Empty for a lifetime quota.
+	Buckets []ActorQuotaUsageBucket `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ActorQuotaUsage) Reset()         { *m = ActorQuotaUsage{} }
+func (m *ActorQuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*ActorQuotaUsage) ProtoMessage()    {}
+func (*ActorQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{5}
+}
+func (m *ActorQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorQuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorQuotaUsage.Merge(m, src)
+}
+func (m *ActorQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorQuotaUsage proto.InternalMessageInfo
+
+func (m *ActorQuotaUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ActorQuotaUsage) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ActorQuotaUsage) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_UNSPECIFIED
+}
+
+func (m *ActorQuotaUsage) GetBuckets() []ActorQuotaUsageBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// ActorQuotaUsageBucket defines the amount used by an actor within a slice of
+// the quota window
+type ActorQuotaUsageBucket struct {
+	// The unix timestamp (in seconds) at which the bucket starts
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// The amount used within the bucket
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ActorQuotaUsageBucket) Reset()         { *m = ActorQuotaUsageBucket{} }
+func (m *ActorQuotaUsageBucket) String() string { return proto.CompactTextString(m) }
+func (*ActorQuotaUsageBucket) ProtoMessage()    {}
+func (*ActorQuotaUsageBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{6}
+}
+func (m *ActorQuotaUsageBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorQuotaUsageBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorQuotaUsageBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorQuotaUsageBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorQuotaUsageBucket.Merge(m, src)
+}
+func (m *ActorQuotaUsageBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorQuotaUsageBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorQuotaUsageBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorQuotaUsageBucket proto.InternalMessageInfo
+
+func (m *ActorQuotaUsageBucket) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
 // RoleManager defines roles for a manager address
 type RoleManager struct {
 	// The manager name
@@ -307,7 +651,7 @@ func (m *RoleManager) Reset()         { *m = RoleManager{} }
 func (m *RoleManager) String() string { return proto.CompactTextString(m) }
 func (*RoleManager) ProtoMessage()    {}
 func (*RoleManager) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{3}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{7}
 }
 func (m *RoleManager) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,6 +694,70 @@ func (m *RoleManager) GetRoles() []string {
 	return nil
 }
 
+// FrozenAccount defines an account whose balance of a denom is frozen in place
+type FrozenAccount struct {
+	// The frozen account's Injective address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The issuer defined code of the reason for the freeze
+	ReasonCode uint32 `protobuf:"varint,2,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	// The unix timestamp (in seconds) at which the account was frozen
+	FrozenAt int64 `protobuf:"varint,3,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+}
+
+func (m *FrozenAccount) Reset()         { *m = FrozenAccount{} }
+func (m *FrozenAccount) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccount) ProtoMessage()    {}
+func (*FrozenAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{8}
+}
+func (m *FrozenAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccount.Merge(m, src)
+}
+func (m *FrozenAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccount proto.InternalMessageInfo
+
+func (m *FrozenAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FrozenAccount) GetReasonCode() uint32 {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return 0
+}
+
+func (m *FrozenAccount) GetFrozenAt() int64 {
+	if m != nil {
+		return m.FrozenAt
+	}
+	return 0
+}
+
 // PolicyStatus defines the status of a policy
 type PolicyStatus struct {
 	// The action code number
@@ -364,7 +772,7 @@ func (m *PolicyStatus) Reset()         { *m = PolicyStatus{} }
 func (m *PolicyStatus) String() string { return proto.CompactTextString(m) }
 func (*PolicyStatus) ProtoMessage()    {}
 func (*PolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{4}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{9}
 }
 func (m *PolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +837,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{5}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{10}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +903,7 @@ func (m *PolicyManagerCapability) Reset()         { *m = PolicyManagerCapability
 func (m *PolicyManagerCapability) String() string { return proto.CompactTextString(m) }
 func (*PolicyManagerCapability) ProtoMessage()    {}
 func (*PolicyManagerCapability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{6}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{11}
 }
 func (m *PolicyManagerCapability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +969,7 @@ func (m *RoleIDs) Reset()         { *m = RoleIDs{} }
 func (m *RoleIDs) String() string { return proto.CompactTextString(m) }
 func (*RoleIDs) ProtoMessage()    {}
 func (*RoleIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{7}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{12}
 }
 func (m *RoleIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +1017,7 @@ func (m *AddressVoucher) Reset()         { *m = AddressVoucher{} }
 func (m *AddressVoucher) String() string { return proto.CompactTextString(m) }
 func (*AddressVoucher) ProtoMessage()    {}
 func (*AddressVoucher) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{8}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{13}
 }
 func (m *AddressVoucher) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,17 +1053,99 @@ func (m *AddressVoucher) GetAddress() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("injective.permissions.v1beta1.Action", Action_name, Action_value)
-	proto.RegisterType((*Namespace)(nil), "injective.permissions.v1beta1.Namespace")
-	proto.RegisterType((*ActorRoles)(nil), "injective.permissions.v1beta1.ActorRoles")
-	proto.RegisterType((*RoleActors)(nil), "injective.permissions.v1beta1.RoleActors")
-	proto.RegisterType((*RoleManager)(nil), "injective.permissions.v1beta1.RoleManager")
-	proto.RegisterType((*PolicyStatus)(nil), "injective.permissions.v1beta1.PolicyStatus")
-	proto.RegisterType((*Role)(nil), "injective.permissions.v1beta1.Role")
-	proto.RegisterType((*PolicyManagerCapability)(nil), "injective.permissions.v1beta1.PolicyManagerCapability")
-	proto.RegisterType((*RoleIDs)(nil), "injective.permissions.v1beta1.RoleIDs")
+// VoucherEntry is the part of the voucher of a receiver sent by a sender
+type VoucherEntry struct {
+	// The token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The Injective address of the receiver
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// The Injective address of the sender
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The amount sent by the sender
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// The unix timestamp (in seconds) at which the entry expires, 0 if it
+	// doesn't expire
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *VoucherEntry) Reset()         { *m = VoucherEntry{} }
+func (m *VoucherEntry) String() string { return proto.CompactTextString(m) }
+func (*VoucherEntry) ProtoMessage()    {}
+func (*VoucherEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{14}
+}
+func (m *VoucherEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoucherEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherEntry.Merge(m, src)
+}
+func (m *VoucherEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherEntry proto.InternalMessageInfo
+
+func (m *VoucherEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VoucherEntry) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *VoucherEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *VoucherEntry) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("injective.permissions.v1beta1.Action", Action_name, Action_value)
+	proto.RegisterType((*Namespace)(nil), "injective.permissions.v1beta1.Namespace")
+	proto.RegisterType((*ActorRoles)(nil), "injective.permissions.v1beta1.ActorRoles")
+	proto.RegisterType((*RoleActors)(nil), "injective.permissions.v1beta1.RoleActors")
+	proto.RegisterType((*ActorRoleExpiry)(nil), "injective.permissions.v1beta1.ActorRoleExpiry")
+	proto.RegisterType((*ActorQuota)(nil), "injective.permissions.v1beta1.ActorQuota")
+	proto.RegisterType((*ActorQuotaUsage)(nil), "injective.permissions.v1beta1.ActorQuotaUsage")
+	proto.RegisterType((*ActorQuotaUsageBucket)(nil), "injective.permissions.v1beta1.ActorQuotaUsageBucket")
+	proto.RegisterType((*RoleManager)(nil), "injective.permissions.v1beta1.RoleManager")
+	proto.RegisterType((*FrozenAccount)(nil), "injective.permissions.v1beta1.FrozenAccount")
+	proto.RegisterType((*PolicyStatus)(nil), "injective.permissions.v1beta1.PolicyStatus")
+	proto.RegisterType((*Role)(nil), "injective.permissions.v1beta1.Role")
+	proto.RegisterType((*PolicyManagerCapability)(nil), "injective.permissions.v1beta1.PolicyManagerCapability")
+	proto.RegisterType((*RoleIDs)(nil), "injective.permissions.v1beta1.RoleIDs")
 	proto.RegisterType((*AddressVoucher)(nil), "injective.permissions.v1beta1.AddressVoucher")
+	proto.RegisterType((*VoucherEntry)(nil), "injective.permissions.v1beta1.VoucherEntry")
 }
 
 func init() {
@@ -663,62 +1153,90 @@ func init() {
 }
 
 var fileDescriptor_6d25f3ecf3806c6c = []byte{
-	// 871 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x15, 0x25, 0x45, 0x92, 0x47, 0xfe, 0x50, 0x17, 0x46, 0x4c, 0x27, 0x8d, 0x24, 0x28, 0x2d,
-	0xea, 0xa6, 0x8d, 0x84, 0xb8, 0x40, 0xd1, 0x4b, 0x80, 0xca, 0x12, 0xd3, 0xb0, 0xb5, 0x28, 0x75,
-	0x65, 0x07, 0x48, 0x2f, 0xc4, 0x8a, 0x5a, 0xd8, 0x5b, 0x8b, 0x5c, 0x82, 0x4b, 0x0b, 0xd0, 0x4d,
-	0x3d, 0xf7, 0xd2, 0x3f, 0xd1, 0x6b, 0x7f, 0x47, 0x8e, 0x3e, 0x16, 0x3d, 0x18, 0x85, 0x7d, 0xeb,
-	0xaf, 0x28, 0x76, 0xb9, 0x94, 0x18, 0xa0, 0x89, 0x83, 0x9e, 0x34, 0x33, 0x6f, 0xdf, 0xdb, 0x99,
-	0xc7, 0x11, 0x09, 0x1d, 0x16, 0xfc, 0x4c, 0xbd, 0x98, 0xcd, 0x69, 0x27, 0xa4, 0x91, 0xcf, 0x84,
-	0x60, 0x3c, 0x10, 0x9d, 0xf9, 0xb3, 0x09, 0x8d, 0xc9, 0xb3, 0x6c, 0xad, 0x1d, 0x46, 0x3c, 0xe6,
-	0xe8, 0xd1, 0x8a, 0xd0, 0xce, 0x82, 0x9a, 0xf0, 0xa0, 0xee, 0x71, 0xe1, 0x73, 0xd1, 0x99, 0x10,
-	0x41, 0x57, 0x2a, 0x1e, 0x67, 0x41, 0x42, 0x7f, 0xb0, 0x7b, 0xc6, 0xcf, 0xb8, 0x0a, 0x3b, 0x32,
-	0x4a, 0xaa, 0xad, 0x5f, 0x8a, 0xb0, 0xe1, 0x10, 0x9f, 0x8a, 0x90, 0x78, 0x14, 0xed, 0xc2, 0xbd,
-	0x29, 0x0d, 0xb8, 0x6f, 0x1a, 0x4d, 0xe3, 0x60, 0x03, 0x27, 0x09, 0x7a, 0x0c, 0x5b, 0x1e, 0x0f,
-	0xe2, 0x88, 0x78, 0xb1, 0x7b, 0xce, 0xf9, 0x85, 0x99, 0x57, 0xe8, 0x66, 0x5a, 0x7c, 0xc9, 0xf9,
-	0x05, 0x72, 0xa0, 0x16, 0xf1, 0x19, 0x75, 0x33, 0xad, 0x99, 0x85, 0x66, 0xe1, 0xa0, 0x7a, 0xf8,
-	0xb8, 0xfd, 0xde, 0xc6, 0xdb, 0x98, 0xcf, 0x28, 0xde, 0x91, 0xe4, 0xd1, 0x1a, 0x45, 0xdf, 0x43,
-	0x95, 0x78, 0x31, 0x8f, 0x5c, 0x09, 0x08, 0xb3, 0xa8, 0xa4, 0x3e, 0xbf, 0x43, 0xaa, 0x2b, 0x19,
-	0x52, 0x4f, 0x60, 0x20, 0xab, 0x18, 0x0d, 0x61, 0x4b, 0xf5, 0xe6, 0x93, 0x80, 0x9c, 0xd1, 0x48,
-	0x98, 0xf7, 0x94, 0xda, 0x93, 0x0f, 0x68, 0x6c, 0x90, 0x50, 0xf0, 0x66, 0xb4, 0x4e, 0x04, 0x3a,
-	0x81, 0x9d, 0x90, 0xcf, 0x98, 0xb7, 0x70, 0x45, 0x4c, 0xe2, 0x4b, 0x41, 0x85, 0x59, 0x52, 0x92,
-	0x5f, 0xdc, 0x21, 0x39, 0x52, 0xac, 0xb1, 0x22, 0xe1, 0xed, 0x30, 0x93, 0x51, 0x81, 0xe6, 0xf0,
-	0x50, 0xab, 0xea, 0x46, 0x5d, 0x8f, 0x84, 0x64, 0xc2, 0x66, 0x2c, 0x66, 0x54, 0x98, 0x65, 0x75,
-	0xc3, 0xd7, 0x1f, 0x74, 0x83, 0xee, 0xb4, 0x97, 0xf2, 0x17, 0x78, 0x3f, 0xfc, 0x4f, 0x80, 0x51,
-	0xd1, 0xfa, 0x06, 0x60, 0x6d, 0x9c, 0xdc, 0x01, 0x65, 0x5d, 0xba, 0x03, 0x2a, 0x91, 0xd5, 0xe4,
-	0x41, 0xe4, 0x9b, 0x05, 0x59, 0x55, 0x89, 0x64, 0x4a, 0x92, 0x62, 0x0b, 0x84, 0xa0, 0x28, 0xcb,
-	0x9a, 0xa8, 0x62, 0x74, 0x1f, 0x4a, 0x4a, 0x20, 0x25, 0xea, 0xac, 0xf5, 0x1c, 0xaa, 0x19, 0x7b,
-	0x91, 0x09, 0x65, 0x3d, 0xb3, 0x66, 0xa7, 0xe9, 0x3b, 0x2e, 0xfe, 0xd5, 0x80, 0xcd, 0xac, 0x97,
-	0xe8, 0xb9, 0xba, 0x87, 0xf1, 0x40, 0xf1, 0xb7, 0x0f, 0x3f, 0xbd, 0x7b, 0x53, 0x18, 0x0f, 0xb0,
-	0x26, 0xa1, 0x06, 0x54, 0x99, 0x70, 0xa7, 0x4c, 0x90, 0xc9, 0x8c, 0x4e, 0xd5, 0x82, 0x57, 0x30,
-	0x30, 0xd1, 0xd7, 0x15, 0xf4, 0x10, 0x36, 0x98, 0x70, 0x05, 0x25, 0x12, 0x2e, 0x28, 0xb8, 0xc2,
-	0xc4, 0x58, 0xe5, 0xad, 0x53, 0x28, 0xca, 0x61, 0xa4, 0x01, 0x01, 0xf1, 0x57, 0x06, 0xc8, 0x18,
-	0xed, 0x41, 0x59, 0xed, 0x1e, 0x4b, 0x54, 0xb7, 0x70, 0x49, 0xa6, 0xf6, 0x14, 0x35, 0xa1, 0xfa,
-	0xf6, 0x7f, 0x45, 0x82, 0xd9, 0x52, 0xeb, 0x0f, 0x03, 0xf6, 0xde, 0xf1, 0x38, 0xdf, 0x63, 0xd8,
-	0xda, 0x89, 0xfc, 0xff, 0x74, 0xc2, 0x23, 0x41, 0x6a, 0x85, 0x1e, 0x15, 0x3c, 0x12, 0x68, 0x2b,
-	0xd0, 0x3e, 0x54, 0xe4, 0x01, 0x69, 0x85, 0x59, 0x54, 0x68, 0xd9, 0x23, 0x81, 0x74, 0xa2, 0xf5,
-	0x09, 0x94, 0xa5, 0x0f, 0x76, 0x5f, 0xc8, 0x53, 0x7a, 0x6c, 0x61, 0x1a, 0xcd, 0xc2, 0xc1, 0x16,
-	0x2e, 0x27, 0x73, 0x8b, 0xd6, 0xef, 0x06, 0x6c, 0x77, 0xa7, 0xd3, 0x88, 0x0a, 0xf1, 0x8a, 0x5f,
-	0x7a, 0xe7, 0xc9, 0xe3, 0x27, 0x49, 0x25, 0x9d, 0x46, 0xa7, 0x68, 0x01, 0xe5, 0x79, 0x72, 0x48,
-	0x8d, 0x53, 0x3d, 0xdc, 0x6f, 0x27, 0xef, 0xb9, 0xb6, 0x7c, 0xcf, 0xad, 0x86, 0xe8, 0x71, 0x16,
-	0x1c, 0xf5, 0xdf, 0x5c, 0x37, 0x72, 0x7f, 0x5d, 0x37, 0x3e, 0x3b, 0x63, 0xf1, 0xf9, 0xe5, 0xa4,
-	0xed, 0x71, 0xbf, 0xa3, 0x5f, 0x8a, 0xc9, 0xcf, 0x53, 0x31, 0xbd, 0xe8, 0xc4, 0x8b, 0x90, 0x0a,
-	0x45, 0xf8, 0xe7, 0xba, 0xf1, 0x91, 0x16, 0xff, 0x92, 0xfb, 0x2c, 0xa6, 0x7e, 0x18, 0x2f, 0x70,
-	0x7a, 0xdf, 0x93, 0x2b, 0x03, 0x4a, 0x89, 0x39, 0x68, 0x07, 0xaa, 0xa7, 0xce, 0x78, 0x64, 0xf5,
-	0xec, 0x17, 0xb6, 0xd5, 0xaf, 0xe5, 0x50, 0x05, 0x8a, 0x03, 0xdb, 0x39, 0xa9, 0x19, 0xa8, 0x0a,
-	0x65, 0x6c, 0xf5, 0x2c, 0xfb, 0x95, 0x55, 0xcb, 0xcb, 0xf2, 0xd1, 0x29, 0x76, 0x6a, 0x45, 0x19,
-	0x8d, 0x2d, 0xa7, 0x5f, 0xab, 0xa0, 0x6d, 0x80, 0xf1, 0xe9, 0xc8, 0xc2, 0xae, 0x42, 0x6a, 0xe8,
-	0x11, 0xdc, 0x1f, 0x0c, 0xfb, 0xf6, 0x8b, 0xd7, 0xee, 0x68, 0x78, 0x6c, 0xf7, 0x5e, 0xbb, 0x83,
-	0xae, 0xd3, 0xfd, 0xce, 0xc2, 0xe3, 0xda, 0x72, 0xb9, 0xfc, 0x16, 0x7d, 0x0c, 0xbb, 0x1a, 0xee,
-	0x0d, 0x9d, 0x13, 0xdc, 0xed, 0x9d, 0xb8, 0x2f, 0x87, 0xc3, 0x1f, 0x24, 0xb8, 0x34, 0x50, 0x03,
-	0xf6, 0x34, 0x8a, 0x87, 0xc7, 0x96, 0x3b, 0xb2, 0xf0, 0xc0, 0x1e, 0x8f, 0xed, 0xa1, 0xa3, 0xd8,
-	0xcb, 0x7c, 0x86, 0xae, 0x0e, 0x64, 0xb5, 0x97, 0xc5, 0xa3, 0x8b, 0x37, 0x37, 0x75, 0xe3, 0xea,
-	0xa6, 0x6e, 0xfc, 0x7d, 0x53, 0x37, 0x7e, 0xbb, 0xad, 0xe7, 0xae, 0x6e, 0xeb, 0xb9, 0x3f, 0x6f,
-	0xeb, 0xb9, 0x9f, 0x7e, 0xcc, 0x78, 0x66, 0xa7, 0xfb, 0x72, 0x4c, 0x26, 0x62, 0xfd, 0x99, 0x7a,
-	0xea, 0xf1, 0x88, 0x66, 0xd3, 0x73, 0xc2, 0x82, 0x8e, 0xcf, 0xa7, 0x97, 0x33, 0x2a, 0xde, 0xfa,
-	0x86, 0x29, 0x8b, 0x27, 0x25, 0xf5, 0x85, 0xf9, 0xea, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62,
-	0x3a, 0xbf, 0x78, 0xe9, 0x06, 0x00, 0x00,
+	// 1328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x1b, 0xcf, 0xda, 0x8e, 0xed, 0x3c, 0xce, 0x87, 0x99, 0x37, 0xc0, 0x02, 0x6f, 0x3e, 0x64, 0x5e,
+	0xf4, 0xa6, 0x14, 0xec, 0x02, 0x6d, 0xc5, 0x05, 0x09, 0xc7, 0xd9, 0x14, 0x97, 0xc4, 0x0e, 0xe3,
+	0x84, 0x0a, 0x0e, 0x5d, 0x8d, 0x77, 0x87, 0x64, 0x9a, 0xec, 0x8e, 0xbb, 0x33, 0x0e, 0x75, 0x4f,
+	0xb9, 0xf4, 0xd4, 0x4b, 0x4f, 0x95, 0xfa, 0x07, 0xf4, 0xda, 0xbf, 0x83, 0x23, 0xc7, 0xaa, 0x07,
+	0x54, 0xc1, 0xa1, 0x12, 0x7f, 0x45, 0x35, 0xb3, 0xb3, 0xf6, 0xa6, 0x4a, 0x88, 0x4b, 0xd5, 0x93,
+	0xe7, 0xf9, 0xf8, 0x3d, 0xf3, 0x7c, 0x8f, 0x17, 0x6a, 0x2c, 0xfc, 0x8a, 0x7a, 0x92, 0x1d, 0xd2,
+	0x5a, 0x8f, 0x46, 0x01, 0x13, 0x82, 0xf1, 0x50, 0xd4, 0x0e, 0x6f, 0x75, 0xa9, 0x24, 0xb7, 0xd2,
+	0xbc, 0x6a, 0x2f, 0xe2, 0x92, 0xa3, 0x85, 0x21, 0xa0, 0x9a, 0x16, 0x1a, 0xc0, 0xe5, 0x45, 0x8f,
+	0x8b, 0x80, 0x8b, 0x5a, 0x97, 0x08, 0x3a, 0xb4, 0xe2, 0x71, 0x16, 0xc6, 0xf0, 0xcb, 0xf3, 0xbb,
+	0x7c, 0x97, 0xeb, 0x63, 0x4d, 0x9d, 0x62, 0x6e, 0xe5, 0x8f, 0x3c, 0x4c, 0xb5, 0x48, 0x40, 0x45,
+	0x8f, 0x78, 0x14, 0xcd, 0xc3, 0xa4, 0x4f, 0x43, 0x1e, 0xd8, 0xd6, 0xb2, 0xb5, 0x32, 0x85, 0x63,
+	0x02, 0x5d, 0x85, 0x19, 0x8f, 0x87, 0x32, 0x22, 0x9e, 0x74, 0xf7, 0x38, 0xdf, 0xb7, 0x33, 0x5a,
+	0x3a, 0x9d, 0x30, 0x1f, 0x70, 0xbe, 0x8f, 0x5a, 0x50, 0x8e, 0xf8, 0x01, 0x75, 0x53, 0xae, 0xd9,
+	0xd9, 0xe5, 0xec, 0x4a, 0xe9, 0xf6, 0xd5, 0xea, 0x3b, 0x1d, 0xaf, 0x62, 0x7e, 0x40, 0xf1, 0x9c,
+	0x02, 0x6f, 0x8d, 0xa4, 0xe8, 0x73, 0x28, 0x11, 0x4f, 0xf2, 0xc8, 0x55, 0x02, 0x61, 0xe7, 0xb4,
+	0xa9, 0x0f, 0xce, 0x30, 0x55, 0x57, 0x08, 0x65, 0x4f, 0x60, 0x20, 0xc3, 0x33, 0x6a, 0xc3, 0x8c,
+	0xf6, 0x2d, 0x20, 0x21, 0xd9, 0xa5, 0x91, 0xb0, 0x27, 0xb5, 0xb5, 0xeb, 0x63, 0x38, 0xb6, 0x19,
+	0x43, 0xf0, 0x74, 0x34, 0x22, 0x04, 0xda, 0x86, 0xb9, 0x1e, 0x3f, 0x60, 0xde, 0xc0, 0x15, 0x92,
+	0xc8, 0xbe, 0xa0, 0xc2, 0xce, 0x6b, 0x93, 0x1f, 0x9e, 0x61, 0x72, 0x4b, 0xa3, 0x3a, 0x1a, 0x84,
+	0x67, 0x7b, 0x29, 0x8a, 0x0a, 0x74, 0x08, 0x57, 0x8c, 0x55, 0xe3, 0xa8, 0xeb, 0x91, 0x1e, 0xe9,
+	0xb2, 0x03, 0x26, 0x19, 0x15, 0x76, 0x41, 0xdf, 0xf0, 0xe9, 0x58, 0x37, 0x18, 0x4f, 0x1b, 0x09,
+	0x7e, 0x80, 0x2f, 0xf5, 0x4e, 0x14, 0x30, 0x2a, 0xd0, 0x97, 0xf0, 0x9f, 0x51, 0xaa, 0x5d, 0xfa,
+	0x4d, 0x8f, 0x45, 0xea, 0xbe, 0xa2, 0xbe, 0xaf, 0x3a, 0x6e, 0xca, 0x1d, 0x85, 0x1b, 0xe0, 0x73,
+	0xe4, 0x18, 0x43, 0xd9, 0xdf, 0x80, 0xe9, 0xd8, 0xfe, 0xd7, 0x7d, 0x2e, 0x89, 0xb0, 0xa7, 0xc6,
+	0xaf, 0xe5, 0x23, 0x85, 0xc0, 0x25, 0x32, 0x3c, 0x0b, 0xb4, 0x03, 0x73, 0xcf, 0x22, 0xfe, 0x2d,
+	0x0d, 0x5d, 0xe2, 0x79, 0xbc, 0x1f, 0x4a, 0x61, 0x83, 0x36, 0x78, 0xe3, 0x0c, 0x83, 0xeb, 0x1a,
+	0x55, 0x8f, 0x41, 0x78, 0xf6, 0x59, 0x9a, 0x14, 0xe8, 0x1a, 0xcc, 0x1e, 0xf2, 0xbe, 0xb7, 0x47,
+	0xa3, 0x38, 0x03, 0x03, 0xbb, 0xb4, 0x6c, 0xad, 0x64, 0xf1, 0x8c, 0xe1, 0xc6, 0xe1, 0xa1, 0xbb,
+	0x60, 0x27, 0x6a, 0x11, 0xf5, 0xf8, 0x21, 0x8d, 0x06, 0x2e, 0xf1, 0xfd, 0x88, 0x0a, 0x61, 0x4f,
+	0xeb, 0xb1, 0xb8, 0x60, 0xe4, 0xd8, 0x88, 0xeb, 0xb1, 0xb4, 0x72, 0x17, 0x60, 0xd4, 0x9e, 0x6a,
+	0xd2, 0x74, 0x50, 0xc9, 0xa4, 0x69, 0x42, 0x71, 0xe3, 0x76, 0xcf, 0x2c, 0x67, 0x15, 0x57, 0x13,
+	0x95, 0x1f, 0x2d, 0x00, 0x85, 0xd2, 0x70, 0x81, 0x10, 0xe4, 0x14, 0xdf, 0x20, 0xf5, 0x19, 0x5d,
+	0x80, 0xbc, 0xb6, 0x90, 0x20, 0x0d, 0x85, 0x3e, 0x82, 0x79, 0x1d, 0x0d, 0x15, 0x2e, 0x91, 0xae,
+	0x64, 0x01, 0x15, 0x92, 0x04, 0x3d, 0x3b, 0xab, 0x63, 0x43, 0x46, 0x56, 0x97, 0xdb, 0x89, 0x04,
+	0x5d, 0x87, 0x73, 0x29, 0xc4, 0x1e, 0x65, 0xbb, 0x7b, 0xd2, 0xce, 0x69, 0xf5, 0xb9, 0xa1, 0xfa,
+	0x03, 0xcd, 0xae, 0xfc, 0x64, 0xc1, 0xdc, 0x5f, 0xea, 0x7f, 0x4a, 0x60, 0x89, 0xcf, 0x99, 0x94,
+	0xcf, 0xff, 0xae, 0x6f, 0xbf, 0x58, 0x26, 0xdf, 0xba, 0x6d, 0x4e, 0x71, 0xeb, 0x9e, 0x4e, 0x1b,
+	0xe3, 0xa1, 0x76, 0x6c, 0xf6, 0xf6, 0xb5, 0xb3, 0x7b, 0x92, 0xf1, 0x10, 0x1b, 0x10, 0xba, 0x03,
+	0x93, 0x07, 0x2c, 0x60, 0x52, 0xbb, 0x3c, 0xb5, 0xba, 0xf0, 0xe2, 0xd5, 0xd2, 0xc4, 0x6f, 0xaf,
+	0x96, 0xce, 0xc7, 0x9b, 0x58, 0xf8, 0xfb, 0x55, 0xc6, 0x6b, 0x01, 0x91, 0x7b, 0xd5, 0x66, 0x28,
+	0x71, 0xac, 0xab, 0x4a, 0xf5, 0x9c, 0x85, 0x3e, 0x7f, 0x6e, 0x3c, 0x37, 0x54, 0xe5, 0xbb, 0x0c,
+	0xcc, 0x8d, 0x1c, 0xde, 0x11, 0x64, 0xf7, 0xb4, 0x7d, 0x3c, 0x8c, 0x25, 0x73, 0x72, 0x2c, 0xd9,
+	0xf7, 0x89, 0xe5, 0x16, 0xe4, 0xfa, 0x82, 0xfa, 0x76, 0x6e, 0x9c, 0x50, 0xb4, 0x2a, 0xda, 0x86,
+	0x42, 0xb7, 0xef, 0xed, 0x53, 0x99, 0x2c, 0xd4, 0x8f, 0xc7, 0x1e, 0x69, 0x1d, 0xde, 0xaa, 0x06,
+	0xaf, 0xe6, 0xd4, 0x5d, 0x38, 0x31, 0x55, 0xf1, 0xe1, 0xfc, 0x89, 0x7a, 0x2a, 0x6c, 0x21, 0x49,
+	0x24, 0x75, 0x32, 0xb2, 0x38, 0x26, 0xd0, 0x27, 0x90, 0x27, 0x81, 0x1a, 0x61, 0x3b, 0x33, 0x8e,
+	0xe7, 0x46, 0xb9, 0x72, 0x0f, 0x4a, 0xa9, 0xf5, 0x8e, 0x6c, 0x28, 0x98, 0x9d, 0x6b, 0x52, 0x9d,
+	0x90, 0xa7, 0x8c, 0xe4, 0x2e, 0xcc, 0x1c, 0x5b, 0x27, 0xca, 0x40, 0xb2, 0x06, 0x8c, 0x01, 0x43,
+	0xa2, 0x25, 0x28, 0x45, 0x94, 0x08, 0x1e, 0xba, 0x1e, 0xf7, 0xe3, 0x09, 0x98, 0xc1, 0x10, 0xb3,
+	0x1a, 0xdc, 0xa7, 0xe8, 0x0a, 0x4c, 0x25, 0x0b, 0x4d, 0x9a, 0xe6, 0x2f, 0x9a, 0xe5, 0x24, 0x2b,
+	0xdf, 0x5b, 0x30, 0x9d, 0x7e, 0x34, 0x52, 0x65, 0xb6, 0xde, 0xa7, 0xcc, 0x4b, 0x50, 0x62, 0xc2,
+	0xf5, 0x99, 0x20, 0xdd, 0x03, 0xea, 0x6b, 0x6f, 0x8a, 0x18, 0x98, 0x58, 0x33, 0x1c, 0xe5, 0x0d,
+	0x13, 0xae, 0xa0, 0x44, 0x89, 0xb3, 0x5a, 0x5c, 0x64, 0xa2, 0xa3, 0xe9, 0xca, 0x0e, 0xe4, 0x54,
+	0xd6, 0xd4, 0x38, 0x87, 0x24, 0x18, 0xae, 0x20, 0x75, 0x46, 0x17, 0xa1, 0xa0, 0xdf, 0x0f, 0xe6,
+	0x9b, 0x18, 0xf3, 0x8a, 0x6c, 0xfa, 0x68, 0x19, 0x4a, 0xc7, 0xff, 0x14, 0x28, 0x61, 0x9a, 0xa5,
+	0x66, 0xf5, 0xe2, 0x29, 0xef, 0xd6, 0x3b, 0x2a, 0xf3, 0x0f, 0x87, 0x77, 0x09, 0x4a, 0x1e, 0x09,
+	0x93, 0x54, 0x98, 0x50, 0xc1, 0x23, 0xa1, 0x49, 0x05, 0xba, 0x04, 0x45, 0xa5, 0xa0, 0x52, 0xa1,
+	0xa7, 0xa2, 0x88, 0x0b, 0x1e, 0x09, 0x55, 0x26, 0x2a, 0xff, 0x83, 0x82, 0xca, 0x43, 0x73, 0x4d,
+	0x28, 0x2d, 0x13, 0xb6, 0xaa, 0x7c, 0x76, 0x65, 0x06, 0x17, 0xe2, 0xb8, 0x45, 0xe5, 0x67, 0x0b,
+	0x66, 0xcd, 0xf6, 0x7f, 0x1c, 0xbf, 0x09, 0xef, 0x68, 0x93, 0x01, 0x14, 0xcc, 0xc3, 0xa1, 0xc3,
+	0x29, 0xdd, 0xbe, 0x54, 0x8d, 0x3b, 0xb8, 0xaa, 0xfe, 0xd0, 0x0d, 0x83, 0x68, 0x70, 0x16, 0xae,
+	0xae, 0x99, 0x1e, 0xff, 0xff, 0x2e, 0x93, 0x7b, 0xfd, 0x6e, 0xd5, 0xe3, 0x41, 0xcd, 0xfc, 0xfb,
+	0x8b, 0x7f, 0x6e, 0x0a, 0x7f, 0xbf, 0x26, 0x07, 0x3d, 0x2a, 0x34, 0xe0, 0xed, 0xab, 0xa5, 0x73,
+	0xc6, 0xf8, 0x0d, 0x1e, 0x30, 0x49, 0x83, 0x9e, 0x1c, 0xe0, 0xe4, 0x3e, 0x95, 0xfe, 0x69, 0xe3,
+	0xa0, 0x13, 0xca, 0x78, 0x87, 0x9f, 0xb0, 0x76, 0x2e, 0x43, 0x31, 0xa2, 0x1e, 0x65, 0x87, 0x34,
+	0xd9, 0x3c, 0x43, 0x5a, 0x2d, 0x35, 0x41, 0x43, 0x9f, 0x46, 0xf1, 0x2a, 0xc4, 0x86, 0x4a, 0x4d,
+	0x67, 0xee, 0x6f, 0x4c, 0x27, 0x5a, 0x00, 0x18, 0x2d, 0x7a, 0x7b, 0x52, 0xcf, 0xc4, 0xd4, 0x70,
+	0xc3, 0x5f, 0x7f, 0x6b, 0x41, 0x3e, 0xae, 0x26, 0x9a, 0x83, 0xd2, 0x4e, 0xab, 0xb3, 0xe5, 0x34,
+	0x9a, 0xeb, 0x4d, 0x67, 0xad, 0x3c, 0x81, 0x8a, 0x90, 0xdb, 0x6c, 0xb6, 0xb6, 0xcb, 0x16, 0x2a,
+	0x41, 0x01, 0x3b, 0x0d, 0xa7, 0xf9, 0xd8, 0x29, 0x67, 0x14, 0x7b, 0x75, 0x07, 0xb7, 0xca, 0x39,
+	0x75, 0xea, 0x38, 0xad, 0xb5, 0x72, 0x11, 0xcd, 0x02, 0x74, 0x76, 0xb6, 0x1c, 0xec, 0x6a, 0x49,
+	0x19, 0x01, 0xe4, 0xd7, 0xb1, 0xe3, 0x3c, 0x75, 0xca, 0xcb, 0x68, 0x1a, 0x8a, 0x8d, 0x8d, 0xfa,
+	0x17, 0xab, 0xf5, 0xc6, 0xc3, 0xf2, 0x7d, 0xb4, 0x00, 0x17, 0x36, 0xdb, 0x6b, 0xcd, 0xf5, 0x27,
+	0xee, 0x56, 0x7b, 0xa3, 0xd9, 0x78, 0xe2, 0x6e, 0xd6, 0x5b, 0xf5, 0xcf, 0x1c, 0xdc, 0x29, 0x1f,
+	0x1d, 0x1d, 0xdd, 0x47, 0xff, 0x85, 0x79, 0x23, 0x6e, 0xb4, 0x5b, 0xdb, 0xb8, 0xde, 0xd8, 0x76,
+	0x1f, 0xb4, 0xdb, 0x0f, 0x95, 0xf0, 0xc8, 0x42, 0x4b, 0x70, 0xd1, 0x48, 0x71, 0x7b, 0xc3, 0x71,
+	0xb7, 0x1c, 0xbc, 0xd9, 0xec, 0x74, 0x9a, 0xed, 0x96, 0x46, 0x1f, 0x65, 0x52, 0x70, 0xad, 0x90,
+	0xb6, 0x7d, 0x94, 0x5b, 0xdd, 0x7f, 0xf1, 0x7a, 0xd1, 0x7a, 0xf9, 0x7a, 0xd1, 0xfa, 0xfd, 0xf5,
+	0xa2, 0xf5, 0xc3, 0x9b, 0xc5, 0x89, 0x97, 0x6f, 0x16, 0x27, 0x7e, 0x7d, 0xb3, 0x38, 0xf1, 0xf4,
+	0x51, 0xaa, 0xfc, 0xcd, 0xa4, 0xf5, 0x37, 0x48, 0x57, 0x8c, 0x3e, 0x2d, 0x6e, 0x7a, 0x3c, 0xa2,
+	0x69, 0x72, 0x8f, 0xb0, 0xb0, 0x16, 0x70, 0xbf, 0x7f, 0x40, 0xc5, 0xb1, 0xef, 0x0e, 0xdd, 0x2d,
+	0xdd, 0xbc, 0xfe, 0x2a, 0xb8, 0xf3, 0xe7, 0x00, 0xd4, 0x78, 0x7b, 0x78, 0x9d, 0x0c, 0x00, 0x00,
 }
 
 func (m *Namespace) Marshal() (dAtA []byte, err error) {
@@ -741,6 +1259,60 @@ func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoucherRecoveryAddress) > 0 {
+		i -= len(m.VoucherRecoveryAddress)
+		copy(dAtA[i:], m.VoucherRecoveryAddress)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.VoucherRecoveryAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.VoucherExpiry != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.VoucherExpiry))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ActorQuotas) > 0 {
+		for iNdEx := len(m.ActorQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ActorRoleExpiries) > 0 {
+		for iNdEx := len(m.ActorRoleExpiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorRoleExpiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PolicyManagerCapabilities) > 0 {
 		for iNdEx := len(m.PolicyManagerCapabilities) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -887,6 +1459,16 @@ func (m *RoleActors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAtTimestamp != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpiresAtTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Actors) > 0 {
 		for iNdEx := len(m.Actors) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Actors[iNdEx])
@@ -906,7 +1488,7 @@ func (m *RoleActors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RoleManager) Marshal() (dAtA []byte, err error) {
+func (m *ActorRoleExpiry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -916,36 +1498,44 @@ func (m *RoleManager) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RoleManager) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorRoleExpiry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RoleManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorRoleExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Roles[iNdEx])
-			copy(dAtA[i:], m.Roles[iNdEx])
-			i = encodeVarintPermissions(dAtA, i, uint64(len(m.Roles[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Manager)))
+	if m.ExpiresAtTimestamp != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpiresAtTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Actor)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PolicyStatus) Marshal() (dAtA []byte, err error) {
+func (m *ActorQuota) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -955,45 +1545,47 @@ func (m *PolicyStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PolicyStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorQuota) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PolicyStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsSealed {
+	if m.Window != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Window))
 		i--
-		if m.IsSealed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if m.IsDisabled {
-		i--
-		if m.IsDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x10
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Action != 0 {
 		i = encodeVarintPermissions(dAtA, i, uint64(m.Action))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Role) Marshal() (dAtA []byte, err error) {
+func (m *ActorQuotaUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1003,37 +1595,63 @@ func (m *Role) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Permissions != 0 {
-		i = encodeVarintPermissions(dAtA, i, uint64(m.Permissions))
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Action != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Action))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.RoleId != 0 {
-		i = encodeVarintPermissions(dAtA, i, uint64(m.RoleId))
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Actor)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Name)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PolicyManagerCapability) Marshal() (dAtA []byte, err error) {
+func (m *ActorQuotaUsageBucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1043,52 +1661,35 @@ func (m *PolicyManagerCapability) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PolicyManagerCapability) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorQuotaUsageBucket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PolicyManagerCapability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorQuotaUsageBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CanSeal {
-		i--
-		if m.CanSeal {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.CanDisable {
-		i--
-		if m.CanDisable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Action != 0 {
-		i = encodeVarintPermissions(dAtA, i, uint64(m.Action))
-		i--
-		dAtA[i] = 0x10
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Manager)))
+	i--
+	dAtA[i] = 0x12
+	if m.Start != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Start))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RoleIDs) Marshal() (dAtA []byte, err error) {
+func (m *RoleManager) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1098,38 +1699,36 @@ func (m *RoleIDs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RoleIDs) MarshalTo(dAtA []byte) (int, error) {
+func (m *RoleManager) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RoleIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RoleManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RoleIds) > 0 {
-		dAtA2 := make([]byte, len(m.RoleIds)*10)
-		var j1 int
-		for _, num := range m.RoleIds {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintPermissions(dAtA, i, uint64(j1))
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Manager)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddressVoucher) Marshal() (dAtA []byte, err error) {
+func (m *FrozenAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1139,26 +1738,26 @@ func (m *AddressVoucher) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddressVoucher) MarshalTo(dAtA []byte) (int, error) {
+func (m *FrozenAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddressVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FrozenAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Voucher.Size()
-		i -= size
-		if _, err := m.Voucher.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	if m.FrozenAt != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.FrozenAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReasonCode != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -1169,218 +1768,1518 @@ func (m *AddressVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPermissions(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PolicyStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Namespace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PolicyStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
-	}
-	l = len(m.ContractHook)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
-	}
-	if len(m.RolePermissions) > 0 {
-		for _, e := range m.RolePermissions {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
-		}
-	}
-	if len(m.ActorRoles) > 0 {
-		for _, e := range m.ActorRoles {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
-		}
-	}
-	if len(m.RoleManagers) > 0 {
-		for _, e := range m.RoleManagers {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
+	if m.IsSealed {
+		i--
+		if m.IsSealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.PolicyStatuses) > 0 {
-		for _, e := range m.PolicyStatuses {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
+	if m.IsDisabled {
+		i--
+		if m.IsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.PolicyManagerCapabilities) > 0 {
-		for _, e := range m.PolicyManagerCapabilities {
-			l = e.Size()
-			n += 1 + l + sovPermissions(uint64(l))
-		}
+	if m.Action != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActorRoles) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Actor)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
-	}
-	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovPermissions(uint64(l))
-		}
+func (m *Role) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RoleActors) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
+	if m.Permissions != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Permissions))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Actors) > 0 {
-		for _, s := range m.Actors {
-			l = len(s)
-			n += 1 + l + sovPermissions(uint64(l))
-		}
+	if m.RoleId != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.RoleId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RoleManager) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PolicyManagerCapability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyManagerCapability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyManagerCapability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Manager)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
-	}
-	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovPermissions(uint64(l))
+	if m.CanSeal {
+		i--
+		if m.CanSeal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *PolicyStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CanDisable {
+		i--
+		if m.CanDisable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
 	if m.Action != 0 {
-		n += 1 + sovPermissions(uint64(m.Action))
-	}
-	if m.IsDisabled {
-		n += 2
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.IsSealed {
-		n += 2
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Role) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RoleIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *RoleIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Name)
+	if len(m.RoleIds) > 0 {
+		dAtA2 := make([]byte, len(m.RoleIds)*10)
+		var j1 int
+		for _, num := range m.RoleIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPermissions(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Voucher.Size()
+		i -= size
+		if _, err := m.Voucher.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoucherEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPermissions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Namespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovPermissions(uint64(l))
 	}
-	if m.RoleId != 0 {
-		n += 1 + sovPermissions(uint64(m.RoleId))
+	l = len(m.ContractHook)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
 	}
-	if m.Permissions != 0 {
-		n += 1 + sovPermissions(uint64(m.Permissions))
+	if len(m.RolePermissions) > 0 {
+		for _, e := range m.RolePermissions {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.ActorRoles) > 0 {
+		for _, e := range m.ActorRoles {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.RoleManagers) > 0 {
+		for _, e := range m.RoleManagers {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.PolicyStatuses) > 0 {
+		for _, e := range m.PolicyStatuses {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.PolicyManagerCapabilities) > 0 {
+		for _, e := range m.PolicyManagerCapabilities {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.ActorRoleExpiries) > 0 {
+		for _, e := range m.ActorRoleExpiries {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.ActorQuotas) > 0 {
+		for _, e := range m.ActorQuotas {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if m.VoucherExpiry != 0 {
+		n += 1 + sovPermissions(uint64(m.VoucherExpiry))
+	}
+	l = len(m.VoucherRecoveryAddress)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
 	}
 	return n
 }
 
-func (m *PolicyManagerCapability) Size() (n int) {
+func (m *ActorRoles) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Manager)
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleActors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.Actors) > 0 {
+		for _, s := range m.Actors {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if m.ExpiresAtTimestamp != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpiresAtTimestamp))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *ActorRoleExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.ExpiresAtTimestamp != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpiresAtTimestamp))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *ActorQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actor)
 	if l > 0 {
 		n += 1 + l + sovPermissions(uint64(l))
 	}
 	if m.Action != 0 {
 		n += 1 + sovPermissions(uint64(m.Action))
 	}
-	if m.CanDisable {
-		n += 2
-	}
-	if m.CanSeal {
-		n += 2
+	l = m.Limit.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovPermissions(uint64(m.Window))
 	}
 	return n
 }
 
-func (m *RoleIDs) Size() (n int) {
+func (m *ActorQuotaUsage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RoleIds) > 0 {
-		l = 0
-		for _, e := range m.RoleIds {
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovPermissions(uint64(m.Action))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActorQuotaUsageBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovPermissions(uint64(m.Start))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
+func (m *RoleManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrozenAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovPermissions(uint64(m.ReasonCode))
+	}
+	if m.FrozenAt != 0 {
+		n += 1 + sovPermissions(uint64(m.FrozenAt))
+	}
+	return n
+}
+
+func (m *PolicyStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovPermissions(uint64(m.Action))
+	}
+	if m.IsDisabled {
+		n += 2
+	}
+	if m.IsSealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.RoleId != 0 {
+		n += 1 + sovPermissions(uint64(m.RoleId))
+	}
+	if m.Permissions != 0 {
+		n += 1 + sovPermissions(uint64(m.Permissions))
+	}
+	return n
+}
+
+func (m *PolicyManagerCapability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovPermissions(uint64(m.Action))
+	}
+	if m.CanDisable {
+		n += 2
+	}
+	if m.CanSeal {
+		n += 2
+	}
+	return n
+}
+
+func (m *RoleIDs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleIds) > 0 {
+		l = 0
+		for _, e := range m.RoleIds {
 			l += sovPermissions(uint64(e))
 		}
-		n += 1 + sovPermissions(uint64(l)) + l
+		n += 1 + sovPermissions(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AddressVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = m.Voucher.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
+func (m *VoucherEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovPermissions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPermissions(x uint64) (n int) {
+	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Namespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolePermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RolePermissions = append(m.RolePermissions, &Role{})
+			if err := m.RolePermissions[len(m.RolePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorRoles = append(m.ActorRoles, &ActorRoles{})
+			if err := m.ActorRoles[len(m.ActorRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleManagers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleManagers = append(m.RoleManagers, &RoleManager{})
+			if err := m.RoleManagers[len(m.RoleManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyStatuses = append(m.PolicyStatuses, &PolicyStatus{})
+			if err := m.PolicyStatuses[len(m.PolicyStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyManagerCapabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyManagerCapabilities = append(m.PolicyManagerCapabilities, &PolicyManagerCapability{})
+			if err := m.PolicyManagerCapabilities[len(m.PolicyManagerCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorRoleExpiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorRoleExpiries = append(m.ActorRoleExpiries, &ActorRoleExpiry{})
+			if err := m.ActorRoleExpiries[len(m.ActorRoleExpiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorQuotas = append(m.ActorQuotas, &ActorQuota{})
+			if err := m.ActorQuotas[len(m.ActorQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, &FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherExpiry", wireType)
+			}
+			m.VoucherExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoucherExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherRecoveryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherRecoveryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleActors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleActors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleActors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actors = append(m.Actors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtTimestamp", wireType)
+			}
+			m.ExpiresAtTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorRoleExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorRoleExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorRoleExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtTimestamp", wireType)
+			}
+			m.ExpiresAtTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *AddressVoucher) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPermissions(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Voucher.Size()
-	n += 1 + l + sovPermissions(uint64(l))
-	return n
-}
-
-func sovPermissions(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPermissions(x uint64) (n int) {
-	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Namespace) Unmarshal(dAtA []byte) error {
+func (m *ActorQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,15 +3302,15 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1439,11 +3338,30 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1471,13 +3389,15 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractHook = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolePermissions", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var msglen int
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -1487,31 +3407,66 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Window |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPermissions
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPermissions
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RolePermissions = append(m.RolePermissions, &Role{})
-			if err := m.RolePermissions[len(m.RolePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorQuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorQuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorRoles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -1521,31 +3476,29 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPermissions
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPermissions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorRoles = append(m.ActorRoles, &ActorRoles{})
-			if err := m.ActorRoles[len(m.ActorRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -1555,31 +3508,48 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPermissions
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPermissions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleManagers = append(m.RoleManagers, &RoleManager{})
-			if err := m.RoleManagers[len(m.RoleManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -1589,29 +3559,29 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPermissions
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPermissions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyStatuses = append(m.PolicyStatuses, &PolicyStatus{})
-			if err := m.PolicyStatuses[len(m.PolicyStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyManagerCapabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1638,8 +3608,8 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyManagerCapabilities = append(m.PolicyManagerCapabilities, &PolicyManagerCapability{})
-			if err := m.PolicyManagerCapabilities[len(m.PolicyManagerCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, ActorQuotaUsageBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1664,7 +3634,7 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActorRoles) Unmarshal(dAtA []byte) error {
+func (m *ActorQuotaUsageBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,17 +3657,17 @@ func (m *ActorRoles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActorRoles: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorQuotaUsageBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActorRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorQuotaUsageBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -1707,27 +3677,14 @@ func (m *ActorRoles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Start |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Actor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,7 +3712,9 @@ func (m *ActorRoles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1778,7 +3737,7 @@ func (m *ActorRoles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleActors) Unmarshal(dAtA []byte) error {
+func (m *RoleManager) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1801,15 +3760,15 @@ func (m *RoleActors) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleActors: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleManager: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleActors: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleManager: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1837,11 +3796,11 @@ func (m *RoleActors) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1869,7 +3828,7 @@ func (m *RoleActors) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actors = append(m.Actors, string(dAtA[iNdEx:postIndex]))
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1892,7 +3851,7 @@ func (m *RoleActors) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleManager) Unmarshal(dAtA []byte) error {
+func (m *FrozenAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1915,15 +3874,15 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleManager: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrozenAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleManager: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrozenAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1951,13 +3910,13 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
 			}
-			var stringLen uint64
+			m.ReasonCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -1967,24 +3926,30 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReasonCode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPermissions
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FrozenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -2617,6 +4582,205 @@ func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoucherEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoucherEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoucherEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPermissions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
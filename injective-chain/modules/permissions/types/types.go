@@ -1,23 +1,33 @@
 package types
 
 import (
+	"slices"
 	"sort"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	EVERYONE = "EVERYONE"
-	MaxPerm  = uint32(Action_MINT) | uint32(Action_RECEIVE) | uint32(Action_BURN) | uint32(Action_SEND) | uint32(Action_SUPER_BURN)
+	MaxPerm  = uint32(Action_MINT) | uint32(Action_RECEIVE) | uint32(Action_BURN) | uint32(Action_SEND) | uint32(Action_SUPER_BURN) | uint32(Action_FREEZE) | uint32(Action_CLAWBACK)
 	// ValidActionsBitmask calculates the valid bitmask for all actions.
-	ValidActionsBitmask uint32 = uint32(Action_MINT | Action_RECEIVE | Action_BURN | Action_SEND | Action_SUPER_BURN | Action_MODIFY_POLICY_MANAGERS | Action_MODIFY_CONTRACT_HOOK | Action_MODIFY_ROLE_PERMISSIONS | Action_MODIFY_ROLE_MANAGERS)
+	ValidActionsBitmask uint32 = uint32(Action_MINT | Action_RECEIVE | Action_BURN | Action_SEND | Action_SUPER_BURN | Action_FREEZE | Action_CLAWBACK | Action_MODIFY_POLICY_MANAGERS | Action_MODIFY_CONTRACT_HOOK | Action_MODIFY_ROLE_PERMISSIONS | Action_MODIFY_ROLE_MANAGERS)
 	// DisallowedEveryoneActions calculates the disallowed actions for the everyone role.
-	DisallowedEveryoneActions = uint32(Action_MINT | Action_SUPER_BURN | Action_MODIFY_POLICY_MANAGERS | Action_MODIFY_CONTRACT_HOOK | Action_MODIFY_ROLE_PERMISSIONS | Action_MODIFY_ROLE_MANAGERS)
+	DisallowedEveryoneActions = uint32(Action_MINT | Action_SUPER_BURN | Action_FREEZE | Action_CLAWBACK | Action_MODIFY_POLICY_MANAGERS | Action_MODIFY_CONTRACT_HOOK | Action_MODIFY_ROLE_PERMISSIONS | Action_MODIFY_ROLE_MANAGERS)
 	MaxRoleNameLength         = 20
+	// QuotaWindowBuckets is the number of buckets a quota window is split into to account the usage over a sliding
+	// window
+	QuotaWindowBuckets = 24
+	// MaxExpiredVouchersPerBlock is the maximum number of expired voucher entries processed in an EndBlocker
+	MaxExpiredVouchersPerBlock = 100
 )
 
+// QuotaActions are the actions whose amounts can be limited by actor quotas
+var QuotaActions = []Action{Action_MINT, Action_RECEIVE, Action_BURN, Action_SEND, Action_SUPER_BURN}
+
 var Actions = []Action{
-	Action_MINT, Action_RECEIVE, Action_BURN, Action_SEND, Action_SUPER_BURN,
+	Action_MINT, Action_RECEIVE, Action_BURN, Action_SEND, Action_SUPER_BURN, Action_FREEZE, Action_CLAWBACK,
 	Action_MODIFY_POLICY_MANAGERS, Action_MODIFY_CONTRACT_HOOK, Action_MODIFY_ROLE_PERMISSIONS, Action_MODIFY_ROLE_MANAGERS,
 }
 
@@ -137,3 +147,96 @@ func NewRoleActors(role string, actors ...sdk.AccAddress) *RoleActors {
 		Actors: actorStrings,
 	}
 }
+
+// IsExpired returns true if the role has expired at the given block time (in seconds) or height
+func (e *ActorRoleExpiry) IsExpired(blockTime, blockHeight int64) bool {
+	if e.ExpiresAtTimestamp > 0 && blockTime >= e.ExpiresAtTimestamp {
+		return true
+	}
+	return e.ExpiresAtHeight > 0 && blockHeight >= e.ExpiresAtHeight
+}
+
+// IsExpired returns true if the role granted to the actors has expired at the given block time (in seconds) or height
+func (r *RoleActors) IsExpired(blockTime, blockHeight int64) bool {
+	expiry := ActorRoleExpiry{ExpiresAtTimestamp: r.ExpiresAtTimestamp, ExpiresAtHeight: r.ExpiresAtHeight}
+	return expiry.IsExpired(blockTime, blockHeight)
+}
+
+// IsLifetime returns true if the quota applies to the whole lifetime of the actor rather than a rolling window
+func (q *ActorQuota) IsLifetime() bool {
+	return q.Window == 0
+}
+
+// BucketDuration returns the duration in seconds of the buckets the quota window is split into
+func (q *ActorQuota) BucketDuration() int64 {
+	return max((q.Window+QuotaWindowBuckets-1)/QuotaWindowBuckets, 1)
+}
+
+// BucketStart returns the start of the bucket the block time falls into
+func (q *ActorQuota) BucketStart(blockTime int64) int64 {
+	return blockTime - blockTime%q.BucketDuration()
+}
+
+// IsBucketExpired returns true if the bucket started at bucketStart no longer overlaps the window ending at the block
+// time. A bucket is only released once all of it is outside the window, so no window ever exceeds the limit.
+func (q *ActorQuota) IsBucketExpired(bucketStart, blockTime int64) bool {
+	return bucketStart+q.BucketDuration() <= blockTime-q.Window
+}
+
+// Remaining returns the amount the actor can still use given the amount already used within the window
+func (q *ActorQuota) Remaining(used math.Int) math.Int {
+	if used.GTE(q.Limit) {
+		return math.ZeroInt()
+	}
+	return q.Limit.Sub(used)
+}
+
+// PruneBuckets removes the buckets outside the window of the quota ending at the block time, and updates the amount
+// used within the window accordingly
+func (u *ActorQuotaUsage) PruneBuckets(quota *ActorQuota, blockTime int64) {
+	if quota.IsLifetime() {
+		return
+	}
+
+	u.Buckets = slices.DeleteFunc(u.Buckets, func(b ActorQuotaUsageBucket) bool {
+		return quota.IsBucketExpired(b.Start, blockTime)
+	})
+
+	u.Used = math.ZeroInt()
+	for _, b := range u.Buckets {
+		u.Used = u.Used.Add(b.Amount)
+	}
+}
+
+// AddUsage records the amount used at the block time
+func (u *ActorQuotaUsage) AddUsage(quota *ActorQuota, blockTime int64, amount math.Int) {
+	u.Used = u.Used.Add(amount)
+	if quota.IsLifetime() {
+		return
+	}
+
+	bucketStart := quota.BucketStart(blockTime)
+	if last := len(u.Buckets) - 1; last >= 0 && u.Buckets[last].Start == bucketStart {
+		u.Buckets[last].Amount = u.Buckets[last].Amount.Add(amount)
+		return
+	}
+
+	u.Buckets = append(u.Buckets, ActorQuotaUsageBucket{
+		Start:  bucketStart,
+		Amount: amount,
+	})
+}
+
+func NewActorQuotaStatus(quota *ActorQuota, usage *ActorQuotaUsage) ActorQuotaStatus {
+	status := ActorQuotaStatus{
+		Quota:     *quota,
+		Used:      usage.Used,
+		Remaining: quota.Remaining(usage.Used),
+	}
+
+	if !quota.IsLifetime() && len(usage.Buckets) > 0 {
+		status.NextReleaseAt = usage.Buckets[0].Start + quota.BucketDuration() + quota.Window
+	}
+
+	return status
+}
@@ -0,0 +1,10 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker runs on every begin block
+func (am AppModule) BeginBlocker(ctx sdk.Context) {
+	am.keeper.ProcessMintingSchedules(ctx)
+}
@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomSupplyCap(),
+		GetCmdDenomMintingSchedules(),
+		GetCmdDenomMinters(),
 	)
 
 	return cmd
@@ -118,3 +121,108 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomSupplyCap returns the supply cap of a queried denom
+func GetCmdDenomSupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-cap [denom] [flags]",
+		Short: "Get the supply cap, current supply and scheduled supply of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			creator, subDenom, err := types.DeconstructDenom(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomSupplyCap(cmd.Context(), &types.QueryDenomSupplyCapRequest{
+				Creator:  creator,
+				SubDenom: subDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomMintingSchedules returns the minting schedules of a queried denom
+func GetCmdDenomMintingSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minting-schedules [denom] [flags]",
+		Short: "Get the minting schedules of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			creator, subDenom, err := types.DeconstructDenom(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomMintingSchedules(cmd.Context(), &types.QueryDenomMintingSchedulesRequest{
+				Creator:  creator,
+				SubDenom: subDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomMinters returns the minters of a queried denom
+func GetCmdDenomMinters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minters [denom] [flags]",
+		Short: "Get the minters of a specific denom and their allowances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			creator, subDenom, err := types.DeconstructDenom(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomMinters(cmd.Context(), &types.QueryDenomMintersRequest{
+				Creator:  creator,
+				SubDenom: subDenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,6 +21,9 @@ import (
 
 const (
 	FlagAllowAdminBurn = "allow-admin-burn"
+	FlagImmutable      = "immutable"
+	FlagCliffDuration  = "cliff-duration"
+	FlagUnlimited      = "unlimited"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,6 +43,12 @@ func GetTxCmd() *cobra.Command {
 		// NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewSetDenomMetadataCmd(),
+		NewSetSupplyCapCmd(),
+		NewCreateMintingScheduleCmd(),
+		NewAcceptAdminCmd(),
+		NewUpdateDenomRolesCmd(),
+		NewSetDenomMinterCmd(),
+		NewRemoveDenomMinterCmd(),
 	)
 
 	return cmd
@@ -204,7 +214,7 @@ func NewBurnCmd() *cobra.Command {
 func NewChangeAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "change-admin [denom] [new-admin-address] [flags]",
-		Short: "Changes the admin address for a factory-created denom. Must have admin authority to do so.",
+		Short: "Proposes a new admin address for a factory-created denom, which has to accept it with accept-admin. Must have admin authority to do so.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -294,3 +304,251 @@ func NewSetDenomMetadataCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetSupplyCapCmd broadcast MsgSetSupplyCap
+func NewSetSupplyCapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-supply-cap [denom] [max-supply] [flags]",
+		Short: "Sets the maximum supply of a factory-created denom. Must have admin authority to do so.",
+		Long: `Sets the maximum supply of a factory-created denom. Must have admin authority to do so.
+The max supply cannot be lower than the current supply plus the amount still to be minted by the minting schedules.
+An immutable supply cap can never be changed again.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			isImmutable, err := cmd.Flags().GetBool(FlagImmutable)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSupplyCap(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+				isImmutable,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagImmutable, false, "True if the supply cap should never be changed again")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCreateMintingScheduleCmd broadcast MsgCreateMintingSchedule
+func NewCreateMintingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-minting-schedule [recipient] [total-amount] [start-time] [duration] [flags]",
+		Short: "Creates a schedule minting an amount of a factory-created denom to a recipient over time. Must have admin authority to do so.",
+		Long: `Creates a schedule minting an amount of a factory-created denom to a recipient over time. Must have admin authority to do so.
+The start time is a unix timestamp and the durations are in seconds. Nothing is minted before the end of the cliff,
+then the total amount is minted linearly from the start time until the end of the duration.`,
+		Example: "create-minting-schedule inj1... 1000000factory/inj1.../mytoken 1735689600 31536000 --cliff-duration=7776000",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			totalAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing start time %v: %w", args[2], err)
+			}
+
+			duration, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing duration %v: %w", args[3], err)
+			}
+
+			cliffDuration, err := cmd.Flags().GetInt64(FlagCliffDuration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMintingSchedule(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				totalAmount,
+				startTime,
+				cliffDuration,
+				duration,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagCliffDuration, 0, "Duration in seconds from the start time before which nothing is minted")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAcceptAdminCmd broadcast MsgAcceptAdmin
+func NewAcceptAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin [denom] [flags]",
+		Short: "Accepts the adminship of a factory-created denom. Must be the pending admin to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgAcceptAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateDenomRolesCmd broadcast MsgUpdateDenomRoles
+func NewUpdateDenomRolesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-roles [denom] [burner] [metadata-manager] [flags]",
+		Short: "Sets the burner and metadata manager addresses of a factory-created denom, empty \"\" to remove a role. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgUpdateDenomRoles(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomMinterCmd broadcast MsgSetDenomMinter
+func NewSetDenomMinterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-minter [denom] [minter] [allowance] [flags]",
+		Short: "Adds a minter to a factory-created denom or updates its allowance. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			allowance, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance: %s", args[2])
+			}
+
+			isUnlimited, err := cmd.Flags().GetBool(FlagUnlimited)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMinter(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				allowance,
+				isUnlimited,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagUnlimited, false, "True if the minter can mint any amount, the allowance is then ignored")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveDenomMinterCmd broadcast MsgRemoveDenomMinter
+func NewRemoveDenomMinterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-denom-minter [denom] [minter] [flags]",
+		Short: "Removes a minter from a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRemoveDenomMinter(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
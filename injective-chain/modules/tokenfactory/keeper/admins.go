@@ -51,13 +51,44 @@ func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata typ
 	return nil
 }
 
+// setAdmin transfers the adminship of the denom and clears its pending admin. The roles granted by the previous admin
+// (minters, burner and metadata manager) are revoked, so that they don't outlive the adminship.
 func (k Keeper) setAdmin(ctx sdk.Context, denom, admin string) error {
 	metadata, err := k.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
 
+	for _, minter := range k.GetDenomMinters(ctx, denom) {
+		k.deleteDenomMinter(ctx, denom, sdk.MustAccAddressFromBech32(minter.Address))
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventRemoveDenomMinter{
+			Denom:  denom,
+			Minter: minter.Address,
+		})
+	}
+
+	if metadata.Burner != "" || metadata.MetadataManager != "" {
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateDenomRoles{
+			Denom: denom,
+		})
+	}
+
 	metadata.Admin = admin
+	metadata.PendingAdmin = ""
+	metadata.Burner = ""
+	metadata.MetadataManager = ""
+
+	return k.SetAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setPendingAdmin(ctx sdk.Context, denom, pendingAdmin string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.PendingAdmin = pendingAdmin
 
 	return k.SetAuthorityMetadata(ctx, denom, metadata)
 }
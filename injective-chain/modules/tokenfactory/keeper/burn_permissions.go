@@ -6,8 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func isNonPermissionedAdminBurn(sender, admin string) bool {
-	return sender == admin
+func isNonPermissionedAdminBurn(sender string, authorityMetadata types.DenomAuthorityMetadata) bool {
+	return authorityMetadata.IsBurner(sender)
 }
 
 func (k msgServer) isPermissionedSuperBurn(ctx sdk.Context, denom string, sender sdk.AccAddress) bool {
@@ -24,7 +24,7 @@ func (k msgServer) verifyBurnFromPermissions(ctx sdk.Context, denom string, send
 		return types.ErrUnauthorized
 	}
 
-	if !hasPermissionsNamespace && !isNonPermissionedAdminBurn(sender.String(), authorityMetadata.GetAdmin()) {
+	if !hasPermissionsNamespace && !isNonPermissionedAdminBurn(sender.String(), authorityMetadata) {
 		return types.ErrUnauthorized
 	}
 
@@ -44,6 +44,11 @@ func (k msgServer) verifySelfBurnPermissions(ctx sdk.Context, denom string, send
 		return types.ErrUnauthorized.Wrapf("sender: %s, for %s action on denom: %s", sender, permissionstypes.Action_BURN, denom)
 	}
 
+	// a frozen balance can only be burned by an admin with the SUPER_BURN permission
+	if hasPermissionsNamespace && k.permissionsKeeper.IsAccountFrozen(ctx, denom, sender) {
+		return types.ErrUnauthorized.Wrapf("sender: %s is frozen for denom: %s", sender, denom)
+	}
+
 	return nil
 }
 
@@ -56,3 +61,14 @@ func (k msgServer) verifyBurnPermissions(ctx sdk.Context, denom string, sender,
 
 	return k.verifyBurnFromPermissions(ctx, denom, sender, hasPermissionsNamespace)
 }
+
+// consumeBurnQuota records the burned amount against the BURN quota of the sender for a self burn, or against its
+// SUPER_BURN quota when burning from another address
+func (k msgServer) consumeBurnQuota(ctx sdk.Context, amount sdk.Coin, sender, burnFromAddr sdk.AccAddress) error {
+	action := permissionstypes.Action_SUPER_BURN
+	if burnFromAddr.Equals(sender) {
+		action = permissionstypes.Action_BURN
+	}
+
+	return k.permissionsKeeper.ConsumeActionQuota(ctx, amount.Denom, sender, action, amount.Amount)
+}
@@ -32,7 +32,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		if genDenom.SupplyCap != nil {
+			if err := k.setSupplyCap(ctx, genDenom.GetDenom(), *genDenom.SupplyCap); err != nil {
+				panic(err)
+			}
+		}
+
+		for _, minter := range genDenom.GetMinters() {
+			if err := k.setDenomMinter(ctx, genDenom.GetDenom(), minter); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	nextScheduleID := uint64(1)
+	for _, schedule := range genState.GetMintingSchedules() {
+		if err := k.setMintingSchedule(ctx, schedule); err != nil {
+			panic(err)
+		}
+		nextScheduleID = max(nextScheduleID, schedule.Id+1)
 	}
+	k.setNextMintingScheduleID(ctx, nextScheduleID)
 }
 
 // ExportGenesis returns the tokenfactory module's exported genesis.
@@ -51,17 +72,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		supplyCap, err := k.GetSupplyCap(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
 			Name:              metadata.GetName(),
 			Symbol:            metadata.GetSymbol(),
 			Decimals:          metadata.GetDecimals(),
+			SupplyCap:         supplyCap,
+			Minters:           k.GetDenomMinters(ctx, denom),
 		})
 	}
 
 	return &types.GenesisState{
-		FactoryDenoms: genDenoms,
-		Params:        k.GetParams(ctx),
+		FactoryDenoms:    genDenoms,
+		Params:           k.GetParams(ctx),
+		MintingSchedules: k.GetAllMintingSchedules(ctx),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
@@ -30,6 +31,43 @@ func (k Keeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDeno
 	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: authorityMetadata}, nil
 }
 
+func (k Keeper) DenomSupplyCap(ctx context.Context, req *types.QueryDenomSupplyCapRequest) (*types.QueryDenomSupplyCapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom := strings.Join([]string{types.ModuleDenomPrefix, req.Creator, req.SubDenom}, "/")
+	supplyCap, err := k.GetSupplyCap(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryDenomSupplyCapResponse{
+		SupplyCap:           supplyCap,
+		CurrentSupply:       k.bankKeeper.GetSupply(sdkCtx, denom).Amount,
+		ScheduledSupply:     k.GetScheduledSupply(sdkCtx, denom),
+		UnscheduledMintable: math.ZeroInt(),
+	}
+
+	if supplyCap != nil {
+		res.UnscheduledMintable = math.MaxInt(supplyCap.MaxSupply.Sub(res.CurrentSupply).Sub(res.ScheduledSupply), math.ZeroInt())
+	}
+
+	return res, nil
+}
+
+func (k Keeper) DenomMintingSchedules(ctx context.Context, req *types.QueryDenomMintingSchedulesRequest) (*types.QueryDenomMintingSchedulesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom := strings.Join([]string{types.ModuleDenomPrefix, req.Creator, req.SubDenom}, "/")
+	return &types.QueryDenomMintingSchedulesResponse{Schedules: k.GetDenomMintingSchedules(sdkCtx, denom)}, nil
+}
+
+func (k Keeper) DenomMinters(ctx context.Context, req *types.QueryDenomMintersRequest) (*types.QueryDenomMintersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom := strings.Join([]string{types.ModuleDenomPrefix, req.Creator, req.SubDenom}, "/")
+	return &types.QueryDenomMintersResponse{Minters: k.GetDenomMinters(sdkCtx, denom)}, nil
+}
+
 func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	denoms := k.getDenomsFromCreator(sdkCtx, req.GetCreator())
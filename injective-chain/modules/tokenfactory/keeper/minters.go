@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
+)
+
+// GetDenomMinter returns the minter of a specific denom, or nil if the address isn't a minter of the denom
+func (k Keeper) GetDenomMinter(ctx sdk.Context, denom string, minter sdk.AccAddress) (*types.DenomMinter, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetDenomMinterKey(minter))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	denomMinter := &types.DenomMinter{}
+	if err := proto.Unmarshal(bz, denomMinter); err != nil {
+		return nil, err
+	}
+
+	return denomMinter, nil
+}
+
+func (k Keeper) setDenomMinter(ctx sdk.Context, denom string, denomMinter types.DenomMinter) error {
+	if err := denomMinter.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&denomMinter)
+	if err != nil {
+		return err
+	}
+
+	minter := sdk.MustAccAddressFromBech32(denomMinter.Address)
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetDenomMinterKey(minter), bz)
+	return nil
+}
+
+func (k Keeper) deleteDenomMinter(ctx sdk.Context, denom string, minter sdk.AccAddress) {
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetDenomMinterKey(minter))
+}
+
+// GetDenomMinters returns the minters of a specific denom
+func (k Keeper) GetDenomMinters(ctx sdk.Context, denom string) []types.DenomMinter {
+	minters := make([]types.DenomMinter, 0)
+
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomMintersPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var minter types.DenomMinter
+		if err := proto.Unmarshal(iterator.Value(), &minter); err != nil {
+			panic(err)
+		}
+		minters = append(minters, minter)
+	}
+
+	return minters
+}
+
+// consumeMintAllowance deducts the amount from the allowance of the minter, failing with ErrUnauthorized if the
+// address isn't a minter of the denom and with ErrMintAllowanceExceeded if its allowance is too low
+func (k Keeper) consumeMintAllowance(ctx sdk.Context, minter sdk.AccAddress, amount sdk.Coin) error {
+	denomMinter, err := k.GetDenomMinter(ctx, amount.Denom, minter)
+	if err != nil {
+		return err
+	}
+
+	if denomMinter == nil {
+		return types.ErrUnauthorized
+	}
+
+	if denomMinter.IsUnlimited {
+		return nil
+	}
+
+	if denomMinter.Allowance.LT(amount.Amount) {
+		return types.ErrMintAllowanceExceeded.Wrapf("minter %s can mint %s%s, %s requested", minter, denomMinter.Allowance, amount.Denom, amount)
+	}
+
+	denomMinter.Allowance = denomMinter.Allowance.Sub(amount.Amount)
+	return k.setDenomMinter(ctx, amount.Denom, *denomMinter)
+}
+
+// newDenomMinter returns a minter with the allowance, which is ignored for unlimited minters
+func newDenomMinter(minter string, allowance math.Int, isUnlimited bool) types.DenomMinter {
+	if isUnlimited {
+		allowance = math.ZeroInt()
+	}
+
+	return types.DenomMinter{
+		Address:     minter,
+		Allowance:   allowance,
+		IsUnlimited: isUnlimited,
+	}
+}
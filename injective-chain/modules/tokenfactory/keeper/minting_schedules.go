@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
+)
+
+// GetMintingSchedule returns the minting schedule of the denom with the given ID, or nil if it doesn't exist
+func (k Keeper) GetMintingSchedule(ctx sdk.Context, denom string, id uint64) (*types.MintingSchedule, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetMintingScheduleKey(id))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	schedule := &types.MintingSchedule{}
+	if err := proto.Unmarshal(bz, schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// setMintingSchedule stores the minting schedule and queues it at the next time it has an amount to mint
+func (k Keeper) setMintingSchedule(ctx sdk.Context, schedule types.MintingSchedule) error {
+	bz, err := proto.Marshal(&schedule)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, schedule.Denom).Set(types.GetMintingScheduleKey(schedule.Id), bz)
+	ctx.KVStore(k.storeKey).Set(types.GetMintingScheduleQueueKey(schedule.NextVestTime(), schedule.Id), []byte(schedule.Denom))
+	return nil
+}
+
+// deleteMintingSchedule deletes the minting schedule along with its queue entry at the given time, which is its next vest
+// time unless it's being retried
+func (k Keeper) deleteMintingSchedule(ctx sdk.Context, schedule types.MintingSchedule, queueTime int64) {
+	k.GetDenomPrefixStore(ctx, schedule.Denom).Delete(types.GetMintingScheduleKey(schedule.Id))
+	ctx.KVStore(k.storeKey).Delete(types.GetMintingScheduleQueueKey(queueTime, schedule.Id))
+}
+
+// requeueMintingSchedule moves the queue entry of the minting schedule from the given time to the retry time
+func (k Keeper) requeueMintingSchedule(ctx sdk.Context, schedule types.MintingSchedule, queueTime, retryTime int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMintingScheduleQueueKey(queueTime, schedule.Id))
+	store.Set(types.GetMintingScheduleQueueKey(retryTime, schedule.Id), []byte(schedule.Denom))
+}
+
+// GetAllMintingSchedules returns all the minting schedules, ordered by the next time they have an amount to mint
+func (k Keeper) GetAllMintingSchedules(ctx sdk.Context) []types.MintingSchedule {
+	schedules := make([]types.MintingSchedule, 0)
+
+	k.iterateMintingScheduleQueue(ctx, nil, func(_ int64, denom string, id uint64) (stop bool) {
+		schedule, err := k.GetMintingSchedule(ctx, denom, id)
+		if err != nil {
+			panic(err)
+		}
+		schedules = append(schedules, *schedule)
+		return false
+	})
+
+	return schedules
+}
+
+// GetDenomMintingSchedules returns the minting schedules of a specific denom, ordered by ID
+func (k Keeper) GetDenomMintingSchedules(ctx sdk.Context, denom string) []types.MintingSchedule {
+	schedules := make([]types.MintingSchedule, 0)
+
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.MintingSchedulesPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.MintingSchedule
+		if err := proto.Unmarshal(iterator.Value(), &schedule); err != nil {
+			panic(err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// iterateMintingScheduleQueue iterates over the queued minting schedules in the order of the next time they have an
+// amount to mint (or are retried), up to the given block time (unix seconds) if not nil
+func (k Keeper) iterateMintingScheduleQueue(ctx sdk.Context, untilTime *int64, process func(queueTime int64, denom string, id uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintingScheduleQueuePrefix)
+
+	var end []byte
+	if untilTime != nil {
+		end = sdk.Uint64ToBigEndian(uint64(*untilTime + 1))
+	}
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is made of the big endian vest time and schedule ID
+		queueTime := int64(sdk.BigEndianToUint64(iterator.Key()[:8]))
+		id := sdk.BigEndianToUint64(iterator.Key()[8:])
+		if process(queueTime, string(iterator.Value()), id) {
+			return
+		}
+	}
+}
+
+// getNextMintingScheduleID returns the ID of the next minting schedule to be created
+func (k Keeper) getNextMintingScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MintingScheduleIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextMintingScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MintingScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// createMintingSchedule stores a new minting schedule for the amount and returns it. The schedule amount is reserved
+// against the supply cap of the denom until it's minted.
+func (k Keeper) createMintingSchedule(
+	ctx sdk.Context,
+	recipient string,
+	totalAmount sdk.Coin,
+	startTime, cliffDuration, duration int64,
+) (*types.MintingSchedule, error) {
+	if len(k.GetDenomMintingSchedules(ctx, totalAmount.Denom)) >= types.MaxMintingSchedulesPerDenom {
+		return nil, types.ErrInvalidMintingSchedule.Wrapf("denom %s already has %d minting schedules", totalAmount.Denom, types.MaxMintingSchedulesPerDenom)
+	}
+
+	if err := k.checkSupplyCap(ctx, totalAmount); err != nil {
+		return nil, err
+	}
+
+	id := k.getNextMintingScheduleID(ctx)
+	schedule := types.MintingSchedule{
+		Id:            id,
+		Denom:         totalAmount.Denom,
+		Recipient:     recipient,
+		TotalAmount:   totalAmount.Amount,
+		MintedAmount:  math.ZeroInt(),
+		StartTime:     startTime,
+		CliffDuration: cliffDuration,
+		Duration:      duration,
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.setMintingSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+	k.setNextMintingScheduleID(ctx, id+1)
+
+	return &schedule, nil
+}
+
+// ProcessMintingSchedules mints the amounts vested since the last block by the minting schedules to their recipients.
+// Only the schedules queued up to the block time are visited, up to MaxMintingSchedulesPerBlock of them, the remaining
+// ones are processed in the next blocks. Schedules that are fully minted are removed. A schedule failing to mint is
+// requeued to be retried after MintingScheduleRetryDelay.
+func (k Keeper) ProcessMintingSchedules(ctx sdk.Context) {
+	blockTime := ctx.BlockTime().Unix()
+
+	type queuedSchedule struct {
+		queueTime int64
+		denom     string
+		id        uint64
+	}
+
+	// collect the due schedules first, since processing them updates the queue
+	dueSchedules := make([]queuedSchedule, 0)
+	k.iterateMintingScheduleQueue(ctx, &blockTime, func(queueTime int64, denom string, id uint64) (stop bool) {
+		dueSchedules = append(dueSchedules, queuedSchedule{queueTime: queueTime, denom: denom, id: id})
+		return len(dueSchedules) >= types.MaxMintingSchedulesPerBlock
+	})
+
+	for _, due := range dueSchedules {
+		schedule, err := k.GetMintingSchedule(ctx, due.denom, due.id)
+		if err != nil || schedule == nil {
+			k.Logger(ctx).Error("failed to load minting schedule", "id", due.id, "denom", due.denom, "error", err)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		amount, err := k.processMintingSchedule(cacheCtx, *schedule, due.queueTime, blockTime)
+		if err != nil {
+			k.Logger(ctx).Error("failed to process minting schedule", "id", schedule.Id, "error", err)
+			k.requeueMintingSchedule(ctx, *schedule, due.queueTime, blockTime+types.MintingScheduleRetryDelay)
+			continue
+		}
+		writeCache()
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventScheduledMint{
+			ScheduleId: schedule.Id,
+			Amount:     amount,
+			Recipient:  schedule.Recipient,
+		})
+	}
+}
+
+// processMintingSchedule mints the amount vested by the schedule at the block time and requeues the schedule, or
+// removes it once fully minted. It returns the amount minted. Panics are recovered, so that a faulty schedule can't
+// halt the chain.
+func (k Keeper) processMintingSchedule(ctx sdk.Context, schedule types.MintingSchedule, queueTime, blockTime int64) (amount sdk.Coin, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while processing minting schedule: %v", r)
+		}
+	}()
+
+	amount = sdk.NewCoin(schedule.Denom, schedule.VestedAmount(blockTime).Sub(schedule.MintedAmount))
+
+	// unqueue the schedule from its current queue time before its vest time changes
+	k.deleteMintingSchedule(ctx, schedule, queueTime)
+
+	if amount.IsPositive() {
+		if err := k.mintTo(ctx, amount, sdk.MustAccAddressFromBech32(schedule.Recipient)); err != nil {
+			return amount, err
+		}
+		schedule.MintedAmount = schedule.MintedAmount.Add(amount.Amount)
+	}
+
+	if schedule.RemainingAmount().IsZero() {
+		return amount, nil
+	}
+
+	return amount, k.setMintingSchedule(ctx, schedule)
+}
@@ -75,14 +75,26 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 
 	hasPermissionsNamespace := k.permissionsKeeper.HasNamespace(ctx, denom)
 
-	// for non-permissioned tokens, only the admin can mint
+	// for non-permissioned tokens, only the admin and the minters within their allowance can mint
 	if !hasPermissionsNamespace && msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+		if err := k.consumeMintAllowance(ctx, sender, msg.Amount); err != nil {
+			return nil, err
+		}
 	}
 	if hasPermissionsNamespace && !k.permissionsKeeper.HasPermissionsForAction(ctx, denom, sender, permissionstypes.Action_MINT) {
 		return nil, types.ErrUnauthorized.Wrapf("sender %s, for %s action on denom: %s", sender, permissionstypes.Action_MINT, denom)
 	}
 
+	if hasPermissionsNamespace {
+		if err := k.permissionsKeeper.ConsumeActionQuota(ctx, denom, sender, permissionstypes.Action_MINT, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.checkSupplyCap(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	receiver := sender
 	if msg.Receiver != "" {
 		receiver = sdk.MustAccAddressFromBech32(msg.Receiver)
@@ -121,6 +133,12 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 		return nil, err
 	}
 
+	if hasPermissionsNamespace {
+		if err := k.consumeBurnQuota(ctx, msg.Amount, sender, burnFromAddr); err != nil {
+			return nil, err
+		}
+	}
+
 	err = k.burnFrom(ctx, msg.Amount, burnFromAddr)
 	if err != nil {
 		return nil, err
@@ -147,17 +165,61 @@ func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin)
 		return nil, types.ErrUnauthorized
 	}
 
-	err = k.setAdmin(ctx, msg.Denom, msg.NewAdmin)
+	// renouncing the adminship to the zero address takes effect immediately, since nobody can accept it
+	if types.IsZeroAddress(msg.NewAdmin) {
+		if err := k.setAdmin(ctx, msg.Denom, msg.NewAdmin); err != nil {
+			return nil, err
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventChangeAdmin{
+			Denom:           msg.Denom,
+			NewAdminAddress: msg.NewAdmin,
+		})
+
+		return &types.MsgChangeAdminResponse{}, nil
+	}
+
+	// the adminship is only transferred once the new admin accepts it
+	err = k.setPendingAdmin(ctx, msg.Denom, msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventProposeAdmin{
+		Denom:               msg.Denom,
+		PendingAdminAddress: msg.NewAdmin,
+	})
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+func (k msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.GetPendingAdmin() == "" {
+		return nil, types.ErrNoPendingAdmin.Wrapf("denom: %s", msg.Denom)
+	}
+
+	if msg.Sender != authorityMetadata.GetPendingAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = k.setAdmin(ctx, msg.Denom, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventChangeAdmin{
 		Denom:           msg.Denom,
-		NewAdminAddress: msg.NewAdmin,
+		NewAdminAddress: msg.Sender,
 	})
 
-	return &types.MsgChangeAdminResponse{}, nil
+	return &types.MsgAcceptAdminResponse{}, nil
 }
 
 func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
@@ -176,10 +238,16 @@ func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDeno
 
 	isAdminAllowed := authorityMetadata.GetAdmin() != "" && msg.Sender == authorityMetadata.GetAdmin()
 	isGovernanceAllowed := authorityMetadata.GetAdmin() == "" && msg.Sender == k.authority
-	if !(isAdminAllowed || isGovernanceAllowed) {
+	isMetadataManagerAllowed := authorityMetadata.IsMetadataManager(msg.Sender)
+	if !(isAdminAllowed || isGovernanceAllowed || isMetadataManagerAllowed) {
 		return nil, types.ErrUnauthorized
 	}
 
+	// the admin burn capability can only be changed by the admin itself
+	if msg.AdminBurnDisabled != nil && !(isAdminAllowed || isGovernanceAllowed) {
+		return nil, types.ErrUnauthorized.Wrap("only the admin can disable admin burn")
+	}
+
 	existingMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, msg.Metadata.Base)
 	if found {
 		if existingMetadata.Decimals != 0 && existingMetadata.Decimals != msg.Metadata.Decimals {
@@ -207,3 +275,180 @@ func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDeno
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+func (k msgServer) SetSupplyCap(goCtx context.Context, msg *types.MsgSetSupplyCap) (*types.MsgSetSupplyCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, doesDenomExist := k.bankKeeper.GetDenomMetaData(ctx, msg.Denom); !doesDenomExist {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	existingCap, err := k.GetSupplyCap(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingCap != nil && existingCap.IsImmutable {
+		return nil, types.ErrSupplyCapImmutable.Wrapf("denom: %s", msg.Denom)
+	}
+
+	// the cap must leave room for the current supply and the amounts the minting schedules still have to mint
+	supply := k.bankKeeper.GetSupply(ctx, msg.Denom).Amount
+	scheduled := k.GetScheduledSupply(ctx, msg.Denom)
+	if msg.MaxSupply.LT(supply.Add(scheduled)) {
+		return nil, types.ErrInvalidSupplyCap.Wrapf(
+			"max supply %s is lower than the current supply %s plus the scheduled supply %s", msg.MaxSupply, supply, scheduled,
+		)
+	}
+
+	supplyCap := types.DenomSupplyCap{
+		MaxSupply:   msg.MaxSupply,
+		IsImmutable: msg.IsImmutable,
+	}
+
+	if err := k.setSupplyCap(ctx, msg.Denom, supplyCap); err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetSupplyCap{
+		Denom:     msg.Denom,
+		SupplyCap: supplyCap,
+	})
+
+	return &types.MsgSetSupplyCapResponse{}, nil
+}
+
+func (k msgServer) CreateMintingSchedule(goCtx context.Context, msg *types.MsgCreateMintingSchedule) (*types.MsgCreateMintingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := msg.TotalAmount.Denom
+	if _, doesDenomExist := k.bankKeeper.GetDenomMetaData(ctx, denom); !doesDenomExist {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	schedule, err := k.createMintingSchedule(ctx, msg.Recipient, msg.TotalAmount, msg.StartTime, msg.CliffDuration, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventCreateMintingSchedule{
+		Schedule: *schedule,
+	})
+
+	return &types.MsgCreateMintingScheduleResponse{
+		ScheduleId: schedule.Id,
+	}, nil
+}
+
+func (k msgServer) UpdateDenomRoles(goCtx context.Context, msg *types.MsgUpdateDenomRoles) (*types.MsgUpdateDenomRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.GetAdmin() == "" || msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	authorityMetadata.Burner = msg.Burner
+	authorityMetadata.MetadataManager = msg.MetadataManager
+
+	err = k.SetAuthorityMetadata(ctx, msg.Denom, authorityMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventUpdateDenomRoles{
+		Denom:           msg.Denom,
+		Burner:          msg.Burner,
+		MetadataManager: msg.MetadataManager,
+	})
+
+	return &types.MsgUpdateDenomRolesResponse{}, nil
+}
+
+func (k msgServer) SetDenomMinter(goCtx context.Context, msg *types.MsgSetDenomMinter) (*types.MsgSetDenomMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, doesDenomExist := k.bankKeeper.GetDenomMetaData(ctx, msg.Denom); !doesDenomExist {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.GetAdmin() == "" || msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	// minting of permissioned tokens is governed by the MINT role of their namespace instead
+	if k.permissionsKeeper.HasNamespace(ctx, msg.Denom) {
+		return nil, types.ErrUnauthorized.Wrapf("minters of %s are managed by its permissions namespace", msg.Denom)
+	}
+
+	minter := newDenomMinter(msg.Minter, msg.Allowance, msg.IsUnlimited)
+	if err := k.setDenomMinter(ctx, msg.Denom, minter); err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetDenomMinter{
+		Denom:  msg.Denom,
+		Minter: minter,
+	})
+
+	return &types.MsgSetDenomMinterResponse{}, nil
+}
+
+func (k msgServer) RemoveDenomMinter(goCtx context.Context, msg *types.MsgRemoveDenomMinter) (*types.MsgRemoveDenomMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.GetAdmin() == "" || msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	minter := sdk.MustAccAddressFromBech32(msg.Minter)
+	denomMinter, err := k.GetDenomMinter(ctx, msg.Denom, minter)
+	if err != nil {
+		return nil, err
+	}
+
+	if denomMinter == nil {
+		return nil, types.ErrInvalidDenomMinter.Wrapf("%s is not a minter of %s", msg.Minter, msg.Denom)
+	}
+
+	k.deleteDenomMinter(ctx, msg.Denom, minter)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventRemoveDenomMinter{
+		Denom:  msg.Denom,
+		Minter: msg.Minter,
+	})
+
+	return &types.MsgRemoveDenomMinterResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
+)
+
+// GetSupplyCap returns the supply cap of a specific denom, or nil if its supply isn't capped
+func (k Keeper) GetSupplyCap(ctx sdk.Context, denom string) (*types.DenomSupplyCap, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.SupplyCapKey)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	supplyCap := &types.DenomSupplyCap{}
+	if err := proto.Unmarshal(bz, supplyCap); err != nil {
+		return nil, err
+	}
+
+	return supplyCap, nil
+}
+
+func (k Keeper) setSupplyCap(ctx sdk.Context, denom string, supplyCap types.DenomSupplyCap) error {
+	if err := supplyCap.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&supplyCap)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.SupplyCapKey, bz)
+	return nil
+}
+
+// GetScheduledSupply returns the amount of the denom that is still to be minted by its minting schedules
+func (k Keeper) GetScheduledSupply(ctx sdk.Context, denom string) math.Int {
+	scheduled := math.ZeroInt()
+	for _, schedule := range k.GetDenomMintingSchedules(ctx, denom) {
+		scheduled = scheduled.Add(schedule.RemainingAmount())
+	}
+
+	return scheduled
+}
+
+// checkSupplyCap returns ErrSupplyCapExceeded if minting the amount on top of the current supply and the amount
+// reserved by the minting schedules of the denom would exceed its supply cap
+func (k Keeper) checkSupplyCap(ctx sdk.Context, amount sdk.Coin) error {
+	supplyCap, err := k.GetSupplyCap(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if supplyCap == nil {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+	scheduled := k.GetScheduledSupply(ctx, amount.Denom)
+
+	if supply.Add(scheduled).Add(amount.Amount).GT(supplyCap.MaxSupply) {
+		return types.ErrSupplyCapExceeded.Wrapf(
+			"minting %s would exceed max supply %s (current supply %s, scheduled %s)",
+			amount, supplyCap.MaxSupply, supply, scheduled,
+		)
+	}
+
+	return nil
+}
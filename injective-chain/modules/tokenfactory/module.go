@@ -35,7 +35,8 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 const ConsensusVersion = 2
@@ -145,6 +146,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}
 }
 
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.BeginBlocker(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // InitGenesis performs the x/tokenfactory module's genesis initialization. It
 // returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
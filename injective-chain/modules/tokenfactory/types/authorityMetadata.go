@@ -1,15 +1,67 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (metadata DenomAuthorityMetadata) Validate() error {
-	if metadata.Admin != "" {
-		_, err := sdk.AccAddressFromBech32(metadata.Admin)
+	for _, addr := range []string{metadata.Admin, metadata.PendingAdmin, metadata.Burner, metadata.MetadataManager} {
+		if addr == "" {
+			continue
+		}
+
+		_, err := sdk.AccAddressFromBech32(addr)
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// IsZeroAddress returns true if the address is the zero address, used to renounce the adminship of a denom
+func IsZeroAddress(addr string) bool {
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil || len(accAddr) == 0 {
+		return false
+	}
+
+	for _, b := range accAddr {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsBurner returns true if the address can burn tokens from other addresses, which requires admin burn to be allowed
+func (metadata DenomAuthorityMetadata) IsBurner(addr string) bool {
+	if !metadata.AdminBurnAllowed || addr == "" {
+		return false
+	}
+
+	return addr == metadata.Admin || addr == metadata.Burner
+}
+
+// IsMetadataManager returns true if the address can set the denom metadata
+func (metadata DenomAuthorityMetadata) IsMetadataManager(addr string) bool {
+	if addr == "" {
+		return false
+	}
+
+	return addr == metadata.Admin || addr == metadata.MetadataManager
+}
+
+// Validate performs basic validation of the denom minter
+func (m DenomMinter) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errors.Wrapf(ErrInvalidDenomMinter, "invalid minter address (%s)", err)
+	}
+
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return errors.Wrap(ErrInvalidDenomMinter, "allowance must be non-negative")
+	}
+
+	return nil
+}
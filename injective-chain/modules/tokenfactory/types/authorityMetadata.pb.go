@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,13 +26,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin holds every capability
+// and can delegate burning and metadata management to other addresses, while
+// minters are stored separately as DenomMinter.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid injective address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
 	// true if the admin can burn tokens from other addresses
 	AdminBurnAllowed bool `protobuf:"varint,2,opt,name=admin_burn_allowed,json=adminBurnAllowed,proto3" json:"admin_burn_allowed,omitempty" yaml:"admin_burn_allowed"`
+	// The address proposed as the new admin, which has to accept the adminship
+	// before it's transferred. Empty if no admin change is pending.
+	PendingAdmin string `protobuf:"bytes,3,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty" yaml:"pending_admin"`
+	// The address allowed to burn tokens from other addresses on top of the
+	// admin, if admin burn is allowed. Can be empty.
+	Burner string `protobuf:"bytes,4,opt,name=burner,proto3" json:"burner,omitempty" yaml:"burner"`
+	// The address allowed to set the denom metadata on top of the admin. Can be
+	// empty.
+	MetadataManager string `protobuf:"bytes,5,opt,name=metadata_manager,json=metadataManager,proto3" json:"metadata_manager,omitempty" yaml:"metadata_manager"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -81,8 +92,88 @@ func (m *DenomAuthorityMetadata) GetAdminBurnAllowed() bool {
 	return false
 }
 
+func (m *DenomAuthorityMetadata) GetPendingAdmin() string {
+	if m != nil {
+		return m.PendingAdmin
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetBurner() string {
+	if m != nil {
+		return m.Burner
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataManager() string {
+	if m != nil {
+		return m.MetadataManager
+	}
+	return ""
+}
+
+// DenomMinter defines an address allowed to mint a token factory denom on top
+// of its admin.
+type DenomMinter struct {
+	// The minter's Injective address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// The amount the minter can still mint, ignored if the minter is unlimited
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance" yaml:"allowance"`
+	// true if the minter can mint any amount
+	IsUnlimited bool `protobuf:"varint,3,opt,name=is_unlimited,json=isUnlimited,proto3" json:"is_unlimited,omitempty" yaml:"is_unlimited"`
+}
+
+func (m *DenomMinter) Reset()         { *m = DenomMinter{} }
+func (m *DenomMinter) String() string { return proto.CompactTextString(m) }
+func (*DenomMinter) ProtoMessage()    {}
+func (*DenomMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525494b77b96b2d3, []int{1}
+}
+func (m *DenomMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMinter.Merge(m, src)
+}
+func (m *DenomMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMinter proto.InternalMessageInfo
+
+func (m *DenomMinter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DenomMinter) GetIsUnlimited() bool {
+	if m != nil {
+		return m.IsUnlimited
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "injective.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomMinter)(nil), "injective.tokenfactory.v1beta1.DenomMinter")
 }
 
 func init() {
@@ -90,27 +181,39 @@ func init() {
 }
 
 var fileDescriptor_525494b77b96b2d3 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
-	0x10, 0xc7, 0xb3, 0x1f, 0x9f, 0xa2, 0x87, 0x45, 0x38, 0x44, 0x62, 0xc0, 0x4d, 0xb8, 0x42, 0xd2,
-	0x78, 0x4b, 0x10, 0x2c, 0xd2, 0xe5, 0xb0, 0x11, 0xb5, 0x49, 0x69, 0x13, 0x66, 0xf7, 0xd6, 0x64,
-	0xf5, 0x76, 0x27, 0xec, 0xee, 0x45, 0xee, 0x2d, 0x04, 0x5f, 0xc0, 0xc7, 0xb1, 0x4c, 0x69, 0x15,
-	0x24, 0x69, 0xac, 0xf3, 0x04, 0xe2, 0x9d, 0x86, 0xc4, 0x6e, 0xe6, 0x3f, 0xf3, 0xe3, 0x0f, 0xbf,
-	0xe0, 0x42, 0x99, 0x07, 0x29, 0xbc, 0x9a, 0x4a, 0xe6, 0xf1, 0x51, 0x9a, 0x7b, 0x10, 0x1e, 0x6d,
-	0xc1, 0xa6, 0x5d, 0x2e, 0x3d, 0x74, 0x19, 0xe4, 0x7e, 0x8c, 0x56, 0xf9, 0xe2, 0x56, 0x7a, 0x48,
-	0xc1, 0x43, 0x3c, 0xb1, 0xe8, 0x31, 0xa4, 0x6b, 0x2e, 0xde, 0xe4, 0xe2, 0x1f, 0xae, 0x79, 0x38,
-	0xc2, 0x11, 0x96, 0xaf, 0xec, 0x7b, 0xaa, 0xa8, 0x26, 0x15, 0xe8, 0x34, 0x3a, 0xc6, 0xc1, 0xc9,
-	0x75, 0x85, 0x40, 0x65, 0xaa, 0x7b, 0xf4, 0x42, 0x82, 0xa3, 0x4b, 0x69, 0x50, 0xf7, 0xff, 0xd6,
-	0x86, 0xa7, 0xc1, 0x0e, 0xa4, 0x5a, 0x99, 0x06, 0x69, 0x93, 0xce, 0x7e, 0x52, 0x5f, 0xcd, 0x5b,
-	0x07, 0x05, 0xe8, 0xac, 0x17, 0x95, 0x71, 0x34, 0xa8, 0xce, 0xe1, 0x75, 0x10, 0x96, 0xc3, 0x90,
-	0xe7, 0xd6, 0x0c, 0x21, 0xcb, 0xf0, 0x49, 0xa6, 0x8d, 0x7f, 0x6d, 0xd2, 0xd9, 0x4b, 0x4e, 0x56,
-	0xf3, 0xd6, 0xf1, 0x06, 0xb4, 0xf5, 0x13, 0x0d, 0xea, 0x65, 0x98, 0xe4, 0xd6, 0xf4, 0xab, 0xa8,
-	0xf7, 0xff, 0xf3, 0xb5, 0x45, 0x92, 0xec, 0x6d, 0x41, 0xc9, 0x6c, 0x41, 0xc9, 0xc7, 0x82, 0x92,
-	0xe7, 0x25, 0xad, 0xcd, 0x96, 0xb4, 0xf6, 0xbe, 0xa4, 0xb5, 0xbb, 0xc1, 0x48, 0xf9, 0x71, 0xce,
-	0x63, 0x81, 0x9a, 0x5d, 0xfd, 0x0a, 0xb9, 0x01, 0xee, 0xd8, 0x5a, 0xcf, 0x99, 0x40, 0x2b, 0x37,
-	0xd7, 0x31, 0x28, 0xc3, 0x34, 0xa6, 0x79, 0x26, 0xdd, 0xb6, 0x73, 0x5f, 0x4c, 0xa4, 0xe3, 0xbb,
-	0xa5, 0x8a, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x06, 0x42, 0x33, 0x9a, 0x01, 0x00,
-	0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xb1, 0x8d, 0xd5, 0xeb, 0xa0, 0x84, 0xc1, 0xca, 0x10, 0xf1, 0xe4, 0x03, 0x1a,
+	0x12, 0x24, 0xaa, 0x90, 0x38, 0x54, 0xe2, 0xd0, 0x08, 0x21, 0x4d, 0x50, 0x21, 0x45, 0xe2, 0xc2,
+	0xa5, 0x72, 0x12, 0xd3, 0x9a, 0xc5, 0x76, 0x65, 0x3b, 0x43, 0x7d, 0x0b, 0x1e, 0x81, 0x97, 0x41,
+	0xda, 0x09, 0xed, 0x88, 0x38, 0x58, 0xa8, 0xbd, 0x70, 0xce, 0x13, 0xa0, 0xd9, 0x49, 0x69, 0x77,
+	0xb3, 0xff, 0xdf, 0xf7, 0xfb, 0xfe, 0xf1, 0x3f, 0x1f, 0x78, 0x45, 0xf9, 0x17, 0x92, 0x69, 0x7a,
+	0x41, 0x22, 0x2d, 0xce, 0x09, 0xff, 0x8c, 0x33, 0x2d, 0xe4, 0x3c, 0xba, 0xe8, 0xa7, 0x44, 0xe3,
+	0x7e, 0x84, 0x4b, 0x3d, 0x15, 0x92, 0xea, 0xf9, 0x88, 0x68, 0x9c, 0x63, 0x8d, 0xc3, 0x99, 0x14,
+	0x5a, 0xf8, 0xc1, 0x8a, 0x0b, 0xd7, 0xb9, 0xb0, 0xe6, 0x8e, 0x0f, 0x27, 0x62, 0x22, 0x6c, 0x6b,
+	0x74, 0x7d, 0x72, 0xd4, 0x71, 0x90, 0x09, 0xc5, 0x84, 0x8a, 0x52, 0xac, 0xc8, 0xca, 0x22, 0x13,
+	0x94, 0xbb, 0x3a, 0xfa, 0xb1, 0x05, 0x1e, 0xbe, 0x21, 0x5c, 0xb0, 0xe1, 0x4d, 0x5b, 0xff, 0x29,
+	0xd8, 0xc1, 0x39, 0xa3, 0xbc, 0xe7, 0x9d, 0x78, 0xa7, 0xed, 0xb8, 0x5b, 0x19, 0xd8, 0x99, 0x63,
+	0x56, 0x0c, 0x90, 0x95, 0x51, 0xe2, 0xca, 0xfe, 0x3b, 0xe0, 0xdb, 0xc3, 0x38, 0x2d, 0x25, 0x1f,
+	0xe3, 0xa2, 0x10, 0x5f, 0x49, 0xde, 0xdb, 0x3a, 0xf1, 0x4e, 0xf7, 0xe2, 0x27, 0x95, 0x81, 0x8f,
+	0xd6, 0xa0, 0x8d, 0x1e, 0x94, 0x74, 0xad, 0x18, 0x97, 0x92, 0x0f, 0x9d, 0xe4, 0xbf, 0x06, 0x07,
+	0x33, 0xc2, 0x73, 0xca, 0x27, 0x63, 0x67, 0x7e, 0xcb, 0x9a, 0xf7, 0x2a, 0x03, 0x0f, 0xdd, 0x9c,
+	0x8d, 0x32, 0x4a, 0x3a, 0xf5, 0x7d, 0x68, 0xbf, 0xe5, 0x19, 0xd8, 0xbd, 0x76, 0x20, 0xb2, 0xb7,
+	0x6d, 0xb9, 0x7b, 0x95, 0x81, 0x07, 0x8e, 0x73, 0x3a, 0x4a, 0xea, 0x06, 0xff, 0x2d, 0xe8, 0xb2,
+	0xfa, 0xa9, 0x63, 0x86, 0x39, 0x9e, 0x10, 0xd9, 0xdb, 0xb1, 0xd0, 0xe3, 0xca, 0xc0, 0x23, 0x07,
+	0xdd, 0xec, 0x40, 0xc9, 0xdd, 0x46, 0x1a, 0x39, 0x65, 0xb0, 0xfd, 0xf7, 0x3b, 0xf4, 0xd0, 0x4f,
+	0x0f, 0xec, 0xdb, 0x1c, 0x47, 0x94, 0x6b, 0x22, 0xfd, 0xe7, 0xe0, 0x36, 0xce, 0x73, 0x49, 0x94,
+	0xaa, 0xe3, 0xf3, 0x2b, 0x03, 0xef, 0x34, 0x49, 0xd8, 0x02, 0x4a, 0x9a, 0x16, 0xff, 0x03, 0x68,
+	0xdb, 0x4c, 0x30, 0xcf, 0x88, 0x4d, 0xae, 0x1d, 0xf7, 0x2f, 0x0d, 0x6c, 0xfd, 0x36, 0xf0, 0x81,
+	0xfb, 0x81, 0x2a, 0x3f, 0x0f, 0xa9, 0x88, 0x18, 0xd6, 0xd3, 0xf0, 0x8c, 0xeb, 0xca, 0xc0, 0x6e,
+	0x3d, 0xac, 0xe1, 0x50, 0xf2, 0x7f, 0x86, 0x3f, 0x00, 0x1d, 0xaa, 0xc6, 0x25, 0x2f, 0x28, 0xa3,
+	0x9a, 0xe4, 0x36, 0xc5, 0xbd, 0xf8, 0xa8, 0x32, 0xf0, 0xbe, 0xc3, 0xd6, 0xab, 0x28, 0xd9, 0xa7,
+	0xea, 0x63, 0x73, 0x73, 0x0f, 0x8a, 0x8b, 0xcb, 0x45, 0xe0, 0x5d, 0x2d, 0x02, 0xef, 0xcf, 0x22,
+	0xf0, 0xbe, 0x2d, 0x83, 0xd6, 0xd5, 0x32, 0x68, 0xfd, 0x5a, 0x06, 0xad, 0x4f, 0xc9, 0x84, 0xea,
+	0x69, 0x99, 0x86, 0x99, 0x60, 0xd1, 0x59, 0xb3, 0x93, 0xef, 0x71, 0xaa, 0xa2, 0xd5, 0x86, 0xbe,
+	0xc8, 0x84, 0x24, 0xeb, 0xd7, 0x29, 0xa6, 0x3c, 0x62, 0x22, 0x2f, 0x0b, 0xa2, 0x36, 0xd7, 0x5e,
+	0xcf, 0x67, 0x44, 0xa5, 0xbb, 0x76, 0x1b, 0x5f, 0xfe, 0x1b, 0x00, 0x91, 0x00, 0xb1, 0x1b, 0x1d,
+	0x03, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -138,6 +241,45 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.AdminBurnAllowed != that1.AdminBurnAllowed {
 		return false
 	}
+	if this.PendingAdmin != that1.PendingAdmin {
+		return false
+	}
+	if this.Burner != that1.Burner {
+		return false
+	}
+	if this.MetadataManager != that1.MetadataManager {
+		return false
+	}
+	return true
+}
+func (this *DenomMinter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMinter)
+	if !ok {
+		that2, ok := that.(DenomMinter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
+	if this.IsUnlimited != that1.IsUnlimited {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -160,6 +302,27 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataManager) > 0 {
+		i -= len(m.MetadataManager)
+		copy(dAtA[i:], m.MetadataManager)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataManager)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.AdminBurnAllowed {
 		i--
 		if m.AdminBurnAllowed {
@@ -180,6 +343,56 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsUnlimited {
+		i--
+		if m.IsUnlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -204,6 +417,36 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if m.AdminBurnAllowed {
 		n += 2
 	}
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.MetadataManager)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	if m.IsUnlimited {
+		n += 2
+	}
 	return n
 }
 
@@ -294,6 +537,238 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AdminBurnAllowed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUnlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUnlimited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
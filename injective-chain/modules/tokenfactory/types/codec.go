@@ -18,6 +18,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "injective/tokenfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "injective/tokenfactory/update-params", nil)
 	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "injective/tokenfactory/set-denom-metadata", nil)
+	cdc.RegisterConcrete(&MsgSetSupplyCap{}, "injective/tokenfactory/set-supply-cap", nil)
+	cdc.RegisterConcrete(&MsgCreateMintingSchedule{}, "injective/tokenfactory/create-minting-schedule", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, "injective/tokenfactory/accept-admin", nil)
+	cdc.RegisterConcrete(&MsgUpdateDenomRoles{}, "injective/tokenfactory/update-denom-roles", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMinter{}, "injective/tokenfactory/set-denom-minter", nil)
+	cdc.RegisterConcrete(&MsgRemoveDenomMinter{}, "injective/tokenfactory/remove-denom-minter", nil)
 	cdc.RegisterConcrete(&Params{}, "injective/tokenfactory/Params", nil)
 
 }
@@ -31,6 +37,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgChangeAdmin{},
 		&MsgUpdateParams{},
 		&MsgSetDenomMetadata{},
+		&MsgSetSupplyCap{},
+		&MsgCreateMintingSchedule{},
+		&MsgAcceptAdmin{},
+		&MsgUpdateDenomRoles{},
+		&MsgSetDenomMinter{},
+		&MsgRemoveDenomMinter{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
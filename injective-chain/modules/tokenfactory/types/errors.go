@@ -22,4 +22,11 @@ var (
 	ErrCreatorTooLong           = errors.Register(ModuleName, 11, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errors.Register(ModuleName, 12, "denom does not exist")
 	ErrAmountNotPositive        = errors.Register(ModuleName, 13, "amount has to be positive")
+	ErrSupplyCapExceeded        = errors.Register(ModuleName, 14, "supply cap exceeded")
+	ErrSupplyCapImmutable       = errors.Register(ModuleName, 15, "supply cap is immutable")
+	ErrInvalidSupplyCap         = errors.Register(ModuleName, 16, "invalid supply cap")
+	ErrInvalidMintingSchedule   = errors.Register(ModuleName, 17, "invalid minting schedule")
+	ErrNoPendingAdmin           = errors.Register(ModuleName, 18, "no pending admin")
+	ErrMintAllowanceExceeded    = errors.Register(ModuleName, 19, "mint allowance exceeded")
+	ErrInvalidDenomMinter       = errors.Register(ModuleName, 20, "invalid denom minter")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -15,6 +16,7 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 
 	HasSupply(ctx context.Context, denom string) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -40,4 +42,6 @@ type CommunityPoolKeeper interface {
 type PermissionsKeeper interface {
 	HasNamespace(ctx sdk.Context, denom string) bool
 	HasPermissionsForAction(ctx sdk.Context, denom string, actor sdk.AccAddress, action permissionstypes.Action) bool
+	ConsumeActionQuota(ctx sdk.Context, denom string, actor sdk.AccAddress, action permissionstypes.Action, amount math.Int) error
+	IsAccountFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
 }
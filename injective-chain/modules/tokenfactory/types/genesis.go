@@ -13,8 +13,9 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		FactoryDenoms: []GenesisDenom{},
+		Params:           DefaultParams(),
+		FactoryDenoms:    []GenesisDenom{},
+		MintingSchedules: []MintingSchedule{},
 	}
 }
 
@@ -45,6 +46,52 @@ func (gs GenesisState) Validate() error {
 				return errors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid authority metadata (%s)", err)
+		}
+
+		if denom.SupplyCap != nil {
+			if err := denom.SupplyCap.Validate(); err != nil {
+				return err
+			}
+		}
+
+		seenMinters := map[string]bool{}
+
+		for _, minter := range denom.Minters {
+			if seenMinters[minter.Address] {
+				return errors.Wrapf(ErrInvalidGenesis, "duplicate minter %s for denom: %s", minter.Address, denom.GetDenom())
+			}
+			seenMinters[minter.Address] = true
+
+			if err := minter.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	seenScheduleIDs := map[uint64]bool{}
+	denomScheduleCounts := map[string]int{}
+
+	for _, schedule := range gs.GetMintingSchedules() {
+		if schedule.Id == 0 || seenScheduleIDs[schedule.Id] {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid or duplicate minting schedule id: %d", schedule.Id)
+		}
+		seenScheduleIDs[schedule.Id] = true
+
+		if !seenDenoms[schedule.Denom] {
+			return errors.Wrapf(ErrInvalidGenesis, "minting schedule %d for unknown denom: %s", schedule.Id, schedule.Denom)
+		}
+
+		denomScheduleCounts[schedule.Denom]++
+		if denomScheduleCounts[schedule.Denom] > MaxMintingSchedulesPerDenom {
+			return errors.Wrapf(ErrInvalidGenesis, "more than %d minting schedules for denom: %s", MaxMintingSchedulesPerDenom, schedule.Denom)
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -26,8 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the tokenfactory module's genesis state.
 type GenesisState struct {
 	// params defines the parameters of the module.
-	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FactoryDenoms    []GenesisDenom    `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	MintingSchedules []MintingSchedule `protobuf:"bytes,3,rep,name=minting_schedules,json=mintingSchedules,proto3" json:"minting_schedules" yaml:"minting_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +78,13 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetMintingSchedules() []MintingSchedule {
+	if m != nil {
+		return m.MintingSchedules
+	}
+	return nil
+}
+
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
 // denom's admin.
@@ -91,6 +99,10 @@ type GenesisDenom struct {
 	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
 	// The number of decimals
 	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
+	// The supply cap, if any
+	SupplyCap *DenomSupplyCap `protobuf:"bytes,6,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty" yaml:"supply_cap"`
+	// The minters
+	Minters []DenomMinter `protobuf:"bytes,7,rep,name=minters,proto3" json:"minters" yaml:"minters"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -161,6 +173,20 @@ func (m *GenesisDenom) GetDecimals() uint32 {
 	return 0
 }
 
+func (m *GenesisDenom) GetSupplyCap() *DenomSupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMinters() []DenomMinter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "injective.tokenfactory.v1beta1.GenesisDenom")
@@ -171,36 +197,43 @@ func init() {
 }
 
 var fileDescriptor_a7bae9323951328f = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0xae, 0xab, 0xc0, 0x5d, 0x19, 0x33, 0x20, 0x85, 0x49, 0x24, 0xc5, 0x48, 0x53,
-	0x11, 0x23, 0xd6, 0x86, 0xb4, 0xc3, 0x6e, 0x44, 0x95, 0x10, 0x12, 0x48, 0xc8, 0xdc, 0xb8, 0x4c,
-	0x4e, 0x6a, 0xda, 0x40, 0x1c, 0x57, 0xb1, 0x3b, 0x29, 0xdf, 0x80, 0x23, 0x1f, 0x81, 0x8f, 0xd3,
-	0xe3, 0x8e, 0x9c, 0x22, 0xd4, 0x5e, 0x38, 0xe7, 0x13, 0xa0, 0xda, 0x5e, 0xb4, 0x51, 0x69, 0xbd,
-	0xd9, 0xef, 0xfd, 0xfe, 0xef, 0xfd, 0xdf, 0xb3, 0xe1, 0x71, 0x9a, 0x7f, 0xe3, 0x89, 0x4e, 0x2f,
-	0x39, 0xd1, 0xf2, 0x3b, 0xcf, 0xbf, 0xb2, 0x44, 0xcb, 0xa2, 0x24, 0x97, 0x27, 0x31, 0xd7, 0xec,
-	0x84, 0x4c, 0x78, 0xce, 0x55, 0xaa, 0xc2, 0x59, 0x21, 0xb5, 0x44, 0x7e, 0x43, 0x87, 0x37, 0xe9,
-	0xd0, 0xd1, 0x87, 0x8f, 0x27, 0x72, 0x22, 0x0d, 0x4a, 0xd6, 0x27, 0xab, 0x3a, 0x3c, 0xdb, 0xd2,
-	0x83, 0xcd, 0xf5, 0x54, 0x16, 0xa9, 0x2e, 0x3f, 0x72, 0xcd, 0xc6, 0x4c, 0x33, 0xa7, 0x7b, 0xb5,
-	0x45, 0x37, 0x63, 0x05, 0x13, 0xce, 0x1a, 0x5e, 0x00, 0xb8, 0xf7, 0xce, 0x9a, 0xfd, 0xac, 0x99,
-	0xe6, 0x68, 0x04, 0xbb, 0x16, 0xf0, 0xc0, 0x00, 0x0c, 0x7b, 0xa7, 0x47, 0xe1, 0xdd, 0xe6, 0xc3,
-	0x4f, 0x86, 0x8e, 0x3a, 0x8b, 0x2a, 0x68, 0x51, 0xa7, 0x45, 0x05, 0x7c, 0xe0, 0xb8, 0x8b, 0x31,
-	0xcf, 0xa5, 0x50, 0x5e, 0x7b, 0xb0, 0x33, 0xec, 0x9d, 0x1e, 0x6f, 0xab, 0xe6, 0xbc, 0x8c, 0xd6,
-	0xa2, 0xe8, 0xd9, 0xba, 0x66, 0x5d, 0x05, 0x4f, 0x4a, 0x26, 0xb2, 0x73, 0x7c, 0xbb, 0x22, 0xa6,
-	0x7d, 0x17, 0x18, 0xd9, 0xfb, 0xa2, 0xdd, 0x8c, 0x62, 0x22, 0xe8, 0x08, 0xee, 0x1a, 0xd4, 0x4c,
-	0x72, 0x3f, 0x7a, 0x58, 0x57, 0xc1, 0x9e, 0xad, 0x64, 0xc2, 0x98, 0xda, 0x34, 0xfa, 0x01, 0x20,
-	0x6a, 0x96, 0x79, 0x21, 0xdc, 0x36, 0xbd, 0xb6, 0x99, 0xff, 0x6c, 0x9b, 0x63, 0xd3, 0xeb, 0xed,
-	0xff, 0x6f, 0x11, 0x3d, 0x77, 0xde, 0x9f, 0xda, 0x8e, 0x9b, 0xf5, 0x31, 0x3d, 0xd8, 0x78, 0x41,
-	0xf4, 0x02, 0x76, 0x72, 0x26, 0xb8, 0xb7, 0x63, 0x1c, 0xef, 0xd7, 0x55, 0xd0, 0xb3, 0xfa, 0x75,
-	0x14, 0x53, 0x93, 0x44, 0x2f, 0x61, 0x57, 0x95, 0x22, 0x96, 0x99, 0xd7, 0x31, 0xd8, 0x41, 0x5d,
-	0x05, 0x7d, 0x8b, 0xd9, 0x38, 0xa6, 0x0e, 0x40, 0x04, 0xde, 0x1b, 0xf3, 0x24, 0x15, 0x2c, 0x53,
-	0xde, 0xee, 0x00, 0x0c, 0xfb, 0xd1, 0xa3, 0xba, 0x0a, 0xf6, 0xaf, 0xb7, 0x60, 0x33, 0x98, 0x36,
-	0xd0, 0x79, 0xe7, 0xef, 0xaf, 0x00, 0x44, 0xd9, 0x62, 0xe9, 0x83, 0xab, 0xa5, 0x0f, 0xfe, 0x2c,
-	0x7d, 0xf0, 0x73, 0xe5, 0xb7, 0xae, 0x56, 0x7e, 0xeb, 0xf7, 0xca, 0x6f, 0x7d, 0xa1, 0x93, 0x54,
-	0x4f, 0xe7, 0x71, 0x98, 0x48, 0x41, 0xde, 0x5f, 0x2f, 0xe6, 0x03, 0x8b, 0x15, 0x69, 0xd6, 0xf4,
-	0x3a, 0x91, 0x05, 0xbf, 0x79, 0x9d, 0xb2, 0x34, 0x27, 0x42, 0x8e, 0xe7, 0x19, 0x57, 0xb7, 0xbf,
-	0xa4, 0x2e, 0x67, 0x5c, 0xc5, 0x5d, 0xf3, 0x15, 0xdf, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xb1,
-	0x74, 0x8d, 0xe9, 0x55, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xad, 0xeb, 0x98, 0xb7, 0x6e, 0xab, 0x61, 0x28, 0x4c, 0x22, 0x29, 0x46, 0x9a,
+	0x8a, 0x36, 0x12, 0x6d, 0x48, 0x3b, 0xec, 0x46, 0x98, 0x84, 0x90, 0xa8, 0x84, 0xd2, 0x1b, 0x12,
+	0xaa, 0xdc, 0xd4, 0xb4, 0x81, 0x38, 0x8e, 0x62, 0x77, 0x52, 0x2e, 0x9c, 0xb9, 0x20, 0xf1, 0x11,
+	0xf8, 0x38, 0x3d, 0xee, 0xc8, 0x29, 0x42, 0xed, 0x85, 0x73, 0x3e, 0x01, 0xaa, 0xed, 0x86, 0x95,
+	0x4a, 0xb4, 0xb7, 0xf6, 0xf9, 0xf7, 0xff, 0xff, 0x9f, 0x9f, 0x5f, 0xc0, 0x59, 0x18, 0x7f, 0x22,
+	0x81, 0x08, 0x6f, 0x88, 0x2b, 0xd8, 0x67, 0x12, 0x7f, 0xc4, 0x81, 0x60, 0x69, 0xe6, 0xde, 0x9c,
+	0xf7, 0x88, 0xc0, 0xe7, 0xee, 0x80, 0xc4, 0x84, 0x87, 0xdc, 0x49, 0x52, 0x26, 0x18, 0xb4, 0x4a,
+	0xda, 0xb9, 0x4b, 0x3b, 0x9a, 0x3e, 0x7e, 0x30, 0x60, 0x03, 0x26, 0x51, 0x77, 0xf6, 0x4b, 0xa9,
+	0x8e, 0x2f, 0x57, 0x64, 0xe0, 0x91, 0x18, 0xb2, 0x34, 0x14, 0x59, 0x9b, 0x08, 0xdc, 0xc7, 0x02,
+	0x6b, 0xdd, 0xe9, 0x0a, 0x5d, 0x82, 0x53, 0x4c, 0xf9, 0x9a, 0x30, 0x1f, 0x25, 0x49, 0x94, 0x29,
+	0x18, 0x8d, 0x37, 0xc0, 0xde, 0x6b, 0x75, 0xb3, 0x8e, 0xc0, 0x82, 0xc0, 0x6b, 0x50, 0x53, 0x6e,
+	0xa6, 0xd1, 0x34, 0x5a, 0xbb, 0x17, 0x27, 0xce, 0xff, 0x6f, 0xea, 0xbc, 0x93, 0xb4, 0x57, 0x1d,
+	0xe7, 0x76, 0xc5, 0xd7, 0x5a, 0x98, 0x82, 0x7d, 0xcd, 0x75, 0xfb, 0x24, 0x66, 0x94, 0x9b, 0x1b,
+	0xcd, 0xcd, 0xd6, 0xee, 0xc5, 0xd9, 0x2a, 0x37, 0xdd, 0xcb, 0xf5, 0x4c, 0xe4, 0x3d, 0x9e, 0x79,
+	0x16, 0xb9, 0x7d, 0x94, 0x61, 0x1a, 0x5d, 0xa1, 0x45, 0x47, 0xe4, 0xd7, 0x75, 0x41, 0xc2, 0x1c,
+	0x7e, 0x01, 0x0d, 0x1a, 0xc6, 0x22, 0x8c, 0x07, 0x5d, 0x1e, 0x0c, 0x49, 0x7f, 0x14, 0x11, 0x6e,
+	0x6e, 0xca, 0x58, 0x77, 0x55, 0x6c, 0x5b, 0x09, 0x3b, 0x5a, 0xe7, 0x35, 0x75, 0xb2, 0xa9, 0x92,
+	0x97, 0x7c, 0x91, 0x7f, 0x48, 0x17, 0x25, 0x1c, 0x7d, 0xab, 0x96, 0xa3, 0x94, 0x1d, 0xc1, 0x13,
+	0xb0, 0x25, 0x5b, 0x95, 0x93, 0xdc, 0xf1, 0x0e, 0x8b, 0xdc, 0xde, 0x53, 0x7e, 0xb2, 0x8c, 0x7c,
+	0x75, 0x0c, 0xbf, 0x1a, 0x00, 0x96, 0x2f, 0xdf, 0xa5, 0xfa, 0xe9, 0xcd, 0x0d, 0x39, 0xff, 0xcb,
+	0x55, 0xad, 0xcb, 0xac, 0x97, 0xff, 0x2e, 0x8e, 0xf7, 0x44, 0xdf, 0xe0, 0x91, 0x4a, 0x5c, 0xf6,
+	0x47, 0x7e, 0x63, 0x69, 0xdd, 0xe0, 0x53, 0x50, 0x8d, 0x31, 0x25, 0xe6, 0xa6, 0xec, 0xf8, 0xa0,
+	0xc8, 0xed, 0x5d, 0xa5, 0x9f, 0x55, 0x91, 0x2f, 0x0f, 0xe1, 0x33, 0x50, 0xe3, 0x19, 0xed, 0xb1,
+	0xc8, 0xac, 0x4a, 0xac, 0x51, 0xe4, 0x76, 0x5d, 0x61, 0xaa, 0x8e, 0x7c, 0x0d, 0x40, 0x17, 0xdc,
+	0xeb, 0x93, 0x20, 0xa4, 0x38, 0xe2, 0xe6, 0x56, 0xd3, 0x68, 0xd5, 0xbd, 0xfb, 0x45, 0x6e, 0x1f,
+	0xcc, 0xa7, 0xa0, 0x4e, 0x90, 0x5f, 0x42, 0xb0, 0x0f, 0x80, 0xda, 0xcf, 0x6e, 0x80, 0x13, 0xb3,
+	0x26, 0x47, 0xe0, 0xac, 0x35, 0x82, 0x8e, 0x94, 0xbd, 0xc2, 0x89, 0x77, 0x54, 0xe4, 0x76, 0x43,
+	0xf7, 0x53, 0x7a, 0x21, 0x7f, 0x87, 0xcf, 0x09, 0xf8, 0x01, 0x6c, 0xcf, 0x9e, 0x8f, 0xa4, 0xdc,
+	0xdc, 0x96, 0x0b, 0x72, 0xba, 0x56, 0x44, 0x5b, 0x6a, 0xbc, 0x87, 0x7a, 0xb4, 0xfb, 0x7f, 0x97,
+	0x83, 0xa4, 0x1c, 0xf9, 0x73, 0xcf, 0xab, 0xea, 0xef, 0x1f, 0xb6, 0xe1, 0x45, 0xe3, 0x89, 0x65,
+	0xdc, 0x4e, 0x2c, 0xe3, 0xd7, 0xc4, 0x32, 0xbe, 0x4f, 0xad, 0xca, 0xed, 0xd4, 0xaa, 0xfc, 0x9c,
+	0x5a, 0x95, 0xf7, 0xfe, 0x20, 0x14, 0xc3, 0x51, 0xcf, 0x09, 0x18, 0x75, 0xdf, 0xcc, 0x73, 0xdf,
+	0xe2, 0x1e, 0x77, 0xcb, 0x2e, 0x9e, 0x07, 0x2c, 0x25, 0x77, 0xff, 0x0e, 0x71, 0x18, 0xbb, 0x94,
+	0xc9, 0x55, 0x5b, 0xfc, 0xae, 0x45, 0x96, 0x10, 0xde, 0xab, 0xc9, 0xef, 0xf9, 0xc5, 0x9f, 0x01,
+	0x00, 0x4d, 0xf3, 0xd6, 0x98, 0xc7, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -237,6 +270,17 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if this.Decimals != that1.Decimals {
 		return false
 	}
+	if !this.SupplyCap.Equal(that1.SupplyCap) {
+		return false
+	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if !this.Minters[i].Equal(&that1.Minters[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -259,6 +303,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintingSchedules) > 0 {
+		for iNdEx := len(m.MintingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -306,6 +364,32 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SupplyCap != nil {
+		{
+			size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Decimals != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
 		i--
@@ -370,6 +454,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MintingSchedules) > 0 {
+		for _, e := range m.MintingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -396,6 +486,16 @@ func (m *GenesisDenom) Size() (n int) {
 	if m.Decimals != 0 {
 		n += 1 + sovGenesis(uint64(m.Decimals))
 	}
+	if m.SupplyCap != nil {
+		l = m.SupplyCap.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +601,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintingSchedules = append(m.MintingSchedules, MintingSchedule{})
+			if err := m.MintingSchedules[len(m.MintingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -699,6 +833,76 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SupplyCap == nil {
+				m.SupplyCap = &DenomSupplyCap{}
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, DenomMinter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
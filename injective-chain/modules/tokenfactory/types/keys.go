@@ -2,6 +2,8 @@ package types
 
 import (
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -25,11 +27,16 @@ const (
 const KeySeparator = "|"
 
 var (
-	DenomAuthorityMetadataKey = []byte{0x01}
-	DenomsPrefixKey           = []byte{0x02}
-	CreatorPrefixKey          = []byte{0x03}
-	AdminPrefixKey            = []byte{0x04}
-	ParamsKey                 = []byte{0x05}
+	DenomAuthorityMetadataKey  = []byte{0x01}
+	DenomsPrefixKey            = []byte{0x02}
+	CreatorPrefixKey           = []byte{0x03}
+	AdminPrefixKey             = []byte{0x04}
+	ParamsKey                  = []byte{0x05}
+	SupplyCapKey               = []byte{0x06}
+	MintingSchedulesPrefix     = []byte{0x07}
+	MintingScheduleIDKey       = []byte{0x08}
+	DenomMintersPrefix         = []byte{0x09}
+	MintingScheduleQueuePrefix = []byte{0x0a}
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -48,3 +55,25 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return append(CreatorPrefixKey, []byte(KeySeparator)...)
 }
+
+// GetMintingScheduleKey returns the key of the minting schedule with the given ID within the denom prefix store
+func GetMintingScheduleKey(id uint64) []byte {
+	return append(MintingSchedulesPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMintingScheduleQueueKey returns the store key indexing the minting schedule with the given ID by the next block
+// time (unix seconds) at which it has an amount to mint
+func GetMintingScheduleQueueKey(vestTime int64, id uint64) []byte {
+	return append(GetMintingScheduleQueueTimePrefix(vestTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMintingScheduleQueueTimePrefix returns the store prefix of the minting schedules with an amount to mint at the
+// given block time (unix seconds)
+func GetMintingScheduleQueueTimePrefix(vestTime int64) []byte {
+	return append(MintingScheduleQueuePrefix, sdk.Uint64ToBigEndian(uint64(vestTime))...)
+}
+
+// GetDenomMinterKey returns the key of a minter within the denom prefix store
+func GetDenomMinterKey(minter sdk.AccAddress) []byte {
+	return append(DenomMintersPrefix, minter.Bytes()...)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -19,6 +20,13 @@ const (
 	TypeMsgChangeAdmin      = "change_admin"
 	TypeMsgSetDenomMetadata = "set_denom_metadata"
 	TypeMsgUpdateParams     = "update_params"
+
+	TypeMsgSetSupplyCap          = "set_supply_cap"
+	TypeMsgCreateMintingSchedule = "create_minting_schedule"
+	TypeMsgAcceptAdmin           = "accept_admin"
+	TypeMsgUpdateDenomRoles      = "update_denom_roles"
+	TypeMsgSetDenomMinter        = "set_denom_minter"
+	TypeMsgRemoveDenomMinter     = "remove_denom_minter"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -27,6 +35,12 @@ var _ sdk.Msg = &MsgBurn{}
 var _ sdk.Msg = &MsgSetDenomMetadata{}
 var _ sdk.Msg = &MsgChangeAdmin{}
 var _ sdk.Msg = &MsgUpdateParams{}
+var _ sdk.Msg = &MsgSetSupplyCap{}
+var _ sdk.Msg = &MsgCreateMintingSchedule{}
+var _ sdk.Msg = &MsgAcceptAdmin{}
+var _ sdk.Msg = &MsgUpdateDenomRoles{}
+var _ sdk.Msg = &MsgSetDenomMinter{}
+var _ sdk.Msg = &MsgRemoveDenomMinter{}
 
 func (m MsgUpdateParams) Route() string { return RouterKey }
 
@@ -185,7 +199,7 @@ func (m MsgBurn) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// NewMsgChangeAdmin creates a message to change the admin
+// NewMsgChangeAdmin creates a message to propose a new admin
 func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
 	return &MsgChangeAdmin{
 		Sender:   sender,
@@ -275,3 +289,243 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgSetSupplyCap creates a message to set the supply cap of a denom
+func NewMsgSetSupplyCap(sender, denom string, maxSupply math.Int, isImmutable bool) *MsgSetSupplyCap {
+	return &MsgSetSupplyCap{
+		Sender:      sender,
+		Denom:       denom,
+		MaxSupply:   maxSupply,
+		IsImmutable: isImmutable,
+	}
+}
+
+func (m MsgSetSupplyCap) Route() string { return RouterKey }
+func (m MsgSetSupplyCap) Type() string  { return TypeMsgSetSupplyCap }
+func (m MsgSetSupplyCap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	supplyCap := DenomSupplyCap{MaxSupply: m.MaxSupply, IsImmutable: m.IsImmutable}
+	return supplyCap.Validate()
+}
+
+func (m *MsgSetSupplyCap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetSupplyCap) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgCreateMintingSchedule creates a message to create a minting schedule
+func NewMsgCreateMintingSchedule(sender, recipient string, totalAmount sdk.Coin, startTime, cliffDuration, duration int64) *MsgCreateMintingSchedule {
+	return &MsgCreateMintingSchedule{
+		Sender:        sender,
+		Recipient:     recipient,
+		TotalAmount:   totalAmount,
+		StartTime:     startTime,
+		CliffDuration: cliffDuration,
+		Duration:      duration,
+	}
+}
+
+func (m MsgCreateMintingSchedule) Route() string { return RouterKey }
+func (m MsgCreateMintingSchedule) Type() string  { return TypeMsgCreateMintingSchedule }
+func (m MsgCreateMintingSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !m.TotalAmount.IsValid() || m.TotalAmount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, m.TotalAmount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.TotalAmount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateMintingScheduleTimes(m.StartTime, m.CliffDuration, m.Duration)
+}
+
+func (m *MsgCreateMintingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCreateMintingSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgAcceptAdmin creates a message to accept the adminship of a denom
+func NewMsgAcceptAdmin(sender, denom string) *MsgAcceptAdmin {
+	return &MsgAcceptAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgAcceptAdmin) Route() string { return RouterKey }
+func (m MsgAcceptAdmin) Type() string  { return TypeMsgAcceptAdmin }
+func (m MsgAcceptAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgUpdateDenomRoles creates a message to update the burner and metadata manager of a denom
+func NewMsgUpdateDenomRoles(sender, denom, burner, metadataManager string) *MsgUpdateDenomRoles {
+	return &MsgUpdateDenomRoles{
+		Sender:          sender,
+		Denom:           denom,
+		Burner:          burner,
+		MetadataManager: metadataManager,
+	}
+}
+
+func (m MsgUpdateDenomRoles) Route() string { return RouterKey }
+func (m MsgUpdateDenomRoles) Type() string  { return TypeMsgUpdateDenomRoles }
+func (m MsgUpdateDenomRoles) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.Burner != "" {
+		_, err = sdk.AccAddressFromBech32(m.Burner)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid burner address (%s)", err)
+		}
+	}
+
+	if m.MetadataManager != "" {
+		_, err = sdk.AccAddressFromBech32(m.MetadataManager)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid metadata manager address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateDenomRoles) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateDenomRoles) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetDenomMinter creates a message to add a minter to a denom or update its allowance
+func NewMsgSetDenomMinter(sender, denom, minter string, allowance math.Int, isUnlimited bool) *MsgSetDenomMinter {
+	return &MsgSetDenomMinter{
+		Sender:      sender,
+		Denom:       denom,
+		Minter:      minter,
+		Allowance:   allowance,
+		IsUnlimited: isUnlimited,
+	}
+}
+
+func (m MsgSetDenomMinter) Route() string { return RouterKey }
+func (m MsgSetDenomMinter) Type() string  { return TypeMsgSetDenomMinter }
+func (m MsgSetDenomMinter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	minter := DenomMinter{Address: m.Minter, Allowance: m.Allowance, IsUnlimited: m.IsUnlimited}
+	return minter.Validate()
+}
+
+func (m *MsgSetDenomMinter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetDenomMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRemoveDenomMinter creates a message to remove a minter from a denom
+func NewMsgRemoveDenomMinter(sender, denom, minter string) *MsgRemoveDenomMinter {
+	return &MsgRemoveDenomMinter{
+		Sender: sender,
+		Denom:  denom,
+		Minter: minter,
+	}
+}
+
+func (m MsgRemoveDenomMinter) Route() string { return RouterKey }
+func (m MsgRemoveDenomMinter) Type() string  { return TypeMsgRemoveDenomMinter }
+func (m MsgRemoveDenomMinter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MsgRemoveDenomMinter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRemoveDenomMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -310,6 +311,322 @@ func (m *QueryDenomsFromCreatorResponse) GetDenoms() []string {
 	return nil
 }
 
+// QueryDenomSupplyCapRequest defines the request structure for the
+// DenomSupplyCap gRPC query.
+type QueryDenomSupplyCapRequest struct {
+	// The creator's Injective address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// The sub-denom
+	SubDenom string `protobuf:"bytes,2,opt,name=sub_denom,json=subDenom,proto3" json:"sub_denom,omitempty" yaml:"sub_denom"`
+}
+
+func (m *QueryDenomSupplyCapRequest) Reset()         { *m = QueryDenomSupplyCapRequest{} }
+func (m *QueryDenomSupplyCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyCapRequest) ProtoMessage()    {}
+func (*QueryDenomSupplyCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{6}
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyCapRequest.Merge(m, src)
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyCapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyCapRequest proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyCapRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomSupplyCapRequest) GetSubDenom() string {
+	if m != nil {
+		return m.SubDenom
+	}
+	return ""
+}
+
+// QueryDenomSupplyCapResponse defines the response structure for the
+// DenomSupplyCap gRPC query.
+type QueryDenomSupplyCapResponse struct {
+	// The supply cap, nil if the supply isn't capped
+	SupplyCap *DenomSupplyCap `protobuf:"bytes,1,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty" yaml:"supply_cap"`
+	// The current total supply
+	CurrentSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=current_supply,json=currentSupply,proto3,customtype=cosmossdk.io/math.Int" json:"current_supply" yaml:"current_supply"`
+	// The amount still to be minted by the minting schedules
+	ScheduledSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=scheduled_supply,json=scheduledSupply,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled_supply" yaml:"scheduled_supply"`
+	// The amount that can still be minted outside of the minting schedules, only
+	// set if the supply is capped
+	UnscheduledMintable cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=unscheduled_mintable,json=unscheduledMintable,proto3,customtype=cosmossdk.io/math.Int" json:"unscheduled_mintable" yaml:"unscheduled_mintable"`
+}
+
+func (m *QueryDenomSupplyCapResponse) Reset()         { *m = QueryDenomSupplyCapResponse{} }
+func (m *QueryDenomSupplyCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyCapResponse) ProtoMessage()    {}
+func (*QueryDenomSupplyCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{7}
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyCapResponse.Merge(m, src)
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyCapResponse proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyCapResponse) GetSupplyCap() *DenomSupplyCap {
+	if m != nil {
+		return m.SupplyCap
+	}
+	return nil
+}
+
+// QueryDenomMintingSchedulesRequest defines the request structure for the
+// DenomMintingSchedules gRPC query.
+type QueryDenomMintingSchedulesRequest struct {
+	// The creator's Injective address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// The sub-denom
+	SubDenom string `protobuf:"bytes,2,opt,name=sub_denom,json=subDenom,proto3" json:"sub_denom,omitempty" yaml:"sub_denom"`
+}
+
+func (m *QueryDenomMintingSchedulesRequest) Reset()         { *m = QueryDenomMintingSchedulesRequest{} }
+func (m *QueryDenomMintingSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintingSchedulesRequest) ProtoMessage()    {}
+func (*QueryDenomMintingSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{8}
+}
+func (m *QueryDenomMintingSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintingSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintingSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintingSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintingSchedulesRequest.Merge(m, src)
+}
+func (m *QueryDenomMintingSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintingSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintingSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintingSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintingSchedulesRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomMintingSchedulesRequest) GetSubDenom() string {
+	if m != nil {
+		return m.SubDenom
+	}
+	return ""
+}
+
+// QueryDenomMintingSchedulesResponse defines the response structure for the
+// DenomMintingSchedules gRPC query.
+type QueryDenomMintingSchedulesResponse struct {
+	// The minting schedules that haven't been fully minted yet
+	Schedules []MintingSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules" yaml:"schedules"`
+}
+
+func (m *QueryDenomMintingSchedulesResponse) Reset()         { *m = QueryDenomMintingSchedulesResponse{} }
+func (m *QueryDenomMintingSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintingSchedulesResponse) ProtoMessage()    {}
+func (*QueryDenomMintingSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{9}
+}
+func (m *QueryDenomMintingSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintingSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintingSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintingSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintingSchedulesResponse.Merge(m, src)
+}
+func (m *QueryDenomMintingSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintingSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintingSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintingSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintingSchedulesResponse) GetSchedules() []MintingSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+// QueryDenomMintersRequest defines the request structure for the
+// DenomMinters gRPC query.
+type QueryDenomMintersRequest struct {
+	// The creator's Injective address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// The sub-denom
+	SubDenom string `protobuf:"bytes,2,opt,name=sub_denom,json=subDenom,proto3" json:"sub_denom,omitempty" yaml:"sub_denom"`
+}
+
+func (m *QueryDenomMintersRequest) Reset()         { *m = QueryDenomMintersRequest{} }
+func (m *QueryDenomMintersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintersRequest) ProtoMessage()    {}
+func (*QueryDenomMintersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{10}
+}
+func (m *QueryDenomMintersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintersRequest.Merge(m, src)
+}
+func (m *QueryDenomMintersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintersRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintersRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomMintersRequest) GetSubDenom() string {
+	if m != nil {
+		return m.SubDenom
+	}
+	return ""
+}
+
+// QueryDenomMintersResponse defines the response structure for the
+// DenomMinters gRPC query.
+type QueryDenomMintersResponse struct {
+	// The minters on top of the admin
+	Minters []DenomMinter `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters" yaml:"minters"`
+}
+
+func (m *QueryDenomMintersResponse) Reset()         { *m = QueryDenomMintersResponse{} }
+func (m *QueryDenomMintersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintersResponse) ProtoMessage()    {}
+func (*QueryDenomMintersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{11}
+}
+func (m *QueryDenomMintersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintersResponse.Merge(m, src)
+}
+func (m *QueryDenomMintersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintersResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintersResponse) GetMinters() []DenomMinter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
 // QueryModuleStateRequest is the request type for the
 // Query/TokenfactoryModuleState RPC method.
 type QueryModuleStateRequest struct {
@@ -319,7 +636,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a5ba391b550eeda, []int{6}
+	return fileDescriptor_5a5ba391b550eeda, []int{12}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +676,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a5ba391b550eeda, []int{7}
+	return fileDescriptor_5a5ba391b550eeda, []int{13}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,6 +719,12 @@ func init() {
 	proto.RegisterType((*QueryDenomAuthorityMetadataResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse")
 	proto.RegisterType((*QueryDenomsFromCreatorRequest)(nil), "injective.tokenfactory.v1beta1.QueryDenomsFromCreatorRequest")
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
+	proto.RegisterType((*QueryDenomSupplyCapRequest)(nil), "injective.tokenfactory.v1beta1.QueryDenomSupplyCapRequest")
+	proto.RegisterType((*QueryDenomSupplyCapResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomSupplyCapResponse")
+	proto.RegisterType((*QueryDenomMintingSchedulesRequest)(nil), "injective.tokenfactory.v1beta1.QueryDenomMintingSchedulesRequest")
+	proto.RegisterType((*QueryDenomMintingSchedulesResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomMintingSchedulesResponse")
+	proto.RegisterType((*QueryDenomMintersRequest)(nil), "injective.tokenfactory.v1beta1.QueryDenomMintersRequest")
+	proto.RegisterType((*QueryDenomMintersResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomMintersResponse")
 	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.tokenfactory.v1beta1.QueryModuleStateRequest")
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.tokenfactory.v1beta1.QueryModuleStateResponse")
 }
@@ -411,51 +734,74 @@ func init() {
 }
 
 var fileDescriptor_5a5ba391b550eeda = []byte{
-	// 703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xee, 0xa2, 0x54, 0x19, 0x3f, 0x02, 0x23, 0x11, 0x68, 0x74, 0xab, 0x63, 0x42, 0x10, 0xeb,
-	0x4e, 0x00, 0x83, 0xc6, 0x04, 0x8d, 0x0b, 0x6a, 0x8c, 0x12, 0x65, 0x35, 0x31, 0xd1, 0xc4, 0x66,
-	0xb6, 0x0c, 0xcb, 0x6a, 0x77, 0xa7, 0xec, 0xcc, 0x92, 0x34, 0x84, 0x8b, 0x5e, 0x3c, 0x9a, 0xf8,
-	0x0b, 0xfc, 0x07, 0xfe, 0x06, 0x4f, 0x78, 0x23, 0xf1, 0xa2, 0x97, 0xc6, 0x80, 0xbf, 0xa0, 0x47,
-	0x4f, 0xa6, 0x33, 0xd3, 0x0f, 0x58, 0xec, 0x16, 0xbd, 0xb5, 0x33, 0xcf, 0xf3, 0xbc, 0xef, 0xf3,
-	0xce, 0xf3, 0x66, 0xc1, 0xa4, 0x1f, 0xbe, 0xa6, 0x25, 0xe1, 0xaf, 0x53, 0x2c, 0xd8, 0x1b, 0x1a,
-	0xae, 0x90, 0x92, 0x60, 0x51, 0x15, 0xaf, 0x4f, 0xb9, 0x54, 0x90, 0x29, 0xbc, 0x16, 0xd3, 0xa8,
-	0x6a, 0x55, 0x22, 0x26, 0x18, 0x34, 0x5b, 0x58, 0xab, 0x13, 0x6b, 0x69, 0x6c, 0x6e, 0xd8, 0x63,
-	0x1e, 0x93, 0x50, 0xdc, 0xf8, 0xa5, 0x58, 0xb9, 0x73, 0x1e, 0x63, 0x5e, 0x99, 0x62, 0x52, 0xf1,
-	0x31, 0x09, 0x43, 0x26, 0x88, 0xf0, 0x59, 0xc8, 0xf5, 0xed, 0x64, 0x89, 0xf1, 0x80, 0x71, 0xec,
-	0x12, 0x4e, 0x55, 0xb1, 0x56, 0xe9, 0x0a, 0xf1, 0xfc, 0x50, 0x82, 0x35, 0x76, 0x36, 0xa5, 0x57,
-	0x12, 0x8b, 0x55, 0x16, 0xf9, 0xa2, 0xba, 0x48, 0x05, 0x59, 0x26, 0x82, 0x68, 0xde, 0x95, 0x14,
-	0x5e, 0x85, 0x44, 0x24, 0x68, 0x36, 0x54, 0x48, 0x01, 0x7b, 0x34, 0xa4, 0xdc, 0xd7, 0x68, 0x34,
-	0x0c, 0xe0, 0x52, 0xa3, 0xe9, 0x27, 0x52, 0xc2, 0xa1, 0x6b, 0x31, 0xe5, 0x02, 0xbd, 0x04, 0x67,
-	0xf6, 0x9c, 0xf2, 0x0a, 0x0b, 0x39, 0x85, 0x0b, 0x20, 0xab, 0x4a, 0x8d, 0x1a, 0x17, 0x8c, 0x89,
-	0x13, 0xd3, 0xe3, 0x56, 0xf7, 0x81, 0x5a, 0x8a, 0x6f, 0x1f, 0xdd, 0xaa, 0xe5, 0x33, 0x8e, 0xe6,
-	0xa2, 0x77, 0x06, 0x40, 0x52, 0x7d, 0x81, 0x86, 0x2c, 0xb8, 0xb3, 0xdf, 0xb3, 0xee, 0x01, 0x4e,
-	0x82, 0x63, 0xa5, 0x88, 0x12, 0xc1, 0x22, 0x59, 0x6d, 0xc0, 0x1e, 0xac, 0xd7, 0xf2, 0x27, 0xab,
-	0x24, 0x28, 0xdf, 0x44, 0xcb, 0x0d, 0x26, 0x72, 0x9a, 0x00, 0x38, 0x05, 0x06, 0x78, 0xec, 0x16,
-	0xe5, 0xf1, 0x68, 0x9f, 0x44, 0x0f, 0xd7, 0x6b, 0xf9, 0x41, 0x85, 0x6e, 0x5d, 0x21, 0xe7, 0x38,
-	0x8f, 0x5d, 0x59, 0x16, 0x7d, 0x36, 0xc0, 0xa5, 0xae, 0x5d, 0x68, 0xcf, 0xef, 0x0d, 0x00, 0x5b,
-	0xef, 0x52, 0x0c, 0xf4, 0xb5, 0x1e, 0xc0, 0x6c, 0xda, 0x00, 0x0e, 0x16, 0xb7, 0x2f, 0x36, 0x06,
-	0x52, 0xaf, 0xe5, 0xc7, 0x54, 0x83, 0x49, 0x7d, 0xe4, 0x0c, 0x25, 0xc2, 0x80, 0x16, 0xc1, 0xf9,
-	0x76, 0xc7, 0xfc, 0x5e, 0xc4, 0x82, 0x79, 0xe5, 0xbf, 0x39, 0xb2, 0xc2, 0xfe, 0x91, 0xc1, 0x7a,
-	0x2d, 0x7f, 0x5a, 0xd5, 0xd0, 0x17, 0xed, 0xa1, 0xa1, 0x87, 0xc0, 0xfc, 0x9b, 0x9c, 0xf6, 0x7e,
-	0x19, 0x64, 0xe5, 0xdc, 0x1a, 0xef, 0x7d, 0x64, 0x62, 0xc0, 0x1e, 0xaa, 0xd7, 0xf2, 0xa7, 0x3a,
-	0x5e, 0x80, 0x23, 0x47, 0x03, 0xd0, 0x18, 0x18, 0x91, 0x62, 0x8b, 0x6c, 0x39, 0x2e, 0xd3, 0xa7,
-	0x82, 0x08, 0xda, 0x0c, 0xd3, 0x2b, 0x30, 0x9a, 0xbc, 0xd2, 0x15, 0x6c, 0xd0, 0xcf, 0x1b, 0x07,
-	0x7a, 0x9e, 0x85, 0xb4, 0x79, 0xde, 0x57, 0xe1, 0x55, 0x22, 0x8a, 0x3a, 0xfd, 0x35, 0x0b, 0xfa,
-	0x65, 0x01, 0xf8, 0xc9, 0x00, 0x59, 0x15, 0x39, 0x38, 0x9d, 0xa6, 0x94, 0x4c, 0x7d, 0x6e, 0xe6,
-	0x50, 0x1c, 0xe5, 0x00, 0x59, 0x6f, 0xbf, 0xfd, 0xfa, 0xd8, 0x37, 0x01, 0xc7, 0x71, 0x4f, 0x4b,
-	0x0a, 0x7f, 0x1b, 0xe0, 0xec, 0xc1, 0xa9, 0x80, 0x76, 0x4f, 0xf5, 0xbb, 0x6e, 0x4d, 0x6e, 0xfe,
-	0xbf, 0x34, 0xb4, 0xa7, 0xe7, 0xd2, 0xd3, 0x12, 0x7c, 0x9c, 0xe6, 0x49, 0x3d, 0x3e, 0xde, 0xd0,
-	0x91, 0xda, 0xc4, 0x1b, 0xad, 0x5d, 0xdb, 0xc4, 0xc9, 0x54, 0xc3, 0x1f, 0x06, 0x18, 0x4a, 0xc4,
-	0x0d, 0xce, 0xf5, 0xde, 0xf3, 0x01, 0xa9, 0xcf, 0xdd, 0xfa, 0x57, 0xba, 0x76, 0x7b, 0x57, 0xba,
-	0xbd, 0x0d, 0xe7, 0x7a, 0x73, 0x5b, 0x5c, 0x89, 0x58, 0x50, 0xd4, 0x8e, 0xdb, 0xd6, 0xe1, 0x17,
-	0x03, 0x8c, 0x3c, 0xeb, 0xa0, 0x75, 0xc4, 0x1d, 0x5e, 0xef, 0xa9, 0xc5, 0xe4, 0xee, 0xe4, 0x6e,
-	0x1c, 0x9e, 0xa8, 0x5d, 0x5d, 0x93, 0xae, 0x2c, 0x58, 0x48, 0x73, 0x15, 0x48, 0x72, 0x51, 0xee,
-	0x92, 0x5d, 0xde, 0xda, 0x31, 0x8d, 0xed, 0x1d, 0xd3, 0xf8, 0xb9, 0x63, 0x1a, 0x1f, 0x76, 0xcd,
-	0xcc, 0xf6, 0xae, 0x99, 0xf9, 0xbe, 0x6b, 0x66, 0x5e, 0x38, 0x9e, 0x2f, 0x56, 0x63, 0xd7, 0x2a,
-	0xb1, 0x00, 0x3f, 0x68, 0x2a, 0x3e, 0x22, 0x2e, 0x6f, 0xeb, 0x5f, 0x2d, 0xb1, 0x88, 0x76, 0xfe,
-	0x5d, 0x25, 0x7e, 0xa8, 0xf5, 0xf9, 0xde, 0xe2, 0xa2, 0x5a, 0xa1, 0xdc, 0xcd, 0xca, 0x6f, 0xd0,
-	0xcc, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x0e, 0x92, 0xf1, 0xc4, 0x07, 0x00, 0x00,
+	// 1067 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x9b, 0x36, 0x25, 0x93, 0x36, 0x24, 0xd3, 0xa4, 0xd9, 0xb8, 0xb0, 0x4b, 0x07, 0xa9,
+	0x0a, 0x69, 0xb0, 0x95, 0x14, 0x85, 0x50, 0x28, 0xa8, 0x4e, 0x68, 0x15, 0xd1, 0xd0, 0xd6, 0x41,
+	0x20, 0x81, 0xca, 0x6a, 0xd6, 0x3b, 0xdd, 0x35, 0x5d, 0x7b, 0x5c, 0xcf, 0xb8, 0xd2, 0x12, 0xe5,
+	0x02, 0x1c, 0xb8, 0x20, 0x21, 0xf1, 0x09, 0xf8, 0x06, 0x1c, 0x39, 0x73, 0xea, 0xb1, 0x88, 0x0b,
+	0xf4, 0xb0, 0x42, 0x09, 0x9f, 0x60, 0x91, 0x38, 0x70, 0xaa, 0x3c, 0x33, 0xde, 0xff, 0x8d, 0x9d,
+	0x5d, 0xa9, 0xb7, 0xed, 0x9b, 0xf7, 0x7e, 0x7f, 0xde, 0x1b, 0xcf, 0x6b, 0xc0, 0xb2, 0xeb, 0x7f,
+	0x45, 0x1c, 0xee, 0x3e, 0x22, 0x26, 0xa7, 0x0f, 0x88, 0x7f, 0x1f, 0x3b, 0x9c, 0x86, 0x75, 0xf3,
+	0xd1, 0x6a, 0x89, 0x70, 0xbc, 0x6a, 0x3e, 0x8c, 0x48, 0x58, 0x37, 0x82, 0x90, 0x72, 0x0a, 0xf3,
+	0xad, 0x5c, 0xa3, 0x33, 0xd7, 0x50, 0xb9, 0xfa, 0x5c, 0x85, 0x56, 0xa8, 0x48, 0x35, 0xe3, 0x5f,
+	0xb2, 0x4a, 0x7f, 0xa5, 0x42, 0x69, 0xa5, 0x46, 0x4c, 0x1c, 0xb8, 0x26, 0xf6, 0x7d, 0xca, 0x31,
+	0x77, 0xa9, 0xcf, 0xd4, 0xe9, 0xb2, 0x43, 0x99, 0x47, 0x99, 0x59, 0xc2, 0x8c, 0x48, 0xb2, 0x16,
+	0x75, 0x80, 0x2b, 0xae, 0x2f, 0x92, 0x55, 0xee, 0x7a, 0x8a, 0x56, 0x1c, 0xf1, 0x2a, 0x0d, 0x5d,
+	0x5e, 0xdf, 0x21, 0x1c, 0x97, 0x31, 0xc7, 0xaa, 0xee, 0x72, 0x4a, 0x5d, 0x80, 0x43, 0xec, 0x25,
+	0x82, 0x56, 0x52, 0x92, 0x2b, 0xc4, 0x27, 0xcc, 0x65, 0x19, 0xa1, 0x59, 0x14, 0x04, 0x35, 0xd5,
+	0x3f, 0x34, 0x07, 0xe0, 0xdd, 0xd8, 0xe1, 0x1d, 0xc1, 0x67, 0x93, 0x87, 0x11, 0x61, 0x1c, 0x7d,
+	0x01, 0xce, 0x75, 0x45, 0x59, 0x40, 0x7d, 0x46, 0xe0, 0x16, 0x98, 0x90, 0xba, 0x72, 0xda, 0x6b,
+	0xda, 0xd2, 0xd4, 0xda, 0x25, 0xe3, 0xe8, 0xee, 0x1b, 0xb2, 0xde, 0x3a, 0xf9, 0xb8, 0x51, 0x18,
+	0xb3, 0x55, 0x2d, 0xfa, 0x56, 0x03, 0x48, 0xa0, 0x6f, 0x11, 0x9f, 0x7a, 0xd7, 0x7b, 0x1b, 0xa4,
+	0x34, 0xc0, 0x65, 0x70, 0xda, 0x09, 0x09, 0xe6, 0x34, 0x14, 0x6c, 0x93, 0xd6, 0x4c, 0xb3, 0x51,
+	0x38, 0x53, 0xc7, 0x5e, 0xed, 0x2a, 0x2a, 0xc7, 0x95, 0xc8, 0x4e, 0x12, 0xe0, 0x2a, 0x98, 0x64,
+	0x51, 0xa9, 0x28, 0xc2, 0xb9, 0x13, 0x22, 0x7b, 0xae, 0xd9, 0x28, 0xcc, 0xc8, 0xec, 0xd6, 0x11,
+	0xb2, 0x5f, 0x62, 0x51, 0x49, 0xd0, 0xa2, 0x5f, 0x34, 0xf0, 0xfa, 0x91, 0x2a, 0x94, 0xe7, 0xef,
+	0x35, 0x00, 0x5b, 0x43, 0x2c, 0x7a, 0xea, 0x58, 0x35, 0x60, 0x3d, 0xad, 0x01, 0x83, 0xc1, 0xad,
+	0x8b, 0x71, 0x43, 0x9a, 0x8d, 0xc2, 0xa2, 0x14, 0xd8, 0x8f, 0x8f, 0xec, 0xd9, 0xbe, 0x9b, 0x83,
+	0x76, 0xc0, 0xab, 0x6d, 0xc5, 0xec, 0x46, 0x48, 0xbd, 0x4d, 0xe9, 0x3f, 0x69, 0xd9, 0x4a, 0x6f,
+	0xcb, 0x60, 0xb3, 0x51, 0x98, 0x96, 0x1c, 0xea, 0xa0, 0xdd, 0x34, 0xf4, 0x11, 0xc8, 0x3f, 0x0f,
+	0x4e, 0x79, 0x7f, 0x03, 0x4c, 0x88, 0xbe, 0xc5, 0xf3, 0x1e, 0x5f, 0x9a, 0xb4, 0x66, 0x9b, 0x8d,
+	0xc2, 0xd9, 0x8e, 0x09, 0x30, 0x64, 0xab, 0x04, 0xb4, 0x0f, 0xf4, 0x36, 0xd8, 0xae, 0xb8, 0x61,
+	0x9b, 0x38, 0x18, 0x4a, 0xd8, 0x30, 0xd3, 0xfc, 0x75, 0x1c, 0x5c, 0x18, 0xc8, 0xaf, 0x9c, 0x94,
+	0x01, 0x90, 0xd7, 0xbe, 0xe8, 0xe0, 0x40, 0x0d, 0xcf, 0xc8, 0x34, 0xbc, 0x16, 0x96, 0x35, 0xdf,
+	0x6c, 0x14, 0x66, 0x13, 0x0d, 0x09, 0x16, 0xb2, 0x27, 0x59, 0x92, 0x01, 0xef, 0x81, 0x69, 0x27,
+	0x0a, 0x43, 0xe2, 0xf3, 0xa2, 0x0c, 0x2a, 0xf5, 0xeb, 0xf1, 0xb8, 0x9f, 0x36, 0x0a, 0xf3, 0xf2,
+	0x61, 0x61, 0xe5, 0x07, 0x86, 0x4b, 0x4d, 0x0f, 0xf3, 0xaa, 0xb1, 0xed, 0xf3, 0x66, 0xa3, 0x30,
+	0xaf, 0x5a, 0xd1, 0x55, 0x8c, 0xec, 0xb3, 0x2a, 0x20, 0x35, 0x40, 0x07, 0xcc, 0x30, 0xa7, 0x4a,
+	0xca, 0x51, 0x8d, 0x94, 0x13, 0x82, 0x71, 0x41, 0xb0, 0x91, 0x46, 0xb0, 0xa0, 0x74, 0xf7, 0x94,
+	0x23, 0xfb, 0xe5, 0x56, 0x48, 0x91, 0x50, 0x30, 0x17, 0xf9, 0xed, 0x3c, 0xcf, 0xf5, 0x39, 0x2e,
+	0xd5, 0x48, 0xee, 0xa4, 0x20, 0x7a, 0x2f, 0x8d, 0xe8, 0x82, 0x24, 0x1a, 0x04, 0x81, 0xec, 0x73,
+	0x1d, 0xe1, 0x9d, 0x24, 0xfa, 0x9d, 0x06, 0x2e, 0xb6, 0x47, 0x17, 0x87, 0x5d, 0xbf, 0xb2, 0xab,
+	0xd2, 0xd8, 0x0b, 0xbb, 0x41, 0x3f, 0x74, 0xbd, 0x4a, 0xfd, 0x32, 0xd4, 0x45, 0xaa, 0x80, 0xc9,
+	0xc4, 0x82, 0xfc, 0x2a, 0xa6, 0xd6, 0xcc, 0xb4, 0x7b, 0xd4, 0x03, 0x66, 0xe5, 0xd4, 0xd7, 0x3f,
+	0xd3, 0x3d, 0x14, 0x16, 0xdf, 0xa5, 0xd6, 0xef, 0x3d, 0x90, 0xeb, 0x96, 0x43, 0xc2, 0x17, 0xd7,
+	0x8c, 0xaf, 0xc1, 0xe2, 0x00, 0x72, 0xd5, 0x82, 0x7b, 0xe0, 0xb4, 0x27, 0x43, 0xaa, 0x01, 0x97,
+	0x33, 0x7d, 0x48, 0x12, 0xc6, 0x3a, 0xaf, 0xcc, 0x2b, 0xb9, 0x0a, 0x09, 0xd9, 0x09, 0x26, 0x5a,
+	0x04, 0x0b, 0x82, 0x7b, 0x87, 0xc6, 0x8d, 0xd8, 0xe5, 0x98, 0x93, 0x64, 0x2d, 0x7d, 0x09, 0x72,
+	0xfd, 0x47, 0x4a, 0x95, 0x05, 0x4e, 0xb1, 0x38, 0xa0, 0x3e, 0xee, 0x95, 0x34, 0x4d, 0x37, 0xe5,
+	0xce, 0x94, 0x20, 0xb2, 0x74, 0xed, 0xdf, 0x29, 0x70, 0x4a, 0x10, 0xc0, 0x9f, 0x35, 0x30, 0x21,
+	0x97, 0x17, 0x5c, 0x4b, 0x43, 0xea, 0xdf, 0x9f, 0xfa, 0x95, 0x63, 0xd5, 0x48, 0x07, 0xc8, 0xf8,
+	0xe6, 0x8f, 0x7f, 0x7e, 0x3a, 0xb1, 0x04, 0x2f, 0x99, 0x99, 0xfe, 0x6f, 0x00, 0xff, 0xd7, 0xc0,
+	0xf9, 0xc1, 0xfb, 0x05, 0x5a, 0x99, 0xf8, 0x8f, 0xdc, 0xbf, 0xfa, 0xe6, 0x48, 0x18, 0xca, 0xd3,
+	0x67, 0xc2, 0xd3, 0x5d, 0x78, 0x3b, 0xcd, 0x93, 0x5c, 0x23, 0xe6, 0x9e, 0xba, 0xb4, 0xfb, 0xe6,
+	0x5e, 0xeb, 0x62, 0xee, 0x9b, 0xfd, 0xfb, 0x11, 0xfe, 0xa5, 0x81, 0xd9, 0xbe, 0xc5, 0x05, 0xaf,
+	0x65, 0xd7, 0x3c, 0x60, 0x7f, 0xea, 0xef, 0x0f, 0x5b, 0xae, 0xdc, 0x7e, 0x28, 0xdc, 0x7e, 0x00,
+	0xaf, 0x65, 0x73, 0x5b, 0xbc, 0x1f, 0x52, 0xaf, 0xa8, 0x1c, 0xb7, 0xad, 0xc3, 0xa7, 0x1a, 0x98,
+	0xee, 0xde, 0x3d, 0xf0, 0x6a, 0x76, 0x65, 0xbd, 0xcb, 0x57, 0x7f, 0x77, 0xa8, 0x5a, 0x65, 0xe9,
+	0xb6, 0xb0, 0xb4, 0x0d, 0x6f, 0x8e, 0x34, 0xc0, 0xf6, 0xbe, 0x84, 0xff, 0x69, 0x60, 0x7e, 0xe0,
+	0x13, 0x0b, 0xaf, 0x67, 0xd7, 0xf9, 0x9c, 0x2d, 0xa1, 0x5b, 0xa3, 0x40, 0x28, 0xc7, 0x9f, 0x0a,
+	0xc7, 0x77, 0xe0, 0xc7, 0x23, 0x39, 0xf6, 0x24, 0x7c, 0xb1, 0xf5, 0xa0, 0xc3, 0xdf, 0x35, 0x70,
+	0xa6, 0xf3, 0x3d, 0x85, 0x1b, 0xc7, 0x13, 0xdb, 0x7e, 0xff, 0xf5, 0x77, 0x86, 0xa8, 0x54, 0xee,
+	0x6e, 0x09, 0x77, 0x37, 0xe0, 0xd6, 0xc8, 0xee, 0x62, 0x0b, 0xbf, 0x69, 0x60, 0xe1, 0x93, 0x8e,
+	0xea, 0x8e, 0x87, 0x19, 0xbe, 0x9d, 0x49, 0x64, 0xff, 0x2b, 0xaf, 0x6f, 0x1c, 0xbf, 0x50, 0x99,
+	0x7b, 0x4b, 0x98, 0x33, 0xe0, 0x4a, 0x9a, 0x39, 0x4f, 0x14, 0x17, 0xc5, 0xab, 0x6f, 0xd5, 0x1e,
+	0x1f, 0xe4, 0xb5, 0x27, 0x07, 0x79, 0xed, 0xef, 0x83, 0xbc, 0xf6, 0xe3, 0x61, 0x7e, 0xec, 0xc9,
+	0x61, 0x7e, 0xec, 0xcf, 0xc3, 0xfc, 0xd8, 0xe7, 0x76, 0xc5, 0xe5, 0xd5, 0xa8, 0x64, 0x38, 0xd4,
+	0x33, 0xb7, 0x13, 0xc4, 0x5b, 0xb8, 0xc4, 0xda, 0xf8, 0x6f, 0x3a, 0x34, 0x24, 0x9d, 0xff, 0xac,
+	0x62, 0xd7, 0x57, 0xf8, 0xac, 0x9b, 0x9c, 0xd7, 0x03, 0xc2, 0x4a, 0x13, 0xe2, 0xef, 0xae, 0x2b,
+	0xcf, 0x06, 0x00, 0x5b, 0xdd, 0xc8, 0xd5, 0xe5, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -479,6 +825,15 @@ type QueryClient interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// DenomSupplyCap defines a gRPC query method for fetching the supply cap of
+	// a denom along with its current and scheduled supply.
+	DenomSupplyCap(ctx context.Context, in *QueryDenomSupplyCapRequest, opts ...grpc.CallOption) (*QueryDenomSupplyCapResponse, error)
+	// DenomMintingSchedules defines a gRPC query method for fetching the
+	// minting schedules of a denom.
+	DenomMintingSchedules(ctx context.Context, in *QueryDenomMintingSchedulesRequest, opts ...grpc.CallOption) (*QueryDenomMintingSchedulesResponse, error)
+	// DenomMinters defines a gRPC query method for fetching the minters of a
+	// denom and their allowances.
+	DenomMinters(ctx context.Context, in *QueryDenomMintersRequest, opts ...grpc.CallOption) (*QueryDenomMintersResponse, error)
 	// Retrieves the entire auction module's state
 	TokenfactoryModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -518,6 +873,33 @@ func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFrom
 	return out, nil
 }
 
+func (c *queryClient) DenomSupplyCap(ctx context.Context, in *QueryDenomSupplyCapRequest, opts ...grpc.CallOption) (*QueryDenomSupplyCapResponse, error) {
+	out := new(QueryDenomSupplyCapResponse)
+	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Query/DenomSupplyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMintingSchedules(ctx context.Context, in *QueryDenomMintingSchedulesRequest, opts ...grpc.CallOption) (*QueryDenomMintingSchedulesResponse, error) {
+	out := new(QueryDenomMintingSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Query/DenomMintingSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMinters(ctx context.Context, in *QueryDenomMintersRequest, opts ...grpc.CallOption) (*QueryDenomMintersResponse, error) {
+	out := new(QueryDenomMintersResponse)
+	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Query/DenomMinters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TokenfactoryModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Query/TokenfactoryModuleState", in, out, opts...)
@@ -538,6 +920,15 @@ type QueryServer interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// DenomSupplyCap defines a gRPC query method for fetching the supply cap of
+	// a denom along with its current and scheduled supply.
+	DenomSupplyCap(context.Context, *QueryDenomSupplyCapRequest) (*QueryDenomSupplyCapResponse, error)
+	// DenomMintingSchedules defines a gRPC query method for fetching the
+	// minting schedules of a denom.
+	DenomMintingSchedules(context.Context, *QueryDenomMintingSchedulesRequest) (*QueryDenomMintingSchedulesResponse, error)
+	// DenomMinters defines a gRPC query method for fetching the minters of a
+	// denom and their allowances.
+	DenomMinters(context.Context, *QueryDenomMintersRequest) (*QueryDenomMintersResponse, error)
 	// Retrieves the entire auction module's state
 	TokenfactoryModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -555,6 +946,15 @@ func (*UnimplementedQueryServer) DenomAuthorityMetadata(ctx context.Context, req
 func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
 }
+func (*UnimplementedQueryServer) DenomSupplyCap(ctx context.Context, req *QueryDenomSupplyCapRequest) (*QueryDenomSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSupplyCap not implemented")
+}
+func (*UnimplementedQueryServer) DenomMintingSchedules(ctx context.Context, req *QueryDenomMintingSchedulesRequest) (*QueryDenomMintingSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintingSchedules not implemented")
+}
+func (*UnimplementedQueryServer) DenomMinters(ctx context.Context, req *QueryDenomMintersRequest) (*QueryDenomMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMinters not implemented")
+}
 func (*UnimplementedQueryServer) TokenfactoryModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenfactoryModuleState not implemented")
 }
@@ -617,6 +1017,60 @@ func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSupplyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.tokenfactory.v1beta1.Query/DenomSupplyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSupplyCap(ctx, req.(*QueryDenomSupplyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMintingSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintingSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintingSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.tokenfactory.v1beta1.Query/DenomMintingSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintingSchedules(ctx, req.(*QueryDenomMintingSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMinters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMinters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.tokenfactory.v1beta1.Query/DenomMinters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMinters(ctx, req.(*QueryDenomMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TokenfactoryModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -652,12 +1106,24 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_DenomsFromCreator_Handler,
 		},
 		{
-			MethodName: "TokenfactoryModuleState",
-			Handler:    _Query_TokenfactoryModuleState_Handler,
+			MethodName: "DenomSupplyCap",
+			Handler:    _Query_DenomSupplyCap_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "injective/tokenfactory/v1beta1/query.proto",
+		{
+			MethodName: "DenomMintingSchedules",
+			Handler:    _Query_DenomMintingSchedules_Handler,
+		},
+		{
+			MethodName: "DenomMinters",
+			Handler:    _Query_DenomMinters_Handler,
+		},
+		{
+			MethodName: "TokenfactoryModuleState",
+			Handler:    _Query_TokenfactoryModuleState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/tokenfactory/v1beta1/query.proto",
 }
 
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
@@ -848,6 +1314,256 @@ func (m *QueryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomSupplyCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubDenom) > 0 {
+		i -= len(m.SubDenom)
+		copy(dAtA[i:], m.SubDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSupplyCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnscheduledMintable.Size()
+		i -= size
+		if _, err := m.UnscheduledMintable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ScheduledSupply.Size()
+		i -= size
+		if _, err := m.ScheduledSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentSupply.Size()
+		i -= size
+		if _, err := m.CurrentSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SupplyCap != nil {
+		{
+			size, err := m.SupplyCap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintingSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintingSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintingSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubDenom) > 0 {
+		i -= len(m.SubDenom)
+		copy(dAtA[i:], m.SubDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintingSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintingSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintingSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubDenom) > 0 {
+		i -= len(m.SubDenom)
+		copy(dAtA[i:], m.SubDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -993,35 +1709,629 @@ func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryModuleStateRequest) Size() (n int) {
+func (m *QueryDenomSupplyCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SubDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSupplyCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SupplyCap != nil {
+		l = m.SupplyCap.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ScheduledSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnscheduledMintable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomMintingSchedulesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SubDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintingSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SubDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryModuleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomSupplyCapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,12 +2354,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomSupplyCapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomSupplyCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1071,7 +2445,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomSupplyCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,15 +2468,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomSupplyCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomSupplyCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1129,7 +2503,112 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SupplyCap == nil {
+				m.SupplyCap = &DenomSupplyCap{}
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnscheduledMintable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnscheduledMintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1154,7 +2633,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintingSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,10 +2656,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintingSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintingSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1268,7 +2747,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintingSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,15 +2770,15 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintingSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintingSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1326,7 +2805,8 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, MintingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1351,7 +2831,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1374,10 +2854,10 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1412,6 +2892,38 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1433,7 +2945,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,17 +2968,17 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1476,23 +2988,25 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Minters = append(m.Minters, DenomMinter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
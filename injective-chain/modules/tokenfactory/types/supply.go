@@ -0,0 +1,116 @@
+package types
+
+import (
+	stdmath "math"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxMintingSchedulesPerDenom is the maximum number of minting schedules that a denom can have at the same time
+	MaxMintingSchedulesPerDenom = 100
+	// MaxMintingScheduleAmountBitLen is the maximum bit length of the total amount of a minting schedule, which keeps
+	// the vested amount computations far from the 256 bits limit of math.Int
+	MaxMintingScheduleAmountBitLen = 192
+	// MaxMintingSchedulesPerBlock is the maximum number of due minting schedules processed in a block, the remaining ones
+	// are processed in the next blocks
+	MaxMintingSchedulesPerBlock = 100
+	// MintingScheduleRetryDelay is the delay (in seconds) after which a minting schedule failing to mint is retried
+	MintingScheduleRetryDelay = 60 * 60
+)
+
+// Validate performs basic validation of the supply cap
+func (c DenomSupplyCap) Validate() error {
+	if c.MaxSupply.IsNil() || c.MaxSupply.IsNegative() {
+		return errors.Wrapf(ErrInvalidSupplyCap, "max supply must be non-negative")
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the minting schedule
+func (s MintingSchedule) Validate() error {
+	if _, _, err := DeconstructDenom(s.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return errors.Wrapf(ErrInvalidMintingSchedule, "invalid recipient address (%s)", err)
+	}
+
+	if s.TotalAmount.IsNil() || !s.TotalAmount.IsPositive() {
+		return errors.Wrap(ErrInvalidMintingSchedule, "total amount must be positive")
+	}
+
+	if s.TotalAmount.BigInt().BitLen() > MaxMintingScheduleAmountBitLen {
+		return errors.Wrapf(ErrInvalidMintingSchedule, "total amount cannot exceed %d bits", MaxMintingScheduleAmountBitLen)
+	}
+
+	if s.MintedAmount.IsNil() || s.MintedAmount.IsNegative() || s.MintedAmount.GT(s.TotalAmount) {
+		return errors.Wrap(ErrInvalidMintingSchedule, "minted amount must be between zero and the total amount")
+	}
+
+	return ValidateMintingScheduleTimes(s.StartTime, s.CliffDuration, s.Duration)
+}
+
+// ValidateMintingScheduleTimes checks that the start time and durations of a minting schedule are consistent
+func ValidateMintingScheduleTimes(startTime, cliffDuration, duration int64) error {
+	if startTime < 0 {
+		return errors.Wrap(ErrInvalidMintingSchedule, "start time cannot be negative")
+	}
+
+	if cliffDuration < 0 || duration < 0 {
+		return errors.Wrap(ErrInvalidMintingSchedule, "durations cannot be negative")
+	}
+
+	if cliffDuration > duration {
+		return errors.Wrap(ErrInvalidMintingSchedule, "cliff duration cannot exceed the duration")
+	}
+
+	if duration > stdmath.MaxInt64-startTime {
+		return errors.Wrap(ErrInvalidMintingSchedule, "end time overflows")
+	}
+
+	return nil
+}
+
+// RemainingAmount returns the amount still to be minted by the schedule
+func (s MintingSchedule) RemainingAmount() math.Int {
+	return s.TotalAmount.Sub(s.MintedAmount)
+}
+
+// VestedAmount returns the amount that should have been minted by the schedule at the given block time (unix
+// seconds). Nothing is vested before the end of the cliff, then the total amount vests linearly from the start time.
+func (s MintingSchedule) VestedAmount(blockTime int64) math.Int {
+	elapsed := blockTime - s.StartTime
+	if elapsed < s.CliffDuration {
+		return math.ZeroInt()
+	}
+
+	if elapsed >= s.Duration {
+		return s.TotalAmount
+	}
+
+	vested := new(big.Int).Mul(s.TotalAmount.BigInt(), big.NewInt(elapsed))
+	return math.NewIntFromBigInt(vested.Quo(vested, big.NewInt(s.Duration)))
+}
+
+// NextVestTime returns the earliest block time (unix seconds) at which the vested amount of the schedule exceeds its
+// minted amount, i.e. the next time at which the schedule has an amount to mint.
+func (s MintingSchedule) NextVestTime() int64 {
+	if s.Duration == 0 || !s.RemainingAmount().IsPositive() {
+		return s.StartTime + s.Duration
+	}
+
+	// smallest elapsed time such that TotalAmount * elapsed / Duration >= MintedAmount + 1, which is at most Duration
+	// since MintedAmount < TotalAmount
+	total := s.TotalAmount.BigInt()
+	elapsed := new(big.Int).Mul(s.MintedAmount.AddRaw(1).BigInt(), big.NewInt(s.Duration))
+	elapsed.Add(elapsed, total).Sub(elapsed, big.NewInt(1))
+	elapsed.Quo(elapsed, total)
+
+	return s.StartTime + max(s.CliffDuration, elapsed.Int64())
+}
@@ -0,0 +1,923 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/tokenfactory/v1beta1/supply.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomSupplyCap defines the maximum total supply of a token factory denom,
+// including the amounts still to be minted by its minting schedules.
+type DenomSupplyCap struct {
+	// The maximum total supply
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// true if the supply cap can't be changed anymore
+	IsImmutable bool `protobuf:"varint,2,opt,name=is_immutable,json=isImmutable,proto3" json:"is_immutable,omitempty" yaml:"is_immutable"`
+}
+
+func (m *DenomSupplyCap) Reset()         { *m = DenomSupplyCap{} }
+func (m *DenomSupplyCap) String() string { return proto.CompactTextString(m) }
+func (*DenomSupplyCap) ProtoMessage()    {}
+func (*DenomSupplyCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4e83f4f9d42e7e, []int{0}
+}
+func (m *DenomSupplyCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSupplyCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSupplyCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSupplyCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSupplyCap.Merge(m, src)
+}
+func (m *DenomSupplyCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSupplyCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSupplyCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSupplyCap proto.InternalMessageInfo
+
+func (m *DenomSupplyCap) GetIsImmutable() bool {
+	if m != nil {
+		return m.IsImmutable
+	}
+	return false
+}
+
+// MintingSchedule defines a pre-declared emission of a token factory denom to
+// a recipient. Nothing is minted before the end of the cliff, then the total
+// amount vests linearly from the start time and the vested amount is minted in
+// the BeginBlocker. Minting schedules can't be changed nor cancelled once
+// created.
+type MintingSchedule struct {
+	// The unique ID of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// The denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// The Injective address receiving the minted tokens
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// The total amount minted over the schedule
+	TotalAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_amount" yaml:"total_amount"`
+	// The amount minted so far
+	MintedAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=minted_amount,json=mintedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"minted_amount" yaml:"minted_amount"`
+	// The unix timestamp (in seconds) of the start of the schedule
+	StartTime int64 `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" yaml:"start_time"`
+	// The duration (in seconds) from the start during which nothing is minted
+	CliffDuration int64 `protobuf:"varint,7,opt,name=cliff_duration,json=cliffDuration,proto3" json:"cliff_duration,omitempty" yaml:"cliff_duration"`
+	// The duration (in seconds) of the schedule, the total amount is minted once
+	// it has elapsed
+	Duration int64 `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MintingSchedule) Reset()         { *m = MintingSchedule{} }
+func (m *MintingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintingSchedule) ProtoMessage()    {}
+func (*MintingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4e83f4f9d42e7e, []int{1}
+}
+func (m *MintingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintingSchedule.Merge(m, src)
+}
+func (m *MintingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintingSchedule proto.InternalMessageInfo
+
+func (m *MintingSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MintingSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintingSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MintingSchedule) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MintingSchedule) GetCliffDuration() int64 {
+	if m != nil {
+		return m.CliffDuration
+	}
+	return 0
+}
+
+func (m *MintingSchedule) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DenomSupplyCap)(nil), "injective.tokenfactory.v1beta1.DenomSupplyCap")
+	proto.RegisterType((*MintingSchedule)(nil), "injective.tokenfactory.v1beta1.MintingSchedule")
+}
+
+func init() {
+	proto.RegisterFile("injective/tokenfactory/v1beta1/supply.proto", fileDescriptor_2e4e83f4f9d42e7e)
+}
+
+var fileDescriptor_2e4e83f4f9d42e7e = []byte{
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x9b, 0xb6, 0xdb, 0xbf, 0xf1, 0xda, 0x6d, 0xff, 0xac, 0x15, 0x01, 0x89, 0xa4, 0xf2,
+	0x01, 0x55, 0x42, 0x24, 0xda, 0x18, 0x97, 0x9e, 0x20, 0xec, 0x52, 0x09, 0x0e, 0x64, 0x48, 0x48,
+	0xbb, 0x54, 0x6e, 0xe2, 0xb6, 0x66, 0xb1, 0x1d, 0x25, 0xce, 0xb4, 0x7e, 0x0b, 0x3e, 0x02, 0x17,
+	0xbe, 0xcb, 0x8e, 0x3b, 0x22, 0x0e, 0x11, 0x6a, 0x2f, 0x1c, 0x51, 0x3e, 0x01, 0xaa, 0x9d, 0xa6,
+	0xdd, 0x69, 0xb7, 0x3c, 0x7e, 0x9f, 0xdf, 0x13, 0xdb, 0xaf, 0x5f, 0xf0, 0x92, 0xb0, 0xaf, 0x38,
+	0x10, 0xe4, 0x06, 0xbb, 0x82, 0x5f, 0x63, 0x36, 0x45, 0x81, 0xe0, 0xc9, 0xc2, 0xbd, 0x39, 0x9d,
+	0x60, 0x81, 0x4e, 0xdd, 0x34, 0x8b, 0xe3, 0x68, 0xe1, 0xc4, 0x09, 0x17, 0xdc, 0xb0, 0x2a, 0xb3,
+	0xb3, 0x6b, 0x76, 0x4a, 0xf3, 0xb3, 0xee, 0x8c, 0xcf, 0xb8, 0xb4, 0xba, 0xeb, 0x2f, 0x45, 0xc1,
+	0x1f, 0x1a, 0x38, 0xbc, 0xc0, 0x8c, 0xd3, 0x4b, 0x99, 0xf5, 0x1e, 0xc5, 0xc6, 0x27, 0x00, 0x28,
+	0xba, 0x1d, 0xab, 0x70, 0x53, 0xeb, 0x6b, 0x03, 0xdd, 0x3b, 0xbb, 0xcb, 0xed, 0xda, 0xaf, 0xdc,
+	0xee, 0x05, 0x3c, 0xa5, 0x3c, 0x4d, 0xc3, 0x6b, 0x87, 0x70, 0x97, 0x22, 0x31, 0x77, 0x46, 0x4c,
+	0x14, 0xb9, 0xfd, 0xff, 0x02, 0xd1, 0x68, 0x08, 0xb7, 0x20, 0xf4, 0x75, 0x8a, 0x6e, 0x55, 0xaa,
+	0x31, 0x04, 0x6d, 0x92, 0x8e, 0x09, 0xa5, 0x99, 0x40, 0x93, 0x08, 0x9b, 0xf5, 0xbe, 0x36, 0x68,
+	0x79, 0x4f, 0x8a, 0xdc, 0x3e, 0x51, 0xdc, 0x6e, 0x15, 0xfa, 0x07, 0x24, 0x1d, 0x6d, 0xd4, 0xb0,
+	0xf9, 0xe7, 0xbb, 0xad, 0xc1, 0xbf, 0x0d, 0x70, 0xf4, 0x91, 0x30, 0x41, 0xd8, 0xec, 0x32, 0x98,
+	0xe3, 0x30, 0x8b, 0xb0, 0xf1, 0x1c, 0xd4, 0x49, 0x28, 0x37, 0xd8, 0xf4, 0x3a, 0x45, 0x6e, 0xeb,
+	0x65, 0x56, 0x08, 0xfd, 0x3a, 0x09, 0x8d, 0x17, 0x60, 0x2f, 0x5c, 0x9f, 0x4c, 0xfe, 0x4d, 0xf7,
+	0x8e, 0x8b, 0xdc, 0x6e, 0x2b, 0x87, 0x5c, 0x86, 0xbe, 0x2a, 0x1b, 0x67, 0x40, 0x4f, 0x70, 0x40,
+	0x62, 0x82, 0x99, 0x30, 0x1b, 0xd2, 0xdb, 0x2d, 0x72, 0xfb, 0x58, 0x79, 0xab, 0x12, 0xf4, 0xb7,
+	0x36, 0xe3, 0x0b, 0x68, 0x0b, 0x2e, 0x50, 0x34, 0x46, 0x94, 0x67, 0x4c, 0x98, 0x4d, 0x89, 0x9d,
+	0x3f, 0x76, 0x4b, 0xe5, 0x69, 0x77, 0x51, 0xe8, 0x1f, 0x48, 0xf9, 0x4e, 0x2a, 0xe3, 0x0a, 0x74,
+	0x28, 0x61, 0x02, 0x87, 0x9b, 0xe4, 0x3d, 0x99, 0xfc, 0xe6, 0xb1, 0xe4, 0x6e, 0x79, 0xff, 0xbb,
+	0x2c, 0xf4, 0xdb, 0x4a, 0x97, 0xd9, 0xe7, 0x00, 0xa4, 0x02, 0x25, 0x62, 0x2c, 0x08, 0xc5, 0xe6,
+	0x7e, 0x5f, 0x1b, 0x34, 0xbc, 0xde, 0xb6, 0x77, 0xdb, 0x1a, 0xf4, 0x75, 0x29, 0x3e, 0x13, 0x8a,
+	0x8d, 0xb7, 0xe0, 0x30, 0x88, 0xc8, 0x74, 0x3a, 0x0e, 0xb3, 0x04, 0x09, 0xc2, 0x99, 0xf9, 0x9f,
+	0x24, 0x9f, 0x16, 0xb9, 0xdd, 0x53, 0xe4, 0xc3, 0x3a, 0xf4, 0x3b, 0x72, 0xe1, 0xa2, 0xd4, 0x86,
+	0x0b, 0x5a, 0x15, 0xdb, 0x92, 0xec, 0x49, 0x91, 0xdb, 0x47, 0x65, 0x2f, 0x2a, 0xaa, 0x32, 0xa9,
+	0x96, 0x7b, 0xd1, 0xdd, 0xd2, 0xd2, 0xee, 0x97, 0x96, 0xf6, 0x7b, 0x69, 0x69, 0xdf, 0x56, 0x56,
+	0xed, 0x7e, 0x65, 0xd5, 0x7e, 0xae, 0xac, 0xda, 0x95, 0x3f, 0x23, 0x62, 0x9e, 0x4d, 0x9c, 0x80,
+	0x53, 0x77, 0xb4, 0x79, 0xf5, 0x1f, 0xd0, 0x24, 0x75, 0xab, 0x19, 0x78, 0x15, 0xf0, 0x04, 0xef,
+	0xca, 0x39, 0x22, 0xcc, 0xa5, 0x7c, 0xfd, 0x70, 0xd2, 0x87, 0xd3, 0x24, 0x16, 0x31, 0x4e, 0x27,
+	0xfb, 0x72, 0x1e, 0x5e, 0xff, 0x1b, 0x00, 0xdf, 0x42, 0x7b, 0xc9, 0x74, 0x03, 0x00, 0x00,
+}
+
+func (this *DenomSupplyCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomSupplyCap)
+	if !ok {
+		that2, ok := that.(DenomSupplyCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.IsImmutable != that1.IsImmutable {
+		return false
+	}
+	return true
+}
+func (this *MintingSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintingSchedule)
+	if !ok {
+		that2, ok := that.(MintingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.TotalAmount.Equal(that1.TotalAmount) {
+		return false
+	}
+	if !this.MintedAmount.Equal(that1.MintedAmount) {
+		return false
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if this.CliffDuration != that1.CliffDuration {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
+func (m *DenomSupplyCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSupplyCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSupplyCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsImmutable {
+		i--
+		if m.IsImmutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintSupply(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CliffDuration != 0 {
+		i = encodeVarintSupply(dAtA, i, uint64(m.CliffDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintSupply(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MintedAmount.Size()
+		i -= size
+		if _, err := m.MintedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSupply(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSupply(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSupply(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSupply(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSupply(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomSupplyCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovSupply(uint64(l))
+	if m.IsImmutable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MintingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSupply(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSupply(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSupply(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovSupply(uint64(l))
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovSupply(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovSupply(uint64(m.StartTime))
+	}
+	if m.CliffDuration != 0 {
+		n += 1 + sovSupply(uint64(m.CliffDuration))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovSupply(uint64(m.Duration))
+	}
+	return n
+}
+
+func sovSupply(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSupply(x uint64) (n int) {
+	return sovSupply(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomSupplyCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSupplyCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSupplyCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupply
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsImmutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsImmutable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupply
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupply
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupply
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSupply
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffDuration", wireType)
+			}
+			m.CliffDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSupply(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSupply
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSupply
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSupply
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSupply
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSupply        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSupply          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSupply = fmt.Errorf("proto: unexpected end of group")
+)
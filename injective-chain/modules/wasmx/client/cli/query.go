@@ -19,6 +19,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetWasmxParamsCmd(),
 		GetContractInfoCmd(),
+		GetContractScheduleCmd(),
 	)
 	return cmd
 }
@@ -62,3 +63,14 @@ func GetContractInfoCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetContractScheduleCmd() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"contract-schedule <contract-address>",
+		"Gets the execution schedule of a registered contract with its last and next executions",
+		types.NewQueryClient,
+		&types.QueryContractScheduleRequest{}, nil, nil,
+	)
+
+	return cmd
+}
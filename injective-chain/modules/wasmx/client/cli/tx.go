@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -43,6 +45,11 @@ const (
 	flagAmount                = "amount"
 	FlagContractCallerAddress = "contract-caller-address"
 	FlagContractExecMsg       = "contract-exec-msg"
+	FlagBlockInterval         = "block-interval"
+	FlagTimeInterval          = "time-interval"
+	FlagAtHeight              = "at-height"
+	FlagContractType          = "contract-type"
+	FlagEVMCalldata           = "evm-calldata"
 
 	flagAllowedMsgKeys  = "allow-msg-keys"
 	flagAllowedRawMsgs  = "allow-raw-msgs"
@@ -79,6 +86,7 @@ func NewContractRegistrationRequestProposalTxCmd() *cobra.Command {
 		Long: `Submit a proposal to register contract.
 			Example:
 			$ %s tx xwasm propose-contract-registration-request --migration-allowed true --contract-gas-limit 20000 --contract-gas-price "1000000000" --contract-address "inj14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9swvf72y" (--contract-funding-mode=dual) (--granter-address=inj1dzqd00lfd4y4qy2pxa0dsdwzfnmsu27hgttswz) --pin-contract=true --from mykey
+			$ %s tx xwasm propose-contract-registration-request --contract-type evm --contract-gas-limit 200000 --contract-gas-price "1000000000" --contract-address "0x6ac3e5d2ae44c0dbb6a05f2b1f6e8b6c3ca1f0e4" --evm-calldata 0xd826f88f --contract-funding-mode=self-funded --from mykey
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -124,6 +132,9 @@ func NewContractRegistrationRequestProposalTxCmd() *cobra.Command {
 	cmd.Flags().Uint64(FlagCodeId, 0, "code-id of contract")
 	cmd.Flags().Bool(FlagMigrationAllowed, true, "is contract migration allowed?")
 	cmd.Flags().String(FlagContractAdmin, "", "address of contract admin")
+	cmd.Flags().String(FlagContractType, "cosmwasm", "contract type: cosmwasm, evm")
+	cmd.Flags().String(FlagEVMCalldata, "", "hex encoded calldata of the EVM contract call, starting with the function selector")
+	addExecutionScheduleFlags(cmd)
 
 	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
@@ -233,11 +244,21 @@ func ContractRegistrationRequestProposalArgsToContent(
 		return nil, err
 	}
 
+	contractType, evmCalldata, err := parseContractTypeFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	contractAddrStr, err := cmd.Flags().GetString(FlagContractAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	// EVM contracts can be given by their hex address
+	if common.IsHexAddress(contractAddrStr) {
+		contractAddrStr = sdk.AccAddress(common.HexToAddress(contractAddrStr).Bytes()).String()
+	}
+
 	contractGasLimit, err := cmd.Flags().GetUint64(FlagContractGasLimit)
 	if err != nil {
 		return nil, err
@@ -258,7 +279,7 @@ func ContractRegistrationRequestProposalArgsToContent(
 		return nil, err
 	}
 
-	if codeId == 0 {
+	if codeId == 0 && contractType == types.ContractType_CosmWasm {
 		return nil, fmt.Errorf("code id cannot be equal to 0")
 	}
 
@@ -301,9 +322,17 @@ func ContractRegistrationRequestProposalArgsToContent(
 			IsMigrationAllowed: allowMigration,
 			AdminAddress:       adminAddress,
 			FundingMode:        fundingMode,
+			ContractType:       contractType,
+			EvmCalldata:        evmCalldata,
 		},
 	}
 
+	schedule, err := parseExecutionScheduleFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	content.ContractRegistrationRequest.Schedule = schedule
+
 	if fundingMode > types.FundingMode_SelfFunded {
 		granterAddrFlag, err := cmd.Flags().GetString(FlagGranterAddress)
 		if err != nil {
@@ -421,7 +450,7 @@ func ContractParamsUpdateTxCmd() *cobra.Command {
 		Use:   "contract-params-update <contract-address> [flags]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Update registered contract params",
-		Long: `Update registered contract params (gas price, gas limit, admin address).
+		Long: `Update registered contract params (gas price, gas limit, admin address, execution schedule).
 			Example:
 			$ %s tx xwasm contract-params-update inj14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9swvf72y --contract-gas-limit 20000 --contract-gas-price "1000000000" --contract-admin="inj1p7z8p649xspcey7wp5e4leqf7wa39kjjj6wja8" --from mykey
 		`,
@@ -462,6 +491,15 @@ func ContractParamsUpdateTxCmd() *cobra.Command {
 				GasLimit:        contract.GasLimit,
 				GasPrice:        contract.GasPrice,
 				AdminAddress:    contract.AdminAddress,
+				Schedule:        contract.Schedule,
+			}
+
+			if flagsChanged(cmd, FlagBlockInterval, FlagTimeInterval, FlagAtHeight) {
+				schedule, err := parseExecutionScheduleFlags(cmd)
+				if err != nil {
+					return err
+				}
+				msg.Schedule = schedule
 			}
 
 			cmd.Flags().Visit(func(f *pflag.Flag) {
@@ -493,11 +531,85 @@ func ContractParamsUpdateTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagContractAddress, "", "contract address ")
 	cmd.Flags().Uint64(FlagContractGasPrice, 1000000000, "gas price in inj to use for the contract execution")
 	cmd.Flags().String(FlagContractAdmin, "", "contract admin allowed to perform changes")
+	addExecutionScheduleFlags(cmd)
 
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+func parseContractTypeFlags(cmd *cobra.Command) (types.ContractType, []byte, error) {
+	contractTypeFlag, err := cmd.Flags().GetString(FlagContractType)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	switch strings.ToLower(contractTypeFlag) {
+	case "cosmwasm":
+		return types.ContractType_CosmWasm, nil, nil
+	case "evm":
+		evmCalldataFlag, err := cmd.Flags().GetString(FlagEVMCalldata)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		evmCalldata, err := hexutil.Decode(evmCalldataFlag)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to parse EVM calldata due to: %s", err.Error())
+		}
+		return types.ContractType_EVM, evmCalldata, nil
+	default:
+		return 0, nil, fmt.Errorf("following contract types are valid: 'cosmwasm' and 'evm'; but '%s' was provided", contractTypeFlag)
+	}
+}
+
+func addExecutionScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagBlockInterval, 0, "execute the contract every N blocks")
+	cmd.Flags().Uint64(FlagTimeInterval, 0, "execute the contract every N seconds")
+	cmd.Flags().Int64(FlagAtHeight, 0, "execute the contract once, at the given height")
+}
+
+func flagsChanged(cmd *cobra.Command, flags ...string) bool {
+	for _, flag := range flags {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseExecutionScheduleFlags returns the execution schedule set by the flags, or nil if the contract should be
+// executed every block
+func parseExecutionScheduleFlags(cmd *cobra.Command) (*types.ExecutionSchedule, error) {
+	blockInterval, err := cmd.Flags().GetUint64(FlagBlockInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	timeInterval, err := cmd.Flags().GetUint64(FlagTimeInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	atHeight, err := cmd.Flags().GetInt64(FlagAtHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockInterval == 0 && timeInterval == 0 && atHeight == 0 {
+		return nil, nil
+	}
+
+	schedule := &types.ExecutionSchedule{
+		BlockInterval: blockInterval,
+		TimeInterval:  timeInterval,
+		AtHeight:      atHeight,
+	}
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
 func ContractActivateTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract-activate [flags]",
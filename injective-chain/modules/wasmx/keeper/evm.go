@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/wasmx/types"
+)
+
+// hasEVMContract returns true if there is a contract deployed at the EVM address of contractAddr
+func (k *Keeper) hasEVMContract(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	acc := k.evmKeeper.GetAccount(ctx, common.BytesToAddress(contractAddr.Bytes()))
+	return acc != nil && !bytes.Equal(acc.CodeHash, evmtypes.EmptyCodeHash)
+}
+
+// executeEVMContract calls the EVM contract with its registered calldata. The call is sent by the wasmx module account,
+// which contracts can check to restrict the function to the BeginBlocker execution. The gas limit of the call is the
+// gas remaining in the context, the gas used by the EVM is then consumed from it.
+func (k *Keeper) executeEVMContract(
+	ctx sdk.Context,
+	contractAddr sdk.AccAddress,
+	contract *types.RegisteredContract,
+) ([]byte, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	sender := authtypes.NewModuleAddress(types.ModuleName)
+	nonce, err := k.accountKeeper.GetSequence(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	to := common.BytesToAddress(contractAddr.Bytes())
+	gasLimit := ctx.GasMeter().GasRemaining()
+	gasPrice := new(big.Int).SetUint64(contract.GasPrice)
+
+	msg := evmtypes.NewTx(
+		nil,      // chain id
+		nonce,    // nonce
+		&to,      // to
+		nil,      // amount
+		gasLimit, // gas limit
+		gasPrice, // gas price
+		nil,      // gas fee cap
+		nil,      // gas tip cap
+		contract.EvmCalldata,
+		nil,
+	)
+	msg.From = sender.Bytes()
+
+	res, err := k.evmKeeper.ApplyTransaction(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.VmError {
+	case "":
+		return res.Ret, nil
+	case vm.ErrOutOfGas.Error():
+		// reported as an SDK out of gas error, so that the contract is deactivated like CosmWasm contracts running out of gas
+		return nil, sdkerrortypes.ErrOutOfGas.Wrapf("EVM contract %s ran out of gas, gas used = %d", to.Hex(), res.GasUsed)
+	case vm.ErrExecutionReverted.Error():
+		return nil, errors.Wrap(types.ErrEVMExecutionFailed, evmtypes.NewExecErrorWithReason(res.Ret).Error())
+	default:
+		return nil, errors.Wrap(types.ErrEVMExecutionFailed, res.VmError)
+	}
+}
@@ -16,12 +16,21 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetContract(ctx, address, *contract.RegisteredContract)
 	}
 
+	for _, executionState := range data.ContractExecutionStates {
+		address, err := sdk.AccAddressFromBech32(executionState.Address)
+		if err != nil {
+			panic("error in contract address:" + executionState.Address)
+		}
+		k.SetContractExecutionState(ctx, address, executionState.ExecutionState)
+	}
+
 	k.CreateModuleAccount(ctx)
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:              k.GetParams(ctx),
-		RegisteredContracts: k.GetAllRegisteredContracts(ctx),
+		Params:                  k.GetParams(ctx),
+		RegisteredContracts:     k.GetAllRegisteredContracts(ctx),
+		ContractExecutionStates: k.GetAllContractExecutionStates(ctx),
 	}
 }
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/InjectiveLabs/metrics"
 
@@ -42,6 +44,40 @@ func (k *Keeper) ContractRegistrationInfo(c context.Context, req *types.QueryCon
 	return res, nil
 }
 
+func (k *Keeper) ContractSchedule(c context.Context, req *types.QueryContractScheduleRequest) (*types.QueryContractScheduleResponse, error) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, types.ErrInvalidContractAddress
+	}
+
+	contract := k.GetContractByAddress(ctx, contractAddr)
+	if contract == nil {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "contract %s is not registered", req.ContractAddress)
+	}
+
+	executionState := k.GetContractExecutionState(ctx, contractAddr)
+	nextRunHeight, nextRunTimestamp := contract.Schedule.NextRun(executionState, ctx.BlockHeight(), ctx.BlockTime().Unix())
+
+	res := &types.QueryContractScheduleResponse{
+		Schedule:         contract.Schedule,
+		NextRunHeight:    nextRunHeight,
+		NextRunTimestamp: nextRunTimestamp,
+	}
+
+	// the last execution of the contracts executed every block is only stored while their executions fail, so it
+	// would be stale once they succeed again
+	if contract.Schedule != nil {
+		res.LastRunHeight = executionState.LastRunHeight
+		res.LastRunTimestamp = executionState.LastRunTimestamp
+	}
+
+	return res, nil
+}
+
 func (k *Keeper) WasmxModuleState(c context.Context, _ *types.QueryModuleStateRequest) (*types.QueryModuleStateResponse, error) {
 	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
 
@@ -25,6 +25,7 @@ type Keeper struct {
 	wasmViewKeeper        types.WasmViewKeeper
 	wasmContractOpsKeeper types.WasmContractOpsKeeper
 	feeGrantKeeper        feegrantkeeper.Keeper
+	evmKeeper             types.EVMKeeper
 
 	svcTags metrics.Tags
 
@@ -38,6 +39,7 @@ func NewKeeper(
 	ak authkeeper.AccountKeeper,
 	bk types.BankKeeper,
 	fk feegrantkeeper.Keeper,
+	ek types.EVMKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -46,6 +48,7 @@ func NewKeeper(
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		feeGrantKeeper: fk,
+		evmKeeper:      ek,
 		authority:      authority,
 		svcTags: metrics.Tags{
 			"svc": "wasmx_k",
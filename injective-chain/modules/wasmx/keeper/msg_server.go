@@ -91,10 +91,15 @@ func (m msgServer) UpdateRegistryContractParams(goCtx context.Context, msg *type
 		return nil, err
 	}
 
+	if msg.Schedule != nil && msg.Schedule.AtHeight > 0 && msg.Schedule.AtHeight <= ctx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidExecutionSchedule, "execution height %d must be greater than the current height %d", msg.Schedule.AtHeight, ctx.BlockHeight())
+	}
+
 	m.updateRegisteredContractData(ctx, contractAddr, contract, func(contract *types.RegisteredContract) {
 		contract.GasLimit = msg.GasLimit
 		contract.GasPrice = msg.GasPrice
 		contract.AdminAddress = msg.AdminAddress
+		contract.Schedule = msg.Schedule
 	})
 	return &types.MsgUpdateContractResponse{}, nil
 }
@@ -115,6 +120,13 @@ func (m msgServer) ActivateRegistryContract(goCtx context.Context, msg *types.Ms
 		contract.IsExecutable = true
 	})
 
+	// give a fresh start to contracts deactivated after failing repeatedly
+	executionState := m.GetContractExecutionState(ctx, contractAddr)
+	if executionState.ConsecutiveFailures > 0 {
+		executionState.ConsecutiveFailures = 0
+		m.SetContractExecutionState(ctx, contractAddr, executionState)
+	}
+
 	return &types.MsgActivateContractResponse{}, nil
 }
 
@@ -42,8 +42,17 @@ func (k *Keeper) HandleContractRegistration(
 		)
 	}
 
+	// enforce that an EVM contract is deployed at contractAddress
+	if req.ContractType == types.ContractType_EVM && !k.hasEVMContract(ctx, contractAddress) {
+		return errors.Wrapf(
+			types.ErrInvalidEVMContract,
+			"ContractRegistrationRequestProposal: The EVM contract address %s does not exist",
+			contractAddress.String(),
+		)
+	}
+
 	// if migrations are not allowed, enforce that a contract exists at contractAddress and that it's code_id matches the one in the proposal
-	if !req.IsMigrationAllowed {
+	if req.ContractType == types.ContractType_CosmWasm && !req.IsMigrationAllowed {
 		contractInfo := k.GetContractInfo(ctx, contractAddress)
 		if contractInfo == nil {
 			return errors.Wrapf(
@@ -61,6 +70,16 @@ func (k *Keeper) HandleContractRegistration(
 		}
 	}
 
+	// Enforce that a one-off execution is scheduled in the future
+	if req.Schedule != nil && req.Schedule.AtHeight > 0 && req.Schedule.AtHeight <= ctx.BlockHeight() {
+		return errors.Wrapf(
+			types.ErrInvalidExecutionSchedule,
+			"ContractRegistrationRequestProposal: The execution height (%d) must be greater than the current height (%d)",
+			req.Schedule.AtHeight,
+			ctx.BlockHeight(),
+		)
+	}
+
 	// Enforce grant only account to have a registered granter address
 	if req.FundingMode == types.FundingMode_GrantOnly || req.FundingMode == types.FundingMode_Dual {
 		granter, _ := sdk.AccAddressFromBech32(req.GranterAddress)
@@ -117,6 +136,9 @@ func (k *Keeper) RegisterContract(
 		AdminAddress:   req.AdminAddress,
 		GranterAddress: req.GranterAddress,
 		FundMode:       req.FundingMode,
+		Schedule:       req.Schedule,
+		ContractType:   req.ContractType,
+		EvmCalldata:    req.EvmCalldata,
 	}
 	contractAddr, err := sdk.AccAddressFromBech32(req.ContractAddress)
 	if err != nil {
@@ -124,7 +146,7 @@ func (k *Keeper) RegisterContract(
 		return err
 	}
 
-	if !req.IsMigrationAllowed {
+	if req.ContractType == types.ContractType_CosmWasm && !req.IsMigrationAllowed {
 		contractInfo := k.wasmViewKeeper.GetContractInfo(ctx, contractAddr)
 		contract.CodeId = contractInfo.CodeID
 	}
@@ -144,6 +166,7 @@ func (k *Keeper) RegisterContract(
 		AdminAddress:       req.AdminAddress,
 		GranterAddress:     req.GranterAddress,
 		FundingMode:        req.FundingMode,
+		ContractType:       req.ContractType,
 	})
 	return nil
 }
@@ -162,8 +185,11 @@ func (k *Keeper) DeregisterContract(
 		return nil
 	}
 
-	if err := k.UnpinContract(ctx, contractAddress); err != nil {
-		return err
+	isEVMContract := registeredContract.ContractType == types.ContractType_EVM
+	if !isEVMContract {
+		if err := k.UnpinContract(ctx, contractAddress); err != nil {
+			return err
+		}
 	}
 	k.DeleteContract(ctx, contractAddress)
 	k.Logger(ctx).
@@ -174,6 +200,11 @@ func (k *Keeper) DeregisterContract(
 		ContractAddress: contractAddress.String(),
 	})
 
+	// EVM contracts don't have a deregister callback
+	if isEVMContract {
+		return nil
+	}
+
 	contractBalance := k.bankKeeper.GetBalance(ctx, contractAddress, chaintypes.InjectiveCoin)
 	maxAvailableGas := contractBalance.Amount.QuoRaw(int64(registeredContract.GasPrice)).Uint64()
 
@@ -225,6 +256,12 @@ func (k *Keeper) DeactivateContract(
 
 	registeredContract.IsExecutable = false
 	k.SetContract(ctx, contractAddress, *registeredContract)
+
+	// EVM contracts don't have a deactivate callback
+	if registeredContract.ContractType == types.ContractType_EVM {
+		return nil
+	}
+
 	contractBalance := k.bankKeeper.GetBalance(ctx, contractAddress, chaintypes.InjectiveCoin)
 	maxAvailableGas := contractBalance.Amount.QuoRaw(int64(registeredContract.GasPrice)).Uint64()
 	params := k.GetParams(ctx)
@@ -65,6 +65,7 @@ func (k *Keeper) DeleteContract(
 
 	store.Delete(contractsKey)
 	store.Delete(indexKey)
+	store.Delete(types.GetContractExecutionStateKey(contractAddress))
 }
 
 func (k *Keeper) GetContractByAddress(
@@ -142,3 +143,59 @@ func (k *Keeper) GetAllRegisteredContracts(
 
 	return allContracts
 }
+
+// GetContractExecutionState returns the last execution of the contract, which is empty if the contract was never executed
+func (k *Keeper) GetContractExecutionState(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+) types.ContractExecutionState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	var executionState types.ContractExecutionState
+
+	bz := k.getStore(ctx).Get(types.GetContractExecutionStateKey(contractAddress))
+	if bz == nil {
+		return executionState
+	}
+
+	k.cdc.MustUnmarshal(bz, &executionState)
+	return executionState
+}
+
+func (k *Keeper) SetContractExecutionState(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	executionState types.ContractExecutionState,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(&executionState)
+	k.getStore(ctx).Set(types.GetContractExecutionStateKey(contractAddress), bz)
+}
+
+func (k *Keeper) GetAllContractExecutionStates(
+	ctx sdk.Context,
+) []types.ContractExecutionStateWithAddress {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	executionStates := make([]types.ContractExecutionStateWithAddress, 0)
+
+	executionStateStore := prefix.NewStore(k.getStore(ctx), types.ContractExecutionStatePrefix)
+	iter := executionStateStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var executionState types.ContractExecutionState
+		k.cdc.MustUnmarshal(iter.Value(), &executionState)
+
+		executionStates = append(executionStates, types.ContractExecutionStateWithAddress{
+			Address:        sdk.AccAddress(iter.Key()).String(),
+			ExecutionState: executionState,
+		})
+	}
+
+	return executionStates
+}
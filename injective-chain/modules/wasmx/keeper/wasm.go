@@ -64,6 +64,12 @@ func (k *Keeper) ExecuteContracts(ctx sdk.Context) error {
 		ctx,
 		params.MinGasPrice,
 		func(addr sdk.AccAddress, contract types.RegisteredContract) bool {
+			// Skip contracts whose schedule is not due yet, the gas price ordering of the remaining ones is preserved
+			executionState := k.GetContractExecutionState(ctx, addr)
+			if !contract.Schedule.IsDue(executionState, ctx.BlockHeight(), ctx.BlockTime().Unix()) {
+				return false
+			}
+
 			shouldVerifyCodeId := contract.CodeId > 0
 			if shouldVerifyCodeId && !k.hasValidCodeId(ctx, addr, contract) {
 				return false
@@ -80,17 +86,47 @@ func (k *Keeper) ExecuteContracts(ctx sdk.Context) error {
 				gasToDeduct,
 			)
 
+			// Record the execution attempt, so that failed executions are not retried before the next scheduled run
+			hasFailed := otherErr != nil || executeErr != nil
+			hadFailures := executionState.ConsecutiveFailures > 0
+			executionState.LastRunHeight = ctx.BlockHeight()
+			executionState.LastRunTimestamp = ctx.BlockTime().Unix()
+			if hasFailed {
+				executionState.ConsecutiveFailures++
+			} else {
+				executionState.ConsecutiveFailures = 0
+			}
+
+			// Contracts executed every block only need their state to count consecutive failures, which saves a
+			// write per contract and block
+			if contract.Schedule != nil || hasFailed || hadFailures {
+				k.SetContractExecutionState(ctx, addr, executionState)
+			}
+
+			if contract.Schedule != nil && contract.Schedule.AtHeight > 0 {
+				// nolint:errcheck //ignored on purpose
+				ctx.EventManager().EmitTypedEvent(&types.EventContractScheduleCompleted{
+					ContractAddress: addr.String(),
+					AtHeight:        contract.Schedule.AtHeight,
+					Success:         !hasFailed,
+				})
+			}
+
 			otherErrString := ""
 			executionErrString := ""
 
-			if otherErr != nil || executeErr != nil {
+			if hasFailed {
 
 				if otherErr != nil {
 					otherErrString = otherErr.Error()
 				}
 
+				// EVM contracts are also deactivated after failing too many times in a row, e.g. by reverting
+				hasFailedRepeatedly := contract.ContractType == types.ContractType_EVM &&
+					executionState.ConsecutiveFailures >= params.EffectiveMaxEVMConsecutiveFailures()
+
 				switch {
-				case errors.Is(otherErr, types.ErrDeductingGasFees) || errors.Is(otherErr, sdkerrortypes.ErrOutOfGas) || errors.Is(executeErr, types.ErrDeductingGasFees) || errors.Is(executeErr, sdkerrortypes.ErrOutOfGas):
+				case errors.Is(otherErr, types.ErrDeductingGasFees) || errors.Is(otherErr, sdkerrortypes.ErrOutOfGas) || errors.Is(executeErr, types.ErrDeductingGasFees) || errors.Is(executeErr, sdkerrortypes.ErrOutOfGas) || hasFailedRepeatedly:
 					deactivateMeteredCtx := ctx.WithGasMeter(
 						storetypes.NewGasMeter(params.MaxContractGasLimit * 3),
 					)
@@ -143,6 +179,10 @@ func (k *Keeper) ExecuteContract(
 		contract.GasLimit,
 		gasDeducted,
 		func(subCtx sdk.Context) ([]byte, error) {
+			if contract.ContractType == types.ContractType_EVM {
+				return k.executeEVMContract(subCtx, contractAddr, contract)
+			}
+
 			execMsg, err := types.NewBeginBlockerExecMsg()
 			if err != nil {
 				k.Logger(ctx).
@@ -91,22 +91,86 @@ func (m *EventContractExecution) GetExecutionError() string {
 	return ""
 }
 
+// EventContractScheduleCompleted is emitted once the one-off execution of a
+// contract scheduled at a given height has been attempted. A failed execution
+// isn't retried.
+type EventContractScheduleCompleted struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	AtHeight        int64  `protobuf:"varint,2,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
+	Success         bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *EventContractScheduleCompleted) Reset()         { *m = EventContractScheduleCompleted{} }
+func (m *EventContractScheduleCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventContractScheduleCompleted) ProtoMessage()    {}
+func (*EventContractScheduleCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2ba06c5f04cb490, []int{1}
+}
+func (m *EventContractScheduleCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractScheduleCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractScheduleCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventContractScheduleCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractScheduleCompleted.Merge(m, src)
+}
+func (m *EventContractScheduleCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractScheduleCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractScheduleCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractScheduleCompleted proto.InternalMessageInfo
+
+func (m *EventContractScheduleCompleted) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *EventContractScheduleCompleted) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
+func (m *EventContractScheduleCompleted) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
 type EventContractRegistered struct {
-	ContractAddress    string      `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	GasPrice           uint64      `protobuf:"varint,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	ShouldPinContract  bool        `protobuf:"varint,4,opt,name=should_pin_contract,json=shouldPinContract,proto3" json:"should_pin_contract,omitempty"`
-	IsMigrationAllowed bool        `protobuf:"varint,5,opt,name=is_migration_allowed,json=isMigrationAllowed,proto3" json:"is_migration_allowed,omitempty"`
-	CodeId             uint64      `protobuf:"varint,6,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	AdminAddress       string      `protobuf:"bytes,7,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
-	GranterAddress     string      `protobuf:"bytes,8,opt,name=granter_address,json=granterAddress,proto3" json:"granter_address,omitempty"`
-	FundingMode        FundingMode `protobuf:"varint,9,opt,name=funding_mode,json=fundingMode,proto3,enum=injective.wasmx.v1.FundingMode" json:"funding_mode,omitempty"`
+	ContractAddress    string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	GasPrice           uint64       `protobuf:"varint,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	ShouldPinContract  bool         `protobuf:"varint,4,opt,name=should_pin_contract,json=shouldPinContract,proto3" json:"should_pin_contract,omitempty"`
+	IsMigrationAllowed bool         `protobuf:"varint,5,opt,name=is_migration_allowed,json=isMigrationAllowed,proto3" json:"is_migration_allowed,omitempty"`
+	CodeId             uint64       `protobuf:"varint,6,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	AdminAddress       string       `protobuf:"bytes,7,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	GranterAddress     string       `protobuf:"bytes,8,opt,name=granter_address,json=granterAddress,proto3" json:"granter_address,omitempty"`
+	FundingMode        FundingMode  `protobuf:"varint,9,opt,name=funding_mode,json=fundingMode,proto3,enum=injective.wasmx.v1.FundingMode" json:"funding_mode,omitempty"`
+	ContractType       ContractType `protobuf:"varint,10,opt,name=contract_type,json=contractType,proto3,enum=injective.wasmx.v1.ContractType" json:"contract_type,omitempty"`
 }
 
 func (m *EventContractRegistered) Reset()         { *m = EventContractRegistered{} }
 func (m *EventContractRegistered) String() string { return proto.CompactTextString(m) }
 func (*EventContractRegistered) ProtoMessage()    {}
 func (*EventContractRegistered) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f2ba06c5f04cb490, []int{1}
+	return fileDescriptor_f2ba06c5f04cb490, []int{2}
 }
 func (m *EventContractRegistered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,6 +255,13 @@ func (m *EventContractRegistered) GetFundingMode() FundingMode {
 	return FundingMode_Unspecified
 }
 
+func (m *EventContractRegistered) GetContractType() ContractType {
+	if m != nil {
+		return m.ContractType
+	}
+	return ContractType_CosmWasm
+}
+
 type EventContractDeregistered struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
@@ -199,7 +270,7 @@ func (m *EventContractDeregistered) Reset()         { *m = EventContractDeregist
 func (m *EventContractDeregistered) String() string { return proto.CompactTextString(m) }
 func (*EventContractDeregistered) ProtoMessage()    {}
 func (*EventContractDeregistered) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f2ba06c5f04cb490, []int{2}
+	return fileDescriptor_f2ba06c5f04cb490, []int{3}
 }
 func (m *EventContractDeregistered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,6 +308,7 @@ func (m *EventContractDeregistered) GetContractAddress() string {
 
 func init() {
 	proto.RegisterType((*EventContractExecution)(nil), "injective.wasmx.v1.EventContractExecution")
+	proto.RegisterType((*EventContractScheduleCompleted)(nil), "injective.wasmx.v1.EventContractScheduleCompleted")
 	proto.RegisterType((*EventContractRegistered)(nil), "injective.wasmx.v1.EventContractRegistered")
 	proto.RegisterType((*EventContractDeregistered)(nil), "injective.wasmx.v1.EventContractDeregistered")
 }
@@ -244,37 +316,42 @@ func init() {
 func init() { proto.RegisterFile("injective/wasmx/v1/events.proto", fileDescriptor_f2ba06c5f04cb490) }
 
 var fileDescriptor_f2ba06c5f04cb490 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0x7e, 0xfd, 0xd2, 0x64, 0x1a, 0x1a, 0x18, 0x2a, 0x6a, 0x82, 0xe4, 0x84, 0xb0,
-	0x68, 0x58, 0x60, 0x53, 0x78, 0x82, 0x16, 0x52, 0xa9, 0x82, 0x4a, 0x95, 0x97, 0x6c, 0xac, 0x89,
-	0xe7, 0xd6, 0x19, 0x64, 0xcf, 0xb5, 0x66, 0xc6, 0x69, 0x79, 0x0b, 0x5e, 0x83, 0x37, 0x61, 0xd9,
-	0x25, 0x4b, 0x94, 0xec, 0x79, 0x06, 0xe4, 0xf1, 0x1f, 0xa8, 0xe8, 0x06, 0x76, 0x73, 0xcf, 0x39,
-	0x77, 0xee, 0x4f, 0x57, 0x97, 0x8c, 0x85, 0xfc, 0x08, 0xb1, 0x11, 0x2b, 0x08, 0xae, 0x98, 0xce,
-	0xae, 0x83, 0xd5, 0x51, 0x00, 0x2b, 0x90, 0x46, 0xfb, 0xb9, 0x42, 0x83, 0x94, 0xb6, 0x01, 0xdf,
-	0x06, 0xfc, 0xd5, 0xd1, 0xc8, 0xbb, 0xa3, 0xa9, 0x32, 0x6d, 0xcf, 0xe8, 0xe9, 0x1d, 0x7e, 0xae,
-	0x30, 0x47, 0xcd, 0xd2, 0x3a, 0xb2, 0x9f, 0x60, 0x82, 0xf6, 0x19, 0x94, 0xaf, 0x4a, 0x9d, 0x7e,
-	0x71, 0xc8, 0xa3, 0x79, 0x39, 0xfd, 0x0d, 0x4a, 0xa3, 0x58, 0x6c, 0xe6, 0xd7, 0x10, 0x17, 0x46,
-	0xa0, 0xa4, 0xcf, 0xc9, 0xfd, 0xb8, 0x16, 0x23, 0xc6, 0xb9, 0x02, 0xad, 0x5d, 0x67, 0xe2, 0xcc,
-	0xfa, 0xe1, 0xb0, 0xd1, 0x8f, 0x2b, 0x99, 0x8e, 0x48, 0x4f, 0x81, 0xce, 0x51, 0x6a, 0x70, 0xb7,
-	0x26, 0xce, 0x6c, 0x10, 0xb6, 0x35, 0x1d, 0x93, 0x5d, 0x34, 0x4b, 0x50, 0x11, 0x28, 0x85, 0xca,
-	0xfd, 0xcf, 0xfe, 0x40, 0xac, 0x34, 0x2f, 0x15, 0x7a, 0x48, 0x86, 0xd0, 0x0c, 0xad, 0x43, 0xdb,
-	0x36, 0xb4, 0xd7, 0xca, 0x36, 0x38, 0xfd, 0xb1, 0x45, 0x0e, 0x6e, 0xb1, 0x86, 0x90, 0x08, 0x6d,
-	0x40, 0x01, 0xff, 0x1b, 0xd8, 0x27, 0xa4, 0x9f, 0x30, 0x1d, 0xe5, 0x4a, 0xc4, 0x60, 0x71, 0xb6,
-	0xc3, 0x5e, 0xc2, 0xf4, 0x45, 0x59, 0x53, 0x9f, 0x3c, 0xd4, 0x4b, 0x2c, 0x52, 0x1e, 0xe5, 0x42,
-	0x46, 0x4d, 0xab, 0x05, 0xea, 0x85, 0x0f, 0x2a, 0xeb, 0x42, 0xc8, 0x86, 0x80, 0xbe, 0x24, 0xfb,
-	0x42, 0x47, 0x99, 0x48, 0x14, 0xb3, 0xfc, 0x2c, 0x4d, 0xf1, 0x0a, 0xb8, 0xfb, 0xbf, 0x6d, 0xa0,
-	0x42, 0x9f, 0x37, 0xd6, 0x71, 0xe5, 0xd0, 0x03, 0xb2, 0x13, 0x23, 0x87, 0x48, 0x70, 0xb7, 0x6b,
-	0x87, 0x77, 0xcb, 0xf2, 0x8c, 0xd3, 0x67, 0xe4, 0x1e, 0xe3, 0x99, 0x90, 0x2d, 0xff, 0x8e, 0xe5,
-	0x1f, 0x58, 0xb1, 0x81, 0x3f, 0x24, 0xc3, 0x44, 0x31, 0x69, 0x40, 0xb5, 0xb1, 0x5e, 0xb5, 0xac,
-	0x5a, 0x6e, 0x82, 0x27, 0x64, 0x70, 0x59, 0x48, 0x2e, 0x64, 0x12, 0x65, 0xc8, 0xc1, 0xed, 0x4f,
-	0x9c, 0xd9, 0xde, 0xab, 0xb1, 0xff, 0xe7, 0x71, 0xf9, 0xa7, 0x55, 0xee, 0x1c, 0x39, 0x84, 0xbb,
-	0x97, 0xbf, 0x8a, 0xe9, 0x29, 0x79, 0x7c, 0x6b, 0xdf, 0x6f, 0x41, 0xfd, 0xcb, 0xc6, 0x4f, 0xe0,
-	0xeb, 0xda, 0x73, 0x6e, 0xd6, 0x9e, 0xf3, 0x7d, 0xed, 0x39, 0x9f, 0x37, 0x5e, 0xe7, 0x66, 0xe3,
-	0x75, 0xbe, 0x6d, 0xbc, 0xce, 0x87, 0x77, 0x89, 0x30, 0xcb, 0x62, 0xe1, 0xc7, 0x98, 0x05, 0x67,
-	0x0d, 0xd9, 0x7b, 0xb6, 0xd0, 0x41, 0xcb, 0xf9, 0x22, 0x46, 0x05, 0xbf, 0x97, 0x4b, 0x26, 0x64,
-	0x90, 0x21, 0x2f, 0x52, 0xd0, 0xf5, 0xb5, 0x9b, 0x4f, 0x39, 0xe8, 0x45, 0xd7, 0x9e, 0xf4, 0xeb,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69, 0x98, 0x56, 0x6d, 0x62, 0x03, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0x6d, 0x68, 0x69, 0x53, 0x77, 0xdb, 0x82, 0xa9, 0x68, 0x28, 0x52, 0x76, 0x59, 0x0e, 0x5d,
+	0x0e, 0x24, 0x14, 0xbe, 0xa0, 0x2d, 0x5b, 0x51, 0x41, 0xa5, 0x2a, 0x70, 0xe2, 0x12, 0x79, 0xed,
+	0x69, 0x62, 0x94, 0xd8, 0x91, 0xed, 0x6c, 0xdb, 0x2b, 0x5f, 0xc0, 0x6f, 0xf0, 0x01, 0xfc, 0x03,
+	0xc7, 0x1e, 0x39, 0xa2, 0xdd, 0x1f, 0x41, 0x71, 0x36, 0xa1, 0x2b, 0xf6, 0x52, 0x6e, 0x7e, 0x6f,
+	0xde, 0xcc, 0x3c, 0xcf, 0xd8, 0xa8, 0xcb, 0xc5, 0x17, 0xa0, 0x86, 0x8f, 0x21, 0xbc, 0x24, 0x3a,
+	0xbf, 0x0a, 0xc7, 0x07, 0x21, 0x8c, 0x41, 0x18, 0x1d, 0x14, 0x4a, 0x1a, 0x89, 0x71, 0x2b, 0x08,
+	0xac, 0x20, 0x18, 0x1f, 0xec, 0xf9, 0x0b, 0x92, 0xea, 0xa0, 0xcd, 0xd9, 0x7b, 0xb6, 0x20, 0x5e,
+	0x28, 0x59, 0x48, 0x4d, 0xb2, 0x99, 0x64, 0x27, 0x91, 0x89, 0xb4, 0xc7, 0xb0, 0x3a, 0xd5, 0x6c,
+	0xff, 0xbb, 0x83, 0x1e, 0x0f, 0xab, 0xee, 0xc7, 0x52, 0x18, 0x45, 0xa8, 0x19, 0x5e, 0x01, 0x2d,
+	0x0d, 0x97, 0x02, 0xbf, 0x40, 0x0f, 0xe8, 0x8c, 0x8c, 0x09, 0x63, 0x0a, 0xb4, 0xf6, 0x9c, 0x9e,
+	0x33, 0x58, 0x8f, 0xb6, 0x1b, 0xfe, 0xb0, 0xa6, 0xf1, 0x1e, 0x72, 0x15, 0xe8, 0x42, 0x0a, 0x0d,
+	0xde, 0xbd, 0x9e, 0x33, 0xe8, 0x44, 0x2d, 0xc6, 0x5d, 0xb4, 0x21, 0x4d, 0x0a, 0x2a, 0x06, 0xa5,
+	0xa4, 0xf2, 0x96, 0x6d, 0x05, 0x64, 0xa9, 0x61, 0xc5, 0xe0, 0x7d, 0xb4, 0x0d, 0x4d, 0xd3, 0x99,
+	0x68, 0xc5, 0x8a, 0xb6, 0x5a, 0xda, 0x0a, 0xfb, 0x5f, 0x1d, 0xe4, 0xcf, 0x79, 0xfd, 0x48, 0x53,
+	0x60, 0x65, 0x06, 0xc7, 0x32, 0x2f, 0x32, 0x30, 0xc0, 0xee, 0xe2, 0xf9, 0x29, 0x5a, 0x27, 0x26,
+	0x4e, 0x81, 0x27, 0xa9, 0xb1, 0xa6, 0x97, 0x23, 0x97, 0x98, 0x77, 0x16, 0x63, 0x0f, 0xad, 0xe9,
+	0x92, 0xd2, 0x2a, 0xbd, 0x32, 0xec, 0x46, 0x0d, 0xec, 0xff, 0x58, 0x46, 0xbb, 0x73, 0x26, 0x22,
+	0x48, 0xb8, 0x36, 0xa0, 0xee, 0xdc, 0x3d, 0x21, 0x3a, 0x2e, 0x14, 0xa7, 0x60, 0x5b, 0xac, 0x44,
+	0x6e, 0x42, 0xf4, 0x79, 0x85, 0x71, 0x80, 0x1e, 0xe9, 0x54, 0x96, 0x19, 0x8b, 0x0b, 0x2e, 0xe2,
+	0x26, 0xd5, 0x4e, 0xc5, 0x8d, 0x1e, 0xd6, 0xa1, 0x73, 0x2e, 0x1a, 0x07, 0xf8, 0x15, 0xda, 0xe1,
+	0x3a, 0xce, 0x79, 0xa2, 0x88, 0x1d, 0x22, 0xc9, 0x32, 0x79, 0x09, 0xcc, 0xbb, 0x6f, 0x13, 0x30,
+	0xd7, 0x67, 0x4d, 0xe8, 0xb0, 0x8e, 0xe0, 0x5d, 0xb4, 0x46, 0x25, 0x83, 0x98, 0x33, 0x6f, 0xd5,
+	0x36, 0x5f, 0xad, 0xe0, 0x29, 0xc3, 0xcf, 0xd1, 0x26, 0x61, 0x39, 0x17, 0xad, 0xff, 0x35, 0xeb,
+	0xbf, 0x63, 0xc9, 0xc6, 0xfc, 0x3e, 0xda, 0x4e, 0x14, 0x11, 0x06, 0x54, 0x2b, 0x73, 0xeb, 0x8d,
+	0xcd, 0xe8, 0x46, 0x78, 0x84, 0x3a, 0x17, 0xa5, 0x60, 0x5c, 0x24, 0x71, 0x2e, 0x19, 0x78, 0xeb,
+	0x3d, 0x67, 0xb0, 0xf5, 0xba, 0x1b, 0xfc, 0xfb, 0xc2, 0x83, 0x93, 0x5a, 0x77, 0x26, 0x19, 0x44,
+	0x1b, 0x17, 0x7f, 0x01, 0x1e, 0xa2, 0xcd, 0x76, 0xa8, 0xe6, 0xba, 0x00, 0x0f, 0xd9, 0x22, 0xbd,
+	0x45, 0x45, 0x9a, 0x89, 0x7c, 0xba, 0x2e, 0x20, 0xea, 0xd0, 0x5b, 0xa8, 0x7f, 0x82, 0x9e, 0xcc,
+	0xad, 0xed, 0x2d, 0xa8, 0xff, 0x59, 0xdc, 0x11, 0xfc, 0x9c, 0xf8, 0xce, 0xcd, 0xc4, 0x77, 0x7e,
+	0x4f, 0x7c, 0xe7, 0xdb, 0xd4, 0x5f, 0xba, 0x99, 0xfa, 0x4b, 0xbf, 0xa6, 0xfe, 0xd2, 0xe7, 0xf7,
+	0x09, 0x37, 0x69, 0x39, 0x0a, 0xa8, 0xcc, 0xc3, 0xd3, 0xc6, 0xdb, 0x07, 0x32, 0xd2, 0x61, 0xeb,
+	0xf4, 0x25, 0x95, 0x0a, 0x6e, 0xc3, 0x94, 0x70, 0x11, 0xe6, 0xb2, 0x7a, 0xc4, 0x7a, 0xf6, 0x73,
+	0xab, 0x3b, 0xea, 0xd1, 0xaa, 0xfd, 0x9e, 0x6f, 0xfe, 0x0c, 0x00, 0x75, 0xe8, 0xc4, 0xaa, 0x2e,
+	0x04, 0x00, 0x00,
 }
 
 func (m *EventContractExecution) Marshal() (dAtA []byte, err error) {
@@ -328,6 +405,51 @@ func (m *EventContractExecution) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventContractScheduleCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractScheduleCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractScheduleCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AtHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventContractRegistered) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -348,6 +470,11 @@ func (m *EventContractRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ContractType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.FundingMode != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.FundingMode))
 		i--
@@ -473,6 +600,25 @@ func (m *EventContractExecution) Size() (n int) {
 	return n
 }
 
+func (m *EventContractScheduleCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AtHeight != 0 {
+		n += 1 + sovEvents(uint64(m.AtHeight))
+	}
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
 func (m *EventContractRegistered) Size() (n int) {
 	if m == nil {
 		return 0
@@ -506,6 +652,9 @@ func (m *EventContractRegistered) Size() (n int) {
 	if m.FundingMode != 0 {
 		n += 1 + sovEvents(uint64(m.FundingMode))
 	}
+	if m.ContractType != 0 {
+		n += 1 + sovEvents(uint64(m.ContractType))
+	}
 	return n
 }
 
@@ -708,6 +857,127 @@ func (m *EventContractExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventContractScheduleCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContractScheduleCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContractScheduleCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
+			}
+			m.AtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventContractRegistered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -930,6 +1200,25 @@ func (m *EventContractRegistered) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5,6 +5,10 @@ import (
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/statedb"
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
 )
 
 // BankKeeper defines the expected bank keeper methods
@@ -27,3 +31,8 @@ type WasmViewKeeper interface {
 type WasmContractOpsKeeper interface {
 	wasmtypes.ContractOpsKeeper
 }
+
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	ApplyTransaction(ctx sdk.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error)
+}
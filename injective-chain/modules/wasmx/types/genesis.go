@@ -1,5 +1,10 @@
 package types
 
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewGenesisState() GenesisState {
 	return GenesisState{}
 }
@@ -8,6 +13,26 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	for _, contract := range gs.RegisteredContracts {
+		if contract.RegisteredContract == nil {
+			continue
+		}
+		if err := contract.RegisteredContract.Schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenExecutionStates := make(map[string]struct{}, len(gs.ContractExecutionStates))
+	for _, executionState := range gs.ContractExecutionStates {
+		if _, err := sdk.AccAddressFromBech32(executionState.Address); err != nil {
+			return errors.Wrapf(ErrInvalidContractAddress, "invalid execution state address %s: %s", executionState.Address, err.Error())
+		}
+		if _, ok := seenExecutionStates[executionState.Address]; ok {
+			return errors.Wrapf(ErrDuplicateContract, "duplicate execution state for contract %s", executionState.Address)
+		}
+		seenExecutionStates[executionState.Address] = struct{}{}
+	}
 	return nil
 }
 
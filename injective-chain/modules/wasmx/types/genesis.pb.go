@@ -75,6 +75,58 @@ func (m *RegisteredContractWithAddress) GetRegisteredContract() *RegisteredContr
 	return nil
 }
 
+type ContractExecutionStateWithAddress struct {
+	Address        string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ExecutionState ContractExecutionState `protobuf:"bytes,2,opt,name=execution_state,json=executionState,proto3" json:"execution_state"`
+}
+
+func (m *ContractExecutionStateWithAddress) Reset()         { *m = ContractExecutionStateWithAddress{} }
+func (m *ContractExecutionStateWithAddress) String() string { return proto.CompactTextString(m) }
+func (*ContractExecutionStateWithAddress) ProtoMessage()    {}
+func (*ContractExecutionStateWithAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8642938473ffc6e5, []int{1}
+}
+func (m *ContractExecutionStateWithAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecutionStateWithAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecutionStateWithAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecutionStateWithAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecutionStateWithAddress.Merge(m, src)
+}
+func (m *ContractExecutionStateWithAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecutionStateWithAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecutionStateWithAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecutionStateWithAddress proto.InternalMessageInfo
+
+func (m *ContractExecutionStateWithAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractExecutionStateWithAddress) GetExecutionState() ContractExecutionState {
+	if m != nil {
+		return m.ExecutionState
+	}
+	return ContractExecutionState{}
+}
+
 // GenesisState defines the wasmx module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to wasmx.
@@ -82,13 +134,16 @@ type GenesisState struct {
 	// registered_contracts is an array containing the genesis registered
 	// contracts
 	RegisteredContracts []RegisteredContractWithAddress `protobuf:"bytes,2,rep,name=registered_contracts,json=registeredContracts,proto3" json:"registered_contracts"`
+	// contract_execution_states is an array containing the last executions of
+	// the registered contracts
+	ContractExecutionStates []ContractExecutionStateWithAddress `protobuf:"bytes,3,rep,name=contract_execution_states,json=contractExecutionStates,proto3" json:"contract_execution_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8642938473ffc6e5, []int{1}
+	return fileDescriptor_8642938473ffc6e5, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,35 +186,48 @@ func (m *GenesisState) GetRegisteredContracts() []RegisteredContractWithAddress
 	return nil
 }
 
+func (m *GenesisState) GetContractExecutionStates() []ContractExecutionStateWithAddress {
+	if m != nil {
+		return m.ContractExecutionStates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegisteredContractWithAddress)(nil), "injective.wasmx.v1.RegisteredContractWithAddress")
+	proto.RegisterType((*ContractExecutionStateWithAddress)(nil), "injective.wasmx.v1.ContractExecutionStateWithAddress")
 	proto.RegisterType((*GenesisState)(nil), "injective.wasmx.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("injective/wasmx/v1/genesis.proto", fileDescriptor_8642938473ffc6e5) }
 
 var fileDescriptor_8642938473ffc6e5 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x8d, 0x0b, 0x2a, 0xc2, 0x65, 0x72, 0x3b, 0x44, 0x95, 0x30, 0x51, 0x07, 0xd4, 0x05, 0x5b,
-	0x2d, 0x0b, 0x2b, 0x65, 0x40, 0x08, 0x06, 0x14, 0x86, 0x4a, 0x2c, 0xc8, 0x4d, 0x4e, 0xa9, 0x2b,
-	0x12, 0x57, 0xb6, 0x5b, 0xe0, 0x2f, 0x10, 0xdf, 0xc2, 0x47, 0x74, 0xec, 0xc8, 0x84, 0x50, 0xf3,
-	0x23, 0x88, 0x24, 0x8d, 0x2a, 0x25, 0x03, 0xdb, 0xbb, 0xf3, 0x7b, 0xf7, 0x9e, 0xef, 0xb0, 0x27,
-	0x93, 0x19, 0x04, 0x56, 0x2e, 0x81, 0xbf, 0x08, 0x13, 0xbf, 0xf2, 0xe5, 0x80, 0x47, 0x90, 0x80,
-	0x91, 0x86, 0xcd, 0xb5, 0xb2, 0x8a, 0x90, 0x92, 0xc1, 0x32, 0x06, 0x5b, 0x0e, 0xba, 0xb4, 0x46,
-	0x95, 0x3f, 0x66, 0x9a, 0x6e, 0x27, 0x52, 0x91, 0xca, 0x20, 0xff, 0x43, 0x79, 0xb7, 0xf7, 0x81,
-	0xf0, 0xb1, 0x0f, 0x91, 0x34, 0x16, 0x34, 0x84, 0x57, 0x2a, 0xb1, 0x5a, 0x04, 0x76, 0x2c, 0xed,
-	0xf4, 0x32, 0x0c, 0x35, 0x18, 0x43, 0x5c, 0x7c, 0x20, 0x72, 0xe8, 0x22, 0x0f, 0xf5, 0x0f, 0xfd,
-	0x6d, 0x49, 0xc6, 0xb8, 0xad, 0x4b, 0xe9, 0x53, 0x50, 0x68, 0xdd, 0x86, 0x87, 0xfa, 0xad, 0xe1,
-	0x29, 0xab, 0x66, 0x64, 0x55, 0x27, 0x9f, 0xe8, 0x4a, 0xaf, 0xf7, 0x89, 0xf0, 0xd1, 0x75, 0xfe,
-	0xe1, 0x07, 0x2b, 0x2c, 0x90, 0x0b, 0xdc, 0x9c, 0x0b, 0x2d, 0xe2, 0x3c, 0x42, 0x6b, 0xd8, 0xad,
-	0x1b, 0x7e, 0x9f, 0x31, 0x46, 0xfb, 0xab, 0xef, 0x13, 0xc7, 0x2f, 0xf8, 0x64, 0x86, 0x3b, 0x35,
-	0x19, 0x8d, 0xdb, 0xf0, 0xf6, 0xfa, 0xad, 0xe1, 0xe0, 0x7f, 0x21, 0x77, 0xd6, 0x51, 0x8c, 0x6f,
-	0x57, 0x53, 0x9b, 0x11, 0xac, 0x36, 0x14, 0xad, 0x37, 0x14, 0xfd, 0x6c, 0x28, 0x7a, 0x4f, 0xa9,
-	0xb3, 0x4e, 0xa9, 0xf3, 0x95, 0x52, 0xe7, 0xf1, 0x36, 0x92, 0x76, 0xba, 0x98, 0xb0, 0x40, 0xc5,
-	0xfc, 0x66, 0xeb, 0x78, 0x27, 0x26, 0x86, 0x97, 0xfe, 0x67, 0x81, 0xd2, 0xb0, 0x5b, 0x4e, 0x85,
-	0x4c, 0x78, 0xac, 0xc2, 0xc5, 0x33, 0x98, 0xe2, 0xa2, 0xf6, 0x6d, 0x0e, 0x66, 0xd2, 0xcc, 0x2e,
-	0x77, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x76, 0xe6, 0x73, 0x45, 0x27, 0x02, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x4a, 0xeb, 0x40,
+	0x18, 0xcd, 0xb4, 0x97, 0x5e, 0xee, 0xf4, 0x72, 0x2f, 0x4c, 0x0b, 0x37, 0xb7, 0x60, 0x8c, 0x5d,
+	0x48, 0x11, 0x4c, 0x68, 0x45, 0x70, 0x6b, 0x45, 0x44, 0x74, 0x21, 0x71, 0x51, 0x74, 0x53, 0xa6,
+	0xc9, 0x47, 0x3a, 0xc5, 0x64, 0xca, 0xcc, 0xf4, 0xc7, 0xb7, 0x10, 0x57, 0xbe, 0x84, 0xef, 0xd1,
+	0x65, 0x97, 0xae, 0x44, 0xda, 0x17, 0x91, 0xe6, 0xa7, 0x54, 0x93, 0x45, 0x77, 0xdf, 0x77, 0x72,
+	0xce, 0x9c, 0xc3, 0xc9, 0x87, 0x4d, 0x16, 0x0e, 0xc0, 0x55, 0x6c, 0x0c, 0xf6, 0x84, 0xca, 0x60,
+	0x6a, 0x8f, 0x9b, 0xb6, 0x0f, 0x21, 0x48, 0x26, 0xad, 0xa1, 0xe0, 0x8a, 0x13, 0xb2, 0x66, 0x58,
+	0x11, 0xc3, 0x1a, 0x37, 0x6b, 0x46, 0x8e, 0x2a, 0xfe, 0x18, 0x69, 0x6a, 0x55, 0x9f, 0xfb, 0x3c,
+	0x1a, 0xed, 0xd5, 0x14, 0xa3, 0xf5, 0x67, 0x84, 0x77, 0x1c, 0xf0, 0x99, 0x54, 0x20, 0xc0, 0x3b,
+	0xe3, 0xa1, 0x12, 0xd4, 0x55, 0x1d, 0xa6, 0xfa, 0xa7, 0x9e, 0x27, 0x40, 0x4a, 0xa2, 0xe3, 0x9f,
+	0x34, 0x1e, 0x75, 0x64, 0xa2, 0xc6, 0x2f, 0x27, 0x5d, 0x49, 0x07, 0x57, 0xc4, 0x5a, 0xda, 0x75,
+	0x13, 0xad, 0x5e, 0x30, 0x51, 0xa3, 0xdc, 0xda, 0xb7, 0xb2, 0x19, 0xad, 0xac, 0x93, 0x43, 0x44,
+	0x06, 0xab, 0xbf, 0x20, 0xbc, 0x97, 0x2e, 0xe7, 0x53, 0x70, 0x47, 0x8a, 0xf1, 0xf0, 0x56, 0x51,
+	0x05, 0xdb, 0x05, 0xbb, 0xc3, 0x7f, 0x21, 0x95, 0x75, 0xe5, 0x4a, 0x97, 0x84, 0x3a, 0xc8, 0x0b,
+	0x95, 0xef, 0xd4, 0xfe, 0x31, 0x7b, 0xdf, 0xd5, 0x9c, 0x3f, 0xf0, 0x05, 0xad, 0xbf, 0x16, 0xf0,
+	0xef, 0x8b, 0xf8, 0x5f, 0x44, 0x00, 0x39, 0xc1, 0xa5, 0x21, 0x15, 0x34, 0x88, 0x43, 0x94, 0x5b,
+	0xb5, 0x3c, 0x8b, 0x9b, 0x88, 0x91, 0x3c, 0x99, 0xf0, 0xc9, 0x00, 0x57, 0x73, 0xea, 0x93, 0x7a,
+	0xc1, 0x2c, 0x36, 0xca, 0xad, 0xe6, 0x76, 0xfd, 0x6d, 0x14, 0x92, 0x3c, 0x5f, 0xc9, 0x16, 0x2a,
+	0xc9, 0x04, 0xff, 0x4f, 0x0d, 0xba, 0xdf, 0xaa, 0x91, 0x7a, 0x31, 0x32, 0x3c, 0xde, 0xbe, 0x9b,
+	0xac, 0xe9, 0x3f, 0x37, 0x97, 0x28, 0xdb, 0x30, 0x5b, 0x18, 0x68, 0xbe, 0x30, 0xd0, 0xc7, 0xc2,
+	0x40, 0x4f, 0x4b, 0x43, 0x9b, 0x2f, 0x0d, 0xed, 0x6d, 0x69, 0x68, 0xf7, 0x57, 0x3e, 0x53, 0xfd,
+	0x51, 0xcf, 0x72, 0x79, 0x60, 0x5f, 0xa6, 0xce, 0xd7, 0xb4, 0x27, 0xed, 0x75, 0x8e, 0x43, 0x97,
+	0x0b, 0xd8, 0x5c, 0xfb, 0x94, 0x85, 0x76, 0xc0, 0xbd, 0xd1, 0x03, 0xc8, 0xe4, 0xca, 0xd5, 0xe3,
+	0x10, 0x64, 0xaf, 0x14, 0x5d, 0xf3, 0xd1, 0xe7, 0x00, 0x9a, 0x3a, 0x48, 0x61, 0x3b, 0x03, 0x00,
+	0x00,
 }
 
 func (m *RegisteredContractWithAddress) Marshal() (dAtA []byte, err error) {
@@ -204,6 +272,46 @@ func (m *RegisteredContractWithAddress) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractExecutionStateWithAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecutionStateWithAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecutionStateWithAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExecutionState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -224,6 +332,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractExecutionStates) > 0 {
+		for iNdEx := len(m.ContractExecutionStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractExecutionStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RegisteredContracts) > 0 {
 		for iNdEx := len(m.RegisteredContracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -279,6 +401,21 @@ func (m *RegisteredContractWithAddress) Size() (n int) {
 	return n
 }
 
+func (m *ContractExecutionStateWithAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ExecutionState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -293,6 +430,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractExecutionStates) > 0 {
+		for _, e := range m.ContractExecutionStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -420,6 +563,121 @@ func (m *RegisteredContractWithAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractExecutionStateWithAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionStateWithAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionStateWithAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -516,6 +774,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractExecutionStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractExecutionStates = append(m.ContractExecutionStates, ContractExecutionStateWithAddress{})
+			if err := m.ContractExecutionStates[len(m.ContractExecutionStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
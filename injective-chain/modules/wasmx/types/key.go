@@ -13,9 +13,10 @@ const (
 )
 
 var (
-	ContractsByGasPricePrefix = []byte{0x01} // key to the smart contract execution request ID
-	ContractsIndexPrefix      = []byte{0x02}
-	ParamsKey                 = []byte{0x10}
+	ContractsByGasPricePrefix    = []byte{0x01} // key to the smart contract execution request ID
+	ContractsIndexPrefix         = []byte{0x02}
+	ContractExecutionStatePrefix = []byte{0x03} // key to the last execution of a contract
+	ParamsKey                    = []byte{0x10}
 )
 
 func GetContractsByGasPriceKey(gasPrice uint64, address sdk.AccAddress) []byte {
@@ -30,3 +31,8 @@ func getGasPriceAddressInfix(gasPrice uint64, address sdk.AccAddress) []byte {
 func GetContractsIndexKey(address sdk.AccAddress) []byte {
 	return append(ContractsIndexPrefix, address.Bytes()...)
 }
+
+// GetContractExecutionStateKey provides the key for the contract address => last execution
+func GetContractExecutionStateKey(address sdk.AccAddress) []byte {
+	return append(ContractExecutionStatePrefix, address.Bytes()...)
+}
@@ -78,6 +78,10 @@ func (msg MsgUpdateContract) ValidateBasic() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "GasPrice must be > 0")
 	}
 
+	if err := msg.Schedule.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -23,6 +23,8 @@ var (
 	DefaultMaxBeginBlockTotalGas uint64 = 42_000_000                        // 42M
 	DefaultMaxContractGasLimit   uint64 = DefaultMaxBeginBlockTotalGas / 12 // 3.5M
 	DefaultMinGasPrice           uint64 = 1_000_000_000                     // 1B
+
+	DefaultMaxEVMConsecutiveFailures uint32 = 3
 )
 
 // Parameter keys
@@ -70,12 +72,22 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		IsExecutionEnabled:     DefaultIsExecutionEnabled,
-		MaxBeginBlockTotalGas:  DefaultMaxBeginBlockTotalGas,
-		MaxContractGasLimit:    DefaultMaxContractGasLimit,
-		MinGasPrice:            DefaultMinGasPrice,
-		RegisterContractAccess: wasmtypes.AccessConfig{},
+		IsExecutionEnabled:        DefaultIsExecutionEnabled,
+		MaxBeginBlockTotalGas:     DefaultMaxBeginBlockTotalGas,
+		MaxContractGasLimit:       DefaultMaxContractGasLimit,
+		MinGasPrice:               DefaultMinGasPrice,
+		RegisterContractAccess:    wasmtypes.AccessConfig{},
+		MaxEvmConsecutiveFailures: DefaultMaxEVMConsecutiveFailures,
+	}
+}
+
+// EffectiveMaxEVMConsecutiveFailures returns the number of consecutive failed executions after which an EVM contract is
+// deactivated, falling back to the default for params set before EVM contracts could be registered
+func (p Params) EffectiveMaxEVMConsecutiveFailures() uint32 {
+	if p.MaxEvmConsecutiveFailures == 0 {
+		return DefaultMaxEVMConsecutiveFailures
 	}
+	return p.MaxEvmConsecutiveFailures
 }
 
 // Validate performs basic validation on wasmx parameters.
@@ -87,6 +87,37 @@ func (req *ContractRegistrationRequest) Validate() error {
 		return errors.Wrapf(ErrInvalidFundingMode, "GranterAddress must be empty for self-funded contracts")
 	}
 
+	if err := req.Schedule.Validate(); err != nil {
+		return err
+	}
+
+	if err := req.validateContractType(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (req *ContractRegistrationRequest) validateContractType() error {
+	switch req.ContractType {
+	case ContractType_CosmWasm:
+		if len(req.EvmCalldata) > 0 {
+			return errors.Wrapf(ErrInvalidEVMContract, "ContractRegistrationRequestProposal: EvmCalldata must be empty for CosmWasm contracts")
+		}
+	case ContractType_EVM:
+		if len(req.EvmCalldata) < 4 {
+			return errors.Wrapf(ErrInvalidEVMContract, "ContractRegistrationRequestProposal: EvmCalldata must start with a 4 bytes function selector")
+		}
+		if req.ShouldPinContract {
+			return errors.Wrapf(ErrInvalidEVMContract, "ContractRegistrationRequestProposal: EVM contracts cannot be pinned")
+		}
+		if req.CodeId != 0 {
+			return errors.Wrapf(ErrInvalidCodeId, "ContractRegistrationRequestProposal: CodeId must be empty for EVM contracts")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidEVMContract, "ContractRegistrationRequestProposal: unknown contract type %d", req.ContractType)
+	}
+
 	return nil
 }
 
@@ -57,6 +57,35 @@ func (FundingMode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ba51f3e994cc61a5, []int{0}
 }
 
+// ContractType defines the virtual machine executing a registered contract
+type ContractType int32
+
+const (
+	// CosmWasm contract, executed with the begin_blocker sudo message
+	ContractType_CosmWasm ContractType = 0
+	// EVM contract, called by the wasmx module account with the registered
+	// calldata
+	ContractType_EVM ContractType = 1
+)
+
+var ContractType_name = map[int32]string{
+	0: "CosmWasm",
+	1: "EVM",
+}
+
+var ContractType_value = map[string]int32{
+	"CosmWasm": 0,
+	"EVM":      1,
+}
+
+func (x ContractType) String() string {
+	return proto.EnumName(ContractType_name, int32(x))
+}
+
+func (ContractType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba51f3e994cc61a5, []int{1}
+}
+
 type ContractRegistrationRequestProposal struct {
 	Title                       string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description                 string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -176,6 +205,71 @@ func (m *BatchContractDeregistrationProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BatchContractDeregistrationProposal proto.InternalMessageInfo
 
+// ExecutionSchedule defines when a registered contract is executed in the
+// BeginBlocker. At most one of the fields can be set.
+type ExecutionSchedule struct {
+	// execute the contract every block_interval blocks
+	BlockInterval uint64 `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// execute the contract every time_interval seconds (of block time)
+	TimeInterval uint64 `protobuf:"varint,2,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	// execute the contract once, at the given height
+	AtHeight int64 `protobuf:"varint,3,opt,name=at_height,json=atHeight,proto3" json:"at_height,omitempty"`
+}
+
+func (m *ExecutionSchedule) Reset()         { *m = ExecutionSchedule{} }
+func (m *ExecutionSchedule) String() string { return proto.CompactTextString(m) }
+func (*ExecutionSchedule) ProtoMessage()    {}
+func (*ExecutionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba51f3e994cc61a5, []int{3}
+}
+func (m *ExecutionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionSchedule.Merge(m, src)
+}
+func (m *ExecutionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionSchedule proto.InternalMessageInfo
+
+func (m *ExecutionSchedule) GetBlockInterval() uint64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *ExecutionSchedule) GetTimeInterval() uint64 {
+	if m != nil {
+		return m.TimeInterval
+	}
+	return 0
+}
+
+func (m *ExecutionSchedule) GetAtHeight() int64 {
+	if m != nil {
+		return m.AtHeight
+	}
+	return 0
+}
+
 type ContractRegistrationRequest struct {
 	// Unique Identifier for contract instance to be registered.
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -198,13 +292,21 @@ type ContractRegistrationRequest struct {
 	GranterAddress string `protobuf:"bytes,8,opt,name=granter_address,json=granterAddress,proto3" json:"granter_address,omitempty"`
 	// Specifies how the contract will fund its execution
 	FundingMode FundingMode `protobuf:"varint,9,opt,name=funding_mode,json=fundingMode,proto3,enum=injective.wasmx.v1.FundingMode" json:"funding_mode,omitempty"`
+	// Optional schedule of the contract executions, the contract is executed
+	// every block if unset
+	Schedule *ExecutionSchedule `protobuf:"bytes,10,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Type of the contract, CosmWasm if unset
+	ContractType ContractType `protobuf:"varint,11,opt,name=contract_type,json=contractType,proto3,enum=injective.wasmx.v1.ContractType" json:"contract_type,omitempty"`
+	// Calldata of the EVM contract call, starting with the 4 bytes function
+	// selector. Must be set for EVM contracts only.
+	EvmCalldata []byte `protobuf:"bytes,12,opt,name=evm_calldata,json=evmCalldata,proto3" json:"evm_calldata,omitempty"`
 }
 
 func (m *ContractRegistrationRequest) Reset()         { *m = ContractRegistrationRequest{} }
 func (m *ContractRegistrationRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractRegistrationRequest) ProtoMessage()    {}
 func (*ContractRegistrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba51f3e994cc61a5, []int{3}
+	return fileDescriptor_ba51f3e994cc61a5, []int{4}
 }
 func (m *ContractRegistrationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,6 +398,27 @@ func (m *ContractRegistrationRequest) GetFundingMode() FundingMode {
 	return FundingMode_Unspecified
 }
 
+func (m *ContractRegistrationRequest) GetSchedule() *ExecutionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *ContractRegistrationRequest) GetContractType() ContractType {
+	if m != nil {
+		return m.ContractType
+	}
+	return ContractType_CosmWasm
+}
+
+func (m *ContractRegistrationRequest) GetEvmCalldata() []byte {
+	if m != nil {
+		return m.EvmCalldata
+	}
+	return nil
+}
+
 type BatchStoreCodeProposal struct {
 	Title       string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -306,7 +429,7 @@ func (m *BatchStoreCodeProposal) Reset()         { *m = BatchStoreCodeProposal{}
 func (m *BatchStoreCodeProposal) String() string { return proto.CompactTextString(m) }
 func (*BatchStoreCodeProposal) ProtoMessage()    {}
 func (*BatchStoreCodeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ba51f3e994cc61a5, []int{4}
+	return fileDescriptor_ba51f3e994cc61a5, []int{5}
 }
 func (m *BatchStoreCodeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,9 +460,11 @@ var xxx_messageInfo_BatchStoreCodeProposal proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("injective.wasmx.v1.FundingMode", FundingMode_name, FundingMode_value)
+	proto.RegisterEnum("injective.wasmx.v1.ContractType", ContractType_name, ContractType_value)
 	proto.RegisterType((*ContractRegistrationRequestProposal)(nil), "injective.wasmx.v1.ContractRegistrationRequestProposal")
 	proto.RegisterType((*BatchContractRegistrationRequestProposal)(nil), "injective.wasmx.v1.BatchContractRegistrationRequestProposal")
 	proto.RegisterType((*BatchContractDeregistrationProposal)(nil), "injective.wasmx.v1.BatchContractDeregistrationProposal")
+	proto.RegisterType((*ExecutionSchedule)(nil), "injective.wasmx.v1.ExecutionSchedule")
 	proto.RegisterType((*ContractRegistrationRequest)(nil), "injective.wasmx.v1.ContractRegistrationRequest")
 	proto.RegisterType((*BatchStoreCodeProposal)(nil), "injective.wasmx.v1.BatchStoreCodeProposal")
 }
@@ -347,56 +472,96 @@ func init() {
 func init() { proto.RegisterFile("injective/wasmx/v1/proposal.proto", fileDescriptor_ba51f3e994cc61a5) }
 
 var fileDescriptor_ba51f3e994cc61a5 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0x93, 0xb4, 0x4d, 0x36, 0xfd, 0x93, 0xee, 0xaf, 0xfa, 0x61, 0xda, 0xe2, 0x84, 0x44,
-	0x82, 0xb4, 0x52, 0x6d, 0x5a, 0x6e, 0xb9, 0x35, 0xad, 0x88, 0x2a, 0x5a, 0x51, 0xb9, 0x70, 0x80,
-	0x8b, 0xb5, 0xf1, 0x6e, 0x9c, 0x45, 0xb6, 0xd7, 0x78, 0x37, 0x29, 0x11, 0x2f, 0x80, 0x38, 0xf1,
-	0x08, 0x7d, 0x02, 0xe0, 0xc0, 0x89, 0x27, 0xa8, 0x38, 0x95, 0x1b, 0x27, 0x84, 0xd2, 0x03, 0x3c,
-	0x06, 0xf2, 0xbf, 0x34, 0xa8, 0x10, 0x45, 0xad, 0xb8, 0x44, 0x99, 0x99, 0x6f, 0x67, 0xe6, 0xfb,
-	0x66, 0xd6, 0x0b, 0x6e, 0x53, 0xf7, 0x39, 0x31, 0x05, 0xed, 0x11, 0xed, 0x18, 0x71, 0xe7, 0xa5,
-	0xd6, 0xdb, 0xd4, 0x3c, 0x9f, 0x79, 0x8c, 0x23, 0x5b, 0xf5, 0x7c, 0x26, 0x18, 0x84, 0x43, 0x88,
-	0x1a, 0x42, 0xd4, 0xde, 0xe6, 0xf2, 0x4d, 0x93, 0x71, 0x87, 0x71, 0x23, 0x44, 0x68, 0x91, 0x11,
-	0xc1, 0x97, 0xef, 0x04, 0x56, 0x00, 0x0c, 0x13, 0x8e, 0xe6, 0x33, 0x6c, 0x62, 0x21, 0xb3, 0x1f,
-	0xe3, 0x96, 0x2c, 0x66, 0xb1, 0xe8, 0x7c, 0xf0, 0x2f, 0xf6, 0x2e, 0x22, 0x87, 0xba, 0x4c, 0x0b,
-	0x7f, 0x23, 0x57, 0xe5, 0x5d, 0x1a, 0x54, 0x77, 0x98, 0x2b, 0x7c, 0x64, 0x0a, 0x9d, 0x58, 0x94,
-	0x0b, 0x1f, 0x09, 0xca, 0x5c, 0x9d, 0xbc, 0xe8, 0x12, 0x2e, 0x0e, 0xe3, 0xec, 0x70, 0x09, 0x4c,
-	0x09, 0x2a, 0x6c, 0x22, 0x4b, 0x65, 0xa9, 0x96, 0xd7, 0x23, 0x03, 0x96, 0x41, 0x01, 0x13, 0x6e,
-	0xfa, 0xd4, 0x0b, 0xce, 0xc8, 0xe9, 0x30, 0x36, 0xea, 0x82, 0x7d, 0x70, 0xcb, 0x8c, 0xd3, 0x1b,
-	0xfe, 0x48, 0x7e, 0xc3, 0x8f, 0x0a, 0xc8, 0x99, 0xb2, 0x54, 0x2b, 0x6c, 0x69, 0xea, 0x65, 0x1d,
-	0xd4, 0x31, 0x7d, 0x35, 0xb2, 0xa7, 0xdf, 0x4a, 0x29, 0x7d, 0xc5, 0xfc, 0x3b, 0xa4, 0xfe, 0xf8,
-	0xf5, 0x49, 0x29, 0xf5, 0xf3, 0xa4, 0x94, 0xfa, 0xfc, 0x71, 0x63, 0x39, 0x56, 0xd1, 0x62, 0x3d,
-	0xb5, 0xb7, 0xd9, 0x22, 0x02, 0x45, 0xe9, 0x89, 0x2b, 0xde, 0xfc, 0xf8, 0xb0, 0xbe, 0x16, 0x8d,
-	0x67, 0x02, 0x21, 0x2a, 0x9f, 0xd2, 0xa0, 0xd6, 0x40, 0xc2, 0xec, 0xfc, 0x4b, 0xd5, 0x5e, 0x01,
-	0x65, 0xac, 0x6a, 0x5c, 0xce, 0x94, 0x33, 0x57, 0x97, 0x6d, 0x75, 0x8c, 0x6c, 0xbc, 0xfe, 0x74,
-	0x72, 0xdd, 0xa2, 0xa2, 0xda, 0xa4, 0x7a, 0x54, 0xbe, 0x48, 0xa0, 0xfa, 0x1b, 0x78, 0x97, 0x8c,
-	0xd2, 0xbb, 0xb6, 0x6e, 0xab, 0x20, 0x9f, 0x50, 0x8b, 0x24, 0xca, 0xeb, 0x17, 0x8e, 0x2b, 0x2c,
-	0xc4, 0x04, 0xbd, 0x56, 0xde, 0x67, 0xc0, 0xca, 0x18, 0xee, 0x70, 0x0d, 0x14, 0x87, 0xb3, 0x44,
-	0x18, 0xfb, 0x84, 0xf3, 0x98, 0xd6, 0x42, 0xe2, 0xdf, 0x8e, 0xdc, 0x70, 0x05, 0xe4, 0x2d, 0xc4,
-	0x0d, 0x9b, 0x3a, 0x54, 0x84, 0xf4, 0xb2, 0x7a, 0xce, 0x42, 0x7c, 0x3f, 0xb0, 0x93, 0xa0, 0xe7,
-	0x53, 0x93, 0x84, 0xb7, 0x26, 0x0a, 0x1e, 0x06, 0x36, 0x54, 0xc1, 0x7f, 0xbc, 0xc3, 0xba, 0x36,
-	0x36, 0x3c, 0xea, 0x1a, 0x49, 0x5e, 0x39, 0x5b, 0x96, 0x6a, 0x39, 0x7d, 0x31, 0x0a, 0x1d, 0x52,
-	0x37, 0xe9, 0x13, 0xde, 0x03, 0x4b, 0x94, 0x1b, 0x0e, 0xb5, 0xe2, 0xbd, 0x42, 0xb6, 0xcd, 0x8e,
-	0x09, 0x96, 0xa7, 0xc2, 0x03, 0x90, 0xf2, 0x83, 0x24, 0xb4, 0x1d, 0x45, 0xe0, 0x0d, 0x30, 0x63,
-	0x32, 0x4c, 0x0c, 0x8a, 0xe5, 0xe9, 0xb0, 0xf8, 0x74, 0x60, 0xee, 0x61, 0x58, 0x05, 0x73, 0x08,
-	0x3b, 0xd4, 0x1d, 0x92, 0x9b, 0x09, 0xc9, 0xcd, 0x86, 0xce, 0x84, 0xd9, 0x5d, 0xb0, 0x60, 0xf9,
-	0xc8, 0x15, 0xc4, 0x1f, 0xc2, 0x72, 0x21, 0x6c, 0x3e, 0x76, 0x27, 0xc0, 0x06, 0x98, 0x6d, 0x77,
-	0x5d, 0x4c, 0x5d, 0xcb, 0x70, 0x18, 0x26, 0x72, 0xbe, 0x2c, 0xd5, 0xe6, 0xb7, 0x4a, 0x7f, 0xda,
-	0xf3, 0x07, 0x11, 0xee, 0x80, 0x61, 0xa2, 0x17, 0xda, 0x17, 0x46, 0x5d, 0x19, 0x3f, 0xdf, 0xca,
-	0x40, 0x02, 0xff, 0x87, 0x93, 0x3d, 0x12, 0xcc, 0x27, 0x3b, 0x0c, 0x93, 0x6b, 0x2f, 0x5e, 0x13,
-	0xe4, 0x93, 0x0f, 0x71, 0x72, 0x37, 0xab, 0x6a, 0xf2, 0xad, 0x0e, 0x5b, 0x0e, 0x3a, 0xbe, 0x54,
-	0x2f, 0xbe, 0x8f, 0x17, 0x67, 0xeb, 0xcd, 0xc9, 0x77, 0x74, 0x75, 0x64, 0x47, 0x2f, 0x65, 0x5e,
-	0x6f, 0x82, 0xc2, 0x88, 0x40, 0x70, 0x01, 0x14, 0x9e, 0xb8, 0xdc, 0x23, 0x26, 0x6d, 0x53, 0x82,
-	0x8b, 0x29, 0x38, 0x0f, 0xc0, 0x11, 0xb1, 0xdb, 0x01, 0x86, 0xe0, 0xa2, 0x04, 0xe7, 0x40, 0xbe,
-	0x19, 0x8c, 0xe2, 0x91, 0x6b, 0xf7, 0x8b, 0x69, 0x98, 0x03, 0xd9, 0xdd, 0x2e, 0xb2, 0x8b, 0x99,
-	0x06, 0x39, 0x1d, 0x28, 0xd2, 0xd9, 0x40, 0x91, 0xbe, 0x0f, 0x14, 0xe9, 0xed, 0xb9, 0x92, 0x3a,
-	0x3b, 0x57, 0x52, 0x5f, 0xcf, 0x95, 0xd4, 0xb3, 0x87, 0x16, 0x15, 0x9d, 0x6e, 0x4b, 0x35, 0x99,
-	0xa3, 0xed, 0x25, 0xf3, 0xd9, 0x47, 0x2d, 0xae, 0x0d, 0xa7, 0xb5, 0x61, 0x32, 0x9f, 0x8c, 0x9a,
-	0x1d, 0x44, 0x5d, 0xcd, 0x61, 0xb8, 0x6b, 0x13, 0x1e, 0x3f, 0x8a, 0xa2, 0xef, 0x11, 0xde, 0x9a,
-	0x0e, 0xdf, 0xa3, 0xfb, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xee, 0xb6, 0x0c, 0x34, 0x07,
-	0x00, 0x00,
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x6c, 0x9b, 0x4c, 0xd2, 0x36, 0x1d, 0x2a, 0x30, 0x6d, 0x49, 0xb2, 0xa9, 0x0a,
+	0xd9, 0x4a, 0x6b, 0xd3, 0xe5, 0x96, 0x5b, 0xdb, 0x2d, 0xa5, 0x62, 0x2b, 0x2a, 0x77, 0x01, 0xc1,
+	0xc5, 0x9a, 0x8c, 0x5f, 0x9d, 0x01, 0xdb, 0x63, 0x3c, 0x93, 0xec, 0x46, 0xdc, 0x38, 0x21, 0x4e,
+	0x7c, 0x84, 0x7e, 0x02, 0xc4, 0x81, 0x13, 0x9f, 0x60, 0xc5, 0x69, 0xb9, 0x71, 0x40, 0x08, 0xb5,
+	0x07, 0xf6, 0x63, 0x20, 0x8f, 0xff, 0xd4, 0xa8, 0x4b, 0x14, 0x6d, 0xb5, 0x97, 0xaa, 0xef, 0xf7,
+	0x7e, 0xef, 0xcd, 0xbc, 0xdf, 0x7b, 0xcf, 0x13, 0x74, 0x97, 0x05, 0x5f, 0x01, 0x95, 0x6c, 0x02,
+	0xe6, 0x13, 0x22, 0xfc, 0xa7, 0xe6, 0x64, 0xd7, 0x0c, 0x23, 0x1e, 0x72, 0x41, 0x3c, 0x23, 0x8c,
+	0xb8, 0xe4, 0x18, 0xe7, 0x14, 0x43, 0x51, 0x8c, 0xc9, 0xee, 0xfa, 0xdb, 0x94, 0x0b, 0x9f, 0x0b,
+	0x5b, 0x31, 0xcc, 0xc4, 0x48, 0xe8, 0xeb, 0xef, 0xc6, 0x56, 0x4c, 0x54, 0x09, 0x8b, 0xf9, 0x6c,
+	0x0f, 0x5c, 0x42, 0xa7, 0x29, 0x6f, 0xcd, 0xe5, 0x2e, 0x4f, 0xe2, 0xe3, 0xff, 0x52, 0x74, 0x95,
+	0xf8, 0x2c, 0xe0, 0xa6, 0xfa, 0x9b, 0x40, 0xbd, 0x9f, 0xca, 0x68, 0xeb, 0x80, 0x07, 0x32, 0x22,
+	0x54, 0x5a, 0xe0, 0x32, 0x21, 0x23, 0x22, 0x19, 0x0f, 0x2c, 0xf8, 0x66, 0x0c, 0x42, 0x9e, 0xa6,
+	0xd9, 0xf1, 0x1a, 0xba, 0x23, 0x99, 0xf4, 0x40, 0xd7, 0xba, 0x5a, 0xbf, 0x6e, 0x25, 0x06, 0xee,
+	0xa2, 0x86, 0x03, 0x82, 0x46, 0x2c, 0x8c, 0x63, 0xf4, 0xb2, 0xf2, 0x15, 0x21, 0x3c, 0x45, 0xef,
+	0xd0, 0x34, 0xbd, 0x1d, 0x15, 0xf2, 0xdb, 0x51, 0x72, 0x80, 0x5e, 0xe9, 0x6a, 0xfd, 0xc6, 0x03,
+	0xd3, 0xb8, 0xa9, 0x83, 0x31, 0xe3, 0x5e, 0xfb, 0xd5, 0x67, 0x7f, 0x75, 0x4a, 0xd6, 0x06, 0xfd,
+	0x7f, 0xca, 0xe0, 0xf1, 0xf7, 0x17, 0x9d, 0xd2, 0x8b, 0x8b, 0x4e, 0xe9, 0xb7, 0x5f, 0xee, 0xaf,
+	0xa7, 0x2a, 0xba, 0x7c, 0x62, 0x4c, 0x76, 0x87, 0x20, 0x49, 0x92, 0x1e, 0x02, 0xf9, 0xc3, 0x3f,
+	0x3f, 0xef, 0xdc, 0x4b, 0xda, 0x33, 0x87, 0x10, 0xbd, 0x5f, 0xcb, 0xa8, 0xbf, 0x4f, 0x24, 0x1d,
+	0xbd, 0x4e, 0xd5, 0xbe, 0x45, 0xed, 0x99, 0xaa, 0x09, 0xbd, 0xd2, 0xad, 0xbc, 0xba, 0x6c, 0x9b,
+	0x33, 0x64, 0x13, 0x83, 0x2f, 0xe6, 0xd7, 0x2d, 0x39, 0xd4, 0x9c, 0x57, 0x8f, 0xde, 0xef, 0x1a,
+	0xda, 0xfa, 0x0f, 0xf9, 0x21, 0x14, 0xcb, 0xbb, 0xb5, 0x6e, 0x9b, 0xa8, 0x9e, 0x95, 0x96, 0x48,
+	0x54, 0xb7, 0xae, 0x81, 0x57, 0x18, 0x88, 0x39, 0xee, 0xda, 0xfb, 0x4e, 0x43, 0xab, 0x87, 0x4f,
+	0x81, 0x8e, 0x63, 0xf4, 0x8c, 0x8e, 0xc0, 0x19, 0x7b, 0x80, 0xb7, 0xd1, 0xf2, 0xd0, 0xe3, 0xf4,
+	0x6b, 0x9b, 0x05, 0x12, 0xa2, 0x09, 0xf1, 0x54, 0x29, 0x55, 0x6b, 0x49, 0xa1, 0xc7, 0x29, 0x88,
+	0xb7, 0xd0, 0x92, 0x64, 0x3e, 0x5c, 0xb3, 0xca, 0x8a, 0xd5, 0x8c, 0xc1, 0x9c, 0xb4, 0x81, 0xea,
+	0x44, 0xda, 0x23, 0x60, 0xee, 0x28, 0xd9, 0x97, 0x8a, 0x55, 0x23, 0xf2, 0x23, 0x65, 0x0f, 0xaa,
+	0x2f, 0x2e, 0x3a, 0x5a, 0xef, 0xcf, 0x2a, 0xda, 0x98, 0xd1, 0x00, 0x7c, 0x0f, 0xb5, 0xf2, 0x81,
+	0x22, 0x8e, 0x13, 0x81, 0x10, 0xa9, 0xb6, 0x2b, 0x19, 0xbe, 0x97, 0xc0, 0xf1, 0x69, 0x2e, 0x11,
+	0xb6, 0xc7, 0x7c, 0x26, 0xd3, 0xeb, 0xd4, 0x5c, 0x22, 0x1e, 0xc5, 0x76, 0xe6, 0x0c, 0x23, 0x46,
+	0x41, 0xaf, 0xe4, 0xce, 0xd3, 0xd8, 0xc6, 0x06, 0x7a, 0x43, 0x8c, 0xf8, 0xd8, 0x73, 0xec, 0x90,
+	0x05, 0x76, 0x96, 0x57, 0xaf, 0x76, 0xb5, 0x7e, 0xcd, 0x5a, 0x4d, 0x5c, 0xa7, 0x2c, 0xc8, 0xee,
+	0x89, 0xdf, 0x47, 0x6b, 0x4c, 0xd8, 0x3e, 0x73, 0xd3, 0xe1, 0x26, 0x9e, 0xc7, 0x9f, 0x80, 0xa3,
+	0xdf, 0x51, 0x01, 0x98, 0x89, 0x93, 0xcc, 0xb5, 0x97, 0x78, 0xf0, 0x5b, 0x68, 0x91, 0x72, 0x07,
+	0x6c, 0xe6, 0xe8, 0x0b, 0xea, 0xf0, 0x85, 0xd8, 0x3c, 0x76, 0x62, 0x1d, 0x89, 0xe3, 0xb3, 0x20,
+	0x2f, 0x6e, 0x51, 0x15, 0xd7, 0x54, 0x60, 0x56, 0xd9, 0x7b, 0x68, 0xc5, 0x8d, 0x48, 0xac, 0x6a,
+	0x4e, 0xab, 0x29, 0xda, 0x72, 0x0a, 0x67, 0xc4, 0x7d, 0xd4, 0x3c, 0x1f, 0x07, 0x0e, 0x0b, 0x5c,
+	0xdb, 0xe7, 0x0e, 0xe8, 0xf5, 0xae, 0xd6, 0x5f, 0x7e, 0xd0, 0x79, 0xd9, 0xb2, 0x7d, 0x98, 0xf0,
+	0x4e, 0xb8, 0x03, 0x56, 0xe3, 0xfc, 0xda, 0xc0, 0x7b, 0xa8, 0x26, 0xd2, 0x61, 0xd0, 0x91, 0xfa,
+	0xc6, 0x6d, 0xbf, 0x2c, 0xfe, 0xc6, 0xe4, 0x58, 0x79, 0x18, 0x3e, 0x44, 0x4b, 0x79, 0xd3, 0xe4,
+	0x34, 0x04, 0xbd, 0xa1, 0xee, 0xd1, 0x9d, 0xb5, 0xf4, 0x8f, 0xa7, 0x21, 0x58, 0x4d, 0x5a, 0xb0,
+	0xf0, 0x5d, 0xd4, 0x84, 0x89, 0x6f, 0x53, 0xe2, 0x79, 0x0e, 0x91, 0x44, 0x6f, 0x76, 0xb5, 0x7e,
+	0xd3, 0x6a, 0xc0, 0xc4, 0x3f, 0x48, 0xa1, 0x41, 0x7b, 0xf6, 0x46, 0xf4, 0x2e, 0x35, 0xf4, 0xa6,
+	0xda, 0x85, 0x33, 0xc9, 0x23, 0x38, 0xe0, 0x0e, 0xdc, 0x7a, 0x55, 0x8f, 0x50, 0x3d, 0x7b, 0xba,
+	0xb2, 0xaf, 0xd9, 0x96, 0x91, 0xbd, 0x6e, 0xaa, 0xae, 0xb8, 0xac, 0x1b, 0xe7, 0xa5, 0x5f, 0xb0,
+	0xeb, 0xd8, 0xc1, 0xd1, 0xfc, 0x5b, 0xbd, 0x59, 0xd8, 0xea, 0x1b, 0x99, 0x77, 0x8e, 0x50, 0xa3,
+	0xd0, 0x4d, 0xbc, 0x82, 0x1a, 0x9f, 0x06, 0x22, 0x04, 0xca, 0xce, 0x19, 0x38, 0xad, 0x12, 0x5e,
+	0x46, 0xe8, 0x0c, 0xbc, 0xf3, 0x98, 0x03, 0x4e, 0x4b, 0xc3, 0x4b, 0xa8, 0x7e, 0x14, 0xcf, 0xcd,
+	0x27, 0x81, 0x37, 0x6d, 0x95, 0x71, 0x0d, 0x55, 0x1f, 0x8e, 0x89, 0xd7, 0xaa, 0xec, 0x6c, 0xa3,
+	0x66, 0xb1, 0x1d, 0xb8, 0x89, 0x6a, 0x07, 0x5c, 0xf8, 0x9f, 0x13, 0xe1, 0xb7, 0x4a, 0x78, 0x11,
+	0x55, 0x0e, 0x3f, 0x3b, 0x69, 0x69, 0xfb, 0xf0, 0xec, 0xb2, 0xad, 0x3d, 0xbf, 0x6c, 0x6b, 0x7f,
+	0x5f, 0xb6, 0xb5, 0x1f, 0xaf, 0xda, 0xa5, 0xe7, 0x57, 0xed, 0xd2, 0x1f, 0x57, 0xed, 0xd2, 0x97,
+	0x1f, 0xbb, 0x4c, 0x8e, 0xc6, 0x43, 0x83, 0x72, 0xdf, 0x3c, 0xce, 0x7a, 0xfd, 0x88, 0x0c, 0x85,
+	0x99, 0x77, 0xfe, 0x3e, 0xe5, 0x11, 0x14, 0xcd, 0x11, 0x61, 0x81, 0xe9, 0xf3, 0x78, 0x70, 0x44,
+	0xfa, 0x6b, 0x23, 0x9e, 0x19, 0x31, 0x5c, 0x50, 0x0f, 0xfd, 0x07, 0xff, 0x0e, 0x00, 0x9d, 0x7d,
+	0x2a, 0x3d, 0x8d, 0x08, 0x00, 0x00,
+}
+
+func (this *ExecutionSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecutionSchedule)
+	if !ok {
+		that2, ok := that.(ExecutionSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockInterval != that1.BlockInterval {
+		return false
+	}
+	if this.TimeInterval != that1.TimeInterval {
+		return false
+	}
+	if this.AtHeight != that1.AtHeight {
+		return false
+	}
+	return true
 }
-
 func (m *ContractRegistrationRequestProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -541,6 +706,44 @@ func (m *BatchContractDeregistrationProposal) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AtHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.AtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimeInterval != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.TimeInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockInterval != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractRegistrationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -561,6 +764,30 @@ func (m *ContractRegistrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmCalldata) > 0 {
+		i -= len(m.EvmCalldata)
+		copy(dAtA[i:], m.EvmCalldata)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.EvmCalldata)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.FundingMode != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.FundingMode))
 		i--
@@ -752,6 +979,24 @@ func (m *BatchContractDeregistrationProposal) Size() (n int) {
 	return n
 }
 
+func (m *ExecutionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockInterval != 0 {
+		n += 1 + sovProposal(uint64(m.BlockInterval))
+	}
+	if m.TimeInterval != 0 {
+		n += 1 + sovProposal(uint64(m.TimeInterval))
+	}
+	if m.AtHeight != 0 {
+		n += 1 + sovProposal(uint64(m.AtHeight))
+	}
+	return n
+}
+
 func (m *ContractRegistrationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -788,6 +1033,17 @@ func (m *ContractRegistrationRequest) Size() (n int) {
 	if m.FundingMode != 0 {
 		n += 1 + sovProposal(uint64(m.FundingMode))
 	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ContractType != 0 {
+		n += 1 + sovProposal(uint64(m.ContractType))
+	}
+	l = len(m.EvmCalldata)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -1261,6 +1517,113 @@ func (m *BatchContractDeregistrationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExecutionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+			}
+			m.TimeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtHeight", wireType)
+			}
+			m.AtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ContractRegistrationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1502,6 +1865,95 @@ func (m *ContractRegistrationRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &ExecutionSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCalldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmCalldata = append(m.EvmCalldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmCalldata == nil {
+				m.EvmCalldata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -286,6 +286,135 @@ func (m *QueryContractRegistrationInfoResponse) GetContract() *RegisteredContrac
 	return nil
 }
 
+type QueryContractScheduleRequest struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryContractScheduleRequest) Reset()         { *m = QueryContractScheduleRequest{} }
+func (m *QueryContractScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractScheduleRequest) ProtoMessage()    {}
+func (*QueryContractScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_660c5209971a3cd4, []int{6}
+}
+func (m *QueryContractScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractScheduleRequest.Merge(m, src)
+}
+func (m *QueryContractScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryContractScheduleRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type QueryContractScheduleResponse struct {
+	// schedule of the contract executions, nil if executed every block
+	Schedule *ExecutionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// height of the last execution, 0 if never executed or if executed every
+	// block
+	LastRunHeight int64 `protobuf:"varint,2,opt,name=last_run_height,json=lastRunHeight,proto3" json:"last_run_height,omitempty"`
+	// unix timestamp (in seconds) of the last execution, 0 if never executed or
+	// if executed every block
+	LastRunTimestamp int64 `protobuf:"varint,3,opt,name=last_run_timestamp,json=lastRunTimestamp,proto3" json:"last_run_timestamp,omitempty"`
+	// earliest height of the next execution, 0 if it doesn't depend on the
+	// height or if the contract won't be executed anymore
+	NextRunHeight int64 `protobuf:"varint,4,opt,name=next_run_height,json=nextRunHeight,proto3" json:"next_run_height,omitempty"`
+	// earliest unix timestamp (in seconds) of the next execution, 0 if it doesn't
+	// depend on the block time
+	NextRunTimestamp int64 `protobuf:"varint,5,opt,name=next_run_timestamp,json=nextRunTimestamp,proto3" json:"next_run_timestamp,omitempty"`
+}
+
+func (m *QueryContractScheduleResponse) Reset()         { *m = QueryContractScheduleResponse{} }
+func (m *QueryContractScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractScheduleResponse) ProtoMessage()    {}
+func (*QueryContractScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_660c5209971a3cd4, []int{7}
+}
+func (m *QueryContractScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractScheduleResponse.Merge(m, src)
+}
+func (m *QueryContractScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryContractScheduleResponse) GetSchedule() *ExecutionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *QueryContractScheduleResponse) GetLastRunHeight() int64 {
+	if m != nil {
+		return m.LastRunHeight
+	}
+	return 0
+}
+
+func (m *QueryContractScheduleResponse) GetLastRunTimestamp() int64 {
+	if m != nil {
+		return m.LastRunTimestamp
+	}
+	return 0
+}
+
+func (m *QueryContractScheduleResponse) GetNextRunHeight() int64 {
+	if m != nil {
+		return m.NextRunHeight
+	}
+	return 0
+}
+
+func (m *QueryContractScheduleResponse) GetNextRunTimestamp() int64 {
+	if m != nil {
+		return m.NextRunTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryWasmxParamsRequest)(nil), "injective.wasmx.v1.QueryWasmxParamsRequest")
 	proto.RegisterType((*QueryWasmxParamsResponse)(nil), "injective.wasmx.v1.QueryWasmxParamsResponse")
@@ -293,44 +422,55 @@ func init() {
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.wasmx.v1.QueryModuleStateResponse")
 	proto.RegisterType((*QueryContractRegistrationInfoRequest)(nil), "injective.wasmx.v1.QueryContractRegistrationInfoRequest")
 	proto.RegisterType((*QueryContractRegistrationInfoResponse)(nil), "injective.wasmx.v1.QueryContractRegistrationInfoResponse")
+	proto.RegisterType((*QueryContractScheduleRequest)(nil), "injective.wasmx.v1.QueryContractScheduleRequest")
+	proto.RegisterType((*QueryContractScheduleResponse)(nil), "injective.wasmx.v1.QueryContractScheduleResponse")
 }
 
 func init() { proto.RegisterFile("injective/wasmx/v1/query.proto", fileDescriptor_660c5209971a3cd4) }
 
 var fileDescriptor_660c5209971a3cd4 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x4a, 0x5b, 0x74, 0x7a, 0xb0, 0x0c, 0x82, 0x71, 0x29, 0x6b, 0x18, 0x54, 0x2a, 0xea,
-	0x0e, 0xad, 0x20, 0xd1, 0x93, 0xc6, 0x83, 0x14, 0x15, 0xec, 0x2a, 0x08, 0x5e, 0xc2, 0x64, 0xf3,
-	0xba, 0x1d, 0x6d, 0x66, 0xb6, 0x33, 0x93, 0x68, 0x11, 0x2f, 0xe2, 0xd1, 0x83, 0xe0, 0xc1, 0xaf,
-	0xd4, 0x63, 0xc5, 0x8b, 0x27, 0x91, 0xc4, 0x0f, 0x22, 0x99, 0x99, 0x8d, 0xd1, 0x4c, 0x5a, 0xe9,
-	0x6d, 0xf6, 0xbd, 0xf7, 0xfb, 0xf3, 0xfe, 0xb0, 0x28, 0xe1, 0xe2, 0x25, 0xe4, 0x86, 0x0f, 0x80,
-	0xbe, 0x66, 0xba, 0xf7, 0x86, 0x0e, 0xd6, 0xe9, 0x5e, 0x1f, 0xd4, 0x7e, 0x5a, 0x2a, 0x69, 0x24,
-	0xc6, 0x93, 0x7c, 0x6a, 0xf3, 0xe9, 0x60, 0x3d, 0x5e, 0x2d, 0xa4, 0x2c, 0x76, 0x81, 0xb2, 0x92,
-	0x53, 0x26, 0x84, 0x34, 0xcc, 0x70, 0x29, 0xb4, 0x43, 0xc4, 0x21, 0x46, 0x07, 0x75, 0xf9, 0x46,
-	0x20, 0x5f, 0x80, 0x00, 0xcd, 0x2b, 0x86, 0x73, 0x85, 0x2c, 0xa4, 0x7d, 0xd2, 0xf1, 0xcb, 0x45,
-	0xc9, 0x05, 0x74, 0x7e, 0x6b, 0x6c, 0xec, 0xf9, 0x18, 0xf4, 0x84, 0x29, 0xd6, 0xd3, 0x19, 0xec,
-	0xf5, 0x41, 0x1b, 0xf2, 0x0c, 0xd5, 0x67, 0x53, 0xba, 0x94, 0x42, 0x03, 0x6e, 0xa2, 0xa5, 0xd2,
-	0x46, 0xea, 0x51, 0x23, 0x5a, 0x5b, 0xde, 0x88, 0xd3, 0xd9, 0x8e, 0x52, 0x87, 0x69, 0x2d, 0x1c,
-	0xfc, 0xb8, 0x58, 0xcb, 0x7c, 0xfd, 0x44, 0xf0, 0xb1, 0xec, 0xf6, 0x77, 0xe1, 0xa9, 0x61, 0x06,
-	0x2a, 0xc1, 0xcc, 0x0b, 0xfe, 0x95, 0xf2, 0x82, 0xb7, 0xd0, 0xa2, 0x1e, 0x07, 0xbc, 0x5e, 0x23,
-	0xa4, 0xf7, 0xc0, 0xf5, 0xeb, 0x80, 0xae, 0x9c, 0x6c, 0xa1, 0x4b, 0x96, 0xf3, 0xbe, 0x14, 0x46,
-	0xb1, 0xdc, 0x64, 0x50, 0x70, 0x6d, 0x94, 0x9d, 0xed, 0xa6, 0xd8, 0x96, 0x5e, 0x1b, 0x5f, 0x45,
-	0x2b, 0xb9, 0x2f, 0x69, 0xb3, 0x6e, 0x57, 0x81, 0x76, 0xad, 0x9d, 0xc9, 0xce, 0x56, 0xf1, 0x7b,
-	0x2e, 0x4c, 0x5e, 0xa1, 0xcb, 0xc7, 0x50, 0x7a, 0xcf, 0x2d, 0x74, 0xba, 0xc2, 0x7a, 0xdb, 0x57,
-	0x42, 0xb6, 0x1d, 0x1e, 0x14, 0x74, 0x27, 0x8c, 0x13, 0xdc, 0xc6, 0x87, 0x05, 0xb4, 0x68, 0xd5,
-	0xf0, 0xc7, 0x08, 0x2d, 0x4f, 0xad, 0x02, 0x5f, 0x0b, 0x71, 0xcd, 0xd9, 0x65, 0x7c, 0xfd, 0xff,
-	0x8a, 0x9d, 0x71, 0x42, 0xde, 0x7f, 0xfb, 0xf5, 0xf9, 0xd4, 0x2a, 0x8e, 0x69, 0xe0, 0xaa, 0xdc,
-	0x1e, 0xf1, 0xd7, 0x08, 0xd5, 0xe7, 0x4d, 0x00, 0x37, 0xe7, 0xca, 0x1d, 0xb3, 0x87, 0xf8, 0xf6,
-	0x09, 0x90, 0xde, 0xf5, 0x5d, 0xeb, 0xfa, 0x0e, 0x6e, 0x86, 0x5c, 0xab, 0x29, 0x54, 0x9b, 0x8b,
-	0x6d, 0x49, 0xdf, 0xfe, 0xbb, 0xef, 0x77, 0xf8, 0x4b, 0x84, 0x56, 0xec, 0x3c, 0xa6, 0x2e, 0xf0,
-	0x88, 0x39, 0xcf, 0x9e, 0xf0, 0x11, 0x73, 0x0e, 0x1c, 0x35, 0x59, 0xb3, 0x8e, 0x09, 0x6e, 0x84,
-	0x1c, 0xf7, 0x2c, 0xa0, 0x6d, 0xcf, 0xb8, 0x05, 0x07, 0xc3, 0x24, 0x3a, 0x1c, 0x26, 0xd1, 0xcf,
-	0x61, 0x12, 0x7d, 0x1a, 0x25, 0xb5, 0xc3, 0x51, 0x52, 0xfb, 0x3e, 0x4a, 0x6a, 0x2f, 0x1e, 0x16,
-	0xdc, 0xec, 0xf4, 0x3b, 0x69, 0x2e, 0x7b, 0x74, 0xb3, 0x62, 0x79, 0xc4, 0x3a, 0xfa, 0x0f, 0xe7,
-	0x8d, 0x5c, 0x2a, 0x98, 0xfe, 0xdc, 0x61, 0x5c, 0x78, 0x7e, 0xed, 0x05, 0xcd, 0x7e, 0x09, 0xba,
-	0xb3, 0x64, 0x7f, 0x0a, 0x37, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x06, 0x07, 0x17, 0xc0,
-	0x04, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0xb4, 0xdd, 0x52, 0xa7, 0x48, 0x97, 0x41, 0x30, 0x86, 0x1a, 0xd7, 0x60, 0x4b, 0xc5,
+	0x9a, 0xd8, 0x0a, 0x52, 0x3d, 0xd9, 0x8a, 0x68, 0x51, 0xc1, 0xa6, 0x05, 0xc1, 0xcb, 0x32, 0xcd,
+	0x4e, 0xb3, 0xd1, 0xcd, 0x4c, 0x9a, 0x99, 0xac, 0x5b, 0xc4, 0x8b, 0x67, 0x11, 0xc1, 0x83, 0x9f,
+	0xc5, 0x9b, 0xc7, 0x1e, 0x2b, 0x5e, 0x3c, 0x89, 0xec, 0xfa, 0x41, 0x24, 0x33, 0x93, 0x74, 0xff,
+	0x64, 0xdd, 0xb5, 0xb7, 0xec, 0x7b, 0xbf, 0x7f, 0xf3, 0xf6, 0x65, 0x02, 0xcc, 0x80, 0xbc, 0xc2,
+	0x1e, 0x0f, 0x5a, 0xd8, 0x79, 0x83, 0x58, 0xd8, 0x76, 0x5a, 0x6b, 0xce, 0x61, 0x82, 0xe3, 0x23,
+	0x3b, 0x8a, 0x29, 0xa7, 0x10, 0xe6, 0x7d, 0x5b, 0xf4, 0xed, 0xd6, 0x9a, 0xb1, 0xe8, 0x53, 0xea,
+	0x37, 0xb1, 0x83, 0xa2, 0xc0, 0x41, 0x84, 0x50, 0x8e, 0x78, 0x40, 0x09, 0x93, 0x0c, 0xa3, 0x48,
+	0x51, 0x52, 0x65, 0xbf, 0x5a, 0xd0, 0xf7, 0x31, 0xc1, 0x2c, 0xc8, 0x14, 0xae, 0x16, 0x20, 0xa2,
+	0x98, 0x46, 0x94, 0xa1, 0xa6, 0x82, 0x5c, 0xf0, 0xa9, 0x4f, 0xc5, 0xa3, 0x93, 0x3e, 0xc9, 0xaa,
+	0x75, 0x09, 0x5c, 0xdc, 0x49, 0xb3, 0xbf, 0x48, 0x59, 0xcf, 0x51, 0x8c, 0x42, 0xe6, 0xe2, 0xc3,
+	0x04, 0x33, 0x6e, 0xed, 0x01, 0x7d, 0xb8, 0xc5, 0x22, 0x4a, 0x18, 0x86, 0x1b, 0x60, 0x36, 0x12,
+	0x15, 0x5d, 0xab, 0x6a, 0x2b, 0xf3, 0xeb, 0x86, 0x3d, 0x7c, 0x68, 0x5b, 0x72, 0xb6, 0x66, 0x8e,
+	0x7f, 0x5d, 0x29, 0xb9, 0x0a, 0x9f, 0x1b, 0x3e, 0xa3, 0xf5, 0xa4, 0x89, 0x77, 0x39, 0xe2, 0x38,
+	0x33, 0x74, 0x81, 0x3e, 0xdc, 0x52, 0x86, 0x77, 0x40, 0x99, 0xa5, 0x05, 0xe5, 0x57, 0x2d, 0xf2,
+	0x7b, 0x24, 0x47, 0x22, 0x89, 0x12, 0x6e, 0xed, 0x80, 0x6b, 0x42, 0xf3, 0x01, 0x25, 0x3c, 0x46,
+	0x1e, 0x77, 0xb1, 0x1f, 0x30, 0x1e, 0x8b, 0xf1, 0x6f, 0x93, 0x03, 0xaa, 0xbc, 0xe1, 0x75, 0x50,
+	0xf1, 0x14, 0xa4, 0x86, 0xea, 0xf5, 0x18, 0x33, 0x79, 0xb4, 0x73, 0xee, 0x42, 0x56, 0xdf, 0x94,
+	0x65, 0xeb, 0x35, 0x58, 0x1a, 0x23, 0xa9, 0x32, 0x6f, 0x81, 0xb9, 0x8c, 0xab, 0x62, 0x2f, 0x17,
+	0xc5, 0x96, 0x7c, 0x1c, 0xe3, 0x7a, 0xae, 0x98, 0xf3, 0xac, 0x6d, 0xb0, 0xd8, 0x67, 0xb6, 0xeb,
+	0x35, 0x70, 0x3a, 0x9d, 0x33, 0xe4, 0xfe, 0x38, 0x05, 0x2e, 0x8f, 0xd0, 0x52, 0x81, 0x37, 0xc1,
+	0x1c, 0x53, 0x35, 0x15, 0x78, 0xa9, 0x28, 0xf0, 0xc3, 0x36, 0xf6, 0x92, 0xf4, 0xb4, 0xb9, 0x40,
+	0x4e, 0x83, 0xcb, 0x60, 0xa1, 0x89, 0x18, 0xaf, 0xc5, 0x09, 0xa9, 0x35, 0x70, 0xe0, 0x37, 0xb8,
+	0x3e, 0x55, 0xd5, 0x56, 0xa6, 0xdd, 0xf3, 0x69, 0xd9, 0x4d, 0xc8, 0x63, 0x51, 0x84, 0xab, 0x00,
+	0xe6, 0x38, 0x1e, 0x84, 0x98, 0x71, 0x14, 0x46, 0xfa, 0xb4, 0x80, 0x56, 0x14, 0x74, 0x2f, 0xab,
+	0xa7, 0xaa, 0x04, 0xb7, 0xfb, 0x54, 0x67, 0xa4, 0x6a, 0x5a, 0xee, 0x53, 0xcd, 0x71, 0xa7, 0xaa,
+	0x65, 0xa9, 0xaa, 0xa0, 0xb9, 0xea, 0xfa, 0xb7, 0x32, 0x28, 0x8b, 0x81, 0xc0, 0x0f, 0x1a, 0x98,
+	0xef, 0x59, 0x73, 0x78, 0xa3, 0xe8, 0xd8, 0x23, 0xde, 0x13, 0x63, 0x75, 0x32, 0xb0, 0x9c, 0xb1,
+	0x65, 0xbd, 0xff, 0xf1, 0xe7, 0xf3, 0xd4, 0x22, 0x34, 0x9c, 0xa2, 0x57, 0x56, 0xda, 0x7f, 0xd7,
+	0x80, 0x3e, 0x6a, 0xbb, 0xe0, 0xc6, 0x48, 0xbb, 0x31, 0x3b, 0x6e, 0xdc, 0x3d, 0x03, 0x53, 0xa5,
+	0xbe, 0x2f, 0x52, 0xdf, 0x83, 0x1b, 0x45, 0xa9, 0xe3, 0x1e, 0x56, 0x2d, 0x20, 0x07, 0xd4, 0x79,
+	0x3b, 0xb8, 0x93, 0xef, 0xe0, 0x57, 0x0d, 0x54, 0x06, 0x17, 0x0f, 0xde, 0x1a, 0x9b, 0x68, 0x60,
+	0xdf, 0x8d, 0xb5, 0xff, 0x60, 0x4c, 0x92, 0x3d, 0x0f, 0x9a, 0x6d, 0x70, 0x51, 0xf6, 0x2f, 0x1a,
+	0xa8, 0x88, 0xff, 0xb2, 0xe7, 0x66, 0xfa, 0xc7, 0x8e, 0x0c, 0x5f, 0x6d, 0xc6, 0xea, 0x64, 0x60,
+	0x95, 0x78, 0x45, 0x24, 0xb6, 0x60, 0xb5, 0x28, 0x71, 0x28, 0x08, 0x35, 0x71, 0xbd, 0x6d, 0xe1,
+	0xe3, 0x8e, 0xa9, 0x9d, 0x74, 0x4c, 0xed, 0x77, 0xc7, 0xd4, 0x3e, 0x75, 0xcd, 0xd2, 0x49, 0xd7,
+	0x2c, 0xfd, 0xec, 0x9a, 0xa5, 0x97, 0x4f, 0xfc, 0x80, 0x37, 0x92, 0x7d, 0xdb, 0xa3, 0xa1, 0xb3,
+	0x9d, 0xa9, 0x3c, 0x45, 0xfb, 0xec, 0x54, 0xf3, 0xa6, 0x47, 0x63, 0xdc, 0xfb, 0xb3, 0x81, 0x02,
+	0xa2, 0xf4, 0x99, 0x32, 0xe4, 0x47, 0x11, 0x66, 0xfb, 0xb3, 0xe2, 0x63, 0x71, 0xfb, 0xef, 0x00,
+	0x40, 0xf8, 0x6a, 0xf6, 0xfb, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +489,9 @@ type QueryClient interface {
 	WasmxParams(ctx context.Context, in *QueryWasmxParamsRequest, opts ...grpc.CallOption) (*QueryWasmxParamsResponse, error)
 	// Retrieves contract registration info
 	ContractRegistrationInfo(ctx context.Context, in *QueryContractRegistrationInfoRequest, opts ...grpc.CallOption) (*QueryContractRegistrationInfoResponse, error)
+	// Retrieves the execution schedule of a registered contract along with its
+	// last and next executions
+	ContractSchedule(ctx context.Context, in *QueryContractScheduleRequest, opts ...grpc.CallOption) (*QueryContractScheduleResponse, error)
 	// Retrieves the entire wasmx module's state
 	WasmxModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -379,6 +522,15 @@ func (c *queryClient) ContractRegistrationInfo(ctx context.Context, in *QueryCon
 	return out, nil
 }
 
+func (c *queryClient) ContractSchedule(ctx context.Context, in *QueryContractScheduleRequest, opts ...grpc.CallOption) (*QueryContractScheduleResponse, error) {
+	out := new(QueryContractScheduleResponse)
+	err := c.cc.Invoke(ctx, "/injective.wasmx.v1.Query/ContractSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) WasmxModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/injective.wasmx.v1.Query/WasmxModuleState", in, out, opts...)
@@ -394,6 +546,9 @@ type QueryServer interface {
 	WasmxParams(context.Context, *QueryWasmxParamsRequest) (*QueryWasmxParamsResponse, error)
 	// Retrieves contract registration info
 	ContractRegistrationInfo(context.Context, *QueryContractRegistrationInfoRequest) (*QueryContractRegistrationInfoResponse, error)
+	// Retrieves the execution schedule of a registered contract along with its
+	// last and next executions
+	ContractSchedule(context.Context, *QueryContractScheduleRequest) (*QueryContractScheduleResponse, error)
 	// Retrieves the entire wasmx module's state
 	WasmxModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -408,6 +563,9 @@ func (*UnimplementedQueryServer) WasmxParams(ctx context.Context, req *QueryWasm
 func (*UnimplementedQueryServer) ContractRegistrationInfo(ctx context.Context, req *QueryContractRegistrationInfoRequest) (*QueryContractRegistrationInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContractRegistrationInfo not implemented")
 }
+func (*UnimplementedQueryServer) ContractSchedule(ctx context.Context, req *QueryContractScheduleRequest) (*QueryContractScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSchedule not implemented")
+}
 func (*UnimplementedQueryServer) WasmxModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WasmxModuleState not implemented")
 }
@@ -452,6 +610,24 @@ func _Query_ContractRegistrationInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.wasmx.v1.Query/ContractSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSchedule(ctx, req.(*QueryContractScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_WasmxModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -482,6 +658,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContractRegistrationInfo",
 			Handler:    _Query_ContractRegistrationInfo_Handler,
 		},
+		{
+			MethodName: "ContractSchedule",
+			Handler:    _Query_ContractSchedule_Handler,
+		},
 		{
 			MethodName: "WasmxModuleState",
 			Handler:    _Query_WasmxModuleState_Handler,
@@ -670,6 +850,91 @@ func (m *QueryContractRegistrationInfoResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryContractScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRunTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRunTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextRunHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRunHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastRunTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRunTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastRunHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRunHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -749,6 +1014,44 @@ func (m *QueryContractRegistrationInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContractScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastRunHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastRunHeight))
+	}
+	if m.LastRunTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.LastRunTimestamp))
+	}
+	if m.NextRunHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextRunHeight))
+	}
+	if m.NextRunTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.NextRunTimestamp))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1192,6 +1495,250 @@ func (m *QueryContractRegistrationInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryContractScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &ExecutionSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunHeight", wireType)
+			}
+			m.LastRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunTimestamp", wireType)
+			}
+			m.LastRunTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRunTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunHeight", wireType)
+			}
+			m.NextRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunTimestamp", wireType)
+			}
+			m.NextRunTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// Validate checks that at most one of the schedule fields is set
+func (s *ExecutionSchedule) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if s.AtHeight < 0 {
+		return errors.Wrapf(ErrInvalidExecutionSchedule, "at_height (%d) cannot be negative", s.AtHeight)
+	}
+
+	setFields := 0
+	if s.BlockInterval > 0 {
+		setFields++
+	}
+	if s.TimeInterval > 0 {
+		setFields++
+	}
+	if s.AtHeight > 0 {
+		setFields++
+	}
+
+	if setFields > 1 {
+		return errors.Wrap(ErrInvalidExecutionSchedule, "only one of block_interval, time_interval or at_height can be set")
+	}
+
+	return nil
+}
+
+// IsDue returns true if a contract with this schedule should be executed at the given height and block time (unix
+// seconds). A nil or empty schedule is due every block.
+func (s *ExecutionSchedule) IsDue(state ContractExecutionState, height, blockTime int64) bool {
+	switch {
+	case s == nil:
+		return true
+	case s.AtHeight > 0:
+		return height >= s.AtHeight && state.LastRunHeight < s.AtHeight
+	case s.TimeInterval > 0:
+		return state.LastRunTimestamp == 0 || blockTime >= state.LastRunTimestamp+int64(s.TimeInterval)
+	case s.BlockInterval > 0:
+		return state.LastRunHeight == 0 || height >= state.LastRunHeight+int64(s.BlockInterval)
+	default:
+		return true
+	}
+}
+
+// NextRun returns the earliest height and block time (unix seconds) at which a contract with this schedule will be
+// executed, given the current height and block time. A zero value means that the next execution doesn't depend on it,
+// both are zero if the contract won't be executed anymore.
+func (s *ExecutionSchedule) NextRun(state ContractExecutionState, height, blockTime int64) (nextHeight, nextTimestamp int64) {
+	switch {
+	case s == nil:
+		return nextEveryBlocks(state, 1, height), 0
+	case s.AtHeight > 0:
+		if state.LastRunHeight >= s.AtHeight {
+			return 0, 0
+		}
+		return max(s.AtHeight, height), 0
+	case s.TimeInterval > 0:
+		if state.LastRunTimestamp == 0 {
+			return 0, blockTime
+		}
+		return 0, max(state.LastRunTimestamp+int64(s.TimeInterval), blockTime)
+	case s.BlockInterval > 0:
+		return nextEveryBlocks(state, int64(s.BlockInterval), height), 0
+	default:
+		return nextEveryBlocks(state, 1, height), 0
+	}
+}
+
+func nextEveryBlocks(state ContractExecutionState, interval, height int64) int64 {
+	if state.LastRunHeight == 0 {
+		return height
+	}
+	return max(state.LastRunHeight+interval, height)
+}
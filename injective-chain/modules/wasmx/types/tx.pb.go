@@ -162,6 +162,9 @@ type MsgUpdateContract struct {
 	GasPrice uint64 `protobuf:"varint,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 	// optional - admin account that will be allowed to perform any changes
 	AdminAddress string `protobuf:"bytes,5,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	// optional - schedule of the contract executions, executed every block if
+	// unset
+	Schedule *ExecutionSchedule `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *MsgUpdateContract) Reset()         { *m = MsgUpdateContract{} }
@@ -232,6 +235,13 @@ func (m *MsgUpdateContract) GetAdminAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateContract) GetSchedule() *ExecutionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
 type MsgUpdateContractResponse struct {
 }
 
@@ -644,58 +654,59 @@ func init() {
 func init() { proto.RegisterFile("injective/wasmx/v1/tx.proto", fileDescriptor_f7afe23baa925f70) }
 
 var fileDescriptor_f7afe23baa925f70 = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x4f, 0xdc, 0x46,
-	0x14, 0x5e, 0x97, 0x5d, 0x04, 0x53, 0x2a, 0xc0, 0x85, 0xb2, 0xeb, 0x2d, 0x86, 0xba, 0xaa, 0x60,
-	0xa1, 0xac, 0x05, 0xad, 0x50, 0xb5, 0x37, 0xa0, 0x3d, 0x54, 0x65, 0x25, 0xe4, 0xaa, 0x97, 0x5e,
-	0xb6, 0xb3, 0xf6, 0x30, 0xb8, 0x5a, 0x7b, 0x5c, 0xcf, 0x2c, 0x65, 0xdb, 0x4b, 0xd5, 0x1c, 0x22,
-	0xe5, 0x94, 0x5f, 0x91, 0x5b, 0x24, 0x0e, 0xf9, 0x03, 0xb9, 0x71, 0x44, 0xc9, 0x25, 0xa7, 0x28,
-	0x82, 0x48, 0xfc, 0x8d, 0xc8, 0x33, 0xe3, 0xd9, 0xe0, 0xb5, 0xc9, 0x46, 0x4a, 0x2e, 0x2b, 0xbf,
-	0xf7, 0xbe, 0x99, 0xef, 0xfb, 0xde, 0xbc, 0xf1, 0x1a, 0xd4, 0xfd, 0xf0, 0x4f, 0xe4, 0x32, 0xff,
-	0x14, 0xd9, 0x7f, 0x43, 0x1a, 0x9c, 0xd9, 0xa7, 0xdb, 0x36, 0x3b, 0x6b, 0x46, 0x31, 0x61, 0x44,
-	0xd7, 0x55, 0xb1, 0xc9, 0x8b, 0xcd, 0xd3, 0x6d, 0x63, 0x01, 0x13, 0x4c, 0x78, 0xd9, 0x4e, 0x9e,
-	0x04, 0xd2, 0xa8, 0x61, 0x42, 0x70, 0x0f, 0xd9, 0x3c, 0xea, 0xf6, 0x8f, 0x6d, 0x18, 0x0e, 0xd2,
-	0x92, 0x4b, 0x68, 0x40, 0x68, 0x47, 0xac, 0x11, 0x81, 0x2c, 0x2d, 0x89, 0xc8, 0x0e, 0x28, 0x4e,
-	0x78, 0x03, 0x8a, 0x65, 0xc1, 0xcc, 0x51, 0x25, 0x14, 0x88, 0xfa, 0x57, 0x39, 0xf5, 0x28, 0x26,
-	0x11, 0xa1, 0xb0, 0x27, 0x21, 0xf3, 0x30, 0xf0, 0x43, 0x62, 0xf3, 0x5f, 0x91, 0xb2, 0x1e, 0x69,
-	0xa0, 0xda, 0xa6, 0xf8, 0xa7, 0x33, 0xe4, 0xf6, 0x19, 0x3a, 0x20, 0x21, 0x8b, 0xa1, 0xcb, 0x0e,
-	0x48, 0x10, 0x41, 0xa6, 0x7f, 0x01, 0x26, 0x29, 0x0a, 0x3d, 0x14, 0x57, 0xb5, 0x55, 0x6d, 0x7d,
-	0xda, 0x91, 0x91, 0x6e, 0x80, 0x29, 0x57, 0x22, 0xab, 0x9f, 0xf0, 0x8a, 0x8a, 0xf5, 0x39, 0x30,
-	0x11, 0x50, 0x5c, 0x9d, 0xe0, 0xe9, 0xe4, 0x51, 0x5f, 0x00, 0x95, 0xe3, 0x7e, 0xe8, 0xd1, 0x6a,
-	0x99, 0xe7, 0x44, 0xd0, 0x6a, 0xfe, 0x7f, 0x73, 0xbe, 0x21, 0x37, 0x7c, 0x70, 0x73, 0xbe, 0x61,
-	0x0a, 0xd1, 0x45, 0x5a, 0xac, 0x5d, 0xb0, 0x5a, 0x54, 0x73, 0x10, 0x8d, 0x48, 0x48, 0x91, 0xae,
-	0x83, 0xb2, 0x07, 0x19, 0xe4, 0x6a, 0x67, 0x1c, 0xfe, 0x6c, 0xbd, 0xd6, 0xc0, 0x7c, 0x9b, 0xe2,
-	0xdf, 0x22, 0x0f, 0x0e, 0xd7, 0x15, 0x3a, 0x6b, 0x80, 0xb9, 0xd4, 0x49, 0x07, 0x7a, 0x5e, 0x8c,
-	0x28, 0x95, 0x0e, 0x67, 0xd3, 0xfc, 0x9e, 0x48, 0xeb, 0x75, 0x30, 0x8d, 0x21, 0xed, 0xf4, 0xfc,
-	0xc0, 0x67, 0xdc, 0x6e, 0xd9, 0x99, 0xc2, 0x90, 0x1e, 0x26, 0x71, 0x5a, 0x8c, 0x62, 0xdf, 0x45,
-	0xdc, 0xb7, 0x28, 0x1e, 0x25, 0xb1, 0xde, 0x00, 0x9f, 0x41, 0x2f, 0xf0, 0x43, 0xc5, 0x50, 0x49,
-	0x18, 0xf6, 0xcb, 0x17, 0x2f, 0x57, 0x34, 0x67, 0x86, 0x97, 0x24, 0x49, 0x6b, 0x2d, 0xd3, 0xa5,
-	0x25, 0xd5, 0xa5, 0xdb, 0x86, 0xac, 0x3a, 0xa8, 0x8d, 0x24, 0xd3, 0xbe, 0x58, 0xf7, 0x34, 0xf0,
-	0x79, 0x9b, 0xe2, 0xbd, 0x64, 0x3a, 0x3e, 0x6c, 0x17, 0x5a, 0x8d, 0x8c, 0xc0, 0x9a, 0x12, 0x98,
-	0x65, 0xb3, 0x96, 0x41, 0x3d, 0x27, 0xad, 0x44, 0xde, 0xd7, 0xc0, 0x62, 0x9b, 0xe2, 0x1f, 0x11,
-	0xfc, 0x08, 0x32, 0x37, 0x33, 0x32, 0xeb, 0x4a, 0xe6, 0x28, 0x9f, 0xb5, 0x02, 0x96, 0x73, 0x0b,
-	0x4a, 0xea, 0x63, 0x0d, 0xcc, 0xaa, 0x6e, 0x1f, 0xc1, 0x18, 0x06, 0x54, 0xdf, 0x05, 0xd3, 0xb0,
-	0xcf, 0x4e, 0x48, 0xec, 0xb3, 0x81, 0xd0, 0xb9, 0x5f, 0x7d, 0xf6, 0x64, 0x6b, 0x41, 0x5e, 0x6e,
-	0x29, 0xe4, 0x57, 0x16, 0xfb, 0x21, 0x76, 0x86, 0x50, 0xfd, 0x07, 0x30, 0x19, 0xf1, 0x1d, 0xb8,
-	0xf4, 0x4f, 0x77, 0x8c, 0xe6, 0xe8, 0x0b, 0xa6, 0x29, 0x38, 0xf8, 0x84, 0x94, 0x1c, 0x89, 0x6f,
-	0xad, 0x27, 0x9e, 0x86, 0x3b, 0x25, 0xb6, 0x16, 0x33, 0xe3, 0x21, 0xd6, 0x59, 0x35, 0xb0, 0x94,
-	0x49, 0x29, 0x2b, 0xcf, 0xc5, 0x68, 0x38, 0x08, 0xfb, 0x94, 0xa1, 0xf8, 0x9d, 0x3d, 0x1f, 0x80,
-	0x65, 0xd5, 0xf3, 0x98, 0x2f, 0x8a, 0x21, 0xf3, 0x49, 0xd8, 0x89, 0xd1, 0x5f, 0x7d, 0x44, 0x99,
-	0x74, 0x61, 0xe7, 0xb9, 0x18, 0xf6, 0x71, 0xb8, 0xce, 0x11, 0xcb, 0xa4, 0xb5, 0xba, 0x5b, 0x0c,
-	0xb9, 0x63, 0xd4, 0xb2, 0xea, 0xe5, 0xa8, 0x65, 0xd3, 0xa9, 0xe9, 0x9d, 0xa7, 0x15, 0x30, 0xd1,
-	0xa6, 0x58, 0x67, 0xe0, 0x4b, 0xd1, 0x14, 0x49, 0x37, 0x48, 0x91, 0xf2, 0x4c, 0xbf, 0xc9, 0x73,
-	0x31, 0x72, 0xcd, 0x8c, 0xad, 0xb1, 0x60, 0xea, 0x2d, 0xc5, 0x40, 0x35, 0xbd, 0x04, 0x59, 0x5e,
-	0x7d, 0xad, 0x60, 0xab, 0xec, 0xad, 0x31, 0xec, 0x31, 0x81, 0x8a, 0xf5, 0x1f, 0x60, 0x0c, 0x27,
-	0x7a, 0x84, 0xb7, 0x51, 0xb0, 0xdd, 0xe8, 0x25, 0x30, 0xb6, 0xc7, 0x86, 0x2a, 0xee, 0x7f, 0xc1,
-	0x62, 0xfe, 0x1f, 0xcc, 0xb7, 0x05, 0x7b, 0xe5, 0xa2, 0x8d, 0xef, 0xdf, 0x07, 0xad, 0xc8, 0xff,
-	0x00, 0x33, 0xb7, 0x2e, 0xea, 0xd7, 0x77, 0x9e, 0x96, 0x00, 0x19, 0x9b, 0x63, 0x80, 0x14, 0x43,
-	0x0f, 0xcc, 0x8d, 0xdc, 0x9f, 0xa2, 0x83, 0xcc, 0x02, 0x0b, 0x0f, 0xb2, 0x68, 0x78, 0x8d, 0xca,
-	0x7f, 0x37, 0xe7, 0x1b, 0xda, 0x3e, 0xba, 0xb8, 0x32, 0xb5, 0xcb, 0x2b, 0x53, 0x7b, 0x75, 0x65,
-	0x6a, 0x0f, 0xaf, 0xcd, 0xd2, 0xe5, 0xb5, 0x59, 0x7a, 0x71, 0x6d, 0x96, 0x7e, 0xff, 0x05, 0xfb,
-	0xec, 0xa4, 0xdf, 0x6d, 0xba, 0x24, 0xb0, 0x7f, 0x4e, 0xf7, 0x3e, 0x84, 0x5d, 0x6a, 0x2b, 0xa6,
-	0x2d, 0x97, 0xc4, 0xe8, 0xed, 0xf0, 0x04, 0xfa, 0xa1, 0x1d, 0x10, 0xaf, 0xdf, 0x43, 0x54, 0x7e,
-	0x3e, 0xb0, 0x41, 0x84, 0x68, 0x77, 0x92, 0x7f, 0x26, 0x7c, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff,
-	0x2d, 0xeb, 0x38, 0x54, 0x14, 0x09, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6e, 0xeb, 0x44,
+	0x14, 0x8d, 0x5f, 0x93, 0xa8, 0x9d, 0x57, 0xf4, 0x5a, 0xd3, 0x52, 0xc7, 0xa1, 0x6e, 0x31, 0x42,
+	0x6d, 0x5a, 0x1a, 0xab, 0x05, 0x55, 0x28, 0xbb, 0xb6, 0xb0, 0x40, 0x34, 0x52, 0xe5, 0x8a, 0x0d,
+	0x9b, 0x30, 0xb1, 0xa7, 0x13, 0xa3, 0xd8, 0x63, 0x3c, 0x93, 0xd2, 0xc0, 0x06, 0xc1, 0x02, 0x89,
+	0x15, 0x5f, 0x01, 0x2b, 0xa4, 0x2e, 0xf8, 0x01, 0x76, 0x5d, 0x56, 0xb0, 0x61, 0x85, 0x50, 0xbb,
+	0xe8, 0x6f, 0x20, 0xcf, 0x8c, 0x27, 0xd4, 0xb1, 0x4b, 0x90, 0x78, 0x9b, 0xc8, 0xf7, 0x9e, 0x33,
+	0x73, 0xce, 0xbd, 0xbe, 0x37, 0x09, 0x68, 0x06, 0xd1, 0x67, 0xc8, 0x63, 0xc1, 0x25, 0x72, 0xbe,
+	0x80, 0x34, 0xbc, 0x72, 0x2e, 0xf7, 0x1d, 0x76, 0xd5, 0x8e, 0x13, 0xc2, 0x88, 0xae, 0x2b, 0xb0,
+	0xcd, 0xc1, 0xf6, 0xe5, 0xbe, 0xb9, 0x82, 0x09, 0x26, 0x1c, 0x76, 0xd2, 0x27, 0xc1, 0x34, 0x1b,
+	0x98, 0x10, 0x3c, 0x44, 0x0e, 0x8f, 0xfa, 0xa3, 0x0b, 0x07, 0x46, 0xe3, 0x0c, 0xf2, 0x08, 0x0d,
+	0x09, 0xed, 0x89, 0x33, 0x22, 0x90, 0xd0, 0x9a, 0x88, 0x9c, 0x90, 0xe2, 0x54, 0x37, 0xa4, 0x58,
+	0x02, 0x56, 0x81, 0x2b, 0xe1, 0x40, 0xe0, 0x6f, 0x14, 0xe0, 0x71, 0x42, 0x62, 0x42, 0xe1, 0x50,
+	0x52, 0x96, 0x61, 0x18, 0x44, 0xc4, 0xe1, 0x9f, 0x22, 0x65, 0xff, 0xa8, 0x01, 0xa3, 0x4b, 0xf1,
+	0x07, 0x57, 0xc8, 0x1b, 0x31, 0x74, 0x42, 0x22, 0x96, 0x40, 0x8f, 0x9d, 0x90, 0x30, 0x86, 0x4c,
+	0x7f, 0x0d, 0xd4, 0x29, 0x8a, 0x7c, 0x94, 0x18, 0xda, 0xa6, 0xb6, 0xbd, 0xe0, 0xca, 0x48, 0x37,
+	0xc1, 0xbc, 0x27, 0x99, 0xc6, 0x33, 0x8e, 0xa8, 0x58, 0x5f, 0x02, 0x73, 0x21, 0xc5, 0xc6, 0x1c,
+	0x4f, 0xa7, 0x8f, 0xfa, 0x0a, 0xa8, 0x5d, 0x8c, 0x22, 0x9f, 0x1a, 0x55, 0x9e, 0x13, 0x41, 0xa7,
+	0xfd, 0xcd, 0xc3, 0xf5, 0x8e, 0xbc, 0xf0, 0xfb, 0x87, 0xeb, 0x1d, 0x4b, 0x98, 0x2e, 0xf3, 0x62,
+	0x1f, 0x82, 0xcd, 0x32, 0xcc, 0x45, 0x34, 0x26, 0x11, 0x45, 0xba, 0x0e, 0xaa, 0x3e, 0x64, 0x90,
+	0xbb, 0x5d, 0x74, 0xf9, 0xb3, 0xfd, 0xd3, 0x33, 0xb0, 0xdc, 0xa5, 0xf8, 0xe3, 0xd8, 0x87, 0x93,
+	0x73, 0xa5, 0x95, 0xb5, 0xc0, 0x52, 0x56, 0x49, 0x0f, 0xfa, 0x7e, 0x82, 0x28, 0x95, 0x15, 0xbe,
+	0xc8, 0xf2, 0x47, 0x22, 0xad, 0x37, 0xc1, 0x02, 0x86, 0xb4, 0x37, 0x0c, 0xc2, 0x80, 0xf1, 0x72,
+	0xab, 0xee, 0x3c, 0x86, 0xf4, 0x34, 0x8d, 0x33, 0x30, 0x4e, 0x02, 0x0f, 0x19, 0x55, 0x05, 0x9e,
+	0xa5, 0xb1, 0xde, 0x02, 0xaf, 0x40, 0x3f, 0x0c, 0x22, 0xa5, 0x50, 0x4b, 0x15, 0x8e, 0xab, 0x37,
+	0x7f, 0x6e, 0x68, 0xee, 0x22, 0x87, 0x32, 0x91, 0x23, 0x30, 0x4f, 0xbd, 0x01, 0xf2, 0x47, 0x43,
+	0x64, 0xd4, 0x37, 0xb5, 0xed, 0xe7, 0x07, 0x6f, 0xb5, 0xa7, 0x07, 0xb0, 0x2d, 0xda, 0x12, 0x90,
+	0xe8, 0x5c, 0x92, 0x5d, 0x75, 0xac, 0xb3, 0x95, 0x6b, 0xf4, 0x9a, 0x6a, 0xf4, 0xe3, 0x9e, 0xd8,
+	0x4d, 0xd0, 0x98, 0x4a, 0x66, 0xad, 0xb5, 0xbf, 0xd5, 0xc0, 0xab, 0x5d, 0x8a, 0x8f, 0x52, 0xe1,
+	0xff, 0xb7, 0x91, 0x9d, 0x56, 0xce, 0x60, 0x43, 0x19, 0xcc, 0xab, 0xd9, 0xeb, 0xa0, 0x59, 0x90,
+	0x56, 0x26, 0xbf, 0xd3, 0xc0, 0x6a, 0x97, 0xe2, 0xf7, 0x11, 0x7c, 0x09, 0x36, 0x77, 0x73, 0x36,
+	0x9b, 0xca, 0xe6, 0xb4, 0x9e, 0xbd, 0x01, 0xd6, 0x0b, 0x01, 0x65, 0xf5, 0x67, 0x0d, 0xbc, 0x50,
+	0xdd, 0x3e, 0x83, 0x09, 0x0c, 0xa9, 0x7e, 0x08, 0x16, 0xe0, 0x88, 0x0d, 0x48, 0x12, 0xb0, 0xb1,
+	0xf0, 0x79, 0x6c, 0xfc, 0xf6, 0xcb, 0xde, 0x8a, 0xfc, 0x7e, 0x90, 0x46, 0xce, 0x59, 0x12, 0x44,
+	0xd8, 0x9d, 0x50, 0xf5, 0xf7, 0x40, 0x3d, 0xe6, 0x37, 0x70, 0xeb, 0xcf, 0x0f, 0xcc, 0xa2, 0x11,
+	0x11, 0x1a, 0x7c, 0xc8, 0x2a, 0xae, 0xe4, 0x77, 0xb6, 0xd3, 0x9a, 0x26, 0x37, 0xa5, 0x65, 0xad,
+	0xe6, 0xc6, 0x43, 0x9c, 0xb3, 0x1b, 0x60, 0x2d, 0x97, 0x52, 0xa5, 0xfc, 0x2e, 0x46, 0xc3, 0x45,
+	0x38, 0xa0, 0x0c, 0x25, 0xff, 0xda, 0xf3, 0x31, 0x58, 0x57, 0x3d, 0x4f, 0xf8, 0xa1, 0x04, 0xa6,
+	0xb3, 0xdb, 0x4b, 0xd0, 0xe7, 0x23, 0x44, 0x99, 0xac, 0xc2, 0x29, 0xaa, 0x62, 0xd2, 0xc7, 0xc9,
+	0x39, 0x57, 0x1c, 0x93, 0xa5, 0x35, 0xbd, 0x72, 0xca, 0x13, 0xa3, 0x96, 0x77, 0x2f, 0x47, 0x2d,
+	0x9f, 0xce, 0x8a, 0x3e, 0xf8, 0xb5, 0x06, 0xe6, 0xba, 0x14, 0xeb, 0x0c, 0xbc, 0x2e, 0x9a, 0x22,
+	0xe5, 0xc6, 0x19, 0x53, 0xbe, 0xd3, 0xc2, 0x75, 0x9d, 0x5a, 0x33, 0x73, 0x6f, 0x26, 0x9a, 0xfa,
+	0xa2, 0x63, 0xc0, 0xc8, 0x96, 0x20, 0xaf, 0xab, 0x6f, 0x95, 0x5c, 0x95, 0xdf, 0x1a, 0xd3, 0x99,
+	0x91, 0xa8, 0x54, 0xbf, 0x04, 0xe6, 0x64, 0xa2, 0xa7, 0x74, 0x5b, 0x25, 0xd7, 0x4d, 0x2f, 0x81,
+	0xb9, 0x3f, 0x33, 0x55, 0x69, 0x7f, 0x05, 0x56, 0x8b, 0x7f, 0xa3, 0xde, 0x2e, 0xb9, 0xab, 0x90,
+	0x6d, 0xbe, 0xfb, 0x5f, 0xd8, 0x4a, 0xfc, 0x53, 0xb0, 0xf8, 0x68, 0x51, 0xdf, 0x7c, 0xf2, 0x6d,
+	0x09, 0x92, 0xb9, 0x3b, 0x03, 0x49, 0x29, 0x0c, 0xc1, 0xd2, 0xd4, 0xfe, 0x94, 0xbd, 0xc8, 0x3c,
+	0xd1, 0x74, 0x66, 0x24, 0x66, 0x6a, 0x66, 0xed, 0xeb, 0x87, 0xeb, 0x1d, 0xed, 0x18, 0xdd, 0xdc,
+	0x59, 0xda, 0xed, 0x9d, 0xa5, 0xfd, 0x75, 0x67, 0x69, 0x3f, 0xdc, 0x5b, 0x95, 0xdb, 0x7b, 0xab,
+	0xf2, 0xc7, 0xbd, 0x55, 0xf9, 0xe4, 0x23, 0x1c, 0xb0, 0xc1, 0xa8, 0xdf, 0xf6, 0x48, 0xe8, 0x7c,
+	0x98, 0xdd, 0x7d, 0x0a, 0xfb, 0xd4, 0x51, 0x4a, 0x7b, 0x1e, 0x49, 0xd0, 0x3f, 0xc3, 0x01, 0x0c,
+	0x22, 0x27, 0x24, 0xe9, 0x6f, 0x0e, 0x95, 0xff, 0x40, 0xd8, 0x38, 0x46, 0xb4, 0x5f, 0xe7, 0xff,
+	0x34, 0xde, 0xf9, 0x7b, 0x00, 0xb5, 0x4e, 0x62, 0xa9, 0x57, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1059,6 +1070,18 @@ func (m *MsgUpdateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.AdminAddress) > 0 {
 		i -= len(m.AdminAddress)
 		copy(dAtA[i:], m.AdminAddress)
@@ -1435,6 +1458,10 @@ func (m *MsgUpdateContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1978,6 +2005,42 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 			}
 			m.AdminAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &ExecutionSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
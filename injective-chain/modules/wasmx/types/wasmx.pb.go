@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	types "github.com/CosmWasm/wasmd/x/wasm/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -39,6 +40,9 @@ type Params struct {
 	// executed in the BeginBlocker.
 	MinGasPrice            uint64             `protobuf:"varint,4,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
 	RegisterContractAccess types.AccessConfig `protobuf:"bytes,5,opt,name=register_contract_access,json=registerContractAccess,proto3" json:"register_contract_access" yaml:"register_contract_access"`
+	// number of consecutive failed executions after which an EVM contract is
+	// deactivated, the default value is used if unset
+	MaxEvmConsecutiveFailures uint32 `protobuf:"varint,6,opt,name=max_evm_consecutive_failures,json=maxEvmConsecutiveFailures,proto3" json:"max_evm_consecutive_failures,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,6 +113,13 @@ func (m *Params) GetRegisterContractAccess() types.AccessConfig {
 	return types.AccessConfig{}
 }
 
+func (m *Params) GetMaxEvmConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.MaxEvmConsecutiveFailures
+	}
+	return 0
+}
+
 type RegisteredContract struct {
 	// limit of gas per BB execution
 	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
@@ -126,6 +137,13 @@ type RegisteredContract struct {
 	GranterAddress string `protobuf:"bytes,6,opt,name=granter_address,json=granterAddress,proto3" json:"granter_address,omitempty"`
 	// funding mode
 	FundMode FundingMode `protobuf:"varint,7,opt,name=fund_mode,json=fundMode,proto3,enum=injective.wasmx.v1.FundingMode" json:"fund_mode,omitempty"`
+	// Optional: schedule of the contract executions, executed every block if
+	// unset
+	Schedule *ExecutionSchedule `protobuf:"bytes,8,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// type of the contract
+	ContractType ContractType `protobuf:"varint,9,opt,name=contract_type,json=contractType,proto3,enum=injective.wasmx.v1.ContractType" json:"contract_type,omitempty"`
+	// calldata of the EVM contract call, set for EVM contracts only
+	EvmCalldata []byte `protobuf:"bytes,10,opt,name=evm_calldata,json=evmCalldata,proto3" json:"evm_calldata,omitempty"`
 }
 
 func (m *RegisteredContract) Reset()         { *m = RegisteredContract{} }
@@ -210,52 +228,149 @@ func (m *RegisteredContract) GetFundMode() FundingMode {
 	return FundingMode_Unspecified
 }
 
+func (m *RegisteredContract) GetSchedule() *ExecutionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *RegisteredContract) GetContractType() ContractType {
+	if m != nil {
+		return m.ContractType
+	}
+	return ContractType_CosmWasm
+}
+
+func (m *RegisteredContract) GetEvmCalldata() []byte {
+	if m != nil {
+		return m.EvmCalldata
+	}
+	return nil
+}
+
+// ContractExecutionState defines when a registered contract was last executed
+type ContractExecutionState struct {
+	// height of the last execution, 0 if never executed
+	LastRunHeight int64 `protobuf:"varint,1,opt,name=last_run_height,json=lastRunHeight,proto3" json:"last_run_height,omitempty"`
+	// unix timestamp (in seconds) of the last execution, 0 if never executed
+	LastRunTimestamp int64 `protobuf:"varint,2,opt,name=last_run_timestamp,json=lastRunTimestamp,proto3" json:"last_run_timestamp,omitempty"`
+	// number of executions that failed in a row since the last successful one
+	ConsecutiveFailures uint32 `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+}
+
+func (m *ContractExecutionState) Reset()         { *m = ContractExecutionState{} }
+func (m *ContractExecutionState) String() string { return proto.CompactTextString(m) }
+func (*ContractExecutionState) ProtoMessage()    {}
+func (*ContractExecutionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6818ff331f2cddc4, []int{2}
+}
+func (m *ContractExecutionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecutionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecutionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecutionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecutionState.Merge(m, src)
+}
+func (m *ContractExecutionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecutionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecutionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecutionState proto.InternalMessageInfo
+
+func (m *ContractExecutionState) GetLastRunHeight() int64 {
+	if m != nil {
+		return m.LastRunHeight
+	}
+	return 0
+}
+
+func (m *ContractExecutionState) GetLastRunTimestamp() int64 {
+	if m != nil {
+		return m.LastRunTimestamp
+	}
+	return 0
+}
+
+func (m *ContractExecutionState) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.wasmx.v1.Params")
 	proto.RegisterType((*RegisteredContract)(nil), "injective.wasmx.v1.RegisteredContract")
+	proto.RegisterType((*ContractExecutionState)(nil), "injective.wasmx.v1.ContractExecutionState")
 }
 
 func init() { proto.RegisterFile("injective/wasmx/v1/wasmx.proto", fileDescriptor_6818ff331f2cddc4) }
 
 var fileDescriptor_6818ff331f2cddc4 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0xc6, 0xcf, 0xc7, 0x71, 0xbd, 0xba, 0x7f, 0x10, 0xa6, 0xad, 0x42, 0x81, 0xdc, 0x71, 0x2c,
-	0x47, 0x45, 0x13, 0xda, 0x2e, 0xa8, 0x62, 0xe9, 0x55, 0xa5, 0xaa, 0x28, 0x52, 0x15, 0x31, 0xb1,
-	0x44, 0x8e, 0xed, 0xa6, 0x86, 0xd8, 0x3e, 0xc5, 0xb9, 0xe3, 0xfa, 0x05, 0x18, 0x98, 0xf8, 0x08,
-	0x8c, 0x8c, 0xfd, 0x18, 0x1d, 0x3b, 0x32, 0x55, 0xa8, 0x1d, 0xca, 0xc2, 0xc2, 0x27, 0x40, 0xb6,
-	0x93, 0x6b, 0x25, 0x60, 0x89, 0x9c, 0xf7, 0xf7, 0x3c, 0xaf, 0xf3, 0x3e, 0x8e, 0xa1, 0xcf, 0xe5,
-	0x7b, 0x46, 0x0a, 0x3e, 0x62, 0xe1, 0x47, 0xac, 0xc5, 0x38, 0x1c, 0xad, 0xb9, 0x45, 0x30, 0xc8,
-	0x55, 0xa1, 0x10, 0x9a, 0xf0, 0xc0, 0x95, 0x47, 0x6b, 0xcb, 0x0b, 0xa9, 0x4a, 0x95, 0xc5, 0xa1,
-	0x59, 0x39, 0xe5, 0xf2, 0x43, 0xa2, 0xb4, 0x30, 0x1a, 0xeb, 0x37, 0x7d, 0x8a, 0xe3, 0x01, 0xd3,
-	0x25, 0xbd, 0x8b, 0x05, 0x97, 0x2a, 0xb4, 0xcf, 0xb2, 0xf4, 0xf8, 0x1f, 0x5b, 0x0f, 0x72, 0x35,
-	0x50, 0x1a, 0x67, 0x4e, 0xd2, 0xfd, 0x55, 0x87, 0xcd, 0x03, 0x9c, 0x63, 0xa1, 0xd1, 0x73, 0xb8,
-	0xc0, 0x75, 0xcc, 0xc6, 0x8c, 0x0c, 0x0b, 0xae, 0x64, 0xcc, 0x24, 0x4e, 0x32, 0x46, 0x3d, 0xd0,
-	0x01, 0xbd, 0x56, 0x84, 0xb8, 0xde, 0xa9, 0xd0, 0x8e, 0x23, 0xe8, 0x05, 0xbc, 0x2f, 0xf0, 0x38,
-	0x4e, 0x58, 0xca, 0x65, 0x9c, 0x64, 0x8a, 0x7c, 0x88, 0x0b, 0x55, 0xe0, 0x2c, 0x4e, 0xb1, 0xf6,
-	0xea, 0x1d, 0xd0, 0x6b, 0x44, 0x8b, 0x02, 0x8f, 0xfb, 0x86, 0xf7, 0x0d, 0x7e, 0x6b, 0xe8, 0x2e,
-	0xd6, 0x68, 0x03, 0x2e, 0x19, 0x27, 0x51, 0xb2, 0xc8, 0x31, 0x29, 0x8c, 0x21, 0xce, 0xb8, 0xe0,
-	0x85, 0x77, 0xcb, 0xda, 0xee, 0x09, 0x3c, 0xde, 0x2e, 0xe1, 0x2e, 0xd6, 0xfb, 0x06, 0xa1, 0x2e,
-	0x9c, 0x13, 0x5c, 0x5a, 0xed, 0x20, 0xe7, 0x84, 0x79, 0x0d, 0xab, 0x9d, 0x11, 0x5c, 0xee, 0x62,
-	0x7d, 0x60, 0x4a, 0xe8, 0x13, 0x80, 0x5e, 0xce, 0x52, 0xae, 0x0b, 0x96, 0x5f, 0xb7, 0xc7, 0x84,
-	0x30, 0xad, 0xbd, 0xdb, 0x1d, 0xd0, 0x9b, 0x59, 0xf7, 0x83, 0x2a, 0x47, 0x1b, 0x78, 0x30, 0x5a,
-	0x0b, 0xb6, 0x2c, 0xdf, 0x56, 0xf2, 0x90, 0xa7, 0xfd, 0x67, 0xa7, 0xe7, 0xed, 0xda, 0xef, 0xf3,
-	0x76, 0xfb, 0x18, 0x8b, 0x6c, 0xb3, 0xfb, 0xbf, 0x6e, 0xdd, 0x6f, 0x57, 0x27, 0x2b, 0x20, 0x5a,
-	0xaa, 0x78, 0xf5, 0xb9, 0xae, 0xd7, 0xe6, 0xe2, 0xcf, 0xaf, 0x6d, 0xf0, 0xf9, 0xea, 0x64, 0x65,
-	0xd6, 0x45, 0xef, 0x42, 0xee, 0x9e, 0xd4, 0x21, 0x8a, 0x4a, 0x07, 0xa3, 0x95, 0x07, 0x3d, 0x80,
-	0xd3, 0xd7, 0x11, 0x00, 0x3b, 0x56, 0x2b, 0xad, 0xe6, 0x2e, 0xa1, 0x9b, 0xb9, 0x3e, 0x81, 0x6e,
-	0xe0, 0x27, 0x70, 0x6e, 0x72, 0x6a, 0xe6, 0x54, 0x6c, 0x80, 0xad, 0x68, 0xb6, 0x3a, 0x2e, 0x53,
-	0x43, 0x8f, 0xe0, 0x14, 0x51, 0x94, 0xc5, 0x9c, 0xba, 0xcc, 0xfa, 0x8d, 0xd3, 0xf3, 0x36, 0x88,
-	0x9a, 0xa6, 0xb8, 0x47, 0xd1, 0x53, 0x38, 0x87, 0xa9, 0x89, 0x16, 0x53, 0x9a, 0x57, 0x41, 0x4d,
-	0x97, 0xa2, 0x59, 0x8b, 0xb6, 0x1c, 0x41, 0xab, 0xf0, 0x4e, 0x9a, 0x63, 0x69, 0xf2, 0xa8, 0xc4,
-	0xcd, 0x1b, 0xe2, 0xf9, 0x12, 0x56, 0xf2, 0x97, 0x70, 0xfa, 0x70, 0x28, 0x69, 0x2c, 0x14, 0x65,
-	0xde, 0x54, 0x07, 0xf4, 0xe6, 0xd7, 0xdb, 0xc1, 0xdf, 0x3f, 0x7c, 0xf0, 0x6a, 0x28, 0x29, 0x97,
-	0xe9, 0x1b, 0x45, 0x59, 0xd4, 0x32, 0x0e, 0xb3, 0xda, 0x6c, 0x98, 0x0c, 0xfb, 0xec, 0xf4, 0xc2,
-	0x07, 0x67, 0x17, 0x3e, 0xf8, 0x71, 0xe1, 0x83, 0x2f, 0x97, 0x7e, 0xed, 0xec, 0xd2, 0xaf, 0x7d,
-	0xbf, 0xf4, 0x6b, 0xef, 0x5e, 0xa7, 0xbc, 0x38, 0x1a, 0x26, 0x01, 0x51, 0x22, 0xdc, 0xab, 0x9a,
-	0xee, 0xe3, 0x44, 0x87, 0x93, 0x2d, 0x56, 0x89, 0xca, 0xd9, 0xcd, 0xd7, 0x23, 0xcc, 0x65, 0x28,
-	0x14, 0x1d, 0x66, 0x4c, 0x97, 0xb7, 0xc2, 0xde, 0xa2, 0xa4, 0x69, 0x2f, 0xc4, 0xc6, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x2d, 0xc0, 0xef, 0x9c, 0xb0, 0x03, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0x2b, 0x35,
+	0x18, 0x8d, 0x6f, 0x42, 0x6e, 0xe2, 0x26, 0xf7, 0x82, 0x6f, 0x6f, 0x35, 0x94, 0x32, 0x49, 0x83,
+	0x40, 0xa1, 0x6a, 0x27, 0xa4, 0xdd, 0xa0, 0x0a, 0x09, 0x35, 0x51, 0x5a, 0x2a, 0x8a, 0x54, 0x0d,
+	0x5d, 0xb1, 0x19, 0x39, 0x33, 0xee, 0xc4, 0x30, 0xb6, 0xa3, 0xb1, 0x67, 0x48, 0x5f, 0x80, 0x05,
+	0x2b, 0x1e, 0x01, 0x76, 0xac, 0x50, 0x1f, 0xa3, 0xcb, 0x2e, 0xd9, 0x50, 0xa1, 0x76, 0x51, 0xd6,
+	0x3c, 0x01, 0xb2, 0xe7, 0x27, 0x95, 0x1a, 0x36, 0x91, 0xe7, 0x9c, 0xf3, 0x7d, 0x76, 0xce, 0xf9,
+	0x6c, 0x68, 0x53, 0xfe, 0x3d, 0xf1, 0x15, 0x4d, 0xc9, 0xe0, 0x47, 0x2c, 0xd9, 0x62, 0x90, 0x0e,
+	0xb3, 0x85, 0x33, 0x8f, 0x85, 0x12, 0x08, 0x95, 0xbc, 0x93, 0xc1, 0xe9, 0x70, 0x73, 0x3d, 0x14,
+	0xa1, 0x30, 0xf4, 0x40, 0xaf, 0x32, 0xe5, 0xe6, 0x96, 0x2f, 0x24, 0xd3, 0x1a, 0x53, 0xaf, 0xfb,
+	0xa8, 0xab, 0x39, 0x91, 0x39, 0xfb, 0x1e, 0x66, 0x94, 0x8b, 0x81, 0xf9, 0xcd, 0xa1, 0xed, 0x15,
+	0x5b, 0xcf, 0x63, 0x31, 0x17, 0x12, 0x47, 0x99, 0xa4, 0xf7, 0x47, 0x15, 0xd6, 0xcf, 0x71, 0x8c,
+	0x99, 0x44, 0x9f, 0xc1, 0x75, 0x2a, 0x3d, 0xb2, 0x20, 0x7e, 0xa2, 0xa8, 0xe0, 0x1e, 0xe1, 0x78,
+	0x1a, 0x91, 0xc0, 0x02, 0x5d, 0xd0, 0x6f, 0xb8, 0x88, 0xca, 0x49, 0x41, 0x4d, 0x32, 0x06, 0x7d,
+	0x0e, 0xdf, 0x67, 0x78, 0xe1, 0x4d, 0x49, 0x48, 0xb9, 0x37, 0x8d, 0x84, 0xff, 0x83, 0xa7, 0x84,
+	0xc2, 0x91, 0x17, 0x62, 0x69, 0xbd, 0xe8, 0x82, 0x7e, 0xcd, 0x7d, 0xcb, 0xf0, 0x62, 0xa4, 0xf9,
+	0x91, 0xa6, 0x2f, 0x34, 0x7b, 0x82, 0x25, 0x3a, 0x80, 0x1b, 0xba, 0xd2, 0x17, 0x5c, 0xc5, 0xd8,
+	0x57, 0xba, 0xc0, 0x8b, 0x28, 0xa3, 0xca, 0xaa, 0x9a, 0xb2, 0x37, 0x0c, 0x2f, 0xc6, 0x39, 0x79,
+	0x82, 0xe5, 0x99, 0xa6, 0x50, 0x0f, 0xb6, 0x19, 0xe5, 0x46, 0x3b, 0x8f, 0xa9, 0x4f, 0xac, 0x9a,
+	0xd1, 0xae, 0x31, 0xca, 0x4f, 0xb0, 0x3c, 0xd7, 0x10, 0xfa, 0x09, 0x40, 0x2b, 0x26, 0x21, 0x95,
+	0x8a, 0xc4, 0xcb, 0xf6, 0xd8, 0xf7, 0x89, 0x94, 0xd6, 0x3b, 0x5d, 0xd0, 0x5f, 0xdb, 0xb7, 0x9d,
+	0xc2, 0x47, 0x63, 0xb8, 0x93, 0x0e, 0x9d, 0x23, 0xc3, 0x8f, 0x05, 0xbf, 0xa4, 0xe1, 0x68, 0xf7,
+	0xe6, 0xae, 0x53, 0xf9, 0xf7, 0xae, 0xd3, 0xb9, 0xc2, 0x2c, 0x3a, 0xec, 0xfd, 0x5f, 0xb7, 0xde,
+	0xef, 0x8f, 0xd7, 0x3b, 0xc0, 0xdd, 0x28, 0xf8, 0xe2, 0xb8, 0x59, 0x2f, 0xf4, 0x25, 0xdc, 0xd2,
+	0xff, 0x90, 0xa4, 0x4c, 0x17, 0x4a, 0xe3, 0x5c, 0x4a, 0xbc, 0x4b, 0x4c, 0xa3, 0x24, 0x26, 0xd2,
+	0xaa, 0x77, 0x41, 0xbf, 0xed, 0x6a, 0xff, 0x26, 0x29, 0x1b, 0x2f, 0x15, 0xc7, 0xb9, 0xe0, 0xf0,
+	0xed, 0x3f, 0xbf, 0x76, 0xc0, 0xcf, 0x8f, 0xd7, 0x3b, 0xad, 0x2c, 0xbb, 0x2c, 0xa5, 0xde, 0x5f,
+	0x55, 0x88, 0xdc, 0x7c, 0x4b, 0x12, 0x14, 0x9b, 0xa2, 0x0f, 0x60, 0x73, 0xe9, 0x21, 0x30, 0xbe,
+	0x34, 0xc2, 0xc2, 0xb8, 0x9c, 0xcc, 0x4c, 0x7b, 0x51, 0x92, 0x99, 0x63, 0x1f, 0xc1, 0x76, 0x19,
+	0xbb, 0x8e, 0xd5, 0x24, 0xd0, 0x70, 0x5b, 0x45, 0xde, 0x1a, 0x43, 0x1f, 0xc2, 0x97, 0xbe, 0x08,
+	0x88, 0x47, 0x83, 0xcc, 0xf4, 0x51, 0xed, 0xe6, 0xae, 0x03, 0xdc, 0xba, 0x06, 0x4f, 0x03, 0xf4,
+	0x29, 0x6c, 0xe3, 0x40, 0x67, 0x83, 0x83, 0x20, 0x2e, 0x9c, 0x6e, 0xe6, 0xa2, 0x96, 0xa1, 0x8e,
+	0x32, 0x06, 0xed, 0xc1, 0xd7, 0x61, 0x8c, 0xb9, 0x36, 0xb4, 0x10, 0xd7, 0x9f, 0x88, 0x5f, 0xe5,
+	0x64, 0x21, 0xff, 0x02, 0x36, 0x2f, 0x13, 0x1e, 0x78, 0x4c, 0x04, 0xc4, 0x7a, 0xd9, 0x05, 0xfd,
+	0x57, 0xfb, 0x1d, 0xe7, 0xf9, 0x8d, 0x71, 0x8e, 0x13, 0x1e, 0x50, 0x1e, 0x7e, 0x23, 0x02, 0xe2,
+	0x36, 0x74, 0x85, 0x5e, 0xa1, 0x23, 0xd8, 0x90, 0xfe, 0x8c, 0x04, 0x49, 0x44, 0xac, 0x86, 0x09,
+	0xff, 0xe3, 0x55, 0xc5, 0xe5, 0x60, 0x7f, 0x9b, 0x8b, 0xdd, 0xb2, 0x0c, 0x4d, 0x60, 0xbb, 0x0c,
+	0x5e, 0x5f, 0x37, 0xab, 0x69, 0x0e, 0xd1, 0x5d, 0xd5, 0xa7, 0x48, 0xe3, 0xe2, 0x6a, 0x4e, 0xdc,
+	0x96, 0xff, 0xe4, 0x0b, 0x6d, 0xc3, 0x96, 0x19, 0x05, 0x1c, 0x45, 0x01, 0x56, 0xd8, 0x82, 0x5d,
+	0xd0, 0x6f, 0xb9, 0x6b, 0x24, 0x65, 0xe3, 0x1c, 0x3a, 0xac, 0xe9, 0xc0, 0x7b, 0xbf, 0x01, 0xb8,
+	0x51, 0xf4, 0x59, 0x9e, 0x4b, 0x61, 0x45, 0xd0, 0x27, 0xf0, 0x75, 0x84, 0xa5, 0xf2, 0xe2, 0x84,
+	0x7b, 0x33, 0x42, 0xc3, 0x59, 0x96, 0x74, 0xd5, 0x6d, 0x6b, 0xd8, 0x4d, 0xf8, 0x57, 0x06, 0x44,
+	0xbb, 0x10, 0x95, 0x3a, 0x45, 0x19, 0x91, 0x0a, 0xb3, 0xb9, 0xc9, 0xbd, 0xea, 0xbe, 0x9b, 0x4b,
+	0x2f, 0x0a, 0x1c, 0x0d, 0xe1, 0xfa, 0xca, 0x01, 0xad, 0x9a, 0x01, 0x7d, 0xe3, 0x3f, 0x1f, 0xcd,
+	0x11, 0xb9, 0xb9, 0xb7, 0xc1, 0xed, 0xbd, 0x0d, 0xfe, 0xbe, 0xb7, 0xc1, 0x2f, 0x0f, 0x76, 0xe5,
+	0xf6, 0xc1, 0xae, 0xfc, 0xf9, 0x60, 0x57, 0xbe, 0xfb, 0x3a, 0xa4, 0x6a, 0x96, 0x4c, 0x1d, 0x5f,
+	0xb0, 0xc1, 0x69, 0x61, 0xd0, 0x19, 0x9e, 0xca, 0x41, 0x69, 0xd7, 0x9e, 0x2f, 0x62, 0xf2, 0xf4,
+	0x73, 0x86, 0x29, 0x1f, 0x30, 0xa1, 0xdd, 0x96, 0xf9, 0x3b, 0x65, 0xde, 0xb5, 0x69, 0xdd, 0x3c,
+	0x51, 0x07, 0xff, 0x0d, 0x00, 0xea, 0x4d, 0x8e, 0xcf, 0x42, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -292,6 +407,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RegisterContractAccess.Equal(&that1.RegisterContractAccess) {
 		return false
 	}
+	if this.MaxEvmConsecutiveFailures != that1.MaxEvmConsecutiveFailures {
+		return false
+	}
 	return true
 }
 func (this *RegisteredContract) Equal(that interface{}) bool {
@@ -334,6 +452,15 @@ func (this *RegisteredContract) Equal(that interface{}) bool {
 	if this.FundMode != that1.FundMode {
 		return false
 	}
+	if !this.Schedule.Equal(that1.Schedule) {
+		return false
+	}
+	if this.ContractType != that1.ContractType {
+		return false
+	}
+	if !bytes.Equal(this.EvmCalldata, that1.EvmCalldata) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -356,6 +483,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEvmConsecutiveFailures != 0 {
+		i = encodeVarintWasmx(dAtA, i, uint64(m.MaxEvmConsecutiveFailures))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size, err := m.RegisterContractAccess.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -414,6 +546,30 @@ func (m *RegisteredContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmCalldata) > 0 {
+		i -= len(m.EvmCalldata)
+		copy(dAtA[i:], m.EvmCalldata)
+		i = encodeVarintWasmx(dAtA, i, uint64(len(m.EvmCalldata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintWasmx(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWasmx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.FundMode != 0 {
 		i = encodeVarintWasmx(dAtA, i, uint64(m.FundMode))
 		i--
@@ -461,6 +617,44 @@ func (m *RegisteredContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractExecutionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecutionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecutionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintWasmx(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastRunTimestamp != 0 {
+		i = encodeVarintWasmx(dAtA, i, uint64(m.LastRunTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastRunHeight != 0 {
+		i = encodeVarintWasmx(dAtA, i, uint64(m.LastRunHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWasmx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWasmx(v)
 	base := offset
@@ -492,6 +686,9 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.RegisterContractAccess.Size()
 	n += 1 + l + sovWasmx(uint64(l))
+	if m.MaxEvmConsecutiveFailures != 0 {
+		n += 1 + sovWasmx(uint64(m.MaxEvmConsecutiveFailures))
+	}
 	return n
 }
 
@@ -524,6 +721,35 @@ func (m *RegisteredContract) Size() (n int) {
 	if m.FundMode != 0 {
 		n += 1 + sovWasmx(uint64(m.FundMode))
 	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovWasmx(uint64(l))
+	}
+	if m.ContractType != 0 {
+		n += 1 + sovWasmx(uint64(m.ContractType))
+	}
+	l = len(m.EvmCalldata)
+	if l > 0 {
+		n += 1 + l + sovWasmx(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractExecutionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRunHeight != 0 {
+		n += 1 + sovWasmx(uint64(m.LastRunHeight))
+	}
+	if m.LastRunTimestamp != 0 {
+		n += 1 + sovWasmx(uint64(m.LastRunTimestamp))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovWasmx(uint64(m.ConsecutiveFailures))
+	}
 	return n
 }
 
@@ -672,6 +898,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvmConsecutiveFailures", wireType)
+			}
+			m.MaxEvmConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEvmConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasmx(dAtA[iNdEx:])
@@ -882,6 +1127,202 @@ func (m *RegisteredContract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &ExecutionSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCalldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasmx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmCalldata = append(m.EvmCalldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvmCalldata == nil {
+				m.EvmCalldata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractExecutionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunHeight", wireType)
+			}
+			m.LastRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRunTimestamp", wireType)
+			}
+			m.LastRunTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRunTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWasmx(dAtA[iNdEx:])
@@ -79,6 +79,8 @@ type TokenFactoryMsg struct {
 	CreateDenom *tokenfactorytypes.MsgCreateDenom `json:"create_denom,omitempty"`
 	/// Contracts can change the admin of a denom that they are the admin of.
 	ChangeAdmin *tokenfactorytypes.MsgChangeAdmin `json:"change_admin,omitempty"`
+	/// Contracts can accept the adminship of a denom that was transferred to them.
+	AcceptAdmin *tokenfactorytypes.MsgAcceptAdmin `json:"accept_admin,omitempty"`
 	/// Contracts can mint native tokens for an existing factory denom
 	/// that they are the admin of.
 	MintTokens *MintTokens `json:"mint,omitempty"`
@@ -80,6 +80,7 @@ type OracleQuery struct {
 	OracleVolatility *oracletypes.QueryOracleVolatilityRequest `json:"oracle_volatility,omitempty"`
 	OraclePrice      *oracletypes.QueryOraclePriceRequest      `json:"oracle_price,omitempty"`
 	PythPrice        *oracletypes.QueryPythPriceRequest        `json:"pyth_price,omitempty"`
+	OracleTWAP       *oracletypes.QueryOracleTWAPRequest       `json:"oracle_twap,omitempty"`
 }
 
 type PeggyQuery struct{}
@@ -104,6 +104,8 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		sdkMsg = contractMsg.CreateDenom
 	case contractMsg.ChangeAdmin != nil:
 		sdkMsg = contractMsg.ChangeAdmin
+	case contractMsg.AcceptAdmin != nil:
+		sdkMsg = contractMsg.AcceptAdmin
 	case contractMsg.MintTokens != nil:
 		// special case: since MsgMint's handler doesn't allow sending directly to recipient, but we want to expose this
 		mint := contractMsg.MintTokens
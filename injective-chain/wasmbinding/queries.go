@@ -244,6 +244,17 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 		bz, err = json.Marshal(oracletypes.QueryPythPriceResponse{
 			PriceState: pythPriceState,
 		})
+	case query.OracleTWAP != nil:
+		req := query.OracleTWAP
+
+		twap, twapErr := qp.oracleKeeper.GetOracleTWAP(ctx, req.BaseInfo, req.QuoteInfo, req.StartTime, req.EndTime, req.MaxGap)
+		if twapErr != nil {
+			return nil, twapErr
+		}
+
+		bz, err = json.Marshal(oracletypes.QueryOracleTWAPResponse{
+			Twap: *twap,
+		})
 	default:
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("unknown oracle query variant: %+v", string(queryData))}
 	}
@@ -126,6 +126,7 @@ func getWhitelistedQueries() wasmkeeper.AcceptedQueries {
 		"/injective.exchange.v2.Query/MarketAtomicExecutionFeeMultiplier":  &exchangev2.QueryMarketAtomicExecutionFeeMultiplierResponse{},
 		// Oracle
 		"/injective.oracle.v1beta1.Query/OracleVolatility": &oracletypes.QueryOracleVolatilityResponse{},
+		"/injective.oracle.v1beta1.Query/OracleTWAP":       &oracletypes.QueryOracleTWAPResponse{},
 		"/injective.oracle.v1beta1.Query/OraclePrice":      &oracletypes.QueryOraclePriceResponse{},
 		"/injective.oracle.v1beta1.Query/PythPrice":        &oracletypes.QueryPythPriceResponse{},
 		// Auction
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "peggo"
+
+var (
+	readyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "ready"),
+		"Whether all orchestrator loops completed an iteration recently.",
+		nil, nil,
+	)
+	loopLastSuccessDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "loop", "last_success_timestamp_seconds"),
+		"Unix time of the last successful loop iteration.",
+		[]string{"loop"}, nil,
+	)
+	loopErrorsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "loop", "errors_total"),
+		"Number of failed loop iterations.",
+		[]string{"loop"}, nil,
+	)
+	loopPausedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "loop", "paused"),
+		"Whether the loop is paused by an operator.",
+		[]string{"loop"}, nil,
+	)
+	missingNoncesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "missing_nonces_validators"),
+		"Number of bonded validators that have not submitted any Ethereum event claim.",
+		nil, nil,
+	)
+	validatorMissingNoncesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "validator_missing_nonces"),
+		"Whether our own validator has not submitted any Ethereum event claim.",
+		nil, nil,
+	)
+	balanceDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "signer", "balance"),
+		"Balance of the signer account, in INJ on Injective and in ETH on Ethereum.",
+		[]string{"chain"}, nil,
+	)
+)
+
+// collector gathers metrics from the orchestrator status on every scrape.
+type collector struct {
+	orch Orchestrator
+}
+
+func newCollector(orch Orchestrator) prometheus.Collector {
+	return &collector{orch: orch}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- readyDesc
+	ch <- loopLastSuccessDesc
+	ch <- loopErrorsDesc
+	ch <- loopPausedDesc
+	ch <- missingNoncesDesc
+	ch <- validatorMissingNoncesDesc
+	ch <- balanceDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), statusQueryTimeout)
+	defer cancelFn()
+
+	status := c.orch.Status(ctx)
+
+	ch <- prometheus.MustNewConstMetric(readyDesc, prometheus.GaugeValue, boolToFloat(status.Ready))
+
+	for _, l := range status.Loops {
+		var lastSuccess float64
+		if !l.LastSuccess.IsZero() {
+			lastSuccess = float64(l.LastSuccess.Unix())
+		}
+
+		ch <- prometheus.MustNewConstMetric(loopLastSuccessDesc, prometheus.GaugeValue, lastSuccess, l.Name)
+		ch <- prometheus.MustNewConstMetric(loopErrorsDesc, prometheus.CounterValue, float64(l.Errors), l.Name)
+		ch <- prometheus.MustNewConstMetric(loopPausedDesc, prometheus.GaugeValue, boolToFloat(l.Paused), l.Name)
+	}
+
+	ch <- prometheus.MustNewConstMetric(missingNoncesDesc, prometheus.GaugeValue, float64(len(status.MissingNonces)))
+	ch <- prometheus.MustNewConstMetric(validatorMissingNoncesDesc, prometheus.GaugeValue, boolToFloat(status.ValidatorMissingNonces))
+
+	injBalance, _ := status.InjectiveBalance.Float64()
+	ethBalance, _ := status.EthereumBalance.Float64()
+	ch <- prometheus.MustNewConstMetric(balanceDesc, prometheus.GaugeValue, injBalance, "injective")
+	ch <- prometheus.MustNewConstMetric(balanceDesc, prometheus.GaugeValue, ethBalance, "ethereum")
+}
+
+func boolToFloat(v bool) float64 {
+	if v {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
+)
+
+const statusQueryTimeout = 10 * time.Second
+
+// Orchestrator is the part of the orchestrator exposed over HTTP.
+type Orchestrator interface {
+	Ready() bool
+	Loops() []loops.LoopStatus
+	Status(ctx context.Context) *orchestrator.Status
+	PauseLoop(name string) error
+	ResumeLoop(name string) error
+}
+
+type Config struct {
+	ListenAddr string
+	// AdminToken protects the admin endpoints (bearer auth). Admin endpoints are disabled when empty.
+	AdminToken string
+}
+
+// Server is peggo's operator HTTP API:
+//
+//	GET  /healthz                     liveness
+//	GET  /readyz                      readiness (all loops completed an iteration recently)
+//	GET  /status                      loops, missing nonces and signer balances
+//	GET  /metrics                     Prometheus metrics
+//	POST /admin/loops/{name}/pause    pause a loop (requires admin token)
+//	POST /admin/loops/{name}/resume   resume a loop (requires admin token)
+type Server struct {
+	cfg    Config
+	orch   Orchestrator
+	srv    *http.Server
+	logger log.Logger
+}
+
+func NewServer(cfg Config, orch Orchestrator) *Server {
+	s := &Server{
+		cfg:    cfg,
+		orch:   orch,
+		logger: log.WithField("svc", "api"),
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(newCollector(orch))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", s.handleHealth)
+	mux.HandleFunc("GET /readyz", s.handleReady)
+	mux.HandleFunc("GET /status", s.handleStatus)
+	mux.Handle("GET /metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("POST /admin/loops/{name}/pause", s.admin(s.handlePause))
+	mux.HandleFunc("POST /admin/loops/{name}/resume", s.admin(s.handleResume))
+
+	s.srv = &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	return s
+}
+
+// Start serves the API in the background.
+func (s *Server) Start() {
+	s.logger.WithField("addr", s.cfg.ListenAddr).Infoln("starting HTTP API")
+
+	go func() {
+		if err := s.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.WithError(err).Errorln("HTTP API stopped")
+		}
+	}()
+}
+
+func (s *Server) Close() error {
+	ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFn()
+
+	return s.srv.Shutdown(ctx)
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) handleReady(w http.ResponseWriter, _ *http.Request) {
+	code := http.StatusOK
+	if !s.orch.Ready() {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, code, map[string]interface{}{
+		"ready": code == http.StatusOK,
+		"loops": s.orch.Loops(),
+	})
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, cancelFn := context.WithTimeout(r.Context(), statusQueryTimeout)
+	defer cancelFn()
+
+	writeJSON(w, http.StatusOK, s.orch.Status(ctx))
+}
+
+func (s *Server) handlePause(w http.ResponseWriter, r *http.Request) {
+	s.setLoop(w, r.PathValue("name"), s.orch.PauseLoop)
+}
+
+func (s *Server) handleResume(w http.ResponseWriter, r *http.Request) {
+	s.setLoop(w, r.PathValue("name"), s.orch.ResumeLoop)
+}
+
+func (s *Server) setLoop(w http.ResponseWriter, name string, fn func(string) error) {
+	if err := fn(name); err != nil {
+		if errors.Is(err, loops.ErrUnknownLoop) {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.orch.Loops())
+}
+
+// admin rejects requests without a valid admin token.
+func (s *Server) admin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.cfg.AdminToken == "" {
+			writeError(w, http.StatusForbidden, errors.New("admin API is disabled"))
+			return
+		}
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.AdminToken)) != 1 {
+			writeError(w, http.StatusUnauthorized, errors.New("invalid admin token"))
+			return
+		}
+
+		s.logger.WithFields(log.Fields{"path": r.URL.Path, "remote": r.RemoteAddr}).Infoln("admin request")
+
+		next(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Debugln("failed to write response")
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
@@ -16,9 +16,9 @@ func (s *Orchestrator) runBatchCreator(ctx context.Context) (err error) {
 	bc := batchCreator{Orchestrator: s}
 	s.logger.WithField("loop_duration", s.cfg.LoopDuration.String()).Debugln("starting BatchCreator...")
 
-	return loops.RunLoop(ctx, s.cfg.LoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.LoopDuration, s.tracker.Track(LoopBatchCreator, s.cfg.LoopDuration, func() error {
 		return bc.requestTokenBatches(ctx)
-	})
+	}))
 }
 
 type batchCreator struct {
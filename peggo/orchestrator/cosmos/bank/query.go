@@ -0,0 +1,54 @@
+package bank
+
+import (
+	"context"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/metrics"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type QueryClient interface {
+	Balance(ctx context.Context, addr cosmostypes.AccAddress, denom string) (cosmostypes.Coin, error)
+}
+
+type queryClient struct {
+	banktypes.QueryClient
+
+	svcTags metrics.Tags
+}
+
+func NewQueryClient(client banktypes.QueryClient) QueryClient {
+	return queryClient{
+		QueryClient: client,
+		svcTags:     metrics.Tags{"svc": "bank_query"},
+	}
+}
+
+func (c queryClient) Balance(ctx context.Context, addr cosmostypes.AccAddress, denom string) (cosmostypes.Coin, error) {
+	metrics.ReportFuncCall(c.svcTags)
+	doneFn := metrics.ReportFuncTiming(c.svcTags)
+	defer doneFn()
+
+	req := &banktypes.QueryBalanceRequest{
+		Address: addr.String(),
+		Denom:   denom,
+	}
+
+	resp, err := c.QueryClient.Balance(ctx, req)
+	if err != nil {
+		metrics.ReportFuncError(c.svcTags)
+		return cosmostypes.Coin{}, errors.Wrap(err, "failed to query Balance from daemon")
+	}
+
+	if resp == nil || resp.Balance == nil {
+		metrics.ReportFuncError(c.svcTags)
+		return cosmostypes.Coin{}, ErrNotFound
+	}
+
+	return *resp.Balance, nil
+}
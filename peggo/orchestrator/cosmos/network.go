@@ -3,10 +3,14 @@ package cosmos
 import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/pkg/errors"
 
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/bank"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/client"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/oracle"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/peggy"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/tendermint"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/keystore"
@@ -24,6 +28,8 @@ type Network interface {
 	peggy.QueryClient
 	peggy.BroadcastClient
 	tendermint.Client
+	oracle.QueryClient
+	bank.QueryClient
 }
 
 func NewNetwork(
@@ -74,19 +80,25 @@ func NewNetwork(
 	}
 
 	var (
-		query = peggy.NewQueryClient(peggytypes.NewQueryClient(clientCtx.GRPCClient))
-		tx    = peggy.NewBroadcastClient(chainClient, ethSignFn)
-		tm    = tendermint.NewRPCClient(cfg.TendermintRPC)
+		query       = peggy.NewQueryClient(peggytypes.NewQueryClient(clientCtx.GRPCClient))
+		tx          = peggy.NewBroadcastClient(chainClient, ethSignFn)
+		tm          = tendermint.NewRPCClient(cfg.TendermintRPC)
+		oracleQuery = oracle.NewQueryClient(oracletypes.NewQueryClient(clientCtx.GRPCClient))
+		bankQuery   = bank.NewQueryClient(banktypes.NewQueryClient(clientCtx.GRPCClient))
 	)
 
 	net := struct {
 		peggy.QueryClient
 		peggy.BroadcastClient
 		tendermint.Client
+		oracle.QueryClient
+		bank.QueryClient
 	}{
 		query,
 		tx,
 		tm,
+		oracleQuery,
+		bankQuery,
 	}
 
 	return net, nil
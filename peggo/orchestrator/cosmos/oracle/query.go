@@ -0,0 +1,80 @@
+package oracle
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/pkg/errors"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type QueryClient interface {
+	OraclePrice(ctx context.Context, oracleType oracletypes.OracleType, base, quote string) (sdkmath.LegacyDec, error)
+	ProviderPrice(ctx context.Context, provider, symbol string) (sdkmath.LegacyDec, error)
+}
+
+type queryClient struct {
+	oracletypes.QueryClient
+
+	svcTags metrics.Tags
+}
+
+func NewQueryClient(client oracletypes.QueryClient) QueryClient {
+	return queryClient{
+		QueryClient: client,
+		svcTags:     metrics.Tags{"svc": "oracle_query"},
+	}
+}
+
+func (c queryClient) OraclePrice(ctx context.Context, oracleType oracletypes.OracleType, base, quote string) (sdkmath.LegacyDec, error) {
+	metrics.ReportFuncCall(c.svcTags)
+	doneFn := metrics.ReportFuncTiming(c.svcTags)
+	defer doneFn()
+
+	req := &oracletypes.QueryOraclePriceRequest{
+		OracleType: oracleType,
+		Base:       base,
+		Quote:      quote,
+	}
+
+	resp, err := c.QueryClient.OraclePrice(ctx, req)
+	if err != nil {
+		metrics.ReportFuncError(c.svcTags)
+		return sdkmath.LegacyDec{}, errors.Wrap(err, "failed to query OraclePrice from daemon")
+	}
+
+	if resp == nil || resp.PricePairState == nil || resp.PricePairState.PairPrice.IsNil() {
+		metrics.ReportFuncError(c.svcTags)
+		return sdkmath.LegacyDec{}, ErrNotFound
+	}
+
+	return resp.PricePairState.PairPrice, nil
+}
+
+func (c queryClient) ProviderPrice(ctx context.Context, provider, symbol string) (sdkmath.LegacyDec, error) {
+	metrics.ReportFuncCall(c.svcTags)
+	doneFn := metrics.ReportFuncTiming(c.svcTags)
+	defer doneFn()
+
+	req := &oracletypes.QueryProviderPriceStateRequest{
+		Provider: provider,
+		Symbol:   symbol,
+	}
+
+	resp, err := c.QueryClient.ProviderPriceState(ctx, req)
+	if err != nil {
+		metrics.ReportFuncError(c.svcTags)
+		return sdkmath.LegacyDec{}, errors.Wrap(err, "failed to query ProviderPriceState from daemon")
+	}
+
+	if resp == nil || resp.PriceState == nil || resp.PriceState.Price.IsNil() {
+		metrics.ReportFuncError(c.svcTags)
+		return sdkmath.LegacyDec{}, ErrNotFound
+	}
+
+	return resp.PriceState.Price, nil
+}
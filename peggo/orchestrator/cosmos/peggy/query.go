@@ -28,6 +28,8 @@ type QueryClient interface {
 	LatestTransactionBatches(ctx context.Context) ([]*peggytypes.OutgoingTxBatch, error)
 	UnbatchedTokensWithFees(ctx context.Context) ([]*peggytypes.BatchFees, error)
 	TransactionBatchSignatures(ctx context.Context, nonce uint64, tokenContract gethcommon.Address) ([]*peggytypes.MsgConfirmBatch, error)
+
+	MissingPeggoNonces(ctx context.Context) ([]string, error)
 }
 
 type queryClient struct {
@@ -298,3 +300,23 @@ func (c queryClient) GetValidatorAddress(ctx context.Context, addr gethcommon.Ad
 
 	return valAddr, nil
 }
+
+// MissingPeggoNonces returns the operator addresses of bonded validators that have not submitted any Ethereum event claim.
+func (c queryClient) MissingPeggoNonces(ctx context.Context) ([]string, error) {
+	metrics.ReportFuncCall(c.svcTags)
+	doneFn := metrics.ReportFuncTiming(c.svcTags)
+	defer doneFn()
+
+	resp, err := c.QueryClient.MissingPeggoNonces(ctx, &peggytypes.MissingNoncesRequest{})
+	if err != nil {
+		metrics.ReportFuncError(c.svcTags)
+		return nil, errors.Wrap(err, "failed to query MissingPeggoNonces from daemon")
+	}
+
+	if resp == nil {
+		metrics.ReportFuncError(c.svcTags)
+		return nil, ErrNotFound
+	}
+
+	return resp.OperatorAddresses, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/provider"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
 )
 
 // EVMCommitter defines an interface for submitting transactions
@@ -23,22 +24,62 @@ type EVMCommitter interface {
 		recipient common.Address,
 		txData []byte,
 	) (txHash common.Hash, err error)
+	EstimateTxCost(
+		ctx context.Context,
+		recipient common.Address,
+		txData []byte,
+	) (cost *big.Int, err error)
 }
 
 type EVMCommitterOption func(o *options) error
 
+// PendingTxStore persists pending submissions, so that they can still be
+// waited for or replaced after a restart.
+type PendingTxStore interface {
+	PendingTxs() (map[common.Hash]store.PendingTx, error)
+	SetPendingTx(key common.Hash, tx store.PendingTx) error
+	DeletePendingTx(key common.Hash) error
+}
+
+type PriorityFeePolicy string
+
+const (
+	// PriorityFeeSuggested uses the node's suggested tip multiplied by the gas price adjustment
+	PriorityFeeSuggested PriorityFeePolicy = "suggested"
+	// PriorityFeeFixed always uses the configured tip
+	PriorityFeeFixed PriorityFeePolicy = "fixed"
+)
+
+// minFeeBumpPercent is the minimal price increase most EVM nodes accept for a replacement tx
+const minFeeBumpPercent = 10
+
 type options struct {
 	GasPrice   decimal.Decimal
 	GasLimit   uint64
 	RPCTimeout time.Duration
+
+	DynamicFees       bool
+	PriorityFeePolicy PriorityFeePolicy
+	PriorityFee       *big.Int
+	FeeBumpPercent    uint64
+	StuckTxTimeout    time.Duration
+
+	PendingTxStore PendingTxStore
 }
 
 func defaultOptions() *options {
 	v, _ := decimal.NewFromString("20")
+	tip, _ := decimal.NewFromString("2")
 	return &options{
 		GasPrice:   v.Shift(9), // 20 gwei
 		GasLimit:   1000000,
 		RPCTimeout: 10 * time.Second,
+
+		DynamicFees:       true,
+		PriorityFeePolicy: PriorityFeeSuggested,
+		PriorityFee:       tip.Shift(9).BigInt(), // 2 gwei
+		FeeBumpPercent:    20,
+		StuckTxTimeout:    10 * time.Minute,
 	}
 }
 
@@ -66,6 +107,7 @@ func OptionGasPriceFromString(str string) EVMCommitterOption {
 	}
 }
 
+// ParseMaxGasPrice parses a gas price with an optional gwei denom (wei otherwise).
 func ParseMaxGasPrice(maxGasPriceStr string) int64 {
 	maxGasPriceStr = strings.TrimSpace(maxGasPriceStr)
 	maxGasPriceStr = strings.ToLower(maxGasPriceStr)
@@ -120,3 +162,53 @@ func TxBroadcastTimeout(dur time.Duration) EVMCommitterOption {
 		return nil
 	}
 }
+
+// OptionDynamicFees toggles EIP-1559 dynamic-fee transactions. Legacy transactions
+// are still sent if the network does not report a base fee.
+func OptionDynamicFees(enabled bool) EVMCommitterOption {
+	return func(o *options) error {
+		o.DynamicFees = enabled
+		return nil
+	}
+}
+
+// OptionPriorityFee sets the policy used to pick the priority fee (tip) of dynamic-fee transactions.
+// The fixed tip is specified in wei or gwei (e.g. "2gwei").
+func OptionPriorityFee(policy, fixedTip string) EVMCommitterOption {
+	return func(o *options) error {
+		switch PriorityFeePolicy(policy) {
+		case PriorityFeeSuggested, PriorityFeeFixed:
+			o.PriorityFeePolicy = PriorityFeePolicy(policy)
+		default:
+			return errors.Errorf("unsupported priority fee policy: %s", policy)
+		}
+
+		if fixedTip != "" {
+			o.PriorityFee = big.NewInt(ParseMaxGasPrice(fixedTip))
+		}
+
+		return nil
+	}
+}
+
+// OptionFeeBump configures replace-by-fee: a submission that is still pending after stuckTimeout
+// is re-sent with the same nonce and fees increased by bumpPercent.
+func OptionFeeBump(bumpPercent uint64, stuckTimeout time.Duration) EVMCommitterOption {
+	return func(o *options) error {
+		if bumpPercent < minFeeBumpPercent {
+			return errors.Errorf("fee bump must be at least %d%%", minFeeBumpPercent)
+		}
+
+		o.FeeBumpPercent = bumpPercent
+		o.StuckTxTimeout = stuckTimeout
+		return nil
+	}
+}
+
+// OptionPendingTxStore makes the committer persist its pending submissions.
+func OptionPendingTxStore(s PendingTxStore) EVMCommitterOption {
+	return func(o *options) error {
+		o.PendingTxStore = s
+		return nil
+	}
+}
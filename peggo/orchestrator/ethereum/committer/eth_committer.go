@@ -4,12 +4,15 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
@@ -17,6 +20,7 @@ import (
 
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/provider"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/util"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
 )
 
 // NewEthCommitter returns an instance of EVMCommitter, which
@@ -41,12 +45,17 @@ func NewEthCommitter(
 		fromSigner:            fromSigner,
 		evmProvider:           evmProvider,
 		nonceCache:            util.NewNonceCache(),
+		pendingTxs:            make(map[common.Hash]*pendingTx),
 	}
 
 	if err := applyOptions(committer.committerOpts, committerOpts...); err != nil {
 		return nil, err
 	}
 
+	if err := committer.loadPendingTxs(context.TODO()); err != nil {
+		return nil, err
+	}
+
 	committer.nonceCache.Sync(fromAddress, func() (uint64, error) {
 		nonce, err := evmProvider.PendingNonceAt(context.TODO(), fromAddress)
 		return nonce, err
@@ -66,9 +75,46 @@ type ethCommitter struct {
 	evmProvider           provider.EVMProviderWithRet
 	nonceCache            util.NonceCache
 
+	// pendingTxs tracks our own submissions (keyed by recipient and input) until they are mined,
+	// so that stuck ones can be replaced using the same nonce.
+	pendingTxs   map[common.Hash]*pendingTx
+	pendingTxsMx sync.Mutex
+
 	svcTags metrics.Tags
 }
 
+// txFees holds either a legacy gas price or the EIP-1559 fee cap and tip.
+type txFees struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+func (f *txFees) isDynamic() bool {
+	return f.GasFeeCap != nil
+}
+
+// maxPrice is the highest price per gas the tx may pay.
+func (f *txFees) maxPrice() *big.Int {
+	if f.isDynamic() {
+		return f.GasFeeCap
+	}
+
+	return f.GasPrice
+}
+
+// pendingTx is one of our submissions along with its own payload, so that the same payload
+// can be re-broadcast at the same nonce if it gets stuck.
+type pendingTx struct {
+	Nonce     uint64
+	Fees      *txFees
+	TxHash    common.Hash
+	SentAt    time.Time
+	Recipient common.Address
+	Data      []byte
+	GasLimit  uint64
+}
+
 func (e *ethCommitter) FromAddress() common.Address {
 	return e.fromAddress
 }
@@ -87,52 +133,43 @@ func (e *ethCommitter) SendTx(
 	defer doneFn()
 
 	opts := &bind.TransactOpts{
-		From:   e.fromAddress,
-		Signer: e.fromSigner,
-
-		GasPrice: e.committerOpts.GasPrice.BigInt(),
+		From:     e.fromAddress,
+		Signer:   e.fromSigner,
 		GasLimit: e.committerOpts.GasLimit,
 		Context:  ctx, // with RPC timeout
 	}
 
-	// Figure out the gas price values
-	suggestedGasPrice, err := e.evmProvider.SuggestGasPrice(opts.Context)
+	fees, err := e.suggestFees(opts.Context)
 	if err != nil {
 		metrics.ReportFuncError(e.svcTags)
-		return common.Hash{}, errors.Errorf("failed to suggest gas price: %v", err)
+		return common.Hash{}, err
 	}
 
-	// Suggested gas price is not accurate. Increment by multiplying with gasprice adjustment factor
-	incrementedPrice := big.NewFloat(0).Mul(new(big.Float).SetInt(suggestedGasPrice), big.NewFloat(e.ethGasPriceAdjustment))
-
-	// set gasprice to incremented gas price.
-	gasPrice := new(big.Int)
-	incrementedPrice.Int(gasPrice)
+	gasLimit, err := e.estimateGas(opts.Context, recipient, txData, fees)
+	if err != nil {
+		metrics.ReportFuncError(e.svcTags)
+		return common.Hash{}, err
+	}
 
-	opts.GasPrice = gasPrice
+	opts.GasLimit = gasLimit
 
-	//The gas price should be less than max gas price
-	maxGasPrice := big.NewInt(int64(e.ethMaxGasPrice))
-	if opts.GasPrice.Cmp(maxGasPrice) > 0 {
-		return common.Hash{}, errors.Errorf("Suggested gas price %v is greater than max gas price %v", opts.GasPrice.Int64(), maxGasPrice.Int64())
-	}
+	txKey := pendingTxKey(recipient, txData)
 
-	// estimate gas limit
-	msg := ethereum.CallMsg{
-		From:     opts.From,
-		To:       &recipient,
-		GasPrice: gasPrice,
-		Value:    new(big.Int),
-		Data:     txData,
+	// Replace-by-fee: re-broadcast a stuck submission (with bumped fees) at its nonce so that it
+	// doesn't block the queue, and wait for an identical submission instead of sending it again.
+	var isPending bool
+	if err := e.nonceCache.Serialize(e.fromAddress, func() (err error) {
+		isPending, txHash, err = e.replaceStuckTx(ctx, txKey, fees)
+		return err
+	}); err != nil {
+		metrics.ReportFuncError(e.svcTags)
+		return common.Hash{}, err
 	}
 
-	gasLimit, err := e.evmProvider.EstimateGas(opts.Context, msg)
-	if err != nil {
-		return common.Hash{}, errors.Wrap(err, "failed to estimate gas")
+	if isPending {
+		return txHash, nil
 	}
 
-	opts.GasLimit = gasLimit
-
 	resyncNonces := func(from common.Address) {
 		e.nonceCache.Sync(from, func() (uint64, error) {
 			nonce, err := e.evmProvider.PendingNonceAt(context.TODO(), from)
@@ -152,10 +189,8 @@ func (e *ethCommitter) SendTx(
 			opts.Nonce = big.NewInt(nonce)
 			opts.Context, _ = context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
 
-			tx := types.NewTransaction(opts.Nonce.Uint64(), recipient, nil, opts.GasLimit, opts.GasPrice, txData)
-			signedTx, err := opts.Signer(opts.From, tx)
+			signedTx, err := e.signTx(opts, recipient, txData, fees)
 			if err != nil {
-				err := errors.Wrap(err, "failed to sign transaction")
 				return err
 			}
 
@@ -166,6 +201,16 @@ func (e *ethCommitter) SendTx(
 				// override with a real hash from node resp
 				txHash = txHashRet
 				e.nonceCache.Incr(e.fromAddress)
+				e.setPendingTx(txKey, &pendingTx{
+					Nonce:     opts.Nonce.Uint64(),
+					Fees:      fees,
+					TxHash:    txHash,
+					SentAt:    time.Now(),
+					Recipient: recipient,
+					Data:      txData,
+					GasLimit:  opts.GasLimit,
+				})
+
 				return nil
 			} else {
 				log.WithFields(log.Fields{
@@ -224,3 +269,436 @@ func (e *ethCommitter) SendTx(
 
 	return txHash, nil
 }
+
+// EstimateTxCost returns the projected cost in wei of sending the tx now,
+// i.e. estimated gas multiplied by the effective gas price (base fee + tip).
+func (e *ethCommitter) EstimateTxCost(
+	ctx context.Context,
+	recipient common.Address,
+	txData []byte,
+) (*big.Int, error) {
+	metrics.ReportFuncCall(e.svcTags)
+	doneFn := metrics.ReportFuncTiming(e.svcTags)
+	defer doneFn()
+
+	fees, err := e.suggestFees(ctx)
+	if err != nil {
+		metrics.ReportFuncError(e.svcTags)
+		return nil, err
+	}
+
+	gasLimit, err := e.estimateGas(ctx, recipient, txData, fees)
+	if err != nil {
+		metrics.ReportFuncError(e.svcTags)
+		return nil, err
+	}
+
+	gasPrice := fees.GasPrice
+	if fees.isDynamic() {
+		header, err := e.evmProvider.HeaderByNumber(ctx, nil)
+		if err != nil {
+			metrics.ReportFuncError(e.svcTags)
+			return nil, errors.Wrap(err, "failed to get latest header")
+		}
+
+		gasPrice = new(big.Int).Add(header.BaseFee, fees.GasTipCap)
+		if gasPrice.Cmp(fees.GasFeeCap) > 0 {
+			gasPrice = fees.GasFeeCap
+		}
+	}
+
+	return new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit)), nil
+}
+
+// suggestFees figures out the fees for a new transaction. Dynamic (EIP-1559) fees are used when
+// enabled and supported by the network, otherwise the legacy gas price is used.
+func (e *ethCommitter) suggestFees(ctx context.Context) (*txFees, error) {
+	maxGasPrice := big.NewInt(e.ethMaxGasPrice)
+
+	if e.committerOpts.DynamicFees {
+		header, err := e.evmProvider.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest header")
+		}
+
+		if header.BaseFee != nil {
+			return e.suggestDynamicFees(ctx, header.BaseFee, maxGasPrice)
+		}
+
+		log.Debugln("network does not report a base fee, falling back to legacy gas price")
+	}
+
+	suggestedGasPrice, err := e.evmProvider.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, errors.Errorf("failed to suggest gas price: %v", err)
+	}
+
+	// Suggested gas price is not accurate. Increment by multiplying with gasprice adjustment factor
+	gasPrice := e.adjust(suggestedGasPrice)
+
+	//The gas price should be less than max gas price
+	if gasPrice.Cmp(maxGasPrice) > 0 {
+		return nil, errors.Errorf("Suggested gas price %v is greater than max gas price %v", gasPrice.String(), maxGasPrice.String())
+	}
+
+	return &txFees{GasPrice: gasPrice}, nil
+}
+
+func (e *ethCommitter) suggestDynamicFees(ctx context.Context, baseFee, maxGasPrice *big.Int) (*txFees, error) {
+	if baseFee.Cmp(maxGasPrice) >= 0 {
+		return nil, errors.Errorf("base fee %v is greater than max gas price %v", baseFee.String(), maxGasPrice.String())
+	}
+
+	var tip *big.Int
+	switch e.committerOpts.PriorityFeePolicy {
+	case PriorityFeeFixed:
+		tip = new(big.Int).Set(e.committerOpts.PriorityFee)
+	default:
+		suggestedTip, err := e.evmProvider.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, errors.Errorf("failed to suggest gas tip cap: %v", err)
+		}
+
+		tip = e.adjust(suggestedTip)
+	}
+
+	// leave room for the base fee to double before the tx becomes unexecutable
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+	if feeCap.Cmp(maxGasPrice) > 0 {
+		feeCap = new(big.Int).Set(maxGasPrice)
+	}
+
+	if tip.Cmp(new(big.Int).Sub(feeCap, baseFee)) > 0 {
+		tip = new(big.Int).Sub(feeCap, baseFee)
+	}
+
+	return &txFees{GasTipCap: tip, GasFeeCap: feeCap}, nil
+}
+
+// bumpFees increases the fees of a stuck tx by the configured percentage, but never below
+// the currently suggested fees. Nodes reject replacements that do not raise both fee fields.
+func (e *ethCommitter) bumpFees(prev, suggested *txFees) (*txFees, error) {
+	maxGasPrice := big.NewInt(e.ethMaxGasPrice)
+
+	bump := func(v *big.Int) *big.Int {
+		bumped := new(big.Int).Mul(v, new(big.Int).SetUint64(100+e.committerOpts.FeeBumpPercent))
+		return bumped.Div(bumped, big.NewInt(100))
+	}
+
+	maxOf := func(a, b *big.Int) *big.Int {
+		if b != nil && b.Cmp(a) > 0 {
+			return new(big.Int).Set(b)
+		}
+
+		return a
+	}
+
+	var bumped *txFees
+	if prev.isDynamic() {
+		bumped = &txFees{
+			GasTipCap: maxOf(bump(prev.GasTipCap), suggested.GasTipCap),
+			GasFeeCap: maxOf(bump(prev.GasFeeCap), suggested.GasFeeCap),
+		}
+
+		if bumped.GasTipCap.Cmp(bumped.GasFeeCap) > 0 {
+			bumped.GasFeeCap = new(big.Int).Set(bumped.GasTipCap)
+		}
+	} else {
+		bumped = &txFees{GasPrice: maxOf(bump(prev.GasPrice), suggested.maxPrice())}
+	}
+
+	if bumped.maxPrice().Cmp(maxGasPrice) > 0 {
+		return nil, errors.Errorf("bumped gas price %v is greater than max gas price %v", bumped.maxPrice().String(), maxGasPrice.String())
+	}
+
+	return bumped, nil
+}
+
+// replaceStuckTx checks our previous submissions. The oldest stuck one is re-broadcast with its own
+// payload at the same nonce, using bumped fees, on a best-effort basis. It returns isPending=true (and the hash to report)
+// when the same submission as txKey is still pending, so it should not be sent again.
+func (e *ethCommitter) replaceStuckTx(
+	ctx context.Context,
+	txKey common.Hash,
+	suggested *txFees,
+) (isPending bool, txHash common.Hash, err error) {
+	if !e.hasPendingTxs() {
+		return false, common.Hash{}, nil
+	}
+
+	minedNonce, err := e.evmProvider.NonceAt(ctx, e.fromAddress, nil)
+	if err != nil {
+		return false, common.Hash{}, errors.Wrap(err, "failed to get account nonce")
+	}
+
+	// drop submissions that (or whose replacements) got mined
+	e.prunePendingTxs(minedNonce)
+
+	// a stuck tx that can't be replaced (e.g. its bumped fees exceed the max gas price) must not
+	// block new submissions, so the failure is only logged
+	if stuckKey, stuck := e.oldestStuckTx(); stuck != nil {
+		if err := e.rebroadcastStuckTx(ctx, stuckKey, stuck, suggested); err != nil {
+			log.WithFields(log.Fields{
+				"tx_hash": stuck.TxHash.Hex(),
+				"nonce":   stuck.Nonce,
+			}).WithError(err).Warningln("failed to replace stuck tx")
+		}
+	}
+
+	if prev := e.getPendingTx(txKey); prev != nil {
+		log.WithFields(log.Fields{
+			"tx_hash": prev.TxHash.Hex(),
+			"nonce":   prev.Nonce,
+		}).Debugln("same tx is already pending, waiting for it to be mined")
+
+		return true, prev.TxHash, nil
+	}
+
+	return false, common.Hash{}, nil
+}
+
+// rebroadcastStuckTx re-signs the payload of the stuck tx at its nonce with bumped fees and sends it.
+func (e *ethCommitter) rebroadcastStuckTx(ctx context.Context, stuckKey common.Hash, stuck *pendingTx, suggested *txFees) error {
+	fees, err := e.bumpFees(stuck.Fees, suggested)
+	if err != nil {
+		return errors.Wrap(err, "unable to replace stuck tx")
+	}
+
+	sendCtx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	opts := &bind.TransactOpts{
+		From:     e.fromAddress,
+		Signer:   e.fromSigner,
+		Nonce:    new(big.Int).SetUint64(stuck.Nonce),
+		GasLimit: stuck.GasLimit,
+		Context:  sendCtx,
+	}
+
+	signedTx, err := e.signTx(opts, stuck.Recipient, stuck.Data, fees)
+	if err != nil {
+		return err
+	}
+
+	txHash, err := e.evmProvider.SendTransactionWithRet(sendCtx, signedTx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to replace stuck tx %s", stuck.TxHash.Hex())
+	}
+
+	log.WithFields(log.Fields{
+		"old_tx_hash":   stuck.TxHash.Hex(),
+		"new_tx_hash":   txHash.Hex(),
+		"nonce":         stuck.Nonce,
+		"max_gas_price": fees.maxPrice().String(),
+	}).Infoln("replaced stuck tx with bumped fees")
+
+	e.setPendingTx(stuckKey, &pendingTx{
+		Nonce:     stuck.Nonce,
+		Fees:      fees,
+		TxHash:    txHash,
+		SentAt:    time.Now(),
+		Recipient: stuck.Recipient,
+		Data:      stuck.Data,
+		GasLimit:  stuck.GasLimit,
+	})
+
+	return nil
+}
+
+func (e *ethCommitter) estimateGas(ctx context.Context, recipient common.Address, txData []byte, fees *txFees) (uint64, error) {
+	msg := ethereum.CallMsg{
+		From:      e.fromAddress,
+		To:        &recipient,
+		GasPrice:  fees.GasPrice,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
+		Value:     new(big.Int),
+		Data:      txData,
+	}
+
+	gasLimit, err := e.evmProvider.EstimateGas(ctx, msg)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to estimate gas")
+	}
+
+	return gasLimit, nil
+}
+
+func (e *ethCommitter) signTx(opts *bind.TransactOpts, recipient common.Address, txData []byte, fees *txFees) (*types.Transaction, error) {
+	var tx *types.Transaction
+	if fees.isDynamic() {
+		chainID, err := e.evmProvider.ChainID(opts.Context)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get chain ID")
+		}
+
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     opts.Nonce.Uint64(),
+			GasTipCap: fees.GasTipCap,
+			GasFeeCap: fees.GasFeeCap,
+			Gas:       opts.GasLimit,
+			To:        &recipient,
+			Value:     new(big.Int),
+			Data:      txData,
+		})
+	} else {
+		tx = types.NewTransaction(opts.Nonce.Uint64(), recipient, nil, opts.GasLimit, fees.GasPrice, txData)
+	}
+
+	signedTx, err := opts.Signer(opts.From, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	return signedTx, nil
+}
+
+func (e *ethCommitter) adjust(v *big.Int) *big.Int {
+	adjusted := big.NewFloat(0).Mul(new(big.Float).SetInt(v), big.NewFloat(e.ethGasPriceAdjustment))
+
+	res := new(big.Int)
+	adjusted.Int(res)
+
+	return res
+}
+
+// loadPendingTxs restores the submissions that were pending when peggo stopped,
+// dropping the ones that got mined in the meantime.
+func (e *ethCommitter) loadPendingTxs(ctx context.Context) error {
+	pendingTxStore := e.committerOpts.PendingTxStore
+	if pendingTxStore == nil {
+		return nil
+	}
+
+	txs, err := pendingTxStore.PendingTxs()
+	if err != nil {
+		return errors.Wrap(err, "failed to load pending txs")
+	}
+
+	if len(txs) == 0 {
+		return nil
+	}
+
+	minedNonce, err := e.evmProvider.NonceAt(ctx, e.fromAddress, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get account nonce")
+	}
+
+	for key, tx := range txs {
+		if tx.Nonce < minedNonce {
+			if err := pendingTxStore.DeletePendingTx(key); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		e.pendingTxs[key] = &pendingTx{
+			Nonce: tx.Nonce,
+			Fees: &txFees{
+				GasPrice:  tx.GasPrice,
+				GasTipCap: tx.GasTipCap,
+				GasFeeCap: tx.GasFeeCap,
+			},
+			TxHash:    tx.TxHash,
+			SentAt:    tx.SentAt,
+			Recipient: tx.Recipient,
+			Data:      tx.Data,
+			GasLimit:  tx.GasLimit,
+		}
+
+		log.WithFields(log.Fields{
+			"tx_hash": tx.TxHash.Hex(),
+			"nonce":   tx.Nonce,
+		}).Infoln("restored pending tx")
+	}
+
+	return nil
+}
+
+func (e *ethCommitter) hasPendingTxs() bool {
+	e.pendingTxsMx.Lock()
+	defer e.pendingTxsMx.Unlock()
+
+	return len(e.pendingTxs) > 0
+}
+
+func (e *ethCommitter) getPendingTx(key common.Hash) *pendingTx {
+	e.pendingTxsMx.Lock()
+	defer e.pendingTxsMx.Unlock()
+
+	return e.pendingTxs[key]
+}
+
+func (e *ethCommitter) setPendingTx(key common.Hash, tx *pendingTx) {
+	e.pendingTxsMx.Lock()
+	defer e.pendingTxsMx.Unlock()
+
+	e.pendingTxs[key] = tx
+
+	if s := e.committerOpts.PendingTxStore; s != nil {
+		record := store.PendingTx{
+			Nonce:     tx.Nonce,
+			TxHash:    tx.TxHash,
+			SentAt:    tx.SentAt,
+			GasPrice:  tx.Fees.GasPrice,
+			GasTipCap: tx.Fees.GasTipCap,
+			GasFeeCap: tx.Fees.GasFeeCap,
+			Recipient: tx.Recipient,
+			Data:      tx.Data,
+			GasLimit:  tx.GasLimit,
+		}
+
+		if err := s.SetPendingTx(key, record); err != nil {
+			log.WithError(err).Warningln("failed to persist pending tx")
+		}
+	}
+}
+
+func (e *ethCommitter) prunePendingTxs(minedNonce uint64) {
+	e.pendingTxsMx.Lock()
+	defer e.pendingTxsMx.Unlock()
+
+	for key, tx := range e.pendingTxs {
+		if tx.Nonce < minedNonce {
+			delete(e.pendingTxs, key)
+			e.deleteStoredPendingTx(key)
+		}
+	}
+}
+
+func (e *ethCommitter) deleteStoredPendingTx(key common.Hash) {
+	if s := e.committerOpts.PendingTxStore; s != nil {
+		if err := s.DeletePendingTx(key); err != nil {
+			log.WithError(err).Warningln("failed to delete persisted pending tx")
+		}
+	}
+}
+
+// oldestStuckTx returns the pending submission with the lowest nonce that has been pending for longer than the stuck timeout.
+func (e *ethCommitter) oldestStuckTx() (common.Hash, *pendingTx) {
+	e.pendingTxsMx.Lock()
+	defer e.pendingTxsMx.Unlock()
+
+	var (
+		stuckKey common.Hash
+		stuck    *pendingTx
+	)
+
+	for key, tx := range e.pendingTxs {
+		if time.Since(tx.SentAt) < e.committerOpts.StuckTxTimeout {
+			continue
+		}
+
+		if stuck == nil || tx.Nonce < stuck.Nonce {
+			stuckKey, stuck = key, tx
+		}
+	}
+
+	return stuckKey, stuck
+}
+
+func pendingTxKey(recipient common.Address, txData []byte) common.Hash {
+	return crypto.Keccak256Hash(recipient.Bytes(), txData)
+}
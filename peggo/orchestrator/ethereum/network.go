@@ -28,6 +28,15 @@ type NetworkConfig struct {
 	MaxGasPrice           string
 	PendingTxWaitDuration string
 	EthNodeAlchemyWS      string
+
+	DynamicFees       bool
+	PriorityFeePolicy string
+	PriorityFee       string
+	FeeBumpPercent    uint64
+	StuckTxTimeout    time.Duration
+
+	// PendingTxStore, if set, keeps pending valset/batch submissions across restarts
+	PendingTxStore committer.PendingTxStore
 }
 
 // Network is the orchestrator's reference endpoint to the Ethereum network
@@ -53,8 +62,14 @@ type Network interface {
 		batch *peggytypes.OutgoingTxBatch,
 		confirms []*peggytypes.MsgConfirmBatch,
 	) (*gethcommon.Hash, error)
+	EstimateTransactionBatchCost(ctx context.Context,
+		currentValset *peggytypes.Valset,
+		batch *peggytypes.OutgoingTxBatch,
+		confirms []*peggytypes.MsgConfirmBatch,
+	) (*big.Int, error)
 
 	TokenDecimals(ctx context.Context, tokenContract gethcommon.Address) (uint8, error)
+	GetBalance(ctx context.Context, account gethcommon.Address) (*big.Int, error)
 }
 
 type network struct {
@@ -75,12 +90,23 @@ func NewNetwork(
 		return nil, errors.Wrapf(err, "failed to connect to ethereum RPC: %s", cfg.EthNodeRPC)
 	}
 
+	committerOpts := []committer.EVMCommitterOption{
+		committer.OptionDynamicFees(cfg.DynamicFees),
+		committer.OptionPriorityFee(cfg.PriorityFeePolicy, cfg.PriorityFee),
+		committer.OptionFeeBump(cfg.FeeBumpPercent, cfg.StuckTxTimeout),
+	}
+
+	if cfg.PendingTxStore != nil {
+		committerOpts = append(committerOpts, committer.OptionPendingTxStore(cfg.PendingTxStore))
+	}
+
 	ethCommitter, err := committer.NewEthCommitter(
 		fromAddr,
 		cfg.GasPriceAdjustment,
 		cfg.MaxGasPrice,
 		signerFn,
 		provider.NewEVMProvider(evmRPC),
+		committerOpts...,
 	)
 	if err != nil {
 		return nil, err
@@ -135,6 +161,14 @@ func (n *network) TokenDecimals(ctx context.Context, tokenContract gethcommon.Ad
 	return uint8(big.NewInt(0).SetBytes(res).Uint64()), nil
 }
 
+func (n *network) GetBalance(ctx context.Context, account gethcommon.Address) (*big.Int, error) {
+	metrics.ReportFuncCall(n.svcTags)
+	doneFn := metrics.ReportFuncTiming(n.svcTags)
+	defer doneFn()
+
+	return n.Provider().BalanceAt(ctx, account, nil)
+}
+
 func (n *network) GetHeaderByNumber(ctx context.Context, number *big.Int) (*gethtypes.Header, error) {
 	metrics.ReportFuncCall(n.svcTags)
 	doneFn := metrics.ReportFuncTiming(n.svcTags)
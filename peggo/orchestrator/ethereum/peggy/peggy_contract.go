@@ -42,6 +42,13 @@ type PeggyContract interface {
 		confirms []*peggytypes.MsgConfirmBatch,
 	) (*common.Hash, error)
 
+	EstimateTransactionBatchCost(
+		ctx context.Context,
+		currentValset *peggytypes.Valset,
+		batch *peggytypes.OutgoingTxBatch,
+		confirms []*peggytypes.MsgConfirmBatch,
+	) (*big.Int, error)
+
 	SendEthValsetUpdate(
 		ctx context.Context,
 		oldValset *peggytypes.Valset,
@@ -29,59 +29,9 @@ func (s *peggyContract) SendTransactionBatch(
 		"confirmations":  len(confirms),
 	}).Infoln("checking signatures and submitting batch")
 
-	validators, powers, sigV, sigR, sigS, err := checkBatchSigsAndRepack(currentValset, confirms)
+	txData, err := packSubmitBatch(currentValset, batch, confirms)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		err = errors.Wrap(err, "confirmations check failed")
-		return nil, err
-	}
-
-	amounts, destinations, fees := getBatchCheckpointValues(batch)
-	currentValsetNonce := new(big.Int).SetUint64(currentValset.Nonce)
-	batchNonce := new(big.Int).SetUint64(batch.BatchNonce)
-	batchTimeout := new(big.Int).SetUint64(batch.BatchTimeout)
-
-	// Solidity function signature
-	// function submitBatch(
-	// 		// The validators that approve the batch and new valset
-	// 		address[] memory _currentValidators,
-	// 		uint256[] memory _currentPowers,
-	// 		uint256 _currentValsetNonce,
-	//
-	// 		// These are arrays of the parts of the validators signatures
-	// 		uint8[] memory _v,
-	// 		bytes32[] memory _r,
-	// 		bytes32[] memory _s,
-	//
-	// 		// The batch of transactions
-	// 		uint256[] memory _amounts,
-	// 		address[] memory _destinations,
-	// 		uint256[] memory _fees,
-	// 		uint256 _batchNonce,
-	// 		address _tokenContract
-	// )
-
-	currentValsetArs := ValsetArgs{
-		Validators:   validators,
-		Powers:       powers,
-		ValsetNonce:  currentValsetNonce,
-		RewardAmount: currentValset.RewardAmount.BigInt(),
-		RewardToken:  common.HexToAddress(currentValset.RewardToken),
-	}
-
-	txData, err := peggyABI.Pack("submitBatch",
-		currentValsetArs,
-		sigV, sigR, sigS,
-		amounts,
-		destinations,
-		fees,
-		batchNonce,
-		common.HexToAddress(batch.TokenContract),
-		batchTimeout,
-	)
-	if err != nil {
-		metrics.ReportFuncError(s.svcTags)
-		log.WithError(err).Errorln("ABI Pack (Peggy submitBatch) method")
 		return nil, err
 	}
 
@@ -150,6 +100,94 @@ func (s *peggyContract) SendTransactionBatch(
 	return &txHash, nil
 }
 
+// EstimateTransactionBatchCost returns the projected cost (in wei) of submitting the batch to Ethereum.
+func (s *peggyContract) EstimateTransactionBatchCost(
+	ctx context.Context,
+	currentValset *peggytypes.Valset,
+	batch *peggytypes.OutgoingTxBatch,
+	confirms []*peggytypes.MsgConfirmBatch,
+) (*big.Int, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	txData, err := packSubmitBatch(currentValset, batch, confirms)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, err
+	}
+
+	cost, err := s.EstimateTxCost(ctx, s.peggyAddress, txData)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, errors.Wrap(err, "failed to estimate batch submission cost")
+	}
+
+	return cost, nil
+}
+
+func packSubmitBatch(
+	currentValset *peggytypes.Valset,
+	batch *peggytypes.OutgoingTxBatch,
+	confirms []*peggytypes.MsgConfirmBatch,
+) ([]byte, error) {
+	validators, powers, sigV, sigR, sigS, err := checkBatchSigsAndRepack(currentValset, confirms)
+	if err != nil {
+		err = errors.Wrap(err, "confirmations check failed")
+		return nil, err
+	}
+
+	amounts, destinations, fees := getBatchCheckpointValues(batch)
+	currentValsetNonce := new(big.Int).SetUint64(currentValset.Nonce)
+	batchNonce := new(big.Int).SetUint64(batch.BatchNonce)
+	batchTimeout := new(big.Int).SetUint64(batch.BatchTimeout)
+
+	// Solidity function signature
+	// function submitBatch(
+	// 		// The validators that approve the batch and new valset
+	// 		address[] memory _currentValidators,
+	// 		uint256[] memory _currentPowers,
+	// 		uint256 _currentValsetNonce,
+	//
+	// 		// These are arrays of the parts of the validators signatures
+	// 		uint8[] memory _v,
+	// 		bytes32[] memory _r,
+	// 		bytes32[] memory _s,
+	//
+	// 		// The batch of transactions
+	// 		uint256[] memory _amounts,
+	// 		address[] memory _destinations,
+	// 		uint256[] memory _fees,
+	// 		uint256 _batchNonce,
+	// 		address _tokenContract
+	// )
+
+	currentValsetArs := ValsetArgs{
+		Validators:   validators,
+		Powers:       powers,
+		ValsetNonce:  currentValsetNonce,
+		RewardAmount: currentValset.RewardAmount.BigInt(),
+		RewardToken:  common.HexToAddress(currentValset.RewardToken),
+	}
+
+	txData, err := peggyABI.Pack("submitBatch",
+		currentValsetArs,
+		sigV, sigR, sigS,
+		amounts,
+		destinations,
+		fees,
+		batchNonce,
+		common.HexToAddress(batch.TokenContract),
+		batchTimeout,
+	)
+	if err != nil {
+		log.WithError(err).Errorln("ABI Pack (Peggy submitBatch) method")
+		return nil, err
+	}
+
+	return txData, nil
+}
+
 func getBatchCheckpointValues(batch *peggytypes.OutgoingTxBatch) (amounts []*big.Int, destinations []common.Address, fees []*big.Int) {
 	amounts = make([]*big.Int, len(batch.Transactions))
 	destinations = make([]common.Address, len(batch.Transactions))
@@ -0,0 +1,152 @@
+package loops
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownLoop is returned when pausing or resuming a loop that was never registered.
+var ErrUnknownLoop = errors.New("unknown loop")
+
+// LoopStatus is a snapshot of a tracked loop.
+type LoopStatus struct {
+	Name        string        `json:"name"`
+	Interval    time.Duration `json:"interval"`
+	Paused      bool          `json:"paused"`
+	LastRun     time.Time     `json:"last_run"`
+	LastSuccess time.Time     `json:"last_success"`
+	LastError   string        `json:"last_error,omitempty"`
+	Errors      uint64        `json:"errors"`
+}
+
+// Healthy reports whether the loop succeeded recently enough. Paused loops are always
+// considered healthy, since they were stopped on purpose.
+func (s LoopStatus) Healthy(now time.Time) bool {
+	if s.Paused {
+		return true
+	}
+
+	if s.LastSuccess.IsZero() {
+		return false
+	}
+
+	// allow a few slow or failed (and retried) iterations before reporting
+	return now.Sub(s.LastSuccess) <= 3*s.Interval
+}
+
+// Tracker records the outcome of loop iterations and allows loops to be paused.
+type Tracker struct {
+	mx    sync.RWMutex
+	loops map[string]*LoopStatus
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		loops: make(map[string]*LoopStatus),
+	}
+}
+
+// Track registers the loop and wraps its iteration function. The returned function skips
+// the iteration while the loop is paused and records the outcome otherwise.
+func (t *Tracker) Track(name string, interval time.Duration, fn func() error) func() error {
+	t.mx.Lock()
+	t.loops[name] = &LoopStatus{
+		Name:     name,
+		Interval: interval,
+	}
+	t.mx.Unlock()
+
+	return func() error {
+		if t.isPaused(name) {
+			return nil
+		}
+
+		err := fn()
+		t.record(name, err)
+
+		return err
+	}
+}
+
+func (t *Tracker) Pause(name string) error {
+	return t.setPaused(name, true)
+}
+
+func (t *Tracker) Resume(name string) error {
+	return t.setPaused(name, false)
+}
+
+// Status returns the status of every tracked loop, sorted by name.
+func (t *Tracker) Status() []LoopStatus {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	statuses := make([]LoopStatus, 0, len(t.loops))
+	for _, s := range t.loops {
+		statuses = append(statuses, *s)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+// Ready reports whether loops are being tracked and all of them are healthy.
+func (t *Tracker) Ready() bool {
+	statuses := t.Status()
+	if len(statuses) == 0 {
+		return false
+	}
+
+	now := time.Now()
+	for _, s := range statuses {
+		if !s.Healthy(now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *Tracker) isPaused(name string) bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.loops[name].Paused
+}
+
+func (t *Tracker) setPaused(name string, paused bool) error {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	s, ok := t.loops[name]
+	if !ok {
+		return errors.Wrap(ErrUnknownLoop, name)
+	}
+
+	s.Paused = paused
+
+	return nil
+}
+
+func (t *Tracker) record(name string, err error) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	s := t.loops[name]
+	s.LastRun = time.Now()
+
+	if err != nil && err != ErrGracefulStop {
+		s.LastError = err.Error()
+		s.Errors++
+		return
+	}
+
+	s.LastSuccess = s.LastRun
+	s.LastError = ""
+}
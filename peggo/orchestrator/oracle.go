@@ -10,6 +10,7 @@ import (
 
 	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
 	peggyevents "github.com/InjectiveLabs/injective-core/peggo/solidity/wrappers/Peggy"
 	"github.com/InjectiveLabs/metrics"
 )
@@ -37,9 +38,9 @@ func (s *Orchestrator) runOracle(ctx context.Context, lastObservedBlock uint64)
 
 	s.logger.WithField("loop_duration", s.cfg.LoopDuration.String()).Debugln("starting Oracle...")
 
-	return loops.RunLoop(ctx, s.cfg.LoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.LoopDuration, s.tracker.Track(LoopOracle, s.cfg.LoopDuration, func() error {
 		return oracle.observeEthEvents(ctx)
-	})
+	}))
 }
 
 type oracle struct {
@@ -125,7 +126,7 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 			"eth_block_end":            latestHeight,
 		}).Infoln("no new events on Ethereum")
 
-		l.lastRecordedEthEventHeight = latestHeight
+		l.setLastRecordedEthEventHeight(latestHeight)
 		l.resetQueryRange()
 
 		return nil
@@ -143,7 +144,7 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 			"last_claimed_event_nonce": lastClaim.EthereumEventNonce,
 		}).Debugln("orchestrator missed an Ethereum event. Restarting block search from last claim...")
 
-		l.lastRecordedEthEventHeight = lastClaim.EthereumEventHeight
+		l.setLastRecordedEthEventHeight(lastClaim.EthereumEventHeight)
 
 		return nil
 	}
@@ -159,12 +160,21 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 	}).Infoln("sent new event claims to Injective")
 
 	lastEvent := newEvents[len(newEvents)-1]
-	l.lastRecordedEthEventHeight = lastEvent.BlockHeight()
+	l.setLastRecordedEthEventHeight(lastEvent.BlockHeight())
 	l.resetQueryRange()
 
 	return nil
 }
 
+// setLastRecordedEthEventHeight also persists the height, so that a restart resumes from it.
+func (l *oracle) setLastRecordedEthEventHeight(height uint64) {
+	l.lastRecordedEthEventHeight = height
+
+	if err := l.store.SetLastObservedEthBlock(height); err != nil {
+		l.Log().WithError(err).Warningln("failed to persist last observed Ethereum block")
+	}
+}
+
 func (l *oracle) resetQueryRange() {
 	if l.queryRange < defaultBlocksToSearch {
 		l.queryRange *= 2
@@ -282,7 +292,19 @@ func (l *oracle) sendNewEventClaims(ctx context.Context, events []event) error {
 			return err
 		}
 
-		newEvents := filterEvents(events, lastClaim.EthereumEventNonce)
+		lastClaimedNonce := lastClaim.EthereumEventNonce
+
+		// skip claims that were sent moments ago and are yet to be included
+		localClaim, err := l.store.LastClaim()
+		if err != nil {
+			return err
+		}
+
+		if localClaim != nil && localClaim.EventNonce > lastClaimedNonce && time.Since(localClaim.SubmittedAt) < claimInclusionTimeout {
+			lastClaimedNonce = localClaim.EventNonce
+		}
+
+		newEvents := filterEvents(events, lastClaimedNonce)
 		if len(newEvents) == 0 {
 			return nil
 		}
@@ -292,6 +314,16 @@ func (l *oracle) sendNewEventClaims(ctx context.Context, events []event) error {
 				return err
 			}
 
+			claim := store.Claim{
+				EventNonce:     event.Nonce(),
+				EthBlockHeight: event.BlockHeight(),
+				SubmittedAt:    time.Now(),
+			}
+
+			if err := l.store.SetClaim(claim); err != nil {
+				l.Log().WithError(err).Warningln("failed to persist submitted claim")
+			}
+
 			// Considering block time ~1s on Injective chain, adding Sleep to make sure new event is sent
 			// only after previous event is executed successfully. Otherwise it will through `non contiguous event nonce` failing CheckTx.
 			time.Sleep(1100 * time.Millisecond)
@@ -12,6 +12,7 @@ import (
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
 	"github.com/InjectiveLabs/metrics"
 )
 
@@ -32,6 +33,15 @@ type Config struct {
 	RelayValsets         bool
 	RelayBatches         bool
 	RelayerOnlyMode      bool
+
+	// RelayBatchProfitabilityCheck makes the relayer skip batches whose fees
+	// do not cover the projected gas cost. ETH is priced using EthPriceToken.
+	RelayBatchProfitabilityCheck bool
+	EthPriceToken                gethcommon.Address
+
+	// RelayResubmitDelay is how long the relayer waits for a submitted
+	// valset/batch to land on Ethereum before submitting it again.
+	RelayResubmitDelay time.Duration
 }
 
 type Orchestrator struct {
@@ -43,12 +53,15 @@ type Orchestrator struct {
 	injective cosmos.Network
 	ethereum  ethereum.Network
 	priceFeed PriceFeed
+	store     *store.Store
+	tracker   *loops.Tracker
 }
 
 func NewOrchestrator(
 	inj cosmos.Network,
 	eth ethereum.Network,
 	priceFeed PriceFeed,
+	stateStore *store.Store,
 	cfg Config,
 ) (*Orchestrator, error) {
 	o := &Orchestrator{
@@ -57,6 +70,8 @@ func NewOrchestrator(
 		injective:   inj,
 		ethereum:    eth,
 		priceFeed:   priceFeed,
+		store:       stateStore,
+		tracker:     loops.NewTracker(),
 		cfg:         cfg,
 		maxAttempts: 10,
 	}
@@ -79,7 +94,12 @@ func (s *Orchestrator) Run(ctx context.Context, inj cosmos.Network, eth ethereum
 func (s *Orchestrator) startValidatorMode(ctx context.Context, inj cosmos.Network, eth ethereum.Network) error {
 	log.Infoln("running orchestrator in validator mode")
 
-	lastObservedEthBlock, _ := s.getLastClaimBlockHeight(ctx, inj)
+	lastObservedEthBlock, err := s.resumeEthBlock(ctx, inj)
+	if err != nil {
+		s.logger.WithError(err).Warningln("unable to resume from local state, falling back to last claim on Injective")
+		lastObservedEthBlock, _ = s.getLastClaimBlockHeight(ctx, inj)
+	}
+
 	if lastObservedEthBlock == 0 {
 		peggyParams, err := inj.PeggyParams(ctx)
 		if err != nil {
@@ -89,6 +109,8 @@ func (s *Orchestrator) startValidatorMode(ctx context.Context, inj cosmos.Networ
 		lastObservedEthBlock = peggyParams.BridgeContractStartHeight
 	}
 
+	s.reconcileRelays(ctx, eth)
+
 	// get peggy ID from contract
 	peggyContractID, err := eth.GetPeggyID(ctx)
 	if err != nil {
@@ -111,6 +133,8 @@ func (s *Orchestrator) startValidatorMode(ctx context.Context, inj cosmos.Networ
 func (s *Orchestrator) startRelayerMode(ctx context.Context, inj cosmos.Network, eth ethereum.Network) error {
 	log.Infoln("running orchestrator in relayer mode")
 
+	s.reconcileRelays(ctx, eth)
+
 	var pg loops.ParanoidGroup
 
 	pg.Go(func() error { return s.runBatchCreator(ctx) })
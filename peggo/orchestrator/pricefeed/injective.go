@@ -0,0 +1,103 @@
+package pricefeed
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/InjectiveLabs/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/oracle"
+)
+
+const maxOracleQueryTime = 10 * time.Second
+
+// OracleSource points a token to a price stored in Injective's oracle module. For the
+// Provider oracle type, Base is the provider name and Quote is the provider symbol.
+type OracleSource struct {
+	OracleType oracletypes.OracleType
+	Base       string
+	Quote      string
+}
+
+// InjectivePriceFeed reads token prices from the chain's own oracle module (Pyth, Stork,
+// Provider, PriceFeed, etc.) over gRPC instead of relying on a third-party API.
+type InjectivePriceFeed struct {
+	client  oracle.QueryClient
+	sources map[common.Address]OracleSource
+
+	logger  log.Logger
+	svcTags metrics.Tags
+}
+
+func NewInjectivePriceFeed(client oracle.QueryClient, sources map[common.Address]OracleSource) *InjectivePriceFeed {
+	if sources == nil {
+		sources = make(map[common.Address]OracleSource)
+	}
+
+	return &InjectivePriceFeed{
+		client:  client,
+		sources: sources,
+
+		logger: log.WithFields(log.Fields{
+			"svc":      "oracle",
+			"provider": "injective",
+		}),
+		svcTags: metrics.Tags{
+			"provider": "injective",
+		},
+	}
+}
+
+// SetSource sets the oracle price used for the given token.
+func (ip *InjectivePriceFeed) SetSource(erc20Contract common.Address, source OracleSource) {
+	ip.sources[erc20Contract] = source
+}
+
+func (ip *InjectivePriceFeed) QueryUSDPrice(erc20Contract common.Address) (float64, error) {
+	metrics.ReportFuncCall(ip.svcTags)
+	doneFn := metrics.ReportFuncTiming(ip.svcTags)
+	defer doneFn()
+
+	source, ok := ip.sources[erc20Contract]
+	if !ok {
+		metrics.ReportFuncError(ip.svcTags)
+		return zeroPrice, errors.Errorf("no oracle source configured for token %s", erc20Contract.String())
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), maxOracleQueryTime)
+	defer cancelFn()
+
+	var (
+		price sdkmath.LegacyDec
+		err   error
+	)
+
+	if source.OracleType == oracletypes.OracleType_Provider {
+		price, err = ip.client.ProviderPrice(ctx, source.Base, source.Quote)
+	} else {
+		price, err = ip.client.OraclePrice(ctx, source.OracleType, source.Base, source.Quote)
+	}
+
+	if err != nil {
+		metrics.ReportFuncError(ip.svcTags)
+		return zeroPrice, errors.Wrapf(err, "failed to fetch %s price for %s/%s", source.OracleType.String(), source.Base, source.Quote)
+	}
+
+	if !price.IsPositive() {
+		metrics.ReportFuncError(ip.svcTags)
+		return zeroPrice, errors.Errorf("invalid %s price for %s/%s: %s", source.OracleType.String(), source.Base, source.Quote, price.String())
+	}
+
+	priceUSD, err := price.Float64()
+	if err != nil {
+		metrics.ReportFuncError(ip.svcTags)
+		return zeroPrice, errors.Wrap(err, "failed to convert oracle price")
+	}
+
+	return priceUSD, nil
+}
@@ -0,0 +1,145 @@
+package pricefeed
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+type Source string
+
+const (
+	SourceCoingecko Source = "coingecko"
+	SourceInjective Source = "oracle"
+	SourceStatic    Source = "static"
+)
+
+// USDPriceFeed is implemented by every price source.
+type USDPriceFeed interface {
+	QueryUSDPrice(erc20Contract common.Address) (float64, error)
+}
+
+// MultiPriceFeed routes price queries to a per-token source, falling back to a default one.
+type MultiPriceFeed struct {
+	defaultFeed USDPriceFeed
+	feeds       map[common.Address]USDPriceFeed
+}
+
+func NewMultiPriceFeed(defaultFeed USDPriceFeed) *MultiPriceFeed {
+	return &MultiPriceFeed{
+		defaultFeed: defaultFeed,
+		feeds:       make(map[common.Address]USDPriceFeed),
+	}
+}
+
+// SetFeed makes the given token use the given price source.
+func (mp *MultiPriceFeed) SetFeed(erc20Contract common.Address, feed USDPriceFeed) {
+	mp.feeds[erc20Contract] = feed
+}
+
+func (mp *MultiPriceFeed) QueryUSDPrice(erc20Contract common.Address) (float64, error) {
+	if feed, ok := mp.feeds[erc20Contract]; ok {
+		return feed.QueryUSDPrice(erc20Contract)
+	}
+
+	if mp.defaultFeed == nil {
+		return zeroPrice, errors.Errorf("no price source configured for token %s", erc20Contract.String())
+	}
+
+	return mp.defaultFeed.QueryUSDPrice(erc20Contract)
+}
+
+// TokenSource is a parsed per-token price source entry.
+type TokenSource struct {
+	Token  common.Address
+	Source Source
+	Static float64
+	Oracle OracleSource
+}
+
+// ParseTokenSources parses a comma-separated list of per-token price sources:
+//
+//	<token>=coingecko
+//	<token>=static:<usd price>
+//	<token>=oracle:<oracle type>:<base>[:<quote>]   (quote defaults to USD)
+//	<token>=oracle:provider:<provider>:<symbol>
+func ParseTokenSources(spec string) ([]TokenSource, error) {
+	var sources []TokenSource
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		token, sourceSpec, ok := strings.Cut(entry, "=")
+		if !ok || !common.IsHexAddress(token) {
+			return nil, errors.Errorf("invalid price source entry: %s", entry)
+		}
+
+		src, err := parseTokenSource(sourceSpec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid price source for token %s", token)
+		}
+
+		src.Token = common.HexToAddress(token)
+		sources = append(sources, src)
+	}
+
+	return sources, nil
+}
+
+func parseTokenSource(spec string) (TokenSource, error) {
+	parts := strings.Split(spec, ":")
+
+	switch Source(parts[0]) {
+	case SourceCoingecko:
+		if len(parts) != 1 {
+			return TokenSource{}, errors.New("coingecko source takes no arguments")
+		}
+
+		return TokenSource{Source: SourceCoingecko}, nil
+	case SourceStatic:
+		if len(parts) != 2 {
+			return TokenSource{}, errors.New("static source must be static:<usd price>")
+		}
+
+		price, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || price <= 0 {
+			return TokenSource{}, errors.Errorf("invalid static price: %s", parts[1])
+		}
+
+		return TokenSource{Source: SourceStatic, Static: price}, nil
+	case SourceInjective:
+		if len(parts) != 3 && len(parts) != 4 {
+			return TokenSource{}, errors.New("oracle source must be oracle:<oracle type>:<base>[:<quote>]")
+		}
+
+		oracleType, err := oracletypes.GetOracleType(parts[1])
+		if err != nil {
+			return TokenSource{}, err
+		}
+
+		quote := oracletypes.QuoteUSD
+		if len(parts) == 4 {
+			quote = parts[3]
+		} else if oracleType == oracletypes.OracleType_Provider {
+			return TokenSource{}, errors.New("provider oracle source must be oracle:provider:<provider>:<symbol>")
+		}
+
+		return TokenSource{
+			Source: SourceInjective,
+			Oracle: OracleSource{
+				OracleType: oracleType,
+				Base:       parts[2],
+				Quote:      quote,
+			},
+		}, nil
+	}
+
+	return TokenSource{}, errors.Errorf("unknown price source: %s", parts[0])
+}
@@ -0,0 +1,36 @@
+package pricefeed
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// StaticPriceFeed returns fixed USD prices configured by the operator. It is useful for
+// stablecoins and for tokens that have no reliable market price.
+type StaticPriceFeed struct {
+	prices map[common.Address]float64
+}
+
+func NewStaticPriceFeed(prices map[common.Address]float64) *StaticPriceFeed {
+	if prices == nil {
+		prices = make(map[common.Address]float64)
+	}
+
+	return &StaticPriceFeed{
+		prices: prices,
+	}
+}
+
+// SetPrice sets the fixed USD price for the given token.
+func (sp *StaticPriceFeed) SetPrice(erc20Contract common.Address, priceUSD float64) {
+	sp.prices[erc20Contract] = priceUSD
+}
+
+func (sp *StaticPriceFeed) QueryUSDPrice(erc20Contract common.Address) (float64, error) {
+	price, ok := sp.prices[erc20Contract]
+	if !ok {
+		return zeroPrice, errors.Errorf("no static price configured for token %s", erc20Contract.String())
+	}
+
+	return price, nil
+}
@@ -14,6 +14,7 @@ import (
 	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/util"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
 	peggyevents "github.com/InjectiveLabs/injective-core/peggo/solidity/wrappers/Peggy"
 	"github.com/InjectiveLabs/metrics"
 )
@@ -30,9 +31,9 @@ func (s *Orchestrator) runRelayer(ctx context.Context) error {
 	r := relayer{Orchestrator: s}
 	s.logger.WithFields(log.Fields{"loop_duration": s.cfg.RelayerLoopDuration.String(), "relay_token_batches": r.cfg.RelayBatches, "relay_validator_sets": s.cfg.RelayValsets}).Debugln("starting Relayer...")
 
-	return loops.RunLoop(ctx, s.cfg.RelayerLoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.RelayerLoopDuration, s.tracker.Track(LoopRelayer, s.cfg.RelayerLoopDuration, func() error {
 		return r.relay(ctx)
-	})
+	}))
 }
 
 type relayer struct {
@@ -53,6 +54,8 @@ func (l *relayer) relay(ctx context.Context) error {
 		return errors.Wrap(err, "failed to get latest eth valset")
 	}
 
+	l.reconcileRelays(ctx, l.ethereum)
+
 	var pg loops.ParanoidGroup
 
 	if l.cfg.RelayValsets {
@@ -143,11 +146,17 @@ func (l *relayer) relayValset(ctx context.Context, latestEthValset *peggytypes.V
 		return nil
 	}
 
+	if l.isRelayPending(store.RelayValset, gethcommon.Address{}, latestConfirmedValset.Nonce) {
+		return nil
+	}
+
 	txHash, err := l.ethereum.SendEthValsetUpdate(ctx, latestEthValset, latestConfirmedValset, confirmations)
 	if err != nil {
 		return err
 	}
 
+	l.recordRelay(store.RelayValset, gethcommon.Address{}, latestConfirmedValset.Nonce, *txHash)
+
 	l.Log().WithField("tx_hash", txHash.Hex()).Infoln("sent validator set update to Ethereum")
 
 	return nil
@@ -212,7 +221,12 @@ func (l *relayer) relayTokenBatch(ctx context.Context, latestEthValset *peggytyp
 
 	for _, batch := range filtered {
 		if l.shouldRelayBatch(ctx, batch) {
-			sigs, err := l.injective.TransactionBatchSignatures(ctx, batch.BatchNonce, gethcommon.HexToAddress(batch.TokenContract))
+			tokenContract := gethcommon.HexToAddress(batch.TokenContract)
+			if l.isRelayPending(store.RelayBatch, tokenContract, batch.BatchNonce) {
+				continue
+			}
+
+			sigs, err := l.injective.TransactionBatchSignatures(ctx, batch.BatchNonce, tokenContract)
 			if err != nil {
 				l.Log().WithError(err).WithField("batch_nonce", batch.BatchNonce).Warningln("failed to get transaction batch signatures")
 				continue
@@ -222,6 +236,10 @@ func (l *relayer) relayTokenBatch(ctx context.Context, latestEthValset *peggytyp
 				continue
 			}
 
+			if l.cfg.RelayBatchProfitabilityCheck && !l.isBatchProfitable(ctx, latestEthValset, batch, sigs) {
+				continue
+			}
+
 			txHash, err := l.ethereum.SendTransactionBatch(ctx, latestEthValset, batch, sigs)
 			if err != nil {
 				// we try to move on the next batch
@@ -229,6 +247,8 @@ func (l *relayer) relayTokenBatch(ctx context.Context, latestEthValset *peggytyp
 				continue
 			}
 
+			l.recordRelay(store.RelayBatch, tokenContract, batch.BatchNonce, *txHash)
+
 			l.Log().WithField("tx_hash", txHash.Hex()).Infoln("sent outgoing tx batch to Ethereum")
 
 			// a batch was sent successfully, return early
@@ -272,30 +292,13 @@ func (l *relayer) shouldRelayBatch(ctx context.Context, batch *peggytypes.Outgoi
 	}
 
 	if l.cfg.MinBatchFeeUSD != 0 {
-		fees := sdkmath.ZeroInt()
-		for _, tx := range batch.Transactions {
-			fees = fees.Add(tx.Erc20Fee.Amount)
-		}
-
-		price, err := l.priceFeed.QueryUSDPrice(gethcommon.HexToAddress(batch.TokenContract))
-		if err != nil {
-			l.Log().WithError(err).Warningln("failed to query USD price")
-			return false
-
-		}
-
-		tokenDecimals, err := l.ethereum.TokenDecimals(ctx, gethcommon.HexToAddress(batch.TokenContract))
+		totalFee, err := l.batchFeesUSD(ctx, batch)
 		if err != nil {
-			l.Log().WithError(err).Warningln("failed to get token decimals")
+			l.Log().WithError(err).Warningln("failed to get batch fees in USD")
 			return false
 		}
 
-		var (
-			minFeeUSD = decimal.NewFromFloat(l.cfg.MinBatchFeeUSD)
-			priceUSD  = decimal.NewFromFloat(price)
-			totalFee  = decimal.NewFromBigInt(fees.BigInt(), -1*int32(tokenDecimals)).Mul(priceUSD)
-		)
-
+		minFeeUSD := decimal.NewFromFloat(l.cfg.MinBatchFeeUSD)
 		if totalFee.LessThan(minFeeUSD) {
 			l.Log().WithFields(log.Fields{
 				"batch_nonce": batch.BatchNonce,
@@ -309,6 +312,66 @@ func (l *relayer) shouldRelayBatch(ctx context.Context, batch *peggytypes.Outgoi
 	return true
 }
 
+// isBatchProfitable compares the batch fees to the projected gas cost of submitting it to Ethereum.
+func (l *relayer) isBatchProfitable(
+	ctx context.Context,
+	latestEthValset *peggytypes.Valset,
+	batch *peggytypes.OutgoingTxBatch,
+	sigs []*peggytypes.MsgConfirmBatch,
+) bool {
+	totalFee, err := l.batchFeesUSD(ctx, batch)
+	if err != nil {
+		l.Log().WithError(err).Warningln("failed to get batch fees in USD")
+		return false
+	}
+
+	gasCost, err := l.ethereum.EstimateTransactionBatchCost(ctx, latestEthValset, batch, sigs)
+	if err != nil {
+		l.Log().WithError(err).Warningln("failed to estimate batch gas cost")
+		return false
+	}
+
+	ethPrice, err := l.priceFeed.QueryUSDPrice(l.cfg.EthPriceToken)
+	if err != nil {
+		l.Log().WithError(err).Warningln("failed to query ETH USD price")
+		return false
+	}
+
+	gasCostUSD := decimal.NewFromBigInt(gasCost, -18).Mul(decimal.NewFromFloat(ethPrice))
+
+	if totalFee.LessThan(gasCostUSD) {
+		l.Log().WithFields(log.Fields{
+			"batch_nonce": batch.BatchNonce,
+			"gas_cost":    gasCostUSD.String(),
+			"total_fees":  totalFee.String(),
+		}).Debugln("skipping unprofitable batch")
+		return false
+	}
+
+	return true
+}
+
+func (l *relayer) batchFeesUSD(ctx context.Context, batch *peggytypes.OutgoingTxBatch) (decimal.Decimal, error) {
+	fees := sdkmath.ZeroInt()
+	for _, tx := range batch.Transactions {
+		fees = fees.Add(tx.Erc20Fee.Amount)
+	}
+
+	price, err := l.priceFeed.QueryUSDPrice(gethcommon.HexToAddress(batch.TokenContract))
+	if err != nil {
+		return decimal.Zero, errors.Wrap(err, "failed to query USD price")
+	}
+
+	tokenDecimals, err := l.ethereum.TokenDecimals(ctx, gethcommon.HexToAddress(batch.TokenContract))
+	if err != nil {
+		return decimal.Zero, errors.Wrap(err, "failed to get token decimals")
+	}
+
+	priceUSD := decimal.NewFromFloat(price)
+
+	return decimal.NewFromBigInt(fees.BigInt(), -1*int32(tokenDecimals)).Mul(priceUSD), nil
+}
+
 // FindLatestValset finds the latest valset on the Peggy contract by looking back through the event
 // history and finding the most recent ValsetUpdatedEvent. Most of the time this will be very fast
 // as the latest update will be in recent blockchain history and the search moves from the present
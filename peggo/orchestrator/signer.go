@@ -22,9 +22,9 @@ func (s *Orchestrator) runSigner(ctx context.Context, peggyID gethcommon.Hash) e
 
 	s.logger.WithField("loop_duration", s.cfg.LoopDuration.String()).Debugln("starting Signer...")
 
-	return loops.RunLoop(ctx, s.cfg.LoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.LoopDuration, s.tracker.Track(LoopSigner, s.cfg.LoopDuration, func() error {
 		return signer.sign(ctx)
-	})
+	}))
 }
 
 type signer struct {
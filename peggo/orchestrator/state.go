@@ -0,0 +1,169 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/store"
+)
+
+// Claims sent shortly before a restart may still be waiting in the mempool,
+// so they are not sent again until this much time has passed.
+const claimInclusionTimeout = 30 * time.Second
+
+// resumeEthBlock returns the Ethereum block from which the oracle resumes scanning. The locally stored
+// block is used as long as it is consistent with the claims observed on Injective, which avoids
+// re-scanning every block since our last claim.
+func (s *Orchestrator) resumeEthBlock(ctx context.Context, inj cosmos.Network) (uint64, error) {
+	lastClaim, err := inj.LastClaimEventByAddr(ctx, s.cfg.CosmosAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	localBlock, err := s.store.LastObservedEthBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	localClaim, err := s.store.LastClaim()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.store.PruneClaims(lastClaim.EthereumEventNonce); err != nil {
+		return 0, err
+	}
+
+	logger := s.logger.WithFields(log.Fields{
+		"local_eth_block":          localBlock,
+		"last_claimed_eth_block":   lastClaim.EthereumEventHeight,
+		"last_claimed_event_nonce": lastClaim.EthereumEventNonce,
+	})
+
+	switch {
+	case localBlock == 0:
+		return lastClaim.EthereumEventHeight, nil
+	case localClaim != nil && localClaim.EventNonce > lastClaim.EthereumEventNonce:
+		logger.WithField("local_event_nonce", localClaim.EventNonce).Warningln("some submitted claims did not reach Injective, resuming from last claim")
+		return lastClaim.EthereumEventHeight, nil
+	case localBlock < lastClaim.EthereumEventHeight:
+		return lastClaim.EthereumEventHeight, nil
+	}
+
+	logger.Infoln("resuming Ethereum event scan from local state")
+
+	return localBlock, nil
+}
+
+// reconcileRelays drops the submitted valsets/batches that have been executed on Ethereum. The latest
+// valset nonce and the latest batch nonce of each token are fetched once and compared against all the relays.
+func (s *Orchestrator) reconcileRelays(ctx context.Context, eth ethereum.Network) {
+	relays, err := s.store.Relays()
+	if err != nil {
+		s.logger.WithError(err).Warningln("failed to load submitted relays")
+		return
+	}
+
+	if len(relays) == 0 {
+		return
+	}
+
+	var (
+		latestValsetNonce  uint64
+		valsetNonceFetched bool
+		valsetNonceFailed  bool
+	)
+
+	latestBatchNonces := make(map[gethcommon.Address]uint64)
+	failedBatchTokens := make(map[gethcommon.Address]struct{})
+
+	for _, relay := range relays {
+		var executed bool
+		switch relay.Kind {
+		case store.RelayValset:
+			if valsetNonceFailed {
+				continue
+			}
+
+			if !valsetNonceFetched {
+				nonce, err := eth.GetValsetNonce(ctx)
+				if err != nil {
+					s.logger.WithError(err).Warningln("failed to get latest valset nonce from Ethereum")
+					valsetNonceFailed = true
+					continue
+				}
+
+				latestValsetNonce, valsetNonceFetched = nonce.Uint64(), true
+			}
+
+			executed = relay.Nonce <= latestValsetNonce
+		case store.RelayBatch:
+			if _, failed := failedBatchTokens[relay.TokenContract]; failed {
+				continue
+			}
+
+			latestNonce, ok := latestBatchNonces[relay.TokenContract]
+			if !ok {
+				nonce, err := eth.GetTxBatchNonce(ctx, relay.TokenContract)
+				if err != nil {
+					s.logger.WithError(err).WithField("batch_token", relay.TokenContract.Hex()).Warningln("failed to get latest batch nonce from Ethereum")
+					failedBatchTokens[relay.TokenContract] = struct{}{}
+					continue
+				}
+
+				latestNonce = nonce.Uint64()
+				latestBatchNonces[relay.TokenContract] = latestNonce
+			}
+
+			executed = relay.Nonce <= latestNonce
+		}
+
+		if !executed {
+			continue
+		}
+
+		if err := s.store.DeleteRelay(relay); err != nil {
+			s.logger.WithError(err).Warningln("failed to delete submitted relay")
+		}
+	}
+}
+
+// isRelayPending reports whether the valset/batch was submitted recently and might still land on Ethereum.
+func (s *Orchestrator) isRelayPending(kind store.RelayKind, tokenContract gethcommon.Address, nonce uint64) bool {
+	relay, err := s.store.Relay(kind, tokenContract, nonce)
+	if err != nil {
+		s.logger.WithError(err).Warningln("failed to load submitted relay")
+		return false
+	}
+
+	if relay == nil || time.Since(relay.SentAt) >= s.cfg.RelayResubmitDelay {
+		return false
+	}
+
+	s.logger.WithFields(log.Fields{
+		"kind":    relay.Kind,
+		"nonce":   relay.Nonce,
+		"tx_hash": relay.TxHash.Hex(),
+	}).Debugln("already submitted to Ethereum, waiting for it to be executed")
+
+	return true
+}
+
+func (s *Orchestrator) recordRelay(kind store.RelayKind, tokenContract gethcommon.Address, nonce uint64, txHash gethcommon.Hash) {
+	relay := store.Relay{
+		Kind:          kind,
+		Nonce:         nonce,
+		TokenContract: tokenContract,
+		TxHash:        txHash,
+		SentAt:        time.Now(),
+	}
+
+	if err := s.store.SetRelay(relay); err != nil {
+		s.logger.WithError(err).Warningln("failed to persist submitted relay")
+	}
+}
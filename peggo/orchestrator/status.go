@@ -0,0 +1,128 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
+)
+
+// Names of the orchestrator loops, as used by the status API.
+const (
+	LoopOracle       = "oracle"
+	LoopSigner       = "signer"
+	LoopBatchCreator = "batch_creator"
+	LoopRelayer      = "relayer"
+)
+
+const injDenom = "inj"
+
+// Status is a snapshot of the orchestrator state, exposed to operators.
+type Status struct {
+	Mode  string             `json:"mode"`
+	Ready bool               `json:"ready"`
+	Loops []loops.LoopStatus `json:"loops"`
+
+	// MissingNonces lists the bonded validators that have not submitted any Ethereum event claim
+	MissingNonces []string `json:"missing_nonces"`
+	// ValidatorMissingNonces is true when our own validator is among them
+	ValidatorMissingNonces bool `json:"validator_missing_nonces"`
+
+	InjectiveBalance decimal.Decimal `json:"injective_balance"`
+	EthereumBalance  decimal.Decimal `json:"ethereum_balance"`
+
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Ready reports whether every running loop has completed an iteration recently.
+func (s *Orchestrator) Ready() bool {
+	return s.tracker.Ready()
+}
+
+func (s *Orchestrator) Loops() []loops.LoopStatus {
+	return s.tracker.Status()
+}
+
+// PauseLoop stops the loop from running any further iterations until it is resumed.
+func (s *Orchestrator) PauseLoop(name string) error {
+	if err := s.tracker.Pause(name); err != nil {
+		return err
+	}
+
+	s.logger.WithField("loop", name).Warningln("loop paused")
+
+	return nil
+}
+
+func (s *Orchestrator) ResumeLoop(name string) error {
+	if err := s.tracker.Resume(name); err != nil {
+		return err
+	}
+
+	s.logger.WithField("loop", name).Infoln("loop resumed")
+
+	return nil
+}
+
+// Status collects the current state of the orchestrator. Failed queries are reported in Status.Errors.
+func (s *Orchestrator) Status(ctx context.Context) *Status {
+	status := &Status{
+		Mode:  "validator",
+		Ready: s.tracker.Ready(),
+		Loops: s.tracker.Status(),
+	}
+
+	if s.cfg.RelayerOnlyMode {
+		status.Mode = "relayer"
+	}
+
+	if missing, err := s.injective.MissingPeggoNonces(ctx); err != nil {
+		status.Errors = append(status.Errors, err.Error())
+	} else {
+		status.MissingNonces = missing
+		if !s.cfg.RelayerOnlyMode {
+			status.ValidatorMissingNonces = s.isValidatorMissingNonces(ctx, missing)
+		}
+	}
+
+	if balance, err := s.injective.Balance(ctx, s.cfg.CosmosAddr, injDenom); err != nil {
+		status.Errors = append(status.Errors, err.Error())
+	} else {
+		status.InjectiveBalance = decimal.NewFromBigInt(balance.Amount.BigInt(), -18)
+	}
+
+	if balance, err := s.ethereum.GetBalance(ctx, s.cfg.EthereumAddr); err != nil {
+		status.Errors = append(status.Errors, err.Error())
+	} else {
+		status.EthereumBalance = decimal.NewFromBigInt(balance, -18)
+	}
+
+	return status
+}
+
+func (s *Orchestrator) isValidatorMissingNonces(ctx context.Context, operators []string) bool {
+	if len(operators) == 0 {
+		return false
+	}
+
+	validator, err := s.injective.GetValidatorAddress(ctx, s.cfg.EthereumAddr)
+	if err != nil {
+		return false
+	}
+
+	for _, op := range operators {
+		valAddr, err := cosmostypes.ValAddressFromBech32(op)
+		if err != nil {
+			continue
+		}
+
+		if bytes.Equal(valAddr.Bytes(), validator.Bytes()) {
+			return true
+		}
+	}
+
+	return false
+}
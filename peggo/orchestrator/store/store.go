@@ -0,0 +1,290 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"os"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+const dbName = "peggo"
+
+var (
+	lastObservedEthBlockKey = []byte{0x01}
+	claimsPrefix            = []byte{0x02}
+	relaysPrefix            = []byte{0x03}
+	pendingTxsPrefix        = []byte{0x04}
+)
+
+// Store is peggo's local state. It keeps what would otherwise be lost on restart: how far
+// Ethereum has been scanned, which claims and relays were submitted and which Ethereum
+// transactions are still pending. All writes are synced to disk.
+type Store struct {
+	db dbm.DB
+
+	claims     *dbm.PrefixDB
+	relays     *dbm.PrefixDB
+	pendingTxs *dbm.PrefixDB
+}
+
+// NewStore opens (or creates) the local state database in the given directory.
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create state dir %s", dir)
+	}
+
+	db, err := dbm.NewGoLevelDB(dbName, dir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open state db in %s", dir)
+	}
+
+	return &Store{
+		db:         db,
+		claims:     dbm.NewPrefixDB(db, claimsPrefix),
+		relays:     dbm.NewPrefixDB(db, relaysPrefix),
+		pendingTxs: dbm.NewPrefixDB(db, pendingTxsPrefix),
+	}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// LastObservedEthBlock returns the last Ethereum block scanned for Peggy events, or 0 if unknown.
+func (s *Store) LastObservedEthBlock() (uint64, error) {
+	bz, err := s.db.Get(lastObservedEthBlockKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read last observed eth block")
+	}
+
+	if bz == nil {
+		return 0, nil
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func (s *Store) SetLastObservedEthBlock(height uint64) error {
+	if err := s.db.SetSync(lastObservedEthBlockKey, uint64ToBytes(height)); err != nil {
+		return errors.Wrap(err, "failed to write last observed eth block")
+	}
+
+	return nil
+}
+
+// Claim is an Ethereum event claim submitted to Injective.
+type Claim struct {
+	EventNonce     uint64    `json:"event_nonce"`
+	EthBlockHeight uint64    `json:"eth_block_height"`
+	SubmittedAt    time.Time `json:"submitted_at"`
+}
+
+func (s *Store) SetClaim(claim Claim) error {
+	return s.set(s.claims, uint64ToBytes(claim.EventNonce), claim)
+}
+
+// LastClaim returns the submitted claim with the highest event nonce, or nil if there is none.
+func (s *Store) LastClaim() (*Claim, error) {
+	iter, err := s.claims.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate claims")
+	}
+
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, nil
+	}
+
+	var claim Claim
+	if err := json.Unmarshal(iter.Value(), &claim); err != nil {
+		return nil, errors.Wrap(err, "failed to decode claim")
+	}
+
+	return &claim, nil
+}
+
+// PruneClaims removes claims with an event nonce lower than the given one.
+func (s *Store) PruneClaims(beforeNonce uint64) error {
+	return s.deleteRange(s.claims, nil, uint64ToBytes(beforeNonce))
+}
+
+type RelayKind string
+
+const (
+	RelayValset RelayKind = "valset"
+	RelayBatch  RelayKind = "batch"
+)
+
+// Relay is a valset update or a transaction batch submitted to the Peggy contract.
+type Relay struct {
+	Kind          RelayKind      `json:"kind"`
+	Nonce         uint64         `json:"nonce"`
+	TokenContract common.Address `json:"token_contract,omitempty"`
+	TxHash        common.Hash    `json:"tx_hash"`
+	SentAt        time.Time      `json:"sent_at"`
+}
+
+func (r Relay) key() []byte {
+	key := append([]byte(r.Kind), r.TokenContract.Bytes()...)
+	return append(key, uint64ToBytes(r.Nonce)...)
+}
+
+func (s *Store) SetRelay(relay Relay) error {
+	return s.set(s.relays, relay.key(), relay)
+}
+
+// Relay returns the submitted relay for the given kind, token and nonce, or nil if there is none.
+// The token contract is ignored for valset updates.
+func (s *Store) Relay(kind RelayKind, tokenContract common.Address, nonce uint64) (*Relay, error) {
+	relay := Relay{Kind: kind, TokenContract: tokenContract, Nonce: nonce}
+	if kind == RelayValset {
+		relay.TokenContract = common.Address{}
+	}
+
+	bz, err := s.relays.Get(relay.key())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read relay")
+	}
+
+	if bz == nil {
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(bz, &relay); err != nil {
+		return nil, errors.Wrap(err, "failed to decode relay")
+	}
+
+	return &relay, nil
+}
+
+func (s *Store) Relays() ([]Relay, error) {
+	var relays []Relay
+	err := s.iterate(s.relays, func(_, value []byte) error {
+		var relay Relay
+		if err := json.Unmarshal(value, &relay); err != nil {
+			return errors.Wrap(err, "failed to decode relay")
+		}
+
+		relays = append(relays, relay)
+		return nil
+	})
+
+	return relays, err
+}
+
+func (s *Store) DeleteRelay(relay Relay) error {
+	if err := s.relays.DeleteSync(relay.key()); err != nil {
+		return errors.Wrap(err, "failed to delete relay")
+	}
+
+	return nil
+}
+
+// PendingTx is an Ethereum transaction sent by peggo that has not been mined yet. Its payload is kept
+// so that it can be re-broadcast with higher fees if it gets stuck.
+type PendingTx struct {
+	Nonce     uint64         `json:"nonce"`
+	TxHash    common.Hash    `json:"tx_hash"`
+	SentAt    time.Time      `json:"sent_at"`
+	GasPrice  *big.Int       `json:"gas_price,omitempty"`
+	GasTipCap *big.Int       `json:"gas_tip_cap,omitempty"`
+	GasFeeCap *big.Int       `json:"gas_fee_cap,omitempty"`
+	Recipient common.Address `json:"recipient"`
+	Data      hexutil.Bytes  `json:"data"`
+	GasLimit  uint64         `json:"gas_limit"`
+}
+
+func (s *Store) PendingTxs() (map[common.Hash]PendingTx, error) {
+	txs := make(map[common.Hash]PendingTx)
+	err := s.iterate(s.pendingTxs, func(key, value []byte) error {
+		var tx PendingTx
+		if err := json.Unmarshal(value, &tx); err != nil {
+			return errors.Wrap(err, "failed to decode pending tx")
+		}
+
+		txs[common.BytesToHash(key)] = tx
+		return nil
+	})
+
+	return txs, err
+}
+
+func (s *Store) SetPendingTx(key common.Hash, tx PendingTx) error {
+	return s.set(s.pendingTxs, key.Bytes(), tx)
+}
+
+func (s *Store) DeletePendingTx(key common.Hash) error {
+	if err := s.pendingTxs.DeleteSync(key.Bytes()); err != nil {
+		return errors.Wrap(err, "failed to delete pending tx")
+	}
+
+	return nil
+}
+
+func (s *Store) set(db *dbm.PrefixDB, key []byte, v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode value")
+	}
+
+	if err := db.SetSync(key, bz); err != nil {
+		return errors.Wrap(err, "failed to write value")
+	}
+
+	return nil
+}
+
+func (s *Store) iterate(db *dbm.PrefixDB, fn func(key, value []byte) error) error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create iterator")
+	}
+
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+
+	return iter.Error()
+}
+
+func (s *Store) deleteRange(db *dbm.PrefixDB, start, end []byte) error {
+	iter, err := db.Iterator(start, end)
+	if err != nil {
+		return errors.Wrap(err, "failed to create iterator")
+	}
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	iter.Close()
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return errors.Wrap(err, "failed to delete value")
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+func uint64ToBytes(v uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, v)
+	return bz
+}
@@ -7,6 +7,8 @@ pushd injective-chain/modules/evm/precompiles/bindings
 # clone and build contracts via forge
 rm -fr solidity-contracts
 git clone --single-branch git@github.com:InjectiveLabs/solidity-contracts.git
+# interfaces kept in this repo until they are upstreamed to solidity-contracts
+cp cosmos/precompile/oracle/Oracle.sol solidity-contracts/src/
 pushd solidity-contracts
 for file in $(find ./src -maxdepth 2 -name '*.sol'); do
     CONTRACT=$(echo "${file##*/}" | sed 's/\.[^.]*$//')
@@ -58,6 +60,11 @@ CONTRACT=StakingTest
 mkdir -p cosmos/precompile/staking/test && \
 ${abigen} --pkg staking --abi "$OUT_DIR/$CONTRACT.sol/$CONTRACT.abi" --bin "$OUT_DIR/$CONTRACT.sol/$CONTRACT.bin" --out "cosmos/precompile/staking/test/staking_test.abigen.go" --type $CONTRACT
 
+# oracle
+CONTRACT=Oracle
+mkdir -p cosmos/precompile/oracle && \
+${abigen} --pkg oracle --abi "$OUT_DIR/$CONTRACT.sol/$CONTRACT.abi" --bin "$OUT_DIR/$CONTRACT.sol/IOracleModule.bin" --out "cosmos/precompile/oracle/i_oracle_module.abigen.go" --type OracleModule
+
 rm -fr solidity-contracts
 popd
 